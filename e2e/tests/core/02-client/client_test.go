@@ -35,6 +35,7 @@ import (
 	"github.com/cosmos/ibc-go/e2e/testvalues"
 	wasmtypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -326,6 +327,146 @@ func (s *ClientTestSuite) TestRecoverClient_Succeeds() {
 	})
 }
 
+// TestRecoverClient_InFlightPacketsSettle simulates a chain halt which lasts longer than the trusting period
+// of the clients used by a channel. A packet is sent before the halt and is left unrelayed, the clients expire
+// and are recovered on both chains via MsgRecoverClient. Once relaying resumes the in-flight packet must settle.
+func (s *ClientTestSuite) TestRecoverClient_InFlightPacketsSettle() {
+	t := s.T()
+	ctx := context.TODO()
+
+	var (
+		relayer            ibc.Relayer
+		subjectPathName    string
+		subjectClientID    string
+		substituteClientID string
+		subjectChannel     ibc.ChannelOutput
+		// set the trusting period to a value which is long enough to complete the connection and channel
+		// handshakes, but which will have elapsed by the time relaying resumes
+		badTrustingPeriod = time.Second * 30
+	)
+
+	t.Run("create substitute clients with correct trusting period", func(t *testing.T) {
+		relayer, _ = s.SetupChainsRelayerAndChannel(ctx, nil)
+
+		// TODO: update when client identifier created is accessible
+		// currently assumes first client is 07-tendermint-0
+		substituteClientID = clienttypes.FormatClientIdentifier(ibcexported.Tendermint, 0)
+	})
+
+	chainA, chainB := s.GetChains()
+	chainADenom := chainA.Config().Denom
+
+	chainAWallet := s.CreateUserOnChainA(ctx, testvalues.StartingTokenAmount)
+	chainAAddress := chainAWallet.FormattedAddress()
+
+	chainBWallet := s.CreateUserOnChainB(ctx, testvalues.StartingTokenAmount)
+	chainBAddress := chainBWallet.FormattedAddress()
+
+	t.Run("create subject clients, connection and channel with bad trusting period", func(t *testing.T) {
+		createClientOptions := ibc.CreateClientOptions{
+			TrustingPeriod: badTrustingPeriod.String(),
+		}
+
+		s.SetupClients(ctx, relayer, createClientOptions)
+		subjectPathName = s.GetPathName(1)
+
+		// TODO: update when client identifier created is accessible
+		// currently assumes second client is 07-tendermint-1
+		subjectClientID = clienttypes.FormatClientIdentifier(ibcexported.Tendermint, 1)
+
+		eRep := s.GetRelayerExecReporter()
+		s.Require().NoError(relayer.CreateConnections(ctx, eRep, subjectPathName))
+		s.Require().NoError(relayer.CreateChannel(ctx, eRep, subjectPathName, ibc.DefaultChannelOpts()))
+
+		channels, err := relayer.GetChannels(ctx, eRep, chainA.Config().ChainID)
+		s.Require().NoError(err)
+		subjectChannel = channels[len(channels)-1]
+	})
+
+	t.Run("send packet over subject channel before the halt", func(t *testing.T) {
+		transferTxResp := s.Transfer(ctx, chainA, chainAWallet, subjectChannel.PortID, subjectChannel.ChannelID, testvalues.DefaultTransferAmount(chainADenom), chainAAddress, chainBAddress, s.GetTimeoutHeight(ctx, chainB), 0, "")
+		s.AssertTxSuccess(transferTxResp)
+	})
+
+	t.Run("tokens are escrowed", func(t *testing.T) {
+		actualBalance, err := s.GetChainANativeBalance(ctx, chainAWallet)
+		s.Require().NoError(err)
+
+		expected := testvalues.StartingTokenAmount - testvalues.IBCTransferAmount
+		s.Require().Equal(expected, actualBalance)
+	})
+
+	// no client updates are submitted for the subject clients for longer than their trusting period,
+	// which is equivalent to the counterparty chain being halted from the point of view of the clients.
+	time.Sleep(badTrustingPeriod)
+
+	t.Run("update substitute clients", func(t *testing.T) {
+		s.UpdateClients(ctx, relayer, s.GetPathName(0))
+	})
+
+	s.Require().NoError(test.WaitForBlocks(ctx, 1, chainA, chainB), "failed to wait for blocks")
+
+	t.Run("subject clients should be expired", func(t *testing.T) {
+		for _, chain := range []ibc.Chain{chainA, chainB} {
+			status, err := query.ClientStatus(ctx, chain, subjectClientID)
+			s.Require().NoError(err)
+			s.Require().Equal(ibcexported.Expired.String(), status)
+		}
+	})
+
+	t.Run("packet commitment still exists on chainA", func(t *testing.T) {
+		resp, err := query.GRPCQuery[channeltypes.QueryPacketCommitmentResponse](ctx, chainA, &channeltypes.QueryPacketCommitmentRequest{
+			PortId:    subjectChannel.PortID,
+			ChannelId: subjectChannel.ChannelID,
+			Sequence:  1,
+		})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(resp.Commitment)
+	})
+
+	t.Run("execute proposals for MsgRecoverClient", func(t *testing.T) {
+		t.Run("on chainA", func(t *testing.T) {
+			authority, err := query.ModuleAccountAddress(ctx, govtypes.ModuleName, chainA)
+			s.Require().NoError(err)
+			recoverClientMsg := clienttypes.NewMsgRecoverClient(authority.String(), subjectClientID, substituteClientID)
+			s.Require().NotNil(recoverClientMsg)
+			s.ExecuteAndPassGovV1Proposal(ctx, recoverClientMsg, chainA, chainAWallet)
+		})
+
+		t.Run("on chainB", func(t *testing.T) {
+			authority, err := query.ModuleAccountAddress(ctx, govtypes.ModuleName, chainB)
+			s.Require().NoError(err)
+			recoverClientMsg := clienttypes.NewMsgRecoverClient(authority.String(), subjectClientID, substituteClientID)
+			s.Require().NotNil(recoverClientMsg)
+			s.ExecuteAndPassGovV1Proposal(ctx, recoverClientMsg, chainB, chainBWallet)
+		})
+	})
+
+	t.Run("subject clients should be active", func(t *testing.T) {
+		for _, chain := range []ibc.Chain{chainA, chainB} {
+			status, err := query.ClientStatus(ctx, chain, subjectClientID)
+			s.Require().NoError(err)
+			s.Require().Equal(ibcexported.Active.String(), status)
+		}
+	})
+
+	t.Run("start relayer on subject path", func(t *testing.T) {
+		s.Require().NoError(relayer.StartRelayer(ctx, s.GetRelayerExecReporter(), subjectPathName))
+		s.Require().NoError(test.WaitForBlocks(ctx, 10, chainA, chainB), "failed to wait for blocks")
+	})
+
+	t.Run("in-flight packet is relayed and acknowledged", func(t *testing.T) {
+		s.AssertPacketRelayed(ctx, chainA, subjectChannel.PortID, subjectChannel.ChannelID, 1)
+
+		chainBIBCToken := testsuite.GetIBCToken(chainADenom, subjectChannel.Counterparty.PortID, subjectChannel.Counterparty.ChannelID)
+		actualBalance, err := query.Balance(ctx, chainB, chainBAddress, chainBIBCToken.IBCDenom())
+		s.Require().NoError(err)
+
+		expected := testvalues.IBCTransferAmount
+		s.Require().Equal(expected, actualBalance.Int64())
+	})
+}
+
 func (s *ClientTestSuite) TestClient_Update_Misbehaviour() {
 	t := s.T()
 	ctx := context.TODO()
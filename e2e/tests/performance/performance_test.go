@@ -0,0 +1,332 @@
+//go:build !test_e2e
+
+package performance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	test "github.com/strangelove-ventures/interchaintest/v8/testutil"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/e2e/testsuite"
+	"github.com/cosmos/ibc-go/e2e/testsuite/performance"
+	e2equery "github.com/cosmos/ibc-go/e2e/testsuite/query"
+	"github.com/cosmos/ibc-go/e2e/testvalues"
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+const (
+	// packetPollInterval is the interval at which packet receipt and acknowledgement are polled.
+	// It bounds the resolution of the measured latencies.
+	packetPollInterval = time.Millisecond * 500
+	// packetRelayTimeout is the time to wait for all packets to be acknowledged once they have been sent.
+	packetRelayTimeout = time.Minute * 5
+	// packetTimeout is the relative timeout timestamp of the packets sent, chosen to never be reached during a test.
+	packetTimeout = time.Hour
+)
+
+func TestPerformanceTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(PerformanceTestSuite))
+}
+
+// PerformanceTestSuite pumps a configurable volume of packets through channels between two chains and
+// writes throughput, latency and gas statistics as JSON reports into e2e/diagnostics/performance.
+type PerformanceTestSuite struct {
+	testsuite.E2ETestSuite
+}
+
+// TestTransferPerformance sends fungible token transfers from a number of accounts on chainA to chainB.
+func (s *PerformanceTestSuite) TestTransferPerformance() {
+	t := s.T()
+	ctx := context.TODO()
+
+	cfg, err := performance.LoadConfig()
+	s.Require().NoError(err)
+
+	relayer, channelA := s.SetupChainsRelayerAndChannel(ctx, s.TransferChannelOptions())
+	chainA, chainB := s.GetChains()
+
+	chainADenom := chainA.Config().Denom
+	chainBAddress := s.CreateUserOnChainB(ctx, testvalues.StartingTokenAmount).FormattedAddress()
+
+	senders := make([]ibc.Wallet, cfg.Senders)
+	for i := range senders {
+		senders[i] = s.CreateUserOnChainA(ctx, testvalues.StartingTokenAmount)
+	}
+
+	s.Require().NoError(test.WaitForBlocks(ctx, 1, chainA, chainB), "failed to wait for blocks")
+
+	t.Run("start relayer", func(t *testing.T) {
+		s.StartRelayer(relayer)
+	})
+
+	var results []performance.PacketResult
+	t.Run("send packets", func(t *testing.T) {
+		results = s.sendPackets(ctx, cfg, chainA, senders, func(sender ibc.Wallet) sdk.Msg {
+			timeoutTimestamp := uint64(time.Now().Add(packetTimeout).UnixNano())
+			return transfertypes.NewMsgTransfer(channelA.PortID, channelA.ChannelID, testvalues.TransferAmount(1, chainADenom), sender.FormattedAddress(), chainBAddress, clienttypes.ZeroHeight(), timeoutTimestamp, "")
+		})
+	})
+
+	t.Run("packets are relayed", func(t *testing.T) {
+		s.trackPackets(ctx, chainA, chainB, channelA, results)
+	})
+
+	s.writeReport(cfg, chainA, chainB, results)
+}
+
+// TestInterchainAccountsPerformance sends interchain accounts transactions executing a bank send on chainB.
+// Packets are sent by the single owner of the interchain account and are therefore broadcast sequentially.
+func (s *PerformanceTestSuite) TestInterchainAccountsPerformance() {
+	t := s.T()
+	ctx := context.TODO()
+
+	cfg, err := performance.LoadConfig()
+	s.Require().NoError(err)
+
+	// setup relayers and connection-0 between two chains
+	// channel-0 is a transfer channel but it will not be used in this test case
+	relayer, _ := s.SetupChainsRelayerAndChannel(ctx, nil)
+	chainA, chainB := s.GetChains()
+
+	controllerAccount := s.CreateUserOnChainA(ctx, testvalues.StartingTokenAmount)
+	controllerAddress := controllerAccount.FormattedAddress()
+	chainBAddress := s.CreateUserOnChainB(ctx, testvalues.StartingTokenAmount).FormattedAddress()
+
+	t.Run("broadcast MsgRegisterInterchainAccount", func(t *testing.T) {
+		// explicitly set the version string because we don't want to use incentivized channels.
+		version := icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+		msgRegisterAccount := controllertypes.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, controllerAddress, version, channeltypes.UNORDERED)
+
+		txResp := s.BroadcastMessages(ctx, chainA, controllerAccount, msgRegisterAccount)
+		s.AssertTxSuccess(txResp)
+	})
+
+	t.Run("start relayer", func(t *testing.T) {
+		s.StartRelayer(relayer)
+	})
+
+	var (
+		hostAccount string
+		icaChannel  ibc.ChannelOutput
+	)
+	t.Run("verify interchain account", func(t *testing.T) {
+		hostAccount, err = e2equery.InterchainAccount(ctx, chainA, controllerAddress, ibctesting.FirstConnectionID)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(hostAccount)
+
+		channels, err := relayer.GetChannels(ctx, s.GetRelayerExecReporter(), chainA.Config().ChainID)
+		s.Require().NoError(err)
+
+		portID, err := icatypes.NewControllerPortID(controllerAddress)
+		s.Require().NoError(err)
+
+		for _, channel := range channels {
+			if channel.PortID == portID {
+				icaChannel = channel
+			}
+		}
+		s.Require().NotEmpty(icaChannel.ChannelID, "interchain account channel not found")
+	})
+
+	t.Run("fund interchain account wallet", func(t *testing.T) {
+		err := chainB.SendFunds(ctx, interchaintest.FaucetAccountKeyName, ibc.WalletAmount{
+			Address: hostAccount,
+			Amount:  sdkmath.NewInt(testvalues.StartingTokenAmount),
+			Denom:   chainB.Config().Denom,
+		})
+		s.Require().NoError(err)
+	})
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: hostAccount,
+		ToAddress:   chainBAddress,
+		Amount:      sdk.NewCoins(testvalues.TransferAmount(1, chainB.Config().Denom)),
+	}
+
+	bz, err := icatypes.SerializeCosmosTx(testsuite.Codec(), []proto.Message{msgSend}, icatypes.EncodingProtobuf)
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: bz,
+	}
+
+	var results []performance.PacketResult
+	t.Run("send packets", func(t *testing.T) {
+		results = s.sendPackets(ctx, cfg, chainA, []ibc.Wallet{controllerAccount}, func(_ ibc.Wallet) sdk.Msg {
+			return controllertypes.NewMsgSendTx(controllerAddress, ibctesting.FirstConnectionID, uint64(packetTimeout.Nanoseconds()), packetData)
+		})
+	})
+
+	t.Run("packets are relayed", func(t *testing.T) {
+		s.trackPackets(ctx, chainA, chainB, icaChannel, results)
+	})
+
+	s.writeReport(cfg, chainA, chainB, results)
+}
+
+// sendPackets broadcasts cfg.PacketCount messages created by newMsg at cfg.PacketsPerSecond. Messages are assigned
+// to the senders in a round robin fashion and each sender broadcasts its messages sequentially.
+func (s *PerformanceTestSuite) sendPackets(ctx context.Context, cfg performance.Config, chain ibc.Chain, senders []ibc.Wallet, newMsg func(sender ibc.Wallet) sdk.Msg) []performance.PacketResult {
+	results := make([]performance.PacketResult, cfg.PacketCount)
+
+	var wg sync.WaitGroup
+	queues := make([]chan int, len(senders))
+	for i, sender := range senders {
+		queues[i] = make(chan int, cfg.PacketCount)
+
+		wg.Add(1)
+		go func(sender ibc.Wallet, queue <-chan int) {
+			defer wg.Done()
+
+			for idx := range queue {
+				results[idx] = s.sendPacket(ctx, chain, sender, newMsg(sender))
+			}
+		}(sender, queues[i])
+	}
+
+	ticker := time.NewTicker(cfg.SendInterval())
+	defer ticker.Stop()
+
+	for i := 0; i < cfg.PacketCount; i++ {
+		if i > 0 {
+			<-ticker.C
+		}
+
+		queues[i%len(queues)] <- i
+	}
+
+	for _, queue := range queues {
+		close(queue)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// sendPacket broadcasts a message which sends a single packet and records its sequence and the gas used.
+func (s *PerformanceTestSuite) sendPacket(ctx context.Context, chain ibc.Chain, sender ibc.Wallet, msg sdk.Msg) performance.PacketResult {
+	result := performance.PacketResult{SubmittedAt: time.Now()}
+
+	resp, err := s.BroadcastMessagesWithoutWait(ctx, chain, sender, msg)
+	if err == nil && resp.Code != 0 {
+		err = fmt.Errorf("transaction failed with code %d: %s", resp.Code, resp.RawLog)
+	}
+
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	packet, err := ibctesting.ParsePacketFromEvents(resp.Events)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Sequence = packet.Sequence
+	result.GasUsed = resp.GasUsed
+
+	return result
+}
+
+// trackPackets polls both chains until every sent packet has been acknowledged, recording the time at which each
+// packet was first observed to be received on the counterparty chain and acknowledged on the sending chain.
+func (s *PerformanceTestSuite) trackPackets(ctx context.Context, chainA, chainB ibc.Chain, channel ibc.ChannelOutput, results []performance.PacketResult) {
+	pending := make(map[uint64]int)
+	for i, result := range results {
+		if result.Err == nil {
+			pending[result.Sequence] = i
+		}
+	}
+
+	err := test.WaitForCondition(packetRelayTimeout, packetPollInterval, func() (bool, error) {
+		observedAt := time.Now()
+
+		sequences := make([]uint64, 0, len(pending))
+		for sequence := range pending {
+			sequences = append(sequences, sequence)
+		}
+
+		acks, err := e2equery.PacketAcknowledgements(ctx, chainB, channel.Counterparty.PortID, channel.Counterparty.ChannelID, sequences)
+		if err != nil {
+			return false, err
+		}
+
+		for _, ack := range acks {
+			if result := &results[pending[ack.Sequence]]; !result.Received() {
+				result.RecvLatency = observedAt.Sub(result.SubmittedAt)
+			}
+		}
+
+		commitments, err := e2equery.GRPCQuery[channeltypes.QueryPacketCommitmentsResponse](ctx, chainA, &channeltypes.QueryPacketCommitmentsRequest{
+			PortId:     channel.PortID,
+			ChannelId:  channel.ChannelID,
+			Pagination: &query.PageRequest{Limit: uint64(len(results))},
+		})
+		if err != nil {
+			return false, err
+		}
+
+		committed := make(map[uint64]struct{}, len(commitments.Commitments))
+		for _, commitment := range commitments.Commitments {
+			committed[commitment.Sequence] = struct{}{}
+		}
+
+		for sequence, idx := range pending {
+			if _, found := committed[sequence]; found {
+				continue
+			}
+
+			// the packet commitment is deleted once the acknowledgement is relayed, packets which were received
+			// and acknowledged between two polls are considered received at the time of the acknowledgement.
+			result := &results[idx]
+			result.AckLatency = observedAt.Sub(result.SubmittedAt)
+			if !result.Received() {
+				result.RecvLatency = result.AckLatency
+			}
+
+			delete(pending, sequence)
+		}
+
+		return len(pending) == 0, nil
+	})
+	s.Require().NoError(err, "%d packets were not acknowledged", len(pending))
+}
+
+// writeReport aggregates the packet results into a performance report, writes it as a JSON artifact and
+// asserts that every packet was sent and acknowledged.
+func (s *PerformanceTestSuite) writeReport(cfg performance.Config, chainA, chainB ibc.Chain, results []performance.PacketResult) {
+	t := s.T()
+
+	report := performance.NewReport(t.Name(), chainA.Config().Images[0].Version, chainB.Config().Images[0].Version, cfg, results)
+
+	filePath, err := performance.WriteReport(t, report)
+	s.Require().NoError(err)
+
+	t.Logf("performance report written to %s: %d/%d packets acknowledged, %.2f packets per second, p95 acknowledgement latency %.2fs",
+		filePath, report.PacketsAcked, cfg.PacketCount, report.ThroughputPerSec, report.AckLatencySeconds.P95)
+
+	s.Require().Zero(report.PacketsFailed, "failed to send %d packets", report.PacketsFailed)
+	s.Require().Equal(cfg.PacketCount, report.PacketsAcked)
+}
@@ -0,0 +1,233 @@
+package performance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-go/e2e/internal/directories"
+)
+
+const (
+	// PacketCountEnv specifies the number of packets sent by a performance test.
+	PacketCountEnv = "PERFORMANCE_PACKET_COUNT"
+	// PacketsPerSecondEnv specifies the rate at which a performance test sends packets.
+	PacketsPerSecondEnv = "PERFORMANCE_PACKETS_PER_SECOND"
+	// SendersEnv specifies the number of accounts which send packets concurrently, where supported by the test.
+	SendersEnv = "PERFORMANCE_SENDERS"
+
+	defaultPacketCount      = 50
+	defaultPacketsPerSecond = 2
+	defaultSenders          = 4
+
+	// reportsDir is the directory relative to the e2e directory which performance reports are written to.
+	reportsDir = "diagnostics/performance"
+
+	defaultFilePerm = 0o750
+)
+
+// Config defines the packet volume generated by a performance test.
+type Config struct {
+	PacketCount      int `json:"packet_count"`
+	PacketsPerSecond int `json:"packets_per_second"`
+	Senders          int `json:"senders"`
+}
+
+// LoadConfig returns the performance test configuration, applying any environment variable overrides to the defaults.
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		PacketCount:      defaultPacketCount,
+		PacketsPerSecond: defaultPacketsPerSecond,
+		Senders:          defaultSenders,
+	}
+
+	for env, field := range map[string]*int{
+		PacketCountEnv:      &cfg.PacketCount,
+		PacketsPerSecondEnv: &cfg.PacketsPerSecond,
+		SendersEnv:          &cfg.Senders,
+	} {
+		value := strings.TrimSpace(os.Getenv(env))
+		if value == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return Config{}, fmt.Errorf("%s must be a positive integer, got %q", env, value)
+		}
+
+		*field = n
+	}
+
+	return cfg, nil
+}
+
+// SendInterval returns the interval between two consecutive packet sends.
+func (c Config) SendInterval() time.Duration {
+	return time.Second / time.Duration(c.PacketsPerSecond)
+}
+
+// PacketResult records the measurements of a single packet sent by a performance test.
+// Latencies are measured from the moment the packet sending transaction is broadcast.
+type PacketResult struct {
+	Sequence    uint64
+	GasUsed     int64
+	SubmittedAt time.Time
+	RecvLatency time.Duration
+	AckLatency  time.Duration
+	Err         error
+}
+
+// Received returns true if the packet was observed to be received on the counterparty chain.
+func (r PacketResult) Received() bool {
+	return r.RecvLatency > 0
+}
+
+// Acknowledged returns true if the packet was observed to be acknowledged on the sending chain.
+func (r PacketResult) Acknowledged() bool {
+	return r.AckLatency > 0
+}
+
+// Stats summarises a set of measurements.
+type Stats struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P95  float64 `json:"p95"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// NewStats computes the statistics of the provided values. The zero value is returned if no values are provided.
+func NewStats(values []float64) Stats {
+	if len(values) == 0 {
+		return Stats{}
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+
+	return Stats{
+		Min:  sorted[0],
+		Mean: sum / float64(len(sorted)),
+		P50:  percentile(sorted, 50),
+		P95:  percentile(sorted, 95),
+		P99:  percentile(sorted, 99),
+		Max:  sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of the provided sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// Report is the JSON artifact written by a performance test.
+type Report struct {
+	Name               string  `json:"name"`
+	ChainAVersion      string  `json:"chain_a_version"`
+	ChainBVersion      string  `json:"chain_b_version"`
+	Config             Config  `json:"config"`
+	PacketsSent        int     `json:"packets_sent"`
+	PacketsFailed      int     `json:"packets_failed"`
+	PacketsReceived    int     `json:"packets_received"`
+	PacketsAcked       int     `json:"packets_acknowledged"`
+	DurationSeconds    float64 `json:"duration_seconds"`
+	ThroughputPerSec   float64 `json:"throughput_packets_per_second"`
+	RecvLatencySeconds Stats   `json:"recv_latency_seconds"`
+	AckLatencySeconds  Stats   `json:"ack_latency_seconds"`
+	GasPerPacket       Stats   `json:"gas_per_packet"`
+}
+
+// NewReport aggregates the provided packet results into a report. The throughput is the number of acknowledged
+// packets divided by the time elapsed between the first packet being submitted and the last acknowledgement.
+func NewReport(name, chainAVersion, chainBVersion string, cfg Config, results []PacketResult) Report {
+	report := Report{
+		Name:          name,
+		ChainAVersion: chainAVersion,
+		ChainBVersion: chainBVersion,
+		Config:        cfg,
+	}
+
+	var (
+		recvLatencies, ackLatencies, gasUsed []float64
+		start, end                           time.Time
+	)
+	for _, result := range results {
+		if result.Err != nil {
+			report.PacketsFailed++
+			continue
+		}
+
+		report.PacketsSent++
+		gasUsed = append(gasUsed, float64(result.GasUsed))
+
+		if start.IsZero() || result.SubmittedAt.Before(start) {
+			start = result.SubmittedAt
+		}
+
+		if result.Received() {
+			report.PacketsReceived++
+			recvLatencies = append(recvLatencies, result.RecvLatency.Seconds())
+		}
+
+		if result.Acknowledged() {
+			report.PacketsAcked++
+			ackLatencies = append(ackLatencies, result.AckLatency.Seconds())
+
+			if ackedAt := result.SubmittedAt.Add(result.AckLatency); ackedAt.After(end) {
+				end = ackedAt
+			}
+		}
+	}
+
+	if end.After(start) {
+		report.DurationSeconds = end.Sub(start).Seconds()
+		report.ThroughputPerSec = float64(report.PacketsAcked) / report.DurationSeconds
+	}
+
+	report.RecvLatencySeconds = NewStats(recvLatencies)
+	report.AckLatencySeconds = NewStats(ackLatencies)
+	report.GasPerPacket = NewStats(gasUsed)
+
+	return report
+}
+
+// WriteReport writes the report as JSON into e2e/diagnostics/performance/<test-name>.json and returns the file path.
+func WriteReport(t *testing.T, report Report) (string, error) {
+	t.Helper()
+
+	e2eDir, err := directories.E2E(t)
+	if err != nil {
+		return "", err
+	}
+
+	filePath := path.Join(e2eDir, reportsDir, fmt.Sprintf("%s.json", t.Name()))
+	if err := os.MkdirAll(path.Dir(filePath), defaultFilePerm); err != nil {
+		return "", err
+	}
+
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(filePath, bz, defaultFilePerm); err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
@@ -38,9 +38,22 @@ import (
 // BroadcastMessages broadcasts the provided messages to the given chain and signs them on behalf of the provided user.
 // Once the broadcast response is returned, we wait for a few blocks to be created on both chain A and chain B.
 func (s *E2ETestSuite) BroadcastMessages(ctx context.Context, chain ibc.Chain, user ibc.Wallet, msgs ...sdk.Msg) sdk.TxResponse {
+	resp, err := s.BroadcastMessagesWithoutWait(ctx, chain, user, msgs...)
+	s.Require().NoError(err)
+
+	chainA, chainB := s.GetChains()
+	s.Require().NoError(test.WaitForBlocks(ctx, 2, chainA, chainB))
+
+	return resp
+}
+
+// BroadcastMessagesWithoutWait broadcasts the provided messages to the given chain and signs them on behalf of the provided user.
+// Unlike BroadcastMessages it returns as soon as the transaction is included in a block and reports errors instead of failing the
+// test, which allows it to be called concurrently for different users.
+func (s *E2ETestSuite) BroadcastMessagesWithoutWait(ctx context.Context, chain ibc.Chain, user ibc.Wallet, msgs ...sdk.Msg) (sdk.TxResponse, error) {
 	cosmosChain, ok := chain.(*cosmos.CosmosChain)
 	if !ok {
-		panic("BroadcastMessages expects a cosmos.CosmosChain")
+		panic("BroadcastMessagesWithoutWait expects a cosmos.CosmosChain")
 	}
 
 	broadcaster := cosmos.NewBroadcaster(s.T(), cosmosChain)
@@ -71,12 +84,8 @@ func (s *E2ETestSuite) BroadcastMessages(ctx context.Context, chain ibc.Chain, u
 	} else {
 		resp, err = broadcastFunc()
 	}
-	s.Require().NoError(err)
 
-	chainA, chainB := s.GetChains()
-	s.Require().NoError(test.WaitForBlocks(ctx, 2, chainA, chainB))
-
-	return resp
+	return resp, err
 }
 
 // retryNtimes retries the provided function up to the provided number of attempts.
@@ -0,0 +1,53 @@
+package logging
+
+import (
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Keys of the structured key-value pairs logged by the IBC keepers. Node log pipelines may rely on these keys
+// to filter the logs of operations on a given client, connection, channel or packet.
+const (
+	KeyClientID        = "client-id"
+	KeyConnectionID    = "connection-id"
+	KeyPortID          = "port-id"
+	KeyChannelID       = "channel-id"
+	KeySequence        = "sequence"
+	KeyHeight          = "height"
+	KeySrcPort         = "src-port"
+	KeySrcChannel      = "src-channel"
+	KeyDstPort         = "dst-port"
+	KeyDstChannel      = "dst-channel"
+	KeyPreviousState   = "previous-state"
+	KeyNewState        = "new-state"
+	KeyUpgradeSequence = "upgrade-sequence"
+	KeyError           = "error"
+)
+
+// WithClient returns a logger which logs the given client identifier with every message.
+func WithClient(logger log.Logger, clientID string) log.Logger {
+	return logger.With(KeyClientID, clientID)
+}
+
+// WithConnection returns a logger which logs the given connection identifier with every message.
+func WithConnection(logger log.Logger, connectionID string) log.Logger {
+	return logger.With(KeyConnectionID, connectionID)
+}
+
+// WithChannel returns a logger which logs the given port and channel identifiers with every message.
+func WithChannel(logger log.Logger, portID, channelID string) log.Logger {
+	return logger.With(KeyPortID, portID, KeyChannelID, channelID)
+}
+
+// WithPacket returns a logger which logs the sequence and the source and destination port and channel
+// identifiers of the given packet with every message.
+func WithPacket(logger log.Logger, packet exported.PacketI) log.Logger {
+	return logger.With(
+		KeySequence, packet.GetSequence(),
+		KeySrcPort, packet.GetSourcePort(),
+		KeySrcChannel, packet.GetSourceChannel(),
+		KeyDstPort, packet.GetDestPort(),
+		KeyDstChannel, packet.GetDestChannel(),
+	)
+}
@@ -18,6 +18,7 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
 		GetCmdParams(),
+		GetCmdQueryRetryPolicy(),
 	)
 
 	return queryCmd
@@ -36,6 +37,7 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		newRegisterInterchainAccountCmd(),
 		newSendTxCmd(),
+		newSetRetryPolicyCmd(),
 	)
 
 	return cmd
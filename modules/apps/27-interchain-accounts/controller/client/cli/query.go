@@ -74,3 +74,37 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryRetryPolicy returns the command handler for the controller submodule retry policy querying.
+func GetCmdQueryRetryPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retry-policy [owner] [connection-id]",
+		Short:   "Query the packet timeout retry policy for a given owner on a particular connection",
+		Long:    "Query the controller submodule for the packet timeout retry policy for a given owner on a particular connection",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller retry-policy cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRetryPolicyRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.RetryPolicy(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +28,7 @@ const (
 	flagOrdering               = "ordering"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagBackoffBlocks          = "backoff-blocks"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -150,6 +152,52 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 	return cmd
 }
 
+func newSetRetryPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-retry-policy [connection-id] [max-retries]",
+		Short: "Set the packet timeout retry policy of the interchain account on the provided connection.",
+		Long: strings.TrimSpace(`Sets the policy used to automatically re-send the packet data of timed out packets sent by the 
+interchain account on the provided connection. Timed out packet data is re-sent up to {max-retries} times, {backoff-blocks} 
+blocks after the timeout is processed, using the relative timeout provided by the {packet-timeout-timestamp} flag. 
+Setting {max-retries} to 0 removes the retry policy.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			owner := clientCtx.GetFromAddress().String()
+
+			maxRetries, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max retries: %w", err)
+			}
+
+			backoffBlocks, err := cmd.Flags().GetUint64(flagBackoffBlocks)
+			if err != nil {
+				return err
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRetryPolicy(owner, connectionID, types.NewRetryPolicy(maxRetries, backoffBlocks, relativeTimeout))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagBackoffBlocks, 0, "Number of blocks to wait after a timeout is processed before the packet data is re-sent.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Relative timeout in nanoseconds of re-sent packets. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // parseOrdering gets the channel ordering from the flags.
 func parseOrdering(cmd *cobra.Command) (channeltypes.Order, error) {
 	orderString, err := cmd.Flags().GetString(flagOrdering)
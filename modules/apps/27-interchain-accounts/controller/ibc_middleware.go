@@ -171,14 +171,34 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 }
 
 // OnRecvPacket implements the IBCMiddleware interface
-func (IBCMiddleware) OnRecvPacket(
+//
+// The controller chain only receives host notification packets over channels which negotiated host notifications.
+// Host notifications are passed to the underlying application if it is set and enabled for the channel, otherwise
+// a successful acknowledgement is returned.
+func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
-	_ sdk.AccAddress,
+	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	err := errorsmod.Wrapf(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain")
-	ack := channeltypes.NewErrorAcknowledgement(err)
-	keeper.EmitAcknowledgementEvent(ctx, packet, ack, err)
+	if err := im.keeper.OnRecvPacket(ctx, packet); err != nil {
+		ack := channeltypes.NewErrorAcknowledgement(err)
+		keeper.EmitAcknowledgementEvent(ctx, packet, ack, err)
+		return ack
+	}
+
+	connectionID, err := im.keeper.GetConnectionID(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		ack := channeltypes.NewErrorAcknowledgement(err)
+		keeper.EmitAcknowledgementEvent(ctx, packet, ack, err)
+		return ack
+	}
+
+	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, packet.GetDestPort(), connectionID) {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	keeper.EmitAcknowledgementEvent(ctx, packet, ack, nil)
 	return ack
 }
 
@@ -198,6 +218,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
+	im.keeper.OnAcknowledgementPacket(ctx, packet)
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
 		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
@@ -19,6 +19,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -519,13 +520,73 @@ func (suite *InterchainAccountsTestSuite) TestOnChanCloseConfirm() {
 }
 
 func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
+	var (
+		path       *ibctesting.Path
+		packetData []byte
+		isNilApp   bool
+	)
+
 	testCases := []struct {
 		name     string
 		malleate func()
-		expPass  bool
+		expErr   error
 	}{
 		{
-			"ICA OnRecvPacket fails with ErrInvalidChannelFlow", func() {}, false,
+			"success", func() {
+				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnRecvPacket = func(
+					ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress,
+				) exported.Acknowledgement {
+					return ibcmock.MockAcknowledgement
+				}
+			}, nil,
+		},
+		{
+			"success: nil underlying app", func() {
+				isNilApp = true
+			}, nil,
+		},
+		{
+			"success: middleware disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+
+				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnRecvPacket = func(
+					ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress,
+				) exported.Acknowledgement {
+					panic("underlying app should be unreachable")
+				}
+			}, nil,
+		},
+		{
+			"failure: controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"failure: host notifications not negotiated", func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.Version = TestVersion })
+			}, icatypes.ErrInvalidChannelFlow,
+		},
+		{
+			"failure: invalid packet data", func() {
+				packetData = []byte("invalid packet data")
+			}, icatypes.ErrUnknownDataType,
+		},
+		{
+			"failure: packet data is not a host notification", func() {
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("notification"),
+				}.GetBytes()
+			}, icatypes.ErrInvalidChannelFlow,
+		},
+		{
+			"failure: ICA auth module callback fails", func() {
+				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnRecvPacket = func(
+					ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress,
+				) exported.Acknowledgement {
+					return channeltypes.NewErrorAcknowledgement(ibcmock.MockApplicationCallbackError)
+				}
+			}, ibcmock.MockApplicationCallbackError,
 		},
 	}
 
@@ -534,13 +595,27 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 		suite.Run(tc.name, func() {
 			suite.SetupTest() // reset
+			isNilApp = false
 
-			path := NewICAPath(suite.chainA, suite.chainB)
+			path = NewICAPath(suite.chainA, suite.chainB)
 			path.SetupConnections()
 
 			err := SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) {
+				metadata, err := icatypes.MetadataFromVersion(channel.Version)
+				suite.Require().NoError(err)
+
+				metadata.HostNotifications = true
+				channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			})
+
+			packetData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.HOST_NOTIFICATION,
+				Data: []byte("notification"),
+			}.GetBytes()
+
 			tc.malleate() // malleate mutates test data
 
 			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
@@ -549,9 +624,13 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 			cbs, ok := suite.chainA.App.GetIBCKeeper().PortKeeper.Route(module)
 			suite.Require().True(ok)
 
+			if isNilApp {
+				cbs = controller.NewIBCMiddleware(nil, suite.chainA.GetSimApp().ICAControllerKeeper)
+			}
+
 			packet := channeltypes.NewPacket(
-				[]byte("empty packet data"),
-				suite.chainB.SenderAccount.GetSequence(),
+				packetData,
+				1,
 				path.EndpointB.ChannelConfig.PortID,
 				path.EndpointB.ChannelID,
 				path.EndpointA.ChannelConfig.PortID,
@@ -560,26 +639,59 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				0,
 			)
 
-			ctx := suite.chainA.GetContext()
-			ack := cbs.OnRecvPacket(ctx, packet, nil)
-			suite.Require().Equal(tc.expPass, ack.Success())
-
-			expectedEvents := sdk.Events{
-				sdk.NewEvent(
-					icatypes.EventTypePacket,
-					sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
-					sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, packet.GetDestChannel()),
-					sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, fmt.Sprintf("%t", false)),
-					sdk.NewAttribute(icatypes.AttributeKeyAckError, "cannot receive packet on controller chain: invalid message sent to channel end"),
-				),
-			}.ToABCIEvents()
-
-			expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
-			ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			ack := cbs.OnRecvPacket(suite.chainA.GetContext(), packet, nil)
+
+			if tc.expErr == nil {
+				suite.Require().True(ack.Success())
+			} else {
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.expErr), ack)
+			}
 		})
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketEvents() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainA.App.GetIBCKeeper().PortKeeper.Route(module)
+	suite.Require().True(ok)
+
+	packet := channeltypes.NewPacket(
+		[]byte("empty packet data"),
+		suite.chainB.SenderAccount.GetSequence(),
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	ctx := suite.chainA.GetContext()
+	ack := cbs.OnRecvPacket(ctx, packet, nil)
+	suite.Require().False(ack.Success())
+
+	expectedEvents := sdk.Events{
+		sdk.NewEvent(
+			icatypes.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, fmt.Sprintf("%t", false)),
+			sdk.NewAttribute(icatypes.AttributeKeyAckError, fmt.Sprintf("cannot receive packet on controller chain, host notifications were not negotiated on channel %s: invalid message sent to channel end", path.EndpointA.ChannelID)),
+		),
+	}.ToABCIEvents()
+
+	expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+	ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 	var (
 		path     *ibctesting.Path
@@ -915,7 +1027,13 @@ func (suite *InterchainAccountsTestSuite) TestOnChanUpgradeAck() {
 		expError error
 	}{
 		{
-			"success", func() {}, nil,
+			"success", func() {
+				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnRecvPacket = func(
+					ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress,
+				) exported.Acknowledgement {
+					return ibcmock.MockAcknowledgement
+				}
+			}, nil,
 		},
 		{
 			"success: nil underlying app",
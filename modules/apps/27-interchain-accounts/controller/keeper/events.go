@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -30,3 +33,93 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet channeltypes.Packet, ack e
 		),
 	)
 }
+
+// emitSendTxEvent emits an event signalling that an interchain account transaction has been sent on the provided
+// channel, listing the type URLs of the messages to be executed on the host chain.
+func emitSendTxEvent(ctx sdk.Context, portID, connectionID, channelID string, sequence uint64, msgTypeURLs []string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeSendTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyMsgTypeURLs, strings.Join(msgTypeURLs, ",")),
+		),
+	)
+}
+
+// emitRetryScheduledEvent emits an event signalling that the packet data of a timed out packet is scheduled to be re-sent.
+func emitRetryScheduledEvent(ctx sdk.Context, portID, connectionID string, packet channeltypes.Packet, attempt, retryHeight uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeRetryScheduled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, packet.GetSourceChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyRetryAttempt, strconv.FormatUint(attempt, 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyRetryHeight, strconv.FormatUint(retryHeight, 10)),
+		),
+	)
+}
+
+// emitRetriesExhaustedEvent emits an event signalling that a timed out packet is not re-sent as its retry policy is exhausted.
+func emitRetriesExhaustedEvent(ctx sdk.Context, portID, connectionID string, packet channeltypes.Packet, attempt uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeRetriesExhausted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, packet.GetSourceChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyRetryAttempt, strconv.FormatUint(attempt, 10)),
+		),
+	)
+}
+
+// emitRetrySentEvent emits an event signalling that the packet data of a pending retry was re-sent.
+func emitRetrySentEvent(ctx sdk.Context, pendingRetry types.PendingRetry, channelID string, sequence uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeRetrySent,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, pendingRetry.PortId),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, pendingRetry.ConnectionId),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyRetryAttempt, strconv.FormatUint(pendingRetry.Attempt, 10)),
+		),
+	)
+}
+
+// emitRetryFailedEvent emits an event signalling that the packet data of a pending retry could not be re-sent.
+func emitRetryFailedEvent(ctx sdk.Context, pendingRetry types.PendingRetry, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeRetryFailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, pendingRetry.PortId),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, pendingRetry.ConnectionId),
+			sdk.NewAttribute(icatypes.AttributeKeyRetryAttempt, strconv.FormatUint(pendingRetry.Attempt, 10)),
+			sdk.NewAttribute(icatypes.AttributeKeyRetryError, err.Error()),
+		),
+	)
+}
+
+// emitChannelCapabilityMigratedEvent emits an event signalling that the active channel of an interchain account
+// has been handed over to the controller submodule.
+func emitChannelCapabilityMigratedEvent(ctx sdk.Context, portID, connectionID, channelID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeChannelMigrated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, channelID),
+		),
+	)
+}
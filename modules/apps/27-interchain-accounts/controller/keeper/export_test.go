@@ -14,3 +14,13 @@ import (
 func (k Keeper) GetAppMetadata(ctx sdk.Context, portID, channelID string) (icatypes.Metadata, error) {
 	return k.getAppMetadata(ctx, portID, channelID)
 }
+
+// GetRetryAttempt is a wrapper around getRetryAttempt to allow the function to be directly called in tests.
+func (k Keeper) GetRetryAttempt(ctx sdk.Context, portID, channelID string, sequence uint64) uint64 {
+	return k.getRetryAttempt(ctx, portID, channelID, sequence)
+}
+
+// SetRetryAttempt is a wrapper around setRetryAttempt to allow the function to be directly called in tests.
+func (k Keeper) SetRetryAttempt(ctx sdk.Context, portID, channelID string, sequence, attempt uint64) {
+	k.setRetryAttempt(ctx, portID, channelID, sequence, attempt)
+}
@@ -40,15 +40,22 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.ControllerGe
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, rp := range state.RetryPolicies {
+		keeper.SetRetryPolicy(ctx, rp.PortId, rp.ConnectionId, rp.RetryPolicy)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
 // ExportGenesis returns the interchain accounts controller exported genesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.ControllerGenesisState {
-	return genesistypes.NewControllerGenesisState(
+	genesisState := genesistypes.NewControllerGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
 	)
+	genesisState.RetryPolicies = keeper.GetAllRetryPolicies(ctx)
+
+	return genesisState
 }
@@ -53,6 +53,13 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: ports,
+		RetryPolicies: []genesistypes.RegisteredRetryPolicy{
+			{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+				RetryPolicy:  types.NewRetryPolicy(3, 10, 100000),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -78,6 +85,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			suite.Require().True(found)
 			suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
+			retryPolicy, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetRetryPolicy(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewRetryPolicy(3, 10, 100000), retryPolicy)
+
 			expParams := types.NewParams(false)
 			params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 			suite.Require().Equal(expParams, params)
@@ -106,6 +117,9 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 	suite.Require().True(exists)
 
+	retryPolicy := types.NewRetryPolicy(3, 10, 100000)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetRetryPolicy(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, retryPolicy)
+
 	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -117,6 +131,10 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
 
+	suite.Require().Equal(path.EndpointA.ConnectionID, genesisState.RetryPolicies[0].ConnectionId)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, genesisState.RetryPolicies[0].PortId)
+	suite.Require().Equal(retryPolicy, genesisState.RetryPolicies[0].RetryPolicy)
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
@@ -46,3 +46,26 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// RetryPolicy implements the Query/RetryPolicy gRPC method
+func (k Keeper) RetryPolicy(goCtx context.Context, req *types.QueryRetryPolicyRequest) (*types.QueryRetryPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	retryPolicy, found := k.GetRetryPolicy(ctx, portID, req.ConnectionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve retry policy for %s on connection %s", portID, req.ConnectionId)
+	}
+
+	return &types.QueryRetryPolicyResponse{
+		RetryPolicy: &retryPolicy,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// HandoverChannelCapability claims the channel capability of the active channel for the provided port and connection
+// identifier pair on behalf of the controller submodule. It is intended to be invoked during a chain upgrade by chains
+// whose legacy authentication modules claimed channel capabilities themselves: the capability must be retrieved using the
+// scoped keeper of the legacy authentication module. If the controller submodule already owns the capability this is a no-op.
+func (k Keeper) HandoverChannelCapability(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID, connectionID string) error {
+	channelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	name := host.ChannelCapabilityPath(portID, channelID)
+	if k.scopedKeeper.AuthenticateCapability(ctx, chanCap, name) {
+		return nil
+	}
+
+	if err := k.ClaimCapability(ctx, chanCap, name); err != nil {
+		return errorsmod.Wrapf(err, "failed to claim capability: %s", name)
+	}
+
+	k.Logger(ctx).Info("successfully claimed channel capability", "name", name)
+
+	return nil
+}
+
+// migrateChannelCapability hands over the active channel for the provided port and connection identifier pair to the
+// controller submodule. Once migrated, the underlying application no longer receives callbacks for the channel and
+// the interchain account is controlled using MsgSendTx. The controller submodule must own the channel capability.
+func (k Keeper) migrateChannelCapability(ctx sdk.Context, portID, connectionID string) (string, error) {
+	channelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	if !k.IsMiddlewareEnabled(ctx, portID, connectionID) {
+		return "", errorsmod.Wrapf(icatypes.ErrInvalidChannelFlow, "channel %s is already controlled by the controller submodule", channelID)
+	}
+
+	name := host.ChannelCapabilityPath(portID, channelID)
+	if _, found := k.scopedKeeper.GetCapability(ctx, name); !found {
+		return "", errorsmod.Wrapf(capabilitytypes.ErrCapabilityNotOwned, "controller submodule does not own capability %s, it must be handed over first", name)
+	}
+
+	k.SetMiddlewareDisabled(ctx, portID, connectionID)
+
+	emitChannelCapabilityMigratedEvent(ctx, portID, connectionID, channelID)
+
+	return channelID, nil
+}
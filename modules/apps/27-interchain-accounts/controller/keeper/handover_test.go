@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandoverChannelCapability() {
+	var (
+		path         *ibctesting.Path
+		connectionID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: capability already owned by the controller submodule",
+			func() {
+				chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.Require().True(found)
+
+				err := suite.chainA.GetSimApp().ScopedICAControllerKeeper.ClaimCapability(suite.chainA.GetContext(), chanCap, host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: active channel not found",
+			func() {
+				connectionID = ibctesting.InvalidID
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			releaseChannelCapabilityToAuthModule(suite.chainA, path)
+			connectionID = path.EndpointA.ConnectionID
+
+			tc.malleate()
+
+			name := host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			chanCap, found := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), name)
+			suite.Require().True(found)
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.HandoverChannelCapability(suite.chainA.GetContext(), chanCap, path.EndpointA.ChannelConfig.PortID, connectionID)
+
+			_, found = suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(suite.chainA.GetContext(), name)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+
+				// the capability owned by the legacy authentication module alongside the controller submodule is valid
+				_, broken := ibckeeper.ChannelCapabilitiesInvariant(suite.chainA.GetSimApp().IBCKeeper)(suite.chainA.GetContext())
+				suite.Require().False(broken)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+// releaseChannelCapabilityToAuthModule mimics a channel opened prior to ibc-go v6 by transferring ownership
+// of the channel capability from the controller submodule to the mock authentication module.
+func releaseChannelCapabilityToAuthModule(chain *ibctesting.TestChain, path *ibctesting.Path) {
+	name := host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	chanCap, found := chain.GetSimApp().ScopedICAControllerKeeper.GetCapability(chain.GetContext(), name)
+	if !found {
+		panic("channel capability not found")
+	}
+
+	if err := chain.GetSimApp().ScopedICAMockKeeper.ClaimCapability(chain.GetContext(), chanCap, name); err != nil {
+		panic(err)
+	}
+
+	if err := chain.GetSimApp().ScopedICAControllerKeeper.ReleaseCapability(chain.GetContext(), chanCap); err != nil {
+		panic(err)
+	}
+}
@@ -281,6 +281,11 @@ func (k Keeper) DeleteMiddlewareEnabled(ctx sdk.Context, portID, connectionID st
 	store.Delete(icatypes.KeyIsMiddlewareEnabled(portID, connectionID))
 }
 
+// GetChannelKeeper returns the channel keeper used by the controller submodule.
+func (k Keeper) GetChannelKeeper() icatypes.ChannelKeeper {
+	return k.channelKeeper
+}
+
 // GetAuthority returns the ica/controller submodule's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
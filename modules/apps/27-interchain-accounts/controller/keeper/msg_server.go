@@ -73,6 +73,25 @@ func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.M
 	return &types.MsgSendTxResponse{Sequence: seq}, nil
 }
 
+// SetRetryPolicy defines a rpc handler for MsgSetRetryPolicy
+func (s msgServer) SetRetryPolicy(goCtx context.Context, msg *types.MsgSetRetryPolicy) (*types.MsgSetRetryPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.RetryPolicy.IsEnabled() {
+		s.Keeper.DeleteRetryPolicy(ctx, portID, msg.ConnectionId)
+		return &types.MsgSetRetryPolicyResponse{}, nil
+	}
+
+	s.Keeper.SetRetryPolicy(ctx, portID, msg.ConnectionId, msg.RetryPolicy)
+
+	return &types.MsgSetRetryPolicyResponse{}, nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ica/controller submodule's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -84,3 +103,29 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// MigrateChannelCapability defines a rpc handler for MsgMigrateChannelCapability
+func (s msgServer) MigrateChannelCapability(goCtx context.Context, msg *types.MsgMigrateChannelCapability) (*types.MsgMigrateChannelCapabilityResponse, error) {
+	if s.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", s.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := s.migrateChannelCapability(ctx, portID, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully migrated channel to controller submodule", "port-id", portID, "channel-id", channelID)
+
+	return &types.MsgMigrateChannelCapabilityResponse{
+		ChannelId: channelID,
+		PortId:    portID,
+	}, nil
+}
@@ -10,11 +10,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -258,3 +260,106 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetRetryPolicy() {
+	suite.SetupTest()
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	retryPolicy := types.NewRetryPolicy(3, 10, 100000)
+
+	_, err := msgServer.SetRetryPolicy(suite.chainA.GetContext(), types.NewMsgSetRetryPolicy(TestOwnerAddress, ibctesting.FirstConnectionID, retryPolicy))
+	suite.Require().NoError(err)
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.RetryPolicy(suite.chainA.GetContext(), &types.QueryRetryPolicyRequest{
+		Owner:        TestOwnerAddress,
+		ConnectionId: ibctesting.FirstConnectionID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(retryPolicy, *res.RetryPolicy)
+
+	// a disabled retry policy removes the existing policy
+	_, err = msgServer.SetRetryPolicy(suite.chainA.GetContext(), types.NewMsgSetRetryPolicy(TestOwnerAddress, ibctesting.FirstConnectionID, types.RetryPolicy{}))
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetRetryPolicy(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestMigrateChannelCapability() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgMigrateChannelCapability
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: active channel not found",
+			func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"failure: channel is already controlled by the controller submodule",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			},
+			icatypes.ErrInvalidChannelFlow,
+		},
+		{
+			"failure: channel capability is owned by a legacy authentication module",
+			func() {
+				releaseChannelCapabilityToAuthModule(suite.chainA, path)
+			},
+			capabilitytypes.ErrCapabilityNotOwned,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgMigrateChannelCapability(suite.chainA.GetSimApp().ICAControllerKeeper.GetAuthority(), TestOwnerAddress, path.EndpointA.ConnectionID)
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.MigrateChannelCapability(suite.chainA.GetContext(), msg)
+
+			isMiddlewareEnabled := suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointA.ChannelID, res.ChannelId)
+				suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, res.PortId)
+				suite.Require().False(isMiddlewareEnabled)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
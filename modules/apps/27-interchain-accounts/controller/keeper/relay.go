@@ -55,11 +55,71 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 		return 0, err
 	}
 
+	emitSendTxEvent(ctx, portID, connectionID, activeChannelID, sequence, k.getMsgTypeURLs(ctx, portID, activeChannelID, icaPacketData))
+
 	return sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
-func (Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
-	return nil
+// getMsgTypeURLs returns the type URLs of the messages contained in the packet data of a transaction sent on the
+// provided channel, decoded with the encoding negotiated in the channel version. The type URLs are only used to
+// inform indexers, so packet data which cannot be decoded is logged and results in no type URLs being returned.
+func (k Keeper) getMsgTypeURLs(ctx sdk.Context, portID, channelID string, icaPacketData icatypes.InterchainAccountPacketData) []string {
+	if icaPacketData.Type != icatypes.EXECUTE_TX {
+		return nil
+	}
+
+	version, found := k.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return nil
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		k.Logger(ctx).Debug("failed to parse interchain account channel version", "port-id", portID, "channel-id", channelID, "error", err)
+		return nil
+	}
+
+	msgTypeURLs, err := icatypes.CosmosTxMessageTypeURLs(icaPacketData.Data, metadata.Encoding)
+	if err != nil {
+		k.Logger(ctx).Debug("failed to decode interchain account packet data", "port-id", portID, "channel-id", channelID, "error", err)
+		return nil
+	}
+
+	return msgTypeURLs
+}
+
+// OnRecvPacket validates a host notification packet received from the host chain. Packets are only accepted if host
+// notifications were negotiated in the version of the channel and the packet data is of the host notification type.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if !k.GetParams(ctx).ControllerEnabled {
+		return types.ErrControllerSubModuleDisabled
+	}
+
+	metadata, err := k.getAppMetadata(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return err
+	}
+
+	if !metadata.HostNotifications {
+		return errorsmod.Wrapf(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain, host notifications were not negotiated on channel %s", packet.GetDestChannel())
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	if data.Type != icatypes.HOST_NOTIFICATION {
+		return errorsmod.Wrapf(icatypes.ErrInvalidChannelFlow, "cannot receive packet of type %s on controller chain", data.Type)
+	}
+
+	return data.ValidateBasic()
+}
+
+// OnTimeoutPacket schedules the packet data of the timed out packet to be re-sent if a retry policy is registered
+// for the interchain account. The underlying channel end is closed due to the semantics of ORDERED channels, in which
+// case the retry is only sent if a new active channel is opened before the retry height.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.scheduleRetry(ctx, packet)
 }
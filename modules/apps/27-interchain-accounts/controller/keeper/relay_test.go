@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"strconv"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	sdkmath "cosmossdk.io/math"
@@ -20,6 +22,7 @@ func (suite *KeeperTestSuite) TestSendTx() {
 		path             *ibctesting.Path
 		packetData       icatypes.InterchainAccountPacketData
 		timeoutTimestamp uint64
+		expMsgTypeURLs   string
 	)
 
 	testCases := []struct {
@@ -75,6 +78,20 @@ func (suite *KeeperTestSuite) TestSendTx() {
 					Type: icatypes.EXECUTE_TX,
 					Data: data,
 				}
+
+				expMsgTypeURLs = sdk.MsgTypeURL(&banktypes.MsgSend{}) + "," + sdk.MsgTypeURL(&banktypes.MsgSend{})
+			},
+			true,
+		},
+		{
+			"success with packet data which cannot be decoded",
+			func() {
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("invalid packet data"),
+				}
+
+				expMsgTypeURLs = ""
 			},
 			true,
 		},
@@ -148,6 +165,7 @@ func (suite *KeeperTestSuite) TestSendTx() {
 		suite.Run(tc.msg, func() {
 			suite.SetupTest()             // reset
 			timeoutTimestamp = ^uint64(0) // default
+			expMsgTypeURLs = sdk.MsgTypeURL(&banktypes.MsgSend{})
 
 			path = NewICAPath(suite.chainA, suite.chainB)
 			path.SetupConnections()
@@ -157,11 +175,28 @@ func (suite *KeeperTestSuite) TestSendTx() {
 
 			tc.malleate() // malleate mutates test data
 
+			ctx := suite.chainA.GetContext()
+
 			//nolint: staticcheck // SA1019: ibctesting.FirstConnectionID is deprecated: use path.EndpointA.ConnectionID instead. (staticcheck)
-			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(ctx, nil, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						icatypes.EventTypeSendTx,
+						sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+						sdk.NewAttribute(icatypes.AttributeKeyPortID, path.EndpointA.ChannelConfig.PortID),
+						sdk.NewAttribute(icatypes.AttributeKeyConnectionID, path.EndpointA.ConnectionID),
+						sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, path.EndpointA.ChannelID),
+						sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+						sdk.NewAttribute(icatypes.AttributeKeyMsgTypeURLs, expMsgTypeURLs),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
 			} else {
 				suite.Require().Error(err)
 			}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// GetRetryPolicy returns the packet timeout retry policy for the provided portID and connectionID.
+func (k Keeper) GetRetryPolicy(ctx sdk.Context, portID, connectionID string) (types.RetryPolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRetryPolicy(portID, connectionID))
+	if len(bz) == 0 {
+		return types.RetryPolicy{}, false
+	}
+
+	var retryPolicy types.RetryPolicy
+	k.cdc.MustUnmarshal(bz, &retryPolicy)
+	return retryPolicy, true
+}
+
+// SetRetryPolicy stores the packet timeout retry policy for the provided portID and connectionID.
+func (k Keeper) SetRetryPolicy(ctx sdk.Context, portID, connectionID string, retryPolicy types.RetryPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRetryPolicy(portID, connectionID), k.cdc.MustMarshal(&retryPolicy))
+}
+
+// DeleteRetryPolicy removes the packet timeout retry policy for the provided portID and connectionID.
+func (k Keeper) DeleteRetryPolicy(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRetryPolicy(portID, connectionID))
+}
+
+// GetAllRetryPolicies returns all packet timeout retry policies stored by the controller submodule. Used in ExportGenesis
+func (k Keeper) GetAllRetryPolicies(ctx sdk.Context) []genesistypes.RegisteredRetryPolicy {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RetryPolicyKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var retryPolicies []genesistypes.RegisteredRetryPolicy
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		var retryPolicy types.RetryPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &retryPolicy)
+
+		retryPolicies = append(retryPolicies, genesistypes.RegisteredRetryPolicy{
+			PortId:       keySplit[1],
+			ConnectionId: keySplit[2],
+			RetryPolicy:  retryPolicy,
+		})
+	}
+
+	return retryPolicies
+}
+
+// getRetryAttempt returns the retry attempt number of the packet sent with the provided sequence. Zero is returned
+// for packets which were not re-sent by the controller submodule.
+func (k Keeper) getRetryAttempt(ctx sdk.Context, portID, channelID string, sequence uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRetryAttempt(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setRetryAttempt stores the retry attempt number of the packet sent with the provided sequence.
+func (k Keeper) setRetryAttempt(ctx sdk.Context, portID, channelID string, sequence, attempt uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRetryAttempt(portID, channelID, sequence), sdk.Uint64ToBigEndian(attempt))
+}
+
+// deleteRetryAttempt removes the retry attempt number of the packet sent with the provided sequence.
+func (k Keeper) deleteRetryAttempt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRetryAttempt(portID, channelID, sequence))
+}
+
+// setPendingRetry schedules the provided pending retry to be re-sent at the provided height.
+func (k Keeper) setPendingRetry(ctx sdk.Context, height uint64, packet channeltypes.Packet, pendingRetry types.PendingRetry) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPendingRetry(height, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	store.Set(key, k.cdc.MustMarshal(&pendingRetry))
+}
+
+// OnAcknowledgementPacket removes the retry attempt bookkeeping of an acknowledged packet.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.deleteRetryAttempt(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// scheduleRetry schedules the packet data of the provided timed out packet to be re-sent if a retry policy is
+// registered for the packet's port and connection and the policy's maximum number of retries is not exhausted.
+func (k Keeper) scheduleRetry(ctx sdk.Context, packet channeltypes.Packet) error {
+	portID, channelID, sequence := packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()
+
+	attempt := k.getRetryAttempt(ctx, portID, channelID, sequence)
+	k.deleteRetryAttempt(ctx, portID, channelID, sequence)
+
+	connectionID, err := k.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	retryPolicy, found := k.GetRetryPolicy(ctx, portID, connectionID)
+	if !found || !retryPolicy.IsEnabled() {
+		return nil
+	}
+
+	if attempt >= retryPolicy.MaxRetries {
+		emitRetriesExhaustedEvent(ctx, portID, connectionID, packet, attempt)
+		return nil
+	}
+
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		return err
+	}
+
+	retryHeight := uint64(ctx.BlockHeight()) + retryPolicy.BackoffBlocks
+	k.setPendingRetry(ctx, retryHeight, packet, types.PendingRetry{
+		ConnectionId: connectionID,
+		PortId:       portID,
+		PacketData:   packetData,
+		Attempt:      attempt + 1,
+	})
+
+	emitRetryScheduledEvent(ctx, portID, connectionID, packet, attempt+1, retryHeight)
+
+	return nil
+}
+
+// ProcessPendingRetries re-sends the packet data of all pending retries scheduled at or before the current block
+// height. Pending retries are removed from state regardless of the outcome, a retry which cannot be sent (for
+// example because the interchain account has no open active channel) is not rescheduled.
+func (k Keeper) ProcessPendingRetries(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingRetryKeyPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var (
+		keys           [][]byte
+		pendingRetries []types.PendingRetry
+	)
+
+	endKey := types.KeyPendingRetryHeight(uint64(ctx.BlockHeight()) + 1)
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Key()) >= string(endKey) {
+			break
+		}
+
+		var pendingRetry types.PendingRetry
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingRetry)
+
+		keys = append(keys, iterator.Key())
+		pendingRetries = append(pendingRetries, pendingRetry)
+	}
+
+	for i, pendingRetry := range pendingRetries {
+		store.Delete(keys[i])
+		k.sendRetry(ctx, pendingRetry)
+	}
+}
+
+// sendRetry re-sends the packet data of the provided pending retry using the relative timeout of the retry policy
+// currently registered for the interchain account. Failures are emitted as events and do not revert state changes
+// of other retries.
+func (k Keeper) sendRetry(ctx sdk.Context, pendingRetry types.PendingRetry) {
+	portID, connectionID := pendingRetry.PortId, pendingRetry.ConnectionId
+
+	retryPolicy, found := k.GetRetryPolicy(ctx, portID, connectionID)
+	if !found || !retryPolicy.IsEnabled() {
+		emitRetryFailedEvent(ctx, pendingRetry, types.ErrRetryPolicyNotFound)
+		return
+	}
+
+	channelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		emitRetryFailedEvent(ctx, pendingRetry, icatypes.ErrActiveChannelNotFound)
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + retryPolicy.RelativeTimeout
+	sequence, err := k.sendTx(cacheCtx, connectionID, portID, pendingRetry.PacketData, timeoutTimestamp)
+	if err != nil {
+		k.Logger(ctx).Error("failed to re-send interchain account packet data", "port-id", portID, "connection-id", connectionID, "attempt", pendingRetry.Attempt, "error", err.Error())
+		emitRetryFailedEvent(ctx, pendingRetry, err)
+		return
+	}
+
+	writeFn()
+
+	k.setRetryAttempt(ctx, portID, channelID, sequence, pendingRetry.Attempt)
+	emitRetrySentEvent(ctx, pendingRetry, channelID, sequence)
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestPacketTimeoutRetry() {
+	var (
+		path        *ibctesting.Path
+		ctx         sdk.Context
+		sequence    uint64
+		retryPolicy types.RetryPolicy
+	)
+
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expEventTypes []string
+		expResend     bool
+	}{
+		{
+			"success: packet data is re-sent",
+			func() {},
+			[]string{icatypes.EventTypeRetryScheduled, icatypes.EventTypeSendTx, icatypes.EventTypeRetrySent},
+			true,
+		},
+		{
+			"success: packet data is re-sent after backoff",
+			func() {
+				retryPolicy.BackoffBlocks = 2
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetRetryPolicy(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, retryPolicy)
+			},
+			[]string{icatypes.EventTypeRetryScheduled, icatypes.EventTypeSendTx, icatypes.EventTypeRetrySent},
+			true,
+		},
+		{
+			"no retry policy registered",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.DeleteRetryPolicy(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			},
+			nil,
+			false,
+		},
+		{
+			"retries exhausted",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetRetryAttempt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, retryPolicy.MaxRetries)
+			},
+			[]string{icatypes.EventTypeRetriesExhausted},
+			false,
+		},
+		{
+			"retry fails: active channel is not open",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			[]string{icatypes.EventTypeRetryScheduled, icatypes.EventTypeRetryFailed},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+			ctx = suite.chainA.GetContext()
+
+			retryPolicy = types.NewRetryPolicy(2, 0, uint64(ibctesting.TimeIncrement.Nanoseconds()))
+			controllerKeeper.SetRetryPolicy(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, retryPolicy)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+
+			timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + 1
+			sequence, err = controllerKeeper.SendTx(ctx, nil, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				sequence,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.ZeroHeight(),
+				timeoutTimestamp,
+			)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err = controllerKeeper.OnTimeoutPacket(ctx, packet)
+			suite.Require().NoError(err)
+
+			if retryPolicy.BackoffBlocks > 0 {
+				// retries are not re-sent before the backoff has elapsed
+				controllerKeeper.ProcessPendingRetries(ctx.WithBlockHeight(ctx.BlockHeight() + int64(retryPolicy.BackoffBlocks) - 1))
+				suite.Require().Len(ctx.EventManager().Events(), 1)
+
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(retryPolicy.BackoffBlocks))
+			}
+
+			controllerKeeper.ProcessPendingRetries(ctx)
+
+			var eventTypes []string
+			for _, event := range ctx.EventManager().Events() {
+				if strings.HasPrefix(event.Type, "ics27_") {
+					eventTypes = append(eventTypes, event.Type)
+				}
+			}
+			suite.Require().Equal(tc.expEventTypes, eventTypes)
+
+			resendSequence := sequence + 1
+			commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, resendSequence)
+			if tc.expResend {
+				suite.Require().NotEmpty(commitment)
+				suite.Require().Equal(uint64(1), controllerKeeper.GetRetryAttempt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, resendSequence))
+
+				// pending retries are only processed once
+				expEvents := ctx.EventManager().Events()
+				controllerKeeper.ProcessPendingRetries(ctx)
+				suite.Require().Equal(expEvents, ctx.EventManager().Events())
+			} else {
+				suite.Require().Empty(commitment)
+			}
+
+			// the retry attempt of the timed out packet is always removed
+			suite.Require().Zero(controllerKeeper.GetRetryAttempt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence))
+		})
+	}
+}
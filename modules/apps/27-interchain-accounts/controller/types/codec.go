@@ -13,6 +13,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterInterchainAccount{},
 		&MsgSendTx{},
 		&MsgUpdateParams{},
+		&MsgSetRetryPolicy{},
+		&MsgMigrateChannelCapability{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -69,8 +71,146 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+// RetryPolicy defines the policy used by the controller submodule to automatically re-send the packet data
+// of timed out packets sent by an interchain account owner on a given connection.
+type RetryPolicy struct {
+	// max_retries defines the maximum number of times the packet data of a timed out packet is re-sent.
+	MaxRetries uint64 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// backoff_blocks defines the number of blocks to wait after a timeout is processed before the packet data is re-sent.
+	BackoffBlocks uint64 `protobuf:"varint,2,opt,name=backoff_blocks,json=backoffBlocks,proto3" json:"backoff_blocks,omitempty"`
+	// relative_timeout defines the relative timeout (in nanoseconds) added to the block time at which a packet is
+	// re-sent.
+	RelativeTimeout uint64 `protobuf:"varint,3,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxRetries() uint64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffBlocks() uint64 {
+	if m != nil {
+		return m.BackoffBlocks
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+// PendingRetry defines the packet data of a timed out packet which is scheduled to be re-sent.
+type PendingRetry struct {
+	ConnectionId string                            `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId       string                            `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PacketData   types.InterchainAccountPacketData `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	// attempt defines the retry attempt number, starting at one for the first re-send.
+	Attempt uint64 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (m *PendingRetry) Reset()         { *m = PendingRetry{} }
+func (m *PendingRetry) String() string { return proto.CompactTextString(m) }
+func (*PendingRetry) ProtoMessage()    {}
+func (*PendingRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{2}
+}
+func (m *PendingRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRetry.Merge(m, src)
+}
+func (m *PendingRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRetry proto.InternalMessageInfo
+
+func (m *PendingRetry) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PendingRetry) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingRetry) GetPacketData() types.InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return types.InterchainAccountPacketData{}
+}
+
+func (m *PendingRetry) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*RetryPolicy)(nil), "ibc.applications.interchain_accounts.controller.v1.RetryPolicy")
+	proto.RegisterType((*PendingRetry)(nil), "ibc.applications.interchain_accounts.controller.v1.PendingRetry")
 }
 
 func init() {
@@ -78,21 +218,35 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xa5, 0x42, 0xd9, 0xc8, 0xc4, 0x64, 0x21, 0x26, 0x96, 0xf8, 0xd4, 0x32, 0x94,
-	0x19, 0xc4, 0x5e, 0x31, 0xb2, 0x54, 0xf6, 0xd5, 0x6a, 0x0f, 0x39, 0xbe, 0xc8, 0xe7, 0x44, 0xe2,
-	0x2d, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x04, 0x1d, 0x92, 0x21, 0xe3, 0xe9, 0xd3,
-	0x7d, 0xfa, 0xbf, 0xf2, 0x99, 0x1c, 0x82, 0x6d, 0xdb, 0x40, 0x68, 0x33, 0x71, 0x14, 0xa0, 0x98,
-	0x7d, 0xc2, 0x93, 0xa5, 0xb8, 0xb7, 0x88, 0xdc, 0xc5, 0x2c, 0x80, 0x1c, 0x73, 0xe2, 0x10, 0x7c,
-	0x82, 0x7e, 0x3d, 0xbb, 0x4c, 0x9b, 0x38, 0x73, 0xb5, 0x21, 0x87, 0x66, 0x2e, 0x31, 0x0b, 0x12,
-	0x33, 0x7b, 0xeb, 0xd7, 0x77, 0xdb, 0x72, 0xb5, 0xb3, 0xc9, 0x36, 0x52, 0xd5, 0x65, 0x35, 0xa1,
-	0xbd, 0x8f, 0xd6, 0x05, 0x7f, 0xb8, 0x51, 0xb7, 0xea, 0xfe, 0xea, 0xf5, 0x7a, 0x22, 0x2f, 0x17,
-	0xf0, 0xf4, 0xfe, 0x35, 0x68, 0x75, 0x1e, 0xb4, 0xfa, 0x19, 0xb4, 0xfa, 0x1c, 0x75, 0x71, 0x1e,
-	0x75, 0xf1, 0x3d, 0xea, 0xe2, 0x6d, 0x77, 0xa4, 0x7c, 0xea, 0x9c, 0x41, 0x6e, 0x00, 0x59, 0x1a,
-	0x16, 0x20, 0x87, 0xf5, 0x91, 0xa1, 0x7f, 0x84, 0x86, 0x0f, 0x5d, 0xf0, 0xf2, 0xd7, 0x2a, 0xb0,
-	0xd9, 0xd6, 0xd3, 0xc2, 0x7a, 0x29, 0x33, 0x7f, 0xb4, 0x5e, 0xdc, 0xea, 0xbf, 0xef, 0xe1, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x7d, 0x5b, 0xa9, 0x45, 0x26, 0x01, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6e, 0xdb, 0x30,
+	0x10, 0xc6, 0xad, 0xd4, 0x70, 0x5a, 0x3a, 0xe9, 0x1f, 0xa2, 0x40, 0x85, 0x0c, 0x4a, 0xe1, 0xa2,
+	0x40, 0x3b, 0x58, 0x84, 0xdd, 0x02, 0xe9, 0x5a, 0x37, 0x1d, 0xbc, 0x09, 0x42, 0xa7, 0x2e, 0x02,
+	0x45, 0x31, 0x0a, 0x6b, 0x89, 0x27, 0x90, 0x67, 0x21, 0xde, 0xfa, 0x08, 0x7d, 0xac, 0x8c, 0x01,
+	0xba, 0x74, 0x2a, 0x0a, 0xfb, 0x45, 0x0a, 0x91, 0x49, 0xec, 0x21, 0x83, 0x37, 0xdd, 0xef, 0xf8,
+	0x7d, 0xfc, 0x4e, 0x3c, 0xf2, 0x45, 0xe5, 0x82, 0xf1, 0xa6, 0xa9, 0x94, 0xe0, 0xa8, 0x40, 0x5b,
+	0xa6, 0x34, 0x4a, 0x23, 0x2e, 0xb9, 0xd2, 0x19, 0x17, 0x02, 0x96, 0x1a, 0x2d, 0x13, 0xa0, 0xd1,
+	0x40, 0x55, 0x49, 0xc3, 0xda, 0xc9, 0x4e, 0x15, 0x37, 0x06, 0x10, 0xe8, 0x54, 0xe5, 0x22, 0xde,
+	0x35, 0x89, 0x1f, 0x30, 0x89, 0x77, 0x64, 0xed, 0xe4, 0xe4, 0x65, 0x09, 0x25, 0x38, 0x39, 0xeb,
+	0xbe, 0xbc, 0xd3, 0xc9, 0xc7, 0xbd, 0xe2, 0xb4, 0x13, 0xd6, 0x70, 0xb1, 0x90, 0xe8, 0x55, 0xa3,
+	0x33, 0x32, 0x48, 0xb8, 0xe1, 0xb5, 0xa5, 0x63, 0x42, 0xb7, 0xd7, 0x64, 0x52, 0xf3, 0xbc, 0x92,
+	0x45, 0x18, 0xbc, 0x0e, 0xde, 0x3d, 0x4e, 0x5f, 0x6c, 0x3b, 0x5f, 0x7d, 0x63, 0xf4, 0x33, 0x20,
+	0xc3, 0x54, 0xa2, 0x59, 0x25, 0x50, 0x29, 0xb1, 0xa2, 0xa7, 0x64, 0x58, 0xf3, 0xab, 0xcc, 0x48,
+	0x34, 0x4a, 0x5a, 0xa7, 0xeb, 0xa7, 0xa4, 0xe6, 0x57, 0xa9, 0x27, 0xf4, 0x2d, 0x79, 0x9a, 0x73,
+	0xb1, 0x80, 0x8b, 0x8b, 0x2c, 0xaf, 0x40, 0x2c, 0x6c, 0x78, 0xe0, 0xce, 0x1c, 0xdf, 0xd2, 0x99,
+	0x83, 0xf4, 0x3d, 0x79, 0x6e, 0x64, 0xc5, 0x51, 0xb5, 0x32, 0x43, 0x55, 0x4b, 0x58, 0x62, 0xf8,
+	0xc8, 0x1d, 0x7c, 0x76, 0xc7, 0xbf, 0x79, 0x3c, 0xfa, 0x1d, 0x90, 0xa3, 0x44, 0xea, 0x42, 0xe9,
+	0xd2, 0x25, 0xa1, 0x6f, 0xc8, 0xb1, 0x00, 0xad, 0xa5, 0xe8, 0xe6, 0xcf, 0x94, 0x4f, 0xff, 0x24,
+	0x3d, 0xda, 0xc2, 0x79, 0x41, 0x5f, 0x91, 0xc3, 0x06, 0x0c, 0x76, 0xed, 0x03, 0xd7, 0x1e, 0x74,
+	0xe5, 0xbc, 0xa0, 0x0b, 0x32, 0xf4, 0xbf, 0x26, 0x2b, 0x38, 0x72, 0x77, 0xe9, 0x70, 0x7a, 0x1e,
+	0xef, 0xf5, 0x40, 0xed, 0x24, 0x9e, 0xdf, 0xe3, 0xcf, 0x9e, 0x26, 0xce, 0xec, 0x9c, 0x23, 0x9f,
+	0xf5, 0xaf, 0xff, 0x9e, 0xf6, 0x52, 0xd2, 0xdc, 0x13, 0x1a, 0x92, 0x43, 0x8e, 0x28, 0xeb, 0x06,
+	0xc3, 0xbe, 0x9b, 0xee, 0xae, 0x9c, 0xfd, 0xb8, 0x5e, 0x47, 0xc1, 0xcd, 0x3a, 0x0a, 0xfe, 0xad,
+	0xa3, 0xe0, 0xd7, 0x26, 0xea, 0xdd, 0x6c, 0xa2, 0xde, 0x9f, 0x4d, 0xd4, 0xfb, 0x9e, 0x94, 0x0a,
+	0x2f, 0x97, 0x79, 0x2c, 0xa0, 0x66, 0x02, 0x6c, 0x0d, 0x96, 0xa9, 0x5c, 0x8c, 0x4b, 0x60, 0xed,
+	0x27, 0x56, 0x43, 0xb1, 0xac, 0xa4, 0xed, 0x36, 0xc0, 0xb2, 0xe9, 0xd9, 0x78, 0x9b, 0x72, 0xfc,
+	0xd0, 0x2e, 0xe2, 0xaa, 0x91, 0x36, 0x1f, 0xb8, 0x25, 0xf8, 0xf0, 0x7f, 0x00, 0x42, 0xaf, 0x44,
+	0x10, 0xcb, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -128,6 +282,96 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BackoffBlocks != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.BackoffBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintController(dAtA []byte, offset int, v uint64) int {
 	offset -= sovController(v)
 	base := offset
@@ -151,6 +395,46 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		n += 1 + sovController(uint64(m.MaxRetries))
+	}
+	if m.BackoffBlocks != 0 {
+		n += 1 + sovController(uint64(m.BackoffBlocks))
+	}
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovController(uint64(m.RelativeTimeout))
+	}
+	return n
+}
+
+func (m *PendingRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.Attempt != 0 {
+		n += 1 + sovController(uint64(m.Attempt))
+	}
+	return n
+}
+
 func sovController(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -227,6 +511,279 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffBlocks", wireType)
+			}
+			m.BackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipController(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,4 +7,5 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrRetryPolicyNotFound         = errorsmod.Register(SubModuleName, 3, "retry policy not found")
 )
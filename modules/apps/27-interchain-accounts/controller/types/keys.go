@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
@@ -10,3 +16,35 @@ const (
 	// ParamsKey is the store key for the interchain accounts controller parameters
 	ParamsKey = "params"
 )
+
+var (
+	// RetryPolicyKeyPrefix defines the key prefix used to store packet timeout retry policies
+	RetryPolicyKeyPrefix = "retryPolicy"
+
+	// PendingRetryKeyPrefix defines the key prefix used to store packet data scheduled to be re-sent
+	PendingRetryKeyPrefix = "pendingRetry"
+
+	// RetryAttemptKeyPrefix defines the key prefix used to store the retry attempt number of re-sent packets
+	RetryAttemptKeyPrefix = "retryAttempt"
+)
+
+// KeyRetryPolicy creates and returns a new key used for retry policy store operations
+func KeyRetryPolicy(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RetryPolicyKeyPrefix, portID, connectionID))
+}
+
+// KeyPendingRetryHeight creates and returns the key prefix of all pending retries scheduled for the provided height.
+// The height is encoded in big endian to allow pending retries to be iterated in ascending height order.
+func KeyPendingRetryHeight(height uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/", PendingRetryKeyPrefix)), sdk.Uint64ToBigEndian(height)...)
+}
+
+// KeyPendingRetry creates and returns a new key used for pending retry store operations
+func KeyPendingRetry(height uint64, portID, channelID string, sequence uint64) []byte {
+	return append(KeyPendingRetryHeight(height), []byte(fmt.Sprintf("/%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// KeyRetryAttempt creates and returns a new key used for retry attempt store operations
+func KeyRetryAttempt(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", RetryAttemptKeyPrefix, portID, channelID, sequence))
+}
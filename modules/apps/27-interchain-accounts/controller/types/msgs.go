@@ -20,10 +20,14 @@ var (
 	_ sdk.Msg = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.Msg = (*MsgSendTx)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgSetRetryPolicy)(nil)
+	_ sdk.Msg = (*MsgMigrateChannelCapability)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendTx)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetRetryPolicy)(nil)
+	_ sdk.HasValidateBasic = (*MsgMigrateChannelCapability)(nil)
 )
 
 // NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
@@ -109,3 +113,64 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetRetryPolicy creates a new instance of MsgSetRetryPolicy
+func NewMsgSetRetryPolicy(owner, connectionID string, retryPolicy RetryPolicy) *MsgSetRetryPolicy {
+	return &MsgSetRetryPolicy{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		RetryPolicy:  retryPolicy,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetRetryPolicy) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	if err := msg.RetryPolicy.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid retry policy")
+	}
+
+	return nil
+}
+
+// NewMsgMigrateChannelCapability creates a new instance of MsgMigrateChannelCapability
+func NewMsgMigrateChannelCapability(signer, owner, connectionID string) *MsgMigrateChannelCapability {
+	return &MsgMigrateChannelCapability{
+		Signer:       signer,
+		Owner:        owner,
+		ConnectionId: connectionID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgMigrateChannelCapability) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	return nil
+}
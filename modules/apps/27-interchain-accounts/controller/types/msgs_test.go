@@ -285,3 +285,128 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 
 	}
 }
+
+func TestMsgSetRetryPolicyValidateBasic(t *testing.T) {
+	var msg *types.MsgSetRetryPolicy
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: disabled retry policy",
+			func() {
+				msg.RetryPolicy = types.RetryPolicy{}
+			},
+			true,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"owner address is too long",
+			func() {
+				msg.Owner = ibctesting.GenerateString(types.MaximumOwnerLength + 1)
+			},
+			false,
+		},
+		{
+			"relative timeout is not set",
+			func() {
+				msg.RetryPolicy.RelativeTimeout = 0
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		msg = types.NewMsgSetRetryPolicy(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, types.NewRetryPolicy(3, 10, 100000))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgMigrateChannelCapabilityValidateBasic(t *testing.T) {
+	var msg *types.MsgMigrateChannelCapability
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"failure: malformed signer address",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"owner address is too long",
+			func() {
+				msg.Owner = ibctesting.GenerateString(types.MaximumOwnerLength + 1)
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		msg = types.NewMsgMigrateChannelCapability(ibctesting.TestAccAddress, ibctesting.TestAccAddress, ibctesting.FirstConnectionID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
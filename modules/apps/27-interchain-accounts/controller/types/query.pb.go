@@ -209,11 +209,111 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryRetryPolicyRequest is the request type for the Query/RetryPolicy RPC method.
+type QueryRetryPolicyRequest struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryRetryPolicyRequest) Reset()         { *m = QueryRetryPolicyRequest{} }
+func (m *QueryRetryPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRetryPolicyRequest) ProtoMessage()    {}
+func (*QueryRetryPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *QueryRetryPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetryPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetryPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRetryPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetryPolicyRequest.Merge(m, src)
+}
+func (m *QueryRetryPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetryPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetryPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetryPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryRetryPolicyRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryRetryPolicyRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryRetryPolicyResponse is the response type for the Query/RetryPolicy RPC method.
+type QueryRetryPolicyResponse struct {
+	RetryPolicy *RetryPolicy `protobuf:"bytes,1,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+}
+
+func (m *QueryRetryPolicyResponse) Reset()         { *m = QueryRetryPolicyResponse{} }
+func (m *QueryRetryPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRetryPolicyResponse) ProtoMessage()    {}
+func (*QueryRetryPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{5}
+}
+func (m *QueryRetryPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetryPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetryPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRetryPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetryPolicyResponse.Merge(m, src)
+}
+func (m *QueryRetryPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetryPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetryPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetryPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryRetryPolicyResponse) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
 	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRetryPolicyRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryRetryPolicyRequest")
+	proto.RegisterType((*QueryRetryPolicyResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryRetryPolicyResponse")
 }
 
 func init() {
@@ -221,35 +321,39 @@ func init() {
 }
 
 var fileDescriptor_df0d8b259d72854e = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0xa7, 0x23, 0x3b, 0x62, 0xd4, 0x83, 0x71, 0x0f, 0x43, 0xd1, 0x22, 0xf5, 0xe2, 0x65,
-	0x12, 0xb6, 0x0a, 0xca, 0x1c, 0x04, 0x15, 0x94, 0xbd, 0xed, 0xf6, 0x20, 0xb2, 0x07, 0x97, 0x34,
-	0x0d, 0xdd, 0x48, 0x9b, 0xd7, 0x4d, 0xd2, 0x91, 0x65, 0xd9, 0x8b, 0x9f, 0x40, 0xf0, 0xe6, 0x27,
-	0xf2, 0xb8, 0x20, 0x82, 0x47, 0x99, 0xf1, 0x63, 0x78, 0x90, 0x49, 0xa3, 0x9d, 0xc1, 0x51, 0x9c,
-	0xd1, 0x53, 0xc9, 0x0b, 0xef, 0xf7, 0x7f, 0xef, 0xff, 0x4f, 0xd1, 0x43, 0x99, 0x71, 0xca, 0xea,
-	0xba, 0x94, 0x9c, 0x59, 0x09, 0xca, 0x50, 0xa9, 0xac, 0xd0, 0xfc, 0x88, 0x49, 0x75, 0xc8, 0x38,
-	0x87, 0x46, 0x59, 0x43, 0x39, 0x28, 0xab, 0xa1, 0x2c, 0x85, 0xa6, 0x93, 0x1d, 0x7a, 0xdc, 0x08,
-	0x7d, 0x42, 0x6a, 0x0d, 0x16, 0x70, 0x22, 0x33, 0x4e, 0x16, 0xfb, 0xc9, 0x8a, 0x7e, 0xd2, 0xf5,
-	0x93, 0xc9, 0x4e, 0xf8, 0x64, 0x03, 0xcd, 0x05, 0x82, 0x13, 0x0e, 0x6f, 0x14, 0x00, 0x45, 0x29,
-	0x28, 0xab, 0x25, 0x65, 0x4a, 0x81, 0xf5, 0xf2, 0xee, 0x36, 0x3e, 0x40, 0x37, 0xf7, 0xe7, 0x53,
-	0xee, 0xfe, 0x04, 0x3f, 0x6a, 0xb9, 0xa9, 0x38, 0x6e, 0x84, 0xb1, 0x78, 0x1b, 0x6d, 0xc1, 0x6b,
-	0x25, 0xf4, 0x30, 0xb8, 0x15, 0xdc, 0xb9, 0x94, 0xb6, 0x07, 0x7c, 0x1b, 0x5d, 0xe5, 0xa0, 0x94,
-	0xe0, 0x73, 0xd6, 0xa1, 0xcc, 0x87, 0x7d, 0x77, 0x7b, 0xa5, 0x2b, 0xee, 0xe6, 0xf1, 0x18, 0x45,
-	0xbf, 0x63, 0x9b, 0x1a, 0x94, 0x11, 0x78, 0x88, 0x2e, 0xb2, 0x3c, 0xd7, 0xc2, 0x18, 0x8f, 0xff,
-	0x71, 0x8c, 0xb7, 0x11, 0x76, 0xbd, 0x7b, 0x4c, 0xb3, 0xca, 0xf8, 0x61, 0x62, 0x89, 0xae, 0x2f,
-	0x55, 0x3d, 0x26, 0x45, 0x83, 0xda, 0x55, 0x1c, 0xe5, 0x72, 0x32, 0x26, 0xeb, 0x9b, 0x4d, 0x3c,
-	0xd3, 0x93, 0x92, 0x6f, 0x17, 0xd0, 0x96, 0xd3, 0xc2, 0xef, 0xfb, 0xe8, 0xda, 0x2f, 0x2b, 0xe0,
-	0xfd, 0x4d, 0x34, 0xfe, 0x68, 0x75, 0x98, 0xfe, 0x4f, 0x64, 0x6b, 0x4d, 0xfc, 0xf2, 0xcd, 0xc7,
-	0xaf, 0xef, 0xfa, 0x2f, 0xf0, 0x73, 0xea, 0xdf, 0xd2, 0xdf, 0xbc, 0x21, 0x97, 0xb1, 0xa1, 0xa7,
-	0xee, 0x7b, 0x46, 0xbb, 0x50, 0x0d, 0x3d, 0x5d, 0x8a, 0xfd, 0x0c, 0x7f, 0x0a, 0xd0, 0xa0, 0x75,
-	0x0e, 0x3f, 0xdd, 0x78, 0xfc, 0xa5, 0x90, 0xc3, 0x67, 0xff, 0xcc, 0xf1, 0xbb, 0x8f, 0xdd, 0xee,
-	0xf7, 0x70, 0xb2, 0xce, 0xee, 0x6d, 0xfc, 0x8f, 0x5f, 0x7d, 0x98, 0x46, 0xc1, 0xf9, 0x34, 0x0a,
-	0xbe, 0x4c, 0xa3, 0xe0, 0xed, 0x2c, 0xea, 0x9d, 0xcf, 0xa2, 0xde, 0xe7, 0x59, 0xd4, 0x3b, 0xd8,
-	0x2b, 0xa4, 0x3d, 0x6a, 0x32, 0xc2, 0xa1, 0xa2, 0x1c, 0x4c, 0x05, 0x66, 0x8e, 0x1f, 0x15, 0x40,
-	0x27, 0x0f, 0x68, 0x05, 0x79, 0x53, 0x0a, 0xd3, 0x8a, 0x25, 0xf7, 0x47, 0x9d, 0xde, 0x68, 0x95,
-	0x9e, 0x3d, 0xa9, 0x85, 0xc9, 0x06, 0xee, 0x57, 0xbc, 0xfb, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xae,
-	0x56, 0xc8, 0x35, 0x63, 0x04, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x9b, 0xc2, 0x56, 0x9c, 0xae, 0x07, 0xc7, 0x05, 0x4b, 0xd1, 0x20, 0xf1, 0xe2, 0xa5,
+	0x19, 0x36, 0x0a, 0x4a, 0x0f, 0x8a, 0x0a, 0xca, 0xa2, 0x87, 0x6e, 0x10, 0x91, 0x3d, 0x58, 0x92,
+	0xc9, 0xd0, 0x1d, 0x4d, 0xe7, 0x65, 0x67, 0x26, 0x95, 0xb2, 0xac, 0x07, 0x3f, 0x81, 0xa0, 0x5e,
+	0xfc, 0x44, 0x1e, 0x17, 0x44, 0xf0, 0x28, 0xad, 0x1f, 0x44, 0x3a, 0x19, 0x4d, 0x4a, 0x57, 0x71,
+	0x63, 0x3d, 0x85, 0x79, 0xc3, 0xfb, 0xbd, 0xff, 0x7f, 0xde, 0x7b, 0x41, 0xb7, 0x79, 0x4c, 0x49,
+	0x94, 0x65, 0x29, 0xa7, 0x91, 0xe6, 0x20, 0x14, 0xe1, 0x42, 0x33, 0x49, 0xf7, 0x23, 0x2e, 0x86,
+	0x11, 0xa5, 0x90, 0x0b, 0xad, 0x08, 0x05, 0xa1, 0x25, 0xa4, 0x29, 0x93, 0x64, 0xb2, 0x4d, 0x0e,
+	0x72, 0x26, 0xa7, 0x7e, 0x26, 0x41, 0x03, 0x0e, 0x78, 0x4c, 0xfd, 0x6a, 0xbe, 0x7f, 0x42, 0xbe,
+	0x5f, 0xe6, 0xfb, 0x93, 0xed, 0xee, 0xfd, 0x1a, 0x35, 0x2b, 0x04, 0x53, 0xb8, 0x7b, 0x69, 0x04,
+	0x30, 0x4a, 0x19, 0x89, 0x32, 0x4e, 0x22, 0x21, 0x40, 0xdb, 0xf2, 0xe6, 0xd6, 0xdb, 0x43, 0x97,
+	0x77, 0x17, 0x2a, 0x77, 0x7e, 0x81, 0xef, 0x16, 0xdc, 0x90, 0x1d, 0xe4, 0x4c, 0x69, 0xbc, 0x85,
+	0x36, 0xe0, 0x95, 0x60, 0xb2, 0xe3, 0x5c, 0x71, 0xae, 0x9d, 0x0d, 0x8b, 0x03, 0xbe, 0x8a, 0xce,
+	0x51, 0x10, 0x82, 0xd1, 0x05, 0x6b, 0xc8, 0x93, 0x4e, 0xd3, 0xdc, 0x6e, 0x96, 0xc1, 0x9d, 0xc4,
+	0xeb, 0x23, 0xf7, 0x77, 0x6c, 0x95, 0x81, 0x50, 0x0c, 0x77, 0xd0, 0x99, 0x28, 0x49, 0x24, 0x53,
+	0xca, 0xe2, 0x7f, 0x1e, 0xbd, 0x2d, 0x84, 0x4d, 0xee, 0x20, 0x92, 0xd1, 0x58, 0x59, 0x31, 0x1e,
+	0x47, 0x17, 0x96, 0xa2, 0x16, 0x13, 0xa2, 0x56, 0x66, 0x22, 0x86, 0xd2, 0x0e, 0xfa, 0xfe, 0xe9,
+	0x1f, 0xdb, 0xb7, 0x4c, 0x4b, 0xf2, 0x9e, 0xa0, 0x8b, 0xa6, 0x54, 0xc8, 0xb4, 0x9c, 0x0e, 0x20,
+	0xe5, 0x74, 0xba, 0x86, 0x27, 0x79, 0x8d, 0x3a, 0xab, 0x54, 0xeb, 0x22, 0x46, 0x9b, 0x72, 0x11,
+	0x1e, 0x66, 0x26, 0x6e, 0xbd, 0xdc, 0xa9, 0xe3, 0xa5, 0x8a, 0x6f, 0xcb, 0xf2, 0x10, 0xbc, 0x6f,
+	0xa1, 0x0d, 0x23, 0x00, 0x7f, 0x6c, 0xa2, 0xf3, 0x2b, 0x8d, 0xc1, 0xbb, 0x75, 0xaa, 0xfd, 0x71,
+	0x80, 0xba, 0xe1, 0x3a, 0x91, 0xc5, 0x53, 0x79, 0xcf, 0xdf, 0x7c, 0xfe, 0xfe, 0xae, 0xf9, 0x0c,
+	0x3f, 0x25, 0x76, 0x43, 0xfe, 0x66, 0x33, 0x4c, 0x9b, 0x14, 0x39, 0x34, 0xdf, 0x23, 0x52, 0xf6,
+	0x45, 0x91, 0xc3, 0xa5, 0xce, 0x1d, 0xe1, 0x2f, 0x0e, 0x6a, 0x15, 0xf3, 0x80, 0x1f, 0xd4, 0x96,
+	0xbf, 0x34, 0xba, 0xdd, 0x87, 0xff, 0xcc, 0xb1, 0xde, 0xfb, 0xc6, 0xfb, 0x0d, 0x1c, 0x9c, 0xc6,
+	0x7b, 0x31, 0xd4, 0xf8, 0x43, 0x13, 0xb5, 0x2b, 0xb3, 0x81, 0x1f, 0xd5, 0x16, 0xb5, 0xba, 0x16,
+	0xdd, 0xc7, 0xeb, 0x81, 0x59, 0x9b, 0x2f, 0x8d, 0x4d, 0x86, 0xe9, 0xff, 0x69, 0x31, 0xa9, 0xae,
+	0xda, 0xbd, 0x17, 0x9f, 0x66, 0xae, 0x73, 0x3c, 0x73, 0x9d, 0x6f, 0x33, 0xd7, 0x79, 0x3b, 0x77,
+	0x1b, 0xc7, 0x73, 0xb7, 0xf1, 0x75, 0xee, 0x36, 0xf6, 0x06, 0x23, 0xae, 0xf7, 0xf3, 0xd8, 0xa7,
+	0x30, 0x26, 0x14, 0xd4, 0x18, 0xd4, 0x42, 0x4f, 0x6f, 0x04, 0x64, 0x72, 0x8b, 0x8c, 0x21, 0xc9,
+	0x53, 0xa6, 0x0a, 0x75, 0xc1, 0xcd, 0x5e, 0x29, 0xb0, 0x77, 0x92, 0x40, 0x3d, 0xcd, 0x98, 0x8a,
+	0x5b, 0xe6, 0xc7, 0x7b, 0xfd, 0xc7, 0x00, 0xd2, 0x30, 0x70, 0x2d, 0x51, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +372,8 @@ type QueryClient interface {
 	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RetryPolicy returns the packet timeout retry policy for a given owner address on a given connection
+	RetryPolicy(ctx context.Context, in *QueryRetryPolicyRequest, opts ...grpc.CallOption) (*QueryRetryPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -296,12 +402,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RetryPolicy(ctx context.Context, in *QueryRetryPolicyRequest, opts ...grpc.CallOption) (*QueryRetryPolicyResponse, error) {
+	out := new(QueryRetryPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/RetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InterchainAccount returns the interchain account address for a given owner address on a given connection
 	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RetryPolicy returns the packet timeout retry policy for a given owner address on a given connection
+	RetryPolicy(context.Context, *QueryRetryPolicyRequest) (*QueryRetryPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -314,6 +431,9 @@ func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RetryPolicy(ctx context.Context, req *QueryRetryPolicyRequest) (*QueryRetryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -355,6 +475,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/RetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetryPolicy(ctx, req.(*QueryRetryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -367,6 +505,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RetryPolicy",
+			Handler:    _Query_RetryPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/query.proto",
@@ -497,6 +639,78 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRetryPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -560,6 +774,36 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRetryPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetryPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -898,6 +1142,206 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRetryPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
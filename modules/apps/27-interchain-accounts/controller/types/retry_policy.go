@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// NewRetryPolicy creates a new RetryPolicy instance
+func NewRetryPolicy(maxRetries, backoffBlocks, relativeTimeout uint64) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:      maxRetries,
+		BackoffBlocks:   backoffBlocks,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+// IsEnabled returns true if the retry policy allows at least one retry.
+func (rp RetryPolicy) IsEnabled() bool {
+	return rp.MaxRetries > 0
+}
+
+// Validate performs a basic validation of the retry policy fields. A disabled policy is always valid.
+func (rp RetryPolicy) Validate() error {
+	if !rp.IsEnabled() {
+		return nil
+	}
+
+	if rp.RelativeTimeout == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
@@ -272,6 +272,166 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetRetryPolicy defines the payload for Msg/SetRetryPolicy
+type MsgSetRetryPolicy struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// retry_policy defines the policy applied to timed out packets sent by the owner on the connection.
+	// A policy with zero max_retries removes the existing policy.
+	RetryPolicy RetryPolicy `protobuf:"bytes,3,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy"`
+}
+
+func (m *MsgSetRetryPolicy) Reset()         { *m = MsgSetRetryPolicy{} }
+func (m *MsgSetRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetryPolicy) ProtoMessage()    {}
+func (*MsgSetRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{6}
+}
+func (m *MsgSetRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetryPolicy.Merge(m, src)
+}
+func (m *MsgSetRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRetryPolicy proto.InternalMessageInfo
+
+// MsgSetRetryPolicyResponse defines the response for Msg/SetRetryPolicy
+type MsgSetRetryPolicyResponse struct {
+}
+
+func (m *MsgSetRetryPolicyResponse) Reset()         { *m = MsgSetRetryPolicyResponse{} }
+func (m *MsgSetRetryPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetryPolicyResponse) ProtoMessage()    {}
+func (*MsgSetRetryPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{7}
+}
+func (m *MsgSetRetryPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetryPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetryPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRetryPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetryPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetRetryPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetryPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetryPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRetryPolicyResponse proto.InternalMessageInfo
+
+// MsgMigrateChannelCapability defines the payload for Msg/MigrateChannelCapability
+type MsgMigrateChannelCapability struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// owner of the interchain account whose active channel is handed over to the controller submodule.
+	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *MsgMigrateChannelCapability) Reset()         { *m = MsgMigrateChannelCapability{} }
+func (m *MsgMigrateChannelCapability) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateChannelCapability) ProtoMessage()    {}
+func (*MsgMigrateChannelCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{8}
+}
+func (m *MsgMigrateChannelCapability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateChannelCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateChannelCapability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateChannelCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateChannelCapability.Merge(m, src)
+}
+func (m *MsgMigrateChannelCapability) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateChannelCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateChannelCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateChannelCapability proto.InternalMessageInfo
+
+// MsgMigrateChannelCapabilityResponse defines the response for Msg/MigrateChannelCapability
+type MsgMigrateChannelCapabilityResponse struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *MsgMigrateChannelCapabilityResponse) Reset()         { *m = MsgMigrateChannelCapabilityResponse{} }
+func (m *MsgMigrateChannelCapabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateChannelCapabilityResponse) ProtoMessage()    {}
+func (*MsgMigrateChannelCapabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{9}
+}
+func (m *MsgMigrateChannelCapabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateChannelCapabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateChannelCapabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateChannelCapabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateChannelCapabilityResponse.Merge(m, src)
+}
+func (m *MsgMigrateChannelCapabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateChannelCapabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateChannelCapabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateChannelCapabilityResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
@@ -279,6 +439,10 @@ func init() {
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetRetryPolicy)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSetRetryPolicy")
+	proto.RegisterType((*MsgSetRetryPolicyResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSetRetryPolicyResponse")
+	proto.RegisterType((*MsgMigrateChannelCapability)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgMigrateChannelCapability")
+	proto.RegisterType((*MsgMigrateChannelCapabilityResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgMigrateChannelCapabilityResponse")
 }
 
 func init() {
@@ -286,49 +450,57 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0xbe, 0x94, 0x02, 0x03, 0x2f, 0xbc, 0xef, 0x86, 0x48, 0xd9, 0x68, 0xc1, 0xea, 0x01,
-	0x49, 0x98, 0x49, 0xeb, 0xdf, 0xd4, 0x78, 0x10, 0xf0, 0xd0, 0x98, 0xc6, 0x66, 0xc5, 0x84, 0x78,
-	0x69, 0xa6, 0xb3, 0x93, 0x61, 0xa4, 0x3b, 0xb3, 0xce, 0x4c, 0x57, 0xbc, 0x19, 0x4f, 0xc6, 0x83,
-	0xf1, 0xe0, 0x07, 0xe0, 0x23, 0x70, 0xf7, 0x03, 0xc8, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0xc0, 0xcd,
-	0xcf, 0x60, 0xf6, 0x4f, 0xb7, 0x28, 0x48, 0xb0, 0x70, 0xdb, 0xdf, 0x6f, 0xe6, 0x79, 0x7e, 0xcf,
-	0xf3, 0xcc, 0xec, 0x80, 0xfb, 0xbc, 0x4d, 0x10, 0x0e, 0x82, 0x0e, 0x27, 0xd8, 0x70, 0x29, 0x34,
-	0xe2, 0xc2, 0x50, 0x45, 0x36, 0x30, 0x17, 0x2d, 0x4c, 0x88, 0xec, 0x0a, 0xa3, 0x11, 0x91, 0xc2,
-	0x28, 0xd9, 0xe9, 0x50, 0x85, 0xc2, 0x0a, 0x32, 0x5b, 0x30, 0x50, 0xd2, 0x48, 0xbb, 0xca, 0xdb,
-	0x04, 0x1e, 0x05, 0xc3, 0x13, 0xc0, 0xb0, 0x0f, 0x86, 0x61, 0xc5, 0x99, 0x66, 0x92, 0xc9, 0x18,
-	0x8e, 0xa2, 0xaf, 0x84, 0xc9, 0xb9, 0x75, 0x26, 0x19, 0x61, 0x05, 0x05, 0x98, 0x6c, 0x52, 0x93,
-	0xa2, 0x56, 0x06, 0x10, 0x7f, 0x44, 0x4d, 0x42, 0x32, 0x43, 0xa4, 0xf6, 0xa5, 0x46, 0xbe, 0x66,
-	0xd1, 0xba, 0xaf, 0x59, 0xba, 0x70, 0x35, 0x62, 0x27, 0x52, 0x51, 0x44, 0x36, 0xb0, 0x10, 0xb4,
-	0x13, 0xc3, 0x93, 0xcf, 0x64, 0x4b, 0xf9, 0xb3, 0x05, 0x2e, 0x37, 0x34, 0x73, 0x29, 0xe3, 0xda,
-	0x50, 0x55, 0xcf, 0xa6, 0x3f, 0x4c, 0x86, 0xdb, 0xd3, 0x60, 0x58, 0xbe, 0x12, 0x54, 0x15, 0xad,
-	0x79, 0x6b, 0x61, 0xcc, 0x4d, 0x0a, 0xfb, 0x1a, 0xf8, 0x97, 0x48, 0x21, 0x28, 0x89, 0x44, 0xb7,
-	0xb8, 0x57, 0xfc, 0x27, 0x5e, 0x9d, 0xe8, 0x37, 0xeb, 0x9e, 0x5d, 0x04, 0x23, 0x21, 0x55, 0x9a,
-	0x4b, 0x51, 0x1c, 0x8a, 0x97, 0x7b, 0xa5, 0x7d, 0x07, 0x8c, 0x4a, 0xe5, 0x51, 0xc5, 0x05, 0x2b,
-	0xe6, 0xe7, 0xad, 0x85, 0xc9, 0xaa, 0x03, 0xa3, 0x93, 0x88, 0xb4, 0xc2, 0x9e, 0xc0, 0xb0, 0x02,
-	0x9f, 0x44, 0x9b, 0xdc, 0x6c, 0x6f, 0x6d, 0xf2, 0xdd, 0xf6, 0x5c, 0xee, 0xed, 0xe1, 0xce, 0x62,
-	0x22, 0xa3, 0xec, 0x81, 0xeb, 0xa7, 0x89, 0x77, 0xa9, 0x0e, 0xa4, 0xd0, 0xd4, 0xbe, 0x02, 0x40,
-	0xca, 0x1a, 0x69, 0x4d, 0x9c, 0x8c, 0xa5, 0x9d, 0xba, 0x67, 0xcf, 0x80, 0x91, 0x40, 0x2a, 0xd3,
-	0xf7, 0x51, 0x88, 0xca, 0xba, 0x57, 0xcb, 0x47, 0xf3, 0xca, 0x3f, 0x2c, 0x30, 0xd6, 0xd0, 0xec,
-	0x29, 0x15, 0xde, 0xda, 0xd6, 0x79, 0x02, 0xd9, 0x04, 0xe3, 0xc9, 0xe9, 0xb7, 0x3c, 0x6c, 0x70,
-	0x1c, 0xca, 0x78, 0x75, 0x15, 0x9e, 0xe9, 0x0e, 0x86, 0x15, 0x78, 0xcc, 0x5f, 0x33, 0x26, 0x5b,
-	0xc5, 0x06, 0x2f, 0xe7, 0x77, 0xbf, 0xcd, 0xe5, 0x5c, 0x10, 0x64, 0x1d, 0xfb, 0x06, 0xf8, 0x4f,
-	0xd1, 0x0e, 0x36, 0x3c, 0xa4, 0x2d, 0xc3, 0x7d, 0x2a, 0xbb, 0x26, 0xce, 0x3a, 0xef, 0x4e, 0xf5,
-	0xfa, 0x6b, 0x49, 0xfb, 0x58, 0xac, 0xb7, 0xc1, 0xff, 0x99, 0xdf, 0x2c, 0x43, 0x07, 0x8c, 0x6a,
-	0xfa, 0xb2, 0x4b, 0x05, 0xa1, 0xb1, 0xf5, 0xbc, 0x9b, 0xd5, 0x69, 0x4e, 0x9f, 0x2c, 0x30, 0xd5,
-	0xd0, 0xec, 0x59, 0xe0, 0x61, 0x43, 0x9b, 0x58, 0x61, 0x5f, 0xdb, 0x97, 0x40, 0x41, 0x73, 0xd6,
-	0x8f, 0x2b, 0xad, 0xec, 0x75, 0x50, 0x08, 0xe2, 0x1d, 0x71, 0x50, 0xe3, 0xd5, 0x1a, 0xfc, 0xfb,
-	0x3f, 0x11, 0x26, 0x33, 0x52, 0xef, 0x29, 0x5f, 0x6d, 0xaa, 0x67, 0x26, 0x1d, 0x55, 0x9e, 0x05,
-	0x33, 0xbf, 0xa9, 0xea, 0x79, 0xaa, 0xbe, 0xcf, 0x83, 0xa1, 0x86, 0x66, 0xf6, 0x17, 0x0b, 0xcc,
-	0xfe, 0xf9, 0x17, 0x68, 0x0e, 0xa2, 0xed, 0xb4, 0x7b, 0xe9, 0xac, 0x5f, 0x34, 0x63, 0x76, 0x4a,
-	0x1f, 0x2c, 0x50, 0x48, 0x2f, 0xea, 0x83, 0x01, 0x87, 0x24, 0x70, 0xe7, 0xd1, 0xb9, 0xe0, 0x99,
-	0xa0, 0x6d, 0x0b, 0x4c, 0xfc, 0x72, 0x23, 0x56, 0x06, 0xe4, 0x3d, 0x4a, 0xe2, 0x3c, 0xbe, 0x00,
-	0x92, 0x9e, 0x44, 0x67, 0xf8, 0xcd, 0xe1, 0xce, 0xa2, 0xb5, 0xfc, 0x62, 0x77, 0xbf, 0x64, 0xed,
-	0xed, 0x97, 0xac, 0xef, 0xfb, 0x25, 0xeb, 0xe3, 0x41, 0x29, 0xb7, 0x77, 0x50, 0xca, 0x7d, 0x3d,
-	0x28, 0xe5, 0x9e, 0x37, 0x19, 0x37, 0x1b, 0xdd, 0x36, 0x24, 0xd2, 0x47, 0xe9, 0x5b, 0xcb, 0xdb,
-	0x64, 0x89, 0x49, 0x14, 0xde, 0x43, 0xbe, 0xf4, 0xba, 0x1d, 0xaa, 0xa3, 0x57, 0x5c, 0xa3, 0xea,
-	0xdd, 0xa5, 0xbe, 0x8e, 0xa5, 0x93, 0x1e, 0x70, 0xf3, 0x3a, 0xa0, 0xba, 0x5d, 0x88, 0x5f, 0xdf,
-	0x9b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xee, 0x6a, 0x51, 0xfa, 0xbd, 0x06, 0x00, 0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbf, 0x4f, 0x1b, 0x49,
+	0x14, 0xf6, 0x80, 0x31, 0x30, 0xe6, 0xe0, 0x6e, 0x85, 0x0e, 0xb3, 0xdc, 0x19, 0xce, 0x5c, 0xc1,
+	0x21, 0xb1, 0x2b, 0xfb, 0x7e, 0xca, 0xa7, 0xd3, 0x29, 0x98, 0x14, 0x56, 0x64, 0x61, 0x6d, 0x88,
+	0x82, 0xd2, 0x58, 0xe3, 0xd9, 0xd1, 0x7a, 0xc2, 0x7a, 0x67, 0x33, 0x33, 0xde, 0xe0, 0x2e, 0x49,
+	0x95, 0x2a, 0x4a, 0x91, 0x3f, 0x80, 0x2a, 0x35, 0x7d, 0xda, 0x48, 0xa1, 0xa4, 0x4c, 0x15, 0x45,
+	0x50, 0xd0, 0xe5, 0x0f, 0x48, 0x15, 0xed, 0x0f, 0xaf, 0x1d, 0x8c, 0x2d, 0x62, 0xdc, 0xed, 0x7b,
+	0x33, 0xef, 0x7b, 0xdf, 0xf7, 0xbd, 0x9d, 0xd1, 0xc0, 0x7f, 0x69, 0x1d, 0xeb, 0xc8, 0x75, 0x6d,
+	0x8a, 0x91, 0xa4, 0xcc, 0x11, 0x3a, 0x75, 0x24, 0xe1, 0xb8, 0x81, 0xa8, 0x53, 0x43, 0x18, 0xb3,
+	0x96, 0x23, 0x85, 0x8e, 0x99, 0x23, 0x39, 0xb3, 0x6d, 0xc2, 0x75, 0x2f, 0xaf, 0xcb, 0x43, 0xcd,
+	0xe5, 0x4c, 0x32, 0xa5, 0x40, 0xeb, 0x58, 0xeb, 0x2d, 0xd6, 0xae, 0x28, 0xd6, 0xba, 0xc5, 0x9a,
+	0x97, 0x57, 0x17, 0x2d, 0x66, 0xb1, 0xa0, 0x5c, 0xf7, 0xbf, 0x42, 0x24, 0xf5, 0x8f, 0x6b, 0xd1,
+	0xf0, 0xf2, 0xba, 0x8b, 0xf0, 0x01, 0x91, 0x51, 0x55, 0x69, 0x04, 0xf2, 0xdd, 0x28, 0x02, 0x59,
+	0xc2, 0x4c, 0x34, 0x99, 0xd0, 0x9b, 0xc2, 0xf2, 0xd7, 0x9b, 0xc2, 0x8a, 0x16, 0x7e, 0xf1, 0xd1,
+	0x31, 0xe3, 0x44, 0xc7, 0x0d, 0xe4, 0x38, 0xc4, 0x0e, 0xca, 0xc3, 0xcf, 0x70, 0x4b, 0xee, 0x0d,
+	0x80, 0x3f, 0x55, 0x84, 0x65, 0x10, 0x8b, 0x0a, 0x49, 0x78, 0x39, 0xee, 0x7e, 0x2b, 0x6c, 0xae,
+	0x2c, 0xc2, 0x29, 0xf6, 0xd8, 0x21, 0x3c, 0x03, 0xd6, 0xc0, 0xc6, 0xac, 0x11, 0x06, 0xca, 0x3a,
+	0xfc, 0x0e, 0x33, 0xc7, 0x21, 0xd8, 0x27, 0x5d, 0xa3, 0x66, 0x66, 0x22, 0x58, 0x9d, 0xeb, 0x26,
+	0xcb, 0xa6, 0x92, 0x81, 0xd3, 0x1e, 0xe1, 0x82, 0x32, 0x27, 0x33, 0x19, 0x2c, 0x77, 0x42, 0xe5,
+	0x2f, 0x38, 0xc3, 0xb8, 0x49, 0x38, 0x75, 0xac, 0x4c, 0x72, 0x0d, 0x6c, 0xcc, 0x17, 0x54, 0xcd,
+	0x9f, 0x84, 0xcf, 0x55, 0xeb, 0x10, 0xf4, 0xf2, 0xda, 0xae, 0xbf, 0xc9, 0x88, 0xf7, 0x16, 0xe7,
+	0x9f, 0x1f, 0xad, 0x26, 0x9e, 0x5d, 0x1c, 0x6f, 0x86, 0x34, 0x72, 0x26, 0xfc, 0x75, 0x18, 0x79,
+	0x83, 0x08, 0x97, 0x39, 0x82, 0x28, 0x3f, 0x43, 0x18, 0xa1, 0xfa, 0x5c, 0x43, 0x25, 0xb3, 0x51,
+	0xa6, 0x6c, 0x2a, 0x4b, 0x70, 0xda, 0x65, 0x5c, 0x76, 0x75, 0xa4, 0xfc, 0xb0, 0x6c, 0x16, 0x93,
+	0x7e, 0xbf, 0xdc, 0x27, 0x00, 0x67, 0x2b, 0xc2, 0xba, 0x4b, 0x1c, 0x73, 0xef, 0xf0, 0x26, 0x86,
+	0x1c, 0xc0, 0x74, 0x38, 0xfd, 0x9a, 0x89, 0x24, 0x0a, 0x4c, 0x49, 0x17, 0x76, 0xb4, 0x6b, 0xfd,
+	0x83, 0x5e, 0x5e, 0xeb, 0xd3, 0x57, 0x0d, 0xc0, 0x76, 0x90, 0x44, 0xdb, 0xc9, 0x93, 0x0f, 0xab,
+	0x09, 0x03, 0xba, 0x71, 0x46, 0xf9, 0x0d, 0x7e, 0xcf, 0x89, 0x8d, 0x24, 0xf5, 0x48, 0x4d, 0xd2,
+	0x26, 0x61, 0x2d, 0x19, 0x78, 0x9d, 0x34, 0x16, 0x3a, 0xf9, 0xbd, 0x30, 0xdd, 0x67, 0xeb, 0x9f,
+	0xf0, 0x87, 0x58, 0x6f, 0xec, 0xa1, 0x0a, 0x67, 0x04, 0x79, 0xd4, 0x22, 0x0e, 0x26, 0x81, 0xf4,
+	0xa4, 0x11, 0xc7, 0x91, 0x4f, 0xaf, 0x00, 0x5c, 0xa8, 0x08, 0xeb, 0x9e, 0x6b, 0x22, 0x49, 0xaa,
+	0x88, 0xa3, 0xa6, 0x50, 0x7e, 0x84, 0x29, 0x41, 0xad, 0xae, 0x5d, 0x51, 0xa4, 0xec, 0xc3, 0x94,
+	0x1b, 0xec, 0x08, 0x8c, 0x4a, 0x17, 0x8a, 0xda, 0xb7, 0x9f, 0x44, 0x2d, 0xec, 0x11, 0x69, 0x8f,
+	0xf0, 0x8a, 0x0b, 0x1d, 0x31, 0x51, 0xab, 0xdc, 0x32, 0x5c, 0xba, 0xc4, 0xaa, 0xa3, 0x29, 0x77,
+	0x02, 0x22, 0xa5, 0xd2, 0x20, 0x92, 0xb7, 0xab, 0xcc, 0xa6, 0xb8, 0x7d, 0x93, 0x09, 0x37, 0xe0,
+	0x1c, 0xf7, 0x91, 0x6a, 0x6e, 0x00, 0x15, 0x8d, 0xf8, 0xff, 0x51, 0xc4, 0xf5, 0x30, 0x8a, 0x14,
+	0xa6, 0x79, 0x37, 0xd5, 0x37, 0xb3, 0x15, 0xb8, 0xdc, 0xa7, 0x24, 0xd6, 0xf9, 0x14, 0xc0, 0x95,
+	0x8a, 0xb0, 0x2a, 0xd4, 0xe2, 0x48, 0x92, 0x52, 0xf8, 0xe3, 0x97, 0x90, 0x8b, 0xea, 0xd4, 0xa6,
+	0xb2, 0x3d, 0x70, 0x4a, 0xb1, 0x13, 0x13, 0x43, 0x9d, 0x98, 0xec, 0x77, 0xa2, 0x7f, 0x0c, 0x18,
+	0xae, 0x0f, 0xa1, 0x30, 0x9e, 0xa3, 0x5a, 0xf8, 0x9c, 0x82, 0x93, 0x15, 0x61, 0x29, 0xef, 0x00,
+	0x5c, 0x1e, 0x7c, 0xa7, 0x55, 0x47, 0x99, 0xc7, 0xb0, 0x8b, 0x46, 0xdd, 0x1f, 0x37, 0x62, 0xec,
+	0xc7, 0x0b, 0x00, 0x53, 0xd1, 0xcd, 0xf3, 0xdf, 0x88, 0x4d, 0xc2, 0x72, 0xf5, 0xf6, 0x8d, 0xca,
+	0x63, 0x42, 0x47, 0x00, 0xce, 0x7d, 0x75, 0xc4, 0x4b, 0x23, 0xe2, 0xf6, 0x82, 0xa8, 0x77, 0xc6,
+	0x00, 0x12, 0x53, 0x7c, 0x0d, 0xe0, 0xfc, 0xa5, 0x33, 0x3d, 0xba, 0xf8, 0x5e, 0x18, 0xb5, 0x32,
+	0x16, 0x98, 0x98, 0xe8, 0x5b, 0x00, 0x33, 0x03, 0x0f, 0xe5, 0xee, 0x88, 0xbd, 0x06, 0x01, 0xaa,
+	0xf7, 0xc7, 0x0c, 0xd8, 0x91, 0xa1, 0x4e, 0x3d, 0xb9, 0x38, 0xde, 0x04, 0xdb, 0x0f, 0x4f, 0xce,
+	0xb2, 0xe0, 0xf4, 0x2c, 0x0b, 0x3e, 0x9e, 0x65, 0xc1, 0xcb, 0xf3, 0x6c, 0xe2, 0xf4, 0x3c, 0x9b,
+	0x78, 0x7f, 0x9e, 0x4d, 0x3c, 0xa8, 0x5a, 0x54, 0x36, 0x5a, 0x75, 0x0d, 0xb3, 0xa6, 0x1e, 0x3d,
+	0x56, 0x68, 0x1d, 0x6f, 0x59, 0x4c, 0xf7, 0xfe, 0xd1, 0x9b, 0xcc, 0x6c, 0xd9, 0x44, 0xf8, 0xcf,
+	0x20, 0xa1, 0x17, 0xfe, 0xde, 0xea, 0x72, 0xda, 0xba, 0xea, 0x05, 0x24, 0xdb, 0x2e, 0x11, 0xf5,
+	0x54, 0xf0, 0x7c, 0xf9, 0xfd, 0xcb, 0x00, 0x4f, 0x35, 0x8f, 0xdb, 0xfe, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +521,10 @@ type MsgClient interface {
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetRetryPolicy defines a rpc handler for MsgSetRetryPolicy.
+	SetRetryPolicy(ctx context.Context, in *MsgSetRetryPolicy, opts ...grpc.CallOption) (*MsgSetRetryPolicyResponse, error)
+	// MigrateChannelCapability defines a rpc handler for MsgMigrateChannelCapability.
+	MigrateChannelCapability(ctx context.Context, in *MsgMigrateChannelCapability, opts ...grpc.CallOption) (*MsgMigrateChannelCapabilityResponse, error)
 }
 
 type msgClient struct {
@@ -386,6 +562,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetRetryPolicy(ctx context.Context, in *MsgSetRetryPolicy, opts ...grpc.CallOption) (*MsgSetRetryPolicyResponse, error) {
+	out := new(MsgSetRetryPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/SetRetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateChannelCapability(ctx context.Context, in *MsgMigrateChannelCapability, opts ...grpc.CallOption) (*MsgMigrateChannelCapabilityResponse, error) {
+	out := new(MsgMigrateChannelCapabilityResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/MigrateChannelCapability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
@@ -394,6 +588,10 @@ type MsgServer interface {
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetRetryPolicy defines a rpc handler for MsgSetRetryPolicy.
+	SetRetryPolicy(context.Context, *MsgSetRetryPolicy) (*MsgSetRetryPolicyResponse, error)
+	// MigrateChannelCapability defines a rpc handler for MsgMigrateChannelCapability.
+	MigrateChannelCapability(context.Context, *MsgMigrateChannelCapability) (*MsgMigrateChannelCapabilityResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -409,6 +607,12 @@ func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetRetryPolicy(ctx context.Context, req *MsgSetRetryPolicy) (*MsgSetRetryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetryPolicy not implemented")
+}
+func (*UnimplementedMsgServer) MigrateChannelCapability(ctx context.Context, req *MsgMigrateChannelCapability) (*MsgMigrateChannelCapabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateChannelCapability not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -468,6 +672,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRetryPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/SetRetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRetryPolicy(ctx, req.(*MsgSetRetryPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateChannelCapability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateChannelCapability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateChannelCapability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/MigrateChannelCapability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateChannelCapability(ctx, req.(*MsgMigrateChannelCapability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -484,6 +724,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetRetryPolicy",
+			Handler:    _Msg_SetRetryPolicy_Handler,
+		},
+		{
+			MethodName: "MigrateChannelCapability",
+			Handler:    _Msg_MigrateChannelCapability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
@@ -718,113 +966,330 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgRegisterInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Ordering != 0 {
-		n += 1 + sovTx(uint64(m.Ordering))
+func (m *MsgSetRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.PacketData.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.RelativeTimeout != 0 {
-		n += 1 + sovTx(uint64(m.RelativeTimeout))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
+func (m *MsgSetRetryPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetRetryPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetryPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *MsgMigrateChannelCapability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateChannelCapability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateChannelCapability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateChannelCapabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateChannelCapabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateChannelCapabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
+	return n
+}
+
+func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendTx) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovTx(uint64(m.RelativeTimeout))
+	}
+	return n
+}
+
+func (m *MsgSendTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RetryPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRetryPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateChannelCapability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateChannelCapabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -957,13 +1422,362 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= types.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.Ordering = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -973,7 +1787,7 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ordering |= types.Order(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -999,7 +1813,7 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,15 +1836,15 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1058,13 +1872,13 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1074,23 +1888,24 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1113,7 +1928,7 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1136,10 +1951,60 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1208,7 +2073,7 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1235,29 +2100,10 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
-			}
-			m.RelativeTimeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RelativeTimeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1279,7 +2125,7 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRetryPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1302,31 +2148,12 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRetryPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRetryPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1348,7 +2175,7 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateChannelCapability) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,10 +2198,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateChannelCapability: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateChannelCapability: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1411,9 +2238,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1423,24 +2250,55 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1463,7 +2321,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateChannelCapabilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,12 +2344,76 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateChannelCapabilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateChannelCapabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
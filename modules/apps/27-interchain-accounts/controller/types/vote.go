@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// NewWeightedVotePacketData returns the interchain account packet data executing a weighted governance
+// vote of the interchain account with the given address on the host chain. The vote options are validated
+// and the MsgVoteWeighted is serialized using the given encoding. The message type must be allowed by
+// the host chain's allow list for the vote to be executed.
+func NewWeightedVotePacketData(
+	cdc codec.Codec, encoding, interchainAccountAddr string, proposalID uint64,
+	options []*govv1.WeightedVoteOption, metadata, memo string,
+) (icatypes.InterchainAccountPacketData, error) {
+	if strings.TrimSpace(interchainAccountAddr) == "" {
+		return icatypes.InterchainAccountPacketData{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "interchain account address cannot be empty")
+	}
+
+	if err := icatypes.ValidateWeightedVoteOptions(options); err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	msg := &govv1.MsgVoteWeighted{
+		ProposalId: proposalID,
+		Voter:      interchainAccountAddr,
+		Options:    options,
+		Metadata:   metadata,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, []proto.Message{msg}, encoding)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, errorsmod.Wrap(err, "failed to serialize weighted vote")
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	return packetData, nil
+}
@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	ica "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestNewWeightedVotePacketData(t *testing.T) {
+	var (
+		interchainAccountAddr string
+		options               []*govv1.WeightedVoteOption
+		memo                  string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty interchain account address",
+			func() {
+				interchainAccountAddr = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid vote options",
+			func() {
+				options = append(options, govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(1, 1)))
+			},
+			icatypes.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: memo too long",
+			func() {
+				memo = ibctesting.GenerateString(icatypes.MaxMemoCharLength + 1)
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name+" "+encoding, func(t *testing.T) {
+				encodingConfig := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+				govv1.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+				interchainAccountAddr = ibctesting.TestAccAddress
+				options = []*govv1.WeightedVoteOption{
+					govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(7, 1)),
+					govv1.NewWeightedVoteOption(govv1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(3, 1)),
+				}
+				memo = "memo"
+
+				tc.malleate()
+
+				packetData, err := types.NewWeightedVotePacketData(encodingConfig.Codec, encoding, interchainAccountAddr, 1, options, "metadata", memo)
+
+				if tc.expErr == nil {
+					require.NoError(t, err)
+					require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
+					require.Equal(t, memo, packetData.Memo)
+
+					msgs, err := icatypes.DeserializeCosmosTx(encodingConfig.Codec, packetData.Data, encoding)
+					require.NoError(t, err)
+
+					expMsg := &govv1.MsgVoteWeighted{
+						ProposalId: 1,
+						Voter:      interchainAccountAddr,
+						Options:    options,
+						Metadata:   "metadata",
+					}
+					require.Len(t, msgs, 1)
+					require.Equal(t, expMsg, msgs[0])
+				} else {
+					require.ErrorIs(t, err, tc.expErr)
+				}
+			})
+		}
+	}
+}
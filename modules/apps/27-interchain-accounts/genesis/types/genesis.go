@@ -77,6 +77,20 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	for _, rp := range gs.RetryPolicies {
+		if err := host.PortIdentifierValidator(rp.PortId); err != nil {
+			return err
+		}
+
+		if err := host.ConnectionIdentifierValidator(rp.ConnectionId); err != nil {
+			return err
+		}
+
+		if err := rp.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
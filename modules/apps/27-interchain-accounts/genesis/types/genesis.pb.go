@@ -84,6 +84,7 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	RetryPolicies      []RegisteredRetryPolicy       `protobuf:"bytes,5,rep,name=retry_policies,json=retryPolicies,proto3" json:"retry_policies"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,6 +148,13 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetRetryPolicies() []RegisteredRetryPolicy {
+	if m != nil {
+		return m.RetryPolicies
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
@@ -347,12 +355,74 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 	return ""
 }
 
+// RegisteredRetryPolicy contains a connection ID, port ID and associated packet timeout retry policy
+type RegisteredRetryPolicy struct {
+	ConnectionId string            `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId       string            `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	RetryPolicy  types.RetryPolicy `protobuf:"bytes,3,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy"`
+}
+
+func (m *RegisteredRetryPolicy) Reset()         { *m = RegisteredRetryPolicy{} }
+func (m *RegisteredRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RegisteredRetryPolicy) ProtoMessage()    {}
+func (*RegisteredRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4aa48c8e29a1947, []int{5}
+}
+func (m *RegisteredRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredRetryPolicy.Merge(m, src)
+}
+func (m *RegisteredRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredRetryPolicy proto.InternalMessageInfo
+
+func (m *RegisteredRetryPolicy) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RegisteredRetryPolicy) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RegisteredRetryPolicy) GetRetryPolicy() types.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return types.RetryPolicy{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.GenesisState")
 	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
 	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
 	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.genesis.v1.ActiveChannel")
 	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*RegisteredRetryPolicy)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredRetryPolicy")
 }
 
 func init() {
@@ -360,44 +430,48 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x9b, 0xb4, 0x56, 0x3b, 0xfb, 0xc3, 0x65, 0x76, 0x5d, 0xc3, 0x8a, 0xb1, 0xd4, 0x83,
-	0xbd, 0x34, 0xa1, 0x55, 0x58, 0x11, 0x14, 0xba, 0x45, 0xd6, 0x82, 0x0b, 0x12, 0x2f, 0xe2, 0x25,
-	0x4c, 0x27, 0x43, 0x3a, 0x90, 0x64, 0x42, 0xde, 0xb4, 0xe2, 0x59, 0xc1, 0xa3, 0xfe, 0x09, 0xfe,
-	0x39, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0xf6, 0x0f, 0xf0, 0x2f, 0x10, 0x64, 0x26, 0xd9, 0xb6, 0xd6,
-	0x2a, 0x2d, 0x1e, 0x3d, 0x65, 0xe6, 0x7d, 0xf3, 0xbe, 0xef, 0x93, 0xf7, 0x92, 0x0c, 0x7a, 0xcc,
-	0x07, 0xd4, 0x25, 0x69, 0x1a, 0x71, 0x4a, 0x24, 0x17, 0x09, 0xb8, 0x3c, 0x91, 0x2c, 0xa3, 0x43,
-	0xc2, 0x13, 0x9f, 0x50, 0x2a, 0x46, 0x89, 0x04, 0x37, 0x64, 0x09, 0x03, 0x0e, 0xee, 0xb8, 0x7d,
-	0xb9, 0x74, 0xd2, 0x4c, 0x48, 0x81, 0x5d, 0x3e, 0xa0, 0xce, 0x62, 0xba, 0xb3, 0x22, 0xdd, 0xb9,
-	0xcc, 0x19, 0xb7, 0x8f, 0x0e, 0x42, 0x11, 0x0a, 0x9d, 0xeb, 0xaa, 0x55, 0x6e, 0x73, 0xd4, 0x5b,
-	0x8b, 0x82, 0x8a, 0x44, 0x66, 0x22, 0x8a, 0x58, 0xa6, 0x40, 0xe6, 0xbb, 0xc2, 0xe4, 0x78, 0x2d,
-	0x93, 0xa1, 0x00, 0xa9, 0xd2, 0xd5, 0x35, 0x4f, 0x6c, 0x7c, 0x34, 0xd1, 0xf6, 0x69, 0x8e, 0xf8,
-	0x52, 0x12, 0xc9, 0xf0, 0x07, 0x03, 0x59, 0x73, 0x7b, 0xbf, 0xc0, 0xf7, 0x41, 0x89, 0x96, 0x51,
-	0x37, 0x9a, 0x5b, 0x9d, 0x53, 0x67, 0xc3, 0x27, 0x77, 0x7a, 0x33, 0xc3, 0xc5, 0x5a, 0x27, 0x95,
-	0xf3, 0xaf, 0x77, 0x4a, 0xde, 0x21, 0x5d, 0xa9, 0xe2, 0x11, 0xc2, 0x0a, 0x74, 0x09, 0xc1, 0xd4,
-	0x08, 0xdd, 0x8d, 0x11, 0x9e, 0x09, 0x90, 0x2b, 0x8a, 0xef, 0x0d, 0x97, 0xe2, 0x8d, 0x1f, 0x26,
-	0x3a, 0x5c, 0xcd, 0x8b, 0x63, 0x74, 0x9d, 0x50, 0xc9, 0xc7, 0xcc, 0xa7, 0x43, 0x92, 0x24, 0x2c,
-	0x02, 0xcb, 0xa8, 0x97, 0x9b, 0x5b, 0x9d, 0x27, 0x1b, 0xe3, 0x74, 0xb5, 0x4f, 0x2f, 0xb7, 0x29,
-	0x58, 0x76, 0xc9, 0x62, 0x10, 0xf0, 0x3b, 0x03, 0xed, 0xaf, 0xb0, 0xb1, 0x4c, 0x5d, 0xf3, 0xf9,
-	0xc6, 0x35, 0x3d, 0x16, 0x72, 0x90, 0x2c, 0x63, 0x41, 0x7f, 0x76, 0x63, 0x37, 0xbf, 0xaf, 0x20,
-	0xc0, 0x7c, 0x59, 0x00, 0x7c, 0x80, 0xae, 0xa4, 0x22, 0x93, 0x60, 0x95, 0xeb, 0xe5, 0x66, 0xcd,
-	0xcb, 0x37, 0xf8, 0x15, 0xaa, 0xa6, 0x24, 0x23, 0x31, 0x58, 0x15, 0x3d, 0x90, 0x47, 0xeb, 0xd1,
-	0x2c, 0xbc, 0xb8, 0xe3, 0xb6, 0xf3, 0x42, 0x3b, 0x14, 0xb5, 0x0b, 0xbf, 0xc6, 0x77, 0x13, 0xed,
-	0x2d, 0x0f, 0xeb, 0xff, 0xec, 0x3c, 0x46, 0x15, 0xd5, 0x6c, 0xab, 0x5c, 0x37, 0x9a, 0x35, 0x4f,
-	0xaf, 0xb1, 0xb7, 0xd4, 0xf7, 0x07, 0xeb, 0xb1, 0xe8, 0x2f, 0xfe, 0x4f, 0x1d, 0xff, 0x6c, 0xa0,
-	0x9d, 0x5f, 0xba, 0x82, 0xef, 0xa2, 0x1d, 0x2a, 0x92, 0x84, 0x51, 0xe5, 0xe8, 0xf3, 0x40, 0x7f,
-	0xf8, 0x35, 0x6f, 0x7b, 0x1e, 0xec, 0x07, 0xf8, 0x26, 0xba, 0xaa, 0x90, 0x94, 0x6c, 0x6a, 0xb9,
-	0xaa, 0xb6, 0xfd, 0x00, 0xdf, 0x46, 0xa8, 0x98, 0x92, 0xd2, 0x72, 0xfa, 0x5a, 0x11, 0xe9, 0x07,
-	0xb8, 0x83, 0x6e, 0x70, 0xf0, 0x63, 0x1e, 0x04, 0x11, 0x7b, 0x43, 0x32, 0xe6, 0xb3, 0x84, 0x0c,
-	0x22, 0x16, 0xe8, 0x27, 0xba, 0xe6, 0xed, 0x73, 0x38, 0x9b, 0x69, 0x4f, 0x73, 0xa9, 0xf1, 0xde,
-	0x40, 0xb7, 0xfe, 0xd2, 0xc4, 0x7f, 0x04, 0xbe, 0xa7, 0xde, 0x2e, 0x6d, 0xe4, 0x93, 0x20, 0xc8,
-	0x18, 0x40, 0x41, 0xbd, 0x5b, 0x84, 0xbb, 0x79, 0xf4, 0x24, 0x3c, 0x9f, 0xd8, 0xc6, 0xc5, 0xc4,
-	0x36, 0xbe, 0x4d, 0x6c, 0xe3, 0xd3, 0xd4, 0x2e, 0x5d, 0x4c, 0xed, 0xd2, 0x97, 0xa9, 0x5d, 0x7a,
-	0x7d, 0x16, 0x72, 0x39, 0x1c, 0x0d, 0x1c, 0x2a, 0x62, 0x97, 0x0a, 0x88, 0x05, 0xa8, 0xe3, 0xa1,
-	0x15, 0x0a, 0x77, 0xfc, 0xd0, 0x8d, 0x45, 0x30, 0x8a, 0x18, 0xa8, 0x1f, 0x34, 0xb8, 0x9d, 0xe3,
-	0xd6, 0x7c, 0x42, 0xad, 0xdf, 0x8e, 0x19, 0xf9, 0x36, 0x65, 0x30, 0xa8, 0xea, 0xbf, 0xf3, 0xfd,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0x57, 0x5a, 0x11, 0xa3, 0x06, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0x76, 0xdb, 0xef, 0x57, 0x77, 0x1b, 0x93, 0xf7, 0x87, 0x68, 0x88, 0x52, 0x95,
+	0x03, 0xbd, 0x2c, 0xd1, 0x0a, 0xd2, 0x10, 0x12, 0xa0, 0x32, 0xc1, 0xa8, 0xc4, 0xa4, 0x29, 0x5c,
+	0x10, 0x97, 0xc8, 0x75, 0xac, 0xd4, 0x52, 0x1a, 0x47, 0x7e, 0xdc, 0xa2, 0x9e, 0x41, 0xe2, 0x08,
+	0x2f, 0x81, 0x77, 0xc2, 0x75, 0xc7, 0x1d, 0x39, 0x21, 0xb4, 0xbd, 0x00, 0x24, 0x5e, 0x01, 0xb2,
+	0x93, 0xb5, 0xa5, 0x0b, 0xa8, 0xa5, 0x47, 0x4e, 0xb1, 0x9f, 0x6f, 0x9e, 0xef, 0xf3, 0x71, 0x1e,
+	0x3b, 0x46, 0x0f, 0x79, 0x87, 0xba, 0x24, 0x49, 0x22, 0x4e, 0x89, 0xe2, 0x22, 0x06, 0x97, 0xc7,
+	0x8a, 0x49, 0xda, 0x25, 0x3c, 0xf6, 0x09, 0xa5, 0xa2, 0x1f, 0x2b, 0x70, 0x43, 0x16, 0x33, 0xe0,
+	0xe0, 0x0e, 0xf6, 0x2f, 0x87, 0x4e, 0x22, 0x85, 0x12, 0xd8, 0xe5, 0x1d, 0xea, 0x4c, 0xa6, 0x3b,
+	0x39, 0xe9, 0xce, 0x65, 0xce, 0x60, 0x7f, 0x77, 0x2b, 0x14, 0xa1, 0x30, 0xb9, 0xae, 0x1e, 0xa5,
+	0x36, 0xbb, 0x87, 0x33, 0x51, 0x50, 0x11, 0x2b, 0x29, 0xa2, 0x88, 0x49, 0x0d, 0x32, 0x9e, 0x65,
+	0x26, 0x07, 0x33, 0x99, 0x74, 0x05, 0x28, 0x9d, 0xae, 0x9f, 0x69, 0x62, 0xfd, 0x43, 0x11, 0xad,
+	0x1e, 0xa5, 0x88, 0x2f, 0x15, 0x51, 0x0c, 0xbf, 0xb7, 0x90, 0x3d, 0xb6, 0xf7, 0x33, 0x7c, 0x1f,
+	0xb4, 0x68, 0x5b, 0x35, 0xab, 0x51, 0x69, 0x1e, 0x39, 0x73, 0xae, 0xdc, 0x39, 0x1c, 0x19, 0x4e,
+	0xd6, 0x7a, 0xb2, 0x74, 0xfa, 0xf5, 0x56, 0xc1, 0xdb, 0xa1, 0xb9, 0x2a, 0xee, 0x23, 0xac, 0x41,
+	0xa7, 0x10, 0x8a, 0x06, 0xa1, 0x35, 0x37, 0xc2, 0x73, 0x01, 0x2a, 0xa7, 0xf8, 0x46, 0x77, 0x2a,
+	0x5e, 0xff, 0x51, 0x42, 0x3b, 0xf9, 0xbc, 0xb8, 0x87, 0xae, 0x11, 0xaa, 0xf8, 0x80, 0xf9, 0xb4,
+	0x4b, 0xe2, 0x98, 0x45, 0x60, 0x5b, 0xb5, 0x52, 0xa3, 0xd2, 0x7c, 0x34, 0x37, 0x4e, 0xcb, 0xf8,
+	0x1c, 0xa6, 0x36, 0x19, 0xcb, 0x3a, 0x99, 0x0c, 0x02, 0x7e, 0x6b, 0xa1, 0xcd, 0x1c, 0x1b, 0xbb,
+	0x68, 0x6a, 0xbe, 0x98, 0xbb, 0xa6, 0xc7, 0x42, 0x0e, 0x8a, 0x49, 0x16, 0xb4, 0x47, 0x2f, 0xb6,
+	0xd2, 0xf7, 0x32, 0x02, 0xcc, 0xa7, 0x05, 0xc0, 0x5b, 0x68, 0x39, 0x11, 0x52, 0x81, 0x5d, 0xaa,
+	0x95, 0x1a, 0x65, 0x2f, 0x9d, 0xe0, 0x57, 0x68, 0x25, 0x21, 0x92, 0xf4, 0xc0, 0x5e, 0x32, 0x0d,
+	0x79, 0x30, 0x1b, 0xcd, 0xc4, 0xc6, 0x1d, 0xec, 0x3b, 0x27, 0xc6, 0x21, 0xab, 0x9d, 0xf9, 0x61,
+	0x40, 0xeb, 0x92, 0x29, 0x39, 0xf4, 0x13, 0x11, 0x71, 0xca, 0x19, 0xd8, 0xcb, 0x66, 0xbd, 0xcf,
+	0x16, 0x58, 0xaf, 0xa7, 0x0d, 0x4f, 0xb4, 0xdf, 0x30, 0xab, 0xb6, 0x26, 0x47, 0x21, 0xce, 0xa0,
+	0xfe, 0xbd, 0x88, 0x36, 0xa6, 0x77, 0xc8, 0xbf, 0xd9, 0x6e, 0x8c, 0x96, 0x74, 0x87, 0xed, 0x52,
+	0xcd, 0x6a, 0x94, 0x3d, 0x33, 0xc6, 0xde, 0x54, 0xb3, 0xef, 0xcd, 0xc6, 0x62, 0x7e, 0x33, 0xbf,
+	0x69, 0x73, 0xfd, 0x93, 0x85, 0xd6, 0x7e, 0xf9, 0x2a, 0xf8, 0x36, 0x5a, 0xa3, 0x22, 0x8e, 0x19,
+	0xd5, 0x8e, 0x3e, 0x0f, 0xcc, 0xdf, 0xa6, 0xec, 0xad, 0x8e, 0x83, 0xed, 0x00, 0x5f, 0x47, 0xff,
+	0x69, 0x24, 0x2d, 0x17, 0x8d, 0xbc, 0xa2, 0xa7, 0xed, 0x00, 0xdf, 0x44, 0x28, 0xeb, 0x92, 0xd6,
+	0x52, 0xfa, 0x72, 0x16, 0x69, 0x07, 0xb8, 0x89, 0xb6, 0x39, 0xf8, 0x3d, 0x1e, 0x04, 0x11, 0x7b,
+	0x43, 0x24, 0xf3, 0x59, 0x4c, 0x3a, 0x11, 0x0b, 0xcc, 0x8a, 0xfe, 0xf7, 0x36, 0x39, 0x1c, 0x8f,
+	0xb4, 0xa7, 0xa9, 0x54, 0x7f, 0x67, 0xa1, 0x1b, 0x7f, 0xf8, 0x88, 0x0b, 0x02, 0xdf, 0xd1, 0xbb,
+	0xcb, 0x18, 0xf9, 0x24, 0x08, 0x24, 0x03, 0xc8, 0xa8, 0xd7, 0xb3, 0x70, 0x2b, 0x8d, 0xd6, 0x3f,
+	0x5b, 0x68, 0x3b, 0x77, 0x2b, 0x2f, 0x08, 0xd0, 0x45, 0xab, 0x13, 0x07, 0x6d, 0x68, 0xaa, 0x57,
+	0x9a, 0x8f, 0xff, 0xe6, 0x20, 0x5f, 0x3d, 0x5f, 0x15, 0x39, 0x11, 0x0a, 0x4f, 0xcf, 0xab, 0xd6,
+	0xd9, 0x79, 0xd5, 0xfa, 0x76, 0x5e, 0xb5, 0x3e, 0x5e, 0x54, 0x0b, 0x67, 0x17, 0xd5, 0xc2, 0x97,
+	0x8b, 0x6a, 0xe1, 0xf5, 0x71, 0xc8, 0x55, 0xb7, 0xdf, 0x71, 0xa8, 0xe8, 0xb9, 0x54, 0x40, 0x4f,
+	0x80, 0xbe, 0x55, 0xf7, 0x42, 0xe1, 0x0e, 0xee, 0xbb, 0x3d, 0x11, 0xf4, 0x23, 0x06, 0xfa, 0x5e,
+	0x03, 0xb7, 0x79, 0xb0, 0x37, 0xe6, 0xd8, 0xbb, 0x72, 0x3b, 0xab, 0x61, 0xc2, 0xa0, 0xb3, 0x62,
+	0x2e, 0xb5, 0xbb, 0x3f, 0x07, 0x00, 0x08, 0xac, 0xc9, 0xfe, 0xda, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +537,20 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetryPolicies) > 0 {
+		for iNdEx := len(m.RetryPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -679,6 +767,53 @@ func (m *RegisteredInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *RegisteredRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -729,6 +864,12 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RetryPolicies) > 0 {
+		for _, e := range m.RetryPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -804,6 +945,25 @@ func (m *RegisteredInterchainAccount) Size() (n int) {
 	return n
 }
 
+func (m *RegisteredRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RetryPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1088,6 +1248,40 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryPolicies = append(m.RetryPolicies, RegisteredRetryPolicy{})
+			if err := m.RetryPolicies[len(m.RetryPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1604,6 +1798,153 @@ func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RegisteredRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,10 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdChannelMetadata(),
+		GetCmdExpectedInterchainAccountAddress(),
+		GetCmdExecutionResult(),
+		GetCmdDecodePacketData(),
 	)
 
 	return queryCmd
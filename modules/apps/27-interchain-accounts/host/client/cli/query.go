@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -50,6 +51,152 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdChannelMetadata returns the command handler for the host channel metadata querying.
+func GetCmdChannelMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-metadata [channel-id]",
+		Short:   "Query the interchain-accounts metadata of a host channel",
+		Long:    "Query the interchain-accounts metadata negotiated for a host channel, including the encoding, tx type, connection identifiers and interchain account address",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host channel-metadata channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelMetadata(cmd.Context(), &types.QueryChannelMetadataRequest{ChannelId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Metadata)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExpectedInterchainAccountAddress returns the command handler for querying the expected interchain account address.
+func GetCmdExpectedInterchainAccountAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "expected-interchain-account-address [connection-id] [owner]",
+		Short:   "Query the address of an interchain account before it is registered",
+		Long:    "Query the address the interchain account of an owner on a host connection will have once registered, or the address of the interchain account if it has already been registered",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host expected-interchain-account-address connection-0 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExpectedInterchainAccountAddress(cmd.Context(), &types.QueryExpectedInterchainAccountAddressRequest{ConnectionId: args[0], Owner: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExecutionResult returns the command handler for the host execution result querying.
+func GetCmdExecutionResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execution-result [channel-id] [sequence]",
+		Short:   "Query the execution result of an interchain accounts packet",
+		Long:    "Query the persisted result of the execution of the interchain accounts packet received with a given sequence on a host channel, including the message type URLs, gas used and error",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host execution-result channel-0 100", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExecutionResult(cmd.Context(), &types.QueryExecutionResultRequest{ChannelId: args[0], Sequence: seq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDecodePacketData returns the command handler for the host packet data decoding.
+func GetCmdDecodePacketData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-packet-data [packet-data]",
+		Short: "Decode the messages contained in interchain accounts packet data",
+		Long: `Decode the messages contained in interchain accounts packet data and print them as human-readable JSON.
+The packet data is provided as the JSON packet data, or hex encoded as found in the packet_data_hex attribute of packet events
+if the hex flag is set. The messages are decoded with the provided encoding, which defaults to proto3.`,
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%s query interchain-accounts host decode-packet-data '{"type":"TYPE_EXECUTE_TX","data":"CqIBChwvY29z...","memo":""}'
+%s query interchain-accounts host decode-packet-data 7b2274797065... --hex --encoding proto3json`, version.AppName, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			isHex, err := cmd.Flags().GetBool(hexFlag)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(encodingFlag)
+			if err != nil {
+				return err
+			}
+
+			packetData := []byte(args[0])
+			if isHex {
+				packetData, err = hex.DecodeString(args[0])
+				if err != nil {
+					return fmt.Errorf("invalid hex encoded packet data: %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DecodePacketData(cmd.Context(), &types.QueryDecodePacketDataRequest{PacketData: packetData, Encoding: encoding})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(hexFlag, false, "interpret the packet data as hex encoded bytes")
+	cmd.Flags().String(encodingFlag, "", "optional encoding format of the messages in the interchain accounts packet data, defaults to proto3")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdPacketEvents returns the command handler for the host packet events querying.
 func GetCmdPacketEvents() *cobra.Command {
 	cmd := &cobra.Command{
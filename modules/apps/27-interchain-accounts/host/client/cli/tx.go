@@ -19,17 +19,19 @@ import (
 const (
 	memoFlag     string = "memo"
 	encodingFlag string = "encoding"
+	hexFlag      string = "hex"
 )
 
 func generatePacketDataCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "generate-packet-data [message]",
-		Short: "Generates protobuf or proto3 JSON encoded ICA packet data.",
+		Short: "Generates protobuf, proto3 JSON or amino JSON encoded ICA packet data.",
 		Long: `generate-packet-data accepts a message string and serializes it (depending on the
-encoding parameter) using protobuf or proto3 JSON into packet data which is outputted to stdout.
+encoding parameter) using protobuf, proto3 JSON or amino JSON into packet data which is outputted to stdout.
 It can be used in conjunction with send-tx which submits pre-built packet data containing messages 
 to be executed on the host chain. The default encoding format is protobuf if none is specified;
-otherwise the encoding flag can be used in combination with either "proto3" or "proto3json".`,
+otherwise the encoding flag can be used in combination with either "proto3", "proto3json" or "aminojson".
+Messages encoded with amino JSON must be registered on the legacy amino codec of the application.`,
 		Example: fmt.Sprintf(`%s tx interchain-accounts host generate-packet-data '{
     "@type":"/cosmos.bank.v1beta1.MsgSend",
     "from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
@@ -82,11 +84,11 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 				return err
 			}
 
-			if !slices.Contains([]string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}, encoding) {
+			if !slices.Contains([]string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON, icatypes.EncodingAminoJSON}, encoding) {
 				return fmt.Errorf("unsupported encoding type: %s", encoding)
 			}
 
-			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, encoding)
+			packetDataBytes, err := generatePacketData(cdc, clientCtx.LegacyAmino, []byte(args[0]), memo, encoding)
 			if err != nil {
 				return err
 			}
@@ -103,14 +105,15 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 }
 
 // generatePacketData takes in message bytes and a memo and serializes the message into an
-// instance of InterchainAccountPacketData which is returned as bytes.
-func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string, encoding string) ([]byte, error) {
+// instance of InterchainAccountPacketData which is returned as bytes. The legacy amino codec is
+// only used to serialize the messages with the amino JSON encoding.
+func generatePacketData(cdc *codec.ProtoCodec, legacyAmino *codec.LegacyAmino, msgBytes []byte, memo string, encoding string) ([]byte, error) {
 	protoMessages, err := convertBytesIntoProtoMessages(cdc, msgBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo, encoding)
+	return generateIcaPacketDataFromProtoMessages(cdc, legacyAmino, protoMessages, memo, encoding)
 }
 
 // convertBytesIntoProtoMessages returns a list of proto messages from bytes. The bytes can be in the form of a single
@@ -142,8 +145,17 @@ func convertBytesIntoProtoMessages(cdc *codec.ProtoCodec, msgBytes []byte) ([]pr
 }
 
 // generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages and a memo.
-func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string, encoding string) ([]byte, error) {
-	icaPacketDataBytes, err := icatypes.SerializeCosmosTx(cdc, sdkMessages, encoding)
+func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, legacyAmino *codec.LegacyAmino, sdkMessages []proto.Message, memo string, encoding string) ([]byte, error) {
+	var txCdc codec.JSONCodec = cdc
+	if encoding == icatypes.EncodingAminoJSON {
+		if legacyAmino == nil {
+			return nil, fmt.Errorf("legacy amino codec is required for encoding type: %s", encoding)
+		}
+
+		txCdc = codec.NewAminoCodec(legacyAmino)
+	}
+
+	icaPacketDataBytes, err := icatypes.SerializeCosmosTx(txCdc, sdkMessages, encoding)
 	if err != nil {
 		return nil, err
 	}
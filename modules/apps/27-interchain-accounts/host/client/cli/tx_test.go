@@ -101,7 +101,7 @@ func TestGeneratePacketData(t *testing.T) {
 		},
 	}
 
-	encodings := []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}
+	encodings := []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON, icatypes.EncodingAminoJSON}
 	for _, encoding := range encodings {
 		for _, tc := range tests {
 			tc := tc
@@ -112,8 +112,18 @@ func TestGeneratePacketData(t *testing.T) {
 
 			cdc := codec.NewProtoCodec(ir)
 
+			legacyAmino := codec.NewLegacyAmino()
+			sdk.RegisterLegacyAminoCodec(legacyAmino)
+			stakingtypes.RegisterLegacyAminoCodec(legacyAmino)
+			banktypes.RegisterLegacyAminoCodec(legacyAmino)
+
+			var txCdc codec.JSONCodec = cdc
+			if encoding == icatypes.EncodingAminoJSON {
+				txCdc = codec.NewAminoCodec(legacyAmino)
+			}
+
 			t.Run(fmt.Sprintf("%s with %s encoding", tc.name, encoding), func(t *testing.T) {
-				bz, err := generatePacketData(cdc, []byte(tc.message), tc.memo, encoding)
+				bz, err := generatePacketData(cdc, legacyAmino, []byte(tc.message), tc.memo, encoding)
 
 				if tc.expectedPass {
 					require.NoError(t, err)
@@ -127,7 +137,7 @@ func TestGeneratePacketData(t *testing.T) {
 					require.Equal(t, tc.memo, packetData.Memo)
 
 					data := packetData.Data
-					messages, err := icatypes.DeserializeCosmosTx(cdc, data, encoding)
+					messages, err := icatypes.DeserializeCosmosTx(txCdc, data, encoding)
 
 					require.NoError(t, err)
 					require.NotNil(t, messages)
@@ -18,9 +18,10 @@ import (
 )
 
 var (
-	_ porttypes.IBCModule             = (*IBCModule)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCModule)(nil)
-	_ porttypes.UpgradableModule      = (*IBCModule)(nil)
+	_ porttypes.IBCModule                = (*IBCModule)(nil)
+	_ porttypes.PacketDataUnmarshaler    = (*IBCModule)(nil)
+	_ porttypes.UpgradableModule         = (*IBCModule)(nil)
+	_ porttypes.RecvPacketFailureHandler = (*IBCModule)(nil)
 )
 
 // IBCModule implements the ICS26 interface for interchain accounts host chains
@@ -122,6 +123,7 @@ func (im IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
 	}
 
+	gasBefore := ctx.GasMeter().GasConsumed()
 	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
@@ -129,6 +131,9 @@ func (im IBCModule) OnRecvPacket(
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
 	} else {
 		im.keeper.Logger(ctx).Info("successfully handled packet", "sequence", packet.Sequence)
+
+		// the results of failed executions are recorded by OnRecvPacketFailure, as the state changes made here are discarded
+		im.keeper.RecordExecutionResult(ctx, packet, ack.Acknowledgement(), ctx.GasMeter().GasConsumed()-gasBefore)
 	}
 
 	// Emit an event indicating a successful or failed acknowledgement.
@@ -138,23 +143,38 @@ func (im IBCModule) OnRecvPacket(
 	return ack
 }
 
+// OnRecvPacketFailure implements the RecvPacketFailureHandler interface. It records the result of a packet whose
+// execution failed, as the state changes made by OnRecvPacket are discarded for error acknowledgements.
+func (im IBCModule) OnRecvPacketFailure(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	gasUsed uint64,
+) {
+	im.keeper.RecordExecutionResult(ctx, packet, acknowledgement, gasUsed)
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
-func (IBCModule) OnAcknowledgementPacket(
+//
+// A host chain only sends host notification packets over the channel, acknowledgements of any other packet are rejected.
+func (im IBCModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive acknowledgement on a host channel end, a host chain does not send a packet over the channel")
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
 }
 
 // OnTimeoutPacket implements the IBCModule interface
-func (IBCModule) OnTimeoutPacket(
+//
+// A host chain only sends host notification packets over the channel, timeouts of any other packet are rejected.
+func (im IBCModule) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
+	return im.keeper.OnTimeoutPacket(ctx, packet)
 }
 
 // OnChanUpgradeInit implements the IBCModule interface
@@ -149,8 +149,8 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 			}, true,
 		},
 		{
-			"account address generation is block dependent", func() {
-				icaHostAccount := icatypes.GenerateAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			"success: pre-funded interchain account address", func() {
+				icaHostAccount := icatypes.GenerateDeterministicAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), icaHostAccount, sdk.Coins{sdk.NewCoin("stake", sdkmath.NewInt(1))})
 				suite.Require().NoError(err)
 				suite.Require().True(suite.chainB.GetSimApp().AccountKeeper.HasAccount(suite.chainB.GetContext(), icaHostAccount))
@@ -533,13 +533,41 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 }
 
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		packetData []byte
+		ackBytes   []byte
+	)
+
 	testCases := []struct {
 		name     string
 		malleate func()
 		expPass  bool
 	}{
 		{
-			"ICA OnAcknowledgementPacket fails with ErrInvalidChannelFlow", func() {}, false,
+			"success: host notification", func() {}, true,
+		},
+		{
+			"success: host notification error acknowledgement", func() {
+				ackBytes = channeltypes.NewErrorAcknowledgement(icatypes.ErrInvalidChannelFlow).Acknowledgement()
+			}, true,
+		},
+		{
+			"ICA OnAcknowledgementPacket fails with ErrInvalidChannelFlow", func() {
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+				}.GetBytes()
+			}, false,
+		},
+		{
+			"invalid packet data", func() {
+				packetData = []byte("empty packet data")
+			}, false,
+		},
+		{
+			"invalid acknowledgement", func() {
+				ackBytes = []byte("ackBytes")
+			}, false,
 		},
 	}
 
@@ -555,6 +583,12 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 			err := SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
+			packetData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.HOST_NOTIFICATION,
+				Data: []byte("notification"),
+			}.GetBytes()
+			ackBytes = channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
 			tc.malleate() // malleate mutates test data
 
 			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
@@ -564,8 +598,8 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 			suite.Require().True(ok)
 
 			packet := channeltypes.NewPacket(
-				[]byte("empty packet data"),
-				suite.chainA.SenderAccount.GetSequence(),
+				packetData,
+				1,
 				path.EndpointB.ChannelConfig.PortID,
 				path.EndpointB.ChannelID,
 				path.EndpointA.ChannelConfig.PortID,
@@ -574,7 +608,7 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 				0,
 			)
 
-			err = cbs.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, []byte("ackBytes"), nil)
+			err = cbs.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, ackBytes, nil)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -586,13 +620,28 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 }
 
 func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
+	var packetData []byte
+
 	testCases := []struct {
 		name     string
 		malleate func()
 		expPass  bool
 	}{
 		{
-			"ICA OnTimeoutPacket fails with ErrInvalidChannelFlow", func() {}, false,
+			"success: host notification", func() {}, true,
+		},
+		{
+			"ICA OnTimeoutPacket fails with ErrInvalidChannelFlow", func() {
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+				}.GetBytes()
+			}, false,
+		},
+		{
+			"invalid packet data", func() {
+				packetData = []byte("empty packet data")
+			}, false,
 		},
 	}
 
@@ -608,17 +657,22 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 			err := SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
+			packetData = icatypes.InterchainAccountPacketData{
+				Type: icatypes.HOST_NOTIFICATION,
+				Data: []byte("notification"),
+			}.GetBytes()
+
 			tc.malleate() // malleate mutates test data
 
-			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), path.EndpointB.ChannelConfig.PortID)
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
 			suite.Require().NoError(err)
 
-			cbs, ok := suite.chainA.App.GetIBCKeeper().PortKeeper.Route(module)
+			cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(module)
 			suite.Require().True(ok)
 
 			packet := channeltypes.NewPacket(
-				[]byte("empty packet data"),
-				suite.chainA.SenderAccount.GetSequence(),
+				packetData,
+				1,
 				path.EndpointB.ChannelConfig.PortID,
 				path.EndpointB.ChannelID,
 				path.EndpointA.ChannelConfig.PortID,
@@ -627,7 +681,7 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 				0,
 			)
 
-			err = cbs.OnTimeoutPacket(suite.chainA.GetContext(), packet, nil)
+			err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), packet, nil)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -864,6 +918,111 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	suite.assertBalance(icaAddr, expBalAfterSecondSend)
 }
 
+func (suite *InterchainAccountsTestSuite) TestExecutionResults() {
+	var fundICAWallet bool
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+	}{
+		{
+			"successful execution is recorded",
+			func() {},
+			true,
+		},
+		{
+			"failed execution is recorded",
+			func() {
+				fundICAWallet = false
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			fundICAWallet = true
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+
+			// use a fee enabled version to cover the unwrapping of incentivized acknowledgements
+			feeMetadata := feetypes.Metadata{
+				FeeVersion: feetypes.Version,
+				AppVersion: TestVersion,
+			}
+
+			feeICAVersion := string(feetypes.ModuleCdc.MustMarshalJSON(&feeMetadata))
+
+			path.EndpointA.ChannelConfig.Version = feeICAVersion
+			path.EndpointB.ChannelConfig.Version = feeICAVersion
+
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			tokenAmt := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)))
+			if fundICAWallet {
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, tokenAmt)
+			}
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      tokenAmt,
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			params.MaxExecutionResults = 10
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+			suite.Require().NoError(err)
+
+			suite.chainA.NextBlock()
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			result, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), path.EndpointB.ChannelID, sequence)
+			suite.Require().True(found)
+
+			suite.Require().Equal(path.EndpointB.ChannelID, result.ChannelId)
+			suite.Require().Equal(sequence, result.Sequence)
+			suite.Require().Equal([]string{sdk.MsgTypeURL(msg)}, result.MsgTypeUrls)
+			suite.Require().NotZero(result.GasUsed)
+			suite.Require().Equal(tc.expSuccess, result.Success)
+
+			if tc.expSuccess {
+				suite.Require().Empty(result.Error)
+			} else {
+				suite.Require().Contains(result.Error, "ABCI code")
+			}
+		})
+	}
+}
+
 // assertBalance asserts that the provided address has exactly the expected balance.
 // CONTRACT: the expected balance must only contain one coin denom.
 func (suite *InterchainAccountsTestSuite) assertBalance(addr sdk.AccAddress, expBalance sdk.Coins) {
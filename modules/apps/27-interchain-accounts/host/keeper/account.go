@@ -9,14 +9,25 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 )
 
-// createInterchainAccount creates a new interchain account. An address is generated using the host connectionID, the controller portID,
-// and block dependent information. An error is returned if an account already exists for the generated account.
+// createInterchainAccount creates a new interchain account. The address is generated deterministically using the host connectionID
+// and the controller portID, see icatypes.GenerateDeterministicAddress. An account which has been pre-funded at the generated address
+// is converted into the interchain account. If the generated address is taken by any other account, an address is generated using
+// block dependent information instead, and an error is returned if an account already exists for that address.
 // An interchain account type is set in the account keeper and the interchain account address mapping is updated.
 func (k Keeper) createInterchainAccount(ctx sdk.Context, connectionID, controllerPortID string) (sdk.AccAddress, error) {
-	accAddress := icatypes.GenerateAddress(ctx, connectionID, controllerPortID)
+	accAddress := icatypes.GenerateDeterministicAddress(connectionID, controllerPortID)
 
 	if acc := k.accountKeeper.GetAccount(ctx, accAddress); acc != nil {
-		return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for newly generated interchain account address %s", accAddress)
+		if baseAccount, ok := acc.(*authtypes.BaseAccount); ok && baseAccount.GetPubKey() == nil && baseAccount.GetSequence() == 0 {
+			// the pre-funded account keeps its account number and balances
+			k.setInterchainAccount(ctx, connectionID, icatypes.NewInterchainAccount(baseAccount, controllerPortID))
+			return accAddress, nil
+		}
+
+		accAddress = icatypes.GenerateAddress(ctx, connectionID, controllerPortID)
+		if acc := k.accountKeeper.GetAccount(ctx, accAddress); acc != nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for newly generated interchain account address %s", accAddress)
+		}
 	}
 
 	interchainAccount := icatypes.NewInterchainAccount(
@@ -25,9 +36,13 @@ func (k Keeper) createInterchainAccount(ctx sdk.Context, connectionID, controlle
 	)
 
 	k.accountKeeper.NewAccount(ctx, interchainAccount)
-	k.accountKeeper.SetAccount(ctx, interchainAccount)
-
-	k.SetInterchainAccountAddress(ctx, connectionID, controllerPortID, interchainAccount.Address)
+	k.setInterchainAccount(ctx, connectionID, interchainAccount)
 
 	return accAddress, nil
 }
+
+// setInterchainAccount sets the interchain account in the account keeper and updates the interchain account address mapping.
+func (k Keeper) setInterchainAccount(ctx sdk.Context, connectionID string, interchainAccount *icatypes.InterchainAccount) {
+	k.accountKeeper.SetAccount(ctx, interchainAccount)
+	k.SetInterchainAccountAddress(ctx, connectionID, interchainAccount.AccountOwner, interchainAccount.Address)
+}
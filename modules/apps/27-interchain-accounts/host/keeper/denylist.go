@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// pendingChannelClosure defines a channel flagged for closure together with the connection it is built upon.
+type pendingChannelClosure struct {
+	portID       string
+	channelID    string
+	connectionID string
+}
+
+// verifyConnectionAllowed returns an error if the connection of the channel associated with the provided port and
+// channel identifiers is present in the connection denylist of the host submodule.
+func (k Keeper) verifyConnectionAllowed(ctx sdk.Context, portID, channelID string) error {
+	connectionID, err := k.getConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if k.GetParams(ctx).IsConnectionDenied(connectionID) {
+		return errorsmod.Wrapf(types.ErrConnectionDenied, "connection %s of channel %s is denied", connectionID, channelID)
+	}
+
+	return nil
+}
+
+// IsChannelClosurePending returns true if the channel associated with the provided port and channel identifiers
+// is flagged for closure, otherwise false.
+func (k Keeper) IsChannelClosurePending(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPendingChannelClosure(portID, channelID))
+}
+
+// setPendingChannelClosure flags the channel associated with the provided port and channel identifiers for closure.
+func (k Keeper) setPendingChannelClosure(ctx sdk.Context, portID, channelID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPendingChannelClosure(portID, channelID), []byte(connectionID))
+}
+
+// deletePendingChannelClosure removes the closure flag of the channel associated with the provided port and channel identifiers.
+func (k Keeper) deletePendingChannelClosure(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingChannelClosure(portID, channelID))
+}
+
+// flagDeniedChannels flags all active channels built on a connection present in the connection denylist for closure.
+// Flagged channels are closed by ProcessPendingChannelClosures.
+func (k Keeper) flagDeniedChannels(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if len(params.DeniedConnections) == 0 {
+		return
+	}
+
+	// the port identifier of active channels is the controller port, the host end of the channel is bound to the host port
+	for _, ch := range k.GetAllActiveChannels(ctx) {
+		if params.IsConnectionDenied(ch.ConnectionId) {
+			k.setPendingChannelClosure(ctx, icatypes.HostPortID, ch.ChannelId, ch.ConnectionId)
+		}
+	}
+}
+
+// ProcessPendingChannelClosures closes all channels flagged for closure. Flags of channels which are already closed,
+// or whose connection was removed from the connection denylist since they were flagged, are removed without closing
+// the channel. Channels which cannot be closed (for example because the client of the connection is not active) remain
+// flagged and closure is attempted again in the next block.
+func (k Keeper) ProcessPendingChannelClosures(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingChannelClosureKeyPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var pendingClosures []pendingChannelClosure
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		pendingClosures = append(pendingClosures, pendingChannelClosure{
+			portID:       keySplit[1],
+			channelID:    keySplit[2],
+			connectionID: string(iterator.Value()),
+		})
+	}
+
+	if len(pendingClosures) == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	for _, pendingClosure := range pendingClosures {
+		portID, channelID := pendingClosure.portID, pendingClosure.channelID
+
+		if !params.IsConnectionDenied(pendingClosure.connectionID) {
+			k.deletePendingChannelClosure(ctx, portID, channelID)
+			continue
+		}
+
+		channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+		if !found || channel.State == channeltypes.CLOSED {
+			k.deletePendingChannelClosure(ctx, portID, channelID)
+			continue
+		}
+
+		if err := k.closeChannel(ctx, portID, channelID); err != nil {
+			k.Logger(ctx).Error("failed to close channel of denied connection", "port-id", portID, "channel-id", channelID, "connection-id", pendingClosure.connectionID, "error", err.Error())
+			continue
+		}
+
+		k.deletePendingChannelClosure(ctx, portID, channelID)
+		emitChannelClosedEvent(ctx, portID, channelID, pendingClosure.connectionID)
+	}
+}
+
+// closeChannel closes the channel associated with the provided port and channel identifiers. State changes are only
+// written if the channel is closed successfully.
+func (k Keeper) closeChannel(ctx sdk.Context, portID, channelID string) error {
+	name := host.ChannelCapabilityPath(portID, channelID)
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, name)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "failed to retrieve capability %s", name)
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.channelKeeper.ChanCloseInit(cacheCtx, portID, channelID, chanCap); err != nil {
+		return err
+	}
+
+	writeFn()
+
+	return nil
+}
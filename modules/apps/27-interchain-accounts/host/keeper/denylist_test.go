@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestProcessPendingChannelClosures() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expClosed  bool
+		expPending bool
+	}{
+		{
+			"success: channel of denied connection is closed",
+			func() {},
+			true,
+			false,
+		},
+		{
+			"connection removed from denylist: channel is not closed",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.DefaultParams())
+			},
+			false,
+			false,
+		},
+		{
+			"channel already closed: closure flag is removed",
+			func() {
+				path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			true,
+			false,
+		},
+		{
+			"client not active: channel remains flagged for closure",
+			func() {
+				clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointB.SetClientState(clientState)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.DefaultParams()
+			params.DeniedConnections = []string{path.EndpointB.ConnectionID}
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainB.GetSimApp().ICAHostKeeper)
+			_, err = msgServer.UpdateParams(suite.chainB.GetContext(), types.NewMsgUpdateParams(suite.chainB.GetSimApp().ICAHostKeeper.GetAuthority(), params))
+			suite.Require().NoError(err)
+
+			suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.IsChannelClosurePending(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().ICAHostKeeper.ProcessPendingChannelClosures(suite.chainB.GetContext())
+
+			channel := path.EndpointB.GetChannel()
+			suite.Require().Equal(tc.expClosed, channel.State == channeltypes.CLOSED)
+
+			isPending := suite.chainB.GetSimApp().ICAHostKeeper.IsChannelClosurePending(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().Equal(tc.expPending, isPending)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisFlagsDeniedChannels() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	genesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
+	genesisState.Params.DeniedConnections = []string{path.EndpointB.ConnectionID}
+
+	keeper.InitGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper, genesisState)
+
+	suite.Require().True(suite.chainB.GetSimApp().ICAHostKeeper.IsChannelClosurePending(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+}
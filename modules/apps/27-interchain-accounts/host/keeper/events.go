@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -44,3 +45,66 @@ func EmitHostDisabledEvent(ctx sdk.Context, packet channeltypes.Packet) {
 		),
 	)
 }
+
+// emitChannelClosedEvent emits an event signalling that a channel built on a denied connection has been closed.
+func emitChannelClosedEvent(ctx sdk.Context, portID, channelID, connectionID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeChannelClosed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+		),
+	)
+}
+
+// emitHostNotificationSentEvent emits an event signalling that a host notification has been sent to a controller chain.
+func emitHostNotificationSentEvent(ctx sdk.Context, controllerPortID, connectionID, channelID string, sequence uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeHostNotificationSent,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, controllerPortID),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, channelID),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+}
+
+// emitHostNotificationAcknowledgedEvent emits an event signalling a successful or failed acknowledgement of a host
+// notification and including the error details if any.
+func emitHostNotificationAcknowledgedEvent(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyPortID, packet.GetDestPort()),
+		sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetSourceChannel()),
+		sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+	}
+
+	if errorResp, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, errorResp.Error))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeHostNotificationAcknowledged,
+			attributes...,
+		),
+	)
+}
+
+// emitHostNotificationTimeoutEvent emits an event signalling that a host notification has timed out.
+func emitHostNotificationTimeoutEvent(ctx sdk.Context, packet channeltypes.Packet) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeHostNotificationTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, packet.GetDestPort()),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetSourceChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		),
+	)
+}
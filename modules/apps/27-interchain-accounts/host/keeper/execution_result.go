@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// RecordExecutionResult persists the result of the execution of the provided packet received on a host channel if
+// the host submodule is configured to persist execution results. Once the number of results persisted for the channel
+// exceeds the maximum set in the host params, the results with the lowest sequences are pruned.
+// The error of a failed execution is read from the acknowledgement written for the packet, as the errors returned by
+// message handlers are not guaranteed to be deterministic.
+func (k Keeper) RecordExecutionResult(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, gasUsed uint64) {
+	maxResults := k.GetParams(ctx).MaxExecutionResults
+	if maxResults == 0 {
+		return
+	}
+
+	result := types.ExecutionResult{
+		ChannelId:   packet.DestinationChannel,
+		Sequence:    packet.Sequence,
+		MsgTypeUrls: k.getMsgTypeURLs(ctx, packet),
+		GasUsed:     gasUsed,
+		Success:     true,
+		BlockHeight: ctx.BlockHeight(),
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		result.Success = false
+		result.Error = ack.GetError()
+	}
+
+	k.setExecutionResult(ctx, result)
+	k.pruneExecutionResults(ctx, packet.DestinationChannel, maxResults)
+}
+
+// GetExecutionResult returns the persisted result of the execution of the packet received with the provided sequence
+// on the provided host channel.
+func (k Keeper) GetExecutionResult(ctx sdk.Context, channelID string, sequence uint64) (types.ExecutionResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyExecutionResult(channelID, sequence))
+	if len(bz) == 0 {
+		return types.ExecutionResult{}, false
+	}
+
+	var result types.ExecutionResult
+	k.cdc.MustUnmarshal(bz, &result)
+
+	return result, true
+}
+
+// setExecutionResult stores the provided execution result.
+func (k Keeper) setExecutionResult(ctx sdk.Context, result types.ExecutionResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyExecutionResult(result.ChannelId, result.Sequence), k.cdc.MustMarshal(&result))
+}
+
+// pruneExecutionResults deletes the execution results with the lowest sequences of the provided host channel until
+// at most maxResults execution results remain persisted for the channel.
+func (k Keeper) pruneExecutionResults(ctx sdk.Context, channelID string, maxResults uint32) {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range k.getPrunableExecutionResultKeys(ctx, channelID, maxResults) {
+		store.Delete(key)
+	}
+}
+
+// getPrunableExecutionResultKeys returns the store keys of the execution results of the provided host channel
+// which exceed the provided maximum, starting from the result with the highest sequence.
+func (k Keeper) getPrunableExecutionResultKeys(ctx sdk.Context, channelID string, maxResults uint32) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyExecutionResultPrefix(channelID))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var (
+		count uint32
+		keys  [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count > maxResults {
+			keys = append(keys, iterator.Key())
+		}
+	}
+
+	return keys
+}
+
+// getMsgTypeURLs returns the type URLs of the messages contained in the interchain account packet data of the
+// provided packet. The type URLs are retrieved on a best effort basis, nil is returned if the packet data cannot
+// be decoded.
+func (k Keeper) getMsgTypeURLs(ctx sdk.Context, packet channeltypes.Packet) []string {
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil || data.Type != icatypes.EXECUTE_TX {
+		return nil
+	}
+
+	metadata, err := k.getAppMetadata(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return nil
+	}
+
+	typeURLs, err := icatypes.CosmosTxMessageTypeURLs(data.Data, metadata.Encoding)
+	if err != nil {
+		return nil
+	}
+
+	return typeURLs
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestRecordExecutionResult() {
+	var (
+		path            *ibctesting.Path
+		packet          channeltypes.Packet
+		acknowledgement []byte
+		expResult       *types.ExecutionResult
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: successful execution",
+			func() {},
+		},
+		{
+			"success: failed execution",
+			func() {
+				ack := channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+				acknowledgement = ack.Acknowledgement()
+
+				expResult.Success = false
+				expResult.Error = ack.GetError()
+			},
+		},
+		{
+			"success: packet data cannot be decoded",
+			func() {
+				packet.Data = []byte("invalid packet data")
+
+				expResult.MsgTypeUrls = nil
+			},
+		},
+		{
+			"execution results are not persisted",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MaxExecutionResults = 0
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				expResult = nil
+			},
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s: %s", encoding, tc.name), func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB, encoding)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				params := types.DefaultParams()
+				params.MaxExecutionResults = 5
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				msg := &banktypes.MsgSend{}
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				packetData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packet = channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+				acknowledgement = channeltypes.NewResultAcknowledgement([]byte("result")).Acknowledgement()
+
+				expResult = &types.ExecutionResult{
+					ChannelId:   path.EndpointB.ChannelID,
+					Sequence:    packet.Sequence,
+					MsgTypeUrls: []string{sdk.MsgTypeURL(msg)},
+					GasUsed:     1000,
+					Success:     true,
+					BlockHeight: suite.chainB.GetContext().BlockHeight(),
+				}
+
+				tc.malleate()
+
+				suite.chainB.GetSimApp().ICAHostKeeper.RecordExecutionResult(suite.chainB.GetContext(), packet, acknowledgement, 1000)
+
+				result, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), path.EndpointB.ChannelID, packet.Sequence)
+				if expResult != nil {
+					suite.Require().True(found)
+					suite.Require().Equal(*expResult, result)
+				} else {
+					suite.Require().False(found)
+				}
+			})
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneExecutionResults() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.MaxExecutionResults = 3
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("result")).Acknowledgement()
+	for seq := uint64(1); seq <= 5; seq++ {
+		packet := channeltypes.NewPacket(nil, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+		suite.chainB.GetSimApp().ICAHostKeeper.RecordExecutionResult(suite.chainB.GetContext(), packet, acknowledgement, 0)
+	}
+
+	for seq := uint64(1); seq <= 5; seq++ {
+		_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), path.EndpointB.ChannelID, seq)
+		suite.Require().Equal(seq > 2, found, "unexpected execution result for sequence %d", seq)
+	}
+
+	// lowering the maximum prunes the oldest results on the next execution
+	params.MaxExecutionResults = 1
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(nil, 6, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.RecordExecutionResult(suite.chainB.GetContext(), packet, acknowledgement, 0)
+
+	for seq := uint64(1); seq <= 6; seq++ {
+		_, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionResult(suite.chainB.GetContext(), path.EndpointB.ChannelID, seq)
+		suite.Require().Equal(seq == 6, found, "unexpected execution result for sequence %d", seq)
+	}
+}
@@ -37,6 +37,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisS
 		panic(fmt.Errorf("could not set ica host params at genesis: %v", err))
 	}
 	keeper.SetParams(ctx, state.Params)
+
+	keeper.flagDeniedChannels(ctx)
 }
 
 // ExportGenesis returns the interchain accounts host exported genesis
@@ -2,10 +2,19 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -19,3 +28,137 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// ChannelMetadata implements the Query/ChannelMetadata gRPC method
+func (k Keeper) ChannelMetadata(c context.Context, req *types.QueryChannelMetadataRequest) (*types.QueryChannelMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	metadata, err := k.GetChannelMetadata(ctx, req.ChannelId)
+	if err != nil {
+		if errors.Is(err, ibcerrors.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChannelMetadataResponse{
+		Metadata: metadata,
+	}, nil
+}
+
+// ExpectedInterchainAccountAddress implements the Query/ExpectedInterchainAccountAddress gRPC method
+func (k Keeper) ExpectedInterchainAccountAddress(c context.Context, req *types.QueryExpectedInterchainAccountAddressRequest) (*types.QueryExpectedInterchainAccountAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if address, found := k.GetInterchainAccountAddress(ctx, req.ConnectionId, portID); found {
+		return &types.QueryExpectedInterchainAccountAddressResponse{
+			Address:    address,
+			Registered: true,
+		}, nil
+	}
+
+	return &types.QueryExpectedInterchainAccountAddressResponse{
+		Address: icatypes.GenerateDeterministicAddress(req.ConnectionId, portID).String(),
+	}, nil
+}
+
+// ExecutionResult implements the Query/ExecutionResult gRPC method
+func (k Keeper) ExecutionResult(c context.Context, req *types.QueryExecutionResultRequest) (*types.QueryExecutionResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	result, found := k.GetExecutionResult(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrExecutionResultNotFound, "channel-id %s, sequence %d", req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryExecutionResultResponse{
+		Result: result,
+	}, nil
+}
+
+// DecodePacketData implements the Query/DecodePacketData gRPC method
+func (k Keeper) DecodePacketData(_ context.Context, req *types.QueryDecodePacketDataRequest) (*types.QueryDecodePacketDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.PacketData) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet data cannot be empty")
+	}
+
+	encoding := req.Encoding
+	if encoding == "" {
+		encoding = icatypes.EncodingProtobuf
+	}
+
+	if err := k.validateEncoding(encoding); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(req.PacketData); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data: %s", err).Error())
+	}
+
+	// only packets executing transactions on the host chain contain messages
+	var messages []string
+	if data.Type == icatypes.EXECUTE_TX {
+		msgs, err := icatypes.DeserializeCosmosTx(k.txCodec(encoding), data.Data, encoding)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		messages = make([]string, len(msgs))
+		for i, msg := range msgs {
+			bz, err := k.cdc.MarshalInterfaceJSON(msg)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			messages[i] = string(bz)
+		}
+	}
+
+	return &types.QueryDecodePacketDataResponse{
+		Type:     data.Type,
+		Memo:     data.Memo,
+		Messages: messages,
+	}, nil
+}
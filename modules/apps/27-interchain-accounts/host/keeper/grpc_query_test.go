@@ -1,7 +1,19 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -10,3 +22,386 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryChannelMetadata() {
+	var (
+		req         *types.QueryChannelMetadataRequest
+		path        *ibctesting.Path
+		encoding    string
+		expMetadata icatypes.Metadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				expMetadata = icatypes.NewMetadata(icatypes.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, interchainAccountAddr, encoding, icatypes.TxTypeSDKMultiMsg)
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, encoding = range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB, encoding)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				req = &types.QueryChannelMetadataRequest{
+					ChannelId: path.EndpointB.ChannelID,
+				}
+
+				tc.malleate()
+
+				res, err := suite.chainB.GetSimApp().ICAHostKeeper.ChannelMetadata(suite.chainB.GetContext(), req)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().Equal(expMetadata, res.Metadata)
+				} else {
+					suite.Require().Error(err)
+				}
+			})
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExpectedInterchainAccountAddress() {
+	var req *types.QueryExpectedInterchainAccountAddressRequest
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expRegistered bool
+		expPass       bool
+	}{
+		{
+			"success: interchain account not yet registered",
+			func() {},
+			false,
+			true,
+		},
+		{
+			"success: interchain account registered",
+			func() {
+				path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+				path.SetupConnections()
+
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+			},
+			true,
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+			false,
+		},
+		{
+			"empty owner",
+			func() {
+				req.Owner = " "
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryExpectedInterchainAccountAddressRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				Owner:        TestOwnerAddress,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ExpectedInterchainAccountAddress(suite.chainB.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRegistered, res.Registered)
+				suite.Require().Equal(icatypes.GenerateDeterministicAddress(ibctesting.FirstConnectionID, TestPortID).String(), res.Address)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExecutionResult() {
+	var (
+		req       *types.QueryExecutionResultRequest
+		expResult types.ExecutionResult
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank"),
+		},
+		{
+			"invalid sequence",
+			func() {
+				req.Sequence = 0
+			},
+			status.Error(codes.InvalidArgument, "packet sequence cannot be 0"),
+		},
+		{
+			"execution result not found",
+			func() {
+				req.Sequence = 2
+			},
+			types.ErrExecutionResultNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := types.DefaultParams()
+			params.MaxExecutionResults = 1
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(nil, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+			acknowledgement := channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+			suite.chainB.GetSimApp().ICAHostKeeper.RecordExecutionResult(suite.chainB.GetContext(), packet, acknowledgement.Acknowledgement(), 100)
+
+			expResult = types.ExecutionResult{
+				ChannelId:   path.EndpointB.ChannelID,
+				Sequence:    1,
+				GasUsed:     100,
+				Error:       acknowledgement.GetError(),
+				BlockHeight: suite.chainB.GetContext().BlockHeight(),
+			}
+
+			req = &types.QueryExecutionResultRequest{
+				ChannelId: path.EndpointB.ChannelID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ExecutionResult(suite.chainB.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResult, res.Result)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDecodePacketData() {
+	var (
+		req         *types.QueryDecodePacketDataRequest
+		msg         *banktypes.MsgSend
+		expMessages []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty encoding defaults to proto3",
+			func() {
+				req.Encoding = ""
+			},
+			nil,
+		},
+		{
+			"success: proto3json encoding",
+			func() {
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProto3JSON)
+				suite.Require().NoError(err)
+
+				packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "memo"}
+				req = &types.QueryDecodePacketDataRequest{PacketData: packetData.GetBytes(), Encoding: icatypes.EncodingProto3JSON}
+			},
+			nil,
+		},
+		{
+			"success: aminojson encoding",
+			func() {
+				data, err := icatypes.SerializeCosmosTx(codec.NewAminoCodec(suite.chainA.GetSimApp().LegacyAmino()), []proto.Message{msg}, icatypes.EncodingAminoJSON)
+				suite.Require().NoError(err)
+
+				packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "memo"}
+				req = &types.QueryDecodePacketDataRequest{PacketData: packetData.GetBytes(), Encoding: icatypes.EncodingAminoJSON}
+			},
+			nil,
+		},
+		{
+			"success: packet data without messages",
+			func() {
+				packetData := icatypes.InterchainAccountPacketData{Type: icatypes.HOST_NOTIFICATION, Data: []byte("notification"), Memo: "memo"}
+				req.PacketData = packetData.GetBytes()
+
+				expMessages = nil
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"empty packet data",
+			func() {
+				req.PacketData = nil
+			},
+			status.Error(codes.InvalidArgument, "packet data cannot be empty"),
+		},
+		{
+			"invalid packet data",
+			func() {
+				req.PacketData = []byte("invalid packet data")
+			},
+			icatypes.ErrUnknownDataType,
+		},
+		{
+			"encoding does not match packet data",
+			func() {
+				req.Encoding = icatypes.EncodingProto3JSON
+			},
+			icatypes.ErrUnknownDataType,
+		},
+		{
+			"unsupported encoding",
+			func() {
+				req.Encoding = "invalid-encoding"
+			},
+			icatypes.ErrInvalidCodec,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = &banktypes.MsgSend{
+				FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+				ToAddress:   suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(ibctesting.TestCoin),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "memo"}
+			req = &types.QueryDecodePacketDataRequest{
+				PacketData: packetData.GetBytes(),
+				Encoding:   icatypes.EncodingProtobuf,
+			}
+
+			msgBz, err := suite.chainB.GetSimApp().AppCodec().MarshalInterfaceJSON(msg)
+			suite.Require().NoError(err)
+			expMessages = []string{string(msgBz)}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.DecodePacketData(suite.chainB.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expMessages, res.Messages)
+				suite.Require().Equal("memo", res.Memo)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -34,6 +35,10 @@ func (k Keeper) OnChanOpenTry(
 		return "", errorsmod.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.HostPortID, portID)
 	}
 
+	if k.GetParams(ctx).IsConnectionDenied(connectionHops[0]) {
+		return "", errorsmod.Wrapf(types.ErrConnectionDenied, "connection %s is denied", connectionHops[0])
+	}
+
 	metadata, err := icatypes.MetadataFromVersion(counterpartyVersion)
 	if err != nil {
 		// Propose the default metadata if the counterparty version is invalid
@@ -53,6 +58,13 @@ func (k Keeper) OnChanOpenTry(
 		return "", err
 	}
 
+	if err = k.validateEncoding(metadata.Encoding); err != nil {
+		return "", err
+	}
+
+	// host notifications requested by the controller are only kept if they are enabled on the host chain
+	metadata.HostNotifications = metadata.HostNotifications && k.GetParams(ctx).NotificationsEnabled
+
 	activeChannelID, found := k.GetActiveChannelID(ctx, connectionHops[0], counterparty.PortId)
 	if found {
 		channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
@@ -141,6 +153,7 @@ func (Keeper) OnChanCloseConfirm(
 // - tx type (must be supported)
 // - encoding (must be supported)
 // - order
+// - host notifications (must be enabled on the host chain if requested)
 //
 // The following may not be changed:
 // - connectionHops (and subsequently host/controller connectionIDs)
@@ -181,6 +194,14 @@ func (k Keeper) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, prop
 		return "", errorsmod.Wrap(err, "invalid metadata")
 	}
 
+	if err := k.validateEncoding(proposedCounterpartyMetadata.Encoding); err != nil {
+		return "", errorsmod.Wrap(err, "invalid metadata")
+	}
+
+	if proposedCounterpartyMetadata.HostNotifications && !k.GetParams(ctx).NotificationsEnabled {
+		return "", errorsmod.Wrap(types.ErrNotificationsDisabled, "cannot upgrade channel to use host notifications")
+	}
+
 	// the interchain account address on the host chain
 	// must remain the same after the upgrade.
 	if currentMetadata.Address != proposedCounterpartyMetadata.Address {
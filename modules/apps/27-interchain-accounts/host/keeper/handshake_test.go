@@ -170,10 +170,35 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"success - pre-funded interchain account address",
+			func() {
+				interchainAccAddr := icatypes.GenerateDeterministicAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), interchainAccAddr, sdk.Coins{sdk.NewCoin("stake", sdkmath.NewInt(1))})
+				suite.Require().NoError(err)
+				suite.Require().True(suite.chainB.GetSimApp().AccountKeeper.HasAccount(suite.chainB.GetContext(), interchainAccAddr))
+			},
+			true,
+		},
+		{
+			"success - deterministic address taken, block dependent address is used",
+			func() {
+				interchainAccAddr := icatypes.GenerateDeterministicAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				acc := suite.chainB.GetSimApp().AccountKeeper.NewAccountWithAddress(suite.chainB.GetContext(), interchainAccAddr)
+				suite.Require().NoError(acc.SetSequence(1))
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), acc)
+			},
+			true,
+		},
 		{
 			"account already exists",
 			func() {
-				interchainAccAddr := icatypes.GenerateAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				interchainAccAddr := icatypes.GenerateDeterministicAddress(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				acc := suite.chainB.GetSimApp().AccountKeeper.NewAccountWithAddress(suite.chainB.GetContext(), interchainAccAddr)
+				suite.Require().NoError(acc.SetSequence(1))
+				suite.chainB.GetSimApp().AccountKeeper.SetAccount(suite.chainB.GetContext(), acc)
+
+				interchainAccAddr = icatypes.GenerateAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), interchainAccAddr, sdk.Coins{sdk.NewCoin("stake", sdkmath.NewInt(1))})
 				suite.Require().NoError(err)
 				suite.Require().True(suite.chainB.GetSimApp().AccountKeeper.HasAccount(suite.chainB.GetContext(), interchainAccAddr))
@@ -215,6 +240,20 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"amino json encoding is not supported without the legacy amino codec",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.WithLegacyAmino(nil)
+
+				metadata.Encoding = icatypes.EncodingAminoJSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"unsupported transaction type",
 			func() {
@@ -289,6 +328,15 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"connection is denied",
+			func() {
+				params := hosttypes.DefaultParams()
+				params.DeniedConnections = []string{path.EndpointB.ConnectionID}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -358,6 +406,50 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnChanOpenTryHostNotifications() {
+	testCases := []struct {
+		name                    string
+		requested               bool
+		enabled                 bool
+		expHostNotificationsSet bool
+	}{
+		{"host notifications requested and enabled", true, true, true},
+		{"host notifications requested but disabled", true, false, false},
+		{"host notifications not requested", false, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+			params.NotificationsEnabled = tc.enabled
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+
+			metadata := icatypes.NewDefaultMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+			metadata.HostNotifications = tc.requested
+			path.EndpointA.ChannelConfig.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// the negotiated version is used on both channel ends
+			for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+				metadata, err := icatypes.MetadataFromVersion(endpoint.GetChannel().Version)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expHostNotificationsSet, metadata.HostNotifications)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnChanOpenConfirm() {
 	var path *ibctesting.Path
 
@@ -482,6 +574,28 @@ func (suite *KeeperTestSuite) TestOnChanUpgradeTry() {
 			},
 			expError: nil,
 		},
+		{
+			name: "success: enable host notifications",
+			malleate: func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.NotificationsEnabled = true
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				updateMetadata(func(metadata *icatypes.Metadata) {
+					metadata.HostNotifications = true
+				})
+			},
+			expError: nil,
+		},
+		{
+			name: "failure: host notifications are disabled",
+			malleate: func() {
+				updateMetadata(func(metadata *icatypes.Metadata) {
+					metadata.HostNotifications = true
+				})
+			},
+			expError: hosttypes.ErrNotificationsDisabled,
+		},
 		{
 			name: "failure: invalid port ID",
 			malleate: func() {
@@ -616,7 +730,7 @@ func (suite *KeeperTestSuite) TestOnChanUpgradeTry() {
 			expPass := tc.expError == nil
 			if expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(path.EndpointB.GetChannel().Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().ErrorIs(err, tc.expError)
 			}
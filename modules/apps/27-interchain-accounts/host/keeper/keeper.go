@@ -33,6 +33,7 @@ import (
 type Keeper struct {
 	storeKey       storetypes.StoreKey
 	cdc            codec.Codec
+	legacyAmino    *codec.LegacyAmino
 	legacySubspace icatypes.ParamSubspace
 
 	ics4Wrapper   porttypes.ICS4Wrapper
@@ -92,6 +93,13 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithLegacyAmino sets the LegacyAmino codec used to deserialize the messages of transactions received on
+// channels which negotiated the amino JSON encoding. Channels may only negotiate the amino JSON encoding
+// if the LegacyAmino codec of the application has been set.
+func (k *Keeper) WithLegacyAmino(legacyAmino *codec.LegacyAmino) {
+	k.legacyAmino = legacyAmino
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
@@ -102,6 +110,25 @@ func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", exported.ModuleName, icatypes.ModuleName))
 }
 
+// validateEncoding returns an error if the provided encoding cannot be used to deserialize transactions
+// on the host chain. The amino JSON encoding requires the LegacyAmino codec of the application to be set.
+func (k Keeper) validateEncoding(encoding string) error {
+	if encoding == icatypes.EncodingAminoJSON && k.legacyAmino == nil {
+		return errorsmod.Wrapf(icatypes.ErrInvalidCodec, "encoding format %s is not supported by the host chain", encoding)
+	}
+
+	return nil
+}
+
+// txCodec returns the codec used to deserialize transactions serialized with the provided encoding.
+func (k Keeper) txCodec(encoding string) codec.JSONCodec {
+	if encoding == icatypes.EncodingAminoJSON {
+		return codec.NewAminoCodec(k.legacyAmino)
+	}
+
+	return k.cdc
+}
+
 // getConnectionID returns the connection id for the given port and channelIDs.
 func (k Keeper) getConnectionID(ctx sdk.Context, portID, channelID string) (string, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
@@ -138,6 +165,11 @@ func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string
 	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
 }
 
+// GetChannelMetadata returns the ICS27 metadata decoded from the application version of the provided host channel.
+func (k Keeper) GetChannelMetadata(ctx sdk.Context, channelID string) (icatypes.Metadata, error) {
+	return k.getAppMetadata(ctx, icatypes.HostPortID, channelID)
+}
+
 // getAppMetadata retrieves the interchain accounts channel metadata from the store associated with the provided portID and channelID
 func (k Keeper) getAppMetadata(ctx sdk.Context, portID, channelID string) (icatypes.Metadata, error) {
 	appVersion, found := k.GetAppVersion(ctx, portID, channelID)
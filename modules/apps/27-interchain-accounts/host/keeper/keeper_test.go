@@ -43,6 +43,14 @@ var (
 		Encoding:               icatypes.EncodingProto3JSON,
 		TxType:                 icatypes.TxTypeSDKMultiMsg,
 	}))
+	// TestVersionWithAminoJSONEncoding defines a reusable interchainaccounts version string that uses amino JSON encoding for testing purposes
+	TestVersionWithAminoJSONEncoding = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingAminoJSON,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
 )
 
 type KeeperTestSuite struct {
@@ -72,6 +80,8 @@ func NewICAPath(chainA, chainB *ibctesting.TestChain, encoding string) *ibctesti
 		version = TestVersion
 	case icatypes.EncodingProto3JSON:
 		version = TestVersionWithJSONEncoding
+	case icatypes.EncodingAminoJSON:
+		version = TestVersionWithAminoJSONEncoding
 	default:
 		panic(fmt.Errorf("unsupported encoding type: %s", encoding))
 	}
@@ -70,5 +70,8 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	m.SetParams(ctx, msg.Params)
 
+	// channels built on denied connections are closed at the beginning of the next block
+	m.flagDeniedChannels(ctx)
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// SendNotification sends a host notification packet containing the provided data to the controller chain of the
+// interchain account associated with the provided connection and controller port identifiers. Notifications may only
+// be sent if the host submodule has enabled notifications and host notifications were negotiated in the version of the
+// active channel. The packet sequence for the outgoing packet is returned as a result.
+// An appropriate absolute timeoutTimestamp must be provided. If the packet is timed out on an ORDERED channel,
+// the channel will be closed.
+func (k Keeper) SendNotification(ctx sdk.Context, connectionID, controllerPortID string, data []byte, memo string, timeoutTimestamp uint64) (uint64, error) {
+	params := k.GetParams(ctx)
+	if !params.HostEnabled {
+		return 0, types.ErrHostSubModuleDisabled
+	}
+
+	if !params.NotificationsEnabled {
+		return 0, types.ErrNotificationsDisabled
+	}
+
+	if params.IsConnectionDenied(connectionID) {
+		return 0, errorsmod.Wrapf(types.ErrConnectionDenied, "connection %s is denied", connectionID)
+	}
+
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, controllerPortID)
+	if !found {
+		return 0, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, controllerPortID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, icatypes.HostPortID, activeChannelID)
+	if !found || channel.State != channeltypes.OPEN {
+		return 0, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "active channel %s on connection %s for port %s is not open", activeChannelID, connectionID, controllerPortID)
+	}
+
+	metadata, err := k.getAppMetadata(ctx, icatypes.HostPortID, activeChannelID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !metadata.HostNotifications {
+		return 0, errorsmod.Wrapf(types.ErrNotificationsDisabled, "host notifications were not negotiated on channel %s", activeChannelID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(icatypes.HostPortID, activeChannelID))
+	if !found {
+		return 0, errorsmod.Wrapf(capabilitytypes.ErrCapabilityNotFound, "failed to find capability: %s", host.ChannelCapabilityPath(icatypes.HostPortID, activeChannelID))
+	}
+
+	if uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
+		return 0, icatypes.ErrInvalidTimeoutTimestamp
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.HOST_NOTIFICATION,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(err, "invalid host notification packet data")
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, icatypes.HostPortID, activeChannelID, clienttypes.ZeroHeight(), timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	emitHostNotificationSentEvent(ctx, controllerPortID, connectionID, activeChannelID, sequence)
+
+	return sequence, nil
+}
+
+// OnAcknowledgementPacket handles the acknowledgement of a host notification packet sent to a controller chain.
+// Acknowledgements of any other packet type are rejected as the host chain only sends host notifications.
+func (Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	if err := validateHostNotification(packet); err != nil {
+		return errorsmod.Wrap(err, "cannot receive acknowledgement on a host channel end for a packet which is not a host notification")
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 host notification acknowledgement: %v", err)
+	}
+
+	emitHostNotificationAcknowledgedEvent(ctx, packet, ack)
+
+	return nil
+}
+
+// OnTimeoutPacket handles the timeout of a host notification packet sent to a controller chain.
+// Timeouts of any other packet type are rejected as the host chain only sends host notifications.
+func (Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if err := validateHostNotification(packet); err != nil {
+		return errorsmod.Wrap(err, "cannot cause a packet timeout on a host channel end for a packet which is not a host notification")
+	}
+
+	emitHostNotificationTimeoutEvent(ctx, packet)
+
+	return nil
+}
+
+// validateHostNotification returns an error if the data of the provided packet is not a host notification.
+func validateHostNotification(packet channeltypes.Packet) error {
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	if data.Type != icatypes.HOST_NOTIFICATION {
+		return errorsmod.Wrapf(icatypes.ErrInvalidChannelFlow, "expected packet data type %s, got %s", icatypes.HOST_NOTIFICATION, data.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+// setupHostNotificationsPath enables host notifications on the host chain and opens an interchain accounts
+// channel which has negotiated host notifications.
+func (suite *KeeperTestSuite) setupHostNotificationsPath() *ibctesting.Path {
+	params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+	params.NotificationsEnabled = true
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+
+	metadata := icatypes.NewDefaultMetadata(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+	metadata.HostNotifications = true
+	path.EndpointA.ChannelConfig.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	return path
+}
+
+func (suite *KeeperTestSuite) TestSendNotification() {
+	var (
+		path             *ibctesting.Path
+		connectionID     string
+		portID           string
+		timeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"host submodule disabled", func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.HostEnabled = false
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, types.ErrHostSubModuleDisabled,
+		},
+		{
+			"host notifications disabled", func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.NotificationsEnabled = false
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, types.ErrNotificationsDisabled,
+		},
+		{
+			"connection is denied", func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.DeniedConnections = []string{connectionID}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, types.ErrConnectionDenied,
+		},
+		{
+			"active channel not found", func() {
+				portID = TestPortID + "-invalid"
+			}, icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"active channel is not open", func() {
+				path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			}, icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"host notifications not negotiated", func() {
+				path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) {
+					metadata, err := icatypes.MetadataFromVersion(channel.Version)
+					suite.Require().NoError(err)
+
+					metadata.HostNotifications = false
+					channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+				})
+			}, types.ErrNotificationsDisabled,
+		},
+		{
+			"timeout timestamp is not in the future", func() {
+				timeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+			}, icatypes.ErrInvalidTimeoutTimestamp,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = suite.setupHostNotificationsPath()
+
+			connectionID = path.EndpointB.ConnectionID
+			portID = path.EndpointA.ChannelConfig.PortID
+			timeoutTimestamp = ^uint64(0)
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainB.GetSimApp().ICAHostKeeper.SendNotification(suite.chainB.GetContext(), connectionID, portID, []byte("notification"), "", timeoutTimestamp)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+
+				commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainB.GetContext(), icatypes.HostPortID, path.EndpointB.ChannelID, sequence)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Zero(sequence)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRelayHostNotification() {
+	suite.SetupTest() // reset
+
+	path := suite.setupHostNotificationsPath()
+
+	var received []byte
+	suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+		var data icatypes.InterchainAccountPacketData
+		suite.Require().NoError(data.UnmarshalJSON(packet.GetData()))
+
+		received = data.Data
+		return ibcmock.MockAcknowledgement
+	}
+
+	sequence, err := suite.chainB.GetSimApp().ICAHostKeeper.SendNotification(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, []byte("notification"), "", ^uint64(0))
+	suite.Require().NoError(err)
+
+	suite.chainB.NextBlock()
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.HOST_NOTIFICATION,
+		Data: []byte("notification"),
+	}
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// the notification is delivered to the controller chain and acknowledged on the host chain
+	suite.Require().Equal([]byte("notification"), received)
+
+	commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainB.GetContext(), icatypes.HostPortID, path.EndpointB.ChannelID, sequence)
+	suite.Require().Empty(commitment)
+}
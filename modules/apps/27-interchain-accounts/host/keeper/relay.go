@@ -7,6 +7,8 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -17,6 +19,10 @@ import (
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	if err := k.verifyConnectionAllowed(ctx, packet.DestinationPort, packet.DestinationChannel); err != nil {
+		return nil, err
+	}
+
 	var data icatypes.InterchainAccountPacketData
 	err := data.UnmarshalJSON(packet.GetData())
 	if err != nil {
@@ -31,7 +37,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, metadata.Encoding)
+		msgs, err := icatypes.DeserializeCosmosTx(k.txCodec(metadata.Encoding), data.Data, metadata.Encoding)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
@@ -74,6 +80,10 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 			}
 		}
 
+		if err := validateMsg(msg); err != nil {
+			return nil, err
+		}
+
 		protoAny, err := k.executeMsg(cacheCtx, msg)
 		if err != nil {
 			return nil, err
@@ -126,6 +136,21 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 	return nil
 }
 
+// validateMsg performs additional validation of the message types which are handled specially when
+// executed by an interchain account. The message type must already be allowed by the host params.
+// Weighted governance votes have their options validated, allowing interchain accounts holding
+// large stakes on behalf of many users to split their voting power across vote options.
+func validateMsg(msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *govv1.MsgVoteWeighted:
+		return icatypes.ValidateWeightedVoteOptions(msg.Options)
+	case *govv1beta1.MsgVoteWeighted:
+		return icatypes.ValidateLegacyWeightedVoteOptions(msg.Options)
+	default:
+		return nil
+	}
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
 func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error) {
@@ -8,6 +8,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -248,6 +249,100 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			nil,
 		},
+		{
+			"interchain account successfully executes govv1.MsgVoteWeighted",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				// Populate the gov keeper in advance with an active proposal
+				testProposal := &govtypes.TextProposal{
+					Title:       "IBC Gov Proposal",
+					Description: "tokens for all!",
+				}
+
+				proposalMsg, err := govv1.NewLegacyContent(testProposal, interchainAccountAddr)
+				suite.Require().NoError(err)
+
+				proposal, err := govv1.NewProposal([]sdk.Msg{proposalMsg}, govtypes.DefaultStartingProposalID, suite.chainA.GetContext().BlockTime(), suite.chainA.GetContext().BlockTime(), "test proposal", "title", "description", sdk.AccAddress(interchainAccountAddr), false)
+				suite.Require().NoError(err)
+
+				err = suite.chainB.GetSimApp().GovKeeper.SetProposal(suite.chainB.GetContext(), proposal)
+				suite.Require().NoError(err)
+				err = suite.chainB.GetSimApp().GovKeeper.ActivateVotingPeriod(suite.chainB.GetContext(), proposal)
+				suite.Require().NoError(err)
+
+				msg := &govv1.MsgVoteWeighted{
+					ProposalId: govtypes.DefaultStartingProposalID,
+					Voter:      interchainAccountAddr,
+					Options: []*govv1.WeightedVoteOption{
+						govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(6, 1)),
+						govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(4, 1)),
+					},
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"failure: govv1.MsgVoteWeighted with total weight lower than one",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				// Populate the gov keeper in advance with an active proposal
+				testProposal := &govtypes.TextProposal{
+					Title:       "IBC Gov Proposal",
+					Description: "tokens for all!",
+				}
+
+				proposalMsg, err := govv1.NewLegacyContent(testProposal, interchainAccountAddr)
+				suite.Require().NoError(err)
+
+				proposal, err := govv1.NewProposal([]sdk.Msg{proposalMsg}, govtypes.DefaultStartingProposalID, suite.chainA.GetContext().BlockTime(), suite.chainA.GetContext().BlockTime(), "test proposal", "title", "description", sdk.AccAddress(interchainAccountAddr), false)
+				suite.Require().NoError(err)
+
+				err = suite.chainB.GetSimApp().GovKeeper.SetProposal(suite.chainB.GetContext(), proposal)
+				suite.Require().NoError(err)
+				err = suite.chainB.GetSimApp().GovKeeper.ActivateVotingPeriod(suite.chainB.GetContext(), proposal)
+				suite.Require().NoError(err)
+
+				msg := &govv1.MsgVoteWeighted{
+					ProposalId: govtypes.DefaultStartingProposalID,
+					Voter:      interchainAccountAddr,
+					Options: []*govv1.WeightedVoteOption{
+						govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(6, 1)),
+						govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(3, 1)),
+					},
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			icatypes.ErrInvalidWeightedVote,
+		},
 		{
 			"interchain account successfully executes disttypes.MsgFundCommunityPool",
 			func(encoding string) {
@@ -519,6 +614,17 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			ibcerrors.ErrUnauthorized,
 		},
+		{
+			"controller connection is denied",
+			func(encoding string) {
+				packetData = []byte("invalid packet data")
+
+				params := types.DefaultParams()
+				params.DeniedConnections = []string{path.EndpointB.ConnectionID}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrConnectionDenied,
+		},
 	}
 
 	for _, encoding := range testedEncodings {
@@ -920,6 +1026,105 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAminoJSONOnRecvPacket() {
+	var packetData []byte
+
+	testCases := []struct {
+		msg      string
+		malleate func(icaAddress string)
+		expErr   error
+	}{
+		{
+			"interchain account successfully executes banktypes.MsgSend",
+			func(icaAddress string) {
+				msg := &banktypes.MsgSend{
+					FromAddress: icaAddress,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(codec.NewAminoCodec(suite.chainA.GetSimApp().LegacyAmino()), []proto.Message{msg}, icatypes.EncodingAminoJSON)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			nil,
+		},
+		{
+			"messages serialized with protobuf cannot be deserialized with amino json",
+			func(icaAddress string) {
+				msg := &banktypes.MsgSend{
+					FromAddress: icaAddress,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+			},
+			icatypes.ErrUnknownDataType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingAminoJSON)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			icaAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, portID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			tc.malleate(icaAddress) // malleate mutates test data
+
+			packet := channeltypes.NewPacket(
+				packetData,
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
@@ -6,5 +6,8 @@ import (
 
 // ICA Host sentinel errors
 var (
-	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrHostSubModuleDisabled   = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrConnectionDenied        = errorsmod.Register(SubModuleName, 3, "connection is denied by the host submodule")
+	ErrNotificationsDisabled   = errorsmod.Register(SubModuleName, 4, "host notifications are disabled")
+	ErrExecutionResultNotFound = errorsmod.Register(SubModuleName, 5, "execution result not found")
 )
@@ -29,6 +29,16 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// denied_connections defines a list of connection identifiers of the host chain over which controller chains are
+	// not allowed to interact with the host submodule. Packets received over a denied connection are rejected with an
+	// error acknowledgement, and the active channels built on it are closed.
+	DeniedConnections []string `protobuf:"bytes,3,rep,name=denied_connections,json=deniedConnections,proto3" json:"denied_connections,omitempty"`
+	// notifications_enabled allows modules on the host chain to send notification packets to controller chains over
+	// interchain account channels which have negotiated host notifications.
+	NotificationsEnabled bool `protobuf:"varint,4,opt,name=notifications_enabled,json=notificationsEnabled,proto3" json:"notifications_enabled,omitempty"`
+	// max_execution_results defines the number of most recent execution results persisted for each host channel. The
+	// oldest results of a channel are pruned once the limit is exceeded. Execution results are not persisted if zero.
+	MaxExecutionResults uint32 `protobuf:"varint,5,opt,name=max_execution_results,json=maxExecutionResults,proto3" json:"max_execution_results,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,6 +88,127 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetDeniedConnections() []string {
+	if m != nil {
+		return m.DeniedConnections
+	}
+	return nil
+}
+
+func (m *Params) GetNotificationsEnabled() bool {
+	if m != nil {
+		return m.NotificationsEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxExecutionResults() uint32 {
+	if m != nil {
+		return m.MaxExecutionResults
+	}
+	return 0
+}
+
+// ExecutionResult defines the result of the execution of an interchain account packet received on a host channel.
+type ExecutionResult struct {
+	// host channel identifier on which the packet was received
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the received packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// type URLs of the messages contained in the packet data
+	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// gas consumed by the execution of the packet
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// success is true if the packet was executed successfully
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// error of the acknowledgement written for the packet if the execution failed
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// block height at which the packet was executed
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
+func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResult) ProtoMessage()    {}
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResult.Merge(m, src)
+}
+func (m *ExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionResult proto.InternalMessageInfo
+
+func (m *ExecutionResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *ExecutionResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // QueryRequest defines the parameters for a particular query request
 // by an interchain account.
 type QueryRequest struct {
@@ -93,7 +224,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48e202774f13d08e, []int{1}
+	return fileDescriptor_48e202774f13d08e, []int{2}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,6 +269,7 @@ func (m *QueryRequest) GetData() []byte {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*ExecutionResult)(nil), "ibc.applications.interchain_accounts.host.v1.ExecutionResult")
 	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
 }
 
@@ -146,25 +278,38 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x31, 0x4b, 0xfc, 0x30,
-	0x18, 0x06, 0xf0, 0xcb, 0xfd, 0xff, 0x1c, 0x5e, 0x3c, 0x1d, 0x3a, 0xdd, 0x14, 0xce, 0x03, 0xa1,
-	0x83, 0x6d, 0x38, 0x05, 0xcf, 0x59, 0x70, 0x11, 0x04, 0xcd, 0xe8, 0x52, 0xde, 0xa4, 0xa1, 0x0d,
-	0xb4, 0x4d, 0xed, 0x9b, 0x56, 0xee, 0x5b, 0xf8, 0xb1, 0x1c, 0x6f, 0x74, 0x94, 0xf6, 0x8b, 0x48,
-	0xa3, 0xa0, 0x82, 0x53, 0x1e, 0x7e, 0xf0, 0x04, 0xde, 0x87, 0x6e, 0x8d, 0x54, 0x1c, 0xea, 0xba,
-	0x30, 0x0a, 0x9c, 0xb1, 0x15, 0x72, 0x53, 0x39, 0xdd, 0xa8, 0x1c, 0x4c, 0x95, 0x80, 0x52, 0xb6,
-	0xad, 0x1c, 0xf2, 0xdc, 0xa2, 0xe3, 0xdd, 0xc6, 0xbf, 0x71, 0xdd, 0x58, 0x67, 0x83, 0x33, 0x23,
-	0x55, 0xfc, 0xb3, 0x18, 0xff, 0x51, 0x8c, 0x7d, 0xa1, 0xdb, 0xac, 0x05, 0x9d, 0xdd, 0x43, 0x03,
-	0x25, 0x06, 0x27, 0x74, 0x31, 0x62, 0xa2, 0x2b, 0x90, 0x85, 0x4e, 0x97, 0x64, 0x45, 0xc2, 0x03,
-	0x71, 0x38, 0xda, 0xcd, 0x27, 0x05, 0xa7, 0xf4, 0x18, 0x8a, 0xc2, 0x3e, 0x27, 0xa5, 0x46, 0x84,
-	0x4c, 0xe3, 0x72, 0xba, 0xfa, 0x17, 0xce, 0xc5, 0x91, 0xd7, 0xbb, 0x2f, 0x5c, 0x5f, 0xd2, 0xc5,
-	0x43, 0xab, 0x9b, 0x9d, 0xd0, 0x4f, 0xad, 0x46, 0x17, 0x04, 0xf4, 0x7f, 0x0d, 0x2e, 0xf7, 0x3f,
-	0xce, 0x85, 0xcf, 0xa3, 0xa5, 0xe0, 0x60, 0x39, 0x5d, 0x91, 0x70, 0x21, 0x7c, 0xbe, 0x4e, 0x5f,
-	0x7b, 0x46, 0xf6, 0x3d, 0x23, 0xef, 0x3d, 0x23, 0x2f, 0x03, 0x9b, 0xec, 0x07, 0x36, 0x79, 0x1b,
-	0xd8, 0xe4, 0xf1, 0x36, 0x33, 0x2e, 0x6f, 0x65, 0xac, 0x6c, 0xc9, 0x95, 0xc5, 0xd2, 0x22, 0x37,
-	0x52, 0x45, 0x99, 0xe5, 0xdd, 0x15, 0x2f, 0x6d, 0xda, 0x16, 0x1a, 0xc7, 0xb1, 0x90, 0x9f, 0x6f,
-	0xa3, 0xef, 0x73, 0xa3, 0xdf, 0x3b, 0xb9, 0x5d, 0xad, 0x51, 0xce, 0xfc, 0x4c, 0x17, 0x1f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x56, 0xc6, 0xd2, 0x5a, 0x61, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x9b, 0x6d, 0xb7, 0x7f, 0xa6, 0xad, 0xe2, 0xb8, 0x0b, 0x51, 0x30, 0xd4, 0x82, 0xd0,
+	0x83, 0x6d, 0xd8, 0x5d, 0x70, 0x3d, 0x2b, 0x0b, 0x2a, 0x08, 0x3a, 0xb8, 0x17, 0x2f, 0xc3, 0x64,
+	0xf2, 0x9a, 0x0c, 0x26, 0x33, 0x31, 0xef, 0xa4, 0xb6, 0xdf, 0xc2, 0x8f, 0xe5, 0x71, 0x8f, 0x9e,
+	0x44, 0xda, 0x9b, 0x9f, 0x42, 0x32, 0x69, 0x77, 0x5d, 0xf1, 0x94, 0xf7, 0xfd, 0x3d, 0x79, 0xe1,
+	0x79, 0x92, 0x87, 0x9c, 0xab, 0x48, 0x86, 0xa2, 0x28, 0x32, 0x25, 0x85, 0x55, 0x46, 0x63, 0xa8,
+	0xb4, 0x85, 0x52, 0xa6, 0x42, 0x69, 0x2e, 0xa4, 0x34, 0x95, 0xb6, 0x18, 0xa6, 0x06, 0x6d, 0xb8,
+	0x3c, 0x71, 0xcf, 0x45, 0x51, 0x1a, 0x6b, 0xe8, 0x53, 0x15, 0xc9, 0xc5, 0xdf, 0x87, 0x8b, 0xff,
+	0x1c, 0x2e, 0xdc, 0xc1, 0xf2, 0x64, 0xfa, 0xdb, 0x23, 0xdd, 0x77, 0xa2, 0x14, 0x39, 0xd2, 0xc7,
+	0x64, 0x54, 0x53, 0x0e, 0x5a, 0x44, 0x19, 0xc4, 0xbe, 0x37, 0xf1, 0x66, 0x7d, 0x36, 0xac, 0xd9,
+	0x45, 0x83, 0xe8, 0x13, 0x72, 0x47, 0x64, 0x99, 0xf9, 0xca, 0x73, 0x40, 0x14, 0x09, 0xa0, 0x7f,
+	0x30, 0x69, 0xcf, 0x06, 0x6c, 0xec, 0xe8, 0xdb, 0x1d, 0xa4, 0x73, 0x42, 0x63, 0xd0, 0x0a, 0x62,
+	0x2e, 0x8d, 0xd6, 0x20, 0x9d, 0x0d, 0xbf, 0xed, 0x5e, 0xbd, 0xd7, 0x28, 0x2f, 0x6f, 0x04, 0x7a,
+	0x46, 0x8e, 0xb5, 0xb1, 0xea, 0xd3, 0xde, 0xf0, 0xb5, 0x83, 0x8e, 0x73, 0x70, 0x74, 0x4b, 0xdc,
+	0x5b, 0x39, 0x25, 0xc7, 0xb9, 0x58, 0x71, 0x58, 0x81, 0xac, 0x6a, 0x81, 0x97, 0x80, 0x55, 0x66,
+	0xd1, 0x3f, 0x9c, 0x78, 0xb3, 0x31, 0xbb, 0x9f, 0x8b, 0xd5, 0xc5, 0x5e, 0x63, 0x8d, 0x34, 0xfd,
+	0xe9, 0x91, 0xbb, 0xff, 0x40, 0xfa, 0x88, 0x10, 0x99, 0x0a, 0xad, 0x21, 0xe3, 0xaa, 0xc9, 0x3c,
+	0x60, 0x83, 0x1d, 0x79, 0x1d, 0xd3, 0x87, 0xa4, 0x8f, 0xf0, 0xa5, 0x02, 0x2d, 0xc1, 0x3f, 0x98,
+	0x78, 0xb3, 0x0e, 0xbb, 0xde, 0xe9, 0x94, 0x8c, 0x73, 0x4c, 0xb8, 0x5d, 0x17, 0xc0, 0xab, 0x32,
+	0xdb, 0x27, 0x1c, 0xe6, 0x98, 0x7c, 0x58, 0x17, 0x70, 0x59, 0x66, 0x48, 0x1f, 0x90, 0x7e, 0x22,
+	0x90, 0x57, 0xb8, 0x8b, 0xd3, 0x61, 0xbd, 0x44, 0xe0, 0x25, 0x42, 0x4c, 0x7d, 0xd2, 0xc3, 0x4a,
+	0x4a, 0xc0, 0xc6, 0x73, 0x9f, 0xed, 0x57, 0x7a, 0x44, 0x0e, 0xa1, 0x2c, 0x4d, 0xe9, 0x77, 0x9d,
+	0x9d, 0x66, 0xa9, 0xff, 0x4f, 0x94, 0x19, 0xf9, 0x99, 0xa7, 0xa0, 0x92, 0xd4, 0xfa, 0xbd, 0x89,
+	0x37, 0x6b, 0xb3, 0xa1, 0x63, 0xaf, 0x1c, 0x9a, 0x3e, 0x23, 0xa3, 0xf7, 0x15, 0x94, 0x6b, 0x56,
+	0x5b, 0x44, 0x4b, 0x29, 0xe9, 0x14, 0xc2, 0xa6, 0xbb, 0x58, 0x6e, 0xae, 0x59, 0x2c, 0xac, 0x70,
+	0x69, 0x46, 0xcc, 0xcd, 0x2f, 0xe2, 0xef, 0x9b, 0xc0, 0xbb, 0xda, 0x04, 0xde, 0xaf, 0x4d, 0xe0,
+	0x7d, 0xdb, 0x06, 0xad, 0xab, 0x6d, 0xd0, 0xfa, 0xb1, 0x0d, 0x5a, 0x1f, 0xdf, 0x24, 0xca, 0xa6,
+	0x55, 0xb4, 0x90, 0x26, 0x0f, 0xa5, 0xc1, 0xdc, 0x60, 0xa8, 0x22, 0x39, 0x4f, 0x4c, 0xb8, 0x7c,
+	0x1e, 0xe6, 0x26, 0xae, 0x32, 0xc0, 0xba, 0xa6, 0x18, 0x9e, 0x9e, 0xcf, 0x6f, 0x8a, 0x36, 0xbf,
+	0xdd, 0xd0, 0xfa, 0x13, 0x61, 0xd4, 0x75, 0x05, 0x3d, 0xfb, 0x33, 0x00, 0x3a, 0xd0, 0x08, 0x2f,
+	0xdb, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +332,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExecutionResults != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxExecutionResults))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NotificationsEnabled {
+		i--
+		if m.NotificationsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DeniedConnections) > 0 {
+		for iNdEx := len(m.DeniedConnections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedConnections[iNdEx])
+			copy(dAtA[i:], m.DeniedConnections[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.DeniedConnections[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -209,6 +378,77 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -272,6 +512,53 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.DeniedConnections) > 0 {
+		for _, s := range m.DeniedConnections {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.NotificationsEnabled {
+		n += 2
+	}
+	if m.MaxExecutionResults != 0 {
+		n += 1 + sovHost(uint64(m.MaxExecutionResults))
+	}
+	return n
+}
+
+func (m *ExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovHost(uint64(m.Sequence))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovHost(uint64(m.GasUsed))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovHost(uint64(m.BlockHeight))
+	}
 	return n
 }
 
@@ -379,6 +666,300 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedConnections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedConnections = append(m.DeniedConnections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotificationsEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionResults", wireType)
+			}
+			m.MaxExecutionResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionResults |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +20,31 @@ const (
 	AllowAllHostMsgs = "*"
 )
 
+var (
+	// PendingChannelClosureKeyPrefix defines the key prefix used to store channels flagged for closure
+	PendingChannelClosureKeyPrefix = "pendingChannelClosure"
+
+	// ExecutionResultKeyPrefix defines the key prefix used to store the execution results of received packets
+	ExecutionResultKeyPrefix = "executionResult"
+)
+
+// KeyPendingChannelClosure creates and returns a new key used for pending channel closure store operations
+func KeyPendingChannelClosure(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PendingChannelClosureKeyPrefix, portID, channelID))
+}
+
+// KeyExecutionResultPrefix creates and returns the key prefix used to store the execution results of the packets
+// received on the provided host channel
+func KeyExecutionResultPrefix(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", ExecutionResultKeyPrefix, channelID))
+}
+
+// KeyExecutionResult creates and returns a new key used for execution result store operations. The sequence is
+// encoded in big endian so that the results of a channel are iterated in ascending sequence order.
+func KeyExecutionResult(channelID string, sequence uint64) []byte {
+	return append(KeyExecutionResultPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 const (
@@ -11,6 +13,10 @@ const (
 	DefaultHostEnabled = true
 	// Maximum length of the allowlist
 	MaxAllowListLength = 500
+	// Maximum length of the connection denylist
+	MaxDenyListLength = 500
+	// Maximum number of execution results persisted for each host channel
+	MaxExecutionResultsLimit = 100
 )
 
 // NewParams creates a new parameter configuration for the host submodule
@@ -28,7 +34,24 @@ func DefaultParams() Params {
 
 // Validate validates all host submodule parameters
 func (p Params) Validate() error {
-	return validateAllowlist(p.AllowMessages)
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	if err := validateDenylist(p.DeniedConnections); err != nil {
+		return err
+	}
+
+	if p.MaxExecutionResults > MaxExecutionResultsLimit {
+		return fmt.Errorf("max execution results must not exceed %d, got %d", MaxExecutionResultsLimit, p.MaxExecutionResults)
+	}
+
+	return nil
+}
+
+// IsConnectionDenied returns true if the provided connection identifier is present in the connection denylist.
+func (p Params) IsConnectionDenied(connectionID string) bool {
+	return slices.Contains(p.DeniedConnections, connectionID)
 }
 
 func validateAllowlist(allowMsgs []string) error {
@@ -48,3 +71,23 @@ func validateAllowlist(allowMsgs []string) error {
 
 	return nil
 }
+
+func validateDenylist(connectionIDs []string) error {
+	if len(connectionIDs) > MaxDenyListLength {
+		return fmt.Errorf("connection deny list length must not exceed %d items", MaxDenyListLength)
+	}
+
+	seen := make(map[string]struct{}, len(connectionIDs))
+	for _, connectionID := range connectionIDs {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return fmt.Errorf("invalid connection identifier in deny list: %w", err)
+		}
+
+		if _, ok := seen[connectionID]; ok {
+			return fmt.Errorf("duplicate connection identifier in deny list: %s", connectionID)
+		}
+		seen[connectionID] = struct{}{}
+	}
+
+	return nil
+}
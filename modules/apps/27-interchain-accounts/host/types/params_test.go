@@ -15,4 +15,33 @@ func TestValidateParams(t *testing.T) {
 	require.Error(t, types.NewParams(true, []string{" "}).Validate())
 	require.Error(t, types.NewParams(true, []string{"*", "/cosmos.bank.v1beta1.MsgSend"}).Validate())
 	require.Error(t, types.NewParams(true, make([]string, types.MaxAllowListLength+1)).Validate())
+
+	params := types.DefaultParams()
+	params.DeniedConnections = []string{"connection-0", "connection-1"}
+	require.NoError(t, params.Validate())
+
+	params.DeniedConnections = []string{"connection-0", "connection-0"}
+	require.Error(t, params.Validate())
+
+	params.DeniedConnections = []string{""}
+	require.Error(t, params.Validate())
+
+	params.DeniedConnections = make([]string, types.MaxDenyListLength+1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxExecutionResults = types.MaxExecutionResultsLimit
+	require.NoError(t, params.Validate())
+
+	params.MaxExecutionResults = types.MaxExecutionResultsLimit + 1
+	require.Error(t, params.Validate())
+}
+
+func TestIsConnectionDenied(t *testing.T) {
+	params := types.DefaultParams()
+	require.False(t, params.IsConnectionDenied("connection-0"))
+
+	params.DeniedConnections = []string{"connection-0"}
+	require.True(t, params.IsConnectionDenied("connection-0"))
+	require.False(t, params.IsConnectionDenied("connection-1"))
 }
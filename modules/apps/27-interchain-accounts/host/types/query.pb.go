@@ -6,8 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -111,9 +113,447 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryChannelMetadataRequest is the request type for the Query/ChannelMetadata RPC method.
+type QueryChannelMetadataRequest struct {
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelMetadataRequest) Reset()         { *m = QueryChannelMetadataRequest{} }
+func (m *QueryChannelMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelMetadataRequest) ProtoMessage()    {}
+func (*QueryChannelMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryChannelMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelMetadataRequest.Merge(m, src)
+}
+func (m *QueryChannelMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryChannelMetadataRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryChannelMetadataResponse is the response type for the Query/ChannelMetadata RPC method.
+type QueryChannelMetadataResponse struct {
+	// metadata defines the ICS27 metadata decoded from the channel version.
+	Metadata types.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *QueryChannelMetadataResponse) Reset()         { *m = QueryChannelMetadataResponse{} }
+func (m *QueryChannelMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelMetadataResponse) ProtoMessage()    {}
+func (*QueryChannelMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryChannelMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelMetadataResponse.Merge(m, src)
+}
+func (m *QueryChannelMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryChannelMetadataResponse) GetMetadata() types.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types.Metadata{}
+}
+
+// QueryExpectedInterchainAccountAddressRequest is the request type for the Query/ExpectedInterchainAccountAddress RPC method.
+type QueryExpectedInterchainAccountAddressRequest struct {
+	// connection identifier on the host chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// owner of the interchain account on the controller chain
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryExpectedInterchainAccountAddressRequest) Reset() {
+	*m = QueryExpectedInterchainAccountAddressRequest{}
+}
+func (m *QueryExpectedInterchainAccountAddressRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryExpectedInterchainAccountAddressRequest) ProtoMessage() {}
+func (*QueryExpectedInterchainAccountAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *QueryExpectedInterchainAccountAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpectedInterchainAccountAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpectedInterchainAccountAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpectedInterchainAccountAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpectedInterchainAccountAddressRequest.Merge(m, src)
+}
+func (m *QueryExpectedInterchainAccountAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpectedInterchainAccountAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpectedInterchainAccountAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpectedInterchainAccountAddressRequest proto.InternalMessageInfo
+
+func (m *QueryExpectedInterchainAccountAddressRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryExpectedInterchainAccountAddressRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryExpectedInterchainAccountAddressResponse is the response type for the Query/ExpectedInterchainAccountAddress RPC method.
+type QueryExpectedInterchainAccountAddressResponse struct {
+	// address of the interchain account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// registered is true if the interchain account has already been registered
+	Registered bool `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (m *QueryExpectedInterchainAccountAddressResponse) Reset() {
+	*m = QueryExpectedInterchainAccountAddressResponse{}
+}
+func (m *QueryExpectedInterchainAccountAddressResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryExpectedInterchainAccountAddressResponse) ProtoMessage() {}
+func (*QueryExpectedInterchainAccountAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryExpectedInterchainAccountAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpectedInterchainAccountAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpectedInterchainAccountAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpectedInterchainAccountAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpectedInterchainAccountAddressResponse.Merge(m, src)
+}
+func (m *QueryExpectedInterchainAccountAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpectedInterchainAccountAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpectedInterchainAccountAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpectedInterchainAccountAddressResponse proto.InternalMessageInfo
+
+func (m *QueryExpectedInterchainAccountAddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryExpectedInterchainAccountAddressResponse) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
+// QueryExecutionResultRequest is the request type for the Query/ExecutionResult RPC method.
+type QueryExecutionResultRequest struct {
+	// host channel unique identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the received packet
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryExecutionResultRequest) Reset()         { *m = QueryExecutionResultRequest{} }
+func (m *QueryExecutionResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionResultRequest) ProtoMessage()    {}
+func (*QueryExecutionResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{6}
+}
+func (m *QueryExecutionResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionResultRequest.Merge(m, src)
+}
+func (m *QueryExecutionResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionResultRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionResultRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryExecutionResultRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryExecutionResultResponse is the response type for the Query/ExecutionResult RPC method.
+type QueryExecutionResultResponse struct {
+	// result of the execution of the packet
+	Result ExecutionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+}
+
+func (m *QueryExecutionResultResponse) Reset()         { *m = QueryExecutionResultResponse{} }
+func (m *QueryExecutionResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionResultResponse) ProtoMessage()    {}
+func (*QueryExecutionResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{7}
+}
+func (m *QueryExecutionResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionResultResponse.Merge(m, src)
+}
+func (m *QueryExecutionResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionResultResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionResultResponse) GetResult() ExecutionResult {
+	if m != nil {
+		return m.Result
+	}
+	return ExecutionResult{}
+}
+
+// QueryDecodePacketDataRequest is the request type for the Query/DecodePacketData RPC method.
+type QueryDecodePacketDataRequest struct {
+	// packet data of an interchain accounts packet
+	PacketData []byte `protobuf:"bytes,1,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
+	// encoding of the transaction contained in the packet data, defaults to proto3 if empty
+	Encoding string `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+}
+
+func (m *QueryDecodePacketDataRequest) Reset()         { *m = QueryDecodePacketDataRequest{} }
+func (m *QueryDecodePacketDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodePacketDataRequest) ProtoMessage()    {}
+func (*QueryDecodePacketDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{8}
+}
+func (m *QueryDecodePacketDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodePacketDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodePacketDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecodePacketDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodePacketDataRequest.Merge(m, src)
+}
+func (m *QueryDecodePacketDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodePacketDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodePacketDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecodePacketDataRequest proto.InternalMessageInfo
+
+func (m *QueryDecodePacketDataRequest) GetPacketData() []byte {
+	if m != nil {
+		return m.PacketData
+	}
+	return nil
+}
+
+func (m *QueryDecodePacketDataRequest) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+// QueryDecodePacketDataResponse is the response type for the Query/DecodePacketData RPC method.
+type QueryDecodePacketDataResponse struct {
+	// type of the packet data
+	Type types.Type `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
+	// memo of the packet data
+	Memo string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	// messages contained in the packet data, each encoded as proto3 JSON
+	Messages []string `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *QueryDecodePacketDataResponse) Reset()         { *m = QueryDecodePacketDataResponse{} }
+func (m *QueryDecodePacketDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodePacketDataResponse) ProtoMessage()    {}
+func (*QueryDecodePacketDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{9}
+}
+func (m *QueryDecodePacketDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodePacketDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodePacketDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecodePacketDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodePacketDataResponse.Merge(m, src)
+}
+func (m *QueryDecodePacketDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodePacketDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodePacketDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecodePacketDataResponse proto.InternalMessageInfo
+
+func (m *QueryDecodePacketDataResponse) GetType() types.Type {
+	if m != nil {
+		return m.Type
+	}
+	return types.UNSPECIFIED
+}
+
+func (m *QueryDecodePacketDataResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *QueryDecodePacketDataResponse) GetMessages() []string {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryChannelMetadataRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryChannelMetadataRequest")
+	proto.RegisterType((*QueryChannelMetadataResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryChannelMetadataResponse")
+	proto.RegisterType((*QueryExpectedInterchainAccountAddressRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryExpectedInterchainAccountAddressRequest")
+	proto.RegisterType((*QueryExpectedInterchainAccountAddressResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryExpectedInterchainAccountAddressResponse")
+	proto.RegisterType((*QueryExecutionResultRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryExecutionResultRequest")
+	proto.RegisterType((*QueryExecutionResultResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryExecutionResultResponse")
+	proto.RegisterType((*QueryDecodePacketDataRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryDecodePacketDataRequest")
+	proto.RegisterType((*QueryDecodePacketDataResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryDecodePacketDataResponse")
 }
 
 func init() {
@@ -121,27 +561,59 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x33, 0x31,
-	0x18, 0xc7, 0x9b, 0x17, 0xde, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xa1,
-	0xb5, 0xd0, 0x8e, 0xea, 0x28, 0x0e, 0xea, 0xe8, 0x22, 0xb9, 0x34, 0x5c, 0x03, 0xbd, 0x3c, 0xe9,
-	0x25, 0x77, 0xd0, 0xd5, 0x4f, 0x20, 0xf8, 0x91, 0x5c, 0x5c, 0x84, 0x82, 0x8b, 0xa3, 0xdc, 0xf9,
-	0x41, 0xe4, 0x72, 0x07, 0x5a, 0x14, 0xe1, 0x70, 0x7d, 0xc2, 0xef, 0xf7, 0x7f, 0xfe, 0x4f, 0xf0,
-	0x4c, 0x47, 0x92, 0x0b, 0x6b, 0x97, 0x5a, 0x0a, 0xaf, 0xc1, 0x38, 0xae, 0x8d, 0x57, 0xa9, 0x5c,
-	0x08, 0x6d, 0x6e, 0x85, 0x94, 0x90, 0x19, 0xef, 0xf8, 0x02, 0x9c, 0xe7, 0xf9, 0x88, 0xaf, 0x32,
-	0x95, 0xae, 0x99, 0x4d, 0xc1, 0x03, 0x19, 0xe8, 0x48, 0xb2, 0xaf, 0x24, 0xfb, 0x81, 0x64, 0x15,
-	0xc9, 0xf2, 0x51, 0xef, 0x20, 0x06, 0x88, 0x97, 0x8a, 0x0b, 0xab, 0xb9, 0x30, 0x06, 0x7c, 0xc3,
-	0x04, 0x57, 0x6f, 0xda, 0x6a, 0x8b, 0xe0, 0x0c, 0x60, 0x7f, 0x0f, 0x93, 0xab, 0x6a, 0xa7, 0x4b,
-	0x91, 0x8a, 0xc4, 0x5d, 0xab, 0x55, 0xa6, 0x9c, 0xef, 0x4b, 0xbc, 0xbb, 0x35, 0x75, 0x16, 0x8c,
-	0x53, 0xe4, 0x02, 0x77, 0x6d, 0x98, 0xec, 0xa3, 0x43, 0x74, 0xb4, 0x33, 0x9e, 0xb0, 0x36, 0x15,
-	0x58, 0x63, 0x6b, 0x1c, 0xe3, 0x67, 0x84, 0xff, 0x87, 0x14, 0xf2, 0x88, 0x70, 0xb7, 0x7e, 0x24,
-	0x27, 0xed, 0x94, 0xdf, 0x77, 0xef, 0x9d, 0xfe, 0xc1, 0x50, 0xf7, 0xec, 0x4f, 0xee, 0x5e, 0xde,
-	0x1f, 0xfe, 0x31, 0x32, 0xe0, 0xcd, 0x59, 0x7f, 0x3f, 0x67, 0xdd, 0xe7, 0x6c, 0xfe, 0x54, 0x50,
-	0xb4, 0x29, 0x28, 0x7a, 0x2b, 0x28, 0xba, 0x2f, 0x69, 0x67, 0x53, 0xd2, 0xce, 0x6b, 0x49, 0x3b,
-	0x37, 0xe7, 0xb1, 0xf6, 0x8b, 0x2c, 0x62, 0x12, 0x12, 0x2e, 0xc1, 0x25, 0xe0, 0x2a, 0xf1, 0x30,
-	0x06, 0x9e, 0xcf, 0x78, 0x02, 0xf3, 0x6c, 0xa9, 0x5c, 0x1d, 0x33, 0x9e, 0x0e, 0x3f, 0x93, 0x86,
-	0xdb, 0x49, 0x7e, 0x6d, 0x95, 0x8b, 0xba, 0xe1, 0xdf, 0x8e, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xbc, 0x5f, 0x87, 0x7c, 0x78, 0x02, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x4f, 0xdb, 0x48,
+	0x18, 0x8d, 0x43, 0xc8, 0xc2, 0xc0, 0x2e, 0xab, 0x59, 0x0e, 0x91, 0x17, 0x02, 0xf2, 0x5e, 0xf6,
+	0x40, 0x3c, 0x4a, 0x16, 0x2d, 0x68, 0xb5, 0x2b, 0x11, 0x16, 0x56, 0x0a, 0xcb, 0x4a, 0xac, 0xdb,
+	0x03, 0x82, 0x43, 0x3a, 0x19, 0x8f, 0x1c, 0xab, 0xb1, 0xc7, 0x78, 0x9c, 0x14, 0x14, 0xe5, 0xd2,
+	0x63, 0xd5, 0x43, 0xd5, 0xfe, 0xa4, 0x4a, 0x15, 0x47, 0xa4, 0x5e, 0x7a, 0xaa, 0x2a, 0xe8, 0xb5,
+	0xd7, 0x9e, 0x2b, 0x8f, 0xc7, 0x4e, 0x48, 0x43, 0xeb, 0x04, 0x7a, 0x8a, 0x67, 0xec, 0xef, 0xbd,
+	0xf7, 0xbd, 0xf9, 0xe6, 0x29, 0x60, 0xd3, 0x6e, 0x10, 0x84, 0x3d, 0xaf, 0x65, 0x13, 0x1c, 0xd8,
+	0xcc, 0xe5, 0xc8, 0x76, 0x03, 0xea, 0x93, 0x26, 0xb6, 0xdd, 0x3a, 0x26, 0x84, 0xb5, 0xdd, 0x80,
+	0xa3, 0x26, 0xe3, 0x01, 0xea, 0x94, 0xd1, 0x49, 0x9b, 0xfa, 0x67, 0xba, 0xe7, 0xb3, 0x80, 0xc1,
+	0x35, 0xbb, 0x41, 0xf4, 0xc1, 0x4a, 0x7d, 0x44, 0xa5, 0x1e, 0x56, 0xea, 0x9d, 0xb2, 0xba, 0x68,
+	0x31, 0x8b, 0x89, 0x42, 0x14, 0x3e, 0x45, 0x18, 0xea, 0x92, 0xc5, 0x98, 0xd5, 0xa2, 0x08, 0x7b,
+	0x36, 0xc2, 0xae, 0xcb, 0x02, 0x89, 0x14, 0xbd, 0xdd, 0x18, 0x4b, 0x9b, 0x60, 0x8a, 0x0a, 0x7f,
+	0x4f, 0x55, 0xd8, 0x29, 0x23, 0x87, 0x06, 0xd8, 0xc4, 0x01, 0x96, 0x75, 0xeb, 0x69, 0xeb, 0x3c,
+	0x4c, 0x1e, 0x52, 0xc9, 0xa6, 0x2d, 0x02, 0xf8, 0x7f, 0xe8, 0xcb, 0x01, 0xf6, 0xb1, 0xc3, 0x0d,
+	0x7a, 0xd2, 0xa6, 0x3c, 0xd0, 0x08, 0xf8, 0xe9, 0xda, 0x2e, 0xf7, 0x98, 0xcb, 0x29, 0xdc, 0x07,
+	0x79, 0x4f, 0xec, 0x14, 0x94, 0x55, 0xe5, 0xd7, 0xb9, 0xca, 0xba, 0x3e, 0x8e, 0x8d, 0xba, 0x44,
+	0x93, 0x18, 0xda, 0x9f, 0xe0, 0x67, 0x41, 0xf2, 0x77, 0x13, 0xbb, 0x2e, 0x6d, 0xfd, 0x27, 0xdb,
+	0x91, 0x1a, 0xe0, 0x32, 0x00, 0x24, 0x7a, 0x53, 0xb7, 0x4d, 0x41, 0x38, 0x6b, 0xcc, 0xca, 0x9d,
+	0x9a, 0xa9, 0x71, 0xb0, 0x34, 0xba, 0x5a, 0x6a, 0xbd, 0x07, 0x66, 0x62, 0x83, 0xa4, 0xda, 0x72,
+	0x3a, 0xb5, 0x9d, 0xb2, 0x1e, 0x83, 0x6d, 0xe7, 0xce, 0xdf, 0xae, 0x64, 0x8c, 0x04, 0x48, 0xb3,
+	0xc1, 0x9a, 0x20, 0xdd, 0x3d, 0xf5, 0x28, 0x09, 0xa8, 0x59, 0x4b, 0xea, 0xab, 0x51, 0x79, 0xd5,
+	0x34, 0x7d, 0xca, 0x63, 0x1f, 0xe1, 0x2f, 0xe0, 0x7b, 0xc2, 0x5c, 0x97, 0x92, 0x90, 0xae, 0xdf,
+	0xc6, 0x7c, 0x7f, 0xb3, 0x66, 0xc2, 0x45, 0x30, 0xcd, 0x1e, 0xb9, 0xd4, 0x2f, 0x64, 0xc5, 0xcb,
+	0x68, 0xa1, 0xd9, 0xa0, 0x94, 0x92, 0x4a, 0x36, 0x5c, 0x00, 0xdf, 0xe1, 0x68, 0x4b, 0xb2, 0xc4,
+	0x4b, 0x58, 0x04, 0xc0, 0xa7, 0x96, 0xcd, 0x03, 0xea, 0x53, 0x53, 0xb0, 0xcc, 0x18, 0x03, 0x3b,
+	0xda, 0xa1, 0x3c, 0x88, 0xdd, 0x53, 0x4a, 0xda, 0xa1, 0x28, 0x83, 0xf2, 0x76, 0x2b, 0x48, 0x77,
+	0x10, 0x50, 0x05, 0x33, 0x3c, 0xfc, 0xd2, 0x25, 0x54, 0x60, 0xe7, 0x8c, 0x64, 0xad, 0x75, 0xc1,
+	0xd2, 0x68, 0x64, 0xa9, 0xf9, 0x18, 0xe4, 0x7d, 0xb1, 0x23, 0x8f, 0xe8, 0xaf, 0xf1, 0x06, 0x6a,
+	0x08, 0x56, 0x1e, 0x97, 0x84, 0xd4, 0x8e, 0x25, 0xf9, 0x0e, 0x25, 0xcc, 0xa4, 0x07, 0x62, 0xea,
+	0x77, 0x06, 0x06, 0x6c, 0x05, 0xcc, 0x45, 0x57, 0xa1, 0x9e, 0x0c, 0xc9, 0xbc, 0x01, 0xbc, 0xe4,
+	0xbb, 0xb0, 0x33, 0xea, 0x12, 0x66, 0xda, 0xae, 0x25, 0xcf, 0x26, 0x59, 0x6b, 0xcf, 0x15, 0xb0,
+	0x7c, 0x03, 0xba, 0xec, 0xad, 0x0a, 0x72, 0xc1, 0x99, 0x47, 0x05, 0xee, 0x0f, 0x95, 0x52, 0xea,
+	0xe1, 0xbb, 0x7f, 0xe6, 0x51, 0x43, 0x94, 0x42, 0x08, 0x72, 0x0e, 0x75, 0x98, 0x24, 0x17, 0xcf,
+	0xa1, 0x28, 0x87, 0x72, 0x8e, 0x2d, 0xca, 0x0b, 0x53, 0xab, 0x53, 0xa1, 0xa8, 0x78, 0x5d, 0x79,
+	0x0a, 0xc0, 0xb4, 0x10, 0x05, 0x5f, 0x2a, 0x20, 0x1f, 0x5d, 0x37, 0xb8, 0x35, 0x9e, 0xa7, 0x9f,
+	0xa7, 0x81, 0x5a, 0xbd, 0x05, 0x42, 0x64, 0x86, 0xb6, 0xfe, 0xf8, 0xf5, 0xfb, 0x17, 0x59, 0x1d,
+	0xae, 0x21, 0x99, 0x52, 0x5f, 0x8e, 0xc3, 0x28, 0x21, 0xe0, 0x47, 0x05, 0x2c, 0x0c, 0xdd, 0x6f,
+	0x58, 0x9b, 0x40, 0xcc, 0xe8, 0x84, 0x51, 0xf7, 0xee, 0x02, 0x4a, 0x36, 0xb8, 0x2f, 0x1a, 0xfc,
+	0x07, 0xee, 0xa4, 0x6b, 0x50, 0x5e, 0x1f, 0x8e, 0xba, 0xfd, 0xab, 0xd5, 0x4b, 0x12, 0x1d, 0xbe,
+	0xca, 0x82, 0xd5, 0xaf, 0x5d, 0x7c, 0x78, 0x34, 0x81, 0xfc, 0x94, 0xc1, 0xa5, 0x1e, 0x7f, 0x13,
+	0x6c, 0xe9, 0x95, 0x2d, 0xbc, 0x22, 0x10, 0xa7, 0xf4, 0x2a, 0x09, 0xcb, 0xd0, 0xae, 0xc1, 0x38,
+	0xed, 0x21, 0x91, 0x95, 0x1c, 0x75, 0xc5, 0x6f, 0x0f, 0x51, 0x29, 0xa1, 0x1e, 0x47, 0xdf, 0x93,
+	0x2c, 0x58, 0x18, 0x4a, 0x89, 0x89, 0x26, 0x68, 0x74, 0x34, 0xaa, 0x7b, 0x77, 0x01, 0x25, 0x5d,
+	0x79, 0x20, 0x5c, 0x39, 0x82, 0x87, 0xb7, 0x99, 0x20, 0x1a, 0x83, 0xd7, 0xa3, 0x10, 0xe4, 0xa8,
+	0x1b, 0x87, 0x71, 0x0f, 0x7e, 0x50, 0xc0, 0x8f, 0xc3, 0x71, 0x05, 0x27, 0x69, 0xe1, 0x86, 0x44,
+	0x55, 0xff, 0xbd, 0x13, 0x2c, 0xe9, 0xc7, 0x96, 0xf0, 0xe3, 0x0f, 0xb8, 0x99, 0xce, 0x0f, 0x53,
+	0xe0, 0xd4, 0x07, 0x12, 0x7d, 0xdb, 0x3c, 0xbf, 0x2c, 0x2a, 0x17, 0x97, 0x45, 0xe5, 0xdd, 0x65,
+	0x51, 0x79, 0x76, 0x55, 0xcc, 0x5c, 0x5c, 0x15, 0x33, 0x6f, 0xae, 0x8a, 0x99, 0xa3, 0x3d, 0xcb,
+	0x0e, 0x9a, 0xed, 0x86, 0x4e, 0x98, 0x83, 0x08, 0xe3, 0x0e, 0xe3, 0x21, 0x49, 0xc9, 0x62, 0xa8,
+	0xb3, 0x89, 0x1c, 0x66, 0xb6, 0x5b, 0x94, 0x47, 0x94, 0x95, 0x8d, 0x52, 0x9f, 0xb5, 0x74, 0x9d,
+	0x35, 0xcc, 0x68, 0xde, 0xc8, 0x8b, 0x3f, 0x52, 0xbf, 0x7d, 0x1a, 0x00, 0x8c, 0xde, 0xfd, 0xfd,
+	0x8d, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +630,17 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ChannelMetadata returns the ICS27 metadata negotiated for a given host channel.
+	ChannelMetadata(ctx context.Context, in *QueryChannelMetadataRequest, opts ...grpc.CallOption) (*QueryChannelMetadataResponse, error)
+	// ExpectedInterchainAccountAddress returns the address of the interchain account of an owner on a host connection. If no
+	// interchain account has been registered yet, the address the interchain account will have once registered is returned.
+	ExpectedInterchainAccountAddress(ctx context.Context, in *QueryExpectedInterchainAccountAddressRequest, opts ...grpc.CallOption) (*QueryExpectedInterchainAccountAddressResponse, error)
+	// ExecutionResult returns the persisted result of the execution of the packet received with a given sequence on a
+	// host channel.
+	ExecutionResult(ctx context.Context, in *QueryExecutionResultRequest, opts ...grpc.CallOption) (*QueryExecutionResultResponse, error)
+	// DecodePacketData decodes interchain accounts packet data serialized with a given encoding and returns the messages
+	// it contains as human-readable JSON.
+	DecodePacketData(ctx context.Context, in *QueryDecodePacketDataRequest, opts ...grpc.CallOption) (*QueryDecodePacketDataResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +660,57 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ChannelMetadata(ctx context.Context, in *QueryChannelMetadataRequest, opts ...grpc.CallOption) (*QueryChannelMetadataResponse, error) {
+	out := new(QueryChannelMetadataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/ChannelMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpectedInterchainAccountAddress(ctx context.Context, in *QueryExpectedInterchainAccountAddressRequest, opts ...grpc.CallOption) (*QueryExpectedInterchainAccountAddressResponse, error) {
+	out := new(QueryExpectedInterchainAccountAddressResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/ExpectedInterchainAccountAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionResult(ctx context.Context, in *QueryExecutionResultRequest, opts ...grpc.CallOption) (*QueryExecutionResultResponse, error) {
+	out := new(QueryExecutionResultResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/ExecutionResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecodePacketData(ctx context.Context, in *QueryDecodePacketDataRequest, opts ...grpc.CallOption) (*QueryDecodePacketDataResponse, error) {
+	out := new(QueryDecodePacketDataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/DecodePacketData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ChannelMetadata returns the ICS27 metadata negotiated for a given host channel.
+	ChannelMetadata(context.Context, *QueryChannelMetadataRequest) (*QueryChannelMetadataResponse, error)
+	// ExpectedInterchainAccountAddress returns the address of the interchain account of an owner on a host connection. If no
+	// interchain account has been registered yet, the address the interchain account will have once registered is returned.
+	ExpectedInterchainAccountAddress(context.Context, *QueryExpectedInterchainAccountAddressRequest) (*QueryExpectedInterchainAccountAddressResponse, error)
+	// ExecutionResult returns the persisted result of the execution of the packet received with a given sequence on a
+	// host channel.
+	ExecutionResult(context.Context, *QueryExecutionResultRequest) (*QueryExecutionResultResponse, error)
+	// DecodePacketData decodes interchain accounts packet data serialized with a given encoding and returns the messages
+	// it contains as human-readable JSON.
+	DecodePacketData(context.Context, *QueryDecodePacketDataRequest) (*QueryDecodePacketDataResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,8 +720,20 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+func (*UnimplementedQueryServer) ChannelMetadata(ctx context.Context, req *QueryChannelMetadataRequest) (*QueryChannelMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelMetadata not implemented")
+}
+func (*UnimplementedQueryServer) ExpectedInterchainAccountAddress(ctx context.Context, req *QueryExpectedInterchainAccountAddressRequest) (*QueryExpectedInterchainAccountAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpectedInterchainAccountAddress not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionResult(ctx context.Context, req *QueryExecutionResultRequest) (*QueryExecutionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionResult not implemented")
+}
+func (*UnimplementedQueryServer) DecodePacketData(ctx context.Context, req *QueryDecodePacketDataRequest) (*QueryDecodePacketDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodePacketData not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
@@ -213,6 +755,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChannelMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/ChannelMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelMetadata(ctx, req.(*QueryChannelMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpectedInterchainAccountAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpectedInterchainAccountAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpectedInterchainAccountAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/ExpectedInterchainAccountAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpectedInterchainAccountAddress(ctx, req.(*QueryExpectedInterchainAccountAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/ExecutionResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionResult(ctx, req.(*QueryExecutionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecodePacketData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecodePacketDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodePacketData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/DecodePacketData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodePacketData(ctx, req.(*QueryDecodePacketDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +835,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ChannelMetadata",
+			Handler:    _Query_ChannelMetadata_Handler,
+		},
+		{
+			MethodName: "ExpectedInterchainAccountAddress",
+			Handler:    _Query_ExpectedInterchainAccountAddress_Handler,
+		},
+		{
+			MethodName: "ExecutionResult",
+			Handler:    _Query_ExecutionResult_Handler,
+		},
+		{
+			MethodName: "DecodePacketData",
+			Handler:    _Query_DecodePacketData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
@@ -273,57 +903,1170 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedInterchainAccountAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedInterchainAccountAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedInterchainAccountAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedInterchainAccountAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedInterchainAccountAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedInterchainAccountAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodePacketDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodePacketDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodePacketDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketData) > 0 {
+		i -= len(m.PacketData)
+		copy(dAtA[i:], m.PacketData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PacketData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodePacketDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodePacketDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodePacketDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExpectedInterchainAccountAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpectedInterchainAccountAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Registered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryExecutionResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryExecutionResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDecodePacketDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecodePacketDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, s := range m.Messages {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedInterchainAccountAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedInterchainAccountAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedInterchainAccountAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedInterchainAccountAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedInterchainAccountAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedInterchainAccountAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExecutionResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDecodePacketDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,12 +2089,78 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecodePacketDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecodePacketDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketData == nil {
+				m.PacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -373,7 +2182,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDecodePacketDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,17 +2205,36 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecodePacketDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecodePacketDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -416,27 +2244,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Messages = append(m.Messages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
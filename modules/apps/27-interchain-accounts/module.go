@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	sdksimulation "github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/client/cli"
 	controllerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
@@ -39,6 +40,7 @@ var (
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
 
 	_ porttypes.IBCModule = (*host.IBCModule)(nil)
 )
@@ -111,6 +113,10 @@ type AppModule struct {
 	AppModuleBasic
 	controllerKeeper *controllerkeeper.Keeper
 	hostKeeper       *hostkeeper.Keeper
+
+	// accountKeeper and bankKeeper are only used to deliver the simulation operations
+	accountKeeper sdksimulation.AccountKeeper
+	bankKeeper    sdksimulation.BankKeeper
 }
 
 // NewAppModule creates a new IBC interchain accounts module
@@ -121,6 +127,15 @@ func NewAppModule(controllerKeeper *controllerkeeper.Keeper, hostKeeper *hostkee
 	}
 }
 
+// WithSimulationKeepers returns a copy of the module using the given account and bank keepers to
+// deliver its simulation operations as signed transactions paying fees. The module does not return
+// any simulation operations unless they are set.
+func (am AppModule) WithSimulationKeepers(accountKeeper sdksimulation.AccountKeeper, bankKeeper sdksimulation.BankKeeper) AppModule {
+	am.accountKeeper = accountKeeper
+	am.bankKeeper = bankKeeper
+	return am
+}
+
 // RegisterServices registers module services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if am.controllerKeeper != nil {
@@ -187,6 +202,20 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 3 }
 
+// BeginBlock re-sends the packet data of timed out packets scheduled for retry by the controller submodule
+// and closes the host channels built on connections denied by the host submodule.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	if am.controllerKeeper != nil {
+		am.controllerKeeper.ProcessPendingRetries(sdk.UnwrapSDKContext(ctx))
+	}
+
+	if am.hostKeeper != nil {
+		am.hostKeeper.ProcessPendingChannelClosures(sdk.UnwrapSDKContext(ctx))
+	}
+
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the ics27 module.
@@ -199,9 +228,9 @@ func (AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Weight
 	return simulation.ProposalMsgs()
 }
 
-// WeightedOperations is unimplemented.
-func (AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+// WeightedOperations returns the all the ics27 module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.controllerKeeper, am.hostKeeper)
 }
 
 // RegisterStoreDecoder registers a decoder for interchain accounts module's types
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
@@ -13,13 +16,43 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 )
 
+// Simulation parameter constants
+const allowMessages = "allow_messages"
+
+// HostMsgTypeURLs returns the message type URLs which may be executed on the host by
+// simulated interchain accounts.
+func HostMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	}
+}
+
+// RandomAllowMessages returns either the allow all wildcard or a random subset of the
+// simulated host message type URLs, with 50% probability each.
+func RandomAllowMessages(r *rand.Rand) []string {
+	if r.Intn(2) == 0 {
+		return []string{hosttypes.AllowAllHostMsgs}
+	}
+
+	allowMsgs := []string{}
+	for _, typeURL := range HostMsgTypeURLs() {
+		if r.Intn(2) == 0 {
+			allowMsgs = append(allowMsgs, typeURL)
+		}
+	}
+
+	return allowMsgs
+}
+
 // RandomEnabled randomized controller or host enabled param with 75% prob of being true.
 func RandomEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 75
 }
 
 // RandomizedGenState generates a random GenesisState for ics27.
-// Only the params are non nil
+// Only the params are non nil. The host allowlist is randomized over the simulated host messages.
 func RandomizedGenState(simState *module.SimulationState) {
 	var controllerEnabled bool
 	simState.AppParams.GetOrGenerate(
@@ -44,9 +77,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { hostEnabled = RandomEnabled(r) },
 	)
 
+	var hostAllowMessages []string
+	simState.AppParams.GetOrGenerate(
+		allowMessages, &hostAllowMessages, simState.Rand,
+		func(r *rand.Rand) { hostAllowMessages = RandomAllowMessages(r) },
+	)
+
 	hostParams := hosttypes.Params{
 		HostEnabled:   hostEnabled,
-		AllowMessages: []string{"*"}, // allow all messages
+		AllowMessages: hostAllowMessages,
 	}
 
 	hostGenesisState := genesistypes.HostGenesisState{
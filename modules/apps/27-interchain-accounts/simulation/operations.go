@@ -0,0 +1,299 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	controllerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	hostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
+	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgRegisterInterchainAccount int = 50
+	DefaultWeightMsgSendTx                    int = 100
+
+	OpWeightMsgRegisterInterchainAccount = "op_weight_msg_register_interchain_account" // #nosec
+	OpWeightMsgSendTx                    = "op_weight_msg_send_tx"                     // #nosec
+)
+
+// WeightedOperations returns all the ics27 controller operations with their respective weights.
+// No operations are returned if the controller submodule is not enabled in the application, or if
+// the account and bank keepers used to deliver the operations as signed transactions are not set.
+// The host submodule, if set, is used to restrict the messages executed by SendTx to the host allowlist.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	controllerKeeper *controllerkeeper.Keeper,
+	hostKeeper *hostkeeper.Keeper,
+) simulation.WeightedOperations {
+	if controllerKeeper == nil || ak == nil || bk == nil {
+		return nil
+	}
+
+	var weightMsgRegisterInterchainAccount, weightMsgSendTx int
+	appParams.GetOrGenerate(OpWeightMsgRegisterInterchainAccount, &weightMsgRegisterInterchainAccount, nil,
+		func(_ *rand.Rand) { weightMsgRegisterInterchainAccount = DefaultWeightMsgRegisterInterchainAccount },
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgSendTx, &weightMsgSendTx, nil,
+		func(_ *rand.Rand) { weightMsgSendTx = DefaultWeightMsgSendTx },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgRegisterInterchainAccount,
+			SimulateMsgRegisterInterchainAccount(txGen, ak, bk, controllerKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendTx,
+			SimulateMsgSendTx(cdc, txGen, ak, bk, controllerKeeper, hostKeeper),
+		),
+	}
+}
+
+// SimulateMsgRegisterInterchainAccount generates a MsgRegisterInterchainAccount for a random owner
+// on a random open connection and delivers it in a transaction signed by the owner.
+func SimulateMsgRegisterInterchainAccount(txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k *controllerkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&controllertypes.MsgRegisterInterchainAccount{})
+
+		if !k.GetParams(ctx).ControllerEnabled {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "controller submodule is disabled"), nil, nil
+		}
+
+		connectionIDs := openConnectionIDs(ctx, k)
+		if len(connectionIDs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open connections"), nil, nil
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+		ordering := channeltypes.ORDERED
+		if r.Intn(2) == 0 {
+			ordering = channeltypes.UNORDERED
+		}
+
+		msg := controllertypes.NewMsgRegisterInterchainAccount(connectionIDs[r.Intn(len(connectionIDs))], owner.Address.String(), "", ordering)
+
+		return deliverMsg(r, app, ctx, txGen, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgSendTx generates a MsgSendTx containing random bank and staking messages for a
+// registered interchain account with an open active channel and delivers it in a transaction
+// signed by the owner of the interchain account. Only interchain accounts owned by a simulation
+// account are used, and only message types allowed by the host allowlist are generated.
+func SimulateMsgSendTx(cdc codec.JSONCodec, txGen client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k *controllerkeeper.Keeper, hostKeeper *hostkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&controllertypes.MsgSendTx{})
+
+		protoCdc, ok := cdc.(*codec.ProtoCodec)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, fmt.Sprintf("expected %T, got %T", &codec.ProtoCodec{}, cdc)), nil, nil
+		}
+
+		var openChannels []channeltypes.IdentifiedChannel
+		for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+			if _, found := findOwner(accs, activeChannel.PortId); !found {
+				continue
+			}
+
+			if _, found := k.GetOpenActiveChannel(ctx, activeChannel.ConnectionId, activeChannel.PortId); found {
+				openChannels = append(openChannels, channeltypes.IdentifiedChannel{
+					PortId:         activeChannel.PortId,
+					ChannelId:      activeChannel.ChannelId,
+					ConnectionHops: []string{activeChannel.ConnectionId},
+				})
+			}
+		}
+
+		if len(openChannels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open active channels"), nil, nil
+		}
+		channel := openChannels[r.Intn(len(openChannels))]
+		connectionID := channel.ConnectionHops[0]
+
+		address, found := k.GetInterchainAccountAddress(ctx, connectionID, channel.PortId)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "interchain account not found"), nil, nil
+		}
+
+		version, found := k.GetAppVersion(ctx, channel.PortId, channel.ChannelId)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "channel version not found"), nil, nil
+		}
+
+		metadata, err := types.MetadataFromVersion(version)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		allowMessages := []string{hosttypes.AllowAllHostMsgs}
+		if hostKeeper != nil {
+			allowMessages = hostKeeper.GetParams(ctx).AllowMessages
+		}
+
+		msgs := randomHostMsgs(r, accs, address, allowMessages)
+		if len(msgs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no messages allowed by host allowlist"), nil, nil
+		}
+
+		data, err := types.SerializeCosmosTx(protoCdc, msgs, metadata.Encoding)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		packetData := types.InterchainAccountPacketData{
+			Type: types.EXECUTE_TX,
+			Data: data,
+			Memo: simtypes.RandStringOfLength(r, r.Intn(32)),
+		}
+
+		relativeTimeout := uint64((time.Duration(1+r.Intn(3600)) * time.Second).Nanoseconds())
+		owner, _ := findOwner(accs, channel.PortId)
+
+		msg := controllertypes.NewMsgSendTx(owner.Address.String(), connectionID, relativeTimeout, packetData)
+
+		return deliverMsg(r, app, ctx, txGen, ak, bk, owner, msg)
+	}
+}
+
+// findOwner returns the simulation account owning the interchain account bound to the given
+// controller port, if any.
+func findOwner(accs []simtypes.Account, portID string) (simtypes.Account, bool) {
+	owner, err := sdk.AccAddressFromBech32(strings.TrimPrefix(portID, types.ControllerPortPrefix))
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+
+	return simtypes.FindAccount(accs, owner)
+}
+
+// randomHostMsgs returns between one and three random bank and staking messages to be executed
+// by the interchain account with the given address. Message types which are not contained in
+// the host allowlist are never generated.
+func randomHostMsgs(r *rand.Rand, accs []simtypes.Account, address string, allowMessages []string) []proto.Message {
+	generators := map[string]func() proto.Message{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}): func() proto.Message {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(address), recipient.Address, randomCoins(r))
+		},
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}): func() proto.Message {
+			validator, _ := simtypes.RandomAcc(r, accs)
+			return stakingtypes.NewMsgDelegate(address, sdk.ValAddress(validator.Address).String(), randomCoins(r)[0])
+		},
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}): func() proto.Message {
+			validator, _ := simtypes.RandomAcc(r, accs)
+			return stakingtypes.NewMsgUndelegate(address, sdk.ValAddress(validator.Address).String(), randomCoins(r)[0])
+		},
+	}
+
+	var allowed []string
+	for _, typeURL := range HostMsgTypeURLs() {
+		if slices.Contains(allowMessages, hosttypes.AllowAllHostMsgs) || slices.Contains(allowMessages, typeURL) {
+			allowed = append(allowed, typeURL)
+		}
+	}
+
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	msgs := make([]proto.Message, 1+r.Intn(3))
+	for i := range msgs {
+		msgs[i] = generators[allowed[r.Intn(len(allowed))]]()
+	}
+
+	return msgs
+}
+
+// randomCoins returns a random positive amount of the default bond denomination.
+func randomCoins(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1+r.Int63n(1000))))
+}
+
+// openConnectionIDs returns the identifiers of all OPEN connections used by existing channels.
+func openConnectionIDs(ctx sdk.Context, k *controllerkeeper.Keeper) []string {
+	channelKeeper := k.GetChannelKeeper()
+
+	var connectionIDs []string
+	for _, channel := range channelKeeper.GetAllChannelsWithPortPrefix(ctx, "") {
+		if len(channel.ConnectionHops) == 0 || slices.Contains(connectionIDs, channel.ConnectionHops[0]) {
+			continue
+		}
+
+		connection, err := channelKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if err != nil || connection.State != connectiontypes.OPEN {
+			continue
+		}
+
+		connectionIDs = append(connectionIDs, channel.ConnectionHops[0])
+	}
+
+	return connectionIDs
+}
+
+// deliverMsg delivers the message in a transaction signed by the given simulation account and
+// paying random fees. The message is first executed in a discarded cached context, so that
+// messages which would be rejected by the controller submodule are reported as no-ops instead
+// of failing the simulation.
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, txGen client.TxConfig,
+	ak simulation.AccountKeeper, bk simulation.BankKeeper, simAccount simtypes.Account, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "no message handler found"), nil, nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+	}
+
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -0,0 +1,175 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/simulation"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+type SimulationTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *SimulationTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestSimulationTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(SimulationTestSuite))
+}
+
+// simAccounts returns the sender accounts of the given test chain as simulation accounts.
+func simAccounts(chain *ibctesting.TestChain) []simtypes.Account {
+	accounts := make([]simtypes.Account, len(chain.SenderAccounts))
+	for i, senderAccount := range chain.SenderAccounts {
+		accounts[i] = simtypes.Account{
+			PrivKey: senderAccount.SenderPrivKey,
+			PubKey:  senderAccount.SenderPrivKey.PubKey(),
+			Address: senderAccount.SenderAccount.GetAddress(),
+		}
+	}
+
+	return accounts
+}
+
+// setupICAPath registers an interchain account for the given owner on chainA and completes
+// the channel handshake with the host on chainB.
+func (suite *SimulationTestSuite) setupICAPath(owner string) *ibctesting.Path {
+	version := icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	path.SetupConnections()
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, version)
+	suite.Require().NoError(err)
+
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	return path
+}
+
+func (suite *SimulationTestSuite) TestWeightedOperations() {
+	simApp := suite.chainA.GetSimApp()
+
+	weightedOps := simulation.WeightedOperations(make(simtypes.AppParams), simApp.AppCodec(), simApp.GetTxConfig(), simApp.AccountKeeper, simApp.BankKeeper, &simApp.ICAControllerKeeper, &simApp.ICAHostKeeper)
+	suite.Require().Len(weightedOps, 2)
+	suite.Require().Equal(simulation.DefaultWeightMsgRegisterInterchainAccount, weightedOps[0].Weight())
+	suite.Require().Equal(simulation.DefaultWeightMsgSendTx, weightedOps[1].Weight())
+
+	weightedOps = simulation.WeightedOperations(make(simtypes.AppParams), simApp.AppCodec(), simApp.GetTxConfig(), simApp.AccountKeeper, simApp.BankKeeper, nil, &simApp.ICAHostKeeper)
+	suite.Require().Empty(weightedOps)
+
+	weightedOps = simulation.WeightedOperations(make(simtypes.AppParams), simApp.AppCodec(), simApp.GetTxConfig(), nil, nil, &simApp.ICAControllerKeeper, &simApp.ICAHostKeeper)
+	suite.Require().Empty(weightedOps)
+}
+
+// beginBlock finalizes the proposed block of the given test chain without committing it, so that
+// simulation operations can deliver transactions in it as they do in the simulator.
+func (suite *SimulationTestSuite) beginBlock(chain *ibctesting.TestChain) {
+	_, err := chain.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             chain.ProposedHeader.Height,
+		Time:               chain.ProposedHeader.GetTime(),
+		NextValidatorsHash: chain.NextVals.Hash(),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *SimulationTestSuite) TestSimulateMsgRegisterInterchainAccount() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	simApp := suite.chainA.GetSimApp()
+
+	r := rand.New(rand.NewSource(1))
+	op := simulation.SimulateMsgRegisterInterchainAccount(simApp.GetTxConfig(), simApp.AccountKeeper, simApp.BankKeeper, &simApp.ICAControllerKeeper)
+
+	suite.beginBlock(suite.chainA)
+	operationMsg, _, err := op(r, suite.chainA.App.GetBaseApp(), suite.chainA.GetContext(), simAccounts(suite.chainA), suite.chainA.ChainID)
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(sdk.MsgTypeURL(&controllertypes.MsgRegisterInterchainAccount{}), operationMsg.Name)
+}
+
+func (suite *SimulationTestSuite) TestSimulateMsgSendTx() {
+	testCases := []struct {
+		name          string
+		allowMessages []string
+		ownedBySim    bool
+		expPass       bool
+	}{
+		{"success: allow all messages", []string{hosttypes.AllowAllHostMsgs}, true, true},
+		{"success: allow bank send only", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, true, true},
+		{"no messages allowed by host", []string{}, true, false},
+		{"interchain account not owned by a simulation account", []string{hosttypes.AllowAllHostMsgs}, false, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			owner := suite.chainA.SenderAccount.GetAddress().String()
+			if !tc.ownedBySim {
+				owner = ibctesting.TestAccAddress
+			}
+
+			suite.setupICAPath(owner)
+
+			simApp := suite.chainA.GetSimApp()
+			hostKeeper := &simApp.ICAHostKeeper
+			hostKeeper.SetParams(suite.chainA.GetContext(), hosttypes.NewParams(true, tc.allowMessages))
+
+			r := rand.New(rand.NewSource(1))
+			op := simulation.SimulateMsgSendTx(simApp.AppCodec(), simApp.GetTxConfig(), simApp.AccountKeeper, simApp.BankKeeper, &simApp.ICAControllerKeeper, hostKeeper)
+
+			suite.beginBlock(suite.chainA)
+			operationMsg, _, err := op(r, suite.chainA.App.GetBaseApp(), suite.chainA.GetContext(), simAccounts(suite.chainA), suite.chainA.ChainID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPass, operationMsg.OK, operationMsg.Comment)
+
+			if tc.expPass {
+				suite.Require().Equal(sdk.MsgTypeURL(&controllertypes.MsgSendTx{}), operationMsg.Name)
+			}
+		})
+	}
+}
@@ -54,6 +54,16 @@ func GenerateAddress(ctx sdk.Context, connectionID, portID string) sdk.AccAddres
 	return sdkaddress.Derive(hostModuleAcc, buf)
 }
 
+// GenerateDeterministicAddress returns an sdk.AccAddress derived using a host module account address, the host connection ID
+// and the controller portID. Unlike GenerateAddress, the address does not depend on the block in which the interchain account
+// is registered and may be computed by controllers before the channel handshake completes.
+func GenerateDeterministicAddress(connectionID, portID string) sdk.AccAddress {
+	hostModuleAcc := sdkaddress.Module(ModuleName, []byte(hostAccountsKey))
+
+	// connection identifiers cannot contain a slash, which makes the derivation key unambiguous
+	return sdkaddress.Derive(hostModuleAcc, []byte(connectionID+"/"+portID))
+}
+
 // ValidateAccountAddress performs basic validation of interchain account addresses, enforcing constraints
 // on address length and character set
 func ValidateAccountAddress(addr string) error {
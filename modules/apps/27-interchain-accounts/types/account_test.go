@@ -51,6 +51,16 @@ func (suite *TypesTestSuite) TestGenerateAddress() {
 	suite.Require().NotEmpty(accAddr)
 }
 
+func (suite *TypesTestSuite) TestGenerateDeterministicAddress() {
+	addr := types.GenerateDeterministicAddress("test-connection-id", "test-port-id")
+	suite.Require().NotEmpty(addr)
+
+	// the address does not depend on the block
+	suite.Require().Equal(addr, types.GenerateDeterministicAddress("test-connection-id", "test-port-id"))
+	suite.Require().NotEqual(addr, types.GenerateDeterministicAddress("test-connection-id", "test-port-id-2"))
+	suite.Require().NotEqual(addr, types.GenerateDeterministicAddress("test-connection-i", "dtest-port-id"))
+}
+
 func (suite *TypesTestSuite) TestValidateAccountAddress() {
 	testCases := []struct {
 		name    string
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
@@ -25,13 +27,25 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*authtypes.GenesisAccount)(nil), &InterchainAccount{})
 }
 
+// aminoCosmosTx is the amino JSON representation of a CosmosTx. The messages are not packed into Any's,
+// they are encoded with the names they are registered with on the LegacyAmino codec.
+type aminoCosmosTx struct {
+	Messages []sdk.Msg `json:"messages"`
+}
+
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
 // packed into Any's and inserted into the Messages field of a CosmosTx. The CosmosTx is marshaled
 // depending on the encoding type passed in. The marshaled bytes are returned. Only the ProtoCodec
-// is supported for serializing messages. Both protobuf and proto3 JSON are supported.
-func SerializeCosmosTx(cdc codec.Codec, msgs []proto.Message, encoding string) ([]byte, error) {
+// is supported for serializing messages with protobuf and proto3 JSON. Messages serialized with
+// amino JSON must be serialized with an AminoCodec wrapping the LegacyAmino codec of the application.
+func SerializeCosmosTx(cdc codec.JSONCodec, msgs []proto.Message, encoding string) ([]byte, error) {
+	if encoding == EncodingAminoJSON {
+		return serializeAminoCosmosTx(cdc, msgs)
+	}
+
 	// this is a defensive check to ensure only the ProtoCodec is used for message serialization
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving messages on the host chain")
 	}
 
@@ -53,12 +67,12 @@ func SerializeCosmosTx(cdc codec.Codec, msgs []proto.Message, encoding string) (
 
 	switch encoding {
 	case EncodingProtobuf:
-		bz, err = cdc.Marshal(cosmosTx)
+		bz, err = protoCdc.Marshal(cosmosTx)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "cannot marshal CosmosTx with protobuf")
 		}
 	case EncodingProto3JSON:
-		bz, err = cdc.MarshalJSON(cosmosTx)
+		bz, err = protoCdc.MarshalJSON(cosmosTx)
 		if err != nil {
 			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot marshal CosmosTx with proto3 json")
 		}
@@ -71,11 +85,17 @@ func SerializeCosmosTx(cdc codec.Codec, msgs []proto.Message, encoding string) (
 
 // DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes into a slice of sdk.Msg's.
 // The transaction bytes are unmarshaled depending on the encoding type passed in. The sdk.Msg's are
-// unpacked from Any's and returned. Only the ProtoCodec is supported for serializing messages. Both
-// protobuf and proto3 JSON are supported.
-func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.Msg, error) {
+// unpacked from Any's and returned. Only the ProtoCodec is supported for deserializing messages with
+// protobuf and proto3 JSON. Messages serialized with amino JSON must be deserialized with an AminoCodec
+// wrapping the LegacyAmino codec of the application.
+func DeserializeCosmosTx(cdc codec.JSONCodec, data []byte, encoding string) ([]sdk.Msg, error) {
+	if encoding == EncodingAminoJSON {
+		return deserializeAminoCosmosTx(cdc, data)
+	}
+
 	// this is a defensive check to ensure only the ProtoCodec is used for message deserialization
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving messages on the host chain")
 	}
 
@@ -83,11 +103,11 @@ func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.M
 
 	switch encoding {
 	case EncodingProtobuf:
-		if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
+		if err := protoCdc.Unmarshal(data, &cosmosTx); err != nil {
 			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosTx with protobuf: %v", err)
 		}
 	case EncodingProto3JSON:
-		if err := cdc.UnmarshalJSON(data, &cosmosTx); err != nil {
+		if err := protoCdc.UnmarshalJSON(data, &cosmosTx); err != nil {
 			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosTx with proto3 json")
 		}
 	default:
@@ -98,7 +118,7 @@ func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.M
 
 	for i, protoAny := range cosmosTx.Messages {
 		var msg sdk.Msg
-		err := cdc.UnpackAny(protoAny, &msg)
+		err := protoCdc.UnpackAny(protoAny, &msg)
 		if err != nil {
 			return nil, err
 		}
@@ -107,3 +127,97 @@ func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.M
 
 	return msgs, nil
 }
+
+// CosmosTxMessageTypeURLs returns the type URLs of the messages contained in a slice of transaction bytes
+// serialized with the provided encoding type. The messages are not unpacked from their Any's, so the type
+// URLs of messages which are not registered with the interface registry of the chain can be retrieved.
+// Messages serialized with amino JSON do not carry their type URLs, the amino names of the messages are
+// returned instead.
+func CosmosTxMessageTypeURLs(data []byte, encoding string) ([]string, error) {
+	var messages []*codectypes.Any
+
+	switch encoding {
+	case EncodingProtobuf:
+		var cosmosTx CosmosTx
+		if err := cosmosTx.Unmarshal(data); err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosTx with protobuf: %v", err)
+		}
+
+		messages = cosmosTx.Messages
+	case EncodingProto3JSON:
+		// messages encoded with proto3 JSON hold their type URL in the @type field
+		var cosmosTx struct {
+			Messages []struct {
+				TypeURL string `json:"@type"`
+			} `json:"messages"`
+		}
+		if err := json.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosTx with proto3 json")
+		}
+
+		typeURLs := make([]string, len(cosmosTx.Messages))
+		for i, msg := range cosmosTx.Messages {
+			typeURLs[i] = msg.TypeURL
+		}
+
+		return typeURLs, nil
+	case EncodingAminoJSON:
+		// messages encoded with amino JSON hold their amino name in the type field
+		var cosmosTx struct {
+			Messages []struct {
+				Type string `json:"type"`
+			} `json:"messages"`
+		}
+		if err := json.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosTx with amino json")
+		}
+
+		aminoNames := make([]string, len(cosmosTx.Messages))
+		for i, msg := range cosmosTx.Messages {
+			aminoNames[i] = msg.Type
+		}
+
+		return aminoNames, nil
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	typeURLs := make([]string, len(messages))
+	for i, msg := range messages {
+		typeURLs[i] = msg.TypeUrl
+	}
+
+	return typeURLs, nil
+}
+
+// serializeAminoCosmosTx serializes a slice of sdk.Msg's into the amino JSON representation of a CosmosTx.
+// The messages must be registered on the LegacyAmino codec wrapped by the provided AminoCodec.
+func serializeAminoCosmosTx(cdc codec.JSONCodec, msgs []proto.Message) ([]byte, error) {
+	aminoCdc, ok := cdc.(*codec.AminoCodec)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the AminoCodec may be used for serializing messages with amino json")
+	}
+
+	bz, err := aminoCdc.LegacyAmino.MarshalJSON(aminoCosmosTx{Messages: msgs})
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot marshal CosmosTx with amino json: %v", err)
+	}
+
+	return bz, nil
+}
+
+// deserializeAminoCosmosTx unmarshals the amino JSON representation of a CosmosTx into a slice of sdk.Msg's.
+// The messages must be registered on the LegacyAmino codec wrapped by the provided AminoCodec.
+func deserializeAminoCosmosTx(cdc codec.JSONCodec, data []byte) ([]sdk.Msg, error) {
+	aminoCdc, ok := cdc.(*codec.AminoCodec)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the AminoCodec may be used for deserializing messages with amino json")
+	}
+
+	var cosmosTx aminoCosmosTx
+	if err := aminoCdc.LegacyAmino.UnmarshalJSON(data, &cosmosTx); err != nil {
+		return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosTx with amino json: %v", err)
+	}
+
+	return cosmosTx.Messages, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -311,6 +312,56 @@ func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTx() {
 	}
 }
 
+func (suite *TypesTestSuite) TestSerializeAndDeserializeAminoJSONCosmosTx() {
+	aminoCdc := codec.NewAminoCodec(suite.chainA.GetSimApp().LegacyAmino())
+
+	msgs := []proto.Message{
+		&banktypes.MsgSend{
+			FromAddress: TestOwnerAddress,
+			ToAddress:   TestOwnerAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdkmath.NewInt(100))),
+		},
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: TestOwnerAddress,
+			ValidatorAddress: TestOwnerAddress,
+			Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)),
+		},
+	}
+
+	bz, err := types.SerializeCosmosTx(aminoCdc, msgs, types.EncodingAminoJSON)
+	suite.Require().NoError(err)
+
+	deserializedMsgs, err := types.DeserializeCosmosTx(aminoCdc, bz, types.EncodingAminoJSON)
+	suite.Require().NoError(err)
+	suite.Require().Len(deserializedMsgs, len(msgs))
+	for i, msg := range msgs {
+		suite.Require().Equal(proto.CompactTextString(msg), proto.CompactTextString(deserializedMsgs[i]))
+	}
+
+	// the amino names of the messages are returned in place of their type URLs
+	aminoNames, err := types.CosmosTxMessageTypeURLs(bz, types.EncodingAminoJSON)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"cosmos-sdk/MsgSend", "cosmos-sdk/MsgDelegate"}, aminoNames)
+
+	_, err = types.CosmosTxMessageTypeURLs([]byte("invalid data"), types.EncodingAminoJSON)
+	suite.Require().ErrorIs(err, types.ErrUnknownDataType)
+
+	// only the AminoCodec may be used with amino json
+	_, err = types.SerializeCosmosTx(suite.chainA.Codec, msgs, types.EncodingAminoJSON)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosTx(suite.chainA.Codec, bz, types.EncodingAminoJSON)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	// the AminoCodec may not be used with protobuf
+	_, err = types.SerializeCosmosTx(aminoCdc, msgs, types.EncodingProtobuf)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	// messages which are not registered on the amino codec cannot be deserialized
+	_, err = types.DeserializeCosmosTx(aminoCdc, []byte(`{"messages":[{"type":"unregistered/MsgUnknown","value":{}}]}`), types.EncodingAminoJSON)
+	suite.Require().ErrorIs(err, types.ErrUnknownDataType)
+}
+
 func (suite *TypesTestSuite) TestJSONDeserializeCosmosTx() {
 	testCases := []struct {
 		name      string
@@ -469,3 +520,45 @@ func (suite *TypesTestSuite) TestUnsupportedEncodingType() {
 	_, err = types.DeserializeCosmosTx(suite.chainA.Codec, data, types.EncodingProtobuf)
 	suite.Require().NoError(err)
 }
+
+func (suite *TypesTestSuite) TestCosmosTxMessageTypeURLs() {
+	msgs := []proto.Message{
+		&banktypes.MsgSend{
+			FromAddress: TestOwnerAddress,
+			ToAddress:   TestOwnerAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdkmath.NewInt(100))),
+		},
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: TestOwnerAddress,
+			ValidatorAddress: TestOwnerAddress,
+			Amount:           sdk.NewCoin("bananas", sdkmath.NewInt(100)),
+		},
+	}
+	expTypeURLs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		data, err := types.SerializeCosmosTx(suite.chainA.Codec, msgs, encoding)
+		suite.Require().NoError(err)
+
+		typeURLs, err := types.CosmosTxMessageTypeURLs(data, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Equal(expTypeURLs, typeURLs, encoding)
+
+		_, err = types.CosmosTxMessageTypeURLs([]byte("invalid data"), encoding)
+		suite.Require().ErrorIs(err, types.ErrUnknownDataType, encoding)
+	}
+
+	// type URLs of messages which are not registered with the interface registry are returned
+	cosmosTx := &types.CosmosTx{
+		Messages: []*codectypes.Any{{TypeUrl: "/unregistered.v1.MsgUnknown"}},
+	}
+	data, err := cosmosTx.Marshal()
+	suite.Require().NoError(err)
+
+	typeURLs, err := types.CosmosTxMessageTypeURLs(data, types.EncodingProtobuf)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"/unregistered.v1.MsgUnknown"}, typeURLs)
+
+	_, err = types.CosmosTxMessageTypeURLs(data, "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
@@ -2,10 +2,28 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket           = "ics27_packet"
+	EventTypeRetryScheduled   = "ics27_retry_scheduled"
+	EventTypeRetrySent        = "ics27_retry_sent"
+	EventTypeRetryFailed      = "ics27_retry_failed"
+	EventTypeRetriesExhausted = "ics27_retries_exhausted"
+	EventTypeChannelMigrated  = "ics27_channel_capability_migrated"
+	EventTypeChannelClosed    = "ics27_channel_closed"
+	EventTypeSendTx           = "ics27_send_tx"
+
+	EventTypeHostNotificationSent         = "ics27_host_notification_sent"
+	EventTypeHostNotificationAcknowledged = "ics27_host_notification_acknowledged"
+	EventTypeHostNotificationTimeout      = "ics27_host_notification_timeout"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyPortID              = "port_id"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeyPacketSequence      = "packet_sequence"
+	AttributeKeyRetryAttempt        = "retry_attempt"
+	AttributeKeyRetryHeight         = "retry_height"
+	AttributeKeyRetryError          = "error"
+	AttributeKeyMsgTypeURLs         = "msg_type_urls"
 )
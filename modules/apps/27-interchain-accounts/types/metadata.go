@@ -1,8 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"slices"
 
+	"github.com/cosmos/gogoproto/jsonpb"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +19,9 @@ const (
 	EncodingProtobuf = "proto3"
 	// EncodingProto3JSON defines the proto3 JSON encoding format
 	EncodingProto3JSON = "proto3json"
+	// EncodingAminoJSON defines the legacy amino JSON encoding format, for host chains whose message
+	// handlers require messages to be encoded with amino JSON
+	EncodingAminoJSON = "aminojson"
 
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
@@ -54,6 +61,39 @@ func NewDefaultMetadataString(controllerConnectionID, hostConnectionID string) s
 	return string(ModuleCdc.MustMarshalJSON(&metadata))
 }
 
+// metadataJSON defines the JSON encoding of Metadata used in the ICS27 channel version.
+type metadataJSON struct {
+	Version                string `json:"version"`
+	ControllerConnectionID string `json:"controller_connection_id"`
+	HostConnectionID       string `json:"host_connection_id"`
+	Address                string `json:"address"`
+	Encoding               string `json:"encoding"`
+	TxType                 string `json:"tx_type"`
+	HostNotifications      bool   `json:"host_notifications,omitempty"`
+}
+
+// MarshalJSONPB implements the jsonpb.JSONPBMarshaler interface. The host_notifications field is omitted unless it is
+// set, such that the channel version remains readable by chains which do not support host notifications.
+func (m *Metadata) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(metadataJSON{
+		Version:                m.Version,
+		ControllerConnectionID: m.ControllerConnectionId,
+		HostConnectionID:       m.HostConnectionId,
+		Address:                m.Address,
+		Encoding:               m.Encoding,
+		TxType:                 m.TxType,
+		HostNotifications:      m.HostNotifications,
+	}); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
 // MetadataFromVersion parses Metadata from a json encoded version string.
 func MetadataFromVersion(versionString string) (Metadata, error) {
 	var metadata Metadata
@@ -150,7 +190,7 @@ func isSupportedEncoding(encoding string) bool {
 
 // getSupportedEncoding returns a string slice of supported encoding formats
 func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf, EncodingProto3JSON}
+	return []string{EncodingProtobuf, EncodingProto3JSON, EncodingAminoJSON}
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
@@ -38,6 +38,9 @@ type Metadata struct {
 	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
 	// tx_type defines the type of transactions the interchain account can execute
 	TxType string `protobuf:"bytes,6,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// host_notifications defines whether the host chain may send notification packets to the controller chain over
+	// the channel. It is requested by the controller chain and is only kept by the host chain if it has enabled notifications.
+	HostNotifications bool `protobuf:"varint,7,opt,name=host_notifications,json=hostNotifications,proto3" json:"host_notifications,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -115,6 +118,13 @@ func (m *Metadata) GetTxType() string {
 	return ""
 }
 
+func (m *Metadata) GetHostNotifications() bool {
+	if m != nil {
+		return m.HostNotifications
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "ibc.applications.interchain_accounts.v1.Metadata")
 }
@@ -124,26 +134,28 @@ func init() {
 }
 
 var fileDescriptor_c29c32e397d1f21e = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x1b, 0x7e, 0xda, 0xe2, 0x09, 0x79, 0x00, 0x8b, 0x21, 0x42, 0x2c, 0x30, 0xd0, 0x58,
-	0x05, 0x09, 0x3a, 0x83, 0x18, 0x18, 0x58, 0x10, 0x13, 0x4b, 0xe4, 0x5c, 0x5b, 0xad, 0xa5, 0xc4,
-	0x37, 0xb2, 0x6f, 0xa3, 0xf6, 0x2d, 0x78, 0x2c, 0xc6, 0x8e, 0x48, 0x2c, 0xa8, 0x7d, 0x11, 0x94,
-	0x50, 0x52, 0x40, 0x8c, 0x47, 0xdf, 0xf9, 0xec, 0xab, 0xc3, 0xae, 0x6c, 0x06, 0x52, 0x95, 0x65,
-	0x6e, 0x41, 0x91, 0x45, 0x17, 0xa4, 0x75, 0x64, 0x3c, 0x4c, 0x94, 0x75, 0xa9, 0x02, 0xc0, 0xa9,
-	0xa3, 0x20, 0xab, 0xa1, 0x2c, 0x0c, 0x29, 0xad, 0x48, 0x25, 0xa5, 0x47, 0x42, 0x7e, 0x6a, 0x33,
-	0x48, 0x7e, 0x7a, 0xc9, 0x3f, 0x5e, 0x52, 0x0d, 0x4f, 0xde, 0x23, 0xd6, 0x7f, 0x58, 0xbb, 0x5c,
-	0xb0, 0x5e, 0x65, 0x7c, 0xb0, 0xe8, 0x44, 0x74, 0x1c, 0x9d, 0xed, 0x3d, 0x7e, 0x47, 0x3e, 0x62,
-	0x02, 0xd0, 0x91, 0xc7, 0x3c, 0x37, 0x3e, 0x05, 0x74, 0xce, 0x40, 0xfd, 0x6e, 0x6a, 0xb5, 0xd8,
-	0x6a, 0xaa, 0x07, 0x1b, 0x7e, 0xdb, 0xe2, 0x7b, 0xcd, 0xcf, 0x19, 0x9f, 0x60, 0xa0, 0x3f, 0xce,
-	0x76, 0xe3, 0xec, 0xd7, 0xe4, 0x57, 0x5b, 0xb0, 0x9e, 0xd2, 0xda, 0x9b, 0x10, 0xc4, 0xce, 0xd7,
-	0x05, 0xeb, 0xc8, 0x8f, 0x58, 0xdf, 0x38, 0x40, 0x6d, 0xdd, 0x58, 0xec, 0x36, 0xa8, 0xcd, 0xfc,
-	0x90, 0xf5, 0x68, 0x96, 0xd2, 0xbc, 0x34, 0xa2, 0xdb, 0xa0, 0x2e, 0xcd, 0x9e, 0xe6, 0xa5, 0xb9,
-	0x49, 0x5f, 0x97, 0x71, 0xb4, 0x58, 0xc6, 0xd1, 0xc7, 0x32, 0x8e, 0x5e, 0x56, 0x71, 0x67, 0xb1,
-	0x8a, 0x3b, 0x6f, 0xab, 0xb8, 0xf3, 0x7c, 0x37, 0xb6, 0x34, 0x99, 0x66, 0x09, 0x60, 0x21, 0x01,
-	0x43, 0x81, 0x41, 0xda, 0x0c, 0x06, 0x63, 0x94, 0xd5, 0x48, 0x16, 0xa8, 0xa7, 0xb9, 0x09, 0xf5,
-	0xf0, 0x41, 0x5e, 0x5c, 0x0f, 0x36, 0xdb, 0x0d, 0xda, 0xcd, 0xeb, 0xdf, 0x42, 0xd6, 0x6d, 0xe6,
-	0xbe, 0xfc, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x82, 0xdb, 0x39, 0xa8, 0x01, 0x00, 0x00,
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0xf3, 0x30,
+	0x10, 0x86, 0x9b, 0x7e, 0x1f, 0x4d, 0xf1, 0x04, 0x1e, 0xc0, 0x62, 0x88, 0x2a, 0x16, 0x3a, 0x90,
+	0x58, 0x05, 0x09, 0x3a, 0x83, 0x18, 0x18, 0x60, 0xa8, 0x98, 0x58, 0x22, 0xc7, 0x36, 0xad, 0xa5,
+	0xc4, 0x17, 0xc5, 0xd7, 0xa8, 0xfd, 0x0b, 0x4c, 0xfc, 0x2c, 0xc6, 0x8e, 0x8c, 0xa8, 0xfd, 0x23,
+	0x28, 0xa1, 0x4d, 0x0b, 0x62, 0x7c, 0xf5, 0xdc, 0x63, 0x9f, 0xee, 0x25, 0x57, 0x26, 0x91, 0x5c,
+	0xe4, 0x79, 0x6a, 0xa4, 0x40, 0x03, 0xd6, 0x71, 0x63, 0x51, 0x17, 0x72, 0x22, 0x8c, 0x8d, 0x85,
+	0x94, 0x30, 0xb5, 0xe8, 0x78, 0x39, 0xe0, 0x99, 0x46, 0xa1, 0x04, 0x8a, 0x28, 0x2f, 0x00, 0x81,
+	0x9e, 0x99, 0x44, 0x46, 0xbb, 0x5e, 0xf4, 0x87, 0x17, 0x95, 0x83, 0xd3, 0xd7, 0x36, 0xe9, 0x3e,
+	0xac, 0x5d, 0xca, 0x88, 0x5f, 0xea, 0xc2, 0x19, 0xb0, 0xcc, 0xeb, 0x79, 0xfd, 0xfd, 0xd1, 0x26,
+	0xd2, 0x21, 0x61, 0x12, 0x2c, 0x16, 0x90, 0xa6, 0xba, 0x88, 0x25, 0x58, 0xab, 0x65, 0xf5, 0x6e,
+	0x6c, 0x14, 0x6b, 0xd7, 0xa3, 0x47, 0x5b, 0x7e, 0xdb, 0xe0, 0x7b, 0x45, 0xcf, 0x09, 0x9d, 0x80,
+	0xc3, 0x5f, 0xce, 0xbf, 0xda, 0x39, 0xa8, 0xc8, 0x8f, 0x69, 0x46, 0x7c, 0xa1, 0x54, 0xa1, 0x9d,
+	0x63, 0xff, 0xbf, 0x37, 0x58, 0x47, 0x7a, 0x42, 0xba, 0xda, 0x4a, 0x50, 0xc6, 0x8e, 0xd9, 0x5e,
+	0x8d, 0x9a, 0x4c, 0x8f, 0x89, 0x8f, 0xb3, 0x18, 0xe7, 0xb9, 0x66, 0x9d, 0x1a, 0x75, 0x70, 0xf6,
+	0x34, 0xcf, 0x35, 0x0d, 0xd7, 0x9f, 0x5b, 0x40, 0xf3, 0xb2, 0x39, 0x05, 0xf3, 0x7b, 0x5e, 0xbf,
+	0x3b, 0x3a, 0xac, 0xc8, 0xe3, 0x2e, 0xb8, 0x89, 0xdf, 0x97, 0x81, 0xb7, 0x58, 0x06, 0xde, 0xe7,
+	0x32, 0xf0, 0xde, 0x56, 0x41, 0x6b, 0xb1, 0x0a, 0x5a, 0x1f, 0xab, 0xa0, 0xf5, 0x7c, 0x37, 0x36,
+	0x38, 0x99, 0x26, 0x91, 0x84, 0x8c, 0x4b, 0x70, 0x19, 0x38, 0x6e, 0x12, 0x19, 0x8e, 0x81, 0x97,
+	0x43, 0x9e, 0x81, 0x9a, 0xa6, 0xda, 0x55, 0x3d, 0x39, 0x7e, 0x71, 0x1d, 0x6e, 0x4f, 0x1d, 0x36,
+	0x15, 0x55, 0xcb, 0xb9, 0xa4, 0x53, 0xb7, 0x73, 0xf9, 0x35, 0x00, 0x50, 0xc3, 0xf6, 0x01, 0xd7,
+	0x01, 0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -166,6 +178,16 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HostNotifications {
+		i--
+		if m.HostNotifications {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.TxType) > 0 {
 		i -= len(m.TxType)
 		copy(dAtA[i:], m.TxType)
@@ -252,6 +274,9 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	if m.HostNotifications {
+		n += 2
+	}
 	return n
 }
 
@@ -482,6 +507,26 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.TxType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostNotifications", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostNotifications = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -1,10 +1,28 @@
 package types_test
 
 import (
+	"fmt"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
+func (suite *TypesTestSuite) TestMetadataJSON() {
+	metadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+
+	// host notifications are omitted from the version string unless set
+	expVersion := fmt.Sprintf(`{"version":"ics27-1","controller_connection_id":"connection-0","host_connection_id":"connection-0","address":"%s","encoding":"proto3","tx_type":"sdk_multi_msg"}`, TestOwnerAddress)
+	suite.Require().Equal(expVersion, string(types.ModuleCdc.MustMarshalJSON(&metadata)))
+
+	metadata.HostNotifications = true
+	expVersion = fmt.Sprintf(`{"version":"ics27-1","controller_connection_id":"connection-0","host_connection_id":"connection-0","address":"%s","encoding":"proto3","tx_type":"sdk_multi_msg","host_notifications":true}`, TestOwnerAddress)
+	suite.Require().Equal(expVersion, string(types.ModuleCdc.MustMarshalJSON(&metadata)))
+
+	decoded, err := types.MetadataFromVersion(expVersion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, decoded)
+}
+
 // use TestVersion as metadata being compared against
 func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 	var (
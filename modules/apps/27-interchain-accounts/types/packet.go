@@ -4,17 +4,21 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 var (
-	_ ibcexported.PacketData         = (*InterchainAccountPacketData)(nil)
-	_ ibcexported.PacketDataProvider = (*InterchainAccountPacketData)(nil)
+	_ ibcexported.PacketData                   = (*InterchainAccountPacketData)(nil)
+	_ ibcexported.PacketDataProvider           = (*InterchainAccountPacketData)(nil)
+	_ ibcexported.AdditionalPacketDataProvider = (*InterchainAccountPacketData)(nil)
 )
 
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
@@ -97,3 +101,26 @@ func (iapd InterchainAccountPacketData) GetCustomPacketData(key string) interfac
 
 	return memoData
 }
+
+// GetAdditionalPacketData decodes the results of the messages executed by the interchain account from a
+// successful acknowledgement. The message responses are returned as a []*codectypes.Any in the order the
+// messages were provided in the packet data.
+// If the packet data is not of type EXECUTE_TX, the acknowledgement is empty (timeout) or an error acknowledgement,
+// or the result cannot be decoded, then nil is returned.
+func (iapd InterchainAccountPacketData) GetAdditionalPacketData(acknowledgement []byte) interface{} {
+	if iapd.Type != EXECUTE_TX || len(acknowledgement) == 0 {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		return nil
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(ack.GetResult(), &txMsgData); err != nil {
+		return nil
+	}
+
+	return txMsgData.MsgResponses
+}
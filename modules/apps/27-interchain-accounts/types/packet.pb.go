@@ -33,16 +33,20 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Notify a controller chain of an event on an interchain accounts host chain
+	HOST_NOTIFICATION Type = 2
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_HOST_NOTIFICATION",
 }
 
 var Type_value = map[string]int32{
-	"TYPE_UNSPECIFIED": 0,
-	"TYPE_EXECUTE_TX":  1,
+	"TYPE_UNSPECIFIED":       0,
+	"TYPE_EXECUTE_TX":        1,
+	"TYPE_HOST_NOTIFICATION": 2,
 }
 
 func (x Type) String() string {
@@ -170,32 +174,34 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0xcd, 0xf8, 0x82, 0x3c, 0xe7, 0xc9, 0x7b, 0x25, 0xbc, 0x45, 0x8c, 0x10, 0xc2, 0x13, 0x31,
-	0x08, 0x99, 0xb1, 0x55, 0xd0, 0x85, 0x9b, 0xda, 0x46, 0xe8, 0x46, 0x4a, 0x4c, 0xa1, 0xba, 0x09,
-	0x93, 0xe9, 0x98, 0x0e, 0x36, 0x99, 0xd0, 0x99, 0x14, 0xf3, 0x07, 0xa5, 0x2b, 0x7f, 0xa0, 0x2b,
-	0x7f, 0xc6, 0x65, 0x97, 0x2e, 0xa5, 0xfd, 0x11, 0xc9, 0x04, 0xdb, 0x2e, 0x5c, 0xb8, 0x3b, 0x1c,
-	0xee, 0x39, 0xf7, 0x9e, 0x7b, 0xe0, 0x2b, 0x9e, 0x52, 0x4c, 0xca, 0x72, 0xc1, 0x29, 0x51, 0x5c,
-	0x14, 0x12, 0xf3, 0x42, 0xb1, 0x25, 0x9d, 0x13, 0x5e, 0x24, 0x84, 0x52, 0x51, 0x15, 0x4a, 0xe2,
-	0x55, 0x17, 0x97, 0x84, 0x7e, 0x65, 0x0a, 0x95, 0x4b, 0xa1, 0x84, 0xf5, 0x8c, 0xa7, 0x14, 0x9d,
-	0xab, 0xd0, 0x3f, 0x54, 0x68, 0xd5, 0x75, 0x1e, 0x65, 0x42, 0x64, 0x0b, 0x86, 0xb5, 0x2c, 0xad,
-	0xbe, 0x60, 0x52, 0xd4, 0xad, 0x87, 0x73, 0x9b, 0x89, 0x4c, 0x68, 0x88, 0x1b, 0xd4, 0xb2, 0x77,
-	0x6b, 0x00, 0x1f, 0x8f, 0x8e, 0x5e, 0xfd, 0xd6, 0x6a, 0xac, 0x77, 0x0f, 0x89, 0x22, 0x56, 0x1f,
-	0x9a, 0xaa, 0x2e, 0x99, 0x0d, 0x3c, 0xe0, 0x5f, 0xf7, 0x02, 0xf4, 0x9f, 0x87, 0xa0, 0xb8, 0x2e,
-	0x59, 0xa4, 0xa5, 0x96, 0x05, 0xcd, 0x19, 0x51, 0xc4, 0xbe, 0xe7, 0x01, 0xff, 0x61, 0xa4, 0x71,
-	0xc3, 0xe5, 0x2c, 0x17, 0xf6, 0x85, 0x07, 0xfc, 0x07, 0x91, 0xc6, 0x77, 0x6f, 0xe1, 0xe5, 0x40,
-	0xc8, 0x5c, 0xc8, 0xf8, 0x9b, 0xf5, 0x02, 0x5e, 0xe6, 0x4c, 0x4a, 0x92, 0x31, 0x69, 0x03, 0xef,
-	0xc2, 0xbf, 0xea, 0xdd, 0xa2, 0x36, 0x1a, 0xfa, 0x1b, 0x0d, 0xf5, 0x8b, 0x3a, 0x3a, 0x4e, 0x3d,
-	0x9f, 0x42, 0xb3, 0xd9, 0x69, 0x3d, 0x85, 0x9d, 0xf8, 0xd3, 0x38, 0x4c, 0x26, 0x1f, 0x3e, 0x8e,
-	0xc3, 0xc1, 0xe8, 0xfd, 0x28, 0x1c, 0x76, 0x0c, 0xe7, 0x66, 0xb3, 0xf5, 0xae, 0xce, 0x28, 0xeb,
-	0x09, 0xbc, 0xd1, 0x63, 0xe1, 0x34, 0x1c, 0x4c, 0xe2, 0x30, 0x89, 0xa7, 0x1d, 0xe0, 0x5c, 0x6f,
-	0xb6, 0x1e, 0x3c, 0x31, 0x8e, 0xb9, 0xfe, 0xe1, 0x1a, 0xef, 0x92, 0x9f, 0x7b, 0x17, 0xec, 0xf6,
-	0x2e, 0xf8, 0xbd, 0x77, 0xc1, 0xf7, 0x83, 0x6b, 0xec, 0x0e, 0xae, 0xf1, 0xeb, 0xe0, 0x1a, 0x9f,
-	0xc3, 0x8c, 0xab, 0x79, 0x95, 0x22, 0x2a, 0x72, 0x4c, 0xf5, 0xe9, 0x98, 0xa7, 0x34, 0xc8, 0x04,
-	0x5e, 0xbd, 0xc1, 0xb9, 0x98, 0x55, 0x0b, 0x26, 0x9b, 0xb2, 0x25, 0xee, 0xbd, 0x0e, 0x4e, 0x8f,
-	0x0a, 0x8e, 0x3d, 0x37, 0xff, 0x91, 0xe9, 0x7d, 0x1d, 0xe9, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf4, 0xb4, 0x4e, 0xa4, 0x1c, 0x02, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x8d, 0xb7, 0x0a, 0x0d, 0x0f, 0x6d, 0xc5, 0x1a, 0xa8, 0x04, 0x29, 0x8a, 0x86, 0x10, 0x15,
+	0x52, 0x6d, 0x5a, 0x90, 0xe0, 0xc0, 0xa5, 0x74, 0x99, 0xc8, 0xa5, 0xad, 0x32, 0x4f, 0x1a, 0x5c,
+	0x22, 0xc7, 0x33, 0x99, 0x45, 0x13, 0x47, 0xb3, 0x53, 0x91, 0x7f, 0x30, 0x95, 0x0b, 0x7f, 0xa0,
+	0x27, 0xfe, 0x0c, 0xc7, 0x1d, 0x39, 0xa2, 0xf6, 0x8f, 0xa0, 0x38, 0xa2, 0x9b, 0x04, 0x07, 0x6e,
+	0x4f, 0xcf, 0xdf, 0x7b, 0x9f, 0xdf, 0xd3, 0x07, 0x5f, 0xc9, 0x84, 0x13, 0x56, 0x14, 0x33, 0xc9,
+	0x99, 0x91, 0x2a, 0xd7, 0x44, 0xe6, 0x46, 0x5c, 0xf2, 0x0b, 0x26, 0xf3, 0x98, 0x71, 0xae, 0xca,
+	0xdc, 0x68, 0x32, 0xef, 0x93, 0x82, 0xf1, 0xcf, 0xc2, 0xe0, 0xe2, 0x52, 0x19, 0x85, 0x9e, 0xc9,
+	0x84, 0xe3, 0xdb, 0x2a, 0xfc, 0x0f, 0x15, 0x9e, 0xf7, 0xdd, 0x47, 0xa9, 0x52, 0xe9, 0x4c, 0x10,
+	0x2b, 0x4b, 0xca, 0x4f, 0x84, 0xe5, 0x55, 0xe3, 0xe1, 0x1e, 0xa4, 0x2a, 0x55, 0x16, 0x92, 0x1a,
+	0x35, 0xec, 0xe1, 0x15, 0x80, 0x8f, 0xc3, 0x8d, 0xd7, 0xb0, 0xb1, 0x9a, 0xda, 0xdd, 0x47, 0xcc,
+	0x30, 0x34, 0x84, 0x2d, 0x53, 0x15, 0xa2, 0x03, 0x7c, 0xd0, 0xdd, 0x1b, 0xf4, 0xf0, 0x7f, 0x7e,
+	0x04, 0xd3, 0xaa, 0x10, 0x91, 0x95, 0x22, 0x04, 0x5b, 0xe7, 0xcc, 0xb0, 0xce, 0x96, 0x0f, 0xba,
+	0xf7, 0x22, 0x8b, 0x6b, 0x2e, 0x13, 0x99, 0xea, 0x6c, 0xfb, 0xa0, 0x7b, 0x37, 0xb2, 0xf8, 0xf0,
+	0x2d, 0xdc, 0x19, 0x29, 0x9d, 0x29, 0x4d, 0xbf, 0xa0, 0x17, 0x70, 0x27, 0x13, 0x5a, 0xb3, 0x54,
+	0xe8, 0x0e, 0xf0, 0xb7, 0xbb, 0xbb, 0x83, 0x03, 0xdc, 0x44, 0xc3, 0x7f, 0xa2, 0xe1, 0x61, 0x5e,
+	0x45, 0x9b, 0xa9, 0xe7, 0x5f, 0x01, 0x6c, 0xd5, 0x4b, 0xd1, 0x53, 0xd8, 0xa6, 0x1f, 0xa6, 0x41,
+	0x7c, 0x3a, 0x3e, 0x99, 0x06, 0xa3, 0xf0, 0x38, 0x0c, 0x8e, 0xda, 0x8e, 0xbb, 0xbf, 0x58, 0xfa,
+	0xbb, 0xb7, 0x28, 0xf4, 0x04, 0xee, 0xdb, 0xb1, 0xe0, 0x2c, 0x18, 0x9d, 0xd2, 0x20, 0xa6, 0x67,
+	0x6d, 0xe0, 0xee, 0x2d, 0x96, 0x3e, 0xbc, 0x61, 0x50, 0x1f, 0x3e, 0xb4, 0x43, 0xef, 0x27, 0x27,
+	0x34, 0x1e, 0x4f, 0x68, 0x78, 0x1c, 0x8e, 0x86, 0x34, 0x9c, 0x8c, 0xdb, 0x5b, 0xee, 0x83, 0xc5,
+	0xd2, 0xbf, 0xff, 0xd7, 0x83, 0xdb, 0xba, 0xfa, 0xee, 0x39, 0xef, 0xe2, 0x1f, 0x2b, 0x0f, 0x5c,
+	0xaf, 0x3c, 0xf0, 0x6b, 0xe5, 0x81, 0x6f, 0x6b, 0xcf, 0xb9, 0x5e, 0x7b, 0xce, 0xcf, 0xb5, 0xe7,
+	0x7c, 0x0c, 0x52, 0x69, 0x2e, 0xca, 0x04, 0x73, 0x95, 0x11, 0x6e, 0xe3, 0x12, 0x99, 0xf0, 0x5e,
+	0xaa, 0xc8, 0xfc, 0x0d, 0xc9, 0xd4, 0x79, 0x39, 0x13, 0xba, 0x3e, 0x10, 0x4d, 0x06, 0xaf, 0x7b,
+	0x37, 0xe5, 0xf6, 0x36, 0xb7, 0x51, 0x77, 0xaa, 0x93, 0x3b, 0xb6, 0x86, 0x97, 0xbf, 0x07, 0x00,
+	0x80, 0x91, 0xf2, 0x83, 0x50, 0x02, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
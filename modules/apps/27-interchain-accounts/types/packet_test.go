@@ -3,7 +3,14 @@ package types_test
 import (
 	"fmt"
 
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -183,6 +190,80 @@ func (suite *TypesTestSuite) TestPacketDataProvider() {
 	}
 }
 
+func (suite *TypesTestSuite) TestGetAdditionalPacketData() {
+	msgResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	suite.Require().NoError(err)
+
+	result, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgResponse}})
+	suite.Require().NoError(err)
+
+	packetData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	testCases := []struct {
+		name            string
+		packetData      types.InterchainAccountPacketData
+		acknowledgement []byte
+		expPass         bool
+	}{
+		{
+			"success",
+			packetData,
+			channeltypes.NewResultAcknowledgement(result).Acknowledgement(),
+			true,
+		},
+		{
+			"failure: packet data type is not EXECUTE_TX",
+			types.InterchainAccountPacketData{
+				Type: types.UNSPECIFIED,
+				Data: []byte("data"),
+			},
+			channeltypes.NewResultAcknowledgement(result).Acknowledgement(),
+			false,
+		},
+		{
+			"failure: empty acknowledgement",
+			packetData,
+			nil,
+			false,
+		},
+		{
+			"failure: error acknowledgement",
+			packetData,
+			channeltypes.NewErrorAcknowledgement(types.ErrInvalidOutgoingData).Acknowledgement(),
+			false,
+		},
+		{
+			"failure: acknowledgement cannot be unmarshaled",
+			packetData,
+			[]byte("invalid acknowledgement"),
+			false,
+		},
+		{
+			"failure: result cannot be unmarshaled",
+			packetData,
+			channeltypes.NewResultAcknowledgement([]byte("invalid result")).Acknowledgement(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		additionalData := tc.packetData.GetAdditionalPacketData(tc.acknowledgement)
+		if tc.expPass {
+			msgResponses, ok := additionalData.([]*codectypes.Any)
+			suite.Require().True(ok, tc.name)
+			suite.Require().Len(msgResponses, 1, tc.name)
+			suite.Require().Equal(msgResponse.TypeUrl, msgResponses[0].TypeUrl, tc.name)
+		} else {
+			suite.Require().Nil(additionalData, tc.name)
+		}
+	}
+}
+
 func (suite *TypesTestSuite) TestPacketDataUnmarshalerInterface() {
 	expPacketData := types.InterchainAccountPacketData{
 		Type: types.EXECUTE_TX,
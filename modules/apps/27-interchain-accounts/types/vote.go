@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// ValidateWeightedVoteOptions validates the options of a weighted governance vote cast by an
+// interchain account. At least one option must be provided, every option must be a valid vote
+// option with a positive weight, no option may be repeated and the weights must sum to exactly one.
+func ValidateWeightedVoteOptions(options []*govv1.WeightedVoteOption) error {
+	if len(options) == 0 {
+		return errorsmod.Wrap(ErrInvalidWeightedVote, "vote options cannot be empty")
+	}
+
+	totalWeight := sdkmath.LegacyZeroDec()
+	usedOptions := make(map[govv1.VoteOption]bool, len(options))
+	for _, option := range options {
+		if option == nil {
+			return errorsmod.Wrap(ErrInvalidWeightedVote, "vote option cannot be nil")
+		}
+
+		if !govv1.ValidWeightedVoteOption(*option) {
+			return errorsmod.Wrapf(ErrInvalidWeightedVote, "invalid vote option %s with weight %s", option.Option, option.Weight)
+		}
+
+		if usedOptions[option.Option] {
+			return errorsmod.Wrapf(ErrInvalidWeightedVote, "duplicate vote option %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+
+		// the weight has been validated by ValidWeightedVoteOption
+		totalWeight = totalWeight.Add(sdkmath.LegacyMustNewDecFromStr(option.Weight))
+	}
+
+	if !totalWeight.Equal(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidWeightedVote, "total weight of vote options must be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// ValidateLegacyWeightedVoteOptions converts the options of a legacy weighted governance vote
+// and validates them using ValidateWeightedVoteOptions.
+func ValidateLegacyWeightedVoteOptions(options []govv1beta1.WeightedVoteOption) error {
+	converted := make([]*govv1.WeightedVoteOption, len(options))
+	for i, option := range options {
+		if option.Weight.IsNil() {
+			return errorsmod.Wrapf(ErrInvalidWeightedVote, "weight of vote option %s cannot be nil", option.Option)
+		}
+
+		converted[i] = govv1.NewWeightedVoteOption(govv1.VoteOption(option.Option), option.Weight)
+	}
+
+	return ValidateWeightedVoteOptions(converted)
+}
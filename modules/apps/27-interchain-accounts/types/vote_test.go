@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+)
+
+func (suite *TypesTestSuite) TestValidateWeightedVoteOptions() {
+	testCases := []struct {
+		name    string
+		options []*govv1.WeightedVoteOption
+		expErr  error
+	}{
+		{
+			"success: single option",
+			[]*govv1.WeightedVoteOption{
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyOneDec()),
+			},
+			nil,
+		},
+		{
+			"success: split vote",
+			[]*govv1.WeightedVoteOption{
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(6, 1)),
+				govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(3, 1)),
+				govv1.NewWeightedVoteOption(govv1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(1, 1)),
+			},
+			nil,
+		},
+		{
+			"failure: empty options",
+			nil,
+			types.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: nil option",
+			[]*govv1.WeightedVoteOption{nil},
+			types.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: invalid vote option",
+			[]*govv1.WeightedVoteOption{
+				govv1.NewWeightedVoteOption(govv1.OptionEmpty, sdkmath.LegacyOneDec()),
+			},
+			types.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: zero weight",
+			[]*govv1.WeightedVoteOption{
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyOneDec()),
+				govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyZeroDec()),
+			},
+			types.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: invalid weight",
+			[]*govv1.WeightedVoteOption{
+				{Option: govv1.OptionYes, Weight: "one"},
+			},
+			types.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: duplicate option",
+			[]*govv1.WeightedVoteOption{
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(5, 1)),
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(5, 1)),
+			},
+			types.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: total weight lower than one",
+			[]*govv1.WeightedVoteOption{
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(5, 1)),
+				govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(4, 1)),
+			},
+			types.ErrInvalidWeightedVote,
+		},
+		{
+			"failure: total weight greater than one",
+			[]*govv1.WeightedVoteOption{
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(6, 1)),
+				govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(6, 1)),
+			},
+			types.ErrInvalidWeightedVote,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := types.ValidateWeightedVoteOptions(tc.options)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestValidateLegacyWeightedVoteOptions() {
+	options := []govv1beta1.WeightedVoteOption{
+		{Option: govv1beta1.OptionYes, Weight: sdkmath.LegacyNewDecWithPrec(7, 1)},
+		{Option: govv1beta1.OptionNoWithVeto, Weight: sdkmath.LegacyNewDecWithPrec(3, 1)},
+	}
+	suite.Require().NoError(types.ValidateLegacyWeightedVoteOptions(options))
+
+	options = append(options, govv1beta1.WeightedVoteOption{Option: govv1beta1.OptionNo})
+	suite.Require().ErrorIs(types.ValidateLegacyWeightedVoteOptions(options), types.ErrInvalidWeightedVote)
+}
@@ -22,10 +22,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalAckFees(),
 		GetCmdTotalTimeoutFees(),
 		GetCmdIncentivizedPacketsForChannel(),
+		GetCmdEscrowedFeesByRefundAccount(),
 		GetCmdPayee(),
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdAggregatedPacketFees(),
+		GetCmdParams(),
 	)
 
 	return queryCmd
@@ -241,6 +241,52 @@ func GetCmdTotalTimeoutFees() *cobra.Command {
 	return cmd
 }
 
+// GetCmdEscrowedFeesByRefundAccount returns the command handler for the Query/EscrowedFeesByRefundAccount rpc.
+func GetCmdEscrowedFeesByRefundAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrowed-fees [refund-address]",
+		Short:   "Query the fees held in escrow on behalf of a refund address",
+		Long:    "Query the fees held in escrow on behalf of a refund address for a page of incentivized packets across all channels, along with their total",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee escrowed-fees cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowedFeesByRefundAccountRequest{
+				RefundAddress: args[0],
+				QueryHeight:   uint64(clientCtx.Height),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.EscrowedFeesByRefundAccount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrowed fees")
+
+	return cmd
+}
+
 // GetCmdPayee returns the command handler for the Query/Payee rpc.
 func GetCmdPayee() *cobra.Command {
 	cmd := &cobra.Command{
@@ -437,3 +483,79 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdAggregatedPacketFees returns the command handler for the Query/AggregatedPacketFees rpc.
+func GetCmdAggregatedPacketFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "aggregated-fees [port-id] [channel-id] [sequence]",
+		Short:   "Query the aggregated fees escrowed for a packet",
+		Long:    "Query the receive, acknowledgement and timeout fees summed over all the packet fees escrowed for a packet, along with the refund addresses of their payers",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee aggregated-fees transfer channel-5 100", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, channelID := args[0], args[1]
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packetID := channeltypes.NewPacketID(portID, channelID, seq)
+
+			if err := packetID.Validate(); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAggregatedPacketFeesRequest{
+				PacketId: packetID,
+			}
+
+			res, err := queryClient.AggregatedPacketFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the command handler for the Query/Params rpc.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc-fee parameters",
+		Long:    "Query the current ibc-fee parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
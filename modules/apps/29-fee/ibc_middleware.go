@@ -17,9 +17,10 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware               = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler    = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule         = (*IBCMiddleware)(nil)
+	_ porttypes.RecvPacketFailureHandler = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the fee middleware given the
@@ -237,6 +238,30 @@ func (im IBCMiddleware) OnRecvPacket(
 	return types.NewIncentivizedAcknowledgement(forwardRelayer, ack.Acknowledgement(), ack.Success())
 }
 
+// OnRecvPacketFailure forwards the callback to the underlying app if it implements the optional
+// RecvPacketFailureHandler interface. If fees are enabled, the underlying app acknowledgement is
+// extracted from the incentivized acknowledgement.
+func (im IBCMiddleware) OnRecvPacketFailure(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	gasUsed uint64,
+) {
+	handler, ok := im.app.(porttypes.RecvPacketFailureHandler)
+	if !ok {
+		return
+	}
+
+	if im.keeper.IsFeeEnabled(ctx, packet.DestinationPort, packet.DestinationChannel) {
+		var ack types.IncentivizedAcknowledgement
+		if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+			acknowledgement = ack.AppAcknowledgement
+		}
+	}
+
+	handler.OnRecvPacketFailure(ctx, packet, acknowledgement, gasUsed)
+}
+
 // OnAcknowledgementPacket implements the IBCMiddleware interface
 // If fees are not enabled, this callback will default to the ibc-core packet callback
 func (im IBCMiddleware) OnAcknowledgementPacket(
@@ -430,8 +455,12 @@ func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID str
 
 	versionMetadata, err := types.MetadataFromVersion(proposedVersion)
 	if err != nil {
-		// set fee disabled and passthrough to the next middleware or application in callstack.
-		im.keeper.DeleteFeeEnabled(ctx, portID, channelID)
+		// disable fees, refunding any outstanding escrowed fees and deleting relayer addresses registered for the channel,
+		// and passthrough to the next middleware or application in callstack.
+		if im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+			im.keeper.DisableFees(ctx, portID, channelID)
+		}
+
 		cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
 		return
 	}
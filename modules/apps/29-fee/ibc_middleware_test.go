@@ -1418,6 +1418,16 @@ func (suite *FeeTestSuite) TestOnChanUpgradeOpen() {
 
 				path.Setup()
 
+				// escrow a fee and register a payee for the channel which should be cleaned up on downgrade
+				packetID := channeltypes.NewPacketID(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				refundAddr := suite.chainA.SenderAccount.GetAddress()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAddr.String(), nil)}))
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAddr, types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), path.EndpointA.ChannelID)
+
 				// Assert in callback that correct version is passed
 				suite.chainA.GetSimApp().FeeMockModule.IBCApp.OnChanUpgradeOpen = func(_ sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) {
 					suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, portID)
@@ -1482,6 +1492,13 @@ func (suite *FeeTestSuite) TestOnChanUpgradeOpen() {
 				suite.Require().True(isFeeEnabled)
 			} else {
 				suite.Require().False(isFeeEnabled)
+
+				// all escrowed fees are refunded and registered payees are deleted
+				suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetIdentifiedPacketFeesForChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress()).IsZero())
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), path.EndpointA.ChannelID)
+				suite.Require().False(found)
 			}
 		})
 	}
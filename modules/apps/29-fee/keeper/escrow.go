@@ -25,11 +25,6 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 		return errorsmod.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", packetFee.RefundAddress)
 	}
 
-	coins := packetFee.Fee.Total()
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
-		return err
-	}
-
 	// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
 	// retrieve any previous fees stored in escrow for the packet and append them to the list
 	fees := []types.PacketFee{packetFee}
@@ -37,11 +32,23 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 		fees = append(fees, feesInEscrow.PacketFees...)
 	}
 
+	// bound the number of packet fees stored for a single packet to prevent state bloat
+	if params := k.GetParams(ctx); params.ExceedsMaxPacketFees(len(fees)) {
+		return errorsmod.Wrapf(types.ErrMaxPacketFeesExceeded, "%d packet fees are already escrowed for packet with sequence %d on port %s and channel %s, maximum is %d", len(fees)-1, packetID.Sequence, packetID.PortId, packetID.ChannelId, params.MaxPacketFees)
+	}
+
+	coins := packetFee.Fee.Total()
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+
 	packetFees := types.NewPacketFees(fees)
 	k.SetFeesInEscrow(ctx, packetID, packetFees)
 
 	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
 
+	incrFeeCounters(metricFeesEscrowed, packetID.PortId, packetID.ChannelId, coins)
+
 	return nil
 }
 
@@ -54,6 +61,7 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 	// forward relayer address will be empty if conversion fails
 	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
 
+	var distributed, refunded sdk.Coins
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -72,34 +80,45 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		paid, unused := k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		distributed = distributed.Add(paid...)
+		refunded = refunded.Add(unused...)
 	}
 
 	// write the cache
 	writeFn()
 
+	incrFeeCounters(metricFeesDistributed, packetID.PortId, packetID.ChannelId, distributed)
+	incrFeeCounters(metricFeesRefunded, packetID.PortId, packetID.ChannelId, refunded)
+
 	// removes the fees from the store as fees are now paid
 	k.DeleteFeesInEscrow(ctx, packetID)
 }
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
+// The fees distributed to relayers and the fees refunded to the refund account are returned.
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) (distributed, refunded sdk.Coins) {
+	var paid, unused sdk.Coins
+
 	// distribute fee to valid forward relayer address otherwise refund the fee
 	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
 		// distribute fee for forward relaying
-		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+		distributed, refunded = k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
 	} else {
 		// refund onRecv fee as forward relayer is not valid address
-		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
+		distributed, refunded = k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
 	}
 
 	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	paid, unused = k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	distributed, refunded = distributed.Add(paid...), refunded.Add(unused...)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
-	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+	paid, unused = k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+
+	return distributed.Add(paid...), refunded.Add(unused...)
 }
 
 // DistributePacketFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
@@ -108,6 +127,7 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
+	var distributed, refunded sdk.Coins
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -126,30 +146,42 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		paid, unused := k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		distributed = distributed.Add(paid...)
+		refunded = refunded.Add(unused...)
 	}
 
 	// write the cache
 	writeFn()
 
+	incrFeeCounters(metricFeesDistributed, packetID.PortId, packetID.ChannelId, distributed)
+	incrFeeCounters(metricFeesRefunded, packetID.PortId, packetID.ChannelId, refunded)
+
 	// removing the fee from the store as the fee is now paid
 	k.DeleteFeesInEscrow(ctx, packetID)
 }
 
 // distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+// The fees distributed to the relayer and the fees refunded to the refund account are returned.
+func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) (distributed, refunded sdk.Coins) {
 	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	distributed, refunded = k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
-	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+	paid, unused := k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+
+	return distributed.Add(paid...), refunded.Add(unused...)
 }
 
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
-// the state changes will be discarded.
-func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+// the state changes will be discarded and the fee is refunded to the refund address. If the
+// refund fails as well, the fee is sent to the fallback address parameter, or to the community
+// pool if no fallback address is set.
+// The fee is returned as distributed if it was sent to the receiver, or as refunded if it was
+// sent to the refund address. Fees sent to a receiver which is the refund address are refunded.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) (distributed, refunded sdk.Coins) {
 	// cache context before trying to distribute fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
@@ -157,7 +189,8 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 	if err != nil {
 		if bytes.Equal(receiver, refundAccAddress) {
 			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
-			return // if sending to the refund address already failed, then return (no-op)
+			k.distributeFeeToFallback(ctx, receiver, fee) // if sending to the refund address already failed, then send to the fallback
+			return nil, nil
 		}
 
 		// if an error is returned from x/bank and the receiver is not the refundAccAddress
@@ -165,16 +198,57 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
 		if err != nil {
 			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
-			return // if sending to the refund address fails, no-op
+			k.distributeFeeToFallback(ctx, receiver, fee) // if sending to the refund address fails, then send to the fallback
+			return nil, nil
 		}
 
 		emitDistributeFeeEvent(ctx, refundAccAddress.String(), fee)
+		refunded = fee
 	} else {
 		emitDistributeFeeEvent(ctx, receiver.String(), fee)
+		if bytes.Equal(receiver, refundAccAddress) {
+			refunded = fee
+		} else {
+			distributed = fee
+		}
+	}
+
+	// write the cache
+	writeFn()
+
+	return distributed, refunded
+}
+
+// distributeFeeToFallback sends a fee which could be distributed neither to its receiver nor to its refund address
+// to the fallback address parameter, or to the community pool if no fallback address is set, so that the fee is not
+// left locked in the fee module account. If this fails as well, the state changes are discarded and the fee is left
+// in the fee module account.
+func (k Keeper) distributeFeeToFallback(ctx sdk.Context, receiver sdk.AccAddress, fee sdk.Coins) {
+	// cache context before trying to send the fee to the fallback
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	var (
+		fallback string
+		err      error
+	)
+
+	if fallbackAddress := k.GetParams(ctx).FallbackAddress; fallbackAddress != "" {
+		fallback = fallbackAddress
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, sdk.MustAccAddressFromBech32(fallbackAddress), fee)
+	} else {
+		fallback = types.AttributeValueCommunityPool
+		err = k.distrKeeper.FundCommunityPool(cacheCtx, fee, k.authKeeper.GetModuleAddress(types.ModuleName))
+	}
+
+	if err != nil {
+		k.Logger(ctx).Error("error distributing fee to the fallback", "fallback", fallback, "fee", fee, "error", err)
+		return
 	}
 
 	// write the cache
 	writeFn()
+
+	emitDistributeFeeFallbackEvent(ctx, receiver.String(), fallback, fee)
 }
 
 // RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
@@ -182,12 +256,38 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 // of a severe bug. When the fee module is locked, no fee distributions will be performed.
 // Please see ADR 004 for more information.
 func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID string) error {
+	k.refundFeesForChannel(ctx, portID, channelID)
+	return nil
+}
+
+// DisableFees disables fee incentivization for the given port and channel identifiers. It is used when a channel
+// is upgraded to a version which does not include the fee middleware. All fees held in escrow for the channel are
+// refunded and any payee, counterparty payee and forward relayer addresses registered for the channel are deleted.
+// Escrowed fees are not refunded while the fee module is locked.
+func (k Keeper) DisableFees(ctx sdk.Context, portID, channelID string) {
+	var refunded sdk.Coins
+	if !k.IsLocked(ctx) {
+		refunded = k.refundFeesForChannel(ctx, portID, channelID)
+	}
+
+	k.DeleteRelayerAddressesForChannel(ctx, portID, channelID)
+	k.DeleteFeeEnabled(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("fee incentivization disabled for channel", "port-id", portID, "channel-id", channelID, "refunded", refunded)
+
+	emitFeeDisabledEvent(ctx, portID, channelID, refunded)
+}
+
+// refundFeesForChannel refunds all fees held in escrow for the given port and channel identifiers and returns
+// the total amount refunded. It locks the fee module if the escrow account has insufficient balance.
+func (k Keeper) refundFeesForChannel(ctx sdk.Context, portID, channelID string) sdk.Coins {
 	identifiedPacketFees := k.GetIdentifiedPacketFeesForChannel(ctx, portID, channelID)
 
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
+	var refunded sdk.Coins
 	for _, identifiedPacketFee := range identifiedPacketFees {
 		var unRefundedFees []types.PacketFee
 		for _, packetFee := range identifiedPacketFee.PacketFees {
@@ -201,7 +301,7 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 				// locking the fee module are persisted
 				k.lockFeeModule(ctx)
 
-				// return a nil error so state changes are committed but distribution stops
+				// return without writing the cache so the lock is committed but no refunds are performed
 				return nil
 			}
 
@@ -216,6 +316,8 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 				unRefundedFees = append(unRefundedFees, packetFee)
 				continue
 			}
+
+			refunded = refunded.Add(packetFee.Fee.Total()...)
 		}
 
 		if len(unRefundedFees) > 0 {
@@ -230,5 +332,7 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 	// write the cache
 	writeFn()
 
-	return nil
+	incrFeeCounters(metricFeesRefunded, portID, channelID, refunded)
+
+	return refunded
 }
@@ -192,7 +192,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 			},
 		},
 		{
-			"invalid refund address: no-op, timeout_fee - (recv_fee + ack_fee) remains in escrow",
+			"invalid refund address: timeout_fee - (recv_fee + ack_fee) sent to community pool",
 			func() {
 				// set the timeout fee to be greater than recv + ack fee so that the refund amount is non-zero
 				fee.TimeoutFee = fee.Total().Add(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
@@ -204,11 +204,14 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				packetFees[1].RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress().String()
 			},
 			func() {
-				// check if the module acc contains the timeoutFee
+				// check if the module acc is empty and the community pool received the refund amount
 				refundCoins := fee.Total().Sub(defaultRecvFee[0]).Sub(defaultAckFee[0]).MulInt(sdkmath.NewInt(2))
-				expectedModuleAccBal := sdk.NewCoin(sdk.DefaultBondDenom, refundCoins.AmountOf(sdk.DefaultBondDenom))
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
-				suite.Require().Equal(expectedModuleAccBal, balance)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt()), balance)
+
+				feePool, err := suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(suite.chainA.GetContext())
+				suite.Require().NoError(err)
+				suite.Require().True(feePool.CommunityPool.AmountOf(sdk.DefaultBondDenom).GTE(sdkmath.LegacyNewDecFromInt(refundCoins.AmountOf(sdk.DefaultBondDenom))))
 			},
 		},
 	}
@@ -247,6 +250,121 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestDistributeFeeAmounts() {
+	var (
+		receiver        sdk.AccAddress
+		refundAcc       sdk.AccAddress
+		fallbackAddress sdk.AccAddress
+	)
+
+	fee := defaultRecvFee
+
+	testCases := []struct {
+		name             string
+		malleate         func()
+		expDistributed   sdk.Coins
+		expRefunded      sdk.Coins
+		expCommunityPool sdk.Coins
+		expFallback      sdk.Coins
+	}{
+		{
+			"fee distributed to receiver",
+			func() {},
+			fee,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"fee refunded: receiver is the refund address",
+			func() {
+				receiver = refundAcc
+			},
+			nil,
+			fee,
+			nil,
+			nil,
+		},
+		{
+			"fee refunded: receiver is blocked",
+			func() {
+				receiver = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress()
+			},
+			nil,
+			fee,
+			nil,
+			nil,
+		},
+		{
+			"fee sent to community pool: receiver and refund address are blocked",
+			func() {
+				receiver = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress()
+				refundAcc = receiver
+			},
+			nil,
+			nil,
+			fee,
+			nil,
+		},
+		{
+			"fee sent to fallback address: receiver and refund address are blocked",
+			func() {
+				receiver = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress()
+				refundAcc = receiver
+
+				params := types.NewParams(types.DefaultMaxPacketFees, fallbackAddress.String())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			nil,
+			nil,
+			nil,
+			fee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			receiver = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			fallbackAddress = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			feePool, err := suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			communityPoolBefore := feePool.CommunityPool
+			fallbackBalBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, fallbackAddress)
+
+			distributed, refunded := suite.chainA.GetSimApp().IBCFeeKeeper.DistributeFee(ctx, receiver, refundAcc, fee)
+			suite.Require().Equal(tc.expDistributed, distributed)
+			suite.Require().Equal(tc.expRefunded, refunded)
+
+			feePool, err = suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(communityPoolBefore.Add(sdk.NewDecCoinsFromCoins(tc.expCommunityPool...)...), feePool.CommunityPool)
+
+			fallbackBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, fallbackAddress)
+			suite.Require().Equal(fallbackBalBefore.Add(tc.expFallback...), fallbackBal)
+
+			expFallbackEvent := !tc.expCommunityPool.IsZero() || !tc.expFallback.IsZero()
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeDistributeFeeFallback {
+					found = true
+				}
+			}
+			suite.Require().Equal(expFallbackEvent, found)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 	var (
 		timeoutRelayer    sdk.AccAddress
@@ -337,7 +455,7 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 			},
 		},
 		{
-			"invalid refund address: no-op, (recv_fee + ack_fee) - timeout_fee remain in escrow",
+			"invalid refund address: (recv_fee + ack_fee) - timeout_fee sent to community pool",
 			func() {
 				// set the recv + ack fee to be greater than timeout fee so that the refund amount is non-zero
 				fee.RecvFee = fee.RecvFee.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
@@ -348,12 +466,14 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				packetFees[1].RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress().String()
 			},
 			func() {
-				// check if the module acc contains the correct amount of fees
+				// check if the module acc is empty and the community pool received the refund amount
 				refundCoins := fee.Total().Sub(defaultTimeoutFee[0]).MulInt(sdkmath.NewInt(2))
-
-				expectedModuleAccBal := refundCoins[0]
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
-				suite.Require().Equal(expectedModuleAccBal, balance)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt()), balance)
+
+				feePool, err := suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(suite.chainA.GetContext())
+				suite.Require().NoError(err)
+				suite.Require().True(feePool.CommunityPool.AmountOf(sdk.DefaultBondDenom).GTE(sdkmath.LegacyNewDecFromInt(refundCoins.AmountOf(sdk.DefaultBondDenom))))
 			},
 		},
 	}
@@ -573,3 +693,99 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDisableFees() {
+	var (
+		packetID  channeltypes.PacketId
+		refundAcc sdk.AccAddress
+		fee       types.Fee
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expRefunds bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: fee module locked, escrowed fees are not refunded", func() {
+				lockFeeModule(suite.chainA)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			ctx := suite.chainA.GetContext()
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			portID, channelID := suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID
+
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			moduleAcc := feeKeeper.GetFeeModuleAddress()
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			// escrow a fee for a packet on the channel
+			packetID = channeltypes.NewPacketID(portID, channelID, 1)
+			feeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			// register relayer addresses for the channel and for a different channel
+			relayer := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			payee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+			feeKeeper.SetPayeeAddress(ctx, relayer, payee, channelID)
+			feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer, payee, channelID)
+			feeKeeper.SetRelayerAddressForAsyncAck(ctx, packetID, relayer)
+
+			otherPacketID := channeltypes.NewPacketID(portID, "channel-100", 1)
+			feeKeeper.SetPayeeAddress(ctx, relayer, payee, otherPacketID.ChannelId)
+			feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer, payee, otherPacketID.ChannelId)
+			feeKeeper.SetRelayerAddressForAsyncAck(ctx, otherPacketID, relayer)
+
+			tc.malleate()
+
+			originalRefundBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc)
+			originalEscrowBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, moduleAcc)
+
+			feeKeeper.DisableFees(ctx, portID, channelID)
+
+			suite.Require().False(feeKeeper.IsFeeEnabled(ctx, portID, channelID))
+
+			refundBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc)
+			escrowBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, moduleAcc)
+
+			if tc.expRefunds {
+				suite.Require().False(feeKeeper.HasFeesInEscrow(ctx, packetID))
+				suite.Require().Equal(originalRefundBal.Add(fee.Total()...), refundBal)
+				suite.Require().Equal(originalEscrowBal.Sub(fee.Total()...), escrowBal)
+			} else {
+				suite.Require().True(feeKeeper.HasFeesInEscrow(ctx, packetID))
+				suite.Require().Equal(originalRefundBal, refundBal)
+				suite.Require().Equal(originalEscrowBal, escrowBal)
+			}
+
+			// relayer addresses for the channel are deleted regardless of refunds
+			_, found := feeKeeper.GetPayeeAddress(ctx, relayer, channelID)
+			suite.Require().False(found)
+			_, found = feeKeeper.GetCounterpartyPayeeAddress(ctx, relayer, channelID)
+			suite.Require().False(found)
+			_, found = feeKeeper.GetRelayerAddressForAsyncAck(ctx, packetID)
+			suite.Require().False(found)
+
+			// relayer addresses for other channels are unaffected
+			_, found = feeKeeper.GetPayeeAddress(ctx, relayer, otherPacketID.ChannelId)
+			suite.Require().True(found)
+			_, found = feeKeeper.GetCounterpartyPayeeAddress(ctx, relayer, otherPacketID.ChannelId)
+			suite.Require().True(found)
+			_, found = feeKeeper.GetRelayerAddressForAsyncAck(ctx, otherPacketID)
+			suite.Require().True(found)
+		})
+	}
+}
@@ -76,6 +76,23 @@ func emitRegisterCounterpartyPayeeEvent(ctx sdk.Context, relayer, counterpartyPa
 	})
 }
 
+// emitDistributeFeeFallbackEvent emits an event containing a fee which could be distributed neither to its
+// receiver nor to its refund address, the intended receiver address and the fallback the fee was sent to
+func emitDistributeFeeFallbackEvent(ctx sdk.Context, receiver, fallback string, fee sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDistributeFeeFallback,
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyFallback, fallback),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
 // emitDistributeFeeEvent emits an event containing a distribution fee and receiver address
 func emitDistributeFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -90,3 +107,20 @@ func emitDistributeFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
 		),
 	})
 }
+
+// emitFeeDisabledEvent emits an event containing the port and channel identifiers of a channel which was downgraded
+// to a non fee enabled version and the total amount of escrowed fees refunded as a result
+func emitFeeDisabledEvent(ctx sdk.Context, portID, channelID string, refunded sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeeDisabled,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyRefundedFee, refunded.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
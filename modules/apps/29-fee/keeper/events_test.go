@@ -92,14 +92,8 @@ func (suite *KeeperTestSuite) TestIncentivizePacketEvent() {
 
 func (suite *KeeperTestSuite) TestDistributeFeeEvent() {
 	// create an incentivized transfer path
-	path := ibctesting.NewPath(suite.chainA, suite.chainB)
-	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
-	path.EndpointA.ChannelConfig.Version = feeTransferVersion
-	path.EndpointB.ChannelConfig.Version = feeTransferVersion
-	path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
-	path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
-
-	path.Setup()
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.SetupWithFee()
 
 	// send a new MsgPayPacketFee and MsgTransfer to chainA
 	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
@@ -10,3 +10,8 @@ import (
 func LegacyTotal(f types.Fee) sdk.Coins {
 	return legacyTotal(f)
 }
+
+// DistributeFee is a wrapper for the distributeFee function for testing.
+func (k Keeper) DistributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) (sdk.Coins, sdk.Coins) {
+	return k.distributeFee(ctx, receiver, refundAccAddress, fee)
+}
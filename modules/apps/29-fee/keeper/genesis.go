@@ -8,6 +8,8 @@ import (
 
 // InitGenesis initializes the fee middleware application state from a provided genesis state
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+
 	for _, identifiedFees := range state.IdentifiedFees {
 		k.SetFeesInEscrow(ctx, identifiedFees.PacketId, types.NewPacketFees(identifiedFees.PacketFees))
 	}
@@ -37,5 +39,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		Params:                       k.GetParams(ctx),
 	}
 }
@@ -42,6 +42,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		Params: types.NewParams(10, ""),
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -64,6 +65,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check params
+	suite.Require().Equal(genesisState.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -123,4 +127,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check params
+	suite.Require().Equal(types.DefaultParams(), genesisState.Params)
 }
@@ -192,6 +192,65 @@ func (k Keeper) TotalTimeoutFees(goCtx context.Context, req *types.QueryTotalTim
 	}, nil
 }
 
+// EscrowedFeesByRefundAccount implements the Query/EscrowedFeesByRefundAccount gRPC method and returns the fees
+// held in escrow on behalf of the provided refund address for a page of incentivized packets, along with their
+// total. As fees in escrow are not indexed by refund address, the query filters the fees of all incentivized
+// packets and its cost grows with the number of packets iterated to fill the page.
+func (k Keeper) EscrowedFeesByRefundAccount(goCtx context.Context, req *types.QueryEscrowedFeesByRefundAccountRequest) (*types.QueryEscrowedFeesByRefundAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.RefundAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid refund address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(int64(req.QueryHeight))
+
+	var (
+		totalEscrowedFees   sdk.Coins
+		incentivizedPackets []types.IdentifiedPacketFees
+	)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeesInEscrowPrefix))
+	pagination, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var packetFees []types.PacketFee
+		for _, packetFee := range k.MustUnmarshalFees(value).PacketFees {
+			if packetFee.RefundAddress == req.RefundAddress {
+				packetFees = append(packetFees, packetFee)
+			}
+		}
+
+		if len(packetFees) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			packetID, err := types.ParseKeyFeesInEscrow(types.FeesInEscrowPrefix + string(key))
+			if err != nil {
+				return false, err
+			}
+
+			for _, packetFee := range packetFees {
+				totalEscrowedFees = totalEscrowedFees.Add(packetFee.Fee.Total()...)
+			}
+
+			incentivizedPackets = append(incentivizedPackets, types.NewIdentifiedPacketFees(packetID, packetFees))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryEscrowedFeesByRefundAccountResponse{
+		TotalEscrowedFees:   totalEscrowedFees,
+		IncentivizedPackets: incentivizedPackets,
+		Pagination:          pagination,
+	}, nil
+}
+
 // Payee implements the Query/Payee gRPC method and returns the registered payee address to which packet fees are paid out
 func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*types.QueryPayeeResponse, error) {
 	if req == nil {
@@ -289,3 +348,54 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// AggregatedPacketFees implements the Query/AggregatedPacketFees gRPC method and returns the receive, acknowledgement
+// and timeout fees summed over all the packet fees escrowed for a packet, along with the refund addresses of their payers
+func (k Keeper) AggregatedPacketFees(goCtx context.Context, req *types.QueryAggregatedPacketFeesRequest) (*types.QueryAggregatedPacketFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, req.PacketId)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrFeeNotFound, "channel: %s, port: %s, sequence: %d", req.PacketId.ChannelId, req.PacketId.PortId, req.PacketId.Sequence).Error(),
+		)
+	}
+
+	var (
+		totalFee        types.Fee
+		refundAddresses []string
+		seenAddresses   = make(map[string]bool)
+	)
+
+	for _, packetFee := range feesInEscrow.PacketFees {
+		totalFee.RecvFee = totalFee.RecvFee.Add(packetFee.Fee.RecvFee...)
+		totalFee.AckFee = totalFee.AckFee.Add(packetFee.Fee.AckFee...)
+		totalFee.TimeoutFee = totalFee.TimeoutFee.Add(packetFee.Fee.TimeoutFee...)
+
+		if !seenAddresses[packetFee.RefundAddress] {
+			seenAddresses[packetFee.RefundAddress] = true
+			refundAddresses = append(refundAddresses, packetFee.RefundAddress)
+		}
+	}
+
+	return &types.QueryAggregatedPacketFeesResponse{
+		TotalFee:        totalFee,
+		PacketFeeCount:  uint64(len(feesInEscrow.PacketFees)),
+		RefundAddresses: refundAddresses,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method and returns the fee middleware parameters
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
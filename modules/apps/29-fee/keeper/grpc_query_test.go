@@ -499,6 +499,120 @@ func (suite *KeeperTestSuite) TestQueryTotalTimeoutFees() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryEscrowedFeesByRefundAccount() {
+	var (
+		req          *types.QueryEscrowedFeesByRefundAccountRequest
+		expTotalFees sdk.Coins
+		expPackets   []types.IdentifiedPacketFees
+		expTotal     uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				}
+
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				expTotalFees = sdk.NewCoins().Add(fee.Total()...).Add(fee.Total()...)
+				expPackets = expPackets[:1]
+			},
+			true,
+		},
+		{
+			"success: no fees escrowed by refund address",
+			func() {
+				req.RefundAddress = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+
+				expTotalFees = nil
+				expPackets = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				req.RefundAddress = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+			otherRefundAddr := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee := types.NewPacketFee(fee, refundAddr, []string(nil))
+			otherPacketFee := types.NewPacketFee(fee, otherRefundAddr, []string(nil))
+
+			expTotalFees = nil
+			expPackets = nil
+			for _, channelID := range []string{ibctesting.FirstChannelID, "channel-1"} {
+				packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, channelID, 1)
+				packetFees := types.NewPacketFees([]types.PacketFee{packetFee, otherPacketFee, packetFee})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, packetFees)
+
+				expTotalFees = expTotalFees.Add(fee.Total()...).Add(fee.Total()...)
+				expPackets = append(expPackets, types.NewIdentifiedPacketFees(packetID, []types.PacketFee{packetFee, packetFee}))
+			}
+
+			// fees escrowed only by another refund address are filtered out
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, "channel-2", 1)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{otherPacketFee}))
+
+			expTotal = 2
+
+			req = &types.QueryEscrowedFeesByRefundAccountRequest{
+				RefundAddress: refundAddr,
+				QueryHeight:   0,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.EscrowedFeesByRefundAccount(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTotalFees, res.TotalEscrowedFees)
+				suite.Require().Equal(expPackets, res.IncentivizedPackets)
+
+				if req.Pagination != nil && req.Pagination.CountTotal {
+					suite.Require().Equal(expTotal, res.Pagination.Total)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryPayee() {
 	var req *types.QueryPayeeRequest
 
@@ -846,3 +960,87 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryAggregatedPacketFees() {
+	var req *types.QueryAggregatedPacketFeesRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"packet not found",
+			func() {
+				req.PacketId = channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 100)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), []string(nil))
+			packetFee2 := types.NewPacketFee(fee, suite.chainB.SenderAccount.GetAddress().String(), []string(nil))
+
+			packetFees := []types.PacketFee{packetFee, packetFee2, packetFee}
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+
+			req = &types.QueryAggregatedPacketFeesRequest{
+				PacketId: packetID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.AggregatedPacketFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// expected totals are three times the default fees
+				expectedFee := types.NewFee(
+					defaultRecvFee.Add(defaultRecvFee...).Add(defaultRecvFee...),
+					defaultAckFee.Add(defaultAckFee...).Add(defaultAckFee...),
+					defaultTimeoutFee.Add(defaultTimeoutFee...).Add(defaultTimeoutFee...),
+				)
+				suite.Require().Equal(expectedFee, res.TotalFee)
+				suite.Require().Equal(uint64(3), res.PacketFeeCount)
+
+				expectedRefundAddresses := []string{suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String()}
+				suite.Require().Equal(expectedRefundAddresses, res.RefundAddresses)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+	expParams := types.DefaultParams()
+	res, _ := suite.chainA.GetSimApp().IBCFeeKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, &res.Params)
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// RegisterIncentivizedSequence escrows the provided packet fee for a packet sent by an application which is
+// not wrapped by the fee middleware. The caller must provide the capability of the channel the packet was sent on,
+// as is required to send the packet. Only packets which have been sent and have not gone through the packet
+// life cycle may be incentivized. Channels which are fee enabled are not supported, fees for packets sent on
+// fee enabled channels must be escrowed using MsgPayPacketFee or MsgPayPacketFeeAsync.
+//
+// Applications registering incentivized sequences are responsible for calling SettleIncentive when the packet
+// is acknowledged or timed out, and RefundFeesOnChannelClosure when the channel is closed.
+func (k Keeper) RegisterIncentivizedSequence(ctx sdk.Context, chanCap *capabilitytypes.Capability, packetID channeltypes.PacketId, packetFee types.PacketFee) error {
+	if k.IsFeeEnabled(ctx, packetID.PortId, packetID.ChannelId) {
+		return errorsmod.Wrapf(types.ErrUnsupportedAction, "fees for packets on fee enabled channel %s must be escrowed through the fee middleware", packetID.ChannelId)
+	}
+
+	if k.IsLocked(ctx) {
+		return types.ErrFeeModuleLocked
+	}
+
+	if err := packetID.Validate(); err != nil {
+		return err
+	}
+
+	if err := packetFee.Validate(); err != nil {
+		return err
+	}
+
+	refundAcc, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, packetFee.Fee.Total()...); err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(refundAcc) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAcc)
+	}
+
+	nextSeqSend, found := k.GetNextSequenceSend(ctx, packetID.PortId, packetID.ChannelId)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrSequenceSendNotFound, "channel does not exist, portID: %s, channelID: %s", packetID.PortId, packetID.ChannelId)
+	}
+
+	if err := k.authenticateChannelCapability(ctx, chanCap, packetID.PortId, packetID.ChannelId); err != nil {
+		return err
+	}
+
+	// only allow incentivizing of packets which have been sent
+	if packetID.Sequence >= nextSeqSend {
+		return channeltypes.ErrPacketNotSent
+	}
+
+	// only allow incentivizing of packets which have not completed the packet life cycle
+	if bz := k.GetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence); len(bz) == 0 {
+		return errorsmod.Wrapf(channeltypes.ErrPacketCommitmentNotFound, "packet has already been acknowledged or timed out")
+	}
+
+	return k.escrowPacketFee(ctx, packetID, packetFee)
+}
+
+// SettleIncentive distributes the fees escrowed using RegisterIncentivizedSequence for the given packet. If the
+// packet timed out, the timeout fee is paid to the relayer and the remaining fees are refunded. Otherwise, the
+// receive fee is paid to the forward relayer, the acknowledgement fee is paid to the relayer and the timeout fee
+// is refunded. The receive fee is refunded if the forward relayer is empty or invalid.
+//
+// The caller must provide the capability of the channel the packet was sent on. SettleIncentive is a no-op if
+// no fees are escrowed for the packet or if the fee module is locked, in which case the fees remain in escrow.
+func (k Keeper) SettleIncentive(ctx sdk.Context, chanCap *capabilitytypes.Capability, packetID channeltypes.PacketId, forwardRelayer string, relayer sdk.AccAddress, timedOut bool) error {
+	if k.IsFeeEnabled(ctx, packetID.PortId, packetID.ChannelId) {
+		return errorsmod.Wrapf(types.ErrUnsupportedAction, "fees for packets on fee enabled channel %s are distributed by the fee middleware", packetID.ChannelId)
+	}
+
+	if err := k.authenticateChannelCapability(ctx, chanCap, packetID.PortId, packetID.ChannelId); err != nil {
+		return err
+	}
+
+	// a locked fee module simply skips fee logic, as is done by the fee middleware
+	if k.IsLocked(ctx) {
+		return nil
+	}
+
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return nil
+	}
+
+	if timedOut {
+		k.DistributePacketFeesOnTimeout(ctx, relayer, feesInEscrow.PacketFees, packetID)
+	} else {
+		k.DistributePacketFeesOnAcknowledgement(ctx, forwardRelayer, relayer, feesInEscrow.PacketFees, packetID)
+	}
+
+	return nil
+}
+
+// authenticateChannelCapability returns an error if the provided capability is not the capability of the channel
+// defined by the port and channel identifiers. Capabilities are unforgeable object references, thus the capability
+// is authenticated by comparing it with the channel capability owned by core IBC.
+func (k Keeper) authenticateChannelCapability(ctx sdk.Context, chanCap *capabilitytypes.Capability, portID, channelID string) error {
+	_, capability, err := k.channelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil || chanCap == nil || capability != chanCap {
+		return errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// sendTransferPacket sends a transfer packet from chainA on the provided path and returns it.
+func (suite *KeeperTestSuite) sendTransferPacket(path *ibctesting.Path) channeltypes.Packet {
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, "")
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *KeeperTestSuite) TestRegisterIncentivizedSequence() {
+	var (
+		path      *ibctesting.Path
+		packet    channeltypes.Packet
+		packetID  channeltypes.PacketId
+		packetFee types.PacketFee
+		chanCap   *capabilitytypes.Capability
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: channel is fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId)
+			},
+			types.ErrUnsupportedAction,
+		},
+		{
+			"failure: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"failure: invalid packet fee",
+			func() {
+				packetFee.Fee.RecvFee = nil
+				packetFee.Fee.AckFee = nil
+				packetFee.Fee.TimeoutFee = nil
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"failure: channel does not exist",
+			func() {
+				packetID.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrSequenceSendNotFound,
+		},
+		{
+			"failure: packet not sent",
+			func() {
+				packetID.Sequence++
+			},
+			channeltypes.ErrPacketNotSent,
+		},
+		{
+			"failure: caller does not own the channel capability",
+			func() {
+				chanCap = capabilitytypes.NewCapability(chanCap.Index + 1)
+			},
+			channeltypes.ErrChannelCapabilityNotFound,
+		},
+		{
+			"failure: packet already acknowledged",
+			func() {
+				err := path.RelayPacket(packet)
+				suite.Require().NoError(err)
+			},
+			channeltypes.ErrPacketCommitmentNotFound,
+		},
+		{
+			"failure: refund account does not exist",
+			func() {
+				packetFee.RefundAddress = sdk.AccAddress("refund-does-not-exist").String()
+			},
+			types.ErrRefundAccNotFound,
+		},
+		{
+			"failure: refund account is blocked",
+			func() {
+				packetFee.RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packet = suite.sendTransferPacket(path)
+			packetID = channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee = types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			chanCap = suite.chainA.GetChannelCapability(packetID.PortId, packetID.ChannelId)
+
+			tc.malleate()
+
+			escrowBalanceBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterIncentivizedSequence(suite.chainA.GetContext(), chanCap, packetID, packetFee)
+
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.PacketFee{packetFee}, feesInEscrow.PacketFees)
+				suite.Require().Equal(escrowBalanceBefore.Add(fee.Total()...), escrowBalance)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+				suite.Require().Equal(escrowBalanceBefore, escrowBalance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSettleIncentive() {
+	var (
+		packetID        channeltypes.PacketId
+		chanCap         *capabilitytypes.Capability
+		forwardRelayer  string
+		timedOut        bool
+		expRefund       sdk.Coins
+		expForwardFee   sdk.Coins
+		expRelayerFee   sdk.Coins
+		expFeesInEscrow bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: packet acknowledged",
+			func() {},
+			nil,
+		},
+		{
+			"success: packet acknowledged without forward relayer",
+			func() {
+				forwardRelayer = ""
+				expRefund = defaultRecvFee
+				expForwardFee = sdk.NewCoins()
+			},
+			nil,
+		},
+		{
+			"success: packet timed out",
+			func() {
+				timedOut = true
+				expForwardFee = sdk.NewCoins()
+				expRelayerFee = defaultTimeoutFee
+			},
+			nil,
+		},
+		{
+			"success: no fees escrowed for packet",
+			func() {
+				packetID.Sequence++
+				expForwardFee = sdk.NewCoins()
+				expRelayerFee = sdk.NewCoins()
+			},
+			nil,
+		},
+		{
+			"success: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+				expForwardFee = sdk.NewCoins()
+				expRelayerFee = sdk.NewCoins()
+				expFeesInEscrow = true
+			},
+			nil,
+		},
+		{
+			"failure: channel is fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId)
+			},
+			types.ErrUnsupportedAction,
+		},
+		{
+			"failure: caller does not own the channel capability",
+			func() {
+				chanCap = capabilitytypes.NewCapability(chanCap.Index + 1)
+			},
+			channeltypes.ErrChannelCapabilityNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packet := suite.sendTransferPacket(path)
+			packetID = channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+			refundAddr := suite.chainA.SenderAccount.GetAddress()
+			relayerAddr := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			forwardAddr := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			chanCap = suite.chainA.GetChannelCapability(packetID.PortId, packetID.ChannelId)
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterIncentivizedSequence(suite.chainA.GetContext(), chanCap, packetID, types.NewPacketFee(fee, refundAddr.String(), nil))
+			suite.Require().NoError(err)
+
+			forwardRelayer = forwardAddr.String()
+			timedOut = false
+			// the escrowed total equals the sum of the receive and acknowledgement fees, nothing is refunded
+			expRefund = sdk.NewCoins()
+			expForwardFee = defaultRecvFee
+			expRelayerFee = defaultAckFee
+			expFeesInEscrow = false
+
+			tc.malleate()
+
+			refundBalBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), refundAddr)
+			relayerBalBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayerAddr)
+			forwardBalBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), forwardAddr)
+
+			err = suite.chainA.GetSimApp().IBCFeeKeeper.SettleIncentive(suite.chainA.GetContext(), chanCap, packetID, forwardRelayer, relayerAddr, timedOut)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(expFeesInEscrow, suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+				refundBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), refundAddr)
+				suite.Require().Equal(refundBalBefore.Add(expRefund...), refundBal)
+
+				relayerBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), relayerAddr)
+				suite.Require().Equal(relayerBalBefore.Add(expRelayerFee...), relayerBal)
+
+				forwardBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), forwardAddr)
+				suite.Require().Equal(forwardBalBefore.Add(expForwardFee...), forwardBal)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"errors"
+	"strings"
+
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -31,15 +34,26 @@ type Keeper struct {
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
-// NewKeeper creates a new 29-fee Keeper instance
+// NewKeeper creates a new 29-fee Keeper instance. WrapAsyncAcknowledgement is registered with the provided
+// channel keeper, so that asynchronous acknowledgements written on fee enabled channels are incentivized.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper, authority string,
 ) Keeper {
-	return Keeper{
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	k := Keeper{
 		cdc:           cdc,
 		storeKey:      key,
 		ics4Wrapper:   ics4Wrapper,
@@ -47,7 +61,15 @@ func NewKeeper(
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		authority:     authority,
 	}
+
+	// wrap asynchronous acknowledgements written on fee enabled channels in incentivized acknowledgements,
+	// regardless of the position of the fee middleware in the ICS4Wrapper stack
+	channelKeeper.RegisterAcknowledgementWrapper(k.WrapAsyncAcknowledgement)
+
+	return k
 }
 
 // WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
@@ -62,6 +84,11 @@ func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// GetAuthority returns the 29-fee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
@@ -93,6 +120,16 @@ func (k Keeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (
 	return k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
 }
 
+// LookupModuleByChannel wraps IBC ChannelKeeper's LookupModuleByChannel function
+func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error) {
+	return k.channelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+}
+
+// RegisterAcknowledgementWrapper wraps IBC ChannelKeeper's RegisterAcknowledgementWrapper function
+func (k Keeper) RegisterAcknowledgementWrapper(wrapper channeltypes.AcknowledgementWrapper) {
+	k.channelKeeper.RegisterAcknowledgementWrapper(wrapper)
+}
+
 // GetFeeModuleAddress returns the ICS29 Fee ModuleAccount address
 func (k Keeper) GetFeeModuleAddress() sdk.AccAddress {
 	return k.authKeeper.GetModuleAddress(types.ModuleName)
@@ -210,6 +247,12 @@ func (k Keeper) GetAllPayees(ctx sdk.Context) []types.RegisteredPayee {
 	return registeredPayees
 }
 
+// DeletePayeeAddress deletes the payee address registered for the given relayer address and channel identifier
+func (k Keeper) DeletePayeeAddress(ctx sdk.Context, relayerAddr, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPayee(relayerAddr, channelID))
+}
+
 // SetCounterpartyPayeeAddress maps the destination chain counterparty payee address to the source relayer address
 // The receiving chain must store the mapping from: address -> counterpartyPayeeAddress for the given channel
 func (k Keeper) SetCounterpartyPayeeAddress(ctx sdk.Context, address, counterpartyAddress, channelID string) {
@@ -255,6 +298,12 @@ func (k Keeper) GetAllCounterpartyPayees(ctx sdk.Context) []types.RegisteredCoun
 	return registeredCounterpartyPayees
 }
 
+// DeleteCounterpartyPayeeAddress deletes the counterparty payee address registered for the given address and channel identifier
+func (k Keeper) DeleteCounterpartyPayeeAddress(ctx sdk.Context, address, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyCounterpartyPayee(address, channelID))
+}
+
 // SetRelayerAddressForAsyncAck sets the forward relayer address during OnRecvPacket in case of async acknowledgement
 func (k Keeper) SetRelayerAddressForAsyncAck(ctx sdk.Context, packetID channeltypes.PacketId, address string) {
 	store := ctx.KVStore(k.storeKey)
@@ -304,6 +353,50 @@ func (k Keeper) DeleteForwardRelayerAddress(ctx sdk.Context, packetID channeltyp
 	store.Delete(key)
 }
 
+// DeleteRelayerAddressesForChannel deletes all payee, counterparty payee and forward relayer addresses
+// stored for the given port and channel identifiers. Forward relayer addresses are keyed by channel and
+// deleted with a prefix iteration, whereas payee and counterparty payee addresses are keyed by relayer
+// address first, so all registered payees are scanned. The scans are only performed when fees are disabled
+// on a channel by a channel upgrade, and their cost is bounded by the number of registrations, each of which
+// was paid for by a relayer transaction.
+func (k Keeper) DeleteRelayerAddressesForChannel(ctx sdk.Context, portID, channelID string) {
+	for _, payee := range k.GetAllPayees(ctx) {
+		if payee.ChannelId == channelID {
+			k.DeletePayeeAddress(ctx, payee.Relayer, channelID)
+		}
+	}
+
+	for _, counterpartyPayee := range k.GetAllCounterpartyPayees(ctx) {
+		if counterpartyPayee.ChannelId == channelID {
+			k.DeleteCounterpartyPayeeAddress(ctx, counterpartyPayee.Relayer, channelID)
+		}
+	}
+
+	for _, packetID := range k.getForwardRelayerPacketIDsForChannel(ctx, portID, channelID) {
+		k.DeleteForwardRelayerAddress(ctx, packetID)
+	}
+}
+
+// getForwardRelayerPacketIDsForChannel returns the identifiers of the packets on the given channel for which
+// a forward relayer address is stored.
+func (k Keeper) getForwardRelayerPacketIDsForChannel(ctx sdk.Context, portID, channelID string) []channeltypes.PacketId {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyForwardRelayerChannelPrefix(portID, channelID))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var packetIDs []channeltypes.PacketId
+	for ; iterator.Valid(); iterator.Next() {
+		packetID, err := types.ParseKeyRelayerAddressForAsyncAck(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		packetIDs = append(packetIDs, packetID)
+	}
+
+	return packetIDs
+}
+
 // GetFeesInEscrow returns all escrowed packet fees for a given packetID
 func (k Keeper) GetFeesInEscrow(ctx sdk.Context, packetID channeltypes.PacketId) (types.PacketFees, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -400,3 +493,23 @@ func (k Keeper) MustUnmarshalFees(bz []byte) types.PacketFees {
 	k.cdc.MustUnmarshal(bz, &fees)
 	return fees
 }
+
+// GetParams returns the total set of the fee middleware parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("ibc-fee params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of the fee middleware parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ParamsKey), bz)
+}
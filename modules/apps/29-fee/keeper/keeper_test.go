@@ -290,6 +290,45 @@ func (suite *KeeperTestSuite) TestGetAllCounterpartyPayees() {
 	suite.Require().Equal(counterpartyPayeeAddr, expectedCounterpartyPayee)
 }
 
+func (suite *KeeperTestSuite) TestDeleteRelayerAddressesForChannel() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	relayerAddr := suite.chainA.SenderAccount.GetAddress().String()
+	payeeAddr := suite.chainB.SenderAccount.GetAddress().String()
+
+	// channel-10 extends the identifier of the deleted channel and must be left untouched
+	otherChannelID := ibctesting.FirstChannelID + "0"
+	for _, channelID := range []string{ibctesting.FirstChannelID, otherChannelID} {
+		feeKeeper.SetPayeeAddress(ctx, relayerAddr, payeeAddr, channelID)
+		feeKeeper.SetCounterpartyPayeeAddress(ctx, relayerAddr, payeeAddr, channelID)
+		feeKeeper.SetRelayerAddressForAsyncAck(ctx, channeltypes.NewPacketID(ibctesting.MockFeePort, channelID, 1), relayerAddr)
+	}
+
+	feeKeeper.SetRelayerAddressForAsyncAck(ctx, channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 2), relayerAddr)
+	feeKeeper.SetRelayerAddressForAsyncAck(ctx, channeltypes.NewPacketID(ibctesting.MockPort, ibctesting.FirstChannelID, 1), relayerAddr)
+
+	feeKeeper.DeleteRelayerAddressesForChannel(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+
+	_, found := feeKeeper.GetPayeeAddress(ctx, relayerAddr, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+	_, found = feeKeeper.GetCounterpartyPayeeAddress(ctx, relayerAddr, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+	_, found = feeKeeper.GetRelayerAddressForAsyncAck(ctx, channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1))
+	suite.Require().False(found)
+	_, found = feeKeeper.GetRelayerAddressForAsyncAck(ctx, channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 2))
+	suite.Require().False(found)
+
+	_, found = feeKeeper.GetPayeeAddress(ctx, relayerAddr, otherChannelID)
+	suite.Require().True(found)
+	_, found = feeKeeper.GetCounterpartyPayeeAddress(ctx, relayerAddr, otherChannelID)
+	suite.Require().True(found)
+	_, found = feeKeeper.GetRelayerAddressForAsyncAck(ctx, channeltypes.NewPacketID(ibctesting.MockFeePort, otherChannelID, 1))
+	suite.Require().True(found)
+	_, found = feeKeeper.GetRelayerAddressForAsyncAck(ctx, channeltypes.NewPacketID(ibctesting.MockPort, ibctesting.FirstChannelID, 1))
+	suite.Require().True(found)
+}
+
 func (suite *KeeperTestSuite) TestWithICS4Wrapper() {
 	suite.SetupTest()
 
@@ -45,6 +45,19 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate2to3 migrates ibc-fee module from ConsensusVersion 2 to 3
+// by setting the default fee middleware parameters.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully set default fee middleware params")
+	return nil
+}
+
 // legacyTotal returns the legacy total amount for a given Fee
 // The total amount is the RecvFee + AckFee + TimeoutFee
 func legacyTotal(f types.Fee) sdk.Coins {
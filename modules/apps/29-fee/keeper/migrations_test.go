@@ -199,3 +199,14 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 		tc.assert(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(ctx, types.NewParams(1, ""))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCFeeKeeper)
+	err := migrator.Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(ctx))
+}
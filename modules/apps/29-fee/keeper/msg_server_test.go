@@ -12,6 +12,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -237,6 +238,29 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			true,
 		},
+		{
+			"success with existing packet fees in escrow paid by a different payer up to the maximum",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(2, ""))
+
+				escrowFee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				payer := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				packetFee := types.NewPacketFee(escrowFee, payer.String(), nil)
+				feesInEscrow := types.NewPacketFees([]types.PacketFee{packetFee})
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, feesInEscrow)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), payer, types.ModuleName, escrowFee.Total())
+				suite.Require().NoError(err)
+
+				expEscrowBalance = expEscrowBalance.Add(escrowFee.Total()...)
+				expFeesInEscrow = append(expFeesInEscrow, packetFee)
+
+				eventFee = types.NewFee(defaultRecvFee.Add(escrowFee.RecvFee...), defaultAckFee.Add(escrowFee.AckFee...), defaultTimeoutFee.Add(escrowFee.TimeoutFee...))
+			},
+			true,
+		},
 		{
 			"bank send enabled for fee denom",
 			func() {
@@ -266,6 +290,17 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			false,
 		},
+		{
+			"maximum number of packet fees exceeded",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(1, ""))
+
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				packetFee := types.NewPacketFee(fee, suite.chainB.SenderAccount.GetAddress().String(), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			},
+			false,
+		},
 		{
 			"fee module disabled on channel",
 			func() {
@@ -605,3 +640,48 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	validAuthority := suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success: valid signer and params",
+			types.NewMsgUpdateParams(validAuthority, types.NewParams(10, "")),
+			nil,
+		},
+		{
+			"success: valid signer and zero maximum",
+			types.NewMsgUpdateParams(validAuthority, types.NewParams(0, "")),
+			nil,
+		},
+		{
+			"failure: invalid signer",
+			types.NewMsgUpdateParams(suite.chainA.SenderAccount.GetAddress().String(), types.NewParams(10, "")),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateParams(ctx, tc.msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(types.DefaultParams(), suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
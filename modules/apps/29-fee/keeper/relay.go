@@ -28,11 +28,23 @@ func (k Keeper) SendPacket(
 }
 
 // WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
-// ICS29 WriteAcknowledgement is used for asynchronous acknowledgements
+// ICS29 WriteAcknowledgement is used for asynchronous acknowledgements. The acknowledgement is passed through
+// unmodified: it is wrapped in an incentivized acknowledgement by WrapAsyncAcknowledgement when it is written
+// by core IBC, so that middleware positioned between the fee middleware and core IBC observe the acknowledgement
+// of the base application.
 func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	// ics4Wrapper may be core IBC or higher-level middleware
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// WrapAsyncAcknowledgement implements the channel keeper AcknowledgementWrapper and is registered by NewKeeper. Asynchronous acknowledgements
+// written on fee enabled channels are wrapped in an incentivized acknowledgement containing the forward relayer
+// address stored in `onRecvPacket`. As the wrapper is invoked by core IBC and the forward relayer address is keyed
+// by packet identifier, the acknowledgement is incentivized regardless of the position of the fee middleware in the
+// ICS4Wrapper stack, or whether the base application wrote the acknowledgement through the fee middleware at all.
+func (k Keeper) WrapAsyncAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) (ibcexported.Acknowledgement, error) {
 	if !k.IsFeeEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
-		// ics4Wrapper may be core IBC or higher-level middleware
-		return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+		return acknowledgement, nil
 	}
 
 	packetID := channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
@@ -40,19 +52,16 @@ func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.C
 	// retrieve the forward relayer that was stored in `onRecvPacket`
 	relayer, found := k.GetRelayerAddressForAsyncAck(ctx, packetID)
 	if !found {
-		return errorsmod.Wrapf(types.ErrRelayerNotFoundForAsyncAck, "no relayer address stored for async acknowledgement for packet with portID: %s, channelID: %s, sequence: %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+		return nil, errorsmod.Wrapf(types.ErrRelayerNotFoundForAsyncAck, "no relayer address stored for async acknowledgement for packet with portID: %s, channelID: %s, sequence: %d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
 	}
 
 	// it is possible that a relayer has not registered a counterparty address.
 	// if there is no registered counterparty address then write acknowledgement with empty relayer address and refund recv_fee.
 	forwardRelayer, _ := k.GetCounterpartyPayeeAddress(ctx, relayer, packet.GetDestChannel())
 
-	ack := types.NewIncentivizedAcknowledgement(forwardRelayer, acknowledgement.Acknowledgement(), acknowledgement.Success())
-
 	k.DeleteForwardRelayerAddress(ctx, packetID)
 
-	// ics4Wrapper may be core IBC or higher-level middleware
-	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+	return types.NewIncentivizedAcknowledgement(forwardRelayer, acknowledgement.Acknowledgement(), acknowledgement.Success()), nil
 }
 
 // GetAppVersion returns the underlying application version.
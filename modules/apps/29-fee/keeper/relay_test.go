@@ -76,6 +76,98 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgementAsync() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestWrapAsyncAcknowledgement() {
+	var (
+		packet channeltypes.Packet
+		expAck []byte
+	)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte("success"))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: acknowledgement wrapped in incentivized acknowledgement",
+			func() {},
+			nil,
+		},
+		{
+			"success: counterparty payee not registered",
+			func() {
+				// the forward relayer did not register a counterparty payee, the recv fee is refunded
+				packetID := channeltypes.NewPacketID(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+				suite.chainB.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainB.GetContext(), packetID, suite.chainB.SenderAccount.GetAddress().String())
+				expAck = types.NewIncentivizedAcknowledgement("", ack.Acknowledgement(), ack.Success()).Acknowledgement()
+			},
+			nil,
+		},
+		{
+			"success: fee not enabled",
+			func() {
+				suite.chainB.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+				expAck = ack.Acknowledgement()
+			},
+			nil,
+		},
+		{
+			"failure: relayer address not stored for async acknowledgement",
+			func() {
+				suite.chainB.GetSimApp().IBCFeeKeeper.DeleteForwardRelayerAddress(suite.chainB.GetContext(), channeltypes.NewPacketID(packet.DestinationPort, packet.DestinationChannel, packet.Sequence))
+			},
+			types.ErrRelayerNotFoundForAsyncAck,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			packet = channeltypes.NewPacket(
+				[]byte("packetData"),
+				1,
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				suite.path.EndpointB.ChannelConfig.PortID,
+				suite.path.EndpointB.ChannelID,
+				clienttypes.ZeroHeight(),
+				^uint64(0),
+			)
+
+			packetID := channeltypes.NewPacketID(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			suite.chainB.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainB.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
+			suite.chainB.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainB.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.path.EndpointB.ChannelID)
+			expAck = types.NewIncentivizedAcknowledgement(suite.chainB.SenderAccount.GetAddress().String(), ack.Acknowledgement(), ack.Success()).Acknowledgement()
+
+			chanCap := suite.chainB.GetChannelCapability(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+
+			tc.malleate()
+
+			// write the acknowledgement directly with core IBC, bypassing the fee middleware as
+			// middleware positioned between the fee middleware and core IBC would do. The acknowledgement
+			// wrapper is registered with the channel keeper when the fee keeper is created.
+			err := suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.WriteAcknowledgement(suite.chainB.GetContext(), chanCap, packet, ack)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				committedAck, found := suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck), committedAck)
+
+				_, found = suite.chainB.GetSimApp().IBCFeeKeeper.GetRelayerAddressForAsyncAck(suite.chainB.GetContext(), packetID)
+				suite.Require().Equal(!suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel), found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWriteAcknowledgementAsyncFeeDisabled() {
 	// open incentivized channel
 	suite.path.Setup()
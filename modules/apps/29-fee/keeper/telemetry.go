@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	metrics "github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
+)
+
+const (
+	metricFeesEscrowed    = "fees_escrowed"
+	metricFeesDistributed = "fees_distributed"
+	metricFeesRefunded    = "fees_refunded"
+)
+
+// incrFeeCounters increments the fee counter with the provided name by the amount of each of the
+// provided fees, labelled with the denomination and the port and channel the packet was sent on.
+// Amounts which do not fit into an int64 are not recorded.
+func incrFeeCounters(name, portID, channelID string, fees sdk.Coins) {
+	for _, fee := range fees {
+		if !fee.Amount.IsInt64() {
+			continue
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, name},
+			float32(fee.Amount.Int64()),
+			[]metrics.Label{
+				telemetry.NewLabel(coretypes.LabelDenom, fee.Denom),
+				telemetry.NewLabel(coretypes.LabelSourcePort, portID),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, channelID),
+			},
+		)
+	}
+}
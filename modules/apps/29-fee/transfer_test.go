@@ -11,14 +11,8 @@ import (
 
 // Integration test to ensure ics29 works with ics20
 func (suite *FeeTestSuite) TestFeeTransfer() {
-	path := ibctesting.NewPath(suite.chainA, suite.chainB)
-	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
-	path.EndpointA.ChannelConfig.Version = feeTransferVersion
-	path.EndpointB.ChannelConfig.Version = feeTransferVersion
-	path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
-	path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
-
-	path.Setup()
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.SetupWithFee()
 
 	// set up coin & ics20 packet
 	coin := ibctesting.TestCoin
@@ -28,16 +22,10 @@ func (suite *FeeTestSuite) TestFeeTransfer() {
 		TimeoutFee: defaultTimeoutFee,
 	}
 
-	msgs := []sdk.Msg{
-		types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), nil),
-		transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 100), 0, ""),
-	}
-	res, err := suite.chainA.SendMsgs(msgs...)
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 100), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
 	suite.Require().NoError(err) // message committed
 
-	// after incentivizing the packets
-	originalChainASenderAccountBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom))
-
 	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
 	suite.Require().NoError(err)
 
@@ -50,23 +38,12 @@ func (suite *FeeTestSuite) TestFeeTransfer() {
 	_, err = suite.chainB.SendMsgs(msgRegister)
 	suite.Require().NoError(err) // message committed
 
-	// relay packet
-	err = path.RelayPacket(packet)
-	suite.Require().NoError(err) // relay committed
-
-	// ensure relayers got paid
+	// relay packet, the fee is escrowed from chainA.SenderAccount and paid out to the relayers
 	// relayer for forward relay: chainB.SenderAccount
 	// relayer for reverse relay: chainA.SenderAccount
-
-	// check forward relay balance
-	suite.Require().Equal(
-		fee.RecvFee,
-		sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainB.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom)),
-	)
-
-	suite.Require().Equal(
-		fee.AckFee, // ack fee paid, no refund needed since timeout_fee = recv_fee + ack_fee
-		sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom)).Sub(originalChainASenderAccountBalance[0]))
+	packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+	_, err = path.RelayPacketWithFee(packet, packetFee)
+	suite.Require().NoError(err) // relay committed
 }
 
 func (suite *FeeTestSuite) TestTransferFeeUpgrade() {
@@ -100,7 +77,7 @@ func (suite *FeeTestSuite) TestTransferFeeUpgrade() {
 			path.Setup()
 
 			// configure the channel upgrade to upgrade to an incentivized fee enabled transfer channel
-			upgradeVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
+			upgradeVersion := ibctesting.FeeVersion(transfertypes.Version)
 			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = upgradeVersion
 			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = upgradeVersion
 
@@ -167,12 +144,9 @@ func (suite *FeeTestSuite) TestTransferFeeUpgrade() {
 func (suite *FeeTestSuite) TestOnesidedFeeMiddlewareTransferHandshake() {
 	RemoveFeeMiddleware(suite.chainB) // remove fee middleware from chainB
 
-	path := ibctesting.NewPath(suite.chainA, suite.chainB)
-	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
-	path.EndpointA.ChannelConfig.Version = feeTransferVersion // this will be renegotiated by the Try step
-	path.EndpointB.ChannelConfig.Version = ""                 // this will be overwritten by the Try step
-	path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
-	path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.EnableFee()                // this will be renegotiated by the Try step
+	path.EndpointB.ChannelConfig.Version = "" // this will be overwritten by the Try step
 
 	path.Setup()
 
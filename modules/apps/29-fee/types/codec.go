@@ -26,6 +26,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -6,14 +6,22 @@ const (
 	EventTypeRegisterPayee             = "register_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
 	EventTypeDistributeFee             = "distribute_fee"
+	EventTypeDistributeFeeFallback     = "distribute_fee_fallback"
+	EventTypeFeeDisabled               = "fee_disabled"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
 	AttributeKeyTimeoutFee        = "timeout_fee"
+	AttributeKeyPortID            = "port_id"
 	AttributeKeyChannelID         = "channel_id"
 	AttributeKeyRelayer           = "relayer"
 	AttributeKeyPayee             = "payee"
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
 	AttributeKeyReceiver          = "receiver"
 	AttributeKeyFee               = "fee"
+	AttributeKeyFallback          = "fallback"
+	AttributeKeyRefundedFee       = "refunded_fee"
+
+	// AttributeValueCommunityPool is the fallback attribute value of fees sent to the community pool
+	AttributeValueCommunityPool = "community_pool"
 )
@@ -21,6 +21,8 @@ type ChannelKeeper interface {
 	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
 	HasChannel(ctx sdk.Context, portID, channelID string) bool
+	LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error)
+	RegisterAcknowledgementWrapper(wrapper channeltypes.AcknowledgementWrapper)
 }
 
 // PortKeeper defines the expected IBC port keeper
@@ -36,3 +38,8 @@ type BankKeeper interface {
 	BlockedAddr(sdk.AccAddress) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 }
+
+// DistributionKeeper defines the expected distribution keeper
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
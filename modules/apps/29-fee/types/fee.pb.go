@@ -257,50 +257,113 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
+// Params defines the set of ICS29 fee middleware parameters.
+type Params struct {
+	// max_packet_fees is the maximum number of packet fees, paid by the same or
+	// different payers, which can be escrowed for a single packet. A value of
+	// zero disables the limit.
+	MaxPacketFees uint64 `protobuf:"varint,1,opt,name=max_packet_fees,json=maxPacketFees,proto3" json:"max_packet_fees,omitempty"`
+	// fallback_address is the address receiving fees which can be distributed
+	// neither to their receiver nor to their refund address, for example because
+	// both are blocked module accounts. If empty, such fees are sent to the
+	// community pool.
+	FallbackAddress string `protobuf:"bytes,2,opt,name=fallback_address,json=fallbackAddress,proto3" json:"fallback_address,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxPacketFees() uint64 {
+	if m != nil {
+		return m.MaxPacketFees
+	}
+	return 0
+}
+
+func (m *Params) GetFallbackAddress() string {
+	if m != nil {
+		return m.FallbackAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
 	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
 	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
 	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*Params)(nil), "ibc.applications.fee.v1.Params")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
 
 var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x04, 0xb5, 0xcd, 0x05, 0x90, 0x30, 0x95, 0x5a, 0x2a, 0x70, 0x8b, 0x25, 0xa4,
-	0xa8, 0x52, 0xee, 0x94, 0x00, 0x12, 0x30, 0xd1, 0x20, 0x45, 0xca, 0x04, 0xca, 0x82, 0xc4, 0x12,
-	0x9d, 0xcf, 0x2f, 0xee, 0x29, 0xb6, 0xcf, 0xf2, 0xd9, 0x41, 0x19, 0x58, 0xf8, 0x04, 0xac, 0xb0,
-	0xb2, 0x31, 0xf5, 0x63, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x19, 0xfa, 0x05, 0xf8, 0x00, 0xe8, 0x9d,
-	0x0f, 0xab, 0x2a, 0xea, 0xc2, 0xd0, 0xc5, 0x77, 0xef, 0xde, 0xbb, 0xf7, 0xfb, 0xdf, 0xbb, 0xe7,
-	0x23, 0x0f, 0x65, 0x20, 0x18, 0xcf, 0xb2, 0x58, 0x0a, 0x5e, 0x48, 0x95, 0x6a, 0x36, 0x03, 0x60,
-	0x8b, 0x3e, 0x0e, 0x34, 0xcb, 0x55, 0xa1, 0xdc, 0x1d, 0x19, 0x08, 0x7a, 0x31, 0x84, 0xa2, 0x6f,
-	0xd1, 0xdf, 0xbb, 0xc3, 0x13, 0x99, 0x2a, 0x66, 0xbe, 0x55, 0xec, 0x9e, 0x27, 0x94, 0x4e, 0x94,
-	0x66, 0x01, 0xd7, 0x98, 0x25, 0x80, 0x82, 0xf7, 0x99, 0x50, 0x32, 0xb5, 0xfe, 0xed, 0x48, 0x45,
-	0xca, 0x4c, 0x19, 0xce, 0xec, 0xaa, 0x11, 0x21, 0x54, 0x0e, 0x4c, 0x1c, 0xf3, 0x34, 0x85, 0x18,
-	0x05, 0xd8, 0xa9, 0x0d, 0xd9, 0xb1, 0x89, 0x13, 0x1d, 0xa1, 0x33, 0xd1, 0x51, 0xe5, 0xf0, 0x7f,
-	0x37, 0x49, 0x6b, 0x04, 0xe0, 0xbe, 0x27, 0x5b, 0x39, 0x88, 0xc5, 0x74, 0x06, 0xb0, 0xeb, 0x1c,
-	0xb4, 0xba, 0x9d, 0xc1, 0x3d, 0x5a, 0xed, 0xa1, 0x28, 0x86, 0x5a, 0x31, 0xf4, 0x95, 0x92, 0xe9,
-	0xf0, 0xe8, 0xf4, 0xc7, 0x7e, 0xe3, 0xdb, 0xcf, 0xfd, 0x6e, 0x24, 0x8b, 0xe3, 0x32, 0xa0, 0x42,
-	0x25, 0xcc, 0x02, 0xaa, 0xa1, 0xa7, 0xc3, 0x39, 0x2b, 0x96, 0x19, 0x68, 0xb3, 0x41, 0x7f, 0x39,
-	0x3f, 0x39, 0xbc, 0x19, 0x43, 0xc4, 0xc5, 0x72, 0x8a, 0xc7, 0xd1, 0x93, 0x4d, 0xa4, 0x21, 0xb8,
-	0x24, 0x9b, 0x5c, 0xcc, 0x0d, 0xb7, 0x79, 0x0d, 0xdc, 0x0d, 0x2e, 0xe6, 0x88, 0xfd, 0x40, 0x3a,
-	0x85, 0x4c, 0x40, 0x95, 0x85, 0x41, 0xb7, 0xae, 0x01, 0x4d, 0x2c, 0x70, 0x04, 0xe0, 0x7f, 0x76,
-	0x48, 0xfb, 0x0d, 0x17, 0x73, 0x40, 0xcb, 0x7d, 0x42, 0x5a, 0x55, 0xdd, 0x9d, 0x6e, 0x67, 0x70,
-	0x9f, 0x5e, 0xd1, 0x30, 0x74, 0x04, 0x30, 0xbc, 0x81, 0x3a, 0x26, 0x18, 0xee, 0x3e, 0x22, 0xb7,
-	0x73, 0x98, 0x95, 0x69, 0x38, 0xe5, 0x61, 0x98, 0x83, 0xd6, 0xbb, 0xcd, 0x03, 0xa7, 0xdb, 0x9e,
-	0xdc, 0xaa, 0x56, 0x8f, 0xaa, 0x45, 0x77, 0x0f, 0x6f, 0x36, 0xe6, 0x4b, 0xc8, 0xb5, 0x39, 0x66,
-	0x7b, 0x52, 0xdb, 0x2f, 0xee, 0x7e, 0x3c, 0x3f, 0x39, 0xbc, 0x94, 0xc5, 0x7f, 0x4b, 0x48, 0x2d,
-	0x4d, 0xbb, 0x63, 0xd2, 0xc9, 0x8c, 0x85, 0x75, 0xd2, 0xb6, 0x37, 0xfc, 0x2b, 0x35, 0xd6, 0x3b,
-	0xad, 0x52, 0x92, 0xd5, 0xa9, 0xfc, 0xaf, 0x0e, 0xd9, 0x1e, 0x87, 0x90, 0x16, 0x72, 0x26, 0x21,
-	0xbc, 0xc0, 0x78, 0x49, 0xda, 0x96, 0x21, 0x43, 0x5b, 0x85, 0x07, 0x86, 0x80, 0x4d, 0x4d, 0xff,
-	0x76, 0x72, 0x9d, 0x7d, 0x1c, 0xda, 0xe4, 0x5b, 0x99, 0xb5, 0x2f, 0xab, 0x6c, 0xfe, 0xbf, 0xca,
-	0xe1, 0xeb, 0xd3, 0x95, 0xe7, 0x9c, 0xad, 0x3c, 0xe7, 0xd7, 0xca, 0x73, 0x3e, 0xad, 0xbd, 0xc6,
-	0xd9, 0xda, 0x6b, 0x7c, 0x5f, 0x7b, 0x8d, 0x77, 0x4f, 0xff, 0xbd, 0x7b, 0x19, 0x88, 0x5e, 0xa4,
-	0xd8, 0xe2, 0x19, 0x4b, 0x54, 0x58, 0xc6, 0xa0, 0xf1, 0x31, 0xd0, 0x6c, 0xf0, 0xbc, 0x87, 0xef,
-	0x80, 0x69, 0x87, 0x60, 0xc3, 0xfc, 0x69, 0x8f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x53,
-	0x6e, 0x63, 0x2c, 0x04, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6e, 0x13, 0x31,
+	0x1c, 0xce, 0x25, 0x55, 0xda, 0x38, 0x94, 0xc2, 0x51, 0xa9, 0x25, 0x82, 0x6b, 0x89, 0x04, 0x0a,
+	0x95, 0x62, 0x2b, 0x01, 0x24, 0x60, 0xa2, 0x41, 0x8a, 0x94, 0x89, 0x2a, 0x0b, 0x12, 0x0c, 0x91,
+	0xcf, 0xf7, 0xcb, 0xd5, 0xca, 0xf9, 0x7c, 0x3a, 0x5f, 0x42, 0x33, 0xb0, 0xf0, 0x04, 0xac, 0xb0,
+	0xb2, 0x31, 0xf5, 0x31, 0x3a, 0x76, 0x64, 0x02, 0x94, 0x0c, 0x7d, 0x01, 0x1e, 0x00, 0xd9, 0xe7,
+	0x46, 0x69, 0x51, 0x27, 0xa4, 0x2e, 0x67, 0xff, 0xfe, 0xf8, 0xfb, 0x3e, 0xdb, 0xdf, 0x19, 0x3d,
+	0xe0, 0x3e, 0x23, 0x34, 0x49, 0x22, 0xce, 0x68, 0xc6, 0x65, 0xac, 0xc8, 0x10, 0x80, 0x4c, 0x5a,
+	0x7a, 0xc0, 0x49, 0x2a, 0x33, 0xe9, 0x6e, 0x71, 0x9f, 0xe1, 0xe5, 0x16, 0xac, 0x6b, 0x93, 0x56,
+	0xed, 0x36, 0x15, 0x3c, 0x96, 0xc4, 0x7c, 0xf3, 0xde, 0x9a, 0xc7, 0xa4, 0x12, 0x52, 0x11, 0x9f,
+	0x2a, 0x8d, 0xe2, 0x43, 0x46, 0x5b, 0x84, 0x49, 0x1e, 0xdb, 0xfa, 0x66, 0x28, 0x43, 0x69, 0xa6,
+	0x44, 0xcf, 0x6c, 0xd6, 0x88, 0x60, 0x32, 0x05, 0xc2, 0x0e, 0x69, 0x1c, 0x43, 0xa4, 0x05, 0xd8,
+	0xa9, 0x6d, 0xd9, 0xb2, 0xc0, 0x42, 0x85, 0xba, 0x28, 0x54, 0x98, 0x17, 0xea, 0x7f, 0x8a, 0xa8,
+	0xd4, 0x05, 0x70, 0x3f, 0xa0, 0xb5, 0x14, 0xd8, 0x64, 0x30, 0x04, 0xd8, 0x76, 0x76, 0x4b, 0x8d,
+	0x6a, 0xfb, 0x2e, 0xce, 0xd7, 0x60, 0x2d, 0x06, 0x5b, 0x31, 0xf8, 0xb5, 0xe4, 0x71, 0x67, 0xff,
+	0xe4, 0xe7, 0x4e, 0xe1, 0xfb, 0xaf, 0x9d, 0x46, 0xc8, 0xb3, 0xc3, 0xb1, 0x8f, 0x99, 0x14, 0xc4,
+	0x12, 0xe4, 0x43, 0x53, 0x05, 0x23, 0x92, 0x4d, 0x13, 0x50, 0x66, 0x81, 0xfa, 0x7a, 0x76, 0xbc,
+	0x77, 0x23, 0x82, 0x90, 0xb2, 0xe9, 0x40, 0x6f, 0x47, 0xf5, 0x57, 0x35, 0x9b, 0x26, 0x1e, 0xa3,
+	0x55, 0xca, 0x46, 0x86, 0xb7, 0x78, 0x0d, 0xbc, 0x65, 0xca, 0x46, 0x9a, 0xf6, 0x23, 0xaa, 0x66,
+	0x5c, 0x80, 0x1c, 0x67, 0x86, 0xba, 0x74, 0x0d, 0xd4, 0xc8, 0x12, 0x76, 0x01, 0xea, 0x5f, 0x1c,
+	0x54, 0x39, 0xa0, 0x6c, 0x04, 0x3a, 0x72, 0x9f, 0xa2, 0x52, 0x7e, 0xee, 0x4e, 0xa3, 0xda, 0xbe,
+	0x87, 0xaf, 0x30, 0x0c, 0xee, 0x02, 0x74, 0x56, 0xb4, 0x8e, 0xbe, 0x6e, 0x77, 0x1f, 0xa2, 0x9b,
+	0x29, 0x0c, 0xc7, 0x71, 0x30, 0xa0, 0x41, 0x90, 0x82, 0x52, 0xdb, 0xc5, 0x5d, 0xa7, 0x51, 0xe9,
+	0xaf, 0xe7, 0xd9, 0xfd, 0x3c, 0xe9, 0xd6, 0xf4, 0xcd, 0x46, 0x74, 0x0a, 0xa9, 0x32, 0xdb, 0xac,
+	0xf4, 0x17, 0xf1, 0xcb, 0x3b, 0x9f, 0xce, 0x8e, 0xf7, 0x2e, 0xa1, 0xd4, 0xdf, 0x22, 0xb4, 0x90,
+	0xa6, 0xdc, 0x1e, 0xaa, 0x26, 0x26, 0xd2, 0xe7, 0xa4, 0xac, 0x37, 0xea, 0x57, 0x6a, 0x5c, 0xac,
+	0xb4, 0x4a, 0x51, 0xb2, 0x80, 0xaa, 0x7f, 0x73, 0xd0, 0x66, 0x2f, 0x80, 0x38, 0xe3, 0x43, 0x0e,
+	0xc1, 0x12, 0xc7, 0x2b, 0x54, 0xb1, 0x1c, 0x3c, 0xb0, 0xa7, 0x70, 0xdf, 0x30, 0x68, 0x53, 0xe3,
+	0x73, 0x27, 0x2f, 0xd0, 0x7b, 0x81, 0x05, 0x5f, 0x4b, 0x6c, 0x7c, 0x59, 0x65, 0xf1, 0x3f, 0x54,
+	0xbe, 0x47, 0xe5, 0x03, 0x9a, 0x52, 0xa1, 0xdc, 0x47, 0x68, 0x43, 0xd0, 0xa3, 0xc1, 0xc5, 0xed,
+	0x3b, 0x8d, 0x95, 0xfe, 0xba, 0xa0, 0x47, 0x4b, 0xf2, 0x1f, 0xa3, 0x5b, 0x43, 0x1a, 0x45, 0xbe,
+	0xf6, 0xf1, 0xc5, 0xab, 0xd8, 0x38, 0xcf, 0xdb, 0xcb, 0xe8, 0xbc, 0x39, 0x99, 0x79, 0xce, 0xe9,
+	0xcc, 0x73, 0x7e, 0xcf, 0x3c, 0xe7, 0xf3, 0xdc, 0x2b, 0x9c, 0xce, 0xbd, 0xc2, 0x8f, 0xb9, 0x57,
+	0x78, 0xf7, 0xec, 0x5f, 0x63, 0x71, 0x9f, 0x35, 0x43, 0x49, 0x26, 0xcf, 0x89, 0x90, 0xc1, 0x38,
+	0x02, 0xa5, 0x5f, 0x1a, 0x45, 0xda, 0x2f, 0x9a, 0xfa, 0x91, 0x31, 0x5e, 0xf3, 0xcb, 0xe6, 0x37,
+	0x7e, 0xf2, 0x77, 0x00, 0x50, 0x5b, 0x1e, 0x47, 0x89, 0x04, 0x00, 0x00,
 }
 
 func (m *Fee) Marshal() (dAtA []byte, err error) {
@@ -501,6 +564,41 @@ func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackAddress) > 0 {
+		i -= len(m.FallbackAddress)
+		copy(dAtA[i:], m.FallbackAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.FallbackAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxPacketFees != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxPacketFees))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFee(v)
 	base := offset
@@ -592,6 +690,22 @@ func (m *IdentifiedPacketFees) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPacketFees != 0 {
+		n += 1 + sovFee(uint64(m.MaxPacketFees))
+	}
+	l = len(m.FallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
 func sovFee(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1098,6 +1212,107 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketFees", wireType)
+			}
+			m.MaxPacketFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFee(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
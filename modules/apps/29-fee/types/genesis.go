@@ -18,6 +18,7 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	params Params,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -25,6 +26,7 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		Params:                       params,
 	}
 }
 
@@ -36,6 +38,7 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		Params:                       DefaultParams(),
 	}
 }
 
@@ -110,5 +113,5 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	return gs.Params.Validate()
 }
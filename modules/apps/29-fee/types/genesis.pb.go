@@ -36,6 +36,8 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers"`
+	// the fee middleware parameters
+	Params Params `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +108,13 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -358,42 +367,43 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xf6, 0x93, 0x7a, 0x88, 0xae, 0x56, 0xd1, 0xa2, 0xc1, 0xc2, 0xa8, 0x84, 0x54, 0x21,
-	0x35, 0x51, 0x0b, 0x48, 0x70, 0x03, 0x26, 0x86, 0x2a, 0x0e, 0x4c, 0xe5, 0x06, 0x48, 0x21, 0xb1,
-	0x5f, 0x32, 0x8b, 0x36, 0x8e, 0x6c, 0xb7, 0xa8, 0x37, 0x2e, 0xdc, 0xe1, 0xbf, 0xda, 0x71, 0x47,
-	0x4e, 0x08, 0xb5, 0xff, 0x08, 0xb2, 0xe3, 0x8c, 0xae, 0x23, 0x08, 0x71, 0xf3, 0x7b, 0xef, 0xfb,
-	0xde, 0x67, 0x7f, 0x4f, 0xcf, 0xe8, 0x1e, 0x8b, 0x49, 0x10, 0xe5, 0xf9, 0x88, 0x91, 0x48, 0x31,
-	0x9e, 0xc9, 0x20, 0x01, 0x08, 0xa6, 0xbd, 0x20, 0x85, 0x0c, 0x24, 0x93, 0x7e, 0x2e, 0xb8, 0xe2,
-	0x78, 0x8f, 0xc5, 0xc4, 0x5f, 0x86, 0xf9, 0x09, 0x80, 0x3f, 0xed, 0xed, 0xb7, 0x52, 0x9e, 0x72,
-	0x83, 0x09, 0xf4, 0xa9, 0x80, 0xef, 0xdf, 0xad, 0xea, 0xaa, 0x59, 0x4b, 0x10, 0xc2, 0x05, 0x04,
-	0xe4, 0x34, 0xca, 0x32, 0x18, 0xe9, 0xb2, 0x3d, 0x16, 0x90, 0xf6, 0xb7, 0x0d, 0x74, 0xfd, 0x65,
-	0x71, 0x8d, 0x37, 0x2a, 0x52, 0x80, 0xdf, 0xa3, 0x06, 0xa3, 0x90, 0x29, 0x96, 0x30, 0xa0, 0x61,
-	0x02, 0x20, 0x5d, 0xe7, 0x70, 0xbd, 0xb3, 0xd3, 0xef, 0xfa, 0x15, 0xf7, 0xf3, 0x07, 0x17, 0xf8,
-	0x93, 0x88, 0x7c, 0x04, 0x75, 0x0c, 0x20, 0x9f, 0x6f, 0x9c, 0xfd, 0xb8, 0x53, 0x1b, 0xde, 0xf8,
-	0xdd, 0x4b, 0x67, 0x71, 0x8c, 0x5a, 0x09, 0x40, 0x08, 0x59, 0x14, 0x8f, 0x80, 0x86, 0xf6, 0x2e,
-	0xd2, 0x5d, 0x33, 0x12, 0xf7, 0x2b, 0x25, 0x8e, 0x01, 0x5e, 0x14, 0x9c, 0xa3, 0x82, 0x62, 0xfb,
-	0xe3, 0x64, 0xb5, 0x20, 0xf1, 0x3b, 0xd4, 0x14, 0x90, 0x32, 0xa9, 0x40, 0x00, 0x0d, 0xf3, 0x68,
-	0xa6, 0xdf, 0xb0, 0x6e, 0x04, 0x3a, 0x95, 0x02, 0xc3, 0x0b, 0xc6, 0x89, 0x26, 0xd8, 0xf6, 0xbb,
-	0xe2, 0x72, 0x5a, 0xe2, 0xcf, 0x0e, 0xf2, 0x96, 0xba, 0x13, 0x3e, 0xc9, 0x14, 0x88, 0x3c, 0x12,
-	0x6a, 0x56, 0x4a, 0x6d, 0x18, 0xa9, 0x87, 0xff, 0x20, 0x75, 0xb4, 0xc4, 0x5e, 0x96, 0xbd, 0x2d,
-	0xaa, 0x21, 0x12, 0x87, 0x68, 0x37, 0xe1, 0xe2, 0x53, 0x24, 0x68, 0x28, 0x60, 0x14, 0xcd, 0x40,
-	0x48, 0x77, 0xd3, 0x68, 0xfa, 0xd5, 0xfe, 0x15, 0x84, 0x61, 0x81, 0x7f, 0x46, 0xa9, 0x00, 0x59,
-	0xce, 0xa8, 0x91, 0x5c, 0x2a, 0xca, 0xf6, 0x2b, 0xd4, 0xbc, 0xe2, 0x37, 0xde, 0x43, 0xdb, 0x39,
-	0x17, 0x2a, 0x64, 0xd4, 0x75, 0x0e, 0x9d, 0x4e, 0x7d, 0xb8, 0xa5, 0xc3, 0x01, 0xc5, 0x07, 0x08,
-	0xd9, 0x31, 0xea, 0xda, 0x9a, 0xa9, 0xd5, 0x6d, 0x66, 0x40, 0xdb, 0x1f, 0x50, 0x63, 0xc5, 0xdb,
-	0x15, 0x86, 0xb3, 0xc2, 0xc0, 0x2e, 0xda, 0xb6, 0xef, 0xb2, 0xdd, 0xca, 0x10, 0xb7, 0xd0, 0xa6,
-	0xf1, 0xd8, 0x5d, 0x37, 0xf9, 0x22, 0x68, 0x7f, 0x71, 0xd0, 0xad, 0xbf, 0x78, 0xfa, 0xff, 0x72,
-	0x5d, 0x84, 0xaf, 0xce, 0xd7, 0x6a, 0x37, 0xc9, 0xaa, 0x4e, 0x5b, 0xa2, 0x9b, 0x7f, 0xb4, 0x59,
-	0x2b, 0x44, 0xc5, 0xd1, 0xaa, 0x97, 0x21, 0x7e, 0x8a, 0xea, 0xb9, 0x59, 0x99, 0xd2, 0xba, 0x9d,
-	0xfe, 0x81, 0x99, 0xa1, 0x5e, 0x5a, 0xbf, 0xdc, 0xd4, 0x69, 0xcf, 0x2f, 0x16, 0x6b, 0x40, 0xed,
-	0xc8, 0xae, 0xe5, 0x65, 0xfc, 0xfa, 0x6c, 0xee, 0x39, 0xe7, 0x73, 0xcf, 0xf9, 0x39, 0xf7, 0x9c,
-	0xaf, 0x0b, 0xaf, 0x76, 0xbe, 0xf0, 0x6a, 0xdf, 0x17, 0x5e, 0xed, 0xed, 0xa3, 0x94, 0xa9, 0xd3,
-	0x49, 0xec, 0x13, 0x3e, 0x0e, 0x08, 0x97, 0x63, 0x2e, 0x03, 0x16, 0x93, 0x6e, 0xca, 0x83, 0xe9,
-	0xe3, 0x60, 0xcc, 0xe9, 0x64, 0x04, 0x52, 0xff, 0x1f, 0x32, 0xe8, 0x3f, 0xe9, 0xea, 0xaf, 0x43,
-	0xcd, 0x72, 0x90, 0xf1, 0x96, 0xf9, 0x17, 0x1e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x6c,
-	0xb0, 0x1e, 0xb5, 0x04, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x3e,
+	0x14, 0x6f, 0xf6, 0xa3, 0xfb, 0xce, 0xfb, 0x8a, 0x6d, 0x56, 0xd1, 0xa2, 0xc1, 0xb2, 0x52, 0x09,
+	0xa9, 0x42, 0x6a, 0xa2, 0x15, 0x90, 0xe0, 0x80, 0x04, 0x4c, 0x0c, 0x55, 0x1c, 0xa8, 0xca, 0x0d,
+	0x90, 0x82, 0x13, 0xbf, 0x64, 0x16, 0x6d, 0x1c, 0xd9, 0x6e, 0x51, 0x6f, 0x5c, 0xb8, 0xf3, 0x67,
+	0xed, 0xd8, 0x23, 0x27, 0x84, 0xda, 0x7f, 0x04, 0xd9, 0x71, 0x46, 0xd7, 0x11, 0x84, 0xb8, 0xf9,
+	0xbd, 0xf7, 0xf9, 0xe1, 0xf8, 0x13, 0x1b, 0xdd, 0x65, 0x51, 0x1c, 0x90, 0x3c, 0x1f, 0xb2, 0x98,
+	0x28, 0xc6, 0x33, 0x19, 0x24, 0x00, 0xc1, 0xe4, 0x24, 0x48, 0x21, 0x03, 0xc9, 0xa4, 0x9f, 0x0b,
+	0xae, 0x38, 0x3e, 0x60, 0x51, 0xec, 0x2f, 0xc3, 0xfc, 0x04, 0xc0, 0x9f, 0x9c, 0x1c, 0x36, 0x52,
+	0x9e, 0x72, 0x83, 0x09, 0xf4, 0xaa, 0x80, 0x1f, 0xde, 0xa9, 0x52, 0xd5, 0xac, 0x25, 0x48, 0xcc,
+	0x05, 0x04, 0xf1, 0x39, 0xc9, 0x32, 0x18, 0xea, 0xb1, 0x5d, 0x16, 0x90, 0xd6, 0x6c, 0x03, 0xfd,
+	0xff, 0xb2, 0xd8, 0xc6, 0x1b, 0x45, 0x14, 0xe0, 0xf7, 0x68, 0x97, 0x51, 0xc8, 0x14, 0x4b, 0x18,
+	0xd0, 0x30, 0x01, 0x90, 0xae, 0xd3, 0x5c, 0x6f, 0xef, 0x74, 0x3b, 0x7e, 0xc5, 0xfe, 0xfc, 0xde,
+	0x25, 0xbe, 0x4f, 0xe2, 0x8f, 0xa0, 0xce, 0x00, 0xe4, 0xf3, 0x8d, 0x8b, 0xef, 0xc7, 0xb5, 0xc1,
+	0x8d, 0x5f, 0x5a, 0xba, 0x8b, 0x23, 0xd4, 0x48, 0x00, 0x42, 0xc8, 0x48, 0x34, 0x04, 0x1a, 0xda,
+	0xbd, 0x48, 0x77, 0xcd, 0x58, 0xdc, 0xab, 0xb4, 0x38, 0x03, 0x78, 0x51, 0x70, 0x4e, 0x0b, 0x8a,
+	0xd5, 0xc7, 0xc9, 0xea, 0x40, 0xe2, 0x77, 0x68, 0x5f, 0x40, 0xca, 0xa4, 0x02, 0x01, 0x34, 0xcc,
+	0xc9, 0x54, 0x7f, 0xc3, 0xba, 0x31, 0x68, 0x57, 0x1a, 0x0c, 0x2e, 0x19, 0x7d, 0x4d, 0xb0, 0xf2,
+	0x7b, 0xe2, 0x6a, 0x5b, 0xe2, 0xcf, 0x0e, 0xf2, 0x96, 0xd4, 0x63, 0x3e, 0xce, 0x14, 0x88, 0x9c,
+	0x08, 0x35, 0x2d, 0xad, 0x36, 0x8c, 0xd5, 0x83, 0xbf, 0xb0, 0x3a, 0x5d, 0x62, 0x2f, 0xdb, 0xde,
+	0x16, 0xd5, 0x10, 0x89, 0x43, 0xb4, 0x97, 0x70, 0xf1, 0x89, 0x08, 0x1a, 0x0a, 0x18, 0x92, 0x29,
+	0x08, 0xe9, 0x6e, 0x1a, 0x4f, 0xbf, 0xfa, 0xfc, 0x0a, 0xc2, 0xa0, 0xc0, 0x3f, 0xa3, 0x54, 0x80,
+	0x2c, 0x33, 0xda, 0x4d, 0xae, 0x0c, 0x25, 0x7e, 0x82, 0xea, 0x39, 0x11, 0x64, 0x24, 0xdd, 0x7a,
+	0xd3, 0x69, 0xef, 0x74, 0x8f, 0x2b, 0x65, 0xfb, 0x06, 0x66, 0x75, 0x2c, 0xa9, 0xf5, 0x0a, 0xed,
+	0x5f, 0x8b, 0x0b, 0x1f, 0xa0, 0xad, 0x9c, 0x0b, 0x15, 0x32, 0xea, 0x3a, 0x4d, 0xa7, 0xbd, 0x3d,
+	0xa8, 0xeb, 0xb2, 0x47, 0xf1, 0x11, 0x42, 0xf6, 0x2f, 0xd0, 0xb3, 0x35, 0x33, 0xdb, 0xb6, 0x9d,
+	0x1e, 0x6d, 0x7d, 0x40, 0xbb, 0x2b, 0xd1, 0xac, 0x30, 0x9c, 0x15, 0x06, 0x76, 0xd1, 0x96, 0x3d,
+	0x16, 0xab, 0x56, 0x96, 0xb8, 0x81, 0x36, 0x4d, 0x44, 0xee, 0xba, 0xe9, 0x17, 0x45, 0xeb, 0x8b,
+	0x83, 0x6e, 0xfd, 0x21, 0x92, 0x7f, 0xb7, 0xeb, 0x20, 0x7c, 0xfd, 0xf7, 0xb0, 0xde, 0xfb, 0xf1,
+	0xaa, 0x4f, 0x4b, 0xa2, 0x9b, 0xbf, 0x4d, 0x49, 0x3b, 0x90, 0x62, 0x69, 0xdd, 0xcb, 0x12, 0x3f,
+	0x45, 0xdb, 0xb9, 0xb9, 0x71, 0xe5, 0xd1, 0xed, 0x74, 0x8f, 0x4c, 0x56, 0xfa, 0xce, 0xfb, 0xe5,
+	0x45, 0x37, 0x39, 0x69, 0x54, 0x8f, 0xda, 0xa4, 0xfe, 0xcb, 0xcb, 0xfa, 0xf5, 0xc5, 0xdc, 0x73,
+	0x66, 0x73, 0xcf, 0xf9, 0x31, 0xf7, 0x9c, 0xaf, 0x0b, 0xaf, 0x36, 0x5b, 0x78, 0xb5, 0x6f, 0x0b,
+	0xaf, 0xf6, 0xf6, 0x61, 0xca, 0xd4, 0xf9, 0x38, 0xf2, 0x63, 0x3e, 0x0a, 0x62, 0x2e, 0x47, 0x5c,
+	0x06, 0x2c, 0x8a, 0x3b, 0x29, 0x0f, 0x26, 0x8f, 0x82, 0x11, 0xa7, 0xe3, 0x21, 0x48, 0xfd, 0xfc,
+	0xc8, 0xa0, 0xfb, 0xb8, 0xa3, 0x5f, 0x1e, 0x35, 0xcd, 0x41, 0x46, 0x75, 0xf3, 0xac, 0xdc, 0xff,
+	0x39, 0x00, 0x9e, 0x4d, 0x66, 0xeb, 0xf4, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +426,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -701,6 +721,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -983,6 +1005,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,9 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// ParamsKey defines the key to store the params in the keeper
+	ParamsKey = "params"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -113,6 +116,13 @@ func KeyRelayerAddressForAsyncAck(packetID channeltypes.PacketId) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%d", ForwardRelayerPrefix, packetID.PortId, packetID.ChannelId, packetID.Sequence))
 }
 
+// KeyForwardRelayerChannelPrefix returns the key prefix for the forward relayer addresses of packets
+// on the given channel. The prefix is terminated by a separator, so that it does not match the keys
+// of channels whose identifiers extend the given channel identifier.
+func KeyForwardRelayerChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ForwardRelayerPrefix, portID, channelID))
+}
+
 // ParseKeyRelayerAddressForAsyncAck parses the key used to store the forward relayer address and returns the packetID
 func ParseKeyRelayerAddressForAsyncAck(key string) (channeltypes.PacketId, error) {
 	keySplit := strings.Split(key, "/")
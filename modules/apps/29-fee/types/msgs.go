@@ -19,11 +19,13 @@ var (
 	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -147,3 +149,21 @@ func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
 
 	return msg.PacketFee.Validate()
 }
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Params.Validate()
+}
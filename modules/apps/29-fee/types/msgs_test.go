@@ -398,3 +398,39 @@ func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, refundAddr.Bytes(), signers[0])
 }
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateParams
+		expPass bool
+	}{
+		{"success", types.NewMsgUpdateParams(defaultAccAddress, types.DefaultParams()), true},
+		{"success with zero maximum", types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(0, "")), true},
+		{"success with fallback address", types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(types.DefaultMaxPacketFees, defaultAccAddress)), true},
+		{"invalid fallback address", types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(types.DefaultMaxPacketFees, invalidAddress)), false},
+		{"invalid signer address", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateParamsGetSigners(t *testing.T) {
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateParams(signer.String(), types.DefaultParams())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, signer.Bytes(), signers[0])
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// DefaultMaxPacketFees is the default maximum number of packet fees which can be escrowed for a single packet.
+const DefaultMaxPacketFees = 100
+
+// NewParams creates a new parameter configuration for the fee middleware.
+func NewParams(maxPacketFees uint64, fallbackAddress string) Params {
+	return Params{
+		MaxPacketFees:   maxPacketFees,
+		FallbackAddress: fallbackAddress,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the fee middleware.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxPacketFees, "")
+}
+
+// Validate performs basic validation of the fee middleware parameters.
+func (p Params) Validate() error {
+	if p.FallbackAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(p.FallbackAddress); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid fallback address: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ExceedsMaxPacketFees returns true if the provided number of packet fees escrowed for a single packet
+// is greater than the maximum number of packet fees. A maximum of zero disables the limit.
+func (p Params) ExceedsMaxPacketFees(packetFeeCount int) bool {
+	return p.MaxPacketFees != 0 && uint64(packetFeeCount) > p.MaxPacketFees
+}
@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+func TestExceedsMaxPacketFees(t *testing.T) {
+	testCases := []struct {
+		name          string
+		params        types.Params
+		packetFeeNum  int
+		expExceedsMax bool
+	}{
+		{"below maximum", types.NewParams(3, ""), 2, false},
+		{"at maximum", types.NewParams(3, ""), 3, false},
+		{"above maximum", types.NewParams(3, ""), 4, true},
+		{"zero maximum disables the limit", types.NewParams(0, ""), 1000, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		require.Equal(t, tc.expExceedsMax, tc.params.ExceedsMaxPacketFees(tc.packetFeeNum), tc.name)
+	}
+}
@@ -857,6 +857,139 @@ func (m *QueryCounterpartyPayeeResponse) GetCounterpartyPayee() string {
 	return ""
 }
 
+// QueryEscrowedFeesByRefundAccountRequest defines the request type for the EscrowedFeesByRefundAccount rpc
+type QueryEscrowedFeesByRefundAccountRequest struct {
+	// the refund address for which escrowed fees are queried
+	RefundAddress string `protobuf:"bytes,1,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// block height at which to query
+	QueryHeight uint64 `protobuf:"varint,2,opt,name=query_height,json=queryHeight,proto3" json:"query_height,omitempty"`
+	// pagination defines an optional pagination for the request, applied to the incentivized packets
+	// with fees escrowed by the refund address.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowedFeesByRefundAccountRequest) Reset() {
+	*m = QueryEscrowedFeesByRefundAccountRequest{}
+}
+func (m *QueryEscrowedFeesByRefundAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedFeesByRefundAccountRequest) ProtoMessage()    {}
+func (*QueryEscrowedFeesByRefundAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{16}
+}
+func (m *QueryEscrowedFeesByRefundAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedFeesByRefundAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedFeesByRefundAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowedFeesByRefundAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedFeesByRefundAccountRequest.Merge(m, src)
+}
+func (m *QueryEscrowedFeesByRefundAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedFeesByRefundAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedFeesByRefundAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowedFeesByRefundAccountRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowedFeesByRefundAccountRequest) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *QueryEscrowedFeesByRefundAccountRequest) GetQueryHeight() uint64 {
+	if m != nil {
+		return m.QueryHeight
+	}
+	return 0
+}
+
+func (m *QueryEscrowedFeesByRefundAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowedFeesByRefundAccountResponse defines the response type for the EscrowedFeesByRefundAccount rpc
+type QueryEscrowedFeesByRefundAccountResponse struct {
+	// the total fees held in escrow on behalf of the refund address for the incentivized packets of the page
+	TotalEscrowedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_escrowed_fees,json=totalEscrowedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed_fees"`
+	// list of identified fees escrowed by the refund address for incentivized packets
+	IncentivizedPackets []IdentifiedPacketFees `protobuf:"bytes,2,rep,name=incentivized_packets,json=incentivizedPackets,proto3" json:"incentivized_packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) Reset() {
+	*m = QueryEscrowedFeesByRefundAccountResponse{}
+}
+func (m *QueryEscrowedFeesByRefundAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedFeesByRefundAccountResponse) ProtoMessage()    {}
+func (*QueryEscrowedFeesByRefundAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{17}
+}
+func (m *QueryEscrowedFeesByRefundAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedFeesByRefundAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedFeesByRefundAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowedFeesByRefundAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedFeesByRefundAccountResponse.Merge(m, src)
+}
+func (m *QueryEscrowedFeesByRefundAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedFeesByRefundAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedFeesByRefundAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowedFeesByRefundAccountResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) GetTotalEscrowedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowedFees
+	}
+	return nil
+}
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) GetIncentivizedPackets() []IdentifiedPacketFees {
+	if m != nil {
+		return m.IncentivizedPackets
+	}
+	return nil
+}
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFeeEnabledChannelsRequest defines the request type for the FeeEnabledChannels rpc
 type QueryFeeEnabledChannelsRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -869,7 +1002,7 @@ func (m *QueryFeeEnabledChannelsRequest) Reset()         { *m = QueryFeeEnabledC
 func (m *QueryFeeEnabledChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelsRequest) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{16}
+	return fileDescriptor_0638a8a78ca2503c, []int{18}
 }
 func (m *QueryFeeEnabledChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1057,7 @@ func (m *QueryFeeEnabledChannelsResponse) Reset()         { *m = QueryFeeEnabled
 func (m *QueryFeeEnabledChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelsResponse) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{17}
+	return fileDescriptor_0638a8a78ca2503c, []int{19}
 }
 func (m *QueryFeeEnabledChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1112,7 @@ func (m *QueryFeeEnabledChannelRequest) Reset()         { *m = QueryFeeEnabledCh
 func (m *QueryFeeEnabledChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelRequest) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{18}
+	return fileDescriptor_0638a8a78ca2503c, []int{20}
 }
 func (m *QueryFeeEnabledChannelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,7 +1165,7 @@ func (m *QueryFeeEnabledChannelResponse) Reset()         { *m = QueryFeeEnabledC
 func (m *QueryFeeEnabledChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeEnabledChannelResponse) ProtoMessage()    {}
 func (*QueryFeeEnabledChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0638a8a78ca2503c, []int{19}
+	return fileDescriptor_0638a8a78ca2503c, []int{21}
 }
 func (m *QueryFeeEnabledChannelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,6 +1201,199 @@ func (m *QueryFeeEnabledChannelResponse) GetFeeEnabled() bool {
 	return false
 }
 
+// QueryAggregatedPacketFeesRequest defines the request type for the AggregatedPacketFees rpc
+type QueryAggregatedPacketFeesRequest struct {
+	// the packet identifier for the associated fees
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+}
+
+func (m *QueryAggregatedPacketFeesRequest) Reset()         { *m = QueryAggregatedPacketFeesRequest{} }
+func (m *QueryAggregatedPacketFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatedPacketFeesRequest) ProtoMessage()    {}
+func (*QueryAggregatedPacketFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{22}
+}
+func (m *QueryAggregatedPacketFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatedPacketFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatedPacketFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatedPacketFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatedPacketFeesRequest.Merge(m, src)
+}
+func (m *QueryAggregatedPacketFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatedPacketFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatedPacketFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatedPacketFeesRequest proto.InternalMessageInfo
+
+func (m *QueryAggregatedPacketFeesRequest) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+// QueryAggregatedPacketFeesResponse defines the response type for the AggregatedPacketFees rpc
+type QueryAggregatedPacketFeesResponse struct {
+	// the receive, acknowledgement and timeout fees summed over all packet fees
+	TotalFee Fee `protobuf:"bytes,1,opt,name=total_fee,json=totalFee,proto3" json:"total_fee"`
+	// the number of packet fees escrowed for the packet
+	PacketFeeCount uint64 `protobuf:"varint,2,opt,name=packet_fee_count,json=packetFeeCount,proto3" json:"packet_fee_count,omitempty"`
+	// the distinct refund addresses of the payers of the packet fees
+	RefundAddresses []string `protobuf:"bytes,3,rep,name=refund_addresses,json=refundAddresses,proto3" json:"refund_addresses,omitempty"`
+}
+
+func (m *QueryAggregatedPacketFeesResponse) Reset()         { *m = QueryAggregatedPacketFeesResponse{} }
+func (m *QueryAggregatedPacketFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregatedPacketFeesResponse) ProtoMessage()    {}
+func (*QueryAggregatedPacketFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{23}
+}
+func (m *QueryAggregatedPacketFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregatedPacketFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregatedPacketFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregatedPacketFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregatedPacketFeesResponse.Merge(m, src)
+}
+func (m *QueryAggregatedPacketFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregatedPacketFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregatedPacketFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregatedPacketFeesResponse proto.InternalMessageInfo
+
+func (m *QueryAggregatedPacketFeesResponse) GetTotalFee() Fee {
+	if m != nil {
+		return m.TotalFee
+	}
+	return Fee{}
+}
+
+func (m *QueryAggregatedPacketFeesResponse) GetPacketFeeCount() uint64 {
+	if m != nil {
+		return m.PacketFeeCount
+	}
+	return 0
+}
+
+func (m *QueryAggregatedPacketFeesResponse) GetRefundAddresses() []string {
+	if m != nil {
+		return m.RefundAddresses
+	}
+	return nil
+}
+
+// QueryParamsRequest defines the request type for the Params rpc
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{24}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for the Params rpc
+type QueryParamsResponse struct {
+	// params defines the parameters of the fee middleware
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{25}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryIncentivizedPacketsRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsRequest")
 	proto.RegisterType((*QueryIncentivizedPacketsResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsResponse")
@@ -1085,10 +1411,16 @@ func init() {
 	proto.RegisterType((*QueryPayeeResponse)(nil), "ibc.applications.fee.v1.QueryPayeeResponse")
 	proto.RegisterType((*QueryCounterpartyPayeeRequest)(nil), "ibc.applications.fee.v1.QueryCounterpartyPayeeRequest")
 	proto.RegisterType((*QueryCounterpartyPayeeResponse)(nil), "ibc.applications.fee.v1.QueryCounterpartyPayeeResponse")
+	proto.RegisterType((*QueryEscrowedFeesByRefundAccountRequest)(nil), "ibc.applications.fee.v1.QueryEscrowedFeesByRefundAccountRequest")
+	proto.RegisterType((*QueryEscrowedFeesByRefundAccountResponse)(nil), "ibc.applications.fee.v1.QueryEscrowedFeesByRefundAccountResponse")
 	proto.RegisterType((*QueryFeeEnabledChannelsRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsRequest")
 	proto.RegisterType((*QueryFeeEnabledChannelsResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsResponse")
 	proto.RegisterType((*QueryFeeEnabledChannelRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelRequest")
 	proto.RegisterType((*QueryFeeEnabledChannelResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelResponse")
+	proto.RegisterType((*QueryAggregatedPacketFeesRequest)(nil), "ibc.applications.fee.v1.QueryAggregatedPacketFeesRequest")
+	proto.RegisterType((*QueryAggregatedPacketFeesResponse)(nil), "ibc.applications.fee.v1.QueryAggregatedPacketFeesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.fee.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.fee.v1.QueryParamsResponse")
 }
 
 func init() {
@@ -1096,86 +1428,103 @@ func init() {
 }
 
 var fileDescriptor_0638a8a78ca2503c = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x6f, 0x4f, 0x1c, 0x45,
-	0x18, 0x67, 0xae, 0xb4, 0xc0, 0x03, 0x4d, 0x64, 0x20, 0x29, 0xdd, 0xc0, 0x41, 0xb7, 0xd6, 0x22,
-	0xe6, 0x76, 0xe5, 0x9a, 0x0a, 0xbc, 0x52, 0x40, 0xa9, 0x68, 0xb5, 0x78, 0x92, 0x68, 0x8c, 0xe6,
-	0xba, 0xb7, 0x3b, 0xb7, 0x6c, 0x38, 0x76, 0xb6, 0xbb, 0x7b, 0x17, 0x29, 0xe2, 0xff, 0xaa, 0x89,
-	0x26, 0x35, 0xf1, 0x53, 0x68, 0xe2, 0x07, 0xf0, 0x1b, 0xf4, 0x55, 0x43, 0xd2, 0x17, 0x1a, 0x5f,
-	0xa8, 0x01, 0xe3, 0x67, 0xf0, 0x85, 0x26, 0x66, 0x67, 0x66, 0xef, 0xf6, 0xd8, 0x5d, 0xee, 0x0e,
-	0x0f, 0xfa, 0x8a, 0xdd, 0x99, 0x79, 0x9e, 0xe7, 0xf7, 0xfb, 0xcd, 0xec, 0x3c, 0xbf, 0x03, 0x2e,
-	0x5b, 0x25, 0x5d, 0xd5, 0x1c, 0xa7, 0x62, 0xe9, 0x9a, 0x6f, 0x51, 0xdb, 0x53, 0xcb, 0x84, 0xa8,
-	0xb5, 0x59, 0xf5, 0x4e, 0x95, 0xb8, 0xdb, 0x8a, 0xe3, 0x52, 0x9f, 0xe2, 0x0b, 0x56, 0x49, 0x57,
-	0xa2, 0x8b, 0x94, 0x32, 0x21, 0x4a, 0x6d, 0x56, 0x1a, 0x35, 0xa9, 0x49, 0xd9, 0x1a, 0x35, 0x78,
-	0xe2, 0xcb, 0xa5, 0x71, 0x93, 0x52, 0xb3, 0x42, 0x54, 0xcd, 0xb1, 0x54, 0xcd, 0xb6, 0xa9, 0x2f,
-	0x82, 0xf8, 0x6c, 0x56, 0xa7, 0xde, 0x16, 0xf5, 0xd4, 0x92, 0xe6, 0x05, 0x85, 0x4a, 0xc4, 0xd7,
-	0x66, 0x55, 0x9d, 0x5a, 0xb6, 0x98, 0x9f, 0x89, 0xce, 0x33, 0x14, 0xf5, 0x55, 0x8e, 0x66, 0x5a,
-	0x36, 0x4b, 0x26, 0xd6, 0x5e, 0x4a, 0x43, 0x1f, 0xe0, 0xe3, 0x4b, 0xae, 0xa4, 0x2d, 0x31, 0x89,
-	0x4d, 0x3c, 0xcb, 0x8b, 0x66, 0xd2, 0xa9, 0x4b, 0x54, 0x7d, 0x43, 0xb3, 0x6d, 0x52, 0x09, 0x96,
-	0x88, 0x47, 0xbe, 0x44, 0xfe, 0x06, 0xc1, 0xe4, 0x1b, 0x01, 0x9e, 0x55, 0x5b, 0x27, 0xb6, 0x6f,
-	0xd5, 0xac, 0xbb, 0xc4, 0x58, 0xd3, 0xf4, 0x4d, 0xe2, 0x7b, 0x05, 0x72, 0xa7, 0x4a, 0x3c, 0x1f,
-	0xaf, 0x00, 0x34, 0x40, 0x8e, 0xa1, 0x29, 0x34, 0x3d, 0x98, 0x7f, 0x4a, 0xe1, 0x8c, 0x94, 0x80,
-	0x91, 0xc2, 0x75, 0x15, 0x8c, 0x94, 0x35, 0xcd, 0x24, 0x22, 0xb6, 0x10, 0x89, 0xc4, 0x97, 0x60,
-	0x88, 0x2d, 0x2c, 0x6e, 0x10, 0xcb, 0xdc, 0xf0, 0xc7, 0x32, 0x53, 0x68, 0xba, 0xb7, 0x30, 0xc8,
-	0xc6, 0x5e, 0x66, 0x43, 0xf2, 0x23, 0x04, 0x53, 0xe9, 0x70, 0x3c, 0x87, 0xda, 0x1e, 0xc1, 0x65,
-	0x18, 0xb5, 0x22, 0xd3, 0x45, 0x87, 0xcf, 0x8f, 0xa1, 0xa9, 0x33, 0xd3, 0x83, 0xf9, 0x9c, 0x92,
-	0xb2, 0xb1, 0xca, 0xaa, 0x11, 0xc4, 0x94, 0xad, 0x30, 0xe3, 0x0a, 0x21, 0xde, 0x52, 0xef, 0x83,
-	0xdf, 0x26, 0x7b, 0x0a, 0x23, 0x56, 0xbc, 0x1e, 0xbe, 0xd1, 0xc4, 0x3b, 0xc3, 0x78, 0x5f, 0x6d,
-	0xc9, 0x9b, 0x83, 0x8c, 0x12, 0x97, 0xef, 0x21, 0xc8, 0xa6, 0xb0, 0x0a, 0x35, 0x7e, 0x01, 0x06,
-	0x38, 0x8d, 0xa2, 0x65, 0x08, 0x89, 0x27, 0x18, 0x91, 0x60, 0xfb, 0x94, 0x70, 0xcf, 0x6a, 0x41,
-	0x91, 0x60, 0xd5, 0xaa, 0x21, 0x80, 0xf7, 0x3b, 0xe2, 0xbd, 0x1d, 0x75, 0xbf, 0x4c, 0xdf, 0xec,
-	0xba, 0xb8, 0x06, 0x8c, 0x24, 0x88, 0x2b, 0x20, 0x1d, 0x4b, 0x5b, 0x1c, 0xd7, 0x56, 0x7e, 0x88,
-	0xe0, 0xe9, 0xb4, 0x7d, 0x5e, 0xa1, 0xee, 0x32, 0xe7, 0xdb, 0xed, 0x03, 0x78, 0x01, 0xfa, 0x1c,
-	0xea, 0x32, 0x89, 0x03, 0x75, 0x06, 0x0a, 0xe7, 0x82, 0xd7, 0x55, 0x03, 0x4f, 0x00, 0x08, 0x89,
-	0x83, 0xb9, 0x33, 0x6c, 0x6e, 0x40, 0x8c, 0x24, 0x48, 0xdb, 0x1b, 0x97, 0xf6, 0x67, 0x04, 0x33,
-	0xed, 0x10, 0x12, 0x2a, 0xdf, 0xee, 0xe2, 0x11, 0x3e, 0xe1, 0xc3, 0xfb, 0x1e, 0x5c, 0x64, 0xc4,
-	0xd6, 0xa9, 0xaf, 0x55, 0x0a, 0x44, 0xaf, 0xb1, 0x9a, 0xdd, 0x3a, 0xb6, 0xf2, 0x17, 0x08, 0xa4,
-	0xa4, 0xfc, 0x42, 0xa8, 0x0d, 0x18, 0x70, 0x89, 0x5e, 0x2b, 0x96, 0x09, 0x09, 0xd5, 0xb9, 0xd8,
-	0xc4, 0x22, 0xc4, 0xbf, 0x4c, 0x2d, 0x7b, 0xe9, 0xd9, 0x20, 0xf9, 0x0f, 0xbf, 0x4f, 0x4e, 0x9b,
-	0x96, 0xbf, 0x51, 0x2d, 0x29, 0x3a, 0xdd, 0x52, 0xc5, 0xcd, 0xcb, 0xff, 0xe4, 0x3c, 0x63, 0x53,
-	0xf5, 0xb7, 0x1d, 0xe2, 0xb1, 0x00, 0xaf, 0xd0, 0xef, 0x8a, 0x8a, 0xf2, 0xbb, 0x30, 0xd6, 0xc0,
-	0xb1, 0xa8, 0x6f, 0x76, 0x97, 0xe6, 0x67, 0x28, 0x2a, 0x63, 0x3d, 0x7d, 0xfd, 0x46, 0xeb, 0xd7,
-	0xf4, 0xcd, 0x13, 0x23, 0xd9, 0xa7, 0xf1, 0x7a, 0xf2, 0x6d, 0x18, 0x6f, 0x80, 0x58, 0xb7, 0xb6,
-	0x08, 0xad, 0xfa, 0xdd, 0xe5, 0x79, 0x1f, 0xc1, 0x44, 0x4a, 0x09, 0xc1, 0xd5, 0x86, 0x21, 0x9f,
-	0x0f, 0x9f, 0x18, 0xdf, 0x41, 0xbf, 0x51, 0x57, 0xbe, 0x09, 0xc3, 0x0c, 0xd0, 0x9a, 0xb6, 0x4d,
-	0xc2, 0x5b, 0xe1, 0xd0, 0x07, 0x8f, 0x0e, 0x7f, 0xf0, 0x63, 0xd0, 0xe7, 0x92, 0x8a, 0xb6, 0x4d,
-	0x5c, 0x71, 0x51, 0x84, 0xaf, 0xf2, 0x02, 0xe0, 0x68, 0x36, 0xc1, 0xe9, 0x32, 0x9c, 0x77, 0x82,
-	0x81, 0xa2, 0x66, 0x18, 0x2e, 0xf1, 0x3c, 0x91, 0x71, 0x88, 0x0d, 0x2e, 0xf2, 0x31, 0xf9, 0x6d,
-	0xa1, 0xcc, 0x32, 0xad, 0xda, 0x3e, 0x71, 0x1d, 0xcd, 0xf5, 0xbb, 0x04, 0xea, 0x96, 0x68, 0x2f,
-	0x09, 0x99, 0x05, 0xc0, 0x1c, 0x60, 0x3d, 0x32, 0x59, 0x64, 0xc0, 0x44, 0x89, 0x61, 0xfd, 0x70,
-	0x98, 0xfc, 0x75, 0xd8, 0xb0, 0x56, 0x08, 0x79, 0xc9, 0xd6, 0x4a, 0x15, 0x62, 0x88, 0x1b, 0xec,
-	0x71, 0x98, 0x82, 0x87, 0x61, 0xdb, 0x4a, 0x42, 0x23, 0x08, 0x96, 0x60, 0xb4, 0x4c, 0x48, 0x91,
-	0xf0, 0xe9, 0xa2, 0x50, 0x2d, 0x3c, 0x5d, 0x33, 0xa9, 0x17, 0x6a, 0x2c, 0x65, 0xd8, 0xb4, 0xca,
-	0xb1, 0x5a, 0xdd, 0xbb, 0x52, 0xdf, 0x12, 0x27, 0x21, 0x56, 0x3c, 0x14, 0x37, 0xd2, 0xa8, 0xd0,
-	0x11, 0x8d, 0x2a, 0x73, 0xe8, 0x88, 0xc8, 0x8b, 0x69, 0xdb, 0x56, 0xd7, 0x69, 0x12, 0x06, 0x23,
-	0x3a, 0xb1, 0xec, 0xfd, 0x05, 0x68, 0x90, 0xcd, 0xff, 0x35, 0x0c, 0x67, 0x59, 0x0e, 0xfc, 0x13,
-	0x82, 0x91, 0x84, 0x6e, 0x86, 0xe7, 0x53, 0xc5, 0x6c, 0x61, 0x24, 0xa5, 0x85, 0x63, 0x44, 0x72,
-	0xdc, 0x72, 0xee, 0xd3, 0x47, 0x7f, 0x7e, 0x97, 0xb9, 0x8a, 0xaf, 0xa8, 0xc2, 0xfa, 0xd6, 0x2d,
-	0x6f, 0x52, 0x1f, 0xc5, 0xf7, 0x33, 0x80, 0xe3, 0xe9, 0xf0, 0x5c, 0xa7, 0x00, 0x42, 0xe4, 0xf3,
-	0x9d, 0x07, 0x0a, 0xe0, 0xf7, 0x10, 0x43, 0xfe, 0x11, 0xde, 0x8d, 0x21, 0x0f, 0x0f, 0xa9, 0xba,
-	0x53, 0xbf, 0x74, 0x95, 0xc6, 0xee, 0xee, 0xaa, 0xc1, 0x9e, 0x37, 0x4d, 0x8a, 0x33, 0xb1, 0xab,
-	0x7a, 0x01, 0x2c, 0x5b, 0x27, 0x4d, 0xb3, 0xe1, 0xe0, 0x6e, 0x92, 0x24, 0xf8, 0x5f, 0x04, 0x13,
-	0x47, 0x7a, 0x13, 0xbc, 0xd4, 0xf1, 0xee, 0xc4, 0x9c, 0x9a, 0xb4, 0xfc, 0xbf, 0x72, 0x08, 0xc9,
-	0xde, 0x64, 0x8a, 0xbd, 0x86, 0x5f, 0x3d, 0x42, 0xb1, 0x24, 0x9d, 0x42, 0x75, 0x12, 0x4f, 0xc4,
-	0x3f, 0x08, 0xce, 0x37, 0x59, 0x0c, 0x9c, 0x3f, 0x1a, 0x6b, 0x92, 0xdf, 0x91, 0xae, 0x75, 0x14,
-	0x23, 0xf8, 0x7c, 0xc2, 0x8f, 0xc0, 0x0e, 0xde, 0x3e, 0xbd, 0x23, 0xe0, 0x07, 0x48, 0x8a, 0x75,
-	0xeb, 0x84, 0xff, 0x46, 0x30, 0x14, 0xb5, 0x1e, 0x78, 0xb6, 0x0d, 0x26, 0xcd, 0x2e, 0x48, 0xca,
-	0x77, 0x12, 0x22, 0xb8, 0x7f, 0xcc, 0xb9, 0xdf, 0xc5, 0xef, 0x9f, 0x36, 0xf7, 0xd0, 0x50, 0xe1,
-	0xaf, 0x32, 0xf0, 0xc4, 0x61, 0x37, 0x82, 0xaf, 0xb7, 0xc1, 0x25, 0x6e, 0x90, 0xa4, 0xe7, 0x3a,
-	0x0d, 0x13, 0x32, 0x7c, 0xce, 0x65, 0xf8, 0x10, 0x7f, 0x70, 0xda, 0x32, 0x44, 0xbd, 0x16, 0xfe,
-	0x1e, 0xc1, 0x59, 0xd6, 0xe1, 0xf1, 0xcc, 0xd1, 0x44, 0xa2, 0xbe, 0x44, 0x7a, 0xa6, 0xad, 0xb5,
-	0x82, 0xe9, 0x0d, 0x46, 0x74, 0x11, 0x3f, 0xdf, 0xe6, 0xc7, 0x2b, 0x3c, 0x8c, 0xa7, 0xee, 0x88,
-	0xa7, 0x5d, 0x95, 0x99, 0x13, 0xfc, 0x2b, 0x82, 0xe1, 0x98, 0xa1, 0xc1, 0x2d, 0x36, 0x20, 0xcd,
-	0x5b, 0x49, 0x73, 0x1d, 0xc7, 0x09, 0x3e, 0xeb, 0x8c, 0xcf, 0xeb, 0xf8, 0xe6, 0xf1, 0xf9, 0xc4,
-	0x9d, 0x17, 0xfe, 0x11, 0x01, 0x8e, 0xbb, 0x99, 0x56, 0xfd, 0x29, 0xd5, 0x8d, 0xb5, 0xea, 0x4f,
-	0xe9, 0xc6, 0x49, 0x7e, 0x92, 0xf1, 0xcb, 0xe2, 0xf1, 0x18, 0xbf, 0x88, 0x4f, 0xc0, 0x7b, 0x08,
-	0x86, 0x63, 0x49, 0x5a, 0x6d, 0x46, 0x9a, 0xbd, 0x91, 0xe6, 0x3a, 0x8e, 0x13, 0x60, 0x5f, 0x61,
-	0x60, 0x5f, 0xc4, 0x4b, 0xc7, 0xec, 0x0c, 0x11, 0x4a, 0x4b, 0xb7, 0x1e, 0xec, 0x67, 0xd1, 0xde,
-	0x7e, 0x16, 0xfd, 0xb1, 0x9f, 0x45, 0xdf, 0x1e, 0x64, 0x7b, 0xf6, 0x0e, 0xb2, 0x3d, 0xbf, 0x1c,
-	0x64, 0x7b, 0xde, 0xb9, 0x1e, 0xff, 0xa1, 0x61, 0x95, 0xf4, 0x9c, 0x49, 0xd5, 0xda, 0xbc, 0xba,
-	0x45, 0x8d, 0x6a, 0x85, 0x78, 0xbc, 0x78, 0x7e, 0x21, 0x17, 0xd4, 0x67, 0xbf, 0x3d, 0x4a, 0xe7,
-	0xd8, 0x7f, 0xd4, 0xae, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xe2, 0xa0, 0x5d, 0x7e, 0x14,
-	0x00, 0x00,
+	// 1536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0x1b, 0x45,
+	0x17, 0xce, 0x38, 0x6d, 0x9a, 0x9c, 0xa4, 0x7d, 0x9b, 0x49, 0xf4, 0x36, 0x59, 0x12, 0x27, 0xdd,
+	0x52, 0x9a, 0x06, 0xe2, 0x25, 0xa9, 0x4a, 0x53, 0x24, 0xd4, 0x26, 0xa1, 0x29, 0x81, 0x42, 0x8b,
+	0x89, 0x04, 0x42, 0x20, 0x77, 0xbd, 0x3b, 0x76, 0x56, 0x71, 0x76, 0xb7, 0xbb, 0x6b, 0x43, 0x1a,
+	0xc2, 0x67, 0x0b, 0x48, 0x20, 0x15, 0xc4, 0xaf, 0x00, 0x89, 0x1f, 0x50, 0xf1, 0x07, 0x7a, 0x55,
+	0x55, 0xea, 0x05, 0x15, 0x17, 0x80, 0x5a, 0xee, 0xb8, 0xe0, 0x96, 0x0b, 0x90, 0xd0, 0xce, 0x9c,
+	0x75, 0xd6, 0x5e, 0xaf, 0xbf, 0xea, 0xb4, 0x57, 0xb1, 0x67, 0xe6, 0x9c, 0xf3, 0x3c, 0xcf, 0xcc,
+	0x9c, 0x39, 0xc7, 0x81, 0x23, 0x46, 0x56, 0x53, 0x54, 0xdb, 0x2e, 0x18, 0x9a, 0xea, 0x19, 0x96,
+	0xe9, 0x2a, 0x39, 0xc6, 0x94, 0xd2, 0xac, 0x72, 0xa5, 0xc8, 0x9c, 0xcd, 0x94, 0xed, 0x58, 0x9e,
+	0x45, 0x0f, 0x19, 0x59, 0x2d, 0x15, 0x5e, 0x94, 0xca, 0x31, 0x96, 0x2a, 0xcd, 0x4a, 0xc3, 0x79,
+	0x2b, 0x6f, 0xf1, 0x35, 0x8a, 0xff, 0x49, 0x2c, 0x97, 0xc6, 0xf2, 0x96, 0x95, 0x2f, 0x30, 0x45,
+	0xb5, 0x0d, 0x45, 0x35, 0x4d, 0xcb, 0x43, 0x23, 0x31, 0x9b, 0xd4, 0x2c, 0x77, 0xc3, 0x72, 0x95,
+	0xac, 0xea, 0xfa, 0x81, 0xb2, 0xcc, 0x53, 0x67, 0x15, 0xcd, 0x32, 0x4c, 0x9c, 0x9f, 0x0e, 0xcf,
+	0x73, 0x14, 0xe5, 0x55, 0xb6, 0x9a, 0x37, 0x4c, 0xee, 0x0c, 0xd7, 0x1e, 0x8e, 0x43, 0xef, 0xe3,
+	0x13, 0x4b, 0x8e, 0xc6, 0x2d, 0xc9, 0x33, 0x93, 0xb9, 0x86, 0x1b, 0xf6, 0xa4, 0x59, 0x0e, 0x53,
+	0xb4, 0x35, 0xd5, 0x34, 0x59, 0xc1, 0x5f, 0x82, 0x1f, 0xc5, 0x12, 0xf9, 0x6b, 0x02, 0x13, 0xaf,
+	0xfb, 0x78, 0x56, 0x4c, 0x8d, 0x99, 0x9e, 0x51, 0x32, 0xae, 0x32, 0xfd, 0x92, 0xaa, 0xad, 0x33,
+	0xcf, 0x4d, 0xb3, 0x2b, 0x45, 0xe6, 0x7a, 0x74, 0x19, 0x60, 0x07, 0xe4, 0x08, 0x99, 0x24, 0x53,
+	0xfd, 0x73, 0x4f, 0xa5, 0x04, 0xa3, 0x94, 0xcf, 0x28, 0x25, 0x74, 0x45, 0x46, 0xa9, 0x4b, 0x6a,
+	0x9e, 0xa1, 0x6d, 0x3a, 0x64, 0x49, 0x0f, 0xc3, 0x00, 0x5f, 0x98, 0x59, 0x63, 0x46, 0x7e, 0xcd,
+	0x1b, 0x49, 0x4c, 0x92, 0xa9, 0x3d, 0xe9, 0x7e, 0x3e, 0xf6, 0x12, 0x1f, 0x92, 0xef, 0x12, 0x98,
+	0x8c, 0x87, 0xe3, 0xda, 0x96, 0xe9, 0x32, 0x9a, 0x83, 0x61, 0x23, 0x34, 0x9d, 0xb1, 0xc5, 0xfc,
+	0x08, 0x99, 0xec, 0x9e, 0xea, 0x9f, 0x9b, 0x49, 0xc5, 0x6c, 0x6c, 0x6a, 0x45, 0xf7, 0x6d, 0x72,
+	0x46, 0xe0, 0x71, 0x99, 0x31, 0x77, 0x71, 0xcf, 0xad, 0x5f, 0x27, 0xba, 0xd2, 0x43, 0x46, 0x34,
+	0x1e, 0x3d, 0x5f, 0xc1, 0x3b, 0xc1, 0x79, 0x1f, 0x6b, 0xc8, 0x5b, 0x80, 0x0c, 0x13, 0x97, 0xaf,
+	0x13, 0x48, 0xc6, 0xb0, 0x0a, 0x34, 0x3e, 0x0b, 0x7d, 0x82, 0x46, 0xc6, 0xd0, 0x51, 0xe2, 0x71,
+	0x4e, 0xc4, 0xdf, 0xbe, 0x54, 0xb0, 0x67, 0x25, 0x3f, 0x88, 0xbf, 0x6a, 0x45, 0x47, 0xe0, 0xbd,
+	0x36, 0x7e, 0x6f, 0x46, 0xdd, 0x2f, 0xe2, 0x37, 0xbb, 0x2c, 0xae, 0x0e, 0x43, 0x35, 0xc4, 0x45,
+	0x48, 0x6d, 0x69, 0x4b, 0xa3, 0xda, 0xca, 0xb7, 0x09, 0x1c, 0x8f, 0xdb, 0xe7, 0x65, 0xcb, 0x59,
+	0x12, 0x7c, 0x3b, 0x7d, 0x00, 0x0f, 0xc1, 0x3e, 0xdb, 0x72, 0xb8, 0xc4, 0xbe, 0x3a, 0x7d, 0xe9,
+	0x1e, 0xff, 0xeb, 0x8a, 0x4e, 0xc7, 0x01, 0x50, 0x62, 0x7f, 0xae, 0x9b, 0xcf, 0xf5, 0xe1, 0x48,
+	0x0d, 0x69, 0xf7, 0x44, 0xa5, 0xfd, 0x99, 0xc0, 0x74, 0x33, 0x84, 0x50, 0xe5, 0xcb, 0x1d, 0x3c,
+	0xc2, 0xbb, 0x7c, 0x78, 0xdf, 0x85, 0x51, 0x4e, 0x6c, 0xd5, 0xf2, 0xd4, 0x42, 0x9a, 0x69, 0x25,
+	0x1e, 0xb3, 0x53, 0xc7, 0x56, 0xfe, 0x9c, 0x80, 0x54, 0xcb, 0x3f, 0x0a, 0xb5, 0x06, 0x7d, 0x0e,
+	0xd3, 0x4a, 0x99, 0x1c, 0x63, 0x81, 0x3a, 0xa3, 0x15, 0x2c, 0x02, 0xfc, 0x4b, 0x96, 0x61, 0x2e,
+	0x3e, 0xeb, 0x3b, 0xff, 0xe1, 0xb7, 0x89, 0xa9, 0xbc, 0xe1, 0xad, 0x15, 0xb3, 0x29, 0xcd, 0xda,
+	0x50, 0xc4, 0x62, 0xfc, 0x33, 0xe3, 0xea, 0xeb, 0x8a, 0xb7, 0x69, 0x33, 0x97, 0x1b, 0xb8, 0xe9,
+	0x5e, 0x07, 0x23, 0xca, 0xef, 0xc0, 0xc8, 0x0e, 0x8e, 0x05, 0x6d, 0xbd, 0xb3, 0x34, 0x3f, 0x23,
+	0x30, 0x5a, 0xc3, 0x7d, 0x39, 0xa3, 0xf5, 0xaa, 0xda, 0xfa, 0xae, 0x91, 0xdc, 0xa7, 0x8a, 0x78,
+	0xf2, 0x65, 0x18, 0xdb, 0x01, 0xb1, 0x6a, 0x6c, 0x30, 0xab, 0xe8, 0x75, 0x96, 0xe7, 0x0d, 0x02,
+	0xe3, 0x31, 0x21, 0x90, 0xab, 0x09, 0x03, 0x9e, 0x18, 0xde, 0x35, 0xbe, 0xfd, 0xde, 0x4e, 0x5c,
+	0xf9, 0x02, 0x0c, 0x72, 0x40, 0x97, 0xd4, 0x4d, 0x16, 0x64, 0x85, 0xaa, 0x0b, 0x4f, 0xaa, 0x2f,
+	0xfc, 0x08, 0xec, 0x73, 0x58, 0x41, 0xdd, 0x64, 0x0e, 0x26, 0x8a, 0xe0, 0xab, 0x7c, 0x1a, 0x68,
+	0xd8, 0x1b, 0x72, 0x3a, 0x02, 0xfb, 0x6d, 0x7f, 0x20, 0xa3, 0xea, 0xba, 0xc3, 0x5c, 0x17, 0x3d,
+	0x0e, 0xf0, 0xc1, 0x05, 0x31, 0x26, 0xbf, 0x85, 0xca, 0x2c, 0x59, 0x45, 0xd3, 0x63, 0x8e, 0xad,
+	0x3a, 0x5e, 0x87, 0x40, 0x5d, 0x84, 0x64, 0x9c, 0x67, 0x04, 0x38, 0x03, 0x54, 0x0b, 0x4d, 0x66,
+	0x38, 0x30, 0x0c, 0x31, 0xa8, 0x55, 0x9b, 0xc9, 0x3f, 0x11, 0x38, 0xc6, 0x3d, 0x9e, 0x73, 0x35,
+	0xc7, 0x7a, 0x8f, 0xe9, 0x3c, 0x9d, 0x6f, 0xa6, 0x59, 0xae, 0x68, 0xea, 0x0b, 0x1a, 0x37, 0x08,
+	0x50, 0x1f, 0x85, 0x03, 0x0e, 0x1f, 0xaf, 0x22, 0xbf, 0x5f, 0x8c, 0x22, 0xfb, 0x26, 0x9e, 0xa7,
+	0xaa, 0x34, 0xdf, 0xdd, 0x6e, 0x9a, 0x97, 0xef, 0x25, 0x60, 0xaa, 0x31, 0x7a, 0x54, 0x66, 0x0b,
+	0x86, 0x3c, 0xff, 0xa8, 0x66, 0x18, 0x2e, 0xde, 0xb5, 0x53, 0x39, 0xc8, 0xe3, 0x84, 0x31, 0xc5,
+	0x56, 0x32, 0x89, 0x5d, 0xad, 0x64, 0xba, 0xdb, 0x7f, 0x0c, 0xbe, 0x0a, 0x2a, 0x99, 0x65, 0xc6,
+	0xce, 0x99, 0x6a, 0xb6, 0xc0, 0x74, 0x7c, 0xda, 0x1e, 0x47, 0xb5, 0x78, 0x3b, 0xa8, 0x67, 0x6a,
+	0xa1, 0xc1, 0xfd, 0xcd, 0xc2, 0x70, 0x8e, 0xb1, 0x0c, 0x13, 0xd3, 0x19, 0xbc, 0x4e, 0xc1, 0x06,
+	0x4f, 0xc7, 0x4a, 0x1c, 0x71, 0x19, 0x54, 0x33, 0xb9, 0x48, 0xac, 0xce, 0xbd, 0xb5, 0x6f, 0x62,
+	0x8a, 0x88, 0x04, 0x0f, 0xc4, 0x0d, 0x55, 0x30, 0xa4, 0x4e, 0x05, 0x93, 0xa8, 0xca, 0x1d, 0xf2,
+	0x42, 0xdc, 0xb6, 0x95, 0x75, 0x9a, 0x80, 0xfe, 0x90, 0x4e, 0xdc, 0x7b, 0x6f, 0x1a, 0x76, 0xc8,
+	0xca, 0x3a, 0x56, 0xe6, 0x0b, 0xf9, 0xbc, 0xc3, 0xf2, 0xaa, 0x57, 0x51, 0x82, 0x74, 0xec, 0xfd,
+	0xb8, 0x49, 0xe0, 0x70, 0x9d, 0x30, 0x08, 0xf6, 0x0c, 0xf4, 0x89, 0x4b, 0x9b, 0xc3, 0x2c, 0xd6,
+	0x3f, 0x37, 0x56, 0x6f, 0x27, 0x83, 0x30, 0xdc, 0x68, 0x99, 0x31, 0x3a, 0x05, 0x07, 0x11, 0xa8,
+	0x4f, 0x9a, 0x67, 0x04, 0x3c, 0x60, 0x07, 0xec, 0x20, 0x1c, 0xcf, 0xa6, 0xf4, 0x38, 0x1c, 0xac,
+	0x4c, 0x6f, 0xcc, 0x1d, 0xe9, 0x9e, 0xec, 0x9e, 0xea, 0x4b, 0xff, 0xaf, 0x22, 0xc1, 0x31, 0x57,
+	0x1e, 0x2e, 0xbf, 0x0d, 0x8e, 0xba, 0x11, 0x68, 0x22, 0xaf, 0xc2, 0x50, 0xc5, 0x28, 0x52, 0x78,
+	0x01, 0x7a, 0x6c, 0x3e, 0x82, 0xf8, 0x27, 0x62, 0xf1, 0x0b, 0x43, 0xa4, 0x80, 0x46, 0x73, 0x7f,
+	0xfd, 0x1f, 0xf6, 0x72, 0xb7, 0xf4, 0x26, 0x81, 0xa1, 0x1a, 0x45, 0x27, 0x9d, 0x8f, 0x75, 0xd8,
+	0xa0, 0xdf, 0x93, 0x4e, 0xb7, 0x61, 0x29, 0x58, 0xc9, 0x33, 0x9f, 0xde, 0xfd, 0xe3, 0xbb, 0xc4,
+	0x31, 0x7a, 0x54, 0xc1, 0x0e, 0xb5, 0xdc, 0x99, 0xd6, 0xca, 0x73, 0xf4, 0x46, 0x02, 0x68, 0xd4,
+	0x1d, 0x3d, 0xd5, 0x2a, 0x80, 0x00, 0xf9, 0x7c, 0xeb, 0x86, 0x08, 0xfc, 0x3a, 0xe1, 0xc8, 0x3f,
+	0xa2, 0xdb, 0x11, 0xe4, 0x41, 0xca, 0x50, 0xb6, 0xca, 0x67, 0x3b, 0xb5, 0x73, 0xd7, 0xb6, 0x15,
+	0xff, 0x06, 0x56, 0x4c, 0xe2, 0x0d, 0xdd, 0x56, 0x5c, 0x1f, 0x96, 0xa9, 0xb1, 0x8a, 0xd9, 0x60,
+	0x70, 0xbb, 0x96, 0x24, 0xf4, 0x5f, 0x02, 0xe3, 0x75, 0x5b, 0x08, 0xba, 0xd8, 0xf2, 0xee, 0x44,
+	0x1a, 0x2a, 0x69, 0xe9, 0xa1, 0x7c, 0xa0, 0x64, 0x6f, 0x70, 0xc5, 0x5e, 0xa5, 0xaf, 0xd4, 0x51,
+	0xac, 0x96, 0x4e, 0x81, 0x3a, 0x35, 0x4f, 0xc4, 0x3f, 0x04, 0xf6, 0x57, 0x74, 0x02, 0x74, 0xae,
+	0x3e, 0xd6, 0x5a, 0x6d, 0x89, 0x74, 0xa2, 0x25, 0x1b, 0xe4, 0xf3, 0x89, 0x38, 0x02, 0x5b, 0x74,
+	0xf3, 0xd1, 0x1d, 0x01, 0x91, 0xc5, 0xca, 0x1d, 0x0e, 0xfd, 0x9b, 0xc0, 0x40, 0xb8, 0x43, 0xa0,
+	0xb3, 0x4d, 0x30, 0xa9, 0x6c, 0x56, 0xa4, 0xb9, 0x56, 0x4c, 0x90, 0xfb, 0xc7, 0x82, 0xfb, 0x55,
+	0xfa, 0xfe, 0xa3, 0xe6, 0x1e, 0xf4, 0x3d, 0xf4, 0xcb, 0x04, 0x1c, 0xac, 0x6e, 0x1a, 0xe8, 0xc9,
+	0x26, 0xb8, 0x44, 0xfb, 0x18, 0xe9, 0xb9, 0x56, 0xcd, 0x50, 0x86, 0x6b, 0x42, 0x86, 0x0f, 0xe9,
+	0x07, 0x8f, 0x5a, 0x86, 0x70, 0x4b, 0x44, 0xbf, 0x27, 0xb0, 0x97, 0x17, 0xe2, 0x74, 0xba, 0x3e,
+	0x91, 0x70, 0xfb, 0x20, 0x3d, 0xdd, 0xd4, 0x5a, 0x64, 0x7a, 0x9e, 0x13, 0x5d, 0xa0, 0x67, 0x9a,
+	0xbc, 0xbc, 0xd8, 0x6a, 0xb8, 0xca, 0x16, 0x7e, 0xda, 0x56, 0x78, 0x0f, 0x41, 0x7f, 0x21, 0x30,
+	0x18, 0xe9, 0x3b, 0x68, 0x83, 0x0d, 0x88, 0x6b, 0x81, 0xa4, 0x53, 0x2d, 0xdb, 0x21, 0x9f, 0x55,
+	0xce, 0xe7, 0x35, 0x7a, 0xa1, 0x7d, 0x3e, 0xd1, 0x06, 0x89, 0xfe, 0x49, 0xe0, 0x89, 0x3a, 0x4d,
+	0x04, 0x3d, 0x5b, 0x1f, 0x6e, 0xe3, 0xee, 0x49, 0x5a, 0x78, 0x08, 0x0f, 0x0d, 0xb7, 0x32, 0x28,
+	0x5c, 0x84, 0x01, 0xe7, 0x1a, 0xae, 0x64, 0xb6, 0x95, 0x8a, 0x9e, 0x87, 0xfe, 0x48, 0x80, 0x46,
+	0x2b, 0xe9, 0x46, 0xaf, 0x71, 0x6c, 0x27, 0x20, 0xcd, 0xb7, 0x6e, 0x88, 0x94, 0x9e, 0xe4, 0x94,
+	0x92, 0x74, 0x2c, 0x42, 0x29, 0x54, 0xa3, 0xd2, 0x3b, 0x04, 0x06, 0x23, 0x4e, 0x1a, 0x1d, 0xbd,
+	0xb8, 0xd2, 0x5a, 0x3a, 0xd5, 0xb2, 0x1d, 0x82, 0x7d, 0x99, 0x83, 0x7d, 0x91, 0x2e, 0xb6, 0xf9,
+	0x0e, 0x86, 0x29, 0x7d, 0x9b, 0x80, 0xe1, 0x5a, 0x95, 0x2f, 0x6d, 0x50, 0x93, 0xd5, 0x29, 0xca,
+	0xa5, 0xe7, 0xdb, 0x31, 0x7d, 0x8c, 0x6f, 0xa2, 0x5a, 0x06, 0x24, 0x8e, 0xe5, 0x35, 0x02, 0x3d,
+	0xa2, 0x06, 0xa6, 0x0d, 0x53, 0x5c, 0xa8, 0xf0, 0x96, 0x9e, 0x69, 0x6e, 0x31, 0x32, 0x9d, 0xe0,
+	0x44, 0x47, 0xe9, 0xa1, 0x08, 0x51, 0x51, 0x71, 0x2f, 0x5e, 0xbc, 0x75, 0x3f, 0x49, 0xee, 0xdc,
+	0x4f, 0x92, 0xdf, 0xef, 0x27, 0xc9, 0x37, 0x0f, 0x92, 0x5d, 0x77, 0x1e, 0x24, 0xbb, 0xee, 0x3d,
+	0x48, 0x76, 0xbd, 0x7d, 0x32, 0xfa, 0x13, 0x80, 0x91, 0xd5, 0x66, 0xf2, 0x96, 0x52, 0x9a, 0x57,
+	0x36, 0x2c, 0xbd, 0x58, 0x60, 0xae, 0xf0, 0x38, 0x77, 0x7a, 0xc6, 0x77, 0xca, 0x7f, 0x15, 0xc8,
+	0xf6, 0xf0, 0xff, 0xc0, 0x9c, 0xf8, 0x6f, 0x00, 0x8f, 0x31, 0x79, 0x51, 0xae, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1206,10 +1555,18 @@ type QueryClient interface {
 	Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error)
 	// CounterpartyPayee returns the registered counterparty payee for forward relaying
 	CounterpartyPayee(ctx context.Context, in *QueryCounterpartyPayeeRequest, opts ...grpc.CallOption) (*QueryCounterpartyPayeeResponse, error)
+	// EscrowedFeesByRefundAccount returns the fees currently held in escrow on behalf of the given refund address
+	// for a page of incentivized packets, along with their total
+	EscrowedFeesByRefundAccount(ctx context.Context, in *QueryEscrowedFeesByRefundAccountRequest, opts ...grpc.CallOption) (*QueryEscrowedFeesByRefundAccountResponse, error)
 	// FeeEnabledChannels returns a list of all fee enabled channels
 	FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error)
 	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
 	FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabledChannelRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelResponse, error)
+	// AggregatedPacketFees returns the aggregated fees held in escrow for a packet given its identifier, summed over
+	// all the packet fees escrowed for the packet
+	AggregatedPacketFees(ctx context.Context, in *QueryAggregatedPacketFeesRequest, opts ...grpc.CallOption) (*QueryAggregatedPacketFeesResponse, error)
+	// Params returns the fee middleware parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1292,6 +1649,15 @@ func (c *queryClient) CounterpartyPayee(ctx context.Context, in *QueryCounterpar
 	return out, nil
 }
 
+func (c *queryClient) EscrowedFeesByRefundAccount(ctx context.Context, in *QueryEscrowedFeesByRefundAccountRequest, opts ...grpc.CallOption) (*QueryEscrowedFeesByRefundAccountResponse, error) {
+	out := new(QueryEscrowedFeesByRefundAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/EscrowedFeesByRefundAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error) {
 	out := new(QueryFeeEnabledChannelsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/FeeEnabledChannels", in, out, opts...)
@@ -1310,6 +1676,24 @@ func (c *queryClient) FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabled
 	return out, nil
 }
 
+func (c *queryClient) AggregatedPacketFees(ctx context.Context, in *QueryAggregatedPacketFeesRequest, opts ...grpc.CallOption) (*QueryAggregatedPacketFeesResponse, error) {
+	out := new(QueryAggregatedPacketFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/AggregatedPacketFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// IncentivizedPackets returns all incentivized packets and their associated fees
@@ -1328,10 +1712,18 @@ type QueryServer interface {
 	Payee(context.Context, *QueryPayeeRequest) (*QueryPayeeResponse, error)
 	// CounterpartyPayee returns the registered counterparty payee for forward relaying
 	CounterpartyPayee(context.Context, *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error)
+	// EscrowedFeesByRefundAccount returns the fees currently held in escrow on behalf of the given refund address
+	// for a page of incentivized packets, along with their total
+	EscrowedFeesByRefundAccount(context.Context, *QueryEscrowedFeesByRefundAccountRequest) (*QueryEscrowedFeesByRefundAccountResponse, error)
 	// FeeEnabledChannels returns a list of all fee enabled channels
 	FeeEnabledChannels(context.Context, *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error)
 	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
 	FeeEnabledChannel(context.Context, *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error)
+	// AggregatedPacketFees returns the aggregated fees held in escrow for a packet given its identifier, summed over
+	// all the packet fees escrowed for the packet
+	AggregatedPacketFees(context.Context, *QueryAggregatedPacketFeesRequest) (*QueryAggregatedPacketFeesResponse, error)
+	// Params returns the fee middleware parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1362,12 +1754,21 @@ func (*UnimplementedQueryServer) Payee(ctx context.Context, req *QueryPayeeReque
 func (*UnimplementedQueryServer) CounterpartyPayee(ctx context.Context, req *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CounterpartyPayee not implemented")
 }
+func (*UnimplementedQueryServer) EscrowedFeesByRefundAccount(ctx context.Context, req *QueryEscrowedFeesByRefundAccountRequest) (*QueryEscrowedFeesByRefundAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedFeesByRefundAccount not implemented")
+}
 func (*UnimplementedQueryServer) FeeEnabledChannels(ctx context.Context, req *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannels not implemented")
 }
 func (*UnimplementedQueryServer) FeeEnabledChannel(ctx context.Context, req *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannel not implemented")
 }
+func (*UnimplementedQueryServer) AggregatedPacketFees(ctx context.Context, req *QueryAggregatedPacketFeesRequest) (*QueryAggregatedPacketFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedPacketFees not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1517,6 +1918,24 @@ func _Query_CounterpartyPayee_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EscrowedFeesByRefundAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedFeesByRefundAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedFeesByRefundAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/EscrowedFeesByRefundAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedFeesByRefundAccount(ctx, req.(*QueryEscrowedFeesByRefundAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeeEnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeeEnabledChannelsRequest)
 	if err := dec(in); err != nil {
@@ -1553,29 +1972,65 @@ func _Query_FeeEnabledChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.fee.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "IncentivizedPackets",
-			Handler:    _Query_IncentivizedPackets_Handler,
-		},
-		{
-			MethodName: "IncentivizedPacket",
-			Handler:    _Query_IncentivizedPacket_Handler,
-		},
-		{
-			MethodName: "IncentivizedPacketsForChannel",
-			Handler:    _Query_IncentivizedPacketsForChannel_Handler,
-		},
-		{
-			MethodName: "TotalRecvFees",
-			Handler:    _Query_TotalRecvFees_Handler,
-		},
-		{
-			MethodName: "TotalAckFees",
-			Handler:    _Query_TotalAckFees_Handler,
+func _Query_AggregatedPacketFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatedPacketFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatedPacketFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/AggregatedPacketFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatedPacketFees(ctx, req.(*QueryAggregatedPacketFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.fee.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IncentivizedPackets",
+			Handler:    _Query_IncentivizedPackets_Handler,
+		},
+		{
+			MethodName: "IncentivizedPacket",
+			Handler:    _Query_IncentivizedPacket_Handler,
+		},
+		{
+			MethodName: "IncentivizedPacketsForChannel",
+			Handler:    _Query_IncentivizedPacketsForChannel_Handler,
+		},
+		{
+			MethodName: "TotalRecvFees",
+			Handler:    _Query_TotalRecvFees_Handler,
+		},
+		{
+			MethodName: "TotalAckFees",
+			Handler:    _Query_TotalAckFees_Handler,
 		},
 		{
 			MethodName: "TotalTimeoutFees",
@@ -1589,6 +2044,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CounterpartyPayee",
 			Handler:    _Query_CounterpartyPayee_Handler,
 		},
+		{
+			MethodName: "EscrowedFeesByRefundAccount",
+			Handler:    _Query_EscrowedFeesByRefundAccount_Handler,
+		},
 		{
 			MethodName: "FeeEnabledChannels",
 			Handler:    _Query_FeeEnabledChannels_Handler,
@@ -1597,6 +2056,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeeEnabledChannel",
 			Handler:    _Query_FeeEnabledChannel_Handler,
 		},
+		{
+			MethodName: "AggregatedPacketFees",
+			Handler:    _Query_AggregatedPacketFees_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/query.proto",
@@ -2209,6 +2676,116 @@ func (m *QueryCounterpartyPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEscrowedFeesByRefundAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedFeesByRefundAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedFeesByRefundAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QueryHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IncentivizedPackets) > 0 {
+		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TotalEscrowedFees) > 0 {
+		for iNdEx := len(m.TotalEscrowedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFeeEnabledChannelsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2368,64 +2945,200 @@ func (m *QueryFeeEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAggregatedPacketFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAggregatedPacketFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatedPacketFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAggregatedPacketFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatedPacketFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatedPacketFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IncentivizedPackets) > 0 {
-		for _, e := range m.IncentivizedPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.RefundAddresses) > 0 {
+		for iNdEx := len(m.RefundAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RefundAddresses[iNdEx])
+			copy(dAtA[i:], m.RefundAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RefundAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PacketFeeCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PacketFeeCount))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size, err := m.TotalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
-	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
 }
 
 func (m *QueryIncentivizedPacketResponse) Size() (n int) {
@@ -2620,6 +3333,51 @@ func (m *QueryCounterpartyPayeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEscrowedFeesByRefundAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowedFeesByRefundAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalEscrowedFees) > 0 {
+		for _, e := range m.TotalEscrowedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryFeeEnabledChannelsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2684,6 +3442,57 @@ func (m *QueryFeeEnabledChannelResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAggregatedPacketFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatedPacketFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PacketFeeCount != 0 {
+		n += 1 + sovQuery(uint64(m.PacketFeeCount))
+	}
+	if len(m.RefundAddresses) > 0 {
+		for _, s := range m.RefundAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4282,7 +5091,7 @@ func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowedFeesByRefundAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4305,17 +5114,17 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowedFeesByRefundAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowedFeesByRefundAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4325,27 +5134,23 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -4366,6 +5171,42 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4387,7 +5228,7 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowedFeesByRefundAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4410,15 +5251,15 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowedFeesByRefundAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowedFeesByRefundAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4445,14 +5286,14 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
-			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalEscrowedFees = append(m.TotalEscrowedFees, types1.Coin{})
+			if err := m.TotalEscrowedFees[len(m.TotalEscrowedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4479,35 +5320,408 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4530,17 +5744,87 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatedPacketFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatedPacketFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatedPacketFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4550,27 +5834,130 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatedPacketFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatedPacketFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatedPacketFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFeeCount", wireType)
+			}
+			m.PacketFeeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketFeeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4598,7 +5985,7 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.RefundAddresses = append(m.RefundAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4621,7 +6008,7 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4644,17 +6031,67 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4664,12 +6101,25 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FeeEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
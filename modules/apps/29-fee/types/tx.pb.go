@@ -360,6 +360,86 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the request type for the UpdateParams rpc
+type MsgUpdateParams struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// params defines the fee middleware parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the response type for the UpdateParams rpc
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
@@ -369,56 +449,62 @@ func init() {
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
 	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.fee.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.fee.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x1b, 0xfa, 0x23, 0xaf, 0x85, 0x12, 0xab, 0x22, 0xa9, 0x69, 0xd3, 0x62, 0x55, 0x50,
-	0x22, 0xc5, 0x6e, 0x82, 0x2a, 0x68, 0x04, 0x03, 0xad, 0xa8, 0x54, 0x89, 0x8a, 0x28, 0x23, 0x4b,
-	0xe5, 0x38, 0xaf, 0xae, 0x69, 0xec, 0xb3, 0x7c, 0x4e, 0x84, 0x37, 0xd4, 0x09, 0x31, 0xc1, 0x7f,
-	0xc0, 0xc8, 0xc0, 0xd0, 0x3f, 0xa3, 0x63, 0x47, 0x16, 0x10, 0x6a, 0x91, 0xfa, 0x1f, 0x30, 0xa3,
-	0x3b, 0x9f, 0x2d, 0x37, 0x69, 0xaa, 0x80, 0xc4, 0x62, 0xf9, 0xde, 0xf7, 0xdd, 0x77, 0xef, 0xfb,
-	0x74, 0x4f, 0x07, 0xcb, 0x76, 0xcb, 0xd4, 0x0d, 0xcf, 0xeb, 0xd8, 0xa6, 0x11, 0xd8, 0xc4, 0xa5,
-	0xfa, 0x3e, 0xa2, 0xde, 0xab, 0xea, 0xc1, 0x5b, 0xcd, 0xf3, 0x49, 0x40, 0xe4, 0x82, 0xdd, 0x32,
-	0xb5, 0x34, 0x43, 0xdb, 0x47, 0xd4, 0x7a, 0x55, 0x25, 0x6f, 0x38, 0xb6, 0x4b, 0x74, 0xfe, 0x8d,
-	0xb8, 0xca, 0x9c, 0x45, 0x2c, 0xc2, 0x7f, 0x75, 0xf6, 0x27, 0xaa, 0xf7, 0x86, 0x9d, 0xc1, 0x84,
-	0x52, 0x14, 0x93, 0xf8, 0xa8, 0x9b, 0x07, 0x86, 0xeb, 0x62, 0x87, 0xc1, 0xe2, 0x57, 0x50, 0x0a,
-	0x26, 0xa1, 0x0e, 0xa1, 0xba, 0x43, 0x2d, 0x06, 0x3a, 0xd4, 0x8a, 0x00, 0xf5, 0xab, 0x04, 0xb7,
-	0x77, 0xa9, 0xd5, 0x44, 0xcb, 0xa6, 0x01, 0xfa, 0x0d, 0x23, 0x44, 0x94, 0x0b, 0x30, 0xe9, 0x11,
-	0x3f, 0xd8, 0xb3, 0xdb, 0x45, 0x69, 0x59, 0x5a, 0xcd, 0x35, 0x27, 0xd8, 0x72, 0xa7, 0x2d, 0x2f,
-	0x02, 0x08, 0x5d, 0x86, 0x8d, 0x71, 0x2c, 0x27, 0x2a, 0x3b, 0x6d, 0xb9, 0x08, 0x93, 0x3e, 0x76,
-	0x8c, 0x10, 0xfd, 0x62, 0x96, 0x63, 0xf1, 0x52, 0x9e, 0x83, 0x71, 0x8f, 0x49, 0x17, 0x6f, 0xf0,
-	0x7a, 0xb4, 0xa8, 0xaf, 0xbd, 0xff, 0xbc, 0x94, 0x39, 0xba, 0x38, 0x2e, 0xc7, 0xbc, 0x0f, 0x17,
-	0xc7, 0xe5, 0xbb, 0x51, 0xab, 0x15, 0xda, 0x3e, 0xd4, 0xfb, 0x3b, 0x53, 0x15, 0x28, 0xf6, 0xd7,
-	0x9a, 0x48, 0x3d, 0xe2, 0x52, 0x54, 0xbf, 0x4b, 0xb0, 0x90, 0x02, 0xb7, 0x48, 0xd7, 0x0d, 0xd0,
-	0xf7, 0x0c, 0x3f, 0x08, 0xff, 0x97, 0xad, 0x0a, 0xc8, 0x66, 0xea, 0x98, 0xbd, 0xb4, 0xc7, 0xbc,
-	0xd9, 0xdf, 0x40, 0xfd, 0xe9, 0x55, 0x7e, 0x1f, 0x5c, 0xed, 0x77, 0xa0, 0x7d, 0xf5, 0x3e, 0xac,
-	0x5c, 0x87, 0x27, 0x39, 0x1c, 0x8d, 0xc1, 0xec, 0x2e, 0xb5, 0x1a, 0x46, 0xd8, 0x30, 0xcc, 0x43,
-	0x0c, 0xb6, 0x11, 0xe5, 0x0d, 0xc8, 0xee, 0x23, 0x72, 0xdb, 0xd3, 0xb5, 0x05, 0x6d, 0xc8, 0xad,
-	0xd4, 0xb6, 0x11, 0x37, 0x73, 0x27, 0x3f, 0x96, 0x32, 0x5f, 0x2e, 0x8e, 0xcb, 0x52, 0x93, 0xed,
-	0x91, 0x57, 0xe0, 0x16, 0x25, 0x5d, 0xdf, 0xc4, 0xbd, 0x38, 0xbc, 0x28, 0xa0, 0x99, 0xa8, 0xda,
-	0x88, 0x22, 0x2c, 0x43, 0x5e, 0xb0, 0x52, 0x49, 0x46, 0x69, 0xcd, 0x46, 0xc0, 0x56, 0x92, 0xe7,
-	0x1d, 0x98, 0xa0, 0xb6, 0xe5, 0xa2, 0x2f, 0x92, 0x12, 0x2b, 0x59, 0x81, 0x29, 0x91, 0x0b, 0x2d,
-	0x8e, 0x2f, 0x67, 0x57, 0x73, 0xcd, 0x64, 0x5d, 0xd7, 0xe2, 0xe8, 0x04, 0x99, 0x25, 0xa7, 0x5c,
-	0x4e, 0x2e, 0x6d, 0x58, 0x9d, 0x87, 0x42, 0x5f, 0x29, 0xc9, 0xe7, 0x97, 0x04, 0x73, 0x7d, 0xd8,
-	0x73, 0x1a, 0xba, 0xa6, 0xfc, 0x02, 0x72, 0x1e, 0xaf, 0xc4, 0x37, 0x64, 0xba, 0xb6, 0xc8, 0xa3,
-	0x62, 0xb3, 0xa5, 0xc5, 0x03, 0xd5, 0xab, 0x6a, 0xd1, 0xbe, 0x9d, 0x76, 0x3a, 0xab, 0x29, 0x4f,
-	0x14, 0xe5, 0x97, 0x00, 0x42, 0x86, 0x45, 0x3e, 0xc6, 0x75, 0xd4, 0xa1, 0x91, 0x27, 0x3d, 0xa4,
-	0xc5, 0x44, 0x1f, 0xdb, 0x88, 0xf5, 0xc7, 0xb1, 0xf1, 0x94, 0x28, 0x33, 0xbf, 0x34, 0xdc, 0x3c,
-	0x77, 0xa3, 0x96, 0xf8, 0x34, 0x0c, 0xd4, 0xe3, 0x18, 0x6a, 0xbf, 0xb3, 0x90, 0xdd, 0xa5, 0x96,
-	0xec, 0xc0, 0xcd, 0xcb, 0xd3, 0xff, 0x70, 0x68, 0xaf, 0xfd, 0xa3, 0xa7, 0x54, 0x47, 0xa6, 0xc6,
-	0xc7, 0xca, 0x9f, 0x24, 0x98, 0x1f, 0x3e, 0xa2, 0xeb, 0xa3, 0x08, 0x0e, 0x6c, 0x53, 0x9e, 0xfd,
-	0xd3, 0xb6, 0xa4, 0xa7, 0x37, 0x30, 0x73, 0x69, 0x5a, 0x56, 0xaf, 0x93, 0x4b, 0x33, 0x95, 0xb5,
-	0x51, 0x99, 0xc9, 0x59, 0x21, 0xe4, 0x07, 0x6f, 0x5e, 0x65, 0x54, 0x19, 0x4e, 0x57, 0xd6, 0xff,
-	0x8a, 0x1e, 0x1f, 0xad, 0x8c, 0xbf, 0x63, 0x97, 0x6b, 0xf3, 0xd5, 0xc9, 0x59, 0x49, 0x3a, 0x3d,
-	0x2b, 0x49, 0x3f, 0xcf, 0x4a, 0xd2, 0xc7, 0xf3, 0x52, 0xe6, 0xf4, 0xbc, 0x94, 0xf9, 0x76, 0x5e,
-	0xca, 0xbc, 0x5e, 0xb7, 0xec, 0xe0, 0xa0, 0xdb, 0xd2, 0x4c, 0xe2, 0xe8, 0xe2, 0xc1, 0xb0, 0x5b,
-	0x66, 0xc5, 0x22, 0x7a, 0xef, 0x89, 0xee, 0x90, 0x76, 0xb7, 0x83, 0x94, 0xbd, 0x45, 0x54, 0xaf,
-	0x6d, 0x54, 0xd8, 0x33, 0x14, 0x84, 0x1e, 0xd2, 0xd6, 0x04, 0x7f, 0x4a, 0x1e, 0xfd, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0x92, 0x13, 0xd2, 0x0f, 0x07, 0x00, 0x00,
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x09, 0x04, 0xf2, 0x60, 0x97, 0x8d, 0x85, 0x36, 0xc1, 0x0b, 0x09, 0x1b, 0xa1, 0x5d,
+	0x36, 0x52, 0x6c, 0x92, 0x15, 0xda, 0x25, 0x2a, 0x87, 0x82, 0x8a, 0x84, 0x54, 0xd4, 0x28, 0x52,
+	0x2f, 0xbd, 0x20, 0xc7, 0x79, 0x18, 0x97, 0xd8, 0x63, 0x79, 0x9c, 0xa8, 0xbe, 0x55, 0x9c, 0xaa,
+	0x9e, 0xda, 0xff, 0xa0, 0xc7, 0x1e, 0x7a, 0xe0, 0xcf, 0xe0, 0xc8, 0xb1, 0x97, 0x56, 0x55, 0xa8,
+	0xc4, 0xbf, 0x51, 0x8d, 0x3d, 0xb6, 0x26, 0x81, 0x44, 0x69, 0xa5, 0x5e, 0x2c, 0xcf, 0x7b, 0xdf,
+	0x7c, 0xef, 0x7d, 0xdf, 0xfc, 0x82, 0x0d, 0xab, 0x6d, 0x68, 0xba, 0xeb, 0x76, 0x2d, 0x43, 0xf7,
+	0x2d, 0xe2, 0x50, 0xed, 0x14, 0x51, 0xeb, 0xd7, 0x34, 0xff, 0x85, 0xea, 0x7a, 0xc4, 0x27, 0x72,
+	0xde, 0x6a, 0x1b, 0xaa, 0x88, 0x50, 0x4f, 0x11, 0xd5, 0x7e, 0x4d, 0xc9, 0xe9, 0xb6, 0xe5, 0x10,
+	0x2d, 0xfc, 0x46, 0x58, 0x65, 0xc5, 0x24, 0x26, 0x09, 0x7f, 0x35, 0xf6, 0xc7, 0xa3, 0x7f, 0x8e,
+	0xab, 0xc1, 0x88, 0x04, 0x88, 0x41, 0x3c, 0xd4, 0x8c, 0x33, 0xdd, 0x71, 0xb0, 0xcb, 0xd2, 0xfc,
+	0x97, 0x43, 0xf2, 0x06, 0xa1, 0x36, 0xa1, 0x9a, 0x4d, 0x4d, 0x96, 0xb4, 0xa9, 0x19, 0x25, 0xca,
+	0x1f, 0x24, 0xf8, 0xed, 0x98, 0x9a, 0x2d, 0x34, 0x2d, 0xea, 0xa3, 0xd7, 0xd4, 0x03, 0x44, 0x39,
+	0x0f, 0xf3, 0x2e, 0xf1, 0xfc, 0x13, 0xab, 0x53, 0x90, 0x36, 0xa4, 0xad, 0x6c, 0x2b, 0xc3, 0x86,
+	0x47, 0x1d, 0x79, 0x1d, 0x80, 0xf3, 0xb2, 0xdc, 0x4c, 0x98, 0xcb, 0xf2, 0xc8, 0x51, 0x47, 0x2e,
+	0xc0, 0xbc, 0x87, 0x5d, 0x3d, 0x40, 0xaf, 0x90, 0x0e, 0x73, 0xf1, 0x50, 0x5e, 0x81, 0x39, 0x97,
+	0x51, 0x17, 0x66, 0xc3, 0x78, 0x34, 0x68, 0x6c, 0xbf, 0x7a, 0x57, 0x4a, 0x5d, 0xdc, 0x5e, 0x56,
+	0x62, 0xdc, 0xeb, 0xdb, 0xcb, 0xca, 0x1f, 0x51, 0xab, 0x55, 0xda, 0x39, 0xd7, 0x46, 0x3b, 0x2b,
+	0x2b, 0x50, 0x18, 0x8d, 0xb5, 0x90, 0xba, 0xc4, 0xa1, 0x58, 0xfe, 0x24, 0xc1, 0x9a, 0x90, 0x3c,
+	0x20, 0x3d, 0xc7, 0x47, 0xcf, 0xd5, 0x3d, 0x3f, 0xf8, 0x59, 0xb2, 0xaa, 0x20, 0x1b, 0x42, 0x99,
+	0x13, 0x51, 0x63, 0xce, 0x18, 0x6d, 0xa0, 0xf1, 0xe0, 0x3e, 0xbd, 0x7f, 0xdf, 0xaf, 0xf7, 0x4e,
+	0xfb, 0xe5, 0xbf, 0x60, 0x73, 0x52, 0x3e, 0xf1, 0xe1, 0x62, 0x06, 0x96, 0x8f, 0xa9, 0xd9, 0xd4,
+	0x83, 0xa6, 0x6e, 0x9c, 0xa3, 0x7f, 0x88, 0x28, 0xef, 0x42, 0xfa, 0x14, 0x31, 0x94, 0xbd, 0x58,
+	0x5f, 0x53, 0xc7, 0xec, 0x4a, 0xf5, 0x10, 0x71, 0x3f, 0x7b, 0xf5, 0xb9, 0x94, 0x7a, 0x7f, 0x7b,
+	0x59, 0x91, 0x5a, 0x6c, 0x8e, 0xbc, 0x09, 0xbf, 0x52, 0xd2, 0xf3, 0x0c, 0x3c, 0x89, 0xcd, 0x8b,
+	0x0c, 0x5a, 0x8a, 0xa2, 0xcd, 0xc8, 0xc2, 0x0a, 0xe4, 0x38, 0x4a, 0x70, 0x32, 0x72, 0x6b, 0x39,
+	0x4a, 0x1c, 0x24, 0x7e, 0xfe, 0x0e, 0x19, 0x6a, 0x99, 0x0e, 0x7a, 0xdc, 0x29, 0x3e, 0x92, 0x15,
+	0x58, 0xe0, 0xbe, 0xd0, 0xc2, 0xdc, 0x46, 0x7a, 0x2b, 0xdb, 0x4a, 0xc6, 0x0d, 0x35, 0xb6, 0x8e,
+	0x83, 0x99, 0x73, 0xca, 0xb0, 0x73, 0xa2, 0xe0, 0xf2, 0x2a, 0xe4, 0x47, 0x42, 0x89, 0x3f, 0x5f,
+	0x25, 0x58, 0x19, 0xc9, 0x3d, 0xa4, 0x81, 0x63, 0xc8, 0x8f, 0x20, 0xeb, 0x86, 0x91, 0x78, 0x87,
+	0x2c, 0xd6, 0xd7, 0x43, 0xab, 0xd8, 0xd9, 0x52, 0xe3, 0x03, 0xd5, 0xaf, 0xa9, 0xd1, 0xbc, 0xa3,
+	0x8e, 0xe8, 0xd5, 0x82, 0xcb, 0x83, 0xf2, 0x63, 0x00, 0x4e, 0xc3, 0x2c, 0x9f, 0x09, 0x79, 0xca,
+	0x63, 0x2d, 0x4f, 0x7a, 0x10, 0xc9, 0x78, 0x1f, 0x87, 0x88, 0x8d, 0xff, 0x62, 0xe1, 0x02, 0x29,
+	0x13, 0x5f, 0x1a, 0x2f, 0x3e, 0x54, 0x53, 0x2e, 0xc2, 0xda, 0x7d, 0xf1, 0xc4, 0x86, 0x20, 0xdc,
+	0x25, 0x4f, 0xdd, 0x8e, 0xee, 0x63, 0x53, 0xf7, 0x74, 0x9b, 0x0a, 0x0b, 0x23, 0x0d, 0x2d, 0xcc,
+	0x1e, 0x64, 0xdc, 0x10, 0xc1, 0xd5, 0x94, 0x26, 0xa8, 0x61, 0xb0, 0xfd, 0x59, 0x26, 0xa5, 0xc5,
+	0x27, 0x35, 0x96, 0x47, 0xd6, 0x8e, 0x2f, 0x8e, 0x58, 0x3a, 0xee, 0xaa, 0x3e, 0x98, 0x85, 0xf4,
+	0x31, 0x35, 0x65, 0x1b, 0x7e, 0x19, 0xbe, 0x93, 0xfe, 0x19, 0x5b, 0x73, 0xf4, 0x42, 0x50, 0x6a,
+	0x53, 0x43, 0xe3, 0xb2, 0xf2, 0x5b, 0x09, 0x56, 0xc7, 0x5f, 0x1c, 0x3b, 0xd3, 0x10, 0xde, 0x99,
+	0xa6, 0xec, 0xfd, 0xd0, 0xb4, 0xa4, 0xa7, 0xe7, 0xb0, 0x34, 0x74, 0x86, 0xb7, 0x26, 0xd1, 0x89,
+	0x48, 0x65, 0x7b, 0x5a, 0x64, 0x52, 0x2b, 0x80, 0xdc, 0xdd, 0xf3, 0x50, 0x9d, 0x96, 0x26, 0x84,
+	0x2b, 0x3b, 0xdf, 0x05, 0x17, 0x65, 0x0e, 0x6d, 0xc2, 0x89, 0x32, 0x45, 0xa4, 0xb2, 0x3d, 0x2d,
+	0x32, 0xae, 0xa5, 0xcc, 0xbd, 0x64, 0xc7, 0x6b, 0xff, 0xc9, 0xd5, 0xa0, 0x28, 0x5d, 0x0f, 0x8a,
+	0xd2, 0x97, 0x41, 0x51, 0x7a, 0x73, 0x53, 0x4c, 0x5d, 0xdf, 0x14, 0x53, 0x1f, 0x6f, 0x8a, 0xa9,
+	0x67, 0x3b, 0xa6, 0xe5, 0x9f, 0xf5, 0xda, 0xaa, 0x41, 0x6c, 0x8d, 0x3f, 0x99, 0x56, 0xdb, 0xa8,
+	0x9a, 0x44, 0xeb, 0xff, 0xaf, 0xd9, 0xa4, 0xd3, 0xeb, 0x22, 0x65, 0xaf, 0x31, 0xd5, 0xea, 0xbb,
+	0x55, 0xf6, 0x10, 0xfb, 0x81, 0x8b, 0xb4, 0x9d, 0x09, 0x1f, 0xd3, 0x7f, 0xbf, 0x0d, 0x00, 0x4e,
+	0x97, 0xb9, 0x33, 0x11, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -455,6 +541,8 @@ type MsgClient interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -501,6 +589,15 @@ func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAs
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
@@ -525,6 +622,8 @@ type MsgServer interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -543,6 +642,9 @@ func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPack
 func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -620,6 +722,24 @@ func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.fee.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -640,6 +760,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PayPacketFeeAsync",
 			Handler:    _Msg_PayPacketFeeAsync_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/tx.proto",
@@ -945,6 +1069,69 @@ func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1084,6 +1271,30 @@ func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1973,6 +2184,171 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -88,13 +88,12 @@ func (s *CallbacksTestSuite) SetupTransferTest() {
 func (s *CallbacksTestSuite) SetupFeeTransferTest() {
 	s.setupChains()
 
-	feeTransferVersion := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: transfertypes.Version}))
-	s.path.EndpointA.ChannelConfig.Version = feeTransferVersion
-	s.path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	s.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = transfertypes.Version
 	s.path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
 	s.path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
 
-	s.path.Setup()
+	s.path.SetupWithFee()
 }
 
 func (s *CallbacksTestSuite) SetupMockFeeTest() {
@@ -188,6 +187,11 @@ func (s *CallbacksTestSuite) AssertHasExecutedExpectedCallback(callbackType type
 		s.Require().Equal(expStatefulEntries, sourceStatefulCounter, "unexpected stateful entry amount for source acknowledgement/timeout callbacks")
 		s.Require().Equal(uint8(0), destStatefulCounter)
 
+	case types.CallbackTypeRefund:
+		expStatefulEntries *= 3 // expect OnAcknowledgement/OnTimeout and the refund callback to be successful as well as the initial SendPacket
+		s.Require().Equal(expStatefulEntries, sourceStatefulCounter, "unexpected stateful entry amount for source refund callbacks")
+		s.Require().Equal(uint8(0), destStatefulCounter)
+
 	case types.CallbackTypeReceivePacket:
 		s.Require().Equal(uint8(0), sourceStatefulCounter)
 		s.Require().Equal(expStatefulEntries, destStatefulCounter)
@@ -231,6 +235,15 @@ func (s *CallbacksTestSuite) AssertCallbackCounters(callbackType types.CallbackT
 
 		s.Require().Len(destCounters, 0)
 
+	case types.CallbackTypeRefund:
+		// a refund callback follows either a timeout or an error acknowledgement callback
+		s.Require().Len(sourceCounters, 3)
+		s.Require().Equal(1, sourceCounters[types.CallbackTypeSendPacket])
+		s.Require().Equal(1, sourceCounters[types.CallbackTypeTimeoutPacket]+sourceCounters[types.CallbackTypeAcknowledgementPacket])
+		s.Require().Equal(1, sourceCounters[types.CallbackTypeRefund])
+
+		s.Require().Len(destCounters, 0)
+
 	default:
 		s.FailNow(fmt.Sprintf("invalid callback type %s", callbackType))
 	}
@@ -205,7 +205,7 @@ func (s *CallbacksTestSuite) TestIncentivizedTransferTimeoutCallbacks() {
 		{
 			"success: source callback",
 			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
-			types.CallbackTypeTimeoutPacket,
+			types.CallbackTypeRefund, // the timeout callback is followed by a refund callback
 			true,
 		},
 		{
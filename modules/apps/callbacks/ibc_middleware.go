@@ -18,9 +18,10 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware               = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler    = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule         = (*IBCMiddleware)(nil)
+	_ porttypes.RecvPacketFailureHandler = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the ibc-callbacks middleware given
@@ -36,6 +37,8 @@ type IBCMiddleware struct {
 	// is reverted if the relayer hadn't provided the minimum(userDefinedGas, maxCallbackGas).
 	// If the actor hasn't defined a gas limit, then it is assumed to be the maxCallbackGas.
 	maxCallbackGas uint64
+	// maxCallbackGasByType overrides maxCallbackGas for the callback types set with WithMaxCallbackGas.
+	maxCallbackGasByType map[types.CallbackType]uint64
 }
 
 // NewIBCMiddleware creates a new IBCMiddlware given the keeper and underlying application.
@@ -62,13 +65,36 @@ func NewIBCMiddleware(
 	}
 
 	return IBCMiddleware{
-		app:            packetDataUnmarshalerApp,
-		ics4Wrapper:    ics4Wrapper,
-		contractKeeper: contractKeeper,
-		maxCallbackGas: maxCallbackGas,
+		app:                  packetDataUnmarshalerApp,
+		ics4Wrapper:          ics4Wrapper,
+		contractKeeper:       contractKeeper,
+		maxCallbackGas:       maxCallbackGas,
+		maxCallbackGasByType: make(map[types.CallbackType]uint64),
 	}
 }
 
+// WithMaxCallbackGas sets the maximum amount of gas that a callback actor can ask the relayer to pay for
+// callbacks of the given type, overriding the maxCallbackGas passed to NewIBCMiddleware. This function
+// may be used after the middleware's creation to set distinct gas limits for acknowledgement, timeout
+// and receive packet callbacks.
+func (im *IBCMiddleware) WithMaxCallbackGas(callbackType types.CallbackType, maxCallbackGas uint64) {
+	if maxCallbackGas == 0 {
+		panic(fmt.Errorf("maxCallbackGas of %s callbacks cannot be zero", callbackType))
+	}
+
+	im.maxCallbackGasByType[callbackType] = maxCallbackGas
+}
+
+// GetMaxCallbackGas returns the maximum amount of gas that a callback actor can ask the relayer to pay
+// for callbacks of the given type.
+func (im IBCMiddleware) GetMaxCallbackGas(callbackType types.CallbackType) uint64 {
+	if maxCallbackGas, ok := im.maxCallbackGasByType[callbackType]; ok {
+		return maxCallbackGas
+	}
+
+	return im.maxCallbackGas
+}
+
 // WithICS4Wrapper sets the ICS4Wrapper. This function may be used after the
 // middleware's creation to set the middleware which is above this module in
 // the IBC application stack.
@@ -99,7 +125,7 @@ func (im IBCMiddleware) SendPacket(
 		return 0, err
 	}
 
-	callbackData, err := types.GetSourceCallbackData(im.app, data, sourcePort, ctx.GasMeter().GasRemaining(), im.maxCallbackGas)
+	callbackData, err := types.GetSourceCallbackData(im.app, data, sourcePort, ctx.GasMeter().GasRemaining(), im.GetMaxCallbackGas(types.CallbackTypeSendPacket))
 	// SendPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
 		return seq, nil
@@ -121,8 +147,19 @@ func (im IBCMiddleware) SendPacket(
 	return seq, nil
 }
 
+// OnRecvPacketFailure forwards the callback to the underlying application if it implements the optional
+// RecvPacketFailureHandler interface.
+func (im IBCMiddleware) OnRecvPacketFailure(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, gasUsed uint64) {
+	if handler, ok := im.app.(porttypes.RecvPacketFailureHandler); ok {
+		handler.OnRecvPacketFailure(ctx, packet, acknowledgement, gasUsed)
+	}
+}
+
 // OnAcknowledgementPacket implements source callbacks for acknowledgement packets.
-// It defers to the underlying application and then calls the contract callback.
+// It defers to the underlying application and then calls the contract callback. The callback is dispatched to
+// IBCOnAcknowledgementPacketCallbackWithAdditionalData if the contract keeper implements the
+// AdditionalPacketDataContractKeeper interface and the packet data provides additional packet data. If the
+// acknowledgement is an error acknowledgement, the refund callback is executed afterwards.
 // If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
 // reverted via a panic.
 func (im IBCMiddleware) OnAcknowledgementPacket(
@@ -138,7 +175,7 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	}
 
 	callbackData, err := types.GetSourceCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.GetMaxCallbackGas(types.CallbackTypeAcknowledgementPacket),
 	)
 	// OnAcknowledgementPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -151,6 +188,16 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		)
 	}
 
+	if contractKeeper, ok := im.contractKeeper.(types.AdditionalPacketDataContractKeeper); ok {
+		if additionalData, ok := types.GetAdditionalPacketData(im.app, packet.GetData(), acknowledgement); ok {
+			callbackExecutor = func(cachedCtx sdk.Context) error {
+				return contractKeeper.IBCOnAcknowledgementPacketCallbackWithAdditionalData(
+					cachedCtx, packet, acknowledgement, additionalData, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+				)
+			}
+		}
+	}
+
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
 	err = im.processCallback(ctx, types.CallbackTypeAcknowledgementPacket, callbackData, callbackExecutor)
 	types.EmitCallbackEvent(
@@ -158,11 +205,22 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		types.CallbackTypeAcknowledgementPacket, callbackData, err,
 	)
 
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		im.processRefundCallback(ctx, packet, relayer)
+	}
+
 	return nil
 }
 
 // OnTimeoutPacket implements timeout source callbacks for the ibc-callbacks middleware.
 // It defers to the underlying application and then calls the contract callback.
+// If the timeout was triggered by the closure of the counterparty channel end, the callback is executed with
+// the timeout on close callback type and dispatched to IBCOnTimeoutOnClosePacketCallback if the contract keeper
+// implements the TimeoutOnCloseContractKeeper interface. Otherwise, the callback is dispatched to
+// IBCOnTimeoutPacketCallbackWithAdditionalData if the contract keeper implements the
+// AdditionalPacketDataContractKeeper interface and the packet data provides additional packet data.
+// The refund callback is executed afterwards.
 // If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
 // reverted via a panic.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
@@ -171,8 +229,13 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 		return err
 	}
 
+	callbackType := types.CallbackTypeTimeoutPacket
+	if channeltypes.IsTimeoutOnClose(ctx) {
+		callbackType = types.CallbackTypeTimeoutOnClose
+	}
+
 	callbackData, err := types.GetSourceCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.GetMaxCallbackGas(callbackType),
 	)
 	// OnTimeoutPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -183,16 +246,74 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 		return im.contractKeeper.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress)
 	}
 
+	if contractKeeper, ok := im.contractKeeper.(types.AdditionalPacketDataContractKeeper); ok {
+		if additionalData, ok := types.GetAdditionalPacketData(im.app, packet.GetData(), nil); ok {
+			callbackExecutor = func(cachedCtx sdk.Context) error {
+				return contractKeeper.IBCOnTimeoutPacketCallbackWithAdditionalData(
+					cachedCtx, packet, additionalData, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+				)
+			}
+		}
+	}
+
+	if callbackType == types.CallbackTypeTimeoutOnClose {
+		if contractKeeper, ok := im.contractKeeper.(types.TimeoutOnCloseContractKeeper); ok {
+			callbackExecutor = func(cachedCtx sdk.Context) error {
+				return contractKeeper.IBCOnTimeoutOnClosePacketCallback(cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress)
+			}
+		}
+	}
+
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
-	err = im.processCallback(ctx, types.CallbackTypeTimeoutPacket, callbackData, callbackExecutor)
+	err = im.processCallback(ctx, callbackType, callbackData, callbackExecutor)
 	types.EmitCallbackEvent(
 		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		types.CallbackTypeTimeoutPacket, callbackData, err,
+		callbackType, callbackData, err,
 	)
 
+	im.processRefundCallback(ctx, packet, relayer)
+
 	return nil
 }
 
+// processRefundCallback executes the refund source callback once the underlying application has refunded the
+// sender of a packet which timed out or was acknowledged with an error acknowledgement. The callback is only
+// executed if the packet data describes the refund and the contract keeper implements RefundContractKeeper.
+// If the contract callback runs out of gas and may be retried with a higher gas limit then the state changes are
+// reverted via a panic.
+func (im IBCMiddleware) processRefundCallback(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) {
+	contractKeeper, ok := im.contractKeeper.(types.RefundContractKeeper)
+	if !ok {
+		return
+	}
+
+	refundType, ok := types.GetRefundType(im.app, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if !ok {
+		return
+	}
+
+	callbackData, err := types.GetSourceCallbackData(
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.GetMaxCallbackGas(types.CallbackTypeRefund),
+	)
+	// the packet lifecycle is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return contractKeeper.IBCOnRefundPacketCallback(
+			cachedCtx, packet, refundType, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = im.processCallback(ctx, types.CallbackTypeRefund, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(
+		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+		types.CallbackTypeRefund, callbackData, err,
+	)
+}
+
 // OnRecvPacket implements the ReceivePacket destination callbacks for the ibc-callbacks middleware during
 // synchronous packet acknowledgement.
 // It defers to the underlying application and then calls the contract callback.
@@ -208,7 +329,7 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet
 	}
 
 	callbackData, err := types.GetDestCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.GetMaxCallbackGas(types.CallbackTypeReceivePacket),
 	)
 	// OnRecvPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -246,7 +367,7 @@ func (im IBCMiddleware) WriteAcknowledgement(
 	}
 
 	callbackData, err := types.GetDestCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.GetMaxCallbackGas(types.CallbackTypeReceivePacket),
 	)
 	// WriteAcknowledgement is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
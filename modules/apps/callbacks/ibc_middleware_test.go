@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/ibc-go/modules/apps/callbacks/testing/simapp"
 	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
 	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
@@ -92,6 +93,67 @@ func (s *CallbacksTestSuite) TestWithICS4Wrapper() {
 	s.Require().IsType((*channelkeeper.Keeper)(nil), ics4Wrapper)
 }
 
+func (s *CallbacksTestSuite) TestWithMaxCallbackGas() {
+	cbsMiddleware := ibccallbacks.NewIBCMiddleware(ibcmock.IBCModule{}, &channelkeeper.Keeper{}, simapp.ContractKeeper{}, maxCallbackGas)
+	s.Require().Equal(uint64(maxCallbackGas), cbsMiddleware.GetMaxCallbackGas(types.CallbackTypeAcknowledgementPacket))
+
+	cbsMiddleware.WithMaxCallbackGas(types.CallbackTypeAcknowledgementPacket, 100_000)
+	cbsMiddleware.WithMaxCallbackGas(types.CallbackTypeReceivePacket, 200_000)
+	s.Require().Equal(uint64(100_000), cbsMiddleware.GetMaxCallbackGas(types.CallbackTypeAcknowledgementPacket))
+	s.Require().Equal(uint64(200_000), cbsMiddleware.GetMaxCallbackGas(types.CallbackTypeReceivePacket))
+	s.Require().Equal(uint64(maxCallbackGas), cbsMiddleware.GetMaxCallbackGas(types.CallbackTypeTimeoutPacket))
+
+	s.Require().PanicsWithError(fmt.Sprintf("maxCallbackGas of %s callbacks cannot be zero", types.CallbackTypeTimeoutPacket), func() {
+		cbsMiddleware.WithMaxCallbackGas(types.CallbackTypeTimeoutPacket, 0)
+	})
+}
+
+func (s *CallbacksTestSuite) TestOnAcknowledgementPacketMaxCallbackGas() {
+	const ackMaxCallbackGas = 100_000
+
+	s.SetupTransferTest()
+
+	cbsMiddleware := ibccallbacks.NewIBCMiddleware(
+		transfer.NewIBCModule(GetSimApp(s.chainA).TransferKeeper), s.chainA.App.GetIBCKeeper().ChannelKeeper,
+		GetSimApp(s.chainA).MockContractKeeper, maxCallbackGas,
+	)
+	cbsMiddleware.WithMaxCallbackGas(types.CallbackTypeAcknowledgementPacket, ackMaxCallbackGas)
+
+	// the user defined gas limit exceeds the gas limit of acknowledgement callbacks
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		ibctesting.TestCoin.GetDenom(), ibctesting.TestCoin.Amount.String(), ibctesting.TestAccAddress, ibctesting.TestAccAddress,
+		fmt.Sprintf(`{"src_callback": {"address":"%s", "gas_limit":"%d"}}`, simapp.OogPanicContract, 600_000),
+	)
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), 1, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, s.chainB.GetTimeoutHeight(), 0,
+	)
+	ack := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+
+	ctx := s.chainA.GetContext()
+	err := cbsMiddleware.OnAcknowledgementPacket(ctx, packet, ack, s.chainA.SenderAccount.GetAddress())
+	s.Require().NoError(err)
+
+	// the callback ran out of the acknowledgement callback gas limit without reverting the acknowledgement
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSourceCallback {
+			continue
+		}
+
+		found = true
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+
+		s.Require().Equal(fmt.Sprintf("%d", ackMaxCallbackGas), attributes[types.AttributeKeyCallbackCommitGasLimit])
+		s.Require().Equal(fmt.Sprintf("%d", ackMaxCallbackGas), attributes[types.AttributeKeyCallbackGasLimit])
+		s.Require().Equal(types.AttributeValueCallbackFailure, attributes[types.AttributeKeyCallbackResult])
+	}
+	s.Require().True(found, "source callback event not emitted")
+}
+
 func (s *CallbacksTestSuite) TestSendPacket() {
 	var packetData transfertypes.FungibleTokenPacketData
 
@@ -384,9 +446,10 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 	)
 
 	var (
-		packetData transfertypes.FungibleTokenPacketData
-		packet     channeltypes.Packet
-		ctx        sdk.Context
+		packetData      transfertypes.FungibleTokenPacketData
+		packet          channeltypes.Packet
+		ctx             sdk.Context
+		expCallbackType types.CallbackType
 	)
 
 	testCases := []struct {
@@ -450,6 +513,27 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 			callbackFailed,
 			nil, // execution failure in OnTimeout should not block timeout processing
 		},
+		{
+			"success: timeout on close",
+			func() {
+				ctx = channeltypes.WithTimeoutOnClose(ctx)
+				expCallbackType = types.CallbackTypeTimeoutOnClose
+			},
+			callbackSuccess,
+			nil,
+		},
+		{
+			"failure: timeout on close callback execution fails",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"src_callback": {"address":"%s"}}`, simapp.ErrorContract)
+				packet.Data = packetData.GetBytes()
+
+				ctx = channeltypes.WithTimeoutOnClose(ctx)
+				expCallbackType = types.CallbackTypeTimeoutOnClose
+			},
+			callbackFailed,
+			nil, // execution failure in OnTimeout should not block timeout processing
+		},
 	}
 
 	for _, tc := range testCases {
@@ -481,6 +565,7 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 
 			ctx = s.chainA.GetContext()
 			gasLimit := ctx.GasMeter().Limit()
+			expCallbackType = types.CallbackTypeTimeoutPacket
 
 			tc.malleate()
 
@@ -508,6 +593,12 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 			sourceStatefulCounter := GetSimApp(s.chainA).MockContractKeeper.GetStateEntryCounter(s.chainA.GetContext())
 			sourceCounters := GetSimApp(s.chainA).MockContractKeeper.Counters
 
+			// the refund callback is not reached if the timeout callback panics
+			expRefundCallbacks := 1
+			if _, ok := tc.expValue.(storetypes.ErrorOutOfGas); ok {
+				expRefundCallbacks = 0
+			}
+
 			// account for SendPacket succeeding
 			switch tc.expResult {
 			case noExecution:
@@ -515,20 +606,22 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 				s.Require().Equal(uint8(1), sourceStatefulCounter)
 
 			case callbackFailed:
-				s.Require().Len(sourceCounters, 2)
-				s.Require().Equal(1, sourceCounters[types.CallbackTypeTimeoutPacket])
+				s.Require().Len(sourceCounters, 2+expRefundCallbacks)
+				s.Require().Equal(1, sourceCounters[expCallbackType])
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeSendPacket])
+				s.Require().Equal(expRefundCallbacks, sourceCounters[types.CallbackTypeRefund])
 				s.Require().Equal(uint8(1), sourceStatefulCounter)
 
 			case callbackSuccess:
-				s.Require().Len(sourceCounters, 2)
-				s.Require().Equal(1, sourceCounters[types.CallbackTypeTimeoutPacket])
+				s.Require().Len(sourceCounters, 3)
+				s.Require().Equal(1, sourceCounters[expCallbackType])
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeSendPacket])
-				s.Require().Equal(uint8(2), sourceStatefulCounter)
+				s.Require().Equal(1, sourceCounters[types.CallbackTypeRefund])
+				s.Require().Equal(uint8(3), sourceStatefulCounter)
 
 				expEvent, exists := GetExpectedEvent(
 					transferStack.(porttypes.PacketDataUnmarshaler), gasLimit, packet.Data, packet.SourcePort,
-					packet.SourcePort, packet.SourceChannel, packet.Sequence, types.CallbackTypeTimeoutPacket, nil,
+					packet.SourcePort, packet.SourceChannel, packet.Sequence, expCallbackType, nil,
 				)
 				s.Require().True(exists)
 				s.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), expEvent)
@@ -573,7 +666,7 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 				packet.Data = []byte("invalid packet data")
 			},
 			noExecution,
-			channeltypes.NewErrorAcknowledgement(ibcerrors.ErrInvalidType),
+			transfertypes.NewErrorAcknowledgement(transfertypes.NewPacketDataFieldError(ibcerrors.ErrInvalidType, transfertypes.FieldData, "malformed JSON")),
 		},
 		{
 			"success: no-op on callback data is not valid",
@@ -8,6 +8,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -16,6 +17,7 @@ import (
 	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -141,6 +143,66 @@ func (s *CallbacksTestSuite) TestICATimeoutCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestICACallbacksWithAdditionalData() {
+	testCases := []struct {
+		name    string
+		timeout bool
+	}{
+		{
+			"success: acknowledgement callback receives message responses",
+			false,
+		},
+		{
+			"success: timeout callback receives no additional data",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			icaAddr := s.SetupICATest()
+
+			var (
+				additionalData interface{}
+				called         bool
+			)
+			mockContractKeeper := GetSimApp(s.chainA).MockContractKeeper
+			mockContractKeeper.IBCOnAcknowledgementPacketCallbackWithAdditionalDataFn = func(
+				_ sdk.Context, _ channeltypes.Packet, _ []byte, data interface{}, _ sdk.AccAddress, _, _ string,
+			) error {
+				additionalData, called = data, true
+				return nil
+			}
+			mockContractKeeper.IBCOnTimeoutPacketCallbackWithAdditionalDataFn = func(
+				_ sdk.Context, _ channeltypes.Packet, data interface{}, _ sdk.AccAddress, _, _ string,
+			) error {
+				additionalData, called = data, true
+				return nil
+			}
+
+			memo := fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract)
+			if tc.timeout {
+				s.ExecuteICATimeout(icaAddr, memo)
+			} else {
+				s.ExecuteICATx(icaAddr, memo)
+			}
+
+			s.Require().True(called)
+			if tc.timeout {
+				s.Require().Nil(additionalData)
+				return
+			}
+
+			msgResponses, ok := additionalData.([]*codectypes.Any)
+			s.Require().True(ok)
+			s.Require().Len(msgResponses, 1)
+			s.Require().Equal(sdk.MsgTypeURL(&stakingtypes.MsgDelegateResponse{}), msgResponses[0].TypeUrl)
+		})
+	}
+}
+
 // ExecuteICATx executes a stakingtypes.MsgDelegate on chainB by sending a packet containing the msg to chainB
 func (s *CallbacksTestSuite) ExecuteICATx(icaAddress, memo string) {
 	timeoutTimestamp := uint64(s.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
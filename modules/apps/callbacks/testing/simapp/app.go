@@ -150,6 +150,7 @@ var (
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
+		ibcexported.ModuleName:         nil,
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
@@ -310,7 +311,7 @@ func NewSimApp(
 		panic(err)
 	}
 
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, ibcexported.TransientStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, ibcmock.MemStoreKey)
 
 	app := &SimApp{
@@ -409,6 +410,8 @@ func NewSimApp(
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibcexported.StoreKey], app.GetSubspace(ibcexported.ModuleName), ibctm.NewConsensusHost(app.StakingKeeper), app.UpgradeKeeper, scopedIBCKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.IBCKeeper.SetTransientStoreKey(tkeys[ibcexported.TransientStoreKey])
+	app.IBCKeeper.SetBankKeeper(app.BankKeeper)
 
 	// NOTE: The mock ContractKeeper is only created for testing.
 	// Real applications should not use the mock ContractKeeper
@@ -447,6 +450,7 @@ func NewSimApp(
 		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
+		app.DistrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// ICA Controller keeper
@@ -467,6 +471,9 @@ func NewSimApp(
 		app.GRPCQueryRouter(), authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// the legacy amino codec is used to deserialize transactions of channels negotiating the amino JSON encoding
+	app.ICAHostKeeper.WithLegacyAmino(legacyAmino)
+
 	// Create IBC Router
 	ibcRouter := porttypes.NewRouter()
 
@@ -742,6 +749,7 @@ func NewSimApp(
 	// transactions
 	overrideModules := map[string]module.AppModuleSimulation{
 		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
+		icatypes.ModuleName:  ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper).WithSimulationKeepers(app.AccountKeeper, app.BankKeeper),
 	}
 	app.simulationManager = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
 
@@ -1033,6 +1041,7 @@ func BlockedAddresses() map[string]bool {
 
 	// allow the following addresses to receive funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	delete(modAccAddrs, authtypes.NewModuleAddress(ibcexported.ModuleName).String())
 	delete(modAccAddrs, authtypes.NewModuleAddress(ibcmock.ModuleName).String())
 
 	return modAccAddrs
@@ -1064,6 +1073,11 @@ func (app *SimApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
 	return app.StakingKeeper
 }
 
+// GetUpgradeKeeper implements the TestingApp interface.
+func (app *SimApp) GetUpgradeKeeper() ibctestingtypes.UpgradeKeeper {
+	return app.UpgradeKeeper
+}
+
 // GetIBCKeeper implements the TestingApp interface.
 func (app *SimApp) GetIBCKeeper() *ibckeeper.Keeper {
 	return app.IBCKeeper
@@ -14,8 +14,14 @@ import (
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
 
-// MockKeeper implements callbacktypes.ContractKeeper
-var _ callbacktypes.ContractKeeper = (*ContractKeeper)(nil)
+// MockKeeper implements callbacktypes.ContractKeeper, callbacktypes.TimeoutOnCloseContractKeeper, callbacktypes.RefundContractKeeper
+// and callbacktypes.AdditionalPacketDataContractKeeper
+var (
+	_ callbacktypes.ContractKeeper                     = (*ContractKeeper)(nil)
+	_ callbacktypes.TimeoutOnCloseContractKeeper       = (*ContractKeeper)(nil)
+	_ callbacktypes.RefundContractKeeper               = (*ContractKeeper)(nil)
+	_ callbacktypes.AdditionalPacketDataContractKeeper = (*ContractKeeper)(nil)
+)
 
 var StatefulCounterKey = "stateful-callback-counter"
 
@@ -67,6 +73,16 @@ type ContractKeeper struct {
 		packetSenderAddress string,
 	) error
 
+	IBCOnAcknowledgementPacketCallbackWithAdditionalDataFn func(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		additionalData interface{},
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
 	IBCOnTimeoutPacketCallbackFn func(
 		cachedCtx sdk.Context,
 		packet channeltypes.Packet,
@@ -75,6 +91,32 @@ type ContractKeeper struct {
 		packetSenderAddress string,
 	) error
 
+	IBCOnTimeoutPacketCallbackWithAdditionalDataFn func(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		additionalData interface{},
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
+	IBCOnTimeoutOnClosePacketCallbackFn func(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
+	IBCOnRefundPacketCallbackFn func(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		refundType ibcexported.RefundType,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+
 	IBCReceivePacketCallbackFn func(
 		cachedCtx sdk.Context,
 		packet ibcexported.PacketI,
@@ -121,10 +163,26 @@ func NewContractKeeper(key storetypes.StoreKey) *ContractKeeper {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeAcknowledgementPacket, contractAddress)
 	}
 
+	k.IBCOnAcknowledgementPacketCallbackWithAdditionalDataFn = func(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ interface{}, _ sdk.AccAddress, contractAddress, _ string) error {
+		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeAcknowledgementPacket, contractAddress)
+	}
+
 	k.IBCOnTimeoutPacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, contractAddress, _ string) error {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeTimeoutPacket, contractAddress)
 	}
 
+	k.IBCOnTimeoutPacketCallbackWithAdditionalDataFn = func(ctx sdk.Context, _ channeltypes.Packet, _ interface{}, _ sdk.AccAddress, contractAddress, _ string) error {
+		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeTimeoutPacket, contractAddress)
+	}
+
+	k.IBCOnTimeoutOnClosePacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, contractAddress, _ string) error {
+		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeTimeoutOnClose, contractAddress)
+	}
+
+	k.IBCOnRefundPacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, _ ibcexported.RefundType, _ sdk.AccAddress, contractAddress, _ string) error {
+		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeRefund, contractAddress)
+	}
+
 	k.IBCReceivePacketCallbackFn = func(ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress string) error {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeReceivePacket, contractAddress)
 	}
@@ -170,6 +228,25 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	return k.IBCOnAcknowledgementPacketCallbackFn(ctx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress)
 }
 
+// IBCOnAcknowledgementPacketCallbackWithAdditionalData increments the stateful entry counter and the acknowledgement_packet callback counter.
+// This function:
+//   - returns MockApplicationCallbackError and consumes half the remaining gas if the contract address is ErrorContract
+//   - Oog panics and consumes all the remaining gas + 1 if the contract address is OogPanicContract
+//   - returns MockApplicationCallbackError and consumes all the remaining gas + 1 if the contract address is OogErrorContract
+//   - Panics and consumes half the remaining gas if the contract address is PanicContract
+//   - returns nil and consumes half the remaining gas if the contract address is SuccessContract or any other value
+func (k ContractKeeper) IBCOnAcknowledgementPacketCallbackWithAdditionalData(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	additionalData interface{},
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.IBCOnAcknowledgementPacketCallbackWithAdditionalDataFn(ctx, packet, acknowledgement, additionalData, relayer, contractAddress, packetSenderAddress)
+}
+
 // IBCOnTimeoutPacketCallback increments the stateful entry counter and the timeout_packet callback counter.
 // This function:
 //   - returns MockApplicationCallbackError and consumes half the remaining gas if the contract address is ErrorContract
@@ -187,6 +264,59 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	return k.IBCOnTimeoutPacketCallbackFn(ctx, packet, relayer, contractAddress, packetSenderAddress)
 }
 
+// IBCOnTimeoutPacketCallbackWithAdditionalData increments the stateful entry counter and the timeout_packet callback counter.
+// This function:
+//   - returns MockApplicationCallbackError and consumes half the remaining gas if the contract address is ErrorContract
+//   - Oog panics and consumes all the remaining gas + 1 if the contract address is OogPanicContract
+//   - returns MockApplicationCallbackError and consumes all the remaining gas + 1 if the contract address is OogErrorContract
+//   - Panics and consumes half the remaining gas if the contract address is PanicContract
+//   - returns nil and consumes half the remaining gas if the contract address is SuccessContract or any other value
+func (k ContractKeeper) IBCOnTimeoutPacketCallbackWithAdditionalData(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	additionalData interface{},
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.IBCOnTimeoutPacketCallbackWithAdditionalDataFn(ctx, packet, additionalData, relayer, contractAddress, packetSenderAddress)
+}
+
+// IBCOnTimeoutOnClosePacketCallback increments the stateful entry counter and the timeout_on_close callback counter.
+// This function:
+//   - returns MockApplicationCallbackError and consumes half the remaining gas if the contract address is ErrorContract
+//   - Oog panics and consumes all the remaining gas + 1 if the contract address is OogPanicContract
+//   - returns MockApplicationCallbackError and consumes all the remaining gas + 1 if the contract address is OogErrorContract
+//   - Panics and consumes half the remaining gas if the contract address is PanicContract
+//   - returns nil and consumes half the remaining gas if the contract address is SuccessContract or any other value
+func (k ContractKeeper) IBCOnTimeoutOnClosePacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.IBCOnTimeoutOnClosePacketCallbackFn(ctx, packet, relayer, contractAddress, packetSenderAddress)
+}
+
+// IBCOnRefundPacketCallback increments the stateful entry counter and the refund callback counter.
+// This function:
+//   - returns MockApplicationCallbackError and consumes half the remaining gas if the contract address is ErrorContract
+//   - Oog panics and consumes all the remaining gas + 1 if the contract address is OogPanicContract
+//   - returns MockApplicationCallbackError and consumes all the remaining gas + 1 if the contract address is OogErrorContract
+//   - Panics and consumes half the remaining gas if the contract address is PanicContract
+//   - returns nil and consumes half the remaining gas if the contract address is SuccessContract or any other value
+func (k ContractKeeper) IBCOnRefundPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	refundType ibcexported.RefundType,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.IBCOnRefundPacketCallbackFn(ctx, packet, refundType, relayer, contractAddress, packetSenderAddress)
+}
+
 // IBCReceivePacketCallback increments the stateful entry counter and the receive_packet callback counter.
 // This function:
 //   - returns MockApplicationCallbackError and consumes half the remaining gas if the contract address is ErrorContract
@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -134,7 +136,7 @@ func (s *CallbacksTestSuite) TestTransferTimeoutCallbacks() {
 		{
 			"success: source callback",
 			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
-			types.CallbackTypeTimeoutPacket,
+			types.CallbackTypeRefund, // the timeout callback is followed by a refund callback
 			true,
 		},
 		{
@@ -175,6 +177,59 @@ func (s *CallbacksTestSuite) TestTransferTimeoutCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestTransferErrorAckCallbacks() {
+	testCases := []struct {
+		name         string
+		transferMemo string
+		expCallback  types.CallbackType
+		expSuccess   bool
+	}{
+		{
+			"success: transfer with no memo",
+			"",
+			"none",
+			true,
+		},
+		{
+			"success: dest callback",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			"none", // error acknowledgements don't execute destination callbacks
+			true,
+		},
+		{
+			"success: source callback",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			types.CallbackTypeRefund, // the acknowledgement callback is followed by a refund callback
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			var refundType ibcexported.RefundType
+			mockContractKeeper := GetSimApp(s.chainA).MockContractKeeper
+			mockContractKeeper.IBCOnRefundPacketCallbackFn = func(
+				ctx sdk.Context, _ channeltypes.Packet, packetRefundType ibcexported.RefundType,
+				_ sdk.AccAddress, contractAddress, _ string,
+			) error {
+				refundType = packetRefundType
+				return mockContractKeeper.ProcessMockCallback(ctx, types.CallbackTypeRefund, contractAddress)
+			}
+
+			s.ExecuteTransferErrorAck(tc.transferMemo)
+			s.AssertHasExecutedExpectedCallback(tc.expCallback, tc.expSuccess)
+
+			if tc.expCallback == types.CallbackTypeRefund {
+				// chainA is the source of the native denomination, so the tokens are unescrowed
+				s.Require().Equal(ibcexported.RefundTypeUnescrow, refundType)
+			}
+		})
+	}
+}
+
 // ExecuteTransfer executes a transfer message on chainA for ibctesting.TestCoin (100 "stake").
 // It checks that the transfer is successful and that the packet is relayed to chainB.
 func (s *CallbacksTestSuite) ExecuteTransfer(memo string) {
@@ -245,3 +300,35 @@ func (s *CallbacksTestSuite) ExecuteTransferTimeout(memo string) {
 	err = s.path.EndpointA.TimeoutPacket(packet)
 	s.Require().NoError(err) // timeout committed
 }
+
+// ExecuteTransferErrorAck executes a transfer message on chainA for ibctesting.TestCoin (100 "stake")
+// to an invalid receiver. The packet is relayed to chainB, and it is acknowledged with an error acknowledgement.
+func (s *CallbacksTestSuite) ExecuteTransferErrorAck(memo string) {
+	escrowAddress := transfertypes.GetEscrowAddress(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	// record the balance of the escrow address before the transfer
+	escrowBalance := GetSimApp(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		ibctesting.TestCoin,
+		s.chainA.SenderAccount.GetAddress().String(),
+		ibctesting.InvalidID,
+		clienttypes.NewHeight(1, 100), 0, memo,
+	)
+
+	res, err := s.chainA.SendMsgs(msg)
+	if err != nil {
+		return // we return if send packet is rejected
+	}
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	// relay send
+	err = s.path.RelayPacket(packet)
+	s.Require().NoError(err) // relay committed
+
+	// check that the escrowed tokens were refunded
+	s.Require().Equal(escrowBalance, GetSimApp(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom))
+}
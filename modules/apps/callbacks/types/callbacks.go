@@ -81,6 +81,46 @@ func GetDestCallbackData(
 	return getCallbackData(packetDataUnmarshaler, data, srcPortID, remainingGas, maxGas, DestinationCallbackKey)
 }
 
+// GetRefundType parses the packet data and returns how the underlying application refunds the packet sender
+// on the provided source port and channel. False is returned if the packet data does not implement the
+// ibcexported.RefundPacketData interface.
+func GetRefundType(
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	data []byte, srcPortID, srcChannelID string,
+) (ibcexported.RefundType, bool) {
+	packetData, err := packetDataUnmarshaler.UnmarshalPacketData(data)
+	if err != nil {
+		return "", false
+	}
+
+	refundPacketData, ok := packetData.(ibcexported.RefundPacketData)
+	if !ok {
+		return "", false
+	}
+
+	return refundPacketData.GetRefundType(srcPortID, srcChannelID), true
+}
+
+// GetAdditionalPacketData parses the packet data and returns the application specific data decoded from the
+// provided acknowledgement. A nil acknowledgement should be provided for packets which timed out. False is
+// returned if the packet data does not implement the ibcexported.AdditionalPacketDataProvider interface.
+func GetAdditionalPacketData(
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	data, acknowledgement []byte,
+) (interface{}, bool) {
+	packetData, err := packetDataUnmarshaler.UnmarshalPacketData(data)
+	if err != nil {
+		return nil, false
+	}
+
+	additionalPacketDataProvider, ok := packetData.(ibcexported.AdditionalPacketDataProvider)
+	if !ok {
+		return nil, false
+	}
+
+	return additionalPacketDataProvider.GetAdditionalPacketData(acknowledgement), true
+}
+
 // getCallbackData parses the packet data and returns the callback data.
 // It also checks that the remaining gas is greater than the gas limit specified in the packet data.
 // The addressGetter and gasLimitGetter functions are used to retrieve the callback
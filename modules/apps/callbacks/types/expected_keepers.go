@@ -81,3 +81,87 @@ type ContractKeeper interface {
 		contractAddress string,
 	) error
 }
+
+// TimeoutOnCloseContractKeeper is an optional extension of the ContractKeeper. Contract keepers implementing
+// it are dispatched IBCOnTimeoutOnClosePacketCallback instead of IBCOnTimeoutPacketCallback when a packet
+// times out because the counterparty channel end was closed, allowing contracts to distinguish a counterparty
+// closure from an ordinary packet expiry.
+type TimeoutOnCloseContractKeeper interface {
+	// IBCOnTimeoutOnClosePacketCallback is called in the source chain when a packet times out because the
+	// counterparty channel end was closed. The packetSenderAddress is determined by the underlying module,
+	// and may be empty if the sender is unknown or undefined. The contract is expected to handle the callback
+	// within the user defined gas limit, and handle any error, out of gas, or panics gracefully.
+	// This entry point is called with a cached context. If an error is returned, then the changes in
+	// this context will not be persisted, but the packet lifecycle will not be blocked.
+	IBCOnTimeoutOnClosePacketCallback(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+}
+
+// RefundContractKeeper is an optional extension of the ContractKeeper. Contract keepers implementing it are
+// dispatched IBCOnRefundPacketCallback after the underlying application has refunded the packet sender of a
+// packet which timed out or was acknowledged with an error acknowledgement, allowing contracts to reconcile
+// their balances without polling. Refund callbacks are only executed for applications whose packet data
+// implements the ibcexported.RefundPacketData interface.
+type RefundContractKeeper interface {
+	// IBCOnRefundPacketCallback is called in the source chain after the packet sender has been refunded.
+	// The refundType indicates whether the refunded tokens were unescrowed or minted back to the sender.
+	// It is called after IBCOnAcknowledgementPacketCallback or IBCOnTimeoutPacketCallback, with its own
+	// gas limit. The packetSenderAddress is determined by the underlying module, and may be empty if the
+	// sender is unknown or undefined. The contract is expected to handle the callback within the user
+	// defined gas limit, and handle any error, out of gas, or panics gracefully.
+	// This entry point is called with a cached context. If an error is returned, then the changes in
+	// this context will not be persisted, but the packet lifecycle will not be blocked.
+	IBCOnRefundPacketCallback(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		refundType ibcexported.RefundType,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+}
+
+// AdditionalPacketDataContractKeeper is an optional extension of the ContractKeeper. Contract keepers implementing
+// it are dispatched the WithAdditionalData variants of the acknowledgement and timeout callbacks for packets whose
+// packet data implements the ibcexported.AdditionalPacketDataProvider interface. This provides contracts with the
+// results decoded by the underlying application, such as the message responses of an interchain accounts transaction.
+type AdditionalPacketDataContractKeeper interface {
+	// IBCOnAcknowledgementPacketCallbackWithAdditionalData is called in the source chain instead of
+	// IBCOnAcknowledgementPacketCallback when a packet acknowledgement is received. The additionalData is decoded
+	// from the acknowledgement by the underlying application, and may be nil if the acknowledgement is an error
+	// acknowledgement or could not be decoded. The packetSenderAddress is determined by the underlying module,
+	// and may be empty if the sender is unknown or undefined. The contract is expected to handle the callback
+	// within the user defined gas limit, and handle any errors, or panics gracefully.
+	// This entry point is called with a cached context. If an error is returned, then the changes in
+	// this context will not be persisted, but the packet lifecycle will not be blocked.
+	IBCOnAcknowledgementPacketCallbackWithAdditionalData(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		additionalData interface{},
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCOnTimeoutPacketCallbackWithAdditionalData is called in the source chain instead of
+	// IBCOnTimeoutPacketCallback when a packet is not received before the timeout height. The additionalData is
+	// decoded by the underlying application without an acknowledgement, and is nil for most applications.
+	// The packetSenderAddress is determined by the underlying module, and may be empty if the sender is unknown
+	// or undefined. The contract is expected to handle the callback within the user defined gas limit, and
+	// handle any error, out of gas, or panics gracefully.
+	// This entry point is called with a cached context. If an error is returned, then the changes in
+	// this context will not be persisted, but the packet lifecycle will not be blocked.
+	IBCOnTimeoutPacketCallbackWithAdditionalData(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		additionalData interface{},
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+}
@@ -8,7 +8,9 @@ const (
 	CallbackTypeSendPacket            CallbackType = "send_packet"
 	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
 	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
+	CallbackTypeTimeoutOnClose        CallbackType = "timeout_on_close"
 	CallbackTypeReceivePacket         CallbackType = "receive_packet"
+	CallbackTypeRefund                CallbackType = "refund"
 
 	// Source callback packet data is set inside the underlying packet data using the this key.
 	// ICS20 and ICS27 will store the callback packet data in the memo field as a json object.
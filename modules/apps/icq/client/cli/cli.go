@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the interchain query module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "icq",
+		Short:                      "IBC interchain query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdParams(),
+	)
+
+	return queryCmd
+}
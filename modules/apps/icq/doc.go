@@ -0,0 +1,9 @@
+/*
+Package icq implements the host side of interchain queries, in the style of the ICS 31
+specification (https://github.com/cosmos/ibc/tree/main/spec/app/ics-031-crosschain-queries).
+Controller chains send packets carrying ABCI query requests over an interchain query channel,
+the host chain executes the queries whose paths are allowed by its parameters and acknowledges
+the packets with the query responses. Raw store queries are answered with proofs of the queried
+values against the latest committed state of the host chain.
+*/
+package icq
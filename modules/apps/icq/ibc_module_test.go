@@ -0,0 +1,240 @@
+package icq_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+const balanceQueryPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+type InterchainQueryTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *InterchainQueryTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestInterchainQueryTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(InterchainQueryTestSuite))
+}
+
+// newICQPath creates a path between a mock controller module on chainA and the interchain query host on chainB.
+func newICQPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+
+	return path
+}
+
+func (suite *InterchainQueryTestSuite) TestOnChanOpenInit() {
+	path := newICQPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	// the host chain initiates the handshake
+	path.EndpointA, path.EndpointB = path.EndpointB, path.EndpointA
+
+	err := path.EndpointA.ChanOpenInit()
+	suite.Require().ErrorContains(err, types.ErrInvalidChannelFlow.Error())
+}
+
+func (suite *InterchainQueryTestSuite) TestOnChanOpenTry() {
+	var (
+		path                *ibctesting.Path
+		order               channeltypes.Order
+		portID              string
+		chanCap             *capabilitytypes.Capability
+		counterpartyVersion string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"failure: host is disabled", func() {
+				suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, nil))
+			}, types.ErrHostDisabled,
+		},
+		{
+			"failure: invalid order - ORDERED", func() {
+				order = channeltypes.ORDERED
+			}, channeltypes.ErrInvalidChannelOrdering,
+		},
+		{
+			"failure: invalid port ID", func() {
+				portID = ibctesting.MockPort
+			}, porttypes.ErrInvalidPort,
+		},
+		{
+			"failure: invalid counterparty version", func() {
+				counterpartyVersion = "icq-2"
+			}, types.ErrInvalidVersion,
+		},
+		{
+			"failure: capability already claimed", func() {
+				err := suite.chainB.GetSimApp().ScopedICQKeeper.ClaimCapability(suite.chainB.GetContext(), chanCap, host.ChannelCapabilityPath(types.PortID, ibctesting.FirstChannelID))
+				suite.Require().NoError(err)
+			}, capabilitytypes.ErrOwnerClaimed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = newICQPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			order = channeltypes.UNORDERED
+			portID = types.PortID
+			counterpartyVersion = types.Version
+
+			chanCap, err = suite.chainB.App.GetScopedIBCKeeper().NewCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(types.PortID, ibctesting.FirstChannelID))
+			suite.Require().NoError(err)
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), types.PortID)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(module)
+			suite.Require().True(ok)
+
+			tc.malleate() // malleate mutates test data
+
+			counterparty := channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			version, err := cbs.OnChanOpenTry(suite.chainB.GetContext(), order, []string{path.EndpointB.ConnectionID}, portID, ibctesting.FirstChannelID, chanCap, counterparty, counterpartyVersion)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.Version, version)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(version)
+			}
+		})
+	}
+}
+
+func (suite *InterchainQueryTestSuite) TestOnAcknowledgementAndTimeoutPacket() {
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), types.PortID)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(module)
+	suite.Require().True(ok)
+
+	packet := channeltypes.NewPacket([]byte("data"), 1, types.PortID, ibctesting.FirstChannelID, ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	err = cbs.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, ibcmock.MockAcknowledgement.Acknowledgement(), nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelFlow)
+
+	err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), packet, nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelFlow)
+}
+
+// TestInterchainQuery sends a packet carrying a gRPC query and a store query from a controller on chainA
+// to the interchain query host on chainB and asserts the responses acknowledged to chainA.
+func (suite *InterchainQueryTestSuite) TestInterchainQuery() {
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"failure: query path is not allowed", func() {
+				suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{balanceQueryPath}))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := newICQPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			storeReq := types.NewStoreQueryRequest(types.StoreKey, types.PortKey)
+			suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{balanceQueryPath, storeReq.Path}))
+
+			tc.malleate()
+
+			balanceReq, err := types.NewGRPCQueryRequest(balanceQueryPath, banktypes.NewQueryBalanceRequest(suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+			suite.Require().NoError(err)
+
+			packetData, err := types.NewInterchainQueryPacketData([]abci.RequestQuery{balanceReq, storeReq}, "")
+			suite.Require().NoError(err)
+
+			var ack []byte
+			suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnAcknowledgementPacket = func(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+				ack = acknowledgement
+				return nil
+			}
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, packetData.GetBytes())
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			responses, err := types.ParseAcknowledgement(ack)
+			if tc.expSuccess {
+				suite.Require().NoError(err)
+				suite.Require().Len(responses, 2)
+
+				var balanceRes banktypes.QueryBalanceResponse
+				suite.Require().NoError(balanceRes.Unmarshal(responses[0].Value))
+				suite.Require().Equal(sdk.DefaultBondDenom, balanceRes.Balance.Denom)
+
+				suite.Require().Equal([]byte(types.PortID), responses[1].Value)
+				suite.Require().NotNil(responses[1].ProofOps)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidAcknowledgement)
+				suite.Require().Nil(responses)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+)
+
+// InitGenesis initializes the interchain query module state and binds to the host port.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.HostPort)
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.hasCapability(ctx, state.HostPort) {
+		if err := k.BindPort(ctx, state.HostPort); err != nil {
+			panic(fmt.Errorf("could not claim port capability: %v", err))
+		}
+	}
+
+	if err := state.Params.Validate(); err != nil {
+		panic(fmt.Errorf("could not set interchain query params at genesis: %v", err))
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis exports the interchain query module's host port and parameters into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetParams(ctx))
+}
@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState(types.PortID, types.NewParams(false, []string{"/cosmos.bank.v1beta1.Query/Balance"}))
+
+	suite.chainA.GetSimApp().ICQKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+
+	exported := suite.chainA.GetSimApp().ICQKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(genesis, exported)
+
+	// the module owns the capability of the host port
+	_, found := suite.chainA.GetSimApp().ScopedICQKeeper.GetCapability(suite.chainA.GetContext(), host.PortPath(types.PortID))
+	suite.Require().True(found)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		expPass       bool
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				suite.chainA.GetSimApp().GetKey(types.StoreKey),
+				suite.chainA.GetSimApp().IBCKeeper.PortKeeper,
+				suite.chainA.GetSimApp().ScopedICQKeeper,
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
+				suite.chainA.GetSimApp().BaseApp,
+				suite.chainA.GetSimApp().ICQKeeper.GetAuthority(),
+			)
+		}, true},
+		{"success: nil abci querier", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				suite.chainA.GetSimApp().GetKey(types.StoreKey),
+				suite.chainA.GetSimApp().IBCKeeper.PortKeeper,
+				suite.chainA.GetSimApp().ScopedICQKeeper,
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
+				nil, // store queries are not supported
+				suite.chainA.GetSimApp().ICQKeeper.GetAuthority(),
+			)
+		}, true},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				suite.chainA.GetSimApp().GetKey(types.StoreKey),
+				suite.chainA.GetSimApp().IBCKeeper.PortKeeper,
+				suite.chainA.GetSimApp().ScopedICQKeeper,
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
+				suite.chainA.GetSimApp().BaseApp,
+				"", // authority
+			)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.expPass {
+				suite.Require().NotPanics(tc.instantiateFn)
+			} else {
+				suite.Require().Panics(tc.instantiateFn)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	expParams := types.DefaultParams()
+
+	params := suite.chainA.GetSimApp().ICQKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+
+	expParams.HostEnabled = false
+	expParams.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/Balance"}
+	suite.chainA.GetSimApp().ICQKeeper.SetParams(suite.chainA.GetContext(), expParams)
+
+	params = suite.chainA.GetSimApp().ICQKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+}
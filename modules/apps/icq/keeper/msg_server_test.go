@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(false, []string{"/cosmos.bank.v1beta1.Query/Balance"})
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(suite.chainA.GetSimApp().ICQKeeper.GetAuthority(), params),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, params),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, err := suite.chainA.GetSimApp().ICQKeeper.UpdateParams(suite.chainA.GetContext(), tc.msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(params, suite.chainA.GetSimApp().ICQKeeper.GetParams(suite.chainA.GetContext()))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(types.DefaultParams(), suite.chainA.GetSimApp().ICQKeeper.GetParams(suite.chainA.GetContext()))
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// OnRecvPacket executes the queries carried by the interchain query packet data and returns the
+// JSON encoded InterchainQueryPacketAck holding their responses, in the order of the requests.
+// An error is returned if the host is disabled or if any of the queries fails, in which case no
+// response is returned.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	params := k.GetParams(ctx)
+	if !params.HostEnabled {
+		return nil, types.ErrHostDisabled
+	}
+
+	var data types.InterchainQueryPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal interchain query packet data: %v", err)
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	requests, err := types.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := k.executeQueries(ctx, requests, params.AllowQueries)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.SerializeCosmosResponse(responses)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.InterchainQueryPacketAck{Data: bz}.GetBytes(), nil
+}
+
+// executeQueries executes the provided query requests, which must all use an allowed query path.
+func (k Keeper) executeQueries(ctx sdk.Context, requests []abci.RequestQuery, allowQueries []string) ([]abci.ResponseQuery, error) {
+	responses := make([]abci.ResponseQuery, len(requests))
+	for i, req := range requests {
+		if !slices.Contains(allowQueries, req.Path) {
+			return nil, errorsmod.Wrapf(types.ErrQueryNotAllowed, "query path %s", req.Path)
+		}
+
+		// queries are always executed at the latest height of the host chain
+		if req.Height != 0 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "query height must be zero, got %d", req.Height)
+		}
+
+		var (
+			res *abci.ResponseQuery
+			err error
+		)
+		if types.IsStoreQuery(req.Path) {
+			res, err = k.executeStoreQuery(ctx, req)
+		} else {
+			res, err = k.executeGRPCQuery(ctx, req)
+		}
+		if err != nil {
+			k.Logger(ctx).Debug("interchain query failed", "path", req.Path, "error", err)
+			return nil, err
+		}
+
+		responses[i] = *res
+	}
+
+	return responses, nil
+}
+
+// executeGRPCQuery executes the gRPC query request against the current state of the host chain.
+// Proofs cannot be provided for gRPC queries.
+func (k Keeper) executeGRPCQuery(ctx sdk.Context, req abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if req.Prove {
+		return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "proofs are only supported for store queries, got query path %s", req.Path)
+	}
+
+	route := k.queryRouter.Route(req.Path)
+	if route == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "no route to query: %s", req.Path)
+	}
+
+	res, err := route(ctx, &abci.RequestQuery{
+		Path: req.Path,
+		Data: req.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &abci.ResponseQuery{
+		Value:  res.Value,
+		Height: ctx.BlockHeight(),
+	}, nil
+}
+
+// executeStoreQuery executes the raw store query request against the latest committed state of the
+// host chain, returning a proof of the queried value when requested. The proof is verifiable against
+// the app hash of the block following the returned height.
+func (k Keeper) executeStoreQuery(ctx sdk.Context, req abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if k.abciQuerier == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "store queries are not supported by the host chain, got query path %s", req.Path)
+	}
+
+	res, err := k.abciQuerier.Query(ctx, &abci.RequestQuery{
+		Path:  req.Path,
+		Data:  req.Data,
+		Prove: req.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.IsErr() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "store query %s failed with code %d", req.Path, res.Code)
+	}
+
+	// store queries are not metered by the store gas meter, the read is charged explicitly
+	gasConfig := storetypes.KVGasConfig()
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat+gasConfig.ReadCostPerByte*storetypes.Gas(len(res.Value)), "interchain store query")
+
+	return &abci.ResponseQuery{
+		Key:      res.Key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}, nil
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+const balanceQueryPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		requests   []abci.RequestQuery
+		packetData []byte
+	)
+
+	storeQueryPath := types.NewStoreQueryRequest(types.StoreKey, types.PortKey).Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: gRPC query", func() {}, nil,
+		},
+		{
+			"success: store query with proof", func() {
+				requests = []abci.RequestQuery{types.NewStoreQueryRequest(types.StoreKey, types.PortKey)}
+			}, nil,
+		},
+		{
+			"success: gRPC and store queries", func() {
+				requests = append(requests, types.NewStoreQueryRequest(types.StoreKey, types.PortKey))
+			}, nil,
+		},
+		{
+			"failure: host is disabled", func() {
+				params := suite.chainB.GetSimApp().ICQKeeper.GetParams(suite.chainB.GetContext())
+				params.HostEnabled = false
+				suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, types.ErrHostDisabled,
+		},
+		{
+			"failure: cannot unmarshal packet data", func() {
+				packetData = []byte("invalid packet data")
+			}, ibcerrors.ErrUnknownRequest,
+		},
+		{
+			"failure: empty packet data", func() {
+				packetData = types.InterchainQueryPacketData{}.GetBytes()
+			}, types.ErrInvalidPacketData,
+		},
+		{
+			"failure: cannot deserialize cosmos query", func() {
+				packetData = types.InterchainQueryPacketData{Data: []byte("invalid")}.GetBytes()
+			}, types.ErrInvalidQuery,
+		},
+		{
+			"failure: query path is not allowed", func() {
+				suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{storeQueryPath}))
+			}, types.ErrQueryNotAllowed,
+		},
+		{
+			"failure: query height is not zero", func() {
+				requests[0].Height = 1
+			}, types.ErrInvalidQuery,
+		},
+		{
+			"failure: proof requested for gRPC query", func() {
+				requests[0].Prove = true
+			}, types.ErrInvalidQuery,
+		},
+		{
+			"failure: no route to gRPC query", func() {
+				path := "/cosmos.bank.v1beta1.Query/Unknown"
+				suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{path}))
+				requests[0].Path = path
+			}, types.ErrInvalidQuery,
+		},
+		{
+			"failure: store query fails", func() {
+				path := "/store/unknown/key"
+				suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{path}))
+				requests = []abci.RequestQuery{{Path: path, Data: types.PortKey, Prove: true}}
+			}, types.ErrInvalidQuery,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{balanceQueryPath, storeQueryPath}))
+
+			balanceReq, err := types.NewGRPCQueryRequest(balanceQueryPath, banktypes.NewQueryBalanceRequest(suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+			suite.Require().NoError(err)
+
+			requests = []abci.RequestQuery{balanceReq}
+			packetData = nil
+
+			tc.malleate()
+
+			if packetData == nil {
+				data, err := types.NewInterchainQueryPacketData(requests, "")
+				suite.Require().NoError(err)
+
+				packetData = data.GetBytes()
+			}
+
+			packet := channeltypes.NewPacket(packetData, 1, ibctesting.MockPort, ibctesting.FirstChannelID, types.PortID, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			res, err := suite.chainB.GetSimApp().ICQKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				responses, err := types.ParseAcknowledgement(channeltypes.NewResultAcknowledgement(res).Acknowledgement())
+				suite.Require().NoError(err)
+				suite.Require().Len(responses, len(requests))
+
+				for i, req := range requests {
+					suite.assertQueryResponse(req, responses[i])
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// assertQueryResponse asserts the response of a successfully executed query on chainB.
+func (suite *KeeperTestSuite) assertQueryResponse(req abci.RequestQuery, res abci.ResponseQuery) {
+	if !types.IsStoreQuery(req.Path) {
+		var balanceRes banktypes.QueryBalanceResponse
+		suite.Require().NoError(balanceRes.Unmarshal(res.Value))
+
+		expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+		suite.Require().Equal(expBalance, *balanceRes.Balance)
+		suite.Require().Equal(suite.chainB.GetContext().BlockHeight(), res.Height)
+		suite.Require().Nil(res.ProofOps)
+		return
+	}
+
+	// store queries are answered at the latest committed height with a proof against its app hash
+	suite.Require().Equal([]byte(types.PortID), res.Value)
+	suite.Require().Equal(suite.chainB.App.LastBlockHeight(), res.Height)
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	suite.Require().NoError(err)
+
+	root := commitmenttypes.NewMerkleRoot(suite.chainB.App.LastCommitID().Hash)
+	merklePath := commitmenttypes.NewMerklePath(types.StoreKey, string(types.PortKey))
+	suite.Require().NoError(merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, res.Value))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketStoreQueriesUnsupported() {
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	storeReq := types.NewStoreQueryRequest(types.StoreKey, types.PortKey)
+
+	icqKeeper := keeper.NewKeeper(
+		suite.chainB.GetSimApp().AppCodec(),
+		suite.chainB.GetSimApp().GetKey(types.StoreKey),
+		suite.chainB.GetSimApp().IBCKeeper.PortKeeper,
+		suite.chainB.GetSimApp().ScopedICQKeeper,
+		suite.chainB.GetSimApp().GRPCQueryRouter(),
+		nil, // store queries are not supported
+		suite.chainB.GetSimApp().ICQKeeper.GetAuthority(),
+	)
+	icqKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{storeReq.Path}))
+
+	data, err := types.NewInterchainQueryPacketData([]abci.RequestQuery{storeReq}, "")
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctesting.MockPort, ibctesting.FirstChannelID, types.PortID, ibctesting.FirstChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	res, err := icqKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+	suite.Require().ErrorIs(err, types.ErrInvalidQuery)
+	suite.Require().Nil(res)
+}
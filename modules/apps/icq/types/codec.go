@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the interchain query message types using the provided InterfaceRegistry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// ModuleCdc references the global interchain query module codec. Note, the codec
+// should ONLY be used in certain instances of tests and for JSON encoding.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Interchain query sentinel errors
+var (
+	ErrHostDisabled           = errorsmod.Register(ModuleName, 2, "interchain query host is disabled")
+	ErrInvalidVersion         = errorsmod.Register(ModuleName, 3, "invalid interchain query version")
+	ErrInvalidChannelFlow     = errorsmod.Register(ModuleName, 4, "invalid message sent to channel end")
+	ErrInvalidPacketData      = errorsmod.Register(ModuleName, 5, "invalid interchain query packet data")
+	ErrQueryNotAllowed        = errorsmod.Register(ModuleName, 6, "query path is not allowed")
+	ErrInvalidQuery           = errorsmod.Register(ModuleName, 7, "invalid interchain query")
+	ErrInvalidAcknowledgement = errorsmod.Register(ModuleName, 8, "invalid interchain query acknowledgement")
+)
@@ -0,0 +1,10 @@
+package types
+
+// Interchain query events
+const (
+	EventTypePacket = "icq_packet"
+
+	AttributeKeyHostChannelID = "host_channel_id"
+	AttributeKeyAckSuccess    = "success"
+	AttributeKeyAckError      = "error"
+)
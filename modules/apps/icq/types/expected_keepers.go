@@ -0,0 +1,35 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// QueryRouter defines the expected gRPC query router used to execute gRPC queries against the
+// current state of the host chain.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
+
+// ABCIQuerier defines the expected ABCI querier used to execute raw store queries, with proofs,
+// against the latest committed state of the host chain. It is implemented by baseapp.BaseApp.
+type ABCIQuerier interface {
+	Query(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error)
+}
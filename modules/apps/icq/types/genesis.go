@@ -0,0 +1,28 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewGenesisState creates a new interchain query GenesisState instance.
+func NewGenesisState(hostPort string, params Params) *GenesisState {
+	return &GenesisState{
+		HostPort: hostPort,
+		Params:   params,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with the default port and parameters.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, DefaultParams())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.HostPort); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"strings"
+)
+
+const (
+	// ModuleName defines the interchain query module name
+	ModuleName = "icq"
+
+	// Version defines the current version the interchain query module supports
+	Version = "icq-1"
+
+	// PortID is the default port id that the interchain query host module binds to
+	PortID = "icqhost"
+
+	// StoreKey is the store key string for the interchain query module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the interchain query module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the interchain query module
+	QuerierRoute = ModuleName
+
+	// ParamsKey is the key to use for the storing params.
+	ParamsKey = "params"
+
+	// StoreQueryPathPrefix is the query path prefix of raw store queries, which are the only
+	// queries the host is able to answer with proofs of the returned values.
+	StoreQueryPathPrefix = "/store/"
+)
+
+// PortKey defines the key to store the port ID in store
+var PortKey = []byte{0x01}
+
+// IsStoreQuery returns true if the provided query path is a raw store query path, i.e. of the
+// format /store/<store key>/key.
+func IsStoreQuery(path string) bool {
+	return strings.HasPrefix(path, StoreQueryPathPrefix)
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+const (
+	// MaxMemoCharLength defines the maximum length for the InterchainQueryPacketData memo field
+	MaxMemoCharLength = 32768
+	// MaxQueriesPerPacket defines the maximum number of queries a single interchain query packet may carry
+	MaxQueriesPerPacket = 100
+)
+
+// NewInterchainQueryPacketData creates a new InterchainQueryPacketData carrying the provided query requests.
+func NewInterchainQueryPacketData(requests []abci.RequestQuery, memo string) (InterchainQueryPacketData, error) {
+	bz, err := SerializeCosmosQuery(requests)
+	if err != nil {
+		return InterchainQueryPacketData{}, err
+	}
+
+	return InterchainQueryPacketData{
+		Data: bz,
+		Memo: memo,
+	}, nil
+}
+
+// ValidateBasic performs basic validation of the interchain query packet data.
+// The memo may be empty.
+func (iqpd InterchainQueryPacketData) ValidateBasic() error {
+	if len(iqpd.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "packet data cannot be empty")
+	}
+
+	if len(iqpd.Memo) > MaxMemoCharLength {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain query packet data.
+func (iqpd InterchainQueryPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&iqpd)
+}
+
+// GetBytes returns the JSON marshalled interchain query packet acknowledgement.
+func (iqpa InterchainQueryPacketAck) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&iqpa)
+}
+
+// NewStoreQueryRequest returns a query request for the value stored under the provided key of the
+// host chain store registered with the provided store key. The host answers the request with the
+// value and a proof of it, queried at the latest committed height of the host chain.
+func NewStoreQueryRequest(storeKey string, key []byte) abci.RequestQuery {
+	return abci.RequestQuery{
+		Path:  fmt.Sprintf("%s%s/key", StoreQueryPathPrefix, storeKey),
+		Data:  key,
+		Prove: true,
+	}
+}
+
+// NewGRPCQueryRequest returns a query request executing the gRPC query method with the provided
+// fully-qualified path (e.g. /cosmos.bank.v1beta1.Query/Balance) and request message. The host
+// answers the request without proof, queried against its current state.
+func NewGRPCQueryRequest(path string, req proto.Message) (abci.RequestQuery, error) {
+	bz, err := proto.Marshal(req)
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+
+	return abci.RequestQuery{
+		Path: path,
+		Data: bz,
+	}, nil
+}
+
+// SerializeCosmosQuery serializes the provided query requests into the protobuf encoded CosmosQuery
+// carried by the interchain query packet data.
+func SerializeCosmosQuery(requests []abci.RequestQuery) ([]byte, error) {
+	if len(requests) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidQuery, "query requests cannot be empty")
+	}
+
+	if len(requests) > MaxQueriesPerPacket {
+		return nil, errorsmod.Wrapf(ErrInvalidQuery, "number of query requests cannot be greater than %d", MaxQueriesPerPacket)
+	}
+
+	query := CosmosQuery{Requests: requests}
+	return query.Marshal()
+}
+
+// DeserializeCosmosQuery deserializes the protobuf encoded CosmosQuery carried by the interchain query
+// packet data into its query requests.
+func DeserializeCosmosQuery(bz []byte) ([]abci.RequestQuery, error) {
+	var query CosmosQuery
+	if err := query.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidQuery, "cannot unmarshal cosmos query: %v", err)
+	}
+
+	if len(query.Requests) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidQuery, "query requests cannot be empty")
+	}
+
+	if len(query.Requests) > MaxQueriesPerPacket {
+		return nil, errorsmod.Wrapf(ErrInvalidQuery, "number of query requests cannot be greater than %d", MaxQueriesPerPacket)
+	}
+
+	return query.Requests, nil
+}
+
+// SerializeCosmosResponse serializes the provided query responses into the protobuf encoded CosmosResponse
+// carried by the interchain query packet acknowledgement.
+func SerializeCosmosResponse(responses []abci.ResponseQuery) ([]byte, error) {
+	response := CosmosResponse{Responses: responses}
+	return response.Marshal()
+}
+
+// DeserializeCosmosResponse deserializes the protobuf encoded CosmosResponse carried by the interchain query
+// packet acknowledgement into its query responses.
+func DeserializeCosmosResponse(bz []byte) ([]abci.ResponseQuery, error) {
+	var response CosmosResponse
+	if err := response.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal cosmos response: %v", err)
+	}
+
+	return response.Responses, nil
+}
+
+// ParseAcknowledgement parses the acknowledgement written by an interchain query host into the
+// query responses, in the order of the requests of the acknowledged packet. An error is returned
+// if the host failed to execute the queries.
+func ParseAcknowledgement(acknowledgement []byte) ([]abci.ResponseQuery, error) {
+	var ack channeltypes.Acknowledgement
+	if err := ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal acknowledgement: %v", err)
+	}
+
+	if !ack.Success() {
+		return nil, errorsmod.Wrapf(ErrInvalidAcknowledgement, "interchain query failed on host chain: %s", ack.GetError())
+	}
+
+	var packetAck InterchainQueryPacketAck
+	if err := ModuleCdc.UnmarshalJSON(ack.GetResult(), &packetAck); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal interchain query packet acknowledgement: %v", err)
+	}
+
+	return DeserializeCosmosResponse(packetAck.Data)
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func TestInterchainQueryPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.InterchainQueryPacketData
+		expErr     error
+	}{
+		{"success", types.InterchainQueryPacketData{Data: []byte("data")}, nil},
+		{"success: with memo", types.InterchainQueryPacketData{Data: []byte("data"), Memo: "memo"}, nil},
+		{"failure: empty data", types.InterchainQueryPacketData{Memo: "memo"}, types.ErrInvalidPacketData},
+		{"failure: memo too long", types.InterchainQueryPacketData{Data: []byte("data"), Memo: strings.Repeat("a", types.MaxMemoCharLength+1)}, types.ErrInvalidPacketData},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.packetData.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestCosmosQuerySerialization(t *testing.T) {
+	balanceReq, err := types.NewGRPCQueryRequest("/cosmos.bank.v1beta1.Query/Balance", &banktypes.QueryBalanceRequest{Address: "address", Denom: "stake"})
+	require.NoError(t, err)
+
+	storeReq := types.NewStoreQueryRequest(banktypes.StoreKey, []byte("key"))
+	require.Equal(t, "/store/bank/key", storeReq.Path)
+	require.True(t, storeReq.Prove)
+	require.True(t, types.IsStoreQuery(storeReq.Path))
+	require.False(t, types.IsStoreQuery(balanceReq.Path))
+
+	requests := []abci.RequestQuery{balanceReq, storeReq}
+
+	packetData, err := types.NewInterchainQueryPacketData(requests, "memo")
+	require.NoError(t, err)
+	require.NoError(t, packetData.ValidateBasic())
+
+	deserialized, err := types.DeserializeCosmosQuery(packetData.Data)
+	require.NoError(t, err)
+	require.Equal(t, requests, deserialized)
+
+	_, err = types.NewInterchainQueryPacketData(nil, "")
+	require.ErrorIs(t, err, types.ErrInvalidQuery)
+
+	_, err = types.NewInterchainQueryPacketData(make([]abci.RequestQuery, types.MaxQueriesPerPacket+1), "")
+	require.ErrorIs(t, err, types.ErrInvalidQuery)
+
+	_, err = types.DeserializeCosmosQuery([]byte("invalid"))
+	require.ErrorIs(t, err, types.ErrInvalidQuery)
+}
+
+func TestParseAcknowledgement(t *testing.T) {
+	responses := []abci.ResponseQuery{
+		{Value: []byte("value"), Height: 10},
+		{Key: []byte("key"), Value: []byte("stored value"), Height: 9},
+	}
+
+	bz, err := types.SerializeCosmosResponse(responses)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		ack    []byte
+		expErr error
+	}{
+		{
+			"success",
+			channeltypes.NewResultAcknowledgement(types.InterchainQueryPacketAck{Data: bz}.GetBytes()).Acknowledgement(),
+			nil,
+		},
+		{
+			"failure: error acknowledgement",
+			channeltypes.NewErrorAcknowledgement(types.ErrQueryNotAllowed).Acknowledgement(),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: invalid acknowledgement",
+			[]byte("invalid"),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: invalid packet acknowledgement",
+			channeltypes.NewResultAcknowledgement([]byte("invalid")).Acknowledgement(),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: invalid cosmos response",
+			channeltypes.NewResultAcknowledgement(types.InterchainQueryPacketAck{Data: []byte("invalid")}.GetBytes()).Acknowledgement(),
+			types.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := types.ParseAcknowledgement(tc.ack)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, responses, res)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Nil(t, res)
+			}
+		})
+	}
+}
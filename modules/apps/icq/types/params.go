@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DefaultHostEnabled is the default value for the host param (set to true)
+	DefaultHostEnabled = true
+	// MaxAllowListLength is the maximum length of the query path allowlist
+	MaxAllowListLength = 500
+)
+
+// NewParams creates a new parameter configuration for the interchain query module
+func NewParams(enableHost bool, allowQueries []string) Params {
+	return Params{
+		HostEnabled:  enableHost,
+		AllowQueries: allowQueries,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the interchain query module.
+// No query paths are allowed by default.
+func DefaultParams() Params {
+	return NewParams(DefaultHostEnabled, nil)
+}
+
+// Validate validates all interchain query module parameters
+func (p Params) Validate() error {
+	return validateAllowlist(p.AllowQueries)
+}
+
+func validateAllowlist(allowQueries []string) error {
+	if len(allowQueries) > MaxAllowListLength {
+		return fmt.Errorf("allow list length must not exceed %d items", MaxAllowListLength)
+	}
+
+	seen := make(map[string]struct{}, len(allowQueries))
+	for _, path := range allowQueries {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", allowQueries)
+		}
+
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("query path must start with a forward slash: %s", path)
+		}
+
+		if _, ok := seen[path]; ok {
+			return fmt.Errorf("duplicate query path in allow list: %s", path)
+		}
+		seen[path] = struct{}{}
+	}
+
+	return nil
+}
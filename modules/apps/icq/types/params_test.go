@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/icq/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	tooManyPaths := make([]string, types.MaxAllowListLength+1)
+	for i := range tooManyPaths {
+		tooManyPaths[i] = fmt.Sprintf("/store/bank/key%d", i)
+	}
+
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{"default params", types.DefaultParams(), nil},
+		{"success: allowed store and gRPC query paths", types.NewParams(true, []string{"/store/bank/key", "/cosmos.bank.v1beta1.Query/Balance"}), nil},
+		{"success: host disabled with empty allow list", types.NewParams(false, nil), nil},
+		{"failure: empty query path", types.NewParams(true, []string{" "}), errors.New("parameter must not contain empty strings")},
+		{"failure: query path without leading forward slash", types.NewParams(true, []string{"store/bank/key"}), errors.New("query path must start with a forward slash")},
+		{"failure: duplicate query path", types.NewParams(true, []string{"/store/bank/key", "/store/bank/key"}), errors.New("duplicate query path in allow list")},
+		{"failure: allow list too long", types.NewParams(true, tooManyPaths), errors.New("allow list length must not exceed")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name    string
+		genesis *types.GenesisState
+		expPass bool
+	}{
+		{"default genesis", types.DefaultGenesisState(), true},
+		{"failure: invalid host port", types.NewGenesisState("", types.DefaultParams()), false},
+		{"failure: invalid params", types.NewGenesisState(types.PortID, types.NewParams(true, []string{""})), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+/*
+Package origintrace implements an IBC middleware which tags the memo of outgoing packets
+with the trace of chains the packet traversed. Every chain running the middleware appends its chain
+identifier to the origin trace of the packets it sends, and validates the origin trace of the packets
+it receives, so that destination applications can apply policies based on the provenance of packets
+across multihop forwards. Origin traces are only extended by the packet forwarding a received packet
+while it is handed off to the underlying application, so that senders cannot forge the provenance of
+their packets. Only packets whose data carries a JSON memo are tagged.
+*/
+package origintrace
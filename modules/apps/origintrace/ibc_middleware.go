@@ -0,0 +1,243 @@
+package origintrace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/origintrace/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var (
+	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the origin trace middleware given the underlying
+// application. Outgoing packets are tagged with the origin trace and the origin trace of incoming
+// packets is validated, all other callbacks are passed through to the underlying application.
+type IBCMiddleware struct {
+	porttypes.BaseMiddleware
+
+	channelKeeper types.ChannelKeeper
+
+	// transientKey is the key of the transient store recording the origin traces of the packets received
+	// while they are handed off to the underlying application, which may be extended by the packets
+	// forwarding them.
+	transientKey storetypes.StoreKey
+
+	// maxTraceLength defines the maximum number of chains the origin trace of a packet may record.
+	maxTraceLength uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the underlying application, ICS4Wrapper, channel keeper,
+// transient store key and the maximum number of chains an origin trace may record. The underlying application
+// must implement the PacketDataUnmarshaler interface, which is used to determine whether the data of its
+// packets carries a memo.
+func NewIBCMiddleware(
+	app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper, transientKey storetypes.StoreKey, maxTraceLength uint64,
+) IBCMiddleware {
+	if _, ok := app.(porttypes.PacketDataUnmarshaler); !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*porttypes.PacketDataUnmarshaler)(nil)))
+	}
+
+	if ics4Wrapper == nil {
+		panic(errors.New("ICS4Wrapper cannot be nil"))
+	}
+
+	if channelKeeper == nil {
+		panic(errors.New("channel keeper cannot be nil"))
+	}
+
+	if transientKey == nil {
+		panic(errors.New("transient store key cannot be nil"))
+	}
+
+	if maxTraceLength == 0 {
+		panic(errors.New("maxTraceLength cannot be zero"))
+	}
+
+	return IBCMiddleware{
+		BaseMiddleware: porttypes.NewBaseMiddleware(app, ics4Wrapper),
+		channelKeeper:  channelKeeper,
+		transientKey:   transientKey,
+		maxTraceLength: maxTraceLength,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface. The chain identifier of the sending chain is appended to
+// the origin trace recorded in the memo of the packet if the packet is sent while a received packet is handed
+// off to the underlying application, i.e. if the packet forwards the received packet, and the origin trace is
+// the one of the received packet. Otherwise, the origin trace recorded in the memo cannot be trusted and a new
+// origin trace is started. The origin trace of a received packet is only extended by the first packet forwarding
+// it. Applications forwarding received packets are expected to copy the origin trace of the received packet into
+// the memo of the forwarded packet. Packets whose data does not carry a memo, or whose memo is neither empty nor
+// a JSON object, are sent untagged.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	packetData, err := im.UnmarshalPacketData(data)
+	if _, ok := packetData.(ibcexported.PacketDataProvider); err != nil || !ok {
+		return im.BaseMiddleware.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	trace, found, err := types.GetOriginTrace(data)
+	if err != nil {
+		return 0, err
+	}
+
+	if found && !im.consumeReceivedOriginTrace(ctx, trace) {
+		trace = nil
+	}
+
+	trace = append(trace, ctx.ChainID())
+	if err := trace.Validate(im.maxTraceLength); err != nil {
+		return 0, err
+	}
+
+	taggedData, tagged, err := types.SetOriginTrace(data, trace)
+	if err != nil {
+		return 0, err
+	}
+
+	if tagged {
+		data = taggedData
+	}
+
+	return im.BaseMiddleware.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// OnRecvPacket implements the IBCModule interface. If the memo of the packet records an origin trace, the
+// origin trace is validated before the packet is passed to the underlying application, and an error
+// acknowledgement is returned if it is invalid. Valid origin traces are recorded for the received packet while
+// it is handed off to the underlying application, so that they can be extended by the packet forwarding it.
+// Untagged packets are passed through as is.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	trace, found, err := types.GetOriginTrace(packet.GetData())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if found {
+		if err := im.validateOriginTrace(ctx, packet, trace); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		emitOriginTraceEvent(ctx, packet, trace)
+
+		packetID := channeltypes.NewPacketID(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		im.setReceivedOriginTrace(ctx, packetID, trace)
+		defer im.deleteReceivedOriginTrace(ctx, packetID)
+
+		ctx = ctx.WithValue(receivedPacketKey{}, packetID)
+	}
+
+	return im.BaseMiddleware.OnRecvPacket(ctx, packet, relayer)
+}
+
+// validateOriginTrace validates the origin trace of a received packet. The origin trace must record at most
+// maxTraceLength chains and, if the light client of the channel tracks a chain identifier, end with the chain
+// identifier of the counterparty chain.
+func (im IBCMiddleware) validateOriginTrace(ctx sdk.Context, packet channeltypes.Packet, trace types.OriginTrace) error {
+	if err := trace.Validate(im.maxTraceLength); err != nil {
+		return err
+	}
+
+	_, clientState, err := im.channelKeeper.GetChannelClientState(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return err
+	}
+
+	// only light clients tracking chains with a chain identifier expose it on their client state
+	chainIDClientState, ok := clientState.(interface{ GetChainID() string })
+	if ok && trace.Last() != chainIDClientState.GetChainID() {
+		return errorsmod.Wrapf(types.ErrOriginTraceMismatch, "expected %s, got %s", chainIDClientState.GetChainID(), trace.Last())
+	}
+
+	return nil
+}
+
+// receivedPacketKey is the context key under which the identifier of the received packet handed off to the
+// underlying application is stored.
+type receivedPacketKey struct{}
+
+// setReceivedOriginTrace records the origin trace of the received packet with the given identifier.
+func (im IBCMiddleware) setReceivedOriginTrace(ctx sdk.Context, packetID channeltypes.PacketId, trace types.OriginTrace) {
+	// the origin trace is JSON encoded, as chain identifiers are not restricted to a character set
+	bz, err := json.Marshal(trace)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.TransientStore(im.transientKey)
+	store.Set(types.ReceivedOriginTraceKey(packetID.PortId, packetID.ChannelId, packetID.Sequence), bz)
+}
+
+// deleteReceivedOriginTrace deletes the origin trace recorded for the received packet with the given identifier.
+func (im IBCMiddleware) deleteReceivedOriginTrace(ctx sdk.Context, packetID channeltypes.PacketId) {
+	store := ctx.TransientStore(im.transientKey)
+	store.Delete(types.ReceivedOriginTraceKey(packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
+
+// consumeReceivedOriginTrace returns true if the packet being sent forwards a received packet with the given
+// origin trace, i.e. if the packet is sent while a received packet with the given origin trace is handed off
+// to the underlying application, and the origin trace has not been extended by another packet yet. The
+// recorded origin trace is deleted, so that it is only extended once.
+func (im IBCMiddleware) consumeReceivedOriginTrace(ctx sdk.Context, trace types.OriginTrace) bool {
+	packetID, ok := ctx.Value(receivedPacketKey{}).(channeltypes.PacketId)
+	if !ok {
+		return false
+	}
+
+	store := ctx.TransientStore(im.transientKey)
+	bz := store.Get(types.ReceivedOriginTraceKey(packetID.PortId, packetID.ChannelId, packetID.Sequence))
+	if len(bz) == 0 {
+		return false
+	}
+
+	var receivedTrace types.OriginTrace
+	if err := json.Unmarshal(bz, &receivedTrace); err != nil || !slices.Equal(receivedTrace, trace) {
+		return false
+	}
+
+	im.deleteReceivedOriginTrace(ctx, packetID)
+	return true
+}
+
+// emitOriginTraceEvent emits an event recording the origin trace of a received packet.
+func emitOriginTraceEvent(ctx sdk.Context, packet channeltypes.Packet, trace types.OriginTrace) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOriginTrace,
+			sdk.NewAttribute(types.AttributeKeyOrigin, trace.Origin()),
+			sdk.NewAttribute(types.AttributeKeyTrace, trace.String()),
+			sdk.NewAttribute(types.AttributeKeyPortID, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		),
+	)
+}
@@ -0,0 +1,371 @@
+package origintrace_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/origintrace"
+	"github.com/cosmos/ibc-go/v8/modules/apps/origintrace/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+type OriginTraceTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *OriginTraceTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestOriginTraceTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(OriginTraceTestSuite))
+}
+
+// captureICS4Wrapper records the data of the packets sent through it before passing them to the underlying ICS4Wrapper.
+type captureICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+
+	data []byte
+}
+
+func (w *captureICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	w.data = data
+	return w.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+func (suite *OriginTraceTestSuite) TestNewIBCMiddleware() {
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	transientKey := suite.chainA.GetSimApp().GetTKey(types.TransientStoreKey)
+	app := mock.NewIBCModule(&mock.AppModule{}, &mock.IBCApp{})
+
+	suite.Require().NotPanics(func() {
+		origintrace.NewIBCMiddleware(app, channelKeeper, channelKeeper, transientKey, types.DefaultMaxTraceLength)
+	})
+
+	suite.Require().Panics(func() {
+		// BlockUpgradeMiddleware does not implement the PacketDataUnmarshaler interface
+		origintrace.NewIBCMiddleware(mock.NewBlockUpgradeMiddleware(&mock.AppModule{}, &mock.IBCApp{}), channelKeeper, channelKeeper, transientKey, types.DefaultMaxTraceLength)
+	})
+
+	suite.Require().Panics(func() {
+		origintrace.NewIBCMiddleware(app, nil, channelKeeper, transientKey, types.DefaultMaxTraceLength)
+	})
+
+	suite.Require().Panics(func() {
+		origintrace.NewIBCMiddleware(app, channelKeeper, nil, transientKey, types.DefaultMaxTraceLength)
+	})
+
+	suite.Require().Panics(func() {
+		origintrace.NewIBCMiddleware(app, channelKeeper, channelKeeper, nil, types.DefaultMaxTraceLength)
+	})
+
+	suite.Require().Panics(func() {
+		origintrace.NewIBCMiddleware(app, channelKeeper, channelKeeper, transientKey, 0)
+	})
+}
+
+func (suite *OriginTraceTestSuite) TestSendPacket() {
+	var (
+		app       porttypes.IBCModule
+		data      []byte
+		recvTrace types.OriginTrace
+		forwards  int
+		expTrace  types.OriginTrace
+	)
+
+	packetDataWithMemo := func(memo string) []byte {
+		return transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", ibctesting.TestAccAddress, ibctesting.TestAccAddress, memo).GetBytes()
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: new origin trace",
+			func() {},
+			nil,
+		},
+		{
+			"success: origin trace of the received packet is extended by the packet forwarding it",
+			func() {
+				recvTrace = types.OriginTrace{"osmosis-1", suite.chainB.ChainID}
+				data = packetDataWithMemo(fmt.Sprintf(`{"origin_trace":["osmosis-1","%s"]}`, suite.chainB.ChainID))
+				expTrace = types.OriginTrace{"osmosis-1", suite.chainB.ChainID, suite.chainA.ChainID}
+			},
+			nil,
+		},
+		{
+			"success: origin trace which was not received is replaced",
+			func() {
+				data = packetDataWithMemo(fmt.Sprintf(`{"origin_trace":["osmosis-1","%s"]}`, suite.chainB.ChainID))
+			},
+			nil,
+		},
+		{
+			"success: origin trace of a packet received earlier is replaced",
+			func() {
+				recvTrace = types.OriginTrace{"osmosis-1", suite.chainB.ChainID}
+				forwards = 0
+				data = packetDataWithMemo(fmt.Sprintf(`{"origin_trace":["osmosis-1","%s"]}`, suite.chainB.ChainID))
+			},
+			nil,
+		},
+		{
+			"success: origin trace which is not the one of the forwarded packet is replaced",
+			func() {
+				recvTrace = types.OriginTrace{"osmosis-1", suite.chainB.ChainID}
+				data = packetDataWithMemo(fmt.Sprintf(`{"origin_trace":["juno-1","%s"]}`, suite.chainB.ChainID))
+			},
+			nil,
+		},
+		{
+			"success: origin trace of the received packet is only extended once",
+			func() {
+				recvTrace = types.OriginTrace{"osmosis-1", suite.chainB.ChainID}
+				forwards = 2
+				data = packetDataWithMemo(fmt.Sprintf(`{"origin_trace":["osmosis-1","%s"]}`, suite.chainB.ChainID))
+			},
+			nil,
+		},
+		{
+			"success: other memo fields are preserved",
+			func() {
+				data = packetDataWithMemo(`{"src_callback":{"address":"cosmos1"}}`)
+			},
+			nil,
+		},
+		{
+			"success: memo which is not a JSON object is not tagged",
+			func() {
+				data = packetDataWithMemo("memo")
+				expTrace = nil
+			},
+			nil,
+		},
+		{
+			"success: packet data without a memo is not tagged",
+			func() {
+				app = mock.NewIBCModule(&mock.AppModule{}, &mock.IBCApp{})
+				data = mock.MockPacketData
+				expTrace = nil
+			},
+			nil,
+		},
+		{
+			"failure: extended origin trace exceeds the maximum length",
+			func() {
+				recvTrace = types.OriginTrace{"a", "b", "c", "d", "e", "f", "g", suite.chainB.ChainID}
+				data = packetDataWithMemo(fmt.Sprintf(`{"origin_trace":["a","b","c","d","e","f","g","%s"]}`, suite.chainB.ChainID))
+			},
+			types.ErrOriginTraceTooLong,
+		},
+		{
+			"failure: origin trace cannot be decoded",
+			func() {
+				data = packetDataWithMemo(`{"origin_trace":"osmosis-1"}`)
+			},
+			types.ErrInvalidOriginTrace,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			app = transfer.NewIBCModule(suite.chainA.GetSimApp().TransferKeeper)
+			data = packetDataWithMemo("")
+			recvTrace = nil
+			forwards = 1
+			expTrace = types.OriginTrace{suite.chainA.ChainID}
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			ics4Wrapper := &captureICS4Wrapper{ICS4Wrapper: channelKeeper}
+			transientKey := suite.chainA.GetSimApp().GetTKey(types.TransientStoreKey)
+			middleware := origintrace.NewIBCMiddleware(app, ics4Wrapper, channelKeeper, transientKey, types.DefaultMaxTraceLength)
+
+			chanCap := suite.chainA.GetChannelCapability(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			sendPacket := func(ctx sdk.Context) error {
+				_, err := middleware.SendPacket(ctx, chanCap, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainB.GetTimeoutHeight(), 0, data)
+				return err
+			}
+
+			ctx := suite.chainA.GetContext()
+
+			var err error
+			if recvTrace != nil {
+				// the received packet is forwarded by the underlying application while it is handed off to it
+				recvApp := mock.NewIBCModule(&mock.AppModule{}, &mock.IBCApp{
+					OnRecvPacket: func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+						for i := 0; i < forwards; i++ {
+							err = sendPacket(ctx)
+						}
+
+						return mock.MockAcknowledgement
+					},
+				})
+				recvMiddleware := origintrace.NewIBCMiddleware(recvApp, channelKeeper, channelKeeper, transientKey, types.DefaultMaxTraceLength)
+
+				traceBz, err := json.Marshal(recvTrace)
+				suite.Require().NoError(err)
+
+				recvData := packetDataWithMemo(fmt.Sprintf(`{"origin_trace":%s}`, traceBz))
+				packet := channeltypes.NewPacket(recvData, 1, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+				ack := recvMiddleware.OnRecvPacket(ctx, packet, suite.chainA.SenderAccount.GetAddress())
+				suite.Require().Equal(mock.MockAcknowledgement, ack)
+			}
+
+			if recvTrace == nil || forwards == 0 {
+				err = sendPacket(ctx)
+			}
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			trace, found, err := types.GetOriginTrace(ics4Wrapper.data)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expTrace != nil, found)
+			suite.Require().Equal(expTrace, trace)
+
+			if expTrace == nil {
+				suite.Require().Equal(data, ics4Wrapper.data)
+			}
+
+			if packetData, ok := app.(porttypes.PacketDataUnmarshaler); ok && expTrace != nil {
+				// the tagged packet data is still valid for the underlying application
+				_, err := packetData.UnmarshalPacketData(ics4Wrapper.data)
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *OriginTraceTestSuite) TestOnRecvPacket() {
+	var (
+		memo         string
+		appCalled    bool
+		expEventEmit bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: untagged packet is passed through",
+			func() {
+				memo = ""
+				expEventEmit = false
+			},
+			nil,
+		},
+		{
+			"success: origin trace ends with the counterparty chain",
+			func() {},
+			nil,
+		},
+		{
+			"failure: origin trace does not end with the counterparty chain",
+			func() {
+				memo = fmt.Sprintf(`{"origin_trace":["%s","osmosis-1"]}`, suite.chainA.ChainID)
+			},
+			types.ErrOriginTraceMismatch,
+		},
+		{
+			"failure: origin trace exceeds the maximum length",
+			func() {
+				memo = fmt.Sprintf(`{"origin_trace":["a","b","c","d","e","f","g","h","%s"]}`, suite.chainA.ChainID)
+			},
+			types.ErrOriginTraceTooLong,
+		},
+		{
+			"failure: origin trace cannot be decoded",
+			func() {
+				memo = `{"origin_trace":{}}`
+			},
+			types.ErrInvalidOriginTrace,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			memo = fmt.Sprintf(`{"origin_trace":["osmosis-1","%s"]}`, suite.chainA.ChainID)
+			appCalled = false
+			expEventEmit = true
+
+			tc.malleate()
+
+			app := mock.NewIBCModule(&mock.AppModule{}, &mock.IBCApp{
+				OnRecvPacket: func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+					appCalled = true
+					return mock.MockAcknowledgement
+				},
+			})
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			transientKey := suite.chainB.GetSimApp().GetTKey(types.TransientStoreKey)
+			middleware := origintrace.NewIBCMiddleware(app, channelKeeper, channelKeeper, transientKey, types.DefaultMaxTraceLength)
+
+			data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", ibctesting.TestAccAddress, ibctesting.TestAccAddress, memo).GetBytes()
+			packet := channeltypes.NewPacket(data, 1, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			ctx := suite.chainB.GetContext()
+			ack := middleware.OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+
+			if tc.expError != nil {
+				suite.Require().False(appCalled)
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.expError), ack)
+				return
+			}
+
+			suite.Require().True(appCalled)
+			suite.Require().Equal(mock.MockAcknowledgement, ack)
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeOriginTrace {
+					found = true
+				}
+			}
+			suite.Require().Equal(expEventEmit, found)
+		})
+	}
+}
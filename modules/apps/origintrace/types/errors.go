@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Origin trace middleware sentinel errors
+var (
+	ErrInvalidOriginTrace  = errorsmod.Register(ModuleName, 2, "invalid origin trace")
+	ErrOriginTraceTooLong  = errorsmod.Register(ModuleName, 3, "origin trace exceeds the maximum length")
+	ErrOriginTraceMismatch = errorsmod.Register(ModuleName, 4, "origin trace does not end with the counterparty chain")
+)
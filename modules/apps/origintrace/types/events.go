@@ -0,0 +1,12 @@
+package types
+
+// Origin trace middleware events
+const (
+	EventTypeOriginTrace = "origin_trace"
+
+	AttributeKeyOrigin         = "origin"
+	AttributeKeyTrace          = "trace"
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyPacketSequence = "packet_sequence"
+)
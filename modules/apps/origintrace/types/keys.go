@@ -0,0 +1,24 @@
+package types
+
+import "fmt"
+
+const (
+	// ModuleName defines the origin trace middleware name
+	ModuleName = "origintrace"
+
+	// TransientStoreKey is the transient store key of the origin trace middleware
+	TransientStoreKey = "transient_" + ModuleName
+
+	// MemoKey is the key of the origin trace in the JSON object of packet memos
+	MemoKey = "origin_trace"
+
+	// DefaultMaxTraceLength is the default maximum number of chains an origin trace may record
+	DefaultMaxTraceLength = 8
+)
+
+// ReceivedOriginTraceKey returns the transient store key under which the origin trace of the packet received
+// with the given destination port, destination channel and sequence is recorded while the packet is handed off
+// to the underlying application.
+func ReceivedOriginTraceKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("receivedOriginTraces/%s/%s/%d", portID, channelID, sequence))
+}
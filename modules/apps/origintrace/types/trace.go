@@ -0,0 +1,200 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// memoField is the JSON field of the memo in the data of packets carrying a memo
+const memoField = "memo"
+
+// OriginTrace is the list of identifiers of the chains a packet traversed, starting with the
+// chain on which the packet originated and ending with the chain which last sent the packet.
+type OriginTrace []string
+
+// Origin returns the identifier of the chain on which the packet originated.
+func (ot OriginTrace) Origin() string {
+	if len(ot) == 0 {
+		return ""
+	}
+
+	return ot[0]
+}
+
+// Last returns the identifier of the chain which last sent the packet.
+func (ot OriginTrace) Last() string {
+	if len(ot) == 0 {
+		return ""
+	}
+
+	return ot[len(ot)-1]
+}
+
+// String returns the comma separated chain identifiers of the origin trace.
+func (ot OriginTrace) String() string {
+	return strings.Join(ot, ",")
+}
+
+// Validate returns an error if the origin trace is empty, records more than maxLength chains
+// or contains a blank chain identifier.
+func (ot OriginTrace) Validate(maxLength uint64) error {
+	if len(ot) == 0 {
+		return errorsmod.Wrap(ErrInvalidOriginTrace, "origin trace cannot be empty")
+	}
+
+	if uint64(len(ot)) > maxLength {
+		return errorsmod.Wrapf(ErrOriginTraceTooLong, "origin trace records %d chains, maximum is %d", len(ot), maxLength)
+	}
+
+	for i, chainID := range ot {
+		if strings.TrimSpace(chainID) == "" {
+			return errorsmod.Wrapf(ErrInvalidOriginTrace, "chain identifier at index %d cannot be blank", i)
+		}
+	}
+
+	return nil
+}
+
+// GetOriginTrace returns the origin trace recorded in the memo of the provided JSON packet data.
+// False is returned if the packet data has no JSON memo or its memo does not record an origin trace.
+// An error is returned if the recorded origin trace cannot be decoded.
+func GetOriginTrace(packetData []byte) (OriginTrace, bool, error) {
+	_, memo, ok := decodeMemo(packetData)
+	if !ok {
+		return nil, false, nil
+	}
+
+	return originTraceFromMemo(memo)
+}
+
+// SetOriginTrace records the provided origin trace in the memo of the provided JSON packet data, replacing
+// the origin trace recorded in the memo, if any, and returns the resulting packet data. Only the value of the
+// memo field is rewritten, all other bytes of the packet data are preserved. The packet data is returned
+// unchanged with false if it has no memo which is empty or a JSON object.
+func SetOriginTrace(packetData []byte, trace OriginTrace) ([]byte, bool, error) {
+	_, memo, ok := decodeMemo(packetData)
+	if !ok {
+		return packetData, false, nil
+	}
+
+	traceBz, err := json.Marshal(trace)
+	if err != nil {
+		return nil, false, err
+	}
+	memo[MemoKey] = traceBz
+
+	memoBz, err := json.Marshal(memo)
+	if err != nil {
+		return nil, false, err
+	}
+
+	memoValue, err := json.Marshal(string(memoBz))
+	if err != nil {
+		return nil, false, err
+	}
+
+	bz, err := replaceMemo(packetData, memoValue)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return bz, true, nil
+}
+
+// replaceMemo replaces the value of the memo field of the provided JSON object with the provided value,
+// or adds the memo field at the end of the object if it has none, leaving all other bytes untouched.
+// If the memo field is duplicated, the last occurrence is replaced as it is the one decoded.
+func replaceMemo(packetData []byte, memoValue []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(packetData))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	start, end, fields := -1, -1, 0
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		if key == memoField {
+			end = int(decoder.InputOffset())
+			start = end - len(value)
+		}
+		fields++
+	}
+
+	if start >= 0 {
+		return concat(packetData[:start], memoValue, packetData[end:]), nil
+	}
+
+	// the closing brace of the object is the last non-whitespace byte of valid JSON packet data
+	closing := bytes.LastIndexByte(packetData, '}')
+	field := concat([]byte(`"`+memoField+`":`), memoValue)
+	if fields > 0 {
+		field = concat([]byte(","), field)
+	}
+
+	return concat(packetData[:closing], field, packetData[closing:]), nil
+}
+
+// decodeMemo decodes the provided packet data and its memo as JSON objects. An empty memo is decoded as
+// an empty JSON object. False is returned if the packet data is not a JSON object or its memo is neither
+// empty nor a JSON object.
+func decodeMemo(packetData []byte) (map[string]json.RawMessage, map[string]json.RawMessage, bool) {
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(packetData, &data); err != nil || data == nil {
+		return nil, nil, false
+	}
+
+	var memoStr string
+	if memoBz, found := data[memoField]; found {
+		if err := json.Unmarshal(memoBz, &memoStr); err != nil {
+			return nil, nil, false
+		}
+	}
+
+	memo := make(map[string]json.RawMessage)
+	if memoStr == "" {
+		return data, memo, true
+	}
+
+	if err := json.Unmarshal([]byte(memoStr), &memo); err != nil || memo == nil {
+		return nil, nil, false
+	}
+
+	return data, memo, true
+}
+
+// concat returns a new byte slice holding the concatenation of the provided byte slices.
+func concat(slices ...[]byte) []byte {
+	var bz []byte
+	for _, s := range slices {
+		bz = append(bz, s...)
+	}
+
+	return bz
+}
+
+// originTraceFromMemo returns the origin trace recorded in the provided memo, if any.
+func originTraceFromMemo(memo map[string]json.RawMessage) (OriginTrace, bool, error) {
+	traceBz, found := memo[MemoKey]
+	if !found {
+		return nil, false, nil
+	}
+
+	var trace OriginTrace
+	if err := json.Unmarshal(traceBz, &trace); err != nil {
+		return nil, false, errorsmod.Wrapf(ErrInvalidOriginTrace, "failed to decode origin trace: %s", err)
+	}
+
+	return trace, true, nil
+}
@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/origintrace/types"
+)
+
+func TestOriginTraceValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trace    types.OriginTrace
+		expError error
+	}{
+		{"success: single chain", types.OriginTrace{"chain-a"}, nil},
+		{"success: maximum length", types.OriginTrace{"chain-a", "chain-b"}, nil},
+		{"failure: empty origin trace", types.OriginTrace{}, types.ErrInvalidOriginTrace},
+		{"failure: exceeds maximum length", types.OriginTrace{"chain-a", "chain-b", "chain-c"}, types.ErrOriginTraceTooLong},
+		{"failure: blank chain identifier", types.OriginTrace{"chain-a", " "}, types.ErrInvalidOriginTrace},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.trace.Validate(2)
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestSetOriginTrace(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData string
+		expData    string
+		expTagged  bool
+	}{
+		{
+			"success: packet data without memo",
+			`{"amount":"100"}`,
+			`{"amount":"100","memo":"{\"origin_trace\":[\"chain-a\",\"chain-b\"]}"}`,
+			true,
+		},
+		{
+			"success: empty packet data object",
+			`{}`,
+			`{"memo":"{\"origin_trace\":[\"chain-a\",\"chain-b\"]}"}`,
+			true,
+		},
+		{
+			"success: existing origin trace is replaced and memo fields are preserved",
+			`{"amount":"100","memo":"{\"forward\":{\"port\":\"transfer\"},\"origin_trace\":[\"chain-c\"]}"}`,
+			`{"amount":"100","memo":"{\"forward\":{\"port\":\"transfer\"},\"origin_trace\":[\"chain-a\",\"chain-b\"]}"}`,
+			true,
+		},
+		{
+			"success: bytes outside of the memo are preserved",
+			`{ "receiver": "cosmos1", "memo": "", "amount": "100" }`,
+			`{ "receiver": "cosmos1", "memo": "{\"origin_trace\":[\"chain-a\",\"chain-b\"]}", "amount": "100" }`,
+			true,
+		},
+		{
+			"success: memo is not a JSON object",
+			`{"amount":"100","memo":"hello"}`,
+			`{"amount":"100","memo":"hello"}`,
+			false,
+		},
+		{
+			"success: packet data is not a JSON object",
+			`packet data`,
+			`packet data`,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			trace := types.OriginTrace{"chain-a", "chain-b"}
+
+			bz, tagged, err := types.SetOriginTrace([]byte(tc.packetData), trace)
+			require.NoError(t, err)
+			require.Equal(t, tc.expData, string(bz))
+			require.Equal(t, tc.expTagged, tagged)
+
+			storedTrace, found, err := types.GetOriginTrace(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.expTagged, found)
+			if tc.expTagged {
+				require.Equal(t, trace, storedTrace)
+			}
+		})
+	}
+}
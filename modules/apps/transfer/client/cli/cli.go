@@ -22,6 +22,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryTransferVolume(),
+		GetCmdQueryTransferVolumes(),
+		GetCmdQueryChannelsByDenom(),
+		GetCmdQueryDecimalConversions(),
+		GetCmdQueryCanonicalChannel(),
+		GetCmdQueryCanonicalChannels(),
+		GetCmdQueryUnwindRoute(),
 	)
 
 	return queryCmd
@@ -39,6 +46,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewMultiSendTxCmd(),
 	)
 
 	return txCmd
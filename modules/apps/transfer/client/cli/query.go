@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
+const flagHopTimeout = "hop-timeout"
+
 // GetCmdQueryDenomTrace defines the command to query a denomination trace from a given trace hash or ibc denom.
 func GetCmdQueryDenomTrace() *cobra.Command {
 	cmd := &cobra.Command{
@@ -201,3 +203,264 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTransferVolume defines the command to query the cumulative transfer volume of a denom over a channel.
+func GetCmdQueryTransferVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "volume [channel-id] [denom]",
+		Short:   "Query the cumulative transfer volume of a denom over a channel",
+		Long:    "Query the cumulative amount of tokens of a denom sent and received over a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer volume channel-0 uosmo", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTransferVolumeRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			res, err := queryClient.TransferVolume(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTransferVolumes defines the command to query the cumulative transfer volumes of all denoms over a channel.
+func GetCmdQueryTransferVolumes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "volumes [channel-id]",
+		Short:   "Query the cumulative transfer volumes of all denoms over a channel",
+		Long:    "Query the cumulative amount of tokens of all denoms sent and received over a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer volumes channel-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferVolumesRequest{
+				ChannelId:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TransferVolumes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer volumes")
+
+	return cmd
+}
+
+// GetCmdQueryChannelsByDenom defines the command to query the cumulative transfer volumes of a denom over all channels.
+func GetCmdQueryChannelsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channels-by-denom [denom]",
+		Short:   "Query the channels a denom has been transferred over",
+		Long:    "Query the cumulative amount of tokens of a denom sent and received over all channels it has been transferred over while volume tracking was enabled",
+		Example: fmt.Sprintf("%s query ibc-transfer channels-by-denom transfer/channel-0/uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelsByDenomRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelsByDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels")
+
+	return cmd
+}
+
+// GetCmdQueryDecimalConversions defines the command to query the decimal conversions of all denoms over a channel.
+func GetCmdQueryDecimalConversions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "decimal-conversions [channel-id]",
+		Short:   "Query the decimal conversions of all denoms over a channel",
+		Long:    "Query the decimal conversions applied to the amounts of all denoms transferred over a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer decimal-conversions channel-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDecimalConversionsRequest{
+				ChannelId:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DecimalConversions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "decimal conversions")
+
+	return cmd
+}
+
+// GetCmdQueryCanonicalChannel defines the command to query the canonical channel of a counterparty chain.
+func GetCmdQueryCanonicalChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "canonical-channel [chain-id]",
+		Short:   "Query the canonical channel of a counterparty chain",
+		Long:    "Query the channel registered as canonical for transfers to the counterparty chain with the given chain identifier",
+		Example: fmt.Sprintf("%s query ibc-transfer canonical-channel cosmoshub-4", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCanonicalChannelRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.CanonicalChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCanonicalChannels defines the command to query the canonical channels of all counterparty chains.
+func GetCmdQueryCanonicalChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "canonical-channels",
+		Short:   "Query the canonical channels of all counterparty chains",
+		Long:    "Query the channels registered as canonical for transfers to all counterparty chains",
+		Example: fmt.Sprintf("%s query ibc-transfer canonical-channels", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCanonicalChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CanonicalChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "canonical channels")
+
+	return cmd
+}
+
+// GetCmdQueryUnwindRoute defines the command to query the route unwinding the vouchers of a denomination
+// to the chain the tokens originate from.
+func GetCmdQueryUnwindRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unwind-route [denom]",
+		Short:   "Query the route unwinding a voucher to its origin chain",
+		Long:    "Query the channels over which the vouchers of a denomination must be sent, hop by hop, to return the tokens to the chain they originate from, with an estimated timeout timestamp for each hop",
+		Example: fmt.Sprintf("%s query ibc-transfer unwind-route transfer/channel-0/transfer/channel-5/uatom --%s 300000000000", version.AppName, flagHopTimeout),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			hopTimeout, err := cmd.Flags().GetUint64(flagHopTimeout)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryUnwindRouteRequest{
+				Denom:      args[0],
+				HopTimeout: hopTimeout,
+			}
+
+			res, err := queryClient.UnwindRoute(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagHopTimeout, types.DefaultUnwindHopTimeout, "Time in nanoseconds allowed for each hop of the route to be relayed. Default is 10 minutes.")
+
+	return cmd
+}
@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +27,8 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 	flagMemo                   = "memo"
+	flagSrcPort                = "src-port"
+	flagGasPerTransfer         = "gas-per-transfer"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -51,32 +57,62 @@ Relative timeout timestamp is added to the value of the user's local system cloc
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coin, err := parseTransferCoin(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			timeoutHeight, timeoutTimestamp, err := parseTimeouts(cmd)
+			if err != nil {
+				return err
 			}
 
-			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			memo, err := cmd.Flags().GetString(flagMemo)
 			if err != nil {
 				return err
 			}
 
-			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMultiSendTxCmd returns the command to create a transaction with a MsgTransfer for every row of a CSV file
+func NewMultiSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [file.csv]",
+		Short: "Transfer fungible tokens through IBC to many receivers in a single transaction",
+		Long: strings.TrimSpace(`Transfer fungible tokens through IBC to many receivers in a single transaction, for example for airdrop-style distributions to other chains.
+Every row of the CSV file is transferred with a MsgTransfer and must be of the form {src-channel},{receiver},{amount}[,{memo}]. Lines starting with # are ignored.
+The memo of a row defaults to the value of the {memo} flag and all packets are sent over the port set with the {src-port} flag.
+Every row is validated before the transaction is generated, and errors are reported with the number of the offending line.
+The timeouts of all packets are set with the same flags as the transfer command. The gas limit of the transaction can be set to
+the number of rows multiplied by the {gas-per-transfer} flag, as an alternative to the {gas} flag.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer multi-send airdrop.csv --%s 150000", version.AppName, flagGasPerTransfer),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			srcPort, err := cmd.Flags().GetString(flagSrcPort)
 			if err != nil {
 				return err
 			}
 
-			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			timeoutHeight, timeoutTimestamp, err := parseTimeouts(cmd)
 			if err != nil {
 				return err
 			}
@@ -86,38 +122,158 @@ Relative timeout timestamp is added to the value of the user's local system cloc
 				return err
 			}
 
-			// NOTE: relative timeouts using block height are not supported.
-			// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
-			if !absoluteTimeouts {
-				if !timeoutHeight.IsZero() {
-					return errors.New("relative timeouts using block height is not supported")
-				}
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
 
-				if timeoutTimestamp == 0 {
-					return errors.New("relative timeouts must provide a non zero value timestamp")
-				}
+			msgs, err := parseMultiSendCSV(file, srcPort, clientCtx.GetFromAddress().String(), timeoutHeight, timeoutTimestamp, memo)
+			if err != nil {
+				return err
+			}
 
-				// use local clock time as reference time for calculating timeout timestamp.
-				now := time.Now().UnixNano()
-				if now <= 0 {
-					return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+			gasPerTransfer, err := cmd.Flags().GetUint64(flagGasPerTransfer)
+			if err != nil {
+				return err
+			}
+
+			if gasPerTransfer != 0 {
+				if cmd.Flags().Changed(flags.FlagGas) {
+					return fmt.Errorf("the %s and %s flags cannot be used together", flagGasPerTransfer, flags.FlagGas)
 				}
 
-				timeoutTimestamp = uint64(now) + timeoutTimestamp
+				gas := gasPerTransfer * uint64(len(msgs))
+				if err := cmd.Flags().Set(flags.FlagGas, strconv.FormatUint(gas, 10)); err != nil {
+					return err
+				}
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
-			)
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
 		},
 	}
 
+	cmd.Flags().String(flagSrcPort, types.PortID, "Source port of the transfers.")
 	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
-	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packets of rows without a memo.")
+	cmd.Flags().Uint64(flagGasPerTransfer, 0, "Gas limit of every transfer, multiplied by the number of rows to set the gas limit of the transaction.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseMultiSendCSV parses every row of the provided CSV into a MsgTransfer sent by the sender over the source port,
+// with the provided timeouts. The memo of rows without a memo column defaults to the provided memo. An error
+// is returned with the line number of the first row which fails validation, or if the CSV has no rows.
+func parseMultiSendCSV(r io.Reader, srcPort, sender string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, defaultMemo string) ([]sdk.Msg, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var msgs []sdk.Msg
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) != 3 && len(record) != 4 {
+			return nil, fmt.Errorf("line %d: expected 3 or 4 columns {src-channel},{receiver},{amount}[,{memo}], got %d", line, len(record))
+		}
+
+		coin, err := parseTransferCoin(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if !coin.IsPositive() {
+			return nil, fmt.Errorf("line %d: amount must be positive, got %s", line, coin)
+		}
+
+		memo := defaultMemo
+		if len(record) == 4 {
+			memo = record[3]
+		}
+
+		msg := types.NewMsgTransfer(srcPort, record[0], coin, sender, record[1], timeoutHeight, timeoutTimestamp, memo)
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil, errors.New("no transfers found in CSV file")
+	}
+
+	return msgs, nil
+}
+
+// parseTransferCoin parses the provided amount into a coin, converting the denomination of vouchers
+// provided as full denomination paths into their IBC denomination.
+func parseTransferCoin(amount string) (sdk.Coin, error) {
+	coin, err := sdk.ParseCoinNormalized(amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !strings.HasPrefix(coin.Denom, "ibc/") {
+		denomTrace := types.ParseDenomTrace(coin.Denom)
+		coin.Denom = denomTrace.IBCDenom()
+	}
+
+	return coin, nil
+}
+
+// parseTimeouts returns the packet timeout height and timestamp set with the timeout flags of the command.
+func parseTimeouts(cmd *cobra.Command) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	// NOTE: relative timeouts using block height are not supported.
+	// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
+	if !absoluteTimeouts {
+		if !timeoutHeight.IsZero() {
+			return clienttypes.Height{}, 0, errors.New("relative timeouts using block height is not supported")
+		}
+
+		if timeoutTimestamp == 0 {
+			return clienttypes.Height{}, 0, errors.New("relative timeouts must provide a non zero value timestamp")
+		}
+
+		// use local clock time as reference time for calculating timeout timestamp.
+		now := time.Now().UnixNano()
+		if now <= 0 {
+			return clienttypes.Height{}, 0, errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+		}
+
+		timeoutTimestamp = uint64(now) + timeoutTimestamp
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
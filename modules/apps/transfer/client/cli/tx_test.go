@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+const receiver = "osmo1ujnj3crphp4hq7lsgh0dydvx4ql7vhkpkmlcxw"
+
+var sender = sdk.AccAddress("sender______________").String()
+
+func TestParseMultiSendCSV(t *testing.T) {
+	timeoutHeight := clienttypes.NewHeight(1, 100)
+
+	testCases := []struct {
+		name     string
+		csv      string
+		expMsgs  []sdk.Msg
+		expError string
+	}{
+		{
+			"success: rows with and without memo",
+			"# airdrop\nchannel-0," + receiver + ",100stake\nchannel-1, " + receiver + ", 5atom,row memo\n",
+			[]sdk.Msg{
+				types.NewMsgTransfer(types.PortID, "channel-0", sdk.NewCoin("stake", sdkmath.NewInt(100)), sender, receiver, timeoutHeight, 0, "default memo"),
+				types.NewMsgTransfer(types.PortID, "channel-1", sdk.NewCoin("atom", sdkmath.NewInt(5)), sender, receiver, timeoutHeight, 0, "row memo"),
+			},
+			"",
+		},
+		{
+			"success: voucher denomination path is converted to IBC denomination",
+			"channel-0," + receiver + ",100transfer/channel-0/stake\n",
+			[]sdk.Msg{
+				types.NewMsgTransfer(types.PortID, "channel-0", sdk.NewCoin(types.ParseDenomTrace("transfer/channel-0/stake").IBCDenom(), sdkmath.NewInt(100)), sender, receiver, timeoutHeight, 0, "default memo"),
+			},
+			"",
+		},
+		{
+			"failure: empty file",
+			"# no transfers\n",
+			nil,
+			"no transfers found",
+		},
+		{
+			"failure: invalid number of columns",
+			"channel-0," + receiver + ",100stake\nchannel-0," + receiver + "\n",
+			nil,
+			"line 2: expected 3 or 4 columns",
+		},
+		{
+			"failure: invalid amount",
+			"channel-0," + receiver + ",stake\n",
+			nil,
+			"line 1:",
+		},
+		{
+			"failure: zero amount",
+			"channel-0," + receiver + ",0stake\n",
+			nil,
+			"line 1: amount must be positive",
+		},
+		{
+			"failure: invalid channel",
+			"channel-0," + receiver + ",100stake\n\nch," + receiver + ",100stake\n",
+			nil,
+			"line 3: invalid source channel ID",
+		},
+		{
+			"failure: missing receiver",
+			"channel-0, ,100stake\n",
+			nil,
+			"line 1: missing recipient address",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := parseMultiSendCSV(strings.NewReader(tc.csv), types.PortID, sender, timeoutHeight, 0, "default memo")
+			if tc.expError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expMsgs, msgs)
+			} else {
+				require.ErrorContains(t, err, tc.expError)
+				require.Nil(t, msgs)
+			}
+		})
+	}
+}
@@ -89,8 +89,9 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, version)
+	// NOTE: transfer versions carry no metadata, decimal conversions of the channel are configured by the module authority
+	if !types.IsSupportedVersion(version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, version)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -121,13 +122,13 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
+	if !types.IsSupportedVersion(counterpartyVersion) {
 		// Propose the current version
 		im.keeper.Logger(ctx).Debug("invalid counterparty version, proposing current app version", "counterpartyVersion", counterpartyVersion, "version", types.Version)
 		return types.Version, nil
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -138,8 +139,8 @@ func (IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 	return nil
 }
@@ -163,12 +164,14 @@ func (IBCModule) OnChanCloseInit(
 	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "user cannot close channel")
 }
 
-// OnChanCloseConfirm implements the IBCModule interface
-func (IBCModule) OnChanCloseConfirm(
+// OnChanCloseConfirm implements the IBCModule interface. The transfer volumes
+// tracked for the channel are pruned.
+func (im IBCModule) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
+	im.keeper.PruneTransferVolumes(ctx, channelID)
 	return nil
 }
 
@@ -185,9 +188,9 @@ func (im IBCModule) OnRecvPacket(
 	var data types.FungibleTokenPacketData
 	var ackErr error
 	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
-		ackErr = errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+		ackErr = types.NewPacketDataUnmarshalError(err)
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = types.NewErrorAcknowledgement(ackErr)
 	}
 
 	// only attempt the application logic if the packet data
@@ -195,7 +198,7 @@ func (im IBCModule) OnRecvPacket(
 	if ack.Success() {
 		err := im.keeper.OnRecvPacket(ctx, packet, data)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ack = types.NewErrorAcknowledgement(err)
 			ackErr = err
 			im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		} else {
@@ -316,8 +319,8 @@ func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if proposedVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, proposedVersion)
+	if !types.IsSupportedVersion(proposedVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, proposedVersion)
 	}
 
 	return proposedVersion, nil
@@ -329,8 +332,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return counterpartyVersion, nil
@@ -338,8 +341,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 
 // OnChanUpgradeAck implements the IBCModule interface
 func (IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return nil
@@ -121,6 +121,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		path                *ibctesting.Path
 		counterparty        channeltypes.Counterparty
 		counterpartyVersion string
+		expVersion          string
 	)
 
 	testCases := []struct {
@@ -131,6 +132,12 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		{
 			"success", func() {}, nil,
 		},
+		{
+			"success: token metadata version", func() {
+				counterpartyVersion = types.VersionWithTokenMetadata
+				expVersion = types.VersionWithTokenMetadata
+			}, nil,
+		},
 		{
 			"success: invalid counterparty version proposes new version", func() {
 				// transfer module will propose the default version
@@ -179,6 +186,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				Version:        types.Version,
 			}
 			counterpartyVersion = types.Version
+			expVersion = types.Version
 
 			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.TransferPort)
 			suite.Require().NoError(err)
@@ -197,7 +205,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 			expPass := tc.expError == nil
 			if expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Contains(err.Error(), tc.expError.Error())
@@ -217,6 +225,11 @@ func (suite *TransferTestSuite) TestOnChanOpenAck() {
 		{
 			"success", func() {}, nil,
 		},
+		{
+			"success: token metadata version", func() {
+				counterpartyVersion = types.VersionWithTokenMetadata
+			}, nil,
+		},
 		{
 			"invalid counterparty version", func() {
 				counterpartyVersion = "version"
@@ -543,3 +556,66 @@ func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 		})
 	}
 }
+
+func (suite *TransferTestSuite) TestOnRecvPacketFieldErrorAcknowledgement() {
+	var data []byte
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expField string
+	}{
+		{
+			"malformed packet data",
+			func() {
+				data = []byte("invalid packet data")
+			},
+			types.FieldData,
+		},
+		{
+			"amount has unexpected JSON type",
+			func() {
+				data = []byte(`{"denom":"stake","amount":100}`)
+			},
+			types.FieldAmount,
+		},
+		{
+			"receiver is not a valid bech32 address",
+			func() {
+				data = types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainB.SenderAccount.GetAddress().String(), "invalid-receiver", "").GetBytes()
+			},
+			types.FieldReceiver,
+		},
+		{
+			"malformed denomination trace",
+			func() {
+				data = types.NewFungibleTokenPacketData("transfer/channel-0/", "100", suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "").GetBytes()
+			},
+			types.FieldDenom,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(data, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+
+			transferModule := transfer.NewIBCModule(suite.chainA.GetSimApp().TransferKeeper)
+			ack := transferModule.OnRecvPacket(suite.chainA.GetContext(), packet, suite.chainA.SenderAccount.GetAddress())
+
+			suite.Require().False(ack.Success())
+
+			channelAck, ok := ack.(channeltypes.Acknowledgement)
+			suite.Require().True(ok)
+			suite.Require().Contains(channelAck.GetError(), "invalid packet data field "+tc.expField)
+		})
+	}
+}
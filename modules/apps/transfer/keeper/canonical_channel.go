@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// GetCanonicalChannel returns the channel registered as canonical for transfers to the counterparty chain
+// with the provided chain identifier. A boolean is returned indicating whether a canonical channel is registered.
+func (k Keeper) GetCanonicalChannel(ctx sdk.Context, chainID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CanonicalChannelKey(chainID))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetCanonicalChannel registers the canonical channel for transfers to a counterparty chain. A canonical
+// channel with an empty channel identifier removes the registered canonical channel of the counterparty chain.
+func (k Keeper) SetCanonicalChannel(ctx sdk.Context, canonicalChannel types.CanonicalChannel) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.CanonicalChannelKey(canonicalChannel.ChainId)
+	if canonicalChannel.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, []byte(canonicalChannel.ChannelId))
+}
+
+// GetAllCanonicalChannels returns the canonical channels registered for all counterparty chains.
+func (k Keeper) GetAllCanonicalChannels(ctx sdk.Context) []types.CanonicalChannel {
+	store := ctx.KVStore(k.storeKey)
+	prefix := []byte(types.KeyCanonicalChannelPrefix + "/")
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var canonicalChannels []types.CanonicalChannel
+	for ; iterator.Valid(); iterator.Next() {
+		chainID := string(iterator.Key()[len(prefix):])
+		canonicalChannels = append(canonicalChannels, types.NewCanonicalChannel(chainID, string(iterator.Value())))
+	}
+
+	return canonicalChannels
+}
+
+// assertCanonicalChannel returns an error if strict canonical channels are enabled in the parameters and another
+// channel than the provided one is registered as canonical for the counterparty chain of the channel. Transfers
+// on channels whose client does not expose the chain identifier of the counterparty chain are always allowed.
+func (k Keeper) assertCanonicalChannel(ctx sdk.Context, params types.Params, portID, channelID string) error {
+	if !params.StrictCanonicalChannels {
+		return nil
+	}
+
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	// only light clients tracking chains with a chain identifier expose it on their client state
+	chainIDClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return nil
+	}
+
+	chainID := chainIDClientState.GetChainID()
+	canonicalChannelID, found := k.GetCanonicalChannel(ctx, chainID)
+	if !found || canonicalChannelID == channelID {
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrNonCanonicalChannel, "channel %s must be used for transfers to %s, got %s", canonicalChannelID, chainID, channelID)
+}
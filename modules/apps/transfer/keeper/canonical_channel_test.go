@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestTransferStrictCanonicalChannels() {
+	var (
+		path   *ibctesting.Path
+		strict bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no canonical channel registered",
+			func() {},
+			nil,
+		},
+		{
+			"success: channel registered as canonical",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalChannel(suite.chainA.GetContext(), types.NewCanonicalChannel(suite.chainB.ChainID, path.EndpointA.ChannelID))
+			},
+			nil,
+		},
+		{
+			"success: canonical channel registered for another chain",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalChannel(suite.chainA.GetContext(), types.NewCanonicalChannel(suite.chainC.ChainID, "channel-100"))
+			},
+			nil,
+		},
+		{
+			"success: another channel registered as canonical without strict mode",
+			func() {
+				strict = false
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalChannel(suite.chainA.GetContext(), types.NewCanonicalChannel(suite.chainB.ChainID, "channel-100"))
+			},
+			nil,
+		},
+		{
+			"failure: another channel registered as canonical",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetCanonicalChannel(suite.chainA.GetContext(), types.NewCanonicalChannel(suite.chainB.ChainID, "channel-100"))
+			},
+			types.ErrNonCanonicalChannel,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			strict = true
+
+			tc.malleate()
+
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+			params.StrictCanonicalChannels = strict
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateCanonicalChannel() {
+	suite.SetupTest() // reset
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	ctx := suite.chainA.GetContext()
+
+	canonicalChannel := types.NewCanonicalChannel(suite.chainB.ChainID, ibctesting.FirstChannelID)
+
+	_, err := transferKeeper.UpdateCanonicalChannel(ctx, types.NewMsgUpdateCanonicalChannel(ibctesting.TestAccAddress, canonicalChannel))
+	suite.Require().ErrorIs(err, ibcerrors.ErrUnauthorized)
+
+	_, err = transferKeeper.UpdateCanonicalChannel(ctx, types.NewMsgUpdateCanonicalChannel(transferKeeper.GetAuthority(), canonicalChannel))
+	suite.Require().NoError(err)
+
+	res, err := transferKeeper.CanonicalChannel(ctx, &types.QueryCanonicalChannelRequest{ChainId: suite.chainB.ChainID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibctesting.FirstChannelID, res.ChannelId)
+
+	allRes, err := transferKeeper.CanonicalChannels(ctx, &types.QueryCanonicalChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.CanonicalChannel{canonicalChannel}, allRes.CanonicalChannels)
+
+	// a canonical channel with an empty channel identifier removes the registered canonical channel
+	canonicalChannel.ChannelId = ""
+	_, err = transferKeeper.UpdateCanonicalChannel(ctx, types.NewMsgUpdateCanonicalChannel(transferKeeper.GetAuthority(), canonicalChannel))
+	suite.Require().NoError(err)
+
+	_, err = transferKeeper.CanonicalChannel(ctx, &types.QueryCanonicalChannelRequest{ChainId: suite.chainB.ChainID})
+	suite.Require().ErrorContains(err, types.ErrCanonicalChannelNotFound.Error())
+	suite.Require().Empty(transferKeeper.GetAllCanonicalChannels(ctx))
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// GetDecimalConversion returns the decimal conversion of the provided denomination over the
+// provided channel. A boolean is returned indicating whether a conversion is set.
+func (k Keeper) GetDecimalConversion(ctx sdk.Context, channelID, denom string) (types.DecimalConversion, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DecimalConversionKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.DecimalConversion{}, false
+	}
+
+	var conversion types.DecimalConversion
+	k.cdc.MustUnmarshal(bz, &conversion)
+	return conversion, true
+}
+
+// SetDecimalConversion stores the decimal conversion of a denomination over a channel. A
+// conversion with equal local and counterparty decimals removes the stored conversion.
+// Conversions are only set by the module authority and genesis, as they are not negotiated
+// through the channel version.
+func (k Keeper) SetDecimalConversion(ctx sdk.Context, conversion types.DecimalConversion) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DecimalConversionKey(conversion.ChannelId, conversion.Denom)
+	if conversion.IsIdentity() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&conversion)
+	store.Set(key, bz)
+}
+
+// GetAllDecimalConversions returns the decimal conversions of all channels and denominations.
+func (k Keeper) GetAllDecimalConversions(ctx sdk.Context) []types.DecimalConversion {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyDecimalConversionPrefix+"/"))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var conversions []types.DecimalConversion
+	for ; iterator.Valid(); iterator.Next() {
+		var conversion types.DecimalConversion
+		k.cdc.MustUnmarshal(iterator.Value(), &conversion)
+		conversions = append(conversions, conversion)
+	}
+
+	return conversions
+}
+
+// GetPacketLocalAmount returns the amount of tokens, in the precision of this chain, escrowed or burned
+// when sending the packet with the provided port, channel and sequence. A boolean is returned indicating
+// whether a local amount is stored, which is only the case for packets whose amount was converted.
+func (k Keeper) GetPacketLocalAmount(ctx sdk.Context, portID, channelID string, sequence uint64) (sdkmath.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketLocalAmountKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return sdkmath.Int{}, false
+	}
+
+	var packetLocalAmount types.PacketLocalAmount
+	k.cdc.MustUnmarshal(bz, &packetLocalAmount)
+
+	amount, err := packetLocalAmount.ParseAmount()
+	if err != nil {
+		panic(err)
+	}
+
+	return amount, true
+}
+
+// setPacketLocalAmount stores the local amount of a packet until the packet is acknowledged or timed out.
+func (k Keeper) setPacketLocalAmount(ctx sdk.Context, packetLocalAmount types.PacketLocalAmount) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packetLocalAmount)
+	store.Set(types.PacketLocalAmountKey(packetLocalAmount.PortId, packetLocalAmount.ChannelId, packetLocalAmount.Sequence), bz)
+}
+
+// deletePacketLocalAmount removes the local amount of a packet once it has been acknowledged or timed out.
+func (k Keeper) deletePacketLocalAmount(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketLocalAmountKey(portID, channelID, sequence))
+}
+
+// GetAllPacketLocalAmounts returns the local amounts of all in-flight packets whose amount was converted.
+func (k Keeper) GetAllPacketLocalAmounts(ctx sdk.Context) []types.PacketLocalAmount {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyPacketLocalAmountPrefix+"/"))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var packetLocalAmounts []types.PacketLocalAmount
+	for ; iterator.Valid(); iterator.Next() {
+		var packetLocalAmount types.PacketLocalAmount
+		k.cdc.MustUnmarshal(iterator.Value(), &packetLocalAmount)
+		packetLocalAmounts = append(packetLocalAmounts, packetLocalAmount)
+	}
+
+	return packetLocalAmounts
+}
+
+// toCounterpartyAmount converts the amount of tokens of the provided denomination sent over the
+// provided channel to the precision of the counterparty chain. It returns the amount to be set in
+// the packet data and the amount to be escrowed or burned on this chain. Amounts are returned
+// unchanged if no decimal conversion is set.
+func (k Keeper) toCounterpartyAmount(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) (sdkmath.Int, sdkmath.Int, error) {
+	conversion, found := k.GetDecimalConversion(ctx, channelID, denom)
+	if !found {
+		return amount, amount, nil
+	}
+
+	return conversion.ToCounterpartyAmount(amount)
+}
+
+// toLocalAmount converts the packet amount of tokens of the provided denomination transferred over
+// the provided channel to the precision of this chain. The amount is returned unchanged if no
+// decimal conversion is set.
+func (k Keeper) toLocalAmount(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) (sdkmath.Int, error) {
+	conversion, found := k.GetDecimalConversion(ctx, channelID, denom)
+	if !found {
+		return amount, nil
+	}
+
+	return conversion.ToLocalAmount(amount)
+}
@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestDecimalConversionSendAndReceive() {
+	testCases := []struct {
+		name         string
+		dustHandling types.DustHandling
+		expPass      bool
+	}{
+		{"success: dust truncated on send", types.TRUNCATE, true},
+		{"failure: dust rejected on send", types.REJECT, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// chainA represents the native token with 18 decimals and chainB with 6 decimals
+			conversion := types.NewDecimalConversion(path.EndpointA.ChannelID, sdk.DefaultBondDenom, 18, 6, tc.dustHandling)
+			suite.chainA.GetSimApp().TransferKeeper.SetDecimalConversion(suite.chainA.GetContext(), conversion)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5_000_000_000_003))
+			transferMsg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+				sender.String(), receiver.String(), suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			result, err := suite.chainA.SendMsgs(transferMsg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, types.ErrDecimalConversionDust.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			// only the convertible amount is escrowed, the dust stays with the sender
+			balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(sdkmath.NewInt(5_000_000_000_000), balanceBefore.Amount.Sub(balanceAfter.Amount))
+
+			packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			var data types.FungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+			suite.Require().Equal("5", data.Amount)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			voucher := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom)
+			suite.Require().Equal(sdkmath.NewInt(5), voucher.Amount)
+
+			// send the vouchers back and expect the amount to be scaled to local precision
+			transferMsg = types.NewMsgTransfer(
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(voucherDenom, sdkmath.NewInt(2)),
+				receiver.String(), sender.String(), suite.chainA.GetTimeoutHeight(), 0, "",
+			)
+			result, err = suite.chainB.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			packet, err = ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			balanceAfter = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(sdkmath.NewInt(3_000_000_000_000), balanceBefore.Amount.Sub(balanceAfter.Amount))
+
+			escrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+			suite.Require().Equal(sdkmath.NewInt(3_000_000_000_000), escrow.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDecimalConversionReceiveDust() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// chainA represents the native token with 6 decimals and chainB with 18 decimals
+	conversion := types.NewDecimalConversion(path.EndpointA.ChannelID, sdk.DefaultBondDenom, 6, 18, types.REJECT)
+	suite.chainA.GetSimApp().TransferKeeper.SetDecimalConversion(suite.chainA.GetContext(), conversion)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	transferMsg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(2)),
+		sender.String(), receiver.String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	voucher := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom)
+	suite.Require().Equal(sdkmath.NewInt(2_000_000_000_000), voucher.Amount)
+
+	// an amount with dust is rejected by chainA and refunded on chainB
+	transferMsg = types.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(voucherDenom, sdkmath.NewInt(1_000_000_000_001)),
+		receiver.String(), sender.String(), suite.chainA.GetTimeoutHeight(), 0, "",
+	)
+	result, err = suite.chainB.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	voucher = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom)
+	suite.Require().Equal(sdkmath.NewInt(2_000_000_000_000), voucher.Amount)
+
+	escrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+	suite.Require().Equal(sdkmath.NewInt(2), escrow.Amount)
+}
+
+func (suite *KeeperTestSuite) TestDecimalConversionRefund() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	conversion := types.NewDecimalConversion(path.EndpointA.ChannelID, sdk.DefaultBondDenom, 18, 6, types.TRUNCATE)
+	transferKeeper.SetDecimalConversion(suite.chainA.GetContext(), conversion)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	transferMsg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(7_000_000_000_001)),
+		sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	var data types.FungibleTokenPacketData
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+	// the truncated escrowed amount is stored in local precision while the packet is in flight
+	localAmount, found := transferKeeper.GetPacketLocalAmount(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(7_000_000_000_000), localAmount)
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled)
+	err = transferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+	suite.Require().NoError(err)
+
+	// the escrowed amount is refunded in local precision
+	balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balanceAfter)
+	suite.Require().True(transferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom).Amount.IsZero())
+
+	_, found = transferKeeper.GetPacketLocalAmount(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestDecimalConversionChangedInFlight() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	conversion := types.NewDecimalConversion(path.EndpointA.ChannelID, sdk.DefaultBondDenom, 18, 6, types.TRUNCATE)
+	transferKeeper.SetDecimalConversion(suite.chainA.GetContext(), conversion)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	transferMsg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(7_000_000_000_001)),
+		sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	var data types.FungibleTokenPacketData
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+	// the conversion is changed while the packet is in flight
+	conversion.CounterpartyDecimals = 12
+	transferKeeper.SetDecimalConversion(suite.chainA.GetContext(), conversion)
+
+	err = transferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+	suite.Require().NoError(err)
+
+	// the amount escrowed when sending is refunded
+	balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balanceAfter)
+	suite.Require().True(transferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom).Amount.IsZero())
+	suite.Require().Empty(transferKeeper.GetAllPacketLocalAmounts(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestUpdateDecimalConversion() {
+	suite.SetupTest() // reset
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	ctx := suite.chainA.GetContext()
+
+	conversion := types.NewDecimalConversion(ibctesting.FirstChannelID, sdk.DefaultBondDenom, 18, 6, types.REJECT)
+
+	_, err := transferKeeper.UpdateDecimalConversion(ctx, types.NewMsgUpdateDecimalConversion(ibctesting.TestAccAddress, conversion))
+	suite.Require().ErrorIs(err, ibcerrors.ErrUnauthorized)
+
+	_, err = transferKeeper.UpdateDecimalConversion(ctx, types.NewMsgUpdateDecimalConversion(transferKeeper.GetAuthority(), conversion))
+	suite.Require().NoError(err)
+
+	res, err := transferKeeper.DecimalConversions(ctx, &types.QueryDecimalConversionsRequest{ChannelId: ibctesting.FirstChannelID})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DecimalConversion{conversion}, res.DecimalConversions)
+
+	// a conversion with equal decimals removes the stored conversion
+	conversion.CounterpartyDecimals = conversion.LocalDecimals
+	_, err = transferKeeper.UpdateDecimalConversion(ctx, types.NewMsgUpdateDecimalConversion(transferKeeper.GetAuthority(), conversion))
+	suite.Require().NoError(err)
+
+	_, found := transferKeeper.GetDecimalConversion(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+	suite.Require().Empty(transferKeeper.GetAllDecimalConversions(ctx))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// emitEscrowReleasedEvent emits an event signalling that the escrowed tokens of a failed or timed out
+// packet have been released from the escrow account back to the sender.
+func emitEscrowReleasedEvent(ctx sdk.Context, packet channeltypes.Packet, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowRelease,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyEscrowAddress, escrowAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+		),
+	)
+}
+
+// emitVoucherBurnedEvent emits an event signalling that the vouchers burned when sending a packet have
+// been successfully acknowledged by the counterparty and are therefore permanently removed from supply.
+func emitVoucherBurnedEvent(ctx sdk.Context, packet channeltypes.Packet, sender string, token sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoucherBurn,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+		),
+	)
+}
+
+// emitDenomRenamedEvent emits an event signalling that the vouchers of a denomination trace have been
+// migrated to a denomination trace with a renamed base denomination.
+func emitDenomRenamedEvent(ctx sdk.Context, oldTrace, newTrace types.DenomTrace) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomRename,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOldDenom, oldTrace.IBCDenom()),
+			sdk.NewAttribute(types.AttributeKeyNewDenom, newTrace.IBCDenom()),
+			sdk.NewAttribute(types.AttributeKeyTraceHash, newTrace.Hash().String()),
+		),
+	)
+}
+
+// emitVoucherConversionSkippedEvent emits an event signalling that the vouchers of a holder could not be converted
+// to the IBC denomination of a renamed base denomination.
+func emitVoucherConversionSkippedEvent(ctx sdk.Context, holder sdk.AccAddress, balance sdk.Coin, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConversionSkip,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, balance.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, balance.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
@@ -14,7 +14,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	for _, trace := range state.DenomTraces {
 		k.SetDenomTrace(ctx, trace)
-		k.setDenomMetadata(ctx, trace)
+		k.setDenomMetadata(ctx, trace, nil)
 	}
 
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -35,14 +35,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, transferVolume := range state.TransferVolumes {
+		k.SetTransferVolume(ctx, transferVolume)
+	}
+
+	for _, conversion := range state.DecimalConversions {
+		k.SetDecimalConversion(ctx, conversion)
+	}
+
+	for _, packetLocalAmount := range state.PacketLocalAmounts {
+		k.setPacketLocalAmount(ctx, packetLocalAmount)
+	}
+
+	for _, canonicalChannel := range state.CanonicalChannels {
+		k.SetCanonicalChannel(ctx, canonicalChannel)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		DenomTraces:   k.GetAllDenomTraces(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:             k.GetPort(ctx),
+		DenomTraces:        k.GetAllDenomTraces(ctx),
+		Params:             k.GetParams(ctx),
+		TotalEscrowed:      k.GetAllTotalEscrowed(ctx),
+		TransferVolumes:    k.GetAllTransferVolumes(ctx),
+		DecimalConversions: k.GetAllDecimalConversions(ctx),
+		PacketLocalAmounts: k.GetAllPacketLocalAmounts(ctx),
+		CanonicalChannels:  k.GetAllCanonicalChannels(ctx),
 	}
 }
@@ -45,11 +45,17 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denom, amount))
 	}
 
+	packetLocalAmount := types.NewPacketLocalAmount(types.PortID, "channel-0", 1, sdkmath.NewInt(1_000_000_000_001))
+	genesisState := types.DefaultGenesisState()
+	genesisState.PacketLocalAmounts = []types.PacketLocalAmount{packetLocalAmount}
+	suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesisState)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denomTraces.Sort(), genesis.DenomTraces)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().Equal([]types.PacketLocalAmount{packetLocalAmount}, genesis.PacketLocalAmounts)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
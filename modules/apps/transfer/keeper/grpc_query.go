@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/internal/validate"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -156,3 +157,235 @@ func (k Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscr
 		Amount: amount,
 	}, nil
 }
+
+// TransferVolume implements the Query/TransferVolume gRPC method.
+func (k Keeper) TransferVolume(c context.Context, req *types.QueryTransferVolumeRequest) (*types.QueryTransferVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	transferVolume := k.GetTransferVolume(ctx, req.ChannelId, req.Denom)
+
+	return &types.QueryTransferVolumeResponse{
+		TransferVolume: transferVolume,
+	}, nil
+}
+
+// TransferVolumes implements the Query/TransferVolumes gRPC method.
+func (k Keeper) TransferVolumes(c context.Context, req *types.QueryTransferVolumesRequest) (*types.QueryTransferVolumesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var transferVolumes []types.TransferVolume
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferVolumesKey(req.ChannelId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var transferVolume types.TransferVolume
+		if err := k.cdc.Unmarshal(value, &transferVolume); err != nil {
+			return err
+		}
+
+		transferVolumes = append(transferVolumes, transferVolume)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferVolumesResponse{
+		TransferVolumes: transferVolumes,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// ChannelsByDenom implements the Query/ChannelsByDenom gRPC method.
+func (k Keeper) ChannelsByDenom(c context.Context, req *types.QueryChannelsByDenomRequest) (*types.QueryChannelsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// the denomination may be provided as the full denomination trace path
+	denom := types.ParseDenomTrace(req.Denom).IBCDenom()
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var transferVolumes []types.TransferVolume
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomChannelsKey(denom))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		transferVolumes = append(transferVolumes, k.GetTransferVolume(ctx, string(key), denom))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelsByDenomResponse{
+		TransferVolumes: transferVolumes,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// DecimalConversions implements the Query/DecimalConversions gRPC method.
+func (k Keeper) DecimalConversions(c context.Context, req *types.QueryDecimalConversionsRequest) (*types.QueryDecimalConversionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var conversions []types.DecimalConversion
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DecimalConversionsKey(req.ChannelId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var conversion types.DecimalConversion
+		if err := k.cdc.Unmarshal(value, &conversion); err != nil {
+			return err
+		}
+
+		conversions = append(conversions, conversion)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDecimalConversionsResponse{
+		DecimalConversions: conversions,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// CanonicalChannel implements the Query/CanonicalChannel gRPC method.
+func (k Keeper) CanonicalChannel(c context.Context, req *types.QueryCanonicalChannelRequest) (*types.QueryCanonicalChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ChainId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain identifier cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channelID, found := k.GetCanonicalChannel(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrCanonicalChannelNotFound, req.ChainId).Error(),
+		)
+	}
+
+	return &types.QueryCanonicalChannelResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// CanonicalChannels implements the Query/CanonicalChannels gRPC method.
+func (k Keeper) CanonicalChannels(c context.Context, req *types.QueryCanonicalChannelsRequest) (*types.QueryCanonicalChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var canonicalChannels []types.CanonicalChannel
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyCanonicalChannelPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		canonicalChannels = append(canonicalChannels, types.NewCanonicalChannel(string(key), string(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCanonicalChannelsResponse{
+		CanonicalChannels: canonicalChannels,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// UnwindRoute implements the Query/UnwindRoute gRPC method.
+func (k Keeper) UnwindRoute(c context.Context, req *types.QueryUnwindRouteRequest) (*types.QueryUnwindRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the denomination may be provided as the voucher denomination or as the full denomination trace path
+	var denomTrace types.DenomTrace
+	if strings.HasPrefix(req.Denom, types.DenomPrefix+"/") {
+		hash, err := types.ParseHexHash(strings.TrimPrefix(req.Denom, types.DenomPrefix+"/"))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash: %s, error: %s", req.Denom, err))
+		}
+
+		var found bool
+		denomTrace, found = k.GetDenomTrace(ctx, hash)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrap(types.ErrTraceNotFound, req.Denom).Error(),
+			)
+		}
+	} else {
+		denomTrace = types.ParseDenomTrace(req.Denom)
+		if err := denomTrace.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	hopTimeout := req.HopTimeout
+	if hopTimeout == 0 {
+		hopTimeout = types.DefaultUnwindHopTimeout
+	}
+
+	hops := denomTrace.UnwindHops(uint64(ctx.BlockTime().UnixNano()), hopTimeout)
+
+	// only the channel of the first hop is stored on this chain, the vouchers cannot be unwound if it is not open
+	if len(hops) > 0 {
+		channel, found := k.channelKeeper.GetChannel(ctx, hops[0].PortId, hops[0].ChannelId)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", hops[0].PortId, hops[0].ChannelId).Error(),
+			)
+		}
+
+		if channel.State != channeltypes.OPEN {
+			return nil, status.Error(
+				codes.FailedPrecondition,
+				errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "expected %s, got %s", channeltypes.OPEN, channel.State).Error(),
+			)
+		}
+	}
+
+	return &types.QueryUnwindRouteResponse{
+		Hops:      hops,
+		BaseDenom: denomTrace.BaseDenom,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -396,3 +398,112 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryUnwindRoute() {
+	var (
+		req     *types.QueryUnwindRouteRequest
+		path    *ibctesting.Path
+		trace   types.DenomTrace
+		expHops []types.UnwindHop
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: full denom trace path",
+			func() {},
+			nil,
+		},
+		{
+			"success: ibc denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+
+				req.Denom = trace.IBCDenom()
+			},
+			nil,
+		},
+		{
+			"success: custom hop timeout",
+			func() {
+				req.HopTimeout = 1
+				expHops = trace.UnwindHops(uint64(suite.chainA.GetContext().BlockTime().UnixNano()), 1)
+			},
+			nil,
+		},
+		{
+			"success: native denom",
+			func() {
+				trace = types.DenomTrace{BaseDenom: sdk.DefaultBondDenom}
+				req.Denom = sdk.DefaultBondDenom
+				expHops = nil
+			},
+			nil,
+		},
+		{
+			"failure: invalid denom trace",
+			func() {
+				req.Denom = "transfer/channel-1/"
+			},
+			errors.New("base denomination cannot be blank"),
+		},
+		{
+			"failure: denom trace not found",
+			func() {
+				req.Denom = trace.IBCDenom()
+			},
+			types.ErrTraceNotFound,
+		},
+		{
+			"failure: channel of the first hop not found",
+			func() {
+				trace.Path = "transfer/channel-100/transfer/channel-7"
+				req.Denom = trace.GetFullDenomPath()
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: channel of the first hop is not open",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			trace = types.DenomTrace{
+				Path:      fmt.Sprintf("%s/%s/transfer/channel-7", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+				BaseDenom: "uatom",
+			}
+			expHops = trace.UnwindHops(uint64(suite.chainA.GetContext().BlockTime().UnixNano()), types.DefaultUnwindHopTimeout)
+
+			req = &types.QueryUnwindRouteRequest{
+				Denom: trace.GetFullDenomPath(),
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.UnwindRoute(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expHops, res.Hops)
+				suite.Require().Equal(trace.BaseDenom, res.BaseDenom)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
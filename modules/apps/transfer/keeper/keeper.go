@@ -36,6 +36,11 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	scopedKeeper  exported.ScopedKeeper
 
+	// optional hook used to map incoming receiver strings to local addresses
+	receiverAddressTransformer types.ReceiverAddressTransformer
+	// optional hook used to reject or redirect outgoing transfers
+	sendRestriction types.SendRestriction
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -84,11 +89,40 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithReceiverAddressTransformer sets the ReceiverAddressTransformer used to map the receiver
+// of incoming packets to a local account address. If no transformer is set, the receiver must
+// be a bech32 encoded account address.
+func (k *Keeper) WithReceiverAddressTransformer(transformer types.ReceiverAddressTransformer) {
+	k.receiverAddressTransformer = transformer
+}
+
+// WithSendRestriction sets the SendRestriction which is invoked for every outgoing transfer
+// before the tokens are escrowed or burned. If no restriction is set, all transfers which pass
+// the module's own checks are sent to the requested receiver.
+func (k *Keeper) WithSendRestriction(restriction types.SendRestriction) {
+	k.sendRestriction = restriction
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// GetChannelKeeper returns the channel keeper used by the transfer module.
+func (k Keeper) GetChannelKeeper() types.ChannelKeeper {
+	return k.channelKeeper
+}
+
+// GetAccountKeeper returns the account keeper used by the transfer module.
+func (k Keeper) GetAccountKeeper() types.AccountKeeper {
+	return k.authKeeper
+}
+
+// GetBankKeeper returns the bank keeper used by the transfer module.
+func (k Keeper) GetBankKeeper() types.BankKeeper {
+	return k.bankKeeper
+}
+
 // GetAuthority returns the transfer module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -195,8 +229,10 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
-// setDenomMetadata sets an IBC token's denomination metadata
-func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
+// setDenomMetadata sets an IBC token's denomination metadata. If the token metadata
+// of the source chain is provided and its display denomination is consistent with the
+// base denomination of the token, its display denomination, decimals and symbol are used.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace, tokenMetadata *types.TokenMetadata) {
 	metadata := banktypes.Metadata{
 		Description: fmt.Sprintf("IBC token from %s", denomTrace.GetFullDenomPath()),
 		DenomUnits: []*banktypes.DenomUnit{
@@ -214,9 +250,46 @@ func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
 		Symbol:  strings.ToUpper(denomTrace.BaseDenom),
 	}
 
+	if tokenMetadata != nil && tokenMetadata.ValidateDisplay(denomTrace.BaseDenom) == nil {
+		if tokenMetadata.Display != denomTrace.BaseDenom {
+			metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+				Denom:    tokenMetadata.Display,
+				Exponent: tokenMetadata.Decimals,
+			})
+		}
+
+		metadata.Display = tokenMetadata.Display
+		metadata.Symbol = tokenMetadata.Symbol
+	}
+
 	k.bankKeeper.SetDenomMetaData(ctx, metadata)
 }
 
+// getTokenMetadata returns the token metadata to be relayed alongside the given denomination,
+// derived from its bank metadata. Nil is returned if no bank metadata is stored for the
+// denomination or if the metadata does not define a valid display denomination unit.
+func (k Keeper) getTokenMetadata(ctx sdk.Context, denom string) *types.TokenMetadata {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != metadata.Display {
+			continue
+		}
+
+		tokenMetadata := types.NewTokenMetadata(unit.Exponent, metadata.Symbol, metadata.Display)
+		if err := tokenMetadata.ValidateBasic(); err != nil {
+			return nil
+		}
+
+		return &tokenMetadata
+	}
+
+	return nil
+}
+
 // GetTotalEscrowForDenom gets the total amount of source chain tokens that
 // are in escrow, keyed by the denomination.
 //
@@ -25,6 +25,10 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	var params types.Params
 	m.keeper.legacySubspace.GetParamSet(ctx, &params)
 
+	// the legacy params do not contain the length limits, apply the defaults
+	params.MaxMemoCharacters = types.DefaultMaxMemoCharacters
+	params.MaxReceiverLength = types.DefaultMaxReceiverLength
+
 	m.keeper.SetParams(ctx, params)
 	m.keeper.Logger(ctx).Info("successfully migrated transfer app self-manage params")
 	return nil
@@ -72,7 +76,7 @@ func (m Migrator) MigrateDenomMetadata(ctx sdk.Context) error {
 		func(dt types.DenomTrace) (stop bool) {
 			// check if the metadata for the given denom trace does not already exist
 			if !m.keeper.bankKeeper.HasDenomMetaData(ctx, dt.IBCDenom()) {
-				m.keeper.setDenomMetadata(ctx, dt)
+				m.keeper.setDenomMetadata(ctx, dt, nil)
 			}
 			return false
 		})
@@ -81,6 +85,18 @@ func (m Migrator) MigrateDenomMetadata(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateParamsLengthLimits sets the default maximum memo characters and maximum receiver length
+// in the transfer module's parameters.
+func (m Migrator) MigrateParamsLengthLimits(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxMemoCharacters = types.DefaultMaxMemoCharacters
+	params.MaxReceiverLength = types.DefaultMaxReceiverLength
+
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully set transfer memo and receiver length limits")
+	return nil
+}
+
 // MigrateTotalEscrowForDenom migrates the total amount of source chain tokens in escrow.
 func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
 	var totalEscrowed sdk.Coins
@@ -105,3 +121,27 @@ func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
 func equalTraces(dtA, dtB types.DenomTrace) bool {
 	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
 }
+
+// MigrateDenomChannels indexes the channels of all tracked transfer volumes under their denomination.
+func (m Migrator) MigrateDenomChannels(ctx sdk.Context) error {
+	for _, transferVolume := range m.keeper.GetAllTransferVolumes(ctx) {
+		m.keeper.SetTransferVolume(ctx, transferVolume)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully indexed channels of tracked transfer volumes by denomination")
+	return nil
+}
+
+// MigrateParamsChannelTimeoutDefaults initializes the channel timeout defaults in the transfer module's parameters
+// with no timeout defaults, so that transfers without timeouts keep being rejected until governance sets them.
+func (m Migrator) MigrateParamsChannelTimeoutDefaults(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.ChannelTimeoutDefaults = nil
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully initialized transfer channel timeout defaults")
+	return nil
+}
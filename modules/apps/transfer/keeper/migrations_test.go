@@ -48,6 +48,54 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigratorMigrateParamsLengthLimits() {
+	params := transfertypes.Params{
+		SendEnabled:    true,
+		ReceiveEnabled: false,
+	}
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	err := migrator.MigrateParamsLengthLimits(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	expParams := transfertypes.NewParams(true, false)
+	suite.Require().Equal(expParams, suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateParamsChannelTimeoutDefaults() {
+	params := transfertypes.NewParams(true, false)
+	params.ChannelTimeoutDefaults = []transfertypes.ChannelTimeoutDefault{{ChannelId: ibctesting.FirstChannelID}}
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	err := migrator.MigrateParamsChannelTimeoutDefaults(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	expParams := transfertypes.NewParams(true, false)
+	suite.Require().Equal(expParams, suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateDenomChannels() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	// store transfer volumes without indexing their channels, as tracked before the index was introduced
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(transfertypes.StoreKey))
+	for _, channelID := range []string{ibctesting.FirstChannelID, "channel-1"} {
+		transferVolume := transfertypes.NewTransferVolume(channelID, sdk.DefaultBondDenom)
+		store.Set(transfertypes.TransferVolumeKey(channelID, sdk.DefaultBondDenom), suite.chainA.Codec.MustMarshal(&transferVolume))
+	}
+
+	suite.Require().Empty(transferKeeper.GetDenomChannels(ctx, sdk.DefaultBondDenom))
+
+	migrator := transferkeeper.NewMigrator(transferKeeper)
+	err := migrator.MigrateDenomChannels(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]string{ibctesting.FirstChannelID, "channel-1"}, transferKeeper.GetDenomChannels(ctx, sdk.DefaultBondDenom))
+}
+
 func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 	testCases := []struct {
 		msg            string
@@ -17,8 +17,17 @@ var _ types.MsgServer = (*Keeper)(nil)
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.GetParams(ctx).SendEnabled {
-		return nil, types.ErrSendDisabled
+	params := k.GetParams(ctx)
+	if !params.IsSendEnabled(msg.SourceChannel) {
+		return nil, errorsmod.Wrapf(types.ErrSendDisabled, "transfers are disabled on channel %s", msg.SourceChannel)
+	}
+
+	if err := params.ValidateReceiverAndMemo(msg.Receiver, msg.Memo); err != nil {
+		return nil, err
+	}
+
+	if msg.Token.IsZero() && !params.PingTransfersEnabled {
+		return nil, errorsmod.Wrap(types.ErrInvalidAmount, "zero amount ping transfers are disabled")
 	}
 
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -34,8 +43,17 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	if err := k.assertCanonicalChannel(ctx, params, msg.SourcePort, msg.SourceChannel); err != nil {
+		return nil, err
+	}
+
+	timeoutHeight, timeoutTimestamp, err := k.applyTimeoutDefaults(ctx, params, msg.SourcePort, msg.SourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, timeoutHeight, timeoutTimestamp,
 		msg.Memo)
 	if err != nil {
 		return nil, err
@@ -68,7 +86,57 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// tracked volumes are pruned when volume tracking is disabled
+	if k.GetParams(ctx).VolumeTrackingEnabled && !msg.Params.VolumeTrackingEnabled {
+		k.PruneAllTransferVolumes(ctx)
+	}
+
 	k.SetParams(ctx, msg.Params)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RenameBaseDenoms defines an rpc handler method for MsgRenameBaseDenoms. Migrates the vouchers of denomination
+// traces whose base denomination has been renamed on their origin chain.
+func (k Keeper) RenameBaseDenoms(goCtx context.Context, msg *types.MsgRenameBaseDenoms) (*types.MsgRenameBaseDenomsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, rename := range msg.Renames {
+		if err := k.RenameBaseDenom(ctx, rename); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRenameBaseDenomsResponse{}, nil
+}
+
+// UpdateDecimalConversion defines an rpc handler method for MsgUpdateDecimalConversion. Sets or removes the
+// decimal conversion of a denomination transferred over a channel.
+func (k Keeper) UpdateDecimalConversion(goCtx context.Context, msg *types.MsgUpdateDecimalConversion) (*types.MsgUpdateDecimalConversionResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetDecimalConversion(ctx, msg.Conversion)
+
+	return &types.MsgUpdateDecimalConversionResponse{}, nil
+}
+
+// UpdateCanonicalChannel defines an rpc handler method for MsgUpdateCanonicalChannel. Registers or removes the
+// canonical channel for transfers to a counterparty chain.
+func (k Keeper) UpdateCanonicalChannel(goCtx context.Context, msg *types.MsgUpdateCanonicalChannel) (*types.MsgUpdateCanonicalChannelResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetCanonicalChannel(ctx, msg.CanonicalChannel)
+
+	return &types.MsgUpdateCanonicalChannelResponse{}, nil
+}
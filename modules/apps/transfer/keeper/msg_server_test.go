@@ -1,12 +1,20 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -47,6 +55,29 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"send transfers disabled on channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelTransferOverrides = []types.ChannelTransferOverride{
+					types.NewChannelTransferOverride(msg.SourceChannel, false, true),
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"send transfers disabled chain-wide cannot be enabled on channel",
+			func() {
+				params := types.DefaultParams()
+				params.SendEnabled = false
+				params.ChannelTransferOverrides = []types.ChannelTransferOverride{
+					types.NewChannelTransferOverride(msg.SourceChannel, true, true),
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"invalid sender",
 			func() {
@@ -80,6 +111,31 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"memo exceeds max memo characters",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxMemoCharacters = uint64(len(msg.Memo) - 1)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"zero amount ping transfers disabled",
+			func() {
+				msg.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt())
+			},
+			false,
+		},
+		{
+			"receiver exceeds max receiver length",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxReceiverLength = uint64(len(msg.Receiver) - 1)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -180,3 +236,241 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+// TestRenameBaseDenoms tests RenameBaseDenoms rpc handler
+func (suite *KeeperTestSuite) TestRenameBaseDenoms() {
+	var (
+		msg    *types.MsgRenameBaseDenoms
+		holder sdk.AccAddress
+	)
+
+	oldTrace := types.ParseDenomTrace("transfer/channel-0/uatom")
+	newTrace := types.ParseDenomTrace("transfer/channel-0/uatomv2")
+	amount := sdkmath.NewInt(100)
+	escrowAmount := sdkmath.NewInt(50)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: vouchers are merged with existing vouchers of the new denomination",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), newTrace)
+				coins := sdk.NewCoins(sdk.NewCoin(newTrace.IBCDenom(), amount))
+				suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, coins))
+				suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, holder, coins))
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denomination trace not found",
+			func() {
+				msg.Renames = []types.BaseDenomRename{types.NewBaseDenomRename("transfer/channel-1", "uatom", "uatomv2")}
+			},
+			types.ErrTraceNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			ctx := suite.chainA.GetContext()
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			holder = suite.chainA.SenderAccount.GetAddress()
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			// vouchers of the old denomination held by an account and escrowed on another channel
+			transferKeeper.SetDenomTrace(ctx, oldTrace)
+			suite.Require().NoError(bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(oldTrace.IBCDenom(), amount.Add(escrowAmount)))))
+			suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, sdk.NewCoins(sdk.NewCoin(oldTrace.IBCDenom(), amount))))
+			suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, escrowAddress, sdk.NewCoins(sdk.NewCoin(oldTrace.IBCDenom(), escrowAmount))))
+			transferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(oldTrace.IBCDenom(), escrowAmount))
+
+			msg = types.NewMsgRenameBaseDenoms(transferKeeper.GetAuthority(), types.NewBaseDenomRename("transfer/channel-0", "uatom", "uatomv2"))
+
+			tc.malleate()
+
+			// include the setup of the test case in the expected holder balance
+			expHolderBalance := amount.Add(bankKeeper.GetBalance(ctx, holder, newTrace.IBCDenom()).Amount)
+
+			_, err := transferKeeper.RenameBaseDenoms(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				// the old denomination trace is kept to refund packets sent prior to the rename
+				suite.Require().True(transferKeeper.HasDenomTrace(ctx, oldTrace.Hash()))
+				suite.Require().True(transferKeeper.HasDenomTrace(ctx, newTrace.Hash()))
+
+				suite.Require().True(bankKeeper.GetBalance(ctx, holder, oldTrace.IBCDenom()).IsZero())
+				suite.Require().Equal(expHolderBalance, bankKeeper.GetBalance(ctx, holder, newTrace.IBCDenom()).Amount)
+
+				// the vouchers in escrow keep the old denomination to be unescrowed when sent back
+				suite.Require().Equal(escrowAmount, bankKeeper.GetBalance(ctx, escrowAddress, oldTrace.IBCDenom()).Amount)
+				suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddress, newTrace.IBCDenom()).IsZero())
+				suite.Require().Equal(escrowAmount, bankKeeper.GetSupply(ctx, oldTrace.IBCDenom()).Amount)
+
+				suite.Require().Equal(escrowAmount, transferKeeper.GetTotalEscrowForDenom(ctx, oldTrace.IBCDenom()).Amount)
+				suite.Require().True(transferKeeper.GetTotalEscrowForDenom(ctx, newTrace.IBCDenom()).IsZero())
+
+				suite.Require().True(bankKeeper.HasDenomMetaData(ctx, newTrace.IBCDenom()))
+
+				expEvent := sdk.NewEvent(
+					types.EventTypeDenomRename,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyOldDenom, oldTrace.IBCDenom()),
+					sdk.NewAttribute(types.AttributeKeyNewDenom, newTrace.IBCDenom()),
+					sdk.NewAttribute(types.AttributeKeyTraceHash, newTrace.Hash().String()),
+				)
+				suite.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), abci.Event(expEvent))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(transferKeeper.HasDenomTrace(ctx, oldTrace.Hash()))
+			}
+		})
+	}
+}
+
+// TestRenameBaseDenomsForwardedVouchers tests that vouchers forwarded to another chain before a rename can be
+// sent back after the rename
+func (suite *KeeperTestSuite) TestRenameBaseDenomsForwardedVouchers() {
+	suite.SetupTest() // reset
+
+	pathAToB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAToB.Setup()
+	pathBToC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBToC.Setup()
+
+	amount := sdkmath.NewInt(100)
+	sender := suite.chainB.SenderAccount.GetAddress()
+
+	// send tokens from chain A to chain B
+	msg := types.NewMsgTransfer(pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(), sender.String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(pathAToB.RelayPacket(packet))
+
+	oldTrace := types.ParseDenomTrace(types.GetPrefixedDenom(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+
+	// forward the vouchers from chain B to chain C
+	msg = types.NewMsgTransfer(pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID, sdk.NewCoin(oldTrace.IBCDenom(), amount), sender.String(), suite.chainC.SenderAccount.GetAddress().String(), suite.chainC.GetTimeoutHeight(), 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(pathBToC.RelayPacket(packet))
+
+	// rename the base denomination on chain B
+	transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+	rename := types.NewBaseDenomRename(oldTrace.Path, sdk.DefaultBondDenom, "stakev2")
+	_, err = transferKeeper.RenameBaseDenoms(suite.chainB.GetContext(), types.NewMsgRenameBaseDenoms(transferKeeper.GetAuthority(), rename))
+	suite.Require().NoError(err)
+
+	escrowAddress := types.GetEscrowAddress(pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID)
+	suite.Require().Equal(amount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, oldTrace.IBCDenom()).Amount)
+
+	// send the vouchers back from chain C to chain B
+	traceOnC := types.ParseDenomTrace(types.GetPrefixedDenom(pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID, oldTrace.GetFullDenomPath()))
+	msg = types.NewMsgTransfer(pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID, sdk.NewCoin(traceOnC.IBCDenom(), amount), suite.chainC.SenderAccount.GetAddress().String(), sender.String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	res, err = suite.chainC.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pathBToC.EndpointA.UpdateClient())
+	res, err = pathBToC.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	// the vouchers are unescrowed with the old denomination, which can be converted by renaming it again
+	bankKeeper := suite.chainB.GetSimApp().BankKeeper
+	suite.Require().Equal(amount, bankKeeper.GetBalance(suite.chainB.GetContext(), sender, oldTrace.IBCDenom()).Amount)
+	suite.Require().True(bankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, oldTrace.IBCDenom()).IsZero())
+	suite.Require().True(transferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), oldTrace.IBCDenom()).IsZero())
+}
+
+// TestRenameBaseDenomsSkipsLockedVouchers tests that holders whose vouchers cannot be converted are skipped
+func (suite *KeeperTestSuite) TestRenameBaseDenomsSkipsLockedVouchers() {
+	suite.SetupTest() // reset
+
+	oldTrace := types.ParseDenomTrace("transfer/channel-0/uatom")
+	newTrace := types.ParseDenomTrace("transfer/channel-0/uatomv2")
+	vouchers := sdk.NewCoins(sdk.NewCoin(oldTrace.IBCDenom(), sdkmath.NewInt(100)))
+
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+	accountKeeper := suite.chainA.GetSimApp().AccountKeeper
+
+	holder := suite.chainA.SenderAccount.GetAddress()
+	vestingAddr := sdk.MustAccAddressFromBech32(ibctesting.TestAccAddress)
+
+	// the vouchers of the vesting account are locked until the end of the vesting period
+	baseAcc := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	vestingAcc, err := vestingtypes.NewContinuousVestingAccount(baseAcc, vouchers, ctx.BlockTime().Add(time.Hour).Unix(), ctx.BlockTime().Add(2*time.Hour).Unix())
+	suite.Require().NoError(err)
+	accountKeeper.SetAccount(ctx, accountKeeper.NewAccount(ctx, vestingAcc))
+
+	transferKeeper.SetDenomTrace(ctx, oldTrace)
+	suite.Require().NoError(bankKeeper.MintCoins(ctx, types.ModuleName, vouchers.Add(vouchers...)))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, vouchers))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, vestingAddr, vouchers))
+
+	msg := types.NewMsgRenameBaseDenoms(transferKeeper.GetAuthority(), types.NewBaseDenomRename("transfer/channel-0", "uatom", "uatomv2"))
+	_, err = transferKeeper.RenameBaseDenoms(ctx, msg)
+	suite.Require().NoError(err)
+
+	// the vouchers of the holder are converted
+	suite.Require().True(bankKeeper.GetBalance(ctx, holder, oldTrace.IBCDenom()).IsZero())
+	suite.Require().Equal(vouchers[0].Amount, bankKeeper.GetBalance(ctx, holder, newTrace.IBCDenom()).Amount)
+
+	// the vouchers of the vesting account are left untouched
+	suite.Require().Equal(vouchers[0], bankKeeper.GetBalance(ctx, vestingAddr, oldTrace.IBCDenom()))
+	suite.Require().True(bankKeeper.GetBalance(ctx, vestingAddr, newTrace.IBCDenom()).IsZero())
+	suite.Require().Equal(vouchers[0], bankKeeper.GetSupply(ctx, oldTrace.IBCDenom()))
+	suite.Require().Equal(vouchers[0].Amount, bankKeeper.GetSupply(ctx, newTrace.IBCDenom()).Amount)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeConversionSkip {
+			continue
+		}
+
+		found = true
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeyHolder:
+				suite.Require().Equal(vestingAddr.String(), attr.Value)
+			case types.AttributeKeyAmount:
+				suite.Require().Equal(vouchers[0].Amount.String(), attr.Value)
+			}
+		}
+	}
+	suite.Require().True(found, "voucher conversion skipped event not emitted")
+}
@@ -78,11 +78,14 @@ func (k Keeper) sendTransfer(
 		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	receiver, err := k.applySendRestriction(ctx, sourcePort, sourceChannel, sender, receiver, token)
+	if err != nil {
+		return 0, err
+	}
+
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
 	fullDenomPath := token.Denom
 
-	var err error
-
 	// deconstruct the token denomination into the denomination trace info
 	// to determine if the sender is the source chain
 	if strings.HasPrefix(token.Denom, "ibc/") {
@@ -92,6 +95,17 @@ func (k Keeper) sendTransfer(
 		}
 	}
 
+	// scale the amount to the precision of the counterparty chain, only the convertible
+	// part of the amount is escrowed or burned. The zero amount of ping transfers is not scaled.
+	packetAmount, localAmount := token.Amount, token.Amount
+	if !token.IsZero() {
+		packetAmount, localAmount, err = k.toCounterpartyAmount(ctx, sourceChannel, fullDenomPath, token.Amount)
+		if err != nil {
+			return 0, err
+		}
+		token = sdk.NewCoin(token.Denom, localAmount)
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
@@ -101,7 +115,10 @@ func (k Keeper) sendTransfer(
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
 
-	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
+	switch {
+	case token.IsZero():
+		// ping transfers only probe the liveness of the channel, no tokens are escrowed or burned
+	case types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath):
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
 
 		// obtain the escrow address for the source channel end
@@ -109,8 +126,7 @@ func (k Keeper) sendTransfer(
 		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
 			return 0, err
 		}
-
-	} else {
+	default:
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
 
 		// transfer the coins to the module account and burn them
@@ -131,14 +147,25 @@ func (k Keeper) sendTransfer(
 	}
 
 	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
+		fullDenomPath, packetAmount.String(), sender.String(), receiver, memo,
 	)
 
+	// token metadata is only relayed on channels which negotiated support for it
+	if appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel); found && appVersion == types.VersionWithTokenMetadata {
+		packetData.Metadata = k.getTokenMetadata(ctx, token.Denom)
+	}
+
 	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
 	if err != nil {
 		return 0, err
 	}
 
+	// the amount escrowed or burned is stored for converted packets, so that the packet is refunded and
+	// tracked using the conversion in force when it was sent
+	if !packetAmount.Equal(localAmount) {
+		k.setPacketLocalAmount(ctx, types.NewPacketLocalAmount(sourcePort, sourceChannel, sequence, localAmount))
+	}
+
 	defer func() {
 		if token.Amount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -169,20 +196,35 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
 	}
 
-	if !k.GetParams(ctx).ReceiveEnabled {
-		return types.ErrReceiveDisabled
+	params := k.GetParams(ctx)
+	if !params.IsReceiveEnabled(packet.GetDestChannel()) {
+		return errorsmod.Wrapf(types.ErrReceiveDisabled, "transfers are disabled on channel %s", packet.GetDestChannel())
+	}
+
+	if err := params.ValidateReceiverAndMemo(data.Receiver, data.Memo); err != nil {
+		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
+	}
+
+	if data.IsPing() {
+		if !params.PingTransfersEnabled {
+			err := errorsmod.Wrap(types.ErrInvalidAmount, "zero amount ping transfers are disabled")
+			return types.NewPacketDataFieldError(err, types.FieldAmount, "must be strictly positive")
+		}
+
+		// ping transfers only probe the liveness of the channel, no tokens are minted or unescrowed
+		return nil
 	}
 
 	// decode the receiver address
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	receiver, err := k.getReceiverAddress(ctx, packet, data.Receiver)
 	if err != nil {
-		return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+		return err
 	}
 
 	// parse the transfer amount
 	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
 	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+		return types.NewPacketDataFieldError(errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount), types.FieldAmount, "cannot be parsed as an integer")
 	}
 
 	labels := []metrics.Label{
@@ -213,7 +255,12 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		if !denomTrace.IsNativeDenom() {
 			denom = denomTrace.IBCDenom()
 		}
-		token := sdk.NewCoin(denom, transferAmount)
+
+		localAmount, err := k.toLocalAmount(ctx, packet.GetDestChannel(), unprefixedDenom, transferAmount)
+		if err != nil {
+			return err
+		}
+		token := sdk.NewCoin(denom, localAmount)
 
 		if k.bankKeeper.BlockedAddr(receiver) {
 			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
@@ -224,6 +271,8 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return err
 		}
 
+		k.trackReceivedVolume(ctx, packet.GetDestChannel(), token.Denom, token.Amount)
+
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -260,7 +309,13 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 	voucherDenom := denomTrace.IBCDenom()
 	if !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
-		k.setDenomMetadata(ctx, denomTrace)
+		// token metadata is only used on channels which negotiated support for it
+		var tokenMetadata *types.TokenMetadata
+		if appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel()); found && appVersion == types.VersionWithTokenMetadata {
+			tokenMetadata = data.Metadata
+		}
+
+		k.setDenomMetadata(ctx, denomTrace, tokenMetadata)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -270,7 +325,12 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
 		),
 	)
-	voucher := sdk.NewCoin(voucherDenom, transferAmount)
+
+	localAmount, err := k.toLocalAmount(ctx, packet.GetDestChannel(), prefixedDenom, transferAmount)
+	if err != nil {
+		return err
+	}
+	voucher := sdk.NewCoin(voucherDenom, localAmount)
 
 	// mint new tokens if the source of the transfer is the same chain
 	if err := k.bankKeeper.MintCoins(
@@ -286,6 +346,8 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
 	}
 
+	k.trackReceivedVolume(ctx, packet.GetDestChannel(), voucher.Denom, voucher.Amount)
+
 	defer func() {
 		if transferAmount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -309,25 +371,88 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 // OnAcknowledgementPacket responds to the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
-// was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
+// was a success then only the sent volume is tracked. If the acknowledgement
+// failed, then the sender is refunded their tokens using the refundPacketToken
+// function.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	// no tokens were escrowed or burned for ping transfers, so there is nothing to settle or refund
+	if data.IsPing() {
+		return nil
+	}
+
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
-		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
+		// the acknowledgement succeeded on the receiving chain so only the
+		// sent volume is tracked and no error needs to be returned
+		k.trackAcknowledgedVolume(ctx, packet, data)
+
+		// vouchers burned when sending are only permanently removed from supply
+		// once the packet is successfully acknowledged
+		if !types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+			if amount, err := k.packetLocalAmount(ctx, packet, data); err == nil {
+				emitVoucherBurnedEvent(ctx, packet, data.Sender, sdk.NewCoin(types.ParseDenomTrace(data.Denom).IBCDenom(), amount))
+			}
+		}
+
+		k.deletePacketLocalAmount(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
 		return nil
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
+
+		k.deletePacketLocalAmount(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+		return nil
 	default:
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected one of [%T, %T], got %T", channeltypes.Acknowledgement_Result{}, channeltypes.Acknowledgement_Error{}, ack.Response)
 	}
 }
 
+// trackAcknowledgedVolume adds the amount of a successfully acknowledged packet to the
+// sent volume of the source channel. The denomination is tracked as represented on this chain.
+func (k Keeper) trackAcknowledgedVolume(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) {
+	amount, err := k.packetLocalAmount(ctx, packet, data)
+	if err != nil {
+		return
+	}
+
+	k.trackSentVolume(ctx, packet.GetSourceChannel(), types.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+}
+
+// packetLocalAmount returns the amount of a packet sent by this chain in the precision of this chain.
+// The amount escrowed or burned is stored when sending packets whose amount was converted, so the
+// decimal conversion in force when the packet is settled is not applied. The packet amount is returned
+// unchanged for packets which were sent without a decimal conversion.
+func (k Keeper) packetLocalAmount(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) (sdkmath.Int, error) {
+	if amount, found := k.GetPacketLocalAmount(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); found {
+		return amount, nil
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
+	}
+
+	return amount, nil
+}
+
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	// no tokens were escrowed or burned for ping transfers, so there is nothing to refund
+	if data.IsPing() {
+		return nil
+	}
+
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+
+	k.deletePacketLocalAmount(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	return nil
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
@@ -340,10 +465,10 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	// parse the denomination from the full denom path
 	trace := types.ParseDenomTrace(data.Denom)
 
-	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
+	// parse the transfer amount in the precision of this chain
+	transferAmount, err := k.packetLocalAmount(ctx, packet, data)
+	if err != nil {
+		return err
 	}
 	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
@@ -356,7 +481,13 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		return k.unescrowToken(ctx, escrowAddress, sender, token)
+		if err := k.unescrowToken(ctx, escrowAddress, sender, token); err != nil {
+			return err
+		}
+
+		emitEscrowReleasedEvent(ctx, packet, escrowAddress, sender, token)
+
+		return nil
 	}
 
 	// mint vouchers back to sender
@@ -389,6 +520,50 @@ func (k Keeper) escrowToken(ctx sdk.Context, sender, escrowAddress sdk.AccAddres
 	return nil
 }
 
+// getReceiverAddress returns the local account address of the given packet receiver. If a
+// ReceiverAddressTransformer is set, it is used to map the receiver, otherwise the receiver
+// is decoded as a bech32 address.
+func (k Keeper) getReceiverAddress(ctx sdk.Context, packet channeltypes.Packet, receiver string) (sdk.AccAddress, error) {
+	if k.receiverAddressTransformer == nil {
+		receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return nil, types.NewPacketDataFieldError(errorsmod.Wrapf(err, "failed to decode receiver address: %s", receiver), types.FieldReceiver, "invalid bech32 address")
+		}
+
+		return receiverAddr, nil
+	}
+
+	receiverAddr, err := k.receiverAddressTransformer.TransformReceiverAddress(ctx, packet, receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidReceiver, "failed to transform receiver address %s: %s", receiver, err)
+	}
+
+	if err := sdk.VerifyAddressFormat(receiverAddr); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidReceiver, "transformed receiver address for %s is invalid: %s", receiver, err)
+	}
+
+	return receiverAddr, nil
+}
+
+// applySendRestriction returns the receiver of an outgoing transfer after applying the SendRestriction
+// set on the keeper. If no restriction is set, the requested receiver is returned unchanged.
+func (k Keeper) applySendRestriction(ctx sdk.Context, sourcePort, sourceChannel string, sender sdk.AccAddress, receiver string, token sdk.Coin) (string, error) {
+	if k.sendRestriction == nil {
+		return receiver, nil
+	}
+
+	newReceiver, err := k.sendRestriction.RestrictSendTransfer(ctx, sourcePort, sourceChannel, sender, receiver, token)
+	if err != nil {
+		return "", errorsmod.Wrapf(types.ErrSendRestricted, "transfer of %s from %s to %s rejected: %s", token, sender, receiver, err)
+	}
+
+	if strings.TrimSpace(newReceiver) == "" {
+		return "", errorsmod.Wrapf(types.ErrSendRestricted, "send restriction returned an empty receiver for %s", receiver)
+	}
+
+	return newReceiver, nil
+}
+
 // unescrowToken will send the given token from the escrow address to the provided receiver. It will also
 // update the total escrow by deducting the unescrowed token from the current total escrow.
 func (k Keeper) unescrowToken(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error {
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -163,6 +165,125 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 	}
 }
 
+// mockSendRestriction is a SendRestriction which rejects transfers from a blocked sender and
+// redirects transfers to a configured receiver.
+type mockSendRestriction struct {
+	blockedSender sdk.AccAddress
+	redirectTo    string
+}
+
+func (r mockSendRestriction) RestrictSendTransfer(_ sdk.Context, _, _ string, sender sdk.AccAddress, receiver string, _ sdk.Coin) (string, error) {
+	if sender.Equals(r.blockedSender) {
+		return "", fmt.Errorf("sender %s is sanctioned", sender)
+	}
+
+	if r.redirectTo != "" {
+		return r.redirectTo, nil
+	}
+
+	return receiver, nil
+}
+
+func (suite *KeeperTestSuite) TestSendTransferWithSendRestriction() {
+	var (
+		path        *ibctesting.Path
+		restriction types.SendRestriction
+		expReceiver string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no restriction set",
+			func() {},
+			nil,
+		},
+		{
+			"success: restriction allows transfer",
+			func() {
+				restriction = mockSendRestriction{blockedSender: suite.chainB.SenderAccount.GetAddress()}
+			},
+			nil,
+		},
+		{
+			"success: restriction redirects transfer",
+			func() {
+				expReceiver = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+				restriction = mockSendRestriction{redirectTo: expReceiver}
+			},
+			nil,
+		},
+		{
+			"failure: restriction rejects sender",
+			func() {
+				restriction = mockSendRestriction{blockedSender: suite.chainA.SenderAccount.GetAddress()}
+			},
+			types.ErrSendRestricted,
+		},
+		{
+			"failure: restriction returns empty receiver",
+			func() {
+				restriction = mockSendRestriction{redirectTo: " "}
+			},
+			types.ErrSendRestricted,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			restriction = nil
+			expReceiver = suite.chainB.SenderAccount.GetAddress().String()
+
+			tc.malleate()
+
+			if restriction != nil {
+				suite.chainA.GetSimApp().TransferKeeper.WithSendRestriction(restriction)
+			}
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+				suite.Require().NoError(err)
+
+				var data types.FungibleTokenPacketData
+				suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+				suite.Require().Equal(expReceiver, data.Receiver)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+
+				// no tokens are escrowed for rejected transfers
+				amount := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, coin.GetDenom())
+				suite.Require().True(amount.Amount.IsZero())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCToken() {
 	/*
 		Given the following flow of tokens:
@@ -241,6 +362,194 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrow.Amount)
 }
 
+// test that the token metadata of the sending chain is relayed and used to register the
+// voucher denom metadata on the receiving chain only for channels negotiating it
+func (suite *KeeperTestSuite) TestSendAndReceiveTokenMetadata() {
+	var (
+		path             *ibctesting.Path
+		expTokenMetadata *types.TokenMetadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: token metadata relayed", func() {
+				path.EndpointA.ChannelConfig.Version = types.VersionWithTokenMetadata
+				path.EndpointB.ChannelConfig.Version = types.VersionWithTokenMetadata
+
+				tokenMetadata := types.NewTokenMetadata(6, "STAKE", "stakecoin")
+				expTokenMetadata = &tokenMetadata
+			},
+		},
+		{
+			"success: token metadata not relayed on channel with default version", func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			expTokenMetadata = nil
+
+			tc.malleate()
+
+			path.Setup()
+
+			suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), banktypes.Metadata{
+				Description: "The native staking token",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: sdk.DefaultBondDenom, Exponent: 0},
+					{Denom: "stakecoin", Exponent: 6},
+				},
+				Base:    sdk.DefaultBondDenom,
+				Display: "stakecoin",
+				Name:    "Stake",
+				Symbol:  "STAKE",
+			})
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			transferMsg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				coin,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			result, err := suite.chainA.SendMsgs(transferMsg)
+			suite.Require().NoError(err) // message committed
+
+			packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			var data types.FungibleTokenPacketData
+			err = json.Unmarshal(packet.GetData(), &data)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expTokenMetadata, data.Metadata)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			trace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			denomMetadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), trace.IBCDenom())
+			suite.Require().True(found)
+
+			if expTokenMetadata != nil {
+				suite.Require().Equal(expTokenMetadata.Display, denomMetadata.Display)
+				suite.Require().Equal(expTokenMetadata.Symbol, denomMetadata.Symbol)
+				suite.Require().Equal([]*banktypes.DenomUnit{
+					{Denom: sdk.DefaultBondDenom, Exponent: 0},
+					{Denom: expTokenMetadata.Display, Exponent: expTokenMetadata.Decimals},
+				}, denomMetadata.DenomUnits)
+			} else {
+				suite.Require().Equal(trace.GetFullDenomPath(), denomMetadata.Display)
+				suite.Require().Equal(strings.ToUpper(sdk.DefaultBondDenom), denomMetadata.Symbol)
+			}
+		})
+	}
+}
+
+// test that the relayed token metadata is only used to register the voucher denom metadata if the
+// receiving channel negotiated support for it and its display denomination is consistent with the base denomination
+func (suite *KeeperTestSuite) TestOnRecvPacketTokenMetadata() {
+	var (
+		path          *ibctesting.Path
+		tokenMetadata types.TokenMetadata
+		expUsed       bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: token metadata used", func() {},
+		},
+		{
+			"success: token metadata ignored on channel with default version", func() {
+				path.EndpointA.ChannelConfig.Version = types.Version
+				path.EndpointB.ChannelConfig.Version = types.Version
+				expUsed = false
+			},
+		},
+		{
+			"success: token metadata with base denomination as display denomination and decimals ignored", func() {
+				tokenMetadata = types.NewTokenMetadata(6, "STAKE", sdk.DefaultBondDenom)
+				expUsed = false
+			},
+		},
+		{
+			"success: token metadata with display denomination without decimals ignored", func() {
+				tokenMetadata = types.NewTokenMetadata(0, "STAKE", "stakecoin")
+				expUsed = false
+			},
+		},
+		{
+			"success: token metadata with IBC denomination as display denomination ignored", func() {
+				denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom))
+				tokenMetadata = types.NewTokenMetadata(6, "STAKE", denomTrace.IBCDenom())
+				expUsed = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.VersionWithTokenMetadata
+			path.EndpointB.ChannelConfig.Version = types.VersionWithTokenMetadata
+			tokenMetadata = types.NewTokenMetadata(6, "STAKE", "stakecoin")
+			expUsed = true
+
+			tc.malleate()
+
+			path.Setup()
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			data.Metadata = &tokenMetadata
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			trace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			denomMetadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), trace.IBCDenom())
+			suite.Require().True(found)
+
+			if expUsed {
+				suite.Require().Equal(tokenMetadata.Display, denomMetadata.Display)
+				suite.Require().Equal(tokenMetadata.Symbol, denomMetadata.Symbol)
+			} else {
+				suite.Require().Equal(trace.GetFullDenomPath(), denomMetadata.Display)
+				suite.Require().Equal(strings.ToUpper(sdk.DefaultBondDenom), denomMetadata.Symbol)
+				suite.Require().Len(denomMetadata.DenomUnits, 1)
+			}
+		})
+	}
+}
+
+// hexReceiverAddressTransformer is a ReceiverAddressTransformer which decodes 0x prefixed hex receivers.
+type hexReceiverAddressTransformer struct{}
+
+func (hexReceiverAddressTransformer) TransformReceiverAddress(_ sdk.Context, _ channeltypes.Packet, receiver string) (sdk.AccAddress, error) {
+	hexAddr, found := strings.CutPrefix(receiver, "0x")
+	if !found {
+		return nil, fmt.Errorf("receiver %s is not hex encoded", receiver)
+	}
+
+	return hex.DecodeString(hexAddr)
+}
+
 // test receiving coin on chainB with coin that originate on chainA and
 // coin that originated on chainB (source). The bulk of the testing occurs
 // in the test case for loop since setup is intensive for all cases. The
@@ -346,6 +655,50 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					})
 			}, false, false,
 		},
+		{
+			"failure: receive is disabled on channel",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelTransferOverrides = []types.ChannelTransferOverride{
+					types.NewChannelTransferOverride(ibctesting.FirstChannelID, true, false),
+				}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false, false,
+		},
+		{
+			"failure: memo exceeds max memo characters",
+			func() {
+				memo = "memo"
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(),
+					types.Params{
+						ReceiveEnabled:    true,
+						MaxMemoCharacters: 3,
+					})
+			}, false, false,
+		},
+		{
+			"success: receiver address transformed",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.WithReceiverAddressTransformer(hexReceiverAddressTransformer{})
+				receiver = "0x" + hex.EncodeToString(suite.chainB.SenderAccount.GetAddress())
+			}, false, true,
+		},
+		{
+			"failure: receiver address transformer returns error",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.WithReceiverAddressTransformer(hexReceiverAddressTransformer{})
+			}, false, false,
+		},
+		{
+			"failure: receiver exceeds max receiver length",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(),
+					types.Params{
+						ReceiveEnabled:    true,
+						MaxReceiverLength: 3,
+					})
+			}, false, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -720,6 +1073,139 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountFo
 	suite.Require().Equal(sdkmath.ZeroInt(), totalEscrowChainB.Amount)
 }
 
+func (suite *KeeperTestSuite) TestEscrowReleasedAndVoucherBurnedEvents() {
+	var (
+		path *ibctesting.Path
+		data types.FungibleTokenPacketData
+	)
+
+	amount := sdkmath.NewInt(100)
+
+	testCases := []struct {
+		name       string
+		ack        *channeltypes.Acknowledgement // a nil acknowledgement times out the packet
+		sourceSend bool
+		expEvent   func(packet channeltypes.Packet) *sdk.Event
+	}{
+		{
+			"success ack of vouchers: voucher burned",
+			&channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Result{Result: []byte{byte(1)}}},
+			false,
+			func(packet channeltypes.Packet) *sdk.Event {
+				trace := types.ParseDenomTrace(data.Denom)
+				event := sdk.NewEvent(
+					types.EventTypeVoucherBurn,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+					sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+					sdk.NewAttribute(channeltypes.AttributeKeySequence, "1"),
+					sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+					sdk.NewAttribute(types.AttributeKeyDenom, trace.IBCDenom()),
+					sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				)
+				return &event
+			},
+		},
+		{
+			"success ack of native tokens: tokens remain in escrow",
+			&channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Result{Result: []byte{byte(1)}}},
+			true,
+			func(channeltypes.Packet) *sdk.Event { return nil },
+		},
+		{
+			"error ack of native tokens: escrow released",
+			&channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Error{Error: "failed packet transfer"}},
+			true,
+			func(packet channeltypes.Packet) *sdk.Event {
+				event := sdk.NewEvent(
+					types.EventTypeEscrowRelease,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+					sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+					sdk.NewAttribute(channeltypes.AttributeKeySequence, "1"),
+					sdk.NewAttribute(types.AttributeKeyEscrowAddress, types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel()).String()),
+					sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+					sdk.NewAttribute(types.AttributeKeyDenom, sdk.DefaultBondDenom),
+					sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				)
+				return &event
+			},
+		},
+		{
+			"timeout of native tokens: escrow released",
+			nil,
+			true,
+			func(packet channeltypes.Packet) *sdk.Event {
+				event := sdk.NewEvent(
+					types.EventTypeEscrowRelease,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+					sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+					sdk.NewAttribute(channeltypes.AttributeKeySequence, "1"),
+					sdk.NewAttribute(types.AttributeKeyEscrowAddress, types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel()).String()),
+					sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+					sdk.NewAttribute(types.AttributeKeyDenom, sdk.DefaultBondDenom),
+					sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				)
+				return &event
+			},
+		},
+		{
+			"error ack of vouchers: vouchers are minted back without escrow release",
+			&channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Error{Error: "failed packet transfer"}},
+			false,
+			func(channeltypes.Packet) *sdk.Event { return nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			denom := types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+			if tc.sourceSend {
+				denom = sdk.DefaultBondDenom
+
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			}
+
+			data = types.NewFungibleTokenPacketData(denom, amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			ctx := suite.chainA.GetContext()
+
+			var err error
+			if tc.ack == nil {
+				err = suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+			} else {
+				err = suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, *tc.ack)
+			}
+			suite.Require().NoError(err)
+
+			events := ctx.EventManager().Events()
+			expEvent := tc.expEvent(packet)
+			if expEvent == nil {
+				for _, event := range events {
+					suite.Require().NotContains([]string{types.EventTypeEscrowRelease, types.EventTypeVoucherBurn}, event.Type)
+				}
+
+				return
+			}
+
+			expectedEvents := sdk.MarkEventsToIndex(sdk.Events{*expEvent}.ToABCIEvents(), map[string]struct{}{})
+			ibctesting.AssertEvents(&suite.Suite, expectedEvents, events.ToABCIEvents())
+		})
+	}
+}
+
 // TestOnTimeoutPacket test private refundPacket function since it is a simple
 // wrapper over it. The actual timeout does not matter since IBC core logic
 // is not being tested. The test is timing out a send from chainA to chainB
@@ -913,3 +1399,89 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	totalEscrowChainB = suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.ZeroInt(), totalEscrowChainB.Amount)
 }
+
+// TestPingTransfer tests that zero amount ping transfers are relayed without moving any funds
+// when enabled and rejected on both the sending and receiving chain when disabled.
+func (suite *KeeperTestSuite) TestPingTransfer() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: ping transfer relayed",
+			func() {},
+			nil,
+		},
+		{
+			"failure: ping transfers disabled on receiving chain",
+			func() {
+				params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+				params.PingTransfersEnabled = false
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+				params := chain.GetSimApp().TransferKeeper.GetParams(chain.GetContext())
+				params.PingTransfersEnabled = true
+				chain.GetSimApp().TransferKeeper.SetParams(chain.GetContext(), params)
+			}
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt())
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), receiver.String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			// no funds are escrowed on the sending chain
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+			suite.Require().Equal(sdkmath.ZeroInt(), totalEscrow.Amount)
+			balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(balanceBefore, balanceAfter)
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, coin.Amount.String(), sender.String(), receiver.String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// no vouchers are minted on the receiving chain
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom).IsZero())
+
+			// neither error acknowledgements nor timeouts refund anything
+			errAck := channeltypes.NewErrorAcknowledgement(types.ErrInvalidAmount)
+			err = suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, errAck)
+			suite.Require().NoError(err)
+
+			err = suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			balanceAfter = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(balanceBefore, balanceAfter)
+		})
+	}
+}
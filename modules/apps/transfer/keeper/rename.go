@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// RenameBaseDenom migrates the IBC vouchers of the denomination trace with the provided path and old base
+// denomination to a denomination trace with the new base denomination. It is intended to be used when a
+// token has been renamed on its origin chain, as vouchers referencing the old base denomination can no
+// longer be sent back to the origin chain. The following state is migrated:
+//   - the voucher balances of all accounts, except the escrow accounts of the transfer channels, are converted
+//     to the new IBC denomination,
+//   - the new denomination trace is set,
+//   - the bank metadata of the new IBC denomination is set, if it does not exist already.
+//
+// The new denomination trace may already exist if vouchers with the new base denomination have been received
+// before the migration, in which case the converted vouchers are merged with them. The vouchers held in escrow,
+// the total amount in escrow, the old denomination trace and the bank metadata of the old IBC denomination are
+// left untouched, so that packets sent with the old IBC denomination before the migration can still be refunded,
+// and vouchers forwarded to other chains can still be sent back and unescrowed. Vouchers of the old IBC
+// denomination which are refunded, received back or could not be converted can be migrated by renaming the
+// base denomination again.
+func (k Keeper) RenameBaseDenom(ctx sdk.Context, rename types.BaseDenomRename) error {
+	if err := rename.Validate(); err != nil {
+		return err
+	}
+
+	oldTrace, newTrace := rename.OldDenomTrace(), rename.NewDenomTrace()
+	if !k.HasDenomTrace(ctx, oldTrace.Hash()) {
+		return errorsmod.Wrap(types.ErrTraceNotFound, oldTrace.GetFullDenomPath())
+	}
+
+	oldDenom, newDenom := oldTrace.IBCDenom(), newTrace.IBCDenom()
+	if err := k.convertVouchers(ctx, oldDenom, newDenom); err != nil {
+		return errorsmod.Wrapf(err, "failed to convert vouchers of %s", oldTrace.GetFullDenomPath())
+	}
+
+	k.SetDenomTrace(ctx, newTrace)
+
+	if !k.bankKeeper.HasDenomMetaData(ctx, newDenom) {
+		k.setDenomMetadata(ctx, newTrace, k.getTokenMetadata(ctx, oldDenom))
+	}
+
+	emitDenomRenamedEvent(ctx, oldTrace, newTrace)
+
+	return nil
+}
+
+// convertVouchers converts the balances of the old IBC denomination held by all accounts, except the escrow
+// accounts of the transfer channels, to the new IBC denomination. The holders of the old IBC denomination are looked up in the denomination index of the bank
+// module. The vouchers are collected by the transfer module account, burned, and the same amount of the new
+// denomination is minted and sent back to each holder. Holders whose vouchers cannot be collected (e.g. vesting
+// accounts whose vouchers are locked) are skipped and keep the vouchers of the old IBC denomination.
+func (k Keeper) convertVouchers(ctx sdk.Context, oldDenom, newDenom string) error {
+	var owners []*banktypes.DenomOwner
+	req := &banktypes.QueryDenomOwnersRequest{Denom: oldDenom, Pagination: &query.PageRequest{}}
+	for {
+		res, err := k.bankKeeper.DenomOwners(ctx, req)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to query holders of %s", oldDenom)
+		}
+
+		owners = append(owners, res.DenomOwners...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+
+	// vouchers in escrow must keep the old IBC denomination to be unescrowed by refunds and received packets
+	escrowAddresses := make(map[string]bool)
+	portID := k.GetPort(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		escrowAddresses[types.GetEscrowAddress(portID, channel.ChannelId).String()] = true
+	}
+
+	var (
+		holders  []sdk.AccAddress
+		balances []sdk.Coin
+	)
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	total := sdkmath.ZeroInt()
+	for _, owner := range owners {
+		if !owner.Balance.IsPositive() || escrowAddresses[owner.Address] {
+			continue
+		}
+
+		holder, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			return err
+		}
+
+		// collect the vouchers in a cached context, so that a failure leaves the balance of the holder untouched
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoins(cacheCtx, holder, moduleAddr, sdk.NewCoins(owner.Balance)); err != nil {
+			k.Logger(ctx).Error("skipping conversion of vouchers", "holder", holder, "denom", oldDenom, "error", err)
+			emitVoucherConversionSkippedEvent(ctx, holder, owner.Balance, err)
+			continue
+		}
+
+		writeFn()
+		holders = append(holders, holder)
+		balances = append(balances, owner.Balance)
+		total = total.Add(owner.Balance.Amount)
+	}
+
+	if len(holders) == 0 {
+		return nil
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(oldDenom, total))); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(newDenom, total))); err != nil {
+		return err
+	}
+
+	for i, holder := range holders {
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, holder, sdk.NewCoins(sdk.NewCoin(newDenom, balances[i].Amount))); err != nil {
+			return errorsmod.Wrapf(err, "failed to return vouchers to %s", holder)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+// applyTimeoutDefaults returns the timeout height and timestamp of a transfer sent on the provided channel. If neither
+// a timeout height nor a timeout timestamp is set and the parameters define a timeout default for the channel, the
+// timeout height is obtained by adding the height offset to the latest height of the counterparty client and the
+// timeout timestamp by adding the timestamp offset to the current block time. Otherwise, the timeouts are returned unchanged.
+func (k Keeper) applyTimeoutDefaults(
+	ctx sdk.Context,
+	params types.Params,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (clienttypes.Height, uint64, error) {
+	if !timeoutHeight.IsZero() || timeoutTimestamp != 0 {
+		return timeoutHeight, timeoutTimestamp, nil
+	}
+
+	timeoutDefault, found := params.GetChannelTimeoutDefault(sourceChannel)
+	if !found {
+		return timeoutHeight, timeoutTimestamp, nil
+	}
+
+	if timeoutDefault.TimeoutHeightOffset != 0 {
+		latestHeight, err := k.channelKeeper.GetChannelClientLatestHeight(ctx, sourcePort, sourceChannel)
+		if err != nil {
+			return clienttypes.Height{}, 0, err
+		}
+
+		timeoutHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+timeoutDefault.TimeoutHeightOffset)
+	}
+
+	if timeoutDefault.TimeoutTimestampOffset != 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + timeoutDefault.TimeoutTimestampOffset
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestTransferTimeoutDefaults() {
+	var (
+		path                *ibctesting.Path
+		msg                 *types.MsgTransfer
+		expTimeoutHeight    clienttypes.Height
+		expTimeoutTimestamp uint64
+	)
+
+	setTimeoutDefault := func(timeoutDefault types.ChannelTimeoutDefault) {
+		params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+		params.ChannelTimeoutDefaults = []types.ChannelTimeoutDefault{timeoutDefault}
+		suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: timeout height offset applied",
+			func() {
+				setTimeoutDefault(types.NewChannelTimeoutDefault(path.EndpointA.ChannelID, 100, 0))
+
+				latestHeight := path.EndpointA.GetClientLatestHeight()
+				expTimeoutHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+100)
+			},
+			nil,
+		},
+		{
+			"success: timeout timestamp offset applied",
+			func() {
+				setTimeoutDefault(types.NewChannelTimeoutDefault(path.EndpointA.ChannelID, 0, 600_000_000_000))
+
+				expTimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + 600_000_000_000
+			},
+			nil,
+		},
+		{
+			"success: timeout height and timestamp offsets applied",
+			func() {
+				setTimeoutDefault(types.NewChannelTimeoutDefault(path.EndpointA.ChannelID, 100, 600_000_000_000))
+
+				latestHeight := path.EndpointA.GetClientLatestHeight()
+				expTimeoutHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+100)
+				expTimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + 600_000_000_000
+			},
+			nil,
+		},
+		{
+			"success: timeouts of the message take precedence over the timeout default",
+			func() {
+				setTimeoutDefault(types.NewChannelTimeoutDefault(path.EndpointA.ChannelID, 100, 600_000_000_000))
+
+				msg.TimeoutHeight = suite.chainB.GetTimeoutHeight()
+				expTimeoutHeight = msg.TimeoutHeight
+			},
+			nil,
+		},
+		{
+			"failure: no timeout default for the channel",
+			func() {
+				setTimeoutDefault(types.NewChannelTimeoutDefault("channel-100", 100, 0))
+			},
+			channeltypes.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			expTimeoutHeight = clienttypes.ZeroHeight()
+			expTimeoutTimestamp = 0
+
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(), 0, "",
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				var found bool
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type != channeltypes.EventTypeSendPacket {
+						continue
+					}
+
+					found = true
+					for _, attr := range event.Attributes {
+						switch attr.Key {
+						case channeltypes.AttributeKeyTimeoutHeight:
+							suite.Require().Equal(expTimeoutHeight.String(), attr.Value)
+						case channeltypes.AttributeKeyTimeoutTimestamp:
+							suite.Require().Equal(fmt.Sprintf("%d", expTimeoutTimestamp), attr.Value)
+						}
+					}
+				}
+				suite.Require().True(found, "send packet event not emitted")
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
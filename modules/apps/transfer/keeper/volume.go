@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// GetTransferVolume returns the cumulative transfer volume of the provided denomination over
+// the provided channel. A zero transfer volume is returned if no volume is tracked.
+func (k Keeper) GetTransferVolume(ctx sdk.Context, channelID, denom string) types.TransferVolume {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TransferVolumeKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.NewTransferVolume(channelID, denom)
+	}
+
+	var transferVolume types.TransferVolume
+	k.cdc.MustUnmarshal(bz, &transferVolume)
+	return transferVolume
+}
+
+// SetTransferVolume stores the cumulative transfer volume of a denomination over a channel,
+// and indexes the channel under the denomination.
+func (k Keeper) SetTransferVolume(ctx sdk.Context, transferVolume types.TransferVolume) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&transferVolume)
+	store.Set(types.TransferVolumeKey(transferVolume.ChannelId, transferVolume.Denom), bz)
+	store.Set(types.DenomChannelKey(transferVolume.Denom, transferVolume.ChannelId), []byte{byte(1)})
+}
+
+// GetDenomChannels returns the identifiers of all channels with a tracked transfer volume for
+// the provided denomination.
+func (k Keeper) GetDenomChannels(ctx sdk.Context, denom string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomChannelsKey(denom))
+	iterator := store.Iterator(nil, nil)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var channelIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		channelIDs = append(channelIDs, string(iterator.Key()))
+	}
+
+	return channelIDs
+}
+
+// GetAllTransferVolumes returns the cumulative transfer volumes of all channels and denominations.
+func (k Keeper) GetAllTransferVolumes(ctx sdk.Context) []types.TransferVolume {
+	var transferVolumes []types.TransferVolume
+	k.iterateTransferVolumes(ctx, []byte(types.KeyTransferVolumePrefix+"/"), func(transferVolume types.TransferVolume) bool {
+		transferVolumes = append(transferVolumes, transferVolume)
+		return false
+	})
+
+	return transferVolumes
+}
+
+// PruneTransferVolumes removes the transfer volumes of all denominations transferred over the provided channel.
+func (k Keeper) PruneTransferVolumes(ctx sdk.Context, channelID string) {
+	k.pruneTransferVolumes(ctx, types.TransferVolumesKey(channelID))
+}
+
+// PruneAllTransferVolumes removes the transfer volumes of all channels and denominations.
+func (k Keeper) PruneAllTransferVolumes(ctx sdk.Context) {
+	k.pruneTransferVolumes(ctx, []byte(types.KeyTransferVolumePrefix+"/"))
+}
+
+// pruneTransferVolumes removes all transfer volumes stored under the provided key prefix.
+func (k Keeper) pruneTransferVolumes(ctx sdk.Context, keyPrefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	k.iterateTransferVolumes(ctx, keyPrefix, func(transferVolume types.TransferVolume) bool {
+		keys = append(keys,
+			types.TransferVolumeKey(transferVolume.ChannelId, transferVolume.Denom),
+			types.DenomChannelKey(transferVolume.Denom, transferVolume.ChannelId),
+		)
+		return false
+	})
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// iterateTransferVolumes iterates over the transfer volumes stored under the provided key prefix
+// and performs a callback function.
+func (k Keeper) iterateTransferVolumes(ctx sdk.Context, keyPrefix []byte, cb func(transferVolume types.TransferVolume) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var transferVolume types.TransferVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &transferVolume)
+
+		if cb(transferVolume) {
+			break
+		}
+	}
+}
+
+// trackSentVolume adds the provided amount to the sent volume of the denomination over the
+// channel if volume tracking is enabled.
+func (k Keeper) trackSentVolume(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) {
+	if !k.GetParams(ctx).VolumeTrackingEnabled {
+		return
+	}
+
+	transferVolume := k.GetTransferVolume(ctx, channelID, denom)
+	transferVolume.Sent = transferVolume.Sent.Add(amount)
+	k.SetTransferVolume(ctx, transferVolume)
+}
+
+// trackReceivedVolume adds the provided amount to the received volume of the denomination over
+// the channel if volume tracking is enabled.
+func (k Keeper) trackReceivedVolume(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) {
+	if !k.GetParams(ctx).VolumeTrackingEnabled {
+		return
+	}
+
+	transferVolume := k.GetTransferVolume(ctx, channelID, denom)
+	transferVolume.Received = transferVolume.Received.Add(amount)
+	k.SetTransferVolume(ctx, transferVolume)
+}
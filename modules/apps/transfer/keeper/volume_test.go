@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestTransferVolumeTracking() {
+	testCases := []struct {
+		name                  string
+		volumeTrackingEnabled bool
+	}{
+		{"success: volumes tracked", true},
+		{"success: volumes not tracked when disabled", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+				params := chain.GetSimApp().TransferKeeper.GetParams(chain.GetContext())
+				params.VolumeTrackingEnabled = tc.volumeTrackingEnabled
+				chain.GetSimApp().TransferKeeper.SetParams(chain.GetContext(), params)
+			}
+
+			// send native tokens from chainA to chainB
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			transferMsg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			result, err := suite.chainA.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			// send half of the vouchers back from chainB to chainA
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			voucher := sdk.NewCoin(voucherDenom, sdkmath.NewInt(50))
+			transferMsg = types.NewMsgTransfer(
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher,
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainA.GetTimeoutHeight(), 0, "",
+			)
+			result, err = suite.chainB.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			packet, err = ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			volumeA := suite.chainA.GetSimApp().TransferKeeper.GetTransferVolume(suite.chainA.GetContext(), path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			volumeB := suite.chainB.GetSimApp().TransferKeeper.GetTransferVolume(suite.chainB.GetContext(), path.EndpointB.ChannelID, voucherDenom)
+
+			if tc.volumeTrackingEnabled {
+				suite.Require().Equal(sdkmath.NewInt(100), volumeA.Sent)
+				suite.Require().Equal(sdkmath.NewInt(50), volumeA.Received)
+				suite.Require().Equal(sdkmath.NewInt(50), volumeB.Sent)
+				suite.Require().Equal(sdkmath.NewInt(100), volumeB.Received)
+			} else {
+				suite.Require().Equal(types.NewTransferVolume(path.EndpointA.ChannelID, sdk.DefaultBondDenom), volumeA)
+				suite.Require().Equal(types.NewTransferVolume(path.EndpointB.ChannelID, voucherDenom), volumeB)
+				suite.Require().Empty(suite.chainA.GetSimApp().TransferKeeper.GetAllTransferVolumes(suite.chainA.GetContext()))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneTransferVolumes() {
+	suite.SetupTest() // reset
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	ctx := suite.chainA.GetContext()
+
+	params := transferKeeper.GetParams(ctx)
+	params.VolumeTrackingEnabled = true
+	transferKeeper.SetParams(ctx, params)
+
+	for _, channelID := range []string{ibctesting.FirstChannelID, "channel-1"} {
+		transferVolume := types.NewTransferVolume(channelID, sdk.DefaultBondDenom)
+		transferVolume.Sent = sdkmath.NewInt(100)
+		transferKeeper.SetTransferVolume(ctx, transferVolume)
+	}
+
+	res, err := transferKeeper.TransferVolumes(ctx, &types.QueryTransferVolumesRequest{ChannelId: ibctesting.FirstChannelID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TransferVolumes, 1)
+	suite.Require().Equal(sdkmath.NewInt(100), res.TransferVolumes[0].Sent)
+
+	// volumes of a single channel are pruned
+	transferKeeper.PruneTransferVolumes(ctx, ibctesting.FirstChannelID)
+
+	transferVolumes := transferKeeper.GetAllTransferVolumes(ctx)
+	suite.Require().Len(transferVolumes, 1)
+	suite.Require().Equal("channel-1", transferVolumes[0].ChannelId)
+	suite.Require().Equal([]string{"channel-1"}, transferKeeper.GetDenomChannels(ctx, sdk.DefaultBondDenom))
+
+	// all volumes are pruned when volume tracking is disabled
+	params.VolumeTrackingEnabled = false
+	_, err = transferKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(transferKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(transferKeeper.GetAllTransferVolumes(ctx))
+	suite.Require().Empty(transferKeeper.GetDenomChannels(ctx, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelsByDenom() {
+	var (
+		req                *types.QueryChannelsByDenomRequest
+		expTransferVolumes []types.TransferVolume
+	)
+
+	voucherTrace := types.ParseDenomTrace("transfer/channel-0/uatom")
+
+	// prefix sharing the first path segment of the voucher denomination
+	prefixDenom := "ibc"
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: native denomination",
+			func() {
+				req = &types.QueryChannelsByDenomRequest{Denom: sdk.DefaultBondDenom}
+				expTransferVolumes = []types.TransferVolume{volume(ibctesting.FirstChannelID, sdk.DefaultBondDenom), volume("channel-1", sdk.DefaultBondDenom)}
+			},
+			true,
+		},
+		{
+			"success: voucher denomination",
+			func() {
+				req = &types.QueryChannelsByDenomRequest{Denom: voucherTrace.IBCDenom()}
+				expTransferVolumes = []types.TransferVolume{volume("channel-1", voucherTrace.IBCDenom())}
+			},
+			true,
+		},
+		{
+			"success: full denomination trace path",
+			func() {
+				req = &types.QueryChannelsByDenomRequest{Denom: voucherTrace.GetFullDenomPath()}
+				expTransferVolumes = []types.TransferVolume{volume("channel-1", voucherTrace.IBCDenom())}
+			},
+			true,
+		},
+		{
+			"success: denomination prefix of another denomination",
+			func() {
+				req = &types.QueryChannelsByDenomRequest{Denom: prefixDenom}
+				expTransferVolumes = nil
+			},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req = &types.QueryChannelsByDenomRequest{
+					Denom:      sdk.DefaultBondDenom,
+					Pagination: &query.PageRequest{Limit: 1},
+				}
+				expTransferVolumes = []types.TransferVolume{volume(ibctesting.FirstChannelID, sdk.DefaultBondDenom)}
+			},
+			true,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"failure: invalid denomination",
+			func() {
+				req = &types.QueryChannelsByDenomRequest{Denom: "0atom"}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			ctx := suite.chainA.GetContext()
+
+			transferKeeper.SetTransferVolume(ctx, volume(ibctesting.FirstChannelID, sdk.DefaultBondDenom))
+			transferKeeper.SetTransferVolume(ctx, volume("channel-1", sdk.DefaultBondDenom))
+			transferKeeper.SetTransferVolume(ctx, volume("channel-1", voucherTrace.IBCDenom()))
+
+			tc.malleate()
+
+			res, err := transferKeeper.ChannelsByDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTransferVolumes, res.TransferVolumes)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// volume returns a transfer volume of the denomination over the channel with a non-zero sent amount.
+func volume(channelID, denom string) types.TransferVolume {
+	transferVolume := types.NewTransferVolume(channelID, denom)
+	transferVolume.Sent = sdkmath.NewInt(100)
+	return transferVolume
+}
@@ -136,6 +136,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.MigrateDenomMetadata); err != nil {
 		panic(fmt.Errorf("failed to migrate transfer app from version 4 to 5 (set denom metadata migration): %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.MigrateParamsLengthLimits); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 5 to 6 (params length limits migration): %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.MigrateDenomChannels); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 6 to 7 (denom channels index migration): %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.MigrateParamsChannelTimeoutDefaults); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 7 to 8 (channel timeout defaults params migration): %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-transfer module. It returns
@@ -154,7 +166,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion defining the current version of transfer.
-func (AppModule) ConsensusVersion() uint64 { return 5 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 // AppModuleSimulation functions
 
@@ -174,6 +186,6 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 }
 
 // WeightedOperations returns the all the transfer module operations with their respective weights.
-func (AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.keeper)
 }
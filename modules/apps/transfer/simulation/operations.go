@@ -0,0 +1,203 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgTransfer int = 100
+
+	OpWeightMsgTransfer = "op_weight_msg_transfer" // #nosec
+
+	// maxRelayDelay is the maximum number of blocks after which the mock relayer
+	// delivers an acknowledgement or timeout for a simulated transfer.
+	maxRelayDelay = 10
+)
+
+// WeightedOperations returns all the transfer module operations with their respective weights.
+func WeightedOperations(appParams simtypes.AppParams, txGen client.TxConfig, k keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgTransfer int
+	appParams.GetOrGenerate(OpWeightMsgTransfer, &weightMsgTransfer, nil,
+		func(_ *rand.Rand) { weightMsgTransfer = DefaultWeightMsgTransfer },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgTransfer,
+			SimulateMsgTransfer(txGen, k),
+		),
+	}
+}
+
+// SimulateMsgTransfer generates a MsgTransfer with a random sender, amount, open transfer
+// channel and timeout, and delivers it. On success a future operation is scheduled which
+// acts as a mock relayer, delivering either a successful acknowledgement, an error
+// acknowledgement or a timeout for the sent packet to the transfer application.
+func SimulateMsgTransfer(txGen client.TxConfig, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTransfer{})
+
+		channels := openTransferChannels(ctx, k)
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open transfer channels"), nil, nil
+		}
+		channel := channels[r.Intn(len(channels))]
+
+		if !k.GetParams(ctx).IsSendEnabled(channel.ChannelId) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "send is disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := k.GetAccountKeeper().GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender account not found"), nil, nil
+		}
+
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, simAccount.Address)
+		if spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender has no spendable coins"), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, nil
+		}
+		token := sdk.NewCoin(coin.Denom, amount)
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(token))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		timeoutHeight, timeoutTimestamp := clienttypes.ZeroHeight(), randomTimeoutTimestamp(r, ctx)
+
+		msg := types.NewMsgTransfer(
+			channel.PortId, channel.ChannelId, token, simAccount.Address.String(), receiver.Address.String(),
+			timeoutHeight, timeoutTimestamp, simtypes.RandStringOfLength(r, r.Intn(32)),
+		)
+
+		sequence, found := k.GetChannelKeeper().GetNextSequenceSend(ctx, channel.PortId, channel.ChannelId)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "next sequence send not found"), nil, nil
+		}
+
+		fullDenomPath := token.Denom
+		if strings.HasPrefix(token.Denom, types.DenomPrefix+"/") {
+			fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "denom trace not found"), nil, nil
+			}
+		}
+
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+		}
+
+		if _, _, err := app.SimDeliver(txGen.TxEncoder(), tx); err != nil {
+			// transfers may legitimately fail, e.g. for non-transferable denominations
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		packetData := types.NewFungibleTokenPacketData(fullDenomPath, token.Amount.String(), msg.Sender, msg.Receiver, msg.Memo)
+		packet := channeltypes.NewPacket(
+			packetData.GetBytes(), sequence, channel.PortId, channel.ChannelId,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, timeoutHeight, timeoutTimestamp,
+		)
+
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(maxRelayDelay),
+				Op:          SimulateRelayPacket(k, packet, packetData),
+			},
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), futureOps, nil
+	}
+}
+
+// SimulateRelayPacket returns an operation which acts as a mock relayer for a packet
+// previously sent by the transfer module. As there is no counterparty chain in simulations,
+// the operation randomly delivers a successful acknowledgement, an error acknowledgement or
+// a timeout directly to the transfer application, exercising the refund logic.
+func SimulateRelayPacket(k keeper.Keeper, packet channeltypes.Packet, data types.FungibleTokenPacketData) simtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			route string
+			err   error
+		)
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		switch r.Intn(3) {
+		case 0:
+			route = "timeout_packet"
+			err = k.OnTimeoutPacket(cacheCtx, packet, data)
+		case 1:
+			route = "acknowledge_packet_error"
+			err = k.OnAcknowledgementPacket(cacheCtx, packet, data, channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled))
+		default:
+			route = "acknowledge_packet_success"
+			err = k.OnAcknowledgementPacket(cacheCtx, packet, data, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+		}
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, route, err.Error()), nil, nil
+		}
+
+		writeFn()
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, route, "", true, nil), nil, nil
+	}
+}
+
+// openTransferChannels returns all OPEN channels bound to the transfer module port.
+func openTransferChannels(ctx sdk.Context, k keeper.Keeper) []channeltypes.IdentifiedChannel {
+	portID := k.GetPort(ctx)
+
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range k.GetChannelKeeper().GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId == portID && channel.State == channeltypes.OPEN {
+			channels = append(channels, channel)
+		}
+	}
+
+	return channels
+}
+
+// randomTimeoutTimestamp returns a random timeout timestamp relative to the current block time.
+// Timeout heights are not used as the height of the counterparty chain is unknown to the simulation.
+func randomTimeoutTimestamp(r *rand.Rand, ctx sdk.Context) uint64 {
+	timeout := time.Duration(1+r.Intn(3600)) * time.Second
+	return uint64(ctx.BlockTime().Add(timeout).UnixNano())
+}
@@ -0,0 +1,107 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/simulation"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+type SimulationTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *SimulationTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestSimulationTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(SimulationTestSuite))
+}
+
+// simAccounts returns the sender accounts of the given test chain as simulation accounts.
+func simAccounts(chain *ibctesting.TestChain) []simtypes.Account {
+	accounts := make([]simtypes.Account, len(chain.SenderAccounts))
+	for i, senderAccount := range chain.SenderAccounts {
+		accounts[i] = simtypes.Account{
+			PrivKey: senderAccount.SenderPrivKey,
+			PubKey:  senderAccount.SenderPrivKey.PubKey(),
+			Address: senderAccount.SenderAccount.GetAddress(),
+		}
+	}
+
+	return accounts
+}
+
+// beginBlock starts a new block on chainA without committing it, allowing transactions
+// to be delivered through the simulation operations. The returned context reads from
+// and writes to the state of the uncommitted block.
+func (suite *SimulationTestSuite) beginBlock() sdk.Context {
+	_, err := suite.chainA.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: suite.chainA.ProposedHeader.Height,
+		Time:   suite.chainA.ProposedHeader.GetTime(),
+	})
+	suite.Require().NoError(err)
+
+	return suite.chainA.App.GetBaseApp().NewContext(false)
+}
+
+func (suite *SimulationTestSuite) TestWeightedOperations() {
+	weightedOps := simulation.WeightedOperations(make(simtypes.AppParams), suite.chainA.TxConfig, suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().Len(weightedOps, 1)
+	suite.Require().Equal(simulation.DefaultWeightMsgTransfer, weightedOps[0].Weight())
+}
+
+func (suite *SimulationTestSuite) TestSimulateMsgTransfer() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	ctx := suite.beginBlock()
+
+	r := rand.New(rand.NewSource(1))
+	op := simulation.SimulateMsgTransfer(suite.chainA.TxConfig, suite.chainA.GetSimApp().TransferKeeper)
+
+	operationMsg, futureOps, err := op(r, suite.chainA.App.GetBaseApp(), ctx, simAccounts(suite.chainA), suite.chainA.ChainID)
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgTransfer{}), operationMsg.Name)
+	suite.Require().Len(futureOps, 1)
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().True(escrowBalance.IsPositive())
+
+	// the mock relayer delivers an acknowledgement or timeout for the sent packet
+	operationMsg, _, err = futureOps[0].Op(r, suite.chainA.App.GetBaseApp(), ctx, simAccounts(suite.chainA), suite.chainA.ChainID)
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK, operationMsg.Comment)
+	suite.Require().Equal(types.ModuleName, operationMsg.Route)
+}
+
+func (suite *SimulationTestSuite) TestSimulateMsgTransferNoChannels() {
+	ctx := suite.beginBlock()
+
+	r := rand.New(rand.NewSource(1))
+	op := simulation.SimulateMsgTransfer(suite.chainA.TxConfig, suite.chainA.GetSimApp().TransferKeeper)
+
+	operationMsg, futureOps, err := op(r, suite.chainA.App.GetBaseApp(), ctx, simAccounts(suite.chainA), suite.chainA.ChainID)
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+	suite.Require().Empty(futureOps)
+}
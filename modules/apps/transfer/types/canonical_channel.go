@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewCanonicalChannel creates a new CanonicalChannel instance.
+func NewCanonicalChannel(chainID, channelID string) CanonicalChannel {
+	return CanonicalChannel{
+		ChainId:   chainID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the CanonicalChannel fields. An empty channel
+// identifier is valid, as it is used to remove the canonical channel of a counterparty chain.
+func (cc CanonicalChannel) Validate() error {
+	if strings.TrimSpace(cc.ChainId) == "" {
+		return errorsmod.Wrap(ErrInvalidCanonicalChannel, "chain identifier cannot be blank")
+	}
+
+	if cc.IsEmpty() {
+		return nil
+	}
+
+	return host.ChannelIdentifierValidator(cc.ChannelId)
+}
+
+// IsEmpty returns true if the canonical channel has no channel identifier.
+func (cc CanonicalChannel) IsEmpty() bool {
+	return cc.ChannelId == ""
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestCanonicalChannelValidate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		canonicalChannel types.CanonicalChannel
+		expErr           error
+	}{
+		{"success: canonical channel", types.NewCanonicalChannel("cosmoshub-4", ibctesting.FirstChannelID), nil},
+		{"success: empty channel identifier", types.NewCanonicalChannel("cosmoshub-4", ""), nil},
+		{"failure: blank chain identifier", types.NewCanonicalChannel(" ", ibctesting.FirstChannelID), types.ErrInvalidCanonicalChannel},
+		{"failure: invalid channel identifier", types.NewCanonicalChannel("cosmoshub-4", "channel"), host.ErrInvalidID},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.canonicalChannel.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxDecimals defines the maximum number of decimals of a token supported by a decimal conversion.
+const MaxDecimals = 36
+
+// NewDecimalConversion creates a new DecimalConversion instance.
+func NewDecimalConversion(channelID, denom string, localDecimals, counterpartyDecimals uint32, dustHandling DustHandling) DecimalConversion {
+	return DecimalConversion{
+		ChannelId:            channelID,
+		Denom:                denom,
+		LocalDecimals:        localDecimals,
+		CounterpartyDecimals: counterpartyDecimals,
+		DustHandling:         dustHandling,
+	}
+}
+
+// Validate performs a basic validation of the DecimalConversion fields.
+func (dc DecimalConversion) Validate() error {
+	if err := host.ChannelIdentifierValidator(dc.ChannelId); err != nil {
+		return err
+	}
+
+	if err := ValidatePrefixedDenom(dc.Denom); err != nil {
+		return err
+	}
+
+	if dc.LocalDecimals > MaxDecimals || dc.CounterpartyDecimals > MaxDecimals {
+		return errorsmod.Wrapf(ErrInvalidDecimalConversion, "decimals must not exceed %d", MaxDecimals)
+	}
+
+	if dc.DustHandling != REJECT && dc.DustHandling != TRUNCATE {
+		return errorsmod.Wrapf(ErrInvalidDecimalConversion, "invalid dust handling %s", dc.DustHandling)
+	}
+
+	return nil
+}
+
+// NewPacketLocalAmount creates a new PacketLocalAmount instance.
+func NewPacketLocalAmount(portID, channelID string, sequence uint64, amount sdkmath.Int) PacketLocalAmount {
+	return PacketLocalAmount{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Amount:    amount.String(),
+	}
+}
+
+// Validate performs a basic validation of the PacketLocalAmount fields.
+func (pla PacketLocalAmount) Validate() error {
+	if err := host.PortIdentifierValidator(pla.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(pla.ChannelId); err != nil {
+		return err
+	}
+
+	if pla.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidDecimalConversion, "packet sequence cannot be 0")
+	}
+
+	if _, err := pla.ParseAmount(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ParseAmount returns the local amount of the packet, which must be positive.
+func (pla PacketLocalAmount) ParseAmount() (sdkmath.Int, error) {
+	amount, ok := sdkmath.NewIntFromString(pla.Amount)
+	if !ok || !amount.IsPositive() {
+		return sdkmath.Int{}, errorsmod.Wrapf(ErrInvalidAmount, "invalid local amount %s of packet %d over %s", pla.Amount, pla.Sequence, pla.ChannelId)
+	}
+
+	return amount, nil
+}
+
+// IsIdentity returns true if the local and counterparty decimals are equal, in which case
+// amounts are transferred unchanged.
+func (dc DecimalConversion) IsIdentity() bool {
+	return dc.LocalDecimals == dc.CounterpartyDecimals
+}
+
+// ToCounterpartyAmount converts an amount in the precision of this chain to the precision of
+// the counterparty chain. It returns the converted amount together with the amount in local
+// precision that is actually transferred, which excludes any truncated remainder.
+func (dc DecimalConversion) ToCounterpartyAmount(amount sdkmath.Int) (sdkmath.Int, sdkmath.Int, error) {
+	converted, err := dc.scale(amount, dc.LocalDecimals, dc.CounterpartyDecimals)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+
+	transferred, err := dc.scale(converted, dc.CounterpartyDecimals, dc.LocalDecimals)
+	if err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+
+	return converted, transferred, nil
+}
+
+// ToLocalAmount converts an amount in the precision of the counterparty chain to the precision
+// of this chain. Amounts of packets sent by this chain always convert back without remainder.
+func (dc DecimalConversion) ToLocalAmount(amount sdkmath.Int) (sdkmath.Int, error) {
+	return dc.scale(amount, dc.CounterpartyDecimals, dc.LocalDecimals)
+}
+
+// scale converts the amount from the source to the destination number of decimals, applying
+// the dust handling rule to any remainder. An error is returned if the converted amount is zero
+// or overflows.
+func (dc DecimalConversion) scale(amount sdkmath.Int, fromDecimals, toDecimals uint32) (sdkmath.Int, error) {
+	if fromDecimals <= toDecimals {
+		converted, err := amount.SafeMul(pow10(toDecimals - fromDecimals))
+		if err != nil {
+			return sdkmath.Int{}, errorsmod.Wrapf(ErrInvalidAmount, "amount %s overflows when converting from %d to %d decimals: %s", amount, fromDecimals, toDecimals, err)
+		}
+
+		return converted, nil
+	}
+
+	factor := pow10(fromDecimals - toDecimals)
+	if dust := amount.Mod(factor); !dust.IsZero() && dc.DustHandling != TRUNCATE {
+		return sdkmath.Int{}, errorsmod.Wrapf(ErrDecimalConversionDust, "amount %s leaves remainder %s when converting from %d to %d decimals", amount, dust, fromDecimals, toDecimals)
+	}
+
+	converted := amount.Quo(factor)
+	if !converted.IsPositive() {
+		return sdkmath.Int{}, errorsmod.Wrapf(ErrInvalidAmount, "amount %s converts to zero when converting from %d to %d decimals", amount, fromDecimals, toDecimals)
+	}
+
+	return converted, nil
+}
+
+// pow10 returns ten to the power of the provided exponent.
+func pow10(exponent uint32) sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil))
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestDecimalConversionValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conversion types.DecimalConversion
+		expErr     error
+	}{
+		{"success: native denom", types.NewDecimalConversion(ibctesting.FirstChannelID, "uatom", 6, 18, types.REJECT), nil},
+		{"success: denom trace path", types.NewDecimalConversion(ibctesting.FirstChannelID, "transfer/channel-1/wei", 18, 6, types.TRUNCATE), nil},
+		{"failure: invalid channel identifier", types.NewDecimalConversion("", "uatom", 6, 18, types.REJECT), host.ErrInvalidID},
+		{"failure: invalid denom", types.NewDecimalConversion(ibctesting.FirstChannelID, "", 6, 18, types.REJECT), types.ErrInvalidDenomForTransfer},
+		{"failure: too many decimals", types.NewDecimalConversion(ibctesting.FirstChannelID, "uatom", 6, types.MaxDecimals+1, types.REJECT), types.ErrInvalidDecimalConversion},
+		{"failure: unspecified dust handling", types.NewDecimalConversion(ibctesting.FirstChannelID, "uatom", 6, 18, types.UNSPECIFIED), types.ErrInvalidDecimalConversion},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conversion.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestDecimalConversionAmounts(t *testing.T) {
+	testCases := []struct {
+		name           string
+		conversion     types.DecimalConversion
+		amount         sdkmath.Int
+		expCounterpart sdkmath.Int
+		expTransferred sdkmath.Int
+		expErr         error
+	}{
+		{
+			"success: scale down without dust",
+			types.NewDecimalConversion(ibctesting.FirstChannelID, "wei", 18, 6, types.REJECT),
+			sdkmath.NewInt(3_000_000_000_000), sdkmath.NewInt(3), sdkmath.NewInt(3_000_000_000_000), nil,
+		},
+		{
+			"success: scale down with dust truncated",
+			types.NewDecimalConversion(ibctesting.FirstChannelID, "wei", 18, 6, types.TRUNCATE),
+			sdkmath.NewInt(3_000_000_000_001), sdkmath.NewInt(3), sdkmath.NewInt(3_000_000_000_000), nil,
+		},
+		{
+			"success: scale up",
+			types.NewDecimalConversion(ibctesting.FirstChannelID, "uatom", 6, 18, types.REJECT),
+			sdkmath.NewInt(3), sdkmath.NewInt(3_000_000_000_000), sdkmath.NewInt(3), nil,
+		},
+		{
+			"failure: scale down with dust rejected",
+			types.NewDecimalConversion(ibctesting.FirstChannelID, "wei", 18, 6, types.REJECT),
+			sdkmath.NewInt(3_000_000_000_001), sdkmath.Int{}, sdkmath.Int{}, types.ErrDecimalConversionDust,
+		},
+		{
+			"failure: amount truncated to zero",
+			types.NewDecimalConversion(ibctesting.FirstChannelID, "wei", 18, 6, types.TRUNCATE),
+			sdkmath.NewInt(999_999_999_999), sdkmath.Int{}, sdkmath.Int{}, types.ErrInvalidAmount,
+		},
+		{
+			"failure: scaled amount overflows",
+			types.NewDecimalConversion(ibctesting.FirstChannelID, "uatom", 0, types.MaxDecimals, types.REJECT),
+			sdkmath.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(50), nil)), sdkmath.Int{}, sdkmath.Int{}, types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			counterpartyAmount, transferred, err := tc.conversion.ToCounterpartyAmount(tc.amount)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expCounterpart, counterpartyAmount)
+			require.Equal(t, tc.expTransferred, transferred)
+
+			// amounts sent by this chain always convert back without remainder
+			localAmount, err := tc.conversion.ToLocalAmount(counterpartyAmount)
+			require.NoError(t, err)
+			require.Equal(t, transferred, localAmount)
+		})
+	}
+}
@@ -6,14 +6,22 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout    = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidDenomForTransfer = errorsmod.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
-	ErrInvalidVersion          = errorsmod.Register(ModuleName, 4, "invalid ICS20 version")
-	ErrInvalidAmount           = errorsmod.Register(ModuleName, 5, "invalid token amount")
-	ErrTraceNotFound           = errorsmod.Register(ModuleName, 6, "denomination trace not found")
-	ErrSendDisabled            = errorsmod.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled         = errorsmod.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
-	ErrMaxTransferChannels     = errorsmod.Register(ModuleName, 9, "max transfer channels")
-	ErrInvalidAuthorization    = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
-	ErrInvalidMemo             = errorsmod.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidPacketTimeout     = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidDenomForTransfer  = errorsmod.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
+	ErrInvalidVersion           = errorsmod.Register(ModuleName, 4, "invalid ICS20 version")
+	ErrInvalidAmount            = errorsmod.Register(ModuleName, 5, "invalid token amount")
+	ErrTraceNotFound            = errorsmod.Register(ModuleName, 6, "denomination trace not found")
+	ErrSendDisabled             = errorsmod.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled          = errorsmod.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels      = errorsmod.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidAuthorization     = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
+	ErrInvalidMemo              = errorsmod.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidTokenMetadata     = errorsmod.Register(ModuleName, 12, "invalid token metadata")
+	ErrInvalidReceiver          = errorsmod.Register(ModuleName, 13, "invalid receiver address")
+	ErrSendRestricted           = errorsmod.Register(ModuleName, 14, "fungible token transfer restricted")
+	ErrInvalidDecimalConversion = errorsmod.Register(ModuleName, 15, "invalid decimal conversion")
+	ErrDecimalConversionDust    = errorsmod.Register(ModuleName, 16, "amount cannot be converted without remainder")
+	ErrInvalidCanonicalChannel  = errorsmod.Register(ModuleName, 17, "invalid canonical channel")
+	ErrCanonicalChannelNotFound = errorsmod.Register(ModuleName, 18, "canonical channel not found")
+	ErrNonCanonicalChannel      = errorsmod.Register(ModuleName, 19, "transfer channel is not the canonical channel of the counterparty chain")
 )
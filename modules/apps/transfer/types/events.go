@@ -2,11 +2,15 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeTimeout        = "timeout"
+	EventTypePacket         = "fungible_token_packet"
+	EventTypeTransfer       = "ibc_transfer"
+	EventTypeChannelClose   = "channel_closed"
+	EventTypeDenomTrace     = "denomination_trace"
+	EventTypeEscrowRelease  = "escrow_released"
+	EventTypeVoucherBurn    = "voucher_burned"
+	EventTypeDenomRename    = "denomination_renamed"
+	EventTypeConversionSkip = "voucher_conversion_skipped"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -19,4 +23,9 @@ const (
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
 	AttributeKeyMemo           = "memo"
+	AttributeKeyEscrowAddress  = "escrow_address"
+	AttributeKeyOldDenom       = "old_denom"
+	AttributeKeyNewDenom       = "new_denom"
+	AttributeKeyHolder         = "holder"
+	AttributeKeyError          = "error"
 )
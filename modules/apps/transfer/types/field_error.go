@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// Field names of the ICS-20 packet data reported in packet data field errors.
+const (
+	FieldData     = "data"
+	FieldDenom    = "denom"
+	FieldAmount   = "amount"
+	FieldSender   = "sender"
+	FieldReceiver = "receiver"
+	FieldMemo     = "memo"
+	FieldMetadata = "metadata"
+)
+
+// ackFieldErrorString defines the format of the string included in error acknowledgements written
+// for packet data field errors.
+// NOTE: Changing this const is state machine breaking as acknowledgements are written into state.
+const ackFieldErrorString = "ABCI code: %d: error handling packet: invalid packet data field %s: %s"
+
+var _ error = (*PacketDataFieldError)(nil)
+
+// PacketDataFieldError is an error describing which field of the ICS-20 packet data is invalid and why.
+// The field and reason are included in the error acknowledgement written for the packet and must therefore
+// be deterministic, while the wrapped error is only emitted in events and logs.
+type PacketDataFieldError struct {
+	Field  string
+	Reason string
+
+	err error
+}
+
+// NewPacketDataFieldError returns a new PacketDataFieldError wrapping the provided error.
+func NewPacketDataFieldError(err error, field, reason string) error {
+	return &PacketDataFieldError{
+		Field:  field,
+		Reason: reason,
+		err:    err,
+	}
+}
+
+// NewPacketDataUnmarshalError returns a PacketDataFieldError for an error returned when unmarshalling the
+// ICS-20 packet data. If a field of the packet data has an unexpected JSON type, the error reports that field.
+func NewPacketDataUnmarshalError(err error) error {
+	ackErr := errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return NewPacketDataFieldError(ackErr, typeErr.Field, "unexpected JSON type")
+	}
+
+	return NewPacketDataFieldError(ackErr, FieldData, "malformed JSON")
+}
+
+// Error implements the error interface.
+func (e *PacketDataFieldError) Error() string {
+	return fmt.Sprintf("invalid packet data field %s: %s: %s", e.Field, e.Reason, e.err)
+}
+
+// Unwrap returns the wrapped error.
+func (e *PacketDataFieldError) Unwrap() error {
+	return e.err
+}
+
+// Cause returns the wrapped error, allowing the ABCI code of the wrapped error to be retrieved.
+func (e *PacketDataFieldError) Cause() error {
+	return e.err
+}
+
+// NewErrorAcknowledgement returns an error acknowledgement for an error returned when receiving an ICS-20 packet.
+// If the error is caused by an invalid field of the packet data, the field and the reason it is invalid are included
+// in the acknowledgement. Otherwise, the acknowledgement is constructed by channeltypes.NewErrorAcknowledgement.
+func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+	var fieldErr *PacketDataFieldError
+	if !errors.As(err, &fieldErr) {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the ABCI code is included in the abcitypes.ResponseDeliverTx hash
+	// constructed in Tendermint and is therefore deterministic
+	_, code, _ := errorsmod.ABCIInfo(err, false) // discard non-deterministic codespace and log values
+
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: fmt.Sprintf(ackFieldErrorString, code, fieldErr.Field, fieldErr.Reason),
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+func TestPacketDataFieldErrors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		expField  string
+		expReason string
+		expErr    error
+	}{
+		{
+			"malformed amount",
+			types.NewFungibleTokenPacketData(denom, "abc", sender, receiver, "").ValidateBasic(),
+			types.FieldAmount,
+			"cannot be parsed as an integer",
+			types.ErrInvalidAmount,
+		},
+		{
+			"negative amount",
+			types.NewFungibleTokenPacketData(denom, "-1", sender, receiver, "").ValidateBasic(),
+			types.FieldAmount,
+			"must not be negative",
+			types.ErrInvalidAmount,
+		},
+		{
+			"blank sender",
+			types.NewFungibleTokenPacketData(denom, amount, emptyAddr, receiver, "").ValidateBasic(),
+			types.FieldSender,
+			"cannot be blank",
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"blank receiver",
+			types.NewFungibleTokenPacketData(denom, amount, sender, emptyAddr, "").ValidateBasic(),
+			types.FieldReceiver,
+			"cannot be blank",
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"malformed denom trace",
+			types.NewFungibleTokenPacketData("transfer/channel-0/", amount, sender, receiver, "").ValidateBasic(),
+			types.FieldDenom,
+			"malformed denomination trace",
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"invalid token metadata",
+			withTokenMetadata(types.NewFungibleTokenPacketData(denom, amount, sender, receiver, ""), types.NewTokenMetadata(6, " ", "atom")).ValidateBasic(),
+			types.FieldMetadata,
+			"invalid token metadata",
+			types.ErrInvalidTokenMetadata,
+		},
+		{
+			"memo exceeds maximum length",
+			types.NewParams(true, true).ValidateReceiverAndMemo(receiver, string(make([]byte, types.MaximumMemoLength+1))),
+			types.FieldMemo,
+			"exceeds maximum length",
+			types.ErrInvalidMemo,
+		},
+		{
+			"unexpected JSON type",
+			types.NewPacketDataUnmarshalError(json.Unmarshal([]byte(`{"amount":100}`), &types.FungibleTokenPacketData{})),
+			types.FieldAmount,
+			"unexpected JSON type",
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"malformed JSON",
+			types.NewPacketDataUnmarshalError(json.Unmarshal([]byte(`{"amount":`), &types.FungibleTokenPacketData{})),
+			types.FieldData,
+			"malformed JSON",
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var fieldErr *types.PacketDataFieldError
+			require.ErrorAs(t, tc.err, &fieldErr)
+			require.Equal(t, tc.expField, fieldErr.Field)
+			require.Equal(t, tc.expReason, fieldErr.Reason)
+			require.ErrorIs(t, tc.err, tc.expErr)
+
+			ack := types.NewErrorAcknowledgement(tc.err)
+			require.False(t, ack.Success())
+			require.NoError(t, ack.ValidateBasic())
+			require.Contains(t, ack.GetError(), "invalid packet data field "+tc.expField+": "+tc.expReason)
+
+			// the ABCI code of the wrapped error is included in the acknowledgement
+			_, code, _ := errorsmod.ABCIInfo(tc.expErr, false)
+			require.True(t, strings.HasPrefix(ack.GetError(), fmt.Sprintf("ABCI code: %d: ", code)))
+		})
+	}
+}
+
+func TestNewErrorAcknowledgementWithoutFieldError(t *testing.T) {
+	require.Equal(t, channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled), types.NewErrorAcknowledgement(types.ErrReceiveDisabled))
+}
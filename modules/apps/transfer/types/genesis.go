@@ -1,6 +1,8 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -35,5 +37,51 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	for _, transferVolume := range gs.TransferVolumes {
+		if err := transferVolume.Validate(); err != nil {
+			return err
+		}
+	}
+	seenConversions := make(map[string]bool)
+	for _, conversion := range gs.DecimalConversions {
+		if err := conversion.Validate(); err != nil {
+			return err
+		}
+		if conversion.IsIdentity() {
+			return errorsmod.Wrapf(ErrInvalidDecimalConversion, "decimal conversion of %s over %s must have differing decimals", conversion.Denom, conversion.ChannelId)
+		}
+		key := string(DecimalConversionKey(conversion.ChannelId, conversion.Denom))
+		if seenConversions[key] {
+			return errorsmod.Wrapf(ErrInvalidDecimalConversion, "duplicate decimal conversion of %s over %s", conversion.Denom, conversion.ChannelId)
+		}
+		seenConversions[key] = true
+	}
+	seenPackets := make(map[string]bool)
+	for _, packetLocalAmount := range gs.PacketLocalAmounts {
+		if err := packetLocalAmount.Validate(); err != nil {
+			return err
+		}
+		key := string(PacketLocalAmountKey(packetLocalAmount.PortId, packetLocalAmount.ChannelId, packetLocalAmount.Sequence))
+		if seenPackets[key] {
+			return errorsmod.Wrapf(ErrInvalidAmount, "duplicate local amount of packet %d over %s", packetLocalAmount.Sequence, packetLocalAmount.ChannelId)
+		}
+		seenPackets[key] = true
+	}
+	seenChainIDs := make(map[string]bool)
+	for _, canonicalChannel := range gs.CanonicalChannels {
+		if err := canonicalChannel.Validate(); err != nil {
+			return err
+		}
+		if canonicalChannel.IsEmpty() {
+			return errorsmod.Wrapf(ErrInvalidCanonicalChannel, "canonical channel of %s must have a channel identifier", canonicalChannel.ChainId)
+		}
+		if seenChainIDs[canonicalChannel.ChainId] {
+			return errorsmod.Wrapf(ErrInvalidCanonicalChannel, "duplicate canonical channel of %s", canonicalChannel.ChainId)
+		}
+		seenChainIDs[canonicalChannel.ChainId] = true
+	}
 	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
 }
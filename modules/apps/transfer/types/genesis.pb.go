@@ -33,6 +33,14 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// transfer_volumes contains the tracked cumulative transfer volumes
+	TransferVolumes []TransferVolume `protobuf:"bytes,5,rep,name=transfer_volumes,json=transferVolumes,proto3" json:"transfer_volumes"`
+	// decimal_conversions contains the decimal conversions of denominations transferred over channels
+	DecimalConversions []DecimalConversion `protobuf:"bytes,6,rep,name=decimal_conversions,json=decimalConversions,proto3" json:"decimal_conversions"`
+	// packet_local_amounts contains the local amounts of in-flight packets sent over channels with a decimal conversion
+	PacketLocalAmounts []PacketLocalAmount `protobuf:"bytes,7,rep,name=packet_local_amounts,json=packetLocalAmounts,proto3" json:"packet_local_amounts"`
+	// canonical_channels contains the channels registered as canonical per counterparty chain
+	CanonicalChannels []CanonicalChannel `protobuf:"bytes,8,rep,name=canonical_channels,json=canonicalChannels,proto3" json:"canonical_channels"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +104,34 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetTransferVolumes() []TransferVolume {
+	if m != nil {
+		return m.TransferVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDecimalConversions() []DecimalConversion {
+	if m != nil {
+		return m.DecimalConversions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketLocalAmounts() []PacketLocalAmount {
+	if m != nil {
+		return m.PacketLocalAmounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCanonicalChannels() []CanonicalChannel {
+	if m != nil {
+		return m.CanonicalChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -105,31 +141,39 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xb5, 0x93, 0xc8, 0x08, 0x27, 0xa4, 0xb0, 0x90, 0x30, 0x11, 0x72, 0x22, 0x44, 0x61, 0x81,
-	0xb2, 0x8b, 0x43, 0x01, 0xb5, 0x01, 0x21, 0x3a, 0x30, 0x54, 0x50, 0x44, 0xeb, 0xf5, 0x62, 0x56,
-	0xc4, 0x1e, 0x6b, 0x67, 0x63, 0x74, 0x7f, 0x71, 0xdf, 0x71, 0xdf, 0x70, 0x1f, 0x90, 0x32, 0xe5,
-	0x55, 0x77, 0xa7, 0xe4, 0x47, 0x4e, 0x5e, 0xfb, 0xa2, 0x48, 0x27, 0xa5, 0xf2, 0x8c, 0xe7, 0xbd,
-	0x37, 0xb3, 0xef, 0xb9, 0xaf, 0x65, 0xca, 0x29, 0xab, 0xaa, 0x95, 0xe4, 0x4c, 0x4b, 0x28, 0x91,
-	0x6a, 0xc5, 0x4a, 0xfc, 0x23, 0x14, 0xad, 0x23, 0x9a, 0x8b, 0x52, 0xa0, 0x44, 0x52, 0x29, 0xd0,
-	0xe0, 0xbd, 0x90, 0x29, 0x27, 0xc7, 0x58, 0x72, 0x8f, 0x25, 0x75, 0x34, 0x79, 0x73, 0x52, 0xe9,
-	0x80, 0x34, 0x52, 0x93, 0x80, 0x03, 0x16, 0x80, 0x34, 0x65, 0x28, 0x68, 0x1d, 0xa5, 0x42, 0xb3,
-	0x88, 0x72, 0x90, 0x65, 0x37, 0x7f, 0x9a, 0x43, 0x0e, 0xa6, 0xa4, 0x4d, 0xd5, 0xfe, 0x7d, 0x79,
-	0xd9, 0x73, 0x47, 0x5f, 0xda, 0x93, 0x7e, 0x68, 0xa6, 0x85, 0xf7, 0xcc, 0x7d, 0x54, 0x81, 0xd2,
-	0x4b, 0x99, 0xf9, 0xf6, 0xcc, 0x0e, 0x1f, 0x27, 0x4e, 0xd3, 0x7e, 0xcd, 0xbc, 0xdf, 0xee, 0x28,
-	0x13, 0x25, 0x14, 0x4b, 0xad, 0x18, 0x17, 0xe8, 0xf7, 0x66, 0xfd, 0x70, 0xb8, 0x08, 0xc9, 0xa9,
-	0x17, 0x90, 0x4f, 0x0d, 0xe3, 0x67, 0x43, 0x88, 0xc7, 0x9b, 0xeb, 0xa9, 0x75, 0x71, 0x33, 0x75,
-	0x4c, 0x8b, 0xc9, 0x30, 0x3b, 0xcc, 0xd0, 0x8b, 0x5d, 0xa7, 0x62, 0x8a, 0x15, 0xe8, 0xf7, 0x67,
-	0x76, 0x38, 0x5c, 0xbc, 0x3a, 0x2d, 0xfb, 0xcd, 0x60, 0xe3, 0x41, 0x23, 0x99, 0x74, 0x4c, 0x4f,
-	0xb9, 0x63, 0x0d, 0x9a, 0xad, 0x96, 0x02, 0xb9, 0x82, 0xff, 0x22, 0xf3, 0x07, 0xe6, 0xc4, 0xe7,
-	0xa4, 0x75, 0x86, 0x34, 0xce, 0x90, 0xce, 0x19, 0xf2, 0x11, 0x64, 0x19, 0xbf, 0xed, 0x6e, 0x0a,
-	0x73, 0xa9, 0xff, 0xae, 0x53, 0xc2, 0xa1, 0xa0, 0x9d, 0x8d, 0xed, 0x67, 0x8e, 0xd9, 0x3f, 0xaa,
-	0xcf, 0x2a, 0x81, 0x86, 0x80, 0xc9, 0x13, 0xb3, 0xe2, 0x73, 0xb7, 0x21, 0xfe, 0xbe, 0xd9, 0x05,
-	0xf6, 0x76, 0x17, 0xd8, 0xb7, 0xbb, 0xc0, 0x3e, 0xdf, 0x07, 0xd6, 0x76, 0x1f, 0x58, 0x57, 0xfb,
-	0xc0, 0xfa, 0xf5, 0xfe, 0xa1, 0xa4, 0x4c, 0xf9, 0x3c, 0x07, 0x5a, 0x7f, 0xa0, 0x05, 0x64, 0xeb,
-	0x95, 0xc0, 0x26, 0xdb, 0xa3, 0x4c, 0xcd, 0x9e, 0xd4, 0x31, 0xc1, 0xbc, 0xbb, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xfe, 0xf0, 0xde, 0x54, 0x47, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0xda, 0xa6, 0xe0, 0x94, 0x02, 0x4b, 0x25, 0x4c, 0x85, 0xdc, 0x08, 0x71, 0x88,
+	0x80, 0xee, 0x92, 0x72, 0x80, 0x2b, 0x09, 0x08, 0x21, 0x71, 0x28, 0xa1, 0xe2, 0x00, 0x42, 0xd6,
+	0x7a, 0xbd, 0x75, 0x57, 0xb5, 0x77, 0x2c, 0xcf, 0xc6, 0x88, 0xb7, 0xe0, 0x39, 0x78, 0x92, 0x1e,
+	0xcb, 0x8d, 0x13, 0xa0, 0xe4, 0x45, 0xd0, 0x6e, 0x36, 0x51, 0x09, 0x52, 0xe0, 0xe4, 0xf1, 0xcc,
+	0x7c, 0xf3, 0xcf, 0xcc, 0x6a, 0xc2, 0xfb, 0x2a, 0x15, 0x8c, 0x57, 0x55, 0xa1, 0x04, 0x37, 0x0a,
+	0x34, 0x32, 0x53, 0x73, 0x8d, 0xc7, 0xb2, 0x66, 0x4d, 0x9f, 0xe5, 0x52, 0x4b, 0x54, 0x48, 0xab,
+	0x1a, 0x0c, 0x90, 0x3b, 0x2a, 0x15, 0xf4, 0x62, 0x2e, 0x9d, 0xe7, 0xd2, 0xa6, 0xbf, 0xfb, 0x60,
+	0x65, 0xa5, 0x45, 0xa6, 0x2b, 0xb5, 0x1b, 0x0b, 0xc0, 0x12, 0x90, 0xa5, 0x1c, 0x25, 0x6b, 0xfa,
+	0xa9, 0x34, 0xbc, 0xcf, 0x04, 0x28, 0xed, 0xe3, 0x3b, 0x39, 0xe4, 0xe0, 0x4c, 0x66, 0xad, 0x99,
+	0xf7, 0xee, 0xb7, 0x8d, 0x70, 0xeb, 0xe5, 0xac, 0xa5, 0xb7, 0x86, 0x1b, 0x49, 0x6e, 0x85, 0x9b,
+	0x15, 0xd4, 0x26, 0x51, 0x59, 0x14, 0x74, 0x83, 0xde, 0x95, 0x51, 0xdb, 0xfe, 0xbe, 0xca, 0xc8,
+	0x87, 0x70, 0x2b, 0x93, 0x1a, 0xca, 0xc4, 0xd4, 0x5c, 0x48, 0x8c, 0x2e, 0x75, 0xd7, 0x7a, 0x9d,
+	0x83, 0x1e, 0x5d, 0x35, 0x01, 0x7d, 0x6e, 0x89, 0x23, 0x0b, 0x0c, 0xb6, 0xcf, 0x7e, 0xec, 0xb5,
+	0xbe, 0xfe, 0xdc, 0x6b, 0xbb, 0x5f, 0x1c, 0x75, 0xb2, 0x45, 0x0c, 0xc9, 0x20, 0x6c, 0x57, 0xbc,
+	0xe6, 0x25, 0x46, 0x6b, 0xdd, 0xa0, 0xd7, 0x39, 0xb8, 0xb7, 0xba, 0xec, 0xa1, 0xcb, 0x1d, 0xac,
+	0xdb, 0x92, 0x23, 0x4f, 0x92, 0x3a, 0xdc, 0x36, 0x60, 0x78, 0x91, 0x48, 0x14, 0x35, 0x7c, 0x92,
+	0x59, 0xb4, 0xee, 0x5a, 0xbc, 0x4d, 0x67, 0x9b, 0xa1, 0x76, 0x33, 0xd4, 0x6f, 0x86, 0x0e, 0x41,
+	0xe9, 0xc1, 0x23, 0xdf, 0x53, 0x2f, 0x57, 0xe6, 0x64, 0x9c, 0x52, 0x01, 0x25, 0xf3, 0x6b, 0x9c,
+	0x7d, 0xf6, 0x31, 0x3b, 0x65, 0xe6, 0x73, 0x25, 0xd1, 0x01, 0x38, 0xba, 0xea, 0x24, 0x5e, 0x78,
+	0x05, 0xf2, 0x31, 0xbc, 0x3e, 0xef, 0x2b, 0x69, 0xa0, 0x18, 0x97, 0x12, 0xa3, 0x0d, 0xa7, 0xfa,
+	0x70, 0xf5, 0x04, 0x47, 0xde, 0x7e, 0xe7, 0x20, 0x3f, 0xc9, 0x35, 0xf3, 0x87, 0x17, 0xc9, 0x71,
+	0x78, 0x33, 0x93, 0x42, 0x95, 0xbc, 0x48, 0x04, 0xe8, 0x46, 0xd6, 0x68, 0x0b, 0x45, 0x6d, 0xa7,
+	0xc0, 0xfe, 0xb5, 0x7a, 0x07, 0x0e, 0x17, 0x9c, 0x17, 0x21, 0xd9, 0x72, 0x00, 0x49, 0x1e, 0xee,
+	0x54, 0x5c, 0x9c, 0x4a, 0x93, 0x14, 0x20, 0x78, 0x91, 0xf0, 0x12, 0xc6, 0xda, 0x60, 0xb4, 0xf9,
+	0x3f, 0x42, 0x87, 0x8e, 0x7c, 0x6d, 0xc1, 0x67, 0x8e, 0x9b, 0x0b, 0x55, 0xcb, 0x01, 0x24, 0x22,
+	0x24, 0x82, 0x6b, 0xd0, 0xca, 0xaa, 0x88, 0x13, 0xae, 0xb5, 0x2c, 0x30, 0xba, 0xec, 0x64, 0xe8,
+	0x6a, 0x99, 0xe1, 0x9c, 0x1b, 0xce, 0x30, 0xaf, 0x72, 0x43, 0x2c, 0xf9, 0x71, 0xf0, 0xe6, 0x6c,
+	0x12, 0x07, 0xe7, 0x93, 0x38, 0xf8, 0x35, 0x89, 0x83, 0x2f, 0xd3, 0xb8, 0x75, 0x3e, 0x8d, 0x5b,
+	0xdf, 0xa7, 0x71, 0xeb, 0xfd, 0x93, 0xbf, 0xdf, 0x59, 0xa5, 0x62, 0x3f, 0x07, 0xd6, 0x3c, 0x65,
+	0x25, 0x64, 0xe3, 0x42, 0xa2, 0x3d, 0xb8, 0x0b, 0x87, 0xe6, 0x1e, 0x3f, 0x6d, 0xbb, 0x6b, 0x79,
+	0xfc, 0x7b, 0x00, 0xf7, 0x31, 0xd1, 0x82, 0xdc, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +196,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CanonicalChannels) > 0 {
+		for iNdEx := len(m.CanonicalChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PacketLocalAmounts) > 0 {
+		for iNdEx := len(m.PacketLocalAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketLocalAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DecimalConversions) > 0 {
+		for iNdEx := len(m.DecimalConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecimalConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TransferVolumes) > 0 {
+		for iNdEx := len(m.TransferVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,6 +335,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferVolumes) > 0 {
+		for _, e := range m.TransferVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DecimalConversions) > 0 {
+		for _, e := range m.DecimalConversions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketLocalAmounts) > 0 {
+		for _, e := range m.PacketLocalAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CanonicalChannels) > 0 {
+		for _, e := range m.CanonicalChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -406,6 +530,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferVolumes = append(m.TransferVolumes, TransferVolume{})
+			if err := m.TransferVolumes[len(m.TransferVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecimalConversions = append(m.DecimalConversions, DecimalConversion{})
+			if err := m.DecimalConversions[len(m.DecimalConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketLocalAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketLocalAmounts = append(m.PacketLocalAmounts, PacketLocalAmount{})
+			if err := m.PacketLocalAmounts[len(m.PacketLocalAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalChannels = append(m.CanonicalChannels, CanonicalChannel{})
+			if err := m.CanonicalChannels[len(m.CanonicalChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
@@ -33,6 +35,96 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid decimal conversions",
+			&types.GenesisState{
+				PortId: types.PortID,
+				DecimalConversions: []types.DecimalConversion{
+					types.NewDecimalConversion("channel-0", "uatom", 6, 18, types.REJECT),
+					types.NewDecimalConversion("channel-1", "uatom", 6, 18, types.TRUNCATE),
+				},
+			},
+			true,
+		},
+		{
+			"invalid decimal conversion with equal decimals",
+			&types.GenesisState{
+				PortId:             types.PortID,
+				DecimalConversions: []types.DecimalConversion{types.NewDecimalConversion("channel-0", "uatom", 6, 6, types.REJECT)},
+			},
+			false,
+		},
+		{
+			"duplicate decimal conversions",
+			&types.GenesisState{
+				PortId: types.PortID,
+				DecimalConversions: []types.DecimalConversion{
+					types.NewDecimalConversion("channel-0", "uatom", 6, 18, types.REJECT),
+					types.NewDecimalConversion("channel-0", "uatom", 8, 18, types.REJECT),
+				},
+			},
+			false,
+		},
+		{
+			"valid packet local amounts",
+			&types.GenesisState{
+				PortId: types.PortID,
+				PacketLocalAmounts: []types.PacketLocalAmount{
+					types.NewPacketLocalAmount(types.PortID, "channel-0", 1, sdkmath.NewInt(1_000_000_000_001)),
+					types.NewPacketLocalAmount(types.PortID, "channel-0", 2, sdkmath.NewInt(1_000_000_000_001)),
+				},
+			},
+			true,
+		},
+		{
+			"invalid packet local amount",
+			&types.GenesisState{
+				PortId:             types.PortID,
+				PacketLocalAmounts: []types.PacketLocalAmount{types.NewPacketLocalAmount(types.PortID, "channel-0", 1, sdkmath.ZeroInt())},
+			},
+			false,
+		},
+		{
+			"duplicate packet local amounts",
+			&types.GenesisState{
+				PortId: types.PortID,
+				PacketLocalAmounts: []types.PacketLocalAmount{
+					types.NewPacketLocalAmount(types.PortID, "channel-0", 1, sdkmath.NewInt(1_000_000_000_001)),
+					types.NewPacketLocalAmount(types.PortID, "channel-0", 1, sdkmath.NewInt(2_000_000_000_001)),
+				},
+			},
+			false,
+		},
+		{
+			"valid canonical channels",
+			&types.GenesisState{
+				PortId: types.PortID,
+				CanonicalChannels: []types.CanonicalChannel{
+					types.NewCanonicalChannel("chain-a", "channel-0"),
+					types.NewCanonicalChannel("chain-b", "channel-1"),
+				},
+			},
+			true,
+		},
+		{
+			"invalid canonical channel without channel identifier",
+			&types.GenesisState{
+				PortId:            types.PortID,
+				CanonicalChannels: []types.CanonicalChannel{types.NewCanonicalChannel("chain-a", "")},
+			},
+			false,
+		},
+		{
+			"duplicate canonical channels",
+			&types.GenesisState{
+				PortId: types.PortID,
+				CanonicalChannels: []types.CanonicalChannel{
+					types.NewCanonicalChannel("chain-a", "channel-0"),
+					types.NewCanonicalChannel("chain-a", "channel-1"),
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
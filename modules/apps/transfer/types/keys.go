@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,6 +16,12 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// VersionWithTokenMetadata defines the IBC transfer version in which
+	// packet data may carry the bank metadata of the transferred token. It is
+	// distinct from the ics20-2 version of the ICS-20 specification, whose packet
+	// data is not compatible with the packet data of this version.
+	VersionWithTokenMetadata = "ics20-tokenmetadata-1"
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -35,6 +42,16 @@ const (
 
 	KeyTotalEscrowPrefix = "totalEscrowForDenom"
 
+	KeyTransferVolumePrefix = "transferVolume"
+
+	KeyDenomChannelsPrefix = "denomChannels"
+
+	KeyDecimalConversionPrefix = "decimalConversion"
+
+	KeyPacketLocalAmountPrefix = "packetLocalAmount"
+
+	KeyCanonicalChannelPrefix = "canonicalChannel"
+
 	ParamsKey = "params"
 )
 
@@ -43,8 +60,18 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+
+	// SupportedVersions defines all the IBC transfer versions the module
+	// is able to negotiate, in order of preference
+	SupportedVersions = []string{VersionWithTokenMetadata, Version}
 )
 
+// IsSupportedVersion returns true if the provided version is one of the
+// IBC transfer versions supported by the module.
+func IsSupportedVersion(version string) bool {
+	return slices.Contains(SupportedVersions, version)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -66,3 +93,53 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 func TotalEscrowForDenomKey(denom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyTotalEscrowPrefix, denom))
 }
+
+// TransferVolumesKey returns the store key prefix under which the transfer volumes
+// of all denominations transferred over the provided channel are stored.
+func TransferVolumesKey(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyTransferVolumePrefix, channelID))
+}
+
+// TransferVolumeKey returns the store key under which the transfer volume of the
+// provided denomination over the provided channel is stored.
+func TransferVolumeKey(channelID, denom string) []byte {
+	return append(TransferVolumesKey(channelID), denom...)
+}
+
+// DenomChannelsKey returns the store key prefix under which the identifiers of all channels
+// with a tracked transfer volume for the provided denomination are indexed. The denomination
+// is hashed, as it may contain the separator used between the key segments.
+func DenomChannelsKey(denom string) []byte {
+	hash := sha256.Sum256([]byte(denom))
+	return []byte(fmt.Sprintf("%s/%X/", KeyDenomChannelsPrefix, hash))
+}
+
+// DenomChannelKey returns the store key under which the provided channel is indexed as having
+// a tracked transfer volume for the provided denomination.
+func DenomChannelKey(denom, channelID string) []byte {
+	return append(DenomChannelsKey(denom), channelID...)
+}
+
+// DecimalConversionsKey returns the store key prefix under which the decimal conversions
+// of all denominations transferred over the provided channel are stored.
+func DecimalConversionsKey(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyDecimalConversionPrefix, channelID))
+}
+
+// DecimalConversionKey returns the store key under which the decimal conversion of the
+// provided denomination over the provided channel is stored.
+func DecimalConversionKey(channelID, denom string) []byte {
+	return append(DecimalConversionsKey(channelID), denom...)
+}
+
+// PacketLocalAmountKey returns the store key under which the local amount of the packet with
+// the provided port, channel and sequence is stored.
+func PacketLocalAmountKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", KeyPacketLocalAmountPrefix, portID, channelID, sequence))
+}
+
+// CanonicalChannelKey returns the store key under which the canonical channel for transfers to
+// the counterparty chain with the provided chain identifier is stored.
+func CanonicalChannelKey(chainID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyCanonicalChannelPrefix, chainID))
+}
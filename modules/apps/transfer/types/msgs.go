@@ -20,8 +20,14 @@ const (
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgRenameBaseDenoms)(nil)
+	_ sdk.Msg              = (*MsgUpdateDecimalConversion)(nil)
+	_ sdk.Msg              = (*MsgUpdateCanonicalChannel)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgRenameBaseDenoms)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateDecimalConversion)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateCanonicalChannel)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -39,7 +45,7 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
-	return nil
+	return msg.Params.Validate()
 }
 
 // NewMsgTransfer creates a new MsgTransfer instance
@@ -65,6 +71,8 @@ func NewMsgTransfer(
 // NOTE: timeout height or timestamp values can be 0 to disable the timeout.
 // NOTE: The recipient addresses format is not validated as the format defined by
 // the chain is not known to IBC.
+// NOTE: A zero amount token denotes a ping transfer, whether ping transfers may be sent
+// is determined by the parameters of the chain.
 func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
 		return errorsmod.Wrap(err, "invalid source port ID")
@@ -75,9 +83,6 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if !msg.Token.IsValid() {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
 	}
-	if !msg.Token.IsPositive() {
-		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, msg.Token.String())
-	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -94,3 +99,115 @@ func (msg MsgTransfer) ValidateBasic() error {
 	}
 	return ValidateIBCDenom(msg.Token.Denom)
 }
+
+// NewMsgRenameBaseDenoms creates a new MsgRenameBaseDenoms instance
+func NewMsgRenameBaseDenoms(signer string, renames ...BaseDenomRename) *MsgRenameBaseDenoms {
+	return &MsgRenameBaseDenoms{
+		Signer:  signer,
+		Renames: renames,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRenameBaseDenoms) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Renames) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "renames cannot be empty")
+	}
+
+	seenTraces := make(map[string]bool)
+	for i, rename := range msg.Renames {
+		if err := rename.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid base denom rename %d", i)
+		}
+
+		fullDenomPath := rename.OldDenomTrace().GetFullDenomPath()
+		if seenTraces[fullDenomPath] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate base denom rename for %s", fullDenomPath)
+		}
+		seenTraces[fullDenomPath] = true
+	}
+
+	return nil
+}
+
+// NewMsgUpdateDecimalConversion creates a new MsgUpdateDecimalConversion instance
+func NewMsgUpdateDecimalConversion(signer string, conversion DecimalConversion) *MsgUpdateDecimalConversion {
+	return &MsgUpdateDecimalConversion{
+		Signer:     signer,
+		Conversion: conversion,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateDecimalConversion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Conversion.Validate()
+}
+
+// NewMsgUpdateCanonicalChannel creates a new MsgUpdateCanonicalChannel instance
+func NewMsgUpdateCanonicalChannel(signer string, canonicalChannel CanonicalChannel) *MsgUpdateCanonicalChannel {
+	return &MsgUpdateCanonicalChannel{
+		Signer:           signer,
+		CanonicalChannel: canonicalChannel,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateCanonicalChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.CanonicalChannel.Validate()
+}
+
+// NewBaseDenomRename creates a new BaseDenomRename instance
+func NewBaseDenomRename(path, oldBaseDenom, newBaseDenom string) BaseDenomRename {
+	return BaseDenomRename{
+		Path:         path,
+		OldBaseDenom: oldBaseDenom,
+		NewBaseDenom: newBaseDenom,
+	}
+}
+
+// OldDenomTrace returns the denomination trace before the rename.
+func (r BaseDenomRename) OldDenomTrace() DenomTrace {
+	return DenomTrace{Path: r.Path, BaseDenom: r.OldBaseDenom}
+}
+
+// NewDenomTrace returns the denomination trace after the rename.
+func (r BaseDenomRename) NewDenomTrace() DenomTrace {
+	return DenomTrace{Path: r.Path, BaseDenom: r.NewBaseDenom}
+}
+
+// Validate performs a basic validation of the base denomination rename. Only the base
+// denomination of IBC vouchers can be renamed, thus the path cannot be empty.
+func (r BaseDenomRename) Validate() error {
+	if strings.TrimSpace(r.Path) == "" {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, "path cannot be empty")
+	}
+
+	if r.OldBaseDenom == r.NewBaseDenom {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "new base denomination cannot be equal to the old base denomination %s", r.OldBaseDenom)
+	}
+
+	if err := r.OldDenomTrace().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	if err := r.NewDenomTrace().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	return nil
+}
@@ -65,7 +65,7 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"too long memo", types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, ibctesting.GenerateString(types.MaximumMemoLength+1)), false},
 		{"channel id contains non-alpha", types.NewMsgTransfer(validPort, invalidChannel, coin, sender, receiver, timeoutHeight, 0, ""), false},
 		{"invalid denom", types.NewMsgTransfer(validPort, validChannel, invalidDenomCoin, sender, receiver, timeoutHeight, 0, ""), false},
-		{"zero coin", types.NewMsgTransfer(validPort, validChannel, zeroCoin, sender, receiver, timeoutHeight, 0, ""), false},
+		{"zero coin ping", types.NewMsgTransfer(validPort, validChannel, zeroCoin, sender, receiver, timeoutHeight, 0, ""), true},
 		{"missing sender address", types.NewMsgTransfer(validPort, validChannel, coin, emptyAddr, receiver, timeoutHeight, 0, ""), false},
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "", timeoutHeight, 0, ""), false},
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), timeoutHeight, 0, ""), false},
@@ -105,6 +105,50 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 		{"success: valid signer and valid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()), true},
 		{"failure: invalid signer with valid params", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), false},
 		{"failure: empty signer with valid params", types.NewMsgUpdateParams(emptyAddr, types.DefaultParams()), false},
+		{"success: zero max memo characters and receiver length", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{SendEnabled: true, ReceiveEnabled: true}), true},
+		{"failure: max memo characters exceeds maximum memo length", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{MaxMemoCharacters: types.MaximumMemoLength + 1}), false},
+		{"failure: max receiver length exceeds maximum receiver length", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{MaxReceiverLength: types.MaximumReceiverLength + 1}), false},
+		{"success: channel timeout defaults", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ChannelTimeoutDefaults: []types.ChannelTimeoutDefault{types.NewChannelTimeoutDefault("channel-0", 100, 0), types.NewChannelTimeoutDefault("channel-1", 0, 600_000_000_000)}}), true},
+		{"failure: channel timeout default with invalid channel identifier", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ChannelTimeoutDefaults: []types.ChannelTimeoutDefault{types.NewChannelTimeoutDefault("", 100, 0)}}), false},
+		{"failure: channel timeout default without offsets", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ChannelTimeoutDefaults: []types.ChannelTimeoutDefault{types.NewChannelTimeoutDefault("channel-0", 0, 0)}}), false},
+		{"failure: duplicate channel timeout defaults", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ChannelTimeoutDefaults: []types.ChannelTimeoutDefault{types.NewChannelTimeoutDefault("channel-0", 100, 0), types.NewChannelTimeoutDefault("channel-0", 0, 600_000_000_000)}}), false},
+		{"success: channel transfer overrides", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ChannelTransferOverrides: []types.ChannelTransferOverride{types.NewChannelTransferOverride("channel-0", false, true), types.NewChannelTransferOverride("channel-1", true, false)}}), true},
+		{"failure: channel transfer override with invalid channel identifier", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ChannelTransferOverrides: []types.ChannelTransferOverride{types.NewChannelTransferOverride("", false, false)}}), false},
+		{"failure: duplicate channel transfer overrides", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ChannelTransferOverrides: []types.ChannelTransferOverride{types.NewChannelTransferOverride("channel-0", false, true), types.NewChannelTransferOverride("channel-0", true, false)}}), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgRenameBaseDenomsValidateBasic tests ValidateBasic for MsgRenameBaseDenoms
+func TestMsgRenameBaseDenomsValidateBasic(t *testing.T) {
+	rename := types.NewBaseDenomRename("transfer/channel-0", "uatom", "uatomv2")
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRenameBaseDenoms
+		expPass bool
+	}{
+		{"success: valid signer and rename", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, rename), true},
+		{"success: multiple renames", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, rename, types.NewBaseDenomRename("transfer/channel-1", "uatom", "uatomv2")), true},
+		{"failure: invalid signer", types.NewMsgRenameBaseDenoms(invalidAddress, rename), false},
+		{"failure: empty signer", types.NewMsgRenameBaseDenoms(emptyAddr, rename), false},
+		{"failure: no renames", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress), false},
+		{"failure: duplicate renames", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, rename, rename), false},
+		{"failure: empty path", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, types.NewBaseDenomRename("", "uatom", "uatomv2")), false},
+		{"failure: invalid path", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, types.NewBaseDenomRename("transfer", "uatom", "uatomv2")), false},
+		{"failure: empty old base denom", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, types.NewBaseDenomRename("transfer/channel-0", "", "uatomv2")), false},
+		{"failure: empty new base denom", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, types.NewBaseDenomRename("transfer/channel-0", "uatom", "")), false},
+		{"failure: old and new base denom are equal", types.NewMsgRenameBaseDenoms(ibctesting.TestAccAddress, types.NewBaseDenomRename("transfer/channel-0", "uatom", "uatom")), false},
 	}
 
 	for i, tc := range testCases {
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
@@ -15,6 +17,7 @@ import (
 var (
 	_ ibcexported.PacketData         = (*FungibleTokenPacketData)(nil)
 	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketData)(nil)
+	_ ibcexported.RefundPacketData   = (*FungibleTokenPacketData)(nil)
 )
 
 // NewFungibleTokenPacketData constructs a new FungibleTokenPacketData instance
@@ -32,29 +35,88 @@ func NewFungibleTokenPacketData(
 	}
 }
 
+// NewTokenMetadata constructs a new TokenMetadata instance
+func NewTokenMetadata(decimals uint32, symbol, display string) TokenMetadata {
+	return TokenMetadata{
+		Decimals: decimals,
+		Symbol:   symbol,
+		Display:  display,
+	}
+}
+
+// ValidateBasic performs a basic validation of the token metadata fields.
+func (tm TokenMetadata) ValidateBasic() error {
+	if strings.TrimSpace(tm.Symbol) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenMetadata, "symbol cannot be blank")
+	}
+	if err := sdk.ValidateDenom(tm.Display); err != nil {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "invalid display denomination: %s", err)
+	}
+	return nil
+}
+
+// ValidateDisplay checks the display denomination and decimals of the token metadata
+// against the base denomination of the token. The display denomination must either be
+// the base denomination with zero decimals, or a denomination other than the base
+// denomination with a positive number of decimals. IBC denominations cannot be used
+// as display denomination.
+func (tm TokenMetadata) ValidateDisplay(baseDenom string) error {
+	if strings.HasPrefix(tm.Display, DenomPrefix+"/") {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "display denomination cannot be an IBC denomination: %s", tm.Display)
+	}
+	if tm.Display == baseDenom && tm.Decimals != 0 {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "display denomination %s is the base denomination, expected zero decimals, got %d", tm.Display, tm.Decimals)
+	}
+	if tm.Display != baseDenom && tm.Decimals == 0 {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "display denomination %s is not the base denomination %s, expected positive decimals", tm.Display, baseDenom)
+	}
+	return nil
+}
+
 // ValidateBasic is used for validating the token transfer.
 // NOTE: The addresses formats are not validated as the sender and recipient can have different
 // formats defined by their corresponding chains that are not known to IBC.
+// NOTE: Zero amounts are valid as they denote ping transfers, whether ping transfers are
+// accepted is determined by the parameters of the chain.
 func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	amount, ok := sdkmath.NewIntFromString(ftpd.Amount)
 	if !ok {
-		return errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", ftpd.Amount)
+		err := errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", ftpd.Amount)
+		return NewPacketDataFieldError(err, FieldAmount, "cannot be parsed as an integer")
 	}
-	if !amount.IsPositive() {
-		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	if amount.IsNegative() {
+		err := errorsmod.Wrapf(ErrInvalidAmount, "amount must not be negative: got %d", amount)
+		return NewPacketDataFieldError(err, FieldAmount, "must not be negative")
 	}
 	if strings.TrimSpace(ftpd.Sender) == "" {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+		err := errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+		return NewPacketDataFieldError(err, FieldSender, "cannot be blank")
 	}
 	if strings.TrimSpace(ftpd.Receiver) == "" {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+		err := errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+		return NewPacketDataFieldError(err, FieldReceiver, "cannot be blank")
+	}
+	if ftpd.Metadata != nil {
+		if err := ftpd.Metadata.ValidateBasic(); err != nil {
+			return NewPacketDataFieldError(err, FieldMetadata, "invalid token metadata")
+		}
 	}
-	return ValidatePrefixedDenom(ftpd.Denom)
+	if err := ValidatePrefixedDenom(ftpd.Denom); err != nil {
+		return NewPacketDataFieldError(err, FieldDenom, "malformed denomination trace")
+	}
+	return nil
+}
+
+// IsPing returns true if the packet data transfers a zero amount. Ping transfers only probe the
+// liveness of the channel, no tokens are escrowed, burned, minted or unescrowed.
+func (ftpd FungibleTokenPacketData) IsPing() bool {
+	amount, ok := sdkmath.NewIntFromString(ftpd.Amount)
+	return ok && amount.IsZero()
 }
 
 // GetBytes is a helper for serialising the packet to bytes.
-// The memo field of FungibleTokenPacketData is marked with the JSON omitempty tag
-// ensuring that the memo field is not included in the marshalled bytes if one is not specified.
+// The memo and metadata fields of FungibleTokenPacketData are marked with the JSON omitempty tag
+// ensuring that they are not included in the marshalled bytes if they are not specified.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
 	bz, err := json.Marshal(ftpd)
 	if err != nil {
@@ -75,6 +137,17 @@ func (ftpd FungibleTokenPacketData) GetPacketSender(sourcePortID string) string
 	return ftpd.Sender
 }
 
+// GetRefundType implements the RefundPacketData interface. When the sending chain is the source of the
+// denomination the escrowed tokens are released back to the sender, otherwise the vouchers burned when
+// sending the packet are minted back to the sender.
+func (ftpd FungibleTokenPacketData) GetRefundType(sourcePortID, sourceChannelID string) ibcexported.RefundType {
+	if SenderChainIsSource(sourcePortID, sourceChannelID, ftpd.Denom) {
+		return ibcexported.RefundTypeUnescrow
+	}
+
+	return ibcexported.RefundTypeMint
+}
+
 // GetCustomPacketData interprets the memo field of the packet data as a JSON object
 // and returns the value associated with the given key.
 // If the key is missing or the memo is not properly formatted, then nil is returned.
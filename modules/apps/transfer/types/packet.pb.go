@@ -36,6 +36,9 @@ type FungibleTokenPacketData struct {
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// optional memo
 	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	// optional metadata of the token on the sending chain, only set on channels
+	// which negotiated a version supporting token metadata
+	Metadata *TokenMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *FungibleTokenPacketData) Reset()         { *m = FungibleTokenPacketData{} }
@@ -106,8 +109,81 @@ func (m *FungibleTokenPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *FungibleTokenPacketData) GetMetadata() *TokenMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// TokenMetadata defines the bank metadata of a token on the sending chain which
+// is relayed alongside the token to the receiving chain
+type TokenMetadata struct {
+	// the number of decimals of the display denomination
+	Decimals uint32 `protobuf:"varint,1,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// the ticker symbol of the token (e.g. ATOM)
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// the display denomination of the token (e.g. atom)
+	Display string `protobuf:"bytes,3,opt,name=display,proto3" json:"display,omitempty"`
+}
+
+func (m *TokenMetadata) Reset()         { *m = TokenMetadata{} }
+func (m *TokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*TokenMetadata) ProtoMessage()    {}
+func (*TokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{1}
+}
+func (m *TokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMetadata.Merge(m, src)
+}
+func (m *TokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMetadata proto.InternalMessageInfo
+
+func (m *TokenMetadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *TokenMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenMetadata) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketData")
+	proto.RegisterType((*TokenMetadata)(nil), "ibc.applications.transfer.v2.TokenMetadata")
 }
 
 func init() {
@@ -115,23 +191,28 @@ func init() {
 }
 
 var fileDescriptor_653ca2ce9a5ca313 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4a, 0x34, 0x31,
-	0x14, 0x46, 0x27, 0xff, 0xbf, 0xbb, 0x68, 0xca, 0x20, 0x3a, 0x88, 0x04, 0xb1, 0xd2, 0xc2, 0x09,
-	0xac, 0x85, 0xd6, 0x22, 0xd6, 0x2a, 0x56, 0x76, 0x49, 0xe6, 0x3a, 0x86, 0x9d, 0xe4, 0x86, 0x24,
-	0x33, 0xe0, 0x53, 0xe8, 0x63, 0x59, 0x6e, 0x69, 0x29, 0x33, 0x2f, 0x22, 0x9b, 0x51, 0xd9, 0x2e,
-	0xe7, 0xe4, 0xbb, 0xcd, 0xa1, 0x67, 0x46, 0x69, 0x21, 0xbd, 0x6f, 0x8d, 0x96, 0xc9, 0xa0, 0x8b,
-	0x22, 0x05, 0xe9, 0xe2, 0x33, 0x04, 0xd1, 0x2f, 0x85, 0x97, 0x7a, 0x05, 0xa9, 0xf2, 0x01, 0x13,
-	0xb2, 0x23, 0xa3, 0x74, 0xb5, 0x3d, 0xad, 0x7e, 0xa7, 0x55, 0xbf, 0x3c, 0x79, 0x23, 0xf4, 0xe0,
-	0xb6, 0x73, 0x8d, 0x51, 0x2d, 0x3c, 0xe2, 0x0a, 0xdc, 0x5d, 0xbe, 0xbd, 0x91, 0x49, 0xb2, 0x3d,
-	0x3a, 0xaf, 0xc1, 0xa1, 0x2d, 0xc9, 0x31, 0x39, 0xdd, 0x7d, 0x98, 0x80, 0xed, 0xd3, 0x85, 0xb4,
-	0xd8, 0xb9, 0x54, 0xfe, 0xcb, 0xfa, 0x87, 0x36, 0x3e, 0x82, 0xab, 0x21, 0x94, 0xff, 0x27, 0x3f,
-	0x11, 0x3b, 0xa4, 0x3b, 0x01, 0x34, 0x98, 0x1e, 0x42, 0x39, 0xcb, 0x3f, 0x7f, 0xcc, 0x18, 0x9d,
-	0x59, 0xb0, 0x58, 0xce, 0xb3, 0xcf, 0xef, 0xeb, 0xfb, 0x8f, 0x81, 0x93, 0xf5, 0xc0, 0xc9, 0xd7,
-	0xc0, 0xc9, 0xfb, 0xc8, 0x8b, 0xf5, 0xc8, 0x8b, 0xcf, 0x91, 0x17, 0x4f, 0x97, 0x8d, 0x49, 0x2f,
-	0x9d, 0xaa, 0x34, 0x5a, 0xa1, 0x31, 0x5a, 0x8c, 0xc2, 0x28, 0x7d, 0xde, 0xa0, 0xe8, 0xaf, 0x84,
-	0xc5, 0xba, 0x6b, 0x21, 0x6e, 0xa2, 0x6c, 0xc5, 0x48, 0xaf, 0x1e, 0xa2, 0x5a, 0xe4, 0x12, 0x17,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x93, 0x3d, 0xc6, 0x36, 0x36, 0x01, 0x00, 0x00,
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4b, 0x03, 0x31,
+	0x1c, 0xc5, 0x1b, 0x6d, 0x6b, 0x8d, 0x74, 0x09, 0xa2, 0x41, 0xe4, 0x28, 0x9d, 0x2a, 0x62, 0x02,
+	0x75, 0xd0, 0x59, 0x44, 0x27, 0x41, 0x8b, 0x93, 0xe0, 0x90, 0xe4, 0xfe, 0xd6, 0xd0, 0x4b, 0x72,
+	0x5c, 0x72, 0x07, 0xfd, 0x16, 0x7e, 0x2c, 0xc7, 0x8e, 0x1d, 0xa5, 0xfd, 0x22, 0xd2, 0x5c, 0x5b,
+	0xea, 0xe2, 0x96, 0xdf, 0xcb, 0xff, 0xc1, 0x7b, 0x3c, 0x7c, 0xa1, 0xa5, 0xe2, 0x22, 0xcf, 0x33,
+	0xad, 0x44, 0xd0, 0xce, 0x7a, 0x1e, 0x0a, 0x61, 0xfd, 0x07, 0x14, 0xbc, 0x1a, 0xf2, 0x5c, 0xa8,
+	0x09, 0x04, 0x96, 0x17, 0x2e, 0x38, 0x72, 0xae, 0xa5, 0x62, 0xbb, 0xa7, 0x6c, 0x73, 0xca, 0xaa,
+	0x61, 0x7f, 0x8e, 0xf0, 0xe9, 0x43, 0x69, 0xc7, 0x5a, 0x66, 0xf0, 0xea, 0x26, 0x60, 0x9f, 0xa3,
+	0xf7, 0x5e, 0x04, 0x41, 0x8e, 0x71, 0x2b, 0x05, 0xeb, 0x0c, 0x45, 0x3d, 0x34, 0x38, 0x1c, 0xd5,
+	0x40, 0x4e, 0x70, 0x5b, 0x18, 0x57, 0xda, 0x40, 0xf7, 0xa2, 0xbc, 0xa6, 0x95, 0xee, 0xc1, 0xa6,
+	0x50, 0xd0, 0xfd, 0x5a, 0xaf, 0x89, 0x9c, 0xe1, 0x4e, 0x01, 0x0a, 0x74, 0x05, 0x05, 0x6d, 0xc6,
+	0x9f, 0x2d, 0x13, 0x82, 0x9b, 0x06, 0x8c, 0xa3, 0xad, 0xa8, 0xc7, 0x37, 0x79, 0xc4, 0x1d, 0x03,
+	0x41, 0xa4, 0x22, 0x08, 0xda, 0xee, 0xa1, 0xc1, 0xd1, 0xf0, 0x92, 0xfd, 0x57, 0x81, 0xc5, 0xd8,
+	0x4f, 0x6b, 0xcb, 0x68, 0x6b, 0xee, 0xbf, 0xe3, 0xee, 0x9f, 0xaf, 0x55, 0x92, 0x14, 0x94, 0x36,
+	0x22, 0xf3, 0xb1, 0x52, 0x77, 0xb4, 0xe5, 0x98, 0x7e, 0x6a, 0xa4, 0xcb, 0x36, 0xad, 0x6a, 0x22,
+	0x14, 0x1f, 0xa4, 0xda, 0xe7, 0x99, 0x98, 0xae, 0x6b, 0x6d, 0xf0, 0xee, 0xe5, 0x7b, 0x91, 0xa0,
+	0xd9, 0x22, 0x41, 0x3f, 0x8b, 0x04, 0x7d, 0x2d, 0x93, 0xc6, 0x6c, 0x99, 0x34, 0xe6, 0xcb, 0xa4,
+	0xf1, 0x76, 0x33, 0xd6, 0xe1, 0xb3, 0x94, 0x4c, 0x39, 0xc3, 0x95, 0xf3, 0xc6, 0x79, 0xae, 0xa5,
+	0xba, 0x1a, 0x3b, 0x5e, 0xdd, 0x72, 0xe3, 0xd2, 0x32, 0x03, 0xbf, 0x1a, 0x6f, 0x67, 0xb4, 0x30,
+	0xcd, 0xc1, 0xcb, 0x76, 0x5c, 0xec, 0xfa, 0x77, 0x00, 0x78, 0x11, 0xd2, 0x0c, 0xde, 0x01, 0x00,
+	0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -154,6 +235,18 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -192,6 +285,48 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -229,6 +364,30 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		n += 1 + sovPacket(uint64(m.Decimals))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -427,6 +586,175 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &TokenMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 const (
@@ -29,11 +30,14 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		{"valid packet with large amount", types.NewFungibleTokenPacketData(denom, largeAmount, sender, receiver, ""), true},
 		{"invalid denom", types.NewFungibleTokenPacketData("", amount, sender, receiver, ""), false},
 		{"invalid empty amount", types.NewFungibleTokenPacketData(denom, "", sender, receiver, ""), false},
-		{"invalid zero amount", types.NewFungibleTokenPacketData(denom, "0", sender, receiver, ""), false},
+		{"valid zero amount ping", types.NewFungibleTokenPacketData(denom, "0", sender, receiver, ""), true},
 		{"invalid negative amount", types.NewFungibleTokenPacketData(denom, "-1", sender, receiver, ""), false},
 		{"invalid large amount", types.NewFungibleTokenPacketData(denom, invalidLargeAmount, sender, receiver, ""), false},
 		{"missing sender address", types.NewFungibleTokenPacketData(denom, amount, emptyAddr, receiver, ""), false},
 		{"missing recipient address", types.NewFungibleTokenPacketData(denom, amount, sender, emptyAddr, ""), false},
+		{"valid packet with token metadata", withTokenMetadata(types.NewFungibleTokenPacketData(denom, amount, sender, receiver, ""), types.NewTokenMetadata(6, "ATOM", "atom")), true},
+		{"invalid token metadata symbol", withTokenMetadata(types.NewFungibleTokenPacketData(denom, amount, sender, receiver, ""), types.NewTokenMetadata(6, " ", "atom")), false},
+		{"invalid token metadata display", withTokenMetadata(types.NewFungibleTokenPacketData(denom, amount, sender, receiver, ""), types.NewTokenMetadata(6, "ATOM", "1atom")), false},
 	}
 
 	for i, tc := range testCases {
@@ -48,6 +52,36 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTokenMetadataValidateDisplay(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tokenMetadata types.TokenMetadata
+		expPass       bool
+	}{
+		{"display denomination with decimals", types.NewTokenMetadata(6, "ATOM", "atom"), true},
+		{"base denomination without decimals", types.NewTokenMetadata(0, "ATOM", "uatom"), true},
+		{"base denomination with decimals", types.NewTokenMetadata(6, "ATOM", "uatom"), false},
+		{"display denomination without decimals", types.NewTokenMetadata(0, "ATOM", "atom"), false},
+		{"IBC denomination", types.NewTokenMetadata(6, "ATOM", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.tokenMetadata.ValidateDisplay("uatom")
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidTokenMetadata, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func withTokenMetadata(packetData types.FungibleTokenPacketData, tokenMetadata types.TokenMetadata) types.FungibleTokenPacketData {
+	packetData.Metadata = &tokenMetadata
+	return packetData
+}
+
 func (suite *TypesTestSuite) TestGetPacketSender() {
 	packetData := types.FungibleTokenPacketData{
 		Denom:    denom,
@@ -60,6 +94,25 @@ func (suite *TypesTestSuite) TestGetPacketSender() {
 	suite.Require().Equal(sender, packetData.GetPacketSender(types.PortID))
 }
 
+func (suite *TypesTestSuite) TestGetRefundType() {
+	testCases := []struct {
+		name          string
+		denom         string
+		expRefundType ibcexported.RefundType
+	}{
+		{"sender chain is source", "atom", ibcexported.RefundTypeUnescrow},
+		{"sender chain is source of ibc denom from another channel", "transfer/channel-1/atom", ibcexported.RefundTypeUnescrow},
+		{"receiver chain is source", "transfer/channel-0/atom", ibcexported.RefundTypeMint},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetData := types.NewFungibleTokenPacketData(tc.denom, amount, sender, receiver, "")
+		suite.Require().Equal(tc.expRefundType, packetData.GetRefundType(types.PortID, "channel-0"), tc.name)
+	}
+}
+
 func (suite *TypesTestSuite) TestPacketDataProvider() {
 	testCases := []struct {
 		name          string
@@ -1,17 +1,38 @@
 package types
 
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultVolumeTrackingEnabled disabled
+	DefaultVolumeTrackingEnabled = false
+	// DefaultPingTransfersEnabled disabled
+	DefaultPingTransfersEnabled = false
+	// DefaultMaxMemoCharacters is the maximum memo length accepted by MsgTransfer
+	DefaultMaxMemoCharacters = MaximumMemoLength
+	// DefaultMaxReceiverLength is the maximum receiver length accepted by MsgTransfer
+	DefaultMaxReceiverLength = MaximumReceiverLength
 )
 
 // NewParams creates a new parameter configuration for the ibc transfer module
 func NewParams(enableSend, enableReceive bool) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:           enableSend,
+		ReceiveEnabled:        enableReceive,
+		VolumeTrackingEnabled: DefaultVolumeTrackingEnabled,
+		MaxMemoCharacters:     DefaultMaxMemoCharacters,
+		MaxReceiverLength:     DefaultMaxReceiverLength,
+		PingTransfersEnabled:  DefaultPingTransfersEnabled,
 	}
 }
 
@@ -19,3 +40,133 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// Validate performs a basic validation of the ibc-transfer module parameters.
+func (p Params) Validate() error {
+	if p.MaxMemoCharacters > MaximumMemoLength {
+		return fmt.Errorf("max memo characters must not exceed %d, got %d", MaximumMemoLength, p.MaxMemoCharacters)
+	}
+	if p.MaxReceiverLength > MaximumReceiverLength {
+		return fmt.Errorf("max receiver length must not exceed %d, got %d", MaximumReceiverLength, p.MaxReceiverLength)
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, timeoutDefault := range p.ChannelTimeoutDefaults {
+		if err := timeoutDefault.Validate(); err != nil {
+			return err
+		}
+		if seenChannels[timeoutDefault.ChannelId] {
+			return fmt.Errorf("duplicate timeout default for channel %s", timeoutDefault.ChannelId)
+		}
+		seenChannels[timeoutDefault.ChannelId] = true
+	}
+
+	seenChannels = make(map[string]bool)
+	for _, override := range p.ChannelTransferOverrides {
+		if err := host.ChannelIdentifierValidator(override.ChannelId); err != nil {
+			return err
+		}
+		if seenChannels[override.ChannelId] {
+			return fmt.Errorf("duplicate transfer override for channel %s", override.ChannelId)
+		}
+		seenChannels[override.ChannelId] = true
+	}
+
+	return nil
+}
+
+// IsSendEnabled returns true if transfers can be sent on the provided channel. Transfers must be enabled by
+// both the chain-wide SendEnabled parameter and the transfer override of the channel, if any.
+func (p Params) IsSendEnabled(channelID string) bool {
+	if override, found := p.GetChannelTransferOverride(channelID); found && !override.SendEnabled {
+		return false
+	}
+
+	return p.SendEnabled
+}
+
+// IsReceiveEnabled returns true if transfers can be received on the provided channel. Transfers must be enabled
+// by both the chain-wide ReceiveEnabled parameter and the transfer override of the channel, if any.
+func (p Params) IsReceiveEnabled(channelID string) bool {
+	if override, found := p.GetChannelTransferOverride(channelID); found && !override.ReceiveEnabled {
+		return false
+	}
+
+	return p.ReceiveEnabled
+}
+
+// GetChannelTransferOverride returns the transfer override of the provided channel.
+func (p Params) GetChannelTransferOverride(channelID string) (ChannelTransferOverride, bool) {
+	for _, override := range p.ChannelTransferOverrides {
+		if override.ChannelId == channelID {
+			return override, true
+		}
+	}
+
+	return ChannelTransferOverride{}, false
+}
+
+// GetChannelTimeoutDefault returns the default relative timeouts of transfers sent on the provided channel.
+func (p Params) GetChannelTimeoutDefault(channelID string) (ChannelTimeoutDefault, bool) {
+	for _, timeoutDefault := range p.ChannelTimeoutDefaults {
+		if timeoutDefault.ChannelId == channelID {
+			return timeoutDefault, true
+		}
+	}
+
+	return ChannelTimeoutDefault{}, false
+}
+
+// NewChannelTimeoutDefault creates a new ChannelTimeoutDefault instance.
+func NewChannelTimeoutDefault(channelID string, timeoutHeightOffset, timeoutTimestampOffset uint64) ChannelTimeoutDefault {
+	return ChannelTimeoutDefault{
+		ChannelId:              channelID,
+		TimeoutHeightOffset:    timeoutHeightOffset,
+		TimeoutTimestampOffset: timeoutTimestampOffset,
+	}
+}
+
+// NewChannelTransferOverride creates a new ChannelTransferOverride instance.
+func NewChannelTransferOverride(channelID string, sendEnabled, receiveEnabled bool) ChannelTransferOverride {
+	return ChannelTransferOverride{
+		ChannelId:      channelID,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the ChannelTimeoutDefault fields.
+func (td ChannelTimeoutDefault) Validate() error {
+	if err := host.ChannelIdentifierValidator(td.ChannelId); err != nil {
+		return err
+	}
+	if td.TimeoutHeightOffset == 0 && td.TimeoutTimestampOffset == 0 {
+		return fmt.Errorf("timeout default for channel %s must set a timeout height offset or a timeout timestamp offset", td.ChannelId)
+	}
+	return nil
+}
+
+// ValidateReceiverAndMemo returns an error if the receiver or the memo exceed the maximum
+// lengths set in the parameters. A maximum length of zero applies the maximum length
+// accepted by MsgTransfer.
+func (p Params) ValidateReceiverAndMemo(receiver, memo string) error {
+	maxReceiverLength := p.MaxReceiverLength
+	if maxReceiverLength == 0 {
+		maxReceiverLength = MaximumReceiverLength
+	}
+	if uint64(len(receiver)) > maxReceiverLength {
+		err := errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recipient address must not exceed %d bytes", maxReceiverLength)
+		return NewPacketDataFieldError(err, FieldReceiver, "exceeds maximum length")
+	}
+
+	maxMemoCharacters := p.MaxMemoCharacters
+	if maxMemoCharacters == 0 {
+		maxMemoCharacters = MaximumMemoLength
+	}
+	if uint64(len(memo)) > maxMemoCharacters {
+		err := errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", maxMemoCharacters)
+		return NewPacketDataFieldError(err, FieldMemo, "exceeds maximum length")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestParamsGetChannelTimeoutDefault(t *testing.T) {
+	timeoutDefault := types.NewChannelTimeoutDefault("channel-1", 100, 600_000_000_000)
+
+	params := types.DefaultParams()
+	params.ChannelTimeoutDefaults = []types.ChannelTimeoutDefault{
+		types.NewChannelTimeoutDefault("channel-0", 50, 0),
+		timeoutDefault,
+	}
+
+	actual, found := params.GetChannelTimeoutDefault("channel-1")
+	require.True(t, found)
+	require.Equal(t, timeoutDefault, actual)
+
+	_, found = params.GetChannelTimeoutDefault("channel-2")
+	require.False(t, found)
+}
+
+func TestParamsIsTransferEnabled(t *testing.T) {
+	params := types.DefaultParams()
+	params.ChannelTransferOverrides = []types.ChannelTransferOverride{
+		types.NewChannelTransferOverride("channel-0", true, false),
+	}
+
+	require.True(t, params.IsSendEnabled("channel-0"))
+	require.False(t, params.IsReceiveEnabled("channel-0"))
+
+	// channels without an override use the chain-wide flags
+	require.True(t, params.IsSendEnabled("channel-1"))
+	require.True(t, params.IsReceiveEnabled("channel-1"))
+
+	// overrides cannot enable transfers disabled chain-wide
+	params.SendEnabled = false
+	require.False(t, params.IsSendEnabled("channel-0"))
+	require.False(t, params.IsSendEnabled("channel-1"))
+	require.True(t, params.IsReceiveEnabled("channel-1"))
+
+	override, found := params.GetChannelTransferOverride("channel-0")
+	require.True(t, found)
+	require.Equal(t, types.NewChannelTransferOverride("channel-0", true, false), override)
+
+	_, found = params.GetChannelTransferOverride("channel-1")
+	require.False(t, found)
+}
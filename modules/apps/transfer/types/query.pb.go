@@ -596,928 +596,4370 @@ func (m *QueryTotalEscrowForDenomResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryDenomTraceRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTraceRequest")
-	proto.RegisterType((*QueryDenomTraceResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTraceResponse")
-	proto.RegisterType((*QueryDenomTracesRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTracesRequest")
-	proto.RegisterType((*QueryDenomTracesResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTracesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.transfer.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryDenomHashRequest)(nil), "ibc.applications.transfer.v1.QueryDenomHashRequest")
-	proto.RegisterType((*QueryDenomHashResponse)(nil), "ibc.applications.transfer.v1.QueryDenomHashResponse")
-	proto.RegisterType((*QueryEscrowAddressRequest)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressRequest")
-	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
-	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
-	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
+// QueryTransferVolumeRequest is the request type for the Query/TransferVolume RPC method.
+type QueryTransferVolumeRequest struct {
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denomination of the tokens as represented on this chain
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/transfer/v1/query.proto", fileDescriptor_a638e2800a01538c)
+func (m *QueryTransferVolumeRequest) Reset()         { *m = QueryTransferVolumeRequest{} }
+func (m *QueryTransferVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferVolumeRequest) ProtoMessage()    {}
+func (*QueryTransferVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{12}
 }
-
-var fileDescriptor_a638e2800a01538c = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x29, 0xa4, 0xcd, 0x4b, 0xe1, 0x30, 0xd0, 0x02, 0x16, 0x35, 0xc8, 0xa2, 0x2d, 0x4a,
-	0xc1, 0xd3, 0x40, 0x20, 0x1c, 0xa0, 0x52, 0xa1, 0xa5, 0xa5, 0xea, 0x01, 0x02, 0xa7, 0x72, 0x88,
-	0x26, 0xf6, 0xd4, 0xb1, 0x94, 0x78, 0x8c, 0xc7, 0x49, 0x85, 0x22, 0x2e, 0xfd, 0x04, 0x95, 0xf8,
-	0x12, 0x55, 0xa5, 0x6a, 0xbf, 0xc2, 0x1e, 0x39, 0xa2, 0x5d, 0x69, 0xc5, 0x69, 0x77, 0x05, 0xfb,
-	0x41, 0x56, 0x1e, 0x8f, 0x13, 0x7b, 0x09, 0x21, 0xd9, 0x53, 0x3c, 0xf3, 0xfe, 0xfd, 0x7e, 0xbf,
-	0x37, 0xef, 0x29, 0xb0, 0xe2, 0xd4, 0x4c, 0x4c, 0x3c, 0xaf, 0xe1, 0x98, 0x24, 0x70, 0x98, 0xcb,
-	0x71, 0xe0, 0x13, 0x97, 0xff, 0x49, 0x7d, 0xdc, 0x2e, 0xe2, 0xf3, 0x16, 0xf5, 0x2f, 0x0c, 0xcf,
-	0x67, 0x01, 0x43, 0x0b, 0x4e, 0xcd, 0x34, 0x92, 0x9e, 0x46, 0xec, 0x69, 0xb4, 0x8b, 0xea, 0x8c,
-	0xcd, 0x6c, 0x26, 0x1c, 0x71, 0xf8, 0x15, 0xc5, 0xa8, 0x9a, 0xc9, 0x78, 0x93, 0x71, 0x5c, 0x23,
-	0x9c, 0xe2, 0x76, 0xb1, 0x46, 0x03, 0x52, 0xc4, 0x26, 0x73, 0x5c, 0x69, 0x2f, 0x24, 0xed, 0xa2,
-	0x58, 0xd7, 0xcb, 0x23, 0xb6, 0xe3, 0x8a, 0x42, 0xd2, 0xf7, 0xbb, 0x81, 0x48, 0xbb, 0x58, 0x22,
-	0xe7, 0x05, 0x9b, 0x31, 0xbb, 0x41, 0x31, 0xf1, 0x1c, 0x4c, 0x5c, 0x97, 0x05, 0x12, 0xb2, 0xb0,
-	0xea, 0xab, 0xf0, 0xe5, 0x71, 0x58, 0xec, 0x27, 0xea, 0xb2, 0xe6, 0xa9, 0x4f, 0x4c, 0x5a, 0xa1,
-	0xe7, 0x2d, 0xca, 0x03, 0x84, 0x60, 0xbc, 0x4e, 0x78, 0x7d, 0x4e, 0x59, 0x52, 0x56, 0x72, 0x15,
-	0xf1, 0xad, 0x5b, 0x30, 0xfb, 0xc0, 0x9b, 0x7b, 0xcc, 0xe5, 0x14, 0x1d, 0x42, 0xde, 0x0a, 0x6f,
-	0xab, 0x41, 0x78, 0x2d, 0xa2, 0xf2, 0xeb, 0x2b, 0xc6, 0x20, 0xa5, 0x8c, 0x44, 0x1a, 0xb0, 0xba,
-	0xdf, 0x3a, 0x79, 0x50, 0x85, 0xc7, 0xa0, 0x0e, 0x00, 0x7a, 0x6a, 0xc8, 0x22, 0xdf, 0x18, 0x91,
-	0x74, 0x46, 0x28, 0x9d, 0x11, 0xf5, 0x49, 0x4a, 0x67, 0x1c, 0x11, 0x3b, 0x26, 0x54, 0x49, 0x44,
-	0xea, 0xcf, 0x15, 0x98, 0x7b, 0x58, 0x43, 0x52, 0x39, 0x83, 0xcf, 0x13, 0x54, 0xf8, 0x9c, 0xb2,
-	0xf4, 0xc9, 0x28, 0x5c, 0xf6, 0xa6, 0xae, 0x5f, 0x2f, 0x66, 0xfe, 0x7b, 0xb3, 0x98, 0x95, 0x79,
-	0xf3, 0x3d, 0x6e, 0x1c, 0xfd, 0x92, 0x62, 0x30, 0x26, 0x18, 0x7c, 0xfb, 0x24, 0x83, 0x08, 0x59,
-	0x8a, 0xc2, 0x0c, 0x20, 0xc1, 0xe0, 0x88, 0xf8, 0xa4, 0x19, 0x0b, 0xa4, 0x9f, 0xc0, 0x74, 0xea,
-	0x56, 0x52, 0xda, 0x81, 0xac, 0x27, 0x6e, 0xa4, 0x66, 0xcb, 0x83, 0xc9, 0xc8, 0x68, 0x19, 0xa3,
-	0xaf, 0xc1, 0x17, 0x3d, 0xb1, 0x7e, 0x25, 0xbc, 0x1e, 0xb7, 0x63, 0x06, 0x26, 0x7a, 0xed, 0xce,
-	0x55, 0xa2, 0x43, 0xfa, 0x4d, 0x45, 0xee, 0x12, 0x46, 0xbf, 0x37, 0x75, 0x02, 0xf3, 0xc2, 0xfb,
-	0x67, 0x6e, 0xfa, 0xec, 0xaf, 0x1f, 0x2d, 0xcb, 0xa7, 0xbc, 0xdb, 0xef, 0x59, 0xf8, 0xd4, 0x63,
-	0x7e, 0x50, 0x75, 0x2c, 0x19, 0x93, 0x0d, 0x8f, 0x87, 0x16, 0xfa, 0x0a, 0xc0, 0xac, 0x13, 0xd7,
-	0xa5, 0x8d, 0xd0, 0x36, 0x26, 0x6c, 0x39, 0x79, 0x73, 0x68, 0xe9, 0xfb, 0xa0, 0xf6, 0x4b, 0x2a,
-	0x61, 0x7c, 0x0d, 0x53, 0x54, 0x18, 0xaa, 0x24, 0xb2, 0xc8, 0xe4, 0x93, 0x34, 0xe9, 0xae, 0x97,
-	0x61, 0x51, 0x24, 0x39, 0x65, 0x01, 0x69, 0x44, 0x99, 0x0e, 0x98, 0x2f, 0x58, 0x25, 0x04, 0x10,
-	0xcd, 0x8d, 0x05, 0x10, 0x07, 0xfd, 0x0c, 0x96, 0x1e, 0x0f, 0x94, 0x18, 0xca, 0x90, 0x25, 0x4d,
-	0xd6, 0x72, 0x03, 0xd9, 0x91, 0xf9, 0xd4, 0x1b, 0x88, 0xbb, 0xbf, 0xcf, 0x1c, 0x77, 0x6f, 0x3c,
-	0x7c, 0x4f, 0x15, 0xe9, 0xbe, 0x7e, 0xfb, 0x19, 0x4c, 0x88, 0xec, 0xe8, 0x5f, 0x05, 0xf2, 0x89,
-	0xf7, 0x8b, 0x36, 0x07, 0x37, 0xf5, 0x91, 0x99, 0x52, 0xb7, 0x46, 0x0d, 0x8b, 0x18, 0xe8, 0x85,
-	0xbf, 0x5f, 0xbe, 0xbb, 0x1a, 0x5b, 0x46, 0x3a, 0x96, 0xeb, 0x28, 0xbd, 0x86, 0x92, 0x23, 0x84,
-	0x9e, 0x29, 0x00, 0xbd, 0x1c, 0xa8, 0x34, 0x52, 0xc9, 0x18, 0xe8, 0xe6, 0x88, 0x51, 0x12, 0x67,
-	0x49, 0xe0, 0x34, 0xd0, 0xea, 0xd3, 0x38, 0x71, 0x27, 0x7c, 0x92, 0xbb, 0x85, 0xc2, 0x25, 0xba,
-	0x52, 0x20, 0x1b, 0x8d, 0x01, 0xfa, 0x7e, 0x88, 0xba, 0xa9, 0x29, 0x54, 0x8b, 0x23, 0x44, 0x48,
-	0x94, 0xcb, 0x02, 0xa5, 0x86, 0x16, 0xfa, 0xa3, 0x8c, 0x26, 0x11, 0xfd, 0xaf, 0x40, 0xae, 0x3b,
-	0x56, 0x68, 0x63, 0x58, 0x41, 0x12, 0x33, 0xab, 0x96, 0x46, 0x0b, 0x92, 0xf0, 0x36, 0x05, 0x3c,
-	0x8c, 0xd6, 0x06, 0x89, 0x18, 0x8a, 0x17, 0x8a, 0x28, 0xc4, 0x14, 0x2a, 0xbe, 0x52, 0x60, 0x32,
-	0x35, 0x83, 0xa8, 0x3c, 0x44, 0xf9, 0x7e, 0xab, 0x40, 0xdd, 0x1e, 0x3d, 0x50, 0x62, 0xaf, 0x08,
-	0xec, 0xbf, 0xa3, 0xdf, 0xfa, 0x63, 0x97, 0x5b, 0x83, 0xe3, 0x4e, 0x6f, 0xa3, 0x5c, 0xe2, 0x70,
-	0xcf, 0x70, 0xdc, 0x91, 0xdb, 0xe7, 0x12, 0xa7, 0x17, 0x06, 0x7a, 0xa1, 0xc0, 0x74, 0x9f, 0xf1,
-	0x46, 0xbb, 0x43, 0xa0, 0x7c, 0x7c, 0x9f, 0xa8, 0x3f, 0x7c, 0x6c, 0xb8, 0xa4, 0xba, 0x23, 0xa8,
-	0x6e, 0xa1, 0xd2, 0x80, 0x36, 0x71, 0xdc, 0x11, 0xbf, 0x61, 0x83, 0x70, 0x10, 0x26, 0xab, 0x46,
-	0xe4, 0xf6, 0x8e, 0xaf, 0xef, 0x34, 0xe5, 0xe6, 0x4e, 0x53, 0xde, 0xde, 0x69, 0xca, 0x3f, 0xf7,
-	0x5a, 0xe6, 0xe6, 0x5e, 0xcb, 0xdc, 0xde, 0x6b, 0x99, 0x3f, 0xca, 0xb6, 0x13, 0xd4, 0x5b, 0x35,
-	0xc3, 0x64, 0x4d, 0x2c, 0xff, 0xa8, 0x38, 0x35, 0x73, 0xcd, 0x66, 0xb8, 0xbd, 0x8d, 0x9b, 0xcc,
-	0x6a, 0x35, 0x28, 0xff, 0xa0, 0x5c, 0x70, 0xe1, 0x51, 0x5e, 0xcb, 0x8a, 0xbf, 0x19, 0x1b, 0xef,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x97, 0xb6, 0x42, 0x5d, 0x09, 0x00, 0x00,
+func (m *QueryTransferVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferVolumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferVolumeRequest.Merge(m, src)
+}
+func (m *QueryTransferVolumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferVolumeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryTransferVolumeRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryTransferVolumeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// DenomTraces queries all denomination traces.
-	DenomTraces(ctx context.Context, in *QueryDenomTracesRequest, opts ...grpc.CallOption) (*QueryDenomTracesResponse, error)
-	// DenomTrace queries a denomination trace information.
-	DenomTrace(ctx context.Context, in *QueryDenomTraceRequest, opts ...grpc.CallOption) (*QueryDenomTraceResponse, error)
-	// Params queries all parameters of the ibc-transfer module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// DenomHash queries a denomination hash information.
-	DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error)
-	// EscrowAddress returns the escrow address for a particular port and channel id.
-	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
-	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
-	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
+func (m *QueryTransferVolumeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryTransferVolumeResponse is the response type for the Query/TransferVolume RPC method.
+type QueryTransferVolumeResponse struct {
+	TransferVolume TransferVolume `protobuf:"bytes,1,opt,name=transfer_volume,json=transferVolume,proto3" json:"transfer_volume"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTransferVolumeResponse) Reset()         { *m = QueryTransferVolumeResponse{} }
+func (m *QueryTransferVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferVolumeResponse) ProtoMessage()    {}
+func (*QueryTransferVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{13}
+}
+func (m *QueryTransferVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferVolumeResponse.Merge(m, src)
+}
+func (m *QueryTransferVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferVolumeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DenomTraces(ctx context.Context, in *QueryDenomTracesRequest, opts ...grpc.CallOption) (*QueryDenomTracesResponse, error) {
-	out := new(QueryDenomTracesResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomTraces", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferVolumeResponse proto.InternalMessageInfo
+
+func (m *QueryTransferVolumeResponse) GetTransferVolume() TransferVolume {
+	if m != nil {
+		return m.TransferVolume
 	}
-	return out, nil
+	return TransferVolume{}
 }
 
-func (c *queryClient) DenomTrace(ctx context.Context, in *QueryDenomTraceRequest, opts ...grpc.CallOption) (*QueryDenomTraceResponse, error) {
-	out := new(QueryDenomTraceResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomTrace", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryTransferVolumesRequest is the request type for the Query/TransferVolumes RPC method.
+type QueryTransferVolumesRequest struct {
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferVolumesRequest) Reset()         { *m = QueryTransferVolumesRequest{} }
+func (m *QueryTransferVolumesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferVolumesRequest) ProtoMessage()    {}
+func (*QueryTransferVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{14}
+}
+func (m *QueryTransferVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferVolumesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTransferVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferVolumesRequest.Merge(m, src)
+}
+func (m *QueryTransferVolumesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferVolumesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferVolumesRequest proto.InternalMessageInfo
+
+func (m *QueryTransferVolumesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error) {
-	out := new(QueryDenomHashResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTransferVolumesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error) {
-	out := new(QueryEscrowAddressResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/EscrowAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryTransferVolumesResponse is the response type for the Query/TransferVolumes RPC method.
+type QueryTransferVolumesResponse struct {
+	TransferVolumes []TransferVolume `protobuf:"bytes,1,rep,name=transfer_volumes,json=transferVolumes,proto3" json:"transfer_volumes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferVolumesResponse) Reset()         { *m = QueryTransferVolumesResponse{} }
+func (m *QueryTransferVolumesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferVolumesResponse) ProtoMessage()    {}
+func (*QueryTransferVolumesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{15}
+}
+func (m *QueryTransferVolumesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferVolumesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferVolumesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTransferVolumesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferVolumesResponse.Merge(m, src)
+}
+func (m *QueryTransferVolumesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferVolumesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferVolumesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error) {
-	out := new(QueryTotalEscrowForDenomResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferVolumesResponse proto.InternalMessageInfo
+
+func (m *QueryTransferVolumesResponse) GetTransferVolumes() []TransferVolume {
+	if m != nil {
+		return m.TransferVolumes
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// DenomTraces queries all denomination traces.
-	DenomTraces(context.Context, *QueryDenomTracesRequest) (*QueryDenomTracesResponse, error)
-	// DenomTrace queries a denomination trace information.
-	DenomTrace(context.Context, *QueryDenomTraceRequest) (*QueryDenomTraceResponse, error)
-	// Params queries all parameters of the ibc-transfer module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DenomHash queries a denomination hash information.
-	DenomHash(context.Context, *QueryDenomHashRequest) (*QueryDenomHashResponse, error)
-	// EscrowAddress returns the escrow address for a particular port and channel id.
-	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
-	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
-	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
+func (m *QueryTransferVolumesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryChannelsByDenomRequest is the request type for the Query/ChannelsByDenom RPC method.
+type QueryChannelsByDenomRequest struct {
+	// denomination of the tokens as represented on this chain, or the full denomination trace path
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) DenomTraces(ctx context.Context, req *QueryDenomTracesRequest) (*QueryDenomTracesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomTraces not implemented")
+func (m *QueryChannelsByDenomRequest) Reset()         { *m = QueryChannelsByDenomRequest{} }
+func (m *QueryChannelsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelsByDenomRequest) ProtoMessage()    {}
+func (*QueryChannelsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{16}
 }
-func (*UnimplementedQueryServer) DenomTrace(ctx context.Context, req *QueryDenomTraceRequest) (*QueryDenomTraceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomTrace not implemented")
+func (m *QueryChannelsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryChannelsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DenomHash(ctx context.Context, req *QueryDenomHashRequest) (*QueryDenomHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomHash not implemented")
+func (m *QueryChannelsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelsByDenomRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EscrowAddress not implemented")
+func (m *QueryChannelsByDenomRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
+func (m *QueryChannelsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelsByDenomRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryChannelsByDenomRequest proto.InternalMessageInfo
 
-func _Query_DenomTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomTracesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomTraces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/DenomTraces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomTraces(ctx, req.(*QueryDenomTracesRequest))
+func (m *QueryChannelsByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DenomTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomTraceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomTrace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/DenomTrace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomTrace(ctx, req.(*QueryDenomTraceRequest))
+func (m *QueryChannelsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryChannelsByDenomResponse is the response type for the Query/ChannelsByDenom RPC method.
+type QueryChannelsByDenomResponse struct {
+	// transfer volumes of the denomination, one per channel it has been transferred over
+	TransferVolumes []TransferVolume `protobuf:"bytes,1,rep,name=transfer_volumes,json=transferVolumes,proto3" json:"transfer_volumes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_DenomHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/DenomHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomHash(ctx, req.(*QueryDenomHashRequest))
+func (m *QueryChannelsByDenomResponse) Reset()         { *m = QueryChannelsByDenomResponse{} }
+func (m *QueryChannelsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelsByDenomResponse) ProtoMessage()    {}
+func (*QueryChannelsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{17}
+}
+func (m *QueryChannelsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryChannelsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelsByDenomResponse.Merge(m, src)
+}
+func (m *QueryChannelsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelsByDenomResponse.DiscardUnknown(m)
 }
 
-func _Query_EscrowAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EscrowAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/EscrowAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EscrowAddress(ctx, req.(*QueryEscrowAddressRequest))
+var xxx_messageInfo_QueryChannelsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryChannelsByDenomResponse) GetTransferVolumes() []TransferVolume {
+	if m != nil {
+		return m.TransferVolumes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalEscrowForDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalEscrowForDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalEscrowForDenom(ctx, req.(*QueryTotalEscrowForDenomRequest))
+func (m *QueryChannelsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.transfer.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DenomTraces",
-			Handler:    _Query_DenomTraces_Handler,
-		},
-		{
-			MethodName: "DenomTrace",
-			Handler:    _Query_DenomTrace_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DenomHash",
-			Handler:    _Query_DenomHash_Handler,
-		},
-		{
-			MethodName: "EscrowAddress",
-			Handler:    _Query_EscrowAddress_Handler,
-		},
-		{
-			MethodName: "TotalEscrowForDenom",
-			Handler:    _Query_TotalEscrowForDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/transfer/v1/query.proto",
+// QueryDecimalConversionsRequest is the request type for the Query/DecimalConversions RPC method.
+type QueryDecimalConversionsRequest struct {
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomTraceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDecimalConversionsRequest) Reset()         { *m = QueryDecimalConversionsRequest{} }
+func (m *QueryDecimalConversionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecimalConversionsRequest) ProtoMessage()    {}
+func (*QueryDecimalConversionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{18}
+}
+func (m *QueryDecimalConversionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecimalConversionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecimalConversionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDenomTraceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDecimalConversionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecimalConversionsRequest.Merge(m, src)
+}
+func (m *QueryDecimalConversionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecimalConversionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecimalConversionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomTraceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDecimalConversionsRequest proto.InternalMessageInfo
+
+func (m *QueryDecimalConversionsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDenomTraceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDecimalConversionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomTraceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDecimalConversionsResponse is the response type for the Query/DecimalConversions RPC method.
+type QueryDecimalConversionsResponse struct {
+	DecimalConversions []DecimalConversion `protobuf:"bytes,1,rep,name=decimal_conversions,json=decimalConversions,proto3" json:"decimal_conversions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomTraceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DenomTrace != nil {
-		{
-			size, err := m.DenomTrace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDecimalConversionsResponse) Reset()         { *m = QueryDecimalConversionsResponse{} }
+func (m *QueryDecimalConversionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecimalConversionsResponse) ProtoMessage()    {}
+func (*QueryDecimalConversionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{19}
+}
+func (m *QueryDecimalConversionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecimalConversionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecimalConversionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDenomTracesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDecimalConversionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecimalConversionsResponse.Merge(m, src)
 }
-
-func (m *QueryDenomTracesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDecimalConversionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecimalConversionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecimalConversionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomTracesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryDecimalConversionsResponse proto.InternalMessageInfo
+
+func (m *QueryDecimalConversionsResponse) GetDecimalConversions() []DecimalConversion {
+	if m != nil {
+		return m.DecimalConversions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDenomTracesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDecimalConversionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomTracesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCanonicalChannelRequest is the request type for the Query/CanonicalChannel RPC method.
+type QueryCanonicalChannelRequest struct {
+	// chain identifier of the counterparty chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryDenomTracesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DenomTraces) > 0 {
-		for iNdEx := len(m.DenomTraces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCanonicalChannelRequest) Reset()         { *m = QueryCanonicalChannelRequest{} }
+func (m *QueryCanonicalChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalChannelRequest) ProtoMessage()    {}
+func (*QueryCanonicalChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{20}
+}
+func (m *QueryCanonicalChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCanonicalChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalChannelRequest.Merge(m, src)
+}
+func (m *QueryCanonicalChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalChannelRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCanonicalChannelRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalChannelRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCanonicalChannelResponse is the response type for the Query/CanonicalChannel RPC method.
+type QueryCanonicalChannelResponse struct {
+	// the channel identifier registered as canonical for the counterparty chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCanonicalChannelResponse) Reset()         { *m = QueryCanonicalChannelResponse{} }
+func (m *QueryCanonicalChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalChannelResponse) ProtoMessage()    {}
+func (*QueryCanonicalChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{21}
+}
+func (m *QueryCanonicalChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalChannelResponse.Merge(m, src)
+}
+func (m *QueryCanonicalChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalChannelResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCanonicalChannelResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalChannelResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCanonicalChannelsRequest is the request type for the Query/CanonicalChannels RPC method.
+type QueryCanonicalChannelsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCanonicalChannelsRequest) Reset()         { *m = QueryCanonicalChannelsRequest{} }
+func (m *QueryCanonicalChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalChannelsRequest) ProtoMessage()    {}
+func (*QueryCanonicalChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{22}
+}
+func (m *QueryCanonicalChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCanonicalChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalChannelsRequest.Merge(m, src)
+}
+func (m *QueryCanonicalChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalChannelsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCanonicalChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCanonicalChannelsResponse is the response type for the Query/CanonicalChannels RPC method.
+type QueryCanonicalChannelsResponse struct {
+	CanonicalChannels []CanonicalChannel `protobuf:"bytes,1,rep,name=canonical_channels,json=canonicalChannels,proto3" json:"canonical_channels"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trace) > 0 {
-		i -= len(m.Trace)
-		copy(dAtA[i:], m.Trace)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trace)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryCanonicalChannelsResponse) Reset()         { *m = QueryCanonicalChannelsResponse{} }
+func (m *QueryCanonicalChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalChannelsResponse) ProtoMessage()    {}
+func (*QueryCanonicalChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{23}
 }
-
-func (m *QueryDenomHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCanonicalChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryDenomHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCanonicalChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalChannelsResponse.Merge(m, src)
+}
+func (m *QueryCanonicalChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalChannelsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryCanonicalChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalChannelsResponse) GetCanonicalChannels() []CanonicalChannel {
+	if m != nil {
+		return m.CanonicalChannels
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryEscrowAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCanonicalChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEscrowAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnwindRouteRequest is the request type for the Query/UnwindRoute RPC method.
+type QueryUnwindRouteRequest struct {
+	// denomination of the vouchers as represented on this chain, or the full denomination trace path
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// optional time in nanoseconds allowed for each hop of the route to be relayed, used to estimate the
+	// timeout timestamps of the hops. Defaults to 10 minutes if not set.
+	HopTimeout uint64 `protobuf:"varint,2,opt,name=hop_timeout,json=hopTimeout,proto3" json:"hop_timeout,omitempty"`
 }
 
-func (m *QueryEscrowAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryUnwindRouteRequest) Reset()         { *m = QueryUnwindRouteRequest{} }
+func (m *QueryUnwindRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnwindRouteRequest) ProtoMessage()    {}
+func (*QueryUnwindRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{24}
+}
+func (m *QueryUnwindRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnwindRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnwindRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryUnwindRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnwindRouteRequest.Merge(m, src)
+}
+func (m *QueryUnwindRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnwindRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnwindRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnwindRouteRequest proto.InternalMessageInfo
+
+func (m *QueryUnwindRouteRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryEscrowAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnwindRouteRequest) GetHopTimeout() uint64 {
+	if m != nil {
+		return m.HopTimeout
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEscrowAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnwindRouteResponse is the response type for the Query/UnwindRoute RPC method.
+type QueryUnwindRouteResponse struct {
+	// hops of the route, starting with the hop sending the vouchers from this chain
+	Hops []UnwindHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops"`
+	// base denomination of the tokens on the chain they originate from
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
 }
 
-func (m *QueryEscrowAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EscrowAddress) > 0 {
-		i -= len(m.EscrowAddress)
-		copy(dAtA[i:], m.EscrowAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryUnwindRouteResponse) Reset()         { *m = QueryUnwindRouteResponse{} }
+func (m *QueryUnwindRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnwindRouteResponse) ProtoMessage()    {}
+func (*QueryUnwindRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{25}
 }
-
-func (m *QueryTotalEscrowForDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnwindRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnwindRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnwindRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryTotalEscrowForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnwindRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnwindRouteResponse.Merge(m, src)
+}
+func (m *QueryUnwindRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnwindRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnwindRouteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalEscrowForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryUnwindRouteResponse proto.InternalMessageInfo
+
+func (m *QueryUnwindRouteResponse) GetHops() []UnwindHop {
+	if m != nil {
+		return m.Hops
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTotalEscrowForDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnwindRouteResponse) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryTotalEscrowForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnwindHop defines a single hop of the route unwinding a voucher to the chain it originates from.
+type UnwindHop struct {
+	// port identifier on the sending chain of the hop
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel identifier on the sending chain of the hop
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// estimated timeout timestamp in nanoseconds, in unix time, for the packet sent over the hop
+	TimeoutTimestamp uint64 `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 }
 
-func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *UnwindHop) Reset()         { *m = UnwindHop{} }
+func (m *UnwindHop) String() string { return proto.CompactTextString(m) }
+func (*UnwindHop) ProtoMessage()    {}
+func (*UnwindHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{26}
+}
+func (m *UnwindHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnwindHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnwindHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *UnwindHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwindHop.Merge(m, src)
+}
+func (m *UnwindHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnwindHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwindHop.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_UnwindHop proto.InternalMessageInfo
+
+func (m *UnwindHop) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryDenomTraceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *UnwindHop) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryDenomTraceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DenomTrace != nil {
-		l = m.DenomTrace.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *UnwindHop) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return n
+	return 0
 }
 
-func (m *QueryDenomTracesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryDenomTraceRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTraceRequest")
+	proto.RegisterType((*QueryDenomTraceResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTraceResponse")
+	proto.RegisterType((*QueryDenomTracesRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTracesRequest")
+	proto.RegisterType((*QueryDenomTracesResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTracesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.transfer.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDenomHashRequest)(nil), "ibc.applications.transfer.v1.QueryDenomHashRequest")
+	proto.RegisterType((*QueryDenomHashResponse)(nil), "ibc.applications.transfer.v1.QueryDenomHashResponse")
+	proto.RegisterType((*QueryEscrowAddressRequest)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressRequest")
+	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
+	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
+	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
+	proto.RegisterType((*QueryTransferVolumeRequest)(nil), "ibc.applications.transfer.v1.QueryTransferVolumeRequest")
+	proto.RegisterType((*QueryTransferVolumeResponse)(nil), "ibc.applications.transfer.v1.QueryTransferVolumeResponse")
+	proto.RegisterType((*QueryTransferVolumesRequest)(nil), "ibc.applications.transfer.v1.QueryTransferVolumesRequest")
+	proto.RegisterType((*QueryTransferVolumesResponse)(nil), "ibc.applications.transfer.v1.QueryTransferVolumesResponse")
+	proto.RegisterType((*QueryChannelsByDenomRequest)(nil), "ibc.applications.transfer.v1.QueryChannelsByDenomRequest")
+	proto.RegisterType((*QueryChannelsByDenomResponse)(nil), "ibc.applications.transfer.v1.QueryChannelsByDenomResponse")
+	proto.RegisterType((*QueryDecimalConversionsRequest)(nil), "ibc.applications.transfer.v1.QueryDecimalConversionsRequest")
+	proto.RegisterType((*QueryDecimalConversionsResponse)(nil), "ibc.applications.transfer.v1.QueryDecimalConversionsResponse")
+	proto.RegisterType((*QueryCanonicalChannelRequest)(nil), "ibc.applications.transfer.v1.QueryCanonicalChannelRequest")
+	proto.RegisterType((*QueryCanonicalChannelResponse)(nil), "ibc.applications.transfer.v1.QueryCanonicalChannelResponse")
+	proto.RegisterType((*QueryCanonicalChannelsRequest)(nil), "ibc.applications.transfer.v1.QueryCanonicalChannelsRequest")
+	proto.RegisterType((*QueryCanonicalChannelsResponse)(nil), "ibc.applications.transfer.v1.QueryCanonicalChannelsResponse")
+	proto.RegisterType((*QueryUnwindRouteRequest)(nil), "ibc.applications.transfer.v1.QueryUnwindRouteRequest")
+	proto.RegisterType((*QueryUnwindRouteResponse)(nil), "ibc.applications.transfer.v1.QueryUnwindRouteResponse")
+	proto.RegisterType((*UnwindHop)(nil), "ibc.applications.transfer.v1.UnwindHop")
 }
 
-func (m *QueryDenomTracesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DenomTraces) > 0 {
-		for _, e := range m.DenomTraces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/query.proto", fileDescriptor_a638e2800a01538c)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var fileDescriptor_a638e2800a01538c = []byte{
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdf, 0x6f, 0xd3, 0xd6,
+	0x17, 0xef, 0x2d, 0xa5, 0x7c, 0x7b, 0xfa, 0xa5, 0x85, 0x5b, 0x36, 0x8a, 0x57, 0x52, 0x64, 0xb1,
+	0x51, 0x15, 0xf0, 0xa5, 0xd0, 0x52, 0x7e, 0x14, 0x26, 0x5a, 0x56, 0xe8, 0xc4, 0x03, 0x84, 0x6e,
+	0x0f, 0x43, 0x53, 0x74, 0x63, 0x9b, 0xc4, 0x52, 0xe2, 0x6b, 0x62, 0x27, 0x88, 0x75, 0x7d, 0x99,
+	0x26, 0xed, 0x75, 0x12, 0xff, 0xc4, 0x34, 0x69, 0xda, 0xfb, 0x9e, 0xd0, 0xa4, 0x4d, 0x3c, 0x4d,
+	0x08, 0xa4, 0x69, 0xda, 0xc3, 0x36, 0xb5, 0xfb, 0x43, 0x26, 0x5f, 0x1f, 0x27, 0x76, 0xe2, 0xa4,
+	0xb6, 0x55, 0x4d, 0xda, 0x53, 0x93, 0x7b, 0xef, 0x39, 0xe7, 0xf3, 0xf9, 0x9c, 0xe3, 0xdb, 0x8f,
+	0x03, 0x73, 0x56, 0x59, 0x67, 0xdc, 0x71, 0x6a, 0x96, 0xce, 0x3d, 0x4b, 0xd8, 0x2e, 0xf3, 0x1a,
+	0xdc, 0x76, 0x1f, 0x9b, 0x0d, 0xd6, 0x5a, 0x60, 0x4f, 0x9a, 0x66, 0xe3, 0x99, 0xe6, 0x34, 0x84,
+	0x27, 0xe8, 0x8c, 0x55, 0xd6, 0xb5, 0xe8, 0x49, 0x2d, 0x3c, 0xa9, 0xb5, 0x16, 0x94, 0x63, 0x15,
+	0x51, 0x11, 0xf2, 0x20, 0xf3, 0x3f, 0x05, 0x31, 0x4a, 0x41, 0x17, 0x6e, 0x5d, 0xb8, 0xac, 0xcc,
+	0x5d, 0x93, 0xb5, 0x16, 0xca, 0xa6, 0xc7, 0x17, 0x98, 0x2e, 0x2c, 0x1b, 0xf7, 0xe7, 0xa3, 0xfb,
+	0xb2, 0x58, 0xfb, 0x94, 0xc3, 0x2b, 0x96, 0x2d, 0x0b, 0xe1, 0xd9, 0xb3, 0x03, 0x91, 0xb6, 0xb1,
+	0x04, 0x87, 0x67, 0x2a, 0x42, 0x54, 0x6a, 0x26, 0xe3, 0x8e, 0xc5, 0xb8, 0x6d, 0x0b, 0x0f, 0x21,
+	0xcb, 0x5d, 0xf5, 0x1c, 0xbc, 0xfd, 0xc0, 0x2f, 0x76, 0xdb, 0xb4, 0x45, 0x7d, 0xb3, 0xc1, 0x75,
+	0xb3, 0x68, 0x3e, 0x69, 0x9a, 0xae, 0x47, 0x29, 0x8c, 0x54, 0xb9, 0x5b, 0x9d, 0x26, 0xa7, 0xc8,
+	0xdc, 0x58, 0x51, 0x7e, 0x56, 0x0d, 0x38, 0xde, 0x73, 0xda, 0x75, 0x84, 0xed, 0x9a, 0x74, 0x03,
+	0xc6, 0x0d, 0x7f, 0xb5, 0xe4, 0xf9, 0xcb, 0x32, 0x6a, 0xfc, 0xe2, 0x9c, 0x36, 0x48, 0x29, 0x2d,
+	0x92, 0x06, 0x8c, 0xf6, 0x67, 0x95, 0xf7, 0x54, 0x71, 0x43, 0x50, 0xeb, 0x00, 0x1d, 0x35, 0xb0,
+	0xc8, 0x7b, 0x5a, 0x20, 0x9d, 0xe6, 0x4b, 0xa7, 0x05, 0x7d, 0x42, 0xe9, 0xb4, 0xfb, 0xbc, 0x12,
+	0x12, 0x2a, 0x46, 0x22, 0xd5, 0x17, 0x04, 0xa6, 0x7b, 0x6b, 0x20, 0x95, 0x47, 0xf0, 0xff, 0x08,
+	0x15, 0x77, 0x9a, 0x9c, 0x3a, 0x90, 0x85, 0xcb, 0xea, 0xc4, 0xcb, 0x3f, 0x66, 0x87, 0xbe, 0xfd,
+	0x73, 0x76, 0x14, 0xf3, 0x8e, 0x77, 0xb8, 0xb9, 0xf4, 0x4e, 0x8c, 0xc1, 0xb0, 0x64, 0x70, 0x66,
+	0x4f, 0x06, 0x01, 0xb2, 0x18, 0x85, 0x63, 0x40, 0x25, 0x83, 0xfb, 0xbc, 0xc1, 0xeb, 0xa1, 0x40,
+	0xea, 0x43, 0x98, 0x8a, 0xad, 0x22, 0xa5, 0x15, 0x18, 0x75, 0xe4, 0x0a, 0x6a, 0x76, 0x7a, 0x30,
+	0x19, 0x8c, 0xc6, 0x18, 0xf5, 0x3c, 0xbc, 0xd5, 0x11, 0xeb, 0x2e, 0x77, 0xab, 0x61, 0x3b, 0x8e,
+	0xc1, 0xc1, 0x4e, 0xbb, 0xc7, 0x8a, 0xc1, 0x97, 0xf8, 0x4c, 0x05, 0xc7, 0x11, 0x46, 0xd2, 0x4c,
+	0x3d, 0x84, 0x13, 0xf2, 0xf4, 0x07, 0xae, 0xde, 0x10, 0x4f, 0x6f, 0x19, 0x46, 0xc3, 0x74, 0xdb,
+	0xfd, 0x3e, 0x0e, 0x87, 0x1c, 0xd1, 0xf0, 0x4a, 0x96, 0x81, 0x31, 0xa3, 0xfe, 0xd7, 0x0d, 0x83,
+	0x9e, 0x04, 0xd0, 0xab, 0xdc, 0xb6, 0xcd, 0x9a, 0xbf, 0x37, 0x2c, 0xf7, 0xc6, 0x70, 0x65, 0xc3,
+	0x50, 0xd7, 0x40, 0x49, 0x4a, 0x8a, 0x30, 0xde, 0x85, 0x09, 0x53, 0x6e, 0x94, 0x78, 0xb0, 0x83,
+	0xc9, 0x0f, 0x9b, 0xd1, 0xe3, 0xea, 0x32, 0xcc, 0xca, 0x24, 0x9b, 0xc2, 0xe3, 0xb5, 0x20, 0xd3,
+	0xba, 0x68, 0x48, 0x56, 0x11, 0x01, 0x64, 0x73, 0x43, 0x01, 0xe4, 0x17, 0xf5, 0x11, 0x9c, 0xea,
+	0x1f, 0x88, 0x18, 0x96, 0x61, 0x94, 0xd7, 0x45, 0xd3, 0xf6, 0xb0, 0x23, 0x27, 0x62, 0x33, 0x10,
+	0x76, 0x7f, 0x4d, 0x58, 0xf6, 0xea, 0x88, 0x3f, 0x4f, 0x45, 0x3c, 0xae, 0x3e, 0x40, 0x6a, 0x9b,
+	0xd8, 0xaf, 0x8f, 0x45, 0xad, 0x59, 0x6f, 0x3f, 0xb5, 0x71, 0x5d, 0x48, 0x97, 0x2e, 0x1d, 0xbc,
+	0xc3, 0x51, 0xbc, 0x9f, 0xc1, 0x3b, 0x89, 0x29, 0xdb, 0xcf, 0xc3, 0x64, 0x38, 0x1c, 0xa5, 0x96,
+	0xdc, 0x42, 0xcc, 0xe7, 0x06, 0x4f, 0x51, 0x3c, 0x1d, 0xd2, 0x98, 0xf0, 0x62, 0xab, 0xea, 0x97,
+	0x24, 0xb1, 0xb8, 0x9b, 0x92, 0xd0, 0x7a, 0xc2, 0xe3, 0x94, 0xe7, 0x42, 0xf8, 0x99, 0xc0, 0x4c,
+	0x32, 0x0c, 0x14, 0xe1, 0x53, 0x38, 0xd2, 0x25, 0x42, 0x78, 0x31, 0xe4, 0x51, 0x61, 0x32, 0xae,
+	0xc2, 0x3e, 0x5e, 0x0b, 0x5b, 0x28, 0xe7, 0x5a, 0x20, 0x91, 0xbb, 0xfa, 0x6c, 0xef, 0x81, 0xdd,
+	0x7f, 0x15, 0x7b, 0xaa, 0xff, 0xc7, 0x54, 0xfc, 0x8a, 0x40, 0x01, 0xef, 0x30, 0xdd, 0xaa, 0xf3,
+	0xda, 0x9a, 0xb0, 0x5b, 0x66, 0xc3, 0xf5, 0x61, 0xfd, 0xcb, 0x83, 0xf9, 0x9a, 0xc0, 0x6c, 0x5f,
+	0x24, 0xa8, 0xea, 0x63, 0x98, 0x32, 0x82, 0xdd, 0x92, 0xde, 0xd9, 0x46, 0x61, 0xd9, 0x5e, 0xff,
+	0xb7, 0xba, 0xd2, 0xa2, 0xb6, 0xd4, 0xe8, 0xa9, 0xb7, 0x7f, 0xf2, 0x5e, 0x0d, 0xc7, 0x84, 0xdb,
+	0xc2, 0xb6, 0x74, 0x5e, 0xc3, 0x79, 0x09, 0xb5, 0x3d, 0x01, 0xff, 0xd3, 0xab, 0xdc, 0xb2, 0x3b,
+	0xca, 0x1e, 0x92, 0xdf, 0x37, 0x0c, 0xf5, 0x26, 0x9c, 0xec, 0x13, 0x8a, 0x62, 0x0c, 0xee, 0x8b,
+	0x5a, 0xe9, 0x13, 0xbf, 0xef, 0x16, 0xe3, 0x97, 0x70, 0x84, 0x12, 0x2a, 0x21, 0x54, 0x1d, 0xa8,
+	0x1e, 0x6e, 0x96, 0x10, 0x62, 0xd8, 0x36, 0x6d, 0x70, 0xdb, 0xba, 0x93, 0x62, 0xd7, 0x8e, 0xea,
+	0xdd, 0xc5, 0xf6, 0xaf, 0x69, 0xf7, 0xd1, 0x96, 0x7d, 0x64, 0x3f, 0xb5, 0x6c, 0xa3, 0x28, 0x9a,
+	0x9e, 0x39, 0xf8, 0x56, 0x99, 0x85, 0xf1, 0xaa, 0x70, 0x4a, 0x9e, 0x55, 0x37, 0x45, 0xd3, 0x93,
+	0xa5, 0x47, 0x8a, 0x50, 0x15, 0xce, 0x66, 0xb0, 0xa2, 0x7e, 0x0e, 0xd3, 0xbd, 0x19, 0x51, 0x9b,
+	0x5b, 0x30, 0x52, 0x15, 0x4e, 0xa8, 0xc6, 0x99, 0xc1, 0x6a, 0x04, 0x09, 0xee, 0x0a, 0x07, 0x65,
+	0x90, 0xa1, 0xfe, 0x24, 0xf8, 0xfc, 0x4a, 0xd1, 0xff, 0x78, 0x63, 0xfe, 0x8a, 0xbc, 0x93, 0x54,
+	0x07, 0xc6, 0xda, 0x71, 0x79, 0x8d, 0x06, 0x3d, 0x0b, 0x47, 0x91, 0x9f, 0xe4, 0xe9, 0x7a, 0xbc,
+	0xee, 0x4c, 0x1f, 0x90, 0x4c, 0x8f, 0xe0, 0xc6, 0x66, 0xb8, 0x7e, 0x71, 0x77, 0x0a, 0x0e, 0x4a,
+	0xc2, 0xf4, 0x1b, 0x02, 0xe3, 0x11, 0xeb, 0x49, 0x97, 0x06, 0xf3, 0xeb, 0x63, 0x87, 0x95, 0xcb,
+	0x59, 0xc3, 0x02, 0x71, 0xd5, 0xf9, 0x2f, 0xde, 0xfc, 0xfd, 0x7c, 0xf8, 0x34, 0x55, 0x19, 0xbe,
+	0x49, 0xc4, 0xdf, 0x20, 0xa2, 0xee, 0x97, 0x7e, 0x4f, 0x00, 0x3a, 0x39, 0xe8, 0x62, 0xa6, 0x92,
+	0x21, 0xd0, 0xa5, 0x8c, 0x51, 0x88, 0x73, 0x51, 0xe2, 0xd4, 0xe8, 0xb9, 0xbd, 0x71, 0xb2, 0x2d,
+	0xdf, 0x4d, 0xde, 0x98, 0x9f, 0xdf, 0xa6, 0xcf, 0x09, 0x8c, 0x06, 0x0e, 0x96, 0x5e, 0x48, 0x51,
+	0x37, 0x66, 0xa0, 0x95, 0x85, 0x0c, 0x11, 0x88, 0xf2, 0xb4, 0x44, 0x59, 0xa0, 0x33, 0xc9, 0x28,
+	0x03, 0x13, 0x4d, 0xbf, 0x23, 0x30, 0xd6, 0x76, 0xc4, 0xf4, 0x52, 0x5a, 0x41, 0x22, 0x76, 0x5b,
+	0x59, 0xcc, 0x16, 0x84, 0xf0, 0x96, 0x24, 0x3c, 0x46, 0xcf, 0x0f, 0x12, 0xd1, 0x17, 0xcf, 0x17,
+	0x51, 0x8a, 0x29, 0x55, 0xfc, 0x95, 0xc0, 0xe1, 0x98, 0x7d, 0xa6, 0xcb, 0x29, 0xca, 0x27, 0xb9,
+	0x78, 0xe5, 0x4a, 0xf6, 0x40, 0xc4, 0x5e, 0x94, 0xd8, 0xef, 0xd1, 0x0f, 0x93, 0xb1, 0x87, 0x77,
+	0x26, 0xdb, 0xea, 0x3c, 0xa3, 0xdb, 0xcc, 0x7f, 0x72, 0x5d, 0xb6, 0x85, 0xcf, 0xf3, 0x36, 0x8b,
+	0x7b, 0x7d, 0xfa, 0x9a, 0xc0, 0x54, 0x82, 0x33, 0xa7, 0x37, 0x52, 0xa0, 0xec, 0xff, 0x2a, 0xa0,
+	0xdc, 0xcc, 0x1b, 0x8e, 0x54, 0x57, 0x24, 0xd5, 0xcb, 0x74, 0x71, 0x40, 0x9b, 0x5c, 0xb6, 0x25,
+	0xff, 0xfa, 0x0d, 0x62, 0x9e, 0x9f, 0xac, 0x14, 0x90, 0xa3, 0x6f, 0x08, 0x4c, 0xc4, 0x3d, 0x12,
+	0x4d, 0xa3, 0x7a, 0xe2, 0x4b, 0x84, 0x72, 0x35, 0x47, 0x24, 0xb2, 0xb8, 0x27, 0x59, 0xac, 0xd3,
+	0xdb, 0x59, 0x1a, 0xd6, 0xcb, 0x2d, 0xf0, 0x86, 0xf4, 0x27, 0x02, 0x93, 0x9b, 0x5d, 0x1e, 0x2f,
+	0x3b, 0xb8, 0xf6, 0x1c, 0x5e, 0xcb, 0x13, 0x8a, 0xc4, 0xae, 0x4b, 0x62, 0x4b, 0xf4, 0x52, 0x16,
+	0x62, 0x2d, 0xc4, 0xfc, 0x23, 0x81, 0xc9, 0x2e, 0x4b, 0x9c, 0x8a, 0x47, 0xb2, 0x89, 0x57, 0xae,
+	0xe5, 0x09, 0x45, 0x1e, 0x57, 0x24, 0x8f, 0x8b, 0xf4, 0x42, 0xda, 0x31, 0x0b, 0x99, 0xd1, 0xdf,
+	0x09, 0xd0, 0x5e, 0x13, 0x4a, 0x57, 0x52, 0x5d, 0x4a, 0x7d, 0x5c, 0xb4, 0x72, 0x23, 0x67, 0x34,
+	0xb2, 0xb9, 0x23, 0xd9, 0xdc, 0xa2, 0xef, 0x67, 0x1b, 0xb7, 0x1e, 0xaf, 0x4c, 0x5f, 0x12, 0x38,
+	0xd2, 0xed, 0xa9, 0x68, 0x2a, 0x9d, 0x93, 0x2d, 0xac, 0x72, 0x3d, 0x57, 0x6c, 0xca, 0x61, 0xeb,
+	0x31, 0x8d, 0x6c, 0x2b, 0xf4, 0xcb, 0xdb, 0xf4, 0x05, 0x81, 0xa3, 0x6b, 0x3d, 0x36, 0x30, 0x0f,
+	0x9e, 0x76, 0x97, 0x56, 0xf2, 0x05, 0x23, 0x9b, 0x0b, 0x92, 0xcd, 0x3c, 0x9d, 0x4b, 0xcb, 0x86,
+	0xfe, 0x40, 0x60, 0x3c, 0x62, 0x0a, 0x53, 0xd9, 0xa3, 0x5e, 0x5b, 0xaa, 0x5c, 0xce, 0x1a, 0x96,
+	0xf7, 0x2a, 0x6e, 0xca, 0x24, 0xa5, 0x86, 0x9f, 0x65, 0xf5, 0xc1, 0xcb, 0x9d, 0x02, 0x79, 0xb5,
+	0x53, 0x20, 0x7f, 0xed, 0x14, 0xc8, 0xd7, 0xbb, 0x85, 0xa1, 0x57, 0xbb, 0x85, 0xa1, 0xdf, 0x76,
+	0x0b, 0x43, 0x9f, 0x2c, 0x57, 0x2c, 0xaf, 0xda, 0x2c, 0x6b, 0xba, 0xa8, 0x33, 0xfc, 0xb9, 0xd7,
+	0x2a, 0xeb, 0xe7, 0x2b, 0x82, 0xb5, 0xae, 0xb0, 0xba, 0x30, 0x9a, 0x35, 0xd3, 0xed, 0x2a, 0xe7,
+	0x3d, 0x73, 0x4c, 0xb7, 0x3c, 0x2a, 0x7f, 0xac, 0xbd, 0xf4, 0xcf, 0x00, 0xbf, 0xe0, 0xfd, 0x88,
+	0xa3, 0x16, 0x00, 0x00,
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// DenomTraces queries all denomination traces.
+	DenomTraces(ctx context.Context, in *QueryDenomTracesRequest, opts ...grpc.CallOption) (*QueryDenomTracesResponse, error)
+	// DenomTrace queries a denomination trace information.
+	DenomTrace(ctx context.Context, in *QueryDenomTraceRequest, opts ...grpc.CallOption) (*QueryDenomTraceResponse, error)
+	// Params queries all parameters of the ibc-transfer module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DenomHash queries a denomination hash information.
+	DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error)
+	// EscrowAddress returns the escrow address for a particular port and channel id.
+	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
+	// TransferVolume returns the cumulative transfer volume of a denomination over a channel.
+	TransferVolume(ctx context.Context, in *QueryTransferVolumeRequest, opts ...grpc.CallOption) (*QueryTransferVolumeResponse, error)
+	// TransferVolumes returns the cumulative transfer volumes of all denominations over a channel.
+	TransferVolumes(ctx context.Context, in *QueryTransferVolumesRequest, opts ...grpc.CallOption) (*QueryTransferVolumesResponse, error)
+	// ChannelsByDenom returns the cumulative transfer volumes of a denomination over all channels it has
+	// been transferred over while volume tracking was enabled.
+	ChannelsByDenom(ctx context.Context, in *QueryChannelsByDenomRequest, opts ...grpc.CallOption) (*QueryChannelsByDenomResponse, error)
+	// DecimalConversions returns the decimal conversions of all denominations transferred over a channel.
+	DecimalConversions(ctx context.Context, in *QueryDecimalConversionsRequest, opts ...grpc.CallOption) (*QueryDecimalConversionsResponse, error)
+	// CanonicalChannel returns the channel registered as canonical for transfers to a counterparty chain.
+	CanonicalChannel(ctx context.Context, in *QueryCanonicalChannelRequest, opts ...grpc.CallOption) (*QueryCanonicalChannelResponse, error)
+	// CanonicalChannels returns the channels registered as canonical for transfers to all counterparty chains.
+	CanonicalChannels(ctx context.Context, in *QueryCanonicalChannelsRequest, opts ...grpc.CallOption) (*QueryCanonicalChannelsResponse, error)
+	// UnwindRoute returns the channels over which vouchers of a denomination must be sent, hop by hop,
+	// to return the tokens to the chain they originate from, with an estimated timeout for each hop.
+	UnwindRoute(ctx context.Context, in *QueryUnwindRouteRequest, opts ...grpc.CallOption) (*QueryUnwindRouteResponse, error)
 }
 
-func (m *QueryDenomHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Trace)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryDenomHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryEscrowAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) DenomTraces(ctx context.Context, in *QueryDenomTracesRequest, opts ...grpc.CallOption) (*QueryDenomTracesResponse, error) {
+	out := new(QueryDenomTracesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomTraces", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEscrowAddressResponse) Size() (n int) {
-	if m == nil {
+func (c *queryClient) DenomTrace(ctx context.Context, in *QueryDenomTraceRequest, opts ...grpc.CallOption) (*QueryDenomTraceResponse, error) {
+	out := new(QueryDenomTraceResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error) {
+	out := new(QueryDenomHashResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error) {
+	out := new(QueryEscrowAddressResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/EscrowAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error) {
+	out := new(QueryTotalEscrowForDenomResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferVolume(ctx context.Context, in *QueryTransferVolumeRequest, opts ...grpc.CallOption) (*QueryTransferVolumeResponse, error) {
+	out := new(QueryTransferVolumeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TransferVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferVolumes(ctx context.Context, in *QueryTransferVolumesRequest, opts ...grpc.CallOption) (*QueryTransferVolumesResponse, error) {
+	out := new(QueryTransferVolumesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TransferVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelsByDenom(ctx context.Context, in *QueryChannelsByDenomRequest, opts ...grpc.CallOption) (*QueryChannelsByDenomResponse, error) {
+	out := new(QueryChannelsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/ChannelsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecimalConversions(ctx context.Context, in *QueryDecimalConversionsRequest, opts ...grpc.CallOption) (*QueryDecimalConversionsResponse, error) {
+	out := new(QueryDecimalConversionsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DecimalConversions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalChannel(ctx context.Context, in *QueryCanonicalChannelRequest, opts ...grpc.CallOption) (*QueryCanonicalChannelResponse, error) {
+	out := new(QueryCanonicalChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/CanonicalChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalChannels(ctx context.Context, in *QueryCanonicalChannelsRequest, opts ...grpc.CallOption) (*QueryCanonicalChannelsResponse, error) {
+	out := new(QueryCanonicalChannelsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/CanonicalChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnwindRoute(ctx context.Context, in *QueryUnwindRouteRequest, opts ...grpc.CallOption) (*QueryUnwindRouteResponse, error) {
+	out := new(QueryUnwindRouteResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/UnwindRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DenomTraces queries all denomination traces.
+	DenomTraces(context.Context, *QueryDenomTracesRequest) (*QueryDenomTracesResponse, error)
+	// DenomTrace queries a denomination trace information.
+	DenomTrace(context.Context, *QueryDenomTraceRequest) (*QueryDenomTraceResponse, error)
+	// Params queries all parameters of the ibc-transfer module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DenomHash queries a denomination hash information.
+	DenomHash(context.Context, *QueryDenomHashRequest) (*QueryDenomHashResponse, error)
+	// EscrowAddress returns the escrow address for a particular port and channel id.
+	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
+	// TransferVolume returns the cumulative transfer volume of a denomination over a channel.
+	TransferVolume(context.Context, *QueryTransferVolumeRequest) (*QueryTransferVolumeResponse, error)
+	// TransferVolumes returns the cumulative transfer volumes of all denominations over a channel.
+	TransferVolumes(context.Context, *QueryTransferVolumesRequest) (*QueryTransferVolumesResponse, error)
+	// ChannelsByDenom returns the cumulative transfer volumes of a denomination over all channels it has
+	// been transferred over while volume tracking was enabled.
+	ChannelsByDenom(context.Context, *QueryChannelsByDenomRequest) (*QueryChannelsByDenomResponse, error)
+	// DecimalConversions returns the decimal conversions of all denominations transferred over a channel.
+	DecimalConversions(context.Context, *QueryDecimalConversionsRequest) (*QueryDecimalConversionsResponse, error)
+	// CanonicalChannel returns the channel registered as canonical for transfers to a counterparty chain.
+	CanonicalChannel(context.Context, *QueryCanonicalChannelRequest) (*QueryCanonicalChannelResponse, error)
+	// CanonicalChannels returns the channels registered as canonical for transfers to all counterparty chains.
+	CanonicalChannels(context.Context, *QueryCanonicalChannelsRequest) (*QueryCanonicalChannelsResponse, error)
+	// UnwindRoute returns the channels over which vouchers of a denomination must be sent, hop by hop,
+	// to return the tokens to the chain they originate from, with an estimated timeout for each hop.
+	UnwindRoute(context.Context, *QueryUnwindRouteRequest) (*QueryUnwindRouteResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DenomTraces(ctx context.Context, req *QueryDenomTracesRequest) (*QueryDenomTracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTraces not implemented")
+}
+func (*UnimplementedQueryServer) DenomTrace(ctx context.Context, req *QueryDenomTraceRequest) (*QueryDenomTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTrace not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DenomHash(ctx context.Context, req *QueryDenomHashRequest) (*QueryDenomHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomHash not implemented")
+}
+func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowAddress not implemented")
+}
+func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
+}
+func (*UnimplementedQueryServer) TransferVolume(ctx context.Context, req *QueryTransferVolumeRequest) (*QueryTransferVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVolume not implemented")
+}
+func (*UnimplementedQueryServer) TransferVolumes(ctx context.Context, req *QueryTransferVolumesRequest) (*QueryTransferVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVolumes not implemented")
+}
+func (*UnimplementedQueryServer) ChannelsByDenom(ctx context.Context, req *QueryChannelsByDenomRequest) (*QueryChannelsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) DecimalConversions(ctx context.Context, req *QueryDecimalConversionsRequest) (*QueryDecimalConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecimalConversions not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalChannel(ctx context.Context, req *QueryCanonicalChannelRequest) (*QueryCanonicalChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalChannel not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalChannels(ctx context.Context, req *QueryCanonicalChannelsRequest) (*QueryCanonicalChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalChannels not implemented")
+}
+func (*UnimplementedQueryServer) UnwindRoute(ctx context.Context, req *QueryUnwindRouteRequest) (*QueryUnwindRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwindRoute not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DenomTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTracesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTraces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/DenomTraces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTraces(ctx, req.(*QueryDenomTracesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/DenomTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTrace(ctx, req.(*QueryDenomTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/DenomHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomHash(ctx, req.(*QueryDenomHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/EscrowAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowAddress(ctx, req.(*QueryEscrowAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalEscrowForDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, req.(*QueryTotalEscrowForDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TransferVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferVolume(ctx, req.(*QueryTransferVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TransferVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferVolumes(ctx, req.(*QueryTransferVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/ChannelsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelsByDenom(ctx, req.(*QueryChannelsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecimalConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecimalConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecimalConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/DecimalConversions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecimalConversions(ctx, req.(*QueryDecimalConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/CanonicalChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalChannel(ctx, req.(*QueryCanonicalChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/CanonicalChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalChannels(ctx, req.(*QueryCanonicalChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnwindRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnwindRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnwindRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/UnwindRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnwindRoute(ctx, req.(*QueryUnwindRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.transfer.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DenomTraces",
+			Handler:    _Query_DenomTraces_Handler,
+		},
+		{
+			MethodName: "DenomTrace",
+			Handler:    _Query_DenomTrace_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DenomHash",
+			Handler:    _Query_DenomHash_Handler,
+		},
+		{
+			MethodName: "EscrowAddress",
+			Handler:    _Query_EscrowAddress_Handler,
+		},
+		{
+			MethodName: "TotalEscrowForDenom",
+			Handler:    _Query_TotalEscrowForDenom_Handler,
+		},
+		{
+			MethodName: "TransferVolume",
+			Handler:    _Query_TransferVolume_Handler,
+		},
+		{
+			MethodName: "TransferVolumes",
+			Handler:    _Query_TransferVolumes_Handler,
+		},
+		{
+			MethodName: "ChannelsByDenom",
+			Handler:    _Query_ChannelsByDenom_Handler,
+		},
+		{
+			MethodName: "DecimalConversions",
+			Handler:    _Query_DecimalConversions_Handler,
+		},
+		{
+			MethodName: "CanonicalChannel",
+			Handler:    _Query_CanonicalChannel_Handler,
+		},
+		{
+			MethodName: "CanonicalChannels",
+			Handler:    _Query_CanonicalChannels_Handler,
+		},
+		{
+			MethodName: "UnwindRoute",
+			Handler:    _Query_UnwindRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/transfer/v1/query.proto",
+}
+
+func (m *QueryDenomTraceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTraceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTraceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTraceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTraceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTraceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DenomTrace != nil {
+		{
+			size, err := m.DenomTrace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTracesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTracesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTracesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTracesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTracesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTracesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomTraces) > 0 {
+		for iNdEx := len(m.DenomTraces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trace) > 0 {
+		i -= len(m.Trace)
+		copy(dAtA[i:], m.Trace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalEscrowForDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferVolume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferVolumesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferVolumesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferVolumesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferVolumesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferVolumesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferVolumesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferVolumes) > 0 {
+		for iNdEx := len(m.TransferVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferVolumes) > 0 {
+		for iNdEx := len(m.TransferVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecimalConversionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecimalConversionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecimalConversionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecimalConversionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecimalConversionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecimalConversionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DecimalConversions) > 0 {
+		for iNdEx := len(m.DecimalConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecimalConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CanonicalChannels) > 0 {
+		for iNdEx := len(m.CanonicalChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanonicalChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnwindRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnwindRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnwindRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HopTimeout != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HopTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnwindRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnwindRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnwindRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnwindHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnwindHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnwindHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDenomTraceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomTraceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DenomTrace != nil {
+		l = m.DenomTrace.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomTracesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomTracesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomTraces) > 0 {
+		for _, e := range m.DenomTraces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalEscrowForDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTransferVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TransferVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTransferVolumesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferVolumesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferVolumes) > 0 {
+		for _, e := range m.TransferVolumes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferVolumes) > 0 {
+		for _, e := range m.TransferVolumes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecimalConversionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecimalConversionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DecimalConversions) > 0 {
+		for _, e := range m.DecimalConversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CanonicalChannels) > 0 {
+		for _, e := range m.CanonicalChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnwindRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HopTimeout != 0 {
+		n += 1 + sovQuery(uint64(m.HopTimeout))
+	}
+	return n
+}
+
+func (m *QueryUnwindRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnwindHop) Size() (n int) {
+	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDenomTraceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTraceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTraceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomTraceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTraceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTraceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTrace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomTrace == nil {
+				m.DenomTrace = &DenomTrace{}
+			}
+			if err := m.DenomTrace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomTracesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTracesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTracesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomTracesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTracesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTracesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTraces = append(m.DenomTraces, DenomTrace{})
+			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferVolumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferVolumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryTransferVolumeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferVolumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryTotalEscrowForDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryTransferVolumesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferVolumesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferVolumesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryTransferVolumesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferVolumesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferVolumesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferVolumes = append(m.TransferVolumes, TransferVolume{})
+			if err := m.TransferVolumes[len(m.TransferVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryDenomTraceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1540,15 +4982,15 @@ func (m *QueryDenomTraceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomTraceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomTraceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1576,7 +5018,43 @@ func (m *QueryDenomTraceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1599,7 +5077,7 @@ func (m *QueryDenomTraceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomTraceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelsByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1622,15 +5100,15 @@ func (m *QueryDenomTraceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomTraceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelsByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomTraceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomTrace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1657,10 +5135,44 @@ func (m *QueryDenomTraceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DenomTrace == nil {
-				m.DenomTrace = &DenomTrace{}
+			m.TransferVolumes = append(m.TransferVolumes, TransferVolume{})
+			if err := m.TransferVolumes[len(m.TransferVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.DenomTrace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1685,7 +5197,7 @@ func (m *QueryDenomTraceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomTracesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDecimalConversionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1708,13 +5220,45 @@ func (m *QueryDenomTracesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomTracesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecimalConversionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomTracesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecimalConversionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1771,7 +5315,7 @@ func (m *QueryDenomTracesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomTracesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDecimalConversionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1794,15 +5338,15 @@ func (m *QueryDenomTracesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomTracesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecimalConversionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomTracesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecimalConversionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalConversions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1829,8 +5373,8 @@ func (m *QueryDenomTracesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomTraces = append(m.DenomTraces, DenomTrace{})
-			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DecimalConversions = append(m.DecimalConversions, DecimalConversion{})
+			if err := m.DecimalConversions[len(m.DecimalConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1891,57 +5435,7 @@ func (m *QueryDenomTracesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1964,17 +5458,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1984,27 +5478,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2027,7 +5517,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,15 +5540,15 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2086,7 +5576,7 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trace = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2109,7 +5599,7 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalChannelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,17 +5622,17 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalChannelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2152,23 +5642,27 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2191,7 +5685,7 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2214,17 +5708,17 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalChannels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2234,29 +5728,31 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.CanonicalChannels = append(m.CanonicalChannels, CanonicalChannel{})
+			if err := m.CanonicalChannels[len(m.CanonicalChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2266,23 +5762,27 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2305,7 +5805,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnwindRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2328,15 +5828,15 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnwindRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnwindRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2364,8 +5864,27 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopTimeout", wireType)
+			}
+			m.HopTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HopTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2387,7 +5906,7 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnwindRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2410,15 +5929,49 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnwindRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnwindRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, UnwindHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2446,7 +5999,7 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2469,7 +6022,7 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *UnwindHop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2492,17 +6045,17 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnwindHop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnwindHop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2512,25 +6065,75 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
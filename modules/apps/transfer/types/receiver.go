@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ReceiverAddressTransformer defines an optional hook which may be set on the transfer keeper
+// to map the receiver string of an incoming ICS-20 packet to a local account address. It allows
+// chains to accept receivers which are not bech32 encoded, such as hex addresses, name service
+// entries or sub-account notations. An error returned by the transformer results in an error
+// acknowledgement being written for the packet.
+type ReceiverAddressTransformer interface {
+	TransformReceiverAddress(ctx sdk.Context, packet channeltypes.Packet, receiver string) (sdk.AccAddress, error)
+}
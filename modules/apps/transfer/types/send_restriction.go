@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestriction defines an optional hook which may be set on the transfer keeper and which is
+// invoked for every outgoing ICS-20 transfer before any tokens are escrowed or burned. Similar to
+// the bank module's SendRestrictionFn, it allows chains to plug in sanction lists or circuit breakers
+// which reject outgoing transfers, or which redirect them to a different receiver. The returned string
+// is the receiver set in the packet data. An error returned by the restriction aborts the transfer.
+type SendRestriction interface {
+	RestrictSendTransfer(ctx sdk.Context, sourcePort, sourceChannel string, sender sdk.AccAddress, receiver string, token sdk.Coin) (string, error)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -82,6 +83,32 @@ func (dt DenomTrace) IsNativeDenom() bool {
 	return dt.Path == ""
 }
 
+// DefaultUnwindHopTimeout is the default time allowed for each hop of an unwind route to be relayed.
+var DefaultUnwindHopTimeout = uint64((10 * time.Minute).Nanoseconds())
+
+// UnwindHops returns the hops over which the tokens of the denomination must be sent to return them to the
+// chain they originate from, in the order they must be sent, starting with the hop from the chain the trace
+// is stored on. The timeout timestamp of each hop is estimated by allowing hopTimeout nanoseconds for each
+// hop to be relayed, starting at the provided timestamp. No hops are returned for native denominations.
+func (dt DenomTrace) UnwindHops(timestamp, hopTimeout uint64) []UnwindHop {
+	if dt.IsNativeDenom() {
+		return nil
+	}
+
+	identifiers := strings.Split(dt.Path, "/")
+	hops := make([]UnwindHop, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		timestamp += hopTimeout
+		hops = append(hops, UnwindHop{
+			PortId:           identifiers[i],
+			ChannelId:        identifiers[i+1],
+			TimeoutTimestamp: timestamp,
+		})
+	}
+
+	return hops
+}
+
 // extractPathAndBaseFromFullDenom returns the trace path and the base denom from
 // the elements that constitute the complete denom.
 func extractPathAndBaseFromFullDenom(fullDenomItems []string) (string, string) {
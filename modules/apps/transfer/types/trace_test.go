@@ -64,6 +64,37 @@ func TestDenomTrace_IBCDenom(t *testing.T) {
 	}
 }
 
+func TestDenomTrace_UnwindHops(t *testing.T) {
+	testCases := []struct {
+		name    string
+		trace   types.DenomTrace
+		expHops []types.UnwindHop
+	}{
+		{"native denom", types.DenomTrace{BaseDenom: "uatom"}, nil},
+		{
+			"single hop",
+			types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1"},
+			[]types.UnwindHop{{PortId: "transfer", ChannelId: "channel-1", TimeoutTimestamp: 110}},
+		},
+		{
+			"multiple hops",
+			types.DenomTrace{BaseDenom: "gamm/pool/1", Path: "transfer/channel-1/transfer/channel-0/other/channel-5"},
+			[]types.UnwindHop{
+				{PortId: "transfer", ChannelId: "channel-1", TimeoutTimestamp: 110},
+				{PortId: "transfer", ChannelId: "channel-0", TimeoutTimestamp: 120},
+				{PortId: "other", ChannelId: "channel-5", TimeoutTimestamp: 130},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		hops := tc.trace.UnwindHops(100, 10)
+		require.Equal(t, tc.expHops, hops, tc.name)
+	}
+}
+
 func TestDenomTrace_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
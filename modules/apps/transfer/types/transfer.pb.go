@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,6 +24,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DustHandling defines how amounts that cannot be represented in the precision
+// of the destination chain are handled by a decimal conversion.
+type DustHandling int32
+
+const (
+	// DUST_HANDLING_UNSPECIFIED defines an invalid dust handling rule.
+	UNSPECIFIED DustHandling = 0
+	// DUST_HANDLING_REJECT rejects transfers whose amount cannot be converted
+	// without a remainder.
+	REJECT DustHandling = 1
+	// DUST_HANDLING_TRUNCATE transfers the convertible part of the amount. On
+	// send the remainder stays with the sender and on receive it is dropped.
+	TRUNCATE DustHandling = 2
+)
+
+var DustHandling_name = map[int32]string{
+	0: "DUST_HANDLING_UNSPECIFIED",
+	1: "DUST_HANDLING_REJECT",
+	2: "DUST_HANDLING_TRUNCATE",
+}
+
+var DustHandling_value = map[string]int32{
+	"DUST_HANDLING_UNSPECIFIED": 0,
+	"DUST_HANDLING_REJECT":      1,
+	"DUST_HANDLING_TRUNCATE":    2,
+}
+
+func (x DustHandling) String() string {
+	return proto.EnumName(DustHandling_name, int32(x))
+}
+
+func (DustHandling) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{0}
+}
+
 // DenomTrace contains the base denomination for ICS20 fungible tokens and the
 // source tracing information path.
 type DenomTrace struct {
@@ -90,6 +127,33 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// volume_tracking_enabled enables or disables tracking of the cumulative
+	// volume of tokens sent and received per channel and denomination. Disabling
+	// volume tracking prunes all tracked volumes.
+	VolumeTrackingEnabled bool `protobuf:"varint,3,opt,name=volume_tracking_enabled,json=volumeTrackingEnabled,proto3" json:"volume_tracking_enabled,omitempty"`
+	// max_memo_characters is the maximum length, in bytes, of the memo of tokens
+	// transferred from and to this chain. A value of zero applies the maximum
+	// memo length accepted by MsgTransfer.
+	MaxMemoCharacters uint64 `protobuf:"varint,4,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty"`
+	// max_receiver_length is the maximum length, in bytes, of the receiver of
+	// tokens transferred from and to this chain. A value of zero applies the
+	// maximum receiver length accepted by MsgTransfer.
+	MaxReceiverLength uint64 `protobuf:"varint,5,opt,name=max_receiver_length,json=maxReceiverLength,proto3" json:"max_receiver_length,omitempty"`
+	// channel_timeout_defaults are the relative timeouts applied to transfers
+	// on a channel which do not set a timeout height nor a timeout timestamp.
+	ChannelTimeoutDefaults []ChannelTimeoutDefault `protobuf:"bytes,6,rep,name=channel_timeout_defaults,json=channelTimeoutDefaults,proto3" json:"channel_timeout_defaults"`
+	// strict_canonical_channels rejects transfers sent on a channel to a
+	// counterparty chain for which another channel is registered as canonical.
+	StrictCanonicalChannels bool `protobuf:"varint,7,opt,name=strict_canonical_channels,json=strictCanonicalChannels,proto3" json:"strict_canonical_channels,omitempty"`
+	// ping_transfers_enabled allows zero amount transfers to be sent from and
+	// received by this chain as channel liveness probes. No tokens are escrowed,
+	// burned, minted or unescrowed for ping transfers.
+	PingTransfersEnabled bool `protobuf:"varint,8,opt,name=ping_transfers_enabled,json=pingTransfersEnabled,proto3" json:"ping_transfers_enabled,omitempty"`
+	// channel_transfer_overrides disable the transfers sent or received on a
+	// channel, allowing transfers with a single counterparty to be paused
+	// without pausing all transfers. Overrides cannot enable transfers which are
+	// disabled by send_enabled or receive_enabled.
+	ChannelTransferOverrides []ChannelTransferOverride `protobuf:"bytes,9,rep,name=channel_transfer_overrides,json=channelTransferOverrides,proto3" json:"channel_transfer_overrides"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -139,165 +203,2148 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
-	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+func (m *Params) GetVolumeTrackingEnabled() bool {
+	if m != nil {
+		return m.VolumeTrackingEnabled
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/transfer/v1/transfer.proto", fileDescriptor_5041673e96e97901)
+func (m *Params) GetMaxMemoCharacters() uint64 {
+	if m != nil {
+		return m.MaxMemoCharacters
+	}
+	return 0
 }
 
-var fileDescriptor_5041673e96e97901 = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3f, 0x4b, 0x03, 0x31,
-	0x18, 0x87, 0x9b, 0x22, 0xc5, 0x46, 0x51, 0xc8, 0xd4, 0x41, 0x83, 0x76, 0x51, 0x10, 0x2f, 0x14,
-	0x07, 0xdd, 0x04, 0xd1, 0x5d, 0x4b, 0x27, 0x97, 0x92, 0xe4, 0x5e, 0xdb, 0xc0, 0xe5, 0x0f, 0x79,
-	0xd3, 0x03, 0xbf, 0x85, 0x1f, 0xcb, 0xb1, 0xa3, 0xa3, 0xdc, 0x7d, 0x11, 0xb9, 0x58, 0x8e, 0x6e,
-	0x3f, 0x9e, 0x3c, 0x79, 0x87, 0x87, 0xde, 0x18, 0xa5, 0x85, 0x0c, 0xa1, 0x32, 0x5a, 0x26, 0xe3,
-	0x1d, 0x8a, 0x14, 0xa5, 0xc3, 0x0f, 0x88, 0xa2, 0x9e, 0xf5, 0xbb, 0x08, 0xd1, 0x27, 0xcf, 0xce,
-	0x8c, 0xd2, 0xc5, 0xbe, 0x5c, 0xf4, 0x42, 0x3d, 0x9b, 0x3e, 0x52, 0xfa, 0x0c, 0xce, 0xdb, 0x45,
-	0x94, 0x1a, 0x18, 0xa3, 0x07, 0x41, 0xa6, 0xf5, 0x84, 0x5c, 0x90, 0xeb, 0xf1, 0x3c, 0x6f, 0x76,
-	0x4e, 0xa9, 0x92, 0x08, 0xcb, 0xb2, 0xd3, 0x26, 0xc3, 0xfc, 0x32, 0xee, 0x48, 0xfe, 0x37, 0x5d,
-	0xd0, 0xd1, 0xab, 0x8c, 0xd2, 0x22, 0xbb, 0xa4, 0xc7, 0x08, 0xae, 0x5c, 0x82, 0x93, 0xaa, 0x82,
-	0x32, 0x1f, 0x39, 0x9c, 0x1f, 0x75, 0xec, 0xe5, 0x1f, 0xb1, 0x2b, 0x7a, 0x1a, 0x41, 0x83, 0xa9,
-	0xa1, 0xb7, 0x86, 0xd9, 0x3a, 0xd9, 0xe1, 0x9d, 0xf8, 0xf4, 0xf6, 0xdd, 0x70, 0xb2, 0x6d, 0x38,
-	0xf9, 0x6d, 0x38, 0xf9, 0x6a, 0xf9, 0x60, 0xdb, 0xf2, 0xc1, 0x4f, 0xcb, 0x07, 0xef, 0xf7, 0x2b,
-	0x93, 0xd6, 0x1b, 0x55, 0x68, 0x6f, 0x85, 0xf6, 0x68, 0x3d, 0x0a, 0xa3, 0xf4, 0xed, 0xca, 0x8b,
-	0xfa, 0x41, 0x58, 0x5f, 0x6e, 0x2a, 0xc0, 0xae, 0xcd, 0x5e, 0x93, 0xf4, 0x19, 0x00, 0xd5, 0x28,
-	0xe7, 0xb8, 0xfb, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x24, 0x39, 0xe1, 0x5f, 0x3d, 0x01, 0x00, 0x00,
+func (m *Params) GetMaxReceiverLength() uint64 {
+	if m != nil {
+		return m.MaxReceiverLength
+	}
+	return 0
 }
 
-func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetChannelTimeoutDefaults() []ChannelTimeoutDefault {
+	if m != nil {
+		return m.ChannelTimeoutDefaults
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetStrictCanonicalChannels() bool {
+	if m != nil {
+		return m.StrictCanonicalChannels
+	}
+	return false
 }
 
-func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintTransfer(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x12
+func (m *Params) GetPingTransfersEnabled() bool {
+	if m != nil {
+		return m.PingTransfersEnabled
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *Params) GetChannelTransferOverrides() []ChannelTransferOverride {
+	if m != nil {
+		return m.ChannelTransferOverrides
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ChannelTransferOverride defines whether transfers are enabled on a channel.
+// Transfers are only enabled on the channel if they are also enabled by the
+// chain-wide send_enabled and receive_enabled parameters.
+type ChannelTransferOverride struct {
+	// the channel identifier on this chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// send_enabled enables or disables cross-chain token transfers sent on the
+	// channel.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables cross-chain token transfers received
+	// on the channel.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *ChannelTransferOverride) Reset()         { *m = ChannelTransferOverride{} }
+func (m *ChannelTransferOverride) String() string { return proto.CompactTextString(m) }
+func (*ChannelTransferOverride) ProtoMessage()    {}
+func (*ChannelTransferOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *ChannelTransferOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelTransferOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTransferOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ChannelTransferOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTransferOverride.Merge(m, src)
+}
+func (m *ChannelTransferOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTransferOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTransferOverride.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ChannelTransferOverride proto.InternalMessageInfo
+
+func (m *ChannelTransferOverride) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReceiveEnabled {
-		i--
-		if m.ReceiveEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ChannelTransferOverride) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelTransferOverride) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+// ChannelTimeoutDefault defines the default relative timeouts of transfers sent
+// on a channel. At least one of the offsets must be set.
+type ChannelTimeoutDefault struct {
+	// the channel identifier on this chain
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the number of blocks added to the latest height of the counterparty client
+	// of the channel to obtain the timeout height. Zero disables the timeout height.
+	TimeoutHeightOffset uint64 `protobuf:"varint,2,opt,name=timeout_height_offset,json=timeoutHeightOffset,proto3" json:"timeout_height_offset,omitempty"`
+	// the number of nanoseconds added to the current block time to obtain the
+	// timeout timestamp. Zero disables the timeout timestamp.
+	TimeoutTimestampOffset uint64 `protobuf:"varint,3,opt,name=timeout_timestamp_offset,json=timeoutTimestampOffset,proto3" json:"timeout_timestamp_offset,omitempty"`
+}
+
+func (m *ChannelTimeoutDefault) Reset()         { *m = ChannelTimeoutDefault{} }
+func (m *ChannelTimeoutDefault) String() string { return proto.CompactTextString(m) }
+func (*ChannelTimeoutDefault) ProtoMessage()    {}
+func (*ChannelTimeoutDefault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *ChannelTimeoutDefault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelTimeoutDefault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTimeoutDefault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.SendEnabled {
-		i--
-		if m.SendEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *ChannelTimeoutDefault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTimeoutDefault.Merge(m, src)
+}
+func (m *ChannelTimeoutDefault) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTimeoutDefault) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTimeoutDefault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTimeoutDefault proto.InternalMessageInfo
+
+func (m *ChannelTimeoutDefault) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelTimeoutDefault) GetTimeoutHeightOffset() uint64 {
+	if m != nil {
+		return m.TimeoutHeightOffset
+	}
+	return 0
+}
+
+func (m *ChannelTimeoutDefault) GetTimeoutTimestampOffset() uint64 {
+	if m != nil {
+		return m.TimeoutTimestampOffset
+	}
+	return 0
+}
+
+// TransferVolume defines the cumulative volume of tokens of a denomination
+// transferred over a channel. Sent volume is only accounted for once a packet
+// is successfully acknowledged and received volume once a packet is
+// successfully received.
+type TransferVolume struct {
+	// channel identifier of the transfer channel.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denomination of the tokens as represented on this chain.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// cumulative amount of tokens sent over the channel.
+	Sent cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=sent,proto3,customtype=cosmossdk.io/math.Int" json:"sent"`
+	// cumulative amount of tokens received over the channel.
+	Received cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=received,proto3,customtype=cosmossdk.io/math.Int" json:"received"`
+}
+
+func (m *TransferVolume) Reset()         { *m = TransferVolume{} }
+func (m *TransferVolume) String() string { return proto.CompactTextString(m) }
+func (*TransferVolume) ProtoMessage()    {}
+func (*TransferVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *TransferVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferVolume.Merge(m, src)
+}
+func (m *TransferVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferVolume proto.InternalMessageInfo
+
+func (m *TransferVolume) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TransferVolume) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DecimalConversion defines the scaling of the amounts of a denomination
+// transferred over a channel whose counterparty represents the token with a
+// different number of decimals.
+type DecimalConversion struct {
+	// channel identifier of the transfer channel.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denomination of the tokens as represented on this chain, i.e. the full
+	// denomination trace path of the packet data.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// number of decimals of the token on this chain.
+	LocalDecimals uint32 `protobuf:"varint,3,opt,name=local_decimals,json=localDecimals,proto3" json:"local_decimals,omitempty"`
+	// number of decimals of the token on the counterparty chain.
+	CounterpartyDecimals uint32 `protobuf:"varint,4,opt,name=counterparty_decimals,json=counterpartyDecimals,proto3" json:"counterparty_decimals,omitempty"`
+	// dust_handling defines how remainders of a conversion are handled.
+	DustHandling DustHandling `protobuf:"varint,5,opt,name=dust_handling,json=dustHandling,proto3,enum=ibc.applications.transfer.v1.DustHandling" json:"dust_handling,omitempty"`
+}
+
+func (m *DecimalConversion) Reset()         { *m = DecimalConversion{} }
+func (m *DecimalConversion) String() string { return proto.CompactTextString(m) }
+func (*DecimalConversion) ProtoMessage()    {}
+func (*DecimalConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{5}
+}
+func (m *DecimalConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecimalConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecimalConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecimalConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecimalConversion.Merge(m, src)
+}
+func (m *DecimalConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecimalConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecimalConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecimalConversion proto.InternalMessageInfo
+
+func (m *DecimalConversion) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *DecimalConversion) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DecimalConversion) GetLocalDecimals() uint32 {
+	if m != nil {
+		return m.LocalDecimals
+	}
+	return 0
+}
+
+func (m *DecimalConversion) GetCounterpartyDecimals() uint32 {
+	if m != nil {
+		return m.CounterpartyDecimals
+	}
+	return 0
+}
+
+func (m *DecimalConversion) GetDustHandling() DustHandling {
+	if m != nil {
+		return m.DustHandling
+	}
+	return UNSPECIFIED
+}
+
+// PacketLocalAmount defines the amount of tokens, in the precision of this
+// chain, escrowed or burned when sending a packet over a channel with a decimal
+// conversion. It is used to refund and track the packet once it is settled,
+// independently of the decimal conversion in force at that time.
+type PacketLocalAmount struct {
+	// the port identifier of the packet
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier of the packet
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the amount of tokens in the precision of this chain
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *PacketLocalAmount) Reset()         { *m = PacketLocalAmount{} }
+func (m *PacketLocalAmount) String() string { return proto.CompactTextString(m) }
+func (*PacketLocalAmount) ProtoMessage()    {}
+func (*PacketLocalAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{6}
+}
+func (m *PacketLocalAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketLocalAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketLocalAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketLocalAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketLocalAmount.Merge(m, src)
+}
+func (m *PacketLocalAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketLocalAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketLocalAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketLocalAmount proto.InternalMessageInfo
+
+func (m *PacketLocalAmount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketLocalAmount) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketLocalAmount) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketLocalAmount) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// CanonicalChannel defines the channel registered as canonical for transfers to
+// the counterparty chain with the given chain identifier.
+type CanonicalChannel struct {
+	// chain identifier of the counterparty chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the channel identifier on this chain
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *CanonicalChannel) Reset()         { *m = CanonicalChannel{} }
+func (m *CanonicalChannel) String() string { return proto.CompactTextString(m) }
+func (*CanonicalChannel) ProtoMessage()    {}
+func (*CanonicalChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{7}
+}
+func (m *CanonicalChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalChannel.Merge(m, src)
+}
+func (m *CanonicalChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalChannel proto.InternalMessageInfo
+
+func (m *CanonicalChannel) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *CanonicalChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ibc.applications.transfer.v1.DustHandling", DustHandling_name, DustHandling_value)
+	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
+	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*ChannelTransferOverride)(nil), "ibc.applications.transfer.v1.ChannelTransferOverride")
+	proto.RegisterType((*ChannelTimeoutDefault)(nil), "ibc.applications.transfer.v1.ChannelTimeoutDefault")
+	proto.RegisterType((*TransferVolume)(nil), "ibc.applications.transfer.v1.TransferVolume")
+	proto.RegisterType((*DecimalConversion)(nil), "ibc.applications.transfer.v1.DecimalConversion")
+	proto.RegisterType((*PacketLocalAmount)(nil), "ibc.applications.transfer.v1.PacketLocalAmount")
+	proto.RegisterType((*CanonicalChannel)(nil), "ibc.applications.transfer.v1.CanonicalChannel")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/transfer.proto", fileDescriptor_5041673e96e97901)
+}
+
+var fileDescriptor_5041673e96e97901 = []byte{
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x5b, 0x6f, 0x9a, 0xbe, 0xfd, 0xd8, 0xd6, 0xdb, 0xa4, 0x6e, 0xc4, 0x66, 0x43, 0x04,
+	0xa2, 0x5a, 0x84, 0xa3, 0xb6, 0x7c, 0x2c, 0x5c, 0x50, 0x9b, 0x04, 0x1a, 0x54, 0xda, 0xe2, 0x4d,
+	0x39, 0x70, 0xb1, 0x26, 0xe3, 0x69, 0x3c, 0xaa, 0x3d, 0x13, 0x3c, 0x93, 0x68, 0x7b, 0xe2, 0xc2,
+	0x01, 0xf5, 0xc4, 0x81, 0x6b, 0x4f, 0xf0, 0x0f, 0xf8, 0x13, 0x7b, 0x42, 0x7b, 0x44, 0x1c, 0x56,
+	0xa8, 0xfd, 0x0d, 0xdc, 0x91, 0xc7, 0x63, 0x37, 0x5b, 0xca, 0xb6, 0xda, 0x93, 0xe7, 0x7d, 0x9f,
+	0xe7, 0x99, 0xf7, 0x63, 0xde, 0xf1, 0xc0, 0xfb, 0xb4, 0x8f, 0x9b, 0x68, 0x38, 0x0c, 0x29, 0x46,
+	0x92, 0x72, 0x26, 0x9a, 0x32, 0x46, 0x4c, 0x1c, 0x93, 0xb8, 0x39, 0xde, 0xc8, 0xd7, 0xce, 0x30,
+	0xe6, 0x92, 0x5b, 0x6f, 0xd1, 0x3e, 0x76, 0x26, 0xc9, 0x4e, 0x4e, 0x18, 0x6f, 0x54, 0x57, 0x06,
+	0x7c, 0xc0, 0x15, 0xb1, 0x99, 0xac, 0x52, 0x4d, 0xe3, 0x73, 0x80, 0x36, 0x61, 0x3c, 0xea, 0xc5,
+	0x08, 0x13, 0xcb, 0x02, 0x73, 0x88, 0x64, 0x60, 0x1b, 0x75, 0x63, 0x7d, 0xd6, 0x55, 0x6b, 0xeb,
+	0x21, 0x40, 0x1f, 0x09, 0xe2, 0xf9, 0x09, 0xcd, 0x9e, 0x52, 0xc8, 0x6c, 0xe2, 0x51, 0xba, 0xc6,
+	0x1f, 0x26, 0x14, 0x0f, 0x51, 0x8c, 0x22, 0x61, 0xbd, 0x0d, 0xf3, 0x82, 0x30, 0xdf, 0x23, 0x0c,
+	0xf5, 0x43, 0xe2, 0xab, 0x5d, 0x4a, 0xee, 0x5c, 0xe2, 0xeb, 0xa4, 0x2e, 0xeb, 0x3d, 0xb8, 0x1f,
+	0x13, 0x4c, 0xe8, 0x98, 0xe4, 0xac, 0x29, 0xc5, 0x5a, 0xd4, 0xee, 0x8c, 0xf8, 0x31, 0xac, 0x8e,
+	0x79, 0x38, 0x8a, 0x88, 0x27, 0x63, 0x84, 0x4f, 0x28, 0x1b, 0xe4, 0x82, 0x69, 0x25, 0x28, 0xa7,
+	0x70, 0x4f, 0xa3, 0x99, 0xce, 0x81, 0x07, 0x11, 0x7a, 0xe6, 0x45, 0x24, 0xe2, 0x1e, 0x0e, 0x50,
+	0x8c, 0xb0, 0x24, 0xb1, 0xb0, 0xcd, 0xba, 0xb1, 0x6e, 0xba, 0xcb, 0x11, 0x7a, 0xf6, 0x35, 0x89,
+	0x78, 0x2b, 0x07, 0x32, 0xbe, 0x8e, 0x1e, 0x7b, 0x21, 0x61, 0x03, 0x19, 0xd8, 0xf7, 0x72, 0xbe,
+	0xab, 0x91, 0x3d, 0x05, 0x58, 0x02, 0x6c, 0x1c, 0x20, 0xc6, 0x48, 0xe8, 0x49, 0x1a, 0x11, 0x3e,
+	0x92, 0x9e, 0x4f, 0x8e, 0xd1, 0x28, 0x94, 0xc2, 0x2e, 0xd6, 0xa7, 0xd7, 0xe7, 0x36, 0xb7, 0x9c,
+	0xd7, 0x1d, 0x83, 0xd3, 0x4a, 0xd5, 0xbd, 0x54, 0xdc, 0x4e, 0xb5, 0x3b, 0xe6, 0xf3, 0x97, 0x8f,
+	0x0a, 0x6e, 0x05, 0xdf, 0x04, 0x0a, 0xeb, 0x33, 0x58, 0x13, 0x32, 0xa6, 0x58, 0x7a, 0x18, 0x31,
+	0xce, 0x28, 0x46, 0xa1, 0xa7, 0xa9, 0xc2, 0x9e, 0x51, 0xed, 0x58, 0x4d, 0x09, 0xad, 0x0c, 0xd7,
+	0x61, 0x84, 0xf5, 0x21, 0x54, 0x86, 0x49, 0xf7, 0xb2, 0x1c, 0x44, 0xde, 0xc7, 0x92, 0x12, 0xae,
+	0x24, 0x68, 0x2f, 0x03, 0xb3, 0x36, 0x9e, 0x42, 0x35, 0x2f, 0x53, 0x63, 0x1e, 0x1f, 0x93, 0x38,
+	0xa6, 0x3e, 0x11, 0xf6, 0xac, 0x2a, 0xf4, 0xa3, 0xbb, 0x15, 0xaa, 0x5d, 0x07, 0x5a, 0xad, 0x4b,
+	0xb5, 0xf1, 0xcd, 0xb0, 0x68, 0xfc, 0x68, 0xc0, 0xea, 0xff, 0x68, 0x93, 0x59, 0xcc, 0xd2, 0xa2,
+	0xbe, 0x9e, 0xd2, 0x59, 0xed, 0xe9, 0xfa, 0xff, 0x19, 0xc0, 0xa9, 0x3b, 0x0d, 0xe0, 0xf4, 0x4d,
+	0x03, 0xd8, 0xf8, 0xcd, 0x80, 0xf2, 0x8d, 0x67, 0x75, 0x5b, 0x12, 0x9b, 0x50, 0xce, 0x26, 0x23,
+	0x20, 0x74, 0x10, 0x48, 0x8f, 0x1f, 0x1f, 0x0b, 0x22, 0x55, 0x36, 0xa6, 0xfb, 0x40, 0x83, 0xbb,
+	0x0a, 0x3b, 0x50, 0x90, 0xf5, 0x04, 0xec, 0x4c, 0x93, 0x7c, 0x85, 0x44, 0xd1, 0x30, 0x93, 0x4d,
+	0x2b, 0x59, 0x45, 0xe3, 0xbd, 0x0c, 0x4e, 0x95, 0x8d, 0xdf, 0x0d, 0x58, 0xcc, 0xda, 0xf4, 0xad,
+	0xba, 0x11, 0xb7, 0xe5, 0xb7, 0x02, 0xf7, 0x26, 0xaf, 0x72, 0x6a, 0x58, 0x1b, 0x60, 0x0a, 0xc2,
+	0xd2, 0x68, 0xb3, 0x3b, 0x0f, 0x93, 0x33, 0xfa, 0xeb, 0xe5, 0xa3, 0x32, 0xe6, 0x22, 0xe2, 0x42,
+	0xf8, 0x27, 0x0e, 0xe5, 0xcd, 0x08, 0xc9, 0xc0, 0xe9, 0x32, 0xe9, 0x2a, 0xaa, 0xf5, 0x29, 0x94,
+	0x74, 0xcf, 0x7c, 0xdb, 0xbc, 0x8b, 0x2c, 0xa7, 0x37, 0xfe, 0x31, 0x60, 0xb9, 0x4d, 0x30, 0x8d,
+	0x50, 0xd8, 0xe2, 0x6c, 0x4c, 0x62, 0x41, 0x39, 0x7b, 0xb3, 0xc4, 0xdf, 0x85, 0xc5, 0x90, 0x27,
+	0x17, 0xc2, 0x4f, 0xf7, 0x13, 0xaa, 0x84, 0x05, 0x77, 0x41, 0x79, 0x75, 0x10, 0x61, 0x6d, 0x41,
+	0x19, 0xf3, 0x11, 0x93, 0x24, 0x1e, 0xa2, 0x58, 0x9e, 0x5e, 0xb1, 0x4d, 0xc5, 0x5e, 0x99, 0x04,
+	0x73, 0xd1, 0x01, 0x2c, 0xf8, 0x23, 0x21, 0xbd, 0x00, 0x31, 0x3f, 0xa4, 0x6c, 0xa0, 0x7e, 0x0b,
+	0x8b, 0x9b, 0x8f, 0x5f, 0x3f, 0xf8, 0xed, 0x91, 0x90, 0xbb, 0x5a, 0xe1, 0xce, 0xfb, 0x13, 0x56,
+	0xe3, 0x07, 0x58, 0x3e, 0x44, 0xf8, 0x84, 0xc8, 0xbd, 0x24, 0xb9, 0xed, 0x28, 0x09, 0x6a, 0xad,
+	0xc2, 0xcc, 0x90, 0xc7, 0xf2, 0xaa, 0xe6, 0x62, 0x62, 0x76, 0xfd, 0x6b, 0xfd, 0x98, 0xba, 0xde,
+	0x8f, 0x2a, 0x94, 0x04, 0xf9, 0x7e, 0x44, 0x18, 0x26, 0x7a, 0x48, 0x72, 0xdb, 0xaa, 0x40, 0x11,
+	0xa9, 0xdd, 0xd3, 0x93, 0x71, 0xb5, 0xd5, 0xd8, 0x83, 0xa5, 0xeb, 0xbf, 0x08, 0x6b, 0x0d, 0x4a,
+	0x38, 0x40, 0x94, 0x5d, 0x25, 0x30, 0xa3, 0xec, 0x5b, 0x33, 0x78, 0xfc, 0x8b, 0x01, 0xf3, 0x93,
+	0xd5, 0x5a, 0x0e, 0xac, 0xb5, 0x8f, 0x9e, 0xf6, 0xbc, 0xdd, 0xed, 0xfd, 0xf6, 0x5e, 0x77, 0xff,
+	0x4b, 0xef, 0x68, 0xff, 0xe9, 0x61, 0xa7, 0xd5, 0xfd, 0xa2, 0xdb, 0x69, 0x2f, 0x15, 0xaa, 0xf7,
+	0xcf, 0xce, 0xeb, 0x73, 0x13, 0x2e, 0xeb, 0x1d, 0x58, 0x79, 0x95, 0xef, 0x76, 0xbe, 0xea, 0xb4,
+	0x7a, 0x4b, 0x46, 0x15, 0xce, 0xce, 0xeb, 0xc5, 0xd4, 0xb2, 0xd6, 0xa1, 0xf2, 0x2a, 0xab, 0xe7,
+	0x1e, 0xed, 0xb7, 0xb6, 0x7b, 0x9d, 0xa5, 0xa9, 0xea, 0xfc, 0xd9, 0x79, 0xbd, 0x94, 0xd9, 0x55,
+	0xf3, 0xa7, 0x5f, 0x6b, 0x85, 0x9d, 0x6f, 0x9e, 0x5f, 0xd4, 0x8c, 0x17, 0x17, 0x35, 0xe3, 0xef,
+	0x8b, 0x9a, 0xf1, 0xf3, 0x65, 0xad, 0xf0, 0xe2, 0xb2, 0x56, 0xf8, 0xf3, 0xb2, 0x56, 0xf8, 0xee,
+	0x93, 0x01, 0x95, 0xc1, 0xa8, 0xef, 0x60, 0x1e, 0x35, 0xd3, 0x19, 0x6d, 0xd2, 0x3e, 0xfe, 0x60,
+	0xc0, 0x9b, 0xe3, 0x27, 0xcd, 0x88, 0xfb, 0xa3, 0x90, 0x88, 0xe4, 0xb9, 0x9d, 0x78, 0x66, 0xe5,
+	0xe9, 0x90, 0x88, 0x7e, 0x51, 0xbd, 0x96, 0x5b, 0xff, 0x0e, 0x00, 0x71, 0xc2, 0xc7, 0x17, 0x90,
+	0x07, 0x00, 0x00,
+}
+
+func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelTransferOverrides) > 0 {
+		for iNdEx := len(m.ChannelTransferOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTransferOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PingTransfersEnabled {
+		i--
+		if m.PingTransfersEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StrictCanonicalChannels {
+		i--
+		if m.StrictCanonicalChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChannelTimeoutDefaults) > 0 {
+		for iNdEx := len(m.ChannelTimeoutDefaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTimeoutDefaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxReceiverLength != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxReceiverLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMemoCharacters != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxMemoCharacters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VolumeTrackingEnabled {
+		i--
+		if m.VolumeTrackingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelTransferOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTransferOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTransferOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelTimeoutDefault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTimeoutDefault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTimeoutDefault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestampOffset != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.TimeoutTimestampOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimeoutHeightOffset != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.TimeoutHeightOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Received.Size()
+		i -= size
+		if _, err := m.Received.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Sent.Size()
+		i -= size
+		if _, err := m.Sent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecimalConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecimalConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecimalConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DustHandling != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.DustHandling))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CounterpartyDecimals != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.CounterpartyDecimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LocalDecimals != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.LocalDecimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketLocalAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketLocalAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketLocalAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanonicalChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if m.VolumeTrackingEnabled {
+		n += 2
+	}
+	if m.MaxMemoCharacters != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxMemoCharacters))
+	}
+	if m.MaxReceiverLength != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxReceiverLength))
+	}
+	if len(m.ChannelTimeoutDefaults) > 0 {
+		for _, e := range m.ChannelTimeoutDefaults {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.StrictCanonicalChannels {
+		n += 2
+	}
+	if m.PingTransfersEnabled {
+		n += 2
+	}
+	if len(m.ChannelTransferOverrides) > 0 {
+		for _, e := range m.ChannelTransferOverrides {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelTransferOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChannelTimeoutDefault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.TimeoutHeightOffset != 0 {
+		n += 1 + sovTransfer(uint64(m.TimeoutHeightOffset))
+	}
+	if m.TimeoutTimestampOffset != 0 {
+		n += 1 + sovTransfer(uint64(m.TimeoutTimestampOffset))
+	}
+	return n
+}
+
+func (m *TransferVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.Sent.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *DecimalConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.LocalDecimals != 0 {
+		n += 1 + sovTransfer(uint64(m.LocalDecimals))
+	}
+	if m.CounterpartyDecimals != 0 {
+		n += 1 + sovTransfer(uint64(m.CounterpartyDecimals))
+	}
+	if m.DustHandling != 0 {
+		n += 1 + sovTransfer(uint64(m.DustHandling))
+	}
+	return n
+}
+
+func (m *PacketLocalAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransfer(uint64(m.Sequence))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *CanonicalChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTrackingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VolumeTrackingEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReceiverLength", wireType)
+			}
+			m.MaxReceiverLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReceiverLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTimeoutDefaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelTimeoutDefaults = append(m.ChannelTimeoutDefaults, ChannelTimeoutDefault{})
+			if err := m.ChannelTimeoutDefaults[len(m.ChannelTimeoutDefaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictCanonicalChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictCanonicalChannels = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingTransfersEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PingTransfersEnabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTransferOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelTransferOverrides = append(m.ChannelTransferOverrides, ChannelTransferOverride{})
+			if err := m.ChannelTransferOverrides[len(m.ChannelTransferOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelTransferOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelTransferOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelTransferOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelTimeoutDefault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelTimeoutDefault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelTimeoutDefault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightOffset", wireType)
+			}
+			m.TimeoutHeightOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeightOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestampOffset", wireType)
+			}
+			m.TimeoutTimestampOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestampOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecimalConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecimalConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecimalConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDecimals", wireType)
+			}
+			m.LocalDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyDecimals", wireType)
+			}
+			m.CounterpartyDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustHandling", wireType)
+			}
+			m.DustHandling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustHandling |= DustHandling(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransfer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DenomTrace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SendEnabled {
-		n += 2
-	}
-	if m.ReceiveEnabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTransfer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransfer(x uint64) (n int) {
-	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+func (m *PacketLocalAmount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -320,15 +2367,15 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketLocalAmount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketLocalAmount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -356,11 +2403,11 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -388,7 +2435,58 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -411,7 +2509,7 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CanonicalChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -434,17 +2532,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanonicalChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanonicalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -454,17 +2552,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SendEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransfer
@@ -474,12 +2584,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReceiveEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -209,11 +209,304 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRenameBaseDenoms is the Msg/RenameBaseDenoms request type. It migrates the IBC vouchers
+// of tokens whose base denomination has been renamed on their origin chain.
+type MsgRenameBaseDenoms struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// renames defines the base denominations to be renamed.
+	Renames []BaseDenomRename `protobuf:"bytes,2,rep,name=renames,proto3" json:"renames"`
+}
+
+func (m *MsgRenameBaseDenoms) Reset()         { *m = MsgRenameBaseDenoms{} }
+func (m *MsgRenameBaseDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgRenameBaseDenoms) ProtoMessage()    {}
+func (*MsgRenameBaseDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{4}
+}
+func (m *MsgRenameBaseDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenameBaseDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenameBaseDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenameBaseDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenameBaseDenoms.Merge(m, src)
+}
+func (m *MsgRenameBaseDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenameBaseDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenameBaseDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenameBaseDenoms proto.InternalMessageInfo
+
+// BaseDenomRename defines the rename of the base denomination of the denomination trace
+// with the provided path.
+type BaseDenomRename struct {
+	// path defines the chain of port/channel identifiers of the denomination trace to be migrated.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// old_base_denom defines the base denomination of the trace before the rename.
+	OldBaseDenom string `protobuf:"bytes,2,opt,name=old_base_denom,json=oldBaseDenom,proto3" json:"old_base_denom,omitempty"`
+	// new_base_denom defines the base denomination of the trace after the rename.
+	NewBaseDenom string `protobuf:"bytes,3,opt,name=new_base_denom,json=newBaseDenom,proto3" json:"new_base_denom,omitempty"`
+}
+
+func (m *BaseDenomRename) Reset()         { *m = BaseDenomRename{} }
+func (m *BaseDenomRename) String() string { return proto.CompactTextString(m) }
+func (*BaseDenomRename) ProtoMessage()    {}
+func (*BaseDenomRename) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{5}
+}
+func (m *BaseDenomRename) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseDenomRename) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseDenomRename.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseDenomRename) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseDenomRename.Merge(m, src)
+}
+func (m *BaseDenomRename) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseDenomRename) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseDenomRename.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseDenomRename proto.InternalMessageInfo
+
+// MsgRenameBaseDenomsResponse defines the response structure for executing a
+// MsgRenameBaseDenoms message.
+type MsgRenameBaseDenomsResponse struct {
+}
+
+func (m *MsgRenameBaseDenomsResponse) Reset()         { *m = MsgRenameBaseDenomsResponse{} }
+func (m *MsgRenameBaseDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenameBaseDenomsResponse) ProtoMessage()    {}
+func (*MsgRenameBaseDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{6}
+}
+func (m *MsgRenameBaseDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenameBaseDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenameBaseDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenameBaseDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenameBaseDenomsResponse.Merge(m, src)
+}
+func (m *MsgRenameBaseDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenameBaseDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenameBaseDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenameBaseDenomsResponse proto.InternalMessageInfo
+
+// MsgUpdateDecimalConversion is the Msg/UpdateDecimalConversion request type. It sets the decimal
+// conversion of a denomination transferred over a channel. A conversion with equal local and
+// counterparty decimals removes the existing conversion.
+type MsgUpdateDecimalConversion struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// conversion defines the decimal conversion to be set.
+	Conversion DecimalConversion `protobuf:"bytes,2,opt,name=conversion,proto3" json:"conversion"`
+}
+
+func (m *MsgUpdateDecimalConversion) Reset()         { *m = MsgUpdateDecimalConversion{} }
+func (m *MsgUpdateDecimalConversion) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDecimalConversion) ProtoMessage()    {}
+func (*MsgUpdateDecimalConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{7}
+}
+func (m *MsgUpdateDecimalConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDecimalConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDecimalConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDecimalConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDecimalConversion.Merge(m, src)
+}
+func (m *MsgUpdateDecimalConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDecimalConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDecimalConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDecimalConversion proto.InternalMessageInfo
+
+// MsgUpdateDecimalConversionResponse defines the response structure for executing a
+// MsgUpdateDecimalConversion message.
+type MsgUpdateDecimalConversionResponse struct {
+}
+
+func (m *MsgUpdateDecimalConversionResponse) Reset()         { *m = MsgUpdateDecimalConversionResponse{} }
+func (m *MsgUpdateDecimalConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDecimalConversionResponse) ProtoMessage()    {}
+func (*MsgUpdateDecimalConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{8}
+}
+func (m *MsgUpdateDecimalConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDecimalConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDecimalConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDecimalConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDecimalConversionResponse.Merge(m, src)
+}
+func (m *MsgUpdateDecimalConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDecimalConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDecimalConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDecimalConversionResponse proto.InternalMessageInfo
+
+// MsgUpdateCanonicalChannel is the Msg/UpdateCanonicalChannel request type. It registers the
+// canonical channel for transfers to a counterparty chain. A canonical channel with an empty
+// channel identifier removes the registered canonical channel of the counterparty chain.
+type MsgUpdateCanonicalChannel struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// canonical_channel defines the canonical channel to be registered.
+	CanonicalChannel CanonicalChannel `protobuf:"bytes,2,opt,name=canonical_channel,json=canonicalChannel,proto3" json:"canonical_channel"`
+}
+
+func (m *MsgUpdateCanonicalChannel) Reset()         { *m = MsgUpdateCanonicalChannel{} }
+func (m *MsgUpdateCanonicalChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCanonicalChannel) ProtoMessage()    {}
+func (*MsgUpdateCanonicalChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{9}
+}
+func (m *MsgUpdateCanonicalChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCanonicalChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCanonicalChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCanonicalChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCanonicalChannel.Merge(m, src)
+}
+func (m *MsgUpdateCanonicalChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCanonicalChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCanonicalChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCanonicalChannel proto.InternalMessageInfo
+
+// MsgUpdateCanonicalChannelResponse defines the response structure for executing a
+// MsgUpdateCanonicalChannel message.
+type MsgUpdateCanonicalChannelResponse struct {
+}
+
+func (m *MsgUpdateCanonicalChannelResponse) Reset()         { *m = MsgUpdateCanonicalChannelResponse{} }
+func (m *MsgUpdateCanonicalChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCanonicalChannelResponse) ProtoMessage()    {}
+func (*MsgUpdateCanonicalChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{10}
+}
+func (m *MsgUpdateCanonicalChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCanonicalChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCanonicalChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCanonicalChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCanonicalChannelResponse.Merge(m, src)
+}
+func (m *MsgUpdateCanonicalChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCanonicalChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCanonicalChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCanonicalChannelResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
 	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRenameBaseDenoms)(nil), "ibc.applications.transfer.v1.MsgRenameBaseDenoms")
+	proto.RegisterType((*BaseDenomRename)(nil), "ibc.applications.transfer.v1.BaseDenomRename")
+	proto.RegisterType((*MsgRenameBaseDenomsResponse)(nil), "ibc.applications.transfer.v1.MsgRenameBaseDenomsResponse")
+	proto.RegisterType((*MsgUpdateDecimalConversion)(nil), "ibc.applications.transfer.v1.MsgUpdateDecimalConversion")
+	proto.RegisterType((*MsgUpdateDecimalConversionResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateDecimalConversionResponse")
+	proto.RegisterType((*MsgUpdateCanonicalChannel)(nil), "ibc.applications.transfer.v1.MsgUpdateCanonicalChannel")
+	proto.RegisterType((*MsgUpdateCanonicalChannelResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateCanonicalChannelResponse")
 }
 
 func init() {
@@ -221,46 +514,62 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x7e, 0x29, 0xfd, 0xc2, 0x54, 0x40, 0x56, 0x03, 0xcb, 0xc6, 0x6c, 0x49, 0x23, 0x09,
-	0x96, 0x30, 0x93, 0x62, 0x0c, 0xa6, 0xc7, 0x72, 0xf1, 0x20, 0x09, 0x36, 0x78, 0xf1, 0x42, 0x76,
-	0xa7, 0xcf, 0xed, 0x84, 0xee, 0xcc, 0x3a, 0x33, 0x6d, 0xf4, 0x62, 0x88, 0x27, 0xe3, 0xc9, 0x3f,
-	0xc1, 0xa3, 0x47, 0xfe, 0x0c, 0x8e, 0x1c, 0x3d, 0x19, 0x03, 0x07, 0x2e, 0xfe, 0x11, 0x66, 0x66,
-	0xa7, 0x75, 0xf5, 0x50, 0xf5, 0xb2, 0xfb, 0x7e, 0x7c, 0xde, 0xaf, 0xcf, 0x9b, 0x87, 0xb6, 0x58,
-	0x42, 0x49, 0x9c, 0xe7, 0x43, 0x46, 0x63, 0xcd, 0x04, 0x57, 0x44, 0xcb, 0x98, 0xab, 0x97, 0x20,
-	0xc9, 0xb8, 0x4d, 0xf4, 0x6b, 0x9c, 0x4b, 0xa1, 0x85, 0x7f, 0x8f, 0x25, 0x14, 0x97, 0x61, 0x78,
-	0x02, 0xc3, 0xe3, 0x76, 0xb8, 0x1a, 0x67, 0x8c, 0x0b, 0x62, 0xbf, 0x45, 0x40, 0x78, 0x37, 0x15,
-	0xa9, 0xb0, 0x22, 0x31, 0x92, 0xb3, 0xae, 0x53, 0xa1, 0x32, 0xa1, 0x48, 0xa6, 0x52, 0x93, 0x3e,
-	0x53, 0xa9, 0x73, 0x44, 0xce, 0x91, 0xc4, 0x0a, 0xc8, 0xb8, 0x9d, 0x80, 0x8e, 0xdb, 0x84, 0x0a,
-	0xc6, 0x9d, 0xbf, 0x61, 0xda, 0xa4, 0x42, 0x02, 0xa1, 0x43, 0x06, 0x5c, 0x9b, 0xe8, 0x42, 0x72,
-	0x80, 0x9d, 0xd9, 0x73, 0x4c, 0x9a, 0xb5, 0xe0, 0xe6, 0xd9, 0x1c, 0xaa, 0x1f, 0xaa, 0xf4, 0xd8,
-	0x59, 0xfd, 0x06, 0xaa, 0x2b, 0x31, 0x92, 0x14, 0x4e, 0x72, 0x21, 0x75, 0xe0, 0x6d, 0x7a, 0xdb,
-	0x8b, 0x3d, 0x54, 0x98, 0x8e, 0x84, 0xd4, 0xfe, 0x16, 0x5a, 0x76, 0x00, 0x3a, 0x88, 0x39, 0x87,
-	0x61, 0xf0, 0x9f, 0xc5, 0x2c, 0x15, 0xd6, 0x83, 0xc2, 0xe8, 0x77, 0xd0, 0xbc, 0x16, 0xa7, 0xc0,
-	0x83, 0xb9, 0x4d, 0x6f, 0xbb, 0xbe, 0xb7, 0x81, 0x8b, 0xa9, 0xb0, 0x99, 0x0a, 0xbb, 0xa9, 0xf0,
-	0x81, 0x60, 0xbc, 0xbb, 0x78, 0xf1, 0xb5, 0x51, 0xf9, 0x7c, 0x73, 0xde, 0xf2, 0x7a, 0x45, 0x88,
-	0xbf, 0x86, 0x6a, 0x0a, 0x78, 0x1f, 0x64, 0x50, 0xb5, 0xa9, 0x9d, 0xe6, 0x87, 0x68, 0x41, 0x02,
-	0x05, 0x36, 0x06, 0x19, 0xcc, 0x5b, 0xcf, 0x54, 0xf7, 0x9f, 0xa2, 0x65, 0xcd, 0x32, 0x10, 0x23,
-	0x7d, 0x32, 0x00, 0x96, 0x0e, 0x74, 0x50, 0xb3, 0x85, 0x43, 0x6c, 0xd6, 0x65, 0xe8, 0xc2, 0x8e,
-	0xa4, 0x71, 0x1b, 0x3f, 0xb1, 0x88, 0x72, 0xe5, 0x25, 0x17, 0x5c, 0x78, 0xfc, 0x1d, 0xb4, 0x3a,
-	0xc9, 0x66, 0xfe, 0x4a, 0xc7, 0x59, 0x1e, 0xfc, 0xbf, 0xe9, 0x6d, 0x57, 0x7b, 0xb7, 0x9d, 0xe3,
-	0x78, 0x62, 0xf7, 0x7d, 0x54, 0xcd, 0x20, 0x13, 0xc1, 0x82, 0x6d, 0xc9, 0xca, 0x9d, 0xd6, 0xfb,
-	0x4f, 0x8d, 0xca, 0xbb, 0x9b, 0xf3, 0x96, 0xeb, 0xfd, 0xc3, 0xcd, 0x79, 0x6b, 0xad, 0xa0, 0x60,
-	0x57, 0xf5, 0x4f, 0x49, 0x89, 0xf2, 0xe6, 0x3e, 0xba, 0x53, 0x52, 0x7b, 0xa0, 0x72, 0xc1, 0x15,
-	0x98, 0x69, 0x15, 0xbc, 0x1a, 0x01, 0xa7, 0x60, 0xd7, 0x50, 0xed, 0x4d, 0xf5, 0x4e, 0xd5, 0xa4,
-	0x6f, 0xbe, 0x45, 0x2b, 0x87, 0x2a, 0x7d, 0x9e, 0xf7, 0x63, 0x0d, 0x47, 0xb1, 0x8c, 0x33, 0x65,
-	0xa9, 0x63, 0x29, 0x07, 0xe9, 0x36, 0xe7, 0x34, 0xbf, 0x8b, 0x6a, 0xb9, 0x45, 0xd8, 0x6d, 0xd5,
-	0xf7, 0xee, 0xe3, 0x59, 0xaf, 0x18, 0x17, 0xd9, 0xba, 0x55, 0x43, 0x50, 0xcf, 0x45, 0x76, 0x56,
-	0x7e, 0xce, 0x64, 0x93, 0x36, 0x37, 0xd0, 0xfa, 0x6f, 0xf5, 0x27, 0xcd, 0xef, 0x7d, 0xf7, 0xd0,
-	0xdc, 0xa1, 0x4a, 0xfd, 0x01, 0x5a, 0x98, 0x3e, 0xad, 0x07, 0xb3, 0x6b, 0x96, 0x38, 0x08, 0xdb,
-	0x7f, 0x0d, 0x9d, 0xd2, 0xa5, 0xd1, 0xad, 0x5f, 0x98, 0xd8, 0xfd, 0x63, 0x8a, 0x32, 0x3c, 0x7c,
-	0xf4, 0x4f, 0xf0, 0x49, 0xd5, 0x70, 0xfe, 0xcc, 0x3c, 0x9f, 0xee, 0xb3, 0x8b, 0xab, 0xc8, 0xbb,
-	0xbc, 0x8a, 0xbc, 0x6f, 0x57, 0x91, 0xf7, 0xf1, 0x3a, 0xaa, 0x5c, 0x5e, 0x47, 0x95, 0x2f, 0xd7,
-	0x51, 0xe5, 0xc5, 0x7e, 0xca, 0xf4, 0x60, 0x94, 0x60, 0x2a, 0x32, 0xe2, 0x0e, 0x9b, 0x25, 0x74,
-	0x37, 0x15, 0x64, 0xfc, 0x98, 0x64, 0xa2, 0x3f, 0x1a, 0x82, 0x32, 0xc7, 0x5a, 0x3a, 0x52, 0xfd,
-	0x26, 0x07, 0x95, 0xd4, 0xec, 0x7d, 0x3e, 0xfc, 0x11, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xda, 0xd1,
-	0xc3, 0x96, 0x04, 0x00, 0x00,
+	// 868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x62, 0x27, 0x4d, 0x9f, 0xdb, 0xfc, 0x58, 0x50, 0xe2, 0x2c, 0xe0, 0x04, 0x93, 0x4a,
+	0x21, 0x55, 0x76, 0x65, 0x23, 0x94, 0x92, 0x0b, 0xc8, 0xe9, 0x81, 0x03, 0x91, 0x8a, 0xd5, 0x5e,
+	0xb8, 0x44, 0xe3, 0xf1, 0x63, 0x3d, 0xea, 0xee, 0xcc, 0xb2, 0x33, 0x76, 0xe0, 0x82, 0x22, 0x4e,
+	0x08, 0x09, 0x09, 0x21, 0x71, 0xeb, 0x81, 0x1b, 0x1c, 0xf3, 0x67, 0xf4, 0xd8, 0x23, 0x27, 0x84,
+	0x92, 0x43, 0xfe, 0x0d, 0x34, 0xb3, 0xb3, 0xcb, 0xd6, 0xae, 0xdd, 0x34, 0x97, 0x64, 0xe6, 0xcd,
+	0xf7, 0xde, 0x7c, 0xdf, 0xf7, 0x66, 0xd6, 0x03, 0xf7, 0x58, 0x9f, 0x06, 0x24, 0x49, 0x22, 0x46,
+	0x89, 0x62, 0x82, 0xcb, 0x40, 0xa5, 0x84, 0xcb, 0x6f, 0x30, 0x0d, 0xc6, 0xed, 0x40, 0x7d, 0xe7,
+	0x27, 0xa9, 0x50, 0xc2, 0x7d, 0x8f, 0xf5, 0xa9, 0x5f, 0x86, 0xf9, 0x39, 0xcc, 0x1f, 0xb7, 0xbd,
+	0x35, 0x12, 0x33, 0x2e, 0x02, 0xf3, 0x37, 0x4b, 0xf0, 0xde, 0x09, 0x45, 0x28, 0xcc, 0x30, 0xd0,
+	0x23, 0x1b, 0xdd, 0xa0, 0x42, 0xc6, 0x42, 0x06, 0xb1, 0x0c, 0x75, 0xf9, 0x58, 0x86, 0x76, 0xa1,
+	0x69, 0x17, 0xfa, 0x44, 0x62, 0x30, 0x6e, 0xf7, 0x51, 0x91, 0x76, 0x40, 0x05, 0xe3, 0x76, 0x7d,
+	0x4b, 0xd3, 0xa4, 0x22, 0xc5, 0x80, 0x46, 0x0c, 0xb9, 0xd2, 0xd9, 0xd9, 0xc8, 0x02, 0xee, 0xcf,
+	0xd7, 0x91, 0x93, 0x35, 0xe0, 0xd6, 0x59, 0x15, 0xea, 0xc7, 0x32, 0x7c, 0x6c, 0xa3, 0xee, 0x16,
+	0xd4, 0xa5, 0x18, 0xa5, 0x14, 0x4f, 0x12, 0x91, 0xaa, 0x86, 0xb3, 0xed, 0xec, 0xde, 0xee, 0x41,
+	0x16, 0x7a, 0x24, 0x52, 0xe5, 0xde, 0x83, 0x65, 0x0b, 0xa0, 0x43, 0xc2, 0x39, 0x46, 0x8d, 0xb7,
+	0x0c, 0xe6, 0x6e, 0x16, 0x3d, 0xca, 0x82, 0xee, 0x21, 0x2c, 0x28, 0xf1, 0x14, 0x79, 0xa3, 0xba,
+	0xed, 0xec, 0xd6, 0x3b, 0x9b, 0x7e, 0xa6, 0xca, 0xd7, 0xaa, 0x7c, 0xab, 0xca, 0x3f, 0x12, 0x8c,
+	0x77, 0x6f, 0x3f, 0xff, 0x67, 0xab, 0xf2, 0xd7, 0xd5, 0xf9, 0x9e, 0xd3, 0xcb, 0x52, 0xdc, 0x75,
+	0x58, 0x94, 0xc8, 0x07, 0x98, 0x36, 0x6a, 0xa6, 0xb4, 0x9d, 0xb9, 0x1e, 0x2c, 0xa5, 0x48, 0x91,
+	0x8d, 0x31, 0x6d, 0x2c, 0x98, 0x95, 0x62, 0xee, 0x7e, 0x09, 0xcb, 0x8a, 0xc5, 0x28, 0x46, 0xea,
+	0x64, 0x88, 0x2c, 0x1c, 0xaa, 0xc6, 0xa2, 0xd9, 0xd8, 0xf3, 0x75, 0xbb, 0xb4, 0x5d, 0xbe, 0x35,
+	0x69, 0xdc, 0xf6, 0xbf, 0x30, 0x88, 0xf2, 0xce, 0x77, 0x6d, 0x72, 0xb6, 0xe2, 0xde, 0x87, 0xb5,
+	0xbc, 0x9a, 0xfe, 0x2f, 0x15, 0x89, 0x93, 0xc6, 0xad, 0x6d, 0x67, 0xb7, 0xd6, 0x5b, 0xb5, 0x0b,
+	0x8f, 0xf3, 0xb8, 0xeb, 0x42, 0x2d, 0xc6, 0x58, 0x34, 0x96, 0x0c, 0x25, 0x33, 0x3e, 0xdc, 0xfb,
+	0xe9, 0x8f, 0xad, 0xca, 0x8f, 0x57, 0xe7, 0x7b, 0x96, 0xfb, 0xcf, 0x57, 0xe7, 0x7b, 0xeb, 0x99,
+	0x05, 0xfb, 0x72, 0xf0, 0x34, 0x28, 0x59, 0xde, 0x3a, 0x80, 0xb7, 0x4b, 0xd3, 0x1e, 0xca, 0x44,
+	0x70, 0x89, 0x5a, 0xad, 0xc4, 0x6f, 0x47, 0xc8, 0x29, 0x9a, 0x36, 0xd4, 0x7a, 0xc5, 0xfc, 0xb0,
+	0xa6, 0xcb, 0xb7, 0x7e, 0x80, 0x95, 0x63, 0x19, 0x3e, 0x49, 0x06, 0x44, 0xe1, 0x23, 0x92, 0x92,
+	0x58, 0x1a, 0xeb, 0x58, 0xc8, 0x31, 0xb5, 0x9d, 0xb3, 0x33, 0xb7, 0x0b, 0x8b, 0x89, 0x41, 0x98,
+	0x6e, 0xd5, 0x3b, 0x3b, 0xfe, 0xbc, 0x53, 0xec, 0x67, 0xd5, 0xba, 0x35, 0x6d, 0x50, 0xcf, 0x66,
+	0x1e, 0xae, 0xfc, 0xaf, 0xc9, 0x14, 0x6d, 0x6d, 0xc2, 0xc6, 0xc4, 0xfe, 0x39, 0xf9, 0xd6, 0x2f,
+	0x8e, 0x11, 0xd5, 0x43, 0x4e, 0x62, 0xec, 0x12, 0x89, 0x0f, 0x91, 0x8b, 0x39, 0xfc, 0x8e, 0xe1,
+	0x56, 0x6a, 0xb0, 0x9a, 0x60, 0x75, 0xb7, 0xde, 0xd9, 0x9f, 0x4f, 0xb0, 0x28, 0x69, 0x77, 0xc8,
+	0x98, 0xe6, 0x35, 0xa6, 0xa9, 0x9e, 0xc2, 0xca, 0x44, 0x8a, 0x6e, 0x5b, 0x42, 0xd4, 0xd0, 0x12,
+	0x31, 0x63, 0x77, 0x07, 0x96, 0x45, 0x34, 0x38, 0xd1, 0x87, 0xf4, 0x64, 0xa0, 0xb1, 0xf6, 0x70,
+	0xdf, 0x11, 0xd1, 0xa0, 0xc8, 0xd7, 0x28, 0x8e, 0xa7, 0x65, 0x54, 0x35, 0x43, 0x71, 0x3c, 0x2d,
+	0x50, 0xb6, 0x47, 0xef, 0xc3, 0xbb, 0xaf, 0xf0, 0xa1, 0xf0, 0xe9, 0x99, 0x03, 0x5e, 0xe1, 0xe1,
+	0x43, 0xa4, 0x2c, 0x26, 0xd1, 0x91, 0xe0, 0x63, 0x4c, 0x25, 0x13, 0x7c, 0xa6, 0x5d, 0x4f, 0x00,
+	0x68, 0x81, 0xb2, 0x2d, 0x0d, 0xe6, 0x3b, 0x36, 0x55, 0xdc, 0x7a, 0x56, 0x2a, 0x34, 0x6d, 0xdb,
+	0x0e, 0xb4, 0x66, 0xb3, 0x2b, 0x44, 0xfc, 0xe9, 0xc0, 0x66, 0x01, 0x3b, 0x22, 0x5c, 0x70, 0x46,
+	0x49, 0x94, 0x7f, 0x09, 0x66, 0x69, 0x20, 0xb0, 0x46, 0x73, 0xec, 0x4b, 0xdf, 0x92, 0x7a, 0xc7,
+	0x9f, 0x2f, 0x65, 0x72, 0x0b, 0xab, 0x64, 0x95, 0x4e, 0xc4, 0xa7, 0xf5, 0x7c, 0x08, 0x1f, 0xcc,
+	0x24, 0x9a, 0xcb, 0xe9, 0x3c, 0x5b, 0x80, 0xea, 0xb1, 0x0c, 0xdd, 0x21, 0x2c, 0x15, 0x9f, 0xc5,
+	0x8f, 0xe6, 0x33, 0x2a, 0xdd, 0x5f, 0xaf, 0x7d, 0x6d, 0x68, 0x71, 0xd5, 0x15, 0xdc, 0x79, 0xe9,
+	0x16, 0xef, 0xbf, 0xb6, 0x44, 0x19, 0xee, 0x7d, 0xf2, 0x46, 0xf0, 0x62, 0xd7, 0x33, 0x07, 0x56,
+	0xa7, 0x2e, 0xe8, 0xeb, 0xd9, 0x4f, 0xa6, 0x78, 0x9f, 0xbe, 0x71, 0x4a, 0x41, 0xe1, 0x77, 0x07,
+	0x36, 0x66, 0x9d, 0xfd, 0x07, 0xd7, 0x54, 0x35, 0x95, 0xe9, 0x7d, 0x7e, 0xd3, 0xcc, 0x82, 0xd7,
+	0x6f, 0x0e, 0xac, 0xcf, 0x38, 0xce, 0x07, 0xd7, 0x2c, 0x3e, 0x99, 0xe8, 0x7d, 0x76, 0xc3, 0xc4,
+	0x9c, 0x94, 0xb7, 0x70, 0xa6, 0x7f, 0xaa, 0xba, 0x5f, 0x3d, 0xbf, 0x68, 0x3a, 0x2f, 0x2e, 0x9a,
+	0xce, 0xbf, 0x17, 0x4d, 0xe7, 0xd7, 0xcb, 0x66, 0xe5, 0xc5, 0x65, 0xb3, 0xf2, 0xf7, 0x65, 0xb3,
+	0xf2, 0xf5, 0x41, 0xc8, 0xd4, 0x70, 0xd4, 0xf7, 0xa9, 0x88, 0x03, 0xfb, 0x88, 0x60, 0x7d, 0xba,
+	0x1f, 0x8a, 0x60, 0xfc, 0x20, 0x88, 0xc5, 0x60, 0x14, 0xa1, 0xd4, 0x0f, 0x83, 0xd2, 0x83, 0x40,
+	0x7d, 0x9f, 0xa0, 0xec, 0x2f, 0x9a, 0xb7, 0xc0, 0xc7, 0xff, 0x0d, 0x00, 0xf9, 0x3e, 0xe8, 0xeb,
+	0x02, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +588,12 @@ type MsgClient interface {
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RenameBaseDenoms defines a rpc handler for MsgRenameBaseDenoms.
+	RenameBaseDenoms(ctx context.Context, in *MsgRenameBaseDenoms, opts ...grpc.CallOption) (*MsgRenameBaseDenomsResponse, error)
+	// UpdateDecimalConversion defines a rpc handler for MsgUpdateDecimalConversion.
+	UpdateDecimalConversion(ctx context.Context, in *MsgUpdateDecimalConversion, opts ...grpc.CallOption) (*MsgUpdateDecimalConversionResponse, error)
+	// UpdateCanonicalChannel defines a rpc handler for MsgUpdateCanonicalChannel.
+	UpdateCanonicalChannel(ctx context.Context, in *MsgUpdateCanonicalChannel, opts ...grpc.CallOption) (*MsgUpdateCanonicalChannelResponse, error)
 }
 
 type msgClient struct {
@@ -307,12 +622,45 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RenameBaseDenoms(ctx context.Context, in *MsgRenameBaseDenoms, opts ...grpc.CallOption) (*MsgRenameBaseDenomsResponse, error) {
+	out := new(MsgRenameBaseDenomsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/RenameBaseDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDecimalConversion(ctx context.Context, in *MsgUpdateDecimalConversion, opts ...grpc.CallOption) (*MsgUpdateDecimalConversionResponse, error) {
+	out := new(MsgUpdateDecimalConversionResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateDecimalConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCanonicalChannel(ctx context.Context, in *MsgUpdateCanonicalChannel, opts ...grpc.CallOption) (*MsgUpdateCanonicalChannelResponse, error) {
+	out := new(MsgUpdateCanonicalChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateCanonicalChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RenameBaseDenoms defines a rpc handler for MsgRenameBaseDenoms.
+	RenameBaseDenoms(context.Context, *MsgRenameBaseDenoms) (*MsgRenameBaseDenomsResponse, error)
+	// UpdateDecimalConversion defines a rpc handler for MsgUpdateDecimalConversion.
+	UpdateDecimalConversion(context.Context, *MsgUpdateDecimalConversion) (*MsgUpdateDecimalConversionResponse, error)
+	// UpdateCanonicalChannel defines a rpc handler for MsgUpdateCanonicalChannel.
+	UpdateCanonicalChannel(context.Context, *MsgUpdateCanonicalChannel) (*MsgUpdateCanonicalChannelResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +673,15 @@ func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RenameBaseDenoms(ctx context.Context, req *MsgRenameBaseDenoms) (*MsgRenameBaseDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameBaseDenoms not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDecimalConversion(ctx context.Context, req *MsgUpdateDecimalConversion) (*MsgUpdateDecimalConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDecimalConversion not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCanonicalChannel(ctx context.Context, req *MsgUpdateCanonicalChannel) (*MsgUpdateCanonicalChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCanonicalChannel not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +723,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RenameBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenameBaseDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenameBaseDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/RenameBaseDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenameBaseDenoms(ctx, req.(*MsgRenameBaseDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDecimalConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDecimalConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDecimalConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateDecimalConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDecimalConversion(ctx, req.(*MsgUpdateDecimalConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCanonicalChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCanonicalChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCanonicalChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateCanonicalChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCanonicalChannel(ctx, req.(*MsgUpdateCanonicalChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -378,6 +789,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RenameBaseDenoms",
+			Handler:    _Msg_RenameBaseDenoms_Handler,
+		},
+		{
+			MethodName: "UpdateDecimalConversion",
+			Handler:    _Msg_UpdateDecimalConversion_Handler,
+		},
+		{
+			MethodName: "UpdateCanonicalChannel",
+			Handler:    _Msg_UpdateCanonicalChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/tx.proto",
@@ -557,32 +980,269 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRenameBaseDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRenameBaseDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenameBaseDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourcePort)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Renames) > 0 {
+		for iNdEx := len(m.Renames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Renames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.Token.Size()
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseDenomRename) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseDenomRename) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseDenomRename) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewBaseDenom) > 0 {
+		i -= len(m.NewBaseDenom)
+		copy(dAtA[i:], m.NewBaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldBaseDenom) > 0 {
+		i -= len(m.OldBaseDenom)
+		copy(dAtA[i:], m.OldBaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldBaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenameBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenameBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenameBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDecimalConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDecimalConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDecimalConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Conversion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDecimalConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDecimalConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDecimalConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCanonicalChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCanonicalChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCanonicalChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CanonicalChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCanonicalChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCanonicalChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCanonicalChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
 	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Sender)
 	if l > 0 {
@@ -601,52 +1261,513 @@ func (m *MsgTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenameBaseDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Renames) > 0 {
+		for _, e := range m.Renames {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BaseDenomRename) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenameBaseDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDecimalConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Conversion.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDecimalConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCanonicalChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CanonicalChannel.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCanonicalChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -669,15 +1790,15 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -705,11 +1826,144 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenameBaseDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenameBaseDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenameBaseDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -737,11 +1991,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Renames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -768,13 +2022,64 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Renames = append(m.Renames, BaseDenomRename{})
+			if err := m.Renames[len(m.Renames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseDenomRename) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseDenomRename: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseDenomRename: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -802,11 +2107,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -834,13 +2139,13 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.OldBaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -850,30 +2155,129 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewBaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.TimeoutTimestamp = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenameBaseDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenameBaseDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenameBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDecimalConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDecimalConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDecimalConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -883,16 +2287,29 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversion", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -902,23 +2319,24 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			if err := m.Conversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -941,7 +2359,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDecimalConversionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -964,31 +2382,12 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDecimalConversionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDecimalConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1010,7 +2409,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCanonicalChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1033,10 +2432,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCanonicalChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCanonicalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1073,7 +2472,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1100,7 +2499,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CanonicalChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1125,7 +2524,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCanonicalChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1148,10 +2547,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCanonicalChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCanonicalChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewTransferVolume creates a new TransferVolume instance with zero sent and received volume.
+func NewTransferVolume(channelID, denom string) TransferVolume {
+	return TransferVolume{
+		ChannelId: channelID,
+		Denom:     denom,
+		Sent:      sdkmath.ZeroInt(),
+		Received:  sdkmath.ZeroInt(),
+	}
+}
+
+// Validate performs a basic validation of the TransferVolume fields.
+func (tv TransferVolume) Validate() error {
+	if err := host.ChannelIdentifierValidator(tv.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(tv.Denom); err != nil {
+		return err
+	}
+
+	if tv.Sent.IsNil() || tv.Sent.IsNegative() {
+		return fmt.Errorf("sent volume must be non-negative, got %s", tv.Sent)
+	}
+
+	if tv.Received.IsNil() || tv.Received.IsNegative() {
+		return fmt.Errorf("received volume must be non-negative, got %s", tv.Received)
+	}
+
+	return nil
+}
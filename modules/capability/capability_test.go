@@ -67,7 +67,7 @@ func (suite *CapabilityTestSuite) NewTestContext() sdk.Context {
 // and ensures that the current code successfully fixes the issue.
 // This test emulates statesync by firstly populating persisted state by creating a new scoped keeper and capability.
 // In-memory storage is then discarded by creating a new capability keeper and app module using a mock memstore key.
-// BeginBlock is then called to populate the new in-memory store using the persisted state.
+// Capabilities are lazily loaded from persisted state and BeginBlock is called to set the initialized flag.
 func (suite *CapabilityTestSuite) TestInitializeMemStore() {
 	// create a scoped keeper and instantiate a new capability to populate state
 	scopedKeeper := suite.keeper.ScopeToModule(banktypes.ModuleName)
@@ -88,9 +88,13 @@ func (suite *CapabilityTestSuite) TestInitializeMemStore() {
 	newKeeper.Seal()
 	suite.Require().False(newKeeper.IsInitialized(suite.ctx), "memstore initialized flag set before BeginBlock")
 
-	cap1, ok := scopedKeeper.GetCapability(suite.ctx, "transfer")
-	suite.Require().False(ok)
-	suite.Require().Nil(cap1)
+	// the capability is lazily loaded from persisted state, the previous in-memory capability is not recognised
+	loadedCap, ok := scopedKeeper.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().NotNil(loadedCap)
+	suite.Require().Equal(cap1.GetIndex(), loadedCap.GetIndex())
+	suite.Require().True(scopedKeeper.AuthenticateCapability(suite.ctx, loadedCap, "transfer"))
+	suite.Require().False(scopedKeeper.AuthenticateCapability(suite.ctx, cap1, "transfer"))
 
 	// add a new block gas meter to the context
 	ctx := suite.ctx.WithBlockGasMeter(storetypes.NewGasMeter(50))
@@ -111,22 +115,48 @@ func (suite *CapabilityTestSuite) TestInitializeMemStore() {
 	// assert that the in-memory store is now initialized
 	suite.Require().True(newKeeper.IsInitialized(ctx), "memstore initialized flag not set")
 
-	// ensure that BeginBlock has populated the new in-memory store (using the mock memstore key) and initialized capabilities
-	cap1, ok = scopedKeeper.GetCapability(ctx, "transfer")
-	suite.Require().True(ok)
-	suite.Require().NotNil(cap1)
-
-	// ensure capabilities do not get reinitialized on next BeginBlock by comparing capability pointers
-	// and assert that the in-memory store is still initialized
+	// ensure capabilities do not get reinitialized by BeginBlock by comparing capability pointers
 	err = newModule.BeginBlock(ctx)
 	suite.Require().NoError(err)
 
 	refreshedCap, ok := scopedKeeper.GetCapability(ctx, "transfer")
 	suite.Require().True(ok)
-	suite.Require().Equal(cap1, refreshedCap, "capabilities got reinitialized after second BeginBlock")
+	suite.Require().Same(loadedCap, refreshedCap, "capabilities got reinitialized after BeginBlock")
 	suite.Require().True(newKeeper.IsInitialized(ctx), "memstore initialized flag not set")
 }
 
+// TestBeginBlockKeepsRevertedRelease ensures that BeginBlock only prunes in-memory capabilities
+// whose release was committed, so that a reverted release does not invalidate the capability.
+func (suite *CapabilityTestSuite) TestBeginBlockKeepsRevertedRelease() {
+	module := capability.NewAppModule(suite.cdc, *suite.keeper, true)
+	scopedKeeper := suite.keeper.ScopeToModule(banktypes.ModuleName)
+
+	cap1, err := scopedKeeper.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+
+	// release the capability on a cached context which is not written
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(scopedKeeper.ReleaseCapability(cacheCtx, cap1))
+
+	err = module.BeginBlock(suite.ctx)
+	suite.Require().NoError(err)
+
+	got, ok := scopedKeeper.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Same(cap1, got, "capability pruned after a reverted release")
+	suite.Require().True(scopedKeeper.AuthenticateCapability(suite.ctx, cap1, "transfer"))
+
+	// a committed release is pruned
+	suite.Require().NoError(scopedKeeper.ReleaseCapability(suite.ctx, cap1))
+
+	err = module.BeginBlock(suite.ctx)
+	suite.Require().NoError(err)
+
+	_, ok = scopedKeeper.GetCapability(suite.ctx, "transfer")
+	suite.Require().False(ok)
+	suite.Require().False(scopedKeeper.AuthenticateCapability(suite.ctx, cap1, "transfer"))
+}
+
 func TestCapabilityTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(CapabilityTestSuite))
 }
@@ -4,13 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
-	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/modules/capability/types"
@@ -22,20 +24,22 @@ type (
 	// initialization, the keeper can be hooked up to modules through unique function
 	// references so that it can identify the calling module when later invoked.
 	//
-	// When the initial state is loaded from disk, the keeper allows the ability to
-	// create new capability keys for all previously allocated capability identifiers
-	// (allocated during execution of past transactions and assigned to particular modes),
-	// and keep them in a memory-only store while the chain is running.
+	// Capability owners are persisted together with a reverse index from the module and
+	// capability name to the capability index. The in-memory capability pointers are
+	// created lazily the first time a capability is retrieved after the chain is started,
+	// so no rebuild of in-memory state is required on startup.
 	//
 	// The keeper allows the ability to create scoped sub-keepers which are tied to
 	// a single specific module.
 	Keeper struct {
-		cdc           codec.BinaryCodec
-		storeKey      storetypes.StoreKey
-		memKey        storetypes.StoreKey
-		capMap        map[uint64]*types.Capability
-		scopedModules map[string]struct{}
-		sealed        bool
+		cdc               codec.BinaryCodec
+		memKey            storetypes.StoreKey
+		latestIndex       collections.Item[uint64]
+		owners            collections.Map[uint64, types.CapabilityOwners]
+		capabilityIndexes collections.Map[collections.Pair[string, string], uint64]
+		capMap            *capabilityMap
+		scopedModules     map[string]struct{}
+		sealed            bool
 	}
 
 	// ScopedKeeper defines a scoped sub-keeper which is tied to a single specific
@@ -45,25 +49,104 @@ type (
 	// by name, in addition to creating new capabilities & authenticating capabilities
 	// passed by other modules.
 	ScopedKeeper struct {
-		cdc      codec.BinaryCodec
-		storeKey storetypes.StoreKey
-		memKey   storetypes.StoreKey
-		capMap   map[uint64]*types.Capability
-		module   string
+		latestIndex       collections.Item[uint64]
+		owners            collections.Map[uint64, types.CapabilityOwners]
+		capabilityIndexes collections.Map[collections.Pair[string, string], uint64]
+		capMap            *capabilityMap
+		module            string
 	}
 )
 
+// capabilityMap maps capability indexes to the in-memory capabilities handed out to
+// modules. It is shared between the keeper and all scoped keepers and is safe for
+// concurrent use, since capabilities may be lazily loaded from query contexts.
+//
+// The map is not part of the transactional store, so it is never modified in a way which
+// must be reverted with a transaction: an in-memory capability is only handed out if the
+// persisted reverse index resolves to its index, and the index of a committed capability is
+// never reused.
+// Released capabilities are only removed from the map once their release has been committed.
+type capabilityMap struct {
+	mtx      sync.RWMutex
+	caps     map[uint64]*types.Capability
+	released map[uint64]struct{}
+}
+
+// get returns the in-memory capability for the given index, or nil if it has not been loaded.
+func (m *capabilityMap) get(index uint64) *types.Capability {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.caps[index]
+}
+
+// getOrCreate returns the in-memory capability for the given index, creating it if it
+// has not been loaded yet.
+func (m *capabilityMap) getOrCreate(index uint64) *types.Capability {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	capability, ok := m.caps[index]
+	if !ok {
+		capability = types.NewCapability(index)
+		m.caps[index] = capability
+	}
+
+	return capability
+}
+
+// markReleased records that the capability with the given index no longer has any owner.
+// The in-memory capability is kept until pruneReleased is called, as the release may still
+// be reverted.
+func (m *capabilityMap) markReleased(index uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.released[index] = struct{}{}
+}
+
+// pruneReleased removes the in-memory capabilities marked as released for which hasOwners
+// returns false, and clears the set of released capabilities.
+func (m *capabilityMap) pruneReleased(hasOwners func(index uint64) bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for index := range m.released {
+		if !hasOwners(index) {
+			delete(m.caps, index)
+		}
+	}
+
+	m.released = make(map[uint64]struct{})
+}
+
 // NewKeeper constructs a new CapabilityKeeper instance and initializes maps
-// for capability map and scopedModules map.
+// for capability map and scopedModules map. The provided store key must be a
+// KVStoreKey.
 func NewKeeper(cdc codec.BinaryCodec, storeKey, memKey storetypes.StoreKey) *Keeper {
-	return &Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		memKey:        memKey,
-		capMap:        make(map[uint64]*types.Capability),
-		scopedModules: make(map[string]struct{}),
-		sealed:        false,
+	kvStoreKey, ok := storeKey.(*storetypes.KVStoreKey)
+	if !ok {
+		panic(fmt.Errorf("invalid store key type; got %T, expected: %T", storeKey, &storetypes.KVStoreKey{}))
+	}
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(kvStoreKey))
+
+	keeper := &Keeper{
+		cdc:               cdc,
+		memKey:            memKey,
+		latestIndex:       collections.NewItem(sb, collections.NewPrefix(types.KeyIndex), "index", collections.Uint64Value),
+		owners:            collections.NewMap(sb, collections.NewPrefix(types.KeyPrefixIndexCapability), "owners", collections.Uint64Key, codec.CollValue[types.CapabilityOwners](cdc)),
+		capabilityIndexes: collections.NewMap(sb, collections.NewPrefix(types.KeyPrefixOwnerCapability), "capability_indexes", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+		capMap:            &capabilityMap{caps: make(map[uint64]*types.Capability), released: make(map[uint64]struct{})},
+		scopedModules:     make(map[string]struct{}),
+		sealed:            false,
 	}
+
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+
+	return keeper
 }
 
 // ScopeToModule attempts to create and return a ScopedKeeper for a given module
@@ -84,11 +167,11 @@ func (k *Keeper) ScopeToModule(moduleName string) ScopedKeeper {
 	k.scopedModules[moduleName] = struct{}{}
 
 	return ScopedKeeper{
-		cdc:      k.cdc,
-		storeKey: k.storeKey,
-		memKey:   k.memKey,
-		capMap:   k.capMap,
-		module:   moduleName,
+		latestIndex:       k.latestIndex,
+		owners:            k.owners,
+		capabilityIndexes: k.capabilityIndexes,
+		capMap:            k.capMap,
+		module:            moduleName,
 	}
 }
 
@@ -108,10 +191,14 @@ func (k *Keeper) IsSealed() bool {
 }
 
 // InitMemStore will assure that the module store is a memory store (it will panic if it's not)
-// and will initialize it. The function is safe to be called multiple times.
+// and will set the initialized flag. The function is safe to be called multiple times.
 // InitMemStore must be called every time the app starts before the keeper is used (so
 // `BeginBlock` or `InitChain` - whichever is first). We need access to the store so we
 // can't initialize it in a constructor.
+//
+// In-memory capabilities are loaded lazily from the persisted reverse index, so this
+// function does not iterate over the persisted capabilities. It removes the in-memory
+// capabilities whose release was committed in a previous block.
 func (k *Keeper) InitMemStore(ctx sdk.Context) {
 	memStore := ctx.KVStore(k.memKey)
 	memStoreType := memStore.GetStoreType()
@@ -125,25 +212,15 @@ func (k *Keeper) InitMemStore(ctx sdk.Context) {
 
 	// check if memory store has not been initialized yet by checking if initialized flag is nil.
 	if !k.IsInitialized(noGasCtx) {
-		prefixStore := prefix.NewStore(noGasCtx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
-		iterator := storetypes.KVStorePrefixIterator(prefixStore, nil)
-
-		// initialize the in-memory store for all persisted capabilities
-		defer iterator.Close()
-
-		for ; iterator.Valid(); iterator.Next() {
-			index := types.IndexFromKey(iterator.Key())
-
-			var capOwners types.CapabilityOwners
-
-			k.cdc.MustUnmarshal(iterator.Value(), &capOwners)
-			k.InitializeCapability(noGasCtx, index, capOwners)
-		}
-
 		// set the initialized flag so we don't rerun initialization logic
 		memStore := noGasCtx.KVStore(k.memKey)
 		memStore.Set(types.KeyMemInitialized, []byte{1})
 	}
+
+	k.capMap.pruneReleased(func(index uint64) bool {
+		_, found := k.GetOwners(noGasCtx, index)
+		return found
+	})
 }
 
 // IsInitialized returns true if the keeper is properly initialized, and false otherwise.
@@ -165,70 +242,80 @@ func (k Keeper) InitializeIndex(ctx sdk.Context, index uint64) error {
 	}
 
 	// set the global index to the passed index
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.KeyIndex, types.IndexToKey(index))
-	return nil
+	return k.latestIndex.Set(ctx, index)
 }
 
 // GetLatestIndex returns the latest index of the CapabilityKeeper
 func (k Keeper) GetLatestIndex(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	return types.IndexFromKey(store.Get(types.KeyIndex))
+	index, err := k.latestIndex.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0
+		}
+		panic(err)
+	}
+
+	return index
 }
 
-// SetOwners set the capability owners to the store
+// SetOwners set the capability owners to the store along with the reverse index
+// entries of each owner. Reverse index entries of owners which are no longer present
+// are removed.
 func (k Keeper) SetOwners(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
-	indexKey := types.IndexToKey(index)
+	if prevOwners, found := k.GetOwners(ctx, index); found {
+		for _, owner := range prevOwners.Owners {
+			if err := k.capabilityIndexes.Remove(ctx, collections.Join(owner.Module, owner.Name)); err != nil {
+				panic(err)
+			}
+		}
+	}
 
 	// set owners in persistent store
-	prefixStore.Set(indexKey, k.cdc.MustMarshal(&owners))
+	if err := k.owners.Set(ctx, index, owners); err != nil {
+		panic(err)
+	}
+
+	for _, owner := range owners.Owners {
+		if err := k.capabilityIndexes.Set(ctx, collections.Join(owner.Module, owner.Name), index); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // GetOwners returns the capability owners with a given index.
 func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners, bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
-	indexKey := types.IndexToKey(index)
-
-	// get owners for index from persistent store
-	ownerBytes := prefixStore.Get(indexKey)
-	if ownerBytes == nil {
-		return types.CapabilityOwners{}, false
+	owners, err := k.owners.Get(ctx, index)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.CapabilityOwners{}, false
+		}
+		panic(err)
 	}
-	var owners types.CapabilityOwners
-	k.cdc.MustUnmarshal(ownerBytes, &owners)
+
 	return owners, true
 }
 
 // InitializeCapability takes in an index and an owners array. It creates the capability in memory
-// and sets the fwd and reverse keys for each owner in the memstore.
+// and sets the reverse index entry for each owner in the persistent store.
 // It is used during initialization from genesis.
 func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
-	memStore := ctx.KVStore(k.memKey)
-
-	capability := types.NewCapability(index)
 	for _, owner := range owners.Owners {
-		// Set the forward mapping between the module and capability tuple and the
-		// capability name in the memKVStore
-		memStore.Set(types.FwdCapabilityKey(owner.Module, capability), []byte(owner.Name))
-
-		// Set the reverse mapping between the module and capability name and the
-		// index in the in-memory store. Since marshalling and unmarshalling into a store
-		// will change memory address of capability, we simply store index as value here
-		// and retrieve the in-memory pointer to the capability from our map
-		memStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(index))
-
-		// Set the mapping from index from index to in-memory capability in the go map
-		k.capMap[index] = capability
+		// Set the reverse mapping between the module and capability name and the index.
+		if err := k.capabilityIndexes.Set(ctx, collections.Join(owner.Module, owner.Name), index); err != nil {
+			panic(err)
+		}
 	}
+
+	// Set the mapping from index to in-memory capability in the go map
+	k.capMap.getOrCreate(index)
 }
 
 // NewCapability attempts to create a new capability with a given name. If the
-// capability already exists in the in-memory store, an error will be returned.
+// capability already exists for the scoped module, an error will be returned.
 // Otherwise, a new capability is created with the current global unique index.
 // The newly created capability has the scoped module name and capability name
 // tuple set as the initial owner. Finally, the global index is incremented along
-// with forward and reverse indexes set in the in-memory store.
+// with the reverse index set in the persistent store.
 //
 // Note, namespacing is completely local, which is safe since records are prefixed
 // with the module name and no two ScopedKeeper can have the same module name.
@@ -236,38 +323,30 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 	if strings.TrimSpace(name) == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidCapabilityName, "capability name cannot be empty")
 	}
-	store := ctx.KVStore(sk.storeKey)
 
 	if _, ok := sk.GetCapability(ctx, name); ok {
 		return nil, errorsmod.Wrapf(types.ErrCapabilityTaken, fmt.Sprintf("module: %s, name: %s", sk.module, name))
 	}
 
 	// create new capability with the current global index
-	index := types.IndexFromKey(store.Get(types.KeyIndex))
-	capability := types.NewCapability(index)
+	index, err := sk.latestIndex.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
 
-	// update capability owner set
+	// the in-memory capability may already exist if a previous transaction creating a
+	// capability with the same index was reverted, in which case it is reused
+	capability := sk.capMap.getOrCreate(index)
+
+	// update capability owner set and reverse index
 	if err := sk.addOwner(ctx, capability, name); err != nil {
 		return nil, err
 	}
 
 	// increment global index
-	store.Set(types.KeyIndex, types.IndexToKey(index+1))
-
-	memStore := ctx.KVStore(sk.memKey)
-
-	// Set the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Set(types.FwdCapabilityKey(sk.module, capability), []byte(name))
-
-	// Set the reverse mapping between the module and capability name and the
-	// index in the in-memory store. Since marshalling and unmarshalling into a store
-	// will change memory address of capability, we simply store index as value here
-	// and retrieve the in-memory pointer to the capability from our map
-	memStore.Set(types.RevCapabilityKey(sk.module, name), sdk.Uint64ToBigEndian(index))
-
-	// Set the mapping from index from index to in-memory capability in the go map
-	sk.capMap[index] = capability
+	if err := sk.latestIndex.Set(ctx, index+1); err != nil {
+		return nil, err
+	}
 
 	logger(ctx).Info("created new capability", "module", sk.module, "name", name)
 
@@ -277,11 +356,11 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 // AuthenticateCapability attempts to authenticate a given capability and name
 // from a caller. It allows for a caller to check that a capability does in fact
 // correspond to a particular name. The scoped keeper will lookup the capability
-// from the internal in-memory store and check against the provided name. It returns
-// true upon success and false upon failure.
+// owners and check against the provided name. It returns true upon success and
+// false upon failure.
 //
-// Note, the capability's forward mapping is indexed by a string which should
-// contain its unique memory reference.
+// Note, only capabilities handed out by the keeper are authenticated, a capability
+// with the same index but a different memory reference will fail authentication.
 func (sk ScopedKeeper) AuthenticateCapability(ctx sdk.Context, cap *types.Capability, name string) bool {
 	if strings.TrimSpace(name) == "" || cap == nil {
 		return false
@@ -293,7 +372,7 @@ func (sk ScopedKeeper) AuthenticateCapability(ctx sdk.Context, cap *types.Capabi
 // the scoped module's name tuple are treated as the owner. It will attempt
 // to add the owner to the persistent set of capability owners for the capability
 // index. If the owner already exists, it will return an error. Otherwise, it will
-// also set a forward and reverse index for the capability and capability name.
+// also set the reverse index for the capability name.
 func (sk ScopedKeeper) ClaimCapability(ctx sdk.Context, cap *types.Capability, name string) error {
 	if cap == nil {
 		return errorsmod.Wrap(types.ErrNilCapability, "cannot claim nil capability")
@@ -301,23 +380,11 @@ func (sk ScopedKeeper) ClaimCapability(ctx sdk.Context, cap *types.Capability, n
 	if strings.TrimSpace(name) == "" {
 		return errorsmod.Wrap(types.ErrInvalidCapabilityName, "capability name cannot be empty")
 	}
-	// update capability owner set
+	// update capability owner set and reverse index
 	if err := sk.addOwner(ctx, cap, name); err != nil {
 		return err
 	}
 
-	memStore := ctx.KVStore(sk.memKey)
-
-	// Set the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Set(types.FwdCapabilityKey(sk.module, cap), []byte(name))
-
-	// Set the reverse mapping between the module and capability name and the
-	// index in the in-memory store. Since marshalling and unmarshalling into a store
-	// will change memory address of capability, we simply store index as value here
-	// and retrieve the in-memory pointer to the capability from our map
-	memStore.Set(types.RevCapabilityKey(sk.module, name), sdk.Uint64ToBigEndian(cap.GetIndex()))
-
 	logger(ctx).Info("claimed capability", "module", sk.module, "name", name, "capability", cap.GetIndex())
 
 	return nil
@@ -335,31 +402,27 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 		return errorsmod.Wrap(types.ErrCapabilityNotOwned, sk.module)
 	}
 
-	memStore := ctx.KVStore(sk.memKey)
-
-	// Delete the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Delete(types.FwdCapabilityKey(sk.module, cap))
-
-	// Delete the reverse mapping between the module and capability name and the
-	// index in the in-memory store.
-	memStore.Delete(types.RevCapabilityKey(sk.module, name))
+	// Delete the reverse mapping between the module and capability name and the index.
+	if err := sk.capabilityIndexes.Remove(ctx, collections.Join(sk.module, name)); err != nil {
+		return err
+	}
 
 	// remove owner
 	capOwners := sk.getOwners(ctx, cap)
 	capOwners.Remove(types.NewOwner(sk.module, name))
 
-	prefixStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixIndexCapability)
-	indexKey := types.IndexToKey(cap.GetIndex())
-
 	if len(capOwners.Owners) == 0 {
 		// remove capability owner set
-		prefixStore.Delete(indexKey)
-		// since no one owns capability, we can delete capability from map
-		delete(sk.capMap, cap.GetIndex())
+		if err := sk.owners.Remove(ctx, cap.GetIndex()); err != nil {
+			return err
+		}
+		// since no one owns capability, it can be deleted from the map once the release is committed
+		sk.capMap.markReleased(cap.GetIndex())
 	} else {
 		// update capability owner set
-		prefixStore.Set(indexKey, sk.cdc.MustMarshal(capOwners))
+		if err := sk.owners.Set(ctx, cap.GetIndex(), *capOwners); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -367,34 +430,22 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 
 // GetCapability allows a module to fetch a capability which it previously claimed
 // by name. The module is not allowed to retrieve capabilities which it does not
-// own.
+// own. The in-memory capability is created on first retrieval if it has not been
+// loaded since the chain was started.
 func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capability, bool) {
 	if strings.TrimSpace(name) == "" {
 		return nil, false
 	}
-	memStore := ctx.KVStore(sk.memKey)
-
-	key := types.RevCapabilityKey(sk.module, name)
-	indexBytes := memStore.Get(key)
-	index := sdk.BigEndianToUint64(indexBytes)
 
-	if len(indexBytes) == 0 {
-		// If a tx failed and NewCapability got reverted, it is possible
-		// to still have the capability in the go map since changes to
-		// go map do not automatically get reverted on tx failure,
-		// so we delete here to remove unnecessary values in map
-		// TODO: Delete index correctly from capMap by storing some reverse lookup
-		// in-memory map. Issue: https://github.com/cosmos/cosmos-sdk/issues/7805
-
-		return nil, false
-	}
-
-	capability := sk.capMap[index]
-	if capability == nil {
-		panic(errors.New("capability found in memstore is missing from map"))
+	index, err := sk.capabilityIndexes.Get(ctx, collections.Join(sk.module, name))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, false
+		}
+		panic(err)
 	}
 
-	return capability, true
+	return sk.capMap.getOrCreate(index), true
 }
 
 // GetCapabilityName allows a module to retrieve the name under which it stored a given
@@ -403,9 +454,19 @@ func (sk ScopedKeeper) GetCapabilityName(ctx sdk.Context, cap *types.Capability)
 	if cap == nil {
 		return ""
 	}
-	memStore := ctx.KVStore(sk.memKey)
 
-	return string(memStore.Get(types.FwdCapabilityKey(sk.module, cap)))
+	// only capabilities handed out by the keeper are recognised
+	if sk.capMap.get(cap.GetIndex()) != cap {
+		return ""
+	}
+
+	for _, owner := range sk.getOwners(ctx, cap).Owners {
+		if owner.Module == sk.module {
+			return owner.Name
+		}
+	}
+
+	return ""
 }
 
 // GetOwners all the Owners that own the capability associated with the name this ScopedKeeper uses
@@ -419,25 +480,21 @@ func (sk ScopedKeeper) GetOwners(ctx sdk.Context, name string) (*types.Capabilit
 		return nil, false
 	}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixIndexCapability)
-	indexKey := types.IndexToKey(capability.GetIndex())
-
-	var capOwners types.CapabilityOwners
-
-	bz := prefixStore.Get(indexKey)
-	if len(bz) == 0 {
-		return nil, false
+	capOwners, err := sk.owners.Get(ctx, capability.GetIndex())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, false
+		}
+		panic(err)
 	}
 
-	sk.cdc.MustUnmarshal(bz, &capOwners)
-
 	return &capOwners, true
 }
 
 // LookupModules returns all the module owners for a given capability
 // as a string array and the capability itself.
 // The method returns an error if either the capability or the owners cannot be
-// retrieved from the memstore.
+// retrieved from the store.
 func (sk ScopedKeeper) LookupModules(ctx sdk.Context, name string) ([]string, *types.Capability, error) {
 	if strings.TrimSpace(name) == "" {
 		return nil, nil, errorsmod.Wrap(types.ErrInvalidCapabilityName, "cannot lookup modules with empty capability name")
@@ -461,9 +518,6 @@ func (sk ScopedKeeper) LookupModules(ctx sdk.Context, name string) ([]string, *t
 }
 
 func (sk ScopedKeeper) addOwner(ctx sdk.Context, cap *types.Capability, name string) error {
-	prefixStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixIndexCapability)
-	indexKey := types.IndexToKey(cap.GetIndex())
-
 	capOwners := sk.getOwners(ctx, cap)
 
 	if err := capOwners.Set(types.NewOwner(sk.module, name)); err != nil {
@@ -471,23 +525,23 @@ func (sk ScopedKeeper) addOwner(ctx sdk.Context, cap *types.Capability, name str
 	}
 
 	// update capability owner set
-	prefixStore.Set(indexKey, sk.cdc.MustMarshal(capOwners))
+	if err := sk.owners.Set(ctx, cap.GetIndex(), *capOwners); err != nil {
+		return err
+	}
 
-	return nil
+	// Set the reverse mapping between the module and capability name and the index.
+	return sk.capabilityIndexes.Set(ctx, collections.Join(sk.module, name), cap.GetIndex())
 }
 
 func (sk ScopedKeeper) getOwners(ctx sdk.Context, cap *types.Capability) *types.CapabilityOwners {
-	prefixStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixIndexCapability)
-	indexKey := types.IndexToKey(cap.GetIndex())
-
-	bz := prefixStore.Get(indexKey)
-
-	if len(bz) == 0 {
-		return types.NewCapabilityOwners()
+	capOwners, err := sk.owners.Get(ctx, cap.GetIndex())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NewCapabilityOwners()
+		}
+		panic(err)
 	}
 
-	var capOwners types.CapabilityOwners
-	sk.cdc.MustUnmarshal(bz, &capOwners)
 	return &capOwners
 }
 
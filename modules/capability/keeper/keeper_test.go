@@ -8,6 +8,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
@@ -25,15 +26,19 @@ var (
 type KeeperTestSuite struct {
 	testifysuite.Suite
 
-	ctx    sdk.Context
-	keeper *keeper.Keeper
+	ctx      sdk.Context
+	cdc      codec.Codec
+	storeKey *storetypes.KVStoreKey
+	keeper   *keeper.Keeper
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	suite.ctx = testCtx.Ctx
+	suite.storeKey = key
 	encCfg := moduletestutil.MakeTestEncodingConfig(capability.AppModule{})
+	suite.cdc = encCfg.Codec
 	suite.keeper = keeper.NewKeeper(encCfg.Codec, key, key)
 }
 
@@ -307,6 +312,71 @@ func (suite *KeeperTestSuite) TestRevertCapability() {
 	suite.Require().Equal(cachedCap, got, "did not get correct capability from context")
 }
 
+func (suite *KeeperTestSuite) TestRevertReleaseCapability() {
+	sk := suite.keeper.ScopeToModule(bankModuleName)
+
+	capability, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+
+	// release the capability on a cached context which is not written
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(sk.ReleaseCapability(cacheCtx, capability))
+
+	_, ok := sk.GetCapability(cacheCtx, "transfer")
+	suite.Require().False(ok)
+
+	// the in-memory capability is still the one handed out before the reverted release
+	got, ok := sk.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Same(capability, got)
+	suite.Require().True(sk.AuthenticateCapability(suite.ctx, capability, "transfer"))
+}
+
+func (suite *KeeperTestSuite) TestRevertNewCapability() {
+	sk := suite.keeper.ScopeToModule(bankModuleName)
+
+	// create a capability on a cached context which is not written
+	cacheCtx, _ := suite.ctx.CacheContext()
+	revertedCap, err := sk.NewCapability(cacheCtx, "reverted")
+	suite.Require().NoError(err)
+
+	_, ok := sk.GetCapability(suite.ctx, "reverted")
+	suite.Require().False(ok)
+	suite.Require().Empty(sk.GetCapabilityName(suite.ctx, revertedCap))
+
+	// the index of the reverted capability is reused by the next capability
+	capability, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().Equal(revertedCap.GetIndex(), capability.GetIndex())
+	suite.Require().True(sk.AuthenticateCapability(suite.ctx, capability, "transfer"))
+	suite.Require().False(sk.AuthenticateCapability(suite.ctx, capability, "reverted"))
+}
+
+func (suite *KeeperTestSuite) TestGetCapabilityLazyLoad() {
+	sk := suite.keeper.ScopeToModule(bankModuleName)
+
+	transferCap, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+
+	// a new keeper sharing the persisted state does not have any in-memory capabilities
+	newKeeper := keeper.NewKeeper(suite.cdc, suite.storeKey, suite.storeKey)
+	newSk := newKeeper.ScopeToModule(bankModuleName)
+
+	got, ok := newSk.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Equal(transferCap.GetIndex(), got.GetIndex())
+	suite.Require().NotSame(transferCap, got)
+
+	// subsequent lookups return the same in-memory capability
+	refreshed, ok := newSk.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Same(got, refreshed)
+
+	suite.Require().True(newSk.AuthenticateCapability(suite.ctx, got, "transfer"))
+	suite.Require().False(newSk.AuthenticateCapability(suite.ctx, transferCap, "transfer"))
+	suite.Require().Equal("transfer", newSk.GetCapabilityName(suite.ctx, got))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/capability/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 populates the persistent reverse index from module and capability name
+// to capability index using the persisted capability owners.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var count int
+	err := m.keeper.owners.Walk(ctx, nil, func(index uint64, owners types.CapabilityOwners) (bool, error) {
+		for _, owner := range owners.Owners {
+			if err := m.keeper.capabilityIndexes.Set(ctx, collections.Join(owner.Module, owner.Name), index); err != nil {
+				return true, err
+			}
+			count++
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger(ctx).Info("successfully migrated capability owners to reverse index", "owners", count)
+	return nil
+}
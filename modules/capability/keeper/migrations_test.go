@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/ibc-go/modules/capability/keeper"
+	"github.com/cosmos/ibc-go/modules/capability/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	var (
+		index  uint64
+		owners types.CapabilityOwners
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: no capabilities",
+			func() {},
+		},
+		{
+			"success: capability with multiple owners",
+			func() {
+				index = 5
+				owners = types.CapabilityOwners{
+					Owners: []types.Owner{
+						types.NewOwner(bankModuleName, "transfer"),
+						types.NewOwner(stakingModuleName, "bond"),
+					},
+				}
+
+				// set the owners directly in the store to mimic state without a reverse index
+				store := prefix.NewStore(suite.ctx.KVStore(suite.storeKey), types.KeyPrefixIndexCapability)
+				store.Set(types.IndexToKey(index), suite.cdc.MustMarshal(&owners))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			owners = types.CapabilityOwners{}
+
+			tc.malleate()
+
+			scopedKeepers := map[string]keeper.ScopedKeeper{
+				bankModuleName:    suite.keeper.ScopeToModule(bankModuleName),
+				stakingModuleName: suite.keeper.ScopeToModule(stakingModuleName),
+			}
+
+			for _, owner := range owners.Owners {
+				_, found := scopedKeepers[owner.Module].GetCapability(suite.ctx, owner.Name)
+				suite.Require().False(found)
+			}
+
+			migrator := keeper.NewMigrator(*suite.keeper)
+			err := migrator.Migrate1to2(suite.ctx)
+			suite.Require().NoError(err)
+
+			for _, owner := range owners.Owners {
+				sk := scopedKeepers[owner.Module]
+				capability, found := sk.GetCapability(suite.ctx, owner.Name)
+				suite.Require().True(found)
+				suite.Require().Equal(index, capability.GetIndex())
+				suite.Require().True(sk.AuthenticateCapability(suite.ctx, capability, owner.Name))
+			}
+		})
+	}
+}
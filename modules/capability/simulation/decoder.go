@@ -27,6 +27,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &capOwnersB)
 			return fmt.Sprintf("CapabilityOwners A: %v\nCapabilityOwners B: %v\n", capOwnersA, capOwnersB)
 
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixOwnerCapability):
+			idxA := sdk.BigEndianToUint64(kvA.Value)
+			idxB := sdk.BigEndianToUint64(kvB.Value)
+			return fmt.Sprintf("Capability Index A: %d\nCapability Index B: %d\n", idxA, idxB)
+
 		default:
 			panic(fmt.Errorf("invalid %s key prefix %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
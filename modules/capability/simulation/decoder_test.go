@@ -33,6 +33,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.KeyPrefixIndexCapability,
 				Value: encodingCfg.Codec.MustMarshal(&capOwners),
 			},
+			{
+				Key:   types.KeyPrefixOwnerCapability,
+				Value: sdk.Uint64ToBigEndian(1),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -45,6 +49,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Index", "Index A: 10\nIndex B: 10\n"},
 		{"CapabilityOwners", fmt.Sprintf("CapabilityOwners A: %v\nCapabilityOwners B: %v\n", capOwners, capOwners)},
+		{"CapabilityIndex", "Capability Index A: 1\nCapability Index B: 1\n"},
 		{"other", ""},
 	}
 
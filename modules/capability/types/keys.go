@@ -26,6 +26,10 @@ var (
 	// owners mappings.
 	KeyPrefixIndexCapability = []byte("capability_index")
 
+	// KeyPrefixOwnerCapability defines a key prefix that stores module and capability
+	// name to capability index mappings.
+	KeyPrefixOwnerCapability = []byte("capability_owner")
+
 	// KeyMemInitialized defines the key that stores the initialized flag in the memory store
 	KeyMemInitialized = []byte("mem_initialized")
 )
@@ -8,7 +8,7 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
-// BeginBlocker is used to perform IBC client upgrades
+// BeginBlocker is used to perform IBC client upgrades and to report clients whose latest height is lagging
 func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 	plan, err := k.GetUpgradePlan(ctx)
 	if err == nil {
@@ -39,4 +39,7 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 			k.UpdateLocalhostClient(ctx, clientState)
 		}
 	}
+
+	// report the clients whose latest height starts lagging in this block.
+	k.EmitLaggingClientEvents(ctx)
 }
@@ -21,10 +21,15 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
+		GetCmdQueryClientsByChainID(),
+		GetCmdQueryLaggingClients(),
 		GetCmdQueryClientStatus(),
+		GetCmdQuerySimulateClientRecovery(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
+		GetCmdQueryConsensusStateStoreSize(),
 		GetCmdQueryConsensusState(),
+		GetCmdQueryConsensusStatesWithProofs(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
 		GetCmdClientParams(),
@@ -47,7 +52,9 @@ func NewTxCmd() *cobra.Command {
 		newCreateClientCmd(),
 		newUpdateClientCmd(),
 		newSubmitMisbehaviourCmd(), // Deprecated
+		newSubmitBondedMisbehaviourCmd(),
 		newUpgradeClientCmd(),
+		newDeleteClientCmd(),
 		newSubmitRecoverClientProposalCmd(),
 		newScheduleIBCUpgradeProposalCmd(),
 	)
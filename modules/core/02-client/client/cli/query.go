@@ -58,6 +58,85 @@ func GetCmdQueryClientStates() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientsByChainID defines the command to query the identifiers of all clients
+// tracking the counterparty chain with the given chain identifier.
+func GetCmdQueryClientsByChainID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clients-by-chain-id [chain-id]",
+		Short:   "Query the identifiers of all light clients tracking a counterparty chain",
+		Long:    "Query the identifiers of all light clients tracking the counterparty chain with the given chain identifier",
+		Example: fmt.Sprintf("%s query %s %s clients-by-chain-id [chain-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientsByChainIDRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClientsByChainID(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "clients by chain id")
+
+	return cmd
+}
+
+// GetCmdQueryLaggingClients defines the command to query the clients whose latest height has not
+// advanced in more than MaxClientLagBlocks blocks.
+func GetCmdQueryLaggingClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lagging-clients",
+		Short:   "Query the light clients whose latest height is lagging",
+		Long:    "Query the light clients whose latest height has not advanced in more than the number of blocks defined by the MaxClientLagBlocks parameter",
+		Example: fmt.Sprintf("%s query %s %s lagging-clients", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLaggingClientsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.LaggingClients(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "lagging clients")
+
+	return cmd
+}
+
 // GetCmdQueryClientState defines the command to query the state of a client with
 // a given id as defined in https://github.com/cosmos/ibc/tree/master/spec/core/ics-002-client-semantics#query
 func GetCmdQueryClientState() *cobra.Command {
@@ -125,6 +204,41 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQuerySimulateClientRecovery defines the command to simulate the recovery of a subject client by a substitute client.
+func GetCmdQuerySimulateClientRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-client-recovery [subject-client-id] [substitute-client-id]",
+		Short:   "Simulate a client recovery",
+		Long:    "Simulate the recovery of a subject client by a substitute client without modifying state, reporting any client state fields which prevent the substitution",
+		Example: fmt.Sprintf("%s query %s %s simulate-client-recovery [subject-client-id] [substitute-client-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySimulateClientRecoveryRequest{
+				SubjectClientId:    args[0],
+				SubstituteClientId: args[1],
+			}
+
+			res, err := queryClient.SimulateClientRecovery(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -210,6 +324,40 @@ func GetCmdQueryConsensusStateHeights() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryConsensusStateStoreSize defines the command to query the number of consensus states of a client
+// and the number of bytes they occupy in the store.
+func GetCmdQueryConsensusStateStoreSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consensus-state-store-size [client-id]",
+		Short:   "Query the number of consensus states of a client and their size in the store.",
+		Long:    "Query the number of consensus states associated with the provided client ID, and the number of bytes stored for them and their metadata.",
+		Example: fmt.Sprintf("%s query %s %s consensus-state-store-size [client-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsensusStateStoreSizeRequest{
+				ClientId: args[0],
+			}
+
+			res, err := queryClient.ConsensusStateStoreSize(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusState defines the command to query the consensus state of
 // the chain as defined in https://github.com/cosmos/ibc/tree/master/spec/core/ics-002-client-semantics#query
 func GetCmdQueryConsensusState() *cobra.Command {
@@ -258,6 +406,47 @@ If the '--latest' flag is included, the query returns the latest consensus state
 	return cmd
 }
 
+// GetCmdQueryConsensusStatesWithProofs defines the command to query the consensus states of a client at
+// multiple heights together with their proofs.
+func GetCmdQueryConsensusStatesWithProofs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-states-with-proofs [client-id] [height]...",
+		Short: "Query the consensus states of a client at the given heights with their proofs",
+		Long: `Query the consensus states for a particular light client at the given heights, together with the merkle proofs of their existence.
+All the proofs are retrieved at the same height, which is the '--height' flag if provided or the latest height of the node otherwise.`,
+		Example: fmt.Sprintf("%s query %s %s consensus-states-with-proofs [client-id] [height] [height]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+
+			heights := make([]ibcexported.Height, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				height, err := types.ParseHeight(arg)
+				if err != nil {
+					return err
+				}
+
+				heights = append(heights, height)
+			}
+
+			res, err := utils.QueryConsensusStatesWithProofs(clientCtx, clientID, heights)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryHeader defines the command to query the latest header on the chain
 func GetCmdQueryHeader() *cobra.Command {
 	cmd := &cobra.Command{
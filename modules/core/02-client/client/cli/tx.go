@@ -179,6 +179,52 @@ func newSubmitMisbehaviourCmd() *cobra.Command {
 	return cmd
 }
 
+// newSubmitBondedMisbehaviourCmd defines the command to submit misbehaviour with a bond in order to freeze a client.
+func newSubmitBondedMisbehaviourCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bonded-misbehaviour [client-id] [path/to/misbehaviour.json]",
+		Short: "submit client misbehaviour with a bond",
+		Long: `submit client misbehaviour together with the misbehaviour bond defined in the 02-client params.
+If the misbehaviour is verified the client is frozen and the bond is returned together with the misbehaviour reward,
+otherwise the bond is slashed.`,
+		Example: fmt.Sprintf("%s tx ibc %s bonded-misbehaviour [client-id] [path/to/misbehaviour.json] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			var misbehaviour exported.ClientMessage
+			clientID := args[0]
+			misbehaviourContentOrFileName := args[1]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(misbehaviourContentOrFileName), &misbehaviour); err != nil {
+
+				// check for file path if JSON input is not provided
+				contents, err := os.ReadFile(misbehaviourContentOrFileName)
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for misbehaviour were provided: %w", err)
+				}
+
+				if err := cdc.UnmarshalInterfaceJSON(contents, &misbehaviour); err != nil {
+					return fmt.Errorf("error unmarshalling misbehaviour file: %w", err)
+				}
+			}
+
+			msg, err := types.NewMsgSubmitBondedMisbehaviour(clientID, misbehaviour, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // newUpgradeClientCmd defines the command to upgrade an IBC light client.
 func newUpgradeClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -245,6 +291,30 @@ func newUpgradeClientCmd() *cobra.Command {
 	return cmd
 }
 
+// newDeleteClientCmd defines the command to delete an IBC light client which is not used by any connection.
+func newDeleteClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete [client-id]",
+		Short:   "delete an IBC client",
+		Long:    "delete an IBC client which is not used by any connection. Only the creator of the client or the authority may delete a client.",
+		Example: fmt.Sprintf("%s tx ibc %s delete [client-id] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteClient(args[0], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // newSubmitRecoverClientProposalCmd defines the command to recover an IBC light client.
 func newSubmitRecoverClientProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,11 +2,14 @@ package utils
 
 import (
 	"context"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
@@ -119,6 +122,49 @@ func QueryConsensusStateABCI(
 	return types.NewQueryConsensusStateResponse(anyConsensusState, proofBz, proofHeight), nil
 }
 
+// QueryConsensusStatesWithProofs queries the store to get the consensus states of a light client at the
+// given heights and the merkle proofs of their existence. All the proofs are retrieved at the same height,
+// which is the height of the client context if set, or the latest height of the node otherwise, so that
+// they can be verified against a single consensus state of the counterparty client.
+func QueryConsensusStatesWithProofs(
+	clientCtx client.Context, clientID string, heights []exported.Height,
+) (*types.QueryConsensusStatesWithProofsResponse, error) {
+	if len(heights) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidHeight, "at least one consensus state height must be provided")
+	}
+
+	if clientCtx.Height == 0 {
+		latestHeight, err := ibcclient.QueryLatestHeight(context.Background(), clientCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		clientCtx = clientCtx.WithHeight(latestHeight)
+	}
+
+	var (
+		consensusStates = make([]types.ConsensusStateWithHeight, 0, len(heights))
+		proofs          = make([][]byte, 0, len(heights))
+		proofHeight     types.Height
+	)
+
+	for _, height := range heights {
+		res, err := QueryConsensusStateABCI(clientCtx, clientID, height)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "height %s", height)
+		}
+
+		consensusStates = append(consensusStates, types.ConsensusStateWithHeight{
+			Height:         types.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()),
+			ConsensusState: res.ConsensusState,
+		})
+		proofs = append(proofs, res.Proof)
+		proofHeight = res.ProofHeight
+	}
+
+	return types.NewQueryConsensusStatesWithProofsResponse(consensusStates, proofs, proofHeight), nil
+}
+
 // QueryTendermintHeader takes a client context and returns the appropriate
 // tendermint header
 func QueryTendermintHeader(clientCtx client.Context) (ibctm.Header, int64, error) {
@@ -208,3 +254,94 @@ func QuerySelfConsensusState(clientCtx client.Context) (*ibctm.ConsensusState, i
 
 	return state, height, nil
 }
+
+// QueryTendermintUpdateHeader returns the tendermint header at the given height of the chain of the client
+// context, together with the validators trusted at the given trusted height. The header can be used to update
+// a 07-tendermint client tracking the chain from its consensus state at the trusted height.
+func QueryTendermintUpdateHeader(clientCtx client.Context, height int64, trustedHeight types.Height) (*ibctm.Header, error) {
+	header, _, err := QueryTendermintHeader(clientCtx.WithHeight(height))
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	count := 10_000
+
+	// the trusted validators are the next validators of the consensus state at the trusted height
+	trustedValsHeight := int64(trustedHeight.RevisionHeight) + 1
+	trustedVals, err := node.Validators(context.Background(), &trustedValsHeight, &page, &count)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTrustedValset, err := cmttypes.NewValidatorSet(trustedVals.Validators).ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	header.TrustedHeight = trustedHeight
+	header.TrustedValidators = protoTrustedValset
+
+	return &header, nil
+}
+
+// QueryMsgUpdateTendermintClient returns a MsgUpdateClient which updates the 07-tendermint client with the given
+// identifier on the chain of the counterparty context to the given height of the chain of the client context.
+// A nil message is returned if the latest height of the client is already greater than or equal to the height.
+func QueryMsgUpdateTendermintClient(clientCtx, counterpartyCtx client.Context, clientID string, height int64, signer string) (*types.MsgUpdateClient, error) {
+	res, err := QueryClientState(counterpartyCtx, clientID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, err := types.UnpackClientState(res.ClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidClientType, "expected client %s to be of type %T, got %T", clientID, (*ibctm.ClientState)(nil), clientState)
+	}
+
+	if tmClientState.ChainId != clientCtx.ChainID {
+		return nil, errorsmod.Wrapf(types.ErrInvalidClient, "client %s tracks chain %s, expected %s", clientID, tmClientState.ChainId, clientCtx.ChainID)
+	}
+
+	if tmClientState.LatestHeight.RevisionHeight >= uint64(height) {
+		return nil, nil
+	}
+
+	header, err := QueryTendermintUpdateHeader(clientCtx, height, tmClientState.LatestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgUpdateClient(clientID, header, signer)
+}
+
+// BroadcastTxWithTendermintClientUpdate broadcasts the messages to the chain of the client context, preceded by a
+// MsgUpdateClient which updates the 07-tendermint client with the given identifier to the proof height of the chain
+// of the counterparty context, and waits for the transaction to be included in a block.
+func BroadcastTxWithTendermintClientUpdate(
+	ctx context.Context, clientCtx, counterpartyCtx client.Context, txf tx.Factory,
+	clientID string, proofHeight types.Height, msgs ...sdk.Msg,
+) (*sdk.TxResponse, error) {
+	signer := clientCtx.GetFromAddress().String()
+
+	msgUpdateClient, err := QueryMsgUpdateTendermintClient(counterpartyCtx, clientCtx, clientID, int64(proofHeight.RevisionHeight), signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct update of client %s: %w", clientID, err)
+	}
+
+	if msgUpdateClient != nil {
+		msgs = append([]sdk.Msg{msgUpdateClient}, msgs...)
+	}
+
+	return ibcclient.BroadcastTxAndWait(ctx, clientCtx, txf, msgs...)
+}
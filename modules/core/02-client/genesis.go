@@ -36,6 +36,9 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 		}
 
 		k.SetClientState(ctx, client.ClientId, cs)
+		k.IndexClientChainID(ctx, client.ClientId)
+		k.CountClient(ctx, client.ClientId)
+		k.TrackClientProgress(ctx, client.ClientId)
 	}
 
 	for _, cs := range gs.ClientsConsensus {
@@ -49,6 +52,19 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 		}
 	}
 
+	for _, clientCreator := range gs.ClientCreators {
+		creator, err := sdk.AccAddressFromBech32(clientCreator.Creator)
+		if err != nil {
+			panic(fmt.Errorf("invalid creator address %s for client %s: %w", clientCreator.Creator, clientCreator.ClientId, err))
+		}
+
+		k.SetClientCreator(ctx, clientCreator.ClientId, creator)
+	}
+
+	for _, clientMetadata := range gs.ClientsCreationMetadata {
+		k.SetClientCreationMetadata(ctx, clientMetadata.ClientId, clientMetadata.CreationMetadata)
+	}
+
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 
 	// if the localhost already exists in state (included in the genesis file),
@@ -73,7 +89,9 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		ClientsConsensus: k.GetAllConsensusStates(ctx),
 		Params:           k.GetParams(ctx),
 		// Warning: CreateLocalhost is deprecated
-		CreateLocalhost:    false,
-		NextClientSequence: k.GetNextClientSequence(ctx),
+		CreateLocalhost:         false,
+		NextClientSequence:      k.GetNextClientSequence(ctx),
+		ClientCreators:          k.GetAllClientCreators(ctx),
+		ClientsCreationMetadata: k.GetAllClientCreationMetadata(ctx),
 	}
 }
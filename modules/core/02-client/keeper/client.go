@@ -1,13 +1,18 @@
 package keeper
 
 import (
+	"crypto/sha256"
+
 	metrics "github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
@@ -45,8 +50,12 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 		return "", errorsmod.Wrapf(types.ErrClientNotActive, "cannot create client (%s) with status %s", clientID, status)
 	}
 
+	k.IndexClientChainID(ctx, clientID)
+	k.incrementClientCount(ctx, clientType)
+	k.TrackClientProgress(ctx, clientID)
+
 	initialHeight := clientModule.LatestHeight(ctx, clientID)
-	k.Logger(ctx).Info("client created at height", "client-id", clientID, "height", initialHeight.String())
+	logging.WithClient(k.Logger(ctx), clientID).Info("client created at height", logging.KeyHeight, initialHeight.String())
 
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"ibc", "client", "create"},
@@ -54,13 +63,58 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 		[]metrics.Label{telemetry.NewLabel(types.LabelClientType, clientType)},
 	)
 
+	k.setConsensusStateStoreSizeGauges(ctx, clientID, clientType)
+
 	emitCreateClientEvent(ctx, clientID, clientType, initialHeight)
 
 	return clientID, nil
 }
 
+// VerifyClientLimit returns an error if the number of existing clients of the given client type has reached
+// the maximum number of clients defined for the client type in the module parameters.
+func (k *Keeper) VerifyClientLimit(ctx sdk.Context, clientType string) error {
+	maxClients, found := k.GetParams(ctx).GetClientLimit(clientType)
+	if !found {
+		return nil
+	}
+
+	if k.GetClientCount(ctx, clientType) < maxClients {
+		return nil
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "create", "limit_reached"},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.LabelClientType, clientType)},
+	)
+
+	return errorsmod.Wrapf(types.ErrMaxClientsReached, "cannot create client of type %s: maximum of %d clients reached", clientType, maxClients)
+}
+
 // UpdateClient updates the consensus state and the state root from a provided header.
 func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	return k.updateClient(ctx, clientID, clientMsg, true, "msg")
+}
+
+// UpdateClientFromVoteExtension updates the consensus state and the state root from a header included in the
+// vote extensions of more than 2/3 of the voting power of the validator set. The header is verified by the light
+// client module like any other client message, but the client is never frozen: an error is returned instead if the
+// client message constitutes misbehaviour, as client updates included in vote extensions are not submitted by an
+// accountable party and usually originate from a source shared by the validators.
+//
+// The signatures of the header are verified although the vote extensions were verified by the consensus layer, as
+// they only attest that the validators of this chain agreed on the bytes of the header, not that the header was
+// committed by the counterparty chain. Skipping the verification would allow 2/3 of the voting power of this chain,
+// or a faulty header source, to update the client to arbitrary consensus states.
+func (k *Keeper) UpdateClientFromVoteExtension(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	return k.updateClient(ctx, clientID, clientMsg, false, "vote_extension")
+}
+
+// updateClient updates the client with the provided client message, freezing the client if the client message
+// constitutes misbehaviour and freezeOnMisbehaviour is true. The update type is used to label the update telemetry.
+func (k *Keeper) updateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage, freezeOnMisbehaviour bool, updateType string) error {
+	gasConsumed := ctx.GasMeter().GasConsumed()
+
 	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
@@ -75,34 +129,57 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
 	}
 
+	// competing relayers frequently submit identical client messages within the same block,
+	// skip verification for client messages which have already been processed in this block.
+	var clientMsgHash []byte
+	if k.transientKey != nil {
+		bz, err := types.MarshalClientMessage(k.cdc, clientMsg)
+		if err != nil {
+			return err
+		}
+
+		hash := sha256.Sum256(bz)
+		clientMsgHash = hash[:]
+		if k.hasProcessedClientMessage(ctx, clientID, clientMsgHash) {
+			logging.WithClient(k.Logger(ctx), clientID).Debug("client message already processed in this block, skipping update")
+			k.refundDuplicateUpdateGas(ctx, clientID, ctx.GasMeter().GasConsumed()-gasConsumed)
+			return nil
+		}
+	}
+
+	// client messages whose application would be a no-op are skipped before their verification, so that
+	// relayers racing to submit the same header are not charged the cost of verifying it.
+	if detector, ok := clientModule.(exported.DuplicateClientMessageDetector); ok && detector.IsDuplicateClientMessage(ctx, clientID, clientMsg) {
+		logging.WithClient(k.Logger(ctx), clientID).Debug("client message is a duplicate, skipping update")
+		k.refundDuplicateUpdateGas(ctx, clientID, ctx.GasMeter().GasConsumed()-gasConsumed)
+		return nil
+	}
+
 	if err := clientModule.VerifyClientMessage(ctx, clientID, clientMsg); err != nil {
 		return err
 	}
 
 	foundMisbehaviour := clientModule.CheckForMisbehaviour(ctx, clientID, clientMsg)
 	if foundMisbehaviour {
-		clientModule.UpdateStateOnMisbehaviour(ctx, clientID, clientMsg)
-
-		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
-
-		defer telemetry.IncrCounterWithLabels(
-			[]string{"ibc", "client", "misbehaviour"},
-			1,
-			[]metrics.Label{
-				telemetry.NewLabel(types.LabelClientType, clientType),
-				telemetry.NewLabel(types.LabelClientID, clientID),
-				telemetry.NewLabel(types.LabelMsgType, "update"),
-			},
-		)
-
-		emitSubmitMisbehaviourEvent(ctx, clientID, clientType)
+		if !freezeOnMisbehaviour {
+			return errorsmod.Wrapf(types.ErrInvalidHeader, "client message for client (%s) constitutes misbehaviour", clientID)
+		}
 
+		k.freezeClient(ctx, clientModule, clientID, clientType, clientMsg, "update")
 		return nil
 	}
 
+	prevChainID := k.getClientChainID(ctx, clientID)
+	prevLatestHeight := clientModule.LatestHeight(ctx, clientID)
 	consensusHeights := clientModule.UpdateState(ctx, clientID, clientMsg)
+	k.updateClientChainIDIndex(ctx, clientID, prevChainID)
+	k.trackClientProgressIfAdvanced(ctx, clientModule, clientID, prevLatestHeight)
+
+	if k.transientKey != nil {
+		k.setProcessedClientMessage(ctx, clientID, clientMsgHash)
+	}
 
-	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
+	logging.WithClient(k.Logger(ctx), clientID).Info("client state updated", "heights", consensusHeights)
 
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"ibc", "client", "update"},
@@ -110,16 +187,143 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 		[]metrics.Label{
 			telemetry.NewLabel(types.LabelClientType, clientType),
 			telemetry.NewLabel(types.LabelClientID, clientID),
-			telemetry.NewLabel(types.LabelUpdateType, "msg"),
+			telemetry.NewLabel(types.LabelUpdateType, updateType),
 		},
 	)
 
+	k.setConsensusStateStoreSizeGauges(ctx, clientID, clientType)
+
 	// emitting events in the keeper emits for both begin block and handler client updates
 	emitUpdateClientEvent(ctx, clientID, clientType, consensusHeights, k.cdc, clientMsg)
 
 	return nil
 }
 
+// refundDuplicateUpdateGas refunds the portion of the gas consumed by a skipped no-op client update defined by
+// the DuplicateUpdateGasRefundPercentage parameter to the gas meter of the context, so that relayers racing to
+// submit the same client message are not charged the full cost of the update.
+func (k *Keeper) refundDuplicateUpdateGas(ctx sdk.Context, clientID string, gasConsumed storetypes.Gas) {
+	refund := k.GetParams(ctx).DuplicateUpdateGasRefund(gasConsumed)
+	if refund == 0 {
+		return
+	}
+
+	ctx.GasMeter().RefundGas(refund, "duplicate client update")
+
+	logging.WithClient(k.Logger(ctx), clientID).Debug("refunded gas for duplicate client update", "refund", refund)
+}
+
+// setConsensusStateStoreSizeGauges sets the telemetry gauges of the number of consensus states and the number of
+// bytes stored under the consensus state prefix of the given client. The store is iterated with an infinite gas
+// meter so that the gas consumed by transactions does not depend on whether telemetry is enabled on the node.
+func (k *Keeper) setConsensusStateStoreSizeGauges(ctx sdk.Context, clientID, clientType string) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	numConsensusStates, consensusStateBytes, metadataBytes := k.GetConsensusStateStoreSize(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), clientID)
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(types.LabelClientType, clientType),
+		telemetry.NewLabel(types.LabelClientID, clientID),
+	}
+
+	telemetry.SetGaugeWithLabels([]string{"ibc", "client", "consensus_states"}, float32(numConsensusStates), labels)
+	telemetry.SetGaugeWithLabels([]string{"ibc", "client", "consensus_states", "bytes"}, float32(consensusStateBytes), labels)
+	telemetry.SetGaugeWithLabels([]string{"ibc", "client", "consensus_states", "metadata_bytes"}, float32(metadataBytes), labels)
+}
+
+// freezeClient updates the client state on the misbehaviour contained in the provided client message. The message
+// type is used to label the misbehaviour telemetry.
+func (k *Keeper) freezeClient(ctx sdk.Context, clientModule exported.LightClientModule, clientID, clientType string, clientMsg exported.ClientMessage, msgType string) {
+	evidenceType := types.EvidenceTypeUnknown
+	if classifier, ok := clientModule.(exported.MisbehaviourEvidenceClassifier); ok {
+		evidenceType = classifier.MisbehaviourEvidenceType(ctx, clientID, clientMsg)
+	}
+
+	clientModule.UpdateStateOnMisbehaviour(ctx, clientID, clientMsg)
+
+	logging.WithClient(k.Logger(ctx), clientID).Info("client frozen due to misbehaviour", "evidence-type", evidenceType)
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "misbehaviour"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, clientType),
+			telemetry.NewLabel(types.LabelClientID, clientID),
+			telemetry.NewLabel(types.LabelMsgType, msgType),
+		},
+	)
+
+	emitSubmitMisbehaviourEvent(ctx, clientID, clientType)
+	emitClientFrozenEvent(ctx, clientID, clientType, evidenceType)
+}
+
+// SubmitBondedMisbehaviour escrows the misbehaviour bond defined in the module parameters from the submitter and
+// verifies the provided misbehaviour against the client with the given identifier. If the misbehaviour is verified,
+// the client is frozen and the bond is returned to the submitter together with the misbehaviour reward, which is paid
+// out of the balance of the ibc module account as far as it allows. If the misbehaviour fails to verify, the bond is
+// slashed and remains in the module account, where it funds the rewards of future submissions.
+// The returned boolean indicates whether the client was frozen.
+func (k *Keeper) SubmitBondedMisbehaviour(ctx sdk.Context, clientID string, misbehaviour exported.ClientMessage, submitter sdk.AccAddress) (bool, error) {
+	if k.bankKeeper == nil {
+		return false, errorsmod.Wrap(types.ErrBondedMisbehaviourDisabled, "bank keeper is not set")
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsBondedMisbehaviourEnabled() {
+		return false, errorsmod.Wrap(types.ErrBondedMisbehaviourDisabled, "misbehaviour bond is not set")
+	}
+
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
+		return false, errorsmod.Wrapf(types.ErrClientNotActive, "cannot submit misbehaviour for client (%s) with status %s", clientID, status)
+	}
+
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "unable to parse client identifier %s", clientID)
+	}
+
+	clientModule, found := k.router.GetRoute(clientID)
+	if !found {
+		return false, errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitter, exported.ModuleName, params.MisbehaviourBond); err != nil {
+		return false, errorsmod.Wrap(err, "failed to escrow misbehaviour bond")
+	}
+
+	// state changes made by the light client module are only written if the misbehaviour is verified
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := clientModule.VerifyClientMessage(cacheCtx, clientID, misbehaviour); err != nil || !clientModule.CheckForMisbehaviour(cacheCtx, clientID, misbehaviour) {
+		logging.WithClient(k.Logger(ctx), clientID).Info("bonded misbehaviour failed to verify, bond slashed", "submitter", submitter.String(), logging.KeyError, err)
+
+		emitSubmitBondedMisbehaviourEvent(ctx, clientID, clientType, submitter, false, params.MisbehaviourBond, sdk.NewCoins())
+
+		return false, nil
+	}
+
+	k.freezeClient(cacheCtx, clientModule, clientID, clientType, misbehaviour, "bonded_misbehaviour")
+	writeFn()
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, exported.ModuleName, submitter, params.MisbehaviourBond); err != nil {
+		return false, errorsmod.Wrap(err, "failed to return misbehaviour bond")
+	}
+
+	// the reward is capped by the balance of the module account, which is funded by slashed bonds
+	moduleBalance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(exported.ModuleName))
+	reward := params.MisbehaviourReward.Min(moduleBalance)
+	if !reward.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, exported.ModuleName, submitter, reward); err != nil {
+			return false, errorsmod.Wrap(err, "failed to pay misbehaviour reward")
+		}
+	}
+
+	emitSubmitBondedMisbehaviourEvent(ctx, clientID, clientType, submitter, true, params.MisbehaviourBond, reward)
+
+	return true, nil
+}
+
 // UpgradeClient upgrades the client to a new client state if this new client was committed to
 // by the old client at the specified upgrade height
 func (k *Keeper) UpgradeClient(
@@ -141,12 +345,17 @@ func (k *Keeper) UpgradeClient(
 		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
 	}
 
+	prevChainID := k.getClientChainID(ctx, clientID)
+	prevLatestHeight := clientModule.LatestHeight(ctx, clientID)
 	if err := clientModule.VerifyUpgradeAndUpdateState(ctx, clientID, upgradedClient, upgradedConsState, upgradeClientProof, upgradeConsensusStateProof); err != nil {
 		return errorsmod.Wrapf(err, "cannot upgrade client with ID %s", clientID)
 	}
 
+	k.updateClientChainIDIndex(ctx, clientID, prevChainID)
+	k.trackClientProgressIfAdvanced(ctx, clientModule, clientID, prevLatestHeight)
+
 	latestHeight := clientModule.LatestHeight(ctx, clientID)
-	k.Logger(ctx).Info("client state upgraded", "client-id", clientID, "height", latestHeight.String())
+	logging.WithClient(k.Logger(ctx), clientID).Info("client state upgraded", logging.KeyHeight, latestHeight.String())
 
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"ibc", "client", "upgrade"},
@@ -168,48 +377,121 @@ func (k *Keeper) UpgradeClient(
 // as well as copying the necessary consensus states from the substitute to the subject client store.
 // The substitute must be Active and the subject must not be Active.
 func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	clientType, err := k.recoverClient(ctx, subjectClientID, substituteClientID)
+	if err != nil {
+		return err
+	}
+
+	logging.WithClient(k.Logger(ctx), subjectClientID).Info("client recovered")
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "update"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, clientType),
+			telemetry.NewLabel(types.LabelClientID, subjectClientID),
+			telemetry.NewLabel(types.LabelUpdateType, "recovery"),
+		},
+	)
+
+	// emitting events in the keeper for recovering clients
+	emitRecoverClientEvent(ctx, subjectClientID, clientType)
+
+	return nil
+}
+
+// recoverClient performs the state checks and state transitions of a client recovery and returns the
+// client type of the subject client. It does not log, emit events or record telemetry, allowing it to
+// be used by the SimulateClientRecovery query on a cached context.
+func (k *Keeper) recoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) (string, error) {
 	if status := k.GetClientStatus(ctx, subjectClientID); status == exported.Active {
-		return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover %s subject client", exported.Active)
+		return "", errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover %s subject client", exported.Active)
 	}
 
 	if status := k.GetClientStatus(ctx, substituteClientID); status != exported.Active {
-		return errorsmod.Wrapf(types.ErrClientNotActive, "substitute client is not %s, status is %s", exported.Active, status)
+		return "", errorsmod.Wrapf(types.ErrClientNotActive, "substitute client is not %s, status is %s", exported.Active, status)
 	}
 
 	clientType, _, err := types.ParseClientIdentifier(subjectClientID)
 	if err != nil {
-		return errorsmod.Wrapf(types.ErrClientNotFound, "clientID (%s)", subjectClientID)
+		return "", errorsmod.Wrapf(types.ErrClientNotFound, "clientID (%s)", subjectClientID)
 	}
 
 	clientModule, found := k.router.GetRoute(subjectClientID)
 	if !found {
-		return errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
+		return "", errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
 	}
 
 	subjectLatestHeight := clientModule.LatestHeight(ctx, subjectClientID)
 	substituteLatestHeight := clientModule.LatestHeight(ctx, substituteClientID)
 	if subjectLatestHeight.GTE(substituteLatestHeight) {
-		return errorsmod.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectLatestHeight, substituteLatestHeight)
+		return "", errorsmod.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectLatestHeight, substituteLatestHeight)
 	}
 
+	prevChainID := k.getClientChainID(ctx, subjectClientID)
 	if err := clientModule.RecoverClient(ctx, subjectClientID, substituteClientID); err != nil {
-		return err
+		return "", err
+	}
+
+	k.updateClientChainIDIndex(ctx, subjectClientID, prevChainID)
+	k.TrackClientProgress(ctx, subjectClientID)
+
+	return clientType, nil
+}
+
+// DeleteClient removes all state stored under the client store of the provided client identifier,
+// including the client state, all consensus states and any associated metadata. The localhost
+// client cannot be deleted. Callers are responsible for ensuring the client is not in use by any
+// connection prior to deletion.
+func (k *Keeper) DeleteClient(ctx sdk.Context, clientID string) error {
+	if clientID == exported.LocalhostClientID {
+		return errorsmod.Wrap(types.ErrInvalidClientType, "localhost client cannot be deleted")
+	}
+
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrClientNotFound, "clientID (%s)", clientID)
 	}
 
-	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return errorsmod.Wrapf(types.ErrClientNotFound, "clientID (%s)", clientID)
+	}
+
+	if chainID := k.getClientChainID(ctx, clientID); chainID != "" {
+		ctx.KVStore(k.storeKey).Delete(types.ChainIDClientKey(chainID, clientID))
+	}
+
+	k.deleteClientProgress(ctx, clientID)
+	ctx.KVStore(k.storeKey).Delete(types.ClientCreatorKey(clientID))
+	ctx.KVStore(k.storeKey).Delete(types.ClientCreationMetadataKey(clientID))
+
+	clientStore := k.ClientStore(ctx, clientID)
+
+	var keys [][]byte
+	iterator := clientStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for _, key := range keys {
+		clientStore.Delete(key)
+	}
+
+	k.decrementClientCount(ctx, clientType)
+
+	logging.WithClient(k.Logger(ctx), clientID).Info("client deleted")
 
 	defer telemetry.IncrCounterWithLabels(
-		[]string{"ibc", "client", "update"},
+		[]string{"ibc", "client", "delete"},
 		1,
 		[]metrics.Label{
 			telemetry.NewLabel(types.LabelClientType, clientType),
-			telemetry.NewLabel(types.LabelClientID, subjectClientID),
-			telemetry.NewLabel(types.LabelUpdateType, "recovery"),
+			telemetry.NewLabel(types.LabelClientID, clientID),
 		},
 	)
 
-	// emitting events in the keeper for recovering clients
-	emitRecoverClientEvent(ctx, subjectClientID, clientType)
+	emitDeleteClientEvent(ctx, clientID, clientType)
 
 	return nil
 }
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -525,6 +527,290 @@ func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 	suite.Require().Equal(clienttypes.EventTypeUpdateClient, updateEvent.Type)
 }
 
+func (suite *KeeperTestSuite) TestUpdateClientFrozenEventEmission() {
+	var (
+		path         *ibctesting.Path
+		updateHeader *ibctm.Header
+	)
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		expEvidenceType string
+	}{
+		{
+			"conflicting header at existing height",
+			func() {
+				trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+				suite.Require().True(ok)
+
+				var err error
+				updateHeader, err = path.EndpointB.Chain.IBCClientHeader(path.EndpointB.Chain.LatestCommittedHeader, trustedHeight)
+				suite.Require().NoError(err)
+
+				// set conflicting consensus state in store to create misbehaviour scenario
+				conflictConsState := updateHeader.ConsensusState()
+				conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, updateHeader.GetHeight(), conflictConsState)
+			},
+			clienttypes.EvidenceTypeDuplicateHeight,
+		},
+		{
+			"monotonic time violation",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				trustedHeight := clientState.LatestHeight
+
+				// store intermediate consensus state at a time greater than updateHeader time
+				incrementedClientHeight, ok := clientState.LatestHeight.Increment().(clienttypes.Height)
+				suite.Require().True(ok)
+				intermediateConsState := &ibctm.ConsensusState{
+					Timestamp:          suite.coordinator.CurrentTime.Add(2 * time.Hour),
+					NextValidatorsHash: suite.chainB.Vals.Hash(),
+				}
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, incrementedClientHeight, intermediateConsState)
+				// set iteration key
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				ibctm.SetIterationKey(clientStore, incrementedClientHeight)
+
+				// commit a block so that the update header is above the intermediate consensus state height
+				suite.coordinator.CommitBlock(suite.chainB)
+
+				var err error
+				updateHeader, err = path.EndpointB.Chain.IBCClientHeader(path.EndpointB.Chain.LatestCommittedHeader, trustedHeight)
+				suite.Require().NoError(err)
+			},
+			clienttypes.EvidenceTypeTimeMonotonicity,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, updateHeader)
+			suite.Require().NoError(err)
+
+			expEvent := sdk.NewEvent(
+				clienttypes.EventTypeClientFrozen,
+				sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointA.ClientID),
+				sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+				sdk.NewAttribute(clienttypes.AttributeKeyEvidenceType, tc.expEvidenceType),
+			)
+			suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+
+			// the regular update client event is not emitted when the client is frozen
+			for _, event := range ctx.EventManager().Events() {
+				suite.Require().NotEqual(clienttypes.EventTypeUpdateClient, event.Type)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientDuplicateClientMessage() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	header, err := path.EndpointA.Counterparty.Chain.IBCClientHeader(path.EndpointA.Counterparty.Chain.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	ctx := suite.chainA.GetContext()
+	err = clientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(ctx.EventManager().Events())
+
+	// the duplicate client message is skipped within the same block
+	ctx = suite.chainA.GetContext()
+	err = clientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+	suite.Require().Empty(ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientNoOpClientMessage() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	header, err := path.EndpointA.Counterparty.Chain.IBCClientHeader(path.EndpointA.Counterparty.Chain.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	err = clientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+	updateGas := ctx.GasMeter().GasConsumed()
+
+	// the processed client messages are cleared at the end of the block, the header is then skipped
+	// by the light client module as a no-op before its signatures are verified
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	for i := range header.Commit.Signatures {
+		header.Commit.Signatures[i].Signature = []byte("invalid signature")
+	}
+
+	ctx = suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	err = clientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+	suite.Require().Empty(ctx.EventManager().Events())
+	suite.Require().Less(ctx.GasMeter().GasConsumed(), updateGas)
+
+	// a header conflicting with the stored consensus state is not a duplicate and is verified
+	header.SignedHeader.Header.AppHash = []byte("conflicting app hash")
+
+	err = clientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientDuplicateUpdateGasRefund() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	header, err := path.EndpointA.Counterparty.Chain.IBCClientHeader(path.EndpointA.Counterparty.Chain.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	// updateGasConsumed returns the gas consumed by updating the client with the header, without persisting the update,
+	// when the given percentage of the gas consumed by duplicate updates is refunded.
+	updateGasConsumed := func(refundPercentage uint32) storetypes.Gas {
+		params := clientKeeper.GetParams(suite.chainA.GetContext())
+		params.DuplicateUpdateGasRefundPercentage = refundPercentage
+		clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+		ctx, _ := suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+		err := clientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, header)
+		suite.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// no gas is refunded for an update which is not a duplicate
+	suite.Require().Equal(updateGasConsumed(50), updateGasConsumed(100))
+
+	err = clientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+
+	// gas is refunded for a duplicate skipped within the same block and for a no-op skipped in a later block
+	for _, commitBlock := range []bool{false, true} {
+		if commitBlock {
+			suite.coordinator.CommitBlock(suite.chainA)
+		}
+
+		duplicateGas := updateGasConsumed(0)
+		halfRefundGas := updateGasConsumed(50)
+		fullRefundGas := updateGasConsumed(100)
+
+		suite.Require().Less(halfRefundGas, duplicateGas)
+		suite.Require().Less(fullRefundGas, halfRefundGas)
+		// only the gas consumed by reading the params when computing the refund is not refunded, which
+		// differs slightly with the encoded length of the refund percentage
+		suite.Require().InDelta(float64(duplicateGas-fullRefundGas)/2, float64(duplicateGas-halfRefundGas), 10)
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateClientFromVoteExtension() {
+	var (
+		path   *ibctesting.Path
+		header *ibctm.Header
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: header signatures are verified",
+			func() {
+				for i := range header.Commit.Signatures {
+					header.Commit.Signatures[i].Signature = []byte("invalid signature")
+				}
+			},
+			errors.New("failed to verify header"),
+		},
+		{
+			"failure: misbehaviour does not freeze the client",
+			func() {
+				// set conflicting consensus state in store to create misbehaviour scenario
+				conflictConsState := header.ConsensusState()
+				conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight(), conflictConsState)
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: client is not active",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			var err error
+			header, err = suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			expStatus := clientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			err = clientKeeper.UpdateClientFromVoteExtension(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(header.GetHeight(), path.EndpointA.GetClientLatestHeight())
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+
+				// the client is never frozen by client updates included in vote extensions
+				suite.Require().Equal(expStatus, clientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRecoverClient() {
 	var (
 		subject, substitute                       string
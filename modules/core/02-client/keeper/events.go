@@ -90,6 +90,41 @@ func emitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientType st
 	})
 }
 
+// emitClientFrozenEvent emits a client frozen event when misbehaviour is detected during a client update
+func emitClientFrozenEvent(ctx sdk.Context, clientID, clientType, evidenceType string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClientFrozen,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyEvidenceType, evidenceType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitSubmitBondedMisbehaviourEvent emits a submit bonded misbehaviour event
+func emitSubmitBondedMisbehaviourEvent(ctx sdk.Context, clientID, clientType string, submitter sdk.AccAddress, frozen bool, bond, reward sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitBondedMisbehaviour,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeySubmitter, submitter.String()),
+			sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(frozen)),
+			sdk.NewAttribute(types.AttributeKeyBond, bond.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitRecoverClientEvent emits a recover client event
 func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -105,6 +140,21 @@ func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	})
 }
 
+// emitDeleteClientEvent emits a delete client event
+func emitDeleteClientEvent(ctx sdk.Context, clientID, clientType string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitScheduleIBCSoftwareUpgradeEvent emits a schedule IBC software upgrade event
 func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -120,6 +170,22 @@ func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height i
 	})
 }
 
+// emitClientLaggingEvent emits a client lagging event
+func emitClientLaggingEvent(ctx sdk.Context, clientID string, lastProgressHeight, lagBlocks uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClientLagging,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyLastProgressHeight, strconv.FormatUint(lastProgressHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyLagBlocks, strconv.FormatUint(lagBlocks, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // EmitUpgradeChainEvent emits an upgrade chain event.
 func EmitUpgradeChainEvent(ctx sdk.Context, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
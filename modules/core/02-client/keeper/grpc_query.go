@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -48,11 +49,16 @@ func (k *Keeper) ClientState(c context.Context, req *types.QueryClientStateReque
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	proofHeight := types.GetSelfHeight(ctx)
-	return &types.QueryClientStateResponse{
+	res := &types.QueryClientStateResponse{
 		ClientState: protoAny,
-		ProofHeight: proofHeight,
-	}, nil
+		ProofHeight: types.GetSelfHeight(ctx),
+	}
+
+	if metadata, found := k.GetClientCreationMetadata(ctx, req.ClientId); found {
+		res.CreationMetadata = &metadata
+	}
+
+	return res, nil
 }
 
 // ClientStates implements the Query/ClientStates gRPC method
@@ -229,6 +235,31 @@ func (k *Keeper) ConsensusStateHeights(c context.Context, req *types.QueryConsen
 	}, nil
 }
 
+// ConsensusStateStoreSize implements the Query/ConsensusStateStoreSize gRPC method
+func (k *Keeper) ConsensusStateStoreSize(c context.Context, req *types.QueryConsensusStateStoreSizeRequest) (*types.QueryConsensusStateStoreSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetClientState(ctx, req.ClientId); !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrClientNotFound, req.ClientId).Error())
+	}
+
+	numConsensusStates, consensusStateBytes, metadataBytes := k.GetConsensusStateStoreSize(ctx, req.ClientId)
+
+	return &types.QueryConsensusStateStoreSizeResponse{
+		ConsensusStates:     numConsensusStates,
+		ConsensusStateBytes: consensusStateBytes,
+		MetadataBytes:       metadataBytes,
+	}, nil
+}
+
 // ClientStatus implements the Query/ClientStatus gRPC method
 func (k *Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequest) (*types.QueryClientStatusResponse, error) {
 	if req == nil {
@@ -387,7 +418,7 @@ func (k *Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMembe
 	)
 
 	if err := clientModule.VerifyMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath, req.Value); err != nil {
-		k.Logger(ctx).Debug("proof verification failed", "key", req.MerklePath, "error", err)
+		logging.WithClient(k.Logger(ctx), req.ClientId).Debug("proof verification failed", "key", req.MerklePath, logging.KeyError, err)
 		return &types.QueryVerifyMembershipResponse{
 			Success: false,
 		}, nil
@@ -397,3 +428,119 @@ func (k *Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMembe
 		Success: true,
 	}, nil
 }
+
+// ClientsByChainID implements the Query/ClientsByChainID gRPC method
+func (k *Keeper) ClientsByChainID(c context.Context, req *types.QueryClientsByChainIDRequest) (*types.QueryClientsByChainIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ChainId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain identifier cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var clientIDs []string
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainIDClientsPrefix(req.ChainId))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		// filter clients indexed under chain identifiers which extend the requested chain identifier with a '/'
+		if strings.Contains(string(key), "/") {
+			return false, nil
+		}
+
+		if accumulate {
+			clientIDs = append(clientIDs, string(key))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClientsByChainIDResponse{
+		ClientIds:  clientIDs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SimulateClientRecovery implements the Query/SimulateClientRecovery gRPC method
+func (k *Keeper) SimulateClientRecovery(c context.Context, req *types.QuerySimulateClientRecoveryRequest) (*types.QuerySimulateClientRecoveryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.SubjectClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ClientIdentifierValidator(req.SubstituteClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the recovery is executed on a cached context which is never written, leaving the client stores untouched
+	cachedCtx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	var mismatchedFields []string
+	if clientModule, found := k.router.GetRoute(req.SubjectClientId); found {
+		if reporter, ok := clientModule.(exported.SubstituteMismatchReporter); ok {
+			// errors are surfaced by the simulated recovery below
+			mismatchedFields, _ = reporter.MismatchedSubstituteFields(cachedCtx, req.SubjectClientId, req.SubstituteClientId)
+		}
+	}
+
+	if _, err := k.recoverClient(cachedCtx, req.SubjectClientId, req.SubstituteClientId); err != nil {
+		return &types.QuerySimulateClientRecoveryResponse{
+			Success:          false,
+			Error:            err.Error(),
+			MismatchedFields: mismatchedFields,
+		}, nil
+	}
+
+	return &types.QuerySimulateClientRecoveryResponse{
+		Success: true,
+	}, nil
+}
+
+// LaggingClients implements the Query/LaggingClients gRPC method
+func (k *Keeper) LaggingClients(c context.Context, req *types.QueryLaggingClientsRequest) (*types.QueryLaggingClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	blockHeight := uint64(ctx.BlockHeight())
+
+	clients := []types.LaggingClient{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyClientProgressPrefix+"/"))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		// keys are formatted as {big endian height}/{client identifier}
+		lastProgressHeight, clientID := sdk.BigEndianToUint64(key[:8]), string(key[9:])
+		if lastProgressHeight > blockHeight || !params.IsLaggingClient(blockHeight-lastProgressHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			clients = append(clients, types.LaggingClient{
+				ClientId:           clientID,
+				LastProgressHeight: lastProgressHeight,
+				LagBlocks:          blockHeight - lastProgressHeight,
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLaggingClientsResponse{
+		Clients:            clients,
+		MaxClientLagBlocks: params.MaxClientLagBlocks,
+		Pagination:         pageRes,
+	}, nil
+}
@@ -3,15 +3,19 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -23,8 +27,9 @@ import (
 
 func (suite *KeeperTestSuite) TestQueryClientState() {
 	var (
-		req            *types.QueryClientStateRequest
-		expClientState *codectypes.Any
+		req                 *types.QueryClientStateRequest
+		expClientState      *codectypes.Any
+		expCreationMetadata *types.ClientCreationMetadata
 	)
 
 	testCases := []struct {
@@ -68,6 +73,33 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 				req = &types.QueryClientStateRequest{
 					ClientId: path.EndpointA.ClientID,
 				}
+
+				expCreationMetadata = &types.ClientCreationMetadata{
+					Creator: suite.chainA.SenderAccount.GetAddress().String(),
+				}
+			},
+			true,
+		},
+		{
+			"success: client created without creation metadata",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				var err error
+				expClientState, err = types.PackClientState(path.EndpointA.GetClientState())
+				suite.Require().NoError(err)
+
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(types.ClientCreationMetadataKey(path.EndpointA.ClientID))
+
+				// creation metadata written to the client store by the light client is ignored
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				clientStore.Set([]byte("creationMetadata"), suite.chainA.App.AppCodec().MustMarshal(&types.ClientCreationMetadata{CreationHeight: 1}))
+
+				req = &types.QueryClientStateRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
 			},
 			true,
 		},
@@ -78,6 +110,7 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+			expCreationMetadata = nil
 
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
@@ -88,6 +121,16 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 				suite.Require().NotNil(res)
 				suite.Require().Equal(expClientState, res.ClientState)
 
+				if expCreationMetadata != nil {
+					suite.Require().NotNil(res.CreationMetadata)
+					suite.Require().Equal(expCreationMetadata.Creator, res.CreationMetadata.Creator)
+					suite.Require().NotZero(res.CreationMetadata.CreationHeight)
+					suite.Require().Less(res.CreationMetadata.CreationHeight, uint64(ctx.BlockHeight()))
+					suite.Require().Len(res.CreationMetadata.TxHash, tmhash.Size)
+				} else {
+					suite.Require().Nil(res.CreationMetadata)
+				}
+
 				// ensure UnpackInterfaces is defined
 				cachedValue := res.ClientState.GetCachedValue()
 				suite.Require().NotNil(cachedValue)
@@ -189,6 +232,184 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientsByChainID() {
+	var (
+		req          *types.QueryClientsByChainIDRequest
+		expClientIDs []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetupClients()
+
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2.SetupClients()
+
+				// clients which do not track a chain identifier are not indexed
+				suite.solomachine.CreateClient(suite.chainA)
+
+				expClientIDs = []string{path1.EndpointA.ClientID, path2.EndpointA.ClientID}
+				req = &types.QueryClientsByChainIDRequest{
+					ChainId: suite.chainB.ChainID,
+					Pagination: &query.PageRequest{
+						Limit:      20,
+						CountTotal: true,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: no clients tracking chain",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				expClientIDs = nil
+				req = &types.QueryClientsByChainIDRequest{
+					ChainId: "other-chain-1",
+				}
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"empty chain id",
+			func() {
+				req = &types.QueryClientsByChainIDRequest{}
+			},
+			status.Error(codes.InvalidArgument, "chain identifier cannot be empty"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.ClientsByChainID(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expClientIDs, res.ClientIds)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryLaggingClients() {
+	var (
+		req        *types.QueryLaggingClientsRequest
+		expClients []types.LaggingClient
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetupClients()
+
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2.SetupClients()
+
+				lastProgressHeight, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientLastProgressHeight(suite.chainA.GetContext(), path1.EndpointA.ClientID)
+				suite.Require().True(found)
+
+				suite.coordinator.CommitNBlocks(suite.chainA, 5)
+
+				// the latest height of the second client advances
+				err := path2.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				lagBlocks := uint64(suite.chainA.GetContext().BlockHeight()) - lastProgressHeight
+				expClients = []types.LaggingClient{{ClientId: path1.EndpointA.ClientID, LastProgressHeight: lastProgressHeight, LagBlocks: lagBlocks}}
+				req = &types.QueryLaggingClientsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      20,
+						CountTotal: true,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: max client lag blocks is zero",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				suite.coordinator.CommitNBlocks(suite.chainA, 5)
+
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxClientLagBlocks = 0
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expClients = []types.LaggingClient{}
+				req = &types.QueryLaggingClientsRequest{}
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.MaxClientLagBlocks = 3
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.LaggingClients(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expClients, res.Clients)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	var (
 		req               *types.QueryConsensusStateRequest
@@ -501,6 +722,104 @@ func (suite *KeeperTestSuite) TestQueryConsensusStateHeights() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConsensusStateStoreSize() {
+	var (
+		req                    *types.QueryConsensusStateStoreSizeRequest
+		path                   *ibctesting.Path
+		expConsensusStateCount uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: single consensus state",
+			func() {},
+			nil,
+		},
+		{
+			"success: consensus states added on update",
+			func() {
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				expConsensusStateCount = 3
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client identifier",
+			func() {
+				req.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			status.Error(codes.NotFound, errorsmod.Wrap(types.ErrClientNotFound, ibctesting.InvalidID).Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			req = &types.QueryConsensusStateStoreSizeRequest{
+				ClientId: path.EndpointA.ClientID,
+			}
+			expConsensusStateCount = 1
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.ConsensusStateStoreSize(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				var expConsensusStateBytes uint64
+				heightsRes, err := suite.chainA.QueryServer.ConsensusStateHeights(ctx, &types.QueryConsensusStateHeightsRequest{ClientId: path.EndpointA.ClientID})
+				suite.Require().NoError(err)
+				for _, height := range heightsRes.ConsensusStateHeights {
+					consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, path.EndpointA.ClientID, height)
+					suite.Require().True(found)
+
+					bz := types.MustMarshalConsensusState(suite.chainA.App.AppCodec(), consensusState)
+					expConsensusStateBytes += uint64(len(host.FullConsensusStateKey(path.EndpointA.ClientID, height)) + len(bz))
+				}
+
+				suite.Require().Equal(expConsensusStateCount, res.ConsensusStates)
+				suite.Require().Equal(expConsensusStateBytes, res.ConsensusStateBytes)
+				// the tendermint client stores the processed time and height of every consensus state
+				suite.Require().NotZero(res.MetadataBytes)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	var req *types.QueryClientStatusRequest
 
@@ -956,3 +1275,117 @@ func (suite *KeeperTestSuite) TestQueryVerifyMembershipProof() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQuerySimulateClientRecovery() {
+	var (
+		req                 *types.QuerySimulateClientRecoveryRequest
+		expSuccess          bool
+		expMismatchedFields []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				expSuccess = true
+			},
+			nil,
+		},
+		{
+			"substitute client state does not match subject client state",
+			func() {
+				substituteClientState, ok := suite.chainA.GetClientState(req.SubstituteClientId).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				substituteClientState.UnbondingPeriod += time.Minute
+				substituteClientState.MaxClockDrift += time.Minute
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), req.SubstituteClientId, substituteClientState)
+
+				expMismatchedFields = []string{"unbonding_period", "max_clock_drift"}
+			},
+			nil,
+		},
+		{
+			"subject client is active",
+			func() {
+				subjectClientState, ok := suite.chainA.GetClientState(req.SubjectClientId).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				subjectClientState.FrozenHeight = types.ZeroHeight()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), req.SubjectClientId, subjectClientState)
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid subject client identifier",
+			func() {
+				req.SubjectClientId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"invalid substitute client identifier",
+			func() {
+				req.SubstituteClientId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expSuccess = false
+			expMismatchedFields = nil
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+			suite.Require().NoError(substitutePath.EndpointA.UpdateClient())
+
+			subjectClientState, ok := subjectPath.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			subjectClientState.FrozenHeight = subjectClientState.LatestHeight
+			subjectPath.EndpointA.SetClientState(subjectClientState)
+
+			req = &types.QuerySimulateClientRecoveryRequest{
+				SubjectClientId:    subjectPath.EndpointA.ClientID,
+				SubstituteClientId: substitutePath.EndpointA.ClientID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SimulateClientRecovery(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSuccess, res.Success)
+				suite.Require().Equal(expMismatchedFields, res.MismatchedFields)
+				suite.Require().Equal(expSuccess, res.Error == "")
+
+				if expSuccess {
+					// the subject client must not be recovered by the simulation
+					suite.Require().Equal(exported.Frozen, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, req.SubjectClientId))
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
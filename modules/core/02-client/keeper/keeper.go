@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -25,11 +27,13 @@ import (
 // state information
 type Keeper struct {
 	storeKey       storetypes.StoreKey
+	transientKey   storetypes.StoreKey
 	cdc            codec.BinaryCodec
 	router         *types.Router
 	consensusHost  types.ConsensusHost
 	legacySubspace types.ParamSubspace
 	upgradeKeeper  types.UpgradeKeeper
+	bankKeeper     types.BankKeeper
 }
 
 // NewKeeper creates a new NewKeeper instance
@@ -92,6 +96,40 @@ func (k *Keeper) SetConsensusHost(consensusHost types.ConsensusHost) {
 	k.consensusHost = consensusHost
 }
 
+// SetTransientStoreKey sets the transient store key used to cache the client messages processed
+// within the current block. If it is not set, duplicate client updates are not short-circuited.
+func (k *Keeper) SetTransientStoreKey(transientKey storetypes.StoreKey) {
+	if transientKey == nil {
+		panic(fmt.Errorf("cannot set a nil transient store key"))
+	}
+
+	k.transientKey = transientKey
+}
+
+// SetBankKeeper sets the bank keeper used to escrow and pay out the bonds and rewards of bonded misbehaviour
+// submissions. If it is not set, bonded misbehaviour submissions are rejected.
+func (k *Keeper) SetBankKeeper(bankKeeper types.BankKeeper) {
+	if bankKeeper == nil {
+		panic(fmt.Errorf("cannot set a nil bank keeper"))
+	}
+
+	k.bankKeeper = bankKeeper
+}
+
+// hasProcessedClientMessage returns true if the client message hash has already been processed
+// for the provided client in the current block.
+func (k *Keeper) hasProcessedClientMessage(ctx sdk.Context, clientID string, clientMsgHash []byte) bool {
+	store := ctx.TransientStore(k.transientKey)
+	return store.Has(types.ProcessedClientMessageKey(clientID, clientMsgHash))
+}
+
+// setProcessedClientMessage marks the client message hash as processed for the provided client
+// in the current block.
+func (k *Keeper) setProcessedClientMessage(ctx sdk.Context, clientID string, clientMsgHash []byte) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.ProcessedClientMessageKey(clientID, clientMsgHash), []byte{byte(1)})
+}
+
 // GenerateClientIdentifier returns the next client identifier.
 func (k *Keeper) GenerateClientIdentifier(ctx sdk.Context, clientType string) string {
 	nextClientSeq := k.GetNextClientSequence(ctx)
@@ -183,6 +221,31 @@ func (k *Keeper) IterateConsensusStates(ctx sdk.Context, cb func(clientID string
 	}
 }
 
+// GetConsensusStateStoreSize returns the number of consensus states stored for the given client, along with the
+// total number of bytes of the keys and values of its consensus states and of the metadata stored alongside them
+// under its consensus state prefix, such as the processed times and heights of the consensus states.
+func (k *Keeper) GetConsensusStateStoreSize(ctx sdk.Context, clientID string) (numConsensusStates, consensusStateBytes, metadataBytes uint64) {
+	storePrefix := host.FullClientKey(clientID, []byte(fmt.Sprintf("%s/", host.KeyConsensusStatePrefix)))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	iterator := store.Iterator(nil, nil)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		size := uint64(len(storePrefix) + len(iterator.Key()) + len(iterator.Value()))
+
+		// metadata keys are in the format "<height>/<metadata key>"
+		if bytes.Contains(iterator.Key(), []byte("/")) {
+			metadataBytes += size
+			continue
+		}
+
+		numConsensusStates++
+		consensusStateBytes += size
+	}
+
+	return numConsensusStates, consensusStateBytes, metadataBytes
+}
+
 // iterateMetadata provides an iterator over all stored metadata keys in the client store.
 // For each metadata object, it will perform a callback.
 func (k *Keeper) iterateMetadata(ctx sdk.Context, cb func(clientID string, key, value []byte) bool) {
@@ -385,6 +448,281 @@ func (k *Keeper) ClientStore(ctx sdk.Context, clientID string) storetypes.KVStor
 	return prefix.NewStore(ctx.KVStore(k.storeKey), clientPrefix)
 }
 
+// GetClientCreator returns the address of the account which created the client with the provided
+// identifier. An empty address is returned if no creator is stored for the client.
+func (k *Keeper) GetClientCreator(ctx sdk.Context, clientID string) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(types.ClientCreatorKey(clientID))
+}
+
+// SetClientCreator stores the address of the account which created the client with the provided identifier.
+func (k *Keeper) SetClientCreator(ctx sdk.Context, clientID string, creator sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.ClientCreatorKey(clientID), creator)
+}
+
+// GetAllClientCreators returns the addresses of the accounts which created the stored clients.
+func (k *Keeper) GetAllClientCreators(ctx sdk.Context) []types.ClientCreator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyClientCreatorPrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var creators []types.ClientCreator
+	for ; iterator.Valid(); iterator.Next() {
+		creators = append(creators, types.NewClientCreator(string(iterator.Key()), sdk.AccAddress(iterator.Value()).String()))
+	}
+
+	return creators
+}
+
+// GetClientCreationMetadata returns the metadata recorded on the creation of the client with the
+// provided identifier, including the address of the account which created the client. False is
+// returned if no creation metadata is stored for the client.
+func (k *Keeper) GetClientCreationMetadata(ctx sdk.Context, clientID string) (types.ClientCreationMetadata, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ClientCreationMetadataKey(clientID))
+	if len(bz) == 0 {
+		return types.ClientCreationMetadata{}, false
+	}
+
+	var metadata types.ClientCreationMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+
+	if creator := k.GetClientCreator(ctx, clientID); !creator.Empty() {
+		metadata.Creator = creator.String()
+	}
+
+	return metadata, true
+}
+
+// SetClientCreationMetadata stores the metadata recorded on the creation of the client with the provided identifier.
+// The creator of the client is not stored as part of the metadata, it must be set using SetClientCreator.
+func (k *Keeper) SetClientCreationMetadata(ctx sdk.Context, clientID string, metadata types.ClientCreationMetadata) {
+	metadata.Creator = ""
+	ctx.KVStore(k.storeKey).Set(types.ClientCreationMetadataKey(clientID), k.cdc.MustMarshal(&metadata))
+}
+
+// GetAllClientCreationMetadata returns the metadata recorded on the creation of the stored clients, without
+// the creators of the clients.
+func (k *Keeper) GetAllClientCreationMetadata(ctx sdk.Context) []types.IdentifiedClientCreationMetadata {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyClientCreationMetadataPrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var clientsMetadata []types.IdentifiedClientCreationMetadata
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.ClientCreationMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		clientsMetadata = append(clientsMetadata, types.NewIdentifiedClientCreationMetadata(string(iterator.Key()), metadata))
+	}
+
+	return clientsMetadata
+}
+
+// GetClientIDsByChainID returns the identifiers of all clients tracking the counterparty chain
+// with the given chain identifier.
+func (k *Keeper) GetClientIDsByChainID(ctx sdk.Context, chainID string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainIDClientsPrefix(chainID))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var clientIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		// skip clients indexed under chain identifiers which extend the given chain identifier with a '/'
+		if strings.Contains(string(iterator.Key()), "/") {
+			continue
+		}
+
+		clientIDs = append(clientIDs, string(iterator.Key()))
+	}
+
+	return clientIDs
+}
+
+// IndexClientChainID indexes the client with the given identifier under the chain identifier of the
+// counterparty chain it tracks. Clients whose client state does not expose a chain identifier are not indexed.
+func (k *Keeper) IndexClientChainID(ctx sdk.Context, clientID string) {
+	k.updateClientChainIDIndex(ctx, clientID, "")
+}
+
+// updateClientChainIDIndex indexes the client under the chain identifier of its current client state,
+// removing the index entry stored under the previous chain identifier if it has changed.
+func (k *Keeper) updateClientChainIDIndex(ctx sdk.Context, clientID, prevChainID string) {
+	chainID := k.getClientChainID(ctx, clientID)
+	if chainID == prevChainID {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if prevChainID != "" {
+		store.Delete(types.ChainIDClientKey(prevChainID, clientID))
+	}
+
+	if chainID != "" {
+		store.Set(types.ChainIDClientKey(chainID, clientID), []byte{byte(1)})
+	}
+}
+
+// getClientChainID returns the chain identifier of the counterparty chain tracked by the client with the
+// given identifier. An empty string is returned if the client state does not expose a chain identifier.
+func (k *Keeper) getClientChainID(ctx sdk.Context, clientID string) string {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return ""
+	}
+
+	// only light clients tracking chains with a chain identifier expose it on their client state
+	chainIDClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return ""
+	}
+
+	return chainIDClientState.GetChainID()
+}
+
+// GetClientCount returns the number of stored clients of the given client type.
+func (k *Keeper) GetClientCount(ctx sdk.Context, clientType string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClientCountKey(clientType))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setClientCount sets the number of stored clients of the given client type.
+func (k *Keeper) setClientCount(ctx sdk.Context, clientType string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.ClientCountKey(clientType))
+		return
+	}
+
+	store.Set(types.ClientCountKey(clientType), sdk.Uint64ToBigEndian(count))
+}
+
+// incrementClientCount increments the number of stored clients of the given client type.
+func (k *Keeper) incrementClientCount(ctx sdk.Context, clientType string) {
+	k.setClientCount(ctx, clientType, k.GetClientCount(ctx, clientType)+1)
+}
+
+// decrementClientCount decrements the number of stored clients of the given client type.
+func (k *Keeper) decrementClientCount(ctx sdk.Context, clientType string) {
+	if count := k.GetClientCount(ctx, clientType); count > 0 {
+		k.setClientCount(ctx, clientType, count-1)
+	}
+}
+
+// CountClient counts the client with the given identifier in the number of stored clients of its client type.
+// It is used for clients imported from genesis and by store migrations. The localhost client is not counted.
+func (k *Keeper) CountClient(ctx sdk.Context, clientID string) {
+	if clientID == exported.LocalhostClientID {
+		return
+	}
+
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return
+	}
+
+	k.incrementClientCount(ctx, clientType)
+}
+
+// GetClientLastProgressHeight returns the height of this chain at which the latest height of the client
+// with the given identifier last advanced. It returns false if the progress of the client is not tracked.
+func (k *Keeper) GetClientLastProgressHeight(ctx sdk.Context, clientID string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ClientLastProgressHeightKey(clientID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// TrackClientProgress records the current block height as the height at which the latest height of the client
+// with the given identifier last advanced. It is used on client creation, for clients imported from genesis and
+// by store migrations. The progress of the localhost client, which is updated every block, is not tracked.
+func (k *Keeper) TrackClientProgress(ctx sdk.Context, clientID string) {
+	if clientID == exported.LocalhostClientID {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if prevHeight, found := k.GetClientLastProgressHeight(ctx, clientID); found {
+		store.Delete(types.ClientProgressKey(prevHeight, clientID))
+	}
+
+	height := uint64(ctx.BlockHeight())
+	store.Set(types.ClientProgressKey(height, clientID), []byte{byte(1)})
+	store.Set(types.ClientLastProgressHeightKey(clientID), sdk.Uint64ToBigEndian(height))
+}
+
+// trackClientProgressIfAdvanced records the progress of the client if its latest height has advanced past the
+// provided previous latest height.
+func (k *Keeper) trackClientProgressIfAdvanced(ctx sdk.Context, clientModule exported.LightClientModule, clientID string, prevLatestHeight exported.Height) {
+	if clientModule.LatestHeight(ctx, clientID).GT(prevLatestHeight) {
+		k.TrackClientProgress(ctx, clientID)
+	}
+}
+
+// deleteClientProgress removes the client with the given identifier from the client progress index.
+func (k *Keeper) deleteClientProgress(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	if height, found := k.GetClientLastProgressHeight(ctx, clientID); found {
+		store.Delete(types.ClientProgressKey(height, clientID))
+	}
+
+	store.Delete(types.ClientLastProgressHeightKey(clientID))
+}
+
+// IterateClientsByLastProgressHeight iterates over the clients whose latest height last advanced at a height of
+// this chain lower or equal to the given maximum height, in ascending order of that height, and performs a
+// callback function. Iteration stops if the callback returns true.
+func (k *Keeper) IterateClientsByLastProgressHeight(ctx sdk.Context, maxHeight uint64, cb func(clientID string, lastProgressHeight uint64) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ClientProgressPrefix(0), storetypes.PrefixEndBytes(types.ClientProgressPrefix(maxHeight)))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		height, clientID := parseClientProgressKey(iterator.Key())
+		if cb(clientID, height) {
+			break
+		}
+	}
+}
+
+// parseClientProgressKey returns the height and client identifier encoded in a key of the client progress index.
+func parseClientProgressKey(key []byte) (uint64, string) {
+	key = key[len(types.KeyClientProgressPrefix)+1:]
+	return sdk.BigEndianToUint64(key[:8]), string(key[9:])
+}
+
+// EmitLaggingClientEvents emits a client lagging event for every client whose latest height has not advanced in
+// exactly one block more than the MaxClientLagBlocks parameter, i.e. for the clients which start lagging in the
+// current block. Clients which keep lagging in subsequent blocks are not reported again.
+//
+// The events are emitted based on the current value of the parameter only. Decreasing MaxClientLagBlocks skips the
+// events of the clients which last advanced between the previous and the new lag threshold, and increasing it reports
+// the clients which already started lagging under the previous value again. The LaggingClients query is not affected.
+func (k *Keeper) EmitLaggingClientEvents(ctx sdk.Context) {
+	maxLagBlocks := k.GetParams(ctx).MaxClientLagBlocks
+	if maxLagBlocks == 0 || uint64(ctx.BlockHeight()) <= maxLagBlocks {
+		return
+	}
+
+	progressHeight := uint64(ctx.BlockHeight()) - maxLagBlocks - 1
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClientProgressPrefix(progressHeight))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		clientID := string(iterator.Key())
+
+		logging.WithClient(k.Logger(ctx), clientID).Info("client latest height has not advanced", "last-progress-height", progressHeight)
+		emitClientLaggingEvent(ctx, clientID, progressHeight, maxLagBlocks+1)
+	}
+}
+
 // GetClientStatus returns the status for a client state  given a client identifier. If the client type is not in the allowed
 // clients param field, Unauthorized is returned, otherwise the client state status is returned.
 func (k *Keeper) GetClientStatus(ctx sdk.Context, clientID string) exported.Status {
@@ -405,6 +743,45 @@ func (k *Keeper) GetClientStatus(ctx sdk.Context, clientID string) exported.Stat
 	return clientModule.Status(ctx, clientID)
 }
 
+// RunProofVerification charges the proof verification gas configured in the client params for the
+// client type of the given client identifier and runs the given proof verification. If gas costs are
+// configured for the client type, the verification is run with a gas meter limited to the charged gas,
+// so that the store reads performed during verification are not charged in addition to the configured
+// costs, and the verification fails if it consumes more gas than charged. Otherwise, the verification
+// is run with the given context and is charged for its store reads. The configured costs must cover the
+// computation of clients which perform verification outside of the store, such as 08-wasm contracts.
+func (k *Keeper) RunProofVerification(ctx sdk.Context, clientID string, proof []byte, verify func(sdk.Context) error) (err error) {
+	if clientID == exported.LocalhostClientID {
+		return verify(ctx)
+	}
+
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return verify(ctx)
+	}
+
+	gas, found := k.GetParams(ctx).GetClientProofVerificationGas(clientType)
+	if !found {
+		return verify(ctx)
+	}
+
+	gasLimit := gas.GasCost(len(proof))
+	ctx.GasMeter().ConsumeGas(gasLimit, fmt.Sprintf("proof verification: %s", clientType))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = errorsmod.Wrapf(types.ErrProofVerificationOutOfGas, "gas limit %d exceeded in %s", gasLimit, outOfGas.Descriptor)
+		}
+	}()
+
+	return verify(ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit)))
+}
+
 // GetClientLatestHeight returns the latest height of a client state for a given client identifier. If the client type is not in the allowed
 // clients param field, a zero value height is returned, otherwise the client state latest height is returned.
 func (k *Keeper) GetClientLatestHeight(ctx sdk.Context, clientID string) types.Height {
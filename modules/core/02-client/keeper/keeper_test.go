@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"fmt"
 	"math/rand"
+	"strconv"
 	"testing"
 	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -396,6 +398,220 @@ func (suite *KeeperTestSuite) TestGetClientLatestHeight() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestClientChainIDIndex() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	oldChainID := suite.chainB.ChainID
+
+	// client is indexed on creation
+	suite.Require().Equal([]string{path.EndpointA.ClientID}, clientKeeper.GetClientIDsByChainID(suite.chainA.GetContext(), oldChainID))
+
+	// client is indexed under the new chain identifier once upgraded
+	err := path.EndpointB.UpgradeChain()
+	suite.Require().NoError(err)
+
+	newChainID := suite.chainB.ChainID
+	suite.Require().NotEqual(oldChainID, newChainID)
+	suite.Require().Empty(clientKeeper.GetClientIDsByChainID(suite.chainA.GetContext(), oldChainID))
+	suite.Require().Equal([]string{path.EndpointA.ClientID}, clientKeeper.GetClientIDsByChainID(suite.chainA.GetContext(), newChainID))
+
+	// client is removed from the index on deletion
+	err = clientKeeper.DeleteClient(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().Empty(clientKeeper.GetClientIDsByChainID(suite.chainA.GetContext(), newChainID))
+}
+
+func (suite *KeeperTestSuite) TestClientCount() {
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	suite.Require().Zero(clientKeeper.GetClientCount(suite.chainA.GetContext(), exported.Tendermint))
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	otherPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	otherPath.SetupClients()
+
+	// clients are counted on creation
+	suite.Require().Equal(uint64(2), clientKeeper.GetClientCount(suite.chainA.GetContext(), exported.Tendermint))
+	suite.Require().Zero(clientKeeper.GetClientCount(suite.chainA.GetContext(), exported.Solomachine))
+
+	// clients are no longer counted once deleted
+	err := clientKeeper.DeleteClient(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), clientKeeper.GetClientCount(suite.chainA.GetContext(), exported.Tendermint))
+
+	err = clientKeeper.DeleteClient(suite.chainA.GetContext(), otherPath.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().Zero(clientKeeper.GetClientCount(suite.chainA.GetContext(), exported.Tendermint))
+}
+
+func (suite *KeeperTestSuite) TestClientProgressIndex() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	// the progress of the client is tracked on creation
+	createdHeight, found := clientKeeper.GetClientLastProgressHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	var clientIDs []string
+	clientKeeper.IterateClientsByLastProgressHeight(suite.chainA.GetContext(), createdHeight, func(clientID string, lastProgressHeight uint64) bool {
+		suite.Require().Equal(createdHeight, lastProgressHeight)
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+	suite.Require().Equal([]string{path.EndpointA.ClientID}, clientIDs)
+
+	// the progress of the client is tracked once its latest height advances
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	updatedHeight, found := clientKeeper.GetClientLastProgressHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Greater(updatedHeight, createdHeight)
+
+	clientIDs = nil
+	clientKeeper.IterateClientsByLastProgressHeight(suite.chainA.GetContext(), updatedHeight-1, func(clientID string, _ uint64) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+	suite.Require().Empty(clientIDs)
+
+	// a last progress height written to the client store by the light client is ignored
+	clientStore := clientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	clientStore.Set([]byte("lastProgressHeight"), sdk.Uint64ToBigEndian(createdHeight))
+
+	lastProgressHeight, found := clientKeeper.GetClientLastProgressHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(updatedHeight, lastProgressHeight)
+
+	// client is removed from the index on deletion
+	err = clientKeeper.DeleteClient(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	clientKeeper.IterateClientsByLastProgressHeight(suite.chainA.GetContext(), updatedHeight, func(clientID string, _ uint64) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+	suite.Require().Empty(clientIDs)
+
+	_, found = clientKeeper.GetClientLastProgressHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestEmitLaggingClientEvents() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	params := clientKeeper.GetParams(suite.chainA.GetContext())
+	params.MaxClientLagBlocks = 5
+	clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	lastProgressHeight, found := clientKeeper.GetClientLastProgressHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	expEvent := sdk.NewEvent(
+		types.EventTypeClientLagging,
+		sdk.NewAttribute(types.AttributeKeyClientID, path.EndpointA.ClientID),
+		sdk.NewAttribute(types.AttributeKeyLastProgressHeight, strconv.FormatUint(lastProgressHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyLagBlocks, "6"),
+	)
+
+	testCases := []struct {
+		name      string
+		lagBlocks uint64
+		expEvent  bool
+	}{
+		{"client is not lagging", 5, false},
+		{"client starts lagging", 6, true},
+		{"client keeps lagging", 7, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		ctx := suite.chainA.GetContext().WithBlockHeight(int64(lastProgressHeight + tc.lagBlocks)).WithEventManager(sdk.NewEventManager())
+		clientKeeper.EmitLaggingClientEvents(ctx)
+
+		if tc.expEvent {
+			suite.Require().Contains(ctx.EventManager().Events(), expEvent, tc.name)
+		} else {
+			suite.Require().Empty(ctx.EventManager().Events(), tc.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestRunProofVerification() {
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	proof := make([]byte, 100)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	params := clientKeeper.GetParams(suite.chainA.GetContext())
+	params.ProofVerificationGas = []types.ProofVerificationGas{types.NewProofVerificationGas(exported.Tendermint, 50_000, 10)}
+	clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// gas consumed by reading the params
+	ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	clientKeeper.GetParams(ctx)
+	paramsGas := ctx.GasMeter().GasConsumed()
+
+	// configured client types are charged the gas costs of the params, and store reads during
+	// verification are not charged to the transaction
+	ctx = suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	err := clientKeeper.RunProofVerification(ctx, path.EndpointA.ClientID, proof, func(verifyCtx sdk.Context) error {
+		suite.Require().NotSame(ctx.GasMeter(), verifyCtx.GasMeter())
+		suite.Require().Equal(storetypes.Gas(51_000), verifyCtx.GasMeter().Limit())
+
+		verifyCtx.GasMeter().ConsumeGas(1_000, "store read")
+		return nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(paramsGas+51_000, ctx.GasMeter().GasConsumed())
+
+	// verification fails if it consumes more gas than charged
+	ctx = suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	err = clientKeeper.RunProofVerification(ctx, path.EndpointA.ClientID, proof, func(verifyCtx sdk.Context) error {
+		verifyCtx.GasMeter().ConsumeGas(51_001, "contract execution")
+		return nil
+	})
+	suite.Require().ErrorIs(err, types.ErrProofVerificationOutOfGas)
+	suite.Require().Equal(paramsGas+51_000, ctx.GasMeter().GasConsumed())
+
+	// verification errors are returned
+	err = clientKeeper.RunProofVerification(ctx, path.EndpointA.ClientID, proof, func(sdk.Context) error {
+		return types.ErrFailedMembershipVerification
+	})
+	suite.Require().ErrorIs(err, types.ErrFailedMembershipVerification)
+
+	// client types without gas costs are charged for their store reads
+	params.ProofVerificationGas = nil
+	clientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	ctx = suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	err = clientKeeper.RunProofVerification(ctx, path.EndpointA.ClientID, proof, func(verifyCtx sdk.Context) error {
+		suite.Require().Same(ctx.GasMeter(), verifyCtx.GasMeter())
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// the localhost client is never charged
+	ctx = suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	err = clientKeeper.RunProofVerification(ctx, exported.LocalhostClientID, proof, func(verifyCtx sdk.Context) error {
+		suite.Require().Same(ctx.GasMeter(), verifyCtx.GasMeter())
+		return nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Zero(ctx.GasMeter().GasConsumed())
+}
+
 func (suite *KeeperTestSuite) TestGetTimestampAtHeight() {
 	var (
 		height exported.Height
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/migrations/v7"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -47,3 +48,25 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated client to self-manage params")
 	return nil
 }
+
+// Migrate6to7 migrates from consensus version 6 to 7.
+// This migration indexes all existing clients by the chain identifier of the counterparty chain they track,
+// stores the number of existing clients of each client type and indexes all existing clients by the height
+// of this chain at which their latest height last advanced. As this height is not known for existing clients,
+// the height of the migration is recorded.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	var clientIDs []string
+	m.keeper.IterateClientStates(ctx, nil, func(clientID string, _ exported.ClientState) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+
+	for _, clientID := range clientIDs {
+		m.keeper.IndexClientChainID(ctx, clientID)
+		m.keeper.CountClient(ctx, clientID)
+		m.keeper.TrackClientProgress(ctx, clientID)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully indexed and counted clients", "clients", len(clientIDs))
+	return nil
+}
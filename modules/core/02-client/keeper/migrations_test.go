@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 // TestMigrateParams tests the migration for the client params
@@ -41,3 +42,44 @@ func (suite *KeeperTestSuite) TestMigrateParams() {
 		})
 	}
 }
+
+// TestMigrate6to7 tests the migration indexing existing clients by counterparty chain identifier and by the height at
+// which their latest height last advanced, and counting them by client type
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(ibcexported.StoreKey))
+	clientKeeper := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper
+
+	// remove the index entries and counter written on client creation to simulate a client created prior to the migration
+	lastProgressHeight, found := clientKeeper.GetClientLastProgressHeight(ctx, path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	store.Delete(types.ChainIDClientKey(suite.chainB.ChainID, path.EndpointA.ClientID))
+	store.Delete(types.ClientCountKey(ibcexported.Tendermint))
+	store.Delete(types.ClientProgressKey(lastProgressHeight, path.EndpointA.ClientID))
+	store.Delete(types.ClientLastProgressHeightKey(path.EndpointA.ClientID))
+
+	suite.Require().Empty(clientKeeper.GetClientIDsByChainID(ctx, suite.chainB.ChainID))
+	suite.Require().Zero(clientKeeper.GetClientCount(ctx, ibcexported.Tendermint))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	migrator := keeper.NewMigrator(clientKeeper)
+	err := migrator.Migrate6to7(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]string{path.EndpointA.ClientID}, clientKeeper.GetClientIDsByChainID(ctx, suite.chainB.ChainID))
+
+	suite.Require().Equal(uint64(1), clientKeeper.GetClientCount(ctx, ibcexported.Tendermint))
+	suite.Require().Zero(clientKeeper.GetClientCount(ctx, ibcexported.Localhost))
+
+	lastProgressHeight, found = clientKeeper.GetClientLastProgressHeight(ctx, path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(ctx.BlockHeight()), lastProgressHeight)
+
+	// the localhost client is not indexed
+	_, found = clientKeeper.GetClientLastProgressHeight(ctx, ibcexported.LocalhostClientID)
+	suite.Require().False(found)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
@@ -33,6 +34,12 @@ func NewDecodeStore(cdc ClientUnmarshaler, kvA, kvB kv.Pair) (string, bool) {
 		consensusStateB := cdc.MustUnmarshalConsensusState(kvB.Value)
 		return fmt.Sprintf("ConsensusState A: %v\nConsensusState B: %v", consensusStateA, consensusStateB), true
 
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyChainIDClientsPrefix)):
+		return fmt.Sprintf("ChainIDClient A: %s\nChainIDClient B: %s", kvA.Key, kvB.Key), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(types.KeyClientProgressPrefix)):
+		return fmt.Sprintf("ClientProgress A: %X\nClientProgress B: %X", kvA.Key, kvB.Key), true
+
 	default:
 		return "", false
 	}
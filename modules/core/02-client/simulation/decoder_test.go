@@ -40,6 +40,14 @@ func TestDecodeStore(t *testing.T) {
 				Key:   host.FullConsensusStateKey(clientID, height),
 				Value: app.IBCKeeper.ClientKeeper.MustMarshalConsensusState(consState),
 			},
+			{
+				Key:   types.ChainIDClientKey("chain-id", clientID),
+				Value: []byte{byte(1)},
+			},
+			{
+				Key:   types.ClientProgressKey(10, clientID),
+				Value: []byte{byte(1)},
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -52,6 +60,8 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"ClientState", fmt.Sprintf("ClientState A: %v\nClientState B: %v", clientState, clientState)},
 		{"ConsensusState", fmt.Sprintf("ConsensusState A: %v\nConsensusState B: %v", consState, consState)},
+		{"ChainIDClient", fmt.Sprintf("ChainIDClient A: %s\nChainIDClient B: %s", types.ChainIDClientKey("chain-id", clientID), types.ChainIDClientKey("chain-id", clientID))},
+		{"ClientProgress", fmt.Sprintf("ClientProgress A: %X\nClientProgress B: %X", types.ClientProgressKey(10, clientID), types.ClientProgressKey(10, clientID))},
 		{"other", ""},
 	}
 
@@ -4,10 +4,12 @@
 package types
 
 import (
-	types1 "cosmossdk.io/x/upgrade/types"
+	types2 "cosmossdk.io/x/upgrade/types"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -243,19 +245,109 @@ func (m *Height) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Height proto.InternalMessageInfo
 
+// ClientCreationMetadata defines the metadata recorded when a client is created
+// through MsgCreateClient.
+type ClientCreationMetadata struct {
+	// address of the account which created the client
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// block height at which the client was created
+	CreationHeight uint64 `protobuf:"varint,2,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// hash of the transaction which created the client
+	TxHash []byte `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *ClientCreationMetadata) Reset()         { *m = ClientCreationMetadata{} }
+func (m *ClientCreationMetadata) String() string { return proto.CompactTextString(m) }
+func (*ClientCreationMetadata) ProtoMessage()    {}
+func (*ClientCreationMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{4}
+}
+func (m *ClientCreationMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientCreationMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientCreationMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientCreationMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientCreationMetadata.Merge(m, src)
+}
+func (m *ClientCreationMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientCreationMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientCreationMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientCreationMetadata proto.InternalMessageInfo
+
+func (m *ClientCreationMetadata) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ClientCreationMetadata) GetCreationHeight() uint64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *ClientCreationMetadata) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
 // Params defines the set of IBC light client parameters.
 type Params struct {
 	// allowed_clients defines the list of allowed client state types which can be created
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// max_clients defines the maximum number of clients which can be created per client type
+	// by accounts other than the authority. Client types without a limit can be created without
+	// restriction.
+	MaxClients []ClientTypeLimit `protobuf:"bytes,2,rep,name=max_clients,json=maxClients,proto3" json:"max_clients"`
+	// proof_verification_gas defines the gas charged per proof verification for each client type.
+	// Client types without an entry are charged the gas consumed by the store reads of the
+	// verification.
+	ProofVerificationGas []ProofVerificationGas `protobuf:"bytes,3,rep,name=proof_verification_gas,json=proofVerificationGas,proto3" json:"proof_verification_gas"`
+	// misbehaviour_bond defines the bond escrowed from accounts submitting misbehaviour through
+	// MsgSubmitBondedMisbehaviour. The bond is returned if the misbehaviour freezes the client and is
+	// slashed into the misbehaviour reward pool otherwise. Bonded misbehaviour submission is disabled
+	// if the bond is empty.
+	MisbehaviourBond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=misbehaviour_bond,json=misbehaviourBond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"misbehaviour_bond"`
+	// misbehaviour_reward defines the reward paid from the misbehaviour reward pool to accounts
+	// submitting misbehaviour through MsgSubmitBondedMisbehaviour which freezes a client. The reward
+	// is capped by the balance of the pool.
+	MisbehaviourReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=misbehaviour_reward,json=misbehaviourReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"misbehaviour_reward"`
+	// duplicate_update_gas_refund_percentage defines the percentage of the gas consumed by a client update
+	// which is refunded if the update is skipped as a no-op, i.e. if the client message is a duplicate of a
+	// client message already applied to the client. Gas is not refunded if set to zero.
+	DuplicateUpdateGasRefundPercentage uint32 `protobuf:"varint,6,opt,name=duplicate_update_gas_refund_percentage,json=duplicateUpdateGasRefundPercentage,proto3" json:"duplicate_update_gas_refund_percentage,omitempty"`
+	// max_client_lag_blocks defines the number of blocks of this chain after which a client whose latest
+	// height has not advanced is reported as lagging by the LaggingClients query and a client_lagging event.
+	// Lagging clients are not reported if set to zero.
+	MaxClientLagBlocks uint64 `protobuf:"varint,7,opt,name=max_client_lag_blocks,json=maxClientLagBlocks,proto3" json:"max_client_lag_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{4}
+	return fileDescriptor_b6bc4c8185546947, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,6 +383,205 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetMaxClients() []ClientTypeLimit {
+	if m != nil {
+		return m.MaxClients
+	}
+	return nil
+}
+
+func (m *Params) GetProofVerificationGas() []ProofVerificationGas {
+	if m != nil {
+		return m.ProofVerificationGas
+	}
+	return nil
+}
+
+func (m *Params) GetMisbehaviourBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MisbehaviourBond
+	}
+	return nil
+}
+
+func (m *Params) GetMisbehaviourReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MisbehaviourReward
+	}
+	return nil
+}
+
+func (m *Params) GetDuplicateUpdateGasRefundPercentage() uint32 {
+	if m != nil {
+		return m.DuplicateUpdateGasRefundPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetMaxClientLagBlocks() uint64 {
+	if m != nil {
+		return m.MaxClientLagBlocks
+	}
+	return 0
+}
+
+// ClientTypeLimit defines the maximum number of clients of a client type.
+type ClientTypeLimit struct {
+	// client type the limit applies to.
+	ClientType string `protobuf:"bytes,1,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// maximum number of clients of the client type.
+	MaxClients uint64 `protobuf:"varint,2,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+}
+
+func (m *ClientTypeLimit) Reset()         { *m = ClientTypeLimit{} }
+func (m *ClientTypeLimit) String() string { return proto.CompactTextString(m) }
+func (*ClientTypeLimit) ProtoMessage()    {}
+func (*ClientTypeLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{6}
+}
+func (m *ClientTypeLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientTypeLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientTypeLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientTypeLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientTypeLimit.Merge(m, src)
+}
+func (m *ClientTypeLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientTypeLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientTypeLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientTypeLimit proto.InternalMessageInfo
+
+func (m *ClientTypeLimit) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *ClientTypeLimit) GetMaxClients() uint64 {
+	if m != nil {
+		return m.MaxClients
+	}
+	return 0
+}
+
+// ClientUpdatesVoteExtension defines the client updates a validator includes in its ABCI vote
+// extension. Client messages included in the vote extensions of more than 2/3 of the voting power
+// are applied to their clients in PreBlock without verification by the light client.
+type ClientUpdatesVoteExtension struct {
+	Updates []VoteExtensionClientUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *ClientUpdatesVoteExtension) Reset()         { *m = ClientUpdatesVoteExtension{} }
+func (m *ClientUpdatesVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*ClientUpdatesVoteExtension) ProtoMessage()    {}
+func (*ClientUpdatesVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{7}
+}
+func (m *ClientUpdatesVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientUpdatesVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientUpdatesVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientUpdatesVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientUpdatesVoteExtension.Merge(m, src)
+}
+func (m *ClientUpdatesVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientUpdatesVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientUpdatesVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientUpdatesVoteExtension proto.InternalMessageInfo
+
+func (m *ClientUpdatesVoteExtension) GetUpdates() []VoteExtensionClientUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+// VoteExtensionClientUpdate defines a client message for a client included in a vote extension.
+type VoteExtensionClientUpdate struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// proto encoded client message, usually a header
+	ClientMessage []byte `protobuf:"bytes,2,opt,name=client_message,json=clientMessage,proto3" json:"client_message,omitempty"`
+}
+
+func (m *VoteExtensionClientUpdate) Reset()         { *m = VoteExtensionClientUpdate{} }
+func (m *VoteExtensionClientUpdate) String() string { return proto.CompactTextString(m) }
+func (*VoteExtensionClientUpdate) ProtoMessage()    {}
+func (*VoteExtensionClientUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{8}
+}
+func (m *VoteExtensionClientUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtensionClientUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtensionClientUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtensionClientUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtensionClientUpdate.Merge(m, src)
+}
+func (m *VoteExtensionClientUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtensionClientUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtensionClientUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtensionClientUpdate proto.InternalMessageInfo
+
+func (m *VoteExtensionClientUpdate) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *VoteExtensionClientUpdate) GetClientMessage() []byte {
+	if m != nil {
+		return m.ClientMessage
+	}
+	return nil
+}
+
 // ClientUpdateProposal is a legacy governance proposal. If it passes, the substitute
 // client's latest consensus state is copied over to the subject client. The proposal
 // handler may fail if the subject and the substitute do not match in client and
@@ -315,7 +606,7 @@ func (m *ClientUpdateProposal) Reset()         { *m = ClientUpdateProposal{} }
 func (m *ClientUpdateProposal) String() string { return proto.CompactTextString(m) }
 func (*ClientUpdateProposal) ProtoMessage()    {}
 func (*ClientUpdateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{9}
 }
 func (m *ClientUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +644,7 @@ var xxx_messageInfo_ClientUpdateProposal proto.InternalMessageInfo
 type UpgradeProposal struct {
 	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Plan        types1.Plan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan"`
+	Plan        types2.Plan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan"`
 	// An UpgradedClientState must be provided to perform an IBC breaking upgrade.
 	// This will make the chain commit to the correct upgraded (self) client state
 	// before the upgrade occurs, so that connecting chains can verify that the
@@ -366,7 +657,7 @@ type UpgradeProposal struct {
 func (m *UpgradeProposal) Reset()      { *m = UpgradeProposal{} }
 func (*UpgradeProposal) ProtoMessage() {}
 func (*UpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{10}
 }
 func (m *UpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,63 +686,158 @@ func (m *UpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpgradeProposal proto.InternalMessageInfo
 
+// ProofVerificationGas defines the gas charged for a single proof verification by clients of a client type.
+type ProofVerificationGas struct {
+	// client type the gas costs apply to.
+	ClientType string `protobuf:"bytes,1,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// flat gas charged for every proof verification.
+	BaseGas uint64 `protobuf:"varint,2,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	// gas charged per byte of the proof being verified.
+	GasPerByte uint64 `protobuf:"varint,3,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty"`
+}
+
+func (m *ProofVerificationGas) Reset()         { *m = ProofVerificationGas{} }
+func (m *ProofVerificationGas) String() string { return proto.CompactTextString(m) }
+func (*ProofVerificationGas) ProtoMessage()    {}
+func (*ProofVerificationGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{11}
+}
+func (m *ProofVerificationGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofVerificationGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofVerificationGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofVerificationGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofVerificationGas.Merge(m, src)
+}
+func (m *ProofVerificationGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofVerificationGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofVerificationGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofVerificationGas proto.InternalMessageInfo
+
+func (m *ProofVerificationGas) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *ProofVerificationGas) GetBaseGas() uint64 {
+	if m != nil {
+		return m.BaseGas
+	}
+	return 0
+}
+
+func (m *ProofVerificationGas) GetGasPerByte() uint64 {
+	if m != nil {
+		return m.GasPerByte
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
+	proto.RegisterType((*ClientCreationMetadata)(nil), "ibc.core.client.v1.ClientCreationMetadata")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
+	proto.RegisterType((*ClientTypeLimit)(nil), "ibc.core.client.v1.ClientTypeLimit")
+	proto.RegisterType((*ClientUpdatesVoteExtension)(nil), "ibc.core.client.v1.ClientUpdatesVoteExtension")
+	proto.RegisterType((*VoteExtensionClientUpdate)(nil), "ibc.core.client.v1.VoteExtensionClientUpdate")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
+	proto.RegisterType((*ProofVerificationGas)(nil), "ibc.core.client.v1.ProofVerificationGas")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xa6, 0x31, 0x34, 0x13, 0x69, 0x74, 0x4d, 0x61, 0x4d, 0x4b, 0x36, 0x2c, 0x05, 0x73,
-	0x68, 0x77, 0x4d, 0x04, 0x2d, 0x01, 0x0f, 0xa6, 0x97, 0xf6, 0x22, 0x75, 0xa5, 0x08, 0x82, 0x84,
-	0xfd, 0x98, 0x6e, 0xa6, 0xec, 0xce, 0x2c, 0x3b, 0xb3, 0x91, 0xfc, 0x03, 0x8f, 0x8a, 0x17, 0xc1,
-	0x4b, 0x7f, 0x84, 0x3f, 0xa2, 0x78, 0xea, 0xd1, 0x53, 0x90, 0xf6, 0xe2, 0xb9, 0xbf, 0x40, 0x76,
-	0x3e, 0x6c, 0x63, 0x5a, 0x15, 0xbc, 0xcd, 0xfb, 0xcc, 0x33, 0xef, 0xfb, 0xcc, 0x33, 0xf3, 0xbe,
-	0xc0, 0x44, 0x7e, 0xe0, 0x04, 0x24, 0x83, 0x4e, 0x10, 0x23, 0x88, 0x99, 0x33, 0xe9, 0xc9, 0x95,
-	0x9d, 0x66, 0x84, 0x11, 0x5d, 0x47, 0x7e, 0x60, 0x17, 0x04, 0x5b, 0xc2, 0x93, 0x5e, 0x6b, 0x23,
-	0x20, 0x34, 0x21, 0xd4, 0xc9, 0xd3, 0x28, 0xf3, 0x42, 0xe8, 0x4c, 0x7a, 0x3e, 0x64, 0x5e, 0x4f,
-	0xc5, 0xe2, 0x64, 0xeb, 0xbe, 0x60, 0x8d, 0x78, 0xe4, 0x88, 0x40, 0x6e, 0x35, 0x23, 0x12, 0x11,
-	0x81, 0x17, 0x2b, 0x75, 0x20, 0x22, 0x24, 0x8a, 0xa1, 0xc3, 0x23, 0x3f, 0x3f, 0x74, 0x3c, 0x3c,
-	0x15, 0x5b, 0x56, 0x02, 0x56, 0xf7, 0x42, 0x88, 0x19, 0x3a, 0x44, 0x30, 0xdc, 0xe1, 0x42, 0x5e,
-	0x32, 0x8f, 0x41, 0x7d, 0x0d, 0xd4, 0x84, 0xae, 0x11, 0x0a, 0x0d, 0xad, 0xa3, 0x75, 0x6b, 0xee,
-	0xb2, 0x00, 0xf6, 0x42, 0xfd, 0x09, 0xb8, 0x2d, 0x37, 0x69, 0x41, 0x36, 0xca, 0x1d, 0xad, 0x5b,
-	0xef, 0x37, 0x6d, 0x51, 0xc7, 0x56, 0x75, 0xec, 0x67, 0x78, 0xea, 0xd6, 0x83, 0xcb, 0xac, 0xd6,
-	0x47, 0x0d, 0x18, 0x3b, 0x04, 0x53, 0x88, 0x69, 0x4e, 0x39, 0xf4, 0x0a, 0xb1, 0xf1, 0x2e, 0x44,
-	0xd1, 0x98, 0xe9, 0xdb, 0xa0, 0x3a, 0xe6, 0x2b, 0x5e, 0xaf, 0xde, 0x6f, 0xd9, 0x8b, 0x16, 0xd9,
-	0x82, 0x3b, 0xac, 0x9c, 0xcc, 0xcc, 0x92, 0x2b, 0xf9, 0xfa, 0x53, 0xd0, 0x08, 0x54, 0xd6, 0x7f,
-	0x90, 0xb4, 0x12, 0xcc, 0x49, 0x28, 0x54, 0xad, 0x8a, 0xbb, 0xcf, 0x6b, 0xa3, 0x7f, 0x76, 0xe1,
-	0x0d, 0xb8, 0xf3, 0x5b, 0x55, 0x6a, 0x94, 0x3b, 0x4b, 0xdd, 0x7a, 0x7f, 0xf3, 0x3a, 0xe5, 0x37,
-	0xdd, 0x5b, 0xde, 0xa5, 0x31, 0x2f, 0x8a, 0x5a, 0x21, 0xa8, 0x4a, 0x63, 0x1e, 0x80, 0x46, 0x06,
-	0x27, 0x88, 0x22, 0x82, 0x47, 0x38, 0x4f, 0x7c, 0x98, 0x71, 0x2d, 0x15, 0x77, 0x45, 0xc1, 0xcf,
-	0x39, 0x3a, 0x47, 0x94, 0x56, 0x96, 0xe7, 0x89, 0x22, 0xe3, 0x60, 0xf9, 0xdd, 0xb1, 0x59, 0xfa,
-	0x74, 0x6c, 0x96, 0xac, 0x1e, 0xa8, 0xee, 0x7b, 0x99, 0x97, 0xd0, 0xe2, 0xb0, 0x17, 0xc7, 0xe4,
-	0x2d, 0x0c, 0x47, 0x42, 0x34, 0x35, 0xb4, 0xce, 0x52, 0xb7, 0xe6, 0xae, 0x48, 0x58, 0x58, 0x44,
-	0xad, 0x0f, 0x65, 0xd0, 0x14, 0xeb, 0x83, 0x34, 0xf4, 0x18, 0xdc, 0xcf, 0x48, 0x4a, 0xa8, 0x17,
-	0xeb, 0x4d, 0x70, 0x8b, 0x21, 0x16, 0x43, 0xe9, 0x94, 0x08, 0xf4, 0x0e, 0xa8, 0x87, 0x90, 0x06,
-	0x19, 0x4a, 0x19, 0x22, 0x98, 0x0b, 0xaa, 0xb9, 0x57, 0x21, 0x7d, 0x17, 0xdc, 0xa5, 0xb9, 0x7f,
-	0x04, 0x03, 0x36, 0xba, 0x74, 0x7b, 0xa9, 0xe0, 0x0d, 0xd7, 0x2f, 0x66, 0xa6, 0x31, 0xf5, 0x92,
-	0x78, 0x60, 0x2d, 0x50, 0x2c, 0xb7, 0x21, 0xb1, 0x1d, 0xf5, 0x24, 0x2f, 0x40, 0x93, 0xe6, 0x3e,
-	0x65, 0x88, 0xe5, 0x0c, 0x5e, 0x49, 0x56, 0xe1, 0xc9, 0xcc, 0x8b, 0x99, 0xb9, 0xf6, 0x2b, 0xd9,
-	0x02, 0xcb, 0x72, 0xf5, 0x4b, 0x58, 0xa5, 0x1c, 0x6c, 0x14, 0x56, 0x7d, 0xfd, 0xb2, 0xd5, 0x92,
-	0x8d, 0x16, 0x91, 0x89, 0x2d, 0xfb, 0xb2, 0x78, 0x52, 0x06, 0x31, 0x33, 0x34, 0xeb, 0x73, 0x19,
-	0x34, 0x0e, 0x44, 0x97, 0xfe, 0xb7, 0x1d, 0x8f, 0x41, 0x25, 0x8d, 0x3d, 0xcc, 0x1d, 0xa8, 0xf7,
-	0xd7, 0x6d, 0x59, 0x58, 0x0d, 0x01, 0x55, 0x7c, 0x3f, 0xf6, 0xb0, 0xfc, 0x3b, 0x9c, 0xaf, 0x1f,
-	0x81, 0x55, 0xc9, 0x51, 0x2f, 0x28, 0x7b, 0xa1, 0x72, 0x73, 0x2f, 0x0c, 0x3b, 0x17, 0x33, 0x73,
-	0x5d, 0x78, 0x72, 0xed, 0x61, 0xcb, 0xbd, 0xa7, 0xf0, 0x2b, 0xe3, 0x61, 0xb0, 0xa9, 0x3e, 0xd0,
-	0x8f, 0x63, 0x53, 0xfb, 0x9b, 0x3b, 0x43, 0xf7, 0xe4, 0xac, 0xad, 0x9d, 0x9e, 0xb5, 0xb5, 0xef,
-	0x67, 0x6d, 0xed, 0xfd, 0x79, 0xbb, 0x74, 0x7a, 0xde, 0x2e, 0x7d, 0x3b, 0x6f, 0x97, 0x5e, 0x6f,
-	0x47, 0x88, 0x8d, 0x73, 0xdf, 0x0e, 0x48, 0x22, 0x27, 0x99, 0x83, 0xfc, 0x60, 0x2b, 0x22, 0xce,
-	0x64, 0xdb, 0x49, 0x48, 0x98, 0xc7, 0x90, 0x8a, 0x31, 0xfa, 0xb0, 0xbf, 0x25, 0x27, 0x29, 0x9b,
-	0xa6, 0x90, 0xfa, 0x55, 0x7e, 0x8d, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x80, 0x51,
-	0x2a, 0x69, 0x05, 0x00, 0x00,
+	// 1104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0xae, 0x93, 0x8c, 0xd3, 0xb8, 0xdd, 0x3a, 0x65, 0x93, 0x46, 0xb6, 0xb5, 0x14,
+	0xf0, 0xa1, 0xd9, 0x6d, 0x8c, 0x04, 0x51, 0x24, 0x0e, 0x38, 0x42, 0x4d, 0x51, 0x83, 0xcc, 0x96,
+	0x16, 0x09, 0x09, 0xad, 0x66, 0x77, 0x27, 0xeb, 0x69, 0x76, 0x77, 0x56, 0x3b, 0xb3, 0xae, 0x2d,
+	0xf1, 0x01, 0x38, 0x82, 0xb8, 0x20, 0x71, 0xc9, 0x99, 0x33, 0x1f, 0xa2, 0xe2, 0xd4, 0x23, 0xa7,
+	0x80, 0x92, 0x0b, 0xe7, 0x7c, 0x02, 0x34, 0x7f, 0xd6, 0xb1, 0x13, 0xa7, 0x45, 0x82, 0x93, 0x67,
+	0xde, 0xfc, 0xe6, 0xf7, 0xde, 0xfb, 0xed, 0x7b, 0x6f, 0x0c, 0x5a, 0xd8, 0xf3, 0x6d, 0x9f, 0x64,
+	0xc8, 0xf6, 0x23, 0x8c, 0x12, 0x66, 0x0f, 0xb7, 0xd5, 0xca, 0x4a, 0x33, 0xc2, 0x88, 0xae, 0x63,
+	0xcf, 0xb7, 0x38, 0xc0, 0x52, 0xe6, 0xe1, 0xf6, 0x46, 0xd3, 0x27, 0x34, 0x26, 0xd4, 0xf6, 0x20,
+	0x45, 0xf6, 0x70, 0xdb, 0x43, 0x0c, 0x6e, 0xdb, 0x3e, 0xc1, 0x89, 0xbc, 0xb3, 0x71, 0x5f, 0x9d,
+	0xe7, 0x69, 0x98, 0xc1, 0xe0, 0x02, 0xa2, 0xf6, 0x0a, 0xb5, 0x2e, 0x51, 0xae, 0xd8, 0xd9, 0x72,
+	0xa3, 0x8e, 0x1a, 0x21, 0x09, 0x89, 0xb4, 0xf3, 0x55, 0x71, 0x21, 0x24, 0x24, 0x8c, 0x90, 0x2d,
+	0x76, 0x5e, 0x7e, 0x68, 0xc3, 0x64, 0x2c, 0x8f, 0xcc, 0x18, 0xac, 0x3d, 0x0e, 0x50, 0xc2, 0xf0,
+	0x21, 0x46, 0xc1, 0x9e, 0x08, 0xf4, 0x29, 0x83, 0x0c, 0xe9, 0xf7, 0xc0, 0xb2, 0x8c, 0xdb, 0xc5,
+	0x81, 0xa1, 0xb5, 0xb5, 0xce, 0xb2, 0xb3, 0x24, 0x0d, 0x8f, 0x03, 0xfd, 0x63, 0xb0, 0xa2, 0x0e,
+	0x29, 0x07, 0x1b, 0xe5, 0xb6, 0xd6, 0xa9, 0x75, 0x1b, 0x96, 0xf4, 0x63, 0x15, 0x7e, 0xac, 0x4f,
+	0x93, 0xb1, 0x53, 0xf3, 0x2f, 0x58, 0xcd, 0x9f, 0x34, 0x60, 0xec, 0x91, 0x84, 0xa2, 0x84, 0xe6,
+	0x54, 0x98, 0xbe, 0xc6, 0x6c, 0xb0, 0x8f, 0x70, 0x38, 0x60, 0xfa, 0x0e, 0xa8, 0x0e, 0xc4, 0x4a,
+	0xf8, 0xab, 0x75, 0x37, 0xac, 0xab, 0x12, 0x5a, 0x12, 0xdb, 0xab, 0xbc, 0x3a, 0x69, 0x95, 0x1c,
+	0x85, 0xd7, 0x3f, 0x01, 0x75, 0xbf, 0x60, 0xfd, 0x17, 0x21, 0xad, 0xfa, 0x33, 0x21, 0xf0, 0xa8,
+	0xd6, 0x64, 0xee, 0xb3, 0xb1, 0xd1, 0x37, 0xab, 0xf0, 0x2d, 0xb8, 0x75, 0xc9, 0x2b, 0x35, 0xca,
+	0xed, 0x85, 0x4e, 0xad, 0xfb, 0x60, 0x5e, 0xe4, 0xd7, 0xe5, 0xad, 0x72, 0xa9, 0xcf, 0x06, 0x45,
+	0xcd, 0x00, 0x54, 0x95, 0x30, 0x1f, 0x80, 0x7a, 0x86, 0x86, 0x98, 0x62, 0x92, 0xb8, 0x49, 0x1e,
+	0x7b, 0x28, 0x13, 0xb1, 0x54, 0x9c, 0xd5, 0xc2, 0xfc, 0x85, 0xb0, 0xce, 0x00, 0x95, 0x94, 0xe5,
+	0x59, 0xa0, 0x64, 0xdc, 0x5d, 0xfa, 0xfe, 0xb8, 0x55, 0xfa, 0xf9, 0xb8, 0x55, 0x32, 0x19, 0xb8,
+	0xab, 0x52, 0xcf, 0x10, 0x64, 0x98, 0x24, 0x07, 0x88, 0xc1, 0x00, 0x32, 0xa8, 0x1b, 0x60, 0xd1,
+	0xe7, 0x36, 0x92, 0xa9, 0xcc, 0x8b, 0x2d, 0x77, 0xe3, 0x2b, 0xf4, 0x25, 0x37, 0x85, 0x59, 0x05,
+	0xfe, 0x0e, 0x58, 0x64, 0x23, 0x77, 0x00, 0xe9, 0xc0, 0x58, 0x68, 0x6b, 0x9d, 0x15, 0xa7, 0xca,
+	0x46, 0xfb, 0x90, 0x0e, 0xcc, 0xd3, 0x0a, 0xa8, 0xf6, 0x61, 0x06, 0x63, 0xca, 0xc9, 0x60, 0x14,
+	0x91, 0x97, 0x28, 0x70, 0xa5, 0x56, 0xd4, 0xd0, 0xda, 0x0b, 0x9d, 0x65, 0x67, 0x55, 0x99, 0x65,
+	0x78, 0x54, 0xff, 0x1c, 0xd4, 0x62, 0x38, 0x9a, 0x80, 0xa4, 0xd2, 0xef, 0xce, 0x55, 0x5a, 0xac,
+	0xbe, 0x1a, 0xa7, 0xe8, 0x09, 0x8e, 0x71, 0x21, 0x30, 0x88, 0xe1, 0xa8, 0xe0, 0x0a, 0xc0, 0xdd,
+	0x34, 0x23, 0xe4, 0xd0, 0x1d, 0xa2, 0x0c, 0x1f, 0x62, 0x5f, 0xe6, 0x12, 0x42, 0x6a, 0x2c, 0x08,
+	0xda, 0xce, 0x3c, 0xda, 0x3e, 0xbf, 0xf1, 0x7c, 0xea, 0xc2, 0x23, 0x48, 0x15, 0x77, 0x23, 0x9d,
+	0x73, 0xa6, 0x8f, 0xc0, 0xed, 0x18, 0x53, 0x0f, 0x0d, 0xe0, 0x10, 0x93, 0x3c, 0x73, 0x3d, 0x92,
+	0x04, 0x46, 0x45, 0x38, 0x58, 0xb7, 0x54, 0xdf, 0xf2, 0x51, 0x60, 0xa9, 0x3e, 0xb7, 0xf6, 0x08,
+	0x4e, 0x7a, 0x0f, 0x39, 0xe3, 0xaf, 0x7f, 0xb6, 0x3a, 0x21, 0x66, 0x83, 0xdc, 0xb3, 0x7c, 0x12,
+	0xab, 0x26, 0x57, 0x3f, 0x5b, 0x34, 0x38, 0xb2, 0xd9, 0x38, 0x45, 0x54, 0x5c, 0xa0, 0xce, 0xad,
+	0x69, 0x2f, 0x3d, 0x92, 0x04, 0xfa, 0x77, 0xe0, 0xce, 0x8c, 0xe7, 0x0c, 0xbd, 0x84, 0x59, 0x60,
+	0xdc, 0xf8, 0xff, 0x7d, 0xeb, 0xd3, 0x7e, 0x1c, 0xe1, 0x46, 0x77, 0xc0, 0xfb, 0x41, 0x9e, 0x46,
+	0x5c, 0x09, 0xe4, 0xe6, 0x69, 0xc0, 0x7f, 0x42, 0x48, 0xdd, 0x0c, 0x1d, 0xe6, 0x49, 0xe0, 0xa6,
+	0x28, 0xf3, 0x51, 0xc2, 0x60, 0x88, 0x8c, 0x6a, 0x5b, 0xeb, 0xdc, 0x74, 0xcc, 0x09, 0xfa, 0x99,
+	0x00, 0x3f, 0x82, 0xd4, 0x11, 0xd0, 0xfe, 0x04, 0xa9, 0x6f, 0x83, 0xb5, 0x8b, 0xaf, 0xef, 0x46,
+	0x30, 0x74, 0xbd, 0x88, 0xf8, 0x47, 0xd4, 0x58, 0x14, 0x95, 0xa7, 0x4f, 0x3e, 0xee, 0x13, 0x18,
+	0xf6, 0xc4, 0x89, 0xf9, 0x14, 0xd4, 0x2f, 0x55, 0x82, 0xde, 0x02, 0x6a, 0x1c, 0xb9, 0x3c, 0x07,
+	0x55, 0xd7, 0xc0, 0x9f, 0xa0, 0x38, 0x60, 0xb6, 0xc8, 0x38, 0xf9, 0x54, 0xe5, 0x98, 0x47, 0x60,
+	0x43, 0x2e, 0x65, 0xa8, 0xf4, 0x39, 0x61, 0xe8, 0xb3, 0x11, 0x43, 0x09, 0xef, 0x2e, 0xfd, 0x00,
+	0x2c, 0xca, 0x7c, 0x65, 0x11, 0xd7, 0xba, 0x5b, 0xf3, 0x0a, 0x69, 0xe6, 0xce, 0x34, 0x9b, 0xaa,
+	0xa6, 0x82, 0xc3, 0x74, 0xc1, 0xfa, 0xb5, 0xd8, 0x37, 0xcf, 0xa6, 0xf7, 0xc0, 0xaa, 0x3a, 0x8c,
+	0x11, 0xa5, 0x5c, 0xea, 0xb2, 0x68, 0xc0, 0x9b, 0xd2, 0x7a, 0x20, 0x8d, 0xe6, 0x8f, 0x65, 0xd0,
+	0x98, 0x26, 0xed, 0x67, 0x24, 0x25, 0x14, 0x46, 0x7a, 0x03, 0xdc, 0x60, 0x98, 0x45, 0x85, 0x44,
+	0x72, 0xa3, 0xb7, 0x41, 0x2d, 0x40, 0xd4, 0xcf, 0x70, 0xca, 0x4b, 0x5c, 0x50, 0x2e, 0x3b, 0xd3,
+	0x26, 0x7d, 0x1f, 0xdc, 0xa6, 0xb9, 0xf7, 0x02, 0xf9, 0xcc, 0xbd, 0x08, 0x8e, 0xf7, 0xfe, 0x72,
+	0x6f, 0xf3, 0xfc, 0xa4, 0x65, 0x8c, 0x61, 0x1c, 0xed, 0x9a, 0x57, 0x20, 0xa6, 0x53, 0x57, 0xb6,
+	0xbd, 0x22, 0x83, 0x2f, 0x41, 0x83, 0xe6, 0x1e, 0x65, 0x98, 0xe5, 0x0c, 0x4d, 0x91, 0x55, 0x04,
+	0x59, 0xeb, 0xfc, 0xa4, 0x75, 0x6f, 0x42, 0x76, 0x05, 0x65, 0x3a, 0xfa, 0x85, 0xb9, 0xa0, 0xdc,
+	0xbd, 0xcf, 0xa7, 0xde, 0xef, 0xbf, 0x6d, 0x6d, 0xa8, 0xfa, 0x0f, 0xc9, 0x70, 0xaa, 0xfc, 0x13,
+	0x86, 0x12, 0x66, 0x68, 0xe6, 0x2f, 0x65, 0x50, 0x7f, 0x26, 0x1f, 0xdc, 0xff, 0x2c, 0xc7, 0x47,
+	0xa0, 0x92, 0x46, 0x30, 0x11, 0x0a, 0xd4, 0xba, 0x9b, 0x45, 0xe3, 0x15, 0xef, 0x79, 0xe1, 0xbc,
+	0x1f, 0xc1, 0x44, 0x7d, 0x7b, 0x81, 0xd7, 0x5f, 0x80, 0x35, 0x85, 0x29, 0xa6, 0xa2, 0x7a, 0xd6,
+	0x2a, 0xd7, 0x3f, 0x6b, 0xbd, 0xf6, 0xf9, 0x49, 0x6b, 0x53, 0x6a, 0x32, 0xf7, 0xb2, 0xe9, 0xdc,
+	0x29, 0xec, 0x53, 0x2f, 0xfd, 0xee, 0x83, 0xe2, 0x2d, 0xf8, 0xfb, 0xb8, 0xa5, 0xbd, 0x55, 0x1d,
+	0x06, 0x1a, 0xf3, 0xe6, 0xe0, 0xdb, 0x3b, 0x6b, 0x1d, 0x2c, 0xf1, 0x79, 0x23, 0x86, 0xac, 0x6c,
+	0xab, 0x45, 0xbe, 0xe7, 0x77, 0xdb, 0x60, 0x85, 0x8f, 0x87, 0x14, 0x65, 0xae, 0x37, 0x66, 0x48,
+	0xa8, 0x55, 0x71, 0x40, 0x08, 0x69, 0x1f, 0x65, 0xbd, 0x31, 0x43, 0x3d, 0xe7, 0xd5, 0x69, 0x53,
+	0x7b, 0x7d, 0xda, 0xd4, 0xfe, 0x3a, 0x6d, 0x6a, 0x3f, 0x9c, 0x35, 0x4b, 0xaf, 0xcf, 0x9a, 0xa5,
+	0x3f, 0xce, 0x9a, 0xa5, 0x6f, 0x76, 0xae, 0x4e, 0x2a, 0xec, 0xf9, 0x5b, 0x21, 0xb1, 0x87, 0x3b,
+	0x76, 0x4c, 0x82, 0x3c, 0x42, 0x54, 0xfe, 0x4f, 0x7b, 0xd8, 0xdd, 0x52, 0x7f, 0xd5, 0xc4, 0xfc,
+	0xf2, 0xaa, 0x42, 0xbc, 0x0f, 0xff, 0x19, 0x00, 0x5a, 0x98, 0xd4, 0x8b, 0xca, 0x09, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -651,39 +1037,7 @@ func (m *Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedClients) > 0 {
-		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedClients[iNdEx])
-			copy(dAtA[i:], m.AllowedClients[iNdEx])
-			i = encodeVarintClient(dAtA, i, uint64(len(m.AllowedClients[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
+func (m *ClientCreationMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -693,48 +1047,39 @@ func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClientUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClientCreationMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClientUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClientCreationMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubstituteClientId) > 0 {
-		i -= len(m.SubstituteClientId)
-		copy(dAtA[i:], m.SubstituteClientId)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.SubstituteClientId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SubjectClientId) > 0 {
-		i -= len(m.SubjectClientId)
-		copy(dAtA[i:], m.SubjectClientId)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.SubjectClientId)))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.TxHash)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
+	if m.CreationHeight != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.CreationHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -744,20 +1089,278 @@ func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpgradedClientState != nil {
-		{
-			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	if m.MaxClientLagBlocks != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MaxClientLagBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DuplicateUpdateGasRefundPercentage != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.DuplicateUpdateGasRefundPercentage))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MisbehaviourReward) > 0 {
+		for iNdEx := len(m.MisbehaviourReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MisbehaviourReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MisbehaviourBond) > 0 {
+		for iNdEx := len(m.MisbehaviourBond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MisbehaviourBond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProofVerificationGas) > 0 {
+		for iNdEx := len(m.ProofVerificationGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofVerificationGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxClients) > 0 {
+		for iNdEx := len(m.MaxClients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxClients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedClients) > 0 {
+		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedClients[iNdEx])
+			copy(dAtA[i:], m.AllowedClients[iNdEx])
+			i = encodeVarintClient(dAtA, i, uint64(len(m.AllowedClients[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientTypeLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientTypeLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientTypeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxClients != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MaxClients))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdatesVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdatesVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdatesVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteExtensionClientUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtensionClientUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtensionClientUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientMessage) > 0 {
+		i -= len(m.ClientMessage)
+		copy(dAtA[i:], m.ClientMessage)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubstituteClientId) > 0 {
+		i -= len(m.SubstituteClientId)
+		copy(dAtA[i:], m.SubstituteClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.SubstituteClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SubjectClientId) > 0 {
+		i -= len(m.SubjectClientId)
+		copy(dAtA[i:], m.SubjectClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.SubjectClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradedClientState != nil {
+		{
+			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -793,6 +1396,46 @@ func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProofVerificationGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofVerificationGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofVerificationGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPerByte != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.GasPerByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseGas != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.BaseGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClient(v)
 	base := offset
@@ -870,19 +1513,117 @@ func (m *Height) Size() (n int) {
 	return n
 }
 
-func (m *Params) Size() (n int) {
+func (m *ClientCreationMetadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AllowedClients) > 0 {
-		for _, s := range m.AllowedClients {
-			l = len(s)
-			n += 1 + l + sovClient(uint64(l))
-		}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
 	}
-	return n
+	if m.CreationHeight != 0 {
+		n += 1 + sovClient(uint64(m.CreationHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedClients) > 0 {
+		for _, s := range m.AllowedClients {
+			l = len(s)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.MaxClients) > 0 {
+		for _, e := range m.MaxClients {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.ProofVerificationGas) > 0 {
+		for _, e := range m.ProofVerificationGas {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.MisbehaviourBond) > 0 {
+		for _, e := range m.MisbehaviourBond {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.MisbehaviourReward) > 0 {
+		for _, e := range m.MisbehaviourReward {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if m.DuplicateUpdateGasRefundPercentage != 0 {
+		n += 1 + sovClient(uint64(m.DuplicateUpdateGasRefundPercentage))
+	}
+	if m.MaxClientLagBlocks != 0 {
+		n += 1 + sovClient(uint64(m.MaxClientLagBlocks))
+	}
+	return n
+}
+
+func (m *ClientTypeLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.MaxClients != 0 {
+		n += 1 + sovClient(uint64(m.MaxClients))
+	}
+	return n
+}
+
+func (m *ClientUpdatesVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoteExtensionClientUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.ClientMessage)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
 }
 
 func (m *ClientUpdateProposal) Size() (n int) {
@@ -907,39 +1648,499 @@ func (m *ClientUpdateProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClient(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *UpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovClient(uint64(l))
+	if m.UpgradedClientState != nil {
+		l = m.UpgradedClientState.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofVerificationGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.BaseGas != 0 {
+		n += 1 + sovClient(uint64(m.BaseGas))
+	}
+	if m.GasPerByte != 0 {
+		n += 1 + sovClient(uint64(m.GasPerByte))
+	}
+	return n
+}
+
+func sovClient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClient(x uint64) (n int) {
+	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusStateWithHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusStateWithHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientConsensusStates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *UpgradeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	l = m.Plan.Size()
-	n += 1 + l + sovClient(uint64(l))
-	if m.UpgradedClientState != nil {
-		l = m.UpgradedClientState.Size()
-		n += 1 + l + sovClient(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *Height) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Height: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovClient(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozClient(x uint64) (n int) {
-	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
+func (m *ClientCreationMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,15 +2163,15 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedClientState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientCreationMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientCreationMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -998,13 +2199,32 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1014,26 +2234,24 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClient
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1057,7 +2275,7 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1080,15 +2298,115 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusStateWithHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusStateWithHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxClients = append(m.MaxClients, ClientTypeLimit{})
+			if err := m.MaxClients[len(m.MaxClients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofVerificationGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofVerificationGas = append(m.ProofVerificationGas, ProofVerificationGas{})
+			if err := m.ProofVerificationGas[len(m.ProofVerificationGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourBond", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1115,13 +2433,14 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MisbehaviourBond = append(m.MisbehaviourBond, types1.Coin{})
+			if err := m.MisbehaviourBond[len(m.MisbehaviourBond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviourReward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1148,13 +2467,49 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MisbehaviourReward = append(m.MisbehaviourReward, types1.Coin{})
+			if err := m.MisbehaviourReward[len(m.MisbehaviourReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateUpdateGasRefundPercentage", wireType)
+			}
+			m.DuplicateUpdateGasRefundPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DuplicateUpdateGasRefundPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClientLagBlocks", wireType)
+			}
+			m.MaxClientLagBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClientLagBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -1176,7 +2531,7 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
+func (m *ClientTypeLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1199,15 +2554,15 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientConsensusStates: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientTypeLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientTypeLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1235,13 +2590,13 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.ClientType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClients", wireType)
 			}
-			var msglen int
+			m.MaxClients = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1251,26 +2606,11 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxClients |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthClient
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthClient
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
-			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -1292,7 +2632,7 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Height) Unmarshal(dAtA []byte) error {
+func (m *ClientUpdatesVoteExtension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1315,17 +2655,17 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Height: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientUpdatesVoteExtension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Height: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientUpdatesVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
-			m.RevisionNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1335,30 +2675,26 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RevisionNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthClient
 			}
-			m.RevisionHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowClient
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RevisionHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, VoteExtensionClientUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -1380,7 +2716,7 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *VoteExtensionClientUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,15 +2739,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteExtensionClientUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteExtensionClientUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1439,7 +2775,41 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientMessage = append(m.ClientMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientMessage == nil {
+				m.ClientMessage = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1823,6 +3193,126 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProofVerificationGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofVerificationGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofVerificationGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGas", wireType)
+			}
+			m.BaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerByte", wireType)
+			}
+			m.GasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClient(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46,6 +46,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRecoverClient{},
 		&MsgIBCSoftwareUpgrade{},
 		&MsgUpdateParams{},
+		&MsgDeleteClient{},
+		&MsgSubmitBondedMisbehaviour{},
 	)
 	registry.RegisterImplementations(
 		(*govtypesv1beta1.Content)(nil),
@@ -38,4 +38,9 @@ var (
 	ErrFailedNonMembershipVerification        = errorsmod.Register(SubModuleName, 31, "non-membership verification failed")
 	ErrRouteNotFound                          = errorsmod.Register(SubModuleName, 32, "light client module route not found")
 	ErrClientTypeNotSupported                 = errorsmod.Register(SubModuleName, 33, "client type not supported")
+	ErrClientInUse                            = errorsmod.Register(SubModuleName, 34, "client is in use")
+	ErrMaxClientsReached                      = errorsmod.Register(SubModuleName, 35, "maximum number of clients reached")
+	ErrInvalidVoteExtension                   = errorsmod.Register(SubModuleName, 36, "invalid client updates vote extension")
+	ErrBondedMisbehaviourDisabled             = errorsmod.Register(SubModuleName, 37, "bonded misbehaviour submission is disabled")
+	ErrProofVerificationOutOfGas              = errorsmod.Register(SubModuleName, 38, "proof verification ran out of gas")
 )
@@ -8,14 +8,31 @@ import (
 
 // IBC client events
 const (
-	AttributeKeyClientID          = "client_id"
-	AttributeKeySubjectClientID   = "subject_client_id"
-	AttributeKeyClientType        = "client_type"
-	AttributeKeyConsensusHeight   = "consensus_height"
-	AttributeKeyConsensusHeights  = "consensus_heights"
-	AttributeKeyUpgradeStore      = "upgrade_store"
-	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
-	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyClientID           = "client_id"
+	AttributeKeySubjectClientID    = "subject_client_id"
+	AttributeKeyClientType         = "client_type"
+	AttributeKeyConsensusHeight    = "consensus_height"
+	AttributeKeyConsensusHeights   = "consensus_heights"
+	AttributeKeyUpgradeStore       = "upgrade_store"
+	AttributeKeyUpgradePlanHeight  = "upgrade_plan_height"
+	AttributeKeyUpgradePlanTitle   = "title"
+	AttributeKeyEvidenceType       = "evidence_type"
+	AttributeKeySubmitter          = "submitter"
+	AttributeKeyFrozen             = "frozen"
+	AttributeKeyBond               = "bond"
+	AttributeKeyReward             = "reward"
+	AttributeKeyLastProgressHeight = "last_progress_height"
+	AttributeKeyLagBlocks          = "lag_blocks"
+)
+
+// Misbehaviour evidence types reported in the client frozen event
+const (
+	// EvidenceTypeDuplicateHeight indicates two conflicting headers were submitted for the same height.
+	EvidenceTypeDuplicateHeight = "duplicate_height"
+	// EvidenceTypeTimeMonotonicity indicates headers were submitted which violate monotonic time ordering (BFT time violation).
+	EvidenceTypeTimeMonotonicity = "time_monotonicity"
+	// EvidenceTypeUnknown is used when the light client module does not classify the detected misbehaviour.
+	EvidenceTypeUnknown = "unknown"
 )
 
 // IBC client events vars
@@ -24,9 +41,13 @@ var (
 	EventTypeUpdateClient               = "update_client"
 	EventTypeUpgradeClient              = "upgrade_client"
 	EventTypeSubmitMisbehaviour         = "client_misbehaviour"
+	EventTypeClientFrozen               = "client_frozen"
+	EventTypeSubmitBondedMisbehaviour   = "submit_bonded_misbehaviour"
 	EventTypeRecoverClient              = "recover_client"
+	EventTypeDeleteClient               = "delete_client"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypeClientLagging              = "client_lagging"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
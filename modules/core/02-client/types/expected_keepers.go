@@ -27,6 +27,14 @@ type UpgradeKeeper interface {
 	ScheduleUpgrade(ctx context.Context, plan upgradetypes.Plan) error
 }
 
+// BankKeeper defines the expected bank keeper used to escrow and pay out the bonds and rewards of
+// bonded misbehaviour submissions.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
 // ParamSubspace defines the expected Subspace interface for module parameters.
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -198,6 +199,42 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	clientCreators := make(map[string]bool)
+	for i, clientCreator := range gs.ClientCreators {
+		// check that the creator is for a client in the genesis clients list
+		if _, ok := validClients[clientCreator.ClientId]; !ok {
+			return fmt.Errorf("client creator in genesis has a client id %s that does not map to a genesis client", clientCreator.ClientId)
+		}
+
+		if clientCreators[clientCreator.ClientId] {
+			return fmt.Errorf("duplicate client creator for client id %s", clientCreator.ClientId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(clientCreator.Creator); err != nil {
+			return fmt.Errorf("invalid client creator address %s clientID %s index %d: %w", clientCreator.Creator, clientCreator.ClientId, i, err)
+		}
+
+		clientCreators[clientCreator.ClientId] = true
+	}
+
+	clientsCreationMetadata := make(map[string]bool)
+	for _, clientMetadata := range gs.ClientsCreationMetadata {
+		// check that the creation metadata is for a client in the genesis clients list
+		if _, ok := validClients[clientMetadata.ClientId]; !ok {
+			return fmt.Errorf("creation metadata in genesis has a client id %s that does not map to a genesis client", clientMetadata.ClientId)
+		}
+
+		if clientsCreationMetadata[clientMetadata.ClientId] {
+			return fmt.Errorf("duplicate creation metadata for client id %s", clientMetadata.ClientId)
+		}
+
+		if clientMetadata.CreationMetadata.Creator != "" {
+			return fmt.Errorf("creation metadata for client id %s must not contain the creator, it is recorded in the client creators", clientMetadata.ClientId)
+		}
+
+		clientsCreationMetadata[clientMetadata.ClientId] = true
+	}
+
 	if maxSequence != 0 && maxSequence >= gs.NextClientSequence {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
@@ -205,6 +242,22 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// NewClientCreator creates a new ClientCreator instance.
+func NewClientCreator(clientID, creator string) ClientCreator {
+	return ClientCreator{
+		ClientId: clientID,
+		Creator:  creator,
+	}
+}
+
+// NewIdentifiedClientCreationMetadata creates a new IdentifiedClientCreationMetadata instance.
+func NewIdentifiedClientCreationMetadata(clientID string, metadata ClientCreationMetadata) IdentifiedClientCreationMetadata {
+	return IdentifiedClientCreationMetadata{
+		ClientId:         clientID,
+		CreationMetadata: metadata,
+	}
+}
+
 // NewGenesisMetadata is a constructor for GenesisMetadata
 func NewGenesisMetadata(key, val []byte) GenesisMetadata {
 	return GenesisMetadata{
@@ -37,6 +37,10 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty"` // Deprecated: Do not use.
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty"`
+	// the addresses of the accounts which created the clients
+	ClientCreators []ClientCreator `protobuf:"bytes,7,rep,name=client_creators,json=clientCreators,proto3" json:"client_creators"`
+	// the metadata recorded on the creation of the clients
+	ClientsCreationMetadata []IdentifiedClientCreationMetadata `protobuf:"bytes,8,rep,name=clients_creation_metadata,json=clientsCreationMetadata,proto3" json:"clients_creation_metadata"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +119,129 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetClientCreators() []ClientCreator {
+	if m != nil {
+		return m.ClientCreators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClientsCreationMetadata() []IdentifiedClientCreationMetadata {
+	if m != nil {
+		return m.ClientsCreationMetadata
+	}
+	return nil
+}
+
+// ClientCreator defines the address of the account which created the client
+// with the corresponding client id.
+type ClientCreator struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Creator  string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *ClientCreator) Reset()         { *m = ClientCreator{} }
+func (m *ClientCreator) String() string { return proto.CompactTextString(m) }
+func (*ClientCreator) ProtoMessage()    {}
+func (*ClientCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{1}
+}
+func (m *ClientCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientCreator.Merge(m, src)
+}
+func (m *ClientCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientCreator proto.InternalMessageInfo
+
+func (m *ClientCreator) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientCreator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// IdentifiedClientCreationMetadata defines the metadata recorded on the creation
+// of the client with the corresponding client id. The creator of the client is
+// omitted, as it is recorded in the client creators.
+type IdentifiedClientCreationMetadata struct {
+	ClientId         string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CreationMetadata ClientCreationMetadata `protobuf:"bytes,2,opt,name=creation_metadata,json=creationMetadata,proto3" json:"creation_metadata"`
+}
+
+func (m *IdentifiedClientCreationMetadata) Reset()         { *m = IdentifiedClientCreationMetadata{} }
+func (m *IdentifiedClientCreationMetadata) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedClientCreationMetadata) ProtoMessage()    {}
+func (*IdentifiedClientCreationMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{2}
+}
+func (m *IdentifiedClientCreationMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedClientCreationMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedClientCreationMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedClientCreationMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedClientCreationMetadata.Merge(m, src)
+}
+func (m *IdentifiedClientCreationMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedClientCreationMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedClientCreationMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedClientCreationMetadata proto.InternalMessageInfo
+
+func (m *IdentifiedClientCreationMetadata) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IdentifiedClientCreationMetadata) GetCreationMetadata() ClientCreationMetadata {
+	if m != nil {
+		return m.CreationMetadata
+	}
+	return ClientCreationMetadata{}
+}
+
 // GenesisMetadata defines the genesis type for metadata that will be used
 // to export all client store keys that are not client or consensus states.
 type GenesisMetadata struct {
@@ -128,7 +255,7 @@ func (m *GenesisMetadata) Reset()         { *m = GenesisMetadata{} }
 func (m *GenesisMetadata) String() string { return proto.CompactTextString(m) }
 func (*GenesisMetadata) ProtoMessage()    {}
 func (*GenesisMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcd0c0f1f2e6a91a, []int{1}
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{3}
 }
 func (m *GenesisMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +295,7 @@ func (m *IdentifiedGenesisMetadata) Reset()         { *m = IdentifiedGenesisMeta
 func (m *IdentifiedGenesisMetadata) String() string { return proto.CompactTextString(m) }
 func (*IdentifiedGenesisMetadata) ProtoMessage()    {}
 func (*IdentifiedGenesisMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcd0c0f1f2e6a91a, []int{2}
+	return fileDescriptor_bcd0c0f1f2e6a91a, []int{4}
 }
 func (m *IdentifiedGenesisMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,6 +340,8 @@ func (m *IdentifiedGenesisMetadata) GetClientMetadata() []GenesisMetadata {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.client.v1.GenesisState")
+	proto.RegisterType((*ClientCreator)(nil), "ibc.core.client.v1.ClientCreator")
+	proto.RegisterType((*IdentifiedClientCreationMetadata)(nil), "ibc.core.client.v1.IdentifiedClientCreationMetadata")
 	proto.RegisterType((*GenesisMetadata)(nil), "ibc.core.client.v1.GenesisMetadata")
 	proto.RegisterType((*IdentifiedGenesisMetadata)(nil), "ibc.core.client.v1.IdentifiedGenesisMetadata")
 }
@@ -220,37 +349,44 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x69, 0x68, 0xaf, 0x15, 0x09, 0xa7, 0x08, 0x99, 0x20, 0x39, 0x56, 0x58, 0xcc,
-	0x10, 0xbb, 0x0d, 0x4b, 0xc4, 0x82, 0x94, 0x0e, 0xa8, 0x12, 0x48, 0xc8, 0x6c, 0x0c, 0x58, 0xf6,
-	0xf9, 0xc3, 0xb5, 0xb0, 0x7d, 0x21, 0x77, 0xb6, 0xe8, 0x3f, 0x60, 0x60, 0xe0, 0x27, 0x30, 0xf3,
-	0x4b, 0x3a, 0x76, 0x64, 0x02, 0x94, 0x48, 0xfc, 0x0e, 0xe4, 0xbb, 0x33, 0x95, 0x82, 0xc3, 0xf6,
-	0xe5, 0xbd, 0xf7, 0xbd, 0x97, 0x7b, 0xd6, 0x87, 0xed, 0x34, 0xa2, 0x1e, 0x65, 0x6b, 0xf0, 0x68,
-	0x96, 0x42, 0x21, 0xbc, 0xea, 0xcc, 0x4b, 0xa0, 0x00, 0x9e, 0x72, 0x77, 0xb5, 0x66, 0x82, 0x11,
-	0x92, 0x46, 0xd4, 0xad, 0x15, 0xae, 0x52, 0xb8, 0xd5, 0xd9, 0x78, 0xd2, 0xb2, 0xa5, 0x59, 0xb9,
-	0x34, 0x1e, 0x25, 0x2c, 0x61, 0x72, 0xf4, 0xea, 0x49, 0xa1, 0xd3, 0xdf, 0x5d, 0x7c, 0xf2, 0x5c,
-	0x99, 0xbf, 0x16, 0xa1, 0x00, 0x42, 0xf1, 0x1d, 0xb5, 0xc6, 0x4d, 0x64, 0x77, 0x9d, 0xe3, 0xf9,
-	0x63, 0xf7, 0xdf, 0x34, 0xf7, 0x22, 0x86, 0x42, 0xa4, 0xef, 0x52, 0x88, 0xcf, 0x25, 0x26, 0x77,
-	0x97, 0xd6, 0xf5, 0x8f, 0x89, 0xf1, 0xed, 0xe7, 0xe4, 0x7e, 0x2b, 0xcd, 0xfd, 0xc6, 0x99, 0x54,
-	0xf8, 0x9e, 0x1e, 0x03, 0xca, 0x0a, 0x0e, 0x05, 0x2f, 0xb9, 0xd9, 0xd9, 0x1f, 0xa7, 0x5c, 0xce,
-	0x1b, 0xa9, 0xb2, 0xbb, 0x8d, 0x53, 0x34, 0xdf, 0xe1, 0xfd, 0x21, 0xdd, 0xc1, 0xc9, 0x5b, 0xdc,
-	0x60, 0x41, 0x0e, 0x22, 0x8c, 0x43, 0x11, 0x9a, 0x5d, 0x19, 0x3b, 0xfb, 0xff, 0x2b, 0x75, 0x45,
-	0x2f, 0xf5, 0xd2, 0xb2, 0x57, 0x47, 0xfb, 0x03, 0x6d, 0xd6, 0xc0, 0x64, 0x81, 0xfb, 0xab, 0x70,
-	0x1d, 0xe6, 0xdc, 0xec, 0xd9, 0xc8, 0x39, 0x9e, 0x8f, 0xdb, 0x5c, 0x5f, 0x49, 0x85, 0xb6, 0xd0,
-	0x7a, 0x32, 0xc3, 0x43, 0xba, 0x86, 0x50, 0x40, 0x90, 0x31, 0x1a, 0x66, 0x97, 0x8c, 0x0b, 0xf3,
-	0xc0, 0x46, 0xce, 0xe1, 0xb2, 0x63, 0x22, 0x7f, 0xa0, 0xb8, 0x17, 0x0d, 0x45, 0x4e, 0xf1, 0xa8,
-	0x80, 0x8f, 0x22, 0x50, 0xae, 0x01, 0x87, 0x0f, 0x25, 0x14, 0x14, 0xcc, 0xbe, 0x8d, 0x9c, 0x9e,
-	0x4f, 0x6a, 0x4e, 0x37, 0xaf, 0x99, 0xe9, 0x33, 0x3c, 0xd8, 0x79, 0x04, 0x19, 0xe2, 0xee, 0x7b,
-	0xb8, 0x32, 0x91, 0x8d, 0x9c, 0x13, 0xbf, 0x1e, 0xc9, 0x08, 0x1f, 0x54, 0x61, 0x56, 0x82, 0xd9,
-	0x91, 0x98, 0xfa, 0xf1, 0xb4, 0xf7, 0xe9, 0xeb, 0xc4, 0x98, 0x7e, 0x46, 0xf8, 0xc1, 0xde, 0x42,
-	0xc8, 0x43, 0x7c, 0xa4, 0xff, 0x4b, 0x1a, 0x4b, 0xc7, 0x23, 0xff, 0x50, 0x01, 0x17, 0x31, 0xf1,
-	0xb1, 0x6e, 0xea, 0xb6, 0x75, 0xf5, 0xb1, 0x1f, 0xb5, 0xf5, 0xd3, 0xde, 0xf5, 0x5d, 0x25, 0xf8,
-	0x8b, 0xfa, 0xd7, 0x1b, 0x0b, 0xdd, 0x6c, 0x2c, 0xf4, 0x6b, 0x63, 0xa1, 0x2f, 0x5b, 0xcb, 0xb8,
-	0xd9, 0x5a, 0xc6, 0xf7, 0xad, 0x65, 0xbc, 0x59, 0x24, 0xa9, 0xb8, 0x2c, 0x23, 0x97, 0xb2, 0xdc,
-	0xa3, 0x8c, 0xe7, 0x8c, 0x7b, 0x69, 0x44, 0x67, 0x09, 0xf3, 0xaa, 0x85, 0x97, 0xb3, 0xb8, 0xcc,
-	0x80, 0xab, 0x4b, 0x39, 0x9d, 0xcf, 0xf4, 0xb1, 0x88, 0xab, 0x15, 0xf0, 0xa8, 0x2f, 0x6f, 0xe2,
-	0xc9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x83, 0x8e, 0x3a, 0x82, 0x03, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xaf, 0xfb, 0x7f, 0x5e, 0xa1, 0x9d, 0x55, 0x81, 0x57, 0xa4, 0x34, 0x94, 0x4b, 0x41, 0x6a,
+	0xb2, 0x15, 0x0e, 0x15, 0x17, 0xa4, 0x56, 0x02, 0x4d, 0x02, 0x69, 0x0a, 0x37, 0x24, 0x88, 0x52,
+	0xc7, 0x74, 0x11, 0x6d, 0x5c, 0x6a, 0xb7, 0x62, 0xdf, 0x80, 0x03, 0x07, 0x3e, 0x01, 0xe2, 0xcc,
+	0x89, 0x8f, 0xb1, 0xe3, 0x8e, 0x9c, 0x00, 0xb5, 0x5f, 0x04, 0xc5, 0x76, 0xba, 0x91, 0x66, 0xdd,
+	0x6e, 0xce, 0xef, 0xf7, 0xde, 0xef, 0xf9, 0xf7, 0xde, 0x8b, 0xa1, 0x19, 0x0c, 0x89, 0x4d, 0xd8,
+	0x8c, 0xda, 0x64, 0x1c, 0xd0, 0x50, 0xd8, 0x8b, 0x43, 0x7b, 0x44, 0x43, 0xca, 0x03, 0x6e, 0x4d,
+	0x67, 0x4c, 0x30, 0x84, 0x82, 0x21, 0xb1, 0xa2, 0x08, 0x4b, 0x45, 0x58, 0x8b, 0xc3, 0x46, 0x33,
+	0x25, 0x4b, 0xb3, 0x32, 0xa9, 0x51, 0x1f, 0xb1, 0x11, 0x93, 0x47, 0x3b, 0x3a, 0x29, 0xb4, 0xf5,
+	0xb3, 0x00, 0x2b, 0x2f, 0x94, 0xf8, 0x6b, 0xe1, 0x09, 0x8a, 0x08, 0x2c, 0xa9, 0x34, 0x8e, 0x81,
+	0x99, 0x6b, 0xef, 0x76, 0x1f, 0x5a, 0x9b, 0xd5, 0xac, 0x23, 0x9f, 0x86, 0x22, 0x78, 0x1f, 0x50,
+	0x7f, 0x20, 0x31, 0x99, 0xdb, 0x37, 0xce, 0x7e, 0x37, 0x33, 0x3f, 0xfe, 0x34, 0xef, 0xa4, 0xd2,
+	0xdc, 0x89, 0x95, 0xd1, 0x02, 0xee, 0xe9, 0xa3, 0x4b, 0x58, 0xc8, 0x69, 0xc8, 0xe7, 0x1c, 0x67,
+	0xaf, 0x2e, 0xa7, 0x54, 0x06, 0x71, 0xa8, 0x92, 0xbb, 0x28, 0xa7, 0x68, 0x9e, 0xe0, 0x9d, 0x1a,
+	0x49, 0xe0, 0xe8, 0x1d, 0x8c, 0x31, 0x77, 0x42, 0x85, 0xe7, 0x7b, 0xc2, 0xc3, 0x39, 0x59, 0xb6,
+	0xb3, 0xdd, 0xa5, 0x6e, 0xd1, 0x2b, 0x9d, 0xd4, 0xcf, 0x47, 0xa5, 0x9d, 0xaa, 0x16, 0x8b, 0x61,
+	0xd4, 0x83, 0xc5, 0xa9, 0x37, 0xf3, 0x26, 0x1c, 0xe7, 0x4d, 0xd0, 0xde, 0xed, 0x36, 0xd2, 0x54,
+	0x8f, 0x65, 0x84, 0x96, 0xd0, 0xf1, 0xa8, 0x03, 0x6b, 0x64, 0x46, 0x3d, 0x41, 0xdd, 0x31, 0x23,
+	0xde, 0xf8, 0x84, 0x71, 0x81, 0x0b, 0x26, 0x68, 0x97, 0xfb, 0x59, 0x0c, 0x9c, 0xaa, 0xe2, 0x5e,
+	0xc6, 0x14, 0x3a, 0x80, 0xf5, 0x90, 0x7e, 0x12, 0xae, 0x52, 0x75, 0x39, 0xfd, 0x38, 0xa7, 0x21,
+	0xa1, 0xb8, 0x68, 0x82, 0x76, 0xde, 0x41, 0x11, 0xa7, 0x3b, 0xaf, 0x19, 0x74, 0x0c, 0xf5, 0x6d,
+	0x5d, 0xa9, 0xc5, 0x66, 0x1c, 0x97, 0xa4, 0xf3, 0xfb, 0x5b, 0x1a, 0xae, 0x22, 0xf5, 0x55, 0x6f,
+	0x93, 0xcb, 0x60, 0x34, 0xc4, 0xfd, 0xf5, 0x10, 0x23, 0x2c, 0x60, 0xe1, 0x45, 0x57, 0xcb, 0x52,
+	0xfb, 0xc9, 0x4d, 0x76, 0x67, 0xa0, 0x93, 0x13, 0xcd, 0xbd, 0x1b, 0x4f, 0x2f, 0x41, 0xb7, 0x9e,
+	0xc3, 0x5b, 0xff, 0x5d, 0x0f, 0xdd, 0x83, 0x3b, 0xda, 0x5a, 0xe0, 0x63, 0x60, 0x82, 0xf6, 0x8e,
+	0x53, 0x56, 0xc0, 0x91, 0x8f, 0x30, 0x2c, 0x69, 0xc3, 0x38, 0x2b, 0xa9, 0xf8, 0xb3, 0xf5, 0x0d,
+	0x40, 0xf3, 0xba, 0xbb, 0x6c, 0xd7, 0x7e, 0x0b, 0xf7, 0x36, 0x9d, 0x67, 0xe5, 0xe4, 0x1f, 0x5d,
+	0xd3, 0xd5, 0x4d, 0xbf, 0x35, 0x92, 0x34, 0xfa, 0x0c, 0x56, 0x13, 0x7b, 0x87, 0x6a, 0x30, 0xf7,
+	0x81, 0x9e, 0xca, 0x8b, 0x54, 0x9c, 0xe8, 0x88, 0xea, 0xb0, 0xb0, 0xf0, 0xc6, 0x73, 0x2a, 0xeb,
+	0x56, 0x1c, 0xf5, 0xf1, 0x34, 0xff, 0xf9, 0x7b, 0x33, 0xd3, 0xfa, 0x02, 0xe0, 0xfe, 0x95, 0x3b,
+	0xbc, 0xdd, 0x9a, 0xb3, 0x5e, 0x97, 0x4b, 0xc6, 0xa2, 0x91, 0x3e, 0x48, 0x33, 0x96, 0xfe, 0x7b,
+	0xe8, 0x85, 0x59, 0xa3, 0xce, 0xd9, 0xd2, 0x00, 0xe7, 0x4b, 0x03, 0xfc, 0x5d, 0x1a, 0xe0, 0xeb,
+	0xca, 0xc8, 0x9c, 0xaf, 0x8c, 0xcc, 0xaf, 0x95, 0x91, 0x79, 0xd3, 0x1b, 0x05, 0xe2, 0x64, 0x3e,
+	0xb4, 0x08, 0x9b, 0xd8, 0x84, 0xf1, 0x09, 0xe3, 0x76, 0x30, 0x24, 0x9d, 0x11, 0xb3, 0x17, 0x3d,
+	0x7b, 0xc2, 0xfc, 0xf9, 0x98, 0x72, 0xf5, 0xb8, 0x1d, 0x74, 0x3b, 0xfa, 0x7d, 0x13, 0xa7, 0x53,
+	0xca, 0x87, 0x45, 0xf9, 0x8c, 0x3d, 0xfe, 0x37, 0x00, 0xb4, 0x77, 0xf0, 0x8b, 0x35, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -273,6 +409,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientsCreationMetadata) > 0 {
+		for iNdEx := len(m.ClientsCreationMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientsCreationMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClientCreators) > 0 {
+		for iNdEx := len(m.ClientCreators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientCreators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -343,6 +507,83 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedClientCreationMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedClientCreationMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedClientCreationMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CreationMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -467,6 +708,50 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.ClientCreators) > 0 {
+		for _, e := range m.ClientCreators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClientsCreationMetadata) > 0 {
+		for _, e := range m.ClientsCreationMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *IdentifiedClientCreationMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.CreationMetadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -715,6 +1000,303 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCreators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCreators = append(m.ClientCreators, ClientCreator{})
+			if err := m.ClientCreators[len(m.ClientCreators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientsCreationMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientsCreationMetadata = append(m.ClientsCreationMetadata, IdentifiedClientCreationMetadata{})
+			if err := m.ClientsCreationMetadata[len(m.ClientsCreationMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedClientCreationMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedClientCreationMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedClientCreationMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreationMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -484,3 +484,109 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestValidateGenesisClientCreation() {
+	var genState types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no client creators",
+			func() {
+				genState.ClientCreators = nil
+			},
+			true,
+		},
+		{
+			"client creator for unknown client",
+			func() {
+				genState.ClientCreators[0].ClientId = tmClientID1
+			},
+			false,
+		},
+		{
+			"duplicate client creator",
+			func() {
+				genState.ClientCreators = append(genState.ClientCreators, genState.ClientCreators[0])
+			},
+			false,
+		},
+		{
+			"invalid client creator address",
+			func() {
+				genState.ClientCreators[0].Creator = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"success: no creation metadata",
+			func() {
+				genState.ClientsCreationMetadata = nil
+			},
+			true,
+		},
+		{
+			"creation metadata for unknown client",
+			func() {
+				genState.ClientsCreationMetadata[0].ClientId = tmClientID1
+			},
+			false,
+		},
+		{
+			"duplicate creation metadata",
+			func() {
+				genState.ClientsCreationMetadata = append(genState.ClientsCreationMetadata, genState.ClientsCreationMetadata[0])
+			},
+			false,
+		},
+		{
+			"creation metadata contains the creator",
+			func() {
+				genState.ClientsCreationMetadata[0].CreationMetadata.Creator = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			genState = types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(
+						tmClientID0, ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+					),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Tendermint),
+				false,
+				1,
+			)
+			genState.ClientCreators = []types.ClientCreator{
+				types.NewClientCreator(tmClientID0, suite.chainA.SenderAccount.GetAddress().String()),
+			}
+			genState.ClientsCreationMetadata = []types.IdentifiedClientCreationMetadata{
+				types.NewIdentifiedClientCreationMetadata(tmClientID0, types.ClientCreationMetadata{CreationHeight: 1, TxHash: []byte("tx hash")}),
+			}
+
+			tc.malleate()
+
+			err := genState.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
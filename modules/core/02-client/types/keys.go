@@ -8,6 +8,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
@@ -29,6 +31,35 @@ const (
 	// ParamsKey is the store key for the IBC client parameters
 	ParamsKey = "clientParams"
 
+	// KeyProcessedClientMessagePrefix is the transient store key prefix under which the hashes
+	// of client messages processed in the current block are stored.
+	KeyProcessedClientMessagePrefix = "processedClientMessages"
+
+	// KeyClientCreatorPrefix is the key prefix under which the address of the account which created
+	// each client is stored. It is kept outside of the client stores, which light client modules can write to.
+	KeyClientCreatorPrefix = "clientCreator"
+
+	// KeyClientCreationMetadataPrefix is the key prefix under which the metadata recorded on the creation
+	// of each client is stored. It is kept outside of the client stores, which light client modules can write to.
+	KeyClientCreationMetadataPrefix = "clientCreationMetadata"
+
+	// KeyChainIDClientsPrefix is the key prefix under which the identifiers of clients are indexed
+	// by the chain identifier of the counterparty chain they track.
+	KeyChainIDClientsPrefix = "chainIDClients"
+
+	// KeyClientCountPrefix is the key prefix under which the number of stored clients of each
+	// client type is kept.
+	KeyClientCountPrefix = "clientCount"
+
+	// KeyClientLastProgressHeightPrefix is the key prefix under which the height of this chain at which the
+	// latest height of each client last advanced is stored. It is kept outside of the client stores, which
+	// light client modules can write to.
+	KeyClientLastProgressHeightPrefix = "clientLastProgressHeight"
+
+	// KeyClientProgressPrefix is the key prefix under which the identifiers of clients are indexed
+	// by the height of this chain at which their latest height last advanced.
+	KeyClientProgressPrefix = "clientProgress"
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
@@ -40,6 +71,62 @@ func FormatClientIdentifier(clientType string, sequence uint64) string {
 	return fmt.Sprintf("%s-%d", clientType, sequence)
 }
 
+// ProcessedClientMessageKey returns the transient store key under which the hash of a client message
+// processed for the given client in the current block is stored.
+func ProcessedClientMessageKey(clientID string, clientMsgHash []byte) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%X", KeyProcessedClientMessagePrefix, clientID, clientMsgHash))
+}
+
+// ChainIDClientsPrefix returns the store key prefix under which the identifiers of all clients
+// tracking the counterparty chain with the given chain identifier are indexed.
+func ChainIDClientsPrefix(chainID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyChainIDClientsPrefix, chainID))
+}
+
+// ChainIDClientKey returns the store key under which the given client is indexed for the
+// counterparty chain with the given chain identifier.
+func ChainIDClientKey(chainID, clientID string) []byte {
+	return append(ChainIDClientsPrefix(chainID), clientID...)
+}
+
+// ClientCreatorKey returns the store key under which the address of the account which created the
+// given client is stored.
+func ClientCreatorKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientCreatorPrefix, clientID))
+}
+
+// ClientCreationMetadataKey returns the store key under which the metadata recorded on the creation of
+// the given client is stored.
+func ClientCreationMetadataKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientCreationMetadataPrefix, clientID))
+}
+
+// ClientCountKey returns the store key under which the number of stored clients of the given
+// client type is kept.
+func ClientCountKey(clientType string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientCountPrefix, clientType))
+}
+
+// ClientLastProgressHeightKey returns the store key under which the height of this chain at which the
+// latest height of the given client last advanced is stored.
+func ClientLastProgressHeightKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientLastProgressHeightPrefix, clientID))
+}
+
+// ClientProgressPrefix returns the store key prefix under which the identifiers of all clients whose
+// latest height last advanced at the given height of this chain are indexed. The height is big endian
+// encoded so that the index is iterated in ascending order of height.
+func ClientProgressPrefix(height uint64) []byte {
+	key := append([]byte(KeyClientProgressPrefix+"/"), sdk.Uint64ToBigEndian(height)...)
+	return append(key, '/')
+}
+
+// ClientProgressKey returns the store key under which the given client is indexed by the height of
+// this chain at which its latest height last advanced.
+func ClientProgressKey(height uint64, clientID string) []byte {
+	return append(ClientProgressPrefix(height), clientID...)
+}
+
 // IsClientIDFormat checks if a clientID is in the format required on the SDK for
 // parsing client identifiers. The client identifier must be in the form: `{client-type}-{N}
 // which per the specification only permits ASCII for the {client-type} segment and
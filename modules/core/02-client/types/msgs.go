@@ -20,6 +20,8 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
+	_ sdk.Msg = (*MsgDeleteClient)(nil)
+	_ sdk.Msg = (*MsgSubmitBondedMisbehaviour)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
@@ -28,12 +30,15 @@ var (
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgDeleteClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgSubmitBondedMisbehaviour)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgSubmitMisbehaviour)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpgradeClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgIBCSoftwareUpgrade)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgSubmitBondedMisbehaviour)(nil)
 )
 
 // NewMsgCreateClient creates a new MsgCreateClient instance
@@ -318,3 +323,66 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	}
 	return msg.Params.Validate()
 }
+
+// NewMsgDeleteClient creates a new MsgDeleteClient instance
+func NewMsgDeleteClient(clientID, signer string) *MsgDeleteClient {
+	return &MsgDeleteClient{
+		ClientId: clientID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgDeleteClient.
+func (msg *MsgDeleteClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.ClientId == exported.LocalhostClientID {
+		return errorsmod.Wrap(ErrInvalidClientType, "localhost client cannot be deleted")
+	}
+
+	return nil
+}
+
+// NewMsgSubmitBondedMisbehaviour creates a new MsgSubmitBondedMisbehaviour instance.
+func NewMsgSubmitBondedMisbehaviour(clientID string, misbehaviour exported.ClientMessage, signer string) (*MsgSubmitBondedMisbehaviour, error) {
+	anyMisbehaviour, err := PackClientMessage(misbehaviour)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitBondedMisbehaviour{
+		ClientId:     clientID,
+		Misbehaviour: anyMisbehaviour,
+		Signer:       signer,
+	}, nil
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgSubmitBondedMisbehaviour.
+func (msg *MsgSubmitBondedMisbehaviour) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	misbehaviour, err := UnpackClientMessage(msg.Misbehaviour)
+	if err != nil {
+		return err
+	}
+
+	if err := misbehaviour.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgSubmitBondedMisbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var misbehaviour exported.ClientMessage
+	return unpacker.UnpackAny(msg.Misbehaviour, &misbehaviour)
+}
@@ -623,6 +623,75 @@ func (suite *TypesTestSuite) TestMsgSubmitMisbehaviour_ValidateBasic() {
 	}
 }
 
+// TestMsgSubmitBondedMisbehaviourValidateBasic tests ValidateBasic for MsgSubmitBondedMisbehaviour
+func (suite *TypesTestSuite) TestMsgSubmitBondedMisbehaviourValidateBasic() {
+	var msg *types.MsgSubmitBondedMisbehaviour
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer, client identifier and misbehaviour",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: misbehaviour is not set",
+			func() {
+				msg.Misbehaviour = nil
+			},
+			ibcerrors.ErrUnpackAny,
+		},
+		{
+			"failure: invalid misbehaviour",
+			func() {
+				var err error
+				msg, err = types.NewMsgSubmitBondedMisbehaviour(ibctesting.FirstClientID, &ibctm.Misbehaviour{}, ibctesting.TestAccAddress)
+				suite.Require().NoError(err)
+			},
+			ibctm.ErrInvalidHeader,
+		},
+	}
+
+	for _, tc := range testCases {
+		height := types.NewHeight(0, uint64(suite.chainA.ProposedHeader.Height))
+		heightMinus1 := types.NewHeight(0, uint64(suite.chainA.ProposedHeader.Height)-1)
+		header1 := suite.chainA.CreateTMClientHeader(suite.chainA.ChainID, int64(height.RevisionHeight), heightMinus1, suite.chainA.ProposedHeader.Time, suite.chainA.Vals, suite.chainA.Vals, suite.chainA.Vals, suite.chainA.Signers)
+		header2 := suite.chainA.CreateTMClientHeader(suite.chainA.ChainID, int64(height.RevisionHeight), heightMinus1, suite.chainA.ProposedHeader.Time.Add(time.Minute), suite.chainA.Vals, suite.chainA.Vals, suite.chainA.Vals, suite.chainA.Signers)
+
+		var err error
+		msg, err = types.NewMsgSubmitBondedMisbehaviour(ibctesting.FirstClientID, ibctm.NewMisbehaviour(ibctesting.FirstClientID, header1, header2), ibctesting.TestAccAddress)
+		suite.Require().NoError(err)
+
+		tc.malleate()
+
+		err = msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
 // TestMsgRecoverClientValidateBasic tests ValidateBasic for MsgRecoverClient
 func (suite *TypesTestSuite) TestMsgRecoverClientValidateBasic() {
 	var msg *types.MsgRecoverClient
@@ -714,6 +783,59 @@ func TestMsgRecoverClientGetSigners(t *testing.T) {
 	}
 }
 
+// TestMsgDeleteClientValidateBasic tests ValidateBasic for MsgDeleteClient
+func (suite *TypesTestSuite) TestMsgDeleteClientValidateBasic() {
+	var msg *types.MsgDeleteClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: localhost client ID",
+			func() {
+				msg.ClientId = exported.LocalhostClientID
+			},
+			types.ErrInvalidClientType,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgDeleteClient(ibctesting.FirstClientID, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
 // TestMsgIBCSoftwareUpgrade_NewMsgIBCSoftwareUpgrade tests NewMsgIBCSoftwareUpgrade
 func (suite *TypesTestSuite) TestMsgIBCSoftwareUpgrade_NewMsgIBCSoftwareUpgrade() {
 	testCases := []struct {
@@ -2,8 +2,12 @@ package types
 
 import (
 	"fmt"
+	"math"
+	"math/bits"
 	"slices"
 	"strings"
+
+	storetypes "cosmossdk.io/store/types"
 )
 
 // Maximum length of the allowed clients list
@@ -25,9 +29,107 @@ func DefaultParams() Params {
 	return NewParams(DefaultAllowedClients...)
 }
 
+// NewClientTypeLimit creates a new ClientTypeLimit instance.
+func NewClientTypeLimit(clientType string, maxClients uint64) ClientTypeLimit {
+	return ClientTypeLimit{
+		ClientType: clientType,
+		MaxClients: maxClients,
+	}
+}
+
+// NewProofVerificationGas creates a new ProofVerificationGas instance.
+func NewProofVerificationGas(clientType string, baseGas, gasPerByte uint64) ProofVerificationGas {
+	return ProofVerificationGas{
+		ClientType: clientType,
+		BaseGas:    baseGas,
+		GasPerByte: gasPerByte,
+	}
+}
+
+// GasCost returns the gas charged for verifying a proof of the given length. The gas cost saturates at
+// math.MaxUint64 rather than overflowing.
+func (g ProofVerificationGas) GasCost(proofLength int) storetypes.Gas {
+	hi, byteGas := bits.Mul64(g.GasPerByte, uint64(proofLength))
+	if hi != 0 {
+		return math.MaxUint64
+	}
+
+	gasCost, carry := bits.Add64(g.BaseGas, byteGas, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+
+	return gasCost
+}
+
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	if err := validateMaxClients(p.MaxClients); err != nil {
+		return err
+	}
+
+	if err := validateProofVerificationGas(p.ProofVerificationGas); err != nil {
+		return err
+	}
+
+	if err := p.MisbehaviourBond.Validate(); err != nil {
+		return fmt.Errorf("invalid misbehaviour bond: %w", err)
+	}
+
+	if err := p.MisbehaviourReward.Validate(); err != nil {
+		return fmt.Errorf("invalid misbehaviour reward: %w", err)
+	}
+
+	if p.DuplicateUpdateGasRefundPercentage > 100 {
+		return fmt.Errorf("duplicate update gas refund percentage cannot exceed 100, got %d", p.DuplicateUpdateGasRefundPercentage)
+	}
+
+	return nil
+}
+
+// DuplicateUpdateGasRefund returns the gas refunded for a no-op client update which consumed the given gas.
+func (p Params) DuplicateUpdateGasRefund(gasConsumed storetypes.Gas) storetypes.Gas {
+	return gasConsumed/100*uint64(p.DuplicateUpdateGasRefundPercentage) + gasConsumed%100*uint64(p.DuplicateUpdateGasRefundPercentage)/100
+}
+
+// IsLaggingClient returns true if a client whose latest height has not advanced in the given number of blocks
+// is lagging, i.e. if the number of blocks exceeds the non-zero MaxClientLagBlocks parameter.
+func (p Params) IsLaggingClient(lagBlocks uint64) bool {
+	return p.MaxClientLagBlocks != 0 && lagBlocks > p.MaxClientLagBlocks
+}
+
+// IsBondedMisbehaviourEnabled returns true if misbehaviour can be submitted with a bond, i.e. if the
+// misbehaviour bond is not empty.
+func (p Params) IsBondedMisbehaviourEnabled() bool {
+	return !p.MisbehaviourBond.IsZero()
+}
+
+// GetClientLimit returns the maximum number of clients which can be created for the given client type.
+// It returns false if no limit is defined for the client type.
+func (p Params) GetClientLimit(clientType string) (uint64, bool) {
+	for _, limit := range p.MaxClients {
+		if limit.ClientType == clientType {
+			return limit.MaxClients, true
+		}
+	}
+
+	return 0, false
+}
+
+// GetClientProofVerificationGas returns the proof verification gas costs for the given client type.
+// It returns false if no costs are defined for the client type.
+func (p Params) GetClientProofVerificationGas(clientType string) (ProofVerificationGas, bool) {
+	for _, gas := range p.ProofVerificationGas {
+		if gas.ClientType == clientType {
+			return gas, true
+		}
+	}
+
+	return ProofVerificationGas{}, false
 }
 
 // IsAllowedClient checks if the given client type is registered on the allowlist.
@@ -70,3 +172,51 @@ func validateClients(clients []string) error {
 
 	return nil
 }
+
+// validateMaxClients checks that the given client type limits have non-blank, unique client types
+// and a non-zero maximum number of clients.
+func validateMaxClients(limits []ClientTypeLimit) error {
+	if len(limits) > MaxAllowedClientsLength {
+		return fmt.Errorf("max clients length must not exceed %d items", MaxAllowedClientsLength)
+	}
+
+	foundClients := make(map[string]bool, len(limits))
+	for i, limit := range limits {
+		if strings.TrimSpace(limit.ClientType) == "" {
+			return fmt.Errorf("client type of max clients %d cannot be blank", i)
+		}
+		if limit.MaxClients == 0 {
+			return fmt.Errorf("max clients for client type %s cannot be zero", limit.ClientType)
+		}
+		if foundClients[limit.ClientType] {
+			return fmt.Errorf("duplicate max clients for client type: %s", limit.ClientType)
+		}
+		foundClients[limit.ClientType] = true
+	}
+
+	return nil
+}
+
+// validateProofVerificationGas checks that the given proof verification gas costs have non-blank,
+// unique client types and charge a non-zero amount of gas.
+func validateProofVerificationGas(costs []ProofVerificationGas) error {
+	if len(costs) > MaxAllowedClientsLength {
+		return fmt.Errorf("proof verification gas length must not exceed %d items", MaxAllowedClientsLength)
+	}
+
+	foundClients := make(map[string]bool, len(costs))
+	for i, gas := range costs {
+		if strings.TrimSpace(gas.ClientType) == "" {
+			return fmt.Errorf("client type of proof verification gas %d cannot be blank", i)
+		}
+		if gas.BaseGas == 0 && gas.GasPerByte == 0 {
+			return fmt.Errorf("proof verification gas for client type %s cannot be zero", gas.ClientType)
+		}
+		if foundClients[gas.ClientType] {
+			return fmt.Errorf("duplicate proof verification gas for client type: %s", gas.ClientType)
+		}
+		foundClients[gas.ClientType] = true
+	}
+
+	return nil
+}
@@ -1,10 +1,15 @@
 package types
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
@@ -41,6 +46,21 @@ func TestValidateParams(t *testing.T) {
 		{"duplicate clients", NewParams(exported.Tendermint, exported.Tendermint), false},
 		{"allow all clients plus valid client", NewParams(AllowAllClients, exported.Tendermint), false},
 		{"too many allowed clients", NewParams(make([]string, MaxAllowedClientsLength+1)...), false},
+		{"custom params with max clients", Params{AllowedClients: DefaultAllowedClients, MaxClients: []ClientTypeLimit{NewClientTypeLimit(exported.Tendermint, 100)}}, true},
+		{"max clients with blank client type", Params{AllowedClients: DefaultAllowedClients, MaxClients: []ClientTypeLimit{NewClientTypeLimit(" ", 100)}}, false},
+		{"max clients with zero limit", Params{AllowedClients: DefaultAllowedClients, MaxClients: []ClientTypeLimit{NewClientTypeLimit(exported.Tendermint, 0)}}, false},
+		{"duplicate max clients", Params{AllowedClients: DefaultAllowedClients, MaxClients: []ClientTypeLimit{NewClientTypeLimit(exported.Tendermint, 100), NewClientTypeLimit(exported.Tendermint, 10)}}, false},
+		{"custom params with proof verification gas", Params{AllowedClients: DefaultAllowedClients, ProofVerificationGas: []ProofVerificationGas{NewProofVerificationGas(exported.Wasm, 50_000, 10)}}, true},
+		{"proof verification gas with only gas per byte", Params{AllowedClients: DefaultAllowedClients, ProofVerificationGas: []ProofVerificationGas{NewProofVerificationGas(exported.Wasm, 0, 10)}}, true},
+		{"proof verification gas with blank client type", Params{AllowedClients: DefaultAllowedClients, ProofVerificationGas: []ProofVerificationGas{NewProofVerificationGas(" ", 50_000, 10)}}, false},
+		{"proof verification gas with zero gas", Params{AllowedClients: DefaultAllowedClients, ProofVerificationGas: []ProofVerificationGas{NewProofVerificationGas(exported.Wasm, 0, 0)}}, false},
+		{"duplicate proof verification gas", Params{AllowedClients: DefaultAllowedClients, ProofVerificationGas: []ProofVerificationGas{NewProofVerificationGas(exported.Wasm, 50_000, 10), NewProofVerificationGas(exported.Wasm, 10, 0)}}, false},
+		{"custom params with misbehaviour bond and reward", Params{AllowedClients: DefaultAllowedClients, MisbehaviourBond: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), MisbehaviourReward: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))}, true},
+		{"invalid misbehaviour bond", Params{AllowedClients: DefaultAllowedClients, MisbehaviourBond: sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.ZeroInt()}}}, false},
+		{"invalid misbehaviour reward", Params{AllowedClients: DefaultAllowedClients, MisbehaviourReward: sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.OneInt()}}}, false},
+		{"custom params with duplicate update gas refund", Params{AllowedClients: DefaultAllowedClients, DuplicateUpdateGasRefundPercentage: 100}, true},
+		{"custom params with max client lag blocks", Params{AllowedClients: DefaultAllowedClients, MaxClientLagBlocks: 1000}, true},
+		{"duplicate update gas refund percentage exceeds 100", Params{AllowedClients: DefaultAllowedClients, DuplicateUpdateGasRefundPercentage: 101}, false},
 	}
 
 	for _, tc := range testCases {
@@ -54,3 +74,47 @@ func TestValidateParams(t *testing.T) {
 		}
 	}
 }
+
+func TestGetClientLimit(t *testing.T) {
+	params := Params{
+		AllowedClients: DefaultAllowedClients,
+		MaxClients:     []ClientTypeLimit{NewClientTypeLimit(exported.Tendermint, 100)},
+	}
+
+	maxClients, found := params.GetClientLimit(exported.Tendermint)
+	require.True(t, found)
+	require.Equal(t, uint64(100), maxClients)
+
+	_, found = params.GetClientLimit(exported.Solomachine)
+	require.False(t, found)
+}
+
+func TestGetClientProofVerificationGas(t *testing.T) {
+	params := Params{
+		AllowedClients:       DefaultAllowedClients,
+		ProofVerificationGas: []ProofVerificationGas{NewProofVerificationGas(exported.Wasm, 50_000, 10)},
+	}
+
+	gas, found := params.GetClientProofVerificationGas(exported.Wasm)
+	require.True(t, found)
+	require.Equal(t, uint64(50_000), gas.GasCost(0))
+	require.Equal(t, uint64(51_000), gas.GasCost(100))
+
+	// the gas cost saturates instead of overflowing
+	require.Equal(t, uint64(math.MaxUint64), NewProofVerificationGas(exported.Wasm, 0, math.MaxUint64).GasCost(2))
+	require.Equal(t, uint64(math.MaxUint64), NewProofVerificationGas(exported.Wasm, math.MaxUint64, 1).GasCost(1))
+	require.Equal(t, uint64(math.MaxUint64), NewProofVerificationGas(exported.Wasm, math.MaxUint64-1, 1).GasCost(1))
+
+	_, found = params.GetClientProofVerificationGas(exported.Tendermint)
+	require.False(t, found)
+}
+
+func TestIsLaggingClient(t *testing.T) {
+	params := Params{AllowedClients: DefaultAllowedClients}
+	require.False(t, params.IsLaggingClient(1_000_000))
+
+	params.MaxClientLagBlocks = 100
+	require.False(t, params.IsLaggingClient(0))
+	require.False(t, params.IsLaggingClient(100))
+	require.True(t, params.IsLaggingClient(101))
+}
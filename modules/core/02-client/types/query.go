@@ -64,3 +64,24 @@ func NewQueryConsensusStateResponse(
 func (qcsr QueryConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// NewQueryConsensusStatesWithProofsResponse creates a new QueryConsensusStatesWithProofsResponse instance.
+func NewQueryConsensusStatesWithProofsResponse(
+	consensusStates []ConsensusStateWithHeight, proofs [][]byte, height Height,
+) *QueryConsensusStatesWithProofsResponse {
+	return &QueryConsensusStatesWithProofsResponse{
+		ConsensusStates: consensusStates,
+		Proofs:          proofs,
+		ProofHeight:     height,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qcsr QueryConsensusStatesWithProofsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, cs := range qcsr.ConsensusStates {
+		if err := cs.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"sort"
+
+	"github.com/cosmos/gogoproto/grpc"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -64,3 +67,20 @@ func (rtr *Router) GetRoute(clientID string) (exported.LightClientModule, bool)
 	}
 	return rtr.routes[clientType], true
 }
+
+// RegisterQueryServices registers the gRPC query services of the light client modules added to the
+// Router which implement the LightClientModuleQueryService interface. Query services are registered
+// in order of client type.
+func (rtr *Router) RegisterQueryServices(server grpc.Server) {
+	clientTypes := make([]string, 0, len(rtr.routes))
+	for clientType := range rtr.routes {
+		clientTypes = append(clientTypes, clientType)
+	}
+	sort.Strings(clientTypes)
+
+	for _, clientType := range clientTypes {
+		if module, ok := rtr.routes[clientType].(exported.LightClientModuleQueryService); ok {
+			module.RegisterQueryService(server)
+		}
+	}
+}
@@ -8,11 +8,13 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
@@ -147,3 +149,20 @@ func (suite *TypesTestSuite) TestHasGetRoute() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestRegisterQueryServices() {
+	cdc := suite.chainA.App.AppCodec()
+
+	router := types.NewRouter(storetypes.NewKVStoreKey("store-key"))
+	tmLightClientModule := ibctm.NewLightClientModule(cdc, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	router.AddRoute(exported.Tendermint, &tmLightClientModule)
+
+	// the solo machine light client module does not register a query service
+	smLightClientModule := solomachine.NewLightClientModule(cdc)
+	router.AddRoute(exported.Solomachine, &smLightClientModule)
+
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	router.RegisterQueryServices(queryRouter)
+
+	suite.Require().NotNil(queryRouter.Route("/ibc.lightclients.tendermint.v1.Query/TrustingPeriodRemaining"))
+}
@@ -634,6 +634,177 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgDeleteClient defines an sdk.Msg to delete an IBC client which has no associated connections.
+// The signer must be the creator of the client or the authority.
+type MsgDeleteClient struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgDeleteClient) Reset()         { *m = MsgDeleteClient{} }
+func (m *MsgDeleteClient) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteClient) ProtoMessage()    {}
+func (*MsgDeleteClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{14}
+}
+func (m *MsgDeleteClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteClient.Merge(m, src)
+}
+func (m *MsgDeleteClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteClient proto.InternalMessageInfo
+
+// MsgDeleteClientResponse defines the Msg/DeleteClient response type.
+type MsgDeleteClientResponse struct {
+}
+
+func (m *MsgDeleteClientResponse) Reset()         { *m = MsgDeleteClientResponse{} }
+func (m *MsgDeleteClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteClientResponse) ProtoMessage()    {}
+func (*MsgDeleteClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{15}
+}
+func (m *MsgDeleteClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteClientResponse.Merge(m, src)
+}
+func (m *MsgDeleteClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteClientResponse proto.InternalMessageInfo
+
+// MsgSubmitBondedMisbehaviour defines an sdk.Msg type that submits evidence for light client misbehaviour
+// backed by a bond. The bond defined in the client parameters is escrowed from the signer. If the
+// misbehaviour is verified the client is frozen, the bond is returned and the misbehaviour reward is paid
+// to the signer. Otherwise the bond is slashed.
+type MsgSubmitBondedMisbehaviour struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// misbehaviour used for freezing the light client
+	Misbehaviour *types.Any `protobuf:"bytes,2,opt,name=misbehaviour,proto3" json:"misbehaviour,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSubmitBondedMisbehaviour) Reset()         { *m = MsgSubmitBondedMisbehaviour{} }
+func (m *MsgSubmitBondedMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBondedMisbehaviour) ProtoMessage()    {}
+func (*MsgSubmitBondedMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{16}
+}
+func (m *MsgSubmitBondedMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBondedMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBondedMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBondedMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBondedMisbehaviour.Merge(m, src)
+}
+func (m *MsgSubmitBondedMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBondedMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBondedMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBondedMisbehaviour proto.InternalMessageInfo
+
+// MsgSubmitBondedMisbehaviourResponse defines the Msg/SubmitBondedMisbehaviour response type.
+type MsgSubmitBondedMisbehaviourResponse struct {
+	// frozen is true if the misbehaviour was verified and the client was frozen
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *MsgSubmitBondedMisbehaviourResponse) Reset()         { *m = MsgSubmitBondedMisbehaviourResponse{} }
+func (m *MsgSubmitBondedMisbehaviourResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBondedMisbehaviourResponse) ProtoMessage()    {}
+func (*MsgSubmitBondedMisbehaviourResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{17}
+}
+func (m *MsgSubmitBondedMisbehaviourResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBondedMisbehaviourResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBondedMisbehaviourResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBondedMisbehaviourResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBondedMisbehaviourResponse.Merge(m, src)
+}
+func (m *MsgSubmitBondedMisbehaviourResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBondedMisbehaviourResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBondedMisbehaviourResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBondedMisbehaviourResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitBondedMisbehaviourResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.core.client.v1.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "ibc.core.client.v1.MsgCreateClientResponse")
@@ -649,63 +820,72 @@ func init() {
 	proto.RegisterType((*MsgIBCSoftwareUpgradeResponse)(nil), "ibc.core.client.v1.MsgIBCSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.client.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.client.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgDeleteClient)(nil), "ibc.core.client.v1.MsgDeleteClient")
+	proto.RegisterType((*MsgDeleteClientResponse)(nil), "ibc.core.client.v1.MsgDeleteClientResponse")
+	proto.RegisterType((*MsgSubmitBondedMisbehaviour)(nil), "ibc.core.client.v1.MsgSubmitBondedMisbehaviour")
+	proto.RegisterType((*MsgSubmitBondedMisbehaviourResponse)(nil), "ibc.core.client.v1.MsgSubmitBondedMisbehaviourResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0x8e, 0xd3, 0x36, 0xfa, 0xf5, 0x9a, 0x36, 0xbf, 0x1e, 0x29, 0x4d, 0x5d, 0x9a, 0x54, 0xa1,
-	0x43, 0x29, 0xd4, 0x6e, 0x8a, 0x04, 0x11, 0x88, 0xa1, 0xcd, 0x42, 0x87, 0x48, 0x95, 0x2b, 0x16,
-	0x96, 0x60, 0x3b, 0x97, 0xab, 0x51, 0xec, 0xb3, 0x7c, 0xe7, 0x40, 0x37, 0xc4, 0xc4, 0xc8, 0xc0,
-	0xc2, 0xc6, 0x9f, 0x50, 0xf1, 0x07, 0xb0, 0x21, 0x75, 0xec, 0xc8, 0x84, 0x50, 0x3b, 0x54, 0xe2,
-	0xaf, 0x40, 0xf6, 0x5d, 0x52, 0xdb, 0x89, 0x43, 0x2a, 0xb6, 0xd8, 0xef, 0x7b, 0xf7, 0xbe, 0xef,
-	0xdd, 0x7b, 0x5f, 0x0c, 0x56, 0x2d, 0xc3, 0x54, 0x4d, 0xe2, 0x21, 0xd5, 0xec, 0x5a, 0xc8, 0x61,
-	0x6a, 0xaf, 0xa6, 0xb2, 0xb7, 0x8a, 0xeb, 0x11, 0x46, 0x20, 0xb4, 0x0c, 0x53, 0x09, 0x82, 0x0a,
-	0x0f, 0x2a, 0xbd, 0x9a, 0xbc, 0x6c, 0x12, 0x6a, 0x13, 0xaa, 0xda, 0x14, 0x07, 0x58, 0x9b, 0x62,
-	0x0e, 0x96, 0x37, 0x44, 0xc0, 0x77, 0xb1, 0xa7, 0xb7, 0x91, 0xda, 0xab, 0x19, 0x88, 0xe9, 0xb5,
-	0xfe, 0xb3, 0x40, 0x15, 0x31, 0xc1, 0x24, 0xfc, 0xa9, 0x06, 0xbf, 0xc4, 0xdb, 0x15, 0x4c, 0x08,
-	0xee, 0x22, 0x35, 0x7c, 0x32, 0xfc, 0x8e, 0xaa, 0x3b, 0x27, 0x22, 0x54, 0x19, 0x41, 0x50, 0xb0,
-	0x09, 0x01, 0xd5, 0xaf, 0x12, 0x28, 0x34, 0x29, 0x6e, 0x78, 0x48, 0x67, 0xa8, 0x11, 0x46, 0xe0,
-	0x63, 0x90, 0xe7, 0x98, 0x16, 0x65, 0x3a, 0x43, 0x25, 0x69, 0x5d, 0xda, 0x9c, 0xdb, 0x2d, 0x2a,
-	0xbc, 0x8c, 0xd2, 0x2f, 0xa3, 0xec, 0x39, 0x27, 0xda, 0x1c, 0x47, 0x1e, 0x05, 0x40, 0xf8, 0x0c,
-	0x14, 0x4c, 0xe2, 0x50, 0xe4, 0x50, 0x9f, 0x8a, 0xdc, 0xec, 0x98, 0xdc, 0x85, 0x01, 0x98, 0xa7,
-	0xdf, 0x06, 0x39, 0x6a, 0x61, 0x07, 0x79, 0xa5, 0xa9, 0x75, 0x69, 0x73, 0x56, 0x13, 0x4f, 0x4f,
-	0x0a, 0x1f, 0xbe, 0x54, 0x32, 0xef, 0xaf, 0x4e, 0xb7, 0xc4, 0x8b, 0xea, 0x0a, 0x58, 0x4e, 0x70,
-	0xd6, 0x10, 0x75, 0x83, 0xc3, 0xaa, 0x9f, 0xb8, 0x9e, 0x17, 0x6e, 0xfb, 0x5a, 0xcf, 0x2a, 0x98,
-	0x15, 0x7a, 0xac, 0x76, 0x28, 0x66, 0x56, 0xfb, 0x8f, 0xbf, 0x38, 0x68, 0xc3, 0xa7, 0x60, 0x41,
-	0x04, 0x6d, 0x44, 0xa9, 0x8e, 0xc7, 0x53, 0x9e, 0xe7, 0xd8, 0x26, 0x87, 0xde, 0x94, 0x71, 0x94,
-	0xd5, 0x80, 0xf1, 0xf7, 0x2c, 0xf8, 0x3f, 0x8c, 0x85, 0x17, 0x3d, 0x09, 0xe5, 0xe4, 0xfd, 0x64,
-	0xff, 0xe1, 0x7e, 0xa6, 0x6e, 0x70, 0x3f, 0x3b, 0xa0, 0xe8, 0x7a, 0x84, 0x74, 0x5a, 0x62, 0x28,
-	0x5b, 0xfc, 0xec, 0xd2, 0xf4, 0xba, 0xb4, 0x99, 0xd7, 0x60, 0x18, 0x8b, 0xcb, 0xd8, 0x03, 0x6b,
-	0x89, 0x8c, 0x44, 0xf9, 0x99, 0x30, 0x55, 0x8e, 0xa5, 0xa6, 0x0d, 0x45, 0x6e, 0x7c, 0x8b, 0x65,
-	0x50, 0x4a, 0xb6, 0x71, 0xd0, 0xe3, 0xcf, 0x12, 0x58, 0x6a, 0x52, 0x7c, 0xe4, 0x1b, 0xb6, 0xc5,
-	0x9a, 0x16, 0x35, 0xd0, 0xb1, 0xde, 0xb3, 0x88, 0xef, 0x8d, 0x6f, 0x74, 0x1d, 0xe4, 0xed, 0x08,
-	0x78, 0x6c, 0xa3, 0x63, 0xc8, 0xd4, 0xc1, 0x58, 0x4c, 0xb0, 0x2e, 0x49, 0xd5, 0x0a, 0x58, 0x1b,
-	0x49, 0x2d, 0x4a, 0x3e, 0x18, 0x10, 0x0d, 0x99, 0xa4, 0x87, 0x3c, 0xd1, 0xd9, 0x2d, 0xb0, 0x48,
-	0x7d, 0xe3, 0x35, 0x32, 0x59, 0x2b, 0xc9, 0xbf, 0x20, 0x02, 0x8d, 0xbe, 0x8c, 0x1d, 0x50, 0xa4,
-	0xbe, 0x41, 0x99, 0xc5, 0x7c, 0x86, 0x22, 0xf0, 0x6c, 0x08, 0x87, 0xd7, 0xb1, 0x41, 0xc6, 0xc4,
-	0x73, 0xcd, 0x9b, 0x1e, 0xa3, 0x36, 0xe0, 0xfd, 0x8d, 0x37, 0xfd, 0x60, 0xbf, 0x71, 0x44, 0x3a,
-	0xec, 0x8d, 0xee, 0x21, 0x71, 0x39, 0xf0, 0x11, 0x98, 0x76, 0xbb, 0xba, 0x23, 0x8c, 0xe5, 0x8e,
-	0xc2, 0xbd, 0x4f, 0xe9, 0x7b, 0x9d, 0xf0, 0x3e, 0xe5, 0xb0, 0xab, 0x3b, 0xfb, 0xd3, 0x67, 0x3f,
-	0x2b, 0x19, 0x2d, 0xc4, 0xc3, 0xe7, 0x60, 0x49, 0x60, 0xda, 0xad, 0x89, 0x37, 0xe0, 0x56, 0x3f,
-	0xa5, 0x11, 0xd9, 0x84, 0x34, 0x81, 0x73, 0x51, 0x71, 0xfc, 0x66, 0x86, 0xf9, 0x0f, 0x14, 0xb2,
-	0x88, 0xd7, 0x1c, 0xea, 0x9e, 0x6e, 0xd3, 0xc8, 0xc1, 0x52, 0xf4, 0x60, 0x58, 0x07, 0x39, 0x37,
-	0x44, 0x08, 0xae, 0xb2, 0x32, 0xfc, 0xef, 0xa0, 0xf0, 0x33, 0x84, 0x64, 0x81, 0x1f, 0xef, 0x25,
-	0x3c, 0xa3, 0x4f, 0x68, 0xf7, 0xf7, 0x0c, 0x98, 0x6a, 0x52, 0x0c, 0x5f, 0x81, 0x7c, 0xcc, 0xd1,
-	0xef, 0x8e, 0xaa, 0x96, 0xb0, 0x50, 0xf9, 0xfe, 0x04, 0xa0, 0x7e, 0xa5, 0xa0, 0x42, 0xcc, 0x63,
-	0xd3, 0x2a, 0x44, 0x41, 0xa9, 0x15, 0x46, 0xf9, 0x22, 0x34, 0xc1, 0x7c, 0xdc, 0x4c, 0x36, 0x52,
-	0xb3, 0x23, 0x28, 0xf9, 0xc1, 0x24, 0xa8, 0x41, 0x11, 0x0f, 0xc0, 0x11, 0xa6, 0x70, 0x2f, 0xe5,
-	0x8c, 0x61, 0xa8, 0x5c, 0x9b, 0x18, 0x1a, 0x15, 0x16, 0xdf, 0xe5, 0x34, 0x61, 0x31, 0x54, 0xaa,
-	0xb0, 0x91, 0xcb, 0x17, 0x08, 0x1b, 0xb1, 0x78, 0x69, 0xc2, 0x86, 0xa1, 0xa9, 0xc2, 0xd2, 0xd7,
-	0x01, 0x76, 0x00, 0x8c, 0xde, 0xa4, 0xd8, 0x88, 0xf1, 0x93, 0xc1, 0x41, 0x7f, 0x99, 0x8c, 0xf8,
-	0x94, 0xcb, 0x33, 0xef, 0xae, 0x4e, 0xb7, 0xa4, 0x7d, 0xed, 0xec, 0xa2, 0x2c, 0x9d, 0x5f, 0x94,
-	0xa5, 0x5f, 0x17, 0x65, 0xe9, 0xe3, 0x65, 0x39, 0x73, 0x7e, 0x59, 0xce, 0xfc, 0xb8, 0x2c, 0x67,
-	0x5e, 0xd6, 0xb1, 0xc5, 0x8e, 0x7d, 0x43, 0x31, 0x89, 0xad, 0x8a, 0xef, 0x2a, 0xcb, 0x30, 0xb7,
-	0x31, 0x51, 0x7b, 0x75, 0xd5, 0x26, 0x6d, 0xbf, 0x8b, 0x28, 0xff, 0x2a, 0xda, 0xd9, 0xdd, 0x16,
-	0x1f, 0x46, 0xec, 0xc4, 0x45, 0xd4, 0xc8, 0x85, 0xd6, 0xf1, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa3, 0x68, 0xc1, 0x10, 0xd9, 0x09, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x3d, 0x6f, 0xf3, 0x54,
+	0x14, 0xc7, 0xe3, 0xb4, 0x4f, 0x78, 0x7a, 0x9b, 0xa7, 0xa1, 0x26, 0xa5, 0xa9, 0x4b, 0x93, 0x2a,
+	0xed, 0x50, 0x9a, 0xd6, 0x6e, 0x8a, 0x44, 0x23, 0x50, 0x87, 0x26, 0x0c, 0x74, 0x88, 0x54, 0xb9,
+	0x42, 0x48, 0x2c, 0xa9, 0x5f, 0x6e, 0x5c, 0xa3, 0xd8, 0xd7, 0xf2, 0xbd, 0x0e, 0x94, 0x09, 0x21,
+	0x06, 0x46, 0x06, 0x16, 0x06, 0x24, 0x3e, 0x42, 0xc5, 0x07, 0x60, 0x43, 0xea, 0x58, 0x89, 0x85,
+	0x09, 0xa1, 0x76, 0xe8, 0xd7, 0x40, 0xf1, 0xbd, 0x71, 0xaf, 0x9d, 0xd8, 0x72, 0xc5, 0xc0, 0x66,
+	0xfb, 0xfc, 0xce, 0x3d, 0xff, 0xf3, 0xe2, 0x63, 0x83, 0x4d, 0x5b, 0x37, 0x14, 0x03, 0xf9, 0x50,
+	0x31, 0x46, 0x36, 0x74, 0x89, 0x32, 0x6e, 0x2b, 0xe4, 0x6b, 0xd9, 0xf3, 0x11, 0x41, 0xa2, 0x68,
+	0xeb, 0x86, 0x3c, 0x31, 0xca, 0xd4, 0x28, 0x8f, 0xdb, 0xd2, 0xba, 0x81, 0xb0, 0x83, 0xb0, 0xe2,
+	0x60, 0x6b, 0xc2, 0x3a, 0xd8, 0xa2, 0xb0, 0xb4, 0xcb, 0x0c, 0x81, 0x67, 0xf9, 0x9a, 0x09, 0x95,
+	0x71, 0x5b, 0x87, 0x44, 0x6b, 0x4f, 0xef, 0x19, 0x55, 0xb5, 0x90, 0x85, 0xc2, 0x4b, 0x65, 0x72,
+	0xc5, 0x9e, 0x6e, 0x58, 0x08, 0x59, 0x23, 0xa8, 0x84, 0x77, 0x7a, 0x30, 0x54, 0x34, 0xf7, 0x86,
+	0x99, 0x1a, 0x73, 0x04, 0x32, 0x35, 0x21, 0xd0, 0xfc, 0x4d, 0x00, 0x95, 0x3e, 0xb6, 0x7a, 0x3e,
+	0xd4, 0x08, 0xec, 0x85, 0x16, 0xf1, 0x04, 0x94, 0x29, 0x33, 0xc0, 0x44, 0x23, 0xb0, 0x26, 0x6c,
+	0x0b, 0x7b, 0xcb, 0xc7, 0x55, 0x99, 0x86, 0x91, 0xa7, 0x61, 0xe4, 0x33, 0xf7, 0x46, 0x5d, 0xa6,
+	0xe4, 0xe5, 0x04, 0x14, 0x4f, 0x41, 0xc5, 0x40, 0x2e, 0x86, 0x2e, 0x0e, 0x30, 0xf3, 0x2d, 0x66,
+	0xf8, 0xae, 0x44, 0x30, 0x75, 0x7f, 0x17, 0x94, 0xb0, 0x6d, 0xb9, 0xd0, 0xaf, 0x2d, 0x6c, 0x0b,
+	0x7b, 0x4b, 0x2a, 0xbb, 0xfb, 0xa8, 0xf2, 0xc3, 0xaf, 0x8d, 0xc2, 0x77, 0x4f, 0xb7, 0xfb, 0xec,
+	0x41, 0x73, 0x03, 0xac, 0x27, 0x34, 0xab, 0x10, 0x7b, 0x93, 0xc3, 0x9a, 0x3f, 0xd1, 0x7c, 0x3e,
+	0xf3, 0xcc, 0xe7, 0x7c, 0x36, 0xc1, 0x12, 0xcb, 0xc7, 0x36, 0xc3, 0x64, 0x96, 0xd4, 0xd7, 0xf4,
+	0xc1, 0xb9, 0x29, 0x7e, 0x0c, 0x56, 0x98, 0xd1, 0x81, 0x18, 0x6b, 0x56, 0xb6, 0xe4, 0x37, 0x94,
+	0xed, 0x53, 0xf4, 0xa5, 0x8a, 0x79, 0x55, 0x91, 0xe2, 0x3f, 0x8a, 0xe0, 0xed, 0xd0, 0x16, 0x36,
+	0x3a, 0x8f, 0xe4, 0x64, 0x7f, 0x8a, 0xff, 0xa1, 0x3f, 0x0b, 0x2f, 0xe8, 0xcf, 0x11, 0xa8, 0x7a,
+	0x3e, 0x42, 0xc3, 0x01, 0x1b, 0xca, 0x01, 0x3d, 0xbb, 0xb6, 0xb8, 0x2d, 0xec, 0x95, 0x55, 0x31,
+	0xb4, 0xc5, 0xd3, 0x38, 0x03, 0x5b, 0x09, 0x8f, 0x44, 0xf8, 0x57, 0xa1, 0xab, 0x14, 0x73, 0x4d,
+	0x1b, 0x8a, 0x52, 0x76, 0x89, 0x25, 0x50, 0x4b, 0x96, 0x31, 0xaa, 0xf1, 0xcf, 0x02, 0x58, 0xeb,
+	0x63, 0xeb, 0x32, 0xd0, 0x1d, 0x9b, 0xf4, 0x6d, 0xac, 0xc3, 0x6b, 0x6d, 0x6c, 0xa3, 0xc0, 0xcf,
+	0x2e, 0x74, 0x07, 0x94, 0x1d, 0x0e, 0xce, 0x2c, 0x74, 0x8c, 0x4c, 0x1d, 0x8c, 0xd5, 0x84, 0xea,
+	0x9a, 0xd0, 0x6c, 0x80, 0xad, 0xb9, 0xd2, 0x78, 0xf1, 0x93, 0x01, 0x51, 0xa1, 0x81, 0xc6, 0xd0,
+	0x67, 0x95, 0xdd, 0x07, 0xab, 0x38, 0xd0, 0xbf, 0x84, 0x06, 0x19, 0x24, 0xf5, 0x57, 0x98, 0xa1,
+	0x37, 0x4d, 0xe3, 0x08, 0x54, 0x71, 0xa0, 0x63, 0x62, 0x93, 0x80, 0x40, 0x0e, 0x2f, 0x86, 0xb8,
+	0xf8, 0x6c, 0x8b, 0x3c, 0x72, 0xcf, 0x35, 0x2d, 0x7a, 0x4c, 0x5a, 0xa4, 0xfb, 0x77, 0x5a, 0xf4,
+	0xf3, 0x6e, 0xef, 0x12, 0x0d, 0xc9, 0x57, 0x9a, 0x0f, 0x59, 0x73, 0xc4, 0x0f, 0xc1, 0xa2, 0x37,
+	0xd2, 0x5c, 0xb6, 0x58, 0xde, 0x93, 0xe9, 0xee, 0x93, 0xa7, 0xbb, 0x8e, 0xed, 0x3e, 0xf9, 0x62,
+	0xa4, 0xb9, 0xdd, 0xc5, 0xbb, 0xbf, 0x1b, 0x05, 0x35, 0xe4, 0xc5, 0x4f, 0xc1, 0x1a, 0x63, 0xcc,
+	0x41, 0xee, 0x37, 0xe0, 0x9d, 0xa9, 0x4b, 0x8f, 0x7b, 0x13, 0xd2, 0x12, 0x5c, 0xe6, 0x93, 0xa3,
+	0x9d, 0x99, 0xd5, 0x1f, 0x65, 0x48, 0xb8, 0x5d, 0x73, 0xa1, 0xf9, 0x9a, 0x83, 0xb9, 0x83, 0x05,
+	0xfe, 0x60, 0xb1, 0x03, 0x4a, 0x5e, 0x48, 0x30, 0xad, 0x92, 0x3c, 0xfb, 0x75, 0x90, 0xe9, 0x19,
+	0x2c, 0x65, 0xc6, 0x67, 0xef, 0x12, 0xea, 0x11, 0x09, 0xfa, 0x3c, 0x14, 0xf4, 0x09, 0x1c, 0xc1,
+	0x7c, 0xcb, 0xef, 0x59, 0x6d, 0x31, 0xcf, 0xfe, 0xe2, 0x0f, 0x8e, 0x62, 0xfe, 0x22, 0x80, 0xcd,
+	0x68, 0x80, 0xbb, 0xc8, 0x35, 0xa1, 0xf9, 0x7f, 0xbe, 0x61, 0x33, 0xd2, 0x4f, 0xc1, 0x4e, 0x86,
+	0xbc, 0x69, 0x1a, 0x93, 0xf3, 0x86, 0x3e, 0xfa, 0x06, 0xd2, 0xa9, 0x7c, 0xad, 0xb2, 0xbb, 0xe3,
+	0x3f, 0xdf, 0x02, 0x0b, 0x7d, 0x6c, 0x89, 0x57, 0xa0, 0x1c, 0xfb, 0x48, 0xee, 0xcc, 0x6b, 0x60,
+	0xe2, 0xab, 0x24, 0xb5, 0x72, 0x40, 0x91, 0x82, 0x2b, 0x50, 0x8e, 0x7d, 0xb6, 0xd2, 0x22, 0xf0,
+	0x90, 0xd4, 0xca, 0x01, 0x45, 0x11, 0x0c, 0xf0, 0x26, 0xbe, 0x9f, 0x77, 0x53, 0xbd, 0x39, 0x4a,
+	0x3a, 0xc8, 0x43, 0x45, 0x41, 0x7c, 0x20, 0xce, 0xd9, 0xb3, 0xef, 0xa7, 0x9c, 0x31, 0x8b, 0x4a,
+	0xed, 0xdc, 0x28, 0x9f, 0x58, 0x7c, 0x3d, 0xa6, 0x25, 0x16, 0xa3, 0xa4, 0x83, 0x3c, 0x14, 0x9f,
+	0xd8, 0x9c, 0x5d, 0x96, 0x96, 0xd8, 0x2c, 0x2a, 0xb5, 0x73, 0xa3, 0x51, 0xcc, 0x21, 0x10, 0xf9,
+	0x4e, 0xb2, 0x25, 0x93, 0x3d, 0x19, 0x14, 0x92, 0x5a, 0x39, 0x20, 0x7e, 0xf6, 0x62, 0x5b, 0x23,
+	0x2d, 0x02, 0x0f, 0x49, 0xad, 0x1c, 0x50, 0x14, 0xe1, 0x7b, 0x01, 0xd4, 0x52, 0x77, 0x84, 0x92,
+	0xd9, 0xf2, 0x59, 0x07, 0xe9, 0xe4, 0x85, 0x0e, 0x53, 0x19, 0xd2, 0xab, 0x6f, 0x9f, 0x6e, 0xf7,
+	0x85, 0xae, 0x7a, 0xf7, 0x50, 0x17, 0xee, 0x1f, 0xea, 0xc2, 0x3f, 0x0f, 0x75, 0xe1, 0xc7, 0xc7,
+	0x7a, 0xe1, 0xfe, 0xb1, 0x5e, 0xf8, 0xeb, 0xb1, 0x5e, 0xf8, 0xa2, 0x63, 0xd9, 0xe4, 0x3a, 0xd0,
+	0x65, 0x03, 0x39, 0x0a, 0xfb, 0x27, 0xb7, 0x75, 0xe3, 0xd0, 0x42, 0xca, 0xb8, 0xa3, 0x38, 0xc8,
+	0x0c, 0x46, 0x10, 0xd3, 0x3f, 0xea, 0xa3, 0xe3, 0x43, 0xf6, 0x53, 0x4d, 0x6e, 0x3c, 0x88, 0xf5,
+	0x52, 0xb8, 0xad, 0x3e, 0xf8, 0x77, 0x00, 0xea, 0x5f, 0xfa, 0x41, 0x15, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -734,6 +914,10 @@ type MsgClient interface {
 	IBCSoftwareUpgrade(ctx context.Context, in *MsgIBCSoftwareUpgrade, opts ...grpc.CallOption) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 	UpdateClientParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DeleteClient defines a rpc handler method for MsgDeleteClient.
+	DeleteClient(ctx context.Context, in *MsgDeleteClient, opts ...grpc.CallOption) (*MsgDeleteClientResponse, error)
+	// SubmitBondedMisbehaviour defines a rpc handler method for MsgSubmitBondedMisbehaviour.
+	SubmitBondedMisbehaviour(ctx context.Context, in *MsgSubmitBondedMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitBondedMisbehaviourResponse, error)
 }
 
 type msgClient struct {
@@ -807,6 +991,24 @@ func (c *msgClient) UpdateClientParams(ctx context.Context, in *MsgUpdateParams,
 	return out, nil
 }
 
+func (c *msgClient) DeleteClient(ctx context.Context, in *MsgDeleteClient, opts ...grpc.CallOption) (*MsgDeleteClientResponse, error) {
+	out := new(MsgDeleteClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/DeleteClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBondedMisbehaviour(ctx context.Context, in *MsgSubmitBondedMisbehaviour, opts ...grpc.CallOption) (*MsgSubmitBondedMisbehaviourResponse, error) {
+	out := new(MsgSubmitBondedMisbehaviourResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/SubmitBondedMisbehaviour", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClient defines a rpc handler method for MsgCreateClient.
@@ -823,6 +1025,10 @@ type MsgServer interface {
 	IBCSoftwareUpgrade(context.Context, *MsgIBCSoftwareUpgrade) (*MsgIBCSoftwareUpgradeResponse, error)
 	// UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 	UpdateClientParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DeleteClient defines a rpc handler method for MsgDeleteClient.
+	DeleteClient(context.Context, *MsgDeleteClient) (*MsgDeleteClientResponse, error)
+	// SubmitBondedMisbehaviour defines a rpc handler method for MsgSubmitBondedMisbehaviour.
+	SubmitBondedMisbehaviour(context.Context, *MsgSubmitBondedMisbehaviour) (*MsgSubmitBondedMisbehaviourResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -850,6 +1056,12 @@ func (*UnimplementedMsgServer) IBCSoftwareUpgrade(ctx context.Context, req *MsgI
 func (*UnimplementedMsgServer) UpdateClientParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientParams not implemented")
 }
+func (*UnimplementedMsgServer) DeleteClient(ctx context.Context, req *MsgDeleteClient) (*MsgDeleteClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClient not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBondedMisbehaviour(ctx context.Context, req *MsgSubmitBondedMisbehaviour) (*MsgSubmitBondedMisbehaviourResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBondedMisbehaviour not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -981,6 +1193,42 @@ func _Msg_UpdateClientParams_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeleteClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/DeleteClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteClient(ctx, req.(*MsgDeleteClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBondedMisbehaviour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBondedMisbehaviour)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBondedMisbehaviour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/SubmitBondedMisbehaviour",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBondedMisbehaviour(ctx, req.(*MsgSubmitBondedMisbehaviour))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1013,6 +1261,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateClientParams",
 			Handler:    _Msg_UpdateClientParams_Handler,
 		},
+		{
+			MethodName: "DeleteClient",
+			Handler:    _Msg_DeleteClient_Handler,
+		},
+		{
+			MethodName: "SubmitBondedMisbehaviour",
+			Handler:    _Msg_SubmitBondedMisbehaviour_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/tx.proto",
@@ -1542,90 +1798,232 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDeleteClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDeleteClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ConsensusState != nil {
-		l = m.ConsensusState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ClientMessage != nil {
-		l = m.ClientMessage.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgDeleteClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDeleteClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpgradeClient) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitBondedMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBondedMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBondedMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Misbehaviour != nil {
+		{
+			size, err := m.Misbehaviour.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBondedMisbehaviourResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBondedMisbehaviourResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBondedMisbehaviourResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientMessage != nil {
+		l = m.ClientMessage.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpgradeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.ConsensusState != nil {
 		l = m.ConsensusState.Size()
@@ -1767,6 +2165,65 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDeleteClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBondedMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Misbehaviour != nil {
+		l = m.Misbehaviour.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBondedMisbehaviourResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3243,6 +3700,390 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDeleteClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBondedMisbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBondedMisbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBondedMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Misbehaviour == nil {
+				m.Misbehaviour = &types.Any{}
+			}
+			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBondedMisbehaviourResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBondedMisbehaviourResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBondedMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// MaxVoteExtensionClientUpdates is the maximum number of client updates a validator may include
+// in its vote extension.
+const MaxVoteExtensionClientUpdates = 32
+
+// ClientUpdateSource defines the source of the client updates included by a validator in its vote
+// extensions, usually a header relaying sidecar process run alongside the validator node.
+type ClientUpdateSource interface {
+	// ClientUpdates returns the client messages to be included in the vote extension for the
+	// current height.
+	ClientUpdates(ctx sdk.Context) ([]VoteExtensionClientUpdate, error)
+}
+
+// NewVoteExtensionClientUpdate creates a new VoteExtensionClientUpdate for the provided client
+// identifier and client message.
+func NewVoteExtensionClientUpdate(cdc codec.BinaryCodec, clientID string, clientMsg exported.ClientMessage) (VoteExtensionClientUpdate, error) {
+	bz, err := MarshalClientMessage(cdc, clientMsg)
+	if err != nil {
+		return VoteExtensionClientUpdate{}, err
+	}
+
+	return VoteExtensionClientUpdate{
+		ClientId:      clientID,
+		ClientMessage: bz,
+	}, nil
+}
+
+// ValidateBasic performs basic validation of the client updates included in a vote extension.
+func (ve ClientUpdatesVoteExtension) ValidateBasic() error {
+	if len(ve.Updates) > MaxVoteExtensionClientUpdates {
+		return errorsmod.Wrapf(ErrInvalidVoteExtension, "number of client updates exceeds maximum (%d > %d)", len(ve.Updates), MaxVoteExtensionClientUpdates)
+	}
+
+	for i, update := range ve.Updates {
+		if err := host.ClientIdentifierValidator(update.ClientId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidVoteExtension, "invalid client identifier at index %d: %s", i, err)
+		}
+
+		if len(update.ClientMessage) == 0 {
+			return errorsmod.Wrapf(ErrInvalidVoteExtension, "empty client message at index %d", i)
+		}
+	}
+
+	return nil
+}
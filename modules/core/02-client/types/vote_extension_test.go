@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestClientUpdatesVoteExtensionValidateBasic(t *testing.T) {
+	update := types.VoteExtensionClientUpdate{ClientId: ibctesting.FirstClientID, ClientMessage: []byte("header")}
+
+	tooManyUpdates := make([]types.VoteExtensionClientUpdate, types.MaxVoteExtensionClientUpdates+1)
+	for i := range tooManyUpdates {
+		tooManyUpdates[i] = update
+	}
+
+	testCases := []struct {
+		name          string
+		voteExtension types.ClientUpdatesVoteExtension
+		expErr        error
+	}{
+		{"success", types.ClientUpdatesVoteExtension{Updates: []types.VoteExtensionClientUpdate{update}}, nil},
+		{"success: no updates", types.ClientUpdatesVoteExtension{}, nil},
+		{"too many updates", types.ClientUpdatesVoteExtension{Updates: tooManyUpdates}, types.ErrInvalidVoteExtension},
+		{"invalid client identifier", types.ClientUpdatesVoteExtension{Updates: []types.VoteExtensionClientUpdate{{ClientId: "", ClientMessage: []byte("header")}}}, types.ErrInvalidVoteExtension},
+		{"empty client message", types.ClientUpdatesVoteExtension{Updates: []types.VoteExtensionClientUpdate{{ClientId: ibctesting.FirstClientID}}}, types.ErrInvalidVoteExtension},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.voteExtension.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package client
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/logging"
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// header is implemented by client messages which update a client to a new height, such as 07-tendermint headers.
+// Other client messages, such as misbehaviour, are not accepted as client updates included in vote extensions.
+type header interface {
+	exported.ClientMessage
+	GetHeight() exported.Height
+}
+
+// VoteExtensionHandler provides the ABCI handlers which allow validators to include IBC client updates
+// in their vote extensions. The proposer injects the extended commit info of the previous height as the
+// first transaction of its proposal, and client messages included in the vote extensions of more than 2/3
+// of the voting power are applied to their clients in PreBlock. The client messages are verified by the light
+// client like any other client update, so that a faulty or compromised client update source shared by the
+// validators cannot install arbitrary consensus states. Only headers are accepted and the client is never frozen
+// by a client update included in the vote extensions.
+//
+// The injected transaction is stripped from the transactions executed by the application in PreBlock and
+// reported as successful by the FinalizeBlock wrapper.
+type VoteExtensionHandler struct {
+	cdc      codec.BinaryCodec
+	keeper   *keeper.Keeper
+	valStore baseapp.ValidatorStore
+	source   types.ClientUpdateSource
+}
+
+// NewVoteExtensionHandler creates a new VoteExtensionHandler. The validator store is used to verify the
+// signatures of the injected vote extensions and the source provides the client updates included in the
+// vote extensions of the node.
+func NewVoteExtensionHandler(cdc codec.BinaryCodec, k *keeper.Keeper, valStore baseapp.ValidatorStore, source types.ClientUpdateSource) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		cdc:      cdc,
+		keeper:   k,
+		valStore: valStore,
+		source:   source,
+	}
+}
+
+// ExtendVoteHandler returns the handler including the client updates provided by the client update source
+// in the vote extension. An empty vote extension is returned if the client updates cannot be obtained, so
+// that a faulty source never prevents the node from voting.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		updates, err := h.source.ClientUpdates(ctx)
+		if err != nil {
+			h.keeper.Logger(ctx).Error("failed to get client updates for vote extension", logging.KeyError, err)
+			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		}
+
+		voteExtension := types.ClientUpdatesVoteExtension{Updates: updates}
+		if err := voteExtension.ValidateBasic(); err != nil {
+			h.keeper.Logger(ctx).Error("invalid client updates for vote extension", logging.KeyError, err)
+			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		}
+
+		bz, err := voteExtension.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler rejecting vote extensions of other validators which cannot
+// be decoded or fail basic validation. Empty vote extensions are accepted.
+func (*VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		var voteExtension types.ClientUpdatesVoteExtension
+		if err := voteExtension.Unmarshal(req.VoteExtension); err != nil {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		if err := voteExtension.ValidateBasic(); err != nil {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler wraps the provided handler, injecting the extended commit info of the previous
+// height as the first transaction of the proposal once vote extensions are enabled.
+func (*VoteExtensionHandler) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= int64(len(bz))
+
+		res, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Txs = append([][]byte{bz}, res.Txs...)
+		return res, nil
+	}
+}
+
+// ProcessProposalHandler wraps the provided handler, rejecting proposals whose first transaction is not a
+// valid extended commit info of the previous height once vote extensions are enabled. The extended commit
+// info must match the last commit proposed by CometBFT, so that the proposer cannot omit or reorder votes.
+// The remaining transactions are processed by the wrapped handler.
+func (h *VoteExtensionHandler) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			h.keeper.Logger(ctx).Error("failed to decode injected extended commit info", logging.KeyError, err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := validateExtendedCommitInfo(extCommit, req.ProposedLastCommit); err != nil {
+			h.keeper.Logger(ctx).Error("injected extended commit info does not match the proposed last commit", logging.KeyError, err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			h.keeper.Logger(ctx).Error("invalid vote extensions in injected extended commit info", logging.KeyError, err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+
+		return next(ctx, &nextReq)
+	}
+}
+
+// FinalizeBlock wraps the FinalizeBlock method of the application. Since the injected extended commit info is
+// stripped from the transactions executed by the application in PreBlocker, a successful result is reported
+// for it so that the transaction results match the transactions of the block.
+func (*VoteExtensionHandler) FinalizeBlock(
+	next func(*abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error),
+) func(*abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	return func(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+		// the request is copied as PreBlocker strips the injected transaction from it
+		nextReq := *req
+
+		res, err := next(&nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(res.TxResults) == len(req.Txs)-1 {
+			res.TxResults = append([]*abci.ExecTxResult{{}}, res.TxResults...)
+		}
+
+		return res, nil
+	}
+}
+
+// PreBlocker applies the client updates included in the vote extensions of more than 2/3 of the voting power
+// of the previous height to their clients. Client updates which fail to apply are skipped. The injected extended
+// commit info is stripped from the transactions of the request, so that it is not executed by the application.
+func (h *VoteExtensionHandler) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+	if !voteExtensionsEnabled(ctx, req.Height) || len(req.Txs) == 0 {
+		return nil
+	}
+
+	injectedTx := req.Txs[0]
+	req.Txs = req.Txs[1:]
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(injectedTx); err != nil {
+		// the extended commit info has been validated in ProcessProposal
+		h.keeper.Logger(ctx).Error("failed to decode injected extended commit info", logging.KeyError, err)
+		return nil
+	}
+
+	for _, update := range AggregateClientUpdates(extCommit) {
+		if err := h.applyClientUpdate(ctx, update); err != nil {
+			logging.WithClient(h.keeper.Logger(ctx), update.ClientId).Error("failed to apply client update from vote extensions", logging.KeyError, err)
+		}
+	}
+
+	return nil
+}
+
+// applyClientUpdate applies a single client update in a cached context, which is only written
+// if the update succeeds. Client messages which are not headers are rejected and panics raised
+// while applying the client update are recovered, so that a client update can never halt the chain.
+func (h *VoteExtensionHandler) applyClientUpdate(ctx sdk.Context, update types.VoteExtensionClientUpdate) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrInvalidVoteExtension, "client update panicked with: %v", r)
+		}
+	}()
+
+	clientMsg, err := types.UnmarshalClientMessage(h.cdc, update.ClientMessage)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := clientMsg.(header); !ok {
+		return errorsmod.Wrapf(types.ErrInvalidVoteExtension, "client message of type %T is not a header", clientMsg)
+	}
+
+	if err := clientMsg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidHeader, err.Error())
+	}
+
+	// the header is verified by the light client, as the vote extensions only attest that the validators
+	// agreed on the header, not that it was committed by the counterparty chain
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := h.keeper.UpdateClientFromVoteExtension(cacheCtx, update.ClientId, clientMsg); err != nil {
+		return err
+	}
+
+	writeFn()
+	return nil
+}
+
+// AggregateClientUpdates returns the client updates included in the vote extensions of more than 2/3 of the
+// voting power of the extended commit info, in the order they first appear in the vote extensions. Vote
+// extensions which cannot be decoded are ignored and each validator is counted at most once per update.
+func AggregateClientUpdates(extCommit abci.ExtendedCommitInfo) []types.VoteExtensionClientUpdate {
+	var (
+		totalPower int64
+		updates    []types.VoteExtensionClientUpdate
+	)
+
+	power := make(map[string]int64)
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var voteExtension types.ClientUpdatesVoteExtension
+		if err := voteExtension.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+
+		if err := voteExtension.ValidateBasic(); err != nil {
+			continue
+		}
+
+		counted := make(map[string]bool)
+		for _, update := range voteExtension.Updates {
+			key := clientUpdateKey(update)
+			if counted[key] {
+				continue
+			}
+
+			if _, found := power[key]; !found {
+				updates = append(updates, update)
+			}
+
+			counted[key] = true
+			power[key] += vote.Validator.Power
+		}
+	}
+
+	var agreed []types.VoteExtensionClientUpdate
+	for _, update := range updates {
+		key := clientUpdateKey(update)
+		if power[key]*3 > totalPower*2 {
+			agreed = append(agreed, update)
+		}
+	}
+
+	return agreed
+}
+
+// validateExtendedCommitInfo returns an error if the extended commit info injected by the proposer does not
+// match the last commit proposed by CometBFT in its round, validators, voting power or block ID flags.
+func validateExtendedCommitInfo(extCommit abci.ExtendedCommitInfo, lastCommit abci.CommitInfo) error {
+	if extCommit.Round != lastCommit.Round {
+		return errorsmod.Wrapf(types.ErrInvalidVoteExtension, "extended commit round %d does not match last commit round %d", extCommit.Round, lastCommit.Round)
+	}
+
+	if len(extCommit.Votes) != len(lastCommit.Votes) {
+		return errorsmod.Wrapf(types.ErrInvalidVoteExtension, "extended commit has %d votes, last commit has %d votes", len(extCommit.Votes), len(lastCommit.Votes))
+	}
+
+	for i, vote := range extCommit.Votes {
+		lastVote := lastCommit.Votes[i]
+
+		if !bytes.Equal(vote.Validator.Address, lastVote.Validator.Address) {
+			return errorsmod.Wrapf(types.ErrInvalidVoteExtension, "validator address of vote %d does not match last commit", i)
+		}
+
+		if vote.Validator.Power != lastVote.Validator.Power {
+			return errorsmod.Wrapf(types.ErrInvalidVoteExtension, "validator power of vote %d does not match last commit (%d != %d)", i, vote.Validator.Power, lastVote.Validator.Power)
+		}
+
+		if vote.BlockIdFlag != lastVote.BlockIdFlag {
+			return errorsmod.Wrapf(types.ErrInvalidVoteExtension, "block ID flag of vote %d does not match last commit (%s != %s)", i, vote.BlockIdFlag, lastVote.BlockIdFlag)
+		}
+	}
+
+	return nil
+}
+
+// clientUpdateKey returns the key identifying identical client updates included in different vote extensions.
+func clientUpdateKey(update types.VoteExtensionClientUpdate) string {
+	return update.ClientId + "/" + string(update.ClientMessage)
+}
+
+// voteExtensionsEnabled returns true if vote extensions of the previous height are available at the provided height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,463 @@
+package client_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	client "github.com/cosmos/ibc-go/v8/modules/core/02-client"
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var _ types.ClientUpdateSource = (*mockClientUpdateSource)(nil)
+
+type mockClientUpdateSource struct {
+	updates []types.VoteExtensionClientUpdate
+	err     error
+}
+
+func (m mockClientUpdateSource) ClientUpdates(_ sdk.Context) ([]types.VoteExtensionClientUpdate, error) {
+	return m.updates, m.err
+}
+
+// newVoteExtensionHandler returns a vote extension handler for chainA using the provided source.
+func (suite *ClientTestSuite) newVoteExtensionHandler(source types.ClientUpdateSource) *client.VoteExtensionHandler {
+	return client.NewVoteExtensionHandler(
+		suite.chainA.App.AppCodec(),
+		suite.chainA.App.GetIBCKeeper().ClientKeeper,
+		suite.chainA.GetSimApp().StakingKeeper,
+		source,
+	)
+}
+
+// voteExtensionsContext returns a context of chainA with vote extensions enabled from height 1.
+func (suite *ClientTestSuite) voteExtensionsContext() sdk.Context {
+	ctx := suite.chainA.GetContext()
+	return ctx.WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}})
+}
+
+// clientHeader returns a header of chainB updating the client on chainA.
+func (suite *ClientTestSuite) clientHeader(path *ibctesting.Path) *ibctm.Header {
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, path.EndpointA.GetClientLatestHeight().(types.Height))
+	suite.Require().NoError(err)
+
+	return header
+}
+
+// clientUpdate returns a client update of the client on chainA with a header of chainB.
+func (suite *ClientTestSuite) clientUpdate(path *ibctesting.Path) types.VoteExtensionClientUpdate {
+	update, err := types.NewVoteExtensionClientUpdate(suite.chainA.App.AppCodec(), path.EndpointA.ClientID, suite.clientHeader(path))
+	suite.Require().NoError(err)
+
+	return update
+}
+
+func (suite *ClientTestSuite) TestExtendVoteHandler() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	update := suite.clientUpdate(path)
+
+	testCases := []struct {
+		name       string
+		source     mockClientUpdateSource
+		expUpdates []types.VoteExtensionClientUpdate
+	}{
+		{
+			"success",
+			mockClientUpdateSource{updates: []types.VoteExtensionClientUpdate{update}},
+			[]types.VoteExtensionClientUpdate{update},
+		},
+		{
+			"success: no client updates",
+			mockClientUpdateSource{},
+			nil,
+		},
+		{
+			"empty vote extension: source error",
+			mockClientUpdateSource{err: errors.New("sidecar unavailable")},
+			nil,
+		},
+		{
+			"empty vote extension: invalid client update",
+			mockClientUpdateSource{updates: []types.VoteExtensionClientUpdate{{ClientId: path.EndpointA.ClientID}}},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			handler := suite.newVoteExtensionHandler(tc.source)
+
+			res, err := handler.ExtendVoteHandler()(suite.chainA.GetContext(), &abci.RequestExtendVote{})
+			suite.Require().NoError(err)
+
+			var voteExtension types.ClientUpdatesVoteExtension
+			suite.Require().NoError(voteExtension.Unmarshal(res.VoteExtension))
+			suite.Require().Equal(tc.expUpdates, voteExtension.Updates)
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestVerifyVoteExtensionHandler() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	validExtension := types.ClientUpdatesVoteExtension{Updates: []types.VoteExtensionClientUpdate{suite.clientUpdate(path)}}
+	validBz, err := validExtension.Marshal()
+	suite.Require().NoError(err)
+
+	invalidExtension := types.ClientUpdatesVoteExtension{Updates: []types.VoteExtensionClientUpdate{{ClientId: "", ClientMessage: []byte("header")}}}
+	invalidBz, err := invalidExtension.Marshal()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		voteExtension []byte
+		expStatus     abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{"accept: valid vote extension", validBz, abci.ResponseVerifyVoteExtension_ACCEPT},
+		{"accept: empty vote extension", nil, abci.ResponseVerifyVoteExtension_ACCEPT},
+		{"reject: vote extension cannot be decoded", []byte("invalid"), abci.ResponseVerifyVoteExtension_REJECT},
+		{"reject: vote extension fails basic validation", invalidBz, abci.ResponseVerifyVoteExtension_REJECT},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			handler := suite.newVoteExtensionHandler(mockClientUpdateSource{})
+
+			res, err := handler.VerifyVoteExtensionHandler()(suite.chainA.GetContext(), &abci.RequestVerifyVoteExtension{VoteExtension: tc.voteExtension})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expStatus, res.Status)
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestPrepareProposalHandler() {
+	var nextReq *abci.RequestPrepareProposal
+	next := func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		nextReq = req
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	}
+
+	handler := suite.newVoteExtensionHandler(mockClientUpdateSource{}).PrepareProposalHandler(next)
+
+	extCommit := abci.ExtendedCommitInfo{
+		Round: 1,
+		Votes: []abci.ExtendedVoteInfo{{Validator: abci.Validator{Address: []byte("validator"), Power: 1}, VoteExtension: []byte("extension")}},
+	}
+	req := &abci.RequestPrepareProposal{Height: 2, MaxTxBytes: 1000, Txs: [][]byte{[]byte("tx")}, LocalLastCommit: extCommit}
+
+	// vote extensions are disabled
+	res, err := handler(suite.chainA.GetContext(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(req.Txs, res.Txs)
+
+	// vote extensions are enabled, the extended commit info is injected as the first transaction
+	res, err = handler(suite.voteExtensionsContext(), req)
+	suite.Require().NoError(err)
+
+	bz, err := extCommit.Marshal()
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]byte{bz, []byte("tx")}, res.Txs)
+	suite.Require().Equal(req.MaxTxBytes-int64(len(bz)), nextReq.MaxTxBytes)
+}
+
+func (suite *ClientTestSuite) TestProcessProposalHandler() {
+	var (
+		txs        [][]byte
+		extCommit  abci.ExtendedCommitInfo
+		lastCommit abci.CommitInfo
+	)
+
+	next := func(_ sdk.Context, _ *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+
+	handler := suite.newVoteExtensionHandler(mockClientUpdateSource{}).ProcessProposalHandler(next)
+
+	// injectExtCommit returns a malleate function injecting the extended commit info after applying the provided change.
+	injectExtCommit := func(change func()) func() {
+		return func() {
+			change()
+
+			bz, err := extCommit.Marshal()
+			suite.Require().NoError(err)
+			txs = [][]byte{bz}
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		ctx       func() sdk.Context
+		malleate  func()
+		expStatus abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			"accept: vote extensions disabled",
+			suite.chainA.GetContext,
+			func() {},
+			abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			"reject: no injected extended commit info",
+			suite.voteExtensionsContext,
+			func() {},
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject: injected extended commit info cannot be decoded",
+			suite.voteExtensionsContext,
+			func() {
+				txs = [][]byte{[]byte("invalid")}
+			},
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject: injected extended commit info round does not match the proposed last commit",
+			suite.voteExtensionsContext,
+			injectExtCommit(func() {
+				extCommit.Round++
+			}),
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject: injected extended commit info omits a vote of the proposed last commit",
+			suite.voteExtensionsContext,
+			injectExtCommit(func() {
+				extCommit.Votes = extCommit.Votes[1:]
+			}),
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject: injected extended commit info reorders the validators of the proposed last commit",
+			suite.voteExtensionsContext,
+			injectExtCommit(func() {
+				extCommit.Votes[0], extCommit.Votes[1] = extCommit.Votes[1], extCommit.Votes[0]
+			}),
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject: injected extended commit info inflates the power of a validator",
+			suite.voteExtensionsContext,
+			injectExtCommit(func() {
+				extCommit.Votes[0].Validator.Power++
+			}),
+			abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			"reject: injected extended commit info changes the block ID flag of a vote",
+			suite.voteExtensionsContext,
+			injectExtCommit(func() {
+				lastCommit.Votes[1].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+			}),
+			abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			txs = nil
+			extCommit = abci.ExtendedCommitInfo{Round: 1}
+			lastCommit = abci.CommitInfo{Round: 1}
+			for i := 0; i < 2; i++ {
+				validator := abci.Validator{Address: []byte{byte(i)}, Power: 1}
+				extCommit.Votes = append(extCommit.Votes, abci.ExtendedVoteInfo{Validator: validator, BlockIdFlag: cmtproto.BlockIDFlagCommit})
+				lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: validator, BlockIdFlag: cmtproto.BlockIDFlagCommit})
+			}
+
+			tc.malleate()
+
+			res, err := handler(tc.ctx(), &abci.RequestProcessProposal{Height: 2, Txs: txs, ProposedLastCommit: lastCommit})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expStatus, res.Status)
+		})
+	}
+}
+
+func (suite *ClientTestSuite) TestFinalizeBlock() {
+	var executedTxs [][]byte
+	next := func(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+		// the PreBlocker strips the injected transaction when vote extensions are enabled
+		if len(req.Txs) > 1 {
+			req.Txs = req.Txs[1:]
+		}
+
+		executedTxs = req.Txs
+		txResults := make([]*abci.ExecTxResult, len(req.Txs))
+		for i := range txResults {
+			txResults[i] = &abci.ExecTxResult{Code: 1}
+		}
+
+		return &abci.ResponseFinalizeBlock{TxResults: txResults}, nil
+	}
+
+	finalizeBlock := suite.newVoteExtensionHandler(mockClientUpdateSource{}).FinalizeBlock(next)
+
+	req := &abci.RequestFinalizeBlock{Height: 2, Txs: [][]byte{[]byte("extended commit info"), []byte("tx")}}
+	res, err := finalizeBlock(req)
+	suite.Require().NoError(err)
+
+	// the injected transaction is not executed and reported as successful
+	suite.Require().Equal([][]byte{[]byte("tx")}, executedTxs)
+	suite.Require().Len(res.TxResults, 2)
+	suite.Require().Equal(uint32(0), res.TxResults[0].Code)
+	suite.Require().Equal(uint32(1), res.TxResults[1].Code)
+
+	// the request is not modified
+	suite.Require().Len(req.Txs, 2)
+
+	// no result is added if no transaction was stripped
+	res, err = finalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Txs: [][]byte{[]byte("tx")}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TxResults, 1)
+}
+
+func (suite *ClientTestSuite) TestVoteExtensionPreBlocker() {
+	var (
+		path      *ibctesting.Path
+		header    *ibctm.Header
+		clientMsg exported.ClientMessage
+		clientID  string
+		signers   int
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expUpdate bool
+	}{
+		{
+			"success: client update included by all validators",
+			func() {},
+			true,
+		},
+		{
+			"success: client update included by more than 2/3 of the voting power",
+			func() {
+				signers = 3
+			},
+			true,
+		},
+		{
+			"client update included by 2/3 of the voting power is not applied",
+			func() {
+				signers = 2
+			},
+			false,
+		},
+		{
+			"client update for unknown client is not applied",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"client update with invalid signatures is not applied",
+			func() {
+				for i := range header.Commit.Signatures {
+					header.Commit.Signatures[i].Signature = []byte("invalid signature")
+				}
+			},
+			false,
+		},
+		{
+			"client update with nil header is not applied",
+			func() {
+				header.SignedHeader.Header = nil
+			},
+			false,
+		},
+		{
+			"misbehaviour is not applied",
+			func() {
+				clientMsg = &ibctm.Misbehaviour{ClientId: path.EndpointA.ClientID, Header1: header, Header2: header}
+			},
+			false,
+		},
+		{
+			"client update constituting misbehaviour is not applied and does not freeze the client",
+			func() {
+				// set conflicting consensus state in store to create misbehaviour scenario
+				conflictConsState := header.ConsensusState()
+				conflictConsState.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting apphash"))
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, header.GetHeight(), conflictConsState)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			header = suite.clientHeader(path)
+			clientMsg = header
+			clientID = path.EndpointA.ClientID
+			signers = 4
+
+			tc.malleate()
+
+			update, err := types.NewVoteExtensionClientUpdate(suite.chainA.App.AppCodec(), clientID, clientMsg)
+			suite.Require().NoError(err)
+
+			voteExtension := types.ClientUpdatesVoteExtension{Updates: []types.VoteExtensionClientUpdate{update}}
+			bz, err := voteExtension.Marshal()
+			suite.Require().NoError(err)
+
+			var extCommit abci.ExtendedCommitInfo
+			for i := 0; i < 4; i++ {
+				vote := abci.ExtendedVoteInfo{
+					Validator:   abci.Validator{Address: []byte{byte(i)}, Power: 1},
+					BlockIdFlag: cmtproto.BlockIDFlagCommit,
+				}
+				if i < signers {
+					vote.VoteExtension = bz
+				}
+
+				extCommit.Votes = append(extCommit.Votes, vote)
+			}
+
+			injectedTx, err := extCommit.Marshal()
+			suite.Require().NoError(err)
+
+			heightBefore := path.EndpointA.GetClientLatestHeight()
+
+			ctx := suite.voteExtensionsContext()
+			handler := suite.newVoteExtensionHandler(mockClientUpdateSource{})
+			req := &abci.RequestFinalizeBlock{Height: 2, Txs: [][]byte{injectedTx, []byte("tx")}}
+			err = handler.PreBlocker(ctx, req)
+			suite.Require().NoError(err)
+
+			// the injected transaction is stripped from the transactions executed by the application
+			suite.Require().Equal([][]byte{[]byte("tx")}, req.Txs)
+
+			// the client is never frozen by client updates included in vote extensions
+			suite.Require().Equal(exported.Active, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, path.EndpointA.ClientID))
+
+			latestHeight := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientLatestHeight(ctx, path.EndpointA.ClientID)
+			if tc.expUpdate {
+				suite.Require().True(latestHeight.GT(heightBefore))
+			} else {
+				suite.Require().Equal(heightBefore, latestHeight)
+			}
+		})
+	}
+}
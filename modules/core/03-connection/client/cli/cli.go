@@ -3,6 +3,8 @@ package cli
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client"
+
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 )
 
@@ -17,6 +19,7 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryConnections(),
+		GetCmdQueryConnectionsByCounterpartyClient(),
 		GetCmdQueryConnection(),
 		GetCmdQueryClientConnections(),
 		GetCmdConnectionParams(),
@@ -24,3 +27,20 @@ func GetQueryCmd() *cobra.Command {
 
 	return queryCmd
 }
+
+// NewTxCmd returns a CLI command handler for all x/ibc connection transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.SubModuleName,
+		Short:                      "IBC connection transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		newConnectionHandshakeTxCmd(),
+	)
+
+	return txCmd
+}
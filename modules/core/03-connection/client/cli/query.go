@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -14,14 +15,19 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+const (
+	flagClientID = "client-id"
+	flagState    = "state"
+)
+
 // GetCmdQueryConnections defines the command to query all the connection ends
 // that this chain maintains.
 func GetCmdQueryConnections() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "connections",
 		Short:   "Query all connections",
-		Long:    "Query all connections ends from a chain",
-		Example: fmt.Sprintf("%s query %s %s connections", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Long:    "Query all connections ends from a chain, optionally filtered by client identifier and connection state (INIT, TRYOPEN or OPEN)",
+		Example: fmt.Sprintf("%s query %s %s connections --%s 07-tendermint-0 --%s OPEN", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagClientID, flagState),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -35,8 +41,25 @@ func GetCmdQueryConnections() *cobra.Command {
 				return err
 			}
 
+			clientID, err := cmd.Flags().GetString(flagClientID)
+			if err != nil {
+				return err
+			}
+
+			stateStr, err := cmd.Flags().GetString(flagState)
+			if err != nil {
+				return err
+			}
+
+			state, err := parseConnectionState(stateStr)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryConnectionsRequest{
 				Pagination: pageReq,
+				ClientId:   clientID,
+				State:      state,
 			}
 
 			res, err := queryClient.Connections(cmd.Context(), req)
@@ -48,6 +71,49 @@ func GetCmdQueryConnections() *cobra.Command {
 		},
 	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "connection ends")
+	cmd.Flags().String(flagClientID, "", "only return the connections associated with the client identifier")
+	cmd.Flags().String(flagState, "", "only return the connections in the connection state (INIT, TRYOPEN or OPEN)")
+
+	return cmd
+}
+
+// GetCmdQueryConnectionsByCounterpartyClient defines the command to query the connection ends
+// associated with a client on the counterparty chain.
+func GetCmdQueryConnectionsByCounterpartyClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-client-connections [counterparty-client-id]",
+		Short:   "Query all connections associated with a counterparty client",
+		Long:    "Query all connections ends from a chain associated with the client identifier on the counterparty chain",
+		Example: fmt.Sprintf("%s query %s %s counterparty-client-connections [counterparty-client-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConnectionsByCounterpartyClientRequest{
+				CounterpartyClientId: args[0],
+				Pagination:           pageReq,
+			}
+
+			res, err := queryClient.ConnectionsByCounterpartyClient(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "connection ends")
 
@@ -142,3 +208,22 @@ func GetCmdConnectionParams() *cobra.Command {
 
 	return cmd
 }
+
+// parseConnectionState parses the connection state provided to the connections query command.
+// An empty string does not filter connections by state.
+func parseConnectionState(state string) (types.State, error) {
+	if state == "" {
+		return types.UNINITIALIZED, nil
+	}
+
+	switch strings.ToUpper(state) {
+	case types.INIT.String(), "INIT":
+		return types.INIT, nil
+	case types.TRYOPEN.String(), "TRYOPEN":
+		return types.TRYOPEN, nil
+	case types.OPEN.String(), "OPEN":
+		return types.OPEN, nil
+	default:
+		return types.UNINITIALIZED, fmt.Errorf("invalid connection state %s, expected one of INIT, TRYOPEN or OPEN", state)
+	}
+}
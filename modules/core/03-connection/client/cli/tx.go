@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	clientutils "github.com/cosmos/ibc-go/v8/modules/core/02-client/client/utils"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/client/utils"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcclient "github.com/cosmos/ibc-go/v8/modules/core/client"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+const flagDelayPeriod = "delay-period"
+
+// handshakeProofs contains a connection end, the client state of its client and the consensus state of the client
+// at its latest height, with the proofs of their existence at the same height.
+type handshakeProofs struct {
+	connection      types.ConnectionEnd
+	clientState     *ibctm.ClientState
+	connectionProof []byte
+	clientProof     []byte
+	consensusProof  []byte
+	proofHeight     clienttypes.Height
+}
+
+// newConnectionHandshakeTxCmd returns the command to open a connection by performing all the steps of the connection handshake.
+func newConnectionHandshakeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "handshake [client-id] [counterparty-client-id] [counterparty-node]",
+		Short: "Open a connection by performing all the steps of the connection handshake",
+		Long: `Open a connection between the 07-tendermint clients with the given identifiers by performing all the steps
+		of the connection handshake, acting as a light relayer between this chain and the chain of the counterparty node.
+		ConnOpenInit and ConnOpenAck are submitted to this chain, while ConnOpenTry and ConnOpenConfirm are submitted to
+		the counterparty node at the given CometBFT RPC address. The proofs and the headers used to update the clients are
+		queried directly from the nodes of both chains. Transactions on both chains are signed by the key provided with
+		--from and use the same fee flags. Both chains are expected to use the default IBC commitment prefix.
+		This command is meant for development and testing environments, where running a full relayer is not practical.`,
+		Example: fmt.Sprintf("%s tx %s %s handshake 07-tendermint-0 07-tendermint-1 http://localhost:26657 --gas auto", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID, counterpartyClientID := args[0], args[1]
+
+			delayPeriod, err := cmd.Flags().GetUint64(flagDelayPeriod)
+			if err != nil {
+				return err
+			}
+
+			counterpartyCtx, err := ibcclient.NewCounterpartyContext(cmd.Context(), clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			connectionID, counterpartyConnectionID, err := connectionHandshake(cmd, clientCtx, counterpartyCtx, txf, clientID, counterpartyClientID, delayPeriod)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("opened connection %s on %s with counterparty connection %s on %s\n", connectionID, clientCtx.ChainID, counterpartyConnectionID, counterpartyCtx.ChainID))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagDelayPeriod, 0, "delay period of the connection in nanoseconds")
+
+	return cmd
+}
+
+// connectionHandshake performs the four steps of the connection handshake between the chains of the client
+// and counterparty contexts. The clients receiving proofs are updated in the same transaction as the handshake
+// message which is verified against them. The connection identifiers on both chains are returned.
+func connectionHandshake(
+	cmd *cobra.Command, clientCtx, counterpartyCtx client.Context, txf tx.Factory,
+	clientID, counterpartyClientID string, delayPeriod uint64,
+) (string, string, error) {
+	ctx := cmd.Context()
+	signer := clientCtx.GetFromAddress().String()
+	prefix := commitmenttypes.NewMerklePrefix([]byte(ibcexported.StoreKey))
+
+	msgInit := types.NewMsgConnectionOpenInit(clientID, counterpartyClientID, prefix, nil, delayPeriod, signer)
+	res, err := ibcclient.BroadcastTxAndWait(ctx, clientCtx, txf, msgInit)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit ConnOpenInit: %w", err)
+	}
+
+	connectionID, err := ibcclient.ParseAttributeFromEvents(res.Events, types.EventTypeConnectionOpenInit, types.AttributeKeyConnectionID)
+	if err != nil {
+		return "", "", err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ConnOpenInit: connection %s created on %s\n", connectionID, clientCtx.ChainID)
+
+	proofs, err := queryHandshakeProofs(ctx, clientCtx, connectionID, clientID, res.Height)
+	if err != nil {
+		return "", "", err
+	}
+
+	msgTry := types.NewMsgConnectionOpenTry(
+		counterpartyClientID, connectionID, clientID, proofs.clientState, prefix, proofs.connection.Versions, delayPeriod,
+		proofs.connectionProof, proofs.clientProof, proofs.consensusProof, proofs.proofHeight, proofs.clientState.LatestHeight, signer,
+	)
+	res, err = clientutils.BroadcastTxWithTendermintClientUpdate(ctx, counterpartyCtx, clientCtx, txf, counterpartyClientID, proofs.proofHeight, msgTry)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit ConnOpenTry: %w", err)
+	}
+
+	counterpartyConnectionID, err := ibcclient.ParseAttributeFromEvents(res.Events, types.EventTypeConnectionOpenTry, types.AttributeKeyConnectionID)
+	if err != nil {
+		return "", "", err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ConnOpenTry: connection %s created on %s\n", counterpartyConnectionID, counterpartyCtx.ChainID)
+
+	proofs, err = queryHandshakeProofs(ctx, counterpartyCtx, counterpartyConnectionID, counterpartyClientID, res.Height)
+	if err != nil {
+		return "", "", err
+	}
+
+	msgAck := types.NewMsgConnectionOpenAck(
+		connectionID, counterpartyConnectionID, proofs.clientState, proofs.connectionProof, proofs.clientProof, proofs.consensusProof,
+		proofs.proofHeight, proofs.clientState.LatestHeight, proofs.connection.Versions[0], signer,
+	)
+	res, err = clientutils.BroadcastTxWithTendermintClientUpdate(ctx, clientCtx, counterpartyCtx, txf, clientID, proofs.proofHeight, msgAck)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit ConnOpenAck: %w", err)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ConnOpenAck: connection %s opened on %s\n", connectionID, clientCtx.ChainID)
+
+	proofs, err = queryHandshakeProofs(ctx, clientCtx, connectionID, clientID, res.Height)
+	if err != nil {
+		return "", "", err
+	}
+
+	msgConfirm := types.NewMsgConnectionOpenConfirm(counterpartyConnectionID, proofs.connectionProof, proofs.proofHeight, signer)
+	if _, err := clientutils.BroadcastTxWithTendermintClientUpdate(ctx, counterpartyCtx, clientCtx, txf, counterpartyClientID, proofs.proofHeight, msgConfirm); err != nil {
+		return "", "", fmt.Errorf("failed to submit ConnOpenConfirm: %w", err)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ConnOpenConfirm: connection %s opened on %s\n", counterpartyConnectionID, counterpartyCtx.ChainID)
+
+	return connectionID, counterpartyConnectionID, nil
+}
+
+// queryHandshakeProofs waits for the state written by the transaction included at the given height to be committed
+// and queries the connection end, the client state and the consensus state with their proofs at the latest height.
+func queryHandshakeProofs(ctx context.Context, clientCtx client.Context, connectionID, clientID string, txHeight int64) (*handshakeProofs, error) {
+	height, err := ibcclient.QueryProofHeight(ctx, clientCtx, txHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	queryCtx := clientCtx.WithHeight(height)
+
+	connectionRes, err := utils.QueryConnection(queryCtx, connectionID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	clientStateRes, err := clientutils.QueryClientStateABCI(queryCtx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, err := clienttypes.UnpackClientState(clientStateRes.ClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return nil, fmt.Errorf("expected client %s to be of type %T, got %T", clientID, (*ibctm.ClientState)(nil), clientState)
+	}
+
+	consensusStateRes, err := clientutils.QueryConsensusStateABCI(queryCtx, clientID, tmClientState.LatestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &handshakeProofs{
+		connection:      *connectionRes.Connection,
+		clientState:     tmClientState,
+		connectionProof: connectionRes.Proof,
+		clientProof:     clientStateRes.Proof,
+		consensusProof:  consensusStateRes.Proof,
+		proofHeight:     connectionRes.ProofHeight,
+	}, nil
+}
@@ -50,35 +50,101 @@ func (k *Keeper) Connections(c context.Context, req *types.QueryConnectionsReque
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if req.ClientId != "" {
+		if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if _, ok := types.State_name[int32(req.State)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(types.ErrInvalidConnectionState, "unknown connection state %d", req.State).Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
+	connections, pageRes, err := k.filteredConnections(ctx, req.Pagination, func(connection types.ConnectionEnd) bool {
+		// ignore connections associated with a different client than the requested one
+		if req.ClientId != "" && connection.ClientId != req.ClientId {
+			return false
+		}
+
+		// ignore connections in a different state than the requested one
+		if req.State != types.UNINITIALIZED && connection.State != req.State {
+			return false
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConnectionsResponse{
+		Connections: connections,
+		Pagination:  pageRes,
+		Height:      clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// ConnectionsByCounterpartyClient implements the Query/ConnectionsByCounterpartyClient gRPC method
+func (k *Keeper) ConnectionsByCounterpartyClient(c context.Context, req *types.QueryConnectionsByCounterpartyClientRequest) (*types.QueryConnectionsByCounterpartyClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.CounterpartyClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	connections, pageRes, err := k.filteredConnections(ctx, req.Pagination, func(connection types.ConnectionEnd) bool {
+		return connection.Counterparty.ClientId == req.CounterpartyClientId
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConnectionsByCounterpartyClientResponse{
+		Connections: connections,
+		Pagination:  pageRes,
+		Height:      clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// filteredConnections paginates over the stored connections and returns the connections for which
+// the provided filter returns true.
+func (k *Keeper) filteredConnections(ctx sdk.Context, pagination *query.PageRequest, filter func(types.ConnectionEnd) bool) ([]*types.IdentifiedConnection, *query.PageResponse, error) {
 	var connections []*types.IdentifiedConnection
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyConnectionPrefix))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		var result types.ConnectionEnd
 		if err := k.cdc.Unmarshal(value, &result); err != nil {
-			return err
+			return false, err
+		}
+
+		if !filter(result) {
+			return false, nil
 		}
 
 		connectionID, err := host.ParseConnectionPath(string(key))
 		if err != nil {
-			return err
+			return false, err
+		}
+
+		if accumulate {
+			identifiedConnection := types.NewIdentifiedConnection(connectionID, result)
+			connections = append(connections, &identifiedConnection)
 		}
 
-		identifiedConnection := types.NewIdentifiedConnection(connectionID, result)
-		connections = append(connections, &identifiedConnection)
-		return nil
+		return true, nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &types.QueryConnectionsResponse{
-		Connections: connections,
-		Pagination:  pageRes,
-		Height:      clienttypes.GetSelfHeight(ctx),
-	}, nil
+	return connections, pageRes, nil
 }
 
 // ClientConnections implements the Query/ClientConnections gRPC method
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -155,6 +158,106 @@ func (suite *KeeperTestSuite) TestQueryConnections() {
 			},
 			true,
 		},
+		{
+			"success: filter by client identifier",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetupConnections()
+				path2.SetupConnections()
+
+				connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), path2.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				iconn := types.NewIdentifiedConnection(path2.EndpointA.ConnectionID, connection)
+				expConnections = []*types.IdentifiedConnection{&iconn}
+
+				req = &types.QueryConnectionsRequest{
+					ClientId: path2.EndpointA.ClientID,
+				}
+			},
+			true,
+		},
+		{
+			"success: filter by state",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetupConnections()
+				path2.SetupClients()
+
+				err := path2.EndpointA.ConnOpenInit()
+				suite.Require().NoError(err)
+
+				connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), path2.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				iconn := types.NewIdentifiedConnection(path2.EndpointA.ConnectionID, connection)
+				expConnections = []*types.IdentifiedConnection{&iconn}
+
+				req = &types.QueryConnectionsRequest{
+					State: types.INIT,
+				}
+			},
+			true,
+		},
+		{
+			"success: filter by client identifier and state without match",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				expConnections = nil
+
+				req = &types.QueryConnectionsRequest{
+					ClientId: path.EndpointA.ClientID,
+					State:    types.TRYOPEN,
+				}
+			},
+			true,
+		},
+		{
+			"success: paginate filtered connections",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetupConnections()
+				path2.SetupConnections()
+
+				connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), path1.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				iconn := types.NewIdentifiedConnection(path1.EndpointA.ConnectionID, connection)
+				expConnections = []*types.IdentifiedConnection{&iconn}
+
+				req = &types.QueryConnectionsRequest{
+					State: types.OPEN,
+					Pagination: &query.PageRequest{
+						Limit:      1,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"invalid client identifier",
+			func() {
+				req = &types.QueryConnectionsRequest{
+					ClientId: "//invalid_id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid state",
+			func() {
+				req = &types.QueryConnectionsRequest{
+					State: types.State(10),
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -179,6 +282,96 @@ func (suite *KeeperTestSuite) TestQueryConnections() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConnectionsByCounterpartyClient() {
+	var (
+		req            *types.QueryConnectionsByCounterpartyClientRequest
+		expConnections []*types.IdentifiedConnection
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path1.SetupConnections()
+				path2.SetupConnections()
+
+				connection, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetConnection(suite.chainA.GetContext(), path2.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				iconn := types.NewIdentifiedConnection(path2.EndpointA.ConnectionID, connection)
+				expConnections = []*types.IdentifiedConnection{&iconn}
+
+				req = &types.QueryConnectionsByCounterpartyClientRequest{
+					CounterpartyClientId: path2.EndpointB.ClientID,
+					Pagination: &query.PageRequest{
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: no connections associated with counterparty client",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				expConnections = nil
+
+				req = &types.QueryConnectionsByCounterpartyClientRequest{
+					CounterpartyClientId: "07-tendermint-100",
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid counterparty client identifier",
+			func() {
+				req = &types.QueryConnectionsByCounterpartyClientRequest{
+					CounterpartyClientId: "",
+				}
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.ConnectionsByCounterpartyClient(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expConnections, res.Connections)
+				suite.Require().Equal(clienttypes.GetSelfHeight(ctx), res.Height)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryClientConnections() {
 	var (
 		req      *types.QueryClientConnectionsRequest
@@ -208,7 +401,7 @@ func (suite *KeeperTestSuite) TestQueryClientConnections() {
 			"connection not found",
 			func() {
 				req = &types.QueryClientConnectionsRequest{
-					ClientId: ibctesting.InvalidID,
+					ClientId: "//invalid_id",
 				}
 			},
 			false,
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
@@ -47,7 +48,7 @@ func (k *Keeper) ConnOpenInit(
 	connection := types.NewConnectionEnd(types.INIT, clientID, counterparty, versions, delayPeriod)
 	k.SetConnection(ctx, connectionID, connection)
 
-	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", types.UNINITIALIZED.String(), "new-state", types.INIT.String())
+	logging.WithConnection(k.Logger(ctx), connectionID).Info("connection state updated", logging.KeyPreviousState, types.UNINITIALIZED.String(), logging.KeyNewState, types.INIT.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "connection", "open-init")
 
@@ -142,7 +143,7 @@ func (k *Keeper) ConnOpenTry(
 	}
 
 	k.SetConnection(ctx, connectionID, connection)
-	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", types.UNINITIALIZED.String(), "new-state", types.TRYOPEN.String())
+	logging.WithConnection(k.Logger(ctx), connectionID).Info("connection state updated", logging.KeyPreviousState, types.UNINITIALIZED.String(), logging.KeyNewState, types.TRYOPEN.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "connection", "open-try")
 
@@ -234,7 +235,7 @@ func (k *Keeper) ConnOpenAck(
 		return err
 	}
 
-	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", types.INIT.String(), "new-state", types.OPEN.String())
+	logging.WithConnection(k.Logger(ctx), connectionID).Info("connection state updated", logging.KeyPreviousState, types.INIT.String(), logging.KeyNewState, types.OPEN.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "connection", "open-ack")
 
@@ -288,7 +289,7 @@ func (k *Keeper) ConnOpenConfirm(
 	// Update ChainB's connection to Open
 	connection.State = types.OPEN
 	k.SetConnection(ctx, connectionID, connection)
-	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", types.TRYOPEN.String(), "new-state", types.OPEN.String())
+	logging.WithConnection(k.Logger(ctx), connectionID).Info("connection state updated", logging.KeyPreviousState, types.TRYOPEN.String(), logging.KeyNewState, types.OPEN.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "connection", "open-confirm")
 
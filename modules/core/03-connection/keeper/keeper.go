@@ -26,6 +26,7 @@ type Keeper struct {
 	legacySubspace types.ParamSubspace
 	cdc            codec.BinaryCodec
 	clientKeeper   types.ClientKeeper
+	transientKey   storetypes.StoreKey
 }
 
 // NewKeeper creates a new IBC connection Keeper instance
@@ -38,6 +39,16 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, legacySubspace ty
 	}
 }
 
+// SetTransientStoreKey sets the transient store key in which the channel keeper caches the connection ends
+// resolved within the current block. The cached connection end is removed when a connection end is set.
+func (k *Keeper) SetTransientStoreKey(transientKey storetypes.StoreKey) {
+	if transientKey == nil {
+		panic(errors.New("cannot set a nil transient store key"))
+	}
+
+	k.transientKey = transientKey
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.SubModuleName)
@@ -85,6 +96,10 @@ func (k *Keeper) SetConnection(ctx sdk.Context, connectionID string, connection
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&connection)
 	store.Set(host.ConnectionKey(connectionID), bz)
+
+	if k.transientKey != nil {
+		ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).TransientStore(k.transientKey).Delete(types.ConnectionCacheKey(connectionID))
+	}
 }
 
 // GetClientConnectionPaths returns all the connection paths stored under a
@@ -45,11 +45,13 @@ func (k *Keeper) VerifyClientState(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height,
-		0, 0, // skip delay period checks for non-packet processing verification
-		proof, merklePath, bz,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height,
+			0, 0, // skip delay period checks for non-packet processing verification
+			proof, merklePath, bz,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed client state verification for target client: %s", clientID)
 	}
 
@@ -87,11 +89,13 @@ func (k *Keeper) VerifyClientConsensusState(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height,
-		0, 0, // skip delay period checks for non-packet processing verification
-		proof, merklePath, bz,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height,
+			0, 0, // skip delay period checks for non-packet processing verification
+			proof, merklePath, bz,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed consensus state verification for client (%s)", clientID)
 	}
 
@@ -129,11 +133,13 @@ func (k *Keeper) VerifyConnectionState(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height,
-		0, 0, // skip delay period checks for non-packet processing verification
-		proof, merklePath, bz,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height,
+			0, 0, // skip delay period checks for non-packet processing verification
+			proof, merklePath, bz,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed connection state verification for client (%s)", clientID)
 	}
 
@@ -172,11 +178,13 @@ func (k *Keeper) VerifyChannelState(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height,
-		0, 0, // skip delay period checks for non-packet processing verification
-		proof, merklePath, bz,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height,
+			0, 0, // skip delay period checks for non-packet processing verification
+			proof, merklePath, bz,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed channel state verification for client (%s)", clientID)
 	}
 
@@ -215,9 +223,11 @@ func (k *Keeper) VerifyPacketCommitment(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height, timeDelay, blockDelay, proof, merklePath, commitmentBytes,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height, timeDelay, blockDelay, proof, merklePath, commitmentBytes,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", clientID)
 	}
 
@@ -256,10 +266,12 @@ func (k *Keeper) VerifyPacketAcknowledgement(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height, timeDelay, blockDelay,
-		proof, merklePath, channeltypes.CommitAcknowledgement(acknowledgement),
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height, timeDelay, blockDelay,
+			proof, merklePath, channeltypes.CommitAcknowledgement(acknowledgement),
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed packet acknowledgement verification for client (%s)", clientID)
 	}
 
@@ -303,9 +315,11 @@ func (k *Keeper) VerifyPacketReceiptAbsence(
 		return err
 	}
 
-	if err := clientModule.VerifyNonMembership(
-		ctx, clientID, height, timeDelay, blockDelay, proof, merklePath,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyNonMembership(
+			verifyCtx, clientID, height, timeDelay, blockDelay, proof, merklePath,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", clientID)
 	}
 
@@ -343,17 +357,63 @@ func (k *Keeper) VerifyNextSequenceRecv(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height,
-		timeDelay, blockDelay,
-		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceRecv),
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height,
+			timeDelay, blockDelay,
+			proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceRecv),
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed next sequence receive verification for client (%s)", clientID)
 	}
 
 	return nil
 }
 
+// VerifyCommitmentWatermark verifies a proof of the commitment watermark of the
+// specified channel at the specified port.
+func (k *Keeper) VerifyCommitmentWatermark(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	commitmentWatermark uint64,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	// get time and block delays
+	timeDelay := connection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.CommitmentWatermarkPath(portID, channelID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height,
+			timeDelay, blockDelay,
+			proof, merklePath, sdk.Uint64ToBigEndian(commitmentWatermark),
+		)
+	}); err != nil {
+		return errorsmod.Wrapf(err, "failed commitment watermark verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyChannelUpgradeError verifies a proof of the provided upgrade error receipt.
 func (k *Keeper) VerifyChannelUpgradeError(
 	ctx sdk.Context,
@@ -385,11 +445,13 @@ func (k *Keeper) VerifyChannelUpgradeError(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height,
-		0, 0, // skip delay period checks for non-packet processing verification
-		proof, merklePath, bz,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, proof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, height,
+			0, 0, // skip delay period checks for non-packet processing verification
+			proof, merklePath, bz,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed upgrade error receipt verification for client (%s)", clientID)
 	}
 
@@ -427,11 +489,13 @@ func (k *Keeper) VerifyChannelUpgrade(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, proofHeight,
-		0, 0, // skip delay period checks for non-packet processing verification
-		upgradeProof, merklePath, bz,
-	); err != nil {
+	if err := k.clientKeeper.RunProofVerification(ctx, clientID, upgradeProof, func(verifyCtx sdk.Context) error {
+		return clientModule.VerifyMembership(
+			verifyCtx, clientID, proofHeight,
+			0, 0, // skip delay period checks for non-packet processing verification
+			upgradeProof, merklePath, bz,
+		)
+	}); err != nil {
 		return errorsmod.Wrapf(err, "failed upgrade verification for client (%s) on channel (%s)", clientID, channelID)
 	}
 
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -365,6 +367,47 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 	}
 }
 
+// TestVerifyPacketCommitmentProofVerificationGas asserts that the proof verification gas configured in the
+// client params replaces the store gas of the proof verification.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentProofVerificationGas() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+
+	commitmentKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := suite.chainA.QueryProof(commitmentKey)
+	commitment := channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
+
+	verify := func() storetypes.Gas {
+		ctx, _ := suite.chainB.GetContext().CacheContext()
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitment(
+			ctx, path.EndpointB.GetConnection(), proofHeight, proof,
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment,
+		)
+		suite.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	storeGas := verify()
+
+	const baseGas = 1_000_000
+	clientKeeper := suite.chainB.App.GetIBCKeeper().ClientKeeper
+	params := clientKeeper.GetParams(suite.chainB.GetContext())
+	params.ProofVerificationGas = []clienttypes.ProofVerificationGas{clienttypes.NewProofVerificationGas(exported.Tendermint, baseGas, 10)}
+	clientKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	cost := params.ProofVerificationGas[0].GasCost(len(proof))
+	gasUsed := verify()
+	suite.Require().GreaterOrEqual(gasUsed, cost)
+	suite.Require().Less(gasUsed, storeGas+cost)
+}
+
 // TestVerifyPacketAcknowledgement has chainA verify the acknowledgement on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
@@ -19,6 +19,7 @@ type ClientKeeper interface {
 	IterateClientStates(ctx sdk.Context, prefix []byte, cb func(string, exported.ClientState) bool)
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	Route(clientID string) (exported.LightClientModule, bool)
+	RunProofVerification(ctx sdk.Context, clientID string, proof []byte, verify func(sdk.Context) error) error
 }
 
 // ParamSubspace defines the expected Subspace interface for module parameters.
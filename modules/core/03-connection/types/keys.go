@@ -31,8 +31,18 @@ const (
 
 	// ParamsKey is the store key for the IBC connection parameters
 	ParamsKey = "connectionParams"
+
+	// KeyConnectionCachePrefix is the transient store key prefix under which the OPEN connection
+	// ends resolved by the channel keeper in the current block are cached.
+	KeyConnectionCachePrefix = "connectionCache"
 )
 
+// ConnectionCacheKey returns the transient store key under which the connection end with the
+// given identifier is cached for the current block.
+func ConnectionCacheKey(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyConnectionCachePrefix, connectionID))
+}
+
 // FormatConnectionIdentifier returns the connection identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatConnectionIdentifier(sequence uint64) string {
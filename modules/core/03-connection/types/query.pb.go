@@ -149,6 +149,12 @@ func (m *QueryConnectionResponse) GetProofHeight() types.Height {
 // method
 type QueryConnectionsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional client identifier, if set only the connections associated with
+	// the client are returned
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// optional connection state, if set only the connections in the state are
+	// returned
+	State State `protobuf:"varint,3,opt,name=state,proto3,enum=ibc.core.connection.v1.State" json:"state,omitempty"`
 }
 
 func (m *QueryConnectionsRequest) Reset()         { *m = QueryConnectionsRequest{} }
@@ -191,6 +197,20 @@ func (m *QueryConnectionsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryConnectionsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConnectionsRequest) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return UNINITIALIZED
+}
+
 // QueryConnectionsResponse is the response type for the Query/Connections RPC
 // method.
 type QueryConnectionsResponse struct {
@@ -256,6 +276,135 @@ func (m *QueryConnectionsResponse) GetHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryConnectionsByCounterpartyClientRequest is the request type for the
+// Query/ConnectionsByCounterpartyClient RPC method
+type QueryConnectionsByCounterpartyClientRequest struct {
+	// client identifier on the counterparty chain associated with a connection
+	CounterpartyClientId string `protobuf:"bytes,1,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConnectionsByCounterpartyClientRequest) Reset() {
+	*m = QueryConnectionsByCounterpartyClientRequest{}
+}
+func (m *QueryConnectionsByCounterpartyClientRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConnectionsByCounterpartyClientRequest) ProtoMessage() {}
+func (*QueryConnectionsByCounterpartyClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{4}
+}
+func (m *QueryConnectionsByCounterpartyClientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionsByCounterpartyClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionsByCounterpartyClientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionsByCounterpartyClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionsByCounterpartyClientRequest.Merge(m, src)
+}
+func (m *QueryConnectionsByCounterpartyClientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionsByCounterpartyClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionsByCounterpartyClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionsByCounterpartyClientRequest proto.InternalMessageInfo
+
+func (m *QueryConnectionsByCounterpartyClientRequest) GetCounterpartyClientId() string {
+	if m != nil {
+		return m.CounterpartyClientId
+	}
+	return ""
+}
+
+func (m *QueryConnectionsByCounterpartyClientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConnectionsByCounterpartyClientResponse is the response type for the
+// Query/ConnectionsByCounterpartyClient RPC method
+type QueryConnectionsByCounterpartyClientResponse struct {
+	// list of stored connections associated with the counterparty client.
+	Connections []*IdentifiedConnection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// query block height
+	Height types.Height `protobuf:"bytes,3,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryConnectionsByCounterpartyClientResponse) Reset() {
+	*m = QueryConnectionsByCounterpartyClientResponse{}
+}
+func (m *QueryConnectionsByCounterpartyClientResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConnectionsByCounterpartyClientResponse) ProtoMessage() {}
+func (*QueryConnectionsByCounterpartyClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{5}
+}
+func (m *QueryConnectionsByCounterpartyClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionsByCounterpartyClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionsByCounterpartyClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionsByCounterpartyClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionsByCounterpartyClientResponse.Merge(m, src)
+}
+func (m *QueryConnectionsByCounterpartyClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionsByCounterpartyClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionsByCounterpartyClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionsByCounterpartyClientResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionsByCounterpartyClientResponse) GetConnections() []*IdentifiedConnection {
+	if m != nil {
+		return m.Connections
+	}
+	return nil
+}
+
+func (m *QueryConnectionsByCounterpartyClientResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryConnectionsByCounterpartyClientResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
 // QueryClientConnectionsRequest is the request type for the
 // Query/ClientConnections RPC method
 type QueryClientConnectionsRequest struct {
@@ -267,7 +416,7 @@ func (m *QueryClientConnectionsRequest) Reset()         { *m = QueryClientConnec
 func (m *QueryClientConnectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClientConnectionsRequest) ProtoMessage()    {}
 func (*QueryClientConnectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{4}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{6}
 }
 func (m *QueryClientConnectionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +467,7 @@ func (m *QueryClientConnectionsResponse) Reset()         { *m = QueryClientConne
 func (m *QueryClientConnectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClientConnectionsResponse) ProtoMessage()    {}
 func (*QueryClientConnectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{5}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{7}
 }
 func (m *QueryClientConnectionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +528,7 @@ func (m *QueryConnectionClientStateRequest) Reset()         { *m = QueryConnecti
 func (m *QueryConnectionClientStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConnectionClientStateRequest) ProtoMessage()    {}
 func (*QueryConnectionClientStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{6}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{8}
 }
 func (m *QueryConnectionClientStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +579,7 @@ func (m *QueryConnectionClientStateResponse) Reset()         { *m = QueryConnect
 func (m *QueryConnectionClientStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConnectionClientStateResponse) ProtoMessage()    {}
 func (*QueryConnectionClientStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{7}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{9}
 }
 func (m *QueryConnectionClientStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +642,7 @@ func (m *QueryConnectionConsensusStateRequest) Reset()         { *m = QueryConne
 func (m *QueryConnectionConsensusStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConnectionConsensusStateRequest) ProtoMessage()    {}
 func (*QueryConnectionConsensusStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{8}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{10}
 }
 func (m *QueryConnectionConsensusStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,7 +709,7 @@ func (m *QueryConnectionConsensusStateResponse) Reset()         { *m = QueryConn
 func (m *QueryConnectionConsensusStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConnectionConsensusStateResponse) ProtoMessage()    {}
 func (*QueryConnectionConsensusStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{9}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{11}
 }
 func (m *QueryConnectionConsensusStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +774,7 @@ func (m *QueryConnectionParamsRequest) Reset()         { *m = QueryConnectionPar
 func (m *QueryConnectionParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConnectionParamsRequest) ProtoMessage()    {}
 func (*QueryConnectionParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{10}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{12}
 }
 func (m *QueryConnectionParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +813,7 @@ func (m *QueryConnectionParamsResponse) Reset()         { *m = QueryConnectionPa
 func (m *QueryConnectionParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConnectionParamsResponse) ProtoMessage()    {}
 func (*QueryConnectionParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd8d529f8c7cd06b, []int{11}
+	return fileDescriptor_cd8d529f8c7cd06b, []int{13}
 }
 func (m *QueryConnectionParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,6 +854,8 @@ func init() {
 	proto.RegisterType((*QueryConnectionResponse)(nil), "ibc.core.connection.v1.QueryConnectionResponse")
 	proto.RegisterType((*QueryConnectionsRequest)(nil), "ibc.core.connection.v1.QueryConnectionsRequest")
 	proto.RegisterType((*QueryConnectionsResponse)(nil), "ibc.core.connection.v1.QueryConnectionsResponse")
+	proto.RegisterType((*QueryConnectionsByCounterpartyClientRequest)(nil), "ibc.core.connection.v1.QueryConnectionsByCounterpartyClientRequest")
+	proto.RegisterType((*QueryConnectionsByCounterpartyClientResponse)(nil), "ibc.core.connection.v1.QueryConnectionsByCounterpartyClientResponse")
 	proto.RegisterType((*QueryClientConnectionsRequest)(nil), "ibc.core.connection.v1.QueryClientConnectionsRequest")
 	proto.RegisterType((*QueryClientConnectionsResponse)(nil), "ibc.core.connection.v1.QueryClientConnectionsResponse")
 	proto.RegisterType((*QueryConnectionClientStateRequest)(nil), "ibc.core.connection.v1.QueryConnectionClientStateRequest")
@@ -720,66 +871,73 @@ func init() {
 }
 
 var fileDescriptor_cd8d529f8c7cd06b = []byte{
-	// 934 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xce, 0x6c, 0xd2, 0x88, 0xbc, 0x0d, 0x6d, 0x19, 0xa5, 0xed, 0x62, 0x8a, 0x1b, 0x5c, 0xd2,
-	0xa4, 0x40, 0x67, 0xba, 0x09, 0x89, 0x02, 0x24, 0x08, 0x52, 0x15, 0x92, 0x4b, 0x15, 0x8c, 0x04,
-	0x12, 0x97, 0xc8, 0xf6, 0x4e, 0x1c, 0x4b, 0x59, 0xcf, 0x76, 0xc7, 0xbb, 0x28, 0xaa, 0x22, 0x24,
-	0x7e, 0x01, 0x12, 0x17, 0x2e, 0xbd, 0x82, 0xc4, 0x3f, 0x40, 0xdc, 0x38, 0xf5, 0x58, 0x89, 0x4b,
-	0x4f, 0x15, 0x4a, 0xb8, 0xf2, 0x1f, 0x90, 0x67, 0xc6, 0xf5, 0x78, 0x77, 0x9d, 0xec, 0xae, 0x94,
-	0x9b, 0xf7, 0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0x7b, 0xcf, 0x9f, 0x17, 0x9c, 0xc8, 0x0f, 0x68, 0xc0,
-	0xdb, 0x8c, 0x06, 0x3c, 0x8e, 0x59, 0x90, 0x44, 0x3c, 0xa6, 0xdd, 0x3a, 0x7d, 0xdc, 0x61, 0xed,
-	0x23, 0xd2, 0x6a, 0xf3, 0x84, 0xe3, 0xeb, 0x91, 0x1f, 0x90, 0x34, 0x87, 0xe4, 0x39, 0xa4, 0x5b,
-	0xb7, 0xe6, 0x42, 0x1e, 0x72, 0x99, 0x42, 0xd3, 0x27, 0x95, 0x6d, 0xbd, 0x17, 0x70, 0xd1, 0xe4,
-	0x82, 0xfa, 0x9e, 0x60, 0xaa, 0x0d, 0xed, 0xd6, 0x7d, 0x96, 0x78, 0x75, 0xda, 0xf2, 0xc2, 0x28,
-	0xf6, 0x64, 0xb9, 0xca, 0xbd, 0x95, 0xdf, 0x7e, 0x18, 0xb1, 0x38, 0x49, 0x6f, 0x56, 0x4f, 0x3a,
-	0x61, 0xb1, 0x04, 0x9e, 0x01, 0x44, 0x25, 0xde, 0x0c, 0x39, 0x0f, 0x0f, 0x19, 0xf5, 0x5a, 0x11,
-	0xf5, 0xe2, 0x98, 0x27, 0xf2, 0x1a, 0xa1, 0x4f, 0xdf, 0xd4, 0xa7, 0xf2, 0x97, 0xdf, 0xd9, 0xa7,
-	0x5e, 0xac, 0xc9, 0x39, 0x9b, 0x70, 0xfd, 0xab, 0x14, 0xe4, 0x83, 0x57, 0x1d, 0x5d, 0xf6, 0xb8,
-	0xc3, 0x44, 0x82, 0x6f, 0xc3, 0xeb, 0xf9, 0x35, 0x7b, 0x51, 0xa3, 0x86, 0xe6, 0xd1, 0xd2, 0x8c,
-	0x3b, 0x9b, 0x07, 0x77, 0x1a, 0xce, 0x9f, 0x08, 0x6e, 0xf4, 0xd5, 0x8b, 0x16, 0x8f, 0x05, 0xc3,
-	0x0f, 0x01, 0xf2, 0x5c, 0x59, 0x5d, 0x5d, 0x5e, 0x20, 0x83, 0xc5, 0x24, 0x79, 0xfd, 0xc3, 0xb8,
-	0xe1, 0x1a, 0x85, 0x78, 0x0e, 0x2e, 0xb5, 0xda, 0x9c, 0xef, 0xd7, 0x2a, 0xf3, 0x68, 0x69, 0xd6,
-	0x55, 0x3f, 0xf0, 0x03, 0x98, 0x95, 0x0f, 0x7b, 0x07, 0x2c, 0x0a, 0x0f, 0x92, 0xda, 0xa4, 0x6c,
-	0x6f, 0x19, 0xed, 0x95, 0x8e, 0xdd, 0x3a, 0xd9, 0x96, 0x19, 0x5b, 0x53, 0xcf, 0x5e, 0xde, 0x9a,
-	0x70, 0xab, 0xb2, 0x4a, 0x85, 0x1c, 0xaf, 0x0f, 0xbc, 0xc8, 0xd8, 0x7f, 0x01, 0x90, 0x8f, 0x4b,
-	0x83, 0xbf, 0x43, 0xd4, 0x6c, 0x49, 0x3a, 0x5b, 0xa2, 0x56, 0x44, 0xcf, 0x96, 0xec, 0x7a, 0x21,
-	0xd3, 0xb5, 0xae, 0x51, 0xe9, 0xfc, 0x87, 0xa0, 0xd6, 0x7f, 0x87, 0x56, 0xe8, 0x11, 0x54, 0x73,
-	0xa2, 0xa2, 0x86, 0xe6, 0x27, 0x97, 0xaa, 0xcb, 0x1f, 0x94, 0x49, 0xb4, 0xd3, 0x60, 0x71, 0x12,
-	0xed, 0x47, 0xac, 0x61, 0x88, 0x6d, 0x36, 0xc0, 0x5f, 0x16, 0x40, 0x57, 0x24, 0xe8, 0xc5, 0x73,
-	0x41, 0x2b, 0x30, 0x26, 0x6a, 0xbc, 0x0e, 0xd3, 0x23, 0xea, 0xaa, 0xf3, 0x9d, 0x0d, 0x78, 0x5b,
-	0xd1, 0x95, 0x69, 0x03, 0x84, 0x7d, 0x0b, 0x66, 0x54, 0x8b, 0x7c, 0xa5, 0x5e, 0x53, 0x81, 0x9d,
-	0x86, 0xf3, 0x2b, 0x02, 0xbb, 0xac, 0x5c, 0x6b, 0x76, 0x17, 0xae, 0x1a, 0x6b, 0xd9, 0xf2, 0x92,
-	0x03, 0x25, 0xdc, 0x8c, 0x7b, 0x25, 0x8f, 0xef, 0xa6, 0xe1, 0x8b, 0xdc, 0x9c, 0x6d, 0x78, 0xa7,
-	0x67, 0xaa, 0x0a, 0xf1, 0xd7, 0x89, 0x97, 0xb0, 0x91, 0xde, 0xa0, 0x13, 0x04, 0xce, 0x59, 0xad,
-	0x34, 0x6d, 0x0f, 0x6e, 0x44, 0xaf, 0xe6, 0xbf, 0xa7, 0x15, 0x14, 0x69, 0x8a, 0x5e, 0xce, 0xbb,
-	0x83, 0x08, 0x18, 0x2b, 0x63, 0xf4, 0xbc, 0x16, 0x0d, 0x0a, 0x5f, 0xa4, 0x5c, 0x4f, 0x11, 0xbc,
-	0xdb, 0x4b, 0x32, 0xa5, 0x15, 0x8b, 0x8e, 0x18, 0x59, 0x32, 0xbc, 0x08, 0x57, 0xda, 0xac, 0x1b,
-	0x89, 0x34, 0x25, 0xee, 0x34, 0x7d, 0xd6, 0x96, 0x90, 0xa7, 0xdc, 0xcb, 0x59, 0xf8, 0x91, 0x8c,
-	0x16, 0x12, 0x0d, 0xf8, 0x46, 0xa2, 0xc6, 0xf7, 0x12, 0xc1, 0xc2, 0x39, 0xf8, 0xf4, 0x1c, 0x36,
-	0x21, 0x5d, 0x33, 0x75, 0x52, 0xd0, 0x7f, 0x8e, 0x28, 0x93, 0x25, 0x99, 0xc9, 0x92, 0xcf, 0xe3,
-	0x23, 0xf7, 0x72, 0x50, 0x68, 0x53, 0xdc, 0xfe, 0x4a, 0x71, 0xfb, 0xf3, 0x01, 0x4c, 0x9e, 0x35,
-	0x80, 0xa9, 0x71, 0x06, 0x60, 0xc3, 0xcd, 0x1e, 0x7e, 0xbb, 0x5e, 0xdb, 0x6b, 0x66, 0x6f, 0xa5,
-	0xf3, 0x6d, 0xf6, 0xda, 0xf6, 0x9d, 0x6b, 0xde, 0x6b, 0x30, 0xdd, 0x92, 0x11, 0x4d, 0xd7, 0x2e,
-	0x73, 0x29, 0x5d, 0xa7, 0xb3, 0x97, 0xff, 0x98, 0x81, 0x4b, 0xb2, 0x33, 0xfe, 0x1d, 0x01, 0xe4,
-	0xed, 0x31, 0x29, 0x6b, 0x30, 0xf8, 0x73, 0x64, 0xd1, 0xa1, 0xf3, 0x15, 0x62, 0xe7, 0x93, 0x1f,
-	0xff, 0xfe, 0xf7, 0xe7, 0xca, 0x2a, 0x5e, 0xa1, 0xe7, 0x7e, 0x44, 0x05, 0x7d, 0x52, 0xd8, 0xba,
-	0x63, 0xfc, 0x14, 0x41, 0xd5, 0x70, 0x1f, 0x3c, 0xec, 0xed, 0x99, 0xa0, 0xd6, 0xfd, 0xe1, 0x0b,
-	0x34, 0xde, 0xf7, 0x25, 0xde, 0x05, 0x7c, 0x7b, 0x08, 0xbc, 0xf8, 0x2f, 0x04, 0x6f, 0xf4, 0x79,
-	0x24, 0x5e, 0x3d, 0xfb, 0xd2, 0x12, 0x4b, 0xb6, 0xd6, 0x46, 0x2d, 0xd3, 0x88, 0x3f, 0x95, 0x88,
-	0xd7, 0xf1, 0x5a, 0x29, 0x62, 0xb5, 0xea, 0x45, 0xa1, 0xb3, 0xf5, 0x3f, 0xc6, 0x2f, 0x10, 0x5c,
-	0x1b, 0xe8, 0x7a, 0xf8, 0xa3, 0x21, 0xd5, 0xeb, 0x37, 0x5d, 0xeb, 0xe3, 0x71, 0x4a, 0x35, 0xa1,
-	0x6d, 0x49, 0x68, 0x0b, 0x7f, 0x36, 0xc6, 0xca, 0x50, 0xd3, 0x93, 0xf1, 0x2f, 0x15, 0xa8, 0x95,
-	0x79, 0x09, 0xde, 0x18, 0x16, 0xe2, 0x20, 0x8b, 0xb4, 0x36, 0xc7, 0xac, 0xd6, 0x1c, 0x7f, 0x90,
-	0x1c, 0x8f, 0xf0, 0xf7, 0x63, 0x71, 0x2c, 0x5a, 0x1f, 0xcd, 0x6c, 0x94, 0x3e, 0xe9, 0x31, 0xe4,
-	0x63, 0xaa, 0xdc, 0xca, 0x38, 0x50, 0x81, 0x63, 0xfc, 0x1b, 0x82, 0xab, 0xbd, 0x36, 0x83, 0x3f,
-	0x1c, 0x92, 0x54, 0xc1, 0xb5, 0xac, 0xd5, 0x11, 0xab, 0xb4, 0x04, 0x77, 0xa4, 0x04, 0xf3, 0xd8,
-	0x2e, 0x93, 0x40, 0x79, 0xd7, 0xd6, 0x37, 0xcf, 0x4e, 0x6c, 0xf4, 0xfc, 0xc4, 0x46, 0xff, 0x9c,
-	0xd8, 0xe8, 0xa7, 0x53, 0x7b, 0xe2, 0xf9, 0xa9, 0x3d, 0xf1, 0xe2, 0xd4, 0x9e, 0xf8, 0x6e, 0x23,
-	0x8c, 0x92, 0x83, 0x8e, 0x4f, 0x02, 0xde, 0xa4, 0xfa, 0xff, 0x7e, 0xe4, 0x07, 0xf7, 0x42, 0x4e,
-	0xbb, 0xeb, 0xb4, 0xc9, 0x1b, 0x9d, 0x43, 0x26, 0x54, 0xe3, 0xfb, 0x2b, 0xf7, 0x8c, 0xde, 0xc9,
-	0x51, 0x8b, 0x09, 0x7f, 0x5a, 0x7e, 0x22, 0x56, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x46, 0x93,
-	0x88, 0xce, 0x7d, 0x0c, 0x00, 0x00,
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0xcf, 0x6c, 0x7e, 0xe8, 0x9b, 0xb7, 0xf9, 0x26, 0x65, 0x94, 0xa6, 0x8b, 0x69, 0x9c, 0xe0,
+	0x92, 0x26, 0xa5, 0xad, 0xa7, 0x9b, 0x34, 0x51, 0x80, 0x04, 0x41, 0x96, 0x96, 0xe4, 0x52, 0xa5,
+	0xae, 0x04, 0x82, 0x4b, 0xe4, 0xf5, 0x4e, 0x36, 0x96, 0xb2, 0x9e, 0xad, 0xed, 0x5d, 0xb4, 0xaa,
+	0x22, 0x04, 0x17, 0xae, 0x48, 0x5c, 0xb8, 0xf4, 0x0a, 0x12, 0x1c, 0x39, 0x72, 0x83, 0x4b, 0x8f,
+	0x95, 0xb8, 0xf4, 0x54, 0xa1, 0x84, 0x2b, 0xff, 0x03, 0xf2, 0xcc, 0x38, 0xb6, 0x77, 0xed, 0x8d,
+	0x77, 0x51, 0x2f, 0xdc, 0xec, 0x99, 0xcf, 0x7b, 0xfe, 0x7c, 0x3e, 0x6f, 0xe6, 0xbd, 0x5d, 0xd0,
+	0xec, 0xaa, 0x45, 0x2c, 0xe6, 0x52, 0x62, 0x31, 0xc7, 0xa1, 0x96, 0x6f, 0x33, 0x87, 0xb4, 0xcb,
+	0xe4, 0x71, 0x8b, 0xba, 0x1d, 0xbd, 0xe9, 0x32, 0x9f, 0xe1, 0x39, 0xbb, 0x6a, 0xe9, 0x01, 0x46,
+	0x8f, 0x30, 0x7a, 0xbb, 0xac, 0xcc, 0xd6, 0x59, 0x9d, 0x71, 0x08, 0x09, 0x9e, 0x04, 0x5a, 0x79,
+	0xdb, 0x62, 0x5e, 0x83, 0x79, 0xa4, 0x6a, 0x7a, 0x54, 0xa4, 0x21, 0xed, 0x72, 0x95, 0xfa, 0x66,
+	0x99, 0x34, 0xcd, 0xba, 0xed, 0x98, 0x3c, 0x5c, 0x60, 0x17, 0xa2, 0xaf, 0x1f, 0xdb, 0xd4, 0xf1,
+	0x83, 0x2f, 0x8b, 0x27, 0x09, 0x58, 0xce, 0xa0, 0x17, 0xbd, 0x49, 0xe0, 0xd5, 0x3a, 0x63, 0xf5,
+	0x63, 0x4a, 0xcc, 0xa6, 0x4d, 0x4c, 0xc7, 0x61, 0x3e, 0xff, 0x8c, 0x27, 0x77, 0x5f, 0x97, 0xbb,
+	0xfc, 0xad, 0xda, 0x3a, 0x24, 0xa6, 0x23, 0xc5, 0x69, 0xdb, 0x30, 0xf7, 0x30, 0x20, 0x59, 0x39,
+	0xcf, 0x68, 0xd0, 0xc7, 0x2d, 0xea, 0xf9, 0xf8, 0x1a, 0xfc, 0x3f, 0xfa, 0xcc, 0x81, 0x5d, 0x2b,
+	0xa1, 0x45, 0xb4, 0x32, 0x69, 0x4c, 0x45, 0x8b, 0x7b, 0x35, 0xed, 0x57, 0x04, 0x57, 0x7a, 0xe2,
+	0xbd, 0x26, 0x73, 0x3c, 0x8a, 0xef, 0x01, 0x44, 0x58, 0x1e, 0x5d, 0x5c, 0x5d, 0xd2, 0xd3, 0xcd,
+	0xd4, 0xa3, 0xf8, 0x7b, 0x4e, 0xcd, 0x88, 0x05, 0xe2, 0x59, 0x18, 0x6f, 0xba, 0x8c, 0x1d, 0x96,
+	0x0a, 0x8b, 0x68, 0x65, 0xca, 0x10, 0x2f, 0xb8, 0x02, 0x53, 0xfc, 0xe1, 0xe0, 0x88, 0xda, 0xf5,
+	0x23, 0xbf, 0x34, 0xca, 0xd3, 0x2b, 0xb1, 0xf4, 0xc2, 0xc7, 0x76, 0x59, 0xdf, 0xe5, 0x88, 0x9d,
+	0xb1, 0x67, 0x2f, 0x17, 0x46, 0x8c, 0x22, 0x8f, 0x12, 0x4b, 0xda, 0x2f, 0xbd, 0xec, 0xbd, 0x50,
+	0xfe, 0x7d, 0x80, 0xa8, 0x5e, 0x92, 0xfd, 0x75, 0x5d, 0x14, 0x57, 0x0f, 0x8a, 0xab, 0x8b, 0x33,
+	0x22, 0x8b, 0xab, 0xef, 0x9b, 0x75, 0x2a, 0x63, 0x8d, 0x58, 0x24, 0x7e, 0x03, 0x26, 0x05, 0x95,
+	0xc0, 0xc2, 0x02, 0xb7, 0xf0, 0x7f, 0x62, 0x61, 0xaf, 0x86, 0xd7, 0x60, 0xdc, 0xf3, 0x4d, 0x9f,
+	0x72, 0xfa, 0xd3, 0xab, 0xf3, 0x59, 0xee, 0x3c, 0x0a, 0x40, 0x86, 0xc0, 0x6a, 0x7f, 0x23, 0x28,
+	0xf5, 0xb2, 0x96, 0xa6, 0x3f, 0x80, 0x62, 0x14, 0xea, 0x95, 0xd0, 0xe2, 0xe8, 0x4a, 0x71, 0xf5,
+	0x56, 0x56, 0xde, 0xbd, 0x1a, 0x75, 0x7c, 0xfb, 0xd0, 0xa6, 0xb5, 0x58, 0xfd, 0xe2, 0x09, 0xf0,
+	0xc7, 0x09, 0x1b, 0x0a, 0xdc, 0x86, 0xe5, 0x0b, 0x6d, 0x10, 0x64, 0x12, 0x3e, 0x6c, 0xc2, 0xc4,
+	0x80, 0xa5, 0x92, 0x78, 0xed, 0x67, 0x04, 0x37, 0xbb, 0xf5, 0xee, 0x74, 0x2a, 0xac, 0xe5, 0xf8,
+	0xd4, 0x6d, 0x9a, 0xae, 0xdf, 0xa9, 0xf0, 0x04, 0x61, 0xe5, 0xee, 0xc2, 0x9c, 0x15, 0xdb, 0x3c,
+	0x88, 0xec, 0x17, 0x27, 0x78, 0xd6, 0xea, 0x09, 0xdd, 0xab, 0xe1, 0xfb, 0x29, 0x42, 0x87, 0xa8,
+	0xb7, 0xf6, 0x55, 0x01, 0x6e, 0xe5, 0x63, 0xfb, 0xdf, 0xad, 0xd8, 0x16, 0xcc, 0x0b, 0x0b, 0x38,
+	0x2c, 0xe5, 0x72, 0x25, 0x2e, 0x05, 0x4a, 0x5e, 0x0a, 0xed, 0x07, 0x04, 0x6a, 0x56, 0xb8, 0xf4,
+	0xec, 0x06, 0x5c, 0x8a, 0xf5, 0xa6, 0xa6, 0xe9, 0x1f, 0x09, 0xe3, 0x26, 0x8d, 0x99, 0x68, 0x7d,
+	0x3f, 0x58, 0x7e, 0x95, 0xed, 0x63, 0x17, 0xde, 0xec, 0xaa, 0xb4, 0x60, 0x2c, 0x6e, 0xeb, 0x20,
+	0x6d, 0xf4, 0x14, 0x81, 0xd6, 0x2f, 0x95, 0x94, 0x6d, 0xc2, 0x15, 0xfb, 0xbc, 0xfe, 0xe1, 0xb9,
+	0x16, 0x0d, 0x44, 0x34, 0xa8, 0x1b, 0x69, 0x02, 0x62, 0x47, 0x26, 0x96, 0xf3, 0xb2, 0x9d, 0xb6,
+	0xfc, 0x2a, 0xed, 0x7a, 0x8a, 0xe0, 0xad, 0x6e, 0x91, 0x81, 0x2c, 0xc7, 0x6b, 0x79, 0x03, 0x5b,
+	0x86, 0x97, 0x61, 0xc6, 0xa5, 0x6d, 0xdb, 0x0b, 0x20, 0x4e, 0xab, 0x51, 0xa5, 0x2e, 0xa7, 0x3c,
+	0x66, 0x4c, 0x87, 0xcb, 0x0f, 0xf8, 0x6a, 0x02, 0x18, 0xa3, 0x1f, 0x03, 0x4a, 0x7e, 0x2f, 0x11,
+	0x2c, 0x5d, 0xc0, 0x4f, 0xd6, 0x61, 0x1b, 0x66, 0xac, 0x70, 0x27, 0xe1, 0xff, 0xac, 0x2e, 0x26,
+	0xad, 0x1e, 0x4e, 0x5a, 0xfd, 0x43, 0xa7, 0x63, 0x4c, 0x5b, 0x89, 0x34, 0xfd, 0x47, 0xc2, 0x79,
+	0x01, 0x46, 0xfb, 0x15, 0x60, 0x6c, 0x98, 0x02, 0xa8, 0x70, 0xb5, 0x4b, 0xdf, 0xbe, 0xe9, 0x9a,
+	0x8d, 0xf0, 0x56, 0x6a, 0x9f, 0xc2, 0x7c, 0xc6, 0xbe, 0xd4, 0xbd, 0x01, 0x13, 0x4d, 0xbe, 0x22,
+	0xe5, 0xaa, 0x59, 0x5d, 0x4a, 0xc6, 0x49, 0xf4, 0xea, 0xef, 0x45, 0x18, 0xe7, 0x99, 0xf1, 0x4f,
+	0x08, 0x20, 0x4a, 0x8f, 0xf5, 0xac, 0x04, 0xe9, 0xbf, 0x49, 0x14, 0x92, 0x1b, 0x2f, 0x18, 0x6b,
+	0xef, 0x7d, 0xfd, 0xc7, 0x5f, 0xdf, 0x15, 0xd6, 0xf1, 0x1a, 0xb9, 0xf0, 0x97, 0x94, 0x47, 0x9e,
+	0x24, 0x4e, 0xdd, 0x09, 0x7e, 0x8a, 0xa0, 0x18, 0xe5, 0xf4, 0x70, 0xde, 0xaf, 0x87, 0x86, 0x2a,
+	0x77, 0xf2, 0x07, 0x48, 0xbe, 0x37, 0x39, 0xdf, 0x25, 0x7c, 0x2d, 0x07, 0x5f, 0xfc, 0x4d, 0x01,
+	0x16, 0x2e, 0x98, 0x32, 0xb8, 0x92, 0x97, 0x42, 0x9f, 0x89, 0xaa, 0x7c, 0xf4, 0xef, 0x92, 0x48,
+	0x6d, 0x9f, 0x71, 0x6d, 0x8f, 0xf0, 0xc3, 0x6c, 0x6d, 0x3d, 0x53, 0x9b, 0x17, 0x25, 0x6d, 0x96,
+	0x9f, 0x24, 0x9c, 0xf8, 0x0d, 0xc1, 0x6b, 0x3d, 0xd3, 0x02, 0xaf, 0xf7, 0xa7, 0x9d, 0x31, 0x9c,
+	0x94, 0x8d, 0x41, 0xc3, 0xa4, 0xbe, 0xf7, 0xb9, 0xbe, 0x4d, 0xbc, 0x91, 0xa9, 0x4f, 0x90, 0x4f,
+	0x1e, 0xb9, 0x73, 0x41, 0xf8, 0x05, 0x82, 0xcb, 0xa9, 0xfd, 0x1f, 0xbf, 0x93, 0xd3, 0xff, 0xde,
+	0xf1, 0xa3, 0xbc, 0x3b, 0x4c, 0xa8, 0x14, 0xb4, 0xcb, 0x05, 0xed, 0xe0, 0x0f, 0x86, 0xb8, 0x3c,
+	0x24, 0x3e, 0x9d, 0xf0, 0xf7, 0x05, 0x28, 0x65, 0x75, 0x55, 0xbc, 0x95, 0x97, 0x62, 0xda, 0xb0,
+	0x50, 0xb6, 0x87, 0x8c, 0x96, 0x1a, 0xbf, 0xe4, 0x1a, 0x3b, 0xf8, 0x8b, 0xa1, 0x34, 0x26, 0x87,
+	0x00, 0x09, 0x07, 0x0a, 0x79, 0xd2, 0x35, 0x9a, 0x4e, 0x88, 0xe8, 0xdb, 0xb1, 0x0d, 0xb1, 0x70,
+	0x82, 0x7f, 0x44, 0x70, 0xa9, 0xbb, 0xe1, 0xe2, 0xbb, 0x39, 0x45, 0x25, 0xfa, 0xb7, 0xb2, 0x3e,
+	0x60, 0x94, 0xb4, 0xe0, 0x3a, 0xb7, 0x60, 0x11, 0xab, 0x59, 0x16, 0x88, 0x2e, 0xbe, 0xf3, 0xc9,
+	0xb3, 0x53, 0x15, 0x3d, 0x3f, 0x55, 0xd1, 0x9f, 0xa7, 0x2a, 0xfa, 0xf6, 0x4c, 0x1d, 0x79, 0x7e,
+	0xa6, 0x8e, 0xbc, 0x38, 0x53, 0x47, 0x3e, 0xdf, 0xaa, 0xdb, 0xfe, 0x51, 0xab, 0xaa, 0x5b, 0xac,
+	0x41, 0xe4, 0xdf, 0x5f, 0xbb, 0x6a, 0xdd, 0xae, 0x33, 0xd2, 0xde, 0x24, 0x0d, 0x56, 0x6b, 0x1d,
+	0x53, 0x4f, 0x24, 0xbe, 0xb3, 0x76, 0x3b, 0x96, 0xdb, 0xef, 0x34, 0xa9, 0x57, 0x9d, 0xe0, 0xc3,
+	0x72, 0xed, 0x9f, 0x01, 0x00, 0xdc, 0x71, 0xe9, 0xfc, 0x8c, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -798,6 +956,9 @@ type QueryClient interface {
 	Connection(ctx context.Context, in *QueryConnectionRequest, opts ...grpc.CallOption) (*QueryConnectionResponse, error)
 	// Connections queries all the IBC connections of a chain.
 	Connections(ctx context.Context, in *QueryConnectionsRequest, opts ...grpc.CallOption) (*QueryConnectionsResponse, error)
+	// ConnectionsByCounterpartyClient queries all the IBC connections of a chain
+	// associated with the provided client on the counterparty chain.
+	ConnectionsByCounterpartyClient(ctx context.Context, in *QueryConnectionsByCounterpartyClientRequest, opts ...grpc.CallOption) (*QueryConnectionsByCounterpartyClientResponse, error)
 	// ClientConnections queries the connection paths associated with a client
 	// state.
 	ClientConnections(ctx context.Context, in *QueryClientConnectionsRequest, opts ...grpc.CallOption) (*QueryClientConnectionsResponse, error)
@@ -837,6 +998,15 @@ func (c *queryClient) Connections(ctx context.Context, in *QueryConnectionsReque
 	return out, nil
 }
 
+func (c *queryClient) ConnectionsByCounterpartyClient(ctx context.Context, in *QueryConnectionsByCounterpartyClientRequest, opts ...grpc.CallOption) (*QueryConnectionsByCounterpartyClientResponse, error) {
+	out := new(QueryConnectionsByCounterpartyClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ConnectionsByCounterpartyClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ClientConnections(ctx context.Context, in *QueryClientConnectionsRequest, opts ...grpc.CallOption) (*QueryClientConnectionsResponse, error) {
 	out := new(QueryClientConnectionsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ClientConnections", in, out, opts...)
@@ -879,6 +1049,9 @@ type QueryServer interface {
 	Connection(context.Context, *QueryConnectionRequest) (*QueryConnectionResponse, error)
 	// Connections queries all the IBC connections of a chain.
 	Connections(context.Context, *QueryConnectionsRequest) (*QueryConnectionsResponse, error)
+	// ConnectionsByCounterpartyClient queries all the IBC connections of a chain
+	// associated with the provided client on the counterparty chain.
+	ConnectionsByCounterpartyClient(context.Context, *QueryConnectionsByCounterpartyClientRequest) (*QueryConnectionsByCounterpartyClientResponse, error)
 	// ClientConnections queries the connection paths associated with a client
 	// state.
 	ClientConnections(context.Context, *QueryClientConnectionsRequest) (*QueryClientConnectionsResponse, error)
@@ -902,6 +1075,9 @@ func (*UnimplementedQueryServer) Connection(ctx context.Context, req *QueryConne
 func (*UnimplementedQueryServer) Connections(ctx context.Context, req *QueryConnectionsRequest) (*QueryConnectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Connections not implemented")
 }
+func (*UnimplementedQueryServer) ConnectionsByCounterpartyClient(ctx context.Context, req *QueryConnectionsByCounterpartyClientRequest) (*QueryConnectionsByCounterpartyClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionsByCounterpartyClient not implemented")
+}
 func (*UnimplementedQueryServer) ClientConnections(ctx context.Context, req *QueryClientConnectionsRequest) (*QueryClientConnectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClientConnections not implemented")
 }
@@ -955,6 +1131,24 @@ func _Query_Connections_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConnectionsByCounterpartyClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionsByCounterpartyClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionsByCounterpartyClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Query/ConnectionsByCounterpartyClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionsByCounterpartyClient(ctx, req.(*QueryConnectionsByCounterpartyClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ClientConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryClientConnectionsRequest)
 	if err := dec(in); err != nil {
@@ -1039,6 +1233,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Connections",
 			Handler:    _Query_Connections_Handler,
 		},
+		{
+			MethodName: "ConnectionsByCounterpartyClient",
+			Handler:    _Query_ConnectionsByCounterpartyClient_Handler,
+		},
 		{
 			MethodName: "ClientConnections",
 			Handler:    _Query_ClientConnections_Handler,
@@ -1162,6 +1360,18 @@ func (m *QueryConnectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1236,6 +1446,107 @@ func (m *QueryConnectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConnectionsByCounterpartyClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionsByCounterpartyClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionsByCounterpartyClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CounterpartyClientId) > 0 {
+		i -= len(m.CounterpartyClientId)
+		copy(dAtA[i:], m.CounterpartyClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConnectionsByCounterpartyClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionsByCounterpartyClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionsByCounterpartyClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Connections) > 0 {
+		for iNdEx := len(m.Connections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryClientConnectionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1607,6 +1918,13 @@ func (m *QueryConnectionsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
 	return n
 }
 
@@ -1631,41 +1949,79 @@ func (m *QueryConnectionsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryClientConnectionsRequest) Size() (n int) {
+func (m *QueryConnectionsByCounterpartyClientRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ClientId)
+	l = len(m.CounterpartyClientId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryClientConnectionsResponse) Size() (n int) {
+func (m *QueryConnectionsByCounterpartyClientResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ConnectionPaths) > 0 {
-		for _, s := range m.ConnectionPaths {
-			l = len(s)
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.Proof)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = m.ProofHeight.Size()
+	l = m.Height.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryConnectionClientStateRequest) Size() (n int) {
+func (m *QueryClientConnectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientConnectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConnectionPaths) > 0 {
+		for _, s := range m.ConnectionPaths {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConnectionClientStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2067,6 +2423,57 @@ func (m *QueryConnectionsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2241,6 +2648,277 @@ func (m *QueryConnectionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConnectionsByCounterpartyClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionsByCounterpartyClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionsByCounterpartyClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionsByCounterpartyClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionsByCounterpartyClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionsByCounterpartyClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, &IdentifiedConnection{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryClientConnectionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
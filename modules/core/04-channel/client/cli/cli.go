@@ -23,9 +23,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryChannel(),
 		GetCmdQueryConnectionChannels(),
 		GetCmdQueryChannelClientState(),
+		GetCmdQueryChannelCounterpartyChainID(),
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
 		GetCmdQueryPacketReceipt(),
+		GetCmdQueryPacketReceipts(),
 		GetCmdQueryPacketAcknowledgement(),
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
@@ -33,7 +35,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryNextSequenceSend(),
 		GetCmdQueryUpgradeError(),
 		GetCmdQueryUpgrade(),
+		GetCmdQueryUpgradeSequence(),
+		GetCmdQueryUpgradeCompatibility(),
 		GetCmdChannelParams(),
+		GetCmdQueryPacketCommitmentsAtHeight(),
 	)
 
 	return queryCmd
@@ -51,7 +56,10 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		newUpgradeChannelsTxCmd(),
+		newUpgradeCancelTxCmd(),
 		newPruneAcknowledgementsTxCmd(),
+		newPruneStaleInitChannelTxCmd(),
+		newChannelHandshakeTxCmd(),
 	)
 
 	return txCmd
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -16,7 +17,9 @@ import (
 )
 
 const (
-	flagSequences = "sequences"
+	flagSequences      = "sequences"
+	flagOrdering       = "ordering"
+	flagConnectionHops = "connection-hops"
 )
 
 // GetCmdQueryChannels defines the command to query all the channels ends
@@ -163,6 +166,40 @@ func GetCmdQueryChannelClientState() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelCounterpartyChainID defines the command to query the counterparty chain identifier of a channel
+func GetCmdQueryChannelCounterpartyChainID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-chain-id [port-id] [channel-id]",
+		Short:   "Query the counterparty chain identifier of a channel",
+		Long:    "Query the chain identifier of the counterparty chain of a channel, resolved through the channel's connection and light client.",
+		Example: fmt.Sprintf("%s query ibc channel counterparty-chain-id [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelCounterpartyChainIDRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelCounterpartyChainID(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPacketCommitments defines the command to query all packet commitments associated with
 // a channel
 func GetCmdQueryPacketCommitments() *cobra.Command {
@@ -282,6 +319,56 @@ func GetCmdQueryPacketReceipt() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPacketReceipts defines the command to query the existence of packet receipts for a list of sequences
+func GetCmdQueryPacketReceipts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-receipts [port-id] [channel-id]",
+		Short: "Query the existence of packet receipts for a list of sequences",
+		Long: `Query the existence of packet receipts for a list of packet sequences.
+
+The returned receipts bitmap has the bit at index i set if a packet receipt exists for the i-th provided sequence.
+Bits are ordered least significant bit first within each byte. At most 1000 sequences can be queried at once.
+`,
+		Example: fmt.Sprintf("%s query %s %s packet-receipts [port-id] [channel-id] --sequences=1,2,3", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seqSlice, err := cmd.Flags().GetInt64Slice(flagSequences)
+			if err != nil {
+				return err
+			}
+
+			seqs := make([]uint64, len(seqSlice))
+			for i := range seqSlice {
+				seqs[i] = uint64(seqSlice[i])
+			}
+
+			req := &types.QueryPacketReceiptsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequences: seqs,
+			}
+
+			res, err := queryClient.PacketReceipts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64Slice(flagSequences, []int64{}, "comma separated list of packet sequence numbers")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPacketAcknowledgement defines the command to query a packet acknowledgement
 func GetCmdQueryPacketAcknowledgement() *cobra.Command {
 	cmd := &cobra.Command{
@@ -560,6 +647,115 @@ func GetCmdQueryUpgrade() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryUpgradeSequence defines the command to query for the upgrade sequence and pending upgrade timeouts of a channel
+func GetCmdQueryUpgradeSequence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-sequence [port-id] [channel-id]",
+		Short: "Query the upgrade sequence and pending upgrade timeouts",
+		Long:  "Query the upgrade sequence of a channel along with the timeouts of its pending upgrade, if an upgrade is in progress",
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade-sequence [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUpgradeSequenceRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.UpgradeSequence(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpgradeCompatibility defines the command to query whether proposed upgrade fields are compatible with
+// the current state of a channel
+func GetCmdQueryUpgradeCompatibility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-compatibility [port-id] [channel-id] [version]",
+		Short: "Query whether a proposed channel upgrade conflicts with the state of the channel",
+		Long: `Query whether an upgrade of a channel to the proposed version, ordering and connection hops would be accepted
+in the channel's current state, listing every conflict otherwise. The ordering and connection hops of the channel
+are proposed unless they are provided with flags.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade-compatibility [port-id] [channel-id] [version] --%s ORDER_UNORDERED --%s connection-0",
+			version.AppName, ibcexported.ModuleName, types.SubModuleName, flagOrdering, flagConnectionHops,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			channelRes, err := queryClient.Channel(cmd.Context(), &types.QueryChannelRequest{PortId: args[0], ChannelId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			upgradeFields := types.NewUpgradeFields(channelRes.Channel.Ordering, channelRes.Channel.ConnectionHops, args[2])
+
+			ordering, err := cmd.Flags().GetString(flagOrdering)
+			if err != nil {
+				return err
+			}
+
+			if ordering != "" {
+				order, found := types.Order_value[ordering]
+				if !found {
+					return fmt.Errorf("invalid channel ordering %s", ordering)
+				}
+
+				upgradeFields.Ordering = types.Order(order)
+			}
+
+			connectionHops, err := cmd.Flags().GetString(flagConnectionHops)
+			if err != nil {
+				return err
+			}
+
+			if connectionHops != "" {
+				upgradeFields.ConnectionHops = strings.Split(connectionHops, ",")
+			}
+
+			req := &types.QueryChannelUpgradeCompatibilityRequest{
+				PortId:                args[0],
+				ChannelId:             args[1],
+				ProposedUpgradeFields: upgradeFields,
+			}
+
+			res, err := queryClient.ChannelUpgradeCompatibility(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOrdering, "", "proposed channel ordering (ORDER_ORDERED or ORDER_UNORDERED), defaults to the channel ordering")
+	cmd.Flags().String(flagConnectionHops, "", "comma separated list of proposed connection hops, defaults to the channel connection hops")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdChannelParams returns the command handler for ibc channel parameter querying.
 func GetCmdChannelParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -584,3 +780,50 @@ func GetCmdChannelParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryPacketCommitmentsAtHeight defines the command to query the packet commitments of all channels
+// stored at a given height
+func GetCmdQueryPacketCommitmentsAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-commitments-at-height [height]",
+		Short: "Query the packet commitments of all channels stored at a given height",
+		Long: `Query the packet commitments of all channels stored at a given height, together with their commitment hashes.
+The query is served from the state at the given height, which for historical heights requires an archival node.`,
+		Example: fmt.Sprintf("%s query %s %s packet-commitments-at-height [height]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx.WithHeight(int64(height)))
+			req := &types.QueryPacketCommitmentsAtHeightRequest{
+				Height:     height,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PacketCommitmentsAtHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet commitments stored at the given height")
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"slices"
@@ -16,8 +17,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
+	clientutils "github.com/cosmos/ibc-go/v8/modules/core/02-client/client/utils"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectionutils "github.com/cosmos/ibc-go/v8/modules/core/03-connection/client/utils"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/client/utils"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcclient "github.com/cosmos/ibc-go/v8/modules/core/client"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
 const (
@@ -25,6 +32,11 @@ const (
 	flagPortPattern = "port-pattern"
 	flagExpedited   = "expedited"
 	flagChannelIDs  = "channel-ids"
+
+	flagCounterpartyNode = "counterparty-node"
+
+	flagOrdered        = "ordered"
+	flagChannelVersion = "channel-version"
 )
 
 // newPruneAcknowledgementsTxCmd returns the command to create a new MsgPruneAcknowledgements transaction
@@ -59,6 +71,135 @@ func newPruneAcknowledgementsTxCmd() *cobra.Command {
 	return cmd
 }
 
+// newPruneStaleInitChannelTxCmd returns the command to create a new MsgPruneStaleInitChannel transaction
+func newPruneStaleInitChannelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-stale-init-channel [port] [channel]",
+		Short: "Prune a channel which remained in the INIT state for longer than the stale INIT channel age",
+		Long: `Prune a channel which remained in the INIT state for longer than the stale INIT channel age defined
+		in the channel parameters. The channel end and its sequences are deleted from IBC state.`,
+		Example: fmt.Sprintf("%s tx %s %s prune-stale-init-channel transfer channel-0", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, channelID := args[0], args[1]
+
+			signer := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgPruneStaleInitChannel(portID, channelID, signer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newUpgradeCancelTxCmd returns the command to create a new MsgChannelUpgradeCancel transaction
+func newUpgradeCancelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-cancel [port] [channel]",
+		Short: "Cancel a channel upgrade handshake",
+		Long: `Cancel a channel upgrade handshake which failed on the counterparty. If a counterparty node is provided,
+		the error receipt written by the counterparty and its proof are fetched from the counterparty node. The proof is
+		queried at the latest height of the channel's light client, which must be a 07-tendermint client. If no counterparty
+		node is provided, the message is constructed without an error receipt, which is only valid when signed by the authority.`,
+		Example: fmt.Sprintf("%s tx %s %s upgrade-cancel transfer channel-0 --counterparty-node http://localhost:26657", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, channelID := args[0], args[1]
+
+			counterpartyNode, err := cmd.Flags().GetString(flagCounterpartyNode)
+			if err != nil {
+				return err
+			}
+
+			var (
+				errorReceipt types.ErrorReceipt
+				proof        []byte
+				proofHeight  clienttypes.Height
+			)
+
+			if strings.TrimSpace(counterpartyNode) != "" {
+				res, err := queryCounterpartyUpgradeError(cmd.Context(), clientCtx, portID, channelID, counterpartyNode)
+				if err != nil {
+					return err
+				}
+
+				errorReceipt, proof, proofHeight = res.ErrorReceipt, res.Proof, res.ProofHeight
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgChannelUpgradeCancel(portID, channelID, errorReceipt, proof, proofHeight, signer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagCounterpartyNode, "", "<host>:<port> to the CometBFT RPC interface of a counterparty node, used to fetch the error receipt and its proof")
+
+	return cmd
+}
+
+// queryCounterpartyUpgradeError queries the error receipt of the counterparty channel end from the given
+// counterparty node. The proof is queried at the latest height of the light client of the channel, so that
+// it can be verified against a consensus state stored by the client.
+func queryCounterpartyUpgradeError(ctx context.Context, clientCtx client.Context, portID, channelID, counterpartyNode string) (*types.QueryUpgradeErrorResponse, error) {
+	channelRes, err := utils.QueryChannel(clientCtx, portID, channelID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	clientStateRes, err := utils.QueryChannelClientState(clientCtx, portID, channelID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, err := clienttypes.UnpackClientState(clientStateRes.IdentifiedClientState.ClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return nil, fmt.Errorf("cannot determine proof height for client %s of type %s, expected %T", clientStateRes.IdentifiedClientState.ClientId, clientState.ClientType(), (*ibctm.ClientState)(nil))
+	}
+
+	rpcClient, err := client.NewClientFromNode(counterpartyNode)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := rpcClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyChainID := status.NodeInfo.Network
+	if counterpartyChainID != tmClientState.ChainId {
+		return nil, fmt.Errorf("counterparty node chain ID %s does not match chain ID %s of client %s", counterpartyChainID, tmClientState.ChainId, clientStateRes.IdentifiedClientState.ClientId)
+	}
+
+	counterpartyCtx := clientCtx.
+		WithNodeURI(counterpartyNode).
+		WithClient(rpcClient).
+		WithChainID(counterpartyChainID).
+		WithHeight(int64(tmClientState.LatestHeight.RevisionHeight))
+
+	counterparty := channelRes.Channel.Counterparty
+	return utils.QueryUpgradeError(counterpartyCtx, counterparty.PortId, counterparty.ChannelId, true)
+}
+
 func newUpgradeChannelsTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "upgrade-channels [version]",
@@ -173,3 +314,154 @@ func channelShouldBeUpgraded(channel types.IdentifiedChannel, pattern *regexp.Re
 	// otherwise we only need the port pattern to match.
 	return pattern.MatchString(channel.PortId)
 }
+
+// newChannelHandshakeTxCmd returns the command to open a channel by performing all the steps of the channel handshake.
+func newChannelHandshakeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "handshake [port-id] [counterparty-port-id] [connection-id] [counterparty-node]",
+		Short: "Open a channel by performing all the steps of the channel handshake",
+		Long: `Open a channel on top of the given open connection by performing all the steps of the channel handshake,
+		acting as a light relayer between this chain and the chain of the counterparty node. ChanOpenInit and ChanOpenAck
+		are submitted to this chain, while ChanOpenTry and ChanOpenConfirm are submitted to the counterparty node at the
+		given CometBFT RPC address. The proofs and the headers used to update the 07-tendermint clients of the connection
+		are queried directly from the nodes of both chains. Transactions on both chains are signed by the key provided with
+		--from and use the same fee flags. If no channel version is provided, the application version is negotiated by the
+		applications bound to the ports.
+		This command is meant for development and testing environments, where running a full relayer is not practical.`,
+		Example: fmt.Sprintf("%s tx %s %s handshake transfer transfer connection-0 http://localhost:26657 --gas auto", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, counterpartyPortID, connectionID := args[0], args[1], args[2]
+
+			ordered, err := cmd.Flags().GetBool(flagOrdered)
+			if err != nil {
+				return err
+			}
+
+			order := types.UNORDERED
+			if ordered {
+				order = types.ORDERED
+			}
+
+			channelVersion, err := cmd.Flags().GetString(flagChannelVersion)
+			if err != nil {
+				return err
+			}
+
+			counterpartyCtx, err := ibcclient.NewCounterpartyContext(cmd.Context(), clientCtx, args[3])
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			channelID, counterpartyChannelID, err := channelHandshake(cmd, clientCtx, counterpartyCtx, txf, portID, counterpartyPortID, connectionID, channelVersion, order)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("opened channel %s/%s on %s with counterparty channel %s/%s on %s\n", portID, channelID, clientCtx.ChainID, counterpartyPortID, counterpartyChannelID, counterpartyCtx.ChainID))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagOrdered, false, "open an ORDERED channel instead of an UNORDERED channel")
+	cmd.Flags().String(flagChannelVersion, "", "version of the channel proposed to the applications")
+
+	return cmd
+}
+
+// channelHandshake performs the four steps of the channel handshake between the chains of the client and counterparty
+// contexts. The clients of the connection receiving proofs are updated in the same transaction as the handshake message
+// which is verified against them. The channel identifiers on both chains are returned.
+func channelHandshake(
+	cmd *cobra.Command, clientCtx, counterpartyCtx client.Context, txf tx.Factory,
+	portID, counterpartyPortID, connectionID, channelVersion string, order types.Order,
+) (string, string, error) {
+	ctx := cmd.Context()
+	signer := clientCtx.GetFromAddress().String()
+
+	connectionRes, err := connectionutils.QueryConnection(clientCtx, connectionID, false)
+	if err != nil {
+		return "", "", err
+	}
+
+	clientID := connectionRes.Connection.ClientId
+	counterparty := connectionRes.Connection.Counterparty
+
+	msgInit := types.NewMsgChannelOpenInit(portID, channelVersion, order, []string{connectionID}, counterpartyPortID, signer)
+	res, err := ibcclient.BroadcastTxAndWait(ctx, clientCtx, txf, msgInit)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit ChanOpenInit: %w", err)
+	}
+
+	channelID, err := ibcclient.ParseAttributeFromEvents(res.Events, types.EventTypeChannelOpenInit, types.AttributeKeyChannelID)
+	if err != nil {
+		return "", "", err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ChanOpenInit: channel %s/%s created on %s\n", portID, channelID, clientCtx.ChainID)
+
+	channelRes, err := queryChannelWithProof(ctx, clientCtx, portID, channelID, res.Height)
+	if err != nil {
+		return "", "", err
+	}
+
+	msgTry := types.NewMsgChannelOpenTry(
+		counterpartyPortID, channelRes.Channel.Version, order, []string{counterparty.ConnectionId},
+		portID, channelID, channelRes.Channel.Version, channelRes.Proof, channelRes.ProofHeight, signer,
+	)
+	res, err = clientutils.BroadcastTxWithTendermintClientUpdate(ctx, counterpartyCtx, clientCtx, txf, counterparty.ClientId, channelRes.ProofHeight, msgTry)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit ChanOpenTry: %w", err)
+	}
+
+	counterpartyChannelID, err := ibcclient.ParseAttributeFromEvents(res.Events, types.EventTypeChannelOpenTry, types.AttributeKeyChannelID)
+	if err != nil {
+		return "", "", err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ChanOpenTry: channel %s/%s created on %s\n", counterpartyPortID, counterpartyChannelID, counterpartyCtx.ChainID)
+
+	channelRes, err = queryChannelWithProof(ctx, counterpartyCtx, counterpartyPortID, counterpartyChannelID, res.Height)
+	if err != nil {
+		return "", "", err
+	}
+
+	msgAck := types.NewMsgChannelOpenAck(portID, channelID, counterpartyChannelID, channelRes.Channel.Version, channelRes.Proof, channelRes.ProofHeight, signer)
+	res, err = clientutils.BroadcastTxWithTendermintClientUpdate(ctx, clientCtx, counterpartyCtx, txf, clientID, channelRes.ProofHeight, msgAck)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit ChanOpenAck: %w", err)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ChanOpenAck: channel %s/%s opened on %s\n", portID, channelID, clientCtx.ChainID)
+
+	channelRes, err = queryChannelWithProof(ctx, clientCtx, portID, channelID, res.Height)
+	if err != nil {
+		return "", "", err
+	}
+
+	msgConfirm := types.NewMsgChannelOpenConfirm(counterpartyPortID, counterpartyChannelID, channelRes.Proof, channelRes.ProofHeight, signer)
+	if _, err := clientutils.BroadcastTxWithTendermintClientUpdate(ctx, counterpartyCtx, clientCtx, txf, counterparty.ClientId, channelRes.ProofHeight, msgConfirm); err != nil {
+		return "", "", fmt.Errorf("failed to submit ChanOpenConfirm: %w", err)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "ChanOpenConfirm: channel %s/%s opened on %s\n", counterpartyPortID, counterpartyChannelID, counterpartyCtx.ChainID)
+
+	return channelID, counterpartyChannelID, nil
+}
+
+// queryChannelWithProof waits for the state written by the transaction included at the given height to be committed
+// and queries the channel end with its proof at the latest height.
+func queryChannelWithProof(ctx context.Context, clientCtx client.Context, portID, channelID string, txHeight int64) (*types.QueryChannelResponse, error) {
+	height, err := ibcclient.QueryProofHeight(ctx, clientCtx, txHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.QueryChannel(clientCtx.WithHeight(height), portID, channelID, true)
+}
@@ -19,6 +19,7 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
+		k.SetChannelCounterpartyIndex(ctx, channel.PortId, channel.ChannelId, ch)
 	}
 	for _, ack := range gs.Acknowledgements {
 		k.SetPacketAcknowledgement(ctx, ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
@@ -38,20 +39,28 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, cw := range gs.CommitmentWatermarks {
+		k.SetCommitmentWatermark(ctx, cw.PortId, cw.ChannelId, cw.Sequence)
+	}
+	for _, rw := range gs.ReceiptWatermarks {
+		k.SetReceiptWatermark(ctx, rw.PortId, rw.ChannelId, rw.Sequence)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
-		Params:              k.GetParams(ctx),
+		Channels:             k.GetAllChannels(ctx),
+		Acknowledgements:     k.GetAllPacketAcks(ctx),
+		Commitments:          k.GetAllPacketCommitments(ctx),
+		Receipts:             k.GetAllPacketReceipts(ctx),
+		SendSequences:        k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:        k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:         k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:  k.GetNextChannelSequence(ctx),
+		Params:               k.GetParams(ctx),
+		CommitmentWatermarks: k.GetAllCommitmentWatermarks(ctx),
+		ReceiptWatermarks:    k.GetAllReceiptWatermarks(ctx),
 	}
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 
@@ -84,7 +85,7 @@ func emitChannelOpenConfirmEvent(ctx sdk.Context, portID string, channelID strin
 }
 
 // emitChannelCloseInitEvent emits a channel close init event
-func emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, reason types.ClosureReason) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelCloseInit,
@@ -93,6 +94,24 @@ func emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string,
 			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
 			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
 			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyClosureReason, reason.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitChannelPrunedEvent emits a channel pruned event
+func emitChannelPrunedEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelPruned,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -102,7 +121,7 @@ func emitChannelCloseInitEvent(ctx sdk.Context, portID string, channelID string,
 }
 
 // emitChannelCloseConfirmEvent emits a channel close confirm event
-func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel, reason types.ClosureReason) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelCloseConfirm,
@@ -111,6 +130,7 @@ func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID stri
 			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
 			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
 			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyClosureReason, reason.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -119,13 +139,24 @@ func emitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID stri
 	})
 }
 
+// packetDataAttribute returns the attribute containing the hex encoded packet data, or the hex encoded
+// SHA-256 hash of the packet data if hashData is true.
+func packetDataAttribute(data []byte, hashData bool) sdk.Attribute {
+	if hashData {
+		hash := sha256.Sum256(data)
+		return sdk.NewAttribute(types.AttributeKeyDataHash, hex.EncodeToString(hash[:]))
+	}
+
+	return sdk.NewAttribute(types.AttributeKeyDataHex, hex.EncodeToString(data))
+}
+
 // emitSendPacketEvent emits an event with packet data along with other packet information for relayer
-// to pick up and relay to other chain
-func emitSendPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, timeoutHeight exported.Height) {
+// to pick up and relay to other chain. If hashData is true, only the hash of the packet data is emitted.
+func emitSendPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, timeoutHeight exported.Height, hashData bool) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSendPacket,
-			sdk.NewAttribute(types.AttributeKeyDataHex, hex.EncodeToString(packet.GetData())),
+			packetDataAttribute(packet.GetData(), hashData),
 			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, timeoutHeight.String()),
 			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
@@ -147,12 +178,13 @@ func emitSendPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Cha
 }
 
 // emitRecvPacketEvent emits a receive packet event. It will be emitted both the first time a packet
-// is received for a certain sequence and for all duplicate receives.
-func emitRecvPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Channel) {
+// is received for a certain sequence and for all duplicate receives. If hashData is true, only the hash of
+// the packet data is emitted.
+func emitRecvPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, hashData bool) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRecvPacket,
-			sdk.NewAttribute(types.AttributeKeyDataHex, hex.EncodeToString(packet.GetData())),
+			packetDataAttribute(packet.GetData(), hashData),
 			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
@@ -173,12 +205,13 @@ func emitRecvPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Cha
 	})
 }
 
-// emitWriteAcknowledgementEvent emits an event that the relayer can query for
-func emitWriteAcknowledgementEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, acknowledgement []byte) {
+// emitWriteAcknowledgementEvent emits an event that the relayer can query for. If hashData is true, only the
+// hash of the packet data is emitted.
+func emitWriteAcknowledgementEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, acknowledgement []byte, hashData bool) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeWriteAck,
-			sdk.NewAttribute(types.AttributeKeyDataHex, hex.EncodeToString(packet.GetData())),
+			packetDataAttribute(packet.GetData(), hashData),
 			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, packet.GetTimeoutHeight().String()),
 			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
 			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
@@ -249,7 +282,7 @@ func emitTimeoutPacketEvent(ctx sdk.Context, packet types.Packet, channel types.
 }
 
 // emitChannelClosedEvent emits a channel closed event.
-func emitChannelClosedEvent(ctx sdk.Context, packet types.Packet, channel types.Channel) {
+func emitChannelClosedEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, reason types.ClosureReason) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeChannelClosed,
@@ -259,6 +292,7 @@ func emitChannelClosedEvent(ctx sdk.Context, packet types.Packet, channel types.
 			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
 			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
 			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyClosureReason, reason.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -433,3 +467,21 @@ func emitChannelFlushCompleteEvent(ctx sdk.Context, portID string, channelID str
 		),
 	})
 }
+
+// emitReceiptWatermarkAdvancedEvent emits an event when the receipt watermark of a channel is advanced.
+func emitReceiptWatermarkAdvancedEvent(ctx sdk.Context, portID, channelID string, channel types.Channel, watermark uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWatermarkAdvanced,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyWatermark, fmt.Sprintf("%d", watermark)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
 
@@ -9,9 +10,12 @@ import (
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/internal/validate"
@@ -43,7 +47,10 @@ func (k *Keeper) Channel(c context.Context, req *types.QueryChannelRequest) (*ty
 	}
 
 	selfHeight := clienttypes.GetSelfHeight(ctx)
-	return types.NewQueryChannelResponse(channel, nil, selfHeight), nil
+	res := types.NewQueryChannelResponse(channel, nil, selfHeight)
+	res.ClosureReason, _ = k.GetChannelClosureReason(ctx, req.PortId, req.ChannelId)
+
+	return res, nil
 }
 
 // Channels implements the Query/Channels gRPC method
@@ -156,6 +163,47 @@ func (k *Keeper) ChannelClientState(c context.Context, req *types.QueryChannelCl
 	return types.NewQueryChannelClientStateResponse(identifiedClientState, nil, selfHeight), nil
 }
 
+// ChannelCounterpartyChainID implements the Query/ChannelCounterpartyChainID gRPC method
+func (k *Keeper) ChannelCounterpartyChainID(c context.Context, req *types.QueryChannelCounterpartyChainIDRequest) (*types.QueryChannelCounterpartyChainIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	connectionID, connection, err := k.GetChannelConnection(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connection.ClientId).Error(),
+		)
+	}
+
+	// only light clients tracking chains with a chain identifier expose it on their client state
+	chainIDClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "client %s of type %s does not track a counterparty chain identifier", connection.ClientId, clientState.ClientType()).Error(),
+		)
+	}
+
+	return &types.QueryChannelCounterpartyChainIDResponse{
+		ChainId:      chainIDClientState.GetChainID(),
+		ConnectionId: connectionID,
+		ClientId:     connection.ClientId,
+	}, nil
+}
+
 // ChannelConsensusState implements the Query/ChannelConsensusState gRPC method
 func (k *Keeper) ChannelConsensusState(c context.Context, req *types.QueryChannelConsensusStateRequest) (*types.QueryChannelConsensusStateResponse, error) {
 	if req == nil {
@@ -307,6 +355,44 @@ func (k *Keeper) PacketReceipt(c context.Context, req *types.QueryPacketReceiptR
 	return types.NewQueryPacketReceiptResponse(recvd, nil, selfHeight), nil
 }
 
+// PacketReceipts implements the Query/PacketReceipts gRPC method
+func (k *Keeper) PacketReceipts(c context.Context, req *types.QueryPacketReceiptsRequest) (*types.QueryPacketReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if len(req.Sequences) > types.MaxPacketReceiptsSequences {
+		return nil, status.Errorf(codes.InvalidArgument, "number of sequences %d exceeds the maximum of %d", len(req.Sequences), types.MaxPacketReceiptsSequences)
+	}
+
+	for i, seq := range req.Sequences {
+		if seq == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "sequence at index %d cannot be 0", i)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	receipts := k.HasPacketReceipts(ctx, req.PortId, req.ChannelId, req.Sequences)
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketReceiptsResponse{
+		Receipts: receipts,
+		Height:   selfHeight,
+	}, nil
+}
+
 // PacketAcknowledgement implements the Query/PacketAcknowledgement gRPC method
 func (k *Keeper) PacketAcknowledgement(c context.Context, req *types.QueryPacketAcknowledgementRequest) (*types.QueryPacketAcknowledgementResponse, error) {
 	if req == nil {
@@ -666,6 +752,72 @@ func (k *Keeper) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*ty
 	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
 }
 
+// UpgradeSequence implements the Query/UpgradeSequence gRPC method
+func (k *Keeper) UpgradeSequence(c context.Context, req *types.QueryUpgradeSequenceRequest) (*types.QueryUpgradeSequenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	res := &types.QueryUpgradeSequenceResponse{
+		UpgradeSequence: channel.UpgradeSequence,
+		QueryHeight:     clienttypes.GetSelfHeight(ctx),
+	}
+
+	if upgrade, found := k.GetUpgrade(ctx, req.PortId, req.ChannelId); found {
+		res.UpgradeTimeout = &upgrade.Timeout
+	}
+
+	if counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, req.PortId, req.ChannelId); found {
+		res.CounterpartyUpgradeTimeout = &counterpartyUpgrade.Timeout
+	}
+
+	return res, nil
+}
+
+// ChannelUpgradeCompatibility implements the Query/ChannelUpgradeCompatibility gRPC method
+func (k *Keeper) ChannelUpgradeCompatibility(c context.Context, req *types.QueryChannelUpgradeCompatibilityRequest) (*types.QueryChannelUpgradeCompatibilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	var conflicts []string
+	for _, err := range k.upgradeCompatibilityConflicts(ctx, req.PortId, req.ChannelId, channel, req.ProposedUpgradeFields) {
+		conflicts = append(conflicts, err.Error())
+	}
+
+	return &types.QueryChannelUpgradeCompatibilityResponse{
+		Compatible:  len(conflicts) == 0,
+		Conflicts:   conflicts,
+		QueryHeight: clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
 // ChannelParams implements the Query/ChannelParams gRPC method.
 func (k *Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -675,3 +827,141 @@ func (k *Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsR
 		Params: &params,
 	}, nil
 }
+
+// PacketCommitmentsAtHeight implements the Query/PacketCommitmentsAtHeight gRPC method. The query must be
+// executed against the state at the requested height, which for historical heights is only retained by
+// archival nodes.
+func (k *Keeper) PacketCommitmentsAtHeight(c context.Context, req *types.QueryPacketCommitmentsAtHeightRequest) (*types.QueryPacketCommitmentsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if uint64(ctx.BlockHeight()) != req.Height {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"query served at height %d, expected %d: the query height must be set to the requested height (e.g. using the %s header)",
+			ctx.BlockHeight(), req.Height, grpctypes.GRPCBlockHeightHeader,
+		)
+	}
+
+	var commitments []*types.PacketState
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyPacketCommitmentPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		// key format: ports/{port-id}/channels/{channel-id}/sequences/{sequence}
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 6 {
+			return errorsmod.Wrapf(host.ErrInvalidPath, "invalid packet commitment key %s", key)
+		}
+
+		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		commitment := types.NewPacketState(keySplit[1], keySplit[3], sequence, value)
+		commitments = append(commitments, &commitment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPacketCommitmentsAtHeightResponse{
+		Commitments: commitments,
+		Pagination:  pageRes,
+		Height:      clienttypes.GetSelfHeight(ctx),
+	}, nil
+}
+
+// VerifyPacketProof implements the Query/VerifyPacketProof gRPC method. The packet is received on a cached
+// context which is never written, so that the proof is verified exactly as it would be by MsgRecvPacket.
+func (k *Keeper) VerifyPacketProof(c context.Context, req *types.QueryVerifyPacketProofRequest) (*types.QueryVerifyPacketProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := req.Packet.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.ProofCommitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty proof")
+	}
+
+	if req.ProofHeight.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "proof height must be non-zero")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// consume flat gas fee for proof verification queries.
+	// NOTE: consuming gas prior to method invocation also provides protection against recursive calls reaching stack overflow
+	ctx.GasMeter().ConsumeGas(
+		3*ctx.KVGasConfig().ReadCostPerByte*uint64(len(req.ProofCommitment)),
+		"verify packet proof query",
+	)
+
+	// the packet is received on a cached context which is discarded, and must not be logged as received.
+	// The cached context is given its own gas meter, bounded by the gas remaining, so that the gas consumed
+	// by receiving the packet is charged to the higher level context exactly once.
+	cachedCtx, _ := ctx.WithGasMeter(storetypes.NewGasMeter(ctx.GasMeter().GasRemaining())).CacheContext()
+	cachedCtx = cachedCtx.WithLogger(log.NewNopLogger())
+
+	// make sure we charge the higher level context even on panic
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumed(), "verify packet proof query")
+	}()
+
+	err := k.verifyPacketProof(cachedCtx, req.Packet, req.ProofCommitment, req.ProofHeight, req.ClientId)
+	switch {
+	case err == nil:
+		return &types.QueryVerifyPacketProofResponse{
+			Success: true,
+			Result:  types.SUCCESS,
+		}, nil
+	case errors.Is(err, types.ErrNoOpMsg):
+		return &types.QueryVerifyPacketProofResponse{
+			Success: true,
+			Result:  types.NOOP,
+		}, nil
+	default:
+		return &types.QueryVerifyPacketProofResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+}
+
+// verifyPacketProof receives the packet on the provided context after checking that its destination channel
+// is verified by the client with the given identifier.
+func (k *Keeper) verifyPacketProof(ctx sdk.Context, packet types.Packet, proof []byte, proofHeight clienttypes.Height, clientID string) error {
+	_, connection, err := k.GetChannelConnection(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return err
+	}
+
+	if connection.ClientId != clientID {
+		return errorsmod.Wrapf(
+			clienttypes.ErrInvalidClient,
+			"packet is verified by client %s of channel %s, not %s", connection.ClientId, packet.GetDestChannel(), clientID,
+		)
+	}
+
+	_, capability, err := k.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	return k.RecvPacket(ctx, capability, packet, proof, proofHeight)
+}
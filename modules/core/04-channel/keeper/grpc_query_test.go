@@ -3,11 +3,17 @@ package keeper_test
 import (
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -18,8 +24,9 @@ const doesnotexist = "doesnotexist"
 
 func (suite *KeeperTestSuite) TestQueryChannel() {
 	var (
-		req        *types.QueryChannelRequest
-		expChannel types.Channel
+		req              *types.QueryChannelRequest
+		expChannel       types.Channel
+		expClosureReason types.ClosureReason
 	)
 
 	testCases := []struct {
@@ -84,6 +91,25 @@ func (suite *KeeperTestSuite) TestQueryChannel() {
 			},
 			true,
 		},
+		{
+			"success: closed channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				err := path.EndpointA.ChanCloseInit()
+				suite.Require().NoError(err)
+
+				expChannel = path.EndpointA.GetChannel()
+				expClosureReason = types.CLOSURE_CLOSE_INIT
+
+				req = &types.QueryChannelRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -91,6 +117,7 @@ func (suite *KeeperTestSuite) TestQueryChannel() {
 
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+			expClosureReason = types.CLOSURE_UNSPECIFIED
 
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
@@ -101,6 +128,7 @@ func (suite *KeeperTestSuite) TestQueryChannel() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
 				suite.Require().Equal(&expChannel, res.Channel)
+				suite.Require().Equal(expClosureReason, res.ClosureReason)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -456,6 +484,145 @@ func (suite *KeeperTestSuite) TestQueryChannelClientState() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryChannelCounterpartyChainID() {
+	var (
+		req             *types.QueryChannelCounterpartyChainIDRequest
+		expConnectionID string
+		expClientID     string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryChannelCounterpartyChainIDRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryChannelCounterpartyChainIDRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryChannelCounterpartyChainIDRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"connection not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				channel := path.EndpointA.GetChannel()
+				// update channel to reference a connection that does not exist
+				channel.ConnectionHops[0] = doesnotexist
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channel)
+
+				req = &types.QueryChannelCounterpartyChainIDRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			}, false,
+		},
+		{
+			"client state for channel's connection not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				// set connection to empty so clientID is empty
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, connectiontypes.ConnectionEnd{})
+
+				req = &types.QueryChannelCounterpartyChainIDRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			}, false,
+		},
+		{
+			"client does not track a counterparty chain identifier",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "testing", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, solomachine.ClientState())
+
+				req = &types.QueryChannelCounterpartyChainIDRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			}, false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expConnectionID = path.EndpointA.ConnectionID
+				expClientID = path.EndpointA.ClientID
+
+				req = &types.QueryChannelCounterpartyChainIDRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.ChannelCounterpartyChainID(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(suite.chainB.ChainID, res.ChainId)
+				suite.Require().Equal(expConnectionID, res.ConnectionId)
+				suite.Require().Equal(expClientID, res.ClientId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelConsensusState() {
 	var (
 		req               *types.QueryChannelConsensusStateRequest
@@ -816,6 +983,100 @@ func (suite *KeeperTestSuite) TestQueryPacketCommitments() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryPacketCommitmentsAtHeight() {
+	var (
+		req            *types.QueryPacketCommitmentsAtHeightRequest
+		expCommitments []*types.PacketState
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit:      2,
+					CountTotal: true,
+				}
+
+				expCommitments = expCommitments[:2]
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"height is zero",
+			func() {
+				req.Height = 0
+			},
+			status.Error(codes.InvalidArgument, "height cannot be 0"),
+		},
+		{
+			"query is not served at the requested height",
+			func() {
+				req.Height--
+			},
+			status.Error(codes.InvalidArgument, "query served at height"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expCommitments = nil
+
+			pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+			pathA.Setup()
+
+			pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+			pathB.Setup()
+
+			for _, path := range []*ibctesting.Path{pathA, pathB} {
+				for i := uint64(1); i <= 3; i++ {
+					commitment := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
+					expCommitments = append(expCommitments, &commitment)
+				}
+			}
+
+			ctx := suite.chainA.GetContext()
+			req = &types.QueryPacketCommitmentsAtHeightRequest{
+				Height: uint64(ctx.BlockHeight()),
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.PacketCommitmentsAtHeight(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expCommitments, res.Commitments)
+				suite.Require().Equal(clienttypes.GetSelfHeight(ctx), res.Height)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryPacketReceipt() {
 	var (
 		req         *types.QueryPacketReceiptRequest
@@ -934,10 +1195,10 @@ func (suite *KeeperTestSuite) TestQueryPacketReceipt() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
+func (suite *KeeperTestSuite) TestQueryPacketReceipts() {
 	var (
-		req    *types.QueryPacketAcknowledgementRequest
-		expAck []byte
+		req         *types.QueryPacketReceiptsRequest
+		expReceipts []byte
 	)
 
 	testCases := []struct {
@@ -955,10 +1216,10 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 		{
 			"invalid port ID",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptsRequest{
 					PortId:    "",
 					ChannelId: "test-channel-id",
-					Sequence:  0,
+					Sequences: []uint64{1},
 				}
 			},
 			false,
@@ -966,10 +1227,10 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 		{
 			"invalid channel ID",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptsRequest{
 					PortId:    "test-port-id",
 					ChannelId: "",
-					Sequence:  0,
+					Sequences: []uint64{1},
 				}
 			},
 			false,
@@ -977,26 +1238,32 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 		{
 			"invalid sequence",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
-					PortId:    "test-port-id",
-					ChannelId: "test-channel-id",
-					Sequence:  0,
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				req = &types.QueryPacketReceiptsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequences: []uint64{1, 0},
 				}
 			},
 			false,
 		},
 		{
-			"ack not found",
+			"too many sequences",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
-				expAck = []byte("hash")
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
 
-				req = &types.QueryPacketAcknowledgementRequest{
+				sequences := make([]uint64, types.MaxPacketReceiptsSequences+1)
+				for i := range sequences {
+					sequences[i] = uint64(i + 1)
+				}
+
+				req = &types.QueryPacketReceiptsRequest{
 					PortId:    path.EndpointA.ChannelConfig.PortID,
 					ChannelId: path.EndpointA.ChannelID,
-					Sequence:  2,
+					Sequences: sequences,
 				}
 			},
 			false,
@@ -1004,27 +1271,42 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 		{
 			"channel not found",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptsRequest{
 					PortId:    "test-port-id",
 					ChannelId: "test-channel-id",
-					Sequence:  1,
+					Sequences: []uint64{1},
 				}
 			},
 			false,
 		},
 		{
-			"success",
+			"success: empty sequences",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
-				expAck = []byte("hash")
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
 
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptsRequest{
 					PortId:    path.EndpointA.ChannelConfig.PortID,
 					ChannelId: path.EndpointA.ChannelID,
-					Sequence:  1,
 				}
+				expReceipts = []byte{}
+			},
+			true,
+		},
+		{
+			"success: receipts found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+
+				req = &types.QueryPacketReceiptsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequences: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+				}
+				expReceipts = []byte{0x01, 0x02}
 			},
 			true,
 		},
@@ -1039,12 +1321,15 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
 
-			res, err := suite.chainA.QueryServer.PacketAcknowledgement(ctx, req)
+			res, err := suite.chainA.QueryServer.PacketReceipts(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expAck, res.Acknowledgement)
+				suite.Require().Equal(expReceipts, res.Receipts)
+				for i := range req.Sequences {
+					suite.Require().Equal(expReceipts[i/8]&(1<<(i%8)) != 0, res.HasReceipt(i))
+				}
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1052,10 +1337,10 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
+func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 	var (
-		req                 *types.QueryPacketAcknowledgementsRequest
-		expAcknowledgements = []*types.PacketState{}
+		req    *types.QueryPacketAcknowledgementRequest
+		expAck []byte
 	)
 
 	testCases := []struct {
@@ -1071,9 +1356,127 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
 			false,
 		},
 		{
-			"invalid ID",
+			"invalid port ID",
 			func() {
-				req = &types.QueryPacketAcknowledgementsRequest{
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"ack not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				expAck = []byte("hash")
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
+
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  2,
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				expAck = []byte("hash")
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
+
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.PacketAcknowledgement(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAck, res.Acknowledgement)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
+	var (
+		req                 *types.QueryPacketAcknowledgementsRequest
+		expAcknowledgements = []*types.PacketState{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryPacketAcknowledgementsRequest{
 					PortId:    "",
 					ChannelId: "test-channel-id",
 				}
@@ -1936,9 +2339,465 @@ func (suite *KeeperTestSuite) TestQueryUpgrade() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryUpgradeSequence() {
+	var (
+		req         *types.QueryUpgradeSequenceRequest
+		path        *ibctesting.Path
+		expResponse *types.QueryUpgradeSequenceResponse
+	)
+
+	upgradeTimeout := types.NewTimeout(clienttypes.ZeroHeight(), 1000000)
+	counterpartyUpgradeTimeout := types.NewTimeout(clienttypes.NewHeight(1, 1000), 0)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryUpgradeSequenceRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryUpgradeSequenceRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryUpgradeSequenceRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success: no upgrade in progress",
+			func() {},
+			true,
+		},
+		{
+			"success: upgrade in progress",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.UpgradeSequence = 2
+				path.EndpointA.SetChannel(channel)
+
+				upgrade := types.NewUpgrade(
+					types.NewUpgradeFields(types.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version),
+					upgradeTimeout,
+					0,
+				)
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade)
+
+				counterpartyUpgrade := types.NewUpgrade(
+					types.NewUpgradeFields(types.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version),
+					counterpartyUpgradeTimeout,
+					0,
+				)
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetCounterpartyUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterpartyUpgrade)
+
+				expResponse.UpgradeSequence = 2
+				expResponse.UpgradeTimeout = &upgradeTimeout
+				expResponse.CounterpartyUpgradeTimeout = &counterpartyUpgradeTimeout
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			req = &types.QueryUpgradeSequenceRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			expResponse = &types.QueryUpgradeSequenceResponse{
+				QueryHeight: clienttypes.GetSelfHeight(suite.chainA.GetContext()),
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.UpgradeSequence(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResponse, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelUpgradeCompatibility() {
+	var (
+		req          *types.QueryChannelUpgradeCompatibilityRequest
+		path         *ibctesting.Path
+		expConflicts []error
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"success: compatible upgrade",
+			func() {},
+			true,
+		},
+		{
+			"success: compatible with pending upgrade",
+			func() {
+				upgrade := types.NewUpgrade(req.ProposedUpgradeFields, types.NewTimeout(clienttypes.ZeroHeight(), 1000000), 0)
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade)
+			},
+			true,
+		},
+		{
+			"success: invalid upgrade fields",
+			func() {
+				req.ProposedUpgradeFields.Version = ""
+
+				expConflicts = []error{types.ErrInvalidChannelVersion}
+			},
+			true,
+		},
+		{
+			"success: proposed upgrade fields identical to channel",
+			func() {
+				req.ProposedUpgradeFields.Version = mock.Version
+
+				expConflicts = []error{types.ErrInvalidUpgrade}
+			},
+			true,
+		},
+		{
+			"success: channel is not OPEN and proposed connection not found",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = types.FLUSHING
+				path.EndpointA.SetChannel(channel)
+
+				req.ProposedUpgradeFields.ConnectionHops = []string{ibctesting.InvalidID}
+
+				expConflicts = []error{types.ErrInvalidChannelState, connectiontypes.ErrConnectionNotFound}
+			},
+			true,
+		},
+		{
+			"success: proposed upgrade fields differ from pending upgrade",
+			func() {
+				upgrade := types.NewUpgrade(
+					types.NewUpgradeFields(types.ORDERED, []string{path.EndpointA.ConnectionID}, mock.UpgradeVersion),
+					types.NewTimeout(clienttypes.ZeroHeight(), 1000000),
+					0,
+				)
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgrade)
+
+				expConflicts = []error{types.ErrInvalidUpgrade}
+			},
+			true,
+		},
+		{
+			"success: proposed upgrade fields incompatible with counterparty upgrade",
+			func() {
+				counterpartyUpgrade := types.NewUpgrade(
+					types.NewUpgradeFields(types.UNORDERED, []string{path.EndpointB.ConnectionID}, fmt.Sprintf("%s-v3", mock.Version)),
+					types.NewTimeout(clienttypes.ZeroHeight(), 1000000),
+					0,
+				)
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetCounterpartyUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterpartyUpgrade)
+
+				expConflicts = []error{types.ErrIncompatibleCounterpartyUpgrade}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			req = &types.QueryChannelUpgradeCompatibilityRequest{
+				PortId:                path.EndpointA.ChannelConfig.PortID,
+				ChannelId:             path.EndpointA.ChannelID,
+				ProposedUpgradeFields: types.NewUpgradeFields(types.UNORDERED, []string{path.EndpointA.ConnectionID}, mock.UpgradeVersion),
+			}
+			expConflicts = nil
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.ChannelUpgradeCompatibility(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(len(expConflicts) == 0, res.Compatible)
+				suite.Require().Len(res.Conflicts, len(expConflicts))
+				for i, expConflict := range expConflicts {
+					suite.Require().Contains(res.Conflicts[i], expConflict.Error())
+				}
+				suite.Require().Equal(clienttypes.GetSelfHeight(ctx), res.QueryHeight)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
 	res, _ := suite.chainA.QueryServer.ChannelParams(ctx, &types.QueryChannelParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryVerifyPacketProof() {
+	var (
+		req       *types.QueryVerifyPacketProofRequest
+		path      *ibctesting.Path
+		expResult types.ResponseResultType
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error // the error the packet is expected to be rejected with, if any
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+			true,
+		},
+		{
+			"success: packet already received",
+			func() {
+				err := path.EndpointB.RecvPacket(req.Packet)
+				suite.Require().NoError(err)
+
+				expResult = types.NOOP
+			},
+			nil,
+			true,
+		},
+		{
+			"success: channel not found",
+			func() {
+				req.Packet.DestinationChannel = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+			true,
+		},
+		{
+			"success: client does not verify the channel",
+			func() {
+				req.ClientId = ibctesting.SecondClientID
+			},
+			clienttypes.ErrInvalidClient,
+			true,
+		},
+		{
+			"success: client not updated to proof height",
+			func() {
+				req.ProofHeight = clienttypes.NewHeight(1, 1000)
+			},
+			clienttypes.ErrInvalidHeight,
+			true,
+		},
+		{
+			"success: packet does not match commitment",
+			func() {
+				req.Packet.Data = []byte("invalid packet data")
+			},
+			commitmenttypes.ErrInvalidProof,
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			nil,
+			false,
+		},
+		{
+			"invalid packet",
+			func() {
+				req.Packet.Sequence = 0
+			},
+			nil,
+			false,
+		},
+		{
+			"invalid client ID",
+			func() {
+				req.ClientId = ""
+			},
+			nil,
+			false,
+		},
+		{
+			"empty proof",
+			func() {
+				req.ProofCommitment = nil
+			},
+			nil,
+			false,
+		},
+		{
+			"zero proof height",
+			func() {
+				req.ProofHeight = clienttypes.ZeroHeight()
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+			req = &types.QueryVerifyPacketProofRequest{
+				Packet:          packet,
+				ProofCommitment: proof,
+				ProofHeight:     proofHeight,
+				ClientId:        path.EndpointB.ClientID,
+			}
+			expResult = types.SUCCESS
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			res, err := suite.chainB.QueryServer.VerifyPacketProof(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expError == nil, res.Success)
+
+				if tc.expError == nil {
+					suite.Require().Equal(expResult, res.Result)
+					suite.Require().Empty(res.Error)
+				} else {
+					suite.Require().Equal(types.UNSPECIFIED, res.Result)
+					suite.Require().Contains(res.Error, tc.expError.Error())
+				}
+
+				// the packet must not be received by the query
+				if expResult == types.SUCCESS {
+					_, found := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().False(found)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyPacketProofGasConsumed() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	req := &types.QueryVerifyPacketProofRequest{
+		Packet:          packet,
+		ProofCommitment: proof,
+		ProofHeight:     proofHeight,
+		ClientId:        path.EndpointB.ClientID,
+	}
+
+	// queryGasConsumed returns the gas consumed by the query on a context whose gas meter has already consumed the given gas.
+	queryGasConsumed := func(consumedBefore storetypes.Gas) storetypes.Gas {
+		ctx, _ := suite.chainB.GetContext().WithGasMeter(storetypes.NewGasMeter(1_000_000_000)).CacheContext()
+		ctx.GasMeter().ConsumeGas(consumedBefore, "test")
+
+		res, err := suite.chainB.QueryServer.VerifyPacketProof(ctx, req)
+		suite.Require().NoError(err)
+		suite.Require().True(res.Success)
+
+		return ctx.GasMeter().GasConsumed() - consumedBefore
+	}
+
+	// the gas consumed prior to the query is not charged again
+	suite.Require().Equal(queryGasConsumed(0), queryGasConsumed(100_000_000))
+
+	// the gas consumed by receiving the packet is bounded by the gas remaining
+	ctx := suite.chainB.GetContext().WithGasMeter(storetypes.NewGasMeter(queryGasConsumed(0) - 1))
+	suite.Require().Panics(func() {
+		_, _ = suite.chainB.QueryServer.VerifyPacketProof(ctx, req)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -89,7 +90,9 @@ func (k *Keeper) WriteOpenInitChannel(
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 	k.SetNextSequenceAck(ctx, portID, channelID, 1)
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.UNINITIALIZED.String(), "new-state", types.INIT.String())
+	k.setChannelInitTimestamp(ctx, portID, channelID, uint64(ctx.BlockTime().UnixNano()))
+
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, types.UNINITIALIZED.String(), logging.KeyNewState, types.INIT.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-init")
 
@@ -147,6 +150,16 @@ func (k *Keeper) ChanOpenTry(
 		)
 	}
 
+	// in strict handshake mode a counterparty channel end may only be used to open a single channel,
+	// rejecting repeated TRY attempts which would otherwise leave orphaned channels behind
+	if k.GetParams(ctx).StrictHandshake && k.hasChannelWithCounterparty(ctx, portID, connectionHops[0], counterparty) {
+		return "", nil, errorsmod.Wrapf(
+			types.ErrChannelExists,
+			"strict handshake: a channel on port ID (%s) and connection ID (%s) already exists for counterparty port ID (%s) channel ID (%s)",
+			portID, connectionHops[0], counterparty.PortId, counterparty.ChannelId,
+		)
+	}
+
 	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
@@ -196,8 +209,9 @@ func (k *Keeper) WriteOpenTryChannel(
 	channel := types.NewChannel(types.TRYOPEN, order, counterparty, connectionHops, version)
 
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetChannelCounterpartyIndex(ctx, portID, channelID, channel)
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.UNINITIALIZED.String(), "new-state", types.TRYOPEN.String())
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, types.UNINITIALIZED.String(), logging.KeyNewState, types.TRYOPEN.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-try")
 
@@ -271,8 +285,11 @@ func (k *Keeper) WriteOpenAckChannel(
 	channel.Version = counterpartyVersion
 	channel.Counterparty.ChannelId = counterpartyChannelID
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetChannelCounterpartyIndex(ctx, portID, channelID, channel)
+
+	k.deleteChannelInitTimestamp(ctx, portID, channelID)
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.INIT.String(), "new-state", types.OPEN.String())
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, types.INIT.String(), logging.KeyNewState, types.OPEN.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-ack")
 
@@ -344,7 +361,7 @@ func (k *Keeper) WriteOpenConfirmChannel(
 
 	channel.State = types.OPEN
 	k.SetChannel(ctx, portID, channelID, channel)
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.TRYOPEN.String(), "new-state", types.OPEN.String())
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, types.TRYOPEN.String(), logging.KeyNewState, types.OPEN.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-confirm")
 
@@ -390,14 +407,17 @@ func (k *Keeper) ChanCloseInit(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", types.CLOSED.String())
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, channel.State.String(), logging.KeyNewState, types.CLOSED.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "close-init")
 
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
 
-	emitChannelCloseInitEvent(ctx, portID, channelID, channel)
+	k.deleteChannelInitTimestamp(ctx, portID, channelID)
+	k.setChannelClosureReason(ctx, portID, channelID, types.CLOSURE_CLOSE_INIT)
+
+	emitChannelCloseInitEvent(ctx, portID, channelID, channel, types.CLOSURE_CLOSE_INIT)
 
 	return nil
 }
@@ -458,22 +478,18 @@ func (k *Keeper) ChanCloseConfirm(
 	// If the channel is closing during an upgrade, then we can delete all upgrade information.
 	if k.hasUpgrade(ctx, portID, channelID) {
 		k.deleteUpgradeInfo(ctx, portID, channelID)
-		k.Logger(ctx).Info(
-			"upgrade info deleted",
-			"port_id", portID,
-			"channel_id", channelID,
-			"upgrade_sequence", channel.UpgradeSequence,
-		)
+		logging.WithChannel(k.Logger(ctx), portID, channelID).Info("upgrade info deleted", logging.KeyUpgradeSequence, channel.UpgradeSequence)
 	}
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", types.CLOSED.String())
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, channel.State.String(), logging.KeyNewState, types.CLOSED.String())
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "close-confirm")
 
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.setChannelClosureReason(ctx, portID, channelID, types.CLOSURE_COUNTERPARTY_CLOSED)
 
-	emitChannelCloseConfirmEvent(ctx, portID, channelID, channel)
+	emitChannelCloseConfirmEvent(ctx, portID, channelID, channel, types.CLOSURE_COUNTERPARTY_CLOSED)
 
 	return nil
 }
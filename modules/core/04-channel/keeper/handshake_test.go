@@ -167,6 +167,34 @@ func (suite *KeeperTestSuite) TestChanOpenTry() {
 			suite.chainB.CreatePortCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
 		}, true},
+		{"success: strict handshake", func() {
+			path.SetupConnections()
+			path.SetChannelOrdered()
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+			params.StrictHandshake = true
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			suite.chainB.CreatePortCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
+		}, true},
+		{"strict handshake: channel already exists for counterparty", func() {
+			path.SetupConnections()
+			path.SetChannelOrdered()
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanOpenTry()
+			suite.Require().NoError(err)
+
+			params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+			params.StrictHandshake = true
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
+		}, false},
 		{"connection doesn't exist", func() {
 			path.EndpointA.ConnectionID = ibctesting.FirstConnectionID
 			path.EndpointB.ConnectionID = ibctesting.FirstConnectionID
@@ -683,11 +711,15 @@ func (suite *KeeperTestSuite) TestChanCloseInit() {
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID, channelCap,
 			)
 
+			closureReason, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelClosureReason(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID)
 			if tc.expPass {
 				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(types.CLOSURE_CLOSE_INIT, closureReason)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Contains(err.Error(), expErrorMsgSubstring)
+				suite.Require().False(found)
 			}
 		})
 	}
@@ -839,6 +871,10 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 				suite.Require().False(found)
 				_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetCounterpartyUpgrade(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 				suite.Require().False(found)
+
+				closureReason, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannelClosureReason(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.CLOSURE_COUNTERPARTY_CLOSED, closureReason)
 			} else {
 				suite.Require().Error(err)
 			}
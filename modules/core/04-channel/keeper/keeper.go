@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -36,6 +37,10 @@ type Keeper struct {
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     exported.ScopedKeeper
+	transientKey     storetypes.StoreKey
+
+	writeAckHooks []types.WriteAcknowledgementHook
+	ackWrappers   []types.AcknowledgementWrapper
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
@@ -54,6 +59,38 @@ func NewKeeper(
 	}
 }
 
+// RegisterWriteAcknowledgementHook registers a hook which is invoked after an asynchronous
+// acknowledgement has been written. Hooks are invoked in the order in which they are registered.
+// This function should only be called during application wiring.
+func (k *Keeper) RegisterWriteAcknowledgementHook(hook types.WriteAcknowledgementHook) {
+	if hook == nil {
+		panic(errors.New("cannot register a nil write acknowledgement hook"))
+	}
+
+	k.writeAckHooks = append(k.writeAckHooks, hook)
+}
+
+// RegisterAcknowledgementWrapper registers a wrapper which is invoked before an asynchronous
+// acknowledgement is written. Wrappers are invoked in the order in which they are registered.
+// This function should only be called during application wiring.
+func (k *Keeper) RegisterAcknowledgementWrapper(wrapper types.AcknowledgementWrapper) {
+	if wrapper == nil {
+		panic(errors.New("cannot register a nil acknowledgement wrapper"))
+	}
+
+	k.ackWrappers = append(k.ackWrappers, wrapper)
+}
+
+// SetTransientStoreKey sets the transient store key used to cache the connection ends of channels
+// resolved within the current block. If it is not set, connection ends are always read from the store.
+func (k *Keeper) SetTransientStoreKey(transientKey storetypes.StoreKey) {
+	if transientKey == nil {
+		panic(errors.New("cannot set a nil transient store key"))
+	}
+
+	k.transientKey = transientKey
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.SubModuleName)
@@ -188,6 +225,26 @@ func (k *Keeper) GetPacketReceipt(ctx sdk.Context, portID, channelID string, seq
 	return string(bz), true
 }
 
+// HasPacketReceipt returns true if the packet receipt exists
+func (k *Keeper) HasPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.PacketReceiptKey(portID, channelID, sequence))
+}
+
+// HasPacketReceipts returns a bitmap indicating the existence of packet receipts for the provided
+// sequences. The bit at index i is set if a packet receipt exists for sequences[i]. Bits are ordered
+// least significant bit first within each byte.
+func (k *Keeper) HasPacketReceipts(ctx sdk.Context, portID, channelID string, sequences []uint64) []byte {
+	bitmap := make([]byte, (len(sequences)+7)/8)
+	for i, sequence := range sequences {
+		if k.HasPacketReceipt(ctx, portID, channelID, sequence) {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+
+	return bitmap
+}
+
 // SetPacketReceipt sets an empty packet receipt to the store
 func (k *Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -443,7 +500,7 @@ func (k *Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string
 		return "", nil, errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id: %s", portID, channelID)
 	}
 
-	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connection, found := k.getConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return "", nil, errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection-id: %s", channel.ConnectionHops[0])
 	}
@@ -456,9 +513,29 @@ func (k *Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string
 	return connection.ClientId, clientState, nil
 }
 
+// GetChannelClientLatestHeight returns the latest height of the client associated with a port and channel identifier.
+func (k *Keeper) GetChannelClientLatestHeight(ctx sdk.Context, portID, channelID string) (clienttypes.Height, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return clienttypes.Height{}, errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id: %s", portID, channelID)
+	}
+
+	connection, found := k.getConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return clienttypes.Height{}, errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection-id: %s", channel.ConnectionHops[0])
+	}
+
+	latestHeight := k.clientKeeper.GetClientLatestHeight(ctx, connection.ClientId)
+	if latestHeight.IsZero() {
+		return clienttypes.Height{}, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connection.ClientId)
+	}
+
+	return latestHeight, nil
+}
+
 // GetConnection wraps the connection keeper's GetConnection function.
 func (k *Keeper) GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
-	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	connection, found := k.getConnection(ctx, connectionID)
 	if !found {
 		return connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection-id: %s", connectionID)
 	}
@@ -467,6 +544,9 @@ func (k *Keeper) GetConnection(ctx sdk.Context, connectionID string) (connection
 }
 
 // GetChannelConnection returns the connection ID and state associated with the given port and channel identifier.
+// The returned connection end includes the identifier of the counterparty client. OPEN connection ends are cached
+// for the remainder of the block, so that middlewares resolving the connection of a channel in the packet flow do
+// not repeatedly read it from the store.
 func (k *Keeper) GetChannelConnection(ctx sdk.Context, portID, channelID string) (string, connectiontypes.ConnectionEnd, error) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
 	if !found {
@@ -475,7 +555,7 @@ func (k *Keeper) GetChannelConnection(ctx sdk.Context, portID, channelID string)
 
 	connectionID := channel.ConnectionHops[0]
 
-	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	connection, found := k.getConnection(ctx, connectionID)
 	if !found {
 		return "", connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection-id: %s", connectionID)
 	}
@@ -483,6 +563,37 @@ func (k *Keeper) GetChannelConnection(ctx sdk.Context, portID, channelID string)
 	return connectionID, connection, nil
 }
 
+// getConnection returns the connection end with the given identifier. If a transient store key is set, OPEN
+// connection ends are cached in the transient store for the remainder of the block. The connection keeper
+// removes the cached connection end whenever a connection end is set, and cache entries written by failed
+// transactions are discarded together with the rest of their state changes, so the cache cannot return stale
+// connection ends. A cache hit consumes the same gas as reading the connection end from the store, so that
+// the gas consumed by a transaction does not depend on the transactions which preceded it in the block.
+func (k *Keeper) getConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool) {
+	if k.transientKey == nil {
+		return k.connectionKeeper.GetConnection(ctx, connectionID)
+	}
+
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).TransientStore(k.transientKey)
+	if bz := store.Get(connectiontypes.ConnectionCacheKey(connectionID)); bz != nil {
+		gasConfig := ctx.KVGasConfig()
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(host.ConnectionKey(connectionID))), storetypes.GasReadPerByteDesc)
+		ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(bz)), storetypes.GasReadPerByteDesc)
+
+		var connection connectiontypes.ConnectionEnd
+		k.cdc.MustUnmarshal(bz, &connection)
+		return connection, true
+	}
+
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if found && connection.State == connectiontypes.OPEN {
+		store.Set(connectiontypes.ConnectionCacheKey(connectionID), k.cdc.MustMarshal(&connection))
+	}
+
+	return connection, found
+}
+
 // LookupModuleByChannel will return the IBCModule along with the capability associated with a given channel defined by its portID and channelID
 func (k *Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error) {
 	modules, capability, err := k.scopedKeeper.LookupModules(ctx, host.ChannelCapabilityPath(portID, channelID))
@@ -493,6 +604,17 @@ func (k *Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string
 	return porttypes.GetModuleOwner(modules), capability, nil
 }
 
+// GetChannelCapabilityOwners returns the names of the modules owning the capability of the channel defined by its
+// portID and channelID. False is returned if no capability exists for the channel.
+func (k *Keeper) GetChannelCapabilityOwners(ctx sdk.Context, portID, channelID string) ([]string, bool) {
+	modules, _, err := k.scopedKeeper.LookupModules(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if err != nil {
+		return nil, false
+	}
+
+	return modules, true
+}
+
 // GetUpgradeErrorReceipt returns the upgrade error receipt for the provided port and channel identifiers.
 func (k *Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string) (types.ErrorReceipt, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -718,3 +840,110 @@ func (k *Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string
 
 	return totalPruned, totalRemaining, nil
 }
+
+// GetChannelInitTimestamp returns the block time, in nanoseconds, at which the channel was initialized.
+// The timestamp is only stored while the channel remains in the INIT state.
+func (k *Keeper) GetChannelInitTimestamp(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelInitTimestampKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setChannelInitTimestamp sets the block time, in nanoseconds, at which the channel was initialized.
+func (k *Keeper) setChannelInitTimestamp(ctx sdk.Context, portID, channelID string, timestamp uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ChannelInitTimestampKey(portID, channelID), sdk.Uint64ToBigEndian(timestamp))
+}
+
+// deleteChannelInitTimestamp deletes the block time at which the channel was initialized.
+func (k *Keeper) deleteChannelInitTimestamp(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelInitTimestampKey(portID, channelID))
+}
+
+// GetChannelClosureReason returns the reason for which the channel was closed. The reason is
+// only stored for channels which transitioned to the CLOSED state.
+func (k *Keeper) GetChannelClosureReason(ctx sdk.Context, portID, channelID string) (types.ClosureReason, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelClosureReasonKey(portID, channelID))
+	if len(bz) == 0 {
+		return types.CLOSURE_UNSPECIFIED, false
+	}
+
+	return types.ClosureReason(sdk.BigEndianToUint64(bz)), true
+}
+
+// setChannelClosureReason sets the reason for which the channel was closed.
+func (k *Keeper) setChannelClosureReason(ctx sdk.Context, portID, channelID string, reason types.ClosureReason) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ChannelClosureReasonKey(portID, channelID), sdk.Uint64ToBigEndian(uint64(reason)))
+}
+
+// hasChannelWithCounterparty returns true if a channel bound to the given port and connection
+// already exists with the provided counterparty port and channel identifiers.
+func (k *Keeper) hasChannelWithCounterparty(ctx sdk.Context, portID, connectionID string, counterparty types.Counterparty) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ChannelCounterpartyKey(portID, connectionID, counterparty.PortId, counterparty.ChannelId))
+}
+
+// SetChannelCounterpartyIndex indexes the channel by its port, connection and counterparty channel end. Channels
+// whose counterparty channel identifier is not yet known are not indexed.
+func (k *Keeper) SetChannelCounterpartyIndex(ctx sdk.Context, portID, channelID string, channel types.Channel) {
+	if channel.Counterparty.ChannelId == "" {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ChannelCounterpartyKey(portID, channel.ConnectionHops[0], channel.Counterparty.PortId, channel.Counterparty.ChannelId), []byte(channelID))
+}
+
+// PruneStaleInitChannel closes a channel which has remained in the INIT state for longer than the
+// stale INIT channel age defined in the channel parameters. Pruning is permissionless and disabled
+// when the stale INIT channel age is zero. The channel is moved to the CLOSED state rather than
+// deleted, so that the channel capability, which remains owned by the application which initialized
+// the channel, is never bound to a channel which does not exist. The caller is responsible for invoking
+// the channel closure callbacks of the application owning the provided channel capability.
+func (k *Keeper) PruneStaleInitChannel(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error {
+	staleInitChannelAge := k.GetParams(ctx).StaleInitChannelAge
+	if staleInitChannelAge == 0 {
+		return errorsmod.Wrap(types.ErrChannelNotPrunable, "pruning of stale INIT channels is disabled")
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.INIT {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.INIT, channel.State)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return errorsmod.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	initTimestamp, found := k.GetChannelInitTimestamp(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotPrunable, "init timestamp not found for port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if blockTime < initTimestamp || blockTime-initTimestamp < staleInitChannelAge {
+		return errorsmod.Wrapf(types.ErrChannelNotPrunable, "channel initialized at %d has not reached the stale INIT channel age %d, current block time %d", initTimestamp, staleInitChannelAge, blockTime)
+	}
+
+	channel.State = types.CLOSED
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.setChannelClosureReason(ctx, portID, channelID, types.CLOSURE_STALE_INIT)
+	k.deleteChannelInitTimestamp(ctx, portID, channelID)
+
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("stale INIT channel pruned", "init-timestamp", initTimestamp, logging.KeyPreviousState, types.INIT.String(), logging.KeyNewState, types.CLOSED.String())
+
+	emitChannelPrunedEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
@@ -5,11 +5,16 @@ import (
 	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
+	storetypes "cosmossdk.io/store/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -471,6 +476,135 @@ func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
 	suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq))
 }
 
+// TestHasPacketReceipts verifies that the packet receipts bitmap correctly reflects
+// the existence of packet receipts for the provided sequences.
+func (suite *KeeperTestSuite) TestHasPacketReceipts() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	ctxA := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	for _, seq := range []uint64{1, 3, 9} {
+		channelKeeper.SetPacketReceipt(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+	}
+
+	suite.Require().True(channelKeeper.HasPacketReceipt(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1))
+	suite.Require().False(channelKeeper.HasPacketReceipt(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2))
+
+	bitmap := channelKeeper.HasPacketReceipts(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	suite.Require().Equal([]byte{0x05, 0x01}, bitmap)
+
+	bitmap = channelKeeper.HasPacketReceipts(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nil)
+	suite.Require().Empty(bitmap)
+}
+
+// TestGetChannelConnection verifies that the connection end of a channel is resolved and that OPEN
+// connection ends are cached for the remainder of the block, consuming the same gas as a store read.
+func (suite *KeeperTestSuite) TestGetChannelConnection() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	transientKey := suite.chainA.GetSimApp().GetTKey(exported.TransientStoreKey)
+
+	isCached := func() bool {
+		return suite.chainA.GetContext().TransientStore(transientKey).Has(connectiontypes.ConnectionCacheKey(path.EndpointA.ConnectionID))
+	}
+
+	// resolve returns the gas consumed resolving the connection of the channel on chainA
+	resolve := func() uint64 {
+		gasMeter := storetypes.NewGasMeter(math.MaxUint64)
+		ctx := suite.chainA.GetContext().WithGasMeter(gasMeter)
+
+		connectionID, connection, err := channelKeeper.GetChannelConnection(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(path.EndpointA.ConnectionID, connectionID)
+		suite.Require().Equal(path.EndpointA.GetConnection(), connection)
+		suite.Require().Equal(path.EndpointB.ClientID, connection.Counterparty.ClientId)
+
+		return gasMeter.GasConsumed()
+	}
+
+	suite.Require().False(isCached())
+	uncachedGas := resolve()
+	suite.Require().True(isCached())
+	suite.Require().Equal(uncachedGas, resolve(), "cached connection end must consume the gas of a store read")
+
+	// the cache is cleared when the block is committed
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().False(isCached())
+	suite.Require().Equal(uncachedGas, resolve())
+
+	// the client of a channel resolved by middlewares in the packet flow is looked up through the cache
+	suite.coordinator.CommitBlock(suite.chainA)
+	clientID, _, err := channelKeeper.GetChannelClientState(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.ClientID, clientID)
+	suite.Require().True(isCached())
+
+	_, _, err = channelKeeper.GetChannelConnection(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+	suite.Require().ErrorIs(err, types.ErrChannelNotFound)
+}
+
+// TestSetConnectionInvalidatesCache verifies that a connection end set in the same block is not shadowed
+// by a cached connection end.
+func (suite *KeeperTestSuite) TestSetConnectionInvalidatesCache() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	ctx := suite.chainA.GetContext()
+
+	connection, err := channelKeeper.GetConnection(ctx, path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), connection.DelayPeriod)
+
+	connection.DelayPeriod = 100
+	suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(ctx, path.EndpointA.ConnectionID, connection)
+
+	connection, err = channelKeeper.GetConnection(ctx, path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(100), connection.DelayPeriod)
+}
+
+// TestGetConnectionNotOpenNotCached verifies that connection ends which are not OPEN are not cached,
+// as they may still be modified by the connection handshake in the same block.
+func (suite *KeeperTestSuite) TestGetConnectionNotOpenNotCached() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+	suite.Require().NoError(path.EndpointA.ConnOpenInit())
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	ctx := suite.chainA.GetContext()
+
+	connection, err := channelKeeper.GetConnection(ctx, path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(connectiontypes.INIT, connection.State)
+
+	// complete the connection handshake in the same block
+	suite.Require().NoError(path.EndpointB.ConnOpenTry())
+	suite.Require().NoError(path.EndpointA.ConnOpenAck())
+
+	connection, err = channelKeeper.GetConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(connectiontypes.OPEN, connection.State)
+}
+
+func (suite *KeeperTestSuite) TestGetChannelClientLatestHeight() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	latestHeight, err := channelKeeper.GetChannelClientLatestHeight(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.GetClientLatestHeight(), latestHeight)
+
+	_, err = channelKeeper.GetChannelClientLatestHeight(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+	suite.Require().ErrorIs(err, types.ErrChannelNotFound)
+}
+
 func (suite *KeeperTestSuite) TestSetUpgradeErrorReceipt() {
 	path := ibctesting.NewPath(suite.chainA, suite.chainB)
 	path.SetupConnections()
@@ -507,9 +641,12 @@ func (suite *KeeperTestSuite) TestParams() {
 		expPass bool
 	}{
 		{"success: set default params", types.DefaultParams(), true},
-		{"success: zero timeout height", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 10000)), true},
-		{"fail: zero timeout timestamp", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0)), false},
-		{"fail: zero timeout", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0)), false},
+		{"success: zero timeout height", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 10000), false, 0), true},
+		{"fail: zero timeout timestamp", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0), false, 0), false},
+		{"fail: zero timeout", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0), false, 0), false},
+		{"success: hashed packet data ports", types.NewParams(types.DefaultTimeout, false, 0, ibctesting.MockPort, ibctesting.TransferPort), true},
+		{"fail: invalid hashed packet data port", types.NewParams(types.DefaultTimeout, false, 0, ""), false},
+		{"fail: duplicate hashed packet data port", types.NewParams(types.DefaultTimeout, false, 0, ibctesting.MockPort, ibctesting.MockPort), false},
 	}
 
 	for _, tc := range testCases {
@@ -889,6 +1026,135 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPruneStaleInitChannel() {
+	var (
+		path    *ibctesting.Path
+		chanCap *capabilitytypes.Capability
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: pruning of stale INIT channels is disabled",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.StaleInitChannelAge = 0
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrChannelNotPrunable,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is not in INIT state",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.OPEN })
+			},
+			types.ErrInvalidChannelState,
+		},
+		{
+			"failure: invalid channel capability",
+			func() {
+				chanCap = capabilitytypes.NewCapability(100)
+			},
+			types.ErrChannelCapabilityNotFound,
+		},
+		{
+			"failure: channel has not reached the stale INIT channel age",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.StaleInitChannelAge = uint64(time.Hour.Nanoseconds())
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrChannelNotPrunable,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.StaleInitChannelAge = uint64(time.Minute.Nanoseconds())
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			suite.coordinator.IncrementTimeBy(time.Minute)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneStaleInitChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, chanCap)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				channel, found := channelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.CLOSED, channel.State)
+
+				reason, found := channelKeeper.GetChannelClosureReason(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.CLOSURE_STALE_INIT, reason)
+
+				// the channel capability is still bound to an existing channel
+				_, found = suite.chainA.App.GetScopedIBCKeeper().GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.Require().True(found)
+
+				_, found = channelKeeper.GetChannelInitTimestamp(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelInitTimestampDeletedOnOpenAck() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := path.EndpointA.ChanOpenInit()
+	suite.Require().NoError(err)
+
+	timestamp, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelInitTimestamp(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().NotZero(timestamp)
+
+	err = path.EndpointB.ChanOpenTry()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.ChanOpenAck()
+	suite.Require().NoError(err)
+
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelInitTimestamp(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+}
+
 // UpgradeChannel performs a channel upgrade given a specific set of upgrade fields.
 // Question(jim): setup.coordinator.UpgradeChannel() wen?
 func (suite *KeeperTestSuite) UpgradeChannel(path *ibctesting.Path, upgradeFields types.UpgradeFields) {
@@ -23,3 +23,15 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated ibc channel params")
 	return nil
 }
+
+// Migrate6to7 migrates from consensus version 6 to 7 of the ibc module.
+// This migration indexes all existing channels by their port, connection and counterparty channel end.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	channels := m.keeper.GetAllChannels(ctx)
+	for _, channel := range channels {
+		m.keeper.SetChannelCounterpartyIndex(ctx, channel.PortId, channel.ChannelId, channeltypes.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version))
+	}
+
+	m.keeper.Logger(ctx).Info("successfully indexed channels by counterparty", "channels", len(channels))
+	return nil
+}
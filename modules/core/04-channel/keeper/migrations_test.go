@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 // TestMigrateDefaultParams tests the migration for the channel params
@@ -32,3 +35,23 @@ func (suite *KeeperTestSuite) TestMigrateDefaultParams() {
 		})
 	}
 }
+
+// TestMigrate6to7 tests that the migration indexes existing channels by counterparty channel end
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	ctx := suite.chainA.GetContext()
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	counterpartyKey := host.ChannelCounterpartyKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+	// remove the index to simulate a channel opened before the migration
+	suite.Require().True(store.Has(counterpartyKey))
+	store.Delete(counterpartyKey)
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper)
+	err := migrator.Migrate6to7(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]byte(path.EndpointA.ChannelID), store.Get(counterpartyKey))
+}
@@ -3,13 +3,13 @@ package keeper
 import (
 	"bytes"
 	"slices"
-	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -58,7 +58,7 @@ func (k *Keeper) SendPacket(
 		return 0, errorsmod.Wrap(err, "constructed packet failed basic validation")
 	}
 
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return 0, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
@@ -89,16 +89,9 @@ func (k *Keeper) SendPacket(
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
 
-	emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
+	emitSendPacketEvent(ctx, packet, channel, timeoutHeight, k.GetParams(ctx).IsHashedPacketDataPort(packet.GetSourcePort()))
 
-	k.Logger(ctx).Info(
-		"packet sent",
-		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
-		"src_port", sourcePort,
-		"src_channel", sourceChannel,
-		"dst_port", packet.GetDestPort(),
-		"dst_channel", packet.GetDestChannel(),
-	)
+	logging.WithPacket(k.Logger(ctx), packet).Info("packet sent")
 
 	return packet.GetSequence(), nil
 }
@@ -161,7 +154,7 @@ func (k *Keeper) RecvPacket(
 	// Connection must be OPEN to receive a packet. It is possible for connection to not yet be open if packet was
 	// sent optimistically before connection and channel handshake completed. However, to receive a packet,
 	// connection and channel must both be open
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
@@ -201,10 +194,16 @@ func (k *Keeper) RecvPacket(
 	case types.UNORDERED:
 		// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
 		// on unordered channels. Packet receipts must not be pruned, unless it has been marked stale
-		// by the increase of the recvStartSequence.
+		// by the increase of the recvStartSequence or they are below the receipt watermark. Packets
+		// below the receipt watermark have been acknowledged or timed out on the counterparty and
+		// are rejected as expired.
+		if watermark, found := k.GetReceiptWatermark(ctx, packet.GetDestPort(), packet.GetDestChannel()); found && packet.GetSequence() < watermark {
+			return errorsmod.Wrapf(types.ErrPacketExpired, "packet sequence < receipt watermark (%d < %d)", packet.GetSequence(), watermark)
+		}
+
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 		if found {
-			emitRecvPacketEvent(ctx, packet, channel)
+			emitRecvPacketEvent(ctx, packet, channel, k.GetParams(ctx).IsHashedPacketDataPort(packet.GetDestPort()))
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
 			// from failing and consuming unnecessary fees.
@@ -228,7 +227,7 @@ func (k *Keeper) RecvPacket(
 		}
 
 		if packet.GetSequence() < nextSequenceRecv {
-			emitRecvPacketEvent(ctx, packet, channel)
+			emitRecvPacketEvent(ctx, packet, channel, k.GetParams(ctx).IsHashedPacketDataPort(packet.GetDestPort()))
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
 			// from failing and consuming unnecessary fees.
@@ -254,17 +253,10 @@ func (k *Keeper) RecvPacket(
 	}
 
 	// log that a packet has been received & executed
-	k.Logger(ctx).Info(
-		"packet received",
-		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
-		"src_port", packet.GetSourcePort(),
-		"src_channel", packet.GetSourceChannel(),
-		"dst_port", packet.GetDestPort(),
-		"dst_channel", packet.GetDestChannel(),
-	)
+	logging.WithPacket(k.Logger(ctx), packet).Info("packet received")
 
 	// emit an event that the relayer can query for
-	emitRecvPacketEvent(ctx, packet, channel)
+	emitRecvPacketEvent(ctx, packet, channel, k.GetParams(ctx).IsHashedPacketDataPort(packet.GetDestPort()))
 
 	return nil
 }
@@ -280,6 +272,9 @@ func (k *Keeper) RecvPacket(
 //
 // 2) Assumes that packet receipt has been written (unordered), or nextSeqRecv was incremented (ordered)
 // previously by RecvPacket.
+//
+// 3) The registered write acknowledgement hooks are invoked for asynchronous acknowledgements, i.e.
+// acknowledgements which are not written by the IBC handler.
 func (k *Keeper) WriteAcknowledgement(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -325,6 +320,23 @@ func (k *Keeper) WriteAcknowledgement(
 		return errorsmod.Wrap(types.ErrInvalidAcknowledgement, "acknowledgement cannot be nil")
 	}
 
+	// acknowledgements returned by the OnRecvPacket callback have already been processed by
+	// the middleware stack, the registered wrappers are only invoked for asynchronous acknowledgements
+	isAsyncAck := !types.IsSyncAcknowledgement(ctx)
+	if isAsyncAck {
+		for _, wrap := range k.ackWrappers {
+			var err error
+			acknowledgement, err = wrap(ctx, packet, acknowledgement)
+			if err != nil {
+				return errorsmod.Wrap(err, "acknowledgement wrapper failed")
+			}
+
+			if acknowledgement == nil {
+				return errorsmod.Wrap(types.ErrInvalidAcknowledgement, "acknowledgement wrapper returned a nil acknowledgement")
+			}
+		}
+	}
+
 	bz := acknowledgement.Acknowledgement()
 	if len(bz) == 0 {
 		return errorsmod.Wrap(types.ErrInvalidAcknowledgement, "acknowledgement cannot be empty")
@@ -337,16 +349,17 @@ func (k *Keeper) WriteAcknowledgement(
 	)
 
 	// log that a packet acknowledgement has been written
-	k.Logger(ctx).Info(
-		"acknowledgement written",
-		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
-		"src_port", packet.GetSourcePort(),
-		"src_channel", packet.GetSourceChannel(),
-		"dst_port", packet.GetDestPort(),
-		"dst_channel", packet.GetDestChannel(),
-	)
+	logging.WithPacket(k.Logger(ctx), packet).Info("acknowledgement written")
 
-	emitWriteAcknowledgementEvent(ctx, packet.(types.Packet), channel, bz)
+	emitWriteAcknowledgementEvent(ctx, packet.(types.Packet), channel, bz, k.GetParams(ctx).IsHashedPacketDataPort(packet.GetDestPort()))
+
+	if isAsyncAck {
+		for _, hook := range k.writeAckHooks {
+			if err := hook(ctx, packet, acknowledgement); err != nil {
+				return errorsmod.Wrap(err, "write acknowledgement hook failed")
+			}
+		}
+	}
 
 	return nil
 }
@@ -401,7 +414,7 @@ func (k *Keeper) AcknowledgePacket(
 		)
 	}
 
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
@@ -464,15 +477,12 @@ func (k *Keeper) AcknowledgePacket(
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
+	if channel.Ordering == types.UNORDERED {
+		k.advanceCommitmentWatermark(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
 	// log that a packet has been acknowledged
-	k.Logger(ctx).Info(
-		"packet acknowledged",
-		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
-		"src_port", packet.GetSourcePort(),
-		"src_channel", packet.GetSourceChannel(),
-		"dst_port", packet.GetDestPort(),
-		"dst_channel", packet.GetDestChannel(),
-	)
+	logging.WithPacket(k.Logger(ctx), packet).Info("packet acknowledged")
 
 	// emit an event marking that we have processed the acknowledgement
 	emitAcknowledgePacketEvent(ctx, packet, channel)
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"cosmossdk.io/errors"
@@ -452,6 +454,20 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			types.ErrNoOpMsg,
 		},
+		{
+			"packet below receipt watermark UNORDERED channel",
+			func() {
+				// setup uses an UNORDERED channel
+				path.Setup()
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetReceiptWatermark(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence+1)
+			},
+			types.ErrPacketExpired,
+		},
 		{
 			"out of order packet failure with ORDERED channel",
 			func() {
@@ -828,7 +844,244 @@ func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	}
 }
 
+// TestWriteAcknowledgementHooks tests that the registered write acknowledgement hooks are invoked
+// for asynchronous acknowledgements only.
+func (suite *KeeperTestSuite) TestWriteAcknowledgementHooks() {
+	var (
+		hookCalls int
+		hookErr   error
+		ctx       sdk.Context
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expHookCalls int
+		expErr       error
+	}{
+		{
+			"success: hooks invoked for asynchronous acknowledgement",
+			func() {},
+			2,
+			nil,
+		},
+		{
+			"success: hooks not invoked for synchronous acknowledgement",
+			func() {
+				ctx = types.WithSyncAcknowledgement(ctx)
+			},
+			0,
+			nil,
+		},
+		{
+			"failure: hook returns error",
+			func() {
+				hookErr = ibcmock.MockApplicationCallbackError
+			},
+			1,
+			ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			hookCalls = 0
+			hookErr = nil
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			hook := func(ctx sdk.Context, hookPacket exported.PacketI, ack exported.Acknowledgement) error {
+				hookCalls++
+				suite.Require().Equal(packet, hookPacket)
+				suite.Require().Equal(ibcmock.MockAcknowledgement, ack)
+
+				// the acknowledgement is written before the hooks are invoked
+				suite.Require().True(suite.chainB.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(ctx, hookPacket.GetDestPort(), hookPacket.GetDestChannel(), hookPacket.GetSequence()))
+
+				return hookErr
+			}
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			channelKeeper.RegisterWriteAcknowledgementHook(hook)
+			channelKeeper.RegisterWriteAcknowledgementHook(hook)
+
+			ctx = suite.chainB.GetContext()
+			tc.malleate()
+
+			err := channelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ibcmock.MockAcknowledgement)
+
+			suite.Require().Equal(tc.expHookCalls, hookCalls)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestWriteAcknowledgementWrappers tests that the registered acknowledgement wrappers are invoked in order
+// before an asynchronous acknowledgement is written.
+func (suite *KeeperTestSuite) TestWriteAcknowledgementWrappers() {
+	var (
+		wrapperErr error
+		wrapperAck exported.Acknowledgement
+		ctx        sdk.Context
+		expAck     []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: wrappers invoked for asynchronous acknowledgement",
+			func() {},
+			nil,
+		},
+		{
+			"success: wrappers not invoked for synchronous acknowledgement",
+			func() {
+				ctx = types.WithSyncAcknowledgement(ctx)
+				expAck = ibcmock.MockAcknowledgement.Acknowledgement()
+			},
+			nil,
+		},
+		{
+			"failure: wrapper returns error",
+			func() {
+				wrapperErr = ibcmock.MockApplicationCallbackError
+			},
+			ibcmock.MockApplicationCallbackError,
+		},
+		{
+			"failure: wrapper returns nil acknowledgement",
+			func() {
+				wrapperAck = nil
+			},
+			types.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			wrapperErr = nil
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			// wrap returns a result acknowledgement of the provided acknowledgement with the suffix appended
+			wrap := func(ack exported.Acknowledgement, suffix string) exported.Acknowledgement {
+				return types.NewResultAcknowledgement(append(ack.Acknowledgement(), []byte(suffix)...))
+			}
+
+			newWrapper := func(suffix string) types.AcknowledgementWrapper {
+				return func(_ sdk.Context, wrappedPacket exported.PacketI, ack exported.Acknowledgement) (exported.Acknowledgement, error) {
+					suite.Require().Equal(packet, wrappedPacket)
+					if wrapperErr != nil {
+						return nil, wrapperErr
+					}
+
+					if wrapperAck == nil {
+						return nil, nil
+					}
+
+					return wrap(ack, suffix), nil
+				}
+			}
+
+			wrapperAck = ibcmock.MockAcknowledgement
+			expAck = wrap(wrap(ibcmock.MockAcknowledgement, "/a"), "/b").Acknowledgement()
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			channelKeeper.RegisterAcknowledgementWrapper(newWrapper("/a"))
+			channelKeeper.RegisterAcknowledgementWrapper(newWrapper("/b"))
+
+			ctx = suite.chainB.GetContext()
+			tc.malleate()
+
+			err := channelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ibcmock.MockAcknowledgement)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				committedAck, found := channelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().Equal(types.CommitAcknowledgement(expAck), committedAck)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 // TestAcknowledgePacket tests the call AcknowledgePacket on chainA.
+// TestHashedPacketDataEvents asserts that the packet events of ports configured in the channel params only
+// contain the hash of the packet data.
+func (suite *KeeperTestSuite) TestHashedPacketDataEvents() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+		channelKeeper := chain.App.GetIBCKeeper().ChannelKeeper
+		params := channelKeeper.GetParams(chain.GetContext())
+		params.HashedPacketDataPorts = []string{ibctesting.MockPort}
+		channelKeeper.SetParams(chain.GetContext(), params)
+	}
+
+	dataHash := sha256.Sum256(ibctesting.MockPacketData)
+	expDataHash := hex.EncodeToString(dataHash[:])
+
+	assertHashed := func(events []abci.Event, eventType string) {
+		var found bool
+		for _, event := range events {
+			if event.Type != eventType {
+				continue
+			}
+
+			found = true
+			attributes := make(map[string]string)
+			for _, attr := range event.Attributes {
+				attributes[attr.Key] = attr.Value
+			}
+
+			suite.Require().Equal(expDataHash, attributes[types.AttributeKeyDataHash])
+			suite.Require().NotContains(attributes, types.AttributeKeyDataHex)
+			suite.Require().Equal(path.EndpointA.ChannelID, attributes[types.AttributeKeySrcChannel])
+			suite.Require().Equal(path.EndpointB.ChannelID, attributes[types.AttributeKeyDstChannel])
+			suite.Require().Equal("1", attributes[types.AttributeKeySequence])
+		}
+		suite.Require().True(found, "%s event not emitted", eventType)
+	}
+
+	ctx := suite.chainA.GetContext()
+	channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	sequence, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	assertHashed(ctx.EventManager().ABCIEvents(), types.EventTypeSendPacket)
+
+	suite.chainA.NextBlock()
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	assertHashed(res.Events, types.EventTypeRecvPacket)
+	assertHashed(res.Events, types.EventTypeWriteAck)
+}
+
 func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 	var (
 		path   *ibctesting.Path
@@ -2,13 +2,13 @@ package keeper
 
 import (
 	"bytes"
-	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -54,7 +54,7 @@ func (k *Keeper) TimeoutPacket(
 		)
 	}
 
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return errorsmod.Wrap(
 			connectiontypes.ErrConnectionNotFound,
@@ -150,6 +150,10 @@ func (k *Keeper) TimeoutExecuted(
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
+	if channel.Ordering == types.UNORDERED {
+		k.advanceCommitmentWatermark(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
 	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
 		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
@@ -177,27 +181,16 @@ func (k *Keeper) TimeoutExecuted(
 		// all upgrade information is deleted and the channel is set to CLOSED.
 		if channel.State == types.FLUSHING {
 			k.deleteUpgradeInfo(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
-			k.Logger(ctx).Info(
-				"upgrade info deleted",
-				"port_id", packet.GetSourcePort(),
-				"channel_id", packet.GetSourceChannel(),
-				"upgrade_sequence", channel.UpgradeSequence,
-			)
+			logging.WithChannel(k.Logger(ctx), packet.GetSourcePort(), packet.GetSourceChannel()).Info("upgrade info deleted", logging.KeyUpgradeSequence, channel.UpgradeSequence)
 		}
 
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
-		emitChannelClosedEvent(ctx, packet, channel)
+		k.setChannelClosureReason(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), types.CLOSURE_PACKET_TIMEOUT)
+		emitChannelClosedEvent(ctx, packet, channel, types.CLOSURE_PACKET_TIMEOUT)
 	}
 
-	k.Logger(ctx).Info(
-		"packet timed-out",
-		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
-		"src_port", packet.GetSourcePort(),
-		"src_channel", packet.GetSourceChannel(),
-		"dst_port", packet.GetDestPort(),
-		"dst_channel", packet.GetDestChannel(),
-	)
+	logging.WithPacket(k.Logger(ctx), packet).Info("packet timed-out")
 
 	// emit an event marking that we have processed the timeout
 	emitTimeoutPacketEvent(ctx, packet, channel)
@@ -245,7 +238,7 @@ func (k *Keeper) TimeoutOnClose(
 		)
 	}
 
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	connectionEnd, found := k.getConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
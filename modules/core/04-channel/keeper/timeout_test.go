@@ -273,6 +273,10 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 				// Check channel has been closed
 				channel := path.EndpointA.GetChannel()
 				suite.Require().Equal(channel.State, types.CLOSED)
+
+				closureReason, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelClosureReason(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.CLOSURE_PACKET_TIMEOUT, closureReason)
 			},
 			nil,
 		},
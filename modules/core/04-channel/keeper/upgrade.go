@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -66,7 +67,7 @@ func (k *Keeper) WriteUpgradeInitChannel(ctx sdk.Context, portID, channelID stri
 	k.SetChannel(ctx, portID, channelID, channel)
 	k.SetUpgrade(ctx, portID, channelID, upgrade)
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "state", channel.State, "upgrade-sequence", fmt.Sprintf("%d", channel.UpgradeSequence))
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyNewState, channel.State, logging.KeyUpgradeSequence, channel.UpgradeSequence)
 
 	return channel, upgrade
 }
@@ -134,6 +135,14 @@ func (k *Keeper) ChanUpgradeTry(
 	)
 
 	upgrade, isCrossingHello = k.GetUpgrade(ctx, portID, channelID)
+	if isCrossingHello && k.GetParams(ctx).StrictHandshake {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(
+			types.ErrCrossingHelloNotPermitted,
+			"strict handshake: an upgrade already exists for port ID (%s) channel ID (%s), it must be cancelled or completed before accepting a counterparty upgrade",
+			portID, channelID,
+		)
+	}
+
 	if isCrossingHello {
 		expectedUpgradeSequence = channel.UpgradeSequence
 	} else {
@@ -234,7 +243,7 @@ func (k *Keeper) WriteUpgradeTryChannel(ctx sdk.Context, portID, channelID strin
 	upgrade.Fields.Version = upgradeVersion
 	k.SetUpgrade(ctx, portID, channelID, upgrade)
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.OPEN, "new-state", channel.State)
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, types.OPEN, logging.KeyNewState, channel.State)
 
 	return channel, upgrade
 }
@@ -364,7 +373,7 @@ func (k *Keeper) WriteUpgradeAckChannel(ctx sdk.Context, portID, channelID strin
 		previousState := channel.State
 		channel.State = types.FLUSHCOMPLETE
 		k.SetChannel(ctx, portID, channelID, channel)
-		k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState, "new-state", channel.State)
+		logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, previousState, logging.KeyNewState, channel.State)
 	}
 
 	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
@@ -472,7 +481,7 @@ func (k *Keeper) WriteUpgradeConfirmChannel(ctx sdk.Context, portID, channelID s
 		previousState := channel.State
 		channel.State = types.FLUSHCOMPLETE
 		k.SetChannel(ctx, portID, channelID, channel)
-		k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState, "new-state", channel.State)
+		logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, previousState, logging.KeyNewState, channel.State)
 	}
 
 	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
@@ -629,7 +638,7 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID stri
 	// delete state associated with upgrade which is no longer required.
 	k.deleteUpgradeInfo(ctx, portID, channelID)
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", types.OPEN.String())
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, previousState.String(), logging.KeyNewState, types.OPEN.String())
 	return channel
 }
 
@@ -707,7 +716,7 @@ func (k *Keeper) WriteUpgradeCancelChannel(ctx sdk.Context, portID, channelID st
 	channel = k.restoreChannel(ctx, portID, channelID, sequence, channel)
 	k.WriteErrorReceipt(ctx, portID, channelID, types.NewUpgradeError(sequence, types.ErrInvalidUpgrade))
 
-	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState, "new-state", types.OPEN.String())
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state updated", logging.KeyPreviousState, previousState, logging.KeyNewState, types.OPEN.String())
 }
 
 // ChanUpgradeTimeout times out an outstanding upgrade.
@@ -827,7 +836,7 @@ func (k *Keeper) WriteUpgradeTimeoutChannel(
 	channel = k.restoreChannel(ctx, portID, channelID, channel.UpgradeSequence, channel)
 	k.WriteErrorReceipt(ctx, portID, channelID, types.NewUpgradeError(channel.UpgradeSequence, types.ErrUpgradeTimeout))
 
-	k.Logger(ctx).Info("channel state restored", "port-id", portID, "channel-id", channelID)
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("channel state restored")
 
 	return channel, upgrade
 }
@@ -946,6 +955,40 @@ func (k *Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade type
 	return nil
 }
 
+// upgradeCompatibilityConflicts returns every reason for which an upgrade of the provided channel to the proposed
+// upgrade fields would be rejected in the channel's current state. The proposed upgrade fields conflict with:
+// - the channel if they are invalid, identical to the current channel fields or the channel is not OPEN
+// - the connection if the proposed connection is not OPEN or does not support the proposed ordering
+// - a pending upgrade proposed by this chain, which a new upgrade would invalidate, if their fields differ
+// - a pending upgrade proposed by the counterparty if they are incompatible with the counterparty upgrade fields
+func (k *Keeper) upgradeCompatibilityConflicts(ctx sdk.Context, portID, channelID string, channel types.Channel, proposedUpgrade types.UpgradeFields) []error {
+	if err := proposedUpgrade.ValidateBasic(); err != nil {
+		// the remaining checks require valid upgrade fields
+		return []error{err}
+	}
+
+	var conflicts []error
+	if channel.State != types.OPEN {
+		conflicts = append(conflicts, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State))
+	}
+
+	if err := k.validateSelfUpgradeFields(ctx, proposedUpgrade, channel); err != nil {
+		conflicts = append(conflicts, err)
+	}
+
+	if upgrade, found := k.GetUpgrade(ctx, portID, channelID); found && !reflect.DeepEqual(proposedUpgrade, upgrade.Fields) {
+		conflicts = append(conflicts, errorsmod.Wrapf(types.ErrInvalidUpgrade, "proposed upgrade fields differ from the pending upgrade fields: got %s, pending %s", proposedUpgrade, upgrade.Fields))
+	}
+
+	if counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, portID, channelID); found {
+		if err := k.checkForUpgradeCompatibility(ctx, proposedUpgrade, counterpartyUpgrade.Fields); err != nil {
+			conflicts = append(conflicts, err)
+		}
+	}
+
+	return conflicts
+}
+
 // extractUpgradeFields returns the upgrade fields from the provided channel.
 func extractUpgradeFields(channel types.Channel) types.UpgradeFields {
 	return types.UpgradeFields{
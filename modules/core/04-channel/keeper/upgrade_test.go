@@ -141,6 +141,18 @@ func (suite *KeeperTestSuite) TestChanUpgradeTry() {
 			},
 			nil,
 		},
+		{
+			"crossing hellos: fails in strict handshake mode",
+			func() {
+				err := path.EndpointB.ChanUpgradeInit()
+				suite.Require().NoError(err)
+
+				params := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainB.GetContext())
+				params.StrictHandshake = true
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrCrossingHelloNotPermitted,
+		},
 		{
 			"success: upgrade sequence is fast forwarded to counterparty upgrade sequence",
 			func() {
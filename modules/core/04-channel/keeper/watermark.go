@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/logging"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// maxCommitmentWatermarkSteps is the maximum number of sequences by which the commitment watermark
+// of a channel is advanced when a packet commitment is deleted. It bounds the gas consumed by a single
+// acknowledgement or timeout, the remaining sequences are skipped when later commitments are deleted.
+const maxCommitmentWatermarkSteps = 1000
+
+// GetCommitmentWatermark returns the commitment watermark of a channel. All packets sent on the channel
+// with a sequence below the commitment watermark have been acknowledged or timed out.
+func (k *Keeper) GetCommitmentWatermark(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.CommitmentWatermarkKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetCommitmentWatermark sets the commitment watermark of a channel.
+func (k *Keeper) SetCommitmentWatermark(ctx sdk.Context, portID, channelID string, watermark uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.CommitmentWatermarkKey(portID, channelID), sdk.Uint64ToBigEndian(watermark))
+}
+
+// GetReceiptWatermark returns the receipt watermark of a channel. Packets with a sequence below the
+// receipt watermark can no longer be received on the channel and their receipts have been pruned.
+func (k *Keeper) GetReceiptWatermark(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ReceiptWatermarkKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetReceiptWatermark sets the receipt watermark of a channel.
+func (k *Keeper) SetReceiptWatermark(ctx sdk.Context, portID, channelID string, watermark uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ReceiptWatermarkKey(portID, channelID), sdk.Uint64ToBigEndian(watermark))
+}
+
+// GetAllCommitmentWatermarks returns the commitment watermarks of all channels.
+func (k *Keeper) GetAllCommitmentWatermarks(ctx sdk.Context) []types.PacketSequence {
+	return k.getAllWatermarks(ctx, host.KeyCommitmentWatermark)
+}
+
+// GetAllReceiptWatermarks returns the receipt watermarks of all channels.
+func (k *Keeper) GetAllReceiptWatermarks(ctx sdk.Context) []types.PacketSequence {
+	return k.getAllWatermarks(ctx, host.KeyReceiptWatermark)
+}
+
+// getAllWatermarks returns the watermarks of all channels stored under the provided key prefix.
+func (k *Keeper) getAllWatermarks(ctx sdk.Context, keyPrefix string) []types.PacketSequence {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(keyPrefix+"/"))
+
+	var watermarks []types.PacketSequence
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, watermark uint64) bool {
+		watermarks = append(watermarks, types.NewPacketSequence(portID, channelID, watermark))
+		return false
+	})
+
+	return watermarks
+}
+
+// EnableCommitmentWatermark opts an UNORDERED channel in to tracking its commitment watermark, which is
+// required for the counterparty channel end to advance its receipt watermark. The watermark is initialized
+// to the lowest sequence with a packet commitment, or to the next sequence send if no packets are in flight.
+// Only channels with a commitment watermark advance it when packet commitments are deleted, so the packet
+// commitments of a channel are iterated once on opt-in rather than on acknowledgement or timeout.
+func (k *Keeper) EnableCommitmentWatermark(ctx sdk.Context, portID, channelID string) (uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "commitment watermarks are only supported on %s channels, got %s", types.UNORDERED, channel.Ordering)
+	}
+
+	if _, found := k.GetCommitmentWatermark(ctx, portID, channelID); found {
+		return 0, errorsmod.Wrapf(types.ErrInvalidWatermark, "commitment watermark is already enabled for port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	watermark, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.IteratePacketCommitmentAtChannel(ctx, portID, channelID, func(_, _ string, sequence uint64, _ []byte) bool {
+		watermark = min(watermark, sequence)
+		return false
+	})
+
+	k.SetCommitmentWatermark(ctx, portID, channelID, watermark)
+
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("commitment watermark enabled", "watermark", watermark)
+
+	return watermark, nil
+}
+
+// advanceCommitmentWatermark advances the commitment watermark of an UNORDERED channel past the sequences
+// whose packet commitments have been deleted, up to the next sequence send of the channel. Channels which
+// have not opted in to commitment watermarks using EnableCommitmentWatermark are left unchanged.
+func (k *Keeper) advanceCommitmentWatermark(ctx sdk.Context, portID, channelID string) {
+	watermark, found := k.GetCommitmentWatermark(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	for steps := 0; steps < maxCommitmentWatermarkSteps && watermark < nextSequenceSend; steps++ {
+		if k.HasPacketCommitment(ctx, portID, channelID, watermark) {
+			break
+		}
+
+		watermark++
+	}
+
+	k.SetCommitmentWatermark(ctx, portID, channelID, watermark)
+}
+
+// AdvanceReceiptWatermark advances the receipt watermark of an UNORDERED channel to the commitment watermark
+// of the counterparty channel end, which is verified against the provided proof. Since all packets below the
+// counterparty commitment watermark have been acknowledged or timed out, their packet receipts and
+// acknowledgements are no longer needed and are pruned. The watermark is advanced by at most limit sequences,
+// and packets below it are rejected as expired. The receipt watermark of the channel after the advance is returned.
+func (k *Keeper) AdvanceReceiptWatermark(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyWatermark,
+	limit uint64,
+	proof []byte,
+	proofHeight exported.Height,
+) (uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return 0, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected channel state to be %s, but got %s", types.OPEN, channel.State)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "receipt watermarks are only supported on %s channels, got %s", types.UNORDERED, channel.Ordering)
+	}
+
+	connectionEnd, found := k.getConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return 0, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if connectionEnd.State != connectiontypes.OPEN {
+		return 0, errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
+	}
+
+	watermark, found := k.GetReceiptWatermark(ctx, portID, channelID)
+	if !found {
+		watermark = 1
+	}
+
+	if counterpartyWatermark <= watermark {
+		return 0, errorsmod.Wrapf(types.ErrInvalidWatermark, "counterparty watermark must be greater than the receipt watermark (%d ≤ %d)", counterpartyWatermark, watermark)
+	}
+
+	if err := k.connectionKeeper.VerifyCommitmentWatermark(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, counterpartyWatermark,
+	); err != nil {
+		return 0, errorsmod.Wrap(err, "couldn't verify counterparty commitment watermark")
+	}
+
+	end := counterpartyWatermark
+	if end-watermark > limit {
+		end = watermark + limit
+	}
+
+	for ; watermark < end; watermark++ {
+		k.deletePacketReceipt(ctx, portID, channelID, watermark)
+		k.deletePacketAcknowledgement(ctx, portID, channelID, watermark)
+	}
+
+	k.SetReceiptWatermark(ctx, portID, channelID, watermark)
+
+	logging.WithChannel(k.Logger(ctx), portID, channelID).Info("receipt watermark advanced", "watermark", watermark)
+
+	emitReceiptWatermarkAdvancedEvent(ctx, portID, channelID, channel, watermark)
+
+	return watermark, nil
+}
@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestCommitmentWatermark() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	portID, channelID := path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+
+	// Send 3 packets from B -> A which are acknowledged before the channel opts in to commitment watermarks.
+	suite.sendMockPackets(path, 3, true)
+
+	_, found := channelKeeper.GetCommitmentWatermark(suite.chainB.GetContext(), portID, channelID)
+	suite.Require().False(found)
+
+	// The watermark is initialized to the packet which is in flight when opting in.
+	sequence, err := path.EndpointB.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	watermark, err := channelKeeper.EnableCommitmentWatermark(suite.chainB.GetContext(), portID, channelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sequence, watermark)
+
+	suite.Require().NoError(path.RelayPacket(types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)))
+
+	// Send 2 packets which time out.
+	suite.sendMockPackets(path, 2, false)
+
+	watermark, found = channelKeeper.GetCommitmentWatermark(suite.chainB.GetContext(), portID, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(7), watermark)
+
+	// Send 2 packets and acknowledge them out of order.
+	var packets []types.Packet
+	for i := 0; i < 2; i++ {
+		sequence, err := path.EndpointB.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp))
+	}
+
+	suite.Require().NoError(path.RelayPacket(packets[1]))
+
+	// the watermark cannot advance past the packet which is still in flight
+	watermark, _ = channelKeeper.GetCommitmentWatermark(suite.chainB.GetContext(), portID, channelID)
+	suite.Require().Equal(uint64(7), watermark)
+
+	suite.Require().NoError(path.RelayPacket(packets[0]))
+
+	watermark, _ = channelKeeper.GetCommitmentWatermark(suite.chainB.GetContext(), portID, channelID)
+	suite.Require().Equal(uint64(9), watermark)
+}
+
+func (suite *KeeperTestSuite) TestEnableCommitmentWatermark() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expWatermark uint64
+		expError     error
+	}{
+		{
+			"success: no packets in flight",
+			func() {},
+			4,
+			nil,
+		},
+		{
+			"success: packets in flight",
+			func() {
+				for i := 0; i < 2; i++ {
+					_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+					suite.Require().NoError(err)
+				}
+			},
+			4,
+			nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			0,
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is ORDERED",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.Ordering = types.ORDERED })
+			},
+			0,
+			types.ErrInvalidChannelOrdering,
+		},
+		{
+			"failure: commitment watermark already enabled",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetCommitmentWatermark(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+			},
+			0,
+			types.ErrInvalidWatermark,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// Send 3 packets from A -> B which are acknowledged.
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+			}
+
+			tc.malleate()
+
+			watermark, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.EnableCommitmentWatermark(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expWatermark, watermark)
+
+				stored, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetCommitmentWatermark(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(watermark, stored)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Zero(watermark)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAdvanceReceiptWatermark() {
+	var (
+		path                  *ibctesting.Path
+		counterpartyWatermark uint64
+		limit                 uint64
+		proof                 []byte
+		proofHeight           clienttypes.Height
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expWatermark uint64
+		expError     error
+	}{
+		{
+			"success",
+			func() {},
+			11,
+			nil,
+		},
+		{
+			"success: watermark advanced up to limit",
+			func() {
+				limit = 6
+			},
+			7,
+			nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			0,
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is not OPEN",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+			},
+			0,
+			types.ErrInvalidChannelState,
+		},
+		{
+			"failure: channel is ORDERED",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.Ordering = types.ORDERED })
+			},
+			0,
+			types.ErrInvalidChannelOrdering,
+		},
+		{
+			"failure: counterparty watermark does not advance the receipt watermark",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetReceiptWatermark(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterpartyWatermark)
+			},
+			0,
+			types.ErrInvalidWatermark,
+		},
+		{
+			"failure: counterparty watermark does not match proof",
+			func() {
+				counterpartyWatermark++
+			},
+			0,
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			_, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.EnableCommitmentWatermark(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().NoError(err)
+
+			// Send 10 packets from B -> A, creating 10 packet receipts and 10 packet acks on A.
+			suite.sendMockPackets(path, 10, true)
+
+			var found bool
+			counterpartyWatermark, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetCommitmentWatermark(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(11), counterpartyWatermark)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proof, proofHeight = path.EndpointB.QueryProof(host.CommitmentWatermarkKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			limit = 100
+
+			tc.malleate()
+
+			watermark, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.AdvanceReceiptWatermark(
+				suite.chainA.GetContext(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				counterpartyWatermark,
+				limit,
+				proof,
+				proofHeight,
+			)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expWatermark, watermark)
+
+				stored, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetReceiptWatermark(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(watermark, stored)
+
+				// receipts and acknowledgements below the watermark are pruned
+				for sequence := uint64(1); sequence <= 10; sequence++ {
+					_, hasReceipt := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+					hasAck := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+
+					suite.Require().Equal(sequence >= watermark, hasReceipt)
+					suite.Require().Equal(sequence >= watermark, hasAck)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Zero(watermark)
+			}
+		})
+	}
+}
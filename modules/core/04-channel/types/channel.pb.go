@@ -108,6 +108,46 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3a07336710636a0, []int{1}
 }
 
+// ClosureReason defines why a channel transitioned to the CLOSED state
+type ClosureReason int32
+
+const (
+	// zero-value for channels which have not been closed or were closed before closure reasons were recorded
+	CLOSURE_UNSPECIFIED ClosureReason = 0
+	// the channel was closed by the application on this chain with ChanCloseInit
+	CLOSURE_CLOSE_INIT ClosureReason = 1
+	// the channel was closed with ChanCloseConfirm after the counterparty channel end was closed
+	CLOSURE_COUNTERPARTY_CLOSED ClosureReason = 2
+	// the ORDERED channel was closed because a packet sent on it timed out
+	CLOSURE_PACKET_TIMEOUT ClosureReason = 3
+	// the channel was closed with MsgPruneStaleInitChannel after remaining in the INIT state for too long
+	CLOSURE_STALE_INIT ClosureReason = 4
+)
+
+var ClosureReason_name = map[int32]string{
+	0: "CLOSURE_REASON_UNSPECIFIED",
+	1: "CLOSURE_REASON_CLOSE_INIT",
+	2: "CLOSURE_REASON_COUNTERPARTY_CLOSED",
+	3: "CLOSURE_REASON_PACKET_TIMEOUT",
+	4: "CLOSURE_REASON_STALE_INIT",
+}
+
+var ClosureReason_value = map[string]int32{
+	"CLOSURE_REASON_UNSPECIFIED":         0,
+	"CLOSURE_REASON_CLOSE_INIT":          1,
+	"CLOSURE_REASON_COUNTERPARTY_CLOSED": 2,
+	"CLOSURE_REASON_PACKET_TIMEOUT":      3,
+	"CLOSURE_REASON_STALE_INIT":          4,
+}
+
+func (x ClosureReason) String() string {
+	return proto.EnumName(ClosureReason_name, int32(x))
+}
+
+func (ClosureReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{2}
+}
+
 // Channel defines pipeline for exactly-once packet delivery between specific
 // modules on separate blockchains, which has at least one end capable of
 // sending packets and one end capable of receiving packets.
@@ -561,6 +601,13 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// if true, channel handshakes are restricted to a single opening attempt per counterparty channel end.
+	StrictHandshake bool `protobuf:"varint,2,opt,name=strict_handshake,json=strictHandshake,proto3" json:"strict_handshake,omitempty"`
+	// the age, in nanoseconds, after which channels remaining in the INIT state may be closed by anyone.
+	// A zero value disables the pruning of stale INIT channels.
+	StaleInitChannelAge uint64 `protobuf:"varint,3,opt,name=stale_init_channel_age,json=staleInitChannelAge,proto3" json:"stale_init_channel_age,omitempty"`
+	// the ports whose packet events only contain the hash of the packet data instead of the packet data.
+	HashedPacketDataPorts []string `protobuf:"bytes,4,rep,name=hashed_packet_data_ports,json=hashedPacketDataPorts,proto3" json:"hashed_packet_data_ports,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -603,9 +650,31 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
+func (m *Params) GetStrictHandshake() bool {
+	if m != nil {
+		return m.StrictHandshake
+	}
+	return false
+}
+
+func (m *Params) GetStaleInitChannelAge() uint64 {
+	if m != nil {
+		return m.StaleInitChannelAge
+	}
+	return 0
+}
+
+func (m *Params) GetHashedPacketDataPorts() []string {
+	if m != nil {
+		return m.HashedPacketDataPorts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
+	proto.RegisterEnum("ibc.core.channel.v1.ClosureReason", ClosureReason_name, ClosureReason_value)
 	proto.RegisterType((*Channel)(nil), "ibc.core.channel.v1.Channel")
 	proto.RegisterType((*IdentifiedChannel)(nil), "ibc.core.channel.v1.IdentifiedChannel")
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
@@ -620,66 +689,79 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0xd5, 0xb6, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x4f,
-	0x21, 0x2d, 0x56, 0x26, 0xfd, 0xcd, 0x95, 0x09, 0x3e, 0x3a, 0x85, 0xdc, 0x66, 0x47, 0x49, 0x56,
-	0xf8, 0x06, 0x28, 0x0f, 0xa2, 0x0b, 0x1f, 0xd8, 0xb3, 0x10, 0xbd, 0x80, 0xf8, 0x2f, 0x36, 0x14,
-	0x2b, 0x14, 0x52, 0xa7, 0x77, 0xbe, 0x22, 0xa2, 0x30, 0x21, 0x96, 0x17, 0xa1, 0x02, 0x3d, 0xfb,
-	0x29, 0x09, 0xa9, 0x9e, 0x78, 0xd1, 0x4a, 0xbd, 0xfe, 0x45, 0xdf, 0x18, 0x0e, 0xda, 0x66, 0xdb,
-	0xec, 0x9b, 0x17, 0x2d, 0xf3, 0xa5, 0xd1, 0x18, 0x0e, 0xda, 0xbd, 0xae, 0x51, 0x37, 0x9f, 0x99,
-	0x46, 0x43, 0x49, 0x68, 0x47, 0xab, 0xb5, 0x7e, 0xb8, 0x43, 0x40, 0x2a, 0x00, 0x8f, 0x8b, 0x40,
-	0x45, 0xd2, 0xb2, 0xab, 0xb5, 0x2e, 0x47, 0x67, 0x54, 0x84, 0x43, 0xee, 0xe9, 0x5b, 0xdf, 0x75,
-	0xba, 0x46, 0x5b, 0x49, 0x6a, 0xfb, 0xab, 0xb5, 0x9e, 0x11, 0xe6, 0x26, 0x92, 0x39, 0xf7, 0x78,
-	0x24, 0xf3, 0x9c, 0xc2, 0x01, 0xf7, 0xd4, 0x5b, 0x9d, 0x9e, 0xd1, 0x50, 0x64, 0x0d, 0x56, 0x6b,
-	0x3d, 0xcd, 0x2d, 0xa4, 0x43, 0x9e, 0x7b, 0x9f, 0xb5, 0x06, 0xbd, 0xa6, 0xd9, 0x7e, 0xae, 0xa4,
-	0xb4, 0x83, 0xd5, 0x5a, 0xcf, 0xc6, 0x36, 0x3a, 0x83, 0xe3, 0x2d, 0x46, 0xbd, 0xf3, 0x6d, 0xb7,
-	0x65, 0xf4, 0x0d, 0x25, 0xcd, 0xeb, 0xdf, 0x01, 0x35, 0xf9, 0xed, 0xaf, 0xc5, 0xc4, 0xd9, 0x6b,
-	0x48, 0xb1, 0xa7, 0x1a, 0x7d, 0x02, 0x27, 0x1d, 0xab, 0x61, 0x58, 0xc3, 0x76, 0xa7, 0x6d, 0xdc,
-	0xea, 0x9e, 0x15, 0x18, 0xe1, 0xa8, 0x0c, 0x05, 0xce, 0x1a, 0xb4, 0xd9, 0xaf, 0xd1, 0x50, 0x24,
-	0xed, 0x70, 0xb5, 0xd6, 0x73, 0x37, 0x40, 0xd4, 0x3e, 0xe7, 0xc4, 0x0c, 0xd1, 0xbe, 0x30, 0xb9,
-	0xf0, 0x65, 0xef, 0xdd, 0x55, 0x51, 0x7a, 0x7f, 0x55, 0x94, 0xfe, 0xb8, 0x2a, 0x4a, 0x3f, 0x5f,
-	0x17, 0x13, 0xef, 0xaf, 0x8b, 0x89, 0x0f, 0xd7, 0xc5, 0xc4, 0xcb, 0xaf, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0xd1, 0xb4, 0xca, 0x94, 0x08, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4b, 0x8f, 0xda, 0x56,
+	0x14, 0xc6, 0x0c, 0xc3, 0xc0, 0x99, 0x07, 0xce, 0x9d, 0x86, 0xb8, 0x6e, 0x02, 0x0e, 0x6a, 0xd5,
+	0x49, 0xaa, 0x40, 0x1e, 0x6d, 0x93, 0x56, 0xea, 0x82, 0x80, 0x13, 0xac, 0x10, 0x40, 0xc6, 0x2c,
+	0x92, 0x8d, 0xe5, 0xb1, 0x6f, 0xc1, 0x0a, 0xf8, 0x52, 0xfb, 0x32, 0x51, 0xd4, 0x75, 0xa5, 0x88,
+	0x55, 0xff, 0x00, 0x52, 0xa5, 0xfe, 0x85, 0xfe, 0x84, 0x2e, 0xb2, 0xcc, 0x32, 0xab, 0xaa, 0x4a,
+	0xf6, 0x5d, 0x76, 0x5d, 0xf9, 0xde, 0x6b, 0x5e, 0x1d, 0x45, 0x55, 0xa5, 0xee, 0xba, 0xe2, 0x9e,
+	0xef, 0xfb, 0xce, 0xe3, 0x9e, 0x73, 0x6c, 0x0c, 0x57, 0xfd, 0x53, 0xb7, 0xe6, 0x92, 0x10, 0xd7,
+	0xdc, 0x91, 0x13, 0x04, 0x78, 0x5c, 0x3b, 0xbb, 0x95, 0x1c, 0xab, 0xd3, 0x90, 0x50, 0x82, 0x8e,
+	0xfd, 0x53, 0xb7, 0x1a, 0x4b, 0xaa, 0x09, 0x7e, 0x76, 0x4b, 0xfd, 0x60, 0x48, 0x86, 0x84, 0xf1,
+	0xb5, 0xf8, 0xc4, 0xa5, 0x6a, 0x79, 0x15, 0x6d, 0xec, 0xe3, 0x80, 0xb2, 0x60, 0xec, 0xc4, 0x05,
+	0x95, 0x5f, 0xd2, 0xb0, 0xd7, 0xe0, 0x51, 0xd0, 0x4d, 0xd8, 0x8d, 0xa8, 0x43, 0xb1, 0x22, 0x69,
+	0xd2, 0xc9, 0xd1, 0x6d, 0xb5, 0x7a, 0x4e, 0x9e, 0x6a, 0x3f, 0x56, 0x98, 0x5c, 0x88, 0xbe, 0x84,
+	0x1c, 0x09, 0x3d, 0x1c, 0xfa, 0xc1, 0x50, 0x49, 0xbf, 0xc7, 0xa9, 0x1b, 0x8b, 0xcc, 0xa5, 0x16,
+	0x3d, 0x82, 0x03, 0x97, 0xcc, 0x02, 0x8a, 0xc3, 0xa9, 0x13, 0xd2, 0x17, 0xca, 0x8e, 0x26, 0x9d,
+	0xec, 0xdf, 0xbe, 0x7a, 0xae, 0x6f, 0x63, 0x4d, 0x78, 0x3f, 0xf3, 0xea, 0xb7, 0x72, 0xca, 0xdc,
+	0x70, 0x46, 0x9f, 0x42, 0xc1, 0x25, 0x41, 0x80, 0x5d, 0xea, 0x93, 0xc0, 0x1e, 0x91, 0x69, 0xa4,
+	0x64, 0xb4, 0x9d, 0x93, 0xbc, 0x79, 0xb4, 0x82, 0x5b, 0x64, 0x1a, 0x21, 0x05, 0xf6, 0xce, 0x70,
+	0x18, 0xf9, 0x24, 0x50, 0x76, 0x35, 0xe9, 0x24, 0x6f, 0x26, 0x26, 0xba, 0x06, 0xf2, 0x6c, 0x3a,
+	0x0c, 0x1d, 0x0f, 0xdb, 0x11, 0xfe, 0x6e, 0x86, 0x03, 0x17, 0x2b, 0x59, 0x4d, 0x3a, 0xc9, 0x98,
+	0x05, 0x81, 0xf7, 0x05, 0xfc, 0x75, 0xe6, 0xe5, 0x4f, 0xe5, 0x54, 0xe5, 0xcf, 0x34, 0x5c, 0x30,
+	0x3c, 0x1c, 0x50, 0xff, 0x5b, 0x1f, 0x7b, 0xff, 0x37, 0xf0, 0x12, 0xec, 0x4d, 0x49, 0x48, 0x6d,
+	0xdf, 0x63, 0x7d, 0xcb, 0x9b, 0xd9, 0xd8, 0x34, 0x3c, 0x74, 0x05, 0x40, 0x94, 0x12, 0x73, 0x7b,
+	0x8c, 0xcb, 0x0b, 0xc4, 0xf0, 0xce, 0x6d, 0x7c, 0xee, 0x7d, 0x8d, 0x6f, 0xc3, 0xc1, 0xfa, 0x7d,
+	0xd6, 0x13, 0x4b, 0xef, 0x49, 0x9c, 0xde, 0x4a, 0x2c, 0xa2, 0xbd, 0x49, 0x43, 0xb6, 0xe7, 0xb8,
+	0xcf, 0x30, 0x45, 0x2a, 0xe4, 0x96, 0x15, 0x48, 0xac, 0x82, 0xa5, 0x8d, 0xca, 0xb0, 0x1f, 0x91,
+	0x59, 0xe8, 0x62, 0x3b, 0x0e, 0x2e, 0x82, 0x01, 0x87, 0x7a, 0x24, 0xa4, 0xe8, 0x13, 0x38, 0x12,
+	0x02, 0x91, 0x81, 0x0d, 0x24, 0x6f, 0x1e, 0x72, 0x34, 0xd9, 0x8f, 0x6b, 0x20, 0x7b, 0x38, 0xa2,
+	0x7e, 0xe0, 0xb0, 0x4e, 0xb3, 0x60, 0x19, 0x26, 0x2c, 0xac, 0xe1, 0x2c, 0x62, 0x0d, 0x8e, 0xd7,
+	0xa5, 0x49, 0x58, 0xde, 0x76, 0xb4, 0x46, 0x25, 0xb1, 0x11, 0x64, 0x3c, 0x87, 0x3a, 0xac, 0xfd,
+	0x07, 0x26, 0x3b, 0xa3, 0x87, 0x70, 0x44, 0xfd, 0x09, 0x26, 0x33, 0x6a, 0x8f, 0xb0, 0x3f, 0x1c,
+	0x51, 0x36, 0x80, 0xfd, 0x8d, 0x1d, 0xe3, 0x2f, 0x83, 0xb3, 0x5b, 0xd5, 0x16, 0x53, 0x88, 0x05,
+	0x39, 0x14, 0x7e, 0x1c, 0x44, 0x9f, 0xc1, 0x85, 0x24, 0x50, 0xfc, 0x1b, 0x51, 0x67, 0x32, 0x15,
+	0x73, 0x92, 0x05, 0x61, 0x25, 0xb8, 0x68, 0xed, 0xf7, 0xb0, 0xcf, 0x3b, 0xcb, 0xf6, 0xfd, 0xdf,
+	0xce, 0x69, 0x63, 0x2c, 0x3b, 0x5b, 0x63, 0x49, 0xae, 0x9c, 0x59, 0x5d, 0x59, 0x24, 0xf7, 0x20,
+	0xc7, 0x93, 0x1b, 0xde, 0x7f, 0x91, 0x59, 0x64, 0xe9, 0x42, 0xa1, 0xee, 0x3e, 0x0b, 0xc8, 0xf3,
+	0x31, 0xf6, 0x86, 0x78, 0x82, 0x03, 0x8a, 0x14, 0xc8, 0x86, 0x38, 0x9a, 0x8d, 0xa9, 0x72, 0x31,
+	0x2e, 0xaa, 0x95, 0x32, 0x85, 0x8d, 0x8a, 0xb0, 0x8b, 0xc3, 0x90, 0x84, 0x4a, 0x31, 0x4e, 0xd4,
+	0x4a, 0x99, 0xdc, 0xbc, 0x0f, 0x90, 0x0b, 0x71, 0x34, 0x25, 0x41, 0x84, 0x2b, 0x0e, 0xec, 0x59,
+	0xbc, 0x9b, 0xe8, 0x1e, 0x64, 0xc5, 0xc8, 0xa4, 0x7f, 0x38, 0x32, 0xa1, 0x47, 0x97, 0x21, 0xbf,
+	0x9a, 0x51, 0x9a, 0x15, 0xbe, 0x02, 0x2a, 0x7f, 0x48, 0xf1, 0xc6, 0x87, 0xce, 0x24, 0x42, 0x8f,
+	0x20, 0x79, 0xc6, 0x6c, 0x31, 0x43, 0x91, 0xeb, 0xf2, 0xb9, 0xaf, 0x11, 0x51, 0x99, 0xc8, 0x76,
+	0x24, 0x5c, 0x93, 0x7a, 0xaf, 0x81, 0x1c, 0xd1, 0xd0, 0x77, 0xa9, 0x3d, 0x72, 0x02, 0x2f, 0x1a,
+	0x39, 0xcf, 0x30, 0x4b, 0x9e, 0x33, 0x0b, 0x1c, 0x6f, 0x25, 0x30, 0xba, 0x03, 0xc5, 0x88, 0x3a,
+	0x63, 0x6c, 0xfb, 0x81, 0x4f, 0x93, 0xcd, 0xb6, 0x9d, 0x61, 0xd2, 0xe6, 0x63, 0xc6, 0x1a, 0x81,
+	0x4f, 0xc5, 0x6e, 0xd7, 0x87, 0x18, 0xdd, 0x05, 0x65, 0xe4, 0x44, 0x23, 0xec, 0xd9, 0x53, 0x36,
+	0x58, 0x3b, 0x9e, 0x36, 0x7b, 0x82, 0x92, 0x97, 0xd5, 0x45, 0xce, 0xf3, 0xb9, 0x37, 0x1d, 0xea,
+	0xc4, 0xcf, 0x51, 0x74, 0xfd, 0x87, 0x34, 0xec, 0xf6, 0xc5, 0xbb, 0xb6, 0xdc, 0xb7, 0xea, 0x96,
+	0x6e, 0x0f, 0x3a, 0x46, 0xc7, 0xb0, 0x8c, 0x7a, 0xdb, 0x78, 0xaa, 0x37, 0xed, 0x41, 0xa7, 0xdf,
+	0xd3, 0x1b, 0xc6, 0x03, 0x43, 0x6f, 0xca, 0x29, 0xf5, 0xc2, 0x7c, 0xa1, 0x1d, 0x6e, 0x08, 0x90,
+	0x02, 0xc0, 0xfd, 0x62, 0x50, 0x96, 0xd4, 0xdc, 0x7c, 0xa1, 0x65, 0xe2, 0x33, 0x2a, 0xc1, 0x21,
+	0x67, 0x2c, 0xf3, 0x49, 0xb7, 0xa7, 0x77, 0xe4, 0xb4, 0xba, 0x3f, 0x5f, 0x68, 0x7b, 0xc2, 0x5c,
+	0x79, 0x32, 0x72, 0x87, 0x7b, 0x32, 0xe6, 0x32, 0x1c, 0x70, 0xa6, 0xd1, 0xee, 0xf6, 0xf5, 0xa6,
+	0x9c, 0x51, 0x61, 0xbe, 0xd0, 0xb2, 0xdc, 0x42, 0x1a, 0x1c, 0x71, 0xf6, 0x41, 0x7b, 0xd0, 0x6f,
+	0x19, 0x9d, 0x87, 0xf2, 0xae, 0x7a, 0x30, 0x5f, 0x68, 0xb9, 0xc4, 0x46, 0xd7, 0xe1, 0x78, 0x4d,
+	0xd1, 0xe8, 0x3e, 0xee, 0xb5, 0x75, 0x4b, 0x97, 0xb3, 0xbc, 0xfe, 0x0d, 0x50, 0xcd, 0xbc, 0xfc,
+	0xb9, 0x94, 0xba, 0xfe, 0x1c, 0x76, 0xd9, 0x9f, 0x08, 0xfa, 0x18, 0x8a, 0x5d, 0xb3, 0xa9, 0x9b,
+	0x76, 0xa7, 0xdb, 0xd1, 0xb7, 0x6e, 0xcf, 0x0a, 0x8c, 0x71, 0x54, 0x81, 0x02, 0x57, 0x0d, 0x3a,
+	0xec, 0x57, 0x6f, 0xca, 0x92, 0x7a, 0x38, 0x5f, 0x68, 0xf9, 0x25, 0x10, 0x5f, 0x9f, 0x6b, 0x12,
+	0x85, 0xb8, 0xbe, 0x30, 0x45, 0xe2, 0x5f, 0xd3, 0x70, 0xd8, 0x18, 0x93, 0x68, 0x16, 0x62, 0x13,
+	0x3b, 0x11, 0x09, 0xd0, 0x5d, 0x50, 0xe3, 0x8b, 0x0e, 0x4c, 0xdd, 0x36, 0xf5, 0x7a, 0xbf, 0xdb,
+	0xd9, 0xaa, 0xe2, 0xd2, 0x7c, 0xa1, 0x1d, 0x27, 0x8a, 0x35, 0x0a, 0x7d, 0x01, 0x1f, 0x6e, 0x39,
+	0xc6, 0xe6, 0x72, 0x30, 0xc5, 0xf9, 0x42, 0x43, 0x89, 0x60, 0xc5, 0xa0, 0x87, 0x50, 0xd9, 0x76,
+	0xeb, 0x0e, 0x3a, 0x96, 0x6e, 0xf6, 0xea, 0xa6, 0xf5, 0x24, 0x19, 0x41, 0x5a, 0x2d, 0xcf, 0x17,
+	0xda, 0x47, 0x4b, 0xff, 0xbf, 0x4b, 0xd0, 0x37, 0x70, 0x65, 0x2b, 0x50, 0xaf, 0xde, 0x78, 0xa4,
+	0x5b, 0xb6, 0x65, 0x3c, 0xd6, 0xbb, 0x03, 0x4b, 0xde, 0x51, 0xd5, 0xf9, 0x42, 0x2b, 0x26, 0xa2,
+	0x4d, 0xf6, 0x9c, 0xf2, 0xfb, 0x56, 0xbd, 0x2d, 0xca, 0xcf, 0x6c, 0x96, 0xbf, 0x62, 0x78, 0x1b,
+	0xef, 0xf7, 0x5f, 0xbd, 0x2d, 0x49, 0xaf, 0xdf, 0x96, 0xa4, 0xdf, 0xdf, 0x96, 0xa4, 0x1f, 0xdf,
+	0x95, 0x52, 0xaf, 0xdf, 0x95, 0x52, 0x6f, 0xde, 0x95, 0x52, 0x4f, 0xbf, 0x1a, 0xfa, 0x74, 0x34,
+	0x3b, 0xad, 0xba, 0x64, 0x52, 0x73, 0x49, 0x34, 0x21, 0x51, 0xcd, 0x3f, 0x75, 0x6f, 0x0c, 0x49,
+	0xed, 0xec, 0x5e, 0x6d, 0x42, 0xbc, 0xd9, 0x18, 0x47, 0xfc, 0x1b, 0xf0, 0xe6, 0xe7, 0x37, 0x92,
+	0x8f, 0x4a, 0xfa, 0x62, 0x8a, 0xa3, 0xd3, 0x2c, 0xfb, 0x08, 0xbc, 0xf3, 0xd7, 0x00, 0x26, 0x88,
+	0x9b, 0x17, 0x75, 0x0a, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -1154,6 +1236,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HashedPacketDataPorts) > 0 {
+		for iNdEx := len(m.HashedPacketDataPorts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HashedPacketDataPorts[iNdEx])
+			copy(dAtA[i:], m.HashedPacketDataPorts[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.HashedPacketDataPorts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StaleInitChannelAge != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.StaleInitChannelAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StrictHandshake {
+		i--
+		if m.StrictHandshake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1400,6 +1506,18 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.UpgradeTimeout.Size()
 	n += 1 + l + sovChannel(uint64(l))
+	if m.StrictHandshake {
+		n += 2
+	}
+	if m.StaleInitChannelAge != 0 {
+		n += 1 + sovChannel(uint64(m.StaleInitChannelAge))
+	}
+	if len(m.HashedPacketDataPorts) > 0 {
+		for _, s := range m.HashedPacketDataPorts {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2857,6 +2975,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictHandshake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictHandshake = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleInitChannelAge", wireType)
+			}
+			m.StaleInitChannelAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleInitChannelAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashedPacketDataPorts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashedPacketDataPorts = append(m.HashedPacketDataPorts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
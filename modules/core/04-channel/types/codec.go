@@ -37,6 +37,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelUpgradeTimeout{},
 		&MsgChannelUpgradeCancel{},
 		&MsgPruneAcknowledgements{},
+		&MsgPruneStaleInitChannel{},
+		&MsgAdvanceReceiptWatermark{},
+		&MsgEnableCommitmentWatermark{},
+		&MsgReleasePort{},
+		&MsgRebindPort{},
 		&MsgUpdateParams{},
 	)
 
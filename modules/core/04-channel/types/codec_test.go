@@ -113,6 +113,21 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgPruneAcknowledgements{}),
 			true,
 		},
+		{
+			"success: MsgPruneStaleInitChannel",
+			sdk.MsgTypeURL(&types.MsgPruneStaleInitChannel{}),
+			true,
+		},
+		{
+			"success: MsgAdvanceReceiptWatermark",
+			sdk.MsgTypeURL(&types.MsgAdvanceReceiptWatermark{}),
+			true,
+		},
+		{
+			"success: MsgEnableCommitmentWatermark",
+			sdk.MsgTypeURL(&types.MsgEnableCommitmentWatermark{}),
+			true,
+		},
 		{
 			"success: MsgUpdateParams",
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
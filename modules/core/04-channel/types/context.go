@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// timeoutOnCloseKey is the context key used to mark packet timeouts which are
+// processed because the counterparty channel end was closed.
+type timeoutOnCloseKey struct{}
+
+// WithTimeoutOnClose returns a copy of the context marking that the packet timeout
+// being processed was triggered by the closure of the counterparty channel end.
+func WithTimeoutOnClose(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(timeoutOnCloseKey{}, true)
+}
+
+// IsTimeoutOnClose returns true if the packet timeout being processed in the provided
+// context was triggered by the closure of the counterparty channel end.
+func IsTimeoutOnClose(ctx sdk.Context) bool {
+	timeoutOnClose, ok := ctx.Value(timeoutOnCloseKey{}).(bool)
+	return ok && timeoutOnClose
+}
+
+// syncAcknowledgementKey is the context key used to mark acknowledgements which are
+// written synchronously by core IBC as the result of the OnRecvPacket callback.
+type syncAcknowledgementKey struct{}
+
+// WithSyncAcknowledgement returns a copy of the context marking that the acknowledgement
+// being written was returned synchronously by the OnRecvPacket application callback.
+func WithSyncAcknowledgement(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(syncAcknowledgementKey{}, true)
+}
+
+// IsSyncAcknowledgement returns true if the acknowledgement being written in the provided
+// context was returned synchronously by the OnRecvPacket application callback.
+func IsSyncAcknowledgement(ctx sdk.Context) bool {
+	syncAck, ok := ctx.Value(syncAcknowledgementKey{}).(bool)
+	return ok && syncAck
+}
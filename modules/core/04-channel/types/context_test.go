@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestIsTimeoutOnClose() {
+	ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+	suite.Require().False(types.IsTimeoutOnClose(ctx))
+
+	timeoutOnCloseCtx := types.WithTimeoutOnClose(ctx)
+	suite.Require().True(types.IsTimeoutOnClose(timeoutOnCloseCtx))
+	suite.Require().Equal(ctx.EventManager(), timeoutOnCloseCtx.EventManager())
+
+	// the original context is not modified
+	suite.Require().False(types.IsTimeoutOnClose(ctx))
+}
+
+func (suite *TypesTestSuite) TestIsSyncAcknowledgement() {
+	ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+	suite.Require().False(types.IsSyncAcknowledgement(ctx))
+
+	syncAckCtx := types.WithSyncAcknowledgement(ctx)
+	suite.Require().True(types.IsSyncAcknowledgement(syncAckCtx))
+	suite.Require().False(types.IsTimeoutOnClose(syncAckCtx))
+
+	// the original context is not modified
+	suite.Require().False(types.IsSyncAcknowledgement(ctx))
+}
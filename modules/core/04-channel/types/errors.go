@@ -60,4 +60,9 @@ var (
 	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 40, "timeout elapsed")
 	ErrPruningSequenceStartNotFound    = errorsmod.Register(SubModuleName, 41, "pruning sequence start not found")
 	ErrRecvStartSequenceNotFound       = errorsmod.Register(SubModuleName, 42, "recv start sequence not found")
+	ErrCrossingHelloNotPermitted       = errorsmod.Register(SubModuleName, 43, "crossing hello not permitted")
+	ErrChannelNotPrunable              = errorsmod.Register(SubModuleName, 44, "channel cannot be pruned")
+	ErrAppCallbackPanic                = errorsmod.Register(SubModuleName, 45, "application callback panicked")
+	ErrPacketExpired                   = errorsmod.Register(SubModuleName, 46, "packet sequence is below the receipt watermark")
+	ErrInvalidWatermark                = errorsmod.Register(SubModuleName, 47, "invalid watermark")
 )
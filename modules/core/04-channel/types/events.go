@@ -15,6 +15,8 @@ const (
 	AttributeKeyVersion        = "version"
 	AttributeKeyConnectionHops = "connection_hops"
 	AttributeKeyOrdering       = "ordering"
+	AttributeKeyClosureReason  = "closure_reason"
+	AttributeKeyWatermark      = "receipt_watermark"
 
 	// upgrade specific keys
 	AttributeKeyUpgradeTimeoutHeight    = "timeout_height"
@@ -32,6 +34,7 @@ const (
 	EventTypeTimeoutPacket     = "timeout_packet"
 
 	AttributeKeyDataHex          = "packet_data_hex"
+	AttributeKeyDataHash         = "packet_data_hash"
 	AttributeKeyAckHex           = "packet_ack_hex"
 	AttributeKeyTimeoutHeight    = "packet_timeout_height"
 	AttributeKeyTimeoutTimestamp = "packet_timeout_timestamp"
@@ -53,6 +56,7 @@ var (
 	EventTypeChannelCloseInit      = "channel_close_init"
 	EventTypeChannelCloseConfirm   = "channel_close_confirm"
 	EventTypeChannelClosed         = "channel_close"
+	EventTypeChannelPruned         = "channel_pruned"
 	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
 	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
 	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
@@ -62,6 +66,7 @@ var (
 	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
 	EventTypeChannelUpgradeError   = "channel_upgrade_error"
 	EventTypeChannelFlushComplete  = "channel_flush_complete"
+	EventTypeWatermarkAdvanced     = "receipt_watermark_advanced"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
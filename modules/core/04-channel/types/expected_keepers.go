@@ -68,6 +68,15 @@ type ConnectionKeeper interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyCommitmentWatermark(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		commitmentWatermark uint64,
+	) error
 	VerifyChannelUpgrade(
 		ctx sdk.Context,
 		connection connectiontypes.ConnectionEnd,
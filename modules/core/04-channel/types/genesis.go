@@ -62,15 +62,17 @@ func NewGenesisState(
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
-		Params:              DefaultParams(),
+		Channels:             []IdentifiedChannel{},
+		Acknowledgements:     []PacketState{},
+		Receipts:             []PacketState{},
+		Commitments:          []PacketState{},
+		SendSequences:        []PacketSequence{},
+		RecvSequences:        []PacketSequence{},
+		AckSequences:         []PacketSequence{},
+		NextChannelSequence:  0,
+		Params:               DefaultParams(),
+		CommitmentWatermarks: []PacketSequence{},
+		ReceiptWatermarks:    []PacketSequence{},
 	}
 }
 
@@ -142,6 +144,18 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, cw := range gs.CommitmentWatermarks {
+		if err := cw.Validate(); err != nil {
+			return fmt.Errorf("invalid commitment watermark %v index %d: %w", cw, i, err)
+		}
+	}
+
+	for i, rw := range gs.ReceiptWatermarks {
+		if err := rw.Validate(); err != nil {
+			return fmt.Errorf("invalid receipt watermark %v index %d: %w", rw, i, err)
+		}
+	}
+
 	return nil
 }
 
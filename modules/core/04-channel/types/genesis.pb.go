@@ -35,6 +35,10 @@ type GenesisState struct {
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
 	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the commitment watermarks of the channels, below which all packet commitments have been deleted
+	CommitmentWatermarks []PacketSequence `protobuf:"bytes,10,rep,name=commitment_watermarks,json=commitmentWatermarks,proto3" json:"commitment_watermarks"`
+	// the receipt watermarks of the channels, below which packets can no longer be received
+	ReceiptWatermarks []PacketSequence `protobuf:"bytes,11,rep,name=receipt_watermarks,json=receiptWatermarks,proto3" json:"receipt_watermarks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +137,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetCommitmentWatermarks() []PacketSequence {
+	if m != nil {
+		return m.CommitmentWatermarks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiptWatermarks() []PacketSequence {
+	if m != nil {
+		return m.ReceiptWatermarks
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -203,37 +221,39 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xb5, 0xeb, 0x5a, 0x77, 0x9b, 0xc0, 0x03, 0x11, 0x8a, 0xc8, 0xca, 0x90, 0x50,
-	0x2f, 0x8b, 0x59, 0xe1, 0xc0, 0xae, 0xe5, 0x00, 0xbd, 0xa0, 0x29, 0xbb, 0x21, 0xa1, 0x2a, 0xb1,
-	0x3f, 0x32, 0xab, 0x8d, 0x1d, 0x62, 0xb7, 0xc0, 0xbf, 0xe0, 0xb7, 0xf0, 0x2b, 0x76, 0xdc, 0x91,
-	0xd3, 0x84, 0xda, 0x7f, 0xc1, 0x09, 0xc5, 0x71, 0xb2, 0xa2, 0x95, 0x49, 0xbd, 0xc5, 0xdf, 0xf7,
-	0xbe, 0xcf, 0xfb, 0x1e, 0xf2, 0xa1, 0x67, 0x3c, 0xa2, 0x84, 0xca, 0x0c, 0x08, 0xbd, 0x08, 0x85,
-	0x80, 0x29, 0x99, 0x9f, 0x90, 0x18, 0x04, 0x28, 0xae, 0xfc, 0x34, 0x93, 0x5a, 0xe2, 0x03, 0x1e,
-	0x51, 0x3f, 0x97, 0xf8, 0x56, 0xe2, 0xcf, 0x4f, 0xba, 0x0f, 0x62, 0x19, 0x4b, 0xb3, 0x27, 0xf9,
-	0x57, 0x21, 0xed, 0xae, 0xa5, 0x95, 0x2e, 0x23, 0x39, 0xfa, 0xb9, 0x8d, 0x76, 0xdf, 0x15, 0xfc,
-	0x73, 0x1d, 0x6a, 0xc0, 0x9f, 0x50, 0xcb, 0x2a, 0x94, 0xeb, 0xf4, 0xea, 0xfd, 0xce, 0xe0, 0x85,
-	0xbf, 0x26, 0xd1, 0x1f, 0x31, 0x10, 0x9a, 0x7f, 0xe6, 0xc0, 0xde, 0x16, 0xc3, 0xe1, 0xe3, 0xcb,
-	0xeb, 0xc3, 0xda, 0x9f, 0xeb, 0xc3, 0xfb, 0xb7, 0x56, 0x41, 0x85, 0xc4, 0x01, 0xba, 0x17, 0xd2,
-	0x89, 0x90, 0x5f, 0xa7, 0xc0, 0x62, 0x48, 0x40, 0x68, 0xe5, 0x6e, 0x99, 0x98, 0xde, 0xda, 0x98,
-	0xb3, 0x90, 0x4e, 0x40, 0x9b, 0x6a, 0xc3, 0x46, 0x1e, 0x10, 0xdc, 0xf2, 0xe3, 0xf7, 0xa8, 0x43,
-	0x65, 0x92, 0x70, 0x5d, 0xe0, 0xea, 0x1b, 0xe1, 0x56, 0xad, 0x78, 0x88, 0x5a, 0x19, 0x50, 0xe0,
-	0xa9, 0x56, 0x6e, 0x63, 0x23, 0x4c, 0xe5, 0xc3, 0x67, 0x68, 0x5f, 0x81, 0x60, 0x63, 0x05, 0x5f,
-	0x66, 0x20, 0x28, 0x28, 0x77, 0xdb, 0x90, 0x9e, 0xdf, 0x45, 0xb2, 0x5a, 0x0b, 0xdb, 0xcb, 0x01,
-	0xe5, 0xcc, 0x10, 0x33, 0xa0, 0xf3, 0x15, 0x62, 0x73, 0x63, 0x62, 0x0e, 0xb8, 0x21, 0x7e, 0x40,
-	0x7b, 0x21, 0x9d, 0xac, 0x00, 0x77, 0x36, 0x05, 0xee, 0x86, 0x74, 0x72, 0xc3, 0x1b, 0xa0, 0x87,
-	0x02, 0xbe, 0xe9, 0xb1, 0x75, 0x55, 0x60, 0xb7, 0xd5, 0x73, 0xfa, 0x8d, 0xe0, 0x20, 0x5f, 0xda,
-	0x7f, 0xa1, 0x34, 0xe1, 0x53, 0xd4, 0x4c, 0xc3, 0x2c, 0x4c, 0x94, 0xdb, 0xee, 0x39, 0xfd, 0xce,
-	0xe0, 0xc9, 0x7f, 0xc2, 0x73, 0x89, 0x0d, 0xb5, 0x86, 0x23, 0x86, 0xf6, 0xff, 0x2d, 0x85, 0x1f,
-	0xa1, 0x9d, 0x54, 0x66, 0x7a, 0xcc, 0x99, 0xeb, 0xf4, 0x9c, 0x7e, 0x3b, 0x68, 0xe6, 0xcf, 0x11,
-	0xc3, 0x4f, 0x11, 0x2a, 0x4b, 0x71, 0xe6, 0x6e, 0x99, 0x5d, 0xdb, 0x4e, 0x46, 0x0c, 0x77, 0x51,
-	0xab, 0xea, 0x5a, 0x37, 0x5d, 0xab, 0xf7, 0xf0, 0xfc, 0x72, 0xe1, 0x39, 0x57, 0x0b, 0xcf, 0xf9,
-	0xbd, 0xf0, 0x9c, 0x1f, 0x4b, 0xaf, 0x76, 0xb5, 0xf4, 0x6a, 0xbf, 0x96, 0x5e, 0xed, 0xe3, 0x69,
-	0xcc, 0xf5, 0xc5, 0x2c, 0xf2, 0xa9, 0x4c, 0x08, 0x95, 0x2a, 0x91, 0x8a, 0xf0, 0x88, 0x1e, 0xc7,
-	0x92, 0xcc, 0xdf, 0x90, 0x44, 0xb2, 0xd9, 0x14, 0x54, 0x71, 0x77, 0x2f, 0x5f, 0x1f, 0x97, 0xa7,
-	0xa7, 0xbf, 0xa7, 0xa0, 0xa2, 0xa6, 0x39, 0xbb, 0x57, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xaf,
-	0xa9, 0x60, 0xe8, 0xe9, 0x03, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x26, 0xa4, 0xc9, 0xa5, 0xad, 0xe8, 0xb5, 0x15, 0x26, 0x08, 0x37, 0x14, 0x09,
+	0x65, 0xa9, 0x4d, 0x03, 0x03, 0x5d, 0xc3, 0x00, 0x59, 0x50, 0x95, 0x0e, 0x20, 0x24, 0x88, 0xce,
+	0x77, 0x0f, 0xf7, 0xe4, 0xd8, 0x67, 0x7c, 0x97, 0x14, 0xbe, 0x05, 0x1f, 0xab, 0x63, 0x47, 0xa6,
+	0x0a, 0x25, 0x2b, 0x9f, 0x80, 0x09, 0xd9, 0x3e, 0xdb, 0x41, 0x0d, 0x95, 0xbc, 0xf9, 0xde, 0xfb,
+	0xff, 0x7f, 0x7f, 0xfb, 0x9d, 0xf5, 0xd0, 0x13, 0xee, 0x52, 0x87, 0x8a, 0x18, 0x1c, 0x7a, 0x41,
+	0xc2, 0x10, 0xa6, 0xce, 0xfc, 0xc4, 0xf1, 0x20, 0x04, 0xc9, 0xa5, 0x1d, 0xc5, 0x42, 0x09, 0xbc,
+	0xc7, 0x5d, 0x6a, 0x27, 0x12, 0x5b, 0x4b, 0xec, 0xf9, 0x49, 0x77, 0xdf, 0x13, 0x9e, 0x48, 0xfb,
+	0x4e, 0xf2, 0x94, 0x49, 0xbb, 0x6b, 0x69, 0xb9, 0x2b, 0x95, 0x1c, 0xfd, 0x6e, 0xa2, 0xad, 0x37,
+	0x19, 0xff, 0x5c, 0x11, 0x05, 0xf8, 0x13, 0x6a, 0x69, 0x85, 0x34, 0x8d, 0x5e, 0xbd, 0xdf, 0x19,
+	0x3c, 0xb3, 0xd7, 0x24, 0xda, 0x23, 0x06, 0xa1, 0xe2, 0x5f, 0x38, 0xb0, 0xd7, 0x59, 0x71, 0xf8,
+	0xf0, 0xea, 0xe6, 0xb0, 0xf6, 0xe7, 0xe6, 0x70, 0xf7, 0x56, 0x6b, 0x5c, 0x20, 0xf1, 0x18, 0xdd,
+	0x27, 0xd4, 0x0f, 0xc5, 0xe5, 0x14, 0x98, 0x07, 0x01, 0x84, 0x4a, 0x9a, 0x1b, 0x69, 0x4c, 0x6f,
+	0x6d, 0xcc, 0x19, 0xa1, 0x3e, 0xa8, 0xf4, 0xd5, 0x86, 0x8d, 0x24, 0x60, 0x7c, 0xcb, 0x8f, 0xdf,
+	0xa2, 0x0e, 0x15, 0x41, 0xc0, 0x55, 0x86, 0xab, 0x57, 0xc2, 0xad, 0x5a, 0xf1, 0x10, 0xb5, 0x62,
+	0xa0, 0xc0, 0x23, 0x25, 0xcd, 0x46, 0x25, 0x4c, 0xe1, 0xc3, 0x67, 0x68, 0x47, 0x42, 0xc8, 0x26,
+	0x12, 0xbe, 0xce, 0x20, 0xa4, 0x20, 0xcd, 0x7b, 0x29, 0xe9, 0xe9, 0x5d, 0x24, 0xad, 0xd5, 0xb0,
+	0xed, 0x04, 0x90, 0xd7, 0x52, 0x62, 0x0c, 0x74, 0xbe, 0x42, 0x6c, 0x56, 0x26, 0x26, 0x80, 0x92,
+	0xf8, 0x0e, 0x6d, 0x13, 0xea, 0xaf, 0x00, 0x37, 0xab, 0x02, 0xb7, 0x08, 0xf5, 0x4b, 0xde, 0x00,
+	0x1d, 0x84, 0xf0, 0x4d, 0x4d, 0xb4, 0xab, 0x00, 0x9b, 0xad, 0x9e, 0xd1, 0x6f, 0x8c, 0xf7, 0x92,
+	0xa6, 0xfe, 0x17, 0x72, 0x13, 0x3e, 0x45, 0xcd, 0x88, 0xc4, 0x24, 0x90, 0x66, 0xbb, 0x67, 0xf4,
+	0x3b, 0x83, 0x47, 0xff, 0x09, 0x4f, 0x24, 0x3a, 0x54, 0x1b, 0xf0, 0x67, 0x74, 0x50, 0xde, 0xda,
+	0xe4, 0x92, 0x28, 0x88, 0x03, 0x12, 0xfb, 0xd2, 0x44, 0x55, 0x3f, 0x63, 0xbf, 0xe4, 0xbc, 0x2f,
+	0x30, 0xf8, 0x03, 0xc2, 0xfa, 0x3a, 0x57, 0xe1, 0x9d, 0xaa, 0xf0, 0x5d, 0x0d, 0x29, 0xc9, 0x47,
+	0x0c, 0xed, 0xfc, 0x2b, 0xc5, 0x0f, 0xd0, 0x66, 0x24, 0x62, 0x35, 0xe1, 0xcc, 0x34, 0x7a, 0x46,
+	0xbf, 0x3d, 0x6e, 0x26, 0xc7, 0x11, 0xc3, 0x8f, 0x11, 0xca, 0xc7, 0xc9, 0x99, 0xb9, 0x91, 0xf6,
+	0xda, 0xba, 0x32, 0x62, 0xb8, 0x8b, 0x5a, 0xc5, 0x94, 0xeb, 0xe9, 0x94, 0x8b, 0xf3, 0xf0, 0xfc,
+	0x6a, 0x61, 0x19, 0xd7, 0x0b, 0xcb, 0xf8, 0xb5, 0xb0, 0x8c, 0x1f, 0x4b, 0xab, 0x76, 0xbd, 0xb4,
+	0x6a, 0x3f, 0x97, 0x56, 0xed, 0xe3, 0xa9, 0xc7, 0xd5, 0xc5, 0xcc, 0xb5, 0xa9, 0x08, 0x1c, 0x2a,
+	0x64, 0x20, 0xa4, 0xc3, 0x5d, 0x7a, 0xec, 0x09, 0x67, 0xfe, 0xca, 0x09, 0x04, 0x9b, 0x4d, 0x41,
+	0x66, 0x1b, 0xe3, 0xf9, 0xcb, 0xe3, 0x7c, 0x69, 0xa8, 0xef, 0x11, 0x48, 0xb7, 0x99, 0x2e, 0x8c,
+	0x17, 0x7f, 0x07, 0x00, 0x47, 0xe3, 0x7d, 0x83, 0xa3, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -256,6 +276,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiptWatermarks) > 0 {
+		for iNdEx := len(m.ReceiptWatermarks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptWatermarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CommitmentWatermarks) > 0 {
+		for iNdEx := len(m.CommitmentWatermarks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitmentWatermarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -478,6 +526,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CommitmentWatermarks) > 0 {
+		for _, e := range m.CommitmentWatermarks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptWatermarks) > 0 {
+		for _, e := range m.ReceiptWatermarks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -826,6 +886,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentWatermarks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentWatermarks = append(m.CommitmentWatermarks, PacketSequence{})
+			if err := m.CommitmentWatermarks[len(m.CommitmentWatermarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptWatermarks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptWatermarks = append(m.ReceiptWatermarks, PacketSequence{})
+			if err := m.ReceiptWatermarks[len(m.ReceiptWatermarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
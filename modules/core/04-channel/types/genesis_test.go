@@ -138,6 +138,24 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid commitment watermark",
+			genState: types.GenesisState{
+				CommitmentWatermarks: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, "(testChannel1)", 1),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid receipt watermark",
+			genState: types.GenesisState{
+				ReceiptWatermarks: []types.PacketSequence{
+					types.NewPacketSequence("(testPort1)", testChannel1, 1),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
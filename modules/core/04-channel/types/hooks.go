@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// WriteAcknowledgementHook is invoked by the channel keeper after an asynchronous acknowledgement
+// has been written to state. Middleware may register hooks to perform the bookkeeping associated
+// with asynchronous acknowledgements, regardless of whether the base application wrote the
+// acknowledgement through the middleware's ICS4Wrapper. If a hook returns an error the
+// acknowledgement write fails.
+type WriteAcknowledgementHook func(ctx sdk.Context, packet exported.PacketI, acknowledgement exported.Acknowledgement) error
+
+// AcknowledgementWrapper is invoked by the channel keeper before an asynchronous acknowledgement is
+// written to state. Middleware may register wrappers to transform the acknowledgement written by the
+// base application independently of its position in the ICS4Wrapper stack. The acknowledgement returned
+// by a wrapper is passed to the next registered wrapper and finally written to state. If a wrapper
+// returns an error the acknowledgement write fails.
+type AcknowledgementWrapper func(ctx sdk.Context, packet exported.PacketI, acknowledgement exported.Acknowledgement) (exported.Acknowledgement, error)
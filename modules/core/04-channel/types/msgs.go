@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/base64"
 	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -32,6 +33,11 @@ var (
 	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgPruneStaleInitChannel)(nil)
+	_ sdk.Msg = (*MsgAdvanceReceiptWatermark)(nil)
+	_ sdk.Msg = (*MsgEnableCommitmentWatermark)(nil)
+	_ sdk.Msg = (*MsgReleasePort)(nil)
+	_ sdk.Msg = (*MsgRebindPort)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgChannelOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelOpenTry)(nil)
@@ -50,6 +56,11 @@ var (
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgPruneStaleInitChannel)(nil)
+	_ sdk.HasValidateBasic = (*MsgAdvanceReceiptWatermark)(nil)
+	_ sdk.HasValidateBasic = (*MsgEnableCommitmentWatermark)(nil)
+	_ sdk.HasValidateBasic = (*MsgReleasePort)(nil)
+	_ sdk.HasValidateBasic = (*MsgRebindPort)(nil)
 )
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -774,3 +785,153 @@ func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgPruneStaleInitChannel creates a new instance of MsgPruneStaleInitChannel.
+func NewMsgPruneStaleInitChannel(portID, channelID string, signer string) *MsgPruneStaleInitChannel {
+	return &MsgPruneStaleInitChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPruneStaleInitChannel.
+func (msg *MsgPruneStaleInitChannel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgAdvanceReceiptWatermark creates a new instance of MsgAdvanceReceiptWatermark.
+func NewMsgAdvanceReceiptWatermark(
+	portID, channelID string, counterpartyWatermark uint64,
+	watermarkProof []byte, proofHeight clienttypes.Height,
+	limit uint64, signer string,
+) *MsgAdvanceReceiptWatermark {
+	return &MsgAdvanceReceiptWatermark{
+		PortId:                portID,
+		ChannelId:             channelID,
+		CounterpartyWatermark: counterpartyWatermark,
+		ProofWatermark:        watermarkProof,
+		ProofHeight:           proofHeight,
+		Limit:                 limit,
+		Signer:                signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgAdvanceReceiptWatermark.
+func (msg *MsgAdvanceReceiptWatermark) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if msg.CounterpartyWatermark == 0 {
+		return errorsmod.Wrap(ErrInvalidWatermark, "counterparty watermark cannot be 0")
+	}
+
+	if len(msg.ProofWatermark) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty watermark proof")
+	}
+
+	if msg.Limit == 0 {
+		return errorsmod.Wrap(ErrInvalidPruningLimit, "number of packet receipts to prune must be greater than 0")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgEnableCommitmentWatermark creates a new instance of MsgEnableCommitmentWatermark.
+func NewMsgEnableCommitmentWatermark(portID, channelID string, authority string) *MsgEnableCommitmentWatermark {
+	return &MsgEnableCommitmentWatermark{
+		PortId:    portID,
+		ChannelId: channelID,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgEnableCommitmentWatermark.
+func (msg *MsgEnableCommitmentWatermark) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgReleasePort creates a new instance of MsgReleasePort.
+func NewMsgReleasePort(portID, authority string) *MsgReleasePort {
+	return &MsgReleasePort{
+		PortId:    portID,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgReleasePort.
+func (msg *MsgReleasePort) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgRebindPort creates a new instance of MsgRebindPort.
+func NewMsgRebindPort(portID, module, authority string) *MsgRebindPort {
+	return &MsgRebindPort{
+		PortId:    portID,
+		Module:    module,
+		Authority: authority,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRebindPort.
+func (msg *MsgRebindPort) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if strings.TrimSpace(msg.Module) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "module route cannot be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
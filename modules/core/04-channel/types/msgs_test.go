@@ -1861,6 +1861,135 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgPruneStaleInitChannelValidateBasic() {
+	var msg *types.MsgPruneStaleInitChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgPruneStaleInitChannel(ibctesting.MockPort, ibctesting.FirstChannelID, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAdvanceReceiptWatermarkValidateBasic() {
+	var msg *types.MsgAdvanceReceiptWatermark
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"failure: zero counterparty watermark",
+			func() {
+				msg.CounterpartyWatermark = 0
+			},
+			types.ErrInvalidWatermark,
+		},
+		{
+			"failure: empty watermark proof",
+			func() {
+				msg.ProofWatermark = emptyProof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: zero pruning limit",
+			func() {
+				msg.Limit = 0
+			},
+			types.ErrInvalidPruningLimit,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgAdvanceReceiptWatermark(ibctesting.MockPort, ibctesting.FirstChannelID, 10, suite.proof, height, 5, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 	var msg *types.MsgUpdateParams
 
@@ -1885,14 +2014,14 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 			"invalid params: non zero height",
 			func() {
 				newHeight := clienttypes.NewHeight(1, 1000)
-				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(newHeight, uint64(100000))))
+				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(newHeight, uint64(100000)), false, 0))
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
 		{
 			"invalid params: zero timestamp",
 			func() {
-				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(0))))
+				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(0)), false, 0))
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
@@ -1901,7 +2030,7 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
-			msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(100000))))
+			msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(100000)), false, 0))
 
 			tc.malleate()
 			err := msg.ValidateBasic()
@@ -1927,3 +2056,170 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsGetSigners() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
+
+func (suite *TypesTestSuite) TestMsgEnableCommitmentWatermarkValidateBasic() {
+	var msg *types.MsgEnableCommitmentWatermark
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty authority address",
+			func() {
+				msg.Authority = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgEnableCommitmentWatermark(ibctesting.MockPort, ibctesting.FirstChannelID, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgEnableCommitmentWatermarkGetSigners() {
+	expSigner, err := sdk.AccAddressFromBech32(addr)
+	suite.Require().NoError(err)
+	msg := types.NewMsgEnableCommitmentWatermark(ibctesting.MockPort, ibctesting.FirstChannelID, addr)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(ibc.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+
+	suite.Require().NoError(err)
+	suite.Require().Equal(expSigner.Bytes(), signers[0])
+}
+
+func (suite *TypesTestSuite) TestMsgReleasePortValidateBasic() {
+	var msg *types.MsgReleasePort
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"empty authority address",
+			func() {
+				msg.Authority = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgReleasePort(ibctesting.MockPort, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgRebindPortValidateBasic() {
+	var msg *types.MsgRebindPort
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"empty module route",
+			func() {
+				msg.Module = " "
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"empty authority address",
+			func() {
+				msg.Authority = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgRebindPort(ibctesting.MockPort, ibctesting.MockPort, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
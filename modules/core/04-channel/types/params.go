@@ -1,11 +1,14 @@
 package types
 
 import (
+	"slices"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 // DefaultTimeout defines a default parameter for the channel upgrade protocol.
@@ -14,15 +17,20 @@ import (
 var DefaultTimeout = NewTimeout(clienttypes.ZeroHeight(), uint64(10*time.Minute.Nanoseconds()))
 
 // NewParams creates a new parameter configuration for the channel submodule
-func NewParams(upgradeTimeout Timeout) Params {
+func NewParams(upgradeTimeout Timeout, strictHandshake bool, staleInitChannelAge uint64, hashedPacketDataPorts ...string) Params {
 	return Params{
-		UpgradeTimeout: upgradeTimeout,
+		UpgradeTimeout:        upgradeTimeout,
+		StrictHandshake:       strictHandshake,
+		StaleInitChannelAge:   staleInitChannelAge,
+		HashedPacketDataPorts: hashedPacketDataPorts,
 	}
 }
 
-// DefaultParams is the default parameter configuration for the channel submodule
+// DefaultParams is the default parameter configuration for the channel submodule.
+// Strict handshakes and the pruning of stale INIT channels are disabled by default and the packet
+// data of all ports is emitted in packet events.
 func DefaultParams() Params {
-	return NewParams(DefaultTimeout)
+	return NewParams(DefaultTimeout, false, 0)
 }
 
 // Validate the params.
@@ -33,5 +41,22 @@ func (p Params) Validate() error {
 	if p.UpgradeTimeout.Timestamp == 0 {
 		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
 	}
+
+	foundPorts := make(map[string]bool, len(p.HashedPacketDataPorts))
+	for _, portID := range p.HashedPacketDataPorts {
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			return errorsmod.Wrapf(err, "invalid hashed packet data port")
+		}
+		if foundPorts[portID] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate hashed packet data port: %s", portID)
+		}
+		foundPorts[portID] = true
+	}
+
 	return nil
 }
+
+// IsHashedPacketDataPort returns true if the packet events of the given port only contain the hash of the packet data.
+func (p Params) IsHashedPacketDataPort(portID string) bool {
+	return slices.Contains(p.HashedPacketDataPorts, portID)
+}
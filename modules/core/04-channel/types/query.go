@@ -7,6 +7,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+// MaxPacketReceiptsSequences is the maximum number of packet sequences which can be queried in a
+// single PacketReceipts request.
+const MaxPacketReceiptsSequences = 1000
+
 var (
 	_ codectypes.UnpackInterfacesMessage = (*QueryChannelClientStateResponse)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*QueryChannelConsensusStateResponse)(nil)
@@ -72,6 +76,16 @@ func NewQueryPacketReceiptResponse(
 	}
 }
 
+// HasReceipt returns true if the receipts bitmap indicates that a packet receipt exists for
+// the sequence at the provided index of the request.
+func (qprr QueryPacketReceiptsResponse) HasReceipt(index int) bool {
+	if index < 0 || index/8 >= len(qprr.Receipts) {
+		return false
+	}
+
+	return qprr.Receipts[index/8]&(1<<(index%8)) != 0
+}
+
 // NewQueryPacketAcknowledgementResponse creates a new QueryPacketAcknowledgementResponse instance
 func NewQueryPacketAcknowledgementResponse(
 	acknowledgement []byte, proof []byte, height clienttypes.Height,
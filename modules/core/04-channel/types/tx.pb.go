@@ -42,6 +42,8 @@ const (
 	SUCCESS ResponseResultType = 2
 	// The message was executed unsuccessfully
 	FAILURE ResponseResultType = 3
+	// The message was executed successfully and the acknowledgement will be written asynchronously by the IBC application
+	ASYNC ResponseResultType = 4
 )
 
 var ResponseResultType_name = map[int32]string{
@@ -49,6 +51,7 @@ var ResponseResultType_name = map[int32]string{
 	1: "RESPONSE_RESULT_TYPE_NOOP",
 	2: "RESPONSE_RESULT_TYPE_SUCCESS",
 	3: "RESPONSE_RESULT_TYPE_FAILURE",
+	4: "RESPONSE_RESULT_TYPE_ASYNC",
 }
 
 var ResponseResultType_value = map[string]int32{
@@ -56,6 +59,7 @@ var ResponseResultType_value = map[string]int32{
 	"RESPONSE_RESULT_TYPE_NOOP":        1,
 	"RESPONSE_RESULT_TYPE_SUCCESS":     2,
 	"RESPONSE_RESULT_TYPE_FAILURE":     3,
+	"RESPONSE_RESULT_TYPE_ASYNC":       4,
 }
 
 func (x ResponseResultType) String() string {
@@ -603,6 +607,9 @@ var xxx_messageInfo_MsgRecvPacket proto.InternalMessageInfo
 // MsgRecvPacketResponse defines the Msg/RecvPacket response type.
 type MsgRecvPacketResponse struct {
 	Result ResponseResultType `protobuf:"varint,1,opt,name=result,proto3,enum=ibc.core.channel.v1.ResponseResultType" json:"result,omitempty"`
+	// acknowledgement is the acknowledgement written for the packet. It is empty if the
+	// packet was not received or if the acknowledgement is written asynchronously.
+	Acknowledgement []byte `protobuf:"bytes,2,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
 }
 
 func (m *MsgRecvPacketResponse) Reset()         { *m = MsgRecvPacketResponse{} }
@@ -1630,6 +1637,424 @@ func (m *MsgPruneAcknowledgementsResponse) GetTotalRemainingSequences() uint64 {
 	return 0
 }
 
+// MsgPruneStaleInitChannel defines the request type for the PruneStaleInitChannel rpc.
+type MsgPruneStaleInitChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Signer    string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneStaleInitChannel) Reset()         { *m = MsgPruneStaleInitChannel{} }
+func (m *MsgPruneStaleInitChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneStaleInitChannel) ProtoMessage()    {}
+func (*MsgPruneStaleInitChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{38}
+}
+func (m *MsgPruneStaleInitChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneStaleInitChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneStaleInitChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneStaleInitChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneStaleInitChannel.Merge(m, src)
+}
+func (m *MsgPruneStaleInitChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneStaleInitChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneStaleInitChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneStaleInitChannel proto.InternalMessageInfo
+
+// MsgPruneStaleInitChannelResponse defines the response type for the PruneStaleInitChannel rpc.
+type MsgPruneStaleInitChannelResponse struct {
+}
+
+func (m *MsgPruneStaleInitChannelResponse) Reset()         { *m = MsgPruneStaleInitChannelResponse{} }
+func (m *MsgPruneStaleInitChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneStaleInitChannelResponse) ProtoMessage()    {}
+func (*MsgPruneStaleInitChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{39}
+}
+func (m *MsgPruneStaleInitChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneStaleInitChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneStaleInitChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneStaleInitChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneStaleInitChannelResponse.Merge(m, src)
+}
+func (m *MsgPruneStaleInitChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneStaleInitChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneStaleInitChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneStaleInitChannelResponse proto.InternalMessageInfo
+
+// MsgAdvanceReceiptWatermark defines the request type for the AdvanceReceiptWatermark rpc. It advances
+// the receipt watermark of an UNORDERED channel to the proven commitment watermark of the counterparty
+// channel end, pruning the packet receipts and acknowledgements below it.
+type MsgAdvanceReceiptWatermark struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// commitment watermark of the counterparty channel end at the proof height
+	CounterpartyWatermark uint64       `protobuf:"varint,3,opt,name=counterparty_watermark,json=counterpartyWatermark,proto3" json:"counterparty_watermark,omitempty"`
+	ProofWatermark        []byte       `protobuf:"bytes,4,opt,name=proof_watermark,json=proofWatermark,proto3" json:"proof_watermark,omitempty"`
+	ProofHeight           types.Height `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// maximum number of sequences by which the receipt watermark is advanced
+	Limit  uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Signer string `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgAdvanceReceiptWatermark) Reset()         { *m = MsgAdvanceReceiptWatermark{} }
+func (m *MsgAdvanceReceiptWatermark) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceReceiptWatermark) ProtoMessage()    {}
+func (*MsgAdvanceReceiptWatermark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{40}
+}
+func (m *MsgAdvanceReceiptWatermark) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceReceiptWatermark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceReceiptWatermark.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAdvanceReceiptWatermark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceReceiptWatermark.Merge(m, src)
+}
+func (m *MsgAdvanceReceiptWatermark) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceReceiptWatermark) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceReceiptWatermark.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAdvanceReceiptWatermark proto.InternalMessageInfo
+
+// MsgAdvanceReceiptWatermarkResponse defines the response type for the AdvanceReceiptWatermark rpc.
+type MsgAdvanceReceiptWatermarkResponse struct {
+	// receipt watermark of the channel after the advance
+	ReceiptWatermark uint64 `protobuf:"varint,1,opt,name=receipt_watermark,json=receiptWatermark,proto3" json:"receipt_watermark,omitempty"`
+}
+
+func (m *MsgAdvanceReceiptWatermarkResponse) Reset()         { *m = MsgAdvanceReceiptWatermarkResponse{} }
+func (m *MsgAdvanceReceiptWatermarkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceReceiptWatermarkResponse) ProtoMessage()    {}
+func (*MsgAdvanceReceiptWatermarkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{41}
+}
+func (m *MsgAdvanceReceiptWatermarkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceReceiptWatermarkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceReceiptWatermarkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAdvanceReceiptWatermarkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceReceiptWatermarkResponse.Merge(m, src)
+}
+func (m *MsgAdvanceReceiptWatermarkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceReceiptWatermarkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceReceiptWatermarkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAdvanceReceiptWatermarkResponse proto.InternalMessageInfo
+
+func (m *MsgAdvanceReceiptWatermarkResponse) GetReceiptWatermark() uint64 {
+	if m != nil {
+		return m.ReceiptWatermark
+	}
+	return 0
+}
+
+// MsgEnableCommitmentWatermark defines the request type for the EnableCommitmentWatermark rpc. It opts an
+// UNORDERED channel in to tracking the commitment watermark, which allows the counterparty channel end to
+// prune its packet receipts.
+type MsgEnableCommitmentWatermark struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgEnableCommitmentWatermark) Reset()         { *m = MsgEnableCommitmentWatermark{} }
+func (m *MsgEnableCommitmentWatermark) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableCommitmentWatermark) ProtoMessage()    {}
+func (*MsgEnableCommitmentWatermark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{42}
+}
+func (m *MsgEnableCommitmentWatermark) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableCommitmentWatermark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableCommitmentWatermark.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableCommitmentWatermark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableCommitmentWatermark.Merge(m, src)
+}
+func (m *MsgEnableCommitmentWatermark) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableCommitmentWatermark) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableCommitmentWatermark.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableCommitmentWatermark proto.InternalMessageInfo
+
+// MsgEnableCommitmentWatermarkResponse defines the response type for the EnableCommitmentWatermark rpc.
+type MsgEnableCommitmentWatermarkResponse struct {
+	// commitment watermark of the channel after opting in
+	CommitmentWatermark uint64 `protobuf:"varint,1,opt,name=commitment_watermark,json=commitmentWatermark,proto3" json:"commitment_watermark,omitempty"`
+}
+
+func (m *MsgEnableCommitmentWatermarkResponse) Reset()         { *m = MsgEnableCommitmentWatermarkResponse{} }
+func (m *MsgEnableCommitmentWatermarkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableCommitmentWatermarkResponse) ProtoMessage()    {}
+func (*MsgEnableCommitmentWatermarkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{43}
+}
+func (m *MsgEnableCommitmentWatermarkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableCommitmentWatermarkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableCommitmentWatermarkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableCommitmentWatermarkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableCommitmentWatermarkResponse.Merge(m, src)
+}
+func (m *MsgEnableCommitmentWatermarkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableCommitmentWatermarkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableCommitmentWatermarkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableCommitmentWatermarkResponse proto.InternalMessageInfo
+
+func (m *MsgEnableCommitmentWatermarkResponse) GetCommitmentWatermark() uint64 {
+	if m != nil {
+		return m.CommitmentWatermark
+	}
+	return 0
+}
+
+// MsgReleasePort defines the request type for the ReleasePort rpc. It releases a bound port which is not
+// referenced by any channel that is not CLOSED, so that it can be rebound to a different module route.
+type MsgReleasePort struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgReleasePort) Reset()         { *m = MsgReleasePort{} }
+func (m *MsgReleasePort) String() string { return proto.CompactTextString(m) }
+func (*MsgReleasePort) ProtoMessage()    {}
+func (*MsgReleasePort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{44}
+}
+func (m *MsgReleasePort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleasePort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleasePort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleasePort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleasePort.Merge(m, src)
+}
+func (m *MsgReleasePort) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleasePort) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleasePort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleasePort proto.InternalMessageInfo
+
+// MsgReleasePortResponse defines the response type for the ReleasePort rpc.
+type MsgReleasePortResponse struct {
+}
+
+func (m *MsgReleasePortResponse) Reset()         { *m = MsgReleasePortResponse{} }
+func (m *MsgReleasePortResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleasePortResponse) ProtoMessage()    {}
+func (*MsgReleasePortResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{45}
+}
+func (m *MsgReleasePortResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleasePortResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleasePortResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleasePortResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleasePortResponse.Merge(m, src)
+}
+func (m *MsgReleasePortResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleasePortResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleasePortResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleasePortResponse proto.InternalMessageInfo
+
+// MsgRebindPort defines the request type for the RebindPort rpc. It rebinds a released port to the
+// given module route.
+type MsgRebindPort struct {
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// name of the module route the port is rebound to
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRebindPort) Reset()         { *m = MsgRebindPort{} }
+func (m *MsgRebindPort) String() string { return proto.CompactTextString(m) }
+func (*MsgRebindPort) ProtoMessage()    {}
+func (*MsgRebindPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{46}
+}
+func (m *MsgRebindPort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebindPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebindPort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebindPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebindPort.Merge(m, src)
+}
+func (m *MsgRebindPort) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebindPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebindPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebindPort proto.InternalMessageInfo
+
+// MsgRebindPortResponse defines the response type for the RebindPort rpc.
+type MsgRebindPortResponse struct {
+}
+
+func (m *MsgRebindPortResponse) Reset()         { *m = MsgRebindPortResponse{} }
+func (m *MsgRebindPortResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebindPortResponse) ProtoMessage()    {}
+func (*MsgRebindPortResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{47}
+}
+func (m *MsgRebindPortResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebindPortResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebindPortResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebindPortResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebindPortResponse.Merge(m, src)
+}
+func (m *MsgRebindPortResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebindPortResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebindPortResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebindPortResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
@@ -1670,136 +2095,164 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.channel.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgements")
 	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgementsResponse")
+	proto.RegisterType((*MsgPruneStaleInitChannel)(nil), "ibc.core.channel.v1.MsgPruneStaleInitChannel")
+	proto.RegisterType((*MsgPruneStaleInitChannelResponse)(nil), "ibc.core.channel.v1.MsgPruneStaleInitChannelResponse")
+	proto.RegisterType((*MsgAdvanceReceiptWatermark)(nil), "ibc.core.channel.v1.MsgAdvanceReceiptWatermark")
+	proto.RegisterType((*MsgAdvanceReceiptWatermarkResponse)(nil), "ibc.core.channel.v1.MsgAdvanceReceiptWatermarkResponse")
+	proto.RegisterType((*MsgEnableCommitmentWatermark)(nil), "ibc.core.channel.v1.MsgEnableCommitmentWatermark")
+	proto.RegisterType((*MsgEnableCommitmentWatermarkResponse)(nil), "ibc.core.channel.v1.MsgEnableCommitmentWatermarkResponse")
+	proto.RegisterType((*MsgReleasePort)(nil), "ibc.core.channel.v1.MsgReleasePort")
+	proto.RegisterType((*MsgReleasePortResponse)(nil), "ibc.core.channel.v1.MsgReleasePortResponse")
+	proto.RegisterType((*MsgRebindPort)(nil), "ibc.core.channel.v1.MsgRebindPort")
+	proto.RegisterType((*MsgRebindPortResponse)(nil), "ibc.core.channel.v1.MsgRebindPortResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x36, 0xf5, 0x33, 0x7e, 0x4e, 0xd6, 0x0a, 0xe5, 0xc4, 0x32, 0x6d, 0x4b, 0x8a, 0x5a, 0x6c,
-	0xbc, 0x6e, 0x22, 0xad, 0xbd, 0x49, 0xd1, 0x0d, 0x16, 0x68, 0x1d, 0x55, 0xe9, 0x1a, 0x88, 0x63,
-	0x83, 0xb2, 0x8a, 0x76, 0xb7, 0xa8, 0x20, 0x53, 0x13, 0x8a, 0x90, 0x44, 0x72, 0x49, 0x4a, 0xbb,
-	0x2e, 0xd0, 0x62, 0xd1, 0x53, 0x90, 0xc3, 0xa2, 0x05, 0xf6, 0x1a, 0xa0, 0x45, 0xff, 0x81, 0x3d,
-	0xf7, 0xc7, 0xa1, 0xb7, 0x3d, 0x15, 0x7b, 0x5c, 0x14, 0xe8, 0xa2, 0x48, 0x0e, 0xb9, 0xf4, 0x2f,
-	0x28, 0x50, 0xa0, 0xe0, 0xcc, 0x90, 0xa2, 0xc8, 0xa1, 0x44, 0x59, 0xaa, 0xd1, 0x9b, 0x38, 0xf3,
-	0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0xcd, 0xe3, 0xcc, 0x50, 0xb0, 0xa5, 0x9c, 0x49, 0x15, 0x49, 0x33,
-	0x50, 0x45, 0xea, 0xb4, 0x54, 0x15, 0xf5, 0x2a, 0xc3, 0xbd, 0x8a, 0xf5, 0x49, 0x59, 0x37, 0x34,
-	0x4b, 0xe3, 0xb3, 0xca, 0x99, 0x54, 0xb6, 0x7b, 0xcb, 0xb4, 0xb7, 0x3c, 0xdc, 0x13, 0xd6, 0x64,
-	0x4d, 0xd6, 0x70, 0x7f, 0xc5, 0xfe, 0x45, 0xa0, 0xc2, 0xba, 0xa4, 0x99, 0x7d, 0xcd, 0xac, 0xf4,
-	0x4d, 0xd9, 0x36, 0xd1, 0x37, 0x65, 0xda, 0x51, 0x18, 0x79, 0xe8, 0x29, 0x48, 0xb5, 0xec, 0x5e,
-	0xf2, 0x8b, 0x02, 0x6e, 0xb1, 0x42, 0x70, 0xfc, 0x4d, 0x80, 0x0c, 0x74, 0xd9, 0x68, 0xb5, 0x11,
-	0x81, 0x94, 0x3e, 0xe7, 0x80, 0x3f, 0x32, 0xe5, 0x2a, 0xe9, 0x3f, 0xd6, 0x91, 0x7a, 0xa8, 0x2a,
-	0x16, 0xbf, 0x0e, 0x69, 0x5d, 0x33, 0xac, 0xa6, 0xd2, 0xce, 0x71, 0x45, 0x6e, 0x67, 0x59, 0x4c,
-	0xd9, 0x8f, 0x87, 0x6d, 0xfe, 0x3d, 0x48, 0x53, 0x5b, 0xb9, 0x58, 0x91, 0xdb, 0x59, 0xd9, 0xdf,
-	0x2a, 0x33, 0x26, 0x5b, 0xa6, 0xf6, 0x1e, 0x26, 0xbe, 0xfc, 0xa6, 0xb0, 0x24, 0x3a, 0x43, 0xf8,
-	0x9b, 0x90, 0x32, 0x15, 0x59, 0x45, 0x46, 0x2e, 0x4e, 0xac, 0x92, 0xa7, 0x07, 0xab, 0xcf, 0x7e,
-	0x57, 0x58, 0xfa, 0xf5, 0xeb, 0x2f, 0x76, 0x69, 0x43, 0xe9, 0x43, 0x10, 0x82, 0x51, 0x89, 0xc8,
-	0xd4, 0x35, 0xd5, 0x44, 0xfc, 0x36, 0x00, 0xb5, 0x38, 0x0a, 0x70, 0x99, 0xb6, 0x1c, 0xb6, 0xf9,
-	0x1c, 0xa4, 0x87, 0xc8, 0x30, 0x15, 0x4d, 0xc5, 0x31, 0x2e, 0x8b, 0xce, 0xe3, 0x83, 0x84, 0xed,
-	0xa7, 0xf4, 0x4d, 0x0c, 0xae, 0x8f, 0x5b, 0x3f, 0x35, 0xce, 0xc3, 0xa7, 0xbc, 0x0f, 0x59, 0xdd,
-	0x40, 0x43, 0x45, 0x1b, 0x98, 0x4d, 0x8f, 0x5b, 0x6c, 0xfa, 0x61, 0x2c, 0xc7, 0x89, 0xd7, 0x9d,
-	0xee, 0xaa, 0x1b, 0x82, 0x87, 0xa6, 0xf8, 0xec, 0x34, 0xed, 0xc1, 0x9a, 0xa4, 0x0d, 0x54, 0x0b,
-	0x19, 0x7a, 0xcb, 0xb0, 0xce, 0x9b, 0xce, 0x6c, 0x12, 0x38, 0xae, 0xac, 0xb7, 0xef, 0xc7, 0xa4,
-	0xcb, 0xa6, 0x44, 0x37, 0x34, 0xed, 0x69, 0x53, 0x51, 0x15, 0x2b, 0x97, 0x2c, 0x72, 0x3b, 0x57,
-	0xc5, 0x65, 0xdc, 0x82, 0xf5, 0xac, 0xc2, 0x55, 0xd2, 0xdd, 0x41, 0x8a, 0xdc, 0xb1, 0x72, 0x29,
-	0x1c, 0x94, 0xe0, 0x09, 0x8a, 0xa4, 0xd6, 0x70, 0xaf, 0xfc, 0x3e, 0x46, 0xd0, 0x90, 0x56, 0xf0,
-	0x28, 0xd2, 0xe4, 0x51, 0x2f, 0x3d, 0x59, 0xbd, 0x0f, 0x60, 0x23, 0xc0, 0xaf, 0x2b, 0x9e, 0x47,
-	0x1d, 0x6e, 0x4c, 0x1d, 0x9f, 0xac, 0x31, 0x9f, 0xac, 0x54, 0xbc, 0xbf, 0x06, 0xc4, 0x3b, 0x90,
-	0xba, 0xe1, 0xe2, 0x4d, 0xb6, 0xc9, 0x7f, 0x17, 0xd6, 0xc7, 0x98, 0xf6, 0x60, 0x49, 0x86, 0xde,
-	0xf0, 0x76, 0x8f, 0xf4, 0xbd, 0x80, 0x42, 0x9b, 0x40, 0xf4, 0x68, 0x5a, 0xc6, 0x39, 0x15, 0xe8,
-	0x0a, 0x6e, 0xb0, 0x93, 0xef, 0x72, 0xf5, 0xd9, 0xf4, 0xeb, 0x73, 0x20, 0x75, 0x1d, 0x7d, 0x4a,
-	0x7f, 0xe7, 0xe0, 0xc6, 0x78, 0x6f, 0x55, 0x53, 0x9f, 0x2a, 0x46, 0xff, 0xc2, 0x24, 0xbb, 0x33,
-	0x6f, 0x49, 0x5d, 0x4c, 0xab, 0x33, 0x73, 0x5b, 0x39, 0xff, 0xcc, 0x13, 0xf3, 0xcd, 0x3c, 0x39,
-	0x79, 0xe6, 0x05, 0xd8, 0x66, 0xce, 0xcd, 0x9d, 0xfd, 0x10, 0xb2, 0x23, 0x40, 0xb5, 0xa7, 0x99,
-	0x68, 0x72, 0x3d, 0x9c, 0x32, 0xf5, 0xc8, 0x05, 0x6f, 0x1b, 0x36, 0x19, 0x7e, 0xdd, 0xb0, 0x7e,
-	0x1f, 0x83, 0x9b, 0xbe, 0xfe, 0x79, 0x55, 0x19, 0xaf, 0x18, 0xf1, 0x69, 0x15, 0x63, 0x91, 0xba,
-	0xf0, 0x0f, 0x61, 0x7b, 0x6c, 0xf9, 0xd0, 0x77, 0x52, 0xd3, 0x44, 0x1f, 0x0d, 0x90, 0x2a, 0x21,
-	0x9c, 0xff, 0x09, 0x71, 0xd3, 0x0b, 0x6a, 0x10, 0x4c, 0x9d, 0x42, 0x82, 0x14, 0x16, 0x21, 0xcf,
-	0xa6, 0xc8, 0x65, 0xf1, 0x15, 0x07, 0xd7, 0x8e, 0x4c, 0x59, 0x44, 0xd2, 0xf0, 0xa4, 0x25, 0x75,
-	0x91, 0xc5, 0xbf, 0x0b, 0x29, 0x1d, 0xff, 0xc2, 0xdc, 0xad, 0xec, 0x6f, 0x32, 0xcb, 0x34, 0x01,
-	0xd3, 0x09, 0xd2, 0x01, 0xfc, 0x5b, 0x90, 0x21, 0x04, 0x49, 0x5a, 0xbf, 0xaf, 0x58, 0x7d, 0xa4,
-	0x5a, 0x98, 0xe4, 0xab, 0xe2, 0x2a, 0x6e, 0xaf, 0xba, 0xcd, 0x01, 0x2e, 0xe3, 0xf3, 0x71, 0x99,
-	0x98, 0x9c, 0x4a, 0x3f, 0xc7, 0xeb, 0x77, 0x34, 0x49, 0xb7, 0xf2, 0x7e, 0x1f, 0x52, 0x06, 0x32,
-	0x07, 0x3d, 0x32, 0xd9, 0x37, 0xf6, 0x6f, 0x33, 0x27, 0xeb, 0xc0, 0x45, 0x0c, 0x3d, 0x3d, 0xd7,
-	0x91, 0x48, 0x87, 0xd1, 0x0a, 0xfc, 0x59, 0x0c, 0xe0, 0xc8, 0x94, 0x4f, 0x95, 0x3e, 0xd2, 0x06,
-	0x8b, 0xa1, 0x70, 0xa0, 0x1a, 0x48, 0x42, 0xca, 0x10, 0xb5, 0xc7, 0x28, 0x6c, 0xb8, 0xcd, 0x8b,
-	0xa1, 0xf0, 0x0e, 0xf0, 0x2a, 0xfa, 0xc4, 0x72, 0xd3, 0xac, 0x69, 0x20, 0x69, 0x88, 0xe9, 0x4c,
-	0x88, 0x19, 0xbb, 0xc7, 0x49, 0x2e, 0x9b, 0xbc, 0xe8, 0x45, 0xe5, 0x43, 0xbc, 0x85, 0xa2, 0x7c,
-	0x2c, 0x9a, 0xed, 0x7f, 0x93, 0xf7, 0x1d, 0xb5, 0x7e, 0xac, 0xe2, 0xc4, 0xbe, 0x24, 0xd2, 0x0b,
-	0xb0, 0x42, 0x53, 0xdc, 0x76, 0x4a, 0x6b, 0x04, 0xa9, 0x1a, 0x24, 0x8c, 0x85, 0x14, 0x09, 0xb6,
-	0x2a, 0xc9, 0xa9, 0xaa, 0xa4, 0x66, 0x2b, 0x29, 0xe9, 0x0b, 0x94, 0x94, 0x33, 0xfc, 0xa2, 0x1c,
-	0xe7, 0x7e, 0xd1, 0x02, 0x3f, 0x8b, 0xe1, 0xf4, 0x39, 0x90, 0xba, 0xaa, 0xf6, 0x71, 0x0f, 0xb5,
-	0x65, 0x84, 0x6b, 0xc6, 0x1c, 0x0a, 0xef, 0xc0, 0x6a, 0x6b, 0xdc, 0x9a, 0x23, 0xb0, 0xaf, 0x79,
-	0x24, 0xb0, 0x3d, 0xb0, 0x3d, 0x26, 0xf0, 0x81, 0xdd, 0x72, 0xc9, 0x6f, 0x67, 0x09, 0xef, 0xfa,
-	0x7d, 0x4c, 0x2c, 0x9a, 0xef, 0x3f, 0x8e, 0xed, 0x6f, 0x68, 0x0a, 0xcc, 0xf5, 0x92, 0xff, 0x01,
-	0xa4, 0x9e, 0x2a, 0xa8, 0xd7, 0x36, 0x69, 0x55, 0x2a, 0x31, 0x03, 0xa3, 0x9e, 0x1e, 0x61, 0xa4,
-	0xa3, 0x18, 0x19, 0x17, 0xbd, 0xb6, 0x7f, 0xc6, 0x79, 0x37, 0x30, 0x9e, 0xe0, 0x5d, 0x96, 0xde,
-	0x83, 0x34, 0x4d, 0x7d, 0x9a, 0x38, 0x5b, 0x93, 0xa2, 0x71, 0x4e, 0x1e, 0x74, 0x88, 0x5d, 0x1c,
-	0x02, 0x0b, 0x27, 0x86, 0x17, 0xce, 0xea, 0xc0, 0xb7, 0x58, 0x08, 0x9b, 0xff, 0x89, 0xc3, 0x5a,
-	0x20, 0xa0, 0x89, 0xc7, 0xa9, 0x29, 0x64, 0xfe, 0x08, 0x8a, 0xba, 0xa1, 0xe9, 0x9a, 0x89, 0xda,
-	0xee, 0x1a, 0x96, 0x34, 0x55, 0x45, 0x92, 0xa5, 0x68, 0x6a, 0xb3, 0xa3, 0xe9, 0x36, 0xcd, 0xf1,
-	0x9d, 0x65, 0x71, 0xdb, 0xc1, 0x51, 0xaf, 0x55, 0x17, 0xf5, 0xbe, 0xa6, 0x9b, 0x7c, 0x07, 0x36,
-	0x99, 0x05, 0x81, 0x4a, 0x95, 0x98, 0x51, 0xaa, 0x0d, 0x46, 0xe1, 0x20, 0x80, 0xe9, 0xa5, 0x27,
-	0x39, 0xb5, 0xf4, 0xf0, 0xdf, 0x82, 0x6b, 0xb4, 0xd4, 0xd2, 0x63, 0x63, 0x0a, 0xaf, 0x45, 0xb2,
-	0xfa, 0x28, 0xbb, 0x23, 0x90, 0xa3, 0x70, 0xda, 0x03, 0xa2, 0x16, 0x03, 0x4b, 0xf6, 0xca, 0x7c,
-	0x4b, 0x76, 0x79, 0x72, 0x42, 0xfe, 0x8d, 0x83, 0x2d, 0x96, 0xfe, 0x97, 0x9e, 0x8f, 0x9e, 0xf2,
-	0x10, 0x9f, 0xa7, 0x3c, 0xfc, 0x23, 0xc6, 0x48, 0xe8, 0x79, 0x8e, 0x98, 0x0d, 0xdf, 0x51, 0xd1,
-	0x61, 0x23, 0x1e, 0x99, 0x8d, 0x2c, 0x23, 0x71, 0x82, 0x09, 0x93, 0x88, 0x92, 0x30, 0xc9, 0x08,
-	0x09, 0xf3, 0xbf, 0x3d, 0x7b, 0x22, 0x46, 0xbe, 0x78, 0x8e, 0x9f, 0x8b, 0xaa, 0xf2, 0x7f, 0x8a,
-	0x43, 0x2e, 0xe0, 0x67, 0xde, 0x23, 0xd3, 0x4f, 0x40, 0x60, 0xde, 0x16, 0x98, 0x56, 0xcb, 0x42,
-	0x34, 0xed, 0x04, 0x66, 0xbc, 0x75, 0x1b, 0x21, 0xe6, 0x18, 0x97, 0x09, 0xb8, 0x27, 0x34, 0x49,
-	0x12, 0x0b, 0x4e, 0x92, 0x64, 0x94, 0x24, 0x49, 0x45, 0x48, 0x92, 0xf4, 0x7c, 0x49, 0x72, 0x65,
-	0x72, 0x92, 0x28, 0x50, 0x0c, 0x13, 0x6f, 0xd1, 0x89, 0xf2, 0x69, 0x9c, 0xb1, 0x1d, 0x38, 0xd6,
-	0x91, 0xfa, 0x7f, 0x98, 0x25, 0x53, 0x5f, 0x34, 0x89, 0x0b, 0xbc, 0x68, 0x58, 0x29, 0x71, 0xb9,
-	0x25, 0xa1, 0xc0, 0xd8, 0xd3, 0xd8, 0x0a, 0xb8, 0xe7, 0xf6, 0x3f, 0xc7, 0x18, 0x8b, 0xd9, 0x39,
-	0x7f, 0x2e, 0xaa, 0x2e, 0xcf, 0x7e, 0x5f, 0x9b, 0x65, 0x08, 0x15, 0xad, 0x2e, 0xfb, 0xf9, 0x4d,
-	0xce, 0xc7, 0x6f, 0x6a, 0x32, 0xbf, 0x25, 0xc6, 0x6a, 0xf2, 0x9d, 0x56, 0x4b, 0x7f, 0x89, 0xc1,
-	0x7a, 0x70, 0xc9, 0xb5, 0x54, 0x09, 0xf5, 0x2e, 0xcc, 0xf0, 0x63, 0xb8, 0x86, 0x0c, 0x43, 0x33,
-	0x9a, 0xf8, 0x40, 0xa9, 0x3b, 0x87, 0xf6, 0x5b, 0x4c, 0x6a, 0x6b, 0x36, 0x52, 0x24, 0x40, 0x3a,
-	0xdb, 0xab, 0xc8, 0xd3, 0xc6, 0x97, 0x21, 0x4b, 0x38, 0x1b, 0xb7, 0x49, 0xe8, 0xbd, 0x8e, 0xbb,
-	0xbc, 0x36, 0x2e, 0x99, 0xe3, 0x5b, 0x50, 0x08, 0xa1, 0xcf, 0xa5, 0xf8, 0x57, 0xb0, 0x7a, 0x64,
-	0xca, 0x0d, 0xbd, 0xdd, 0xb2, 0xd0, 0x49, 0xcb, 0x68, 0xf5, 0x4d, 0x7e, 0x0b, 0x96, 0x5b, 0x03,
-	0xab, 0xa3, 0x19, 0x8a, 0x75, 0xee, 0x7c, 0xc7, 0x70, 0x1b, 0xc8, 0x11, 0xd0, 0xc6, 0xd1, 0x4f,
-	0x2d, 0x61, 0x47, 0x40, 0x1b, 0x32, 0x3a, 0x02, 0xda, 0x4f, 0x0f, 0x78, 0x27, 0xbe, 0x91, 0xb9,
-	0xd2, 0x06, 0x56, 0xd8, 0xeb, 0xdf, 0x0d, 0xed, 0xb7, 0x1c, 0x5e, 0x60, 0x27, 0xc6, 0x40, 0x45,
-	0xbe, 0xe3, 0x97, 0x79, 0x61, 0xf9, 0xd7, 0x20, 0xd9, 0x53, 0xfa, 0xf4, 0x6e, 0x31, 0x21, 0x92,
-	0x87, 0xe8, 0x47, 0x9d, 0xcf, 0x39, 0x9c, 0xb6, 0xcc, 0x98, 0xdc, 0x97, 0xc0, 0x3d, 0xb8, 0x69,
-	0x69, 0x56, 0xab, 0xd7, 0xd4, 0x6d, 0x58, 0xdb, 0xad, 0x84, 0x26, 0x0e, 0x35, 0x21, 0xae, 0xe1,
-	0x5e, 0x6c, 0xa3, 0xed, 0x94, 0x40, 0x93, 0x7f, 0x00, 0x1b, 0x64, 0x94, 0x81, 0xfa, 0x2d, 0x45,
-	0x55, 0x54, 0xd9, 0x33, 0x90, 0x6c, 0x2f, 0xd7, 0x31, 0x40, 0x74, 0xfa, 0xdd, 0xb1, 0xbb, 0x5f,
-	0x73, 0xc0, 0x07, 0x5f, 0x2a, 0xfc, 0x7d, 0x28, 0x8a, 0xb5, 0xfa, 0xc9, 0xf1, 0x93, 0x7a, 0xad,
-	0x29, 0xd6, 0xea, 0x8d, 0xc7, 0xa7, 0xcd, 0xd3, 0x9f, 0x9e, 0xd4, 0x9a, 0x8d, 0x27, 0xf5, 0x93,
-	0x5a, 0xf5, 0xf0, 0xd1, 0x61, 0xed, 0x87, 0x99, 0x25, 0x61, 0xf5, 0xf9, 0x8b, 0xe2, 0x8a, 0xa7,
-	0x89, 0xbf, 0x0d, 0x1b, 0xcc, 0x61, 0x4f, 0x8e, 0x8f, 0x4f, 0x32, 0x9c, 0x70, 0xe5, 0xf9, 0x8b,
-	0x62, 0xc2, 0xfe, 0xcd, 0xdf, 0x85, 0x2d, 0x26, 0xb0, 0xde, 0xa8, 0x56, 0x6b, 0xf5, 0x7a, 0x26,
-	0x26, 0xac, 0x3c, 0x7f, 0x51, 0x4c, 0xd3, 0xc7, 0x50, 0xf8, 0xa3, 0x83, 0xc3, 0xc7, 0x0d, 0xb1,
-	0x96, 0x89, 0x13, 0x38, 0x7d, 0x14, 0x12, 0xcf, 0xfe, 0x90, 0x5f, 0xda, 0xff, 0x57, 0x06, 0xe2,
-	0x47, 0xa6, 0xcc, 0x77, 0x61, 0xd5, 0xff, 0x3d, 0x90, 0xfd, 0x72, 0x0d, 0x7e, 0xa2, 0x13, 0x2a,
-	0x11, 0x81, 0xae, 0x82, 0x1d, 0x78, 0xc3, 0xf7, 0x21, 0xee, 0xcd, 0x08, 0x26, 0x4e, 0x8d, 0x73,
-	0xa1, 0x1c, 0x0d, 0x17, 0xe2, 0xc9, 0xde, 0xd2, 0x47, 0xf1, 0x74, 0x20, 0x75, 0x23, 0x79, 0xf2,
-	0xee, 0x61, 0x2d, 0xe0, 0x19, 0x9f, 0x4f, 0x76, 0x23, 0x58, 0xa1, 0x58, 0x61, 0x3f, 0x3a, 0xd6,
-	0xf5, 0xaa, 0x42, 0x26, 0xf0, 0xdd, 0x62, 0x67, 0x8a, 0x1d, 0x17, 0x29, 0xbc, 0x1d, 0x15, 0xe9,
-	0xfa, 0xfb, 0x18, 0xb2, 0xac, 0xef, 0x11, 0xdf, 0x89, 0x62, 0xc8, 0x99, 0xe7, 0x3b, 0x33, 0x80,
-	0x5d, 0xc7, 0x3f, 0x03, 0xf0, 0x5c, 0xe1, 0x97, 0xc2, 0x4c, 0x8c, 0x30, 0xc2, 0xee, 0x74, 0x8c,
-	0x6b, 0xbd, 0x0e, 0x69, 0x67, 0x6b, 0x51, 0x08, 0x1b, 0x46, 0x01, 0xc2, 0xed, 0x29, 0x00, 0x6f,
-	0xee, 0xf9, 0x6e, 0x70, 0xdf, 0x9c, 0x32, 0x94, 0xe2, 0xc2, 0x73, 0x2f, 0xe4, 0x56, 0xb2, 0x0b,
-	0xab, 0xfe, 0xab, 0xc4, 0xd0, 0x28, 0x7d, 0xc0, 0xf0, 0xc5, 0x1b, 0x76, 0x25, 0x37, 0x4a, 0x74,
-	0xef, 0x3d, 0xda, 0xb4, 0x44, 0xf7, 0x60, 0xa7, 0x26, 0x3a, 0xeb, 0x8a, 0xeb, 0x23, 0xb8, 0x1e,
-	0xbc, 0x6f, 0x7a, 0x2b, 0x9a, 0x21, 0xbb, 0x70, 0xec, 0x45, 0x86, 0x86, 0xbb, 0xb4, 0xcb, 0x47,
-	0x44, 0x97, 0x76, 0x05, 0xd9, 0x8b, 0x0c, 0x75, 0x5d, 0xfe, 0x12, 0x6e, 0xb0, 0x4f, 0xaf, 0x77,
-	0xa3, 0xd9, 0x72, 0x96, 0xd8, 0xfd, 0x99, 0xe0, 0xe1, 0xd2, 0xe2, 0x33, 0x51, 0x44, 0x69, 0x6d,
-	0x6c, 0x54, 0x69, 0xbd, 0x3b, 0xfd, 0xe0, 0xa4, 0x9d, 0xa5, 0x18, 0x71, 0xd2, 0xce, 0xc2, 0xbc,
-	0x3f, 0x13, 0xdc, 0x75, 0xff, 0x0b, 0x58, 0x63, 0xee, 0x80, 0xef, 0x44, 0xe4, 0x10, 0xa3, 0x85,
-	0x7b, 0xb3, 0xa0, 0x5d, 0xdf, 0x0a, 0x64, 0xc9, 0xde, 0x8c, 0xa2, 0xe8, 0x16, 0xf1, 0xdb, 0x61,
-	0xc6, 0xbc, 0x1b, 0x39, 0xe1, 0x4e, 0x14, 0x94, 0x97, 0x65, 0xf6, 0x56, 0x2f, 0x94, 0x65, 0x26,
-	0x3c, 0x9c, 0xe5, 0x89, 0x9b, 0x36, 0x21, 0xf9, 0xe9, 0xeb, 0x2f, 0x76, 0xb9, 0x87, 0xf5, 0x2f,
-	0x5f, 0xe6, 0xb9, 0xaf, 0x5e, 0xe6, 0xb9, 0x7f, 0xbe, 0xcc, 0x73, 0xbf, 0x79, 0x95, 0x5f, 0xfa,
-	0xea, 0x55, 0x7e, 0xe9, 0xeb, 0x57, 0xf9, 0xa5, 0x0f, 0xde, 0x95, 0x15, 0xab, 0x33, 0x38, 0x2b,
-	0x4b, 0x5a, 0xbf, 0x42, 0xff, 0x1f, 0xa5, 0x9c, 0x49, 0x77, 0x65, 0xad, 0x32, 0xfc, 0x5e, 0xa5,
-	0xaf, 0xb5, 0x07, 0x3d, 0x64, 0x92, 0xff, 0x35, 0xbd, 0x7d, 0xef, 0xae, 0xf3, 0xd7, 0x26, 0xeb,
-	0x5c, 0x47, 0xe6, 0x59, 0x0a, 0xff, 0xad, 0xe9, 0x9d, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf2,
-	0x6f, 0x97, 0x8a, 0xa1, 0x25, 0x00, 0x00,
+	// 2264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6f, 0xdb, 0xd6,
+	0x15, 0x37, 0xf5, 0x19, 0x1f, 0x27, 0xb1, 0x43, 0x39, 0xb6, 0x4c, 0x7f, 0x29, 0x6a, 0xd1, 0x38,
+	0x4e, 0x22, 0xd5, 0x6e, 0xbc, 0x2d, 0x41, 0x81, 0xcd, 0xd1, 0x94, 0xd5, 0x40, 0x1c, 0x7b, 0x94,
+	0xbd, 0x2d, 0xcd, 0x00, 0x81, 0xa6, 0x6e, 0x64, 0xc2, 0x12, 0xa9, 0x92, 0x94, 0x52, 0x6f, 0xd8,
+	0x50, 0xac, 0x2f, 0x59, 0x1e, 0x8a, 0x0d, 0xe8, 0x6b, 0x80, 0x0d, 0xfb, 0x07, 0xfa, 0x38, 0x6c,
+	0xdd, 0xc3, 0xde, 0xfa, 0x34, 0xf4, 0x71, 0x18, 0xb0, 0x62, 0x48, 0x1e, 0xfa, 0x3f, 0x0c, 0x18,
+	0x30, 0xf0, 0xde, 0xcb, 0x2b, 0x8a, 0xbc, 0x94, 0x28, 0x4b, 0x35, 0xfa, 0x26, 0xde, 0xfb, 0xbb,
+	0xe7, 0x9c, 0xfb, 0x3b, 0x1f, 0x97, 0xe7, 0x52, 0xb0, 0xa4, 0x1d, 0xa9, 0x45, 0xd5, 0x30, 0x51,
+	0x51, 0x3d, 0x56, 0x74, 0x1d, 0x35, 0x8a, 0x9d, 0x8d, 0xa2, 0xfd, 0x61, 0xa1, 0x65, 0x1a, 0xb6,
+	0x21, 0x66, 0xb4, 0x23, 0xb5, 0xe0, 0xcc, 0x16, 0xe8, 0x6c, 0xa1, 0xb3, 0x21, 0xcd, 0xd6, 0x8d,
+	0xba, 0x81, 0xe7, 0x8b, 0xce, 0x2f, 0x02, 0x95, 0xe6, 0x55, 0xc3, 0x6a, 0x1a, 0x56, 0xb1, 0x69,
+	0xd5, 0x1d, 0x11, 0x4d, 0xab, 0x4e, 0x27, 0x56, 0xbb, 0x1a, 0x1a, 0x1a, 0xd2, 0x6d, 0x67, 0x96,
+	0xfc, 0xa2, 0x80, 0x6b, 0x3c, 0x13, 0x5c, 0x7d, 0x7d, 0x20, 0xed, 0x56, 0xdd, 0x54, 0x6a, 0x88,
+	0x40, 0xf2, 0x9f, 0x0a, 0x20, 0xee, 0x5a, 0xf5, 0x12, 0x99, 0xdf, 0x6b, 0x21, 0x7d, 0x47, 0xd7,
+	0x6c, 0x71, 0x1e, 0xd2, 0x2d, 0xc3, 0xb4, 0xab, 0x5a, 0x2d, 0x2b, 0xe4, 0x84, 0xb5, 0x49, 0x39,
+	0xe5, 0x3c, 0xee, 0xd4, 0xc4, 0x77, 0x21, 0x4d, 0x65, 0x65, 0x63, 0x39, 0x61, 0x6d, 0x6a, 0x73,
+	0xa9, 0xc0, 0xd9, 0x6c, 0x81, 0xca, 0xbb, 0x9f, 0xf8, 0xe2, 0xab, 0xd5, 0x09, 0xd9, 0x5d, 0x22,
+	0xce, 0x41, 0xca, 0xd2, 0xea, 0x3a, 0x32, 0xb3, 0x71, 0x22, 0x95, 0x3c, 0xdd, 0x9b, 0x7e, 0xfe,
+	0x87, 0xd5, 0x89, 0xdf, 0x7c, 0xfd, 0xd9, 0x3a, 0x1d, 0xc8, 0x3f, 0x01, 0x29, 0x68, 0x95, 0x8c,
+	0xac, 0x96, 0xa1, 0x5b, 0x48, 0x5c, 0x06, 0xa0, 0x12, 0xbb, 0x06, 0x4e, 0xd2, 0x91, 0x9d, 0x9a,
+	0x98, 0x85, 0x74, 0x07, 0x99, 0x96, 0x66, 0xe8, 0xd8, 0xc6, 0x49, 0xd9, 0x7d, 0xbc, 0x97, 0x70,
+	0xf4, 0xe4, 0xbf, 0x8a, 0xc1, 0x95, 0x5e, 0xe9, 0x07, 0xe6, 0x69, 0xf8, 0x96, 0x37, 0x21, 0xd3,
+	0x32, 0x51, 0x47, 0x33, 0xda, 0x56, 0xd5, 0xa3, 0x16, 0x8b, 0xbe, 0x1f, 0xcb, 0x0a, 0xf2, 0x15,
+	0x77, 0xba, 0xc4, 0x4c, 0xf0, 0xd0, 0x14, 0x1f, 0x9e, 0xa6, 0x0d, 0x98, 0x55, 0x8d, 0xb6, 0x6e,
+	0x23, 0xb3, 0xa5, 0x98, 0xf6, 0x69, 0xd5, 0xdd, 0x4d, 0x02, 0xdb, 0x95, 0xf1, 0xce, 0xfd, 0x84,
+	0x4c, 0x39, 0x94, 0xb4, 0x4c, 0xc3, 0x78, 0x5a, 0xd5, 0x74, 0xcd, 0xce, 0x26, 0x73, 0xc2, 0xda,
+	0x45, 0x79, 0x12, 0x8f, 0x60, 0x7f, 0x96, 0xe0, 0x22, 0x99, 0x3e, 0x46, 0x5a, 0xfd, 0xd8, 0xce,
+	0xa6, 0xb0, 0x51, 0x92, 0xc7, 0x28, 0x12, 0x5a, 0x9d, 0x8d, 0xc2, 0x7b, 0x18, 0x41, 0x4d, 0x9a,
+	0xc2, 0xab, 0xc8, 0x90, 0xc7, 0x7b, 0xe9, 0xfe, 0xde, 0x7b, 0x1f, 0x16, 0x02, 0xfc, 0x32, 0xe7,
+	0x79, 0xbc, 0x23, 0xf4, 0x78, 0xc7, 0xe7, 0xd6, 0x98, 0xcf, 0xad, 0xd4, 0x79, 0x7f, 0x0f, 0x38,
+	0x6f, 0x5b, 0x3d, 0x09, 0x77, 0x5e, 0x7f, 0x99, 0xe2, 0x77, 0x60, 0xbe, 0x87, 0x69, 0x0f, 0x96,
+	0x44, 0xe8, 0x55, 0xef, 0x74, 0xd7, 0xbf, 0x67, 0xf0, 0xd0, 0x22, 0x10, 0x7f, 0x54, 0x6d, 0xf3,
+	0x94, 0x3a, 0xe8, 0x02, 0x1e, 0x70, 0x82, 0xef, 0x7c, 0xfd, 0xb3, 0xe8, 0xf7, 0xcf, 0xb6, 0x7a,
+	0xe2, 0xfa, 0x27, 0xff, 0x2f, 0x01, 0xae, 0xf6, 0xce, 0x96, 0x0c, 0xfd, 0xa9, 0x66, 0x36, 0xcf,
+	0x4c, 0x32, 0xdb, 0xb9, 0xa2, 0x9e, 0x64, 0xe3, 0x9e, 0x9d, 0x3b, 0x9e, 0xf3, 0xef, 0x3c, 0x31,
+	0xda, 0xce, 0x93, 0xfd, 0x77, 0xbe, 0x0a, 0xcb, 0xdc, 0xbd, 0xb1, 0xdd, 0x77, 0x20, 0xd3, 0x05,
+	0x94, 0x1a, 0x86, 0x85, 0xfa, 0xd7, 0xc3, 0x01, 0x5b, 0x8f, 0x5c, 0xf0, 0x96, 0x61, 0x91, 0xa3,
+	0x97, 0x99, 0xf5, 0xc7, 0x18, 0xcc, 0xf9, 0xe6, 0x47, 0xf5, 0x4a, 0x6f, 0xc5, 0x88, 0x0f, 0xaa,
+	0x18, 0xe3, 0xf4, 0x8b, 0x78, 0x1f, 0x96, 0x7b, 0xd2, 0x87, 0x9e, 0x49, 0x55, 0x0b, 0x7d, 0xd0,
+	0x46, 0xba, 0x8a, 0x70, 0xfc, 0x27, 0xe4, 0x45, 0x2f, 0xe8, 0x90, 0x60, 0x2a, 0x14, 0x12, 0xa4,
+	0x30, 0x07, 0x2b, 0x7c, 0x8a, 0x18, 0x8b, 0xaf, 0x05, 0xb8, 0xb4, 0x6b, 0xd5, 0x65, 0xa4, 0x76,
+	0xf6, 0x15, 0xf5, 0x04, 0xd9, 0xe2, 0x5d, 0x48, 0xb5, 0xf0, 0x2f, 0xcc, 0xdd, 0xd4, 0xe6, 0x22,
+	0xb7, 0x4c, 0x13, 0x30, 0xdd, 0x20, 0x5d, 0x20, 0xde, 0x80, 0x19, 0x42, 0x90, 0x6a, 0x34, 0x9b,
+	0x9a, 0xdd, 0x44, 0xba, 0x8d, 0x49, 0xbe, 0x28, 0x4f, 0xe3, 0xf1, 0x12, 0x1b, 0x0e, 0x70, 0x19,
+	0x1f, 0x8d, 0xcb, 0x44, 0xff, 0x50, 0x7a, 0x4e, 0x12, 0xb8, 0xbb, 0x4b, 0x56, 0x7a, 0xbf, 0x0f,
+	0x29, 0x13, 0x59, 0xed, 0x06, 0xd9, 0xed, 0xe5, 0xcd, 0xeb, 0xdc, 0xdd, 0xba, 0x70, 0x19, 0x43,
+	0x0f, 0x4e, 0x5b, 0x48, 0xa6, 0xcb, 0xc4, 0x35, 0x98, 0x56, 0xd4, 0x13, 0xdd, 0x78, 0xd6, 0x40,
+	0xb5, 0x3a, 0xf2, 0x6e, 0xd9, 0x37, 0x4c, 0x8b, 0xf5, 0x27, 0x31, 0x80, 0x5d, 0xab, 0x7e, 0xa0,
+	0x35, 0x91, 0xd1, 0x1e, 0x0f, 0xdb, 0x6d, 0xdd, 0x44, 0x2a, 0xd2, 0x3a, 0xa8, 0xd6, 0xc3, 0xf6,
+	0x21, 0x1b, 0x1e, 0x0f, 0xdb, 0xb7, 0x40, 0xd4, 0xd1, 0x87, 0x36, 0x8b, 0xc8, 0xaa, 0x89, 0xd4,
+	0x0e, 0x66, 0x3e, 0x21, 0xcf, 0x38, 0x33, 0x6e, 0x1c, 0x3a, 0x34, 0x47, 0xaf, 0x3f, 0x4f, 0x40,
+	0xec, 0xf2, 0x31, 0x36, 0xbf, 0x50, 0xb6, 0xff, 0x4b, 0x8e, 0x46, 0x2a, 0x7d, 0x4f, 0xc7, 0x39,
+	0x70, 0x4e, 0xa4, 0xaf, 0xc2, 0x14, 0xcd, 0x06, 0x47, 0x29, 0x2d, 0x27, 0xa4, 0xc0, 0x10, 0x33,
+	0xc6, 0x52, 0x4f, 0xf8, 0x5e, 0x49, 0x0e, 0xf4, 0x4a, 0x6a, 0xb8, 0xea, 0x93, 0x3e, 0x43, 0xf5,
+	0x39, 0x82, 0x85, 0x00, 0xf7, 0xe3, 0x76, 0xf0, 0xf3, 0x18, 0x0e, 0x9f, 0xed, 0xde, 0x5c, 0x1b,
+	0xc5, 0xc3, 0x91, 0x13, 0xba, 0xeb, 0x60, 0x67, 0x61, 0xad, 0xc7, 0xc1, 0xdb, 0xce, 0xc8, 0x39,
+	0x1f, 0xe4, 0x2a, 0x48, 0x41, 0x26, 0xc6, 0xcd, 0xf7, 0x5f, 0x7a, 0x5e, 0x85, 0x68, 0x08, 0x8c,
+	0xf4, 0x3e, 0xf0, 0x03, 0x48, 0x3d, 0xd5, 0x50, 0xa3, 0x66, 0xd1, 0xaa, 0x94, 0xe7, 0x1a, 0x46,
+	0x35, 0x3d, 0xc0, 0x48, 0xd7, 0x63, 0x64, 0x5d, 0xf4, 0x63, 0xe0, 0x13, 0xc1, 0xfb, 0xae, 0xe3,
+	0x31, 0x9e, 0xb1, 0xf4, 0x2e, 0xa4, 0x69, 0xe8, 0x67, 0x85, 0x3e, 0x4d, 0x0a, 0x5d, 0xea, 0x36,
+	0x29, 0x74, 0x89, 0x53, 0x1c, 0x02, 0x89, 0x13, 0xc3, 0x89, 0x33, 0xdd, 0xf6, 0x25, 0x0b, 0x61,
+	0xf3, 0x7f, 0x71, 0x98, 0x0d, 0x18, 0xd4, 0xb7, 0xf3, 0x1a, 0x40, 0xe6, 0x8f, 0x20, 0xd7, 0x32,
+	0x8d, 0x96, 0x61, 0xa1, 0x1a, 0xcb, 0x61, 0xd5, 0xd0, 0x75, 0xa4, 0xda, 0x9a, 0xa1, 0x57, 0x8f,
+	0x8d, 0x96, 0x43, 0x73, 0x7c, 0x6d, 0x52, 0x5e, 0x76, 0x71, 0x54, 0x6b, 0x89, 0xa1, 0xde, 0x33,
+	0x5a, 0x96, 0x78, 0x0c, 0x8b, 0xdc, 0x82, 0x40, 0x5d, 0x95, 0x18, 0xd2, 0x55, 0x0b, 0x9c, 0xc2,
+	0x41, 0x00, 0x83, 0x4b, 0x4f, 0x72, 0x60, 0xe9, 0x11, 0xdf, 0x80, 0x4b, 0xb4, 0xd4, 0xd2, 0x0e,
+	0x33, 0x85, 0x73, 0x91, 0x64, 0x1f, 0x65, 0xb7, 0x0b, 0x72, 0x3d, 0x9c, 0xf6, 0x80, 0xa8, 0xc4,
+	0x40, 0xca, 0x5e, 0x18, 0x2d, 0x65, 0x27, 0xfb, 0x07, 0xe4, 0x3f, 0x04, 0x58, 0xe2, 0xf9, 0xff,
+	0xdc, 0xe3, 0xd1, 0x53, 0x1e, 0xe2, 0xa3, 0x94, 0x87, 0x7f, 0xc7, 0x38, 0x01, 0x3d, 0x4a, 0x37,
+	0x7a, 0xe8, 0xeb, 0x2a, 0x5d, 0x36, 0xe2, 0x91, 0xd9, 0xc8, 0x70, 0x02, 0x27, 0x18, 0x30, 0x89,
+	0x28, 0x01, 0x93, 0x8c, 0x10, 0x30, 0xdf, 0x6c, 0x9b, 0x8a, 0x38, 0xf1, 0xe2, 0xe9, 0x54, 0xc7,
+	0x55, 0xe5, 0xff, 0x1a, 0x87, 0x6c, 0x40, 0xcf, 0xa8, 0xdd, 0xd5, 0xcf, 0x40, 0xe2, 0x5e, 0x2c,
+	0x58, 0xb6, 0x62, 0x23, 0x1a, 0x76, 0x12, 0xd7, 0xde, 0x8a, 0x83, 0x90, 0xb3, 0x9c, 0x7b, 0x07,
+	0x3c, 0x13, 0x1a, 0x24, 0x89, 0x31, 0x07, 0x49, 0x32, 0x4a, 0x90, 0xa4, 0x22, 0x04, 0x49, 0x7a,
+	0xb4, 0x20, 0xb9, 0xd0, 0x3f, 0x48, 0x34, 0xc8, 0x85, 0x39, 0x6f, 0xdc, 0x81, 0xf2, 0x51, 0x9c,
+	0xf3, 0x3a, 0xe0, 0x5c, 0x22, 0x7c, 0x0b, 0xa3, 0x64, 0xe0, 0x41, 0x93, 0x38, 0xc3, 0x41, 0xc3,
+	0x0b, 0x89, 0xf3, 0x2d, 0x09, 0xab, 0xb0, 0xcc, 0xf5, 0x00, 0x6b, 0xf1, 0x3f, 0x8f, 0x71, 0x92,
+	0xd9, 0xed, 0x3f, 0xc7, 0x55, 0x97, 0x87, 0xbf, 0xda, 0xcd, 0x70, 0x1c, 0x15, 0xad, 0x2e, 0xfb,
+	0xf9, 0x4d, 0x8e, 0xc6, 0x6f, 0xaa, 0x3f, 0xbf, 0x79, 0xc8, 0x85, 0xb1, 0xc7, 0x28, 0xfe, 0x5b,
+	0x0c, 0xe6, 0x83, 0x29, 0xa7, 0xe8, 0x2a, 0x6a, 0x9c, 0x99, 0xe1, 0x87, 0x70, 0x09, 0x99, 0xa6,
+	0x61, 0x56, 0x71, 0x43, 0xd9, 0x72, 0x9b, 0xf6, 0x6b, 0x5c, 0x6a, 0xcb, 0x0e, 0x52, 0x26, 0x40,
+	0xba, 0xdb, 0x8b, 0xc8, 0x33, 0x26, 0x16, 0x20, 0x43, 0x38, 0xeb, 0x95, 0x49, 0xe8, 0xbd, 0x82,
+	0xa7, 0xbc, 0x32, 0xce, 0x99, 0xe3, 0x6b, 0xb0, 0x1a, 0x42, 0x1f, 0xa3, 0xf8, 0xd7, 0x30, 0xbd,
+	0x6b, 0xd5, 0x0f, 0x5b, 0x35, 0xc5, 0x46, 0xfb, 0x8a, 0xa9, 0x34, 0x2d, 0x71, 0x09, 0x26, 0x95,
+	0xb6, 0x7d, 0x6c, 0x98, 0x9a, 0x7d, 0xea, 0x7e, 0xf2, 0x60, 0x03, 0xa4, 0x05, 0x74, 0x70, 0xd9,
+	0x58, 0xdf, 0x16, 0xd0, 0x81, 0x74, 0x5b, 0x40, 0xe7, 0xe9, 0x9e, 0xe8, 0xda, 0xd7, 0x15, 0x97,
+	0x5f, 0x80, 0x79, 0x9f, 0x7e, 0x66, 0xda, 0xef, 0x05, 0x9c, 0x60, 0xfb, 0x66, 0x5b, 0x47, 0xbe,
+	0xf6, 0xcb, 0x3a, 0xb3, 0xfb, 0x67, 0x21, 0xd9, 0xd0, 0x9a, 0xf4, 0x1a, 0x32, 0x21, 0x93, 0x87,
+	0xe8, 0xad, 0xce, 0xa7, 0x02, 0xe4, 0xc2, 0x6c, 0x62, 0x87, 0xc0, 0x1d, 0x98, 0xb3, 0x0d, 0x5b,
+	0x69, 0x54, 0x5b, 0x0e, 0xac, 0xc6, 0x2a, 0xa1, 0x85, 0x4d, 0x4d, 0xc8, 0xb3, 0x78, 0x16, 0xcb,
+	0xa8, 0xb9, 0x25, 0xd0, 0x12, 0xef, 0xc1, 0x02, 0x59, 0x65, 0xa2, 0xa6, 0xa2, 0xe9, 0x9a, 0x5e,
+	0xf7, 0x2c, 0x24, 0xaf, 0x97, 0xf3, 0x18, 0x20, 0xbb, 0xf3, 0x6c, 0x6d, 0xfe, 0x97, 0x5d, 0xa6,
+	0x2a, 0xb6, 0xd2, 0xc0, 0xcd, 0x97, 0x9b, 0xd6, 0xdf, 0xf8, 0x85, 0x72, 0x1e, 0x72, 0x61, 0xca,
+	0x99, 0x2f, 0xff, 0x1c, 0x23, 0x5d, 0x74, 0xad, 0xa3, 0x90, 0x3b, 0x13, 0x27, 0x1b, 0x7e, 0xaa,
+	0xd8, 0xc8, 0x6c, 0x2a, 0xe6, 0xd9, 0x5f, 0x63, 0xb7, 0x60, 0xae, 0xa7, 0x5c, 0x3e, 0x73, 0x25,
+	0x52, 0xf7, 0xf6, 0x7c, 0x53, 0xe9, 0xaa, 0xbb, 0x0e, 0xe4, 0x56, 0xc9, 0x83, 0x27, 0x19, 0x7b,
+	0x19, 0x0f, 0x77, 0x81, 0x63, 0x49, 0x57, 0x16, 0x72, 0x29, 0x7e, 0xc8, 0x0d, 0x38, 0x88, 0x7e,
+	0x0c, 0xf9, 0x70, 0xe6, 0x58, 0xcc, 0xdd, 0x84, 0x2b, 0xb4, 0xf8, 0x78, 0x36, 0x45, 0xc2, 0x6d,
+	0xc6, 0xf4, 0x2d, 0x72, 0xee, 0x6d, 0x9d, 0xf7, 0xdd, 0xb2, 0xae, 0x1c, 0x35, 0x50, 0xf7, 0xf6,
+	0x78, 0x74, 0x7f, 0xf4, 0x94, 0x8e, 0xb8, 0xaf, 0x74, 0x70, 0xf3, 0xff, 0x31, 0xbc, 0xd9, 0xcf,
+	0x12, 0xb6, 0x3f, 0xfc, 0x19, 0xcc, 0x9d, 0x0e, 0x6c, 0x31, 0xa3, 0x06, 0x97, 0xe6, 0x9f, 0xc0,
+	0x65, 0x7c, 0x39, 0xdd, 0x40, 0x8a, 0x85, 0xf6, 0x0d, 0xb3, 0xcf, 0xa9, 0xdc, 0x63, 0x77, 0x2c,
+	0x8a, 0xdd, 0x59, 0x98, 0xeb, 0x15, 0xce, 0x42, 0xdd, 0xa4, 0x37, 0xff, 0x47, 0x9a, 0x5e, 0xeb,
+	0xaf, 0x75, 0x0e, 0x52, 0x4d, 0xa3, 0xd6, 0x6e, 0x20, 0xaa, 0x92, 0x3e, 0x9d, 0x81, 0xc5, 0x79,
+	0xb8, 0xda, 0xa3, 0xd3, 0x35, 0x66, 0xfd, 0xe3, 0x18, 0x88, 0xc1, 0xb7, 0x4d, 0x71, 0x0b, 0x72,
+	0x72, 0xb9, 0xb2, 0xbf, 0xf7, 0xa8, 0x52, 0xae, 0xca, 0xe5, 0xca, 0xe1, 0xc3, 0x83, 0xea, 0xc1,
+	0xe3, 0xfd, 0x72, 0xf5, 0xf0, 0x51, 0x65, 0xbf, 0x5c, 0xda, 0x79, 0xb0, 0x53, 0xfe, 0xe1, 0xcc,
+	0x84, 0x34, 0xfd, 0xe2, 0x65, 0x6e, 0xca, 0x33, 0x24, 0x5e, 0x87, 0x05, 0xee, 0xb2, 0x47, 0x7b,
+	0x7b, 0xfb, 0x33, 0x82, 0x74, 0xe1, 0xc5, 0xcb, 0x5c, 0xc2, 0xf9, 0x2d, 0xde, 0x86, 0x25, 0x2e,
+	0xb0, 0x72, 0x58, 0x2a, 0x95, 0x2b, 0x95, 0x99, 0x98, 0x34, 0xf5, 0xe2, 0x65, 0x2e, 0x4d, 0x1f,
+	0x43, 0xe1, 0x0f, 0xb6, 0x77, 0x1e, 0x1e, 0xca, 0xe5, 0x99, 0x38, 0x81, 0xd3, 0x47, 0xf1, 0x06,
+	0x48, 0x5c, 0xf8, 0x76, 0xe5, 0xf1, 0xa3, 0xd2, 0x4c, 0x42, 0x9a, 0x7c, 0xf1, 0x32, 0x97, 0xc4,
+	0x0f, 0x52, 0xe2, 0xf9, 0x9f, 0x56, 0x26, 0x36, 0x3f, 0xbf, 0x0a, 0xf1, 0x5d, 0xab, 0x2e, 0x9e,
+	0xc0, 0xb4, 0xff, 0xef, 0x07, 0xfc, 0x17, 0xf4, 0xe0, 0x3f, 0x02, 0xa4, 0x62, 0x44, 0x20, 0x8b,
+	0xd8, 0x63, 0xb8, 0xec, 0xfb, 0xee, 0xff, 0x56, 0x04, 0x11, 0x07, 0xe6, 0xa9, 0x54, 0x88, 0x86,
+	0x0b, 0xd1, 0xe4, 0x5c, 0x0b, 0x44, 0xd1, 0xb4, 0xad, 0x9e, 0x44, 0xd2, 0xe4, 0xed, 0x83, 0x6d,
+	0x10, 0x39, 0x5f, 0x6b, 0xd7, 0x23, 0x48, 0xa1, 0x58, 0x69, 0x33, 0x3a, 0x96, 0x69, 0xd5, 0x61,
+	0x26, 0xf0, 0x99, 0x74, 0x6d, 0x80, 0x1c, 0x86, 0x94, 0xde, 0x8e, 0x8a, 0x64, 0xfa, 0x9e, 0x41,
+	0x86, 0xf7, 0xf9, 0xf3, 0x66, 0x14, 0x41, 0xee, 0x3e, 0xdf, 0x19, 0x02, 0xcc, 0x14, 0xff, 0x1c,
+	0xc0, 0xf3, 0xc5, 0x30, 0x1f, 0x26, 0xa2, 0x8b, 0x91, 0xd6, 0x07, 0x63, 0x98, 0xf4, 0x0a, 0xa4,
+	0xdd, 0xf6, 0x64, 0x35, 0x6c, 0x19, 0x05, 0x48, 0xd7, 0x07, 0x00, 0xbc, 0xb1, 0xe7, 0xfb, 0x0a,
+	0xf4, 0xd6, 0x80, 0xa5, 0x14, 0x27, 0x15, 0xa2, 0xe1, 0x98, 0xa6, 0x13, 0x98, 0xf6, 0x7f, 0x8e,
+	0x08, 0xb5, 0xd2, 0x07, 0x94, 0x8a, 0x11, 0x81, 0x9c, 0x40, 0xf7, 0xde, 0xc5, 0x0f, 0x0a, 0x74,
+	0x0f, 0x56, 0xda, 0x8c, 0x8e, 0x65, 0x5a, 0x3f, 0x80, 0x2b, 0xc1, 0x3b, 0xeb, 0x1b, 0xd1, 0x04,
+	0x39, 0x85, 0x63, 0x23, 0x32, 0x34, 0x5c, 0xa5, 0x53, 0x3e, 0x22, 0xaa, 0x74, 0x2a, 0xc8, 0x46,
+	0x64, 0x28, 0x53, 0xf9, 0x2b, 0xb8, 0xca, 0xbf, 0x01, 0xbb, 0x1d, 0x4d, 0x96, 0x9b, 0x62, 0x5b,
+	0x43, 0xc1, 0xc3, 0x5d, 0x8b, 0xef, 0x55, 0x22, 0xba, 0xd6, 0xc1, 0x4a, 0x9b, 0xd1, 0xb1, 0xe1,
+	0x9b, 0x76, 0x53, 0x31, 0xe2, 0xa6, 0xdd, 0xc4, 0xdc, 0x1a, 0x0a, 0xce, 0xd4, 0xff, 0x02, 0x66,
+	0xb9, 0x5d, 0xf4, 0xad, 0x88, 0x1c, 0x62, 0xb4, 0x74, 0x67, 0x18, 0x34, 0xd3, 0xad, 0x41, 0x86,
+	0xf4, 0x77, 0x14, 0x45, 0xdb, 0xcc, 0x37, 0xc3, 0x84, 0x79, 0x9b, 0x41, 0xe9, 0x56, 0x14, 0x94,
+	0x97, 0x65, 0x7e, 0xbb, 0x18, 0xca, 0x32, 0x17, 0x2e, 0x6d, 0x0d, 0x05, 0x0f, 0xa8, 0x0f, 0xf4,
+	0x60, 0xfd, 0xd5, 0xfb, 0xe1, 0xd2, 0xd6, 0x50, 0x70, 0xa6, 0xfe, 0x63, 0x01, 0xe6, 0xc3, 0x3a,
+	0xac, 0xf0, 0x0a, 0xc8, 0x5f, 0x20, 0x7d, 0x77, 0xc8, 0x05, 0xcc, 0x8a, 0xdf, 0x0a, 0xb0, 0x10,
+	0xde, 0x59, 0x84, 0xd6, 0x8b, 0xd0, 0x25, 0xd2, 0xdd, 0xa1, 0x97, 0x30, 0x5b, 0xaa, 0x30, 0xe5,
+	0x7d, 0xff, 0x7f, 0x23, 0xfc, 0xb4, 0x64, 0x20, 0xe9, 0x66, 0x04, 0x50, 0xef, 0x89, 0xcd, 0xde,
+	0xf4, 0xfb, 0x9c, 0xd8, 0x2e, 0x46, 0x5a, 0x1f, 0x8c, 0x71, 0xa5, 0x4b, 0xc9, 0x8f, 0xbe, 0xfe,
+	0x6c, 0x5d, 0xb8, 0x5f, 0xf9, 0xe2, 0xd5, 0x8a, 0xf0, 0xe5, 0xab, 0x15, 0xe1, 0x3f, 0xaf, 0x56,
+	0x84, 0xdf, 0xbd, 0x5e, 0x99, 0xf8, 0xf2, 0xf5, 0xca, 0xc4, 0x3f, 0x5f, 0xaf, 0x4c, 0xbc, 0x7f,
+	0xb7, 0xae, 0xd9, 0xc7, 0xed, 0xa3, 0x82, 0x6a, 0x34, 0x8b, 0xf4, 0xef, 0xbd, 0xda, 0x91, 0x7a,
+	0xbb, 0x6e, 0x14, 0x3b, 0xdf, 0x2b, 0x92, 0x6e, 0xc2, 0x22, 0x7f, 0xcb, 0x7d, 0xfb, 0xce, 0x6d,
+	0xf7, 0x9f, 0xb9, 0xf6, 0x69, 0x0b, 0x59, 0x47, 0x29, 0xfc, 0xaf, 0xdc, 0x77, 0xfe, 0x3f, 0x00,
+	0x00, 0x9c, 0xb5, 0x05, 0x60, 0x2c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1853,6 +2306,16 @@ type MsgClient interface {
 	UpdateChannelParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
+	// PruneStaleInitChannel defines a rpc handler method for MsgPruneStaleInitChannel.
+	PruneStaleInitChannel(ctx context.Context, in *MsgPruneStaleInitChannel, opts ...grpc.CallOption) (*MsgPruneStaleInitChannelResponse, error)
+	// AdvanceReceiptWatermark defines a rpc handler method for MsgAdvanceReceiptWatermark.
+	AdvanceReceiptWatermark(ctx context.Context, in *MsgAdvanceReceiptWatermark, opts ...grpc.CallOption) (*MsgAdvanceReceiptWatermarkResponse, error)
+	// EnableCommitmentWatermark defines a rpc handler method for MsgEnableCommitmentWatermark.
+	EnableCommitmentWatermark(ctx context.Context, in *MsgEnableCommitmentWatermark, opts ...grpc.CallOption) (*MsgEnableCommitmentWatermarkResponse, error)
+	// ReleasePort defines a rpc handler method for MsgReleasePort.
+	ReleasePort(ctx context.Context, in *MsgReleasePort, opts ...grpc.CallOption) (*MsgReleasePortResponse, error)
+	// RebindPort defines a rpc handler method for MsgRebindPort.
+	RebindPort(ctx context.Context, in *MsgRebindPort, opts ...grpc.CallOption) (*MsgRebindPortResponse, error)
 }
 
 type msgClient struct {
@@ -2034,33 +2497,78 @@ func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAckno
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
-	ChannelOpenInit(context.Context, *MsgChannelOpenInit) (*MsgChannelOpenInitResponse, error)
-	// ChannelOpenTry defines a rpc handler method for MsgChannelOpenTry.
-	ChannelOpenTry(context.Context, *MsgChannelOpenTry) (*MsgChannelOpenTryResponse, error)
-	// ChannelOpenAck defines a rpc handler method for MsgChannelOpenAck.
-	ChannelOpenAck(context.Context, *MsgChannelOpenAck) (*MsgChannelOpenAckResponse, error)
-	// ChannelOpenConfirm defines a rpc handler method for MsgChannelOpenConfirm.
-	ChannelOpenConfirm(context.Context, *MsgChannelOpenConfirm) (*MsgChannelOpenConfirmResponse, error)
-	// ChannelCloseInit defines a rpc handler method for MsgChannelCloseInit.
-	ChannelCloseInit(context.Context, *MsgChannelCloseInit) (*MsgChannelCloseInitResponse, error)
-	// ChannelCloseConfirm defines a rpc handler method for
-	// MsgChannelCloseConfirm.
-	ChannelCloseConfirm(context.Context, *MsgChannelCloseConfirm) (*MsgChannelCloseConfirmResponse, error)
-	// RecvPacket defines a rpc handler method for MsgRecvPacket.
-	RecvPacket(context.Context, *MsgRecvPacket) (*MsgRecvPacketResponse, error)
-	// Timeout defines a rpc handler method for MsgTimeout.
-	Timeout(context.Context, *MsgTimeout) (*MsgTimeoutResponse, error)
-	// TimeoutOnClose defines a rpc handler method for MsgTimeoutOnClose.
-	TimeoutOnClose(context.Context, *MsgTimeoutOnClose) (*MsgTimeoutOnCloseResponse, error)
-	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
-	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
-	// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
-	ChannelUpgradeInit(context.Context, *MsgChannelUpgradeInit) (*MsgChannelUpgradeInitResponse, error)
-	// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
-	ChannelUpgradeTry(context.Context, *MsgChannelUpgradeTry) (*MsgChannelUpgradeTryResponse, error)
+func (c *msgClient) PruneStaleInitChannel(ctx context.Context, in *MsgPruneStaleInitChannel, opts ...grpc.CallOption) (*MsgPruneStaleInitChannelResponse, error) {
+	out := new(MsgPruneStaleInitChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/PruneStaleInitChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AdvanceReceiptWatermark(ctx context.Context, in *MsgAdvanceReceiptWatermark, opts ...grpc.CallOption) (*MsgAdvanceReceiptWatermarkResponse, error) {
+	out := new(MsgAdvanceReceiptWatermarkResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/AdvanceReceiptWatermark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableCommitmentWatermark(ctx context.Context, in *MsgEnableCommitmentWatermark, opts ...grpc.CallOption) (*MsgEnableCommitmentWatermarkResponse, error) {
+	out := new(MsgEnableCommitmentWatermarkResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/EnableCommitmentWatermark", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleasePort(ctx context.Context, in *MsgReleasePort, opts ...grpc.CallOption) (*MsgReleasePortResponse, error) {
+	out := new(MsgReleasePortResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/ReleasePort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RebindPort(ctx context.Context, in *MsgRebindPort, opts ...grpc.CallOption) (*MsgRebindPortResponse, error) {
+	out := new(MsgRebindPortResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/RebindPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
+	ChannelOpenInit(context.Context, *MsgChannelOpenInit) (*MsgChannelOpenInitResponse, error)
+	// ChannelOpenTry defines a rpc handler method for MsgChannelOpenTry.
+	ChannelOpenTry(context.Context, *MsgChannelOpenTry) (*MsgChannelOpenTryResponse, error)
+	// ChannelOpenAck defines a rpc handler method for MsgChannelOpenAck.
+	ChannelOpenAck(context.Context, *MsgChannelOpenAck) (*MsgChannelOpenAckResponse, error)
+	// ChannelOpenConfirm defines a rpc handler method for MsgChannelOpenConfirm.
+	ChannelOpenConfirm(context.Context, *MsgChannelOpenConfirm) (*MsgChannelOpenConfirmResponse, error)
+	// ChannelCloseInit defines a rpc handler method for MsgChannelCloseInit.
+	ChannelCloseInit(context.Context, *MsgChannelCloseInit) (*MsgChannelCloseInitResponse, error)
+	// ChannelCloseConfirm defines a rpc handler method for
+	// MsgChannelCloseConfirm.
+	ChannelCloseConfirm(context.Context, *MsgChannelCloseConfirm) (*MsgChannelCloseConfirmResponse, error)
+	// RecvPacket defines a rpc handler method for MsgRecvPacket.
+	RecvPacket(context.Context, *MsgRecvPacket) (*MsgRecvPacketResponse, error)
+	// Timeout defines a rpc handler method for MsgTimeout.
+	Timeout(context.Context, *MsgTimeout) (*MsgTimeoutResponse, error)
+	// TimeoutOnClose defines a rpc handler method for MsgTimeoutOnClose.
+	TimeoutOnClose(context.Context, *MsgTimeoutOnClose) (*MsgTimeoutOnCloseResponse, error)
+	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
+	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
+	// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
+	ChannelUpgradeInit(context.Context, *MsgChannelUpgradeInit) (*MsgChannelUpgradeInitResponse, error)
+	// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
+	ChannelUpgradeTry(context.Context, *MsgChannelUpgradeTry) (*MsgChannelUpgradeTryResponse, error)
 	// ChannelUpgradeAck defines a rpc handler method for MsgChannelUpgradeAck.
 	ChannelUpgradeAck(context.Context, *MsgChannelUpgradeAck) (*MsgChannelUpgradeAckResponse, error)
 	// ChannelUpgradeConfirm defines a rpc handler method for MsgChannelUpgradeConfirm.
@@ -2075,6 +2583,16 @@ type MsgServer interface {
 	UpdateChannelParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
+	// PruneStaleInitChannel defines a rpc handler method for MsgPruneStaleInitChannel.
+	PruneStaleInitChannel(context.Context, *MsgPruneStaleInitChannel) (*MsgPruneStaleInitChannelResponse, error)
+	// AdvanceReceiptWatermark defines a rpc handler method for MsgAdvanceReceiptWatermark.
+	AdvanceReceiptWatermark(context.Context, *MsgAdvanceReceiptWatermark) (*MsgAdvanceReceiptWatermarkResponse, error)
+	// EnableCommitmentWatermark defines a rpc handler method for MsgEnableCommitmentWatermark.
+	EnableCommitmentWatermark(context.Context, *MsgEnableCommitmentWatermark) (*MsgEnableCommitmentWatermarkResponse, error)
+	// ReleasePort defines a rpc handler method for MsgReleasePort.
+	ReleasePort(context.Context, *MsgReleasePort) (*MsgReleasePortResponse, error)
+	// RebindPort defines a rpc handler method for MsgRebindPort.
+	RebindPort(context.Context, *MsgRebindPort) (*MsgRebindPortResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2138,6 +2656,21 @@ func (*UnimplementedMsgServer) UpdateChannelParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
 }
+func (*UnimplementedMsgServer) PruneStaleInitChannel(ctx context.Context, req *MsgPruneStaleInitChannel) (*MsgPruneStaleInitChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneStaleInitChannel not implemented")
+}
+func (*UnimplementedMsgServer) AdvanceReceiptWatermark(ctx context.Context, req *MsgAdvanceReceiptWatermark) (*MsgAdvanceReceiptWatermarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceReceiptWatermark not implemented")
+}
+func (*UnimplementedMsgServer) EnableCommitmentWatermark(ctx context.Context, req *MsgEnableCommitmentWatermark) (*MsgEnableCommitmentWatermarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableCommitmentWatermark not implemented")
+}
+func (*UnimplementedMsgServer) ReleasePort(ctx context.Context, req *MsgReleasePort) (*MsgReleasePortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePort not implemented")
+}
+func (*UnimplementedMsgServer) RebindPort(ctx context.Context, req *MsgRebindPort) (*MsgRebindPortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebindPort not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2485,6 +3018,96 @@ func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneStaleInitChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneStaleInitChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneStaleInitChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/PruneStaleInitChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneStaleInitChannel(ctx, req.(*MsgPruneStaleInitChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdvanceReceiptWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdvanceReceiptWatermark)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdvanceReceiptWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/AdvanceReceiptWatermark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdvanceReceiptWatermark(ctx, req.(*MsgAdvanceReceiptWatermark))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableCommitmentWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableCommitmentWatermark)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableCommitmentWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/EnableCommitmentWatermark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableCommitmentWatermark(ctx, req.(*MsgEnableCommitmentWatermark))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleasePort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleasePort)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleasePort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ReleasePort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleasePort(ctx, req.(*MsgReleasePort))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RebindPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebindPort)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebindPort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/RebindPort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebindPort(ctx, req.(*MsgRebindPort))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2565,6 +3188,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAcknowledgements",
 			Handler:    _Msg_PruneAcknowledgements_Handler,
 		},
+		{
+			MethodName: "PruneStaleInitChannel",
+			Handler:    _Msg_PruneStaleInitChannel_Handler,
+		},
+		{
+			MethodName: "AdvanceReceiptWatermark",
+			Handler:    _Msg_AdvanceReceiptWatermark_Handler,
+		},
+		{
+			MethodName: "EnableCommitmentWatermark",
+			Handler:    _Msg_EnableCommitmentWatermark_Handler,
+		},
+		{
+			MethodName: "ReleasePort",
+			Handler:    _Msg_ReleasePort_Handler,
+		},
+		{
+			MethodName: "RebindPort",
+			Handler:    _Msg_RebindPort_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/tx.proto",
@@ -3184,6 +3827,13 @@ func (m *MsgRecvPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Result != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Result))
 		i--
@@ -4347,265 +4997,393 @@ func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPruneStaleInitChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgChannelOpenInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPruneStaleInitChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneStaleInitChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneStaleInitChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneStaleInitChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneStaleInitChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAdvanceReceiptWatermark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceReceiptWatermark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceReceiptWatermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PreviousChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CounterpartyVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofWatermark) > 0 {
+		i -= len(m.ProofWatermark)
+		copy(dAtA[i:], m.ProofWatermark)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofWatermark)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *MsgChannelOpenTryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CounterpartyWatermark != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyWatermark))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAdvanceReceiptWatermarkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceReceiptWatermarkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceReceiptWatermarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CounterpartyChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CounterpartyVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofTry)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ReceiptWatermark != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceiptWatermark))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenAckResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEnableCommitmentWatermark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChannelOpenConfirm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgEnableCommitmentWatermark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableCommitmentWatermark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ProofAck)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEnableCommitmentWatermarkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableCommitmentWatermarkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableCommitmentWatermarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.CommitmentWatermark != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CommitmentWatermark))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelCloseInit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleasePort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleasePort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleasePort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelCloseInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleasePortResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleasePortResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleasePortResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelCloseConfirm) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRebindPort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebindPort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebindPort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CounterpartyUpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelCloseConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRebindPortResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebindPortResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebindPortResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecvPacket) Size() (n int) {
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgChannelOpenInit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofCommitment)
+	l = len(m.PortId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.ProofHeight.Size()
+	l = m.Channel.Size()
 	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Signer)
 	if l > 0 {
@@ -4614,35 +5392,49 @@ func (m *MsgRecvPacket) Size() (n int) {
 	return n
 }
 
-func (m *MsgRecvPacketResponse) Size() (n int) {
+func (m *MsgChannelOpenInitResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgTimeout) Size() (n int) {
+func (m *MsgChannelOpenTry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Packet.Size()
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Channel.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofUnreceived)
+	l = len(m.CounterpartyVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofInit)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
-	if m.NextSequenceRecv != 0 {
-		n += 1 + sovTx(uint64(m.NextSequenceRecv))
-	}
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -4650,74 +5442,82 @@ func (m *MsgTimeout) Size() (n int) {
 	return n
 }
 
-func (m *MsgTimeoutResponse) Size() (n int) {
+func (m *MsgChannelOpenTryResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgTimeoutOnClose) Size() (n int) {
+func (m *MsgChannelOpenAck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofUnreceived)
+	l = len(m.PortId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ProofClose)
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofTry)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
-	if m.NextSequenceRecv != 0 {
-		n += 1 + sovTx(uint64(m.NextSequenceRecv))
-	}
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.CounterpartyUpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
-	}
 	return n
 }
 
-func (m *MsgTimeoutOnCloseResponse) Size() (n int) {
+func (m *MsgChannelOpenAckResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
 	return n
 }
 
-func (m *MsgAcknowledgement) Size() (n int) {
+func (m *MsgChannelOpenConfirm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Acknowledgement)
+	l = len(m.PortId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ProofAcked)
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofAck)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -4730,19 +5530,16 @@ func (m *MsgAcknowledgement) Size() (n int) {
 	return n
 }
 
-func (m *MsgAcknowledgementResponse) Size() (n int) {
+func (m *MsgChannelOpenConfirmResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
 	return n
 }
 
-func (m *MsgChannelUpgradeInit) Size() (n int) {
+func (m *MsgChannelCloseInit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4756,8 +5553,6 @@ func (m *MsgChannelUpgradeInit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Fields.Size()
-	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -4765,21 +5560,16 @@ func (m *MsgChannelUpgradeInit) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeInitResponse) Size() (n int) {
+func (m *MsgChannelCloseInitResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Upgrade.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.UpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.UpgradeSequence))
-	}
 	return n
 }
 
-func (m *MsgChannelUpgradeTry) Size() (n int) {
+func (m *MsgChannelCloseConfirm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4793,22 +5583,7 @@ func (m *MsgChannelUpgradeTry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.ProposedUpgradeConnectionHops) > 0 {
-		for _, s := range m.ProposedUpgradeConnectionHops {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = m.CounterpartyUpgradeFields.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.CounterpartyUpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
-	}
-	l = len(m.ProofChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofUpgrade)
+	l = len(m.ProofInit)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -4818,47 +5593,30 @@ func (m *MsgChannelUpgradeTry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
 	return n
 }
 
-func (m *MsgChannelUpgradeTryResponse) Size() (n int) {
+func (m *MsgChannelCloseConfirmResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Upgrade.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.UpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.UpgradeSequence))
-	}
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
 	return n
 }
 
-func (m *MsgChannelUpgradeAck) Size() (n int) {
+func (m *MsgRecvPacket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.CounterpartyUpgrade.Size()
+	l = m.Packet.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofUpgrade)
+	l = len(m.ProofCommitment)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -4871,7 +5629,7 @@ func (m *MsgChannelUpgradeAck) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeAckResponse) Size() (n int) {
+func (m *MsgRecvPacketResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4880,28 +5638,289 @@ func (m *MsgChannelUpgradeAckResponse) Size() (n int) {
 	if m.Result != 0 {
 		n += 1 + sovTx(uint64(m.Result))
 	}
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgChannelUpgradeConfirm) Size() (n int) {
+func (m *MsgTimeout) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PortId)
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ChannelId)
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.CounterpartyChannelState != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyChannelState))
-	}
-	l = m.CounterpartyUpgrade.Size()
-	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgTimeoutOnClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClose)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgTimeoutOnCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofAcked)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ProposedUpgradeConnectionHops) > 0 {
+		for _, s := range m.ProposedUpgradeConnectionHops {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.CounterpartyUpgradeFields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
+	l = len(m.ProofChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpgrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyUpgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpgrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CounterpartyChannelState != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyChannelState))
+	}
+	l = m.CounterpartyUpgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = len(m.ProofChannel)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -5112,7 +6131,171 @@ func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgPruneStaleInitChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneStaleInitChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdvanceReceiptWatermark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CounterpartyWatermark != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyWatermark))
+	}
+	l = len(m.ProofWatermark)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAdvanceReceiptWatermarkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceiptWatermark != 0 {
+		n += 1 + sovTx(uint64(m.ReceiptWatermark))
+	}
+	return n
+}
+
+func (m *MsgEnableCommitmentWatermark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableCommitmentWatermarkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitmentWatermark != 0 {
+		n += 1 + sovTx(uint64(m.CommitmentWatermark))
+	}
+	return n
+}
+
+func (m *MsgReleasePort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleasePortResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRebindPort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRebindPortResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
@@ -5149,7 +6332,692 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenTryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5177,13 +7045,13 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5193,28 +7061,27 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5242,63 +7109,47 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofTry == nil {
+				m.ProofTry = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenInitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5308,27 +7159,28 @@ func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5356,7 +7208,7 @@ func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5379,7 +7231,7 @@ func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5402,47 +7254,65 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelOpenAckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5470,44 +7340,11 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5535,11 +7372,11 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5566,12 +7403,12 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
+			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAck == nil {
+				m.ProofAck = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -5604,7 +7441,7 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -5657,7 +7494,7 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5680,15 +7517,65 @@ func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenTryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelCloseInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelCloseInit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5716,7 +7603,7 @@ func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5750,6 +7637,38 @@ func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5771,7 +7690,7 @@ func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5794,10 +7713,60 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelCloseInitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelCloseInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5814,27 +7783,126 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5862,13 +7930,13 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
 			}
-			var stringLen uint64
+			m.CounterpartyUpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5878,29 +7946,116 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5910,27 +8065,28 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5957,12 +8113,12 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofTry == nil {
-				m.ProofTry = []byte{}
+			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitment == nil {
+				m.ProofCommitment = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -5995,7 +8151,7 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -6048,7 +8204,7 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6071,12 +8227,65 @@ func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6098,7 +8307,7 @@ func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6121,49 +8330,17 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6173,27 +8350,28 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6220,12 +8398,12 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAck == nil {
-				m.ProofAck = []byte{}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -6258,6 +8436,25 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -6311,7 +8508,7 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6334,12 +8531,31 @@ func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6361,7 +8577,7 @@ func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6384,17 +8600,17 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutOnClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutOnClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6404,29 +8620,30 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6436,25 +8653,113 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClose", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClose = append(m.ProofClose[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClose == nil {
+				m.ProofClose = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -6486,6 +8791,25 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			}
+			m.CounterpartyUpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6507,7 +8831,7 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6530,12 +8854,31 @@ func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseInitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6557,7 +8900,7 @@ func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6580,17 +8923,17 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6600,29 +8943,30 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6632,27 +8976,29 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6679,9 +9025,9 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
+			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAcked == nil {
+				m.ProofAcked = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
@@ -6749,25 +9095,6 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
-			}
-			m.CounterpartyUpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6789,7 +9116,7 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6812,12 +9139,31 @@ func (m *MsgChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6839,7 +9185,7 @@ func (m *MsgChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6862,17 +9208,17 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeInit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeInit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6882,30 +9228,29 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6915,29 +9260,27 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofCommitment == nil {
-				m.ProofCommitment = []byte{}
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6964,7 +9307,7 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7021,7 +9364,7 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeInitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7044,17 +9387,50 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			m.Result = 0
+			m.UpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7064,7 +9440,7 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7090,7 +9466,7 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7100,28 +9476,124 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelUpgradeTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelUpgradeTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedUpgradeConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedUpgradeConnectionHops = append(m.ProposedUpgradeConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7148,13 +9620,32 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyUpgradeFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			}
+			m.CounterpartyUpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7181,16 +9672,16 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUnreceived == nil {
-				m.ProofUnreceived = []byte{}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7200,30 +9691,31 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			m.NextSequenceRecv = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7233,12 +9725,26 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -7291,7 +9797,7 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7314,13 +9820,65 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
@@ -7360,7 +9918,7 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7383,15 +9941,79 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutOnClose: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutOnClose: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7418,13 +10040,13 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7451,14 +10073,14 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUnreceived == nil {
-				m.ProofUnreceived = []byte{}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7485,12 +10107,12 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofClose = append(m.ProofClose[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClose == nil {
-				m.ProofClose = []byte{}
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -7523,26 +10145,7 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
-			}
-			m.NextSequenceRecv = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextSequenceRecv |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -7574,25 +10177,6 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
-			}
-			m.CounterpartyUpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7614,7 +10198,7 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7637,10 +10221,10 @@ func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7683,7 +10267,7 @@ func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7706,15 +10290,98 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
+			}
+			m.CounterpartyChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyChannelState |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7741,13 +10408,13 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7774,14 +10441,14 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
-			if m.Acknowledgement == nil {
-				m.Acknowledgement = []byte{}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7808,12 +10475,12 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAcked == nil {
-				m.ProofAcked = []byte{}
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -7846,7 +10513,7 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -7899,7 +10566,7 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7922,10 +10589,10 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7968,7 +10635,7 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7991,10 +10658,10 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeOpen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeOpen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8061,9 +10728,81 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
+			}
+			m.CounterpartyChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyChannelState |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			}
+			m.CounterpartyUpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8090,11 +10829,11 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -8147,7 +10886,7 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeInitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8170,64 +10909,12 @@ func (m *MsgChannelUpgradeInitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
-			}
-			m.UpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8249,7 +10936,7 @@ func (m *MsgChannelUpgradeInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8272,10 +10959,10 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8344,39 +11031,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedUpgradeConnectionHops", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposedUpgradeConnectionHops = append(m.ProposedUpgradeConnectionHops, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8403,30 +11058,11 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CounterpartyUpgradeFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
-			}
-			m.CounterpartyUpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
@@ -8460,41 +11096,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgrade == nil {
-				m.ProofUpgrade = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -8527,7 +11129,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -8580,7 +11182,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8593,93 +11195,22 @@ func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
-			}
-			m.UpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8701,7 +11232,7 @@ func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8724,10 +11255,10 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8796,7 +11327,7 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8823,13 +11354,13 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofErrorReceipt", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8856,46 +11387,12 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
+			m.ProofErrorReceipt = append(m.ProofErrorReceipt[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofErrorReceipt == nil {
+				m.ProofErrorReceipt = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgrade == nil {
-				m.ProofUpgrade = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -8928,7 +11425,7 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -8981,7 +11478,7 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9004,31 +11501,12 @@ func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9050,7 +11528,7 @@ func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9073,15 +11551,15 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9109,13 +11587,13 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9125,81 +11603,130 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CounterpartyChannelState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyChannelState |= State(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9209,31 +11736,29 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9243,31 +11768,29 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgrade == nil {
-				m.ProofUpgrade = []byte{}
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9277,26 +11800,12 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -9349,7 +11858,7 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9372,17 +11881,17 @@ func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrunedSequences", wireType)
 			}
-			m.Result = 0
+			m.TotalPrunedSequences = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9392,7 +11901,26 @@ func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
+				m.TotalPrunedSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingSequences", wireType)
+			}
+			m.TotalRemainingSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRemainingSequences |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9418,7 +11946,7 @@ func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneStaleInitChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9441,10 +11969,10 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneStaleInitChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneStaleInitChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9512,111 +12040,6 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
-			}
-			m.CounterpartyChannelState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyChannelState |= State(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
-			}
-			m.CounterpartyUpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -9669,7 +12092,7 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneStaleInitChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9692,10 +12115,10 @@ func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneStaleInitChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneStaleInitChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9719,7 +12142,7 @@ func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
+func (m *MsgAdvanceReceiptWatermark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9742,10 +12165,10 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAdvanceReceiptWatermark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAdvanceReceiptWatermark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9813,10 +12236,29 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyWatermark", wireType)
+			}
+			m.CounterpartyWatermark = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyWatermark |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofWatermark", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9826,30 +12268,31 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CounterpartyChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofWatermark = append(m.ProofWatermark[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofWatermark == nil {
+				m.ProofWatermark = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9859,31 +12302,30 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9893,26 +12335,12 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -9965,7 +12393,7 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAdvanceReceiptWatermarkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9988,12 +12416,31 @@ func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAdvanceReceiptWatermarkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAdvanceReceiptWatermarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptWatermark", wireType)
+			}
+			m.ReceiptWatermark = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptWatermark |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10015,7 +12462,7 @@ func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCommitmentWatermark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10038,10 +12485,10 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCommitmentWatermark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCommitmentWatermark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10110,107 +12557,7 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofErrorReceipt", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofErrorReceipt = append(m.ProofErrorReceipt[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofErrorReceipt == nil {
-				m.ProofErrorReceipt = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10238,7 +12585,7 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10261,7 +12608,7 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCommitmentWatermarkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10284,12 +12631,31 @@ func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCommitmentWatermarkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCommitmentWatermarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentWatermark", wireType)
+			}
+			m.CommitmentWatermark = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentWatermark |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10311,7 +12677,7 @@ func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgReleasePort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10334,15 +12700,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleasePort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleasePort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10370,13 +12736,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10386,24 +12752,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10426,7 +12791,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleasePortResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10449,10 +12814,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleasePortResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleasePortResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10476,7 +12841,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
+func (m *MsgRebindPort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10499,10 +12864,10 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgements: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebindPort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebindPort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10539,7 +12904,7 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10567,30 +12932,11 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10618,7 +12964,7 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10641,7 +12987,7 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRebindPortResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10664,50 +13010,12 @@ func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebindPortResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebindPortResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrunedSequences", wireType)
-			}
-			m.TotalPrunedSequences = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPrunedSequences |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingSequences", wireType)
-			}
-			m.TotalRemainingSequences = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalRemainingSequences |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
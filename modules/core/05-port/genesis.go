@@ -0,0 +1,34 @@
+package port
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/05-port/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+// InitGenesis initializes the ibc port submodule's state from a provided genesis
+// state. It panics if a port was rebound to a module route which is not registered in the router.
+func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
+	for _, portID := range gs.ReleasedPorts {
+		k.SetPortReleased(ctx, portID)
+	}
+
+	for _, route := range gs.PortRoutes {
+		if _, found := k.Route(route.Module); !found {
+			panic(fmt.Errorf("route not found to module %s for port %s", route.Module, route.PortId))
+		}
+
+		k.SetPortRoute(ctx, route.PortId, route.Module)
+	}
+}
+
+// ExportGenesis returns the ibc port submodule's exported genesis.
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
+	return types.GenesisState{
+		ReleasedPorts: k.GetAllReleasedPorts(ctx),
+		PortRoutes:    k.GetAllPortRoutes(ctx),
+	}
+}
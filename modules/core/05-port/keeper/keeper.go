@@ -2,12 +2,19 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/internal/logging"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -17,12 +24,16 @@ import (
 type Keeper struct {
 	Router *types.Router
 
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
 	scopedKeeper exported.ScopedKeeper
 }
 
 // NewKeeper creates a new IBC connection Keeper instance
-func NewKeeper(sck exported.ScopedKeeper) *Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sck exported.ScopedKeeper) *Keeper {
 	return &Keeper{
+		cdc:          cdc,
+		storeKey:     key,
 		scopedKeeper: sck,
 	}
 }
@@ -72,16 +83,156 @@ func (k *Keeper) Authenticate(ctx sdk.Context, key *capabilitytypes.Capability,
 	return k.scopedKeeper.AuthenticateCapability(ctx, key, host.PortPath(portID))
 }
 
-// LookupModuleByPort will return the IBCModule along with the capability associated with a given portID
+// LookupModuleByPort will return the IBCModule along with the capability associated with a given portID.
+// The module route a port was rebound to takes precedence over the module owning the port capability, and
+// an error is returned if the port is released.
 func (k *Keeper) LookupModuleByPort(ctx sdk.Context, portID string) (string, *capabilitytypes.Capability, error) {
 	modules, capability, err := k.scopedKeeper.LookupModules(ctx, host.PortPath(portID))
 	if err != nil {
 		return "", nil, err
 	}
 
+	if k.IsReleased(ctx, portID) {
+		return "", nil, errorsmod.Wrapf(types.ErrPortReleased, "port ID (%s)", portID)
+	}
+
+	if module, found := k.GetPortRoute(ctx, portID); found {
+		return module, capability, nil
+	}
+
 	return types.GetModuleOwner(modules), capability, nil
 }
 
+// IsReleased returns true if the given port was released and has not been rebound since.
+func (k *Keeper) IsReleased(ctx sdk.Context, portID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.PortReleasedKey(portID))
+}
+
+// GetPortRoute returns the module route the given port was rebound to, and a boolean indicating whether
+// the port was rebound.
+func (k *Keeper) GetPortRoute(ctx sdk.Context, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PortRouteKey(portID))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetPortReleased marks the given port as released, removing the module route it was rebound to, if any.
+func (k *Keeper) SetPortReleased(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PortReleasedKey(portID), []byte{byte(1)})
+	store.Delete(host.PortRouteKey(portID))
+}
+
+// SetPortRoute stores the module route the given port was rebound to and clears its released flag.
+func (k *Keeper) SetPortRoute(ctx sdk.Context, portID, module string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PortReleasedKey(portID))
+	store.Set(host.PortRouteKey(portID), []byte(module))
+}
+
+// GetAllReleasedPorts returns the identifiers of all ports which were released and have not been rebound since.
+func (k *Keeper) GetAllReleasedPorts(ctx sdk.Context) []string {
+	var portIDs []string
+	k.iteratePortKeys(ctx, host.KeyPortReleased, func(portID string, _ []byte) {
+		portIDs = append(portIDs, portID)
+	})
+
+	return portIDs
+}
+
+// GetAllPortRoutes returns the module routes of all ports which were rebound.
+func (k *Keeper) GetAllPortRoutes(ctx sdk.Context) []types.PortRoute {
+	var routes []types.PortRoute
+	k.iteratePortKeys(ctx, host.KeyPortRoute, func(portID string, value []byte) {
+		routes = append(routes, types.NewPortRoute(portID, string(value)))
+	})
+
+	return routes
+}
+
+// iteratePortKeys iterates over the keys of the port store with the given suffix and performs a callback
+// function with the port identifier and the stored value. Port identifiers cannot contain a slash, so the
+// port identifier is the key between the port prefix and the suffix.
+func (k *Keeper) iteratePortKeys(ctx sdk.Context, suffix string, cb func(portID string, value []byte)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyPortPrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		portID, keySuffix, found := strings.Cut(string(iterator.Key()), "/")
+		if !found || keySuffix != suffix {
+			continue
+		}
+
+		cb(portID, iterator.Value())
+	}
+}
+
+// ReleasePort releases a bound port so that it can be rebound to a different module route using RebindPort.
+// A port can only be released if it is not referenced by any channel which is not CLOSED. Channel handshakes
+// cannot be initiated on a released port. The port capability is kept, so the port remains bound.
+func (k *Keeper) ReleasePort(ctx sdk.Context, portID string) error {
+	if !k.IsBound(ctx, portID) {
+		return errorsmod.Wrapf(types.ErrPortNotFound, "port ID (%s)", portID)
+	}
+
+	if k.IsReleased(ctx, portID) {
+		return errorsmod.Wrapf(types.ErrPortReleased, "port ID (%s)", portID)
+	}
+
+	if channelID, found := k.getUnclosedChannel(ctx, portID); found {
+		return errorsmod.Wrapf(types.ErrPortInUse, "port ID (%s), channel ID (%s)", portID, channelID)
+	}
+
+	k.SetPortReleased(ctx, portID)
+
+	k.Logger(ctx).Info("port released", logging.KeyPortID, portID)
+	return nil
+}
+
+// RebindPort rebinds a released port to the given module route, which must be registered in the router.
+// Channel handshakes initiated on the port after it is rebound are routed to the given module.
+func (k *Keeper) RebindPort(ctx sdk.Context, portID, module string) error {
+	if !k.IsReleased(ctx, portID) {
+		return errorsmod.Wrapf(types.ErrPortNotReleased, "port ID (%s)", portID)
+	}
+
+	if k.Router == nil || !k.Router.HasRoute(module) {
+		return errorsmod.Wrapf(types.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	k.SetPortRoute(ctx, portID, module)
+
+	k.Logger(ctx).Info("port rebound", logging.KeyPortID, portID, "module", module)
+	return nil
+}
+
+// getUnclosedChannel returns the identifier of a channel on the given port which is not CLOSED, and a
+// boolean indicating whether such a channel was found.
+func (k *Keeper) getUnclosedChannel(ctx sdk.Context, portID string) (string, bool) {
+	channelsPrefix := []byte(fmt.Sprintf("%s/%s/%s/", host.KeyChannelEndPrefix, host.PortPath(portID), host.KeyChannelPrefix))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), channelsPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var channel channeltypes.Channel
+		k.cdc.MustUnmarshal(iterator.Value(), &channel)
+
+		if channel.State != channeltypes.CLOSED {
+			return string(iterator.Key()), true
+		}
+	}
+
+	return "", false
+}
+
 // Route returns a IBCModule for a given module, and a boolean indicating
 // whether or not the route is present.
 func (k *Keeper) Route(clientID string) (types.IBCModule, bool) {
@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/05-port/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
 	"github.com/cosmos/ibc-go/v8/testing/simapp"
 )
 
@@ -74,3 +76,44 @@ func (suite *KeeperTestSuite) TestAuthenticate() {
 	auth = suite.keeper.Authenticate(suite.ctx, capKey2, validPort)
 	require.False(suite.T(), auth, "invalid authentication for different capKey failed")
 }
+
+func (suite *KeeperTestSuite) TestReleaseAndRebindPort() {
+	// releasing a port which is not bound fails
+	err := suite.keeper.ReleasePort(suite.ctx, validPort)
+	suite.Require().ErrorIs(err, types.ErrPortNotFound)
+
+	capKey := suite.keeper.BindPort(suite.ctx, validPort)
+
+	// rebinding a port which is not released fails
+	err = suite.keeper.RebindPort(suite.ctx, validPort, mock.ModuleName)
+	suite.Require().ErrorIs(err, types.ErrPortNotReleased)
+
+	err = suite.keeper.ReleasePort(suite.ctx, validPort)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.IsReleased(suite.ctx, validPort))
+	suite.Require().True(suite.keeper.IsBound(suite.ctx, validPort))
+
+	_, _, err = suite.keeper.LookupModuleByPort(suite.ctx, validPort)
+	suite.Require().ErrorIs(err, types.ErrPortReleased)
+
+	// releasing a released port fails
+	err = suite.keeper.ReleasePort(suite.ctx, validPort)
+	suite.Require().ErrorIs(err, types.ErrPortReleased)
+
+	// rebinding to a module without a route fails
+	err = suite.keeper.RebindPort(suite.ctx, validPort, "nonexistent")
+	suite.Require().ErrorIs(err, types.ErrInvalidRoute)
+
+	err = suite.keeper.RebindPort(suite.ctx, validPort, mock.ModuleName)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsReleased(suite.ctx, validPort))
+
+	module, portCap, err := suite.keeper.LookupModuleByPort(suite.ctx, validPort)
+	suite.Require().NoError(err)
+	suite.Require().Equal(mock.ModuleName, module)
+	suite.Require().Equal(capKey, portCap)
+
+	route, found := suite.keeper.GetPortRoute(suite.ctx, validPort)
+	suite.Require().True(found)
+	suite.Require().Equal(mock.ModuleName, route)
+}
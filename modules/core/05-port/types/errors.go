@@ -6,8 +6,11 @@ import (
 
 // IBC port sentinel errors
 var (
-	ErrPortExists   = errorsmod.Register(SubModuleName, 2, "port is already binded")
-	ErrPortNotFound = errorsmod.Register(SubModuleName, 3, "port not found")
-	ErrInvalidPort  = errorsmod.Register(SubModuleName, 4, "invalid port")
-	ErrInvalidRoute = errorsmod.Register(SubModuleName, 5, "route not found")
+	ErrPortExists      = errorsmod.Register(SubModuleName, 2, "port is already binded")
+	ErrPortNotFound    = errorsmod.Register(SubModuleName, 3, "port not found")
+	ErrInvalidPort     = errorsmod.Register(SubModuleName, 4, "invalid port")
+	ErrInvalidRoute    = errorsmod.Register(SubModuleName, 5, "route not found")
+	ErrPortReleased    = errorsmod.Register(SubModuleName, 6, "port is released")
+	ErrPortNotReleased = errorsmod.Register(SubModuleName, 7, "port is not released")
+	ErrPortInUse       = errorsmod.Register(SubModuleName, 8, "port is referenced by channels which are not closed")
 )
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewPortRoute creates a PortRoute instance.
+func NewPortRoute(portID, module string) PortRoute {
+	return PortRoute{
+		PortId: portID,
+		Module: module,
+	}
+}
+
+// NewGenesisState creates a GenesisState instance.
+func NewGenesisState(releasedPorts []string, portRoutes []PortRoute) GenesisState {
+	return GenesisState{
+		ReleasedPorts: releasedPorts,
+		PortRoutes:    portRoutes,
+	}
+}
+
+// DefaultGenesisState returns the ibc port submodule's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		ReleasedPorts: []string{},
+		PortRoutes:    []PortRoute{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. A port can either be released or rebound to a module route, but not both.
+func (gs GenesisState) Validate() error {
+	ports := make(map[string]bool)
+
+	for i, portID := range gs.ReleasedPorts {
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			return fmt.Errorf("invalid released port %d: %w", i, err)
+		}
+
+		if ports[portID] {
+			return fmt.Errorf("duplicate released port %s", portID)
+		}
+
+		ports[portID] = true
+	}
+
+	for i, route := range gs.PortRoutes {
+		if err := host.PortIdentifierValidator(route.PortId); err != nil {
+			return fmt.Errorf("invalid port route %d: %w", i, err)
+		}
+
+		if strings.TrimSpace(route.Module) == "" {
+			return fmt.Errorf("invalid port route %d: module route cannot be blank", i)
+		}
+
+		if ports[route.PortId] {
+			return fmt.Errorf("port %s cannot be both released and rebound, or rebound more than once", route.PortId)
+		}
+
+		ports[route.PortId] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			name: "valid genesis",
+			genState: types.NewGenesisState(
+				[]string{ibctesting.MockPort},
+				[]types.PortRoute{types.NewPortRoute(ibctesting.MockFeePort, mock.MockBlockUpgrade)},
+			),
+			expPass: true,
+		},
+		{
+			name: "invalid released port",
+			genState: types.NewGenesisState(
+				[]string{"(portID)"},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			name: "duplicate released port",
+			genState: types.NewGenesisState(
+				[]string{ibctesting.MockPort, ibctesting.MockPort},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			name: "invalid port route port",
+			genState: types.NewGenesisState(
+				nil,
+				[]types.PortRoute{types.NewPortRoute("(portID)", mock.MockBlockUpgrade)},
+			),
+			expPass: false,
+		},
+		{
+			name: "blank port route module",
+			genState: types.NewGenesisState(
+				nil,
+				[]types.PortRoute{types.NewPortRoute(ibctesting.MockFeePort, " ")},
+			),
+			expPass: false,
+		},
+		{
+			name: "duplicate port route",
+			genState: types.NewGenesisState(
+				nil,
+				[]types.PortRoute{
+					types.NewPortRoute(ibctesting.MockFeePort, mock.MockBlockUpgrade),
+					types.NewPortRoute(ibctesting.MockFeePort, mock.ModuleName),
+				},
+			),
+			expPass: false,
+		},
+		{
+			name: "port both released and rebound",
+			genState: types.NewGenesisState(
+				[]string{ibctesting.MockFeePort},
+				[]types.PortRoute{types.NewPortRoute(ibctesting.MockFeePort, mock.MockBlockUpgrade)},
+			),
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var (
+	_ Middleware            = (*BaseMiddleware)(nil)
+	_ UpgradableModule      = (*BaseMiddleware)(nil)
+	_ PacketDataUnmarshaler = (*BaseMiddleware)(nil)
+)
+
+// BaseMiddleware implements the Middleware interface by passing through all IBCModule callbacks
+// to the underlying application and all ICS4Wrapper calls to the underlying ICS4Wrapper.
+// Middleware implementations may embed BaseMiddleware and only override the callbacks
+// and ICS4Wrapper methods they wish to extend.
+type BaseMiddleware struct {
+	app         IBCModule
+	ics4Wrapper ICS4Wrapper
+}
+
+// NewBaseMiddleware creates a new BaseMiddleware given the underlying application and ICS4Wrapper.
+func NewBaseMiddleware(app IBCModule, ics4Wrapper ICS4Wrapper) BaseMiddleware {
+	return BaseMiddleware{
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+// GetApp returns the underlying application.
+func (im BaseMiddleware) GetApp() IBCModule {
+	return im.app
+}
+
+// GetICS4Wrapper returns the underlying ICS4Wrapper.
+func (im BaseMiddleware) GetICS4Wrapper() ICS4Wrapper {
+	return im.ics4Wrapper
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im BaseMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im BaseMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im BaseMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im BaseMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im BaseMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im BaseMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im BaseMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im BaseMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im BaseMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface.
+func (im BaseMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) (string, error) {
+	cbs, ok := im.app.(UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface.
+func (im BaseMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID, channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	cbs, ok := im.app.(UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface.
+func (im BaseMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, ok := im.app.(UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface.
+func (im BaseMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedOrder channeltypes.Order,
+	proposedConnectionHops []string,
+	proposedVersion string,
+) {
+	cbs, ok := im.app.(UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// SendPacket implements the ICS4Wrapper interface.
+func (im BaseMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (im BaseMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (im BaseMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// UnmarshalPacketData attempts to use the underlying app to unmarshal the packet data.
+// If the underlying app does not support the PacketDataUnmarshaler interface, an error is returned.
+func (im BaseMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	unmarshaler, ok := im.app.(PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidRoute, "underlying app does not implement %T", (*PacketDataUnmarshaler)(nil))
+	}
+
+	return unmarshaler.UnmarshalPacketData(bz)
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+type MiddlewareTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) TestBaseMiddlewarePassthrough() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	ibcApp := &mock.IBCApp{
+		OnChanOpenInit: func(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID, channelID string, chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version string) (string, error) {
+			return "passthrough-version", nil
+		},
+	}
+	mockModule := mock.NewIBCModule(&mock.AppModule{}, ibcApp)
+
+	middleware := porttypes.NewBaseMiddleware(mockModule, channelKeeper)
+	suite.Require().Equal(mockModule, middleware.GetApp())
+	suite.Require().Equal(channelKeeper, middleware.GetICS4Wrapper())
+
+	version, err := middleware.OnChanOpenInit(ctx, channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID}, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nil, channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), "")
+	suite.Require().NoError(err)
+	suite.Require().Equal("passthrough-version", version)
+
+	appVersion, found := middleware.GetAppVersion(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.Version, appVersion)
+
+	packetData, err := middleware.UnmarshalPacketData(mock.MockPacketData)
+	suite.Require().NoError(err)
+	suite.Require().Equal(mock.MockPacketData, packetData)
+}
+
+func (suite *MiddlewareTestSuite) TestBaseMiddlewareUnsupportedApp() {
+	ctx := suite.chainA.GetContext()
+
+	// BlockUpgradeMiddleware implements neither the UpgradableModule nor the PacketDataUnmarshaler interface
+	app := mock.NewBlockUpgradeMiddleware(&mock.AppModule{}, &mock.IBCApp{})
+	middleware := porttypes.NewBaseMiddleware(app, suite.chainA.App.GetIBCKeeper().ChannelKeeper)
+
+	_, err := middleware.OnChanUpgradeInit(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, channeltypes.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version)
+	suite.Require().ErrorIs(err, porttypes.ErrInvalidRoute)
+
+	_, err = middleware.OnChanUpgradeTry(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, channeltypes.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version)
+	suite.Require().ErrorIs(err, porttypes.ErrInvalidRoute)
+
+	err = middleware.OnChanUpgradeAck(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, mock.Version)
+	suite.Require().ErrorIs(err, porttypes.ErrInvalidRoute)
+
+	suite.Require().Panics(func() {
+		middleware.OnChanUpgradeOpen(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, channeltypes.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version)
+	})
+
+	_, err = middleware.UnmarshalPacketData(mock.MockPacketData)
+	suite.Require().ErrorIs(err, porttypes.ErrInvalidRoute)
+}
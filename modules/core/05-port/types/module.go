@@ -196,3 +196,18 @@ type PacketDataUnmarshaler interface {
 	// UnmarshalPacketData unmarshals the packet data into a concrete type
 	UnmarshalPacketData([]byte) (interface{}, error)
 }
+
+// RecvPacketFailureHandler defines an optional interface which allows an IBC application to persist state
+// when receiving a packet results in an unsuccessful acknowledgement. The state changes made by OnRecvPacket
+// are discarded by core IBC in that case, whereas the state changes made by OnRecvPacketFailure are committed.
+// Middlewares should forward the callback to the underlying application if it implements the interface.
+type RecvPacketFailureHandler interface {
+	// OnRecvPacketFailure is called with the unsuccessful acknowledgement written for the packet and the gas
+	// consumed by the OnRecvPacket callback whose state changes were discarded.
+	OnRecvPacketFailure(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		gasUsed uint64,
+	)
+}
@@ -19,3 +19,19 @@ func ChannelUpgradeKey(portID, channelID string) []byte {
 func ChannelCounterpartyUpgradeKey(portID, channelID string) []byte {
 	return []byte(ChannelCounterpartyUpgradePath(portID, channelID))
 }
+
+// ChannelInitTimestampKey returns the store key for the block time at which a particular channel was initialized.
+func ChannelInitTimestampKey(portID, channelID string) []byte {
+	return []byte(ChannelInitTimestampPath(portID, channelID))
+}
+
+// ChannelClosureReasonKey returns the store key for the reason for which a particular channel was closed.
+func ChannelClosureReasonKey(portID, channelID string) []byte {
+	return []byte(ChannelClosureReasonPath(portID, channelID))
+}
+
+// ChannelCounterpartyKey returns the store key for the identifier of the channel bound to a port and connection
+// which was opened with a particular counterparty channel end.
+func ChannelCounterpartyKey(portID, connectionID, counterpartyPortID, counterpartyChannelID string) []byte {
+	return []byte(ChannelCounterpartyPath(portID, connectionID, counterpartyPortID, counterpartyChannelID))
+}
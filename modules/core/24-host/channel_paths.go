@@ -10,6 +10,9 @@ const (
 	KeyUpgradeErrorPrefix      = "upgradeError"
 	KeyCounterpartyUpgrade     = "counterpartyUpgrade"
 	KeyChannelCapabilityPrefix = "capabilities"
+	KeyChannelInitTimestamp    = "channelInitTimestamp"
+	KeyChannelClosureReason    = "channelClosureReason"
+	KeyChannelCounterparty     = "channelCounterparties"
 )
 
 // ICS04
@@ -41,6 +44,22 @@ func ChannelCounterpartyUpgradePath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID))
 }
 
+// ChannelInitTimestampPath defines the path under which the block time at which a channel was initialized is stored.
+func ChannelInitTimestampPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelInitTimestamp, channelPath(portID, channelID))
+}
+
+// ChannelClosureReasonPath defines the path under which the reason for which a channel was closed is stored.
+func ChannelClosureReasonPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelClosureReason, channelPath(portID, channelID))
+}
+
+// ChannelCounterpartyPath defines the path under which the identifier of the channel bound to a port and connection
+// which was opened with a counterparty channel end is stored.
+func ChannelCounterpartyPath(portID, connectionID, counterpartyPortID, counterpartyChannelID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", KeyChannelCounterparty, KeyPortPrefix, portID, connectionID, counterpartyPortID, counterpartyChannelID)
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
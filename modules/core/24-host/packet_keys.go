@@ -45,3 +45,13 @@ func PruningSequenceStartKey(portID, channelID string) []byte {
 func RecvStartSequenceKey(portID, channelID string) []byte {
 	return []byte(RecvStartSequencePath(portID, channelID))
 }
+
+// CommitmentWatermarkKey returns the store key for the commitment watermark of a particular channel
+func CommitmentWatermarkKey(portID, channelID string) []byte {
+	return []byte(CommitmentWatermarkPath(portID, channelID))
+}
+
+// ReceiptWatermarkKey returns the store key for the receipt watermark of a particular channel
+func ReceiptWatermarkKey(portID, channelID string) []byte {
+	return []byte(ReceiptWatermarkPath(portID, channelID))
+}
@@ -12,6 +12,8 @@ const (
 	KeyPacketReceiptPrefix    = "receipts"
 	KeyPruningSequenceStart   = "pruningSequenceStart"
 	KeyRecvStartSequence      = "recvStartSequence"
+	KeyCommitmentWatermark    = "commitmentWatermark"
+	KeyReceiptWatermark       = "receiptWatermark"
 )
 
 // ICS04
@@ -67,6 +69,16 @@ func RecvStartSequencePath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyRecvStartSequence, channelPath(portID, channelID))
 }
 
+// CommitmentWatermarkPath defines the path under which the commitment watermark is stored
+func CommitmentWatermarkPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyCommitmentWatermark, channelPath(portID, channelID))
+}
+
+// ReceiptWatermarkPath defines the path under which the receipt watermark is stored
+func ReceiptWatermarkPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyReceiptWatermark, channelPath(portID, channelID))
+}
+
 func sequencePath(sequence uint64) string {
 	return fmt.Sprintf("%s/%d", KeySequencePrefix, sequence)
 }
@@ -3,7 +3,9 @@ package host
 import "fmt"
 
 const (
-	KeyPortPrefix = "ports"
+	KeyPortPrefix   = "ports"
+	KeyPortReleased = "released"
+	KeyPortRoute    = "route"
 )
 
 // ICS05
@@ -13,3 +15,13 @@ const (
 func PortPath(portID string) string {
 	return fmt.Sprintf("%s/%s", KeyPortPrefix, portID)
 }
+
+// PortReleasedKey returns the store key under which the flag marking a port as released is stored
+func PortReleasedKey(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", PortPath(portID), KeyPortReleased))
+}
+
+// PortRouteKey returns the store key under which the module route a port was rebound to is stored
+func PortRouteKey(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", PortPath(portID), KeyPortRoute))
+}
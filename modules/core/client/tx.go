@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+const (
+	// txInclusionTimeout is the maximum duration to wait for a broadcasted transaction to be included in a block.
+	txInclusionTimeout = time.Minute
+	// pollInterval is the interval at which a node is polled while waiting for a transaction or a block height.
+	pollInterval = time.Second
+)
+
+// NewCounterpartyContext returns a copy of the client context which queries and broadcasts transactions to the
+// counterparty node at the given CometBFT RPC address. The chain ID of the returned context is the network of the
+// counterparty node.
+func NewCounterpartyContext(ctx context.Context, clientCtx client.Context, counterpartyNode string) (client.Context, error) {
+	rpcClient, err := client.NewClientFromNode(counterpartyNode)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	status, err := rpcClient.Status(ctx)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	return clientCtx.
+		WithNodeURI(counterpartyNode).
+		WithClient(rpcClient).
+		WithChainID(status.NodeInfo.Network).
+		WithHeight(0), nil
+}
+
+// QueryLatestHeight returns the latest block height of the node of the client context.
+func QueryLatestHeight(ctx context.Context, clientCtx client.Context) (int64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// WaitForHeight blocks until the node of the client context has committed a block at a height greater than
+// or equal to the given height.
+func WaitForHeight(ctx context.Context, clientCtx client.Context, height int64) error {
+	for {
+		latestHeight, err := QueryLatestHeight(ctx, clientCtx)
+		if err != nil {
+			return err
+		}
+
+		if latestHeight >= height {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// QueryProofHeight waits until the state written by a transaction included at the given height can be proven
+// and returns the latest height of the node of the client context, at which proofs should be queried.
+func QueryProofHeight(ctx context.Context, clientCtx client.Context, txHeight int64) (int64, error) {
+	// the app hash of the state written at the transaction height is included in the header of the next block
+	if err := WaitForHeight(ctx, clientCtx, txHeight+1); err != nil {
+		return 0, err
+	}
+
+	return QueryLatestHeight(ctx, clientCtx)
+}
+
+// BroadcastTxAndWait signs the messages with the key of the client context, broadcasts the transaction to the node of
+// the client context and waits for it to be included in a block. Unlike tx.BroadcastTx, the transaction is broadcasted
+// without asking for confirmation. The account number and sequence of the signer are always queried from the node, so
+// that the same factory can be used to send consecutive transactions, possibly to different chains.
+// An error is returned if the transaction fails in CheckTx or DeliverTx, or if it is not included in a block in time.
+func BroadcastTxAndWait(ctx context.Context, clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if clientCtx.GenerateOnly || clientCtx.Offline || clientCtx.Simulate {
+		return nil, errors.New("transactions must be broadcasted online")
+	}
+
+	for _, msg := range msgs {
+		m, ok := msg.(sdk.HasValidateBasic)
+		if !ok {
+			continue
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	txf, err := txf.WithChainID(clientCtx.ChainID).WithAccountNumber(0).WithSequence(0).Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Sign(ctx, txf, clientCtx.FromName, txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Code != 0 {
+		return nil, fmt.Errorf("transaction %s failed in CheckTx on %s with code %d: %s", res.TxHash, clientCtx.ChainID, res.Code, res.RawLog)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, txInclusionTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s was not included in a block on %s: %w", res.TxHash, clientCtx.ChainID, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+
+		// the transaction is not found until it has been included in a block
+		txRes, err := authtx.QueryTx(clientCtx, res.TxHash)
+		if err != nil {
+			continue
+		}
+
+		if txRes.Code != 0 {
+			return nil, fmt.Errorf("transaction %s failed on %s with code %d: %s", txRes.TxHash, clientCtx.ChainID, txRes.Code, txRes.RawLog)
+		}
+
+		return txRes, nil
+	}
+}
+
+// ParseAttributeFromEvents returns the value of the first attribute with the given key of the first event of the given type.
+func ParseAttributeFromEvents(events []abci.Event, eventType, attributeKey string) (string, error) {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == attributeKey {
+				return attr.Value, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("attribute %s not found in events of type %s", attributeKey, eventType)
+}
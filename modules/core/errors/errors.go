@@ -60,4 +60,15 @@ var (
 
 	// ErrNotFound defines an error when requested entity doesn't exist in the state.
 	ErrNotFound = errorsmod.Register(codespace, 16, "not found")
+
+	// ErrConsensusVersionDowngrade defines an error when the consensus version recorded in the store for an IBC
+	// submodule is greater than the consensus version of the submodule in the running binary.
+	ErrConsensusVersionDowngrade = errorsmod.Register(codespace, 17, "consensus version downgrade")
+
+	// ErrMissingMigration defines an error when the consensus version recorded in the store for an IBC submodule
+	// is lower than the consensus version of the submodule in the running binary.
+	ErrMissingMigration = errorsmod.Register(codespace, 18, "missing store migration")
+
+	// ErrMsgDisabled defines an error when a message has been disabled by the circuit breaker.
+	ErrMsgDisabled = errorsmod.Register(codespace, 19, "message disabled by circuit breaker")
 )
@@ -1,6 +1,7 @@
 package exported
 
 import (
+	"github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 
 	storetypes "cosmossdk.io/store/types"
@@ -21,12 +22,18 @@ const (
 	// Tendermint is used to indicate that the client uses the Tendermint Consensus Algorithm.
 	Tendermint string = "07-tendermint"
 
+	// Wasm is the client type for light clients implemented as CosmWasm contracts.
+	Wasm string = "08-wasm"
+
 	// Localhost is the client type for the localhost client.
 	Localhost string = "09-localhost"
 
 	// LocalhostClientID is the sentinel client ID for the localhost client.
 	LocalhostClientID string = Localhost
 
+	// Ethereum is used to indicate that the client uses the Ethereum beacon chain sync committee light client protocol.
+	Ethereum string = "xx-ethereum"
+
 	// Active is a status type of a client. An active client is allowed to be used.
 	Active Status = "Active"
 
@@ -140,6 +147,44 @@ type LightClientModule interface {
 	) error
 }
 
+// LightClientModuleQueryService is an optional interface which may be implemented by light client modules
+// to expose queries specific to the light client type. Core IBC registers the query service of every light
+// client module added to the 02-client router. Query services should be defined under the
+// ibc.lightclients.<type> proto package.
+type LightClientModuleQueryService interface {
+	// RegisterQueryService registers the light client module gRPC query service on the provided server.
+	RegisterQueryService(server grpc.Server)
+}
+
+// MisbehaviourEvidenceClassifier is an optional interface which may be implemented by light client modules
+// to describe the kind of evidence which caused CheckForMisbehaviour to return true. Core IBC includes the
+// evidence type in the client frozen event emitted by UpdateClient. It is called before UpdateStateOnMisbehaviour
+// and assumes the ClientMessage has already been verified.
+type MisbehaviourEvidenceClassifier interface {
+	// MisbehaviourEvidenceType returns the type of misbehaviour evidence contained in the provided ClientMessage.
+	MisbehaviourEvidenceType(ctx sdk.Context, clientID string, clientMsg ClientMessage) string
+}
+
+// DuplicateClientMessageDetector is an optional interface which may be implemented by light client modules
+// to report client messages whose application would be a no-op, such as headers for which an identical consensus
+// state is already stored. It is called before VerifyClientMessage and core IBC skips the client update if it returns
+// true, refunding the percentage of the gas consumed defined by the DuplicateUpdateGasRefundPercentage parameter,
+// thus it must only return true if the ClientMessage cannot change the client, even if it fails verification or
+// contains misbehaviour.
+type DuplicateClientMessageDetector interface {
+	// IsDuplicateClientMessage returns true if updating the client with the provided ClientMessage would be a no-op.
+	IsDuplicateClientMessage(ctx sdk.Context, clientID string, clientMsg ClientMessage) bool
+}
+
+// SubstituteMismatchReporter is an optional interface which may be implemented by light client modules
+// to report which client state fields prevent a substitute client from being used to recover a subject client.
+// Core IBC includes the reported fields in the response of the SimulateClientRecovery query.
+type SubstituteMismatchReporter interface {
+	// MismatchedSubstituteFields returns the names of the client state fields which differ between the subject and substitute
+	// client and are required to match for the recovery to succeed. An empty list is returned if the client states are compatible.
+	MismatchedSubstituteFields(ctx sdk.Context, subjectClientID, substituteClientID string) ([]string, error)
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
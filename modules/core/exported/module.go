@@ -5,6 +5,8 @@ const (
 	ModuleName = "ibc"
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
+	// TransientStoreKey is the string transient store representation
+	TransientStoreKey = "transient_" + ModuleName
 	// QuerierRoute is the querier route for the IBC module
 	QuerierRoute = ModuleName
 	// RouterKey is the msg router key for the IBC module
@@ -50,3 +50,32 @@ type PacketDataProvider interface {
 	// If no custom packet data exists for the key, nil should be returned.
 	GetCustomPacketData(key string) interface{}
 }
+
+// RefundType describes how an application refunds the sender of a packet which timed out or was acknowledged
+// with an error acknowledgement.
+type RefundType string
+
+const (
+	// RefundTypeUnescrow denotes a refund which releases the tokens escrowed by the sending chain back to the sender.
+	RefundTypeUnescrow RefundType = "unescrow"
+	// RefundTypeMint denotes a refund which mints the vouchers burned by the sending chain back to the sender.
+	RefundTypeMint RefundType = "mint"
+)
+
+// RefundPacketData defines an optional interface which an application's packet data structure may implement
+// if the application refunds the packet sender when the packet times out or is acknowledged with an error
+// acknowledgement. Middlewares such as the callbacks middleware use it to notify the packet sender of the refund.
+type RefundPacketData interface {
+	// GetRefundType returns how the packet sender is refunded by the application bound to the provided source port and channel.
+	GetRefundType(sourcePortID, sourceChannelID string) RefundType
+}
+
+// AdditionalPacketDataProvider defines an optional interface which an application's packet data structure may implement
+// if the acknowledgement written by the counterparty application carries results which are meaningful to the packet sender.
+// Middlewares such as the callbacks middleware use it to provide the decoded results to the packet sender.
+type AdditionalPacketDataProvider interface {
+	// GetAdditionalPacketData returns the application specific data decoded from the provided acknowledgement.
+	// A nil acknowledgement is provided for packets which timed out.
+	// If no additional data can be decoded, nil should be returned.
+	GetAdditionalPacketData(acknowledgement []byte) interface{}
+}
@@ -6,6 +6,7 @@ import (
 	client "github.com/cosmos/ibc-go/v8/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v8/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v8/modules/core/04-channel"
+	port "github.com/cosmos/ibc-go/v8/modules/core/05-port"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/types"
 )
@@ -16,6 +17,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 	client.InitGenesis(ctx, k.ClientKeeper, gs.ClientGenesis)
 	connection.InitGenesis(ctx, k.ConnectionKeeper, gs.ConnectionGenesis)
 	channel.InitGenesis(ctx, k.ChannelKeeper, gs.ChannelGenesis)
+	port.InitGenesis(ctx, k.PortKeeper, gs.PortGenesis)
+
+	k.SetConsensusVersions(ctx)
 }
 
 // ExportGenesis returns the ibc exported genesis.
@@ -24,5 +28,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		ClientGenesis:     client.ExportGenesis(ctx, k.ClientKeeper),
 		ConnectionGenesis: connection.ExportGenesis(ctx, k.ConnectionKeeper),
 		ChannelGenesis:    channel.ExportGenesis(ctx, k.ChannelKeeper),
+		PortGenesis:       port.ExportGenesis(ctx, k.PortKeeper),
 	}
 }
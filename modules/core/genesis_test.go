@@ -12,11 +12,13 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/modules/core/types"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 	"github.com/cosmos/ibc-go/v8/testing/simapp"
 )
 
@@ -204,6 +206,16 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid port genesis",
+			genState: &types.GenesisState{
+				ClientGenesis:     clienttypes.DefaultGenesisState(),
+				ConnectionGenesis: connectiontypes.DefaultGenesisState(),
+				ChannelGenesis:    channeltypes.DefaultGenesisState(),
+				PortGenesis:       porttypes.NewGenesisState([]string{"(portID)"}, nil),
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -331,6 +343,12 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 				// create extra clients
 				ibctesting.NewPath(suite.chainA, suite.chainB).SetupClients()
 				ibctesting.NewPath(suite.chainA, suite.chainB).SetupClients()
+
+				// release a port and rebind another one
+				portKeeper := suite.chainA.App.GetIBCKeeper().PortKeeper
+				suite.Require().NoError(portKeeper.ReleasePort(suite.chainA.GetContext(), ibctesting.TransferPort))
+				suite.Require().NoError(portKeeper.ReleasePort(suite.chainA.GetContext(), ibctesting.MockFeePort))
+				suite.Require().NoError(portKeeper.RebindPort(suite.chainA.GetContext(), ibctesting.MockFeePort, ibcmock.MockBlockUpgrade))
 			},
 		},
 	}
@@ -353,6 +371,38 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 				ibc.InitGenesis(suite.chainA.GetContext(), *suite.chainA.App.GetIBCKeeper(), gs)
 			})
 
+			// the client creators are imported into a new chain
+			suite.Require().Len(gs.ClientGenesis.ClientCreators, 3)
+
+			app := simapp.Setup(suite.T(), false)
+			ctx := app.BaseApp.NewContext(false)
+			ibc.InitGenesis(ctx, *app.IBCKeeper, gs)
+
+			for _, clientCreator := range gs.ClientGenesis.ClientCreators {
+				suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), app.IBCKeeper.ClientKeeper.GetClientCreator(ctx, clientCreator.ClientId))
+			}
+
+			// the released ports and port routes are imported into a new chain
+			suite.Require().Equal([]string{ibctesting.TransferPort}, gs.PortGenesis.ReleasedPorts)
+			suite.Require().Equal([]porttypes.PortRoute{porttypes.NewPortRoute(ibctesting.MockFeePort, ibcmock.MockBlockUpgrade)}, gs.PortGenesis.PortRoutes)
+			suite.Require().True(app.IBCKeeper.PortKeeper.IsReleased(ctx, ibctesting.TransferPort))
+
+			module, found := app.IBCKeeper.PortKeeper.GetPortRoute(ctx, ibctesting.MockFeePort)
+			suite.Require().True(found)
+			suite.Require().Equal(ibcmock.MockBlockUpgrade, module)
+
+			// the client creation metadata is imported into a new chain
+			suite.Require().Len(gs.ClientGenesis.ClientsCreationMetadata, 3)
+
+			for _, clientMetadata := range gs.ClientGenesis.ClientsCreationMetadata {
+				expMetadata, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreationMetadata(suite.chainA.GetContext(), clientMetadata.ClientId)
+				suite.Require().True(found)
+
+				metadata, found := app.IBCKeeper.ClientKeeper.GetClientCreationMetadata(ctx, clientMetadata.ClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(expMetadata, metadata)
+			}
+
 			suite.NotPanics(func() {
 				cdc := codec.NewProtoCodec(suite.chainA.GetSimApp().InterfaceRegistry())
 				genState := cdc.MustMarshalJSON(gs)
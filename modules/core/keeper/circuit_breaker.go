@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+)
+
+// assertMsgAllowed returns an error if the circuit breaker disabled the type of the message, either globally
+// or for any prefix of the identifiers the message applies to. It is a no-op if no circuit breaker is set.
+func (k *Keeper) assertMsgAllowed(ctx sdk.Context, msg sdk.Msg) error {
+	if k.circuitBreaker == nil {
+		return nil
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	identifiers := circuitBreakerIdentifiers(msg)
+
+	for i := 0; i <= len(identifiers); i++ {
+		key := types.CircuitBreakerKey(msgTypeURL, identifiers[:i]...)
+
+		allowed, err := k.circuitBreaker.IsAllowed(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		if !allowed {
+			return errorsmod.Wrapf(ibcerrors.ErrMsgDisabled, "%s is disabled by circuit breaker key %s", msgTypeURL, key)
+		}
+	}
+
+	return nil
+}
+
+// circuitBreakerIdentifiers returns the identifiers, from the least to the most specific, for which the message can
+// be disabled: the client identifier for client messages, the client or connection identifier for connection
+// handshake messages and the local port and channel identifiers for channel and packet messages.
+func circuitBreakerIdentifiers(msg sdk.Msg) []string {
+	switch msg := msg.(type) {
+	case *clienttypes.MsgUpdateClient:
+		return []string{msg.ClientId}
+	case *clienttypes.MsgUpgradeClient:
+		return []string{msg.ClientId}
+	case *clienttypes.MsgSubmitMisbehaviour: //nolint:staticcheck // MsgSubmitMisbehaviour is still handled by the msg server
+		return []string{msg.ClientId}
+	case *clienttypes.MsgDeleteClient:
+		return []string{msg.ClientId}
+	case *clienttypes.MsgSubmitBondedMisbehaviour:
+		return []string{msg.ClientId}
+	case *connectiontypes.MsgConnectionOpenInit:
+		return []string{msg.ClientId}
+	case *connectiontypes.MsgConnectionOpenTry:
+		return []string{msg.ClientId}
+	case *connectiontypes.MsgConnectionOpenAck:
+		return []string{msg.ConnectionId}
+	case *connectiontypes.MsgConnectionOpenConfirm:
+		return []string{msg.ConnectionId}
+	case *channeltypes.MsgChannelOpenInit:
+		return []string{msg.PortId}
+	case *channeltypes.MsgChannelOpenTry:
+		return []string{msg.PortId}
+	case *channeltypes.MsgChannelOpenAck:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelOpenConfirm:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelCloseInit:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelCloseConfirm:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgRecvPacket:
+		return []string{msg.Packet.DestinationPort, msg.Packet.DestinationChannel}
+	case *channeltypes.MsgTimeout:
+		return []string{msg.Packet.SourcePort, msg.Packet.SourceChannel}
+	case *channeltypes.MsgTimeoutOnClose:
+		return []string{msg.Packet.SourcePort, msg.Packet.SourceChannel}
+	case *channeltypes.MsgAcknowledgement:
+		return []string{msg.Packet.SourcePort, msg.Packet.SourceChannel}
+	case *channeltypes.MsgChannelUpgradeInit:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelUpgradeTry:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelUpgradeAck:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelUpgradeConfirm:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelUpgradeOpen:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelUpgradeTimeout:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgChannelUpgradeCancel:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgPruneAcknowledgements:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgPruneStaleInitChannel:
+		return []string{msg.PortId, msg.ChannelId}
+	case *channeltypes.MsgAdvanceReceiptWatermark:
+		return []string{msg.PortId, msg.ChannelId}
+	default:
+		return nil
+	}
+}
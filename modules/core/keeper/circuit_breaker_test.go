@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var _ types.CircuitBreaker = (*mockCircuitBreaker)(nil)
+
+// mockCircuitBreaker disables the messages matching the keys of its disabled list.
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (m mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !m.disabled[typeURL], nil
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreaker() {
+	var (
+		path     *ibctesting.Path
+		disabled map[string]bool
+	)
+
+	recvPacketTypeURL := sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{})
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no message disabled",
+			func() {},
+			nil,
+		},
+		{
+			"success: message disabled for another channel",
+			func() {
+				disabled[types.CircuitBreakerKey(recvPacketTypeURL, path.EndpointB.ChannelConfig.PortID, "channel-100")] = true
+			},
+			nil,
+		},
+		{
+			"success: another message type disabled",
+			func() {
+				disabled[sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{})] = true
+			},
+			nil,
+		},
+		{
+			"failure: message type disabled globally",
+			func() {
+				disabled[recvPacketTypeURL] = true
+			},
+			ibcerrors.ErrMsgDisabled,
+		},
+		{
+			"failure: message type disabled for port",
+			func() {
+				disabled[types.CircuitBreakerKey(recvPacketTypeURL, path.EndpointB.ChannelConfig.PortID)] = true
+			},
+			ibcerrors.ErrMsgDisabled,
+		},
+		{
+			"failure: message type disabled for channel",
+			func() {
+				disabled[types.CircuitBreakerKey(recvPacketTypeURL, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)] = true
+			},
+			ibcerrors.ErrMsgDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			disabled = make(map[string]bool)
+			suite.chainB.App.GetIBCKeeper().SetCircuitBreaker(mockCircuitBreaker{disabled: disabled})
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			tc.malleate()
+
+			proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			_, err = suite.chainB.App.GetIBCKeeper().RecvPacket(suite.chainB.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				// the packet receipt must not be written when the message is disabled
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().False(found)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
+)
+
+// emitAppCallbackPanicEvent emits an event signalling that the named application callback
+// panicked while processing the given packet.
+func emitAppCallbackPanicEvent(ctx sdk.Context, callback string, packet channeltypes.Packet) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			coretypes.EventTypeAppCallbackPanic,
+			sdk.NewAttribute(coretypes.AttributeKeyCallback, callback),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, channeltypes.AttributeValueCategory),
+		),
+	})
+}
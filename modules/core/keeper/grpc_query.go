@@ -3,9 +3,15 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
 // ClientState implements the IBC QueryServer interface
@@ -33,6 +39,11 @@ func (k *Keeper) ConsensusStateHeights(c context.Context, req *clienttypes.Query
 	return k.ClientKeeper.ConsensusStateHeights(c, req)
 }
 
+// ConsensusStateStoreSize implements the IBC QueryServer interface
+func (k *Keeper) ConsensusStateStoreSize(c context.Context, req *clienttypes.QueryConsensusStateStoreSizeRequest) (*clienttypes.QueryConsensusStateStoreSizeResponse, error) {
+	return k.ClientKeeper.ConsensusStateStoreSize(c, req)
+}
+
 // ClientStatus implements the IBC QueryServer interface
 func (k *Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
 	return k.ClientKeeper.ClientStatus(c, req)
@@ -58,6 +69,21 @@ func (k *Keeper) VerifyMembership(c context.Context, req *clienttypes.QueryVerif
 	return k.ClientKeeper.VerifyMembership(c, req)
 }
 
+// ClientsByChainID implements the IBC QueryServer interface
+func (k *Keeper) ClientsByChainID(c context.Context, req *clienttypes.QueryClientsByChainIDRequest) (*clienttypes.QueryClientsByChainIDResponse, error) {
+	return k.ClientKeeper.ClientsByChainID(c, req)
+}
+
+// SimulateClientRecovery implements the IBC QueryServer interface
+func (k *Keeper) SimulateClientRecovery(c context.Context, req *clienttypes.QuerySimulateClientRecoveryRequest) (*clienttypes.QuerySimulateClientRecoveryResponse, error) {
+	return k.ClientKeeper.SimulateClientRecovery(c, req)
+}
+
+// LaggingClients implements the IBC QueryServer interface
+func (k *Keeper) LaggingClients(c context.Context, req *clienttypes.QueryLaggingClientsRequest) (*clienttypes.QueryLaggingClientsResponse, error) {
+	return k.ClientKeeper.LaggingClients(c, req)
+}
+
 // Connection implements the IBC QueryServer interface
 func (k *Keeper) Connection(c context.Context, req *connectiontypes.QueryConnectionRequest) (*connectiontypes.QueryConnectionResponse, error) {
 	return k.ConnectionKeeper.Connection(c, req)
@@ -68,6 +94,11 @@ func (k *Keeper) Connections(c context.Context, req *connectiontypes.QueryConnec
 	return k.ConnectionKeeper.Connections(c, req)
 }
 
+// ConnectionsByCounterpartyClient implements the IBC QueryServer interface
+func (k *Keeper) ConnectionsByCounterpartyClient(c context.Context, req *connectiontypes.QueryConnectionsByCounterpartyClientRequest) (*connectiontypes.QueryConnectionsByCounterpartyClientResponse, error) {
+	return k.ConnectionKeeper.ConnectionsByCounterpartyClient(c, req)
+}
+
 // ClientConnections implements the IBC QueryServer interface
 func (k *Keeper) ClientConnections(c context.Context, req *connectiontypes.QueryClientConnectionsRequest) (*connectiontypes.QueryClientConnectionsResponse, error) {
 	return k.ConnectionKeeper.ClientConnections(c, req)
@@ -128,6 +159,11 @@ func (k *Keeper) PacketReceipt(c context.Context, req *channeltypes.QueryPacketR
 	return k.ChannelKeeper.PacketReceipt(c, req)
 }
 
+// PacketReceipts implements the IBC QueryServer interface
+func (k *Keeper) PacketReceipts(c context.Context, req *channeltypes.QueryPacketReceiptsRequest) (*channeltypes.QueryPacketReceiptsResponse, error) {
+	return k.ChannelKeeper.PacketReceipts(c, req)
+}
+
 // PacketAcknowledgement implements the IBC QueryServer interface
 func (k *Keeper) PacketAcknowledgement(c context.Context, req *channeltypes.QueryPacketAcknowledgementRequest) (*channeltypes.QueryPacketAcknowledgementResponse, error) {
 	return k.ChannelKeeper.PacketAcknowledgement(c, req)
@@ -168,7 +204,74 @@ func (k *Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeReques
 	return k.ChannelKeeper.Upgrade(c, req)
 }
 
+// UpgradeSequence implements the IBC QueryServer interface
+func (k *Keeper) UpgradeSequence(c context.Context, req *channeltypes.QueryUpgradeSequenceRequest) (*channeltypes.QueryUpgradeSequenceResponse, error) {
+	return k.ChannelKeeper.UpgradeSequence(c, req)
+}
+
+// ChannelCounterpartyChainID implements the IBC QueryServer interface
+func (k *Keeper) ChannelCounterpartyChainID(c context.Context, req *channeltypes.QueryChannelCounterpartyChainIDRequest) (*channeltypes.QueryChannelCounterpartyChainIDResponse, error) {
+	return k.ChannelKeeper.ChannelCounterpartyChainID(c, req)
+}
+
+// ChannelUpgradeCompatibility implements the IBC QueryServer interface
+func (k *Keeper) ChannelUpgradeCompatibility(c context.Context, req *channeltypes.QueryChannelUpgradeCompatibilityRequest) (*channeltypes.QueryChannelUpgradeCompatibilityResponse, error) {
+	return k.ChannelKeeper.ChannelUpgradeCompatibility(c, req)
+}
+
 // ChannelParams implements the IBC QueryServer interface
 func (k *Keeper) ChannelParams(c context.Context, req *channeltypes.QueryChannelParamsRequest) (*channeltypes.QueryChannelParamsResponse, error) {
 	return k.ChannelKeeper.ChannelParams(c, req)
 }
+
+// PacketCommitmentsAtHeight implements the IBC QueryServer interface
+func (k *Keeper) PacketCommitmentsAtHeight(c context.Context, req *channeltypes.QueryPacketCommitmentsAtHeightRequest) (*channeltypes.QueryPacketCommitmentsAtHeightResponse, error) {
+	return k.ChannelKeeper.PacketCommitmentsAtHeight(c, req)
+}
+
+// VerifyPacketProof implements the IBC QueryServer interface
+func (k *Keeper) VerifyPacketProof(c context.Context, req *channeltypes.QueryVerifyPacketProofRequest) (*channeltypes.QueryVerifyPacketProofResponse, error) {
+	return k.ChannelKeeper.VerifyPacketProof(c, req)
+}
+
+// FullChannelGraph implements the IBC QueryService interface. It paginates over the channels of the chain
+// and joins each channel with the connection and client it is built upon.
+func (k *Keeper) FullChannelGraph(c context.Context, req *types.QueryFullChannelGraphRequest) (*types.QueryFullChannelGraphResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.ChannelKeeper.Channels(c, &channeltypes.QueryChannelsRequest{Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entries := make([]types.ChannelGraphEntry, 0, len(res.Channels))
+	for _, channel := range res.Channels {
+		entry := types.ChannelGraphEntry{Channel: channel}
+
+		if len(channel.ConnectionHops) > 0 {
+			connectionID := channel.ConnectionHops[0]
+			if connection, found := k.ConnectionKeeper.GetConnection(ctx, connectionID); found {
+				identifiedConnection := connectiontypes.NewIdentifiedConnection(connectionID, connection)
+				entry.Connection = &identifiedConnection
+
+				if clientState, found := k.ClientKeeper.GetClientState(ctx, connection.ClientId); found {
+					identifiedClient := clienttypes.NewIdentifiedClientState(connection.ClientId, clientState)
+					entry.Client = &identifiedClient
+					entry.ClientStatus = k.ClientKeeper.GetClientStatus(ctx, connection.ClientId).String()
+				}
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &types.QueryFullChannelGraphResponse{
+		Channels:   entries,
+		Pagination: res.Pagination,
+		Height:     res.Height,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestFullChannelGraph() {
+	var (
+		req        *types.QueryFullChannelGraphRequest
+		expEntries []types.ChannelGraphEntry
+	)
+
+	// expEntry returns the graph entry of the channel on chainA of the provided path
+	expEntry := func(path *ibctesting.Path) types.ChannelGraphEntry {
+		channel := channeltypes.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.GetChannel())
+		connection := connectiontypes.NewIdentifiedConnection(path.EndpointA.ConnectionID, path.EndpointA.GetConnection())
+		client := clienttypes.NewIdentifiedClientState(path.EndpointA.ClientID, path.EndpointA.GetClientState())
+
+		return types.ChannelGraphEntry{
+			Channel:      &channel,
+			Connection:   &connection,
+			Client:       &client,
+			ClientStatus: exported.Active.String(),
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no channels",
+			func() {
+				req = &types.QueryFullChannelGraphRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+				pathA.Setup()
+
+				// channel on the same connection and client
+				pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+				pathB.EndpointA.ConnectionID = pathA.EndpointA.ConnectionID
+				pathB.EndpointB.ConnectionID = pathA.EndpointB.ConnectionID
+				pathB.EndpointA.ClientID = pathA.EndpointA.ClientID
+				pathB.EndpointB.ClientID = pathA.EndpointB.ClientID
+				pathB.CreateChannels()
+
+				expEntries = []types.ChannelGraphEntry{expEntry(pathA), expEntry(pathB)}
+
+				req = &types.QueryFullChannelGraphRequest{}
+			},
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+				pathA.Setup()
+
+				pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+				pathB.Setup()
+
+				expEntries = []types.ChannelGraphEntry{expEntry(pathA)}
+
+				req = &types.QueryFullChannelGraphRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expEntries = []types.ChannelGraphEntry{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.App.GetIBCKeeper().FullChannelGraph(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expEntries, res.Channels)
+				suite.Require().Equal(clienttypes.GetSelfHeight(suite.chainA.GetContext()), res.Height)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
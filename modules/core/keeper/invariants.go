@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// RegisterInvariants registers all core IBC invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(exported.ModuleName, "channel-connections",
+		ChannelConnectionsInvariant(k))
+	ir.RegisterRoute(exported.ModuleName, "connection-clients",
+		ConnectionClientsInvariant(k))
+	ir.RegisterRoute(exported.ModuleName, "packet-sequences",
+		PacketSequencesInvariant(k))
+	ir.RegisterRoute(exported.ModuleName, "channel-capabilities",
+		ChannelCapabilitiesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the core IBC module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			ChannelConnectionsInvariant(k),
+			ConnectionClientsInvariant(k),
+			PacketSequencesInvariant(k),
+			ChannelCapabilitiesInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// ChannelConnectionsInvariant checks that every channel references an existing connection.
+func ChannelConnectionsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken []string
+
+		for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+			if len(channel.ConnectionHops) == 0 {
+				broken = append(broken, fmt.Sprintf("channel %s on port %s has no connection hops", channel.ChannelId, channel.PortId))
+				continue
+			}
+
+			if _, found := k.ConnectionKeeper.GetConnection(ctx, channel.ConnectionHops[0]); !found {
+				broken = append(broken, fmt.Sprintf("channel %s on port %s references connection %s which does not exist", channel.ChannelId, channel.PortId, channel.ConnectionHops[0]))
+			}
+		}
+
+		return formatInvariant("channel connections invariance", broken)
+	}
+}
+
+// ConnectionClientsInvariant checks that every connection references an existing client.
+func ConnectionClientsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken []string
+
+		for _, connection := range k.ConnectionKeeper.GetAllConnections(ctx) {
+			if _, found := k.ClientKeeper.GetClientState(ctx, connection.ClientId); !found {
+				broken = append(broken, fmt.Sprintf("connection %s references client %s which does not exist", connection.Id, connection.ClientId))
+			}
+		}
+
+		return formatInvariant("connection clients invariance", broken)
+	}
+}
+
+// PacketSequencesInvariant checks that the next sequence values stored for every channel are consistent with
+// the packet state stored for the channel: packet commitments must have been sent with a sequence lower than
+// the next send sequence, and on ORDERED channels packet receipts and acknowledgements must have been written
+// for a sequence lower than the next receive sequence.
+func PacketSequencesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken []string
+
+		channels := make(map[string]channeltypes.IdentifiedChannel)
+		for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+			channels[channelKey(channel.PortId, channel.ChannelId)] = channel
+		}
+
+		for _, commitment := range k.ChannelKeeper.GetAllPacketCommitments(ctx) {
+			nextSequenceSend, found := k.ChannelKeeper.GetNextSequenceSend(ctx, commitment.PortId, commitment.ChannelId)
+			if !found {
+				broken = append(broken, fmt.Sprintf("packet commitment for sequence %d stored for channel %s on port %s which has no next send sequence", commitment.Sequence, commitment.ChannelId, commitment.PortId))
+				continue
+			}
+
+			if commitment.Sequence >= nextSequenceSend {
+				broken = append(broken, fmt.Sprintf("packet commitment for sequence %d stored for channel %s on port %s is not lower than the next send sequence %d", commitment.Sequence, commitment.ChannelId, commitment.PortId, nextSequenceSend))
+			}
+		}
+
+		receivedPackets := append(k.ChannelKeeper.GetAllPacketReceipts(ctx), k.ChannelKeeper.GetAllPacketAcks(ctx)...)
+		for _, packetState := range receivedPackets {
+			channel, found := channels[channelKey(packetState.PortId, packetState.ChannelId)]
+			if !found || channel.Ordering != channeltypes.ORDERED {
+				continue
+			}
+
+			nextSequenceRecv, found := k.ChannelKeeper.GetNextSequenceRecv(ctx, packetState.PortId, packetState.ChannelId)
+			if !found {
+				broken = append(broken, fmt.Sprintf("packet state for sequence %d stored for ORDERED channel %s on port %s which has no next receive sequence", packetState.Sequence, packetState.ChannelId, packetState.PortId))
+				continue
+			}
+
+			if packetState.Sequence >= nextSequenceRecv {
+				broken = append(broken, fmt.Sprintf("packet state for sequence %d stored for ORDERED channel %s on port %s is not lower than the next receive sequence %d", packetState.Sequence, packetState.ChannelId, packetState.PortId, nextSequenceRecv))
+			}
+		}
+
+		return formatInvariant("packet sequences invariance", broken)
+	}
+}
+
+// ChannelCapabilitiesInvariant checks that the capability of every OPEN channel is owned by the IBC module
+// and by the module the port of the channel is routed to, among any other owners.
+func ChannelCapabilitiesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken []string
+
+		for _, channel := range k.ChannelKeeper.GetAllChannels(ctx) {
+			if channel.State != channeltypes.OPEN {
+				continue
+			}
+
+			owners, found := k.ChannelKeeper.GetChannelCapabilityOwners(ctx, channel.PortId, channel.ChannelId)
+			if !found {
+				broken = append(broken, fmt.Sprintf("no capability exists for OPEN channel %s on port %s", channel.ChannelId, channel.PortId))
+				continue
+			}
+
+			module, _, err := k.PortKeeper.LookupModuleByPort(ctx, channel.PortId)
+			if err != nil {
+				broken = append(broken, fmt.Sprintf("failed to look up module for OPEN channel %s on port %s: %v", channel.ChannelId, channel.PortId, err))
+				continue
+			}
+
+			// additional owners are allowed, e.g. the legacy authentication module of a handed over ICA controller channel
+			if !slices.Contains(owners, exported.ModuleName) || !slices.Contains(owners, module) {
+				broken = append(broken, fmt.Sprintf("capability of OPEN channel %s on port %s is owned by %v, expected owners to include %s and %s", channel.ChannelId, channel.PortId, owners, exported.ModuleName, module))
+			}
+		}
+
+		return formatInvariant("channel capabilities invariance", broken)
+	}
+}
+
+// formatInvariant returns the formatted invariant message and whether the invariant is broken
+// for the provided list of violations.
+func formatInvariant(name string, broken []string) (string, bool) {
+	if len(broken) == 0 {
+		return "", false
+	}
+
+	return sdk.FormatInvariant(exported.ModuleName, name, fmt.Sprintf("found %d violation(s):\n%s", len(broken), strings.Join(broken, "\n"))), true
+}
+
+// channelKey returns the key used to index a channel by its port and channel identifiers.
+func channelKey(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", portID, channelID)
+}
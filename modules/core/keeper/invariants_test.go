@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: closed channel without capability",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.CLOSED
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, channel)
+			},
+			true,
+		},
+		{
+			"failure: channel references connection which does not exist",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.ConnectionHops = []string{ibctesting.InvalidID}
+				path.EndpointA.SetChannel(channel)
+			},
+			false,
+		},
+		{
+			"failure: connection references client which does not exist",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.ClientId = ibctesting.InvalidID
+				path.EndpointA.SetConnection(connection)
+			},
+			false,
+		},
+		{
+			"failure: packet commitment sequence is not lower than the next send sequence",
+			func() {
+				nextSequenceSend, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nextSequenceSend, []byte("commitment"))
+			},
+			false,
+		},
+		{
+			"success: unordered channel packet acknowledgement is not checked against the next receive sequence",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 100, []byte("ack"))
+			},
+			true,
+		},
+		{
+			"failure: ordered channel packet acknowledgement sequence is not lower than the next receive sequence",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.Ordering = channeltypes.ORDERED
+				path.EndpointA.SetChannel(channel)
+
+				nextSequenceRecv, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nextSequenceRecv, []byte("ack"))
+			},
+			false,
+		},
+		{
+			"success: open channel capability with an additional owner",
+			func() {
+				name := host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				chanCap, found := suite.chainA.GetSimApp().ScopedTransferKeeper.GetCapability(suite.chainA.GetContext(), name)
+				suite.Require().True(found)
+
+				err := suite.chainA.GetSimApp().ScopedIBCMockKeeper.ClaimCapability(suite.chainA.GetContext(), chanCap, name)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"failure: open channel without capability",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, channel)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			out, broken := keeper.AllInvariants(suite.chainA.App.GetIBCKeeper())(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken)
+				suite.Require().Empty(out)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().NotEmpty(out)
+			}
+		})
+	}
+}
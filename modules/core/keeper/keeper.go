@@ -27,13 +27,16 @@ type Keeper struct {
 	// implements gRPC QueryServer interface
 	types.QueryServer
 
-	cdc codec.BinaryCodec
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
 
 	ClientKeeper     *clientkeeper.Keeper
 	ConnectionKeeper *connectionkeeper.Keeper
 	ChannelKeeper    *channelkeeper.Keeper
 	PortKeeper       *portkeeper.Keeper
 
+	circuitBreaker types.CircuitBreaker
+
 	authority string
 }
 
@@ -62,11 +65,12 @@ func NewKeeper(
 
 	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, consensusHost, upgradeKeeper)
 	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
-	portKeeper := portkeeper.NewKeeper(scopedKeeper)
+	portKeeper := portkeeper.NewKeeper(cdc, key, scopedKeeper)
 	channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
 
 	return &Keeper{
 		cdc:              cdc,
+		storeKey:         key,
 		ClientKeeper:     clientKeeper,
 		ConnectionKeeper: connectionKeeper,
 		ChannelKeeper:    channelKeeper,
@@ -89,6 +93,40 @@ func (k *Keeper) SetConsensusHost(consensusHost clienttypes.ConsensusHost) {
 	k.ClientKeeper.SetConsensusHost(consensusHost)
 }
 
+// SetTransientStoreKey sets the transient store key used by the client keeper to skip
+// duplicate client updates submitted within the same block, and by the channel keeper to
+// cache the connection ends of channels resolved within the same block. The connection keeper
+// removes cached connection ends when they are set.
+func (k *Keeper) SetTransientStoreKey(transientKey storetypes.StoreKey) {
+	if transientKey == nil {
+		panic(fmt.Errorf("cannot set a nil transient store key"))
+	}
+
+	k.ClientKeeper.SetTransientStoreKey(transientKey)
+	k.ConnectionKeeper.SetTransientStoreKey(transientKey)
+	k.ChannelKeeper.SetTransientStoreKey(transientKey)
+}
+
+// SetBankKeeper sets the bank keeper used by the client keeper to escrow and pay out the bonds and rewards
+// of bonded misbehaviour submissions.
+func (k *Keeper) SetBankKeeper(bankKeeper clienttypes.BankKeeper) {
+	if bankKeeper == nil {
+		panic(errors.New("cannot set a nil bank keeper"))
+	}
+
+	k.ClientKeeper.SetBankKeeper(bankKeeper)
+}
+
+// SetCircuitBreaker sets the circuit breaker checked by the msg server before handling messages. Messages can be
+// disabled globally by their type URL, or for specific identifiers with the keys returned by types.CircuitBreakerKey.
+func (k *Keeper) SetCircuitBreaker(circuitBreaker types.CircuitBreaker) {
+	if circuitBreaker == nil {
+		panic(errors.New("cannot set a nil circuit breaker"))
+	}
+
+	k.circuitBreaker = circuitBreaker
+}
+
 // SetRouter sets the Router in IBC Keeper and seals it. The method panics if
 // there is an existing router that's already sealed.
 func (k *Keeper) SetRouter(rtr *porttypes.Router) {
@@ -7,19 +7,32 @@ import (
 	metrics "github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"github.com/cosmos/ibc-go/v8/internal/logging"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
+// names of the application callbacks isolated from panics by core IBC
+const (
+	appCallbackOnRecvPacket            = "OnRecvPacket"
+	appCallbackOnRecvPacketFailure     = "OnRecvPacketFailure"
+	appCallbackOnAcknowledgementPacket = "OnAcknowledgementPacket"
+	appCallbackOnTimeoutPacket         = "OnTimeoutPacket"
+)
+
 var (
 	_ clienttypes.MsgServer     = (*Keeper)(nil)
 	_ connectiontypes.MsgServer = (*Keeper)(nil)
@@ -35,15 +48,44 @@ var (
 func (k *Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateClient) (*clienttypes.MsgCreateClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	clientState, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
 	}
 
-	if _, err = k.ClientKeeper.CreateClient(ctx, clientState.ClientType(), msg.ClientState.Value, msg.ConsensusState.Value); err != nil {
+	// the authority is exempt from the client limits to allow governance to create clients at any time
+	if msg.Signer != k.GetAuthority() {
+		if err := k.ClientKeeper.VerifyClientLimit(ctx, clientState.ClientType()); err != nil {
+			return nil, err
+		}
+	}
+
+	clientID, err := k.ClientKeeper.CreateClient(ctx, clientState.ClientType(), msg.ClientState.Value, msg.ConsensusState.Value)
+	if err != nil {
 		return nil, err
 	}
 
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	k.ClientKeeper.SetClientCreator(ctx, clientID, creator)
+
+	var txHash []byte
+	if len(ctx.TxBytes()) > 0 {
+		txHash = tmhash.Sum(ctx.TxBytes())
+	}
+
+	k.ClientKeeper.SetClientCreationMetadata(ctx, clientID, clienttypes.ClientCreationMetadata{
+		CreationHeight: uint64(ctx.BlockHeight()),
+		TxHash:         txHash,
+	})
+
 	return &clienttypes.MsgCreateClientResponse{}, nil
 }
 
@@ -51,6 +93,10 @@ func (k *Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateC
 func (k *Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateClient) (*clienttypes.MsgUpdateClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	clientMsg, err := clienttypes.UnpackClientMessage(msg.ClientMessage)
 	if err != nil {
 		return nil, err
@@ -72,6 +118,10 @@ func (k *Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateC
 func (k *Keeper) UpgradeClient(goCtx context.Context, msg *clienttypes.MsgUpgradeClient) (*clienttypes.MsgUpgradeClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	if err := k.ClientKeeper.UpgradeClient(
 		ctx, msg.ClientId,
 		msg.ClientState.Value,
@@ -91,6 +141,10 @@ func (k *Keeper) UpgradeClient(goCtx context.Context, msg *clienttypes.MsgUpgrad
 func (k *Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSubmitMisbehaviour) (*clienttypes.MsgSubmitMisbehaviourResponse, error) { //nolint:staticcheck // for now, we're using msgsubmitmisbehaviour.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	misbehaviour, err := clienttypes.UnpackClientMessage(msg.Misbehaviour)
 	if err != nil {
 		return nil, err
@@ -103,6 +157,34 @@ func (k *Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgS
 	return &clienttypes.MsgSubmitMisbehaviourResponse{}, nil
 }
 
+// SubmitBondedMisbehaviour defines a rpc handler method for MsgSubmitBondedMisbehaviour.
+// The bond of the signer is slashed if the misbehaviour fails to verify, in which case the
+// message does not return an error so that the slashing is committed.
+func (k *Keeper) SubmitBondedMisbehaviour(goCtx context.Context, msg *clienttypes.MsgSubmitBondedMisbehaviour) (*clienttypes.MsgSubmitBondedMisbehaviourResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	misbehaviour, err := clienttypes.UnpackClientMessage(msg.Misbehaviour)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := k.ClientKeeper.SubmitBondedMisbehaviour(ctx, msg.ClientId, misbehaviour, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clienttypes.MsgSubmitBondedMisbehaviourResponse{Frozen: frozen}, nil
+}
+
 // RecoverClient defines a rpc handler method for MsgRecoverClient.
 func (k *Keeper) RecoverClient(goCtx context.Context, msg *clienttypes.MsgRecoverClient) (*clienttypes.MsgRecoverClientResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -117,6 +199,39 @@ func (k *Keeper) RecoverClient(goCtx context.Context, msg *clienttypes.MsgRecove
 	return &clienttypes.MsgRecoverClientResponse{}, nil
 }
 
+// DeleteClient defines a rpc handler method for MsgDeleteClient.
+// A client may only be deleted by the account which created it, or by the authority, and only
+// if no connections have been opened using the client.
+func (k *Keeper) DeleteClient(goCtx context.Context, msg *clienttypes.MsgDeleteClient) (*clienttypes.MsgDeleteClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if k.GetAuthority() != msg.Signer {
+		signer, err := sdk.AccAddressFromBech32(msg.Signer)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+		}
+
+		creator := k.ClientKeeper.GetClientCreator(ctx, msg.ClientId)
+		if creator.Empty() || !creator.Equals(signer) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "signer %s is neither the client creator nor the authority", msg.Signer)
+		}
+	}
+
+	if connectionPaths, found := k.ConnectionKeeper.GetClientConnectionPaths(ctx, msg.ClientId); found && len(connectionPaths) != 0 {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientInUse, "client %s is used by connections %v", msg.ClientId, connectionPaths)
+	}
+
+	if err := k.ClientKeeper.DeleteClient(ctx, msg.ClientId); err != nil {
+		return nil, errorsmod.Wrap(err, "client deletion failed")
+	}
+
+	return &clienttypes.MsgDeleteClientResponse{}, nil
+}
+
 // IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 func (k *Keeper) IBCSoftwareUpgrade(goCtx context.Context, msg *clienttypes.MsgIBCSoftwareUpgrade) (*clienttypes.MsgIBCSoftwareUpgradeResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -140,6 +255,10 @@ func (k *Keeper) IBCSoftwareUpgrade(goCtx context.Context, msg *clienttypes.MsgI
 func (k *Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenInit) (*connectiontypes.MsgConnectionOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	if _, err := k.ConnectionKeeper.ConnOpenInit(ctx, msg.ClientId, msg.Counterparty, msg.Version, msg.DelayPeriod); err != nil {
 		return nil, errorsmod.Wrap(err, "connection handshake open init failed")
 	}
@@ -151,6 +270,10 @@ func (k *Keeper) ConnectionOpenInit(goCtx context.Context, msg *connectiontypes.
 func (k *Keeper) ConnectionOpenTry(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenTry) (*connectiontypes.MsgConnectionOpenTryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	targetClient, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
@@ -171,6 +294,10 @@ func (k *Keeper) ConnectionOpenTry(goCtx context.Context, msg *connectiontypes.M
 func (k *Keeper) ConnectionOpenAck(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenAck) (*connectiontypes.MsgConnectionOpenAckResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	targetClient, err := clienttypes.UnpackClientState(msg.ClientState)
 	if err != nil {
 		return nil, err
@@ -191,6 +318,10 @@ func (k *Keeper) ConnectionOpenAck(goCtx context.Context, msg *connectiontypes.M
 func (k *Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenConfirm) (*connectiontypes.MsgConnectionOpenConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	if err := k.ConnectionKeeper.ConnOpenConfirm(
 		ctx, msg.ConnectionId, msg.ProofAck, msg.ProofHeight,
 	); err != nil {
@@ -206,17 +337,21 @@ func (k *Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontyp
 func (k *Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgChannelOpenInit) (*channeltypes.MsgChannelOpenInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by port capability
 	module, portCap, err := k.PortKeeper.LookupModuleByPort(ctx, msg.PortId)
 	if err != nil {
-		ctx.Logger().Error("channel open init failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel open init failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve application callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel open init failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("channel open init failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
@@ -226,21 +361,21 @@ func (k *Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgCha
 		portCap, msg.Channel.Counterparty, msg.Channel.Version,
 	)
 	if err != nil {
-		ctx.Logger().Error("channel open init failed", "error", errorsmod.Wrap(err, "channel handshake open init failed"))
+		ctx.Logger().Error("channel open init failed", logging.KeyError, errorsmod.Wrap(err, "channel handshake open init failed"))
 		return nil, errorsmod.Wrap(err, "channel handshake open init failed")
 	}
 
 	// Perform application logic callback
 	version, err := cbs.OnChanOpenInit(ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId, channelID, capability, msg.Channel.Counterparty, msg.Channel.Version)
 	if err != nil {
-		ctx.Logger().Error("channel open init failed", "port-id", msg.PortId, "channel-id", channelID, "error", errorsmod.Wrap(err, "channel open init callback failed"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, channelID).Error("channel open init failed", logging.KeyError, errorsmod.Wrap(err, "channel open init callback failed"))
 		return nil, errorsmod.Wrapf(err, "channel open init callback failed for port ID: %s, channel ID: %s", msg.PortId, channelID)
 	}
 
 	// Write channel into state
 	k.ChannelKeeper.WriteOpenInitChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version)
 
-	ctx.Logger().Info("channel open init succeeded", "channel-id", channelID, "version", version)
+	logging.WithChannel(ctx.Logger(), msg.PortId, channelID).Info("channel open init succeeded", "version", version)
 
 	return &channeltypes.MsgChannelOpenInitResponse{
 		ChannelId: channelID,
@@ -254,17 +389,21 @@ func (k *Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgCha
 func (k *Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChannelOpenTry) (*channeltypes.MsgChannelOpenTryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by port capability
 	module, portCap, err := k.PortKeeper.LookupModuleByPort(ctx, msg.PortId)
 	if err != nil {
-		ctx.Logger().Error("channel open try failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel open try failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve application callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel open try failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("channel open try failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
@@ -273,21 +412,21 @@ func (k *Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChan
 		portCap, msg.Channel.Counterparty, msg.CounterpartyVersion, msg.ProofInit, msg.ProofHeight,
 	)
 	if err != nil {
-		ctx.Logger().Error("channel open try failed", "error", errorsmod.Wrap(err, "channel handshake open try failed"))
+		ctx.Logger().Error("channel open try failed", logging.KeyError, errorsmod.Wrap(err, "channel handshake open try failed"))
 		return nil, errorsmod.Wrap(err, "channel handshake open try failed")
 	}
 
 	// Perform application logic callback
 	version, err := cbs.OnChanOpenTry(ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId, channelID, capability, msg.Channel.Counterparty, msg.CounterpartyVersion)
 	if err != nil {
-		ctx.Logger().Error("channel open try failed", "port-id", msg.PortId, "channel-id", channelID, "error", errorsmod.Wrap(err, "channel open try callback failed"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, channelID).Error("channel open try failed", logging.KeyError, errorsmod.Wrap(err, "channel open try callback failed"))
 		return nil, errorsmod.Wrapf(err, "channel open try callback failed for port ID: %s, channel ID: %s", msg.PortId, channelID)
 	}
 
 	// Write channel into state
 	k.ChannelKeeper.WriteOpenTryChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version)
 
-	ctx.Logger().Info("channel open try succeeded", "channel-id", channelID, "port-id", msg.PortId, "version", version)
+	logging.WithChannel(ctx.Logger(), msg.PortId, channelID).Info("channel open try succeeded", "version", version)
 
 	return &channeltypes.MsgChannelOpenTryResponse{
 		ChannelId: channelID,
@@ -301,17 +440,21 @@ func (k *Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChan
 func (k *Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChannelOpenAck) (*channeltypes.MsgChannelOpenAckResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel open ack failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open ack failed", logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve application callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel open ack failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open ack failed", logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
@@ -319,7 +462,7 @@ func (k *Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChan
 	if err = k.ChannelKeeper.ChanOpenAck(
 		ctx, msg.PortId, msg.ChannelId, capability, msg.CounterpartyVersion, msg.CounterpartyChannelId, msg.ProofTry, msg.ProofHeight,
 	); err != nil {
-		ctx.Logger().Error("channel open ack failed", "error", err.Error())
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open ack failed", logging.KeyError, errorsmod.Wrap(err, "channel handshake open ack failed"))
 		return nil, errorsmod.Wrap(err, "channel handshake open ack failed")
 	}
 
@@ -328,11 +471,11 @@ func (k *Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChan
 
 	// Perform application logic callback
 	if err = cbs.OnChanOpenAck(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelId, msg.CounterpartyVersion); err != nil {
-		ctx.Logger().Error("channel open ack failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", errorsmod.Wrap(err, "channel open ack callback failed"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open ack failed", logging.KeyError, errorsmod.Wrap(err, "channel open ack callback failed"))
 		return nil, errorsmod.Wrapf(err, "channel open ack callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
 	}
 
-	ctx.Logger().Info("channel open ack succeeded", "channel-id", msg.ChannelId, "port-id", msg.PortId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel open ack succeeded")
 
 	return &channeltypes.MsgChannelOpenAckResponse{}, nil
 }
@@ -343,23 +486,27 @@ func (k *Keeper) ChannelOpenAck(goCtx context.Context, msg *channeltypes.MsgChan
 func (k *Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.MsgChannelOpenConfirm) (*channeltypes.MsgChannelOpenConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel open confirm failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open confirm failed", logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve application callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel open confirm failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open confirm failed", logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	// Perform 04-channel verification
 	if err = k.ChannelKeeper.ChanOpenConfirm(ctx, msg.PortId, msg.ChannelId, capability, msg.ProofAck, msg.ProofHeight); err != nil {
-		ctx.Logger().Error("channel open confirm failed", "error", errorsmod.Wrap(err, "channel handshake open confirm failed"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open confirm failed", logging.KeyError, errorsmod.Wrap(err, "channel handshake open confirm failed"))
 		return nil, errorsmod.Wrap(err, "channel handshake open confirm failed")
 	}
 
@@ -368,11 +515,11 @@ func (k *Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.Msg
 
 	// Perform application logic callback
 	if err = cbs.OnChanOpenConfirm(ctx, msg.PortId, msg.ChannelId); err != nil {
-		ctx.Logger().Error("channel open confirm failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", errorsmod.Wrap(err, "channel open confirm callback failed"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel open confirm failed", logging.KeyError, errorsmod.Wrap(err, "channel open confirm callback failed"))
 		return nil, errorsmod.Wrapf(err, "channel open confirm callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
 	}
 
-	ctx.Logger().Info("channel open confirm succeeded", "channel-id", msg.ChannelId, "port-id", msg.PortId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel open confirm succeeded")
 
 	return &channeltypes.MsgChannelOpenConfirmResponse{}, nil
 }
@@ -381,32 +528,36 @@ func (k *Keeper) ChannelOpenConfirm(goCtx context.Context, msg *channeltypes.Msg
 func (k *Keeper) ChannelCloseInit(goCtx context.Context, msg *channeltypes.MsgChannelCloseInit) (*channeltypes.MsgChannelCloseInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel close init failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel close init failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel close init failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("channel close init failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	if err = cbs.OnChanCloseInit(ctx, msg.PortId, msg.ChannelId); err != nil {
-		ctx.Logger().Error("channel close init failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", errorsmod.Wrap(err, "channel close init callback failed"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel close init failed", logging.KeyError, errorsmod.Wrap(err, "channel close init callback failed"))
 		return nil, errorsmod.Wrapf(err, "channel close init callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
 	}
 
 	err = k.ChannelKeeper.ChanCloseInit(ctx, msg.PortId, msg.ChannelId, capability)
 	if err != nil {
-		ctx.Logger().Error("channel close init failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel close init failed", logging.KeyError, err.Error())
 		return nil, errorsmod.Wrap(err, "channel handshake close init failed")
 	}
 
-	ctx.Logger().Info("channel close init succeeded", "channel-id", msg.ChannelId, "port-id", msg.PortId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel close init succeeded")
 
 	return &channeltypes.MsgChannelCloseInitResponse{}, nil
 }
@@ -415,32 +566,36 @@ func (k *Keeper) ChannelCloseInit(goCtx context.Context, msg *channeltypes.MsgCh
 func (k *Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.MsgChannelCloseConfirm) (*channeltypes.MsgChannelCloseConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel close confirm failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel close confirm failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel close confirm failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("channel close confirm failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	if err = cbs.OnChanCloseConfirm(ctx, msg.PortId, msg.ChannelId); err != nil {
-		ctx.Logger().Error("channel close confirm failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", errorsmod.Wrap(err, "channel close confirm callback failed"))
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel close confirm failed", logging.KeyError, errorsmod.Wrap(err, "channel close confirm callback failed"))
 		return nil, errorsmod.Wrapf(err, "channel close confirm callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
 	}
 
 	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, capability, msg.ProofInit, msg.ProofHeight, msg.CounterpartyUpgradeSequence)
 	if err != nil {
-		ctx.Logger().Error("channel close confirm failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel close confirm failed", logging.KeyError, err.Error())
 		return nil, errorsmod.Wrap(err, "channel handshake close confirm failed")
 	}
 
-	ctx.Logger().Info("channel close confirm succeeded", "channel-id", msg.ChannelId, "port-id", msg.PortId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel close confirm succeeded")
 
 	return &channeltypes.MsgChannelCloseConfirmResponse{}, nil
 }
@@ -449,23 +604,27 @@ func (k *Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Ms
 func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
-		ctx.Logger().Error("receive packet failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		ctx.Logger().Error("receive packet failed", logging.KeyError, errorsmod.Wrap(err, "Invalid address for msg Signer"))
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
 	if err != nil {
-		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("receive packet failed", logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("receive packet failed", logging.KeyPortID, msg.Packet.SourcePort, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
@@ -481,33 +640,56 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		// no-ops do not need event emission as they will be ignored
-		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		logging.WithPacket(ctx.Logger(), msg.Packet).Debug("no-op on redundant relay")
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
 	default:
-		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("receive packet failed", logging.KeyError, errorsmod.Wrap(err, "receive packet verification failed"))
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
 	// Perform application logic callback
 	//
 	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+	// A panic raised by the application is converted into an error acknowledgement so that the
+	// remaining messages of the transaction are not aborted by a badly-behaved application.
+	gasBefore := ctx.GasMeter().GasConsumed()
 	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
+	ack, err := onRecvPacket(cacheCtx, cbs, msg.Packet, relayer)
+	if errors.Is(err, channeltypes.ErrAppCallbackPanic) {
+		emitAppCallbackPanicEvent(ctx, appCallbackOnRecvPacket, msg.Packet)
+		ack = channeltypes.NewErrorAcknowledgement(err)
+	}
 	if ack == nil || ack.Success() {
 		// write application state changes for asynchronous and successful acknowledgements
 		writeFn()
 	} else {
 		// Modify events in cached context to reflect unsuccessful acknowledgement
 		ctx.EventManager().EmitEvents(convertToErrorEvents(cacheCtx.EventManager().Events()))
+
+		// Allow the application to persist state for the unsuccessful acknowledgement
+		if handler, ok := cbs.(porttypes.RecvPacketFailureHandler); ok {
+			gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+
+			cacheCtx, writeFn = ctx.CacheContext()
+			if err := onRecvPacketFailure(cacheCtx, handler, msg.Packet, ack.Acknowledgement(), gasUsed); err != nil {
+				emitAppCallbackPanicEvent(ctx, appCallbackOnRecvPacketFailure, msg.Packet)
+			} else {
+				writeFn()
+			}
+		}
 	}
 
 	// Set packet acknowledgement only if the acknowledgement is not nil.
 	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 	// acknowledgement is nil.
+	res := &channeltypes.MsgRecvPacketResponse{Result: channeltypes.ASYNC}
 	if ack != nil {
-		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, capability, msg.Packet, ack); err != nil {
+		if err := k.ChannelKeeper.WriteAcknowledgement(channeltypes.WithSyncAcknowledgement(ctx), capability, msg.Packet, ack); err != nil {
 			return nil, err
 		}
+
+		res.Result = channeltypes.SUCCESS
+		res.Acknowledgement = ack.Acknowledgement()
 	}
 
 	defer telemetry.IncrCounterWithLabels(
@@ -521,32 +703,36 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 		},
 	)
 
-	ctx.Logger().Info("receive packet callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	logging.WithPacket(ctx.Logger(), msg.Packet).Info("receive packet callback succeeded", "result", res.Result.String())
 
-	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+	return res, nil
 }
 
 // Timeout defines a rpc handler method for MsgTimeout.
 func (k *Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*channeltypes.MsgTimeoutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
-		ctx.Logger().Error("timeout failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		ctx.Logger().Error("timeout failed", logging.KeyError, errorsmod.Wrap(err, "Invalid address for msg Signer"))
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 	if err != nil {
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("timeout failed", logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("timeout failed", logging.KeyPortID, msg.Packet.SourcePort, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
@@ -562,10 +748,10 @@ func (k *Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		// no-ops do not need event emission as they will be ignored
-		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		logging.WithPacket(ctx.Logger(), msg.Packet).Debug("no-op on redundant relay")
 		return &channeltypes.MsgTimeoutResponse{Result: channeltypes.NOOP}, nil
 	default:
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout packet verification failed"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("timeout failed", logging.KeyError, errorsmod.Wrap(err, "timeout packet verification failed"))
 		return nil, errorsmod.Wrap(err, "timeout packet verification failed")
 	}
 
@@ -575,12 +761,23 @@ func (k *Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*
 	}
 
 	// Perform application logic callback
-	err = cbs.OnTimeoutPacket(ctx, msg.Packet, relayer)
+	//
+	// Cache context so that we may discard state changes from callback if the application panics.
+	// A panic raised by the application does not abort the remaining messages of the transaction:
+	// the packet commitment is still deleted and a FAILURE result is returned.
+	cacheCtx, writeFn = ctx.CacheContext()
+	err = onTimeoutPacket(cacheCtx, cbs, msg.Packet, relayer)
+	if errors.Is(err, channeltypes.ErrAppCallbackPanic) {
+		emitAppCallbackPanicEvent(ctx, appCallbackOnTimeoutPacket, msg.Packet)
+		return &channeltypes.MsgTimeoutResponse{Result: channeltypes.FAILURE}, nil
+	}
 	if err != nil {
-		ctx.Logger().Error("timeout failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout packet callback failed"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("timeout failed", logging.KeyError, errorsmod.Wrap(err, "timeout packet callback failed"))
 		return nil, errorsmod.Wrap(err, "timeout packet callback failed")
 	}
 
+	writeFn()
+
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"ibc", "timeout", "packet"},
 		1,
@@ -593,7 +790,7 @@ func (k *Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*
 		},
 	)
 
-	ctx.Logger().Info("timeout packet callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	logging.WithPacket(ctx.Logger(), msg.Packet).Info("timeout packet callback succeeded", "result", channeltypes.SUCCESS.String())
 
 	return &channeltypes.MsgTimeoutResponse{Result: channeltypes.SUCCESS}, nil
 }
@@ -602,23 +799,27 @@ func (k *Keeper) Timeout(goCtx context.Context, msg *channeltypes.MsgTimeout) (*
 func (k *Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeoutOnClose) (*channeltypes.MsgTimeoutOnCloseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
-		ctx.Logger().Error("timeout on close failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		ctx.Logger().Error("timeout on close failed", logging.KeyError, errorsmod.Wrap(err, "Invalid address for msg Signer"))
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 	if err != nil {
-		ctx.Logger().Error("timeout on close failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("timeout on close failed", logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("timeout on close failed", "port-id", msg.Packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("timeout on close failed", logging.KeyPortID, msg.Packet.SourcePort, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
@@ -634,10 +835,10 @@ func (k *Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTime
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		// no-ops do not need event emission as they will be ignored
-		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		logging.WithPacket(ctx.Logger(), msg.Packet).Debug("no-op on redundant relay")
 		return &channeltypes.MsgTimeoutOnCloseResponse{Result: channeltypes.NOOP}, nil
 	default:
-		ctx.Logger().Error("timeout on close failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout on close packet verification failed"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("timeout on close failed", logging.KeyError, errorsmod.Wrap(err, "timeout on close packet verification failed"))
 		return nil, errorsmod.Wrap(err, "timeout on close packet verification failed")
 	}
 
@@ -649,13 +850,25 @@ func (k *Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTime
 	// Perform application logic callback
 	//
 	// NOTE: MsgTimeout and MsgTimeoutOnClose use the same "OnTimeoutPacket"
-	// application logic callback.
-	err = cbs.OnTimeoutPacket(ctx, msg.Packet, relayer)
+	// application logic callback. The context is marked so that applications
+	// may distinguish a timeout on close from an ordinary packet timeout.
+	//
+	// Cache context so that we may discard state changes from callback if the application panics.
+	// A panic raised by the application does not abort the remaining messages of the transaction:
+	// the packet commitment is still deleted and a FAILURE result is returned.
+	cacheCtx, writeFn = ctx.CacheContext()
+	err = onTimeoutPacket(channeltypes.WithTimeoutOnClose(cacheCtx), cbs, msg.Packet, relayer)
+	if errors.Is(err, channeltypes.ErrAppCallbackPanic) {
+		emitAppCallbackPanicEvent(ctx, appCallbackOnTimeoutPacket, msg.Packet)
+		return &channeltypes.MsgTimeoutOnCloseResponse{Result: channeltypes.FAILURE}, nil
+	}
 	if err != nil {
-		ctx.Logger().Error("timeout on close failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "timeout on close callback failed"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("timeout on close failed", logging.KeyError, errorsmod.Wrap(err, "timeout on close callback failed"))
 		return nil, errorsmod.Wrap(err, "timeout on close callback failed")
 	}
 
+	writeFn()
+
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"ibc", "timeout", "packet"},
 		1,
@@ -668,7 +881,7 @@ func (k *Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTime
 		},
 	)
 
-	ctx.Logger().Info("timeout on close callback succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	logging.WithPacket(ctx.Logger(), msg.Packet).Info("timeout on close callback succeeded", "result", channeltypes.SUCCESS.String())
 
 	return &channeltypes.MsgTimeoutOnCloseResponse{Result: channeltypes.SUCCESS}, nil
 }
@@ -677,23 +890,27 @@ func (k *Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTime
 func (k *Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAcknowledgement) (*channeltypes.MsgAcknowledgementResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
-		ctx.Logger().Error("acknowledgement failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		ctx.Logger().Error("acknowledgement failed", logging.KeyError, errorsmod.Wrap(err, "Invalid address for msg Signer"))
 		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
 	}
 
 	// Lookup module by channel capability
 	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 	if err != nil {
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("acknowledgement failed", logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	// Retrieve callbacks from router
 	cbs, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("acknowledgement failed", logging.KeyPortID, msg.Packet.SourcePort, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
@@ -709,20 +926,31 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAck
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		// no-ops do not need event emission as they will be ignored
-		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		logging.WithPacket(ctx.Logger(), msg.Packet).Debug("no-op on redundant relay")
 		return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.NOOP}, nil
 	default:
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet verification failed"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("acknowledgement failed", logging.KeyError, errorsmod.Wrap(err, "acknowledge packet verification failed"))
 		return nil, errorsmod.Wrap(err, "acknowledge packet verification failed")
 	}
 
 	// Perform application logic callback
-	err = cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer)
+	//
+	// Cache context so that we may discard state changes from callback if the application panics.
+	// A panic raised by the application does not abort the remaining messages of the transaction:
+	// the packet commitment is still deleted and a FAILURE result is returned.
+	cacheCtx, writeFn = ctx.CacheContext()
+	err = onAcknowledgementPacket(cacheCtx, cbs, msg.Packet, msg.Acknowledgement, relayer)
+	if errors.Is(err, channeltypes.ErrAppCallbackPanic) {
+		emitAppCallbackPanicEvent(ctx, appCallbackOnAcknowledgementPacket, msg.Packet)
+		return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.FAILURE}, nil
+	}
 	if err != nil {
-		ctx.Logger().Error("acknowledgement failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "acknowledge packet callback failed"))
+		logging.WithPacket(ctx.Logger(), msg.Packet).Error("acknowledgement failed", logging.KeyError, errorsmod.Wrap(err, "acknowledge packet callback failed"))
 		return nil, errorsmod.Wrap(err, "acknowledge packet callback failed")
 	}
 
+	writeFn()
+
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
 		1,
@@ -734,7 +962,7 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAck
 		},
 	)
 
-	ctx.Logger().Info("acknowledgement succeeded", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "result", channeltypes.SUCCESS.String())
+	logging.WithPacket(ctx.Logger(), msg.Packet).Info("acknowledgement succeeded", "result", channeltypes.SUCCESS.String())
 
 	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
 }
@@ -743,31 +971,35 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAck
 func (k *Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	if k.GetAuthority() != msg.Signer {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
 	}
 
 	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel upgrade init failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	app, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("channel upgrade init failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	cbs, ok := app.(porttypes.UpgradableModule)
 	if !ok {
-		ctx.Logger().Error("channel upgrade init failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module))
+		ctx.Logger().Error("channel upgrade init failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
 	}
 
 	upgrade, err := k.ChannelKeeper.ChanUpgradeInit(ctx, msg.PortId, msg.ChannelId, msg.Fields)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade init failed", "error", errorsmod.Wrap(err, "channel upgrade init failed"))
+		ctx.Logger().Error("channel upgrade init failed", logging.KeyError, errorsmod.Wrap(err, "channel upgrade init failed"))
 		return nil, errorsmod.Wrap(err, "channel upgrade init failed")
 	}
 
@@ -776,13 +1008,13 @@ func (k *Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.Msg
 	cacheCtx, _ := ctx.CacheContext()
 	upgradeVersion, err := cbs.OnChanUpgradeInit(cacheCtx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade init callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel upgrade init callback failed", logging.KeyError, err.Error())
 		return nil, errorsmod.Wrapf(err, "channel upgrade init callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
 	}
 
 	channel, upgrade := k.ChannelKeeper.WriteUpgradeInitChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
 
-	ctx.Logger().Info("channel upgrade init succeeded", "channel-id", msg.ChannelId, "version", upgradeVersion)
+	ctx.Logger().Info("channel upgrade init succeeded", logging.KeyChannelID, msg.ChannelId, "version", upgradeVersion)
 	keeper.EmitChannelUpgradeInitEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeInitResponse{
@@ -795,27 +1027,31 @@ func (k *Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.Msg
 func (k *Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTry) (*channeltypes.MsgChannelUpgradeTryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade try failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel upgrade try failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	app, ok := k.PortKeeper.Route(module)
 	if !ok {
-		ctx.Logger().Error("channel upgrade try failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		ctx.Logger().Error("channel upgrade try failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
 	}
 
 	cbs, ok := app.(porttypes.UpgradableModule)
 	if !ok {
-		ctx.Logger().Error("channel upgrade try failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module))
+		ctx.Logger().Error("channel upgrade try failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module))
 		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
 	}
 
 	channel, upgrade, err := k.ChannelKeeper.ChanUpgradeTry(ctx, msg.PortId, msg.ChannelId, msg.ProposedUpgradeConnectionHops, msg.CounterpartyUpgradeFields, msg.CounterpartyUpgradeSequence, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade try failed", "error", errorsmod.Wrap(err, "channel upgrade try failed"))
+		ctx.Logger().Error("channel upgrade try failed", logging.KeyError, errorsmod.Wrap(err, "channel upgrade try failed"))
 		if channeltypes.IsUpgradeError(err) {
 			// In case the error is a wrapped upgrade error, we need to extract the inner error else process as normal
 			var upgradeErr *channeltypes.UpgradeError
@@ -836,13 +1072,13 @@ func (k *Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgC
 	cacheCtx, _ := ctx.CacheContext()
 	upgradeVersion, err := cbs.OnChanUpgradeTry(cacheCtx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade try callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel upgrade try callback failed", logging.KeyError, err.Error())
 		return nil, errorsmod.Wrapf(err, "channel upgrade try callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
 	}
 
 	channel, upgrade = k.ChannelKeeper.WriteUpgradeTryChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
 
-	ctx.Logger().Info("channel upgrade try succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade try succeeded")
 	keeper.EmitChannelUpgradeTryEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeTryResponse{
@@ -856,29 +1092,33 @@ func (k *Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgC
 func (k *Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeAck) (*channeltypes.MsgChannelUpgradeAckResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade ack failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel upgrade ack failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	app, ok := k.PortKeeper.Route(module)
 	if !ok {
 		err = errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
-		ctx.Logger().Error("channel upgrade ack failed", "port-id", msg.PortId, "error", err)
+		ctx.Logger().Error("channel upgrade ack failed", logging.KeyPortID, msg.PortId, logging.KeyError, err)
 		return nil, err
 	}
 
 	cbs, ok := app.(porttypes.UpgradableModule)
 	if !ok {
 		err = errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
-		ctx.Logger().Error("channel upgrade ack failed", "port-id", msg.PortId, "error", err)
+		ctx.Logger().Error("channel upgrade ack failed", logging.KeyPortID, msg.PortId, logging.KeyError, err)
 		return nil, err
 	}
 
 	err = k.ChannelKeeper.ChanUpgradeAck(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade ack failed", "error", errorsmod.Wrap(err, "channel upgrade ack failed"))
+		ctx.Logger().Error("channel upgrade ack failed", logging.KeyError, errorsmod.Wrap(err, "channel upgrade ack failed"))
 		if channeltypes.IsUpgradeError(err) {
 			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
 
@@ -901,7 +1141,7 @@ func (k *Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgC
 			return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
 		}
 
-		ctx.Logger().Error("channel upgrade ack callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("channel upgrade ack callback failed", logging.KeyError, err.Error())
 
 		// explicitly wrap the application callback in an upgrade error with the correct upgrade sequence.
 		// this prevents any errors caused from the application returning an UpgradeError with an incorrect sequence.
@@ -912,7 +1152,7 @@ func (k *Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgC
 
 	channel, upgrade := k.ChannelKeeper.WriteUpgradeAckChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
 
-	ctx.Logger().Info("channel upgrade ack succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade ack succeeded")
 	keeper.EmitChannelUpgradeAckEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.SUCCESS}, nil
@@ -922,29 +1162,33 @@ func (k *Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgC
 func (k *Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeConfirm) (*channeltypes.MsgChannelUpgradeConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade confirm failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel upgrade confirm failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	app, ok := k.PortKeeper.Route(module)
 	if !ok {
 		err = errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
-		ctx.Logger().Error("channel upgrade confirm failed", "port-id", msg.PortId, "error", err)
+		ctx.Logger().Error("channel upgrade confirm failed", logging.KeyPortID, msg.PortId, logging.KeyError, err)
 		return nil, err
 	}
 
 	cbs, ok := app.(porttypes.UpgradableModule)
 	if !ok {
 		err = errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
-		ctx.Logger().Error("channel upgrade confirm failed", "port-id", msg.PortId, "error", err)
+		ctx.Logger().Error("channel upgrade confirm failed", logging.KeyPortID, msg.PortId, logging.KeyError, err)
 		return nil, err
 	}
 
 	err = k.ChannelKeeper.ChanUpgradeConfirm(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgrade, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade confirm failed", "error", errorsmod.Wrap(err, "channel upgrade confirm failed"))
+		ctx.Logger().Error("channel upgrade confirm failed", logging.KeyError, errorsmod.Wrap(err, "channel upgrade confirm failed"))
 		if channeltypes.IsUpgradeError(err) {
 			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
 
@@ -958,7 +1202,7 @@ func (k *Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.
 	}
 
 	channel := k.ChannelKeeper.WriteUpgradeConfirmChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
-	ctx.Logger().Info("channel upgrade confirm succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade confirm succeeded")
 	keeper.EmitChannelUpgradeConfirmEvent(ctx, msg.PortId, msg.ChannelId, channel)
 
 	// Move channel to OPEN state if both chains have finished flushing in-flight packets.
@@ -972,7 +1216,7 @@ func (k *Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.
 		cbs.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
 		channel := k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
 
-		ctx.Logger().Info("channel upgrade open succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade open succeeded")
 		keeper.EmitChannelUpgradeOpenEvent(ctx, msg.PortId, msg.ChannelId, channel)
 	}
 
@@ -983,28 +1227,32 @@ func (k *Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.
 func (k *Keeper) ChannelUpgradeOpen(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeOpen) (*channeltypes.MsgChannelUpgradeOpenResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
 	if err != nil {
-		ctx.Logger().Error("channel upgrade open failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		ctx.Logger().Error("channel upgrade open failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
 		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
 	app, ok := k.PortKeeper.Route(module)
 	if !ok {
 		err = errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
-		ctx.Logger().Error("channel upgrade open failed", "port-id", msg.PortId, "error", err)
+		ctx.Logger().Error("channel upgrade open failed", logging.KeyPortID, msg.PortId, logging.KeyError, err)
 		return nil, err
 	}
 
 	cbs, ok := app.(porttypes.UpgradableModule)
 	if !ok {
 		err = errorsmod.Wrapf(porttypes.ErrInvalidRoute, "upgrade route not found to module: %s", module)
-		ctx.Logger().Error("channel upgrade open failed", "port-id", msg.PortId, "error", err)
+		ctx.Logger().Error("channel upgrade open failed", logging.KeyPortID, msg.PortId, logging.KeyError, err)
 		return nil, err
 	}
 
 	if err = k.ChannelKeeper.ChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgradeSequence, msg.ProofChannel, msg.ProofHeight); err != nil {
-		ctx.Logger().Error("channel upgrade open failed", "error", errorsmod.Wrap(err, "channel upgrade open failed"))
+		ctx.Logger().Error("channel upgrade open failed", logging.KeyError, errorsmod.Wrap(err, "channel upgrade open failed"))
 		return nil, errorsmod.Wrap(err, "channel upgrade open failed")
 	}
 
@@ -1016,7 +1264,7 @@ func (k *Keeper) ChannelUpgradeOpen(goCtx context.Context, msg *channeltypes.Msg
 	cbs.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
 	channel := k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
 
-	ctx.Logger().Info("channel upgrade open succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade open succeeded")
 	keeper.EmitChannelUpgradeOpenEvent(ctx, msg.PortId, msg.ChannelId, channel)
 
 	return &channeltypes.MsgChannelUpgradeOpenResponse{}, nil
@@ -1026,13 +1274,17 @@ func (k *Keeper) ChannelUpgradeOpen(goCtx context.Context, msg *channeltypes.Msg
 func (k *Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTimeout) (*channeltypes.MsgChannelUpgradeTimeoutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	if err := k.ChannelKeeper.ChanUpgradeTimeout(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight); err != nil {
 		return nil, errorsmod.Wrapf(err, "could not timeout upgrade for channel: %s", msg.ChannelId)
 	}
 
 	channel, upgrade := k.ChannelKeeper.WriteUpgradeTimeoutChannel(ctx, msg.PortId, msg.ChannelId)
 
-	ctx.Logger().Info("channel upgrade timeout callback succeeded: portID %s, channelID %s", msg.PortId, msg.ChannelId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade timeout callback succeeded")
 	keeper.EmitChannelUpgradeTimeoutEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
 	return &channeltypes.MsgChannelUpgradeTimeoutResponse{}, nil
@@ -1042,6 +1294,10 @@ func (k *Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.
 func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeCancel) (*channeltypes.MsgChannelUpgradeCancelResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	channel, found := k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
 	if !found {
 		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
@@ -1058,7 +1314,7 @@ func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.M
 
 		k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId, channel.UpgradeSequence)
 
-		ctx.Logger().Info("channel upgrade cancel succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade cancel succeeded")
 
 		keeper.EmitChannelUpgradeCancelEvent(ctx, msg.PortId, msg.ChannelId, channel, upgrade)
 
@@ -1066,7 +1322,7 @@ func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.M
 	}
 
 	if err := k.ChannelKeeper.ChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight); err != nil {
-		ctx.Logger().Error("channel upgrade cancel failed", "port-id", msg.PortId, "error", err.Error())
+		ctx.Logger().Error("channel upgrade cancel failed", logging.KeyPortID, msg.PortId, logging.KeyError, err.Error())
 		return nil, errorsmod.Wrap(err, "channel upgrade cancel failed")
 	}
 
@@ -1078,7 +1334,7 @@ func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.M
 
 	k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt.Sequence)
 
-	ctx.Logger().Info("channel upgrade cancel succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("channel upgrade cancel succeeded")
 
 	// get channel here again to get latest state after write
 	channel, found = k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
@@ -1094,6 +1350,10 @@ func (k *Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.M
 func (k *Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	pruned, remaining, err := k.ChannelKeeper.PruneAcknowledgements(ctx, msg.PortId, msg.ChannelId, msg.Limit)
 	if err != nil {
 		return nil, err
@@ -1105,6 +1365,112 @@ func (k *Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.
 	}, nil
 }
 
+// PruneStaleInitChannel defines a rpc handler method for MsgPruneStaleInitChannel. The channel is closed by
+// core IBC rather than by the application which initialized it, thus the OnChanCloseConfirm callback of the
+// application is invoked so that the application and its middleware clean up the state of the channel.
+// OnChanCloseInit is not used, as applications commonly reject user initiated channel closures in it.
+func (k *Keeper) PruneStaleInitChannel(goCtx context.Context, msg *channeltypes.MsgPruneStaleInitChannel) (*channeltypes.MsgPruneStaleInitChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Lookup module by channel capability
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("prune stale init channel failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.PortKeeper.Route(module)
+	if !ok {
+		ctx.Logger().Error("prune stale init channel failed", logging.KeyPortID, msg.PortId, logging.KeyError, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	if err := k.ChannelKeeper.PruneStaleInitChannel(ctx, msg.PortId, msg.ChannelId, capability); err != nil {
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("prune stale init channel failed", logging.KeyError, err.Error())
+		return nil, err
+	}
+
+	if err := cbs.OnChanCloseConfirm(ctx, msg.PortId, msg.ChannelId); err != nil {
+		logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Error("prune stale init channel failed", logging.KeyError, errorsmod.Wrap(err, "channel close confirm callback failed"))
+		return nil, errorsmod.Wrapf(err, "channel close confirm callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
+	}
+
+	logging.WithChannel(ctx.Logger(), msg.PortId, msg.ChannelId).Info("prune stale init channel succeeded")
+
+	return &channeltypes.MsgPruneStaleInitChannelResponse{}, nil
+}
+
+// AdvanceReceiptWatermark defines a rpc handler method for MsgAdvanceReceiptWatermark.
+func (k *Keeper) AdvanceReceiptWatermark(goCtx context.Context, msg *channeltypes.MsgAdvanceReceiptWatermark) (*channeltypes.MsgAdvanceReceiptWatermarkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertMsgAllowed(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	watermark, err := k.ChannelKeeper.AdvanceReceiptWatermark(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyWatermark, msg.Limit, msg.ProofWatermark, msg.ProofHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgAdvanceReceiptWatermarkResponse{
+		ReceiptWatermark: watermark,
+	}, nil
+}
+
+// EnableCommitmentWatermark defines a rpc handler method for MsgEnableCommitmentWatermark.
+func (k *Keeper) EnableCommitmentWatermark(goCtx context.Context, msg *channeltypes.MsgEnableCommitmentWatermark) (*channeltypes.MsgEnableCommitmentWatermarkResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	watermark, err := k.ChannelKeeper.EnableCommitmentWatermark(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgEnableCommitmentWatermarkResponse{
+		CommitmentWatermark: watermark,
+	}, nil
+}
+
+// ReleasePort defines a rpc handler method for MsgReleasePort.
+func (k *Keeper) ReleasePort(goCtx context.Context, msg *channeltypes.MsgReleasePort) (*channeltypes.MsgReleasePortResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.PortKeeper.ReleasePort(ctx, msg.PortId); err != nil {
+		return nil, errorsmod.Wrap(err, "release port failed")
+	}
+
+	return &channeltypes.MsgReleasePortResponse{}, nil
+}
+
+// RebindPort defines a rpc handler method for MsgRebindPort.
+func (k *Keeper) RebindPort(goCtx context.Context, msg *channeltypes.MsgRebindPort) (*channeltypes.MsgRebindPortResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.PortKeeper.RebindPort(ctx, msg.PortId, msg.Module); err != nil {
+		return nil, errorsmod.Wrap(err, "rebind port failed")
+	}
+
+	return &channeltypes.MsgRebindPortResponse{}, nil
+}
+
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 func (k *Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUpdateParams) (*clienttypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -1141,6 +1507,57 @@ func (k *Keeper) UpdateChannelParams(goCtx context.Context, msg *channeltypes.Ms
 	return &channeltypes.MsgUpdateParamsResponse{}, nil
 }
 
+// onRecvPacket invokes the OnRecvPacket callback of the application. A panic raised by the application
+// is recovered and returned as an error wrapping ErrAppCallbackPanic.
+func onRecvPacket(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet, relayer sdk.AccAddress) (ack exported.Acknowledgement, err error) {
+	defer recoverAppCallbackPanic(ctx, appCallbackOnRecvPacket, packet, &err)
+
+	return cbs.OnRecvPacket(ctx, packet, relayer), nil
+}
+
+// onRecvPacketFailure invokes the OnRecvPacketFailure callback of the application. A panic raised by the
+// application is recovered and returned as an error wrapping ErrAppCallbackPanic.
+func onRecvPacketFailure(ctx sdk.Context, handler porttypes.RecvPacketFailureHandler, packet channeltypes.Packet, acknowledgement []byte, gasUsed uint64) (err error) {
+	defer recoverAppCallbackPanic(ctx, appCallbackOnRecvPacketFailure, packet, &err)
+
+	handler.OnRecvPacketFailure(ctx, packet, acknowledgement, gasUsed)
+	return nil
+}
+
+// onAcknowledgementPacket invokes the OnAcknowledgementPacket callback of the application. A panic raised
+// by the application is recovered and returned as an error wrapping ErrAppCallbackPanic.
+func onAcknowledgementPacket(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) (err error) {
+	defer recoverAppCallbackPanic(ctx, appCallbackOnAcknowledgementPacket, packet, &err)
+
+	return cbs.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// onTimeoutPacket invokes the OnTimeoutPacket callback of the application. A panic raised by the
+// application is recovered and returned as an error wrapping ErrAppCallbackPanic.
+func onTimeoutPacket(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet, relayer sdk.AccAddress) (err error) {
+	defer recoverAppCallbackPanic(ctx, appCallbackOnTimeoutPacket, packet, &err)
+
+	return cbs.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// recoverAppCallbackPanic recovers a panic raised by the named application callback and sets err to an
+// error wrapping ErrAppCallbackPanic. Out of gas panics are propagated so that gas limits remain enforced.
+// It must be called directly by a deferred statement.
+func recoverAppCallbackPanic(ctx sdk.Context, callback string, packet channeltypes.Packet, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	switch r.(type) {
+	case storetypes.ErrorOutOfGas, storetypes.ErrorGasOverflow:
+		panic(r)
+	}
+
+	logging.WithChannel(ctx.Logger(), packet.GetSourcePort(), packet.GetSourceChannel()).Error("application callback panicked", "callback", callback, logging.KeySequence, packet.GetSequence(), "panic", r)
+	*err = errorsmod.Wrapf(channeltypes.ErrAppCallbackPanic, "%s panicked for packet with sequence %d", callback, packet.GetSequence())
+}
+
 // convertToErrorEvents converts all events to error events by appending the
 // error attribute prefix to each event's attribute key.
 func convertToErrorEvents(events sdk.Events) sdk.Events {
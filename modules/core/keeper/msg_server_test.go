@@ -3,11 +3,14 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -21,6 +24,7 @@ import (
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
@@ -172,7 +176,7 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 			msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
 
 			ctx := suite.chainB.GetContext()
-			_, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+			res, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
 
 			events := ctx.EventManager().Events()
 
@@ -180,8 +184,10 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 				suite.Require().NoError(err)
 
 				// replay should not fail since it will be treated as a no-op
-				_, err := suite.chainB.App.GetIBCKeeper().RecvPacket(suite.chainB.GetContext(), msg)
+				replayRes, err := suite.chainB.App.GetIBCKeeper().RecvPacket(suite.chainB.GetContext(), msg)
 				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.NOOP, replayRes.Result)
+				suite.Require().Empty(replayRes.Acknowledgement)
 
 				// check that callback state was handled correctly
 				_, exists := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(suite.chainB.GetContext(), ibcmock.GetMockRecvCanaryCapabilityName(packet))
@@ -215,6 +221,19 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 					suite.Require().NotNil(ack)
 					suite.Require().True(found)
 				}
+
+				// verify the result and the acknowledgement returned in the response
+				switch {
+				case tc.replay:
+					suite.Require().Equal(channeltypes.NOOP, res.Result)
+					suite.Require().Empty(res.Acknowledgement)
+				case tc.async:
+					suite.Require().Equal(channeltypes.ASYNC, res.Result)
+					suite.Require().Empty(res.Acknowledgement)
+				default:
+					suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+					suite.Require().Equal(ack, channeltypes.CommitAcknowledgement(res.Acknowledgement))
+				}
 			} else {
 				suite.Require().Error(err)
 			}
@@ -222,6 +241,87 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// TestCreateClient tests the CreateClient rpc handler enforcing the client limits
+func (suite *KeeperTestSuite) TestCreateClient() {
+	var (
+		signer string
+		params clienttypes.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no client limit",
+			func() {},
+			nil,
+		},
+		{
+			"success: client limit defined for other client type",
+			func() {
+				params.MaxClients = []clienttypes.ClientTypeLimit{clienttypes.NewClientTypeLimit(exported.Tendermint, 1)}
+			},
+			nil,
+		},
+		{
+			"success: client limit reached by authority",
+			func() {
+				params.MaxClients = []clienttypes.ClientTypeLimit{clienttypes.NewClientTypeLimit(exported.Solomachine, 1)}
+				signer = suite.chainA.App.GetIBCKeeper().GetAuthority()
+			},
+			nil,
+		},
+		{
+			"failure: client limit reached",
+			func() {
+				params.MaxClients = []clienttypes.ClientTypeLimit{clienttypes.NewClientTypeLimit(exported.Solomachine, 1)}
+			},
+			clienttypes.ErrMaxClientsReached,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			signer = suite.chainA.SenderAccount.GetAddress().String()
+			params = clienttypes.DefaultParams()
+
+			// create a first solo machine client before any limit is applied
+			solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+			msg, err := clienttypes.NewMsgCreateClient(solomachine.ClientState(), solomachine.ConsensusState(), signer)
+			suite.Require().NoError(err)
+
+			_, err = suite.chainA.App.GetIBCKeeper().CreateClient(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			msg, err = clienttypes.NewMsgCreateClient(solomachine.ClientState(), solomachine.ConsensusState(), signer)
+			suite.Require().NoError(err)
+
+			res, err := suite.chainA.App.GetIBCKeeper().CreateClient(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				clientID := clienttypes.FormatClientIdentifier(exported.Solomachine, 1)
+				suite.Require().Equal(exported.Active, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRecoverClient() {
 	var msg *clienttypes.MsgRecoverClient
 
@@ -299,6 +399,249 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestDeleteClient() {
+	var (
+		path *ibctesting.Path
+		msg  *clienttypes.MsgDeleteClient
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: signer is client creator",
+			func() {},
+			nil,
+		},
+		{
+			"success: signer is authority",
+			func() {
+				msg.Signer = suite.chainA.App.GetIBCKeeper().GetAuthority()
+			},
+			nil,
+		},
+		{
+			"signer is neither client creator nor authority",
+			func() {
+				msg.Signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"creator written to the client store is not the client creator",
+			func() {
+				signer := suite.chainB.SenderAccount.GetAddress()
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				clientStore.Set([]byte("creator"), signer)
+
+				msg.Signer = signer.String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"client is used by a connection",
+			func() {
+				path.CreateConnections()
+			},
+			clienttypes.ErrClientInUse,
+		},
+		{
+			"client not found",
+			func() {
+				msg.ClientId = ibctesting.SecondClientID
+				msg.Signer = suite.chainA.App.GetIBCKeeper().GetAuthority()
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			creator := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreator(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), creator)
+
+			msg = clienttypes.NewMsgDeleteClient(path.EndpointA.ClientID, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().DeleteClient(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().False(found)
+
+				iterator := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID).Iterator(nil, nil)
+				defer iterator.Close()
+				suite.Require().False(iterator.Valid(), "client store is not empty")
+
+				creator := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientCreator(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().Empty(creator)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitBondedMisbehaviour() {
+	var (
+		path        *ibctesting.Path
+		msg         *clienttypes.MsgSubmitBondedMisbehaviour
+		poolBalance sdk.Coins
+		expFrozen   bool
+		expReward   sdk.Coins
+	)
+
+	bond := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	reward := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	newMisbehaviour := func(signers *ibctesting.TestChain) *ibctm.Misbehaviour {
+		trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+		suite.Require().True(ok)
+
+		trustedVals, err := suite.chainB.GetTrustedValidators(int64(trustedHeight.RevisionHeight))
+		suite.Require().NoError(err)
+
+		height := suite.chainB.ProposedHeader.Height
+		return &ibctm.Misbehaviour{
+			Header1: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, height, trustedHeight, suite.chainB.ProposedHeader.Time.Add(time.Minute), signers.Vals, signers.NextVals, trustedVals, signers.Signers),
+			Header2: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, height, trustedHeight, suite.chainB.ProposedHeader.Time, signers.Vals, signers.NextVals, trustedVals, signers.Signers),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: misbehaviour verified, client frozen and reward paid",
+			func() {},
+			nil,
+		},
+		{
+			"success: misbehaviour verified, reward capped by module account balance",
+			func() {
+				poolBalance = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+				expReward = poolBalance
+			},
+			nil,
+		},
+		{
+			"success: misbehaviour verified, no reward paid from empty module account",
+			func() {
+				poolBalance = sdk.NewCoins()
+				expReward = sdk.NewCoins()
+			},
+			nil,
+		},
+		{
+			"success: misbehaviour fails to verify, bond slashed",
+			func() {
+				var err error
+				msg, err = clienttypes.NewMsgSubmitBondedMisbehaviour(path.EndpointA.ClientID, newMisbehaviour(suite.chainA), msg.Signer)
+				suite.Require().NoError(err)
+
+				expFrozen = false
+			},
+			nil,
+		},
+		{
+			"failure: bonded misbehaviour disabled",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				params.MisbehaviourBond = nil
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			clienttypes.ErrBondedMisbehaviourDisabled,
+		},
+		{
+			"failure: client is not active",
+			func() {
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: submitter cannot pay the bond",
+			func() {
+				msg.Signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			params := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+			params.MisbehaviourBond = bond
+			params.MisbehaviourReward = reward
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			poolBalance = reward
+			expReward = reward
+			expFrozen = true
+
+			var err error
+			msg, err = clienttypes.NewMsgSubmitBondedMisbehaviour(path.EndpointA.ClientID, newMisbehaviour(suite.chainB), suite.chainA.SenderAccount.GetAddress().String())
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+			submitter := sdk.MustAccAddressFromBech32(msg.Signer)
+			if !poolBalance.IsZero() {
+				err = bankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), exported.ModuleName, poolBalance)
+				suite.Require().NoError(err)
+			}
+
+			moduleAddr := authtypes.NewModuleAddress(exported.ModuleName)
+			submitterBalance := bankKeeper.GetAllBalances(suite.chainA.GetContext(), submitter)
+
+			res, err := suite.chainA.App.GetIBCKeeper().SubmitBondedMisbehaviour(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFrozen, res.Frozen)
+
+				status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				if expFrozen {
+					suite.Require().Equal(exported.Frozen, status)
+					suite.Require().Equal(submitterBalance.Add(expReward...), bankKeeper.GetAllBalances(suite.chainA.GetContext(), submitter))
+					suite.Require().Equal(poolBalance.Sub(expReward...), bankKeeper.GetAllBalances(suite.chainA.GetContext(), moduleAddr))
+				} else {
+					suite.Require().Equal(exported.Active, status)
+					suite.Require().Equal(submitterBalance.Sub(bond...), bankKeeper.GetAllBalances(suite.chainA.GetContext(), submitter))
+					suite.Require().Equal(poolBalance.Add(bond...), bankKeeper.GetAllBalances(suite.chainA.GetContext(), moduleAddr))
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -752,26 +1095,184 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 
 			proof, proofHeight := suite.chainB.QueryProof(packetKey)
 
-			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
-			closedProof, _ := suite.chainB.QueryProof(channelKey)
+			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			closedProof, _ := suite.chainB.QueryProof(channelKey)
+
+			msg := channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, closedProof, proofHeight, suite.chainA.SenderAccount.GetAddress().String(), counterpartyUpgradeSequence)
+
+			_, err := suite.chainA.App.GetIBCKeeper().TimeoutOnClose(suite.chainA.GetContext(), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// replay should not return an error as it will be treated as a no-op
+				_, err := suite.chainA.App.GetIBCKeeper().TimeoutOnClose(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				// verify packet commitment was deleted on source chain
+				has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().False(has)
+
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// requireAppCallbackPanicEvent asserts that an app_callback_panic event is contained in the given events.
+func (suite *KeeperTestSuite) requireAppCallbackPanicEvent(events []abci.Event) {
+	for _, event := range events {
+		if event.Type == coretypes.EventTypeAppCallbackPanic {
+			return
+		}
+	}
+
+	suite.FailNow("app_callback_panic event not found")
+}
+
+// TestAppCallbackPanicIsolation tests that panics raised by application packet callbacks are recovered
+// by core IBC without aborting the transaction, while out of gas panics are propagated.
+func (suite *KeeperTestSuite) TestAppCallbackPanicIsolation() {
+	var (
+		path   *ibctesting.Path
+		packet channeltypes.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		execute  func() error
+		expErr   error
+	}{
+		{
+			"OnRecvPacket panic is converted into an error acknowledgement",
+			func() {
+				suite.chainB.GetSimApp().IBCMockModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+					panic("badly-behaved application")
+				}
+			},
+			func() error {
+				res, err := path.EndpointB.RecvPacketWithResult(packet)
+				if err != nil {
+					return err
+				}
+
+				ack, err := ibctesting.ParseAckFromEvents(res.Events)
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(channeltypes.ErrAppCallbackPanic).Acknowledgement(), ack)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+
+				return nil
+			},
+			nil,
+		},
+		{
+			"OnAcknowledgementPacket panic is recovered",
+			func() {
+				err := path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnAcknowledgementPacket = func(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+					panic("badly-behaved application")
+				}
+			},
+			func() error {
+				packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+				msg := channeltypes.NewMsgAcknowledgement(packet, ibcmock.MockAcknowledgement.Acknowledgement(), proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+				res, err := suite.chainA.SendMsgs(msg)
+				if err != nil {
+					return err
+				}
+
+				suite.requireAppCallbackPanicEvent(res.Events)
+
+				// packet processing by core IBC is committed
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().Nil(commitment)
+
+				return nil
+			},
+			nil,
+		},
+		{
+			"OnTimeoutPacket panic is recovered",
+			func() {
+				packetTimeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+				sequence, err := path.EndpointA.SendPacket(packetTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packetTimeoutHeight, 0)
+
+				// need to update chainA client to prove missing ack
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnTimeoutPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+					panic("badly-behaved application")
+				}
+			},
+			func() error {
+				packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+				msg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+				res, err := suite.chainA.SendMsgs(msg)
+				if err != nil {
+					return err
+				}
+
+				suite.requireAppCallbackPanicEvent(res.Events)
+
+				// packet processing by core IBC is committed
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().Nil(commitment)
+
+				return nil
+			},
+			nil,
+		},
+		{
+			"OnRecvPacket out of gas panic is not recovered",
+			func() {
+				suite.chainB.GetSimApp().IBCMockModule.IBCApp.OnRecvPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+					panic(storetypes.ErrorOutOfGas{Descriptor: "badly-behaved application"})
+				}
+			},
+			func() error {
+				return path.EndpointB.RecvPacket(packet)
+			},
+			sdkerrors.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
 
-			msg := channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, closedProof, proofHeight, suite.chainA.SenderAccount.GetAddress().String(), counterpartyUpgradeSequence)
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
 
-			_, err := suite.chainA.App.GetIBCKeeper().TimeoutOnClose(suite.chainA.GetContext(), msg)
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
 
-			if tc.expPass {
-				suite.Require().NoError(err)
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
 
-				// replay should not return an error as it will be treated as a no-op
-				_, err := suite.chainA.App.GetIBCKeeper().TimeoutOnClose(suite.chainA.GetContext(), msg)
-				suite.Require().NoError(err)
+			tc.malleate()
 
-				// verify packet commitment was deleted on source chain
-				has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
-				suite.Require().False(has)
+			err = tc.execute()
 
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
 			} else {
-				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.expErr.Error())
 			}
 		})
 	}
@@ -2739,6 +3240,216 @@ func (suite *KeeperTestSuite) TestUpdateChannelParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEnableCommitmentWatermark() {
+	var msg *channeltypes.MsgEnableCommitmentWatermark
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() {
+				msg.Authority = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: core keeper function fails, channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = channeltypes.NewMsgEnableCommitmentWatermark(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().EnableCommitmentWatermark(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), resp.CommitmentWatermark)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReleasePort() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgReleasePort
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() {
+				msg.Authority = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: port is referenced by an open channel",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.OPEN })
+			},
+			porttypes.ErrPortInUse,
+		},
+		{
+			"failure: port is not bound",
+			func() {
+				msg.PortId = "unboundport"
+			},
+			porttypes.ErrPortNotFound,
+		},
+		{
+			"failure: port is already released",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().PortKeeper.ReleasePort(suite.chainA.GetContext(), msg.PortId)
+				suite.Require().NoError(err)
+			},
+			porttypes.ErrPortReleased,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+			msg = channeltypes.NewMsgReleasePort(path.EndpointA.ChannelConfig.PortID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().ReleasePort(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().PortKeeper.IsReleased(suite.chainA.GetContext(), msg.PortId))
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRebindPort() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgRebindPort
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() {
+				msg.Authority = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: module route not found",
+			func() {
+				msg.Module = "nonexistent"
+			},
+			porttypes.ErrInvalidRoute,
+		},
+		{
+			"failure: port is not released",
+			func() {
+				msg.PortId = ibctesting.TransferPort
+			},
+			porttypes.ErrPortNotReleased,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+			portID := path.EndpointA.ChannelConfig.PortID
+			err := suite.chainA.App.GetIBCKeeper().PortKeeper.ReleasePort(suite.chainA.GetContext(), portID)
+			suite.Require().NoError(err)
+
+			msg = channeltypes.NewMsgRebindPort(portID, ibcmock.MockBlockUpgrade, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().RebindPort(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+
+				// channels opened on the rebound port are routed to the new module
+				path.EndpointA.ChannelID, path.EndpointB.ChannelID = "", ""
+				path.CreateChannels()
+
+				module, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.LookupModuleByChannel(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(ibcmock.MockBlockUpgrade, module)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 	var msg *channeltypes.MsgPruneAcknowledgements
 
@@ -2814,3 +3525,95 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPruneStaleInitChannel() {
+	var (
+		path      *ibctesting.Path
+		msg       *channeltypes.MsgPruneStaleInitChannel
+		callbacks int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: channel capability not found",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			capabilitytypes.ErrCapabilityNotFound,
+		},
+		{
+			"failure: core keeper function fails, channel is not in INIT state",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.OPEN })
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"failure: application callback fails",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanCloseConfirm = func(ctx sdk.Context, portID, channelID string) error {
+					return ibcmock.MockApplicationCallbackError
+				}
+			},
+			ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.StaleInitChannelAge = 1
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			msg = channeltypes.NewMsgPruneStaleInitChannel(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			callbacks = 0
+			suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanCloseConfirm = func(ctx sdk.Context, portID, channelID string) error {
+				callbacks++
+				return nil
+			}
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().PruneStaleInitChannel(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				channel, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.CLOSED, channel.State)
+
+				// the application is notified of the channel closure
+				suite.Require().Equal(1, callbacks)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+)
+
+// GetConsensusVersion returns the consensus version recorded in the store for the provided IBC submodule.
+func (k *Keeper) GetConsensusVersion(ctx sdk.Context, submodule string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsensusVersionKey(submodule))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// SetConsensusVersion records the consensus version of the provided IBC submodule in the store.
+func (k *Keeper) SetConsensusVersion(ctx sdk.Context, submodule string, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsensusVersionKey(submodule), sdk.Uint64ToBigEndian(version))
+}
+
+// SetConsensusVersions records the consensus versions of all IBC submodules of the running binary in the store.
+// It is invoked on genesis initialization and after the store migration to the latest consensus version of the ibc module.
+func (k *Keeper) SetConsensusVersions(ctx sdk.Context) {
+	for _, submodule := range types.Submodules() {
+		k.SetConsensusVersion(ctx, submodule, types.SubmoduleConsensusVersions[submodule])
+	}
+}
+
+// CheckConsensusVersions compares the consensus versions recorded in the store with the consensus versions of the
+// IBC submodules of the running binary. An error is returned if the store was written by a newer binary, or if the
+// store migrations of a submodule have not been run.
+func (k *Keeper) CheckConsensusVersions(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := []byte(types.KeyConsensusVersionPrefix + "/")
+
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		submodule := strings.TrimPrefix(string(iterator.Key()), string(prefix))
+		if _, ok := types.SubmoduleConsensusVersions[submodule]; !ok {
+			return errorsmod.Wrapf(ibcerrors.ErrConsensusVersionDowngrade, "consensus version recorded for unknown submodule %s", submodule)
+		}
+	}
+
+	for _, submodule := range types.Submodules() {
+		version := types.SubmoduleConsensusVersions[submodule]
+
+		recorded, found := k.GetConsensusVersion(ctx, submodule)
+		if !found {
+			return errorsmod.Wrapf(ibcerrors.ErrMissingMigration, "no consensus version recorded for submodule %s, expected %d", submodule, version)
+		}
+
+		if recorded > version {
+			return errorsmod.Wrapf(ibcerrors.ErrConsensusVersionDowngrade, "submodule %s store is at consensus version %d, binary supports %d", submodule, recorded, version)
+		}
+
+		if recorded < version {
+			return errorsmod.Wrapf(ibcerrors.ErrMissingMigration, "submodule %s store is at consensus version %d, binary expects %d", submodule, recorded, version)
+		}
+	}
+
+	return nil
+}
+
+// AssertConsensusVersions panics if the consensus versions recorded in the store do not match the consensus versions
+// of the IBC submodules of the running binary. It is intended to be invoked by the application on startup, once the
+// latest version of the store has been loaded, and by upgrade handlers once the store migrations have been run.
+func (k *Keeper) AssertConsensusVersions(ctx sdk.Context) {
+	if err := k.CheckConsensusVersions(ctx); err != nil {
+		panic(errorsmod.Wrap(err, "refusing to start with an incompatible IBC store"))
+	}
+}
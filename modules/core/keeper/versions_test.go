@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+)
+
+func (suite *KeeperTestSuite) TestCheckConsensusVersions() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: versions recorded on genesis",
+			func() {},
+			nil,
+		},
+		{
+			"failure: store downgraded",
+			func() {
+				version := types.SubmoduleConsensusVersions[clienttypes.SubModuleName]
+				suite.chainA.App.GetIBCKeeper().SetConsensusVersion(suite.chainA.GetContext(), clienttypes.SubModuleName, version+1)
+			},
+			ibcerrors.ErrConsensusVersionDowngrade,
+		},
+		{
+			"failure: version recorded for unknown submodule",
+			func() {
+				suite.chainA.App.GetIBCKeeper().SetConsensusVersion(suite.chainA.GetContext(), "unknown", 1)
+			},
+			ibcerrors.ErrConsensusVersionDowngrade,
+		},
+		{
+			"failure: migration skipped",
+			func() {
+				version := types.SubmoduleConsensusVersions[clienttypes.SubModuleName]
+				suite.chainA.App.GetIBCKeeper().SetConsensusVersion(suite.chainA.GetContext(), clienttypes.SubModuleName, version-1)
+			},
+			ibcerrors.ErrMissingMigration,
+		},
+		{
+			"failure: no version recorded",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(ibcexported.StoreKey))
+				store.Delete(types.ConsensusVersionKey(clienttypes.SubModuleName))
+			},
+			ibcerrors.ErrMissingMigration,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().CheckConsensusVersions(suite.chainA.GetContext())
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetConsensusVersions() {
+	ctx := suite.chainA.GetContext()
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+
+	for _, submodule := range types.Submodules() {
+		ibcKeeper.SetConsensusVersion(ctx, submodule, 1)
+	}
+
+	suite.Require().ErrorIs(ibcKeeper.CheckConsensusVersions(ctx), ibcerrors.ErrMissingMigration)
+
+	ibcKeeper.SetConsensusVersions(ctx)
+
+	for _, submodule := range types.Submodules() {
+		version, found := ibcKeeper.GetConsensusVersion(ctx, submodule)
+		suite.Require().True(found)
+		suite.Require().Equal(types.SubmoduleConsensusVersions[submodule], version)
+	}
+
+	suite.Require().NoError(ibcKeeper.CheckConsensusVersions(ctx))
+}
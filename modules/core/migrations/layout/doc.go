@@ -0,0 +1,12 @@
+/*
+Package layout contains golden tests for the store layout of the core IBC module and the
+transfer, interchain accounts and fee applications.
+
+The tests initialize each store from a canonical genesis state and compare the resulting
+keys and value hashes against the golden files in the testdata directory. A change in the
+store layout must be accompanied by a store migration and a consensus version bump of the
+affected module, after which the golden files are regenerated by running:
+
+	go test ./modules/core/migrations/layout/... -update-layout
+*/
+package layout
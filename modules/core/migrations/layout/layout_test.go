@@ -0,0 +1,323 @@
+package layout_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	controllerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
+	hostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
+	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v8/modules/core"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/cosmos/ibc-go/v8/testing/simapp"
+)
+
+var update = flag.Bool("update-layout", false, "update the golden store layout files")
+
+const (
+	chainID           = "testchain-1"
+	clientID          = "07-tendermint-0"
+	connectionID      = "connection-0"
+	transferChannelID = "channel-0"
+	icaChannelID      = "channel-1"
+	versionHeader     = "# consensus_version: "
+)
+
+var (
+	genesisTime = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	height      = clienttypes.NewHeight(1, 10)
+
+	ownerAddress   = sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	icaAddress     = sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()
+	relayerAddress = sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	payeeAddress   = sdk.AccAddress(bytes.Repeat([]byte{4}, 20)).String()
+
+	icaControllerPortID = icatypes.ControllerPortPrefix + ownerAddress
+)
+
+// TestStoreLayout initializes the stores of the core IBC module, transfer, interchain accounts
+// and fee applications from a canonical genesis state and compares the resulting store layout
+// against the golden files in the testdata directory. The test fails if the layout of a store
+// changes without the consensus version of the owning module being bumped, which signals that
+// a key format changed without a corresponding store migration being registered.
+func TestStoreLayout(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{ChainID: chainID, Height: 1, Time: genesisTime})
+
+	initCanonicalGenesis(t, app, ctx)
+
+	testCases := []struct {
+		storeKey   string
+		moduleName string
+	}{
+		{ibcexported.StoreKey, ibcexported.ModuleName},
+		{transfertypes.StoreKey, transfertypes.ModuleName},
+		{controllertypes.StoreKey, icatypes.ModuleName},
+		{hosttypes.StoreKey, icatypes.ModuleName},
+		{feetypes.StoreKey, feetypes.ModuleName},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.storeKey, func(t *testing.T) {
+			appModule, ok := app.ModuleManager.Modules[tc.moduleName].(module.HasConsensusVersion)
+			require.True(t, ok, "module %s does not define a consensus version", tc.moduleName)
+
+			consensusVersion := appModule.ConsensusVersion()
+			layout := storeLayout(ctx.KVStore(app.GetKey(tc.storeKey)))
+
+			goldenPath := filepath.Join("testdata", tc.storeKey+".golden")
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(formatGolden(consensusVersion, layout)), 0o600))
+				return
+			}
+
+			bz, err := os.ReadFile(goldenPath)
+			require.NoError(t, err, "golden file not found, run the tests with -update-layout to generate it")
+
+			goldenVersion, goldenLayout := parseGolden(t, string(bz))
+			if goldenLayout == layout {
+				return
+			}
+
+			if goldenVersion == consensusVersion {
+				require.Failf(t, "store layout changed without a migration",
+					"the layout of the %s store changed but the consensus version of the %s module is still %d: register a store migration and bump the consensus version\n%s",
+					tc.storeKey, tc.moduleName, consensusVersion, diff(goldenLayout, layout),
+				)
+			}
+
+			require.Failf(t, "golden file out of date",
+				"the consensus version of the %s module was bumped from %d to %d: run the tests with -update-layout to regenerate the golden file\n%s",
+				tc.moduleName, goldenVersion, consensusVersion, diff(goldenLayout, layout),
+			)
+		})
+	}
+}
+
+// initCanonicalGenesis initializes the stores under test with a canonical, deterministic genesis state
+// which writes at least one entry for every key format supported by genesis import.
+func initCanonicalGenesis(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+	t.Helper()
+
+	clientState := ibctm.NewClientState(
+		chainID, ibctm.DefaultTrustLevel, time.Hour*24*7*2, time.Hour*24*7*3, time.Second*10,
+		height, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"},
+	)
+	consensusState := ibctm.NewConsensusState(genesisTime, commitmenttypes.NewMerkleRoot([]byte("root")), bytes.Repeat([]byte{1}, 32))
+
+	clientGenesis := clienttypes.NewGenesisState(
+		[]clienttypes.IdentifiedClientState{clienttypes.NewIdentifiedClientState(clientID, clientState)},
+		[]clienttypes.ClientConsensusStates{
+			clienttypes.NewClientConsensusStates(clientID, []clienttypes.ConsensusStateWithHeight{
+				clienttypes.NewConsensusStateWithHeight(height, consensusState),
+			}),
+		},
+		[]clienttypes.IdentifiedGenesisMetadata{
+			clienttypes.NewIdentifiedGenesisMetadata(clientID, []clienttypes.GenesisMetadata{
+				clienttypes.NewGenesisMetadata(ibctm.ProcessedTimeKey(height), sdk.Uint64ToBigEndian(uint64(genesisTime.UnixNano()))),
+				clienttypes.NewGenesisMetadata(ibctm.ProcessedHeightKey(height), []byte(height.String())),
+				clienttypes.NewGenesisMetadata(ibctm.IterationKey(height), ibctm.ProcessedHeightKey(height)),
+			}),
+		},
+		clienttypes.DefaultParams(),
+		false,
+		1,
+	)
+
+	connectionGenesis := connectiontypes.NewGenesisState(
+		[]connectiontypes.IdentifiedConnection{
+			connectiontypes.NewIdentifiedConnection(connectionID, connectiontypes.NewConnectionEnd(
+				connectiontypes.OPEN, clientID,
+				connectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+				connectiontypes.GetCompatibleVersions(), 0,
+			)),
+		},
+		[]connectiontypes.ConnectionPaths{connectiontypes.NewConnectionPaths(clientID, []string{connectionID})},
+		1,
+		connectiontypes.DefaultParams(),
+	)
+
+	icaVersion := icatypes.NewDefaultMetadataString(connectionID, connectionID)
+	channelGenesis := channeltypes.GenesisState{
+		Channels: []channeltypes.IdentifiedChannel{
+			channeltypes.NewIdentifiedChannel(transfertypes.PortID, transferChannelID, channeltypes.NewChannel(
+				channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(transfertypes.PortID, transferChannelID),
+				[]string{connectionID}, transfertypes.Version,
+			)),
+			channeltypes.NewIdentifiedChannel(icaControllerPortID, icaChannelID, channeltypes.NewChannel(
+				channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(icatypes.HostPortID, icaChannelID),
+				[]string{connectionID}, icaVersion,
+			)),
+		},
+		Acknowledgements:    []channeltypes.PacketState{channeltypes.NewPacketState(transfertypes.PortID, transferChannelID, 1, []byte("ack"))},
+		Commitments:         []channeltypes.PacketState{channeltypes.NewPacketState(transfertypes.PortID, transferChannelID, 2, []byte("commitment"))},
+		Receipts:            []channeltypes.PacketState{channeltypes.NewPacketState(transfertypes.PortID, transferChannelID, 1, []byte{byte(1)})},
+		SendSequences:       []channeltypes.PacketSequence{channeltypes.NewPacketSequence(transfertypes.PortID, transferChannelID, 3)},
+		RecvSequences:       []channeltypes.PacketSequence{channeltypes.NewPacketSequence(transfertypes.PortID, transferChannelID, 2)},
+		AckSequences:        []channeltypes.PacketSequence{channeltypes.NewPacketSequence(transfertypes.PortID, transferChannelID, 2)},
+		NextChannelSequence: 2,
+		Params:              channeltypes.DefaultParams(),
+	}
+
+	ibc.InitGenesis(ctx, *app.IBCKeeper, &types.GenesisState{
+		ClientGenesis:     clientGenesis,
+		ConnectionGenesis: connectionGenesis,
+		ChannelGenesis:    channelGenesis,
+	})
+
+	denomTrace := transfertypes.DenomTrace{Path: fmt.Sprintf("%s/%s", transfertypes.PortID, transferChannelID), BaseDenom: "uatom"}
+	transferGenesis := transfertypes.NewGenesisState(
+		transfertypes.PortID,
+		transfertypes.Traces{denomTrace},
+		transfertypes.DefaultParams(),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+	)
+	transferVolume := transfertypes.NewTransferVolume(transferChannelID, sdk.DefaultBondDenom)
+	transferVolume.Sent = sdkmath.NewInt(100)
+	transferGenesis.TransferVolumes = []transfertypes.TransferVolume{transferVolume}
+	app.TransferKeeper.InitGenesis(ctx, *transferGenesis)
+
+	controllerkeeper.InitGenesis(ctx, app.ICAControllerKeeper, genesistypes.NewControllerGenesisState(
+		[]genesistypes.ActiveChannel{
+			{ConnectionId: connectionID, PortId: icaControllerPortID, ChannelId: icaChannelID, IsMiddlewareEnabled: true},
+		},
+		[]genesistypes.RegisteredInterchainAccount{
+			{ConnectionId: connectionID, PortId: icaControllerPortID, AccountAddress: icaAddress},
+		},
+		[]string{icaControllerPortID},
+		controllertypes.DefaultParams(),
+	))
+
+	hostkeeper.InitGenesis(ctx, app.ICAHostKeeper, genesistypes.NewHostGenesisState(
+		[]genesistypes.ActiveChannel{
+			{ConnectionId: connectionID, PortId: icaControllerPortID, ChannelId: icaChannelID},
+		},
+		[]genesistypes.RegisteredInterchainAccount{
+			{ConnectionId: connectionID, PortId: icaControllerPortID, AccountAddress: icaAddress},
+		},
+		icatypes.HostPortID,
+		hosttypes.DefaultParams(),
+	))
+
+	packetID := channeltypes.NewPacketID(transfertypes.PortID, transferChannelID, 2)
+	fee := feetypes.NewFee(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+	)
+	app.IBCFeeKeeper.InitGenesis(ctx, feetypes.GenesisState{
+		IdentifiedFees: []feetypes.IdentifiedPacketFees{
+			feetypes.NewIdentifiedPacketFees(packetID, []feetypes.PacketFee{feetypes.NewPacketFee(fee, ownerAddress, nil)}),
+		},
+		FeeEnabledChannels: []feetypes.FeeEnabledChannel{
+			{PortId: transfertypes.PortID, ChannelId: transferChannelID},
+		},
+		RegisteredPayees: []feetypes.RegisteredPayee{
+			{Relayer: relayerAddress, Payee: payeeAddress, ChannelId: transferChannelID},
+		},
+		RegisteredCounterpartyPayees: []feetypes.RegisteredCounterpartyPayee{
+			{Relayer: relayerAddress, CounterpartyPayee: payeeAddress, ChannelId: transferChannelID},
+		},
+		ForwardRelayers: []feetypes.ForwardRelayerAddress{
+			{Address: relayerAddress, PacketId: channeltypes.NewPacketID(transfertypes.PortID, transferChannelID, 1)},
+		},
+		Params: feetypes.DefaultParams(),
+	})
+}
+
+// storeLayout returns the canonical layout of the store, one line per entry containing the
+// quoted key followed by the hex encoded sha256 hash of the value, in key order.
+func storeLayout(store storetypes.KVStore) string {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var layout strings.Builder
+	for ; iterator.Valid(); iterator.Next() {
+		valueHash := sha256.Sum256(iterator.Value())
+		fmt.Fprintf(&layout, "%s %s\n", strconv.QuoteToASCII(string(iterator.Key())), hex.EncodeToString(valueHash[:]))
+	}
+
+	return layout.String()
+}
+
+// formatGolden returns the golden file contents for the given consensus version and store layout.
+func formatGolden(consensusVersion uint64, layout string) string {
+	return fmt.Sprintf("%s%d\n%s", versionHeader, consensusVersion, layout)
+}
+
+// parseGolden returns the consensus version and store layout recorded in the golden file contents.
+func parseGolden(t *testing.T, golden string) (uint64, string) {
+	t.Helper()
+
+	header, layout, found := strings.Cut(golden, "\n")
+	require.True(t, found, "golden file is missing the consensus version header")
+	require.True(t, strings.HasPrefix(header, versionHeader), "golden file is missing the consensus version header")
+
+	consensusVersion, err := strconv.ParseUint(strings.TrimPrefix(header, versionHeader), 10, 64)
+	require.NoError(t, err)
+
+	return consensusVersion, layout
+}
+
+// diff returns the entries which were removed from or added to the expected layout.
+func diff(expected, actual string) string {
+	expectedLines := strings.Split(strings.TrimSpace(expected), "\n")
+	actualLines := strings.Split(strings.TrimSpace(actual), "\n")
+
+	var sb strings.Builder
+	for _, line := range expectedLines {
+		if !contains(actualLines, line) {
+			fmt.Fprintf(&sb, "- %s\n", line)
+		}
+	}
+
+	for _, line := range actualLines {
+		if !contains(expectedLines, line) {
+			fmt.Fprintf(&sb, "+ %s\n", line)
+		}
+	}
+
+	return sb.String()
+}
+
+func contains(lines []string, line string) bool {
+	for _, l := range lines {
+		if l == line {
+			return true
+		}
+	}
+
+	return false
+}
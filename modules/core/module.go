@@ -40,6 +40,7 @@ var (
 	_ module.HasConsensusVersion = (*AppModule)(nil)
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
+	_ module.HasInvariants       = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
 )
@@ -91,6 +92,10 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	if err != nil {
 		panic(err)
 	}
+	err = types.RegisterQueryServiceHandlerClient(context.Background(), mux, types.NewQueryServiceClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the root tx command for the ibc module.
@@ -126,42 +131,66 @@ func (AppModule) Name() string {
 	return exported.ModuleName
 }
 
+// RegisterInvariants implements the AppModule interface
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	clienttypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	connectiontypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	channeltypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterQueryService(cfg.QueryServer(), am.keeper)
+	am.keeper.ClientKeeper.GetRouter().RegisterQueryServices(cfg.QueryServer())
 
 	clientMigrator := clientkeeper.NewMigrator(am.keeper.ClientKeeper)
-	if err := cfg.RegisterMigration(exported.ModuleName, 2, clientMigrator.Migrate2to3); err != nil {
-		panic(err)
-	}
+	am.registerMigration(cfg, 2, clientMigrator.Migrate2to3)
 
 	connectionMigrator := connectionkeeper.NewMigrator(am.keeper.ConnectionKeeper)
-	if err := cfg.RegisterMigration(exported.ModuleName, 3, func(ctx sdk.Context) error {
+	am.registerMigration(cfg, 3, func(ctx sdk.Context) error {
 		if err := connectionMigrator.Migrate3to4(ctx); err != nil {
 			return err
 		}
 
 		return clientMigrator.Migrate3to4(ctx)
-	}); err != nil {
-		panic(err)
-	}
+	})
 
-	if err := cfg.RegisterMigration(exported.ModuleName, 4, func(ctx sdk.Context) error {
+	am.registerMigration(cfg, 4, func(ctx sdk.Context) error {
 		if err := clientMigrator.MigrateParams(ctx); err != nil {
 			return err
 		}
 
 		return connectionMigrator.MigrateParams(ctx)
-	}); err != nil {
-		panic(err)
-	}
+	})
 
 	channelMigrator := channelkeeper.NewMigrator(am.keeper.ChannelKeeper)
-	err := cfg.RegisterMigration(exported.ModuleName, 5, channelMigrator.MigrateParams)
-	if err != nil {
+	am.registerMigration(cfg, 5, channelMigrator.MigrateParams)
+
+	am.registerMigration(cfg, 6, func(ctx sdk.Context) error {
+		if err := clientMigrator.Migrate6to7(ctx); err != nil {
+			return err
+		}
+
+		return channelMigrator.Migrate6to7(ctx)
+	})
+}
+
+// registerMigration registers the in-place store migration of the ibc module from the provided consensus version.
+// Once the store has been migrated to the consensus version of the running binary, the consensus versions of the
+// IBC submodules are recorded in the store, so that individual migrations never need to record them.
+func (am AppModule) registerMigration(cfg module.Configurator, fromVersion uint64, handler module.MigrationHandler) {
+	if err := cfg.RegisterMigration(exported.ModuleName, fromVersion, func(ctx sdk.Context) error {
+		if err := handler(ctx); err != nil {
+			return err
+		}
+
+		if fromVersion+1 == am.ConsensusVersion() {
+			am.keeper.SetConsensusVersions(ctx)
+		}
+
+		return nil
+	}); err != nil {
 		panic(err)
 	}
 }
@@ -184,7 +213,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
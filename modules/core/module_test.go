@@ -0,0 +1,48 @@
+package ibc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	ibc "github.com/cosmos/ibc-go/v8/modules/core"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// TestMigrationRecordsConsensusVersions tests that the consensus versions of the IBC submodules are recorded
+// once the store has been migrated to the latest consensus version of the ibc module.
+func TestMigrationRecordsConsensusVersions(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	app := chain.GetSimApp()
+	ctx := chain.GetContext()
+
+	// mock a store written before the consensus versions of the submodules were recorded
+	store := ctx.KVStore(app.GetKey(exported.StoreKey))
+	for _, submodule := range types.Submodules() {
+		store.Delete(types.ConsensusVersionKey(submodule))
+	}
+
+	require.ErrorIs(t, app.IBCKeeper.CheckConsensusVersions(ctx), ibcerrors.ErrMissingMigration)
+
+	msgServiceRouter := baseapp.NewMsgServiceRouter()
+	msgServiceRouter.SetInterfaceRegistry(app.InterfaceRegistry())
+	grpcQueryRouter := baseapp.NewGRPCQueryRouter()
+	grpcQueryRouter.SetInterfaceRegistry(app.InterfaceRegistry())
+	configurator := module.NewConfigurator(app.AppCodec(), msgServiceRouter, grpcQueryRouter)
+
+	appModule := ibc.NewAppModule(app.IBCKeeper)
+	appModule.RegisterServices(configurator)
+
+	fromVersion := module.VersionMap{exported.ModuleName: appModule.ConsensusVersion() - 1}
+	_, err := module.NewManager(appModule).RunMigrations(ctx, configurator, fromVersion)
+	require.NoError(t, err)
+
+	require.NoError(t, app.IBCKeeper.CheckConsensusVersions(ctx))
+}
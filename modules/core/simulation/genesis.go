@@ -15,6 +15,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channelsims "github.com/cosmos/ibc-go/v8/modules/core/04-channel/simulation"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/cosmos/ibc-go/v8/modules/core/types"
 )
@@ -53,6 +54,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		ClientGenesis:     clientGenesisState,
 		ConnectionGenesis: connectionGenesisState,
 		ChannelGenesis:    channelGenesisState,
+		PortGenesis:       porttypes.DefaultGenesisState(),
 	}
 
 	bz, err := json.MarshalIndent(&ibcGenesis, "", " ")
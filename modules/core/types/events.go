@@ -1,3 +1,12 @@
 package types
 
-const ErrorAttributeKeyPrefix = "ibccallbackerror-"
+const (
+	ErrorAttributeKeyPrefix = "ibccallbackerror-"
+
+	// EventTypeAppCallbackPanic is the event type emitted when a panic raised by an
+	// application packet callback is recovered by core IBC.
+	EventTypeAppCallbackPanic = "app_callback_panic"
+
+	// AttributeKeyCallback is the attribute key for the name of the application callback.
+	AttributeKeyCallback = "callback"
+)
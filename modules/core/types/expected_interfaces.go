@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -9,3 +11,9 @@ import (
 type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 }
+
+// CircuitBreaker defines the expected circuit breaker interface, implemented by the x/circuit keeper,
+// used to temporarily disable IBC messages.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
@@ -6,6 +6,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 )
 
 var _ codectypes.UnpackInterfacesMessage = (*GenesisState)(nil)
@@ -16,6 +17,7 @@ func DefaultGenesisState() *GenesisState {
 		ClientGenesis:     clienttypes.DefaultGenesisState(),
 		ConnectionGenesis: connectiontypes.DefaultGenesisState(),
 		ChannelGenesis:    channeltypes.DefaultGenesisState(),
+		PortGenesis:       porttypes.DefaultGenesisState(),
 	}
 }
 
@@ -35,5 +37,9 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.ChannelGenesis.Validate()
+	if err := gs.ChannelGenesis.Validate(); err != nil {
+		return err
+	}
+
+	return gs.PortGenesis.Validate()
 }
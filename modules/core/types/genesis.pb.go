@@ -10,6 +10,7 @@ import (
 	types "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	types1 "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	types2 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	types3 "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -34,6 +35,8 @@ type GenesisState struct {
 	ConnectionGenesis types1.GenesisState `protobuf:"bytes,2,opt,name=connection_genesis,json=connectionGenesis,proto3" json:"connection_genesis"`
 	// ICS004 - Channel genesis state
 	ChannelGenesis types2.GenesisState `protobuf:"bytes,3,opt,name=channel_genesis,json=channelGenesis,proto3" json:"channel_genesis"`
+	// ICS005 - Port genesis state
+	PortGenesis types3.GenesisState `protobuf:"bytes,4,opt,name=port_genesis,json=portGenesis,proto3" json:"port_genesis"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +93,13 @@ func (m *GenesisState) GetChannelGenesis() types2.GenesisState {
 	return types2.GenesisState{}
 }
 
+func (m *GenesisState) GetPortGenesis() types3.GenesisState {
+	if m != nil {
+		return m.PortGenesis
+	}
+	return types3.GenesisState{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.types.v1.GenesisState")
 }
@@ -97,26 +107,27 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/types/v1/genesis.proto", fileDescriptor_b9a49c5663e6fc59) }
 
 var fileDescriptor_b9a49c5663e6fc59 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x40, 0x93, 0xbe, 0x27, 0x86, 0x00, 0x45, 0x8d, 0x18, 0x50, 0x06, 0x37, 0x45, 0x1d, 0x58,
-	0xb0, 0x15, 0x58, 0x98, 0xbb, 0xc0, 0x82, 0x84, 0x60, 0x82, 0x05, 0x35, 0xc6, 0x72, 0x2d, 0x25,
-	0xbe, 0x55, 0xed, 0x46, 0xe2, 0x17, 0x98, 0xf8, 0xac, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x7e, 0x04,
-	0xd5, 0x36, 0x4e, 0xa4, 0xa8, 0x5b, 0x94, 0x73, 0x7c, 0x7c, 0x6d, 0x47, 0x63, 0x91, 0x53, 0x42,
-	0x61, 0xc5, 0x88, 0x7e, 0x5f, 0x32, 0x45, 0xaa, 0x8c, 0x70, 0x26, 0x99, 0x12, 0x0a, 0x2f, 0x57,
-	0xa0, 0x21, 0x1e, 0x89, 0x9c, 0xe2, 0x9d, 0x80, 0x8d, 0x80, 0xab, 0x2c, 0x39, 0xe5, 0xc0, 0xc1,
-	0x50, 0xb2, 0xfb, 0xb2, 0x62, 0x92, 0xfa, 0x12, 0x2d, 0x04, 0x93, 0xba, 0x97, 0x4a, 0xa6, 0xad,
-	0x01, 0x52, 0x32, 0xaa, 0x05, 0xc8, 0xbe, 0x35, 0x69, 0xad, 0xc5, 0x5c, 0x4a, 0x56, 0xf4, 0x94,
-	0xf3, 0x8f, 0x41, 0x74, 0x74, 0x6b, 0xff, 0x3c, 0xe9, 0xb9, 0x66, 0xf1, 0x7d, 0x34, 0xb4, 0x9b,
-	0xbe, 0x3a, 0xf1, 0x2c, 0x4c, 0xc3, 0x8b, 0xc3, 0xab, 0x14, 0xfb, 0xe9, 0x2d, 0xc7, 0x55, 0x86,
-	0xbb, 0x2b, 0x67, 0xff, 0x37, 0xdf, 0xe3, 0xe0, 0xf1, 0xd8, 0x52, 0x47, 0xe2, 0xe7, 0x28, 0x6e,
-	0x27, 0xf4, 0xc9, 0x81, 0x49, 0x4e, 0x3b, 0x49, 0xef, 0xec, 0xc9, 0x8e, 0x5a, 0xe3, 0x2f, 0xfd,
-	0x10, 0x9d, 0xb8, 0x63, 0xf9, 0xee, 0x3f, 0xd3, 0x9d, 0x74, 0xba, 0x56, 0xd8, 0x13, 0x1d, 0x3a,
-	0xec, 0xd0, 0xec, 0x6e, 0x53, 0xa3, 0x70, 0x5b, 0xa3, 0xf0, 0xa7, 0x46, 0xe1, 0x67, 0x83, 0x82,
-	0x6d, 0x83, 0x82, 0xaf, 0x06, 0x05, 0x2f, 0x98, 0x0b, 0xbd, 0x58, 0xe7, 0x98, 0x42, 0x49, 0x28,
-	0xa8, 0x12, 0x14, 0x11, 0x39, 0xbd, 0xe4, 0x40, 0xaa, 0x1b, 0x52, 0xc2, 0xdb, 0xba, 0x60, 0xaa,
-	0xf3, 0xf6, 0xf9, 0x81, 0xb9, 0xdd, 0xeb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0xdc, 0x83,
-	0x52, 0x14, 0x02, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x4a, 0xc3, 0x40,
+	0x18, 0x80, 0x93, 0x5a, 0x1c, 0xae, 0xb5, 0xd2, 0xe0, 0x20, 0x1d, 0xae, 0xad, 0x74, 0x70, 0xf1,
+	0x8e, 0xea, 0xe2, 0xdc, 0xa5, 0x2e, 0x82, 0xe8, 0xa4, 0x8b, 0x34, 0xe7, 0x91, 0x1e, 0xb4, 0xf7,
+	0x87, 0xdc, 0x35, 0xe0, 0x5b, 0xf8, 0x58, 0x1d, 0x0b, 0x2e, 0x4e, 0x22, 0xc9, 0x8b, 0x48, 0xee,
+	0xd2, 0x4b, 0x20, 0x64, 0x0b, 0xff, 0xf7, 0xe5, 0x4b, 0xfe, 0xe4, 0xd0, 0x58, 0x84, 0x8c, 0x32,
+	0x48, 0x38, 0xd5, 0x9f, 0x31, 0x57, 0x34, 0x9d, 0xd3, 0x88, 0x4b, 0xae, 0x84, 0x22, 0x71, 0x02,
+	0x1a, 0x82, 0xa1, 0x08, 0x19, 0x29, 0x04, 0x62, 0x04, 0x92, 0xce, 0x47, 0x17, 0x11, 0x44, 0x60,
+	0x28, 0x2d, 0xae, 0xac, 0x38, 0x9a, 0xb8, 0x12, 0xdb, 0x08, 0x2e, 0x75, 0x23, 0x35, 0x9a, 0x55,
+	0x06, 0x48, 0xc9, 0x99, 0x16, 0x20, 0x9b, 0xd6, 0xb4, 0xb2, 0xd6, 0x2b, 0x29, 0xf9, 0xa6, 0xa9,
+	0x60, 0xa7, 0xc4, 0x90, 0x34, 0x1f, 0x74, 0xf5, 0xdd, 0x41, 0xfd, 0xa5, 0x9d, 0xbc, 0xe8, 0x95,
+	0xe6, 0xc1, 0x23, 0x1a, 0xd8, 0x97, 0x7a, 0x2f, 0xc5, 0x4b, 0x7f, 0xe2, 0x5f, 0xf7, 0x6e, 0x27,
+	0xc4, 0x6d, 0x67, 0x39, 0x49, 0xe7, 0xa4, 0x7e, 0xe7, 0xa2, 0xbb, 0xff, 0x1d, 0x7b, 0xcf, 0x67,
+	0x96, 0x96, 0x24, 0x78, 0x45, 0x41, 0xb5, 0x81, 0x4b, 0x76, 0x4c, 0x72, 0x56, 0x4b, 0x3a, 0xa7,
+	0x25, 0x3b, 0xac, 0x8c, 0x63, 0xfa, 0x09, 0x9d, 0x97, 0x6b, 0xbb, 0xee, 0x89, 0xe9, 0x4e, 0x6b,
+	0x5d, 0x2b, 0xb4, 0x44, 0x07, 0x25, 0x3e, 0x16, 0x97, 0xa8, 0x5f, 0x7c, 0x25, 0x97, 0xeb, 0x9a,
+	0x1c, 0xae, 0x72, 0x05, 0x6d, 0x69, 0xf5, 0x0a, 0x56, 0xce, 0x17, 0x0f, 0xfb, 0x0c, 0xfb, 0x87,
+	0x0c, 0xfb, 0x7f, 0x19, 0xf6, 0xbf, 0x72, 0xec, 0x1d, 0x72, 0xec, 0xfd, 0xe4, 0xd8, 0x7b, 0x23,
+	0x91, 0xd0, 0xeb, 0x5d, 0x48, 0x18, 0x6c, 0x29, 0x03, 0xb5, 0x05, 0x45, 0x45, 0xc8, 0x6e, 0x22,
+	0xa0, 0xe9, 0x3d, 0xdd, 0xc2, 0xc7, 0x6e, 0xc3, 0x55, 0xed, 0x90, 0x85, 0xa7, 0xe6, 0x37, 0xdd,
+	0xfd, 0x0f, 0x00, 0x93, 0x04, 0xb6, 0x80, 0x7d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +150,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PortGenesis.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.ChannelGenesis.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -195,6 +216,8 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ChannelGenesis.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PortGenesis.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -332,6 +355,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortGenesis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PortGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyConsensusVersionPrefix is the prefix under which the consensus versions of the IBC submodules are stored.
+const KeyConsensusVersionPrefix = "consensusVersions"
+
+// ConsensusVersionKey returns the store key under which the consensus version of the provided IBC submodule is stored.
+func ConsensusVersionKey(submodule string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyConsensusVersionPrefix, submodule))
+}
+
+// CircuitBreakerKey returns the key checked against the circuit breaker to determine whether messages of the given
+// type URL are allowed for the provided identifiers, e.g. "/ibc.core.channel.v1.MsgRecvPacket/transfer/channel-0".
+// If no identifiers are provided, the key is the type URL itself, which disables the message type for all identifiers.
+func CircuitBreakerKey(msgTypeURL string, identifiers ...string) string {
+	return strings.Join(append([]string{msgTypeURL}, identifiers...), "/")
+}
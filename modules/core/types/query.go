@@ -16,11 +16,14 @@ type QueryServer interface {
 	clienttypes.QueryServer
 	connectiontypes.QueryServer
 	channeltypes.QueryServer
+	QueryServiceServer
 }
 
-// RegisterQueryService registers each individual IBC submodule query service
+// RegisterQueryService registers each individual IBC submodule query service and the
+// query service aggregating queries across the IBC submodules
 func RegisterQueryService(server grpc.Server, queryService QueryServer) {
 	client.RegisterQueryService(server, queryService)
 	connection.RegisterQueryService(server, queryService)
 	channel.RegisterQueryService(server, queryService)
+	RegisterQueryServiceServer(server, queryService)
 }
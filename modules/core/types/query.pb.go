@@ -0,0 +1,1097 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/types/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	types2 "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	types1 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryFullChannelGraphRequest is the request type for the QueryService/FullChannelGraph RPC method
+type QueryFullChannelGraphRequest struct {
+	// pagination request, applied to the channels of the chain
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFullChannelGraphRequest) Reset()         { *m = QueryFullChannelGraphRequest{} }
+func (m *QueryFullChannelGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFullChannelGraphRequest) ProtoMessage()    {}
+func (*QueryFullChannelGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc0ad6869acad8f, []int{0}
+}
+func (m *QueryFullChannelGraphRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFullChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFullChannelGraphRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFullChannelGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFullChannelGraphRequest.Merge(m, src)
+}
+func (m *QueryFullChannelGraphRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFullChannelGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFullChannelGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFullChannelGraphRequest proto.InternalMessageInfo
+
+func (m *QueryFullChannelGraphRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFullChannelGraphResponse is the response type for the QueryService/FullChannelGraph RPC method
+type QueryFullChannelGraphResponse struct {
+	// list of channels joined with their connection and client
+	Channels []ChannelGraphEntry `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// query block height
+	Height types.Height `protobuf:"bytes,3,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryFullChannelGraphResponse) Reset()         { *m = QueryFullChannelGraphResponse{} }
+func (m *QueryFullChannelGraphResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFullChannelGraphResponse) ProtoMessage()    {}
+func (*QueryFullChannelGraphResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc0ad6869acad8f, []int{1}
+}
+func (m *QueryFullChannelGraphResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFullChannelGraphResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFullChannelGraphResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFullChannelGraphResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFullChannelGraphResponse.Merge(m, src)
+}
+func (m *QueryFullChannelGraphResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFullChannelGraphResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFullChannelGraphResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFullChannelGraphResponse proto.InternalMessageInfo
+
+func (m *QueryFullChannelGraphResponse) GetChannels() []ChannelGraphEntry {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *QueryFullChannelGraphResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryFullChannelGraphResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+// ChannelGraphEntry defines a channel together with the connection and client it is built upon.
+type ChannelGraphEntry struct {
+	// channel identified by its port and channel identifiers
+	Channel *types1.IdentifiedChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// connection of the channel, empty if the connection does not exist
+	Connection *types2.IdentifiedConnection `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"`
+	// client of the connection, empty if the client does not exist
+	Client *types.IdentifiedClientState `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	// status of the client
+	ClientStatus string `protobuf:"bytes,4,opt,name=client_status,json=clientStatus,proto3" json:"client_status,omitempty"`
+}
+
+func (m *ChannelGraphEntry) Reset()         { *m = ChannelGraphEntry{} }
+func (m *ChannelGraphEntry) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraphEntry) ProtoMessage()    {}
+func (*ChannelGraphEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc0ad6869acad8f, []int{2}
+}
+func (m *ChannelGraphEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelGraphEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelGraphEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelGraphEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraphEntry.Merge(m, src)
+}
+func (m *ChannelGraphEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelGraphEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraphEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelGraphEntry proto.InternalMessageInfo
+
+func (m *ChannelGraphEntry) GetChannel() *types1.IdentifiedChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *ChannelGraphEntry) GetConnection() *types2.IdentifiedConnection {
+	if m != nil {
+		return m.Connection
+	}
+	return nil
+}
+
+func (m *ChannelGraphEntry) GetClient() *types.IdentifiedClientState {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *ChannelGraphEntry) GetClientStatus() string {
+	if m != nil {
+		return m.ClientStatus
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryFullChannelGraphRequest)(nil), "ibc.core.types.v1.QueryFullChannelGraphRequest")
+	proto.RegisterType((*QueryFullChannelGraphResponse)(nil), "ibc.core.types.v1.QueryFullChannelGraphResponse")
+	proto.RegisterType((*ChannelGraphEntry)(nil), "ibc.core.types.v1.ChannelGraphEntry")
+}
+
+func init() { proto.RegisterFile("ibc/core/types/v1/query.proto", fileDescriptor_8cc0ad6869acad8f) }
+
+var fileDescriptor_8cc0ad6869acad8f = []byte{
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0xb6, 0xa9, 0x80, 0x37, 0x24, 0x66, 0x71, 0xa8, 0xaa, 0x2d, 0x2b, 0x05, 0xb1, 0x82,
+	0xc0, 0xa6, 0xe5, 0xb2, 0x23, 0x0c, 0xd1, 0x0d, 0x89, 0x03, 0x64, 0x37, 0x2e, 0x93, 0x93, 0x7a,
+	0x8e, 0xa5, 0xd4, 0xce, 0x62, 0x27, 0x52, 0xaf, 0x5c, 0xb9, 0x20, 0xf1, 0x01, 0xf8, 0x0a, 0x7c,
+	0x8c, 0x1d, 0x27, 0x71, 0xe1, 0x84, 0x50, 0xcb, 0x89, 0x4f, 0x81, 0x62, 0x9b, 0x24, 0xac, 0xe3,
+	0xcf, 0xed, 0x57, 0xff, 0xde, 0x7b, 0x7d, 0xbf, 0xf7, 0xb3, 0x03, 0xb6, 0x79, 0x18, 0xe1, 0x48,
+	0x66, 0x14, 0xeb, 0x59, 0x4a, 0x15, 0x2e, 0x86, 0xf8, 0x34, 0xa7, 0xd9, 0x0c, 0xa5, 0x99, 0xd4,
+	0x12, 0x6e, 0xf2, 0x30, 0x42, 0x65, 0x1b, 0x99, 0x36, 0x2a, 0x86, 0xdd, 0xfb, 0x91, 0x54, 0x53,
+	0xa9, 0x70, 0x48, 0x14, 0xb5, 0x58, 0x5c, 0x0c, 0x43, 0xaa, 0xc9, 0x10, 0xa7, 0x84, 0x71, 0x41,
+	0x34, 0x97, 0xc2, 0xd2, 0xbb, 0x37, 0x99, 0x64, 0xd2, 0x94, 0xb8, 0xac, 0xdc, 0xe9, 0x16, 0x93,
+	0x92, 0x25, 0x14, 0x93, 0x94, 0x63, 0x22, 0x84, 0xd4, 0x86, 0xa2, 0x5c, 0x77, 0xa7, 0x72, 0x14,
+	0x25, 0x9c, 0x0a, 0x5d, 0x5a, 0xb2, 0x95, 0x03, 0xec, 0xd6, 0x00, 0x29, 0x04, 0x8d, 0x4a, 0xb2,
+	0x01, 0x55, 0xbf, 0x1c, 0xf0, 0x56, 0x0d, 0x8c, 0x89, 0x10, 0x34, 0x31, 0x28, 0x5b, 0x5a, 0x48,
+	0xff, 0x04, 0x6c, 0xbd, 0x2e, 0x47, 0x18, 0xe7, 0x49, 0xf2, 0xcc, 0x76, 0x0e, 0x32, 0x92, 0xc6,
+	0x01, 0x3d, 0xcd, 0xa9, 0xd2, 0x70, 0x0c, 0x40, 0x3d, 0x54, 0xc7, 0xeb, 0x79, 0x83, 0xf5, 0xd1,
+	0x5d, 0x64, 0x13, 0x40, 0x65, 0x02, 0xc8, 0xa6, 0xe5, 0x12, 0x40, 0xaf, 0x08, 0xa3, 0x8e, 0x1b,
+	0x34, 0x98, 0xfd, 0x1f, 0x1e, 0xd8, 0xfe, 0xc3, 0x1f, 0xa9, 0x54, 0x0a, 0x45, 0xe1, 0x18, 0x5c,
+	0x75, 0xd6, 0x54, 0xc7, 0xeb, 0xad, 0x0e, 0xd6, 0x47, 0x77, 0xd0, 0x52, 0xf8, 0xa8, 0x49, 0x7d,
+	0x2e, 0x74, 0x36, 0xdb, 0x5f, 0x3b, 0xfb, 0xba, 0xd3, 0x0a, 0x2a, 0x2e, 0x3c, 0xf8, 0xcd, 0xf1,
+	0x8a, 0x71, 0xbc, 0xfb, 0x4f, 0xc7, 0xd6, 0x44, 0xd3, 0x32, 0xdc, 0x03, 0xed, 0x98, 0x72, 0x16,
+	0xeb, 0xce, 0xaa, 0x11, 0xe9, 0xd6, 0x76, 0xdc, 0x3a, 0x8a, 0x21, 0x3a, 0x34, 0x08, 0x67, 0xc2,
+	0xe1, 0xfb, 0xef, 0x56, 0xc0, 0xe6, 0x92, 0x51, 0xf8, 0x04, 0x5c, 0x71, 0x26, 0xab, 0x1c, 0x6b,
+	0x41, 0xdb, 0x28, 0x15, 0x5f, 0x4c, 0xa8, 0xd0, 0xfc, 0x84, 0xd3, 0x89, 0x93, 0x08, 0x7e, 0xd1,
+	0xe0, 0x4b, 0x00, 0xea, 0x1d, 0xbb, 0xd1, 0x1e, 0x34, 0x44, 0xaa, 0xde, 0x05, 0x9d, 0xea, 0x3c,
+	0x68, 0xf0, 0xe1, 0x53, 0xd0, 0xb6, 0x73, 0xb8, 0xf9, 0xee, 0x5d, 0x36, 0x5f, 0x43, 0xc5, 0x9c,
+	0x1d, 0x69, 0xa2, 0x69, 0xe0, 0x88, 0xf0, 0x36, 0xb8, 0x6e, 0xab, 0x63, 0xa5, 0x89, 0xce, 0x55,
+	0x67, 0xad, 0xe7, 0x0d, 0xae, 0x05, 0x1b, 0x51, 0x85, 0xcd, 0xd5, 0xe8, 0x93, 0x07, 0x36, 0xcc,
+	0xea, 0x8f, 0x68, 0x56, 0xf0, 0x88, 0xc2, 0x8f, 0x1e, 0xb8, 0x71, 0xf1, 0x1a, 0x40, 0x7c, 0xc9,
+	0xb2, 0xff, 0x76, 0x33, 0xbb, 0x8f, 0xfe, 0x9f, 0x60, 0x97, 0xdb, 0x1f, 0xbc, 0xfd, 0xfc, 0xfd,
+	0xc3, 0x4a, 0x1f, 0xf6, 0xf0, 0xf2, 0x9b, 0x77, 0x11, 0x1f, 0xb3, 0x92, 0xb1, 0x7f, 0x78, 0x36,
+	0xf7, 0xbd, 0xf3, 0xb9, 0xef, 0x7d, 0x9b, 0xfb, 0xde, 0xfb, 0x85, 0xdf, 0x3a, 0x5f, 0xf8, 0xad,
+	0x2f, 0x0b, 0xbf, 0xf5, 0x06, 0x31, 0xae, 0xe3, 0x3c, 0x44, 0x91, 0x9c, 0x62, 0xf7, 0x1d, 0xe0,
+	0x61, 0xf4, 0x90, 0x49, 0x5c, 0xec, 0xe1, 0xa9, 0x9c, 0xe4, 0x09, 0x55, 0x0d, 0xe9, 0xb0, 0x6d,
+	0x9e, 0xd9, 0xe3, 0x9f, 0x03, 0x00, 0x5b, 0x30, 0x61, 0x77, 0x67, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	// FullChannelGraph queries all channels of a chain joined with the connection and the client
+	// they are built upon.
+	FullChannelGraph(ctx context.Context, in *QueryFullChannelGraphRequest, opts ...grpc.CallOption) (*QueryFullChannelGraphResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) FullChannelGraph(ctx context.Context, in *QueryFullChannelGraphRequest, opts ...grpc.CallOption) (*QueryFullChannelGraphResponse, error) {
+	out := new(QueryFullChannelGraphResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.types.v1.QueryService/FullChannelGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	// FullChannelGraph queries all channels of a chain joined with the connection and the client
+	// they are built upon.
+	FullChannelGraph(context.Context, *QueryFullChannelGraphRequest) (*QueryFullChannelGraphResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) FullChannelGraph(ctx context.Context, req *QueryFullChannelGraphRequest) (*QueryFullChannelGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FullChannelGraph not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_FullChannelGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFullChannelGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FullChannelGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.types.v1.QueryService/FullChannelGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FullChannelGraph(ctx, req.(*QueryFullChannelGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.core.types.v1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FullChannelGraph",
+			Handler:    _QueryService_FullChannelGraph_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/core/types/v1/query.proto",
+}
+
+func (m *QueryFullChannelGraphRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFullChannelGraphRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFullChannelGraphRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFullChannelGraphResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFullChannelGraphResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFullChannelGraphResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelGraphEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelGraphEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelGraphEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientStatus) > 0 {
+		i -= len(m.ClientStatus)
+		copy(dAtA[i:], m.ClientStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Connection != nil {
+		{
+			size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Channel != nil {
+		{
+			size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFullChannelGraphRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFullChannelGraphResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ChannelGraphEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFullChannelGraphRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFullChannelGraphRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFullChannelGraphRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFullChannelGraphResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFullChannelGraphResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFullChannelGraphResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelGraphEntry{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelGraphEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelGraphEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelGraphEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Channel == nil {
+				m.Channel = &types1.IdentifiedChannel{}
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connection == nil {
+				m.Connection = &types2.IdentifiedConnection{}
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Client == nil {
+				m.Client = &types.IdentifiedClientState{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"sort"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// SubmoduleConsensusVersions defines the consensus version of the store layout of each IBC submodule.
+// The version of a submodule must be incremented whenever a migration of its store is registered,
+// so that binaries which skip the migration or predate it can be detected at startup.
+var SubmoduleConsensusVersions = map[string]uint64{
+	// migrations: 2 to 3, 3 to 4, 4 to 5 (params) and 6 to 7 of the ibc module
+	clienttypes.SubModuleName: 5,
+	// migrations: 3 to 4 and 4 to 5 (params) of the ibc module
+	connectiontypes.SubModuleName: 3,
+	// migrations: 5 to 6 (params) and 6 to 7 of the ibc module
+	channeltypes.SubModuleName: 3,
+}
+
+// Submodules returns the names of the IBC submodules with a tracked consensus version in sorted order.
+func Submodules() []string {
+	submodules := make([]string, 0, len(SubmoduleConsensusVersions))
+	for submodule := range SubmoduleConsensusVersions {
+		submodules = append(submodules, submodule)
+	}
+
+	sort.Strings(submodules)
+
+	return submodules
+}
@@ -0,0 +1,142 @@
+package tendermint
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ QueryServer = (*queryServer)(nil)
+
+// queryServer implements the 07-tendermint QueryServer interface using the client stores
+// provided to the light client module.
+type queryServer struct {
+	lightClientModule LightClientModule
+}
+
+// TrustingPeriodRemaining implements the Query/TrustingPeriodRemaining gRPC method
+func (q queryServer) TrustingPeriodRemaining(goCtx context.Context, req *QueryTrustingPeriodRemainingRequest) (*QueryTrustingPeriodRemainingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateClientID(req.ClientId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientStore := q.lightClientModule.storeProvider.ClientStore(ctx, req.ClientId)
+	cdc := q.lightClientModule.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(clienttypes.ErrClientNotFound, req.ClientId).Error())
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, clientState.LatestHeight)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "client-id: %s, height: %s", req.ClientId, clientState.LatestHeight).Error())
+	}
+
+	expirationTime := consensusState.Timestamp.Add(clientState.TrustingPeriod)
+
+	var remaining time.Duration
+	if expirationTime.After(ctx.BlockTime()) {
+		remaining = expirationTime.Sub(ctx.BlockTime())
+	}
+
+	return &QueryTrustingPeriodRemainingResponse{
+		Remaining:      remaining,
+		ExpirationTime: expirationTime,
+	}, nil
+}
+
+// ConsensusStateMetadata implements the Query/ConsensusStateMetadata gRPC method
+func (q queryServer) ConsensusStateMetadata(goCtx context.Context, req *QueryConsensusStateMetadataRequest) (*QueryConsensusStateMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateClientID(req.ClientId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientStore := q.lightClientModule.storeProvider.ClientStore(ctx, req.ClientId)
+
+	height := clienttypes.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	metadata, found := getConsensusStateMetadata(clientStore, height)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(ErrProcessedTimeNotFound, "client-id: %s, height: %s", req.ClientId, height).Error())
+	}
+
+	return &QueryConsensusStateMetadataResponse{
+		Metadata: metadata,
+	}, nil
+}
+
+// ConsensusStatesMetadata implements the Query/ConsensusStatesMetadata gRPC method
+func (q queryServer) ConsensusStatesMetadata(goCtx context.Context, req *QueryConsensusStatesMetadataRequest) (*QueryConsensusStatesMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateClientID(req.ClientId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clientStore := q.lightClientModule.storeProvider.ClientStore(ctx, req.ClientId)
+
+	var metadata []ConsensusStateMetadata
+	store := prefix.NewStore(clientStore, []byte(KeyIterateConsensusStatePrefix))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		height := GetHeightFromIterationKey(append([]byte(KeyIterateConsensusStatePrefix), key...))
+
+		// consensus states which have been pruned without their iteration key are skipped
+		if m, found := getConsensusStateMetadata(clientStore, height); found {
+			metadata = append(metadata, m)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &QueryConsensusStatesMetadataResponse{
+		Metadata:   metadata,
+		Pagination: pageRes,
+	}, nil
+}
+
+// validateClientID returns a gRPC error if the provided client identifier is invalid or is not
+// the identifier of a tendermint client.
+func validateClientID(clientID string) error {
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if clientType != exported.Tendermint {
+		return status.Error(codes.InvalidArgument, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected %s, got %s", exported.Tendermint, clientType).Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package tendermint_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TendermintTestSuite) TestQueryTrustingPeriodRemaining() {
+	var (
+		path *ibctesting.Path
+		req  *ibctm.QueryTrustingPeriodRemainingRequest
+		// blockTimeOffset is added to the block time of the query context
+		blockTimeOffset time.Duration
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: trusting period elapsed",
+			func() {
+				blockTimeOffset = ibctesting.TrustingPeriod
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client identifier",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{
+			"client type is not tendermint",
+			func() {
+				req.ClientId = clienttypes.FormatClientIdentifier("06-solomachine", 0)
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = clienttypes.FormatClientIdentifier("07-tendermint", 100)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			blockTimeOffset = 0
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			req = &ibctm.QueryTrustingPeriodRemainingRequest{ClientId: path.EndpointA.ClientID}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(blockTimeOffset))
+
+			// the query service is registered by core IBC with the application gRPC query router
+			queryHelper := &baseapp.QueryServiceTestHelper{
+				GRPCQueryRouter: suite.chainA.App.GetBaseApp().GRPCQueryRouter(),
+				Ctx:             ctx,
+			}
+			res, err := ibctm.NewQueryClient(queryHelper).TrustingPeriodRemaining(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				consensusState, ok := path.EndpointA.GetConsensusState(clientState.LatestHeight).(*ibctm.ConsensusState)
+				suite.Require().True(ok)
+
+				expExpirationTime := consensusState.Timestamp.Add(clientState.TrustingPeriod)
+				suite.Require().True(expExpirationTime.Equal(res.ExpirationTime))
+
+				if blockTimeOffset >= clientState.TrustingPeriod {
+					suite.Require().Zero(res.Remaining)
+				} else {
+					suite.Require().Equal(expExpirationTime.Sub(ctx.BlockTime()), res.Remaining)
+					suite.Require().Positive(res.Remaining)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestQueryConsensusStateMetadata() {
+	var (
+		path *ibctesting.Path
+		req  *ibctm.QueryConsensusStateMetadataRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client identifier",
+			func() {
+				req.ClientId = ""
+			},
+			false,
+		},
+		{
+			"client type is not tendermint",
+			func() {
+				req.ClientId = clienttypes.FormatClientIdentifier("06-solomachine", 0)
+			},
+			false,
+		},
+		{
+			"consensus state metadata not found",
+			func() {
+				req.RevisionHeight++
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			height := path.EndpointA.GetClientLatestHeight()
+			req = &ibctm.QueryConsensusStateMetadataRequest{
+				ClientId:       path.EndpointA.ClientID,
+				RevisionNumber: height.GetRevisionNumber(),
+				RevisionHeight: height.GetRevisionHeight(),
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			queryHelper := &baseapp.QueryServiceTestHelper{
+				GRPCQueryRouter: suite.chainA.App.GetBaseApp().GRPCQueryRouter(),
+				Ctx:             ctx,
+			}
+			res, err := ibctm.NewQueryClient(queryHelper).ConsensusStateMetadata(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+				expProcessedTime, found := ibctm.GetProcessedTime(clientStore, height)
+				suite.Require().True(found)
+				expProcessedHeight, found := ibctm.GetProcessedHeight(clientStore, height)
+				suite.Require().True(found)
+
+				suite.Require().Equal(height, res.Metadata.Height)
+				suite.Require().Equal(expProcessedTime, res.Metadata.ProcessedTime)
+				suite.Require().Equal(expProcessedHeight, res.Metadata.ProcessedHeight)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestQueryConsensusStatesMetadata() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	var expHeights []clienttypes.Height
+	expHeights = append(expHeights, path.EndpointA.GetClientLatestHeight().(clienttypes.Height))
+	for i := 0; i < 2; i++ {
+		err := path.EndpointA.UpdateClient()
+		suite.Require().NoError(err)
+
+		expHeights = append(expHeights, path.EndpointA.GetClientLatestHeight().(clienttypes.Height))
+	}
+
+	ctx := suite.chainA.GetContext()
+	queryHelper := &baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: suite.chainA.App.GetBaseApp().GRPCQueryRouter(),
+		Ctx:             ctx,
+	}
+	queryClient := ibctm.NewQueryClient(queryHelper)
+
+	res, err := queryClient.ConsensusStatesMetadata(ctx, &ibctm.QueryConsensusStatesMetadataRequest{ClientId: path.EndpointA.ClientID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Metadata, len(expHeights))
+
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+	for i, metadata := range res.Metadata {
+		// metadata is returned in ascending height order
+		suite.Require().Equal(expHeights[i], metadata.Height)
+
+		expProcessedTime, found := ibctm.GetProcessedTime(clientStore, expHeights[i])
+		suite.Require().True(found)
+		suite.Require().Equal(expProcessedTime, metadata.ProcessedTime)
+	}
+
+	// paginated query
+	res, err = queryClient.ConsensusStatesMetadata(ctx, &ibctm.QueryConsensusStatesMetadataRequest{
+		ClientId:   path.EndpointA.ClientID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Metadata, 1)
+	suite.Require().Equal(expHeights[0], res.Metadata[0].Height)
+	suite.Require().Equal(uint64(len(expHeights)), res.Pagination.Total)
+
+	// invalid client type
+	_, err = queryClient.ConsensusStatesMetadata(ctx, &ibctm.QueryConsensusStatesMetadataRequest{ClientId: clienttypes.FormatClientIdentifier("06-solomachine", 0)})
+	suite.Require().Error(err)
+}
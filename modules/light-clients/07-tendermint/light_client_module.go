@@ -3,7 +3,10 @@ package tendermint
 import (
 	"fmt"
 
+	"github.com/cosmos/gogoproto/grpc"
+
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,21 +17,56 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint/internal/keeper"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule              = (*LightClientModule)(nil)
+	_ exported.LightClientModuleQueryService  = (*LightClientModule)(nil)
+	_ exported.MisbehaviourEvidenceClassifier = (*LightClientModule)(nil)
+	_ exported.DuplicateClientMessageDetector = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
-	keeper        keeper.Keeper
-	storeProvider exported.ClientStoreProvider
+	keeper                   keeper.Keeper
+	storeProvider            exported.ClientStoreProvider
+	signatureVerifier        SignatureVerifier
+	validatorSetContinuity   bool
+	signatureVerificationGas storetypes.Gas
 }
 
 // NewLightClientModule creates and returns a new 07-tendermint LightClientModule.
+// Commit signatures are verified by the default SignatureVerifier unless a different SignatureVerifier is set.
 func NewLightClientModule(cdc codec.BinaryCodec, authority string) LightClientModule {
 	return LightClientModule{
-		keeper: keeper.NewKeeper(cdc, authority),
+		keeper:            keeper.NewKeeper(cdc, authority),
+		signatureVerifier: NewDefaultSignatureVerifier(),
 	}
 }
 
+// WithSignatureVerifier sets the SignatureVerifier used to verify the commit signatures of headers
+// and misbehaviour in place of the default SignatureVerifier. It must be called
+// before the LightClientModule is added to the client router.
+func (l *LightClientModule) WithSignatureVerifier(verifier SignatureVerifier) {
+	l.signatureVerifier = verifier
+}
+
+// WithValidatorSetContinuityFastPath enables the validator set continuity fast path for header verification.
+// Non-adjacent headers whose validator set equals the next validator set of the trusted consensus state are
+// verified like adjacent headers, skipping the trust level verification of the commit against the trusted
+// validators, which is implied by more than 2/3 of the same validators having signed the commit. The fast path
+// is only taken for clients with a trust level of at most 2/3. It must be called before the LightClientModule
+// is added to the client router.
+func (l *LightClientModule) WithValidatorSetContinuityFastPath() {
+	l.validatorSetContinuity = true
+}
+
+// WithSignatureVerificationGas sets the gas consumed for every commit signature checked by a verification pass
+// when verifying headers and misbehaviour. Headers verified using the validator set continuity fast path require
+// a single verification pass instead of two. Commit signature verification consumes no gas by default.
+// It must be called before the LightClientModule is added to the client router.
+func (l *LightClientModule) WithSignatureVerificationGas(gasPerSignature uint64) {
+	l.signatureVerificationGas = gasPerSignature
+}
+
 // RegisterStoreProvider is called by core IBC when a LightClientModule is added to the router.
 // It allows the LightClientModule to set a ClientStoreProvider which supplies isolated prefix client stores
 // to IBC light client instances.
@@ -36,6 +74,12 @@ func (l *LightClientModule) RegisterStoreProvider(storeProvider exported.ClientS
 	l.storeProvider = storeProvider
 }
 
+// RegisterQueryService is called by core IBC when the gRPC services are registered.
+// It registers the 07-tendermint query service which serves queries against the tendermint client stores.
+func (l LightClientModule) RegisterQueryService(server grpc.Server) {
+	RegisterQueryServer(server, queryServer{lightClientModule: l})
+}
+
 // Initialize unmarshals the provided client and consensus states and performs basic validation. It calls into the
 // clientState.Initialize method.
 //
@@ -64,7 +108,8 @@ func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientSt
 	return clientState.Initialize(ctx, l.keeper.Codec(), clientStore, &consensusState)
 }
 
-// VerifyClientMessage obtains the client state associated with the client identifier and calls into the clientState.VerifyClientMessage method.
+// VerifyClientMessage obtains the client state associated with the client identifier and verifies the client message
+// using the SignatureVerifier and the header verification options of the LightClientModule.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
 func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
@@ -76,7 +121,12 @@ func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string,
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
-	return clientState.VerifyClientMessage(ctx, cdc, clientStore, clientMsg)
+	verifier := l.signatureVerifier
+	if l.signatureVerificationGas > 0 {
+		verifier = newGasMeteredSignatureVerifier(verifier, ctx.GasMeter(), l.signatureVerificationGas)
+	}
+
+	return clientState.verifyClientMessage(ctx, cdc, clientStore, clientMsg, verifier, l.validatorSetContinuity)
 }
 
 // CheckForMisbehaviour obtains the client state associated with the client identifier and calls into the clientState.CheckForMisbehaviour method.
@@ -94,6 +144,36 @@ func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string
 	return clientState.CheckForMisbehaviour(ctx, cdc, clientStore, clientMsg)
 }
 
+// MisbehaviourEvidenceType obtains the client state associated with the client identifier and calls into the clientState.MisbehaviourEvidenceType method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) MisbehaviourEvidenceType(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) string {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.MisbehaviourEvidenceType(ctx, cdc, clientStore, clientMsg)
+}
+
+// IsDuplicateClientMessage obtains the client state associated with the client identifier and calls into the clientState.IsDuplicateClientMessage method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) IsDuplicateClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.IsDuplicateClientMessage(cdc, clientStore, clientMsg)
+}
+
 // UpdateStateOnMisbehaviour obtains the client state associated with the client identifier and calls into the clientState.UpdateStateOnMisbehaviour method.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
@@ -251,6 +331,35 @@ func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteCl
 	return clientState.CheckSubstituteAndUpdateState(ctx, cdc, clientStore, substituteClientStore, substituteClient)
 }
 
+// MismatchedSubstituteFields obtains the client states associated with the subject and substitute client identifiers and
+// returns the names of the client state fields which must match for the subject client to be recovered by the substitute.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) MismatchedSubstituteFields(ctx sdk.Context, clientID, substituteClientID string) ([]string, error) {
+	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if substituteClientType != exported.Tendermint {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Tendermint, substituteClientType)
+	}
+
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(l.storeProvider.ClientStore(ctx, clientID), cdc)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	substituteClientState, found := getClientState(l.storeProvider.ClientStore(ctx, substituteClientID), cdc)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
+	}
+
+	return MismatchedClientStateFields(*clientState, *substituteClientState), nil
+}
+
 // VerifyUpgradeAndUpdateState obtains the client state associated with the client identifier and calls into the clientState.VerifyUpgradeAndUpdateState method.
 // The new client and consensus states will be unmarshaled and an error is returned if the new client state is not at a height greater
 // than the existing client.
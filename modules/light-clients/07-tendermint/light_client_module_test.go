@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -1020,3 +1023,92 @@ func (suite *TendermintTestSuite) TestVerifyNonMembership() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestVerifyClientMessageValidatorSetContinuity() {
+	const gasPerSignature = 1000
+
+	var (
+		path      *ibctesting.Path
+		expSkip   bool
+		numBlocks uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"non-adjacent header with unchanged validator set: trust level verification is skipped",
+			func() {},
+		},
+		{
+			"adjacent header: single verification pass with or without the fast path",
+			func() {
+				numBlocks = 1
+				expSkip = false
+			},
+		},
+		{
+			"trust level greater than 2/3: trust level verification is not skipped",
+			func() {
+				tmConfig, ok := path.EndpointA.ClientConfig.(*ibctesting.TendermintConfig)
+				suite.Require().True(ok)
+
+				tmConfig.TrustLevel = ibctm.NewFractionFromTm(cmtmath.Fraction{Numerator: 3, Denominator: 4})
+				expSkip = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			expSkip = true
+			numBlocks = 3
+
+			tc.malleate()
+
+			err := path.EndpointA.CreateClient()
+			suite.Require().NoError(err)
+
+			suite.coordinator.CommitNBlocks(suite.chainB, numBlocks)
+
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+			suite.Require().NoError(err)
+
+			storeProvider := clienttypes.NewStoreProvider(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+			authority := suite.chainA.GetSimApp().IBCKeeper.GetAuthority()
+
+			verifyGasConsumed := func(validatorSetContinuity bool) uint64 {
+				lightClientModule := ibctm.NewLightClientModule(suite.chainA.Codec, authority)
+				lightClientModule.WithSignatureVerificationGas(gasPerSignature)
+				if validatorSetContinuity {
+					lightClientModule.WithValidatorSetContinuityFastPath()
+				}
+				lightClientModule.RegisterStoreProvider(storeProvider)
+
+				ctx := suite.chainA.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+				err := lightClientModule.VerifyClientMessage(ctx, path.EndpointA.ClientID, header)
+				suite.Require().NoError(err)
+
+				return ctx.GasMeter().GasConsumed()
+			}
+
+			gasConsumed := verifyGasConsumed(false)
+			gasConsumedFastPath := verifyGasConsumed(true)
+
+			var expGasSaved uint64
+			if expSkip {
+				expGasSaved = gasPerSignature * uint64(suite.chainB.Vals.Size())
+			}
+
+			suite.Require().Equal(expGasSaved, gasConsumed-gasConsumedFastPath)
+		})
+	}
+}
@@ -19,7 +19,25 @@ import (
 
 // CheckForMisbehaviour detects duplicate height misbehaviour and BFT time violation misbehaviour
 // in a submitted Header message and verifies the correctness of a submitted Misbehaviour ClientMessage
-func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+	_, found := cs.checkForMisbehaviour(ctx, cdc, clientStore, msg)
+	return found
+}
+
+// MisbehaviourEvidenceType returns the type of misbehaviour evidence contained in the provided ClientMessage.
+// Conflicting headers at the same height are reported as duplicate height evidence and headers which break
+// the monotonic time ordering of consensus states are reported as time monotonicity evidence.
+func (cs ClientState) MisbehaviourEvidenceType(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) string {
+	evidenceType, found := cs.checkForMisbehaviour(ctx, cdc, clientStore, msg)
+	if !found {
+		return clienttypes.EvidenceTypeUnknown
+	}
+
+	return evidenceType
+}
+
+// checkForMisbehaviour returns true along with the evidence type if misbehaviour is detected in the provided ClientMessage.
+func (ClientState) checkForMisbehaviour(_ sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) (string, bool) {
 	switch msg := msg.(type) {
 	case *Header:
 		tmHeader := msg
@@ -32,12 +50,12 @@ func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec,
 			// This header has already been submitted and the necessary state is already stored
 			// in client store, thus we can return early without further validation.
 			if reflect.DeepEqual(existingConsState, tmHeader.ConsensusState()) { //nolint:gosimple
-				return false
+				return "", false
 			}
 
 			// A consensus state already exists for this height, but it does not match the provided header.
 			// The assumption is that Header has already been validated. Thus we can return true as misbehaviour is present
-			return true
+			return clienttypes.EvidenceTypeDuplicateHeight, true
 		}
 
 		// Check that consensus state timestamps are monotonic
@@ -46,12 +64,12 @@ func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec,
 		// if previous consensus state exists, check consensus state time is greater than previous consensus state time
 		// if previous consensus state is not before current consensus state return true
 		if prevOk && !prevCons.Timestamp.Before(consState.Timestamp) {
-			return true
+			return clienttypes.EvidenceTypeTimeMonotonicity, true
 		}
 		// if next consensus state exists, check consensus state time is less than next consensus state time
 		// if next consensus state is not after current consensus state return true
 		if nextOk && !nextCons.Timestamp.After(consState.Timestamp) {
-			return true
+			return clienttypes.EvidenceTypeTimeMonotonicity, true
 		}
 	case *Misbehaviour:
 		// if heights are equal check that this is valid misbehaviour of a fork
@@ -59,27 +77,27 @@ func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec,
 		if msg.Header1.GetHeight().EQ(msg.Header2.GetHeight()) {
 			blockID1, err := cmttypes.BlockIDFromProto(&msg.Header1.SignedHeader.Commit.BlockID)
 			if err != nil {
-				return false
+				return "", false
 			}
 
 			blockID2, err := cmttypes.BlockIDFromProto(&msg.Header2.SignedHeader.Commit.BlockID)
 			if err != nil {
-				return false
+				return "", false
 			}
 
 			// Ensure that Commit Hashes are different
 			if !bytes.Equal(blockID1.Hash, blockID2.Hash) {
-				return true
+				return clienttypes.EvidenceTypeDuplicateHeight, true
 			}
 
 		} else if !msg.Header1.SignedHeader.Header.Time.After(msg.Header2.SignedHeader.Header.Time) {
 			// Header1 is at greater height than Header2, therefore Header1 time must be less than or equal to
 			// Header2 time in order to be valid misbehaviour (violation of monotonic time).
-			return true
+			return clienttypes.EvidenceTypeTimeMonotonicity, true
 		}
 	}
 
-	return false
+	return "", false
 }
 
 // verifyMisbehaviour determines whether or not two conflicting
@@ -90,7 +108,7 @@ func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec,
 // Similarly, consensusState2 is the trusted consensus state that corresponds
 // to misbehaviour.Header2
 // Misbehaviour sets frozen height to {0, 1} since it is only used as a boolean value (zero or non-zero).
-func (cs *ClientState) verifyMisbehaviour(ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, misbehaviour *Misbehaviour) error {
+func (cs *ClientState) verifyMisbehaviour(ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, misbehaviour *Misbehaviour, verifier SignatureVerifier) error {
 	// Regardless of the type of misbehaviour, ensure that both headers are valid and would have been accepted by light-client
 
 	// Retrieve trusted consensus states for each Header in misbehaviour
@@ -110,12 +128,12 @@ func (cs *ClientState) verifyMisbehaviour(ctx sdk.Context, clientStore storetype
 	// misbehaviour.ValidateBasic by the client keeper and msg.ValidateBasic
 	// by the base application.
 	if err := checkMisbehaviourHeader(
-		cs, tmConsensusState1, misbehaviour.Header1, ctx.BlockTime(),
+		cs, tmConsensusState1, misbehaviour.Header1, ctx.BlockTime(), verifier,
 	); err != nil {
 		return errorsmod.Wrap(err, "verifying Header1 in Misbehaviour failed")
 	}
 	if err := checkMisbehaviourHeader(
-		cs, tmConsensusState2, misbehaviour.Header2, ctx.BlockTime(),
+		cs, tmConsensusState2, misbehaviour.Header2, ctx.BlockTime(), verifier,
 	); err != nil {
 		return errorsmod.Wrap(err, "verifying Header2 in Misbehaviour failed")
 	}
@@ -126,7 +144,7 @@ func (cs *ClientState) verifyMisbehaviour(ctx sdk.Context, clientStore storetype
 // checkMisbehaviourHeader checks that a Header in Misbehaviour is valid misbehaviour given
 // a trusted ConsensusState
 func checkMisbehaviourHeader(
-	clientState *ClientState, consState *ConsensusState, header *Header, currentTimestamp time.Time,
+	clientState *ClientState, consState *ConsensusState, header *Header, currentTimestamp time.Time, verifier SignatureVerifier,
 ) error {
 	tmTrustedValset, err := cmttypes.ValidatorSetFromProto(header.TrustedValidators)
 	if err != nil {
@@ -163,8 +181,8 @@ func checkMisbehaviourHeader(
 
 	// - ValidatorSet must have TrustLevel similarity with trusted FromValidatorSet
 	// - ValidatorSets on both headers are valid given the last trusted ValidatorSet
-	if err := tmTrustedValset.VerifyCommitLightTrusting(
-		chainID, tmCommit, clientState.TrustLevel.ToTendermint(),
+	if err := verifier.VerifyCommitLightTrusting(
+		chainID, tmTrustedValset, tmCommit, clientState.TrustLevel.ToTendermint(),
 	); err != nil {
 		return errorsmod.Wrapf(clienttypes.ErrInvalidMisbehaviour, "validator set in header has too much change from trusted validator set: %v", err)
 	}
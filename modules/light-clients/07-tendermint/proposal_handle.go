@@ -2,6 +2,7 @@ package tendermint
 
 import (
 	"reflect"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -84,6 +85,13 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 // IsMatchingClientState returns true if all the client state parameters match
 // except for frozen height, latest height, trusting period, chain-id.
 func IsMatchingClientState(subject, substitute ClientState) bool {
+	return len(MismatchedClientStateFields(subject, substitute)) == 0
+}
+
+// MismatchedClientStateFields returns the protobuf names of the client state parameters which
+// differ between the subject and substitute client states. Frozen height, latest height, trusting
+// period and chain-id are not required to match and are never reported.
+func MismatchedClientStateFields(subject, substitute ClientState) []string {
 	// zero out parameters which do not need to match
 	subject.LatestHeight = clienttypes.ZeroHeight()
 	subject.FrozenHeight = clienttypes.ZeroHeight()
@@ -99,5 +107,26 @@ func IsMatchingClientState(subject, substitute ClientState) bool {
 	subject.AllowUpdateAfterMisbehaviour = true
 	substitute.AllowUpdateAfterMisbehaviour = true
 
-	return reflect.DeepEqual(subject, substitute)
+	var mismatched []string
+	subjectValue, substituteValue := reflect.ValueOf(subject), reflect.ValueOf(substitute)
+	for i := 0; i < subjectValue.NumField(); i++ {
+		if reflect.DeepEqual(subjectValue.Field(i).Interface(), substituteValue.Field(i).Interface()) {
+			continue
+		}
+
+		mismatched = append(mismatched, protoFieldName(subjectValue.Type().Field(i)))
+	}
+
+	return mismatched
+}
+
+// protoFieldName returns the protobuf name of the provided struct field, falling back to the Go field name.
+func protoFieldName(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name, found := strings.CutPrefix(option, "name="); found {
+			return name
+		}
+	}
+
+	return field.Name
 }
@@ -239,3 +239,60 @@ func (suite *TendermintTestSuite) TestIsMatchingClientState() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestMismatchedClientStateFields() {
+	var subjectClientState, substituteClientState *ibctm.ClientState
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expMismatch []string
+	}{
+		{
+			"matching clients", func() {}, nil,
+		},
+		{
+			"matching, ignored fields are different", func() {
+				subjectClientState.FrozenHeight = frozenHeight
+				subjectClientState.LatestHeight = clienttypes.NewHeight(0, 10)
+				subjectClientState.ChainId = "bitcoin"
+				subjectClientState.TrustingPeriod = time.Hour * 10
+			}, nil,
+		},
+		{
+			"not matching, trust level is different", func() {
+				subjectClientState.TrustLevel = ibctm.Fraction{2, 3}
+				substituteClientState.TrustLevel = ibctm.Fraction{1, 3}
+			}, []string{"trust_level"},
+		},
+		{
+			"not matching, unbonding period and upgrade path are different", func() {
+				substituteClientState.UnbondingPeriod += time.Minute
+				substituteClientState.UpgradePath = []string{"custom", "path"}
+			}, []string{"unbonding_period", "upgrade_path"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+			substitutePath.SetupClients()
+
+			var ok bool
+			subjectClientState, ok = suite.chainA.GetClientState(subjectPath.EndpointA.ClientID).(*ibctm.ClientState)
+			suite.Require().True(ok)
+			substituteClientState, ok = suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			suite.Require().Equal(tc.expMismatch, ibctm.MismatchedClientStateFields(*subjectClientState, *substituteClientState))
+		})
+	}
+}
@@ -0,0 +1,1916 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/tendermint/v1/query.proto
+
+package tendermint
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryTrustingPeriodRemainingRequest is the request type for the Query/TrustingPeriodRemaining RPC method.
+type QueryTrustingPeriodRemainingRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryTrustingPeriodRemainingRequest) Reset()         { *m = QueryTrustingPeriodRemainingRequest{} }
+func (m *QueryTrustingPeriodRemainingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustingPeriodRemainingRequest) ProtoMessage()    {}
+func (*QueryTrustingPeriodRemainingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438fe431d47114d1, []int{0}
+}
+func (m *QueryTrustingPeriodRemainingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustingPeriodRemainingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustingPeriodRemainingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrustingPeriodRemainingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustingPeriodRemainingRequest.Merge(m, src)
+}
+func (m *QueryTrustingPeriodRemainingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustingPeriodRemainingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustingPeriodRemainingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrustingPeriodRemainingRequest proto.InternalMessageInfo
+
+func (m *QueryTrustingPeriodRemainingRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryTrustingPeriodRemainingResponse is the response type for the Query/TrustingPeriodRemaining RPC method.
+type QueryTrustingPeriodRemainingResponse struct {
+	// remaining duration of the trusting period, zero if the trusting period has elapsed
+	Remaining time.Duration `protobuf:"bytes,1,opt,name=remaining,proto3,stdduration" json:"remaining"`
+	// time at which the client expires, unless it is updated
+	ExpirationTime time.Time `protobuf:"bytes,2,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+}
+
+func (m *QueryTrustingPeriodRemainingResponse) Reset()         { *m = QueryTrustingPeriodRemainingResponse{} }
+func (m *QueryTrustingPeriodRemainingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustingPeriodRemainingResponse) ProtoMessage()    {}
+func (*QueryTrustingPeriodRemainingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438fe431d47114d1, []int{1}
+}
+func (m *QueryTrustingPeriodRemainingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustingPeriodRemainingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustingPeriodRemainingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrustingPeriodRemainingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustingPeriodRemainingResponse.Merge(m, src)
+}
+func (m *QueryTrustingPeriodRemainingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustingPeriodRemainingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustingPeriodRemainingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrustingPeriodRemainingResponse proto.InternalMessageInfo
+
+func (m *QueryTrustingPeriodRemainingResponse) GetRemaining() time.Duration {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *QueryTrustingPeriodRemainingResponse) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
+// ConsensusStateMetadata defines the metadata stored for a consensus state, which is used to enforce the
+// connection delay period.
+type ConsensusStateMetadata struct {
+	// height of the consensus state
+	Height types.Height `protobuf:"bytes,1,opt,name=height,proto3" json:"height"`
+	// time (in nanoseconds) at which the consensus state was created on this chain
+	ProcessedTime uint64 `protobuf:"varint,2,opt,name=processed_time,json=processedTime,proto3" json:"processed_time,omitempty"`
+	// height of this chain at which the consensus state was created
+	ProcessedHeight types.Height `protobuf:"bytes,3,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height"`
+}
+
+func (m *ConsensusStateMetadata) Reset()         { *m = ConsensusStateMetadata{} }
+func (m *ConsensusStateMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStateMetadata) ProtoMessage()    {}
+func (*ConsensusStateMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438fe431d47114d1, []int{2}
+}
+func (m *ConsensusStateMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusStateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusStateMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusStateMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStateMetadata.Merge(m, src)
+}
+func (m *ConsensusStateMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusStateMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStateMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStateMetadata proto.InternalMessageInfo
+
+func (m *ConsensusStateMetadata) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+func (m *ConsensusStateMetadata) GetProcessedTime() uint64 {
+	if m != nil {
+		return m.ProcessedTime
+	}
+	return 0
+}
+
+func (m *ConsensusStateMetadata) GetProcessedHeight() types.Height {
+	if m != nil {
+		return m.ProcessedHeight
+	}
+	return types.Height{}
+}
+
+// QueryConsensusStateMetadataRequest is the request type for the Query/ConsensusStateMetadata RPC method.
+type QueryConsensusStateMetadataRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// consensus state revision number
+	RevisionNumber uint64 `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// consensus state revision height
+	RevisionHeight uint64 `protobuf:"varint,3,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (m *QueryConsensusStateMetadataRequest) Reset()         { *m = QueryConsensusStateMetadataRequest{} }
+func (m *QueryConsensusStateMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStateMetadataRequest) ProtoMessage()    {}
+func (*QueryConsensusStateMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438fe431d47114d1, []int{3}
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateMetadataRequest.Merge(m, src)
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStateMetadataRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConsensusStateMetadataRequest) GetRevisionNumber() uint64 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+func (m *QueryConsensusStateMetadataRequest) GetRevisionHeight() uint64 {
+	if m != nil {
+		return m.RevisionHeight
+	}
+	return 0
+}
+
+// QueryConsensusStateMetadataResponse is the response type for the Query/ConsensusStateMetadata RPC method.
+type QueryConsensusStateMetadataResponse struct {
+	// metadata of the consensus state at the requested height
+	Metadata ConsensusStateMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *QueryConsensusStateMetadataResponse) Reset()         { *m = QueryConsensusStateMetadataResponse{} }
+func (m *QueryConsensusStateMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStateMetadataResponse) ProtoMessage()    {}
+func (*QueryConsensusStateMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438fe431d47114d1, []int{4}
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateMetadataResponse.Merge(m, src)
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStateMetadataResponse) GetMetadata() ConsensusStateMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return ConsensusStateMetadata{}
+}
+
+// QueryConsensusStatesMetadataRequest is the request type for the Query/ConsensusStatesMetadata RPC method.
+type QueryConsensusStatesMetadataRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsensusStatesMetadataRequest) Reset()         { *m = QueryConsensusStatesMetadataRequest{} }
+func (m *QueryConsensusStatesMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStatesMetadataRequest) ProtoMessage()    {}
+func (*QueryConsensusStatesMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438fe431d47114d1, []int{5}
+}
+func (m *QueryConsensusStatesMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStatesMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStatesMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStatesMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStatesMetadataRequest.Merge(m, src)
+}
+func (m *QueryConsensusStatesMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStatesMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStatesMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStatesMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStatesMetadataRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConsensusStatesMetadataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConsensusStatesMetadataResponse is the response type for the Query/ConsensusStatesMetadata RPC method.
+type QueryConsensusStatesMetadataResponse struct {
+	// metadata of the consensus states of the client
+	Metadata []ConsensusStateMetadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsensusStatesMetadataResponse) Reset()         { *m = QueryConsensusStatesMetadataResponse{} }
+func (m *QueryConsensusStatesMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStatesMetadataResponse) ProtoMessage()    {}
+func (*QueryConsensusStatesMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_438fe431d47114d1, []int{6}
+}
+func (m *QueryConsensusStatesMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStatesMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStatesMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStatesMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStatesMetadataResponse.Merge(m, src)
+}
+func (m *QueryConsensusStatesMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStatesMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStatesMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStatesMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStatesMetadataResponse) GetMetadata() []ConsensusStateMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *QueryConsensusStatesMetadataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryTrustingPeriodRemainingRequest)(nil), "ibc.lightclients.tendermint.v1.QueryTrustingPeriodRemainingRequest")
+	proto.RegisterType((*QueryTrustingPeriodRemainingResponse)(nil), "ibc.lightclients.tendermint.v1.QueryTrustingPeriodRemainingResponse")
+	proto.RegisterType((*ConsensusStateMetadata)(nil), "ibc.lightclients.tendermint.v1.ConsensusStateMetadata")
+	proto.RegisterType((*QueryConsensusStateMetadataRequest)(nil), "ibc.lightclients.tendermint.v1.QueryConsensusStateMetadataRequest")
+	proto.RegisterType((*QueryConsensusStateMetadataResponse)(nil), "ibc.lightclients.tendermint.v1.QueryConsensusStateMetadataResponse")
+	proto.RegisterType((*QueryConsensusStatesMetadataRequest)(nil), "ibc.lightclients.tendermint.v1.QueryConsensusStatesMetadataRequest")
+	proto.RegisterType((*QueryConsensusStatesMetadataResponse)(nil), "ibc.lightclients.tendermint.v1.QueryConsensusStatesMetadataResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/tendermint/v1/query.proto", fileDescriptor_438fe431d47114d1)
+}
+
+var fileDescriptor_438fe431d47114d1 = []byte{
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0x14, 0x4d,
+	0x10, 0xde, 0x01, 0x5e, 0x02, 0xfd, 0xe6, 0x85, 0x37, 0x1d, 0x23, 0xb8, 0x9a, 0x59, 0x33, 0xa8,
+	0x18, 0x12, 0xba, 0x5d, 0x4c, 0x94, 0xc4, 0x93, 0x0b, 0xf1, 0x1b, 0xc4, 0x15, 0x13, 0xe3, 0x65,
+	0x33, 0x1f, 0xed, 0xd0, 0xc9, 0xce, 0xf4, 0x30, 0xdd, 0xb3, 0xd1, 0x10, 0xa2, 0xd1, 0x1b, 0x27,
+	0x12, 0x2e, 0xfe, 0x0a, 0x2f, 0x5e, 0xfc, 0x05, 0x06, 0x6f, 0x24, 0x5e, 0x3c, 0xa9, 0x01, 0x7f,
+	0x85, 0x5e, 0x4c, 0x4f, 0xf7, 0xec, 0xac, 0xb8, 0xbb, 0xac, 0xc0, 0xad, 0xb7, 0xba, 0xea, 0xa9,
+	0xe7, 0xa9, 0xae, 0xaa, 0x1d, 0x30, 0x45, 0x1d, 0x17, 0xd7, 0xa9, 0xbf, 0x22, 0xdc, 0x3a, 0x25,
+	0xa1, 0xe0, 0x58, 0x90, 0xd0, 0x23, 0x71, 0x40, 0x43, 0x81, 0x1b, 0x65, 0xbc, 0x9a, 0x90, 0xf8,
+	0x39, 0x8a, 0x62, 0x26, 0x18, 0x34, 0xa9, 0xe3, 0xa2, 0x56, 0x5f, 0x94, 0xfb, 0xa2, 0x46, 0xb9,
+	0x38, 0xe5, 0x32, 0x1e, 0x30, 0x8e, 0x1d, 0x9b, 0x13, 0x15, 0x88, 0x1b, 0x65, 0x87, 0x08, 0xbb,
+	0x8c, 0x23, 0xdb, 0xa7, 0xa1, 0x2d, 0x28, 0x0b, 0x15, 0x56, 0xf1, 0x84, 0xcf, 0x7c, 0x96, 0x1e,
+	0xb1, 0x3c, 0x69, 0xeb, 0x19, 0x9f, 0x31, 0xbf, 0x4e, 0xb0, 0x1d, 0x51, 0x6c, 0x87, 0x21, 0x13,
+	0x69, 0x08, 0xd7, 0xb7, 0x25, 0xc9, 0xd5, 0x65, 0x31, 0xc1, 0x2a, 0xbf, 0xe4, 0xa7, 0x4e, 0xda,
+	0xc1, 0xd4, 0xe1, 0xe9, 0x2f, 0x27, 0x79, 0x8a, 0xbd, 0x24, 0x6e, 0x4d, 0x5a, 0xda, 0x7f, 0x2f,
+	0x68, 0x40, 0xb8, 0xb0, 0x83, 0x48, 0x39, 0x58, 0x15, 0x30, 0xf1, 0x40, 0xf2, 0x5e, 0x8e, 0x13,
+	0x2e, 0x68, 0xe8, 0x2f, 0x91, 0x98, 0x32, 0xaf, 0x4a, 0x02, 0x9b, 0x86, 0x34, 0xf4, 0xab, 0x64,
+	0x35, 0x21, 0x5c, 0xc0, 0xd3, 0x60, 0x58, 0xe5, 0xad, 0x51, 0x6f, 0xdc, 0x38, 0x6b, 0x5c, 0x1c,
+	0xae, 0x0e, 0x29, 0xc3, 0x6d, 0xcf, 0x7a, 0x6f, 0x80, 0x73, 0xdd, 0x41, 0x78, 0xc4, 0x42, 0x4e,
+	0xe0, 0x75, 0x30, 0x1c, 0x67, 0xc6, 0x14, 0xe5, 0xdf, 0x99, 0x53, 0x48, 0x31, 0x44, 0x19, 0x43,
+	0x34, 0xaf, 0x15, 0x54, 0x86, 0xb6, 0xbf, 0x94, 0x0a, 0x6f, 0xbe, 0x96, 0x8c, 0x6a, 0x1e, 0x05,
+	0x17, 0xc0, 0x28, 0x79, 0x16, 0x51, 0xe5, 0x52, 0x93, 0x6a, 0xc6, 0xfb, 0x52, 0xa0, 0xe2, 0x1f,
+	0x40, 0xcb, 0x99, 0x54, 0x85, 0xb4, 0x29, 0x91, 0x46, 0xf2, 0x60, 0x79, 0x6d, 0x7d, 0x30, 0xc0,
+	0xc9, 0x39, 0xc9, 0x2d, 0xe4, 0x09, 0x7f, 0x28, 0x6c, 0x41, 0x16, 0x88, 0xb0, 0x3d, 0x5b, 0xd8,
+	0x70, 0x16, 0x0c, 0xae, 0x10, 0xf9, 0xf4, 0x9a, 0x69, 0x11, 0xc9, 0x66, 0x90, 0x8f, 0x81, 0xf4,
+	0x13, 0x34, 0xca, 0xe8, 0x56, 0xea, 0x51, 0x19, 0x90, 0x09, 0xaa, 0xda, 0x1f, 0x9e, 0x07, 0x23,
+	0x51, 0xcc, 0x5c, 0xc2, 0x39, 0xf1, 0x72, 0x8a, 0x03, 0xd5, 0xff, 0x9a, 0x56, 0x99, 0x1b, 0xde,
+	0x05, 0xff, 0xe7, 0x6e, 0x3a, 0x55, 0x7f, 0x8f, 0xa9, 0x46, 0x9b, 0x91, 0xca, 0x6c, 0x6d, 0x19,
+	0xc0, 0x4a, 0xdf, 0xa0, 0xbd, 0x9a, 0x5e, 0xde, 0x11, 0x4e, 0x82, 0xd1, 0x98, 0x34, 0x28, 0x97,
+	0x95, 0x0d, 0x93, 0xc0, 0x21, 0xb1, 0x26, 0x3e, 0x92, 0x99, 0x17, 0x53, 0xeb, 0x6f, 0x8e, 0x2d,
+	0xc4, 0x5b, 0x1c, 0x35, 0xab, 0x17, 0x60, 0xa2, 0x2b, 0x29, 0xdd, 0x17, 0x8f, 0xc1, 0x50, 0xa0,
+	0x6d, 0xba, 0xd8, 0x57, 0x50, 0xf7, 0xc9, 0x43, 0xed, 0x11, 0x75, 0x75, 0x9a, 0x68, 0xd6, 0x86,
+	0xd1, 0x96, 0x01, 0xff, 0xab, 0xba, 0xdc, 0x00, 0x20, 0x9f, 0x66, 0xdd, 0x6e, 0x17, 0x90, 0x1a,
+	0x7d, 0x24, 0x47, 0x1f, 0xa9, 0x9d, 0xa1, 0x47, 0x1f, 0x2d, 0xd9, 0x3e, 0xd1, 0xc0, 0xd5, 0x96,
+	0x48, 0xeb, 0x63, 0x36, 0x27, 0x1d, 0xc9, 0xb4, 0xad, 0x47, 0xff, 0xf1, 0xd5, 0x03, 0xde, 0x6c,
+	0x23, 0x65, 0xf2, 0x40, 0x29, 0x8a, 0x56, 0xab, 0x96, 0x99, 0x77, 0x83, 0xe0, 0x9f, 0x54, 0x0b,
+	0xfc, 0x69, 0x80, 0xb1, 0x0e, 0x83, 0x0f, 0xe7, 0x0e, 0xa2, 0xdd, 0xc3, 0xee, 0x29, 0xce, 0x1f,
+	0x0d, 0x44, 0x91, 0xb7, 0x1e, 0xbd, 0xfa, 0xf4, 0x7d, 0xab, 0xef, 0x3e, 0x5c, 0xc0, 0x07, 0xec,
+	0xff, 0xcc, 0xba, 0xd6, 0x6c, 0x88, 0x75, 0x2c, 0x34, 0x78, 0x2d, 0x4a, 0xd1, 0x6b, 0xf9, 0x3e,
+	0x7a, 0xdb, 0xd7, 0x71, 0x81, 0x54, 0x7a, 0xe2, 0xdd, 0x75, 0x5e, 0x8b, 0x73, 0x47, 0xc2, 0xd0,
+	0xd2, 0x37, 0x8c, 0x54, 0xfb, 0x6b, 0x03, 0xbe, 0x34, 0x0e, 0xa3, 0xde, 0xcd, 0xe0, 0x6b, 0x5c,
+	0xe2, 0xd7, 0xb2, 0x6e, 0xc2, 0xd9, 0xb4, 0xe3, 0xb5, 0x7d, 0x7b, 0x63, 0x1d, 0xab, 0xb5, 0xd0,
+	0x72, 0xa1, 0x0c, 0xeb, 0xf0, 0x87, 0x01, 0xc6, 0x3a, 0xf4, 0x3f, 0x3c, 0x8c, 0xda, 0xfd, 0xa3,
+	0x5c, 0x9c, 0x3f, 0x1a, 0x88, 0xae, 0xd9, 0x72, 0x5a, 0xb2, 0x45, 0x78, 0xef, 0x38, 0x0b, 0x56,
+	0xf1, 0xb6, 0x77, 0x4d, 0x63, 0x67, 0xd7, 0x34, 0xbe, 0xed, 0x9a, 0xc6, 0xe6, 0x9e, 0x59, 0xd8,
+	0xd9, 0x33, 0x0b, 0x9f, 0xf7, 0xcc, 0xc2, 0x93, 0x3b, 0x3e, 0x15, 0x2b, 0x89, 0x83, 0x5c, 0x16,
+	0x60, 0xfd, 0x51, 0x41, 0x1d, 0x77, 0xda, 0x67, 0xb8, 0x31, 0x8b, 0x03, 0xe6, 0x25, 0x75, 0xc2,
+	0x15, 0x8d, 0xe9, 0x2c, 0xe3, 0xa5, 0xab, 0xd3, 0x39, 0x95, 0x6b, 0xf9, 0xd1, 0x19, 0x4c, 0xff,
+	0x02, 0x2f, 0xff, 0x1a, 0x00, 0xcb, 0x92, 0x92, 0xab, 0xea, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TrustingPeriodRemaining queries the remaining duration of the trusting period of a tendermint client.
+	TrustingPeriodRemaining(ctx context.Context, in *QueryTrustingPeriodRemainingRequest, opts ...grpc.CallOption) (*QueryTrustingPeriodRemainingResponse, error)
+	// ConsensusStateMetadata queries the processed time and processed height of the consensus state of a tendermint
+	// client at the given height.
+	ConsensusStateMetadata(ctx context.Context, in *QueryConsensusStateMetadataRequest, opts ...grpc.CallOption) (*QueryConsensusStateMetadataResponse, error)
+	// ConsensusStatesMetadata queries the processed time and processed height of all consensus states of a tendermint
+	// client in ascending height order.
+	ConsensusStatesMetadata(ctx context.Context, in *QueryConsensusStatesMetadataRequest, opts ...grpc.CallOption) (*QueryConsensusStatesMetadataResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TrustingPeriodRemaining(ctx context.Context, in *QueryTrustingPeriodRemainingRequest, opts ...grpc.CallOption) (*QueryTrustingPeriodRemainingResponse, error) {
+	out := new(QueryTrustingPeriodRemainingResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.tendermint.v1.Query/TrustingPeriodRemaining", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusStateMetadata(ctx context.Context, in *QueryConsensusStateMetadataRequest, opts ...grpc.CallOption) (*QueryConsensusStateMetadataResponse, error) {
+	out := new(QueryConsensusStateMetadataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.tendermint.v1.Query/ConsensusStateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusStatesMetadata(ctx context.Context, in *QueryConsensusStatesMetadataRequest, opts ...grpc.CallOption) (*QueryConsensusStatesMetadataResponse, error) {
+	out := new(QueryConsensusStatesMetadataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.tendermint.v1.Query/ConsensusStatesMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TrustingPeriodRemaining queries the remaining duration of the trusting period of a tendermint client.
+	TrustingPeriodRemaining(context.Context, *QueryTrustingPeriodRemainingRequest) (*QueryTrustingPeriodRemainingResponse, error)
+	// ConsensusStateMetadata queries the processed time and processed height of the consensus state of a tendermint
+	// client at the given height.
+	ConsensusStateMetadata(context.Context, *QueryConsensusStateMetadataRequest) (*QueryConsensusStateMetadataResponse, error)
+	// ConsensusStatesMetadata queries the processed time and processed height of all consensus states of a tendermint
+	// client in ascending height order.
+	ConsensusStatesMetadata(context.Context, *QueryConsensusStatesMetadataRequest) (*QueryConsensusStatesMetadataResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TrustingPeriodRemaining(ctx context.Context, req *QueryTrustingPeriodRemainingRequest) (*QueryTrustingPeriodRemainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustingPeriodRemaining not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStateMetadata(ctx context.Context, req *QueryConsensusStateMetadataRequest) (*QueryConsensusStateMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStateMetadata not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStatesMetadata(ctx context.Context, req *QueryConsensusStatesMetadataRequest) (*QueryConsensusStatesMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStatesMetadata not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TrustingPeriodRemaining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrustingPeriodRemainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrustingPeriodRemaining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.tendermint.v1.Query/TrustingPeriodRemaining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrustingPeriodRemaining(ctx, req.(*QueryTrustingPeriodRemainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusStateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStateMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.tendermint.v1.Query/ConsensusStateMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStateMetadata(ctx, req.(*QueryConsensusStateMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusStatesMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStatesMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStatesMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.tendermint.v1.Query/ConsensusStatesMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStatesMetadata(ctx, req.(*QueryConsensusStatesMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.tendermint.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TrustingPeriodRemaining",
+			Handler:    _Query_TrustingPeriodRemaining_Handler,
+		},
+		{
+			MethodName: "ConsensusStateMetadata",
+			Handler:    _Query_ConsensusStateMetadata_Handler,
+		},
+		{
+			MethodName: "ConsensusStatesMetadata",
+			Handler:    _Query_ConsensusStatesMetadata_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/tendermint/v1/query.proto",
+}
+
+func (m *QueryTrustingPeriodRemainingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustingPeriodRemainingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustingPeriodRemainingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrustingPeriodRemainingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustingPeriodRemainingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustingPeriodRemainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Remaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Remaining):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusStateMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusStateMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusStateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProcessedHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ProcessedTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProcessedTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevisionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RevisionNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevisionNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStatesMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatesMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatesMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStatesMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatesMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatesMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTrustingPeriodRemainingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrustingPeriodRemainingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Remaining)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ConsensusStateMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProcessedTime != 0 {
+		n += 1 + sovQuery(uint64(m.ProcessedTime))
+	}
+	l = m.ProcessedHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsensusStateMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RevisionNumber != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionNumber))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *QueryConsensusStateMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsensusStatesMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsensusStatesMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTrustingPeriodRemainingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustingPeriodRemainingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustingPeriodRemainingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrustingPeriodRemainingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustingPeriodRemainingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustingPeriodRemainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Remaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusStateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusStateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusStateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedTime", wireType)
+			}
+			m.ProcessedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProcessedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionNumber", wireType)
+			}
+			m.RevisionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStatesMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStatesMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStatesMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStatesMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStatesMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStatesMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, ConsensusStateMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,200 @@
+package tendermint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	"github.com/cometbft/cometbft/light"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var _ SignatureVerifier = (*defaultSignatureVerifier)(nil)
+
+// SignatureVerifier defines the backend used by the 07-tendermint light client to verify the validator
+// signatures of a commit when verifying headers and misbehaviour. Like the light client of CometBFT,
+// verification stops as soon as enough voting power has been tallied, so not all signatures are checked.
+type SignatureVerifier interface {
+	// VerifyCommitLight verifies that more than 2/3 of the voting power of the given validator set,
+	// which must be the validator set that produced the commit, signed the commit for the given block.
+	VerifyCommitLight(chainID string, vals *cmttypes.ValidatorSet, blockID cmttypes.BlockID, height int64, commit *cmttypes.Commit) error
+	// VerifyCommitLightTrusting verifies that at least trustLevel of the voting power of the given validator
+	// set signed the commit. The validator set does not need to be the validator set that produced the commit.
+	VerifyCommitLightTrusting(chainID string, vals *cmttypes.ValidatorSet, commit *cmttypes.Commit, trustLevel cmtmath.Fraction) error
+}
+
+// defaultSignatureVerifier verifies commit signatures using the commit verification functions of CometBFT,
+// which batch verify the signatures of commits of validator sets whose key type supports batch verification
+// (ed25519 and sr25519) and verify the signatures individually otherwise.
+type defaultSignatureVerifier struct{}
+
+// NewDefaultSignatureVerifier returns the default SignatureVerifier, which delegates to the commit verification
+// functions of CometBFT.
+func NewDefaultSignatureVerifier() SignatureVerifier {
+	return defaultSignatureVerifier{}
+}
+
+// VerifyCommitLight implements SignatureVerifier.
+func (defaultSignatureVerifier) VerifyCommitLight(chainID string, vals *cmttypes.ValidatorSet, blockID cmttypes.BlockID, height int64, commit *cmttypes.Commit) error {
+	return cmttypes.VerifyCommitLight(chainID, vals, blockID, height, commit)
+}
+
+// VerifyCommitLightTrusting implements SignatureVerifier.
+func (defaultSignatureVerifier) VerifyCommitLightTrusting(chainID string, vals *cmttypes.ValidatorSet, commit *cmttypes.Commit, trustLevel cmtmath.Fraction) error {
+	return cmttypes.VerifyCommitLightTrusting(chainID, vals, commit, trustLevel)
+}
+
+// gasMeteredSignatureVerifier wraps a SignatureVerifier and consumes a fixed amount of gas for every commit
+// signature checked by a verification pass, before delegating the verification to the wrapped SignatureVerifier.
+type gasMeteredSignatureVerifier struct {
+	SignatureVerifier
+	gasMeter        storetypes.GasMeter
+	gasPerSignature storetypes.Gas
+}
+
+// newGasMeteredSignatureVerifier returns a SignatureVerifier which consumes gasPerSignature gas from the gas meter
+// for every signature of the commits verified by the given SignatureVerifier.
+func newGasMeteredSignatureVerifier(verifier SignatureVerifier, gasMeter storetypes.GasMeter, gasPerSignature storetypes.Gas) SignatureVerifier {
+	return gasMeteredSignatureVerifier{
+		SignatureVerifier: verifier,
+		gasMeter:          gasMeter,
+		gasPerSignature:   gasPerSignature,
+	}
+}
+
+// VerifyCommitLight implements SignatureVerifier.
+func (v gasMeteredSignatureVerifier) VerifyCommitLight(chainID string, vals *cmttypes.ValidatorSet, blockID cmttypes.BlockID, height int64, commit *cmttypes.Commit) error {
+	v.consumeGas(commit)
+	return v.SignatureVerifier.VerifyCommitLight(chainID, vals, blockID, height, commit)
+}
+
+// VerifyCommitLightTrusting implements SignatureVerifier.
+func (v gasMeteredSignatureVerifier) VerifyCommitLightTrusting(chainID string, vals *cmttypes.ValidatorSet, commit *cmttypes.Commit, trustLevel cmtmath.Fraction) error {
+	v.consumeGas(commit)
+	return v.SignatureVerifier.VerifyCommitLightTrusting(chainID, vals, commit, trustLevel)
+}
+
+// consumeGas consumes the gas for verifying the signatures of the commit.
+func (v gasMeteredSignatureVerifier) consumeGas(commit *cmttypes.Commit) {
+	if commit == nil {
+		return
+	}
+
+	v.gasMeter.ConsumeGas(v.gasPerSignature*storetypes.Gas(len(commit.Signatures)), "tendermint commit signature verification")
+}
+
+// verifyLightHeader verifies the untrusted header against the trusted header using the given SignatureVerifier.
+// It performs the same checks as the Verify function of the CometBFT light package:
+// - the trusted header must not be expired
+// - the untrusted header must be valid, newer than the trusted header and not from the future
+// - for adjacent headers, the untrusted validator set must equal the next validator set of the trusted header
+// - for non-adjacent headers, trustLevel of the trusted validator set must have signed the untrusted commit
+// - more than 2/3 of the untrusted validator set must have signed the untrusted commit
+//
+// If validatorSetContinuity is true, non-adjacent headers whose validator set equals the next validator set of
+// the trusted header are verified like adjacent headers: since more than 2/3 of the untrusted validator set must
+// have signed the commit, the trust level check against the same validators is skipped, as long as the trust
+// level does not exceed 2/3.
+func verifyLightHeader(
+	verifier SignatureVerifier,
+	trustedHeader *cmttypes.SignedHeader,
+	trustedVals *cmttypes.ValidatorSet,
+	untrustedHeader *cmttypes.SignedHeader,
+	untrustedVals *cmttypes.ValidatorSet,
+	trustingPeriod time.Duration,
+	now time.Time,
+	maxClockDrift time.Duration,
+	trustLevel cmtmath.Fraction,
+	validatorSetContinuity bool,
+) error {
+	if light.HeaderExpired(trustedHeader, trustingPeriod, now) {
+		return light.ErrOldHeaderExpired{At: trustedHeader.Time.Add(trustingPeriod), Now: now}
+	}
+
+	if err := verifyNewHeaderAndVals(untrustedHeader, untrustedVals, trustedHeader, now, maxClockDrift); err != nil {
+		return light.ErrInvalidHeader{Reason: err}
+	}
+
+	switch {
+	case untrustedHeader.Height == trustedHeader.Height+1:
+		if !bytes.Equal(untrustedHeader.ValidatorsHash, trustedHeader.NextValidatorsHash) {
+			return fmt.Errorf("expected old header next validators (%X) to match those from new header (%X)",
+				trustedHeader.NextValidatorsHash, untrustedHeader.ValidatorsHash,
+			)
+		}
+	case validatorSetContinuity && isContinuousValidatorSet(trustedHeader, untrustedHeader, trustLevel):
+		// the untrusted validator set is the trusted next validator set, the verification of the untrusted
+		// commit below implies that at least trustLevel of the trusted validators signed the commit
+	default:
+		if err := verifier.VerifyCommitLightTrusting(trustedHeader.ChainID, trustedVals, untrustedHeader.Commit, trustLevel); err != nil {
+			var errNotEnoughVotingPower cmttypes.ErrNotEnoughVotingPowerSigned
+			if errors.As(err, &errNotEnoughVotingPower) {
+				return light.ErrNewValSetCantBeTrusted{Reason: errNotEnoughVotingPower}
+			}
+
+			return err
+		}
+	}
+
+	if err := verifier.VerifyCommitLight(
+		trustedHeader.ChainID, untrustedVals, untrustedHeader.Commit.BlockID, untrustedHeader.Height, untrustedHeader.Commit,
+	); err != nil {
+		return light.ErrInvalidHeader{Reason: err}
+	}
+
+	return nil
+}
+
+// isContinuousValidatorSet returns true if the validator set of the untrusted header is the next validator set of
+// the trusted header and more than 2/3 of the voting power satisfies the trust level.
+func isContinuousValidatorSet(trustedHeader, untrustedHeader *cmttypes.SignedHeader, trustLevel cmtmath.Fraction) bool {
+	if trustLevel.Numerator > math.MaxUint64/3 || trustLevel.Denominator > math.MaxUint64/2 {
+		return false
+	}
+
+	return trustLevel.Numerator*3 <= trustLevel.Denominator*2 && bytes.Equal(untrustedHeader.ValidatorsHash, trustedHeader.NextValidatorsHash)
+}
+
+// verifyNewHeaderAndVals performs the basic validation of the untrusted header and validator set against the trusted header.
+func verifyNewHeaderAndVals(
+	untrustedHeader *cmttypes.SignedHeader,
+	untrustedVals *cmttypes.ValidatorSet,
+	trustedHeader *cmttypes.SignedHeader,
+	now time.Time,
+	maxClockDrift time.Duration,
+) error {
+	if err := untrustedHeader.ValidateBasic(trustedHeader.ChainID); err != nil {
+		return fmt.Errorf("untrustedHeader.ValidateBasic failed: %w", err)
+	}
+
+	if untrustedHeader.Height <= trustedHeader.Height {
+		return fmt.Errorf("expected new header height %d to be greater than one of old header %d",
+			untrustedHeader.Height, trustedHeader.Height,
+		)
+	}
+
+	if !untrustedHeader.Time.After(trustedHeader.Time) {
+		return fmt.Errorf("expected new header time %v to be after old header time %v",
+			untrustedHeader.Time, trustedHeader.Time,
+		)
+	}
+
+	if !untrustedHeader.Time.Before(now.Add(maxClockDrift)) {
+		return fmt.Errorf("new header has a time from the future %v (now: %v; max clock drift: %v)",
+			untrustedHeader.Time, now, maxClockDrift,
+		)
+	}
+
+	if !bytes.Equal(untrustedHeader.ValidatorsHash, untrustedVals.Hash()) {
+		return fmt.Errorf("expected new header validators (%X) to match those that were supplied (%X) at height %d",
+			untrustedHeader.ValidatorsHash, untrustedVals.Hash(), untrustedHeader.Height,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package tendermint_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TendermintTestSuite) TestSignatureVerifier() {
+	var (
+		vals   *cmttypes.ValidatorSet
+		commit *cmttypes.Commit
+	)
+
+	verifier := ibctm.NewDefaultSignatureVerifier()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: one of four validators did not sign",
+			func() {
+				commit.Signatures[0] = cmttypes.NewCommitSigAbsent()
+			},
+			nil,
+		},
+		{
+			"failure: invalid signature",
+			func() {
+				commit.Signatures[1].Signature = make([]byte, len(commit.Signatures[1].Signature))
+			},
+			errors.New("wrong signature (#1)"),
+		},
+		{
+			"failure: not enough voting power signed",
+			func() {
+				commit.Signatures[0] = cmttypes.NewCommitSigAbsent()
+				commit.Signatures[1] = cmttypes.NewCommitSigAbsent()
+			},
+			cmttypes.ErrNotEnoughVotingPowerSigned{Got: 20, Needed: 26},
+		},
+		{
+			"failure: commit signatures do not match validator set size",
+			func() {
+				commit.Signatures = commit.Signatures[1:]
+			},
+			cmttypes.NewErrInvalidCommitSignatures(4, 3),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var signers map[string]cmttypes.PrivValidator
+			vals, signers = generateValSet(4)
+
+			header := suite.chainA.CreateTMClientHeader(chainID, int64(height.RevisionHeight), clienttypes.NewHeight(0, height.RevisionHeight-1), suite.now, vals, vals, vals, signers)
+			signedHeader, err := cmttypes.SignedHeaderFromProto(header.SignedHeader)
+			suite.Require().NoError(err)
+
+			commit = signedHeader.Commit
+
+			tc.malleate()
+
+			err = verifier.VerifyCommitLight(chainID, vals, commit.BlockID, commit.Height, commit)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestSignatureVerifierTrusting() {
+	verifier := ibctm.NewDefaultSignatureVerifier()
+
+	vals, signers := generateValSet(4)
+
+	header := suite.chainA.CreateTMClientHeader(chainID, int64(height.RevisionHeight), clienttypes.NewHeight(0, height.RevisionHeight-1), suite.now, vals, vals, vals, signers)
+	signedHeader, err := cmttypes.SignedHeaderFromProto(header.SignedHeader)
+	suite.Require().NoError(err)
+
+	// the trusted validator set only shares two of the four validators which signed the commit
+	trustedVals := cmttypes.NewValidatorSet([]*cmttypes.Validator{
+		vals.Validators[0].Copy(),
+		vals.Validators[1].Copy(),
+		cmttypes.NewValidator(suite.valSet.Validators[0].PubKey, 10),
+	})
+
+	oneThird := cmtmath.Fraction{Numerator: 1, Denominator: 3}
+	err = verifier.VerifyCommitLightTrusting(chainID, trustedVals, signedHeader.Commit, oneThird)
+	suite.Require().NoError(err)
+
+	// a trust level of one requires all validators of the trusted validator set to have signed the commit
+	one := cmtmath.Fraction{Numerator: 1, Denominator: 1}
+	err = verifier.VerifyCommitLightTrusting(chainID, trustedVals, signedHeader.Commit, one)
+	suite.Require().ErrorIs(err, cmttypes.ErrNotEnoughVotingPowerSigned{Got: 20, Needed: 30})
+
+	// a signature of a trusted validator included twice in the commit is rejected
+	signedHeader.Commit.Signatures[2] = signedHeader.Commit.Signatures[0]
+	err = verifier.VerifyCommitLightTrusting(chainID, trustedVals, signedHeader.Commit, one)
+	suite.Require().ErrorContains(err, "double vote")
+}
+
+// generateValSet returns a validator set of n validators with equal voting power, together with
+// the private validators of the set indexed by validator address.
+func generateValSet(n int) (*cmttypes.ValidatorSet, map[string]cmttypes.PrivValidator) {
+	validators := make([]*cmttypes.Validator, n)
+	signers := make(map[string]cmttypes.PrivValidator, n)
+	for i := 0; i < n; i++ {
+		privVal := cmttypes.NewMockPV()
+		pubKey, err := privVal.GetPubKey()
+		if err != nil {
+			panic(err)
+		}
+
+		validators[i] = cmttypes.NewValidator(pubKey, 10)
+		signers[validators[i].Address.String()] = privVal
+	}
+
+	return cmttypes.NewValidatorSet(validators), signers
+}
+
+// BenchmarkHeaderCommitVerification compares the commit verification of a non-adjacent header with an unchanged
+// validator set of 100 validators, with and without the validator set continuity fast path. The fast path skips
+// the trust level verification of the commit against the trusted validators.
+func BenchmarkHeaderCommitVerification(b *testing.B) {
+	vals, signers := generateValSet(100)
+	trustLevel := ibctm.DefaultTrustLevel.ToTendermint()
+
+	proposedHeader := cmttypes.Header{
+		ChainID:            chainID,
+		Height:             int64(height.RevisionHeight),
+		Time:               time.Now(),
+		ValidatorsHash:     vals.Hash(),
+		NextValidatorsHash: vals.Hash(),
+	}
+
+	protoSignedHeader, err := ibctesting.CommitHeader(proposedHeader, vals, signers)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	commit, err := cmttypes.CommitFromProto(protoSignedHeader.Commit)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	verifier := ibctm.NewDefaultSignatureVerifier()
+
+	b.Run("trust level verification", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := verifier.VerifyCommitLightTrusting(chainID, vals, commit, trustLevel); err != nil {
+				b.Fatal(err)
+			}
+			if err := verifier.VerifyCommitLight(chainID, vals, commit.BlockID, commit.Height, commit); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("validator set continuity fast path", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := verifier.VerifyCommitLight(chainID, vals, commit.BlockID, commit.Height, commit); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -262,7 +262,8 @@ func GetPreviousConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryC
 
 // PruneAllExpiredConsensusStates iterates over all consensus states for a given
 // client store. If a consensus state is expired, it is deleted and its metadata
-// is deleted. The number of consensus states pruned is returned.
+// is deleted. Metadata stored for consensus states which no longer exist is also
+// deleted. The number of heights pruned is returned.
 func PruneAllExpiredConsensusStates(
 	ctx sdk.Context, clientStore storetypes.KVStore,
 	cdc codec.BinaryCodec, clientState *ClientState,
@@ -271,11 +272,8 @@ func PruneAllExpiredConsensusStates(
 
 	pruneCb := func(height exported.Height) bool {
 		consState, found := GetConsensusState(clientStore, cdc, height)
-		if !found { // consensus state should always be found
-			return true
-		}
-
-		if clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+		// the metadata of a consensus state which no longer exists is pruned
+		if !found || clientState.IsExpired(consState.Timestamp, ctx.BlockTime()) {
 			heights = append(heights, height)
 		}
 
@@ -337,6 +335,26 @@ func setConsensusMetadataWithValues(
 	SetIterationKey(clientStore, height)
 }
 
+// getConsensusStateMetadata returns the processed time and processed height stored for the consensus
+// state at the given height. False is returned if no processed time is stored for the height.
+func getConsensusStateMetadata(clientStore storetypes.KVStore, height exported.Height) (ConsensusStateMetadata, bool) {
+	processedTime, found := GetProcessedTime(clientStore, height)
+	if !found {
+		return ConsensusStateMetadata{}, false
+	}
+
+	metadata := ConsensusStateMetadata{
+		Height:        clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()),
+		ProcessedTime: processedTime,
+	}
+
+	if processedHeight, found := GetProcessedHeight(clientStore, height); found {
+		metadata.ProcessedHeight = clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight())
+	}
+
+	return metadata, true
+}
+
 // deleteConsensusMetadata deletes the metadata stored for a particular consensus state.
 func deleteConsensusMetadata(clientStore storetypes.KVStore, height exported.Height) {
 	deleteProcessedTime(clientStore, height)
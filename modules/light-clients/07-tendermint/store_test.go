@@ -199,3 +199,38 @@ func (suite *TendermintTestSuite) TestGetNeighboringConsensusStates() {
 	suite.Require().Nil(nextCs49, "next consensus state exists after highest consensus state")
 	suite.Require().False(ok)
 }
+
+func (suite *TendermintTestSuite) TestPruneAllExpiredConsensusStatesOrphanedMetadata() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	orphanedHeight := path.EndpointA.GetClientLatestHeight()
+
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	latestHeight := path.EndpointA.GetClientLatestHeight()
+
+	ctx := suite.chainA.GetContext()
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+	// delete the consensus state without its metadata
+	clientStore.Delete(host.ConsensusStateKey(orphanedHeight))
+
+	clientState, ok := path.EndpointA.GetClientState().(*tendermint.ClientState)
+	suite.Require().True(ok)
+
+	pruned := tendermint.PruneAllExpiredConsensusStates(ctx, clientStore, suite.chainA.App.AppCodec(), clientState)
+	suite.Require().Equal(1, pruned)
+
+	// the metadata of the deleted consensus state is pruned
+	_, found := tendermint.GetProcessedTime(clientStore, orphanedHeight)
+	suite.Require().False(found)
+	_, found = tendermint.GetProcessedHeight(clientStore, orphanedHeight)
+	suite.Require().False(found)
+	suite.Require().Nil(tendermint.GetIterationKey(clientStore, orphanedHeight))
+
+	// the metadata of the unexpired consensus state remains
+	_, found = tendermint.GetProcessedTime(clientStore, latestHeight)
+	suite.Require().True(found)
+	suite.Require().NotNil(tendermint.GetIterationKey(clientStore, latestHeight))
+}
@@ -3,6 +3,7 @@ package tendermint
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -10,7 +11,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/cometbft/cometbft/light"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -19,16 +19,28 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message.
+// Commit signatures are verified using the default SignatureVerifier.
 func (cs *ClientState) VerifyClientMessage(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
 	clientMsg exported.ClientMessage,
+) error {
+	return cs.verifyClientMessage(ctx, cdc, clientStore, clientMsg, NewDefaultSignatureVerifier(), false)
+}
+
+// verifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+// using the provided SignatureVerifier to verify commit signatures. If validatorSetContinuity is true, headers
+// with the same validator set as the trusted consensus state are verified using the validator set continuity
+// fast path.
+func (cs *ClientState) verifyClientMessage(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
+	clientMsg exported.ClientMessage, verifier SignatureVerifier, validatorSetContinuity bool,
 ) error {
 	switch msg := clientMsg.(type) {
 	case *Header:
-		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+		return cs.verifyHeader(ctx, clientStore, cdc, msg, verifier, validatorSetContinuity)
 	case *Misbehaviour:
-		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
+		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg, verifier)
 	default:
 		return clienttypes.ErrInvalidClientType
 	}
@@ -44,7 +56,7 @@ func (cs *ClientState) VerifyClientMessage(
 // - header timestamp is less than or equal to the consensus state timestamp
 func (cs *ClientState) verifyHeader(
 	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
-	header *Header,
+	header *Header, verifier SignatureVerifier, validatorSetContinuity bool,
 ) error {
 	currentTimestamp := ctx.BlockTime()
 
@@ -108,11 +120,13 @@ func (cs *ClientState) verifyHeader(
 	// - asserts trusting period not passed
 	// - assert header timestamp is not past the trusting period
 	// - assert header timestamp is past latest stored consensus state timestamp
-	// - assert that a TrustLevel proportion of TrustedValidators signed new Commit
-	err = light.Verify(
-		&signedHeader,
+	// - assert that a TrustLevel proportion of TrustedValidators signed new Commit, unless the validator set
+	//   is unchanged and the validator set continuity fast path is enabled
+	err = verifyLightHeader(
+		verifier, &signedHeader,
 		tmTrustedValidators, tmSignedHeader, tmValidatorSet,
 		cs.TrustingPeriod, currentTimestamp, cs.MaxClockDrift, cs.TrustLevel.ToTendermint(),
+		validatorSetContinuity,
 	)
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to verify header")
@@ -121,6 +135,20 @@ func (cs *ClientState) verifyHeader(
 	return nil
 }
 
+// IsDuplicateClientMessage returns true if the client message is a header whose consensus state is already stored
+// at the height of the header. Updating the client with such a header is a no-op, whether or not the header would
+// pass verification. A header which conflicts with the stored consensus state is not a duplicate, as it must be
+// verified and detected as misbehaviour.
+func (ClientState) IsDuplicateClientMessage(cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) bool {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		return false
+	}
+
+	consState, found := GetConsensusState(clientStore, cdc, header.GetHeight())
+	return found && reflect.DeepEqual(consState, header.ConsensusState())
+}
+
 // UpdateState may be used to either create a consensus state for:
 // - a future height greater than the latest client state height
 // - a past height that was skipped during bisection
@@ -179,12 +207,8 @@ func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.Binar
 
 	pruneCb := func(height exported.Height) bool {
 		consState, found := GetConsensusState(clientStore, cdc, height)
-		// this error should never occur
-		if !found {
-			panic(errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "failed to retrieve consensus state at height: %s", height))
-		}
-
-		if cs.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+		// the metadata of a consensus state which no longer exists is pruned
+		if !found || cs.IsExpired(consState.Timestamp, ctx.BlockTime()) {
 			pruneHeight = height
 		}
 
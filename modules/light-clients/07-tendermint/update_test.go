@@ -642,6 +642,34 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	suite.Require().Equal(expectedConsKey, consKey, "iteration key incorrectly pruned")
 }
 
+func (suite *TendermintTestSuite) TestPruneConsensusStateOrphanedMetadata() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	orphanedHeight := path.EndpointA.GetClientLatestHeight()
+
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	// delete the earliest consensus state without its metadata
+	ctx := path.EndpointA.Chain.GetContext()
+	clientStore := path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+	clientStore.Delete(host.ConsensusStateKey(orphanedHeight))
+
+	// updating the client must not fail and must prune the orphaned metadata
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	ctx = path.EndpointA.Chain.GetContext()
+	clientStore = path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+	_, ok := ibctm.GetProcessedTime(clientStore, orphanedHeight)
+	suite.Require().False(ok, "processed time metadata not pruned")
+	_, ok = ibctm.GetProcessedHeight(clientStore, orphanedHeight)
+	suite.Require().False(ok, "processed height metadata not pruned")
+	suite.Require().Nil(ibctm.GetIterationKey(clientStore, orphanedHeight), "iteration key not pruned")
+}
+
 func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 	var (
 		path          *ibctesting.Path
@@ -649,14 +677,16 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 	)
 
 	testCases := []struct {
-		name     string
-		malleate func()
-		expPass  bool
+		name            string
+		malleate        func()
+		expPass         bool
+		expEvidenceType string
 	}{
 		{
 			"valid update no misbehaviour",
 			func() {},
 			false,
+			clienttypes.EvidenceTypeUnknown,
 		},
 		{
 			"consensus state already exists, already updated",
@@ -675,6 +705,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, tmHeader.GetHeight(), consensusState)
 			},
 			false,
+			clienttypes.EvidenceTypeUnknown,
 		},
 		{
 			"invalid fork misbehaviour: identical headers", func() {
@@ -696,6 +727,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 					Header2: misbehaviourHeader,
 				}
 			}, false,
+			clienttypes.EvidenceTypeUnknown,
 		},
 		{
 			"invalid time misbehaviour: monotonically increasing time", func() {
@@ -710,6 +742,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 					Header2: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, suite.chainB.ProposedHeader.Height, trustedHeight, suite.chainB.ProposedHeader.Time, suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers),
 				}
 			}, false,
+			clienttypes.EvidenceTypeUnknown,
 		},
 		{
 			"consensus state already exists, app hash mismatch",
@@ -728,6 +761,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, tmHeader.GetHeight(), consensusState)
 			},
 			true,
+			clienttypes.EvidenceTypeDuplicateHeight,
 		},
 		{
 			"previous consensus state exists and header time is before previous consensus state time",
@@ -739,6 +773,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 				header.Header.Time = header.GetTime().Add(-time.Hour)
 			},
 			true,
+			clienttypes.EvidenceTypeTimeMonotonicity,
 		},
 		{
 			"next consensus state exists and header time is after next consensus state time",
@@ -755,6 +790,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 				header.Header.Time = header.Header.Time.Add(time.Hour)
 			},
 			true,
+			clienttypes.EvidenceTypeTimeMonotonicity,
 		},
 		{
 			"valid fork misbehaviour returns true",
@@ -784,6 +820,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 				}
 			},
 			true,
+			clienttypes.EvidenceTypeDuplicateHeight,
 		},
 		{
 			"valid time misbehaviour: not monotonically increasing time", func() {
@@ -798,6 +835,7 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 					Header1: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, suite.chainB.ProposedHeader.Height, trustedHeight, suite.chainB.ProposedHeader.Time, suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers),
 				}
 			}, true,
+			clienttypes.EvidenceTypeTimeMonotonicity,
 		},
 	}
 
@@ -836,6 +874,86 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 			} else {
 				suite.Require().False(foundMisbehaviour)
 			}
+
+			evidenceType := clientState.MisbehaviourEvidenceType(
+				suite.chainA.GetContext(),
+				suite.chainA.App.AppCodec(),
+				clientStore,
+				clientMessage,
+			)
+			suite.Require().Equal(tc.expEvidenceType, evidenceType)
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestIsDuplicateClientMessage() {
+	var (
+		path          *ibctesting.Path
+		clientMessage exported.ClientMessage
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expDuplicate bool
+	}{
+		{
+			"header for a new height",
+			func() {},
+			false,
+		},
+		{
+			"header already applied",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, clientMessage)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"header conflicting with the consensus state already stored",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, clientMessage)
+				suite.Require().NoError(err)
+
+				header, ok := clientMessage.(*ibctm.Header)
+				suite.Require().True(ok)
+				header.SignedHeader.Header.AppHash = []byte("conflicting app hash")
+			},
+			false,
+		},
+		{
+			"client message is not a header",
+			func() {
+				clientMessage = &ibctm.Misbehaviour{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			var err error
+			clientMessage, err = path.EndpointA.Counterparty.Chain.IBCClientHeader(path.EndpointA.Counterparty.Chain.LatestCommittedHeader, trustedHeight)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			duplicate := clientState.IsDuplicateClientMessage(suite.chainA.App.AppCodec(), clientStore, clientMessage)
+			suite.Require().Equal(tc.expDuplicate, duplicate)
 		})
 	}
 }
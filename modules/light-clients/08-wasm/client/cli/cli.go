@@ -19,6 +19,10 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		getCmdCode(),
 		getCmdChecksums(),
+		getCmdClientChecksum(),
+		getCmdContractCalls(),
+		getCmdMessageAllowlist(),
+		getCmdVerifyCode(),
 	)
 
 	return queryCmd
@@ -36,6 +40,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		newSubmitStoreCodeProposalCmd(),
+		newSubmitSetMessageAllowlistProposalCmd(),
 		newMigrateContractCmd(),
 	)
 
@@ -2,12 +2,16 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"slices"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
@@ -78,3 +82,169 @@ func getCmdChecksums() *cobra.Command {
 
 	return cmd
 }
+
+// getCmdClientChecksum defines the command to query the checksum of the contract used by a client.
+func getCmdClientChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-checksum [client-id]",
+		Short:   "Query the checksum of a client",
+		Long:    "Query the checksum of the light client wasm contract used by the client with the given identifier",
+		Example: fmt.Sprintf("%s query %s wasm client-checksum [client-id]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryClientChecksumRequest{
+				ClientId: args[0],
+			}
+
+			res, err := queryClient.ClientChecksum(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdContractCalls defines the command to query the contract calls traced for a client.
+func getCmdContractCalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-calls [client-id]",
+		Short:   "Query the contract calls traced for a client",
+		Long:    "Query the most recent light client wasm contract calls traced by the node for the client with the given identifier. Contract call tracing must be enabled in the node configuration",
+		Example: fmt.Sprintf("%s query %s wasm contract-calls [client-id]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryContractCallsRequest{
+				ClientId: args[0],
+			}
+
+			res, err := queryClient.ContractCalls(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdMessageAllowlist defines the command to query the message allowlist of a checksum.
+func getCmdMessageAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "message-allowlist [checksum]",
+		Short:   "Query the message allowlist of a checksum",
+		Long:    "Query the allowlist of the query and sudo messages forwarded to the light client wasm contract with a given checksum. No allowlist is returned if all messages are forwarded.",
+		Example: fmt.Sprintf("%s query %s wasm message-allowlist [checksum]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryMessageAllowlistRequest{
+				Checksum: args[0],
+			}
+
+			res, err := queryClient.MessageAllowlist(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdVerifyCode defines the command to verify that the checksum of a local wasm file is stored on chain.
+func getCmdVerifyCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-code [path/to/wasm-file]",
+		Short: "Verify that the checksum of a local wasm file is stored on chain",
+		Long: `Compute the checksum of a local wasm file, which may be gzip compressed, and verify that it matches a checksum
+stored on chain. This allows the contract referenced by a governance proposal to be verified against a local build.`,
+		Example: fmt.Sprintf("%s query %s-wasm verify-code [path/to/wasm_file]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			code, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			code, err = uncompressWasmCode(code)
+			if err != nil {
+				return err
+			}
+
+			checksum, err := types.CreateChecksum(code)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stored, err := isChecksumStored(cmd.Context(), queryClient, hex.EncodeToString(checksum))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("checksum: %s\nstored: %t\n", hex.EncodeToString(checksum), stored))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// isChecksumStored pages through the checksums stored on chain and returns true if the hex encoded checksum is found.
+func isChecksumStored(ctx context.Context, queryClient types.QueryClient, checksum string) (bool, error) {
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.Checksums(ctx, &types.QueryChecksumsRequest{Pagination: pageReq})
+		if err != nil {
+			return false, err
+		}
+
+		if slices.Contains(res.Checksums, checksum) {
+			return true, nil
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return false, nil
+		}
+
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 
@@ -19,16 +20,24 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-const FlagAuthority = "authority"
+const (
+	FlagAuthority = "authority"
+
+	flagQueryMsgs       = "query-msgs"
+	flagSudoMsgs        = "sudo-msgs"
+	flagRemoveAllowlist = "remove"
+)
 
 // newSubmitStoreCodeProposalCmd returns the command to send a proposal to store new wasm bytecode.
 func newSubmitStoreCodeProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "store-code [path/to/wasm-file]",
-		Short:   "Reads wasm code from the file and creates a proposal to store the wasm code",
-		Long:    "Reads wasm code from the file and creates a proposal to store the wasm code",
-		Example: fmt.Sprintf("%s tx %s-wasm store-code [path/to/wasm_file]", version.AppName, ibcexported.ModuleName),
-		Args:    cobra.ExactArgs(1),
+		Use:   "store-code [path/to/wasm-file]",
+		Short: "Reads wasm code from the file and creates a proposal to store the wasm code",
+		Long: `Reads wasm code from the file and creates a proposal to store the wasm code.
+If the dry-run flag is set, the checksum of the wasm code and an estimate of the gas consumed to store it on chain
+are printed instead, without generating or broadcasting the proposal.`,
+		Example: fmt.Sprintf("%s tx %s-wasm store-code [path/to/wasm_file]\n%s tx %s-wasm store-code [path/to/wasm_file] --title title --%s", version.AppName, ibcexported.ModuleName, version.AppName, ibcexported.ModuleName, flags.FlagDryRun),
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -54,15 +63,30 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 				return err
 			}
 
+			allowlist, err := readMessageAllowlistFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgStoreCode{
-				Signer:       authority,
-				WasmByteCode: code,
+				Signer:           authority,
+				WasmByteCode:     code,
+				MessageAllowlist: allowlist,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
+			if clientCtx.Simulate {
+				checksum, estimatedGas, err := storeCodePreview(code)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf("checksum: %s\nestimated store gas: %d\n", hex.EncodeToString(checksum), estimatedGas))
+			}
+
 			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
 				return fmt.Errorf("failed to create a store code proposal message: %w", err)
 			}
@@ -72,6 +96,125 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 	}
 
 	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
+	addMessageAllowlistFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// storeCodePreview returns the checksum of the wasm code, which may be gzip compressed, and an estimate of the
+// gas consumed to uncompress and store it on chain. The estimate does not include the gas consumed by the
+// transaction and governance proposal carrying the code.
+func storeCodePreview(code []byte) (types.Checksum, uint64, error) {
+	uncompressedCode, err := uncompressWasmCode(code)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	checksum, err := types.CreateChecksum(uncompressedCode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var estimatedGas uint64
+	if types.IsGzip(code) {
+		estimatedGas += types.VMGasRegister.UncompressCosts(len(code))
+	}
+	estimatedGas += types.VMGasRegister.CompileCosts(len(uncompressedCode))
+
+	return checksum, estimatedGas, nil
+}
+
+// uncompressWasmCode returns the uncompressed wasm code if the code is gzip compressed and validates it.
+// Checksums are computed over the uncompressed code on chain.
+func uncompressWasmCode(code []byte) ([]byte, error) {
+	if types.IsGzip(code) {
+		var err error
+		if code, err = types.Uncompress(code, types.MaxWasmSize); err != nil {
+			return nil, fmt.Errorf("failed to uncompress wasm code: %w", err)
+		}
+	}
+
+	if err := types.ValidateWasmCode(code); err != nil {
+		return nil, err
+	}
+
+	return code, nil
+}
+
+// newSubmitSetMessageAllowlistProposalCmd returns the command to send a proposal to set the message allowlist of a checksum.
+func newSubmitSetMessageAllowlistProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-message-allowlist [checksum]",
+		Short: "Creates a proposal to set the allowlist of the messages forwarded to the contract of a checksum",
+		Long: `Creates a proposal to set the allowlist of the query and sudo messages forwarded to the contract of a checksum.
+Messages are identified by the name of their variant in the JSON encoded payload. Messages which are not listed are
+rejected without calling the contract. The allowlist is removed, allowing all messages, if the remove flag is set.`,
+		Example: fmt.Sprintf("%s tx %s-wasm set-message-allowlist [checksum] --%s status,timestamp_at_height --%s update_state,verify_membership", version.AppName, ibcexported.ModuleName, flagQueryMsgs, flagSudoMsgs),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			checksum, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checksum: %w", err)
+			}
+
+			remove, err := cmd.Flags().GetBool(flagRemoveAllowlist)
+			if err != nil {
+				return err
+			}
+
+			var allowlist *types.MessageAllowlist
+			if !remove {
+				if allowlist, err = readMessageAllowlistFlags(cmd); err != nil {
+					return err
+				}
+
+				if allowlist == nil {
+					return fmt.Errorf("either the --%s or --%s flags must be provided, or the --%s flag set to remove the allowlist", flagQueryMsgs, flagSudoMsgs, flagRemoveAllowlist)
+				}
+			}
+
+			msg := types.NewMsgSetMessageAllowlist(authority, checksum, allowlist)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create a set message allowlist proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
+	cmd.Flags().Bool(flagRemoveAllowlist, false, "Remove the message allowlist of the checksum, allowing all messages to be forwarded to the contract")
+	addMessageAllowlistFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 	govcli.AddGovPropFlagsToCmd(cmd)
@@ -83,6 +226,33 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 	return cmd
 }
 
+// addMessageAllowlistFlags adds the flags listing the messages of a message allowlist to the command.
+func addMessageAllowlistFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagQueryMsgs, nil, "Comma separated names of the query messages forwarded to the contract, e.g. status,timestamp_at_height")
+	cmd.Flags().StringSlice(flagSudoMsgs, nil, "Comma separated names of the sudo messages forwarded to the contract, e.g. update_state,verify_membership")
+}
+
+// readMessageAllowlistFlags returns the message allowlist constructed from the message allowlist flags of the
+// command. It returns nil if neither of the flags is set.
+func readMessageAllowlistFlags(cmd *cobra.Command) (*types.MessageAllowlist, error) {
+	if !cmd.Flags().Changed(flagQueryMsgs) && !cmd.Flags().Changed(flagSudoMsgs) {
+		return nil, nil
+	}
+
+	queryMsgs, err := cmd.Flags().GetStringSlice(flagQueryMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	sudoMsgs, err := cmd.Flags().GetStringSlice(flagSudoMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlist := types.NewMessageAllowlist(queryMsgs, sudoMsgs)
+	return &allowlist, nil
+}
+
 func newMigrateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "migrate-contract [client-id] [checksum] [migrate-msg]",
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+func TestStoreCodePreview(t *testing.T) {
+	expChecksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err)
+
+	gzippedCode, err := types.GzipIt(wasmtesting.Code)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		code    []byte
+		expGas  uint64
+		expPass bool
+	}{
+		{
+			"success",
+			wasmtesting.Code,
+			types.VMGasRegister.CompileCosts(len(wasmtesting.Code)),
+			true,
+		},
+		{
+			"success: gzip compressed code",
+			gzippedCode,
+			types.VMGasRegister.UncompressCosts(len(gzippedCode)) + types.VMGasRegister.CompileCosts(len(wasmtesting.Code)),
+			true,
+		},
+		{
+			"failure: empty code",
+			[]byte{},
+			0,
+			false,
+		},
+		{
+			"failure: code is not wasm",
+			[]byte("invalid wasm code"),
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			checksum, gas, err := storeCodePreview(tc.code)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, expChecksum, checksum)
+				require.Equal(t, tc.expGas, gas)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
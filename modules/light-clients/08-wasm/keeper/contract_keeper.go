@@ -9,6 +9,7 @@ import (
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
@@ -35,6 +36,12 @@ var (
 
 // instantiateContract calls vm.Instantiate with appropriate arguments.
 func (k Keeper) instantiateContract(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum, msg []byte) (*wasmvmtypes.ContractResult, error) {
+	ctx, release, err := k.acquireVMInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := types.NewMultipliedGasMeter(sdkGasMeter, types.VMGasRegister)
 	gasLimit := VMGasRegister.RuntimeGasForContract(ctx)
@@ -49,11 +56,18 @@ func (k Keeper) instantiateContract(ctx sdk.Context, clientID string, clientStor
 	ctx.GasMeter().ConsumeGas(types.VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: instantiate")
 	resp, gasUsed, err := k.GetVM().Instantiate(checksum, env, msgInfo, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	types.VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
+	k.traceContractResult(ctx, clientID, entryPointInstantiate, checksum, msg, resp, err)
 	return resp, err
 }
 
 // callContract calls vm.Sudo with internally constructed gas meter and environment.
 func (k Keeper) callContract(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum, msg []byte) (*wasmvmtypes.ContractResult, error) {
+	ctx, release, err := k.acquireVMInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := types.NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
 	gasLimit := VMGasRegister.RuntimeGasForContract(ctx)
@@ -63,11 +77,18 @@ func (k Keeper) callContract(ctx sdk.Context, clientID string, clientStore store
 	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: sudo")
 	resp, gasUsed, err := k.GetVM().Sudo(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
+	k.traceContractResult(ctx, clientID, entryPointSudo, checksum, msg, resp, err)
 	return resp, err
 }
 
 // queryContract calls vm.Query.
 func (k Keeper) queryContract(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum, msg []byte) (*wasmvmtypes.QueryResult, error) {
+	ctx, release, err := k.acquireVMInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := types.NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
 	gasLimit := VMGasRegister.RuntimeGasForContract(ctx)
@@ -77,12 +98,19 @@ func (k Keeper) queryContract(ctx sdk.Context, clientID string, clientStore stor
 	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: query")
 	resp, gasUsed, err := k.GetVM().Query(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
+	k.traceQueryResult(ctx, clientID, checksum, msg, resp, err)
 
 	return resp, err
 }
 
 // migrateContract calls vm.Migrate with internally constructed gas meter and environment.
 func (k Keeper) migrateContract(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, checksum types.Checksum, msg []byte) (*wasmvmtypes.ContractResult, error) {
+	ctx, release, err := k.acquireVMInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := types.NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
 	gasLimit := VMGasRegister.RuntimeGasForContract(ctx)
@@ -92,12 +120,16 @@ func (k Keeper) migrateContract(ctx sdk.Context, clientID string, clientStore st
 	ctx.GasMeter().ConsumeGas(VMGasRegister.SetupContractCost(true, len(msg)), "Loading CosmWasm module: migrate")
 	resp, gasUsed, err := k.GetVM().Migrate(checksum, env, msg, internaltypes.NewStoreAdapter(clientStore), wasmvmAPI, k.newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, types.CostJSONDeserialization)
 	VMGasRegister.ConsumeRuntimeGas(ctx, gasUsed)
+	k.traceContractResult(ctx, clientID, entryPointMigrate, checksum, msg, resp, err)
 
 	return resp, err
 }
 
 // WasmInstantiate accepts a message to instantiate a wasm contract, JSON encodes it and calls instantiateContract.
+// The highest contract API version supported by the module is advertised in the message and the API version
+// reported by the contract is stored in the client store.
 func (k Keeper) WasmInstantiate(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, cs *types.ClientState, payload types.InstantiateMessage) error {
+	payload.MaxAPIVersion = types.MaxContractAPIVersion
 	encodedData, err := json.Marshal(payload)
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to marshal payload for wasm contract instantiation")
@@ -126,7 +158,14 @@ func (k Keeper) WasmInstantiate(ctx sdk.Context, clientID string, clientStore st
 		return errorsmod.Wrapf(types.ErrWasmInvalidContractModification, "expected checksum %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(newClientState.Checksum))
 	}
 
-	return nil
+	apiVersion, err := decodeContractAPIVersion(res.Ok.Data)
+	if err != nil {
+		return errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
+	}
+	types.SetContractAPIVersion(clientStore, apiVersion)
+
+	// index the client so that the checksum cannot be removed while it is in use
+	return k.checksumClients.Set(ctx, collections.Join([]byte(checksum), clientID))
 }
 
 // WasmSudo calls the contract with the given payload and returns the result.
@@ -136,7 +175,20 @@ func (k Keeper) WasmInstantiate(ctx sdk.Context, clientID string, clientStore st
 // - the response of the contract call contains non-empty events
 // - the response of the contract call contains non-empty attributes
 // - the data bytes of the response cannot be unmarshaled into the result type
+// - the payload requires a higher API version than the one implemented by the contract
+// - the payload is not allowed by the message allowlist of the contract checksum
 func (k Keeper) WasmSudo(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, cs *types.ClientState, payload types.SudoMsg) ([]byte, error) {
+	if err := k.checkSudoMsgAllowed(ctx, cs.Checksum, payload); err != nil {
+		return nil, err
+	}
+
+	// optional payloads are only sent to contracts which reported an API version supporting them
+	if required := payload.RequiredAPIVersion(); required > types.ContractAPIVersionLegacy {
+		if apiVersion := types.GetContractAPIVersion(clientStore); apiVersion < required {
+			return nil, errorsmod.Wrapf(types.ErrContractAPIVersionNotSupported, "contract implements API version %d, payload requires API version %d", apiVersion, required)
+		}
+	}
+
 	encodedData, err := json.Marshal(payload)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to marshal payload for wasm execution")
@@ -169,8 +221,10 @@ func (k Keeper) WasmSudo(ctx sdk.Context, clientID string, clientStore storetype
 }
 
 // WasmMigrate migrate calls the migrate entry point of the contract with the given payload and returns the result.
+// The API version reported by the migrated contract replaces the API version stored in the client store.
 // WasmMigrate returns an error if:
 // - the contract migration returns an error
+// - the contract reports an API version which is not supported
 func (k Keeper) WasmMigrate(ctx sdk.Context, clientStore storetypes.KVStore, cs *types.ClientState, clientID string, payload []byte) error {
 	res, err := k.migrateContract(ctx, clientID, clientStore, cs.Checksum, payload)
 	if err != nil {
@@ -184,15 +238,29 @@ func (k Keeper) WasmMigrate(ctx sdk.Context, clientStore storetypes.KVStore, cs
 		return errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
 	}
 
-	_, err = validatePostExecutionClientState(clientStore, k.cdc)
-	return err
+	if _, err = validatePostExecutionClientState(clientStore, k.cdc); err != nil {
+		return err
+	}
+
+	apiVersion, err := decodeContractAPIVersion(res.Ok.Data)
+	if err != nil {
+		return errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
+	}
+	types.SetContractAPIVersion(clientStore, apiVersion)
+
+	return nil
 }
 
 // WasmQuery queries the contract with the given payload and returns the result.
 // WasmQuery returns an error if:
 // - the contract query returns an error
 // - the data bytes of the response cannot be unmarshal into the result type
+// - the payload is not allowed by the message allowlist of the contract checksum
 func (k Keeper) WasmQuery(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, cs *types.ClientState, payload types.QueryMsg) ([]byte, error) {
+	if err := k.checkQueryMsgAllowed(ctx, cs.Checksum, payload); err != nil {
+		return nil, err
+	}
+
 	encodedData, err := json.Marshal(payload)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to marshal payload for wasm query")
@@ -209,6 +277,25 @@ func (k Keeper) WasmQuery(ctx sdk.Context, clientID string, clientStore storetyp
 	return res.Ok, nil
 }
 
+// decodeContractAPIVersion decodes the API version reported in the data of an instantiate or migrate response.
+// Contracts which return no data implement the legacy API version.
+func decodeContractAPIVersion(data []byte) (uint64, error) {
+	if len(data) == 0 {
+		return types.ContractAPIVersionLegacy, nil
+	}
+
+	var result types.APIVersionResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return 0, errorsmod.Wrap(types.ErrWasmInvalidResponseData, err.Error())
+	}
+
+	if result.APIVersion > types.MaxContractAPIVersion {
+		return 0, errorsmod.Wrapf(types.ErrContractAPIVersionNotSupported, "contract API version %d is greater than the maximum supported API version %d", result.APIVersion, types.MaxContractAPIVersion)
+	}
+
+	return result.APIVersion, nil
+}
+
 // validatePostExecutionClientState validates that the contract has not many any invalid modifications
 // to the client state during execution. It ensures that
 // - the client state is still present
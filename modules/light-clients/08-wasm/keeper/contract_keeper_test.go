@@ -16,6 +16,27 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestWasmInstantiate() {
+	var expAPIVersion uint64
+
+	// instantiateWithData stores the client and consensus states of the instantiate message
+	// and returns the provided data in the response of the contract
+	instantiateWithData := func(data []byte) func(wasmvm.Checksum, wasmvmtypes.Env, wasmvmtypes.MessageInfo, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64, wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			var payload types.InstantiateMessage
+			err := json.Unmarshal(initMsg, &payload)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.MaxContractAPIVersion, payload.MaxAPIVersion)
+
+			wrappedClientState, ok := clienttypes.MustUnmarshalClientState(suite.chainA.App.AppCodec(), payload.ClientState).(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			clientState := types.NewClientState(payload.ClientState, payload.Checksum, wrappedClientState.LatestHeight)
+			store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.App.AppCodec(), clientState))
+
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, 0, nil
+		}
+	}
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -50,6 +71,44 @@ func (suite *KeeperTestSuite) TestWasmInstantiate() {
 			},
 			nil,
 		},
+		{
+			"success: contract reports API version",
+			func() {
+				data, err := json.Marshal(types.APIVersionResult{APIVersion: types.ContractAPIVersionBatchVerification})
+				suite.Require().NoError(err)
+
+				suite.mockVM.InstantiateFn = instantiateWithData(data)
+				expAPIVersion = types.ContractAPIVersionBatchVerification
+			},
+			nil,
+		},
+		{
+			"success: contract does not report API version",
+			func() {
+				data, err := json.Marshal(types.EmptyResult{})
+				suite.Require().NoError(err)
+
+				suite.mockVM.InstantiateFn = instantiateWithData(data)
+			},
+			nil,
+		},
+		{
+			"failure: contract reports unsupported API version",
+			func() {
+				data, err := json.Marshal(types.APIVersionResult{APIVersion: types.MaxContractAPIVersion + 1})
+				suite.Require().NoError(err)
+
+				suite.mockVM.InstantiateFn = instantiateWithData(data)
+			},
+			types.ErrContractAPIVersionNotSupported,
+		},
+		{
+			"failure: contract returns invalid API version data",
+			func() {
+				suite.mockVM.InstantiateFn = instantiateWithData([]byte("invalid json"))
+			},
+			types.ErrWasmInvalidResponseData,
+		},
 		{
 			"failure: vm returns error",
 			func() {
@@ -168,6 +227,7 @@ func (suite *KeeperTestSuite) TestWasmInstantiate() {
 		suite.Run(tc.name, func() {
 			suite.SetupWasmWithMockVM()
 			checksum := suite.storeWasmCode(wasmtesting.Code)
+			expAPIVersion = types.ContractAPIVersionLegacy
 
 			tc.malleate()
 
@@ -184,6 +244,7 @@ func (suite *KeeperTestSuite) TestWasmInstantiate() {
 			expPass := tc.expError == nil
 			if expPass {
 				suite.Require().NoError(err)
+				suite.Require().Equal(expAPIVersion, types.GetContractAPIVersion(clientStore))
 			} else {
 				suite.Require().ErrorIs(err, tc.expError)
 			}
@@ -192,6 +253,8 @@ func (suite *KeeperTestSuite) TestWasmInstantiate() {
 }
 
 func (suite *KeeperTestSuite) TestWasmMigrate() {
+	var expAPIVersion uint64
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -214,6 +277,31 @@ func (suite *KeeperTestSuite) TestWasmMigrate() {
 			},
 			nil,
 		},
+		{
+			"success: migrated contract reports API version",
+			func() {
+				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					resp, err := json.Marshal(types.APIVersionResult{APIVersion: types.ContractAPIVersionBatchVerification})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, 0, nil
+				}
+				expAPIVersion = types.ContractAPIVersionBatchVerification
+			},
+			nil,
+		},
+		{
+			"failure: migrated contract reports unsupported API version",
+			func() {
+				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					resp, err := json.Marshal(types.APIVersionResult{APIVersion: types.MaxContractAPIVersion + 1})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, 0, nil
+				}
+			},
+			types.ErrContractAPIVersionNotSupported,
+		},
 		{
 			"failure: vm returns error",
 			func() {
@@ -315,6 +403,8 @@ func (suite *KeeperTestSuite) TestWasmMigrate() {
 			err := endpoint.CreateClient()
 			suite.Require().NoError(err)
 
+			expAPIVersion = types.ContractAPIVersionLegacy
+
 			tc.malleate()
 
 			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), defaultWasmClientID)
@@ -324,6 +414,7 @@ func (suite *KeeperTestSuite) TestWasmMigrate() {
 			expPass := tc.expError == nil
 			if expPass {
 				suite.Require().NoError(err)
+				suite.Require().Equal(expAPIVersion, types.GetContractAPIVersion(clientStore))
 			} else {
 				suite.Require().ErrorIs(err, tc.expError)
 			}
@@ -374,6 +465,20 @@ func (suite *KeeperTestSuite) TestWasmQuery() {
 			},
 			types.ErrWasmContractCallFailed,
 		},
+		{
+			"success: query message allowed by message allowlist",
+			func() {
+				suite.setMessageAllowlist(wasmtesting.Code, types.NewMessageAllowlist([]string{types.QueryMsgStatus}, nil))
+			},
+			nil,
+		},
+		{
+			"failure: query message not allowed by message allowlist",
+			func() {
+				suite.setMessageAllowlist(wasmtesting.Code, types.NewMessageAllowlist([]string{types.QueryMsgTimestampAtHeight}, types.SudoMsgNames()))
+			},
+			types.ErrMsgNotAllowed,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -435,6 +540,30 @@ func (suite *KeeperTestSuite) TestWasmSudo() {
 			},
 			nil,
 		},
+		{
+			"success: optional payload sent to contract implementing required API version",
+			func() {
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), defaultWasmClientID)
+				types.SetContractAPIVersion(clientStore, types.ContractAPIVersionBatchVerification)
+
+				suite.mockVM.RegisterSudoCallback(types.VerifyMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					resp, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, wasmtesting.DefaultGasUsed, nil
+				})
+
+				payload = types.SudoMsg{VerifyMembershipBatch: &types.VerifyMembershipBatchMsg{}}
+			},
+			nil,
+		},
+		{
+			"failure: optional payload not supported by contract API version",
+			func() {
+				payload = types.SudoMsg{VerifyMembershipBatch: &types.VerifyMembershipBatchMsg{}}
+			},
+			types.ErrContractAPIVersionNotSupported,
+		},
 		{
 			"failure: vm returns error",
 			func() {
@@ -545,6 +674,18 @@ func (suite *KeeperTestSuite) TestWasmSudo() {
 			},
 			types.ErrWasmInvalidContractModification,
 		},
+		{
+			"failure: sudo message not allowed by message allowlist",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					suite.FailNow("contract should not be called with a message not allowed by the message allowlist")
+					return nil, 0, nil
+				})
+
+				suite.setMessageAllowlist(wasmtesting.Code, types.NewMessageAllowlist(types.QueryMsgNames(), []string{types.SudoMsgVerifyMembership}))
+			},
+			types.ErrMsgNotAllowed,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/hex"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -37,3 +39,25 @@ func emitMigrateContractEvent(ctx sdk.Context, clientID string, checksum, newChe
 		),
 	})
 }
+
+// emitSetMessageAllowlistEvent emits a set message allowlist event
+func emitSetMessageAllowlistEvent(ctx sdk.Context, checksum types.Checksum, allowlist *types.MessageAllowlist) {
+	var queryMsgs, sudoMsgs []string
+	if allowlist != nil {
+		queryMsgs, sudoMsgs = allowlist.QueryMsgs, allowlist.SudoMsgs
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetMessageAllowlist,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+			sdk.NewAttribute(types.AttributeKeyQueryMsgs, strings.Join(queryMsgs, ",")),
+			sdk.NewAttribute(types.AttributeKeySudoMsgs, strings.Join(sudoMsgs, ",")),
+			sdk.NewAttribute(types.AttributeKeyAllowlistRemoved, strconv.FormatBool(allowlist == nil)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -1,6 +1,10 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
 
 // MigrateContractCode is a wrapper around k.migrateContractCode to allow the method to be directly called in tests.
 func (k Keeper) MigrateContractCode(ctx sdk.Context, clientID string, newChecksum, migrateMsg []byte) error {
@@ -16,3 +20,13 @@ func (k Keeper) GetQueryPlugins() QueryPlugins {
 func (k *Keeper) SetQueryPlugins(plugins QueryPlugins) {
 	k.setQueryPlugins(plugins)
 }
+
+// RemoveChecksumClients is a wrapper around k.removeChecksumClients to allow the method to be directly called in tests.
+func (k Keeper) RemoveChecksumClients(ctx sdk.Context, checksum types.Checksum) error {
+	return k.removeChecksumClients(ctx, checksum)
+}
+
+// AcquireVMInstance is a wrapper around k.acquireVMInstance to allow the method to be directly called in tests.
+func (k Keeper) AcquireVMInstance(ctx sdk.Context) (sdk.Context, func(), error) {
+	return k.acquireVMInstance(ctx)
+}
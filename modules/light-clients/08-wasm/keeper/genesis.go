@@ -17,12 +17,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
 	}
 
 	for _, contract := range gs.Contracts {
-		_, err := k.storeWasmCode(ctx, contract.CodeBytes, storeFn)
+		checksum, err := k.storeWasmCode(ctx, contract.CodeBytes, storeFn)
 		if err != nil {
 			return err
 		}
+
+		if err := k.setMessageAllowlist(ctx, checksum, contract.MessageAllowlist); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	// clients are initialized from the 02-client genesis state without instantiating their contracts,
+	// so the index of clients using a checksum is rebuilt from the stored clients
+	return k.indexChecksumClients(ctx)
 }
 
 // ExportGenesis returns the 08-wasm module's exported genesis. This includes the code
@@ -40,9 +47,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		if err != nil {
 			panic(err)
 		}
-		genesisState.Contracts = append(genesisState.Contracts, types.Contract{
+		contract := types.Contract{
 			CodeBytes: code,
-		})
+		}
+		if allowlist, found := k.GetMessageAllowlist(ctx, checksum); found {
+			contract.MessageAllowlist = &allowlist
+		}
+
+		genesisState.Contracts = append(genesisState.Contracts, contract)
 	}
 
 	return genesisState
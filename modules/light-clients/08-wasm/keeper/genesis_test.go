@@ -69,6 +69,27 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestInitGenesisIndexesChecksumClients() {
+	suite.SetupWasmWithMockVM()
+
+	checksum := suite.storeWasmCode(wasmtesting.Code)
+
+	endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+	err := endpoint.CreateClient()
+	suite.Require().NoError(err)
+
+	// clients imported through the 02-client genesis are not indexed at creation time
+	wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+	suite.Require().NoError(wasmClientKeeper.RemoveChecksumClients(suite.chainA.GetContext(), checksum))
+
+	err = wasmClientKeeper.InitGenesis(suite.chainA.GetContext(), *types.NewGenesisState([]types.Contract{}))
+	suite.Require().NoError(err)
+
+	clientIDs, err := wasmClientKeeper.GetChecksumClients(suite.chainA.GetContext(), checksum)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{endpoint.ClientID}, clientIDs)
+}
+
 func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.SetupWasmWithMockVM()
 
@@ -86,4 +107,30 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	genesisState := GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
 	suite.Require().Len(genesisState.Contracts, 1)
 	suite.Require().NotEmpty(genesisState.Contracts[0].CodeBytes)
+	suite.Require().Nil(genesisState.Contracts[0].MessageAllowlist)
+
+	allowlist := types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState})
+	suite.setMessageAllowlist(wasmtesting.Code, allowlist)
+
+	genesisState = GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
+	suite.Require().Len(genesisState.Contracts, 1)
+	suite.Require().Equal(&allowlist, genesisState.Contracts[0].MessageAllowlist)
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisMessageAllowlist() {
+	suite.SetupWasmWithMockVM()
+
+	allowlist := types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState})
+	genesisState := *types.NewGenesisState([]types.Contract{{CodeBytes: wasmtesting.Code, MessageAllowlist: &allowlist}})
+
+	wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+	err := wasmClientKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
+	suite.Require().NoError(err)
+
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	suite.Require().NoError(err)
+
+	storedAllowlist, found := wasmClientKeeper.GetMessageAllowlist(suite.chainA.GetContext(), checksum)
+	suite.Require().True(found)
+	suite.Require().Equal(allowlist, storedAllowlist)
 }
@@ -14,6 +14,8 @@ import (
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -62,3 +64,82 @@ func (k Keeper) Checksums(goCtx context.Context, req *types.QueryChecksumsReques
 		Pagination: pageRes,
 	}, nil
 }
+
+// MessageAllowlist implements the Query/MessageAllowlist gRPC method
+func (k Keeper) MessageAllowlist(goCtx context.Context, req *types.QueryMessageAllowlistRequest) (*types.QueryMessageAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum")
+	}
+
+	if !k.HasChecksum(goCtx, checksum) {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrWasmChecksumNotFound, req.Checksum).Error())
+	}
+
+	var res types.QueryMessageAllowlistResponse
+	if allowlist, found := k.GetMessageAllowlist(goCtx, checksum); found {
+		res.MessageAllowlist = &allowlist
+	}
+
+	return &res, nil
+}
+
+// ClientChecksum implements the Query/ClientChecksum gRPC method. It returns the hex encoded checksum
+// of the contract used by the given client.
+func (k Keeper) ClientChecksum(goCtx context.Context, req *types.QueryClientChecksumRequest) (*types.QueryClientChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if clientType != exported.Wasm {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected %s, got %s", exported.Wasm, clientType).Error())
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(sdk.UnwrapSDKContext(goCtx), req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(clienttypes.ErrClientNotFound, req.ClientId).Error())
+	}
+
+	wasmClientState, ok := clientState.(*types.ClientState)
+	if !ok {
+		return nil, status.Error(codes.Internal, errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", (*types.ClientState)(nil), clientState).Error())
+	}
+
+	return &types.QueryClientChecksumResponse{
+		Checksum: hex.EncodeToString(wasmClientState.Checksum),
+	}, nil
+}
+
+// ContractCalls implements the Query/ContractCalls gRPC method. It returns the most recent contract calls
+// traced for the given client. An error is returned if contract call tracing is disabled on the node.
+func (k Keeper) ContractCalls(goCtx context.Context, req *types.QueryContractCallsRequest) (*types.QueryContractCallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if clientType != exported.Wasm {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected %s, got %s", exported.Wasm, clientType).Error())
+	}
+
+	if k.tracer == nil {
+		return nil, status.Error(codes.FailedPrecondition, "contract call tracing is disabled")
+	}
+
+	return &types.QueryContractCallsResponse{
+		Calls: k.tracer.getCalls(req.ClientId),
+	}, nil
+}
@@ -2,12 +2,15 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"path/filepath"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryCode() {
@@ -116,3 +119,234 @@ func (suite *KeeperTestSuite) TestQueryChecksums() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryClientChecksum() {
+	var (
+		req         *types.QueryClientChecksumRequest
+		expChecksum string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				_ = suite.storeWasmCode(wasmtesting.Code)
+
+				endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+				err := endpoint.CreateClient()
+				suite.Require().NoError(err)
+
+				clientState, ok := endpoint.GetClientState().(*types.ClientState)
+				suite.Require().True(ok)
+
+				req = &types.QueryClientChecksumRequest{ClientId: endpoint.ClientID}
+				expChecksum = hex.EncodeToString(clientState.Checksum)
+			},
+			true,
+		},
+		{
+			"fails with empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"fails with invalid client identifier",
+			func() {
+				req = &types.QueryClientChecksumRequest{ClientId: "invalid"}
+			},
+			false,
+		},
+		{
+			"fails with client type other than wasm",
+			func() {
+				req = &types.QueryClientChecksumRequest{ClientId: ibctesting.FirstClientID}
+			},
+			false,
+		},
+		{
+			"fails with non-existent client",
+			func() {
+				req = &types.QueryClientChecksumRequest{ClientId: defaultWasmClientID}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			tc.malleate()
+
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ClientChecksum(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expChecksum, res.Checksum)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryContractCalls() {
+	var (
+		wasmClientKeeper keeper.Keeper
+		req              *types.QueryContractCallsRequest
+		expCalls         int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+				err := endpoint.CreateClient()
+				suite.Require().NoError(err)
+
+				// the client creation instantiates the contract and queries its status
+				clientState := endpoint.GetClientState()
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpoint.ClientID)
+				_, err = wasmClientKeeper.WasmQuery(suite.chainA.GetContext(), endpoint.ClientID, clientStore, clientState.(*types.ClientState), types.QueryMsg{Status: &types.StatusMsg{}})
+				suite.Require().NoError(err)
+
+				req = &types.QueryContractCallsRequest{ClientId: endpoint.ClientID}
+				expCalls = 1
+			},
+			true,
+		},
+		{
+			"success: no calls traced for client",
+			func() {
+				req = &types.QueryContractCallsRequest{ClientId: defaultWasmClientID}
+				expCalls = 0
+			},
+			true,
+		},
+		{
+			"fails with empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"fails with client type other than wasm",
+			func() {
+				req = &types.QueryContractCallsRequest{ClientId: ibctesting.FirstClientID}
+			},
+			false,
+		},
+		{
+			"fails with contract call tracing disabled",
+			func() {
+				wasmClientKeeper = GetSimApp(suite.chainA).WasmClientKeeper
+				req = &types.QueryContractCallsRequest{ClientId: defaultWasmClientID}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			_ = suite.storeWasmCode(wasmtesting.Code)
+
+			wasmClientKeeper = suite.newTracingKeeper(filepath.Join(suite.T().TempDir(), "trace.jsonl"), 10)
+
+			tc.malleate()
+
+			res, err := wasmClientKeeper.ContractCalls(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Calls, expCalls)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryMessageAllowlist() {
+	var (
+		req          *types.QueryMessageAllowlistRequest
+		expAllowlist *types.MessageAllowlist
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no allowlist",
+			func() {},
+			true,
+		},
+		{
+			"success: allowlist set",
+			func() {
+				allowlist := types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState})
+				suite.setMessageAllowlist(wasmtesting.Code, allowlist)
+
+				expAllowlist = &allowlist
+			},
+			true,
+		},
+		{
+			"fails with invalid checksum",
+			func() {
+				req = &types.QueryMessageAllowlistRequest{Checksum: "test"}
+			},
+			false,
+		},
+		{
+			"fails with non-existent checksum",
+			func() {
+				req = &types.QueryMessageAllowlistRequest{Checksum: hex.EncodeToString([]byte{1})}
+			},
+			false,
+		},
+		{
+			"fails with nil request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			checksum := suite.storeWasmCode(wasmtesting.Code)
+
+			req = &types.QueryMessageAllowlistRequest{Checksum: hex.EncodeToString(checksum)}
+			expAllowlist = nil
+
+			tc.malleate()
+
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.MessageAllowlist(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAllowlist, res.MessageAllowlist)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -34,8 +34,20 @@ type Keeper struct {
 	checksums    collections.KeySet[[]byte]
 	storeService store.KVStoreService
 
+	// checksumClients indexes the identifiers of the clients using a checksum
+	checksumClients collections.KeySet[collections.Pair[[]byte, string]]
+
+	// messageAllowlists stores the optional allowlists of the messages forwarded to the contracts of checksums
+	messageAllowlists collections.Map[[]byte, types.MessageAllowlist]
+
 	queryPlugins QueryPlugins
 
+	// tracer records contract calls, it is nil if contract call tracing is disabled
+	tracer *contractTracer
+
+	// pool limits the number of concurrent contract calls, it is nil if the concurrency is not limited
+	pool *instancePool
+
 	authority string
 }
 
@@ -180,6 +192,14 @@ func (k Keeper) migrateContractCode(ctx sdk.Context, clientID string, newChecksu
 
 	k.clientKeeper.SetClientState(ctx, clientID, wasmClientState)
 
+	if err := k.checksumClients.Remove(ctx, collections.Join([]byte(oldChecksum), clientID)); err != nil {
+		return err
+	}
+
+	if err := k.checksumClients.Set(ctx, collections.Join([]byte(newChecksum), clientID)); err != nil {
+		return err
+	}
+
 	emitMigrateContractEvent(ctx, clientID, oldChecksum, newChecksum)
 
 	return nil
@@ -232,6 +252,55 @@ func (k Keeper) HasChecksum(ctx context.Context, checksum types.Checksum) bool {
 	return found
 }
 
+// GetChecksumClients returns the identifiers of the clients using the given checksum. Index entries of
+// clients which no longer exist or no longer use the checksum are skipped.
+func (k Keeper) GetChecksumClients(ctx sdk.Context, checksum types.Checksum) ([]string, error) {
+	iterator, err := k.checksumClients.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, string](checksum))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	clientIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		clientID := key.K2()
+
+		wasmClientState, err := k.GetWasmClientState(ctx, clientID)
+		if err != nil || !bytes.Equal(wasmClientState.Checksum, checksum) {
+			continue
+		}
+
+		clientIDs = append(clientIDs, clientID)
+	}
+
+	return clientIDs, nil
+}
+
+// removeChecksumClients removes all index entries of clients for the given checksum.
+func (k Keeper) removeChecksumClients(ctx sdk.Context, checksum types.Checksum) error {
+	return k.checksumClients.Clear(ctx, collections.NewPrefixedPairRange[[]byte, string](checksum))
+}
+
+// indexChecksumClients adds all stored 08-wasm clients to the index of clients using a checksum.
+func (k Keeper) indexChecksumClients(ctx sdk.Context) error {
+	var err error
+	k.clientKeeper.IterateClientStates(ctx, []byte(types.Wasm), func(clientID string, clientState exported.ClientState) bool {
+		wasmClientState, ok := clientState.(*types.ClientState)
+		if !ok {
+			return false
+		}
+
+		err = k.checksumClients.Set(ctx, collections.Join(wasmClientState.Checksum, clientID))
+		return err != nil
+	})
+
+	return err
+}
+
 // InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned
 func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 	checksums, err := k.GetAllChecksums(ctx)
@@ -131,6 +131,16 @@ func (suite *KeeperTestSuite) storeWasmCode(wasmCode []byte) []byte {
 	return response.Checksum
 }
 
+// setMessageAllowlist sets the message allowlist of the checksum of the wasm code.
+func (suite *KeeperTestSuite) setMessageAllowlist(wasmCode []byte, allowlist types.MessageAllowlist) {
+	checksum, err := types.CreateChecksum(wasmCode)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgSetMessageAllowlist(authtypes.NewModuleAddress(govtypes.ModuleName).String(), checksum, &allowlist)
+	_, err = GetSimApp(suite.chainA).WasmClientKeeper.SetMessageAllowlist(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) SetupSnapshotterWithMockVM() *simapp.SimApp {
 	suite.mockVM = wasmtesting.NewMockWasmEngine()
 
@@ -59,6 +59,9 @@ func NewKeeperWithVM(
 		storeService: storeService,
 		clientKeeper: clientKeeper,
 		authority:    authority,
+
+		checksumClients:   collections.NewKeySet(sb, types.ChecksumClientsKey, "checksum_clients", collections.PairKeyCodec(collections.BytesKey, collections.StringKey)),
+		messageAllowlists: collections.NewMap(sb, types.MessageAllowlistsKey, "message_allowlists", collections.BytesKey, codec.CollValue[types.MessageAllowlist](cdc)),
 	}
 
 	_, err := sb.Build()
@@ -94,5 +97,13 @@ func NewKeeperWithConfig(
 		panic(fmt.Errorf("failed to instantiate new Wasm VM instance: %v", err))
 	}
 
+	if wasmConfig.ContractTraceFile != "" {
+		opts = append(opts, WithContractTracing(wasmConfig.ContractTraceFile, wasmConfig.ContractTraceLimit))
+	}
+
+	if wasmConfig.MaxConcurrentContractCalls != 0 {
+		opts = append(opts, WithMaxConcurrentContractCalls(wasmConfig.MaxConcurrentContractCalls))
+	}
+
 	return NewKeeperWithVM(cdc, storeService, clientKeeper, authority, vm, queryRouter, opts...)
 }
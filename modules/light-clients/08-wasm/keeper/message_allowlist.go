@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// GetMessageAllowlist returns the allowlist of the messages forwarded to the contract of the given checksum.
+// It returns false if no allowlist is set for the checksum, in which case all messages are forwarded.
+func (k Keeper) GetMessageAllowlist(ctx context.Context, checksum types.Checksum) (types.MessageAllowlist, bool) {
+	allowlist, err := k.messageAllowlists.Get(ctx, checksum)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.MessageAllowlist{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return allowlist, true
+}
+
+// setMessageAllowlist sets the allowlist of the messages forwarded to the contract of the given checksum.
+// A nil allowlist removes the allowlist of the checksum.
+func (k Keeper) setMessageAllowlist(ctx context.Context, checksum types.Checksum, allowlist *types.MessageAllowlist) error {
+	if allowlist == nil {
+		return k.messageAllowlists.Remove(ctx, checksum)
+	}
+
+	return k.messageAllowlists.Set(ctx, checksum, *allowlist)
+}
+
+// checkQueryMsgAllowed returns an error if the message allowlist of the checksum does not allow the query message.
+func (k Keeper) checkQueryMsgAllowed(ctx context.Context, checksum types.Checksum, payload types.QueryMsg) error {
+	allowlist, found := k.GetMessageAllowlist(ctx, checksum)
+	if !found || allowlist.IsQueryMsgAllowed(payload.Name()) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrMsgNotAllowed, "query message %q, checksum (%s)", payload.Name(), hex.EncodeToString(checksum))
+}
+
+// checkSudoMsgAllowed returns an error if the message allowlist of the checksum does not allow the sudo message.
+func (k Keeper) checkSudoMsgAllowed(ctx context.Context, checksum types.Checksum, payload types.SudoMsg) error {
+	allowlist, found := k.GetMessageAllowlist(ctx, checksum)
+	if !found || allowlist.IsSudoMsgAllowed(payload.Name()) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrMsgNotAllowed, "sudo message %q, checksum (%s)", payload.Name(), hex.EncodeToString(checksum))
+}
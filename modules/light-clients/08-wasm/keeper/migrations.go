@@ -45,6 +45,16 @@ func (m Migrator) MigrateChecksums(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateChecksumClients builds the index of clients using a checksum from the stored 08-wasm clients.
+func (m Migrator) MigrateChecksumClients(ctx sdk.Context) error {
+	if err := m.keeper.indexChecksumClients(ctx); err != nil {
+		return err
+	}
+
+	m.keeper.Logger(ctx).Info("successfully indexed clients using checksums")
+	return nil
+}
+
 // getStoredChecksums returns the checksums stored under the KeyChecksums key.
 func (m Migrator) getStoredChecksums(ctx sdk.Context) ([][]byte, error) {
 	store := m.keeper.storeService.OpenKVStore(ctx)
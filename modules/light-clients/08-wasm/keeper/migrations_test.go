@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 )
 
@@ -46,6 +47,34 @@ func (suite *KeeperTestSuite) TestMigrateWasmStore() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigrateChecksumClients() {
+	suite.SetupWasmWithMockVM()
+
+	checksum := suite.storeWasmCode(wasmtesting.Code)
+
+	endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+	err := endpoint.CreateClient()
+	suite.Require().NoError(err)
+
+	// remove the index entry to mimic a client created before the index existed
+	wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+	suite.Require().NoError(wasmClientKeeper.RemoveChecksumClients(suite.chainA.GetContext(), checksum))
+
+	clientIDs, err := wasmClientKeeper.GetChecksumClients(suite.chainA.GetContext(), checksum)
+	suite.Require().NoError(err)
+	suite.Require().Empty(clientIDs)
+
+	// run the migration
+	m := keeper.NewMigrator(wasmClientKeeper)
+
+	err = m.MigrateChecksumClients(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	clientIDs, err = wasmClientKeeper.GetChecksumClients(suite.chainA.GetContext(), checksum)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{endpoint.ClientID}, clientIDs)
+}
+
 // storeChecksums stores the given checksums under the KeyChecksums key, it runs
 // each time on an empty store so we don't need to read the previous checksums.
 func (suite *KeeperTestSuite) storeChecksums(checksums [][]byte) {
@@ -26,6 +26,10 @@ func (k Keeper) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*type
 		return nil, errorsmod.Wrap(err, "failed to store wasm bytecode")
 	}
 
+	if err := k.setMessageAllowlist(ctx, checksum, msg.MessageAllowlist); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set message allowlist")
+	}
+
 	emitStoreWasmCodeEvent(ctx, checksum)
 
 	return &types.MsgStoreCodeResponse{
@@ -43,11 +47,37 @@ func (k Keeper) RemoveChecksum(goCtx context.Context, msg *types.MsgRemoveChecks
 		return nil, types.ErrWasmChecksumNotFound
 	}
 
-	err := k.GetChecksums().Remove(goCtx, msg.Checksum)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientIDs, err := k.GetChecksumClients(ctx, msg.Checksum)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to retrieve clients using checksum")
+	}
+
+	if len(clientIDs) > 0 && !msg.Force {
+		return nil, errorsmod.Wrapf(types.ErrWasmChecksumInUse, "checksum (%s) is used by clients %v", hex.EncodeToString(msg.Checksum), clientIDs)
+	}
+
+	// migrate the clients still using the checksum to the migration target before removing it
+	for _, clientID := range clientIDs {
+		if err := k.migrateContractCode(ctx, clientID, msg.MigrateToChecksum, msg.MigrateMsg); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to migrate client %s", clientID)
+		}
+	}
+
+	if err := k.removeChecksumClients(ctx, msg.Checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove clients using checksum")
+	}
+
+	err = k.GetChecksums().Remove(goCtx, msg.Checksum)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to remove checksum")
 	}
 
+	if err := k.setMessageAllowlist(ctx, msg.Checksum, nil); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove message allowlist")
+	}
+
 	// unpin the code from the vm in-memory cache
 	if err := k.GetVM().Unpin(msg.Checksum); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to unpin contract with checksum (%s) from vm cache", hex.EncodeToString(msg.Checksum))
@@ -73,3 +103,23 @@ func (k Keeper) MigrateContract(goCtx context.Context, msg *types.MsgMigrateCont
 
 	return &types.MsgMigrateContractResponse{}, nil
 }
+
+// SetMessageAllowlist defines a rpc handler method for MsgSetMessageAllowlist
+func (k Keeper) SetMessageAllowlist(goCtx context.Context, msg *types.MsgSetMessageAllowlist) (*types.MsgSetMessageAllowlistResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if !k.HasChecksum(goCtx, msg.Checksum) {
+		return nil, types.ErrWasmChecksumNotFound
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.setMessageAllowlist(ctx, msg.Checksum, msg.MessageAllowlist); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set message allowlist")
+	}
+
+	emitSetMessageAllowlistEvent(ctx, msg.Checksum, msg.MessageAllowlist)
+
+	return &types.MsgSetMessageAllowlistResponse{}, nil
+}
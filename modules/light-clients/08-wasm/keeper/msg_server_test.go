@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
@@ -40,6 +42,13 @@ func (suite *KeeperTestSuite) TestMsgStoreCode() {
 			},
 			nil,
 		},
+		{
+			"success: with message allowlist",
+			func() {
+				msg = types.NewMsgStoreCodeWithMessageAllowlist(signer, data, types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState}))
+			},
+			nil,
+		},
 		{
 			"fails with duplicate wasm code",
 			func() {
@@ -110,6 +119,12 @@ func (suite *KeeperTestSuite) TestMsgStoreCode() {
 				suite.Require().NotNil(res)
 				suite.Require().NotEmpty(res.Checksum)
 
+				allowlist, found := GetSimApp(suite.chainA).WasmClientKeeper.GetMessageAllowlist(ctx, res.Checksum)
+				suite.Require().Equal(msg.MessageAllowlist != nil, found)
+				if found {
+					suite.Require().Equal(*msg.MessageAllowlist, allowlist)
+				}
+
 				// Verify events
 				expectedEvents := sdk.Events{
 					sdk.NewEvent(
@@ -326,13 +341,24 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 	checksum, err := types.CreateChecksum(wasmtesting.Code)
 	suite.Require().NoError(err)
 
+	newByteCode := wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgRemoveChecksum"))
+
 	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	var (
 		msg          *types.MsgRemoveChecksum
+		newChecksum  types.Checksum
 		expChecksums []types.Checksum
+		expEvents    sdk.Events
 	)
 
+	// createClient creates a client using the checksum to be removed
+	createClient := func() {
+		endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+		err := endpoint.CreateClient()
+		suite.Require().NoError(err)
+	}
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -343,7 +369,7 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			func() {
 				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
 
-				expChecksums = []types.Checksum{}
+				expChecksums = []types.Checksum{newChecksum}
 			},
 			nil,
 		},
@@ -352,7 +378,7 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			func() {
 				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
 
-				expChecksums = []types.Checksum{}
+				expChecksums = []types.Checksum{newChecksum}
 
 				for i := 0; i < 20; i++ {
 					mockCode := wasmtesting.CreateMockContract([]byte{byte(i)})
@@ -368,6 +394,67 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			},
 			nil,
 		},
+		{
+			"success: forced removal migrates clients using the checksum",
+			func() {
+				createClient()
+
+				msg = types.NewMsgForceRemoveChecksum(govAcc, checksum, newChecksum, []byte("{}"))
+
+				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					data, err := json.Marshal(types.EmptyResult{})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: data}}, wasmtesting.DefaultGasUsed, nil
+				}
+
+				expChecksums = []types.Checksum{newChecksum}
+				expEvents = sdk.Events{
+					sdk.NewEvent(
+						"migrate_contract",
+						sdk.NewAttribute(types.AttributeKeyClientID, defaultWasmClientID),
+						sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+						sdk.NewAttribute(types.AttributeKeyNewChecksum, hex.EncodeToString(newChecksum)),
+					),
+					sdk.NewEvent(
+						sdk.EventTypeMessage,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					),
+				}
+			},
+			nil,
+		},
+		{
+			"failure: checksum is used by a client",
+			func() {
+				createClient()
+
+				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
+			},
+			types.ErrWasmChecksumInUse,
+		},
+		{
+			"failure: forced removal with missing migration target",
+			func() {
+				createClient()
+
+				msg = types.NewMsgForceRemoveChecksum(govAcc, checksum, []byte{1}, []byte("{}"))
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: forced removal with failing contract migration",
+			func() {
+				createClient()
+
+				msg = types.NewMsgForceRemoveChecksum(govAcc, checksum, newChecksum, []byte("{}"))
+
+				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return nil, wasmtesting.DefaultGasUsed, wasmtesting.ErrMockVM
+				}
+			},
+			types.ErrVMError,
+		},
 		{
 			"failure: checksum is missing",
 			func() {
@@ -400,10 +487,9 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			suite.SetupWasmWithMockVM()
 
 			_ = suite.storeWasmCode(wasmtesting.Code)
+			newChecksum = suite.storeWasmCode(newByteCode)
 
-			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
-			err := endpoint.CreateClient()
-			suite.Require().NoError(err)
+			expEvents = sdk.Events{}
 
 			tc.malleate()
 
@@ -421,8 +507,13 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 				// Check equality of checksums up to order
 				suite.Require().ElementsMatch(expChecksums, checksums)
 
+				// the removed checksum is no longer used by any client
+				clientIDs, err := GetSimApp(suite.chainA).WasmClientKeeper.GetChecksumClients(suite.chainA.GetContext(), checksum)
+				suite.Require().NoError(err)
+				suite.Require().Empty(clientIDs)
+
 				// Verify events
-				suite.Require().Len(events, 0)
+				suite.Require().Equal(expEvents.ToABCIEvents(), events)
 			} else {
 				suite.Require().ErrorIs(err, tc.expError)
 				suite.Require().Nil(res)
@@ -430,3 +521,108 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetMessageAllowlist() {
+	var (
+		msg      *types.MsgSetMessageAllowlist
+		checksum types.Checksum
+	)
+
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	allowlist := types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState, types.SudoMsgVerifyMembership})
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				msg = types.NewMsgSetMessageAllowlist(govAcc, checksum, &allowlist)
+			},
+			nil,
+		},
+		{
+			"success: overwrite existing allowlist",
+			func() {
+				suite.setMessageAllowlist(wasmtesting.Code, types.NewMessageAllowlist(nil, nil))
+
+				msg = types.NewMsgSetMessageAllowlist(govAcc, checksum, &allowlist)
+			},
+			nil,
+		},
+		{
+			"success: remove allowlist",
+			func() {
+				suite.setMessageAllowlist(wasmtesting.Code, allowlist)
+
+				msg = types.NewMsgSetMessageAllowlist(govAcc, checksum, nil)
+			},
+			nil,
+		},
+		{
+			"failure: checksum is missing",
+			func() {
+				msg = types.NewMsgSetMessageAllowlist(govAcc, []byte{1}, &allowlist)
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgSetMessageAllowlist(suite.chainA.SenderAccount.GetAddress().String(), checksum, &allowlist)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			checksum = suite.storeWasmCode(wasmtesting.Code)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.SetMessageAllowlist(ctx, msg)
+			events := ctx.EventManager().Events()
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				storedAllowlist, found := GetSimApp(suite.chainA).WasmClientKeeper.GetMessageAllowlist(ctx, checksum)
+				suite.Require().Equal(msg.MessageAllowlist != nil, found)
+
+				var queryMsgs, sudoMsgs []string
+				if found {
+					suite.Require().Equal(*msg.MessageAllowlist, storedAllowlist)
+					queryMsgs, sudoMsgs = msg.MessageAllowlist.QueryMsgs, msg.MessageAllowlist.SudoMsgs
+				}
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeSetMessageAllowlist,
+						sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+						sdk.NewAttribute(types.AttributeKeyQueryMsgs, strings.Join(queryMsgs, ",")),
+						sdk.NewAttribute(types.AttributeKeySudoMsgs, strings.Join(sudoMsgs, ",")),
+						sdk.NewAttribute(types.AttributeKeyAllowlistRemoved, strconv.FormatBool(!found)),
+					),
+					sdk.NewEvent(
+						sdk.EventTypeMessage,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					),
+				}
+
+				for _, evt := range expectedEvents {
+					suite.Require().Contains(events, evt)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				suite.Require().Empty(events)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package keeper
 
+import "fmt"
+
 // Option is an extension point to instantiate keeper with non default values
 type Option interface {
 	apply(*Keeper)
@@ -21,3 +23,33 @@ func WithQueryPlugins(plugins *QueryPlugins) Option {
 		k.setQueryPlugins(newPlugins)
 	})
 }
+
+// WithContractTracing is an optional constructor parameter to enable the tracing of contract calls.
+// Every call of a light client contract is appended to the trace file and the most recent calls of
+// each client, up to the given limit, are kept in memory to be returned by the ContractCalls query.
+// Contract call tracing is a debugging tool and must not be enabled on production nodes.
+func WithContractTracing(traceFile string, limit uint32) Option {
+	return optsFn(func(k *Keeper) {
+		tracer, err := newContractTracer(traceFile, limit)
+		if err != nil {
+			panic(fmt.Errorf("failed to enable contract call tracing: %w", err))
+		}
+
+		k.tracer = tracer
+	})
+}
+
+// WithMaxConcurrentContractCalls is an optional constructor parameter to limit the number of wasm VM instances
+// executing light client contracts concurrently for queries and CheckTx. Contract calls exceeding the limit,
+// e.g. when serving many simultaneous client state queries, wait until a running call returns. Contract calls
+// executed in consensus are not limited. The time spent waiting is exposed via telemetry.
+func WithMaxConcurrentContractCalls(maxConcurrency uint32) Option {
+	return optsFn(func(k *Keeper) {
+		pool, err := newInstancePool(maxConcurrency)
+		if err != nil {
+			panic(fmt.Errorf("failed to limit concurrent contract calls: %w", err))
+		}
+
+		k.pool = pool
+	})
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// instancePoolKey is the context key marking a context as holding a slot of the instance pool.
+type instancePoolKey struct{}
+
+// instancePool limits the number of wasm VM instances executing light client contracts concurrently for
+// queries and mempool checks. Every such contract call holds a slot of the pool for its duration, calls
+// exceeding the maximum concurrency wait until a slot is released. Contract calls nested in another contract
+// call, e.g. through the query plugins, reuse the slot of the outer call so that they cannot deadlock on an
+// exhausted pool.
+type instancePool struct {
+	slots chan struct{}
+}
+
+// newInstancePool returns an instancePool allowing up to maxConcurrency concurrent contract calls.
+func newInstancePool(maxConcurrency uint32) (*instancePool, error) {
+	if maxConcurrency == 0 {
+		return nil, errors.New("max concurrency must be greater than zero")
+	}
+
+	return &instancePool{slots: make(chan struct{}, maxConcurrency)}, nil
+}
+
+// acquire waits for a free slot of the pool and returns a context marked as holding it, together with
+// the function releasing the slot. An error is returned if the context is done before a slot is free.
+// The time spent waiting for a slot is measured with telemetry.
+func (p *instancePool) acquire(ctx sdk.Context) (sdk.Context, func(), error) {
+	if ctx.Value(instancePoolKey{}) != nil {
+		return ctx, func() {}, nil
+	}
+
+	start := time.Now()
+	select {
+	case p.slots <- struct{}{}:
+	default:
+		telemetry.IncrCounter(1, "ibc", types.ModuleName, "vm", "pool_exhausted")
+
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Context().Done():
+			return ctx, nil, ctx.Context().Err()
+		}
+	}
+
+	telemetry.MeasureSince(start, "ibc", types.ModuleName, "vm", "pool_wait")
+	telemetry.SetGauge(float32(len(p.slots)), "ibc", types.ModuleName, "vm", "instances")
+
+	return ctx.WithValue(instancePoolKey{}, struct{}{}), func() { <-p.slots }, nil
+}
+
+// acquireVMInstance acquires a slot of the instance pool for a contract call if the concurrency of
+// contract calls is limited. The returned context must be used for the contract call and the returned
+// function must be called once the call has returned. Only contract calls of query and CheckTx contexts
+// are limited: contract calls executed in consensus never wait on the pool, as a node serving many
+// queries must not fall behind or fail to execute blocks.
+func (k Keeper) acquireVMInstance(ctx sdk.Context) (sdk.Context, func(), error) {
+	if k.pool == nil || !ctx.IsCheckTx() {
+		return ctx, func() {}, nil
+	}
+
+	return k.pool.acquire(ctx)
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// newPooledKeeper returns a keeper sharing the state and vm of the chainA wasm client keeper with the
+// number of concurrent contract calls limited to maxConcurrency.
+func (suite *KeeperTestSuite) newPooledKeeper(maxConcurrency uint32) keeper.Keeper {
+	return keeper.NewKeeperWithVM(
+		GetSimApp(suite.chainA).AppCodec(),
+		runtime.NewKVStoreService(GetSimApp(suite.chainA).GetKey(types.StoreKey)),
+		GetSimApp(suite.chainA).IBCKeeper.ClientKeeper,
+		GetSimApp(suite.chainA).WasmClientKeeper.GetAuthority(),
+		GetSimApp(suite.chainA).WasmClientKeeper.GetVM(),
+		GetSimApp(suite.chainA).GRPCQueryRouter(),
+		keeper.WithMaxConcurrentContractCalls(maxConcurrency),
+	)
+}
+
+func (suite *KeeperTestSuite) TestAcquireVMInstance() {
+	suite.SetupWasmWithMockVM()
+
+	suite.Require().Panics(func() {
+		suite.newPooledKeeper(0)
+	})
+
+	wasmClientKeeper := suite.newPooledKeeper(1)
+	// query contexts are created as CheckTx contexts
+	ctx := suite.chainA.GetContext().WithIsCheckTx(true)
+
+	heldCtx, release, err := wasmClientKeeper.AcquireVMInstance(ctx)
+	suite.Require().NoError(err)
+
+	// nested contract calls reuse the slot of the outer call
+	_, releaseNested, err := wasmClientKeeper.AcquireVMInstance(heldCtx)
+	suite.Require().NoError(err)
+	releaseNested()
+
+	// contract calls wait for a free slot until their context is done
+	cancelledCtx, cancel := context.WithCancel(ctx.Context())
+	cancel()
+	_, _, err = wasmClientKeeper.AcquireVMInstance(ctx.WithContext(cancelledCtx))
+	suite.Require().ErrorIs(err, context.Canceled)
+
+	// contract calls executed in consensus are not limited by the exhausted pool
+	consensusCtx := suite.chainA.GetContext().WithExecMode(sdk.ExecModeFinalize)
+	_, releaseConsensus, err := wasmClientKeeper.AcquireVMInstance(consensusCtx.WithContext(cancelledCtx))
+	suite.Require().NoError(err)
+	releaseConsensus()
+
+	release()
+
+	_, release, err = wasmClientKeeper.AcquireVMInstance(ctx.WithContext(cancelledCtx))
+	suite.Require().NoError(err)
+	release()
+}
+
+func (suite *KeeperTestSuite) TestWasmQueryWithInstancePool() {
+	suite.SetupWasmWithMockVM()
+	_ = suite.storeWasmCode(wasmtesting.Code)
+
+	endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+	err := endpoint.CreateClient()
+	suite.Require().NoError(err)
+
+	clientState, ok := endpoint.GetClientState().(*types.ClientState)
+	suite.Require().True(ok)
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpoint.ClientID)
+
+	statusResult, err := json.Marshal(types.StatusResult{Status: exported.Active.String()})
+	suite.Require().NoError(err)
+
+	suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		return &wasmvmtypes.QueryResult{Ok: statusResult}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	wasmClientKeeper := suite.newPooledKeeper(1)
+	queryMsg := types.QueryMsg{Status: &types.StatusMsg{}}
+	queryCtx := suite.chainA.GetContext().WithIsCheckTx(true)
+
+	// the slot is released once the contract call returns
+	for i := 0; i < 2; i++ {
+		_, err = wasmClientKeeper.WasmQuery(queryCtx, endpoint.ClientID, clientStore, clientState, queryMsg)
+		suite.Require().NoError(err)
+	}
+
+	// a query waiting on an exhausted pool fails once its context is done
+	_, release, err := wasmClientKeeper.AcquireVMInstance(queryCtx)
+	suite.Require().NoError(err)
+	defer release()
+
+	cancelledCtx, cancel := context.WithCancel(queryCtx.Context())
+	cancel()
+	_, err = wasmClientKeeper.WasmQuery(queryCtx.WithContext(cancelledCtx), endpoint.ClientID, clientStore, clientState, queryMsg)
+	suite.Require().ErrorIs(err, types.ErrVMError)
+
+	// a contract call executed in consensus does not wait on the exhausted pool
+	_, err = wasmClientKeeper.WasmQuery(suite.chainA.GetContext().WithContext(cancelledCtx), endpoint.ClientID, clientStore, clientState, queryMsg)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// contract entry points recorded in traced contract calls
+const (
+	entryPointInstantiate = "instantiate"
+	entryPointSudo        = "sudo"
+	entryPointQuery       = "query"
+	entryPointMigrate     = "migrate"
+)
+
+// contractTracer records the calls of light client contracts for debugging purposes. Every call is
+// appended as a JSON line to the trace file and the most recent calls of each client are kept in memory.
+// The traced calls are local to the node and are not part of consensus.
+type contractTracer struct {
+	mtx   sync.Mutex
+	file  *os.File
+	limit int
+	calls map[string][]types.ContractCall
+}
+
+// traceLine is the JSON representation of a contract call written to the trace file.
+type traceLine struct {
+	ClientID   string `json:"client_id"`
+	EntryPoint string `json:"entry_point"`
+	Checksum   string `json:"checksum"`
+	Height     int64  `json:"height"`
+	Payload    string `json:"payload"`
+	Response   string `json:"response"`
+	Error      string `json:"error,omitempty"`
+}
+
+// newContractTracer returns a contractTracer appending contract calls to the given trace file
+// and keeping up to limit calls in memory per client.
+func newContractTracer(traceFile string, limit uint32) (*contractTracer, error) {
+	if traceFile == "" {
+		return nil, errors.New("trace file cannot be empty")
+	}
+
+	if limit == 0 {
+		return nil, errors.New("trace limit must be greater than zero")
+	}
+
+	file, err := os.OpenFile(traceFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractTracer{
+		file:  file,
+		limit: int(limit),
+		calls: make(map[string][]types.ContractCall),
+	}, nil
+}
+
+// record appends the contract call to the trace file and keeps it in memory, evicting the oldest call
+// of the client if the limit is reached. Failures to write the trace file are logged and otherwise ignored.
+func (t *contractTracer) record(ctx sdk.Context, call types.ContractCall) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	calls := append(t.calls[call.ClientId], call)
+	if len(calls) > t.limit {
+		calls = calls[len(calls)-t.limit:]
+	}
+	t.calls[call.ClientId] = calls
+
+	bz, err := json.Marshal(traceLine{
+		ClientID:   call.ClientId,
+		EntryPoint: call.EntryPoint,
+		Checksum:   call.Checksum,
+		Height:     call.Height,
+		Payload:    string(call.Payload),
+		Response:   string(call.Response),
+		Error:      call.Error,
+	})
+	if err == nil {
+		_, err = t.file.Write(append(bz, '\n'))
+	}
+	if err != nil {
+		moduleLogger(ctx).Error("failed to write contract call trace", "client-id", call.ClientId, "error", err)
+	}
+}
+
+// getCalls returns a copy of the contract calls kept in memory for the given client, ordered from oldest to newest.
+func (t *contractTracer) getCalls(clientID string) []types.ContractCall {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return append([]types.ContractCall(nil), t.calls[clientID]...)
+}
+
+// traceContractResult records a call of a contract entry point returning a ContractResult if contract call tracing is enabled.
+func (k Keeper) traceContractResult(ctx sdk.Context, clientID, entryPoint string, checksum types.Checksum, msg []byte, res *wasmvmtypes.ContractResult, err error) {
+	if k.tracer == nil {
+		return
+	}
+
+	var (
+		data   []byte
+		errMsg string
+	)
+	switch {
+	case err != nil:
+		errMsg = err.Error()
+	case res == nil:
+	case res.Err != "":
+		errMsg = res.Err
+	case res.Ok != nil:
+		data = res.Ok.Data
+	}
+
+	k.tracer.record(ctx, newContractCall(ctx, clientID, entryPoint, checksum, msg, data, errMsg))
+}
+
+// traceQueryResult records a call of the query entry point of a contract if contract call tracing is enabled.
+func (k Keeper) traceQueryResult(ctx sdk.Context, clientID string, checksum types.Checksum, msg []byte, res *wasmvmtypes.QueryResult, err error) {
+	if k.tracer == nil {
+		return
+	}
+
+	var (
+		data   []byte
+		errMsg string
+	)
+	switch {
+	case err != nil:
+		errMsg = err.Error()
+	case res == nil:
+	case res.Err != "":
+		errMsg = res.Err
+	default:
+		data = res.Ok
+	}
+
+	k.tracer.record(ctx, newContractCall(ctx, clientID, entryPointQuery, checksum, msg, data, errMsg))
+}
+
+func newContractCall(ctx sdk.Context, clientID, entryPoint string, checksum types.Checksum, msg, data []byte, errMsg string) types.ContractCall {
+	return types.ContractCall{
+		ClientId:   clientID,
+		EntryPoint: entryPoint,
+		Checksum:   hex.EncodeToString(checksum),
+		Height:     ctx.BlockHeight(),
+		Payload:    msg,
+		Response:   data,
+		Error:      errMsg,
+	}
+}
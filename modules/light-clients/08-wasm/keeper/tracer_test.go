@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// newTracingKeeper returns a keeper sharing the state and vm of the chainA wasm client keeper with contract call tracing enabled.
+func (suite *KeeperTestSuite) newTracingKeeper(traceFile string, limit uint32) keeper.Keeper {
+	return keeper.NewKeeperWithVM(
+		GetSimApp(suite.chainA).AppCodec(),
+		runtime.NewKVStoreService(GetSimApp(suite.chainA).GetKey(types.StoreKey)),
+		GetSimApp(suite.chainA).IBCKeeper.ClientKeeper,
+		GetSimApp(suite.chainA).WasmClientKeeper.GetAuthority(),
+		GetSimApp(suite.chainA).WasmClientKeeper.GetVM(),
+		GetSimApp(suite.chainA).GRPCQueryRouter(),
+		keeper.WithContractTracing(traceFile, limit),
+	)
+}
+
+func (suite *KeeperTestSuite) TestContractCallTracing() {
+	suite.SetupWasmWithMockVM()
+	_ = suite.storeWasmCode(wasmtesting.Code)
+
+	endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+	err := endpoint.CreateClient()
+	suite.Require().NoError(err)
+
+	clientState, ok := endpoint.GetClientState().(*types.ClientState)
+	suite.Require().True(ok)
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpoint.ClientID)
+
+	traceFile := filepath.Join(suite.T().TempDir(), "trace.jsonl")
+	wasmClientKeeper := suite.newTracingKeeper(traceFile, 2)
+
+	statusResult, err := json.Marshal(types.StatusResult{Status: exported.Active.String()})
+	suite.Require().NoError(err)
+
+	suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		return &wasmvmtypes.QueryResult{Ok: statusResult}, wasmtesting.DefaultGasUsed, nil
+	})
+	suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	queryMsg := types.QueryMsg{Status: &types.StatusMsg{}}
+	sudoMsg := types.SudoMsg{UpdateState: &types.UpdateStateMsg{ClientMessage: []byte("message")}}
+
+	_, err = wasmClientKeeper.WasmQuery(suite.chainA.GetContext(), endpoint.ClientID, clientStore, clientState, queryMsg)
+	suite.Require().NoError(err)
+	_, err = wasmClientKeeper.WasmQuery(suite.chainA.GetContext(), endpoint.ClientID, clientStore, clientState, queryMsg)
+	suite.Require().NoError(err)
+	_, err = wasmClientKeeper.WasmSudo(suite.chainA.GetContext(), endpoint.ClientID, clientStore, clientState, sudoMsg)
+	suite.Require().ErrorIs(err, types.ErrWasmContractCallFailed)
+
+	res, err := wasmClientKeeper.ContractCalls(suite.chainA.GetContext(), &types.QueryContractCallsRequest{ClientId: endpoint.ClientID})
+	suite.Require().NoError(err)
+
+	// only the two most recent calls are kept in memory
+	queryPayload, err := json.Marshal(queryMsg)
+	suite.Require().NoError(err)
+	sudoPayload, err := json.Marshal(sudoMsg)
+	suite.Require().NoError(err)
+
+	expCalls := []types.ContractCall{
+		{
+			ClientId:   endpoint.ClientID,
+			EntryPoint: "query",
+			Checksum:   hex.EncodeToString(clientState.Checksum),
+			Height:     suite.chainA.GetContext().BlockHeight(),
+			Payload:    queryPayload,
+			Response:   statusResult,
+		},
+		{
+			ClientId:   endpoint.ClientID,
+			EntryPoint: "sudo",
+			Checksum:   hex.EncodeToString(clientState.Checksum),
+			Height:     suite.chainA.GetContext().BlockHeight(),
+			Payload:    sudoPayload,
+			Error:      wasmtesting.ErrMockContract.Error(),
+		},
+	}
+	suite.Require().Equal(expCalls, res.Calls)
+
+	// all calls are appended to the trace file
+	file, err := os.Open(traceFile)
+	suite.Require().NoError(err)
+	defer file.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		suite.Require().NoError(json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	suite.Require().NoError(scanner.Err())
+
+	suite.Require().Len(lines, 3)
+	suite.Require().Equal("query", lines[0]["entry_point"])
+	suite.Require().Equal(string(queryPayload), lines[0]["payload"])
+	suite.Require().Equal("sudo", lines[2]["entry_point"])
+	suite.Require().Equal(wasmtesting.ErrMockContract.Error(), lines[2]["error"])
+}
+
+func (suite *KeeperTestSuite) TestWithContractTracingInvalidConfig() {
+	suite.SetupWasmWithMockVM()
+
+	suite.Require().Panics(func() {
+		suite.newTracingKeeper("", 10)
+	})
+
+	suite.Require().Panics(func() {
+		suite.newTracingKeeper(filepath.Join(suite.T().TempDir(), "trace.jsonl"), 0)
+	})
+}
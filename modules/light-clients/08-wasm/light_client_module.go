@@ -268,6 +268,49 @@ func (l LightClientModule) VerifyMembership(
 	return err
 }
 
+// VerifyMembershipBatch obtains the client state associated with the client identifier and verifies the provided membership proofs.
+// Contracts implementing at least the ContractAPIVersionBatchVerification API version verify all proofs in a single call to the
+// verify_membership_batch contract endpoint, the proofs are verified one at a time through the verify_membership endpoint otherwise.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 08-wasm-{n}.
+func (l LightClientModule) VerifyMembershipBatch(ctx sdk.Context, clientID string, proofs []types.VerifyMembershipMsg) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := types.GetClientState(clientStore, cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	for _, proof := range proofs {
+		if clientState.LatestHeight.LT(proof.Height) {
+			return errorsmod.Wrapf(
+				ibcerrors.ErrInvalidHeight,
+				"client state height < proof height (%d < %d), please ensure the client has been updated", clientState.LatestHeight, proof.Height,
+			)
+		}
+	}
+
+	if types.GetContractAPIVersion(clientStore) >= types.ContractAPIVersionBatchVerification {
+		payload := types.SudoMsg{
+			VerifyMembershipBatch: &types.VerifyMembershipBatchMsg{Proofs: proofs},
+		}
+		_, err := l.keeper.WasmSudo(ctx, clientID, clientStore, clientState, payload)
+		return err
+	}
+
+	for i := range proofs {
+		payload := types.SudoMsg{
+			VerifyMembership: &proofs[i],
+		}
+		if _, err := l.keeper.WasmSudo(ctx, clientID, clientStore, clientState, payload); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify membership proof at index %d", i)
+		}
+	}
+
+	return nil
+}
+
 // VerifyNonMembership obtains the client state associated with the client identifier and calls into the appropriate contract endpoint.
 // VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
@@ -11,6 +11,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	wasm "github.com/cosmos/ibc-go/modules/light-clients/08-wasm"
 	internaltypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/types"
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
@@ -508,6 +509,137 @@ func (suite *WasmTestSuite) TestVerifyMembership() {
 	}
 }
 
+func (suite *WasmTestSuite) TestVerifyMembershipBatch() {
+	var (
+		apiVersion         uint64
+		proofs             []types.VerifyMembershipMsg
+		clientID           string
+		batchCalls, calls  int
+		expBatchCalls      int
+		expCalls           int
+		verifyMembershipFn func(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64, wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error)
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: contract implements batch verification",
+			func() {
+				apiVersion = types.ContractAPIVersionBatchVerification
+				expBatchCalls = 1
+			},
+			nil,
+		},
+		{
+			"success: legacy contract verifies proofs one at a time",
+			func() {
+				expCalls = len(proofs)
+			},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedWasmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: proof height greater than client state latest height",
+			func() {
+				proofs[1].Height = clienttypes.NewHeight(1, 100)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: legacy contract returns invalid proof error",
+			func() {
+				verifyMembershipFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore,
+					_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+				) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: commitmenttypes.ErrInvalidProof.Error()}, wasmtesting.DefaultGasUsed, nil
+				}
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+
+			proofs = []types.VerifyMembershipMsg{
+				{Height: clienttypes.NewHeight(0, 1), Proof: wasmtesting.MockValidProofBz, Path: commitmenttypes.NewMerklePath("/ibc/key/path/0"), Value: []byte("value-0")},
+				{Height: clienttypes.NewHeight(0, 1), Proof: wasmtesting.MockValidProofBz, Path: commitmenttypes.NewMerklePath("/ibc/key/path/1"), Value: []byte("value-1")},
+			}
+			apiVersion = types.ContractAPIVersionLegacy
+			batchCalls, calls, expBatchCalls, expCalls = 0, 0, 0, 0
+
+			emptyResult := func() (*wasmvmtypes.ContractResult, uint64, error) {
+				bz, err := json.Marshal(types.EmptyResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: bz}}, wasmtesting.DefaultGasUsed, nil
+			}
+			verifyMembershipFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore,
+				_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+			) (*wasmvmtypes.ContractResult, uint64, error) {
+				var payload types.SudoMsg
+				err := json.Unmarshal(sudoMsg, &payload)
+				suite.Require().NoError(err)
+				suite.Require().Equal(proofs[calls], *payload.VerifyMembership)
+
+				calls++
+				return emptyResult()
+			}
+
+			suite.mockVM.RegisterSudoCallback(types.VerifyMembershipBatchMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore,
+				_ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction,
+			) (*wasmvmtypes.ContractResult, uint64, error) {
+				var payload types.SudoMsg
+				err := json.Unmarshal(sudoMsg, &payload)
+				suite.Require().NoError(err)
+				suite.Require().Equal(proofs, payload.VerifyMembershipBatch.Proofs)
+
+				batchCalls++
+				return emptyResult()
+			})
+
+			tc.malleate()
+
+			suite.mockVM.RegisterSudoCallback(types.VerifyMembershipMsg{}, verifyMembershipFn)
+
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpoint.ClientID)
+			types.SetContractAPIVersion(clientStore, apiVersion)
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(endpoint.ClientID)
+			suite.Require().True(found)
+
+			wasmLightClientModule, ok := lightClientModule.(*wasm.LightClientModule)
+			suite.Require().True(ok)
+
+			err = wasmLightClientModule.VerifyMembershipBatch(suite.chainA.GetContext(), clientID, proofs)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expBatchCalls, batchCalls)
+				suite.Require().Equal(expCalls, calls)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *WasmTestSuite) TestVerifyNonMembership() {
 	var (
 		clientState      *types.ClientState
@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var _ ibctesting.WasmHeaderSource = (*TendermintHeaderSource)(nil)
+
+// TendermintHeaderSource is a WasmHeaderSource which wraps the tendermint client states,
+// consensus states and headers of a counterparty TestChain in the 08-wasm types. It can be
+// used with contracts implementing a tendermint light client or with a mock vm.
+type TendermintHeaderSource struct {
+	Checksum types.Checksum
+	Config   *ibctesting.TendermintConfig
+}
+
+// NewTendermintHeaderSource returns a TendermintHeaderSource for the contract with the given
+// checksum using the default tendermint client configuration.
+func NewTendermintHeaderSource(checksum types.Checksum) *TendermintHeaderSource {
+	return &TendermintHeaderSource{
+		Checksum: checksum,
+		Config:   ibctesting.NewTendermintConfig(),
+	}
+}
+
+// NewWasmConfig returns an ibctesting.WasmConfig using a TendermintHeaderSource for the
+// contract with the given checksum.
+func NewWasmConfig(checksum types.Checksum) *ibctesting.WasmConfig {
+	return ibctesting.NewWasmConfig(NewTendermintHeaderSource(checksum))
+}
+
+// ClientAndConsensusState implements ibctesting.WasmHeaderSource.
+func (s *TendermintHeaderSource) ClientAndConsensusState(counterparty *ibctesting.TestChain) (exported.ClientState, exported.ConsensusState, error) {
+	height, ok := counterparty.LatestCommittedHeader.GetHeight().(clienttypes.Height)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected height type %T, got %T", clienttypes.Height{}, counterparty.LatestCommittedHeader.GetHeight())
+	}
+
+	tmClientState := ibctm.NewClientState(
+		counterparty.ChainID, s.Config.TrustLevel, s.Config.TrustingPeriod, s.Config.UnbondingPeriod, s.Config.MaxClockDrift,
+		height, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+	)
+
+	cdc := counterparty.App.AppCodec()
+	clientStateBz, err := clienttypes.MarshalClientState(cdc, tmClientState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	consensusStateBz, err := clienttypes.MarshalConsensusState(cdc, counterparty.LatestCommittedHeader.ConsensusState())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return types.NewClientState(clientStateBz, s.Checksum, height), types.NewConsensusState(consensusStateBz), nil
+}
+
+// ClientMessage implements ibctesting.WasmHeaderSource.
+func (*TendermintHeaderSource) ClientMessage(counterparty *ibctesting.TestChain, trustedHeight exported.Height) (exported.ClientMessage, error) {
+	height, ok := trustedHeight.(clienttypes.Height)
+	if !ok {
+		return nil, fmt.Errorf("expected height type %T, got %T", clienttypes.Height{}, trustedHeight)
+	}
+
+	header, err := counterparty.IBCClientHeader(counterparty.LatestCommittedHeader, height)
+	if err != nil {
+		return nil, err
+	}
+
+	headerBz, err := clienttypes.MarshalClientMessage(counterparty.App.AppCodec(), header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ClientMessage{Data: headerBz}, nil
+}
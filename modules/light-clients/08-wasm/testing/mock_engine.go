@@ -23,7 +23,7 @@ var (
 	queryTypes = [...]any{types.StatusMsg{}, types.TimestampAtHeightMsg{}, types.VerifyClientMessageMsg{}, types.CheckForMisbehaviourMsg{}}
 
 	// sudoTypes contains all the possible sudo message types.
-	sudoTypes = [...]any{types.UpdateStateMsg{}, types.UpdateStateOnMisbehaviourMsg{}, types.VerifyUpgradeAndUpdateStateMsg{}, types.VerifyMembershipMsg{}, types.VerifyNonMembershipMsg{}, types.MigrateClientStoreMsg{}}
+	sudoTypes = [...]any{types.UpdateStateMsg{}, types.UpdateStateOnMisbehaviourMsg{}, types.VerifyUpgradeAndUpdateStateMsg{}, types.VerifyMembershipMsg{}, types.VerifyNonMembershipMsg{}, types.MigrateClientStoreMsg{}, types.VerifyMembershipBatchMsg{}}
 )
 
 type (
@@ -277,6 +277,10 @@ func getSudoMsgPayloadTypeName(sudoMsgBz []byte) string {
 		payloadField = *payload.MigrateClientStore
 	}
 
+	if payload.VerifyMembershipBatch != nil {
+		payloadField = *payload.VerifyMembershipBatch
+	}
+
 	if payloadField == nil {
 		panic(fmt.Errorf("failed to extract valid sudo message from bytes: %s", string(sudoMsgBz)))
 	}
@@ -28,6 +28,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgStoreCode{},
 		&MsgMigrateContract{},
 		&MsgRemoveChecksum{},
+		&MsgSetMessageAllowlist{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
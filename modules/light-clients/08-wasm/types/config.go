@@ -16,11 +16,13 @@ const (
 	defaultDataDir               string = "ibc_08-wasm_client_data"
 	defaultSupportedCapabilities string = "iterator"
 	defaultContractDebugMode            = false
+	defaultContractTraceLimit    uint32 = 100
 )
 
 // WasmConfig defines configuration parameters for the 08-wasm wasm virtual machine instance.
 // It includes the `dataDir` intended to be used for wasm blobs and internal caches, as well as a comma separated list
-// of features or capabilities the user wishes to enable. A boolean flag is provided to enable debug mode.
+// of features or capabilities the user wishes to enable. A boolean flag is provided to enable debug mode, and
+// a trace file can be provided to enable contract call tracing.
 type WasmConfig struct {
 	// DataDir is the directory for Wasm blobs and various caches
 	DataDir string
@@ -31,6 +33,19 @@ type WasmConfig struct {
 	// ContractDebugMode is a flag to log what contracts print. It must be false on all
 	// production nodes, and only enabled in test environments or debug non-validating nodes.
 	ContractDebugMode bool
+	// ContractTraceFile is the path of the file to which every contract call is appended.
+	// Contract call tracing is disabled if empty. It must be empty on all production nodes,
+	// and only set in test environments or debug non-validating nodes.
+	ContractTraceFile string
+	// ContractTraceLimit is the number of most recent contract calls kept in memory per client
+	// when contract call tracing is enabled. The kept calls are returned by the ContractCalls query.
+	ContractTraceLimit uint32
+	// MaxConcurrentContractCalls is the maximum number of wasm VM instances executing light client
+	// contracts concurrently for queries and CheckTx. Contract calls exceeding the limit wait until a
+	// running call returns, which bounds the memory used by nodes serving many simultaneous queries.
+	// Contract calls executed in consensus are not limited. The number of concurrent contract calls
+	// is not limited if zero.
+	MaxConcurrentContractCalls uint32
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig.
@@ -41,5 +56,6 @@ func DefaultWasmConfig(homePath string) WasmConfig {
 		DataDir:               filepath.Join(homePath, defaultDataDir),
 		SupportedCapabilities: strings.Split(defaultSupportedCapabilities, ","),
 		ContractDebugMode:     defaultContractDebugMode,
+		ContractTraceLimit:    defaultContractTraceLimit,
 	}
 }
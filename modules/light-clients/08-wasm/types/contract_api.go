@@ -5,11 +5,25 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 )
 
+const (
+	// ContractAPIVersionLegacy is the API version of contracts which do not report an API version.
+	ContractAPIVersionLegacy uint64 = 0
+	// ContractAPIVersionBatchVerification is the API version from which contracts accept the
+	// verify_membership_batch sudo message.
+	ContractAPIVersionBatchVerification uint64 = 1
+
+	// MaxContractAPIVersion is the highest contract API version supported by the 08-wasm module.
+	MaxContractAPIVersion = ContractAPIVersionBatchVerification
+)
+
 // InstantiateMessage is the message that is sent to the contract's instantiate entry point.
+// MaxAPIVersion is the highest contract API version supported by the 08-wasm module, the contract
+// reports the API version it implements in the data of its response.
 type InstantiateMessage struct {
 	ClientState    []byte `json:"client_state"`
 	ConsensusState []byte `json:"consensus_state"`
 	Checksum       []byte `json:"checksum"`
+	MaxAPIVersion  uint64 `json:"max_api_version"`
 }
 
 // QueryMsg is used to encode messages that are sent to the contract's query entry point.
@@ -23,6 +37,22 @@ type QueryMsg struct {
 	CheckForMisbehaviour *CheckForMisbehaviourMsg `json:"check_for_misbehaviour,omitempty"`
 }
 
+// Name returns the name of the query message set in the QueryMsg, as encoded in the JSON payload.
+func (m QueryMsg) Name() string {
+	switch {
+	case m.Status != nil:
+		return QueryMsgStatus
+	case m.TimestampAtHeight != nil:
+		return QueryMsgTimestampAtHeight
+	case m.VerifyClientMessage != nil:
+		return QueryMsgVerifyClientMessage
+	case m.CheckForMisbehaviour != nil:
+		return QueryMsgCheckForMisbehaviour
+	default:
+		return ""
+	}
+}
+
 // StatusMsg is a queryMsg sent to the contract to query the status of the wasm client.
 type StatusMsg struct{}
 
@@ -52,6 +82,38 @@ type SudoMsg struct {
 	VerifyMembership            *VerifyMembershipMsg            `json:"verify_membership,omitempty"`
 	VerifyNonMembership         *VerifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
 	MigrateClientStore          *MigrateClientStoreMsg          `json:"migrate_client_store,omitempty"`
+	VerifyMembershipBatch       *VerifyMembershipBatchMsg       `json:"verify_membership_batch,omitempty"`
+}
+
+// RequiredAPIVersion returns the minimum contract API version required to process the sudo message.
+func (m SudoMsg) RequiredAPIVersion() uint64 {
+	if m.VerifyMembershipBatch != nil {
+		return ContractAPIVersionBatchVerification
+	}
+
+	return ContractAPIVersionLegacy
+}
+
+// Name returns the name of the sudo message set in the SudoMsg, as encoded in the JSON payload.
+func (m SudoMsg) Name() string {
+	switch {
+	case m.UpdateState != nil:
+		return SudoMsgUpdateState
+	case m.UpdateStateOnMisbehaviour != nil:
+		return SudoMsgUpdateStateOnMisbehaviour
+	case m.VerifyUpgradeAndUpdateState != nil:
+		return SudoMsgVerifyUpgradeAndUpdateState
+	case m.VerifyMembership != nil:
+		return SudoMsgVerifyMembership
+	case m.VerifyNonMembership != nil:
+		return SudoMsgVerifyNonMembership
+	case m.MigrateClientStore != nil:
+		return SudoMsgMigrateClientStore
+	case m.VerifyMembershipBatch != nil:
+		return SudoMsgVerifyMembershipBatch
+	default:
+		return ""
+	}
 }
 
 // UpdateStateMsg is a sudoMsg sent to the contract to update the client state.
@@ -83,6 +145,12 @@ type VerifyNonMembershipMsg struct {
 	Path             commitmenttypes.MerklePath `json:"path"`
 }
 
+// VerifyMembershipBatchMsg is a sudoMsg sent to the contract to verify multiple membership proofs in a single call.
+// It is only sent to contracts implementing at least ContractAPIVersionBatchVerification.
+type VerifyMembershipBatchMsg struct {
+	Proofs []VerifyMembershipMsg `json:"proofs"`
+}
+
 // VerifyUpgradeAndUpdateStateMsg is a sudoMsg sent to the contract to verify an upgrade and update its state.
 type VerifyUpgradeAndUpdateStateMsg struct {
 	UpgradeClientState         []byte `json:"upgrade_client_state"`
@@ -119,6 +187,12 @@ type CheckForMisbehaviourResult struct {
 	FoundMisbehaviour bool `json:"found_misbehaviour"`
 }
 
+// APIVersionResult is the expected data of the responses of the instantiate and migrate entry points. It returns
+// the API version implemented by the contract, contracts which return no data implement ContractAPIVersionLegacy.
+type APIVersionResult struct {
+	APIVersion uint64 `json:"api_version"`
+}
+
 // UpdateStateResult is the expected return type of the updateStateMsg sudo call. It returns the updated consensus heights.
 type UpdateStateResult struct {
 	Heights []clienttypes.Height `json:"heights"`
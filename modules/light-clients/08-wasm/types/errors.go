@@ -20,4 +20,8 @@ var (
 	ErrWasmInvalidResponseData         = errorsmod.Register(ModuleName, 15, "wasm contract returned invalid response data")
 	ErrWasmInvalidContractModification = errorsmod.Register(ModuleName, 16, "wasm contract made invalid state modifications")
 	ErrVMError                         = errorsmod.Register(ModuleName, 17, "wasm VM error")
+	ErrWasmChecksumInUse               = errorsmod.Register(ModuleName, 18, "wasm checksum is used by clients")
+	ErrContractAPIVersionNotSupported  = errorsmod.Register(ModuleName, 19, "contract API version not supported")
+	ErrInvalidMessageAllowlist         = errorsmod.Register(ModuleName, 20, "invalid message allowlist")
+	ErrMsgNotAllowed                   = errorsmod.Register(ModuleName, 21, "message not allowed by the message allowlist of the checksum")
 )
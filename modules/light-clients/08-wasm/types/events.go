@@ -6,6 +6,8 @@ const (
 	EventTypeStoreWasmCode = "store_wasm_code"
 	// EventTypeMigrateContract defines the event type for a contract migration
 	EventTypeMigrateContract = "migrate_contract"
+	// EventTypeSetMessageAllowlist defines the event type for setting the message allowlist of a checksum
+	EventTypeSetMessageAllowlist = "set_message_allowlist"
 
 	// AttributeKeyWasmChecksum denotes the checksum of the wasm code that was stored or migrated
 	AttributeKeyWasmChecksum = "wasm_checksum"
@@ -13,6 +15,12 @@ const (
 	AttributeKeyClientID = "client_id"
 	// AttributeKeyNewChecksum denotes the checksum of the new wasm code.
 	AttributeKeyNewChecksum = "new_checksum"
+	// AttributeKeyQueryMsgs denotes the comma separated query messages allowed by a message allowlist
+	AttributeKeyQueryMsgs = "query_msgs"
+	// AttributeKeySudoMsgs denotes the comma separated sudo messages allowed by a message allowlist
+	AttributeKeySudoMsgs = "sudo_msgs"
+	// AttributeKeyAllowlistRemoved denotes whether the message allowlist of a checksum was removed
+	AttributeKeyAllowlistRemoved = "allowlist_removed"
 
 	AttributeValueCategory = ModuleName
 )
@@ -6,6 +6,7 @@ import (
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,6 +46,15 @@ func (g WasmGasRegister) ConsumeRuntimeGas(ctx sdk.Context, gas uint64) {
 	}
 }
 
+// CompileCosts returns the costs to persist and compile a new wasm contract of the given uncompressed length.
+// The wasm VM charges the equivalent amount of wasm VM gas when storing code with the default configuration.
+func (g WasmGasRegister) CompileCosts(byteLength int) storetypes.Gas {
+	if byteLength < 0 {
+		panic(errorsmod.Wrap(ErrInvalid, "negative length"))
+	}
+	return g.c.CompileCost * uint64(byteLength)
+}
+
 // MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by out defined multiplier
 type MultipliedGasMeter struct {
 	originalMeter storetypes.GasMeter
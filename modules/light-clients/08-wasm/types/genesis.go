@@ -16,6 +16,12 @@ func (gs GenesisState) Validate() error {
 		if err := ValidateWasmCode(contract.CodeBytes); err != nil {
 			return errorsmod.Wrap(err, "wasm bytecode validation failed")
 		}
+
+		if contract.MessageAllowlist != nil {
+			if err := contract.MessageAllowlist.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
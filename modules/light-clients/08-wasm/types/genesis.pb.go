@@ -73,6 +73,8 @@ func (m *GenesisState) GetContracts() []Contract {
 type Contract struct {
 	// contract byte code
 	CodeBytes []byte `protobuf:"bytes,1,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	// optional allowlist of the messages forwarded to the contract
+	MessageAllowlist *MessageAllowlist `protobuf:"bytes,2,opt,name=message_allowlist,json=messageAllowlist,proto3" json:"message_allowlist,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -118,24 +120,27 @@ func init() {
 }
 
 var fileDescriptor_05e250654f164e20 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4c, 0x4a, 0xd6,
-	0xcf, 0xc9, 0x4c, 0xcf, 0x28, 0x49, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x4f, 0x2c,
-	0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0xc8, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xa7, 0x07, 0x52, 0xa7, 0x57, 0x66,
-	0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x85, 0x71,
-	0xf1, 0xb8, 0x43, 0x0c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x72, 0xe3, 0xe2, 0x4c, 0xce, 0xcf,
-	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd2, 0xc3,
-	0x65, 0xa6, 0x9e, 0x33, 0x54, 0xa9, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x08, 0xad, 0x4a,
-	0xfa, 0x5c, 0x1c, 0x30, 0x49, 0x21, 0x59, 0x2e, 0xae, 0xe4, 0xfc, 0x94, 0xd4, 0xf8, 0xa4, 0xca,
-	0x92, 0x54, 0x90, 0xa1, 0x8c, 0x1a, 0x3c, 0x20, 0xa5, 0x29, 0xa9, 0x4e, 0x20, 0x01, 0x2b, 0x96,
-	0x8e, 0x05, 0xf2, 0x0c, 0x4e, 0x61, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93,
-	0x5a, 0x0c, 0x09, 0x17, 0x5d, 0x58, 0xc0, 0x18, 0x58, 0xe8, 0x82, 0xc3, 0xa6, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x4f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0xb3, 0x17,
-	0x15, 0x41, 0x01, 0x00, 0x00,
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x41, 0x4b, 0x3a, 0x41,
+	0x18, 0xc6, 0x77, 0xfe, 0x7f, 0x89, 0x1a, 0x3d, 0xd4, 0xd2, 0x41, 0x84, 0x56, 0x31, 0x08, 0x09,
+	0x9c, 0x49, 0xbb, 0x44, 0x74, 0xc9, 0xa0, 0x4e, 0x5d, 0x0c, 0x0c, 0xba, 0xc8, 0xce, 0x38, 0x8c,
+	0x03, 0x33, 0xbe, 0xe2, 0x3b, 0x2a, 0x7e, 0x03, 0x8f, 0x7d, 0x84, 0x3e, 0x8e, 0x47, 0x8f, 0x9d,
+	0x22, 0xf4, 0x8b, 0xc4, 0xee, 0x26, 0x45, 0xb0, 0xb7, 0xe1, 0x9d, 0xdf, 0xf3, 0x3c, 0xf0, 0xa3,
+	0x67, 0x46, 0x48, 0x6e, 0x8d, 0x1e, 0x7a, 0x69, 0x8d, 0x1a, 0x79, 0xe4, 0xf3, 0x18, 0x1d, 0x9f,
+	0xb5, 0xb8, 0x56, 0x23, 0x85, 0x06, 0xd9, 0x78, 0x02, 0x1e, 0xc2, 0xb2, 0x11, 0x92, 0xfd, 0xe6,
+	0x58, 0xc2, 0xb1, 0x59, 0xab, 0x72, 0xac, 0x41, 0x43, 0x0a, 0xf1, 0xe4, 0x95, 0xf1, 0x95, 0xd3,
+	0xdc, 0xde, 0x34, 0x97, 0x42, 0xf5, 0x1e, 0x2d, 0x3d, 0x64, 0x2b, 0x4f, 0x3e, 0xf6, 0x2a, 0xbc,
+	0xa7, 0x07, 0x12, 0x46, 0x7e, 0x12, 0x4b, 0x8f, 0x65, 0x52, 0xfb, 0xdf, 0x28, 0xb6, 0xeb, 0x2c,
+	0x6f, 0x98, 0xdd, 0x7d, 0xa3, 0x9d, 0xc2, 0xea, 0xa3, 0x1a, 0x74, 0x7f, 0xa2, 0xf5, 0x25, 0xa1,
+	0xfb, 0xbb, 0xdf, 0xf0, 0x84, 0x52, 0x09, 0x03, 0xd5, 0x17, 0x0b, 0xaf, 0x92, 0x56, 0xd2, 0x28,
+	0x25, 0xec, 0x40, 0x75, 0x92, 0x43, 0xf8, 0x4c, 0x8f, 0x9c, 0x42, 0x8c, 0xb5, 0xea, 0xc7, 0xd6,
+	0xc2, 0xdc, 0x1a, 0xf4, 0xe5, 0x7f, 0x35, 0xd2, 0x28, 0xb6, 0xcf, 0xf3, 0xb7, 0x1f, 0xb3, 0xc8,
+	0xed, 0x2e, 0xd1, 0x3d, 0x74, 0x7f, 0x2e, 0xd7, 0x85, 0xe5, 0x5b, 0x35, 0xe8, 0xf4, 0x56, 0x9b,
+	0x88, 0xac, 0x37, 0x11, 0xf9, 0xdc, 0x44, 0xe4, 0x75, 0x1b, 0x05, 0xeb, 0x6d, 0x14, 0xbc, 0x6f,
+	0xa3, 0xe0, 0xe5, 0x46, 0x1b, 0x3f, 0x9c, 0x0a, 0x26, 0xc1, 0x71, 0x09, 0xe8, 0x00, 0xb9, 0x11,
+	0xb2, 0xa9, 0x81, 0x3b, 0x18, 0x4c, 0xad, 0xc2, 0x4c, 0x5f, 0x73, 0xe7, 0xef, 0xe2, 0xaa, 0x99,
+	0x2a, 0xf4, 0x8b, 0xb1, 0x42, 0xb1, 0x97, 0x1a, 0xbc, 0xfc, 0x1a, 0x00, 0x4a, 0x6c, 0xd5, 0xe5,
+	0xc0, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +200,18 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MessageAllowlist != nil {
+		{
+			size, err := m.MessageAllowlist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.CodeBytes) > 0 {
 		i -= len(m.CodeBytes)
 		copy(dAtA[i:], m.CodeBytes)
@@ -241,6 +258,10 @@ func (m *Contract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.MessageAllowlist != nil {
+		l = m.MessageAllowlist.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -397,6 +418,42 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				m.CodeBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageAllowlist == nil {
+				m.MessageAllowlist = &MessageAllowlist{}
+			}
+			if err := m.MessageAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -24,6 +24,20 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis with message allowlist",
+			&types.GenesisState{
+				Contracts: []types.Contract{{CodeBytes: []byte{1}, MessageAllowlist: &types.MessageAllowlist{QueryMsgs: []string{types.QueryMsgStatus}}}},
+			},
+			true,
+		},
+		{
+			"invalid genesis with invalid message allowlist",
+			&types.GenesisState{
+				Contracts: []types.Contract{{CodeBytes: []byte{1}, MessageAllowlist: &types.MessageAllowlist{SudoMsgs: []string{"unknown"}}}},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
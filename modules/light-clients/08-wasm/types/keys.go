@@ -15,7 +15,17 @@ const (
 	// KeyChecksums is the key under which all checksums are stored
 	// Deprecated: in favor of collections.KeySet
 	KeyChecksums = "checksums"
+
+	// KeyContractAPIVersion is the key in the client store under which the API version implemented by the
+	// client's contract is stored
+	KeyContractAPIVersion = "contractAPIVersion"
 )
 
 // ChecksumsKey is the key under which all checksums are stored
 var ChecksumsKey = collections.NewPrefix(0)
+
+// ChecksumClientsKey is the key under which the index of clients using a checksum is stored
+var ChecksumClientsKey = collections.NewPrefix(1)
+
+// MessageAllowlistsKey is the key under which the message allowlists of checksums are stored
+var MessageAllowlistsKey = collections.NewPrefix(2)
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// QueryMsgStatus is the name of the StatusMsg query message
+	QueryMsgStatus = "status"
+	// QueryMsgTimestampAtHeight is the name of the TimestampAtHeightMsg query message
+	QueryMsgTimestampAtHeight = "timestamp_at_height"
+	// QueryMsgVerifyClientMessage is the name of the VerifyClientMessageMsg query message
+	QueryMsgVerifyClientMessage = "verify_client_message"
+	// QueryMsgCheckForMisbehaviour is the name of the CheckForMisbehaviourMsg query message
+	QueryMsgCheckForMisbehaviour = "check_for_misbehaviour"
+
+	// SudoMsgUpdateState is the name of the UpdateStateMsg sudo message
+	SudoMsgUpdateState = "update_state"
+	// SudoMsgUpdateStateOnMisbehaviour is the name of the UpdateStateOnMisbehaviourMsg sudo message
+	SudoMsgUpdateStateOnMisbehaviour = "update_state_on_misbehaviour"
+	// SudoMsgVerifyUpgradeAndUpdateState is the name of the VerifyUpgradeAndUpdateStateMsg sudo message
+	SudoMsgVerifyUpgradeAndUpdateState = "verify_upgrade_and_update_state"
+	// SudoMsgVerifyMembership is the name of the VerifyMembershipMsg sudo message
+	SudoMsgVerifyMembership = "verify_membership"
+	// SudoMsgVerifyNonMembership is the name of the VerifyNonMembershipMsg sudo message
+	SudoMsgVerifyNonMembership = "verify_non_membership"
+	// SudoMsgMigrateClientStore is the name of the MigrateClientStoreMsg sudo message
+	SudoMsgMigrateClientStore = "migrate_client_store"
+	// SudoMsgVerifyMembershipBatch is the name of the VerifyMembershipBatchMsg sudo message
+	SudoMsgVerifyMembershipBatch = "verify_membership_batch"
+)
+
+// QueryMsgNames returns the names of all query messages sent to contracts.
+func QueryMsgNames() []string {
+	return []string{QueryMsgStatus, QueryMsgTimestampAtHeight, QueryMsgVerifyClientMessage, QueryMsgCheckForMisbehaviour}
+}
+
+// SudoMsgNames returns the names of all sudo messages sent to contracts.
+func SudoMsgNames() []string {
+	return []string{
+		SudoMsgUpdateState, SudoMsgUpdateStateOnMisbehaviour, SudoMsgVerifyUpgradeAndUpdateState, SudoMsgVerifyMembership,
+		SudoMsgVerifyNonMembership, SudoMsgMigrateClientStore, SudoMsgVerifyMembershipBatch,
+	}
+}
+
+// NewMessageAllowlist creates a new MessageAllowlist instance.
+func NewMessageAllowlist(queryMsgs, sudoMsgs []string) MessageAllowlist {
+	return MessageAllowlist{
+		QueryMsgs: queryMsgs,
+		SudoMsgs:  sudoMsgs,
+	}
+}
+
+// Validate performs basic validation of the message allowlist, ensuring that every listed message is a known
+// query or sudo message and is listed only once.
+func (a MessageAllowlist) Validate() error {
+	if err := validateMsgNames(a.QueryMsgs, QueryMsgNames()); err != nil {
+		return errorsmod.Wrap(err, "invalid query messages")
+	}
+
+	if err := validateMsgNames(a.SudoMsgs, SudoMsgNames()); err != nil {
+		return errorsmod.Wrap(err, "invalid sudo messages")
+	}
+
+	return nil
+}
+
+// IsQueryMsgAllowed returns true if the query message with the given name is forwarded to the contract.
+func (a MessageAllowlist) IsQueryMsgAllowed(name string) bool {
+	return slices.Contains(a.QueryMsgs, name)
+}
+
+// IsSudoMsgAllowed returns true if the sudo message with the given name is forwarded to the contract.
+func (a MessageAllowlist) IsSudoMsgAllowed(name string) bool {
+	return slices.Contains(a.SudoMsgs, name)
+}
+
+// validateMsgNames returns an error if any of the provided message names is not one of the known names or is duplicated.
+func validateMsgNames(names, knownNames []string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if !slices.Contains(knownNames, name) {
+			return errorsmod.Wrapf(ErrInvalidMessageAllowlist, "unknown message %q, expected one of %v", name, knownNames)
+		}
+
+		if seen[name] {
+			return errorsmod.Wrapf(ErrInvalidMessageAllowlist, "duplicate message %q", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
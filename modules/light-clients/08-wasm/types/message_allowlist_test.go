@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+func TestMessageAllowlistValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		allowlist types.MessageAllowlist
+		expErr    error
+	}{
+		{
+			"success: empty allowlist",
+			types.NewMessageAllowlist(nil, nil),
+			nil,
+		},
+		{
+			"success: all messages allowed",
+			types.NewMessageAllowlist(types.QueryMsgNames(), types.SudoMsgNames()),
+			nil,
+		},
+		{
+			"success: subset of messages allowed",
+			types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState, types.SudoMsgVerifyMembership}),
+			nil,
+		},
+		{
+			"failure: unknown query message",
+			types.NewMessageAllowlist([]string{"unknown"}, nil),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: sudo message listed as query message",
+			types.NewMessageAllowlist([]string{types.SudoMsgUpdateState}, nil),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: unknown sudo message",
+			types.NewMessageAllowlist(nil, []string{""}),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: duplicate sudo message",
+			types.NewMessageAllowlist(nil, []string{types.SudoMsgUpdateState, types.SudoMsgUpdateState}),
+			types.ErrInvalidMessageAllowlist,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.allowlist.Validate()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMessageAllowlistIsMsgAllowed(t *testing.T) {
+	allowlist := types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState})
+
+	require.True(t, allowlist.IsQueryMsgAllowed(types.QueryMsgStatus))
+	require.False(t, allowlist.IsQueryMsgAllowed(types.QueryMsgTimestampAtHeight))
+	require.True(t, allowlist.IsSudoMsgAllowed(types.SudoMsgUpdateState))
+	require.False(t, allowlist.IsSudoMsgAllowed(types.SudoMsgVerifyMembership))
+}
+
+func TestContractMsgName(t *testing.T) {
+	require.Equal(t, types.QueryMsgStatus, types.QueryMsg{Status: &types.StatusMsg{}}.Name())
+	require.Equal(t, types.QueryMsgCheckForMisbehaviour, types.QueryMsg{CheckForMisbehaviour: &types.CheckForMisbehaviourMsg{}}.Name())
+	require.Empty(t, types.QueryMsg{}.Name())
+
+	require.Equal(t, types.SudoMsgUpdateState, types.SudoMsg{UpdateState: &types.UpdateStateMsg{}}.Name())
+	require.Equal(t, types.SudoMsgVerifyMembershipBatch, types.SudoMsg{VerifyMembershipBatch: &types.VerifyMembershipBatchMsg{}}.Name())
+	require.Empty(t, types.SudoMsg{}.Name())
+}
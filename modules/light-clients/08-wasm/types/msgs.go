@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,9 +14,11 @@ var (
 	_ sdk.Msg              = (*MsgStoreCode)(nil)
 	_ sdk.Msg              = (*MsgMigrateContract)(nil)
 	_ sdk.Msg              = (*MsgRemoveChecksum)(nil)
+	_ sdk.Msg              = (*MsgSetMessageAllowlist)(nil)
 	_ sdk.HasValidateBasic = (*MsgStoreCode)(nil)
 	_ sdk.HasValidateBasic = (*MsgMigrateContract)(nil)
 	_ sdk.HasValidateBasic = (*MsgRemoveChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetMessageAllowlist)(nil)
 )
 
 // NewMsgStoreCode creates a new MsgStoreCode instance
@@ -25,6 +29,16 @@ func NewMsgStoreCode(signer string, code []byte) *MsgStoreCode {
 	}
 }
 
+// NewMsgStoreCodeWithMessageAllowlist creates a new MsgStoreCode instance which restricts the messages
+// forwarded to the contract to the provided allowlist.
+func NewMsgStoreCodeWithMessageAllowlist(signer string, code []byte, allowlist MessageAllowlist) *MsgStoreCode {
+	return &MsgStoreCode{
+		Signer:           signer,
+		WasmByteCode:     code,
+		MessageAllowlist: &allowlist,
+	}
+}
+
 // ValidateBasic implements sdk.HasValidateBasic
 func (m MsgStoreCode) ValidateBasic() error {
 	if err := ValidateWasmCode(m.WasmByteCode); err != nil {
@@ -36,6 +50,12 @@ func (m MsgStoreCode) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
+	if m.MessageAllowlist != nil {
+		if err := m.MessageAllowlist.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -47,6 +67,18 @@ func NewMsgRemoveChecksum(signer string, checksum []byte) *MsgRemoveChecksum {
 	}
 }
 
+// NewMsgForceRemoveChecksum creates a new MsgRemoveChecksum instance which forces the removal of the checksum
+// by migrating all clients using it to the provided checksum.
+func NewMsgForceRemoveChecksum(signer string, checksum, migrateToChecksum, migrateMsg []byte) *MsgRemoveChecksum {
+	return &MsgRemoveChecksum{
+		Signer:            signer,
+		Checksum:          checksum,
+		Force:             true,
+		MigrateToChecksum: migrateToChecksum,
+		MigrateMsg:        migrateMsg,
+	}
+}
+
 // ValidateBasic implements sdk.HasValidateBasic
 func (m MsgRemoveChecksum) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Signer)
@@ -58,6 +90,26 @@ func (m MsgRemoveChecksum) ValidateBasic() error {
 		return err
 	}
 
+	if !m.Force {
+		if len(m.MigrateToChecksum) != 0 || len(m.MigrateMsg) != 0 {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "migration target and message can only be provided when forcing checksum removal")
+		}
+
+		return nil
+	}
+
+	if err := ValidateWasmChecksum(m.MigrateToChecksum); err != nil {
+		return errorsmod.Wrap(err, "invalid migration target checksum")
+	}
+
+	if bytes.Equal(m.Checksum, m.MigrateToChecksum) {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "migration target checksum cannot be the removed checksum")
+	}
+
+	if len(m.MigrateMsg) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "migrate message cannot be empty")
+	}
+
 	return nil
 }
 
@@ -92,3 +144,33 @@ func (m MsgMigrateContract) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetMessageAllowlist creates a new MsgSetMessageAllowlist instance. A nil allowlist removes the
+// allowlist of the checksum.
+func NewMsgSetMessageAllowlist(signer string, checksum []byte, allowlist *MessageAllowlist) *MsgSetMessageAllowlist {
+	return &MsgSetMessageAllowlist{
+		Signer:           signer,
+		Checksum:         checksum,
+		MessageAllowlist: allowlist,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgSetMessageAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := ValidateWasmChecksum(m.Checksum); err != nil {
+		return err
+	}
+
+	if m.MessageAllowlist != nil {
+		if err := m.MessageAllowlist.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
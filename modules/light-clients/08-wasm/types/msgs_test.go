@@ -43,6 +43,16 @@ func TestMsgStoreCodeValidateBasic(t *testing.T) {
 			types.NewMsgStoreCode("invalid", wasmtesting.Code),
 			ibcerrors.ErrInvalidAddress,
 		},
+		{
+			"success: valid message allowlist",
+			types.NewMsgStoreCodeWithMessageAllowlist(signer, wasmtesting.Code, types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState})),
+			nil,
+		},
+		{
+			"failure: invalid message allowlist",
+			types.NewMsgStoreCodeWithMessageAllowlist(signer, wasmtesting.Code, types.NewMessageAllowlist([]string{"unknown"}, nil)),
+			types.ErrInvalidMessageAllowlist,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -195,6 +205,9 @@ func TestMsgRemoveChecksumValidateBasic(t *testing.T) {
 	checksum, err := types.CreateChecksum(wasmtesting.Code)
 	require.NoError(t, err, t.Name())
 
+	migrateToChecksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte("migrate-to")))
+	require.NoError(t, err, t.Name())
+
 	testCases := []struct {
 		name   string
 		msg    *types.MsgRemoveChecksum
@@ -220,6 +233,36 @@ func TestMsgRemoveChecksumValidateBasic(t *testing.T) {
 			types.NewMsgRemoveChecksum(ibctesting.InvalidID, checksum),
 			ibcerrors.ErrInvalidAddress,
 		},
+		{
+			"success: forced removal with migration target",
+			types.NewMsgForceRemoveChecksum(signer, checksum, migrateToChecksum, []byte("{}")),
+			nil,
+		},
+		{
+			"failure: migration target provided without forcing removal",
+			&types.MsgRemoveChecksum{Signer: signer, Checksum: checksum, MigrateToChecksum: migrateToChecksum},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: migrate message provided without forcing removal",
+			&types.MsgRemoveChecksum{Signer: signer, Checksum: checksum, MigrateMsg: []byte("{}")},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: forced removal with invalid migration target",
+			types.NewMsgForceRemoveChecksum(signer, checksum, []byte{1}, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: forced removal with migration target equal to removed checksum",
+			types.NewMsgForceRemoveChecksum(signer, checksum, checksum, []byte("{}")),
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: forced removal with empty migrate message",
+			types.NewMsgForceRemoveChecksum(signer, checksum, migrateToChecksum, nil),
+			ibcerrors.ErrInvalidRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -266,3 +309,56 @@ func (suite *TypesTestSuite) TestMsgRemoveChecksumGetSigners() {
 		})
 	}
 }
+
+func TestMsgSetMessageAllowlistValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+
+	allowlist := types.NewMessageAllowlist([]string{types.QueryMsgStatus}, []string{types.SudoMsgUpdateState})
+	invalidAllowlist := types.NewMessageAllowlist(nil, []string{"unknown"})
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetMessageAllowlist
+		expErr error
+	}{
+		{
+			"success: valid signer address, valid checksum and allowlist",
+			types.NewMsgSetMessageAllowlist(signer, checksum, &allowlist),
+			nil,
+		},
+		{
+			"success: nil allowlist",
+			types.NewMsgSetMessageAllowlist(signer, checksum, nil),
+			nil,
+		},
+		{
+			"failure: checksum is empty",
+			types.NewMsgSetMessageAllowlist(signer, []byte(""), &allowlist),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: invalid allowlist",
+			types.NewMsgSetMessageAllowlist(signer, checksum, &invalidAllowlist),
+			types.ErrInvalidMessageAllowlist,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgSetMessageAllowlist(ibctesting.InvalidID, checksum, &allowlist),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
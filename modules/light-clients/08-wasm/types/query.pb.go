@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -221,11 +222,394 @@ func (m *QueryCodeResponse) GetData() []byte {
 	return nil
 }
 
+// QueryMessageAllowlistRequest is the request type for the Query/MessageAllowlist RPC method.
+type QueryMessageAllowlistRequest struct {
+	// checksum is a hex encoded string of the code stored.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *QueryMessageAllowlistRequest) Reset()         { *m = QueryMessageAllowlistRequest{} }
+func (m *QueryMessageAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageAllowlistRequest) ProtoMessage()    {}
+func (*QueryMessageAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{4}
+}
+func (m *QueryMessageAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageAllowlistRequest.Merge(m, src)
+}
+func (m *QueryMessageAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryMessageAllowlistRequest) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+// QueryMessageAllowlistResponse is the response type for the Query/MessageAllowlist RPC method.
+type QueryMessageAllowlistResponse struct {
+	// allowlist of the messages forwarded to the contract, it is not set if all messages are forwarded.
+	MessageAllowlist *MessageAllowlist `protobuf:"bytes,1,opt,name=message_allowlist,json=messageAllowlist,proto3" json:"message_allowlist,omitempty"`
+}
+
+func (m *QueryMessageAllowlistResponse) Reset()         { *m = QueryMessageAllowlistResponse{} }
+func (m *QueryMessageAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessageAllowlistResponse) ProtoMessage()    {}
+func (*QueryMessageAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{5}
+}
+func (m *QueryMessageAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessageAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessageAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessageAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessageAllowlistResponse.Merge(m, src)
+}
+func (m *QueryMessageAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessageAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessageAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMessageAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryMessageAllowlistResponse) GetMessageAllowlist() *MessageAllowlist {
+	if m != nil {
+		return m.MessageAllowlist
+	}
+	return nil
+}
+
+// QueryClientChecksumRequest is the request type for the Query/ClientChecksum RPC method.
+type QueryClientChecksumRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryClientChecksumRequest) Reset()         { *m = QueryClientChecksumRequest{} }
+func (m *QueryClientChecksumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientChecksumRequest) ProtoMessage()    {}
+func (*QueryClientChecksumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{6}
+}
+func (m *QueryClientChecksumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientChecksumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientChecksumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientChecksumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientChecksumRequest.Merge(m, src)
+}
+func (m *QueryClientChecksumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientChecksumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientChecksumRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientChecksumRequest proto.InternalMessageInfo
+
+func (m *QueryClientChecksumRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryClientChecksumResponse is the response type for the Query/ClientChecksum RPC method.
+type QueryClientChecksumResponse struct {
+	// checksum is the hex encoded checksum of the contract used by the client.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *QueryClientChecksumResponse) Reset()         { *m = QueryClientChecksumResponse{} }
+func (m *QueryClientChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientChecksumResponse) ProtoMessage()    {}
+func (*QueryClientChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{7}
+}
+func (m *QueryClientChecksumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientChecksumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientChecksumResponse.Merge(m, src)
+}
+func (m *QueryClientChecksumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientChecksumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClientChecksumResponse proto.InternalMessageInfo
+
+func (m *QueryClientChecksumResponse) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+// QueryContractCallsRequest is the request type for the Query/ContractCalls RPC method.
+type QueryContractCallsRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryContractCallsRequest) Reset()         { *m = QueryContractCallsRequest{} }
+func (m *QueryContractCallsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractCallsRequest) ProtoMessage()    {}
+func (*QueryContractCallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{8}
+}
+func (m *QueryContractCallsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractCallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractCallsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractCallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractCallsRequest.Merge(m, src)
+}
+func (m *QueryContractCallsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractCallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractCallsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractCallsRequest proto.InternalMessageInfo
+
+func (m *QueryContractCallsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryContractCallsResponse is the response type for the Query/ContractCalls RPC method.
+type QueryContractCallsResponse struct {
+	// calls are the most recent contract calls traced for the client, ordered from oldest to newest.
+	Calls []ContractCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls"`
+}
+
+func (m *QueryContractCallsResponse) Reset()         { *m = QueryContractCallsResponse{} }
+func (m *QueryContractCallsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractCallsResponse) ProtoMessage()    {}
+func (*QueryContractCallsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{9}
+}
+func (m *QueryContractCallsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractCallsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractCallsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractCallsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractCallsResponse.Merge(m, src)
+}
+func (m *QueryContractCallsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractCallsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractCallsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractCallsResponse proto.InternalMessageInfo
+
+func (m *QueryContractCallsResponse) GetCalls() []ContractCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// ContractCall is a traced call of a light client contract.
+type ContractCall struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// entry_point is the contract entry point called: instantiate, sudo, query or migrate.
+	EntryPoint string `protobuf:"bytes,2,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	// checksum is the hex encoded checksum of the contract called.
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// height is the block height at which the contract was called.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// payload is the JSON encoded message passed to the contract.
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// response is the data returned by the contract.
+	Response []byte `protobuf:"bytes,6,opt,name=response,proto3" json:"response,omitempty"`
+	// error is the error returned by the contract or the vm, if any.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ContractCall) Reset()         { *m = ContractCall{} }
+func (m *ContractCall) String() string { return proto.CompactTextString(m) }
+func (*ContractCall) ProtoMessage()    {}
+func (*ContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3718a8cb915777, []int{10}
+}
+func (m *ContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCall.Merge(m, src)
+}
+func (m *ContractCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCall proto.InternalMessageInfo
+
+func (m *ContractCall) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ContractCall) GetEntryPoint() string {
+	if m != nil {
+		return m.EntryPoint
+	}
+	return ""
+}
+
+func (m *ContractCall) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *ContractCall) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractCall) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ContractCall) GetResponse() []byte {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *ContractCall) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryChecksumsRequest)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsRequest")
 	proto.RegisterType((*QueryChecksumsResponse)(nil), "ibc.lightclients.wasm.v1.QueryChecksumsResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "ibc.lightclients.wasm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "ibc.lightclients.wasm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryMessageAllowlistRequest)(nil), "ibc.lightclients.wasm.v1.QueryMessageAllowlistRequest")
+	proto.RegisterType((*QueryMessageAllowlistResponse)(nil), "ibc.lightclients.wasm.v1.QueryMessageAllowlistResponse")
+	proto.RegisterType((*QueryClientChecksumRequest)(nil), "ibc.lightclients.wasm.v1.QueryClientChecksumRequest")
+	proto.RegisterType((*QueryClientChecksumResponse)(nil), "ibc.lightclients.wasm.v1.QueryClientChecksumResponse")
+	proto.RegisterType((*QueryContractCallsRequest)(nil), "ibc.lightclients.wasm.v1.QueryContractCallsRequest")
+	proto.RegisterType((*QueryContractCallsResponse)(nil), "ibc.lightclients.wasm.v1.QueryContractCallsResponse")
+	proto.RegisterType((*ContractCall)(nil), "ibc.lightclients.wasm.v1.ContractCall")
 }
 
 func init() {
@@ -233,34 +617,56 @@ func init() {
 }
 
 var fileDescriptor_9e3718a8cb915777 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x3b, 0xf5, 0x2a, 0x66, 0x74, 0xa1, 0x03, 0x4a, 0x09, 0x97, 0x70, 0x89, 0x7f, 0xee,
-	0xe5, 0x5e, 0x3a, 0xd3, 0xb4, 0x08, 0x82, 0xae, 0x14, 0x74, 0xab, 0x59, 0xb8, 0x70, 0x23, 0x93,
-	0xc9, 0x90, 0x0e, 0x26, 0x99, 0xb4, 0x33, 0xa9, 0x14, 0x11, 0xc1, 0x27, 0x10, 0x5c, 0xea, 0xe3,
-	0xb8, 0x70, 0x59, 0x70, 0xe3, 0x52, 0x5a, 0x1f, 0x44, 0x32, 0x93, 0x34, 0xad, 0x58, 0xda, 0xdd,
-	0xe4, 0xf0, 0x3b, 0xdf, 0xf7, 0x9d, 0x93, 0x03, 0xef, 0x8a, 0x88, 0x91, 0x54, 0x24, 0x63, 0xcd,
-	0x52, 0xc1, 0x73, 0xad, 0xc8, 0x3b, 0xaa, 0x32, 0x32, 0x0b, 0xc8, 0xa4, 0xe4, 0xd3, 0x39, 0x2e,
-	0xa6, 0x52, 0x4b, 0xd4, 0x13, 0x11, 0xc3, 0x9b, 0x14, 0xae, 0x28, 0x3c, 0x0b, 0xdc, 0xe3, 0x44,
-	0xca, 0x24, 0xe5, 0x84, 0x16, 0x82, 0xd0, 0x3c, 0x97, 0x9a, 0x6a, 0x21, 0x73, 0x65, 0xfb, 0xdc,
-	0x73, 0x26, 0x55, 0x26, 0x15, 0x89, 0xa8, 0xe2, 0x56, 0x90, 0xcc, 0x82, 0x88, 0x6b, 0x1a, 0x90,
-	0x82, 0x26, 0x22, 0x37, 0xb0, 0x65, 0xfd, 0x37, 0xf0, 0xd6, 0xcb, 0x8a, 0x78, 0x3a, 0xe6, 0xec,
-	0xad, 0x2a, 0x33, 0x15, 0xf2, 0x49, 0xc9, 0x95, 0x46, 0xcf, 0x20, 0x6c, 0xe1, 0x1e, 0x38, 0x01,
-	0x67, 0xd7, 0x86, 0xf7, 0xb1, 0x55, 0xc6, 0x95, 0x32, 0xb6, 0x51, 0x6b, 0x65, 0xfc, 0x82, 0x26,
-	0xbc, 0xee, 0x0d, 0x37, 0x3a, 0xfd, 0x8f, 0xf0, 0xf6, 0xbf, 0x06, 0xaa, 0x90, 0xb9, 0xe2, 0xe8,
-	0x18, 0x3a, 0xac, 0x29, 0xf6, 0xc0, 0xc9, 0xa5, 0x33, 0x27, 0x6c, 0x0b, 0xe8, 0xf9, 0x96, 0x7f,
-	0xd7, 0xf8, 0x9f, 0xee, 0xf5, 0xb7, 0xd2, 0x5b, 0x01, 0x30, 0xbc, 0x61, 0x03, 0xc8, 0xb8, 0x09,
-	0x88, 0x5c, 0x78, 0xb5, 0x71, 0x32, 0xa3, 0x39, 0xe1, 0xfa, 0xdb, 0x3f, 0x85, 0x37, 0x37, 0xf8,
-	0x3a, 0x2b, 0x82, 0x47, 0x31, 0xd5, 0xd4, 0xc0, 0xd7, 0x43, 0xf3, 0x1e, 0x7e, 0xef, 0xc2, 0xcb,
-	0x86, 0x44, 0x5f, 0x01, 0x74, 0xd6, 0xf3, 0x21, 0x82, 0x77, 0xfd, 0x37, 0xfc, 0xdf, 0x55, 0xbb,
-	0x83, 0xc3, 0x1b, 0x6c, 0x1c, 0xff, 0xe2, 0xd3, 0xcf, 0x3f, 0x5f, 0xba, 0xf7, 0xd0, 0x1d, 0xb2,
-	0xf3, 0x90, 0xda, 0x4d, 0x7e, 0x03, 0xf0, 0xa8, 0x1a, 0x06, 0x9d, 0xef, 0xf3, 0x69, 0x37, 0xe4,
-	0x5e, 0x1c, 0xc4, 0xd6, 0x71, 0x1e, 0x99, 0x38, 0x0f, 0xd0, 0xe8, 0x80, 0x38, 0xe4, 0x7d, 0xf3,
-	0xfc, 0x40, 0x98, 0x8c, 0xf9, 0x93, 0x57, 0x3f, 0x96, 0x1e, 0x58, 0x2c, 0x3d, 0xf0, 0x7b, 0xe9,
-	0x81, 0xcf, 0x2b, 0xaf, 0xb3, 0x58, 0x79, 0x9d, 0x5f, 0x2b, 0xaf, 0xf3, 0xfa, 0x71, 0x22, 0xf4,
-	0xb8, 0x8c, 0x30, 0x93, 0x19, 0xa9, 0x4f, 0x5a, 0x44, 0xac, 0x9f, 0x48, 0x92, 0xc9, 0xb8, 0x4c,
-	0xb9, 0xb2, 0x56, 0xfd, 0xc6, 0x6b, 0xf0, 0xb0, 0x6f, 0xec, 0xf4, 0xbc, 0xe0, 0x2a, 0xba, 0x62,
-	0x0e, 0x7c, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x82, 0x4a, 0xb3, 0x6c, 0x03, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x4f, 0xdb, 0x4a,
+	0x10, 0x8f, 0x21, 0x81, 0x97, 0x81, 0xf7, 0x04, 0x2b, 0x1e, 0xf2, 0x33, 0xbc, 0x80, 0xcc, 0x7b,
+	0x05, 0x81, 0xf0, 0x12, 0xfe, 0x15, 0x5a, 0x84, 0xda, 0x50, 0xf5, 0xcf, 0xa1, 0x12, 0xf5, 0xa1,
+	0x95, 0x7a, 0x49, 0x37, 0xce, 0xca, 0xb1, 0xea, 0x78, 0x83, 0xd7, 0x81, 0x46, 0x08, 0x55, 0xea,
+	0x27, 0xa8, 0xd4, 0x43, 0x0f, 0xed, 0x57, 0xa9, 0xd4, 0x23, 0x87, 0x56, 0x42, 0xea, 0xa5, 0xa7,
+	0xaa, 0x82, 0x7e, 0x90, 0xca, 0xeb, 0x75, 0xfe, 0x40, 0x42, 0x92, 0xdb, 0xee, 0xec, 0xcc, 0xfc,
+	0x7e, 0xbf, 0xf1, 0xcc, 0x18, 0xfe, 0x73, 0x0a, 0x16, 0x76, 0x1d, 0xbb, 0x14, 0x58, 0xae, 0x43,
+	0xbd, 0x80, 0xe3, 0x23, 0xc2, 0xcb, 0xf8, 0x30, 0x8b, 0x0f, 0xaa, 0xd4, 0xaf, 0x19, 0x15, 0x9f,
+	0x05, 0x0c, 0xa9, 0x4e, 0xc1, 0x32, 0x9a, 0xbd, 0x8c, 0xd0, 0xcb, 0x38, 0xcc, 0x6a, 0x13, 0x36,
+	0xb3, 0x99, 0x70, 0xc2, 0xe1, 0x29, 0xf2, 0xd7, 0xa6, 0x6d, 0xc6, 0x6c, 0x97, 0x62, 0x52, 0x71,
+	0x30, 0xf1, 0x3c, 0x16, 0x90, 0xc0, 0x61, 0x1e, 0x97, 0xaf, 0x8b, 0x16, 0xe3, 0x65, 0xc6, 0x71,
+	0x81, 0x70, 0x1a, 0xc1, 0xe0, 0xc3, 0x6c, 0x81, 0x06, 0x24, 0x8b, 0x2b, 0xc4, 0x76, 0x3c, 0xe1,
+	0x2c, 0x7d, 0xe7, 0x3a, 0xf2, 0x13, 0x0c, 0x84, 0x93, 0x9e, 0x87, 0xbf, 0x9f, 0x84, 0x69, 0xf6,
+	0x4a, 0xd4, 0x7a, 0xc9, 0xab, 0x65, 0x6e, 0xd2, 0x83, 0x2a, 0xe5, 0x01, 0xba, 0x0f, 0xd0, 0xc8,
+	0xa8, 0x2a, 0xb3, 0xca, 0xc2, 0xc8, 0xea, 0x0d, 0x23, 0x82, 0x37, 0x42, 0x78, 0x23, 0x52, 0x29,
+	0xe1, 0x8d, 0x7d, 0x62, 0x53, 0x19, 0x6b, 0x36, 0x45, 0xea, 0xaf, 0x61, 0xf2, 0x32, 0x00, 0xaf,
+	0x30, 0x8f, 0x53, 0x34, 0x0d, 0x69, 0x2b, 0x36, 0xaa, 0xca, 0xec, 0xe0, 0x42, 0xda, 0x6c, 0x18,
+	0xd0, 0x83, 0x16, 0xfc, 0x01, 0x81, 0x3f, 0xdf, 0x15, 0x3f, 0x4a, 0xdd, 0x42, 0xc0, 0x80, 0xb1,
+	0x88, 0x00, 0x2b, 0xc6, 0x04, 0x91, 0x06, 0x7f, 0xc4, 0x48, 0x42, 0x5a, 0xda, 0xac, 0xdf, 0xf5,
+	0x79, 0x18, 0x6f, 0xf2, 0x97, 0x5c, 0x11, 0x24, 0x8b, 0x24, 0x20, 0xc2, 0x79, 0xd4, 0x14, 0x67,
+	0xfd, 0x16, 0x4c, 0x0b, 0xc7, 0xc7, 0x94, 0x73, 0x62, 0xd3, 0xbb, 0xae, 0xcb, 0x8e, 0x5c, 0x87,
+	0x07, 0xbd, 0x80, 0xbc, 0x82, 0x7f, 0x3b, 0xc4, 0x4a, 0xc0, 0x67, 0x30, 0x5e, 0x8e, 0xde, 0xf2,
+	0x24, 0x7e, 0x94, 0x5f, 0x61, 0xd1, 0xe8, 0xd4, 0x52, 0xc6, 0x95, 0x74, 0x63, 0xe5, 0x4b, 0x16,
+	0x7d, 0x1b, 0xb4, 0x48, 0x9e, 0x08, 0x8d, 0xbf, 0x4a, 0xcc, 0x79, 0x0a, 0xd2, 0x51, 0xce, 0xbc,
+	0x53, 0xac, 0x93, 0x16, 0x86, 0x47, 0x45, 0x7d, 0x1b, 0xa6, 0xda, 0x86, 0x4a, 0xca, 0xd7, 0xe9,
+	0xdd, 0x82, 0x7f, 0x64, 0x51, 0xbd, 0xc0, 0x27, 0x56, 0xb0, 0x47, 0x5c, 0x97, 0xf7, 0x04, 0xfa,
+	0x02, 0xb4, 0x76, 0x91, 0x12, 0x33, 0x07, 0x29, 0x2b, 0x34, 0x88, 0xfe, 0x09, 0x1b, 0xb4, 0x63,
+	0x69, 0x9a, 0xe3, 0x73, 0xc9, 0xd3, 0x1f, 0x33, 0x09, 0x33, 0x0a, 0xd5, 0xbf, 0x28, 0x30, 0xda,
+	0xfc, 0x7a, 0x2d, 0x1f, 0x34, 0x03, 0x23, 0xd4, 0x0b, 0xfc, 0x5a, 0xbe, 0xc2, 0x1c, 0x2f, 0x10,
+	0x8d, 0x99, 0x36, 0x41, 0x98, 0xf6, 0x43, 0x4b, 0x4b, 0x19, 0x06, 0x5b, 0xcb, 0x80, 0x26, 0x61,
+	0xa8, 0x44, 0x43, 0x76, 0x6a, 0x72, 0x56, 0x59, 0x18, 0x34, 0xe5, 0x0d, 0xa9, 0x30, 0x5c, 0x21,
+	0x35, 0x97, 0x91, 0xa2, 0x9a, 0x12, 0x1d, 0x16, 0x5f, 0xc3, 0x6c, 0xbe, 0x14, 0xab, 0x0e, 0x89,
+	0xa7, 0xfa, 0x1d, 0x4d, 0x40, 0x8a, 0xfa, 0x3e, 0xf3, 0xd5, 0x61, 0x01, 0x13, 0x5d, 0x56, 0xdf,
+	0x0f, 0x43, 0x4a, 0x54, 0x0c, 0x7d, 0x50, 0x20, 0x5d, 0x1f, 0x3b, 0x84, 0x3b, 0xd7, 0xa6, 0xed,
+	0x06, 0xd0, 0x56, 0x7a, 0x0f, 0x88, 0x08, 0xe9, 0x4b, 0x6f, 0xbe, 0xfd, 0x7a, 0x37, 0xf0, 0x3f,
+	0x9a, 0xc3, 0x1d, 0x57, 0x4f, 0x63, 0xc0, 0x3f, 0x2a, 0x90, 0x0c, 0x67, 0x0c, 0x2d, 0x76, 0xc3,
+	0x69, 0x0c, 0xae, 0xb6, 0xd4, 0x93, 0xaf, 0xa4, 0x73, 0x5b, 0xd0, 0xd9, 0x40, 0x6b, 0x3d, 0xd0,
+	0xc1, 0xc7, 0xf1, 0xf1, 0x04, 0x5b, 0x21, 0xab, 0xaf, 0x0a, 0x8c, 0x5d, 0x1e, 0x27, 0xb4, 0xd9,
+	0x05, 0xbe, 0xc3, 0x2a, 0xd0, 0x6e, 0xf6, 0x1d, 0x27, 0x25, 0x3c, 0x14, 0x12, 0x72, 0xe8, 0x4e,
+	0x9f, 0x12, 0xae, 0xec, 0x0e, 0xf4, 0x49, 0x81, 0xbf, 0x5a, 0x07, 0x17, 0xad, 0x77, 0x2b, 0x66,
+	0xbb, 0x15, 0xa1, 0x6d, 0xf4, 0x19, 0x25, 0x95, 0xec, 0x0a, 0x25, 0x5b, 0x68, 0xf3, 0x1a, 0x25,
+	0xf2, 0x7e, 0x5c, 0x9f, 0xbe, 0x93, 0xba, 0x3a, 0xf4, 0x59, 0x81, 0x3f, 0x5b, 0x76, 0x00, 0x5a,
+	0xeb, 0xda, 0x0b, 0x57, 0x77, 0x8d, 0xb6, 0xde, 0x5f, 0x90, 0x24, 0x7f, 0x4f, 0x90, 0xdf, 0x45,
+	0x3b, 0x7d, 0x92, 0x97, 0xc9, 0xf2, 0x62, 0xd1, 0xe4, 0x9e, 0x9e, 0x9e, 0x67, 0x94, 0xb3, 0xf3,
+	0x8c, 0xf2, 0xf3, 0x3c, 0xa3, 0xbc, 0xbd, 0xc8, 0x24, 0xce, 0x2e, 0x32, 0x89, 0xef, 0x17, 0x99,
+	0xc4, 0xf3, 0x1d, 0xdb, 0x09, 0x4a, 0xd5, 0x82, 0x61, 0xb1, 0x32, 0x96, 0x7f, 0x78, 0xa7, 0x60,
+	0x2d, 0xdb, 0x0c, 0x97, 0x59, 0xb1, 0xea, 0x52, 0x1e, 0x61, 0x2e, 0xc7, 0x20, 0x2b, 0x5b, 0xcb,
+	0x02, 0x37, 0xa8, 0x55, 0x28, 0x2f, 0x0c, 0x89, 0x5f, 0xf9, 0xda, 0xef, 0x01, 0x00, 0xeb, 0xf1,
+	0xea, 0x39, 0x91, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +685,13 @@ type QueryClient interface {
 	Checksums(ctx context.Context, in *QueryChecksumsRequest, opts ...grpc.CallOption) (*QueryChecksumsResponse, error)
 	// Get Wasm code for given checksum
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// Get the allowlist of the messages forwarded to the contract of the given checksum
+	MessageAllowlist(ctx context.Context, in *QueryMessageAllowlistRequest, opts ...grpc.CallOption) (*QueryMessageAllowlistResponse, error)
+	// Get the Wasm checksum of the contract used by the given client
+	ClientChecksum(ctx context.Context, in *QueryClientChecksumRequest, opts ...grpc.CallOption) (*QueryClientChecksumResponse, error)
+	// Get the most recent contract calls traced for the given client. Contract call tracing
+	// is a node level debugging configuration and the traced calls are not part of consensus.
+	ContractCalls(ctx context.Context, in *QueryContractCallsRequest, opts ...grpc.CallOption) (*QueryContractCallsResponse, error)
 }
 
 type queryClient struct {
@@ -307,12 +720,46 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) MessageAllowlist(ctx context.Context, in *QueryMessageAllowlistRequest, opts ...grpc.CallOption) (*QueryMessageAllowlistResponse, error) {
+	out := new(QueryMessageAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/MessageAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientChecksum(ctx context.Context, in *QueryClientChecksumRequest, opts ...grpc.CallOption) (*QueryClientChecksumResponse, error) {
+	out := new(QueryClientChecksumResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ClientChecksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractCalls(ctx context.Context, in *QueryContractCallsRequest, opts ...grpc.CallOption) (*QueryContractCallsResponse, error) {
+	out := new(QueryContractCallsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Query/ContractCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Get all Wasm checksums
 	Checksums(context.Context, *QueryChecksumsRequest) (*QueryChecksumsResponse, error)
 	// Get Wasm code for given checksum
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// Get the allowlist of the messages forwarded to the contract of the given checksum
+	MessageAllowlist(context.Context, *QueryMessageAllowlistRequest) (*QueryMessageAllowlistResponse, error)
+	// Get the Wasm checksum of the contract used by the given client
+	ClientChecksum(context.Context, *QueryClientChecksumRequest) (*QueryClientChecksumResponse, error)
+	// Get the most recent contract calls traced for the given client. Contract call tracing
+	// is a node level debugging configuration and the traced calls are not part of consensus.
+	ContractCalls(context.Context, *QueryContractCallsRequest) (*QueryContractCallsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -325,6 +772,15 @@ func (*UnimplementedQueryServer) Checksums(ctx context.Context, req *QueryChecks
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
+func (*UnimplementedQueryServer) MessageAllowlist(ctx context.Context, req *QueryMessageAllowlistRequest) (*QueryMessageAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) ClientChecksum(ctx context.Context, req *QueryClientChecksumRequest) (*QueryClientChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientChecksum not implemented")
+}
+func (*UnimplementedQueryServer) ContractCalls(ctx context.Context, req *QueryContractCallsRequest) (*QueryContractCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCalls not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -366,24 +822,90 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.lightclients.wasm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Checksums",
-			Handler:    _Query_Checksums_Handler,
-		},
-		{
-			MethodName: "Code",
-			Handler:    _Query_Code_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/lightclients/wasm/v1/query.proto",
-}
-
-func (m *QueryChecksumsRequest) Marshal() (dAtA []byte, err error) {
+func _Query_MessageAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessageAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/MessageAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageAllowlist(ctx, req.(*QueryMessageAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ClientChecksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientChecksum(ctx, req.(*QueryClientChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Query/ContractCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCalls(ctx, req.(*QueryContractCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.lightclients.wasm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Checksums",
+			Handler:    _Query_Checksums_Handler,
+		},
+		{
+			MethodName: "Code",
+			Handler:    _Query_Code_Handler,
+		},
+		{
+			MethodName: "MessageAllowlist",
+			Handler:    _Query_MessageAllowlist_Handler,
+		},
+		{
+			MethodName: "ClientChecksum",
+			Handler:    _Query_ClientChecksum_Handler,
+		},
+		{
+			MethodName: "ContractCalls",
+			Handler:    _Query_ContractCalls_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/wasm/v1/query.proto",
+}
+
+func (m *QueryChecksumsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -522,82 +1044,1080 @@ func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMessageAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryChecksumsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryMessageAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessageAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryChecksumsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMessageAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessageAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessageAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Checksums) > 0 {
-		for _, s := range m.Checksums {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.MessageAllowlist != nil {
+		{
+			size, err := m.MessageAllowlist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientChecksumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClientChecksumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientChecksumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientChecksumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Checksum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContractCallsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractCallsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractCallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryContractCallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryContractCallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractCallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntryPoint) > 0 {
+		i -= len(m.EntryPoint)
+		copy(dAtA[i:], m.EntryPoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EntryPoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryChecksumsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChecksumsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		for _, s := range m.Checksums {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMessageAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMessageAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageAllowlist != nil {
+		l = m.MessageAllowlist.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientChecksumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientChecksumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractCallsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractCallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EntryPoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChecksumsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessageAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessageAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessageAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessageAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageAllowlist == nil {
+				m.MessageAllowlist = &MessageAllowlist{}
+			}
+			if err := m.MessageAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientChecksumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientChecksumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientChecksumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientChecksumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -620,17 +2140,17 @@ func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChecksumsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientChecksumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChecksumsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -640,27 +2160,23 @@ func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -683,7 +2199,7 @@ func (m *QueryChecksumsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractCallsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -706,15 +2222,15 @@ func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChecksumsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractCallsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChecksumsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractCallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -742,43 +2258,7 @@ func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksums = append(m.Checksums, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -801,7 +2281,7 @@ func (m *QueryChecksumsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractCallsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -824,17 +2304,17 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractCallsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractCallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -844,23 +2324,25 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksum = string(dAtA[iNdEx:postIndex])
+			m.Calls = append(m.Calls, ContractCall{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -883,7 +2365,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -906,15 +2388,130 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -941,10 +2538,76 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = append(m.Response[:0], dAtA[iNdEx:postIndex]...)
+			if m.Response == nil {
+				m.Response = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
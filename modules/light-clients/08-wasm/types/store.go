@@ -9,6 +9,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -31,6 +32,22 @@ func GetClientState(store storetypes.KVStore, cdc codec.BinaryCodec) (*ClientSta
 	return clientState, ok
 }
 
+// GetContractAPIVersion retrieves the API version implemented by the client's contract from the client store.
+// ContractAPIVersionLegacy is returned if the contract did not report an API version.
+func GetContractAPIVersion(store storetypes.KVStore) uint64 {
+	bz := store.Get([]byte(KeyContractAPIVersion))
+	if len(bz) == 0 {
+		return ContractAPIVersionLegacy
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetContractAPIVersion stores the API version implemented by the client's contract in the client store.
+func SetContractAPIVersion(store storetypes.KVStore, version uint64) {
+	store.Set([]byte(KeyContractAPIVersion), sdk.Uint64ToBigEndian(version))
+}
+
 // Checksum is a type alias used for wasm byte code checksums.
 type Checksum = wasmvmtypes.Checksum
 
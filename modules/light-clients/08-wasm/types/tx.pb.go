@@ -34,6 +34,8 @@ type MsgStoreCode struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// wasm byte code of light client contract. It can be raw or gzip compressed
 	WasmByteCode []byte `protobuf:"bytes,2,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+	// optional allowlist of the messages forwarded to the contract. All messages are forwarded if not set.
+	MessageAllowlist *MessageAllowlist `protobuf:"bytes,3,opt,name=message_allowlist,json=messageAllowlist,proto3" json:"message_allowlist,omitempty"`
 }
 
 func (m *MsgStoreCode) Reset()         { *m = MsgStoreCode{} }
@@ -83,6 +85,13 @@ func (m *MsgStoreCode) GetWasmByteCode() []byte {
 	return nil
 }
 
+func (m *MsgStoreCode) GetMessageAllowlist() *MessageAllowlist {
+	if m != nil {
+		return m.MessageAllowlist
+	}
+	return nil
+}
+
 // MsgStoreCodeResponse defines the response type for the StoreCode rpc
 type MsgStoreCodeResponse struct {
 	// checksum is the sha256 hash of the stored code
@@ -135,6 +144,13 @@ type MsgRemoveChecksum struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// checksum is the sha256 hash to be removed from the store
 	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// force removal of a checksum which is still used by clients. If set, all clients using
+	// the checksum are migrated to migrate_to_checksum before the checksum is removed.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// checksum of the wasm byte code the clients using the removed checksum are migrated to
+	MigrateToChecksum []byte `protobuf:"bytes,4,opt,name=migrate_to_checksum,json=migrateToChecksum,proto3" json:"migrate_to_checksum,omitempty"`
+	// the json encoded message to be passed to the contracts on migration
+	MigrateMsg []byte `protobuf:"bytes,5,opt,name=migrate_msg,json=migrateMsg,proto3" json:"migrate_msg,omitempty"`
 }
 
 func (m *MsgRemoveChecksum) Reset()         { *m = MsgRemoveChecksum{} }
@@ -184,6 +200,27 @@ func (m *MsgRemoveChecksum) GetChecksum() []byte {
 	return nil
 }
 
+func (m *MsgRemoveChecksum) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *MsgRemoveChecksum) GetMigrateToChecksum() []byte {
+	if m != nil {
+		return m.MigrateToChecksum
+	}
+	return nil
+}
+
+func (m *MsgRemoveChecksum) GetMigrateMsg() []byte {
+	if m != nil {
+		return m.MigrateMsg
+	}
+	return nil
+}
+
 // MsgStoreChecksumResponse defines the response type for the StoreCode rpc
 type MsgRemoveChecksumResponse struct {
 }
@@ -331,6 +368,108 @@ func (m *MsgMigrateContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMigrateContractResponse proto.InternalMessageInfo
 
+// MsgSetMessageAllowlist defines the request type for the SetMessageAllowlist rpc.
+type MsgSetMessageAllowlist struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// checksum is the sha256 hash of the wasm byte code the allowlist applies to
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// allowlist of the messages forwarded to the contract. If not set, the allowlist of the checksum is
+	// removed and all messages are forwarded to the contract.
+	MessageAllowlist *MessageAllowlist `protobuf:"bytes,3,opt,name=message_allowlist,json=messageAllowlist,proto3" json:"message_allowlist,omitempty"`
+}
+
+func (m *MsgSetMessageAllowlist) Reset()         { *m = MsgSetMessageAllowlist{} }
+func (m *MsgSetMessageAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessageAllowlist) ProtoMessage()    {}
+func (*MsgSetMessageAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{6}
+}
+func (m *MsgSetMessageAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessageAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessageAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessageAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessageAllowlist.Merge(m, src)
+}
+func (m *MsgSetMessageAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessageAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessageAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessageAllowlist proto.InternalMessageInfo
+
+func (m *MsgSetMessageAllowlist) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetMessageAllowlist) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *MsgSetMessageAllowlist) GetMessageAllowlist() *MessageAllowlist {
+	if m != nil {
+		return m.MessageAllowlist
+	}
+	return nil
+}
+
+// MsgSetMessageAllowlistResponse defines the response type for the SetMessageAllowlist rpc
+type MsgSetMessageAllowlistResponse struct {
+}
+
+func (m *MsgSetMessageAllowlistResponse) Reset()         { *m = MsgSetMessageAllowlistResponse{} }
+func (m *MsgSetMessageAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessageAllowlistResponse) ProtoMessage()    {}
+func (*MsgSetMessageAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d9737363bf1e38d, []int{7}
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessageAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessageAllowlistResponse.Merge(m, src)
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessageAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessageAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessageAllowlistResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "ibc.lightclients.wasm.v1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "ibc.lightclients.wasm.v1.MsgStoreCodeResponse")
@@ -338,40 +477,50 @@ func init() {
 	proto.RegisterType((*MsgRemoveChecksumResponse)(nil), "ibc.lightclients.wasm.v1.MsgRemoveChecksumResponse")
 	proto.RegisterType((*MsgMigrateContract)(nil), "ibc.lightclients.wasm.v1.MsgMigrateContract")
 	proto.RegisterType((*MsgMigrateContractResponse)(nil), "ibc.lightclients.wasm.v1.MsgMigrateContractResponse")
+	proto.RegisterType((*MsgSetMessageAllowlist)(nil), "ibc.lightclients.wasm.v1.MsgSetMessageAllowlist")
+	proto.RegisterType((*MsgSetMessageAllowlistResponse)(nil), "ibc.lightclients.wasm.v1.MsgSetMessageAllowlistResponse")
 }
 
 func init() { proto.RegisterFile("ibc/lightclients/wasm/v1/tx.proto", fileDescriptor_1d9737363bf1e38d) }
 
 var fileDescriptor_1d9737363bf1e38d = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x14, 0xcc, 0x26, 0x50, 0x35, 0x8f, 0xa8, 0x80, 0x85, 0x20, 0xb8, 0xc8, 0x2a, 0x11, 0x42, 0x55,
-	0x21, 0x6b, 0xda, 0x72, 0x40, 0x88, 0x53, 0x7b, 0xe2, 0xe0, 0x8b, 0x41, 0x48, 0x70, 0x89, 0xe2,
-	0xf5, 0x6a, 0xb3, 0x22, 0xeb, 0x8d, 0xfc, 0xd6, 0x81, 0xdc, 0x10, 0xe2, 0x07, 0xf0, 0x53, 0xfa,
-	0x33, 0x38, 0xf6, 0xc8, 0x81, 0x03, 0x4a, 0x0e, 0xfd, 0x1b, 0xc8, 0x5f, 0xc1, 0x4e, 0x95, 0xaa,
-	0xb9, 0x79, 0x9f, 0xe6, 0xcd, 0x8c, 0xdf, 0x68, 0xe0, 0xb1, 0x0c, 0x98, 0x3b, 0x96, 0x62, 0x64,
-	0xd8, 0x58, 0xf2, 0xc8, 0xa0, 0xfb, 0x65, 0x88, 0xca, 0x9d, 0x1e, 0xba, 0xe6, 0x2b, 0x9d, 0xc4,
-	0xda, 0x68, 0xab, 0x2b, 0x03, 0x46, 0xab, 0x10, 0x9a, 0x42, 0xe8, 0xf4, 0xd0, 0x7e, 0xc0, 0x34,
-	0x2a, 0x8d, 0xae, 0x42, 0x91, 0x6e, 0x28, 0x14, 0xf9, 0x4a, 0xef, 0x23, 0x74, 0x3c, 0x14, 0xef,
-	0x8c, 0x8e, 0xf9, 0xa9, 0x0e, 0xb9, 0x75, 0x1f, 0xb6, 0x50, 0x8a, 0x88, 0xc7, 0x5d, 0xb2, 0x47,
-	0xf6, 0xdb, 0x7e, 0xf1, 0xb2, 0x9e, 0xc0, 0x4e, 0xca, 0x35, 0x08, 0x66, 0x86, 0x0f, 0x98, 0x0e,
-	0x79, 0xb7, 0xb9, 0x47, 0xf6, 0x3b, 0x7e, 0x27, 0x9d, 0x9e, 0xcc, 0x4c, 0xb6, 0xfd, 0xfa, 0xd6,
-	0xf7, 0x8b, 0xb3, 0x83, 0x62, 0xa5, 0x77, 0x04, 0xf7, 0xaa, 0xd4, 0x3e, 0xc7, 0x89, 0x8e, 0x90,
-	0x5b, 0x36, 0x6c, 0xb3, 0x11, 0x67, 0x9f, 0x31, 0x51, 0x99, 0x48, 0xc7, 0x5f, 0xbe, 0x7b, 0xef,
-	0xe1, 0xae, 0x87, 0xc2, 0xe7, 0x4a, 0x4f, 0xf9, 0x69, 0x31, 0x5c, 0xeb, 0xa9, 0x4a, 0xd4, 0xac,
-	0x13, 0xd5, 0x9d, 0xec, 0xc2, 0xc3, 0x4b, 0xac, 0xa5, 0x9d, 0xde, 0x0f, 0x02, 0x96, 0x87, 0xc2,
-	0x93, 0x22, 0x1e, 0xa6, 0xbf, 0x11, 0x99, 0x78, 0xc8, 0xcc, 0x5a, 0xd1, 0x5d, 0x68, 0xe7, 0xc7,
-	0x1d, 0xc8, 0x30, 0x53, 0x6d, 0xfb, 0xdb, 0xf9, 0xe0, 0x6d, 0x58, 0x73, 0xd4, 0xaa, 0x3b, 0xb2,
-	0xee, 0x40, 0x4b, 0xa1, 0xe8, 0xde, 0xc8, 0xc6, 0xe9, 0x67, 0xdd, 0xe3, 0x23, 0xb0, 0x2f, 0xbb,
-	0x28, 0x4d, 0x1e, 0xfd, 0x69, 0x42, 0xcb, 0x43, 0x61, 0x31, 0x68, 0xff, 0xcf, 0xea, 0x29, 0x5d,
-	0x97, 0x37, 0xad, 0x1e, 0xde, 0xa6, 0xd7, 0xc3, 0x2d, 0x03, 0x8a, 0x61, 0x67, 0x25, 0x81, 0x67,
-	0x57, 0x32, 0xd4, 0xc1, 0xf6, 0xf1, 0x06, 0xe0, 0xa5, 0x66, 0x02, 0xb7, 0x57, 0x13, 0x78, 0x7e,
-	0x25, 0xcf, 0x0a, 0xda, 0x7e, 0xb9, 0x09, 0xba, 0x94, 0xb5, 0x6f, 0x7e, 0xbb, 0x38, 0x3b, 0x20,
-	0x27, 0x1f, 0x7e, 0xcd, 0x1d, 0x72, 0x3e, 0x77, 0xc8, 0xdf, 0xb9, 0x43, 0x7e, 0x2e, 0x9c, 0xc6,
-	0xf9, 0xc2, 0x69, 0xfc, 0x5e, 0x38, 0x8d, 0x4f, 0x6f, 0x84, 0x34, 0xa3, 0x24, 0xa0, 0x4c, 0x2b,
-	0xb7, 0xe8, 0x90, 0x0c, 0x58, 0x5f, 0x68, 0x57, 0xe9, 0x30, 0x19, 0x73, 0xcc, 0x2b, 0xd9, 0x2f,
-	0x3b, 0xf9, 0xe2, 0x55, 0x3f, 0xab, 0xa5, 0x99, 0x4d, 0x38, 0x06, 0x5b, 0x59, 0xc9, 0x8e, 0xff,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xb7, 0xbc, 0xcb, 0xbc, 0x03, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xed, 0xd6, 0xb4, 0x6a, 0xa6, 0x51, 0x69, 0xdd, 0xaa, 0x04, 0x17, 0x99, 0x10, 0x10, 0x8a,
+	0x02, 0xb1, 0xdb, 0x94, 0x43, 0x85, 0xb8, 0xd0, 0x9c, 0x38, 0xf8, 0x62, 0x10, 0x48, 0x5c, 0xac,
+	0x78, 0xb3, 0x6c, 0x2c, 0xbc, 0xd9, 0xc8, 0xbb, 0x49, 0xc9, 0x0d, 0x10, 0x3f, 0x80, 0x9f, 0x52,
+	0xf5, 0xca, 0x1f, 0xe0, 0xd8, 0x23, 0xc7, 0x2a, 0x39, 0xf4, 0x6f, 0x20, 0x7f, 0x92, 0x38, 0x4d,
+	0x44, 0x38, 0x70, 0xdc, 0xc9, 0x7b, 0x33, 0xef, 0x65, 0x9e, 0x07, 0x1e, 0x78, 0x2e, 0x36, 0x7d,
+	0x8f, 0x76, 0x24, 0xf6, 0x3d, 0xd2, 0x95, 0xc2, 0x3c, 0x6b, 0x09, 0x66, 0x0e, 0x8e, 0x4c, 0xf9,
+	0xc9, 0xe8, 0x05, 0x5c, 0x72, 0xb5, 0xe4, 0xb9, 0xd8, 0x98, 0x84, 0x18, 0x21, 0xc4, 0x18, 0x1c,
+	0x69, 0x77, 0x30, 0x17, 0x8c, 0x0b, 0x93, 0x09, 0x1a, 0x32, 0x98, 0xa0, 0x31, 0x45, 0x7b, 0x38,
+	0xb7, 0x6b, 0x44, 0x8d, 0x40, 0x95, 0x0b, 0x04, 0x45, 0x4b, 0xd0, 0xd7, 0x92, 0x07, 0xa4, 0xc9,
+	0xdb, 0x44, 0xdd, 0x87, 0x75, 0xe1, 0xd1, 0x2e, 0x09, 0x4a, 0xa8, 0x8c, 0xaa, 0x05, 0x3b, 0x79,
+	0xa9, 0x8f, 0x60, 0x2b, 0xa4, 0x39, 0xee, 0x50, 0x12, 0x07, 0xf3, 0x36, 0x29, 0xad, 0x96, 0x51,
+	0xb5, 0x68, 0x17, 0xc3, 0xea, 0xe9, 0x50, 0xc6, 0xec, 0x77, 0xb0, 0xc3, 0x88, 0x10, 0x2d, 0x4a,
+	0x9c, 0x96, 0xef, 0xf3, 0x33, 0xdf, 0x13, 0xb2, 0xa4, 0x94, 0x51, 0x75, 0xb3, 0x51, 0x33, 0xe6,
+	0x59, 0x30, 0xac, 0x98, 0xf2, 0x32, 0x65, 0xd8, 0xdb, 0x2c, 0x57, 0x79, 0xbe, 0xf9, 0xf5, 0xfa,
+	0xbc, 0x96, 0x68, 0xa9, 0x34, 0x60, 0x6f, 0x52, 0xb3, 0x4d, 0x44, 0x8f, 0x77, 0x05, 0x51, 0x35,
+	0xd8, 0xc0, 0x1d, 0x82, 0x3f, 0x8a, 0x3e, 0x8b, 0xd4, 0x17, 0xed, 0xec, 0x5d, 0xf9, 0x81, 0x60,
+	0xc7, 0x12, 0xd4, 0x26, 0x8c, 0x0f, 0x48, 0x33, 0xa9, 0xce, 0x75, 0x3b, 0xd9, 0x69, 0x75, 0xba,
+	0x93, 0xba, 0x07, 0x6b, 0x1f, 0x78, 0x80, 0x49, 0xe4, 0x6b, 0xc3, 0x8e, 0x1f, 0xaa, 0x01, 0xbb,
+	0xcc, 0xa3, 0x41, 0x4b, 0x12, 0x47, 0x72, 0x27, 0x23, 0xdf, 0x8a, 0xc8, 0x3b, 0xc9, 0x4f, 0x6f,
+	0x78, 0x36, 0xf9, 0x3e, 0x6c, 0xa6, 0x78, 0x26, 0x68, 0x69, 0x2d, 0xc2, 0x41, 0x52, 0xb2, 0x04,
+	0x9d, 0x76, 0x7c, 0x00, 0x77, 0x67, 0xc4, 0xa7, 0xb6, 0x2b, 0xdf, 0x10, 0xa8, 0x96, 0xa0, 0x56,
+	0xcc, 0x6d, 0xf2, 0xae, 0x0c, 0x5a, 0x58, 0xce, 0xf5, 0x76, 0x00, 0x85, 0x78, 0x01, 0x8e, 0xd7,
+	0x8e, 0xcc, 0x15, 0xec, 0x8d, 0xb8, 0xf0, 0xaa, 0x3d, 0x65, 0x5c, 0xc9, 0x19, 0xdf, 0x06, 0x25,
+	0x94, 0x1a, 0x5b, 0x52, 0x58, 0x5e, 0xe3, 0x3d, 0xd0, 0x66, 0x55, 0x64, 0x22, 0x2f, 0x10, 0xec,
+	0x87, 0x4b, 0x23, 0x32, 0xbf, 0xed, 0x7f, 0x5a, 0xc2, 0xff, 0x09, 0x5a, 0x19, 0xf4, 0x9b, 0x35,
+	0xa7, 0xb6, 0x1a, 0x57, 0x0a, 0x28, 0x96, 0xa0, 0x2a, 0x86, 0xc2, 0x9f, 0x6f, 0xe8, 0xf1, 0x02,
+	0x05, 0x13, 0xb9, 0xd5, 0x8c, 0xbf, 0xc3, 0x65, 0xf9, 0x0e, 0x60, 0x2b, 0x97, 0xdf, 0x27, 0x0b,
+	0x3b, 0x4c, 0x83, 0xb5, 0xe3, 0x25, 0xc0, 0xd9, 0xcc, 0x3e, 0xdc, 0xce, 0x07, 0xeb, 0xe9, 0xc2,
+	0x3e, 0x39, 0xb4, 0xf6, 0x6c, 0x19, 0x74, 0x36, 0xf6, 0x0b, 0x82, 0xdd, 0x9b, 0xb2, 0x72, 0xb8,
+	0xf8, 0x2f, 0x9b, 0x65, 0x68, 0x27, 0xcb, 0x32, 0x52, 0x0d, 0xda, 0xda, 0xe7, 0xeb, 0xf3, 0x1a,
+	0x3a, 0x7d, 0xfb, 0x73, 0xa4, 0xa3, 0xcb, 0x91, 0x8e, 0xae, 0x46, 0x3a, 0xfa, 0x3e, 0xd6, 0x57,
+	0x2e, 0xc7, 0xfa, 0xca, 0xaf, 0xb1, 0xbe, 0xf2, 0xfe, 0x05, 0xf5, 0x64, 0xa7, 0xef, 0x1a, 0x98,
+	0x33, 0x33, 0xb9, 0xc2, 0x9e, 0x8b, 0xeb, 0x94, 0x9b, 0x8c, 0xb7, 0xfb, 0x3e, 0x11, 0xf1, 0xf9,
+	0xad, 0xa7, 0xf7, 0xf7, 0xf0, 0xa4, 0x1e, 0x9d, 0x60, 0x39, 0xec, 0x11, 0xe1, 0xae, 0x47, 0x17,
+	0xf8, 0xf8, 0xf7, 0x00, 0x81, 0xad, 0x83, 0x28, 0xfe, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -392,6 +541,8 @@ type MsgClient interface {
 	RemoveChecksum(ctx context.Context, in *MsgRemoveChecksum, opts ...grpc.CallOption) (*MsgRemoveChecksumResponse, error)
 	// MigrateContract defines a rpc handler method for MsgMigrateContract.
 	MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error)
+	// SetMessageAllowlist defines a rpc handler method for MsgSetMessageAllowlist.
+	SetMessageAllowlist(ctx context.Context, in *MsgSetMessageAllowlist, opts ...grpc.CallOption) (*MsgSetMessageAllowlistResponse, error)
 }
 
 type msgClient struct {
@@ -429,6 +580,15 @@ func (c *msgClient) MigrateContract(ctx context.Context, in *MsgMigrateContract,
 	return out, nil
 }
 
+func (c *msgClient) SetMessageAllowlist(ctx context.Context, in *MsgSetMessageAllowlist, opts ...grpc.CallOption) (*MsgSetMessageAllowlistResponse, error) {
+	out := new(MsgSetMessageAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/ibc.lightclients.wasm.v1.Msg/SetMessageAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode defines a rpc handler method for MsgStoreCode.
@@ -437,6 +597,8 @@ type MsgServer interface {
 	RemoveChecksum(context.Context, *MsgRemoveChecksum) (*MsgRemoveChecksumResponse, error)
 	// MigrateContract defines a rpc handler method for MsgMigrateContract.
 	MigrateContract(context.Context, *MsgMigrateContract) (*MsgMigrateContractResponse, error)
+	// SetMessageAllowlist defines a rpc handler method for MsgSetMessageAllowlist.
+	SetMessageAllowlist(context.Context, *MsgSetMessageAllowlist) (*MsgSetMessageAllowlistResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -452,6 +614,9 @@ func (*UnimplementedMsgServer) RemoveChecksum(ctx context.Context, req *MsgRemov
 func (*UnimplementedMsgServer) MigrateContract(ctx context.Context, req *MsgMigrateContract) (*MsgMigrateContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateContract not implemented")
 }
+func (*UnimplementedMsgServer) SetMessageAllowlist(ctx context.Context, req *MsgSetMessageAllowlist) (*MsgSetMessageAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessageAllowlist not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -511,6 +676,24 @@ func _Msg_MigrateContract_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMessageAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMessageAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMessageAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.lightclients.wasm.v1.Msg/SetMessageAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMessageAllowlist(ctx, req.(*MsgSetMessageAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.lightclients.wasm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -527,6 +710,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateContract",
 			Handler:    _Msg_MigrateContract_Handler,
 		},
+		{
+			MethodName: "SetMessageAllowlist",
+			Handler:    _Msg_SetMessageAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/lightclients/wasm/v1/tx.proto",
@@ -552,6 +739,18 @@ func (m *MsgStoreCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MessageAllowlist != nil {
+		{
+			size, err := m.MessageAllowlist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.WasmByteCode) > 0 {
 		i -= len(m.WasmByteCode)
 		copy(dAtA[i:], m.WasmByteCode)
@@ -619,6 +818,30 @@ func (m *MsgRemoveChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MigrateMsg) > 0 {
+		i -= len(m.MigrateMsg)
+		copy(dAtA[i:], m.MigrateMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateMsg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MigrateToChecksum) > 0 {
+		i -= len(m.MigrateToChecksum)
+		copy(dAtA[i:], m.MigrateToChecksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateToChecksum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Checksum) > 0 {
 		i -= len(m.Checksum)
 		copy(dAtA[i:], m.Checksum)
@@ -733,6 +956,78 @@ func (m *MsgMigrateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetMessageAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessageAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessageAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageAllowlist != nil {
+		{
+			size, err := m.MessageAllowlist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMessageAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessageAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessageAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -758,6 +1053,10 @@ func (m *MsgStoreCode) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MessageAllowlist != nil {
+		l = m.MessageAllowlist.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -788,6 +1087,17 @@ func (m *MsgRemoveChecksum) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Force {
+		n += 2
+	}
+	l = len(m.MigrateToChecksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MigrateMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -834,6 +1144,36 @@ func (m *MsgMigrateContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetMessageAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MessageAllowlist != nil {
+		l = m.MessageAllowlist.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetMessageAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -935,6 +1275,42 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 				m.WasmByteCode = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageAllowlist == nil {
+				m.MessageAllowlist = &MessageAllowlist{}
+			}
+			if err := m.MessageAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1135,6 +1511,94 @@ func (m *MsgRemoveChecksum) Unmarshal(dAtA []byte) error {
 				m.Checksum = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateToChecksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrateToChecksum = append(m.MigrateToChecksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateToChecksum == nil {
+				m.MigrateToChecksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1438,6 +1902,208 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetMessageAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageAllowlist == nil {
+				m.MessageAllowlist = &MessageAllowlist{}
+			}
+			if err := m.MessageAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMessageAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessageAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -144,6 +144,63 @@ func (m *ClientMessage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClientMessage proto.InternalMessageInfo
 
+// MessageAllowlist defines the query and sudo messages the 08-wasm module forwards to the contract of a checksum.
+// Messages are identified by the name of their variant in the JSON encoded payload, e.g. "status" or
+// "update_state". Messages which are not listed are rejected without calling the contract.
+type MessageAllowlist struct {
+	// query messages forwarded to the query entry point of the contract
+	QueryMsgs []string `protobuf:"bytes,1,rep,name=query_msgs,json=queryMsgs,proto3" json:"query_msgs,omitempty"`
+	// sudo messages forwarded to the sudo entry point of the contract
+	SudoMsgs []string `protobuf:"bytes,2,rep,name=sudo_msgs,json=sudoMsgs,proto3" json:"sudo_msgs,omitempty"`
+}
+
+func (m *MessageAllowlist) Reset()         { *m = MessageAllowlist{} }
+func (m *MessageAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MessageAllowlist) ProtoMessage()    {}
+func (*MessageAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{3}
+}
+func (m *MessageAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageAllowlist.Merge(m, src)
+}
+func (m *MessageAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageAllowlist proto.InternalMessageInfo
+
+func (m *MessageAllowlist) GetQueryMsgs() []string {
+	if m != nil {
+		return m.QueryMsgs
+	}
+	return nil
+}
+
+func (m *MessageAllowlist) GetSudoMsgs() []string {
+	if m != nil {
+		return m.SudoMsgs
+	}
+	return nil
+}
+
 // Checksums defines a list of all checksums that are stored
 //
 // Deprecated: This message is deprecated in favor of storing the checksums
@@ -158,7 +215,7 @@ func (m *Checksums) Reset()         { *m = Checksums{} }
 func (m *Checksums) String() string { return proto.CompactTextString(m) }
 func (*Checksums) ProtoMessage()    {}
 func (*Checksums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678928ebbdee1807, []int{3}
+	return fileDescriptor_678928ebbdee1807, []int{4}
 }
 func (m *Checksums) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,6 +255,7 @@ func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.wasm.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.wasm.v1.ConsensusState")
 	proto.RegisterType((*ClientMessage)(nil), "ibc.lightclients.wasm.v1.ClientMessage")
+	proto.RegisterType((*MessageAllowlist)(nil), "ibc.lightclients.wasm.v1.MessageAllowlist")
 	proto.RegisterType((*Checksums)(nil), "ibc.lightclients.wasm.v1.Checksums")
 }
 
@@ -206,29 +264,32 @@ func init() {
 }
 
 var fileDescriptor_678928ebbdee1807 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xb6, 0x42, 0xd4, 0x6d, 0x19, 0x22, 0x86, 0x28, 0x42, 0x69, 0x55, 0x96, 0x82,
-	0x14, 0x9b, 0xc2, 0x82, 0x2a, 0xa6, 0x56, 0x48, 0x2c, 0x2c, 0x45, 0x62, 0x60, 0x41, 0x89, 0x6b,
-	0x25, 0x16, 0x49, 0x5d, 0xf5, 0x9c, 0x22, 0xde, 0x00, 0x31, 0xf1, 0x08, 0x3c, 0x4e, 0xc7, 0x8e,
-	0x4c, 0x08, 0xb5, 0x2f, 0x82, 0x6c, 0xa7, 0xc0, 0x02, 0x53, 0x2e, 0xbf, 0x3f, 0xdf, 0x7d, 0xf2,
-	0xe1, 0x43, 0x11, 0x33, 0x9a, 0x89, 0x24, 0x55, 0x2c, 0x13, 0x7c, 0xaa, 0x80, 0x3e, 0x46, 0x90,
-	0xd3, 0x45, 0xdf, 0x7c, 0xc9, 0x6c, 0x2e, 0x95, 0x74, 0x3d, 0x11, 0x33, 0xf2, 0x1b, 0x22, 0xe6,
-	0x70, 0xd1, 0xf7, 0xf7, 0x13, 0x99, 0x48, 0x03, 0x51, 0x5d, 0x59, 0xde, 0x6f, 0xeb, 0xa6, 0x4c,
-	0xce, 0x39, 0xb5, 0xbc, 0x6e, 0x67, 0x2b, 0x0b, 0x74, 0x5f, 0x10, 0x6e, 0x8c, 0x4c, 0x70, 0xa3,
-	0x22, 0xc5, 0x5d, 0x17, 0xd7, 0x26, 0x91, 0x8a, 0x3c, 0xd4, 0x41, 0xbd, 0xe6, 0xd8, 0xd4, 0xae,
-	0x8f, 0x77, 0x59, 0xca, 0xd9, 0x03, 0x14, 0xb9, 0x57, 0x31, 0xf9, 0xf7, 0xbf, 0x7b, 0x89, 0x5b,
-	0x59, 0xa4, 0x38, 0xa8, 0xfb, 0x94, 0x6b, 0x2d, 0xaf, 0xda, 0x41, 0xbd, 0xc6, 0xa9, 0x4f, 0xb4,
-	0xa8, 0x1e, 0x4c, 0xca, 0x71, 0x8b, 0x3e, 0xb9, 0x32, 0xc4, 0xb0, 0xb6, 0xfc, 0x68, 0x3b, 0xe3,
-	0xa6, 0xbd, 0x66, 0xb3, 0x41, 0xed, 0xf9, 0xad, 0xed, 0x74, 0x8f, 0xf1, 0xde, 0x48, 0x4e, 0x81,
-	0x4f, 0xa1, 0x80, 0x3f, 0x75, 0x4a, 0xf6, 0x08, 0xb7, 0xac, 0xf7, 0x35, 0x07, 0x88, 0x92, 0xff,
-	0xd0, 0x10, 0xd7, 0x47, 0xa5, 0x2f, 0xb8, 0x07, 0xb8, 0xbe, 0x95, 0x07, 0x0f, 0x75, 0xaa, 0xbd,
-	0xe6, 0xf8, 0x27, 0x18, 0x54, 0x3c, 0x34, 0xbc, 0x5d, 0xae, 0x03, 0xb4, 0x5a, 0x07, 0xe8, 0x73,
-	0x1d, 0xa0, 0xd7, 0x4d, 0xe0, 0xac, 0x36, 0x81, 0xf3, 0xbe, 0x09, 0x9c, 0xbb, 0x8b, 0x44, 0xa8,
-	0xb4, 0x88, 0x09, 0x93, 0x39, 0x65, 0x12, 0x72, 0x09, 0x54, 0xc4, 0x2c, 0x4c, 0x24, 0xcd, 0xe5,
-	0xa4, 0xc8, 0x38, 0xd8, 0xfd, 0x85, 0xdb, 0x05, 0x9e, 0x9c, 0x87, 0x66, 0x87, 0xea, 0x69, 0xc6,
-	0x21, 0xde, 0x31, 0x2f, 0x7e, 0xf6, 0x15, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x78, 0x4d, 0x63, 0xe9,
-	0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0xae, 0xd2, 0x40,
+	0x14, 0x86, 0x3b, 0x40, 0x0c, 0x1d, 0xc0, 0x98, 0x89, 0x8b, 0xa6, 0x6a, 0x21, 0xb8, 0x41, 0x93,
+	0x76, 0x44, 0x37, 0x86, 0xb8, 0x11, 0x62, 0xe2, 0x06, 0x17, 0x35, 0x71, 0xe1, 0x86, 0xb4, 0xc3,
+	0x64, 0xda, 0xd8, 0x32, 0xc8, 0x99, 0x42, 0x78, 0x03, 0xe3, 0xca, 0x47, 0xf0, 0x71, 0x58, 0xb2,
+	0x74, 0x65, 0x6e, 0xe0, 0x45, 0x6e, 0x66, 0xa6, 0xdc, 0x7b, 0x37, 0xf7, 0xae, 0x7a, 0xfa, 0xff,
+	0x5f, 0xcf, 0xf9, 0x3b, 0x73, 0xf0, 0xcb, 0x3c, 0x65, 0xb4, 0xc8, 0x45, 0xa6, 0x58, 0x91, 0xf3,
+	0x95, 0x02, 0xba, 0x4b, 0xa0, 0xa4, 0xdb, 0xb1, 0x79, 0x46, 0xeb, 0x8d, 0x54, 0x92, 0x78, 0x79,
+	0xca, 0xa2, 0xbb, 0x50, 0x64, 0xcc, 0xed, 0xd8, 0x7f, 0x2a, 0xa4, 0x90, 0x06, 0xa2, 0xba, 0xb2,
+	0xbc, 0xdf, 0xd7, 0x4d, 0x99, 0xdc, 0x70, 0x6a, 0x79, 0xdd, 0xce, 0x56, 0x16, 0x18, 0xfe, 0x46,
+	0xb8, 0x33, 0x33, 0xc2, 0x57, 0x95, 0x28, 0x4e, 0x08, 0x6e, 0x2d, 0x13, 0x95, 0x78, 0x68, 0x80,
+	0x46, 0xdd, 0xd8, 0xd4, 0xc4, 0xc7, 0x6d, 0x96, 0x71, 0xf6, 0x03, 0xaa, 0xd2, 0x6b, 0x18, 0xfd,
+	0xe6, 0x9d, 0x7c, 0xc2, 0xbd, 0x22, 0x51, 0x1c, 0xd4, 0x22, 0xe3, 0x3a, 0x96, 0xd7, 0x1c, 0xa0,
+	0x51, 0xe7, 0xad, 0x1f, 0xe9, 0xa0, 0x7a, 0x70, 0x54, 0x8f, 0xdb, 0x8e, 0xa3, 0xcf, 0x86, 0x98,
+	0xb6, 0x0e, 0xff, 0xfb, 0x4e, 0xdc, 0xb5, 0x9f, 0x59, 0x6d, 0xd2, 0xfa, 0xf5, 0xb7, 0xef, 0x0c,
+	0x5f, 0xe3, 0xc7, 0x33, 0xb9, 0x02, 0xbe, 0x82, 0x0a, 0xee, 0x8d, 0x53, 0xb3, 0xaf, 0x70, 0xcf,
+	0xe6, 0x9e, 0x73, 0x80, 0x44, 0x3c, 0x84, 0x7e, 0xc1, 0x4f, 0x6a, 0xe8, 0x63, 0x51, 0xc8, 0x5d,
+	0x91, 0x83, 0x22, 0x2f, 0x30, 0xfe, 0x59, 0xf1, 0xcd, 0x7e, 0x51, 0x82, 0x00, 0x0f, 0x0d, 0x9a,
+	0x23, 0x37, 0x76, 0x8d, 0x32, 0x07, 0x01, 0xe4, 0x19, 0x76, 0xa1, 0x5a, 0x4a, 0xeb, 0x36, 0x8c,
+	0xdb, 0xd6, 0x82, 0x36, 0x87, 0x21, 0x76, 0x67, 0xf5, 0xff, 0x03, 0x79, 0x8e, 0xdd, 0xcb, 0x61,
+	0xd8, 0x3e, 0xdd, 0xf8, 0x56, 0x98, 0x34, 0x3c, 0x34, 0xfd, 0x76, 0x38, 0x05, 0xe8, 0x78, 0x0a,
+	0xd0, 0xd5, 0x29, 0x40, 0x7f, 0xce, 0x81, 0x73, 0x3c, 0x07, 0xce, 0xbf, 0x73, 0xe0, 0x7c, 0xff,
+	0x20, 0x72, 0x95, 0x55, 0x69, 0xc4, 0x64, 0x49, 0x99, 0x84, 0x52, 0x02, 0xcd, 0x53, 0x16, 0x0a,
+	0x49, 0x4b, 0xb9, 0xac, 0x0a, 0x0e, 0x76, 0x1f, 0xc2, 0xcb, 0x42, 0xbc, 0x79, 0x1f, 0x9a, 0x9d,
+	0x50, 0xfb, 0x35, 0x87, 0xf4, 0x91, 0xb9, 0xc1, 0x77, 0xd7, 0x03, 0x00, 0x57, 0xf8, 0x20, 0x00,
+	0x39, 0x02, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -338,6 +399,47 @@ func (m *ClientMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SudoMsgs) > 0 {
+		for iNdEx := len(m.SudoMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SudoMsgs[iNdEx])
+			copy(dAtA[i:], m.SudoMsgs[iNdEx])
+			i = encodeVarintWasm(dAtA, i, uint64(len(m.SudoMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.QueryMsgs) > 0 {
+		for iNdEx := len(m.QueryMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryMsgs[iNdEx])
+			copy(dAtA[i:], m.QueryMsgs[iNdEx])
+			i = encodeVarintWasm(dAtA, i, uint64(len(m.QueryMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Checksums) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -426,6 +528,27 @@ func (m *ClientMessage) Size() (n int) {
 	return n
 }
 
+func (m *MessageAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueryMsgs) > 0 {
+		for _, s := range m.QueryMsgs {
+			l = len(s)
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	if len(m.SudoMsgs) > 0 {
+		for _, s := range m.SudoMsgs {
+			l = len(s)
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Checksums) Size() (n int) {
 	if m == nil {
 		return 0
@@ -766,6 +889,120 @@ func (m *ClientMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MessageAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryMsgs = append(m.QueryMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoMsgs = append(m.SudoMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Checksums) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
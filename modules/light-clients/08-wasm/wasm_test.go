@@ -126,3 +126,75 @@ func storeWasmCode(suite *WasmTestSuite, wasmCode []byte) types.Checksum {
 	suite.Require().NotNil(response.Checksum)
 	return response.Checksum
 }
+
+// TestWasmConfig creates and updates an 08-wasm client of a counterparty chain through the
+// ibctesting endpoint using a WasmConfig backed by a tendermint header source.
+func (suite *WasmTestSuite) TestWasmConfig() {
+	ibctesting.DefaultTestingAppInit = suite.setupWasmWithMockVM
+
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.checksum = storeWasmCode(suite, wasmtesting.Code)
+
+	suite.mockVM.RegisterQueryCallback(types.VerifyClientMessageMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		resp, err := json.Marshal(types.EmptyResult{})
+		suite.Require().NoError(err)
+		return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	suite.mockVM.RegisterQueryCallback(types.CheckForMisbehaviourMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		resp, err := json.Marshal(types.CheckForMisbehaviourResult{FoundMisbehaviour: false})
+		suite.Require().NoError(err)
+		return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		var payload types.SudoMsg
+		err := json.Unmarshal(sudoMsg, &payload)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(payload.UpdateState)
+
+		cdc := suite.chainA.App.AppCodec()
+		clientMessage, err := clienttypes.UnmarshalClientMessage(cdc, payload.UpdateState.ClientMessage)
+		suite.Require().NoError(err)
+
+		header, ok := clientMessage.(*ibctm.Header)
+		suite.Require().True(ok)
+
+		height, ok := header.GetHeight().(clienttypes.Height)
+		suite.Require().True(ok)
+
+		clientState, ok := clienttypes.MustUnmarshalClientState(cdc, store.Get(host.ClientStateKey())).(*types.ClientState)
+		suite.Require().True(ok)
+
+		clientState.LatestHeight = height
+		store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, clientState))
+
+		consensusStateBz := clienttypes.MustMarshalConsensusState(cdc, header.ConsensusState())
+		store.Set(host.ConsensusStateKey(height), clienttypes.MustMarshalConsensusState(cdc, types.NewConsensusState(consensusStateBz)))
+
+		resp, err := json.Marshal(types.UpdateStateResult{Heights: []clienttypes.Height{height}})
+		suite.Require().NoError(err)
+
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	path := ibctesting.NewPath(suite.chainA, chainB)
+	path.EndpointA.ClientConfig = wasmtesting.NewWasmConfig(suite.checksum)
+
+	err := path.EndpointA.CreateClient()
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Wasm, path.EndpointA.ClientConfig.GetClientType())
+
+	clientState, ok := path.EndpointA.GetClientState().(*types.ClientState)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.checksum, types.Checksum(clientState.Checksum))
+
+	initialHeight := clientState.LatestHeight
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	suite.Require().True(path.EndpointA.GetClientLatestHeight().GT(initialHeight))
+}
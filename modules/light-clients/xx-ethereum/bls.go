@@ -0,0 +1,79 @@
+package ethereum
+
+import (
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// blsSignatureDST is the domain separation tag of the BLS signature scheme used by the beacon chain, which signs
+// messages in G2 with proof of possession.
+var blsSignatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// decodePubkey decodes a compressed BLS public key. The point is checked to be in the G1 subgroup and to not be the
+// point at infinity.
+func decodePubkey(bz []byte) (bls12381.G1Affine, error) {
+	var pubkey bls12381.G1Affine
+	if len(bz) != blsPubkeyLength {
+		return pubkey, errorsmod.Wrapf(ErrInvalidSyncCommittee, "public key must be %d bytes, got %d", blsPubkeyLength, len(bz))
+	}
+
+	if _, err := pubkey.SetBytes(bz); err != nil {
+		return pubkey, errorsmod.Wrapf(ErrInvalidSyncCommittee, "failed to decode public key: %s", err)
+	}
+
+	if pubkey.IsInfinity() {
+		return pubkey, errorsmod.Wrap(ErrInvalidSyncCommittee, "public key cannot be the point at infinity")
+	}
+
+	return pubkey, nil
+}
+
+// fastAggregateVerify verifies the aggregate signature of the given public keys over the message, as specified by
+// FastAggregateVerify of the BLS signature standard.
+func fastAggregateVerify(pubkeys [][]byte, msg []byte, signature []byte) error {
+	if len(pubkeys) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignature, "no public keys to verify the signature with")
+	}
+
+	var aggregate bls12381.G1Jac
+	for _, bz := range pubkeys {
+		pubkey, err := decodePubkey(bz)
+		if err != nil {
+			return err
+		}
+		aggregate.AddMixed(&pubkey)
+	}
+
+	var aggregatePubkey bls12381.G1Affine
+	aggregatePubkey.FromJacobian(&aggregate)
+
+	if len(signature) != blsSignatureLength {
+		return errorsmod.Wrapf(ErrInvalidSignature, "signature must be %d bytes, got %d", blsSignatureLength, len(signature))
+	}
+
+	var sig bls12381.G2Affine
+	if _, err := sig.SetBytes(signature); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSignature, "failed to decode signature: %s", err)
+	}
+
+	msgPoint, err := bls12381.HashToG2(msg, blsSignatureDST)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidSignature, "failed to hash message: %s", err)
+	}
+
+	// e(aggregatePubkey, H(msg)) == e(g1, sig) is checked as e(aggregatePubkey, H(msg)) * e(-g1, sig) == 1
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{aggregatePubkey, negG1}, []bls12381.G2Affine{msgPoint, sig})
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidSignature, "failed to check pairing: %s", err)
+	}
+	if !ok {
+		return errorsmod.Wrap(ErrInvalidSignature, "sync committee signature verification failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package ethereum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ethereum "github.com/cosmos/ibc-go/modules/light-clients/xx-ethereum"
+)
+
+func TestFastAggregateVerify(t *testing.T) {
+	committee := newSyncCommittee(1)
+	msg := []byte("message")
+
+	var (
+		pubkeys   [][]byte
+		signature []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: single signer",
+			func() {
+				pubkeys = pubkeys[:1]
+				signature = signBLS(committee.secretKeys[:1], msg)
+			},
+			nil,
+		},
+		{
+			"failure: missing signer",
+			func() {
+				pubkeys = pubkeys[1:]
+			},
+			ethereum.ErrInvalidSignature,
+		},
+		{
+			"failure: wrong message",
+			func() {
+				signature = signBLS(committee.secretKeys, []byte("other message"))
+			},
+			ethereum.ErrInvalidSignature,
+		},
+		{
+			"failure: no public keys",
+			func() {
+				pubkeys = nil
+			},
+			ethereum.ErrInvalidSignature,
+		},
+		{
+			"failure: public key is the point at infinity",
+			func() {
+				infinity := make([]byte, 48)
+				infinity[0] = 0xc0
+				pubkeys[0] = infinity
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: public key is not on the curve",
+			func() {
+				pubkeys[0] = append([]byte{0x80}, make([]byte, 47)...)
+				pubkeys[0][47] = 0x01
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: invalid signature length",
+			func() {
+				signature = signature[:48]
+			},
+			ethereum.ErrInvalidSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			pubkeys = append([][]byte{}, committee.committee.Pubkeys...)
+			signature = signBLS(committee.secretKeys, msg)
+
+			tc.malleate()
+
+			err := ethereum.FastAggregateVerify(pubkeys, msg, signature)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package ethereum
+
+import (
+	"bytes"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+const (
+	// contractAddressLength is the length in bytes of execution layer addresses.
+	contractAddressLength = 20
+	// storageSlotLength is the length in bytes of execution layer storage slots.
+	storageSlotLength = 32
+)
+
+// NewClientState creates a new ClientState instance
+func NewClientState(
+	chainID uint64, genesisValidatorsRoot []byte, genesisTime uint64, forkParameters ForkParameters,
+	secondsPerSlot, slotsPerEpoch, epochsPerSyncCommitteePeriod, syncCommitteeSize uint64,
+	ibcContractAddress, ibcCommitmentSlot []byte, latestHeight clienttypes.Height,
+) *ClientState {
+	return &ClientState{
+		ChainId:                      chainID,
+		GenesisValidatorsRoot:        genesisValidatorsRoot,
+		GenesisTime:                  genesisTime,
+		ForkParameters:               forkParameters,
+		SecondsPerSlot:               secondsPerSlot,
+		SlotsPerEpoch:                slotsPerEpoch,
+		EpochsPerSyncCommitteePeriod: epochsPerSyncCommitteePeriod,
+		SyncCommitteeSize:            syncCommitteeSize,
+		IbcContractAddress:           ibcContractAddress,
+		IbcCommitmentSlot:            ibcCommitmentSlot,
+		LatestHeight:                 latestHeight,
+		FrozenHeight:                 clienttypes.ZeroHeight(),
+	}
+}
+
+// ClientType is Ethereum.
+func (ClientState) ClientType() string {
+	return exported.Ethereum
+}
+
+// GetChainID returns the chain id of the execution layer as a decimal string.
+func (cs ClientState) GetChainID() string {
+	return strconv.FormatUint(cs.ChainId, 10)
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if cs.ChainId == 0 {
+		return errorsmod.Wrap(ErrInvalidChainID, "chain id cannot be 0")
+	}
+	if len(cs.GenesisValidatorsRoot) != rootLength {
+		return errorsmod.Wrapf(ErrInvalidChainSpec, "genesis validators root must be %d bytes, got %d", rootLength, len(cs.GenesisValidatorsRoot))
+	}
+	if cs.GenesisTime == 0 {
+		return errorsmod.Wrap(ErrInvalidChainSpec, "genesis time cannot be 0")
+	}
+	if err := cs.ForkParameters.Validate(); err != nil {
+		return err
+	}
+	if cs.SecondsPerSlot == 0 {
+		return errorsmod.Wrap(ErrInvalidChainSpec, "seconds per slot cannot be 0")
+	}
+	if cs.SlotsPerEpoch == 0 {
+		return errorsmod.Wrap(ErrInvalidChainSpec, "slots per epoch cannot be 0")
+	}
+	if cs.EpochsPerSyncCommitteePeriod == 0 {
+		return errorsmod.Wrap(ErrInvalidChainSpec, "epochs per sync committee period cannot be 0")
+	}
+	if cs.SyncCommitteeSize == 0 || cs.SyncCommitteeSize%8 != 0 {
+		return errorsmod.Wrapf(ErrInvalidChainSpec, "sync committee size must be a positive multiple of 8, got %d", cs.SyncCommitteeSize)
+	}
+	if len(cs.IbcContractAddress) != contractAddressLength {
+		return errorsmod.Wrapf(ErrInvalidChainSpec, "IBC contract address must be %d bytes, got %d", contractAddressLength, len(cs.IbcContractAddress))
+	}
+	if len(cs.IbcCommitmentSlot) != storageSlotLength {
+		return errorsmod.Wrapf(ErrInvalidChainSpec, "IBC commitment slot must be %d bytes, got %d", storageSlotLength, len(cs.IbcCommitmentSlot))
+	}
+	if cs.LatestHeight.RevisionNumber != 0 || cs.LatestHeight.RevisionHeight == 0 {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "latest height must have revision number 0 and a non-zero revision height, got %s", cs.LatestHeight)
+	}
+
+	return nil
+}
+
+// computeEpoch returns the epoch of the given slot.
+func (cs ClientState) computeEpoch(slot uint64) uint64 {
+	return slot / cs.SlotsPerEpoch
+}
+
+// computeSyncCommitteePeriod returns the sync committee period of the given slot.
+func (cs ClientState) computeSyncCommitteePeriod(slot uint64) uint64 {
+	return cs.computeEpoch(slot) / cs.EpochsPerSyncCommitteePeriod
+}
+
+// computeTimestamp returns the unix time in nanoseconds of the given slot.
+func (cs ClientState) computeTimestamp(slot uint64) uint64 {
+	return (cs.GenesisTime + slot*cs.SecondsPerSlot) * 1e9
+}
+
+// currentSlot returns the beacon chain slot at the block time of the context. Zero is returned before genesis.
+func (cs ClientState) currentSlot(ctx sdk.Context) uint64 {
+	blockTime := uint64(ctx.BlockTime().Unix())
+	if blockTime < cs.GenesisTime {
+		return 0
+	}
+
+	return (blockTime - cs.GenesisTime) / cs.SecondsPerSlot
+}
+
+// status returns the status of the ethereum client.
+// The client may be:
+// - Active: if frozen height is zero and the sync committee of the current period can be known from the latest consensus state.
+// - Frozen: if frozen height is not zero.
+// - Expired: if the current period is later than the period following the period of the latest consensus state, or if the
+// latest consensus state cannot be found.
+func (cs ClientState) status(ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec) exported.Status {
+	if !cs.FrozenHeight.IsZero() {
+		return exported.Frozen
+	}
+
+	if _, found := GetConsensusState(clientStore, cdc, cs.LatestHeight); !found {
+		return exported.Expired
+	}
+
+	if cs.computeSyncCommitteePeriod(cs.currentSlot(ctx)) > cs.computeSyncCommitteePeriod(cs.LatestHeight.RevisionHeight)+1 {
+		return exported.Expired
+	}
+
+	return exported.Active
+}
+
+// initialize checks that the initial consensus state is an ethereum consensus state and sets the client state,
+// consensus state and associated metadata in the provided client store.
+func (cs ClientState) initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, consState exported.ConsensusState) error {
+	consensusState, ok := consState.(*ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, consState)
+	}
+
+	setClientState(clientStore, cdc, &cs)
+	setConsensusState(clientStore, cdc, consensusState, cs.LatestHeight)
+	setConsensusMetadata(ctx, clientStore, cs.LatestHeight)
+
+	return nil
+}
+
+// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given
+// CommitmentPath at the specified height. The value is expected to be committed by the IBC contract, which stores the
+// keccak256 hash of the value in its commitments mapping under the keccak256 hash of the last key of the path.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	storedValue, err := cs.verifyCommitment(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if storedValue == nil {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "commitment does not exist in the IBC contract storage")
+	}
+
+	if !bytes.Equal(storedValue, keccak256(value)) {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "stored commitment %X does not match the hash of the value %X", storedValue, keccak256(value))
+	}
+
+	return nil
+}
+
+// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a
+// specified height, i.e. that the commitment slot of the path is empty in the IBC contract storage.
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyNonMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	storedValue, err := cs.verifyCommitment(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if storedValue != nil {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "commitment %X exists in the IBC contract storage", storedValue)
+	}
+
+	return nil
+}
+
+// verifyCommitment verifies the storage proof of the commitment slot of the path against the storage root of the
+// IBC contract at the given height and returns the value stored in the slot, or nil if the slot is empty.
+func (cs ClientState) verifyCommitment(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) ([]byte, error) {
+	if cs.LatestHeight.LT(height) {
+		return nil, errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight, height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return nil, err
+	}
+
+	var storageProof StorageProof
+	if err := cdc.Unmarshal(proof, &storageProof); err != nil {
+		return nil, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ethereum storage proof")
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	if len(merklePath.GetKeyPath()) == 0 {
+		return nil, errorsmod.Wrap(host.ErrInvalidPath, "path cannot be empty")
+	}
+
+	// the IBC contract commits to the ICS 24 path without the counterparty commitment prefix
+	key, err := merklePath.GetKey(uint64(len(merklePath.GetKeyPath()) - 1))
+	if err != nil {
+		return nil, errorsmod.Wrapf(host.ErrInvalidPath, "failed to get last key of path: %v", err)
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	storedValue, err := verifyStorageValue(consensusState.StorageRoot, cs.commitmentStorageSlot(key), storageProof.Proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, err.Error())
+	}
+
+	return storedValue, nil
+}
+
+// commitmentStorageSlot returns the storage slot of the commitment of the given key in the commitments mapping of the
+// IBC contract, as laid out by solidity for mappings: keccak256(keccak256(key) ++ commitmentSlot).
+func (cs ClientState) commitmentStorageSlot(key []byte) []byte {
+	return keccak256(keccak256(key), cs.IbcCommitmentSlot)
+}
+
+// verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
+// since consensus state was submitted before allowing verification to continue.
+func verifyDelayPeriodPassed(ctx sdk.Context, store storetypes.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
+	if delayTimePeriod != 0 {
+		// check that executing chain's timestamp has passed consensusState's processed time + delay time period
+		processedTime, ok := getProcessedTime(store, proofHeight)
+		if !ok {
+			return errorsmod.Wrapf(ErrProcessedTimeNotFound, "processed time not found for height: %s", proofHeight)
+		}
+
+		currentTimestamp := uint64(ctx.BlockTime().UnixNano())
+		validTime := processedTime + delayTimePeriod
+
+		// NOTE: delay time period is inclusive, so if currentTimestamp is validTime, then we return no error
+		if currentTimestamp < validTime {
+			return errorsmod.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until time: %d, current time: %d",
+				validTime, currentTimestamp)
+		}
+	}
+
+	if delayBlockPeriod != 0 {
+		// check that executing chain's height has passed consensusState's processed height + delay block period
+		processedHeight, ok := getProcessedHeight(store, proofHeight)
+		if !ok {
+			return errorsmod.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height: %s", proofHeight)
+		}
+
+		currentHeight := clienttypes.GetSelfHeight(ctx)
+		validHeight := clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight()+delayBlockPeriod)
+
+		// NOTE: delay block period is inclusive, so if currentHeight is validHeight, then we return no error
+		if currentHeight.LT(validHeight) {
+			return errorsmod.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until height: %s, current height: %s",
+				validHeight, currentHeight)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package ethereum_test
+
+import (
+	"math"
+	"time"
+
+	ethereum "github.com/cosmos/ibc-go/modules/light-clients/xx-ethereum"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+func (suite *EthereumTestSuite) TestValidate() {
+	var clientState *ethereum.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"valid client",
+			func() {},
+			nil,
+		},
+		{
+			"invalid chain id",
+			func() {
+				clientState.ChainId = 0
+			},
+			ethereum.ErrInvalidChainID,
+		},
+		{
+			"invalid genesis validators root",
+			func() {
+				clientState.GenesisValidatorsRoot = []byte{0x01}
+			},
+			ethereum.ErrInvalidChainSpec,
+		},
+		{
+			"invalid fork version",
+			func() {
+				clientState.ForkParameters.Capella.Version = []byte{0x03}
+			},
+			ethereum.ErrInvalidForkParameters,
+		},
+		{
+			"fork epochs are decreasing",
+			func() {
+				clientState.ForkParameters.Altair.Epoch = math.MaxUint64
+			},
+			ethereum.ErrInvalidForkParameters,
+		},
+		{
+			"invalid seconds per slot",
+			func() {
+				clientState.SecondsPerSlot = 0
+			},
+			ethereum.ErrInvalidChainSpec,
+		},
+		{
+			"sync committee size is not a multiple of 8",
+			func() {
+				clientState.SyncCommitteeSize = 30
+			},
+			ethereum.ErrInvalidChainSpec,
+		},
+		{
+			"invalid IBC contract address",
+			func() {
+				clientState.IbcContractAddress = make([]byte, 32)
+			},
+			ethereum.ErrInvalidChainSpec,
+		},
+		{
+			"invalid IBC commitment slot",
+			func() {
+				clientState.IbcCommitmentSlot = nil
+			},
+			ethereum.ErrInvalidChainSpec,
+		},
+		{
+			"invalid latest height",
+			func() {
+				clientState.LatestHeight = clienttypes.NewHeight(1, initialSlot)
+			},
+			clienttypes.ErrInvalidHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			clientState = suite.clientState()
+
+			tc.malleate()
+
+			err := clientState.Validate()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestStatus() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"client is active",
+			func() {},
+			exported.Active,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+
+				clientState.(*ethereum.ClientState).FrozenHeight = ethereum.FrozenHeight
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			exported.Frozen,
+		},
+		{
+			"client is expired after the period following the latest period",
+			func() {
+				suite.coordinator.IncrementTimeBy(slotsPerPeriod * secondsPerSlot * time.Second)
+			},
+			exported.Expired,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = clienttypes.FormatClientIdentifier(exported.Ethereum, 999)
+			},
+			exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+
+			tc.malleate()
+
+			status := suite.lightClientModule(clientID).Status(suite.chainA.GetContext(), clientID)
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestVerifyMembership() {
+	var (
+		clientID         string
+		proofHeight      exported.Height
+		delayTimePeriod  uint64
+		path             exported.Path
+		value            []byte
+		proof            []byte
+		verifyMembership bool
+	)
+
+	commitmentKey := host.PacketCommitmentKey("transfer", "channel-0", 1)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: membership",
+			func() {},
+			nil,
+		},
+		{
+			"success: membership with prefixed path",
+			func() {
+				path = commitmenttypes.NewMerklePath("ibc", string(commitmentKey))
+			},
+			nil,
+		},
+		{
+			"success: non-membership",
+			func() {
+				path = commitmenttypes.NewMerklePath(string(host.PacketCommitmentKey("transfer", "channel-0", 2)))
+				verifyMembership = false
+			},
+			nil,
+		},
+		{
+			"failure: value does not match the commitment",
+			func() {
+				value = []byte("other commitment")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: commitment does not exist",
+			func() {
+				path = commitmenttypes.NewMerklePath(string(host.PacketCommitmentKey("transfer", "channel-0", 2)))
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: non-membership of existing commitment",
+			func() {
+				verifyMembership = false
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: proof height is greater than the latest height",
+			func() {
+				proofHeight = clienttypes.NewHeight(0, 111)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: consensus state not found",
+			func() {
+				proofHeight = clienttypes.NewHeight(0, 105)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: delay time period has not passed",
+			func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			ethereum.ErrDelayPeriodNotPassed,
+		},
+		{
+			"failure: proof cannot be unmarshalled",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: proof is not against the storage root",
+			func() {
+				proof = suite.chainA.Codec.MustMarshal(&ethereum.StorageProof{})
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setCommitment(commitmentKey, []byte("commitment"))
+			suite.setCommitment(host.PacketCommitmentKey("transfer", "channel-1", 1), []byte("commitment"))
+
+			clientID = suite.createClient()
+			header := suite.newHeader(initialSlot, 110)
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+			suite.Require().NoError(err)
+
+			_, storageNodes := suite.storageTrie()
+			proof = suite.chainA.Codec.MustMarshal(&ethereum.StorageProof{Proof: storageNodes})
+			proofHeight = header.GetHeight()
+			delayTimePeriod = 0
+			path = commitmenttypes.NewMerklePath(string(commitmentKey))
+			value = []byte("commitment")
+			verifyMembership = true
+
+			tc.malleate()
+
+			lightClientModule := suite.lightClientModule(clientID)
+			if verifyMembership {
+				err = lightClientModule.VerifyMembership(suite.chainA.GetContext(), clientID, proofHeight, delayTimePeriod, 0, proof, path, value)
+			} else {
+				err = lightClientModule.VerifyNonMembership(suite.chainA.GetContext(), clientID, proofHeight, delayTimePeriod, 0, proof, path)
+			}
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
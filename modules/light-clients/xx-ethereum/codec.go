@@ -0,0 +1,28 @@
+package ethereum
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// RegisterInterfaces registers the ethereum concrete client-related
+// implementations and interfaces.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*exported.ClientState)(nil),
+		&ClientState{},
+	)
+	registry.RegisterImplementations(
+		(*exported.ConsensusState)(nil),
+		&ConsensusState{},
+	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&Header{},
+	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&Misbehaviour{},
+	)
+}
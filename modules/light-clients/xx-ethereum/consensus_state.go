@@ -0,0 +1,53 @@
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(timestamp uint64, stateRoot, storageRoot, currentSyncCommittee, nextSyncCommittee []byte) *ConsensusState {
+	return &ConsensusState{
+		Timestamp:            timestamp,
+		StateRoot:            stateRoot,
+		StorageRoot:          storageRoot,
+		CurrentSyncCommittee: currentSyncCommittee,
+		NextSyncCommittee:    nextSyncCommittee,
+	}
+}
+
+// ClientType returns Ethereum.
+func (ConsensusState) ClientType() string {
+	return exported.Ethereum
+}
+
+// GetTimestamp returns the timestamp (in nanoseconds) of the consensus state.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines a basic validation for the ethereum consensus state.
+// The next sync committee may be empty if it is not known yet.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Timestamp == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
+	}
+	if len(cs.StateRoot) != rootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "state root must be %d bytes, got %d", rootLength, len(cs.StateRoot))
+	}
+	if len(cs.StorageRoot) != rootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "storage root must be %d bytes, got %d", rootLength, len(cs.StorageRoot))
+	}
+	if len(cs.CurrentSyncCommittee) != rootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "current sync committee must be %d bytes, got %d", rootLength, len(cs.CurrentSyncCommittee))
+	}
+	if len(cs.NextSyncCommittee) != 0 && len(cs.NextSyncCommittee) != rootLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "next sync committee must be empty or %d bytes, got %d", rootLength, len(cs.NextSyncCommittee))
+	}
+
+	return nil
+}
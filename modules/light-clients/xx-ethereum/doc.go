@@ -0,0 +1,9 @@
+/*
+Package ethereum implements a concrete LightClientModule, ClientState, ConsensusState,
+Header, Misbehaviour and types for the Ethereum beacon chain light client.
+Headers are verified using the sync committee light client protocol specified for the
+Altair fork of the beacon chain (https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md),
+and membership of IBC commitments is verified using Merkle-Patricia trie proofs of the
+storage of the IBC contract on the execution layer.
+*/
+package ethereum
@@ -0,0 +1,23 @@
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IBC ethereum client sentinel errors
+var (
+	ErrInvalidChainID            = errorsmod.Register(ModuleName, 2, "invalid chain id")
+	ErrInvalidForkParameters     = errorsmod.Register(ModuleName, 3, "invalid fork parameters")
+	ErrInvalidChainSpec          = errorsmod.Register(ModuleName, 4, "invalid chain specification")
+	ErrInvalidHeader             = errorsmod.Register(ModuleName, 5, "invalid header")
+	ErrInvalidSyncCommittee      = errorsmod.Register(ModuleName, 6, "invalid sync committee")
+	ErrInsufficientParticipation = errorsmod.Register(ModuleName, 7, "insufficient sync committee participation")
+	ErrInvalidSignature          = errorsmod.Register(ModuleName, 8, "invalid sync committee signature")
+	ErrInvalidMerkleBranch       = errorsmod.Register(ModuleName, 9, "invalid merkle branch")
+	ErrInvalidTrieProof          = errorsmod.Register(ModuleName, 10, "invalid merkle-patricia trie proof")
+	ErrUnsupportedFork           = errorsmod.Register(ModuleName, 11, "unsupported beacon chain fork")
+	ErrProcessedTimeNotFound     = errorsmod.Register(ModuleName, 12, "processed time not found")
+	ErrProcessedHeightNotFound   = errorsmod.Register(ModuleName, 13, "processed height not found")
+	ErrDelayPeriodNotPassed      = errorsmod.Register(ModuleName, 14, "packet-specified delay period has not been reached")
+	ErrInvalidMisbehaviour       = errorsmod.Register(ModuleName, 15, "invalid misbehaviour")
+)
@@ -0,0 +1,4176 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/lightclients/ethereum/v1/ethereum.proto
+
+package ethereum
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClientState from Ethereum tracks the beacon chain configuration, the address
+// of the IBC contract on the execution layer, the latest height and a possible
+// frozen height. The revision height of client heights is the beacon chain slot
+// of the finalized header the consensus state was created for.
+type ClientState struct {
+	// chain id of the execution layer
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// root of the genesis validators of the beacon chain, used to compute
+	// signature domains
+	GenesisValidatorsRoot []byte `protobuf:"bytes,2,opt,name=genesis_validators_root,json=genesisValidatorsRoot,proto3" json:"genesis_validators_root,omitempty"`
+	// unix time in seconds of the beacon chain genesis
+	GenesisTime uint64 `protobuf:"varint,3,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	// fork versions and epochs of the beacon chain
+	ForkParameters ForkParameters `protobuf:"bytes,4,opt,name=fork_parameters,json=forkParameters,proto3" json:"fork_parameters"`
+	// duration of a slot in seconds
+	SecondsPerSlot uint64 `protobuf:"varint,5,opt,name=seconds_per_slot,json=secondsPerSlot,proto3" json:"seconds_per_slot,omitempty"`
+	// number of slots per epoch
+	SlotsPerEpoch uint64 `protobuf:"varint,6,opt,name=slots_per_epoch,json=slotsPerEpoch,proto3" json:"slots_per_epoch,omitempty"`
+	// number of epochs per sync committee period
+	EpochsPerSyncCommitteePeriod uint64 `protobuf:"varint,7,opt,name=epochs_per_sync_committee_period,json=epochsPerSyncCommitteePeriod,proto3" json:"epochs_per_sync_committee_period,omitempty"`
+	// number of members of a sync committee
+	SyncCommitteeSize uint64 `protobuf:"varint,8,opt,name=sync_committee_size,json=syncCommitteeSize,proto3" json:"sync_committee_size,omitempty"`
+	// address of the IBC contract on the execution layer
+	IbcContractAddress []byte `protobuf:"bytes,9,opt,name=ibc_contract_address,json=ibcContractAddress,proto3" json:"ibc_contract_address,omitempty"`
+	// storage slot of the IBC commitments mapping of the IBC contract
+	IbcCommitmentSlot []byte `protobuf:"bytes,10,opt,name=ibc_commitment_slot,json=ibcCommitmentSlot,proto3" json:"ibc_commitment_slot,omitempty"`
+	// Latest height the client was updated to
+	LatestHeight types.Height `protobuf:"bytes,11,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height"`
+	// Height at which the client was frozen due to a misbehaviour
+	FrozenHeight types.Height `protobuf:"bytes,12,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height"`
+}
+
+func (m *ClientState) Reset()         { *m = ClientState{} }
+func (m *ClientState) String() string { return proto.CompactTextString(m) }
+func (*ClientState) ProtoMessage()    {}
+func (*ClientState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{0}
+}
+func (m *ClientState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientState.Merge(m, src)
+}
+func (m *ClientState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientState proto.InternalMessageInfo
+
+// ForkParameters defines the fork versions of the beacon chain and the epochs
+// at which the forks were activated.
+type ForkParameters struct {
+	GenesisForkVersion []byte `protobuf:"bytes,1,opt,name=genesis_fork_version,json=genesisForkVersion,proto3" json:"genesis_fork_version,omitempty"`
+	Altair             Fork   `protobuf:"bytes,2,opt,name=altair,proto3" json:"altair"`
+	Bellatrix          Fork   `protobuf:"bytes,3,opt,name=bellatrix,proto3" json:"bellatrix"`
+	Capella            Fork   `protobuf:"bytes,4,opt,name=capella,proto3" json:"capella"`
+	Deneb              Fork   `protobuf:"bytes,5,opt,name=deneb,proto3" json:"deneb"`
+	Electra            Fork   `protobuf:"bytes,6,opt,name=electra,proto3" json:"electra"`
+}
+
+func (m *ForkParameters) Reset()         { *m = ForkParameters{} }
+func (m *ForkParameters) String() string { return proto.CompactTextString(m) }
+func (*ForkParameters) ProtoMessage()    {}
+func (*ForkParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{1}
+}
+func (m *ForkParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkParameters.Merge(m, src)
+}
+func (m *ForkParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkParameters proto.InternalMessageInfo
+
+func (m *ForkParameters) GetGenesisForkVersion() []byte {
+	if m != nil {
+		return m.GenesisForkVersion
+	}
+	return nil
+}
+
+func (m *ForkParameters) GetAltair() Fork {
+	if m != nil {
+		return m.Altair
+	}
+	return Fork{}
+}
+
+func (m *ForkParameters) GetBellatrix() Fork {
+	if m != nil {
+		return m.Bellatrix
+	}
+	return Fork{}
+}
+
+func (m *ForkParameters) GetCapella() Fork {
+	if m != nil {
+		return m.Capella
+	}
+	return Fork{}
+}
+
+func (m *ForkParameters) GetDeneb() Fork {
+	if m != nil {
+		return m.Deneb
+	}
+	return Fork{}
+}
+
+func (m *ForkParameters) GetElectra() Fork {
+	if m != nil {
+		return m.Electra
+	}
+	return Fork{}
+}
+
+// Fork defines the version of a beacon chain fork and the epoch at which it was
+// activated.
+type Fork struct {
+	Version []byte `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Epoch   uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *Fork) Reset()         { *m = Fork{} }
+func (m *Fork) String() string { return proto.CompactTextString(m) }
+func (*Fork) ProtoMessage()    {}
+func (*Fork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{2}
+}
+func (m *Fork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fork.Merge(m, src)
+}
+func (m *Fork) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fork) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fork proto.InternalMessageInfo
+
+func (m *Fork) GetVersion() []byte {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *Fork) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// ConsensusState defines the consensus state from Ethereum.
+type ConsensusState struct {
+	// unix time in nanoseconds of the slot of the finalized header
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// state root of the execution payload of the finalized header
+	StateRoot []byte `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// storage root of the IBC contract in the execution state
+	StorageRoot []byte `protobuf:"bytes,3,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	// hash tree root of the sync committee of the period of the finalized header
+	CurrentSyncCommittee []byte `protobuf:"bytes,4,opt,name=current_sync_committee,json=currentSyncCommittee,proto3" json:"current_sync_committee,omitempty"`
+	// hash tree root of the sync committee of the period following the period of
+	// the finalized header, empty if it is not known
+	NextSyncCommittee []byte `protobuf:"bytes,5,opt,name=next_sync_committee,json=nextSyncCommittee,proto3" json:"next_sync_committee,omitempty"`
+}
+
+func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
+func (m *ConsensusState) String() string { return proto.CompactTextString(m) }
+func (*ConsensusState) ProtoMessage()    {}
+func (*ConsensusState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{3}
+}
+func (m *ConsensusState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusState.Merge(m, src)
+}
+func (m *ConsensusState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
+
+// Header defines an Ethereum client update. It contains a light client update
+// signed by the sync committee of the period of the trusted consensus state or
+// of the following period, and a proof of the storage root of the IBC contract
+// in the execution state of the finalized header.
+type Header struct {
+	// height of the trusted consensus state
+	TrustedHeight types.Height `protobuf:"bytes,1,opt,name=trusted_height,json=trustedHeight,proto3" json:"trusted_height"`
+	// sync committee which signed the update
+	SyncCommittee   *SyncCommittee     `protobuf:"bytes,2,opt,name=sync_committee,json=syncCommittee,proto3" json:"sync_committee,omitempty"`
+	ConsensusUpdate *LightClientUpdate `protobuf:"bytes,3,opt,name=consensus_update,json=consensusUpdate,proto3" json:"consensus_update,omitempty"`
+	AccountProof    *AccountProof      `protobuf:"bytes,4,opt,name=account_proof,json=accountProof,proto3" json:"account_proof,omitempty"`
+}
+
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{4}
+}
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+// LightClientUpdate defines a beacon chain light client update, as specified
+// by the Altair light client sync protocol, for a finalized header.
+type LightClientUpdate struct {
+	// header attested to by the sync committee
+	AttestedHeader *BeaconBlockHeader `protobuf:"bytes,1,opt,name=attested_header,json=attestedHeader,proto3" json:"attested_header,omitempty"`
+	// sync committee of the period following the period of the attested header,
+	// if it is included in the update
+	NextSyncCommittee *SyncCommittee `protobuf:"bytes,2,opt,name=next_sync_committee,json=nextSyncCommittee,proto3" json:"next_sync_committee,omitempty"`
+	// proof of the next sync committee in the state of the attested header
+	NextSyncCommitteeBranch [][]byte `protobuf:"bytes,3,rep,name=next_sync_committee_branch,json=nextSyncCommitteeBranch,proto3" json:"next_sync_committee_branch,omitempty"`
+	// finalized header
+	FinalizedHeader *BeaconBlockHeader `protobuf:"bytes,4,opt,name=finalized_header,json=finalizedHeader,proto3" json:"finalized_header,omitempty"`
+	// proof of the finalized checkpoint in the state of the attested header
+	FinalityBranch [][]byte `protobuf:"bytes,5,rep,name=finality_branch,json=finalityBranch,proto3" json:"finality_branch,omitempty"`
+	// state root of the execution payload of the finalized header
+	ExecutionStateRoot []byte `protobuf:"bytes,6,opt,name=execution_state_root,json=executionStateRoot,proto3" json:"execution_state_root,omitempty"`
+	// proof of the execution state root in the body of the finalized header
+	ExecutionStateRootBranch [][]byte `protobuf:"bytes,7,rep,name=execution_state_root_branch,json=executionStateRootBranch,proto3" json:"execution_state_root_branch,omitempty"`
+	// aggregate signature of the sync committee over the attested header
+	SyncAggregate *SyncAggregate `protobuf:"bytes,8,opt,name=sync_aggregate,json=syncAggregate,proto3" json:"sync_aggregate,omitempty"`
+	// slot at which the aggregate signature was created
+	SignatureSlot uint64 `protobuf:"varint,9,opt,name=signature_slot,json=signatureSlot,proto3" json:"signature_slot,omitempty"`
+}
+
+func (m *LightClientUpdate) Reset()         { *m = LightClientUpdate{} }
+func (m *LightClientUpdate) String() string { return proto.CompactTextString(m) }
+func (*LightClientUpdate) ProtoMessage()    {}
+func (*LightClientUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{5}
+}
+func (m *LightClientUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientUpdate.Merge(m, src)
+}
+func (m *LightClientUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientUpdate proto.InternalMessageInfo
+
+func (m *LightClientUpdate) GetAttestedHeader() *BeaconBlockHeader {
+	if m != nil {
+		return m.AttestedHeader
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetNextSyncCommittee() *SyncCommittee {
+	if m != nil {
+		return m.NextSyncCommittee
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetNextSyncCommitteeBranch() [][]byte {
+	if m != nil {
+		return m.NextSyncCommitteeBranch
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetFinalizedHeader() *BeaconBlockHeader {
+	if m != nil {
+		return m.FinalizedHeader
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetFinalityBranch() [][]byte {
+	if m != nil {
+		return m.FinalityBranch
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetExecutionStateRoot() []byte {
+	if m != nil {
+		return m.ExecutionStateRoot
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetExecutionStateRootBranch() [][]byte {
+	if m != nil {
+		return m.ExecutionStateRootBranch
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetSyncAggregate() *SyncAggregate {
+	if m != nil {
+		return m.SyncAggregate
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetSignatureSlot() uint64 {
+	if m != nil {
+		return m.SignatureSlot
+	}
+	return 0
+}
+
+// BeaconBlockHeader defines the header of a beacon chain block.
+type BeaconBlockHeader struct {
+	Slot          uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex uint64 `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	ParentRoot    []byte `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	StateRoot     []byte `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	BodyRoot      []byte `protobuf:"bytes,5,opt,name=body_root,json=bodyRoot,proto3" json:"body_root,omitempty"`
+}
+
+func (m *BeaconBlockHeader) Reset()         { *m = BeaconBlockHeader{} }
+func (m *BeaconBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlockHeader) ProtoMessage()    {}
+func (*BeaconBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{6}
+}
+func (m *BeaconBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlockHeader.Merge(m, src)
+}
+func (m *BeaconBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconBlockHeader proto.InternalMessageInfo
+
+func (m *BeaconBlockHeader) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconBlockHeader) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
+	}
+	return 0
+}
+
+func (m *BeaconBlockHeader) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockHeader) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockHeader) GetBodyRoot() []byte {
+	if m != nil {
+		return m.BodyRoot
+	}
+	return nil
+}
+
+// SyncCommittee defines the public keys of the members of a sync committee and
+// their aggregate public key.
+type SyncCommittee struct {
+	Pubkeys         [][]byte `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	AggregatePubkey []byte   `protobuf:"bytes,2,opt,name=aggregate_pubkey,json=aggregatePubkey,proto3" json:"aggregate_pubkey,omitempty"`
+}
+
+func (m *SyncCommittee) Reset()         { *m = SyncCommittee{} }
+func (m *SyncCommittee) String() string { return proto.CompactTextString(m) }
+func (*SyncCommittee) ProtoMessage()    {}
+func (*SyncCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{7}
+}
+func (m *SyncCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCommittee.Merge(m, src)
+}
+func (m *SyncCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCommittee proto.InternalMessageInfo
+
+func (m *SyncCommittee) GetPubkeys() [][]byte {
+	if m != nil {
+		return m.Pubkeys
+	}
+	return nil
+}
+
+func (m *SyncCommittee) GetAggregatePubkey() []byte {
+	if m != nil {
+		return m.AggregatePubkey
+	}
+	return nil
+}
+
+// SyncAggregate defines the participation bits and aggregate signature of a
+// sync committee.
+type SyncAggregate struct {
+	SyncCommitteeBits      []byte `protobuf:"bytes,1,opt,name=sync_committee_bits,json=syncCommitteeBits,proto3" json:"sync_committee_bits,omitempty"`
+	SyncCommitteeSignature []byte `protobuf:"bytes,2,opt,name=sync_committee_signature,json=syncCommitteeSignature,proto3" json:"sync_committee_signature,omitempty"`
+}
+
+func (m *SyncAggregate) Reset()         { *m = SyncAggregate{} }
+func (m *SyncAggregate) String() string { return proto.CompactTextString(m) }
+func (*SyncAggregate) ProtoMessage()    {}
+func (*SyncAggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{8}
+}
+func (m *SyncAggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncAggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncAggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAggregate.Merge(m, src)
+}
+func (m *SyncAggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAggregate proto.InternalMessageInfo
+
+func (m *SyncAggregate) GetSyncCommitteeBits() []byte {
+	if m != nil {
+		return m.SyncCommitteeBits
+	}
+	return nil
+}
+
+func (m *SyncAggregate) GetSyncCommitteeSignature() []byte {
+	if m != nil {
+		return m.SyncCommitteeSignature
+	}
+	return nil
+}
+
+// AccountProof defines a Merkle-Patricia trie proof of the storage root of the
+// IBC contract in the execution state.
+type AccountProof struct {
+	StorageRoot []byte   `protobuf:"bytes,1,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	Proof       [][]byte `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *AccountProof) Reset()         { *m = AccountProof{} }
+func (m *AccountProof) String() string { return proto.CompactTextString(m) }
+func (*AccountProof) ProtoMessage()    {}
+func (*AccountProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{9}
+}
+func (m *AccountProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountProof.Merge(m, src)
+}
+func (m *AccountProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountProof proto.InternalMessageInfo
+
+func (m *AccountProof) GetStorageRoot() []byte {
+	if m != nil {
+		return m.StorageRoot
+	}
+	return nil
+}
+
+func (m *AccountProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// StorageProof defines a Merkle-Patricia trie proof of a storage slot of the
+// IBC contract, used to verify membership and non-membership.
+type StorageProof struct {
+	Proof [][]byte `protobuf:"bytes,1,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *StorageProof) Reset()         { *m = StorageProof{} }
+func (m *StorageProof) String() string { return proto.CompactTextString(m) }
+func (*StorageProof) ProtoMessage()    {}
+func (*StorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{10}
+}
+func (m *StorageProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageProof.Merge(m, src)
+}
+func (m *StorageProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageProof proto.InternalMessageInfo
+
+func (m *StorageProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// Misbehaviour defines misbehaviour for an Ethereum client, consisting of two
+// headers finalizing conflicting beacon block headers at the same slot.
+type Misbehaviour struct {
+	Header1 *Header `protobuf:"bytes,1,opt,name=header_1,json=header1,proto3" json:"header_1,omitempty" yaml:"header_1"`
+	Header2 *Header `protobuf:"bytes,2,opt,name=header_2,json=header2,proto3" json:"header_2,omitempty" yaml:"header_2"`
+}
+
+func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
+func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
+func (*Misbehaviour) ProtoMessage()    {}
+func (*Misbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375052802109acf0, []int{11}
+}
+func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Misbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Misbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Misbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Misbehaviour.Merge(m, src)
+}
+func (m *Misbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *Misbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_Misbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.ethereum.v1.ClientState")
+	proto.RegisterType((*ForkParameters)(nil), "ibc.lightclients.ethereum.v1.ForkParameters")
+	proto.RegisterType((*Fork)(nil), "ibc.lightclients.ethereum.v1.Fork")
+	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.ethereum.v1.ConsensusState")
+	proto.RegisterType((*Header)(nil), "ibc.lightclients.ethereum.v1.Header")
+	proto.RegisterType((*LightClientUpdate)(nil), "ibc.lightclients.ethereum.v1.LightClientUpdate")
+	proto.RegisterType((*BeaconBlockHeader)(nil), "ibc.lightclients.ethereum.v1.BeaconBlockHeader")
+	proto.RegisterType((*SyncCommittee)(nil), "ibc.lightclients.ethereum.v1.SyncCommittee")
+	proto.RegisterType((*SyncAggregate)(nil), "ibc.lightclients.ethereum.v1.SyncAggregate")
+	proto.RegisterType((*AccountProof)(nil), "ibc.lightclients.ethereum.v1.AccountProof")
+	proto.RegisterType((*StorageProof)(nil), "ibc.lightclients.ethereum.v1.StorageProof")
+	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.ethereum.v1.Misbehaviour")
+}
+
+func init() {
+	proto.RegisterFile("ibc/lightclients/ethereum/v1/ethereum.proto", fileDescriptor_375052802109acf0)
+}
+
+var fileDescriptor_375052802109acf0 = []byte{
+	// 1283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x76, 0x9c, 0x1c, 0xff, 0x35, 0x43, 0x68, 0x97, 0xb4, 0x38, 0xc1, 0x2a, 0x10,
+	0x28, 0xb5, 0x9b, 0x80, 0x2a, 0xd4, 0x0a, 0x44, 0x1d, 0xf5, 0x4f, 0x02, 0x11, 0x39, 0xa5, 0x42,
+	0xad, 0xd0, 0x6a, 0x76, 0x76, 0x62, 0x8f, 0xb2, 0xbb, 0x63, 0xcd, 0x8c, 0xa3, 0x38, 0x4f, 0xc0,
+	0x25, 0x17, 0x3c, 0x40, 0xef, 0x79, 0x91, 0x5e, 0xf6, 0x0a, 0xf5, 0x02, 0x55, 0x28, 0x7d, 0x03,
+	0xae, 0xb8, 0x44, 0xf3, 0xb3, 0x1b, 0x3b, 0xae, 0x42, 0x92, 0xbb, 0x99, 0xef, 0x9c, 0xef, 0x9b,
+	0x99, 0x33, 0xe7, 0x9c, 0xd9, 0x85, 0x1b, 0x2c, 0x24, 0xed, 0x98, 0xf5, 0xfa, 0x8a, 0xc4, 0x8c,
+	0xa6, 0x4a, 0xb6, 0xa9, 0xea, 0x53, 0x41, 0x87, 0x49, 0x7b, 0x7f, 0x23, 0x1f, 0xb7, 0x06, 0x82,
+	0x2b, 0x8e, 0xae, 0xb1, 0x90, 0xb4, 0xc6, 0x9d, 0x5b, 0xb9, 0xc3, 0xfe, 0xc6, 0xca, 0xaa, 0x96,
+	0x22, 0x5c, 0xd0, 0xb6, 0xb5, 0x6a, 0x01, 0x3b, 0xb2, 0xf4, 0x95, 0xe5, 0x1e, 0xef, 0x71, 0x33,
+	0x6c, 0xeb, 0x91, 0x45, 0x9b, 0x2f, 0x8a, 0x50, 0xde, 0x32, 0x6e, 0x3b, 0x0a, 0x2b, 0x8a, 0x3e,
+	0x80, 0x05, 0xd2, 0xc7, 0x2c, 0x0d, 0x58, 0xe4, 0x7b, 0x6b, 0xde, 0x7a, 0xa1, 0x5b, 0x32, 0xf3,
+	0xc7, 0x11, 0xba, 0x0d, 0x57, 0x7a, 0x34, 0xa5, 0x92, 0xc9, 0x60, 0x1f, 0xc7, 0x2c, 0xc2, 0x8a,
+	0x0b, 0x19, 0x08, 0xce, 0x95, 0x3f, 0xbb, 0xe6, 0xad, 0x57, 0xba, 0xef, 0x3b, 0xf3, 0xd3, 0xdc,
+	0xda, 0xe5, 0x5c, 0xa1, 0x8f, 0xa0, 0x92, 0xf1, 0x14, 0x4b, 0xa8, 0x3f, 0x67, 0x64, 0xcb, 0x0e,
+	0x7b, 0xc2, 0x12, 0x8a, 0x9e, 0x43, 0x7d, 0x97, 0x8b, 0xbd, 0x60, 0x80, 0x05, 0x4e, 0xa8, 0xa2,
+	0x42, 0xfa, 0x85, 0x35, 0x6f, 0xbd, 0xbc, 0xf9, 0x45, 0xeb, 0xb4, 0x43, 0xb7, 0x1e, 0x70, 0xb1,
+	0xb7, 0x9d, 0x73, 0x3a, 0x85, 0x97, 0x6f, 0x56, 0x67, 0xba, 0xb5, 0xdd, 0x09, 0x14, 0xad, 0xc3,
+	0x25, 0x49, 0x09, 0x4f, 0x23, 0x19, 0x0c, 0xa8, 0x08, 0x64, 0xcc, 0x95, 0x5f, 0x34, 0x7b, 0xa8,
+	0x39, 0x7c, 0x9b, 0x8a, 0x9d, 0x98, 0x2b, 0xf4, 0x09, 0xd4, 0xb5, 0xd5, 0xfa, 0xd1, 0x01, 0x27,
+	0x7d, 0x7f, 0xde, 0x38, 0x56, 0x0d, 0xbc, 0x4d, 0xc5, 0x7d, 0x0d, 0xa2, 0x07, 0xb0, 0x66, 0xac,
+	0x4e, 0x70, 0x94, 0x92, 0x80, 0xf0, 0x24, 0x61, 0x4a, 0x51, 0xaa, 0x21, 0xc6, 0x23, 0xbf, 0x64,
+	0x88, 0xd7, 0xac, 0x9f, 0x5e, 0x60, 0x94, 0x92, 0xad, 0xcc, 0x69, 0xdb, 0xf8, 0xa0, 0x16, 0xbc,
+	0x77, 0x82, 0x2c, 0xd9, 0x21, 0xf5, 0x17, 0x0c, 0x75, 0x49, 0x8e, 0x33, 0x76, 0xd8, 0x21, 0x45,
+	0xb7, 0x60, 0x99, 0x85, 0xda, 0x3d, 0x55, 0x02, 0x13, 0x15, 0xe0, 0x28, 0x12, 0x54, 0x4a, 0x7f,
+	0xd1, 0x84, 0x1f, 0xb1, 0x90, 0x6c, 0x39, 0xd3, 0x3d, 0x6b, 0xd1, 0x2b, 0x58, 0x86, 0x96, 0x49,
+	0x68, 0xaa, 0xec, 0xf1, 0xc1, 0x10, 0x96, 0x0c, 0x21, 0xb3, 0x98, 0x08, 0xdc, 0x87, 0x6a, 0x8c,
+	0x15, 0x95, 0x2a, 0xe8, 0x53, 0x1d, 0x74, 0xbf, 0x6c, 0xae, 0x61, 0xc5, 0x5c, 0x83, 0xce, 0xae,
+	0x96, 0xcb, 0xa9, 0xfd, 0x8d, 0xd6, 0x23, 0xe3, 0xe1, 0x82, 0x5e, 0xb1, 0x34, 0x8b, 0x69, 0x99,
+	0x5d, 0xc1, 0x0f, 0x69, 0x9a, 0xc9, 0x54, 0xce, 0x2a, 0x63, 0x69, 0x16, 0xbb, 0x53, 0xf8, 0xf5,
+	0xc5, 0xea, 0x4c, 0xf3, 0xf7, 0x39, 0xa8, 0x4d, 0x5e, 0xb4, 0x0e, 0x44, 0x96, 0x52, 0x26, 0x6f,
+	0xf6, 0xa9, 0x90, 0x8c, 0xa7, 0x26, 0x63, 0x2b, 0x5d, 0xe4, 0x6c, 0x9a, 0xf4, 0xd4, 0x5a, 0xd0,
+	0x77, 0x30, 0x8f, 0x63, 0x85, 0x99, 0x30, 0xb9, 0x5a, 0xde, 0x6c, 0xfe, 0x7f, 0x62, 0xb9, 0x2d,
+	0x39, 0x1e, 0x7a, 0x00, 0x8b, 0x21, 0x8d, 0x63, 0xac, 0x04, 0x3b, 0xf0, 0xe7, 0xce, 0x29, 0x72,
+	0x4c, 0x45, 0x1d, 0x28, 0x11, 0x3c, 0xd0, 0x53, 0xbf, 0x70, 0x4e, 0x95, 0x8c, 0x88, 0xbe, 0x85,
+	0x62, 0x44, 0x53, 0x1a, 0xfa, 0xc5, 0x73, 0x2a, 0x58, 0x9a, 0xde, 0x03, 0x8d, 0x29, 0x51, 0x02,
+	0xfb, 0xf3, 0xe7, 0x54, 0xc8, 0x88, 0xcd, 0xdb, 0x50, 0xd0, 0x30, 0xf2, 0xa1, 0x34, 0x19, 0xfe,
+	0x6c, 0x8a, 0x96, 0xa1, 0x68, 0x8b, 0x68, 0xd6, 0x24, 0xb4, 0x9d, 0x34, 0xff, 0xf2, 0xa0, 0xb6,
+	0xc5, 0x53, 0x49, 0x53, 0x39, 0x94, 0xb6, 0xe9, 0x5c, 0x83, 0x45, 0xc5, 0x12, 0x2a, 0x15, 0x4e,
+	0x06, 0xae, 0xeb, 0x1c, 0x03, 0xe8, 0x43, 0x00, 0xa9, 0xdd, 0xc6, 0x5b, 0xcd, 0xa2, 0x41, 0xb2,
+	0xf6, 0x22, 0x15, 0x17, 0xb8, 0xe7, 0x1c, 0xe6, 0x8c, 0x43, 0xd9, 0x61, 0xc6, 0xe5, 0x2b, 0xb8,
+	0x4c, 0x86, 0x42, 0x98, 0xf4, 0x9f, 0xa8, 0x37, 0x73, 0x03, 0x95, 0xee, 0xb2, 0xb3, 0x4e, 0xd4,
+	0xa8, 0xae, 0x9d, 0x94, 0x1e, 0x4c, 0x51, 0x8a, 0xb6, 0x76, 0xb4, 0x69, 0xc2, 0xdf, 0x65, 0xeb,
+	0xeb, 0x59, 0x98, 0x7f, 0x44, 0x71, 0x44, 0x05, 0x7a, 0x08, 0x35, 0x25, 0x86, 0x52, 0xd1, 0x28,
+	0x2b, 0x03, 0xef, 0x8c, 0x65, 0x50, 0x75, 0x3c, 0x57, 0x4e, 0x5d, 0xa8, 0x9d, 0xd8, 0x84, 0x4d,
+	0xe2, 0x1b, 0xa7, 0xdf, 0xda, 0xc4, 0xf6, 0xba, 0xd5, 0x89, 0x7e, 0x82, 0x9e, 0xc1, 0x25, 0x92,
+	0xdd, 0x42, 0x30, 0x1c, 0x44, 0x58, 0x51, 0x97, 0xd5, 0xed, 0xd3, 0x55, 0xbf, 0xd7, 0x06, 0xfb,
+	0x64, 0xfc, 0x64, 0x68, 0xdd, 0x7a, 0x2e, 0x64, 0x01, 0xf4, 0x23, 0x54, 0x31, 0x21, 0x7c, 0x98,
+	0xaa, 0x60, 0x20, 0x38, 0xdf, 0x75, 0x89, 0xfe, 0xf9, 0xe9, 0xc2, 0xf7, 0x2c, 0x65, 0x5b, 0x33,
+	0xba, 0x15, 0x3c, 0x36, 0x73, 0xa1, 0xfd, 0xb7, 0x00, 0x4b, 0x53, 0xab, 0xa3, 0x9f, 0xa1, 0x8e,
+	0x95, 0xa2, 0x2e, 0xcc, 0x3a, 0xf0, 0xbe, 0x77, 0x96, 0x73, 0x74, 0x28, 0x26, 0x3c, 0xed, 0xc4,
+	0x9c, 0xec, 0xd9, 0xfb, 0xea, 0xd6, 0x32, 0x1d, 0x77, 0x7f, 0xcf, 0xdf, 0x9d, 0x00, 0x17, 0x88,
+	0xfd, 0x74, 0xb6, 0xa0, 0xbb, 0xb0, 0xf2, 0x0e, 0xf1, 0x20, 0x14, 0x38, 0x25, 0x7d, 0x7f, 0x6e,
+	0x6d, 0x6e, 0xbd, 0xd2, 0xbd, 0x32, 0x45, 0xeb, 0x18, 0xb3, 0xbe, 0xbc, 0x5d, 0x96, 0xe2, 0x98,
+	0x1d, 0x1e, 0x1f, 0xba, 0x70, 0xb1, 0x43, 0xd7, 0x73, 0x21, 0x77, 0xea, 0x4f, 0xc1, 0x41, 0x6a,
+	0x94, 0xed, 0xa6, 0x68, 0x76, 0x53, 0xcb, 0x60, 0xb7, 0x89, 0x5b, 0xb0, 0x4c, 0x0f, 0x28, 0x19,
+	0x2a, 0xc6, 0xd3, 0x60, 0xac, 0x42, 0xe7, 0x6d, 0x13, 0xce, 0x6d, 0x3b, 0x79, 0xa9, 0x7e, 0x03,
+	0x57, 0xdf, 0xc5, 0xc8, 0x96, 0x29, 0x99, 0x65, 0xfc, 0x69, 0xa2, 0x5b, 0x30, 0x2b, 0x03, 0xdc,
+	0xeb, 0x09, 0xda, 0xc3, 0xca, 0xbe, 0x94, 0x67, 0xba, 0x8a, 0x7b, 0x19, 0xc5, 0x96, 0x41, 0x3e,
+	0x45, 0x1f, 0x43, 0x4d, 0xb2, 0x5e, 0x8a, 0xd5, 0x50, 0x50, 0xfb, 0x36, 0x2e, 0xba, 0x17, 0x3f,
+	0x43, 0xf5, 0xbb, 0xd8, 0xfc, 0xc3, 0x83, 0xa5, 0xa9, 0xd8, 0x21, 0x04, 0x05, 0x43, 0xb1, 0x2d,
+	0xcb, 0x8c, 0xb5, 0xe0, 0x40, 0xf0, 0x01, 0x97, 0x54, 0x04, 0x2c, 0x8d, 0xe8, 0x81, 0xeb, 0x7e,
+	0xd5, 0x0c, 0x7d, 0xac, 0x41, 0xb4, 0x0a, 0xe5, 0x01, 0x36, 0x1d, 0x69, 0xac, 0x69, 0x81, 0x85,
+	0x4c, 0xac, 0x26, 0xbb, 0x5e, 0xe1, 0x64, 0xd7, 0xbb, 0x0a, 0x8b, 0x21, 0x8f, 0x46, 0xd6, 0x6a,
+	0x5b, 0xd2, 0x82, 0x06, 0xb4, 0xb1, 0xf9, 0x04, 0xaa, 0x93, 0xc9, 0xe6, 0x43, 0x69, 0x30, 0x0c,
+	0xf7, 0xe8, 0x48, 0xfa, 0x9e, 0x09, 0x72, 0x36, 0x45, 0x9f, 0xc1, 0xa5, 0x3c, 0x9c, 0x81, 0x05,
+	0x5d, 0x8b, 0xad, 0xe7, 0xf8, 0xb6, 0x81, 0x9b, 0x23, 0xab, 0x7a, 0x1c, 0xbb, 0xe9, 0xcf, 0x97,
+	0x90, 0x29, 0xe9, 0x5e, 0x81, 0xc9, 0xcf, 0x97, 0x0e, 0x53, 0x12, 0x7d, 0x0d, 0xfe, 0xd4, 0xe7,
+	0x8e, 0x0b, 0xb2, 0x5b, 0xf3, 0xf2, 0x89, 0x6f, 0x1e, 0x67, 0x6d, 0x3e, 0x84, 0xca, 0x78, 0x77,
+	0x98, 0xea, 0xf9, 0xde, 0x74, 0xcf, 0x5f, 0x86, 0xa2, 0xed, 0x3d, 0xb3, 0xe6, 0xc0, 0x76, 0xd2,
+	0xbc, 0x0e, 0x95, 0x1d, 0xeb, 0x64, 0x85, 0x72, 0x2f, 0x6f, 0xdc, 0xeb, 0x4f, 0x0f, 0x2a, 0x3f,
+	0x30, 0x19, 0xd2, 0x3e, 0xde, 0x67, 0x7c, 0x28, 0x50, 0x00, 0x0b, 0xb6, 0xca, 0x82, 0x0d, 0xd7,
+	0x5c, 0xae, 0x9f, 0x9e, 0x73, 0x36, 0x41, 0x3a, 0x8d, 0xa3, 0x37, 0xab, 0x25, 0x3b, 0xde, 0xf8,
+	0xe7, 0xcd, 0x6a, 0x7d, 0x84, 0x93, 0xf8, 0x4e, 0x33, 0x93, 0x6a, 0x76, 0x4b, 0x76, 0xb8, 0x31,
+	0xb6, 0xc0, 0xa6, 0x3f, 0x7b, 0xb1, 0x05, 0x36, 0xa7, 0x16, 0xd8, 0xcc, 0x17, 0xd8, 0xb4, 0x1d,
+	0xb4, 0xf3, 0xcb, 0xcb, 0xa3, 0x86, 0xf7, 0xea, 0xa8, 0xe1, 0xfd, 0x7d, 0xd4, 0xf0, 0x7e, 0x7b,
+	0xdb, 0x98, 0x79, 0xf5, 0xb6, 0x31, 0xf3, 0xfa, 0x6d, 0x63, 0xe6, 0xd9, 0x56, 0x8f, 0xa9, 0xfe,
+	0x30, 0x6c, 0x11, 0x9e, 0xb4, 0x09, 0x97, 0x09, 0x97, 0x6d, 0x16, 0x92, 0x9b, 0x3d, 0xde, 0x4e,
+	0x78, 0x34, 0x8c, 0xa9, 0xb4, 0xbf, 0x29, 0x37, 0xb3, 0xff, 0x94, 0x83, 0x83, 0x9b, 0xd9, 0x76,
+	0xee, 0x66, 0x83, 0x70, 0xde, 0xfc, 0x53, 0x7c, 0xf9, 0xdf, 0x00, 0x76, 0x66, 0xb1, 0x0e, 0xd7,
+	0x0c, 0x00, 0x00,
+}
+
+func (m *ClientState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.IbcCommitmentSlot) > 0 {
+		i -= len(m.IbcCommitmentSlot)
+		copy(dAtA[i:], m.IbcCommitmentSlot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.IbcCommitmentSlot)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.IbcContractAddress) > 0 {
+		i -= len(m.IbcContractAddress)
+		copy(dAtA[i:], m.IbcContractAddress)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.IbcContractAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SyncCommitteeSize != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.SyncCommitteeSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EpochsPerSyncCommitteePeriod != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.EpochsPerSyncCommitteePeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlotsPerEpoch != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.SlotsPerEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SecondsPerSlot != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.SecondsPerSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.ForkParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.GenesisTime != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GenesisValidatorsRoot) > 0 {
+		i -= len(m.GenesisValidatorsRoot)
+		copy(dAtA[i:], m.GenesisValidatorsRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.GenesisValidatorsRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Electra.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Deneb.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Capella.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Bellatrix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Altair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GenesisForkVersion) > 0 {
+		i -= len(m.GenesisForkVersion)
+		copy(dAtA[i:], m.GenesisForkVersion)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.GenesisForkVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextSyncCommittee) > 0 {
+		i -= len(m.NextSyncCommittee)
+		copy(dAtA[i:], m.NextSyncCommittee)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.NextSyncCommittee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CurrentSyncCommittee) > 0 {
+		i -= len(m.CurrentSyncCommittee)
+		copy(dAtA[i:], m.CurrentSyncCommittee)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.CurrentSyncCommittee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StorageRoot) > 0 {
+		i -= len(m.StorageRoot)
+		copy(dAtA[i:], m.StorageRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.StorageRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountProof != nil {
+		{
+			size, err := m.AccountProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConsensusUpdate != nil {
+		{
+			size, err := m.ConsensusUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SyncCommittee != nil {
+		{
+			size, err := m.SyncCommittee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.TrustedHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignatureSlot != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.SignatureSlot))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SyncAggregate != nil {
+		{
+			size, err := m.SyncAggregate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExecutionStateRootBranch) > 0 {
+		for iNdEx := len(m.ExecutionStateRootBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutionStateRootBranch[iNdEx])
+			copy(dAtA[i:], m.ExecutionStateRootBranch[iNdEx])
+			i = encodeVarintEthereum(dAtA, i, uint64(len(m.ExecutionStateRootBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ExecutionStateRoot) > 0 {
+		i -= len(m.ExecutionStateRoot)
+		copy(dAtA[i:], m.ExecutionStateRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.ExecutionStateRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FinalityBranch) > 0 {
+		for iNdEx := len(m.FinalityBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FinalityBranch[iNdEx])
+			copy(dAtA[i:], m.FinalityBranch[iNdEx])
+			i = encodeVarintEthereum(dAtA, i, uint64(len(m.FinalityBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.FinalizedHeader != nil {
+		{
+			size, err := m.FinalizedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NextSyncCommitteeBranch) > 0 {
+		for iNdEx := len(m.NextSyncCommitteeBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NextSyncCommitteeBranch[iNdEx])
+			copy(dAtA[i:], m.NextSyncCommitteeBranch[iNdEx])
+			i = encodeVarintEthereum(dAtA, i, uint64(len(m.NextSyncCommitteeBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NextSyncCommittee != nil {
+		{
+			size, err := m.NextSyncCommittee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AttestedHeader != nil {
+		{
+			size, err := m.AttestedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BodyRoot) > 0 {
+		i -= len(m.BodyRoot)
+		copy(dAtA[i:], m.BodyRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.BodyRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentRoot) > 0 {
+		i -= len(m.ParentRoot)
+		copy(dAtA[i:], m.ParentRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.ParentRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposerIndex != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.ProposerIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintEthereum(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePubkey) > 0 {
+		i -= len(m.AggregatePubkey)
+		copy(dAtA[i:], m.AggregatePubkey)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.AggregatePubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkeys) > 0 {
+		for iNdEx := len(m.Pubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pubkeys[iNdEx])
+			copy(dAtA[i:], m.Pubkeys[iNdEx])
+			i = encodeVarintEthereum(dAtA, i, uint64(len(m.Pubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncAggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncAggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncAggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SyncCommitteeSignature) > 0 {
+		i -= len(m.SyncCommitteeSignature)
+		copy(dAtA[i:], m.SyncCommitteeSignature)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.SyncCommitteeSignature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SyncCommitteeBits) > 0 {
+		i -= len(m.SyncCommitteeBits)
+		copy(dAtA[i:], m.SyncCommitteeBits)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.SyncCommitteeBits)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintEthereum(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StorageRoot) > 0 {
+		i -= len(m.StorageRoot)
+		copy(dAtA[i:], m.StorageRoot)
+		i = encodeVarintEthereum(dAtA, i, uint64(len(m.StorageRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintEthereum(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Misbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header2 != nil {
+		{
+			size, err := m.Header2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header1 != nil {
+		{
+			size, err := m.Header1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEthereum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEthereum(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEthereum(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEthereum(uint64(m.ChainId))
+	}
+	l = len(m.GenesisValidatorsRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovEthereum(uint64(m.GenesisTime))
+	}
+	l = m.ForkParameters.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	if m.SecondsPerSlot != 0 {
+		n += 1 + sovEthereum(uint64(m.SecondsPerSlot))
+	}
+	if m.SlotsPerEpoch != 0 {
+		n += 1 + sovEthereum(uint64(m.SlotsPerEpoch))
+	}
+	if m.EpochsPerSyncCommitteePeriod != 0 {
+		n += 1 + sovEthereum(uint64(m.EpochsPerSyncCommitteePeriod))
+	}
+	if m.SyncCommitteeSize != 0 {
+		n += 1 + sovEthereum(uint64(m.SyncCommitteeSize))
+	}
+	l = len(m.IbcContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = len(m.IbcCommitmentSlot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	l = m.FrozenHeight.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	return n
+}
+
+func (m *ForkParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisForkVersion)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = m.Altair.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	l = m.Bellatrix.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	l = m.Capella.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	l = m.Deneb.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	l = m.Electra.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	return n
+}
+
+func (m *Fork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovEthereum(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *ConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovEthereum(uint64(m.Timestamp))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = len(m.StorageRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = len(m.CurrentSyncCommittee)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = len(m.NextSyncCommittee)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	return n
+}
+
+func (m *Header) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrustedHeight.Size()
+	n += 1 + l + sovEthereum(uint64(l))
+	if m.SyncCommittee != nil {
+		l = m.SyncCommittee.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.ConsensusUpdate != nil {
+		l = m.ConsensusUpdate.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.AccountProof != nil {
+		l = m.AccountProof.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	return n
+}
+
+func (m *LightClientUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AttestedHeader != nil {
+		l = m.AttestedHeader.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.NextSyncCommittee != nil {
+		l = m.NextSyncCommittee.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if len(m.NextSyncCommitteeBranch) > 0 {
+		for _, b := range m.NextSyncCommitteeBranch {
+			l = len(b)
+			n += 1 + l + sovEthereum(uint64(l))
+		}
+	}
+	if m.FinalizedHeader != nil {
+		l = m.FinalizedHeader.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if len(m.FinalityBranch) > 0 {
+		for _, b := range m.FinalityBranch {
+			l = len(b)
+			n += 1 + l + sovEthereum(uint64(l))
+		}
+	}
+	l = len(m.ExecutionStateRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if len(m.ExecutionStateRootBranch) > 0 {
+		for _, b := range m.ExecutionStateRootBranch {
+			l = len(b)
+			n += 1 + l + sovEthereum(uint64(l))
+		}
+	}
+	if m.SyncAggregate != nil {
+		l = m.SyncAggregate.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.SignatureSlot != 0 {
+		n += 1 + sovEthereum(uint64(m.SignatureSlot))
+	}
+	return n
+}
+
+func (m *BeaconBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovEthereum(uint64(m.Slot))
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovEthereum(uint64(m.ProposerIndex))
+	}
+	l = len(m.ParentRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = len(m.BodyRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pubkeys) > 0 {
+		for _, b := range m.Pubkeys {
+			l = len(b)
+			n += 1 + l + sovEthereum(uint64(l))
+		}
+	}
+	l = len(m.AggregatePubkey)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncAggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SyncCommitteeBits)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	l = len(m.SyncCommitteeSignature)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageRoot)
+	if l > 0 {
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovEthereum(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StorageProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovEthereum(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Misbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header1 != nil {
+		l = m.Header1.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	if m.Header2 != nil {
+		l = m.Header2.Size()
+		n += 1 + l + sovEthereum(uint64(l))
+	}
+	return n
+}
+
+func sovEthereum(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEthereum(x uint64) (n int) {
+	return sovEthereum(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidatorsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisValidatorsRoot = append(m.GenesisValidatorsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisValidatorsRoot == nil {
+				m.GenesisValidatorsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForkParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsPerSlot", wireType)
+			}
+			m.SecondsPerSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsPerSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerEpoch", wireType)
+			}
+			m.SlotsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerSyncCommitteePeriod", wireType)
+			}
+			m.EpochsPerSyncCommitteePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerSyncCommitteePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeSize", wireType)
+			}
+			m.SyncCommitteeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncCommitteeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcContractAddress = append(m.IbcContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcContractAddress == nil {
+				m.IbcContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcCommitmentSlot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcCommitmentSlot = append(m.IbcCommitmentSlot[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcCommitmentSlot == nil {
+				m.IbcCommitmentSlot = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisForkVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisForkVersion = append(m.GenesisForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisForkVersion == nil {
+				m.GenesisForkVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Altair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Altair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bellatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bellatrix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capella", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capella.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deneb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deneb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Electra", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Electra.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRoot = append(m.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StorageRoot == nil {
+				m.StorageRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSyncCommittee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentSyncCommittee = append(m.CurrentSyncCommittee[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentSyncCommittee == nil {
+				m.CurrentSyncCommittee = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncCommittee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextSyncCommittee = append(m.NextSyncCommittee[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextSyncCommittee == nil {
+				m.NextSyncCommittee = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncCommittee == nil {
+				m.SyncCommittee = &SyncCommittee{}
+			}
+			if err := m.SyncCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusUpdate == nil {
+				m.ConsensusUpdate = &LightClientUpdate{}
+			}
+			if err := m.ConsensusUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountProof == nil {
+				m.AccountProof = &AccountProof{}
+			}
+			if err := m.AccountProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttestedHeader == nil {
+				m.AttestedHeader = &BeaconBlockHeader{}
+			}
+			if err := m.AttestedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextSyncCommittee == nil {
+				m.NextSyncCommittee = &SyncCommittee{}
+			}
+			if err := m.NextSyncCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncCommitteeBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextSyncCommitteeBranch = append(m.NextSyncCommitteeBranch, make([]byte, postIndex-iNdEx))
+			copy(m.NextSyncCommitteeBranch[len(m.NextSyncCommitteeBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizedHeader == nil {
+				m.FinalizedHeader = &BeaconBlockHeader{}
+			}
+			if err := m.FinalizedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityBranch = append(m.FinalityBranch, make([]byte, postIndex-iNdEx))
+			copy(m.FinalityBranch[len(m.FinalityBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionStateRoot = append(m.ExecutionStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExecutionStateRoot == nil {
+				m.ExecutionStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStateRootBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionStateRootBranch = append(m.ExecutionStateRootBranch, make([]byte, postIndex-iNdEx))
+			copy(m.ExecutionStateRootBranch[len(m.ExecutionStateRootBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncAggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncAggregate == nil {
+				m.SyncAggregate = &SyncAggregate{}
+			}
+			if err := m.SyncAggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureSlot", wireType)
+			}
+			m.SignatureSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
+			}
+			m.ProposerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRoot = append(m.ParentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentRoot == nil {
+				m.ParentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyRoot = append(m.BodyRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyRoot == nil {
+				m.BodyRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkeys = append(m.Pubkeys, make([]byte, postIndex-iNdEx))
+			copy(m.Pubkeys[len(m.Pubkeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePubkey = append(m.AggregatePubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatePubkey == nil {
+				m.AggregatePubkey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncAggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncAggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncAggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeBits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeBits = append(m.SyncCommitteeBits[:0], dAtA[iNdEx:postIndex]...)
+			if m.SyncCommitteeBits == nil {
+				m.SyncCommitteeBits = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeSignature = append(m.SyncCommitteeSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SyncCommitteeSignature == nil {
+				m.SyncCommitteeSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRoot = append(m.StorageRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StorageRoot == nil {
+				m.StorageRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Misbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Misbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header1 == nil {
+				m.Header1 = &Header{}
+			}
+			if err := m.Header1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header2 == nil {
+				m.Header2 = &Header{}
+			}
+			if err := m.Header2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEthereum(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEthereum
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEthereum
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEthereum
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEthereum
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEthereum
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEthereum        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEthereum          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEthereum = fmt.Errorf("proto: unexpected end of group")
+)
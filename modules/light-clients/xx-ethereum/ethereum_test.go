@@ -0,0 +1,470 @@
+package ethereum_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math"
+	"math/big"
+	"math/bits"
+	"sort"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	ethereum "github.com/cosmos/ibc-go/modules/light-clients/xx-ethereum"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+const (
+	secondsPerSlot               = 12
+	slotsPerEpoch                = 8
+	epochsPerSyncCommitteePeriod = 8
+	slotsPerPeriod               = slotsPerEpoch * epochsPerSyncCommitteePeriod
+	syncCommitteeSize            = 32
+
+	// the initial consensus state is created in period 1 and the current slot of the host chain is in period 2
+	initialSlot = 100
+	currentSlot = 150
+)
+
+var (
+	genesisValidatorsRoot = bytes.Repeat([]byte{0x4b}, 32)
+	ibcContractAddress    = bytes.Repeat([]byte{0xaa}, 20)
+	ibcCommitmentSlot     = make([]byte, 32)
+
+	forkParameters = ethereum.ForkParameters{
+		GenesisForkVersion: []byte{0, 0, 0, 0},
+		Altair:             ethereum.Fork{Version: []byte{1, 0, 0, 0}, Epoch: 0},
+		Bellatrix:          ethereum.Fork{Version: []byte{2, 0, 0, 0}, Epoch: 0},
+		Capella:            ethereum.Fork{Version: []byte{3, 0, 0, 0}, Epoch: 0},
+		Deneb:              ethereum.Fork{Version: []byte{4, 0, 0, 0}, Epoch: 0},
+		Electra:            ethereum.Fork{Version: []byte{5, 0, 0, 0}, Epoch: math.MaxUint64},
+	}
+)
+
+type EthereumTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	// sync committees of the beacon chain indexed by period
+	syncCommittees []*syncCommittee
+	// storage of the IBC contract, mapping storage slots to 32 byte words
+	storage map[string][]byte
+	// proposer index of the finalized headers of new updates, used to create conflicting headers
+	proposerIndex uint64
+}
+
+func TestEthereumTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(EthereumTestSuite))
+}
+
+func (suite *EthereumTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	// the ethereum light client is not wired into the testing simapp of ibc-go
+	lightClientModule := ethereum.NewLightClientModule(suite.chainA.Codec)
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.GetRouter().AddRoute(ethereum.ModuleName, &lightClientModule)
+	ethereum.RegisterInterfaces(suite.chainA.GetSimApp().InterfaceRegistry())
+
+	suite.syncCommittees = nil
+	for period := int64(0); period < 4; period++ {
+		suite.syncCommittees = append(suite.syncCommittees, newSyncCommittee(period))
+	}
+
+	suite.storage = make(map[string][]byte)
+	suite.proposerIndex = 0
+}
+
+// clientState returns an ethereum client state whose genesis time places the host chain at currentSlot.
+func (suite *EthereumTestSuite) clientState() *ethereum.ClientState {
+	genesisTime := uint64(suite.chainA.GetContext().BlockTime().Unix()) - currentSlot*secondsPerSlot
+
+	return ethereum.NewClientState(
+		1, genesisValidatorsRoot, genesisTime, forkParameters, secondsPerSlot, slotsPerEpoch, epochsPerSyncCommitteePeriod,
+		syncCommitteeSize, ibcContractAddress, ibcCommitmentSlot, clienttypes.NewHeight(0, initialSlot),
+	)
+}
+
+// createClient creates an ethereum client trusting the sync committees of periods 1 and 2 at the initial slot.
+func (suite *EthereumTestSuite) createClient() string {
+	clientState := suite.clientState()
+	consensusState := ethereum.NewConsensusState(
+		timestampAtSlot(clientState, initialSlot), bytes.Repeat([]byte{0x01}, 32), bytes.Repeat([]byte{0x02}, 32),
+		suite.syncCommitteeRoot(1), suite.syncCommitteeRoot(2),
+	)
+
+	clientStateBz := suite.chainA.Codec.MustMarshal(clientState)
+	consensusStateBz := suite.chainA.Codec.MustMarshal(consensusState)
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), exported.Ethereum, clientStateBz, consensusStateBz)
+	suite.Require().NoError(err)
+
+	return clientID
+}
+
+// lightClientModule returns the ethereum light client module routed for the given client.
+func (suite *EthereumTestSuite) lightClientModule(clientID string) exported.LightClientModule {
+	lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetRouter().GetRoute(clientID)
+	suite.Require().True(found)
+
+	return lightClientModule
+}
+
+// syncCommitteeRoot returns the hash tree root of the sync committee of the given period.
+func (suite *EthereumTestSuite) syncCommitteeRoot(period uint64) []byte {
+	root := suite.syncCommittees[period].committee.HashTreeRoot()
+	return root[:]
+}
+
+// setCommitment commits to the value under the given key in the IBC contract storage.
+func (suite *EthereumTestSuite) setCommitment(key, value []byte) {
+	suite.storage[string(suite.clientState().CommitmentStorageSlot(key))] = ethereum.Keccak256(value)
+}
+
+// storageTrie returns the root and nodes of the storage trie of the IBC contract.
+func (suite *EthereumTestSuite) storageTrie() ([]byte, [][]byte) {
+	entries := make(map[string][]byte, len(suite.storage))
+	for slot, word := range suite.storage {
+		entries[string(ethereum.Keccak256([]byte(slot)))] = rlpString(bytes.TrimLeft(word, "\x00"))
+	}
+
+	return buildTrie(entries)
+}
+
+// newHeader returns a header updating the client from the trusted slot to the finalized slot. The finalized header is
+// attested to at the next slot and signed by the sync committee of the signature period with full participation. The
+// next sync committee of the attested period is included in the update.
+func (suite *EthereumTestSuite) newHeader(trustedSlot, finalizedSlot uint64) *ethereum.Header {
+	// execution layer state containing the IBC contract and another account
+	storageRoot, _ := suite.storageTrie()
+
+	emptyStorageRoot, _ := buildTrie(nil)
+	codeHash := ethereum.Keccak256([]byte("code"))
+	stateRoot, accountNodes := buildTrie(map[string][]byte{
+		string(ethereum.Keccak256(ibcContractAddress)):             rlpAccount(storageRoot, codeHash),
+		string(ethereum.Keccak256(bytes.Repeat([]byte{0xbb}, 20))): rlpAccount(emptyStorageRoot, codeHash),
+	})
+
+	// beacon block body of the finalized header containing the execution payload
+	body := merkleTree{ethereum.ExecutionStateRootGindexDeneb: toChunk(stateRoot)}
+	finalizedHeader := &ethereum.BeaconBlockHeader{
+		Slot:          finalizedSlot,
+		ProposerIndex: suite.proposerIndex,
+		ParentRoot:    bytes.Repeat([]byte{0x11}, 32),
+		StateRoot:     bytes.Repeat([]byte{0x12}, 32),
+		BodyRoot:      body.root(),
+	}
+
+	// beacon state of the attested header containing the finalized checkpoint and the next sync committee
+	attestedSlot := finalizedSlot + 1
+	nextSyncCommittee := suite.syncCommittees[attestedSlot/slotsPerPeriod+1].committee
+	state := merkleTree{
+		ethereum.FinalizedRootGindex:     finalizedHeader.HashTreeRoot(),
+		ethereum.NextSyncCommitteeGindex: nextSyncCommittee.HashTreeRoot(),
+	}
+	attestedHeader := &ethereum.BeaconBlockHeader{
+		Slot:          attestedSlot,
+		ProposerIndex: 2,
+		ParentRoot:    bytes.Repeat([]byte{0x21}, 32),
+		StateRoot:     state.root(),
+		BodyRoot:      bytes.Repeat([]byte{0x23}, 32),
+	}
+
+	header := &ethereum.Header{
+		TrustedHeight: clienttypes.NewHeight(0, trustedSlot),
+		ConsensusUpdate: &ethereum.LightClientUpdate{
+			AttestedHeader:           attestedHeader,
+			NextSyncCommittee:        nextSyncCommittee,
+			NextSyncCommitteeBranch:  state.branch(ethereum.NextSyncCommitteeGindex),
+			FinalizedHeader:          finalizedHeader,
+			FinalityBranch:           state.branch(ethereum.FinalizedRootGindex),
+			ExecutionStateRoot:       stateRoot,
+			ExecutionStateRootBranch: body.branch(ethereum.ExecutionStateRootGindexDeneb),
+			SignatureSlot:            attestedSlot + 1,
+		},
+		AccountProof: &ethereum.AccountProof{
+			StorageRoot: storageRoot,
+			Proof:       accountNodes,
+		},
+	}
+
+	suite.signHeader(header, syncCommitteeSize)
+
+	return header
+}
+
+// signHeader signs the attested header of the header with the given number of participants of the sync committee of
+// the signature period.
+func (suite *EthereumTestSuite) signHeader(header *ethereum.Header, participants int) {
+	update := header.ConsensusUpdate
+	signingCommittee := suite.syncCommittees[update.SignatureSlot/slotsPerPeriod]
+	signingRoot := suite.clientState().SyncCommitteeSigningRoot(*update.AttestedHeader, update.SignatureSlot)
+
+	header.SyncCommittee = signingCommittee.committee
+	update.SyncAggregate = signingCommittee.sign(signingRoot[:], participants)
+}
+
+// timestampAtSlot returns the timestamp in nanoseconds of the slot.
+func timestampAtSlot(clientState *ethereum.ClientState, slot uint64) uint64 {
+	return (clientState.GenesisTime + slot*secondsPerSlot) * 1e9
+}
+
+// syncCommittee is a sync committee with the secret keys of its members.
+type syncCommittee struct {
+	secretKeys []*big.Int
+	committee  *ethereum.SyncCommittee
+}
+
+// newSyncCommittee returns a sync committee with deterministic secret keys derived from the seed.
+func newSyncCommittee(seed int64) *syncCommittee {
+	_, _, g1, _ := bls12381.Generators()
+
+	sc := &syncCommittee{committee: &ethereum.SyncCommittee{}}
+	var aggregate bls12381.G1Jac
+	for i := int64(0); i < syncCommitteeSize; i++ {
+		secretKey := big.NewInt(seed*1000 + i + 1)
+
+		var pubkey bls12381.G1Affine
+		pubkey.ScalarMultiplication(&g1, secretKey)
+		aggregate.AddMixed(&pubkey)
+
+		pubkeyBz := pubkey.Bytes()
+		sc.secretKeys = append(sc.secretKeys, secretKey)
+		sc.committee.Pubkeys = append(sc.committee.Pubkeys, pubkeyBz[:])
+	}
+
+	var aggregatePubkey bls12381.G1Affine
+	aggregatePubkey.FromJacobian(&aggregate)
+	aggregatePubkeyBz := aggregatePubkey.Bytes()
+	sc.committee.AggregatePubkey = aggregatePubkeyBz[:]
+
+	return sc
+}
+
+// sign returns the sync aggregate of the first participants members of the sync committee over the message.
+func (sc *syncCommittee) sign(msg []byte, participants int) *ethereum.SyncAggregate {
+	return &ethereum.SyncAggregate{
+		SyncCommitteeBits:      participationBits(participants),
+		SyncCommitteeSignature: signBLS(sc.secretKeys[:participants], msg),
+	}
+}
+
+// participationBits returns sync committee bits with the first participants bits set.
+func participationBits(participants int) []byte {
+	syncCommitteeBits := make([]byte, syncCommitteeSize/8)
+	for i := 0; i < participants; i++ {
+		syncCommitteeBits[i/8] |= 1 << (uint(i) % 8)
+	}
+
+	return syncCommitteeBits
+}
+
+// signBLS returns the aggregate BLS signature of the secret keys over the message.
+func signBLS(secretKeys []*big.Int, msg []byte) []byte {
+	aggregateSecretKey := new(big.Int)
+	for _, secretKey := range secretKeys {
+		aggregateSecretKey.Add(aggregateSecretKey, secretKey)
+	}
+
+	msgPoint, err := bls12381.HashToG2(msg, ethereum.BLSSignatureDST)
+	if err != nil {
+		panic(err)
+	}
+
+	var signature bls12381.G2Affine
+	signature.ScalarMultiplication(&msgPoint, aggregateSecretKey)
+	signatureBz := signature.Bytes()
+
+	return signatureBz[:]
+}
+
+// merkleTree is a sparse SSZ merkle tree of leaves indexed by generalized index. All other leaves are zero.
+type merkleTree map[uint64][32]byte
+
+// node returns the node at the given generalized index.
+func (t merkleTree) node(gindex uint64) [32]byte {
+	if leaf, found := t[gindex]; found {
+		return leaf
+	}
+
+	if bits.Len64(gindex)-1 >= t.depth() {
+		return [32]byte{}
+	}
+
+	left, right := t.node(2*gindex), t.node(2*gindex+1)
+	return sha256.Sum256(append(left[:], right[:]...))
+}
+
+// depth returns the depth of the deepest leaf of the tree.
+func (t merkleTree) depth() int {
+	var depth int
+	for gindex := range t {
+		if d := bits.Len64(gindex) - 1; d > depth {
+			depth = d
+		}
+	}
+
+	return depth
+}
+
+// root returns the root of the tree.
+func (t merkleTree) root() []byte {
+	root := t.node(1)
+	return root[:]
+}
+
+// branch returns the merkle branch of the leaf at the given generalized index.
+func (t merkleTree) branch(gindex uint64) [][]byte {
+	var branch [][]byte
+	for ; gindex > 1; gindex /= 2 {
+		sibling := t.node(gindex ^ 1)
+		branch = append(branch, sibling[:])
+	}
+
+	return branch
+}
+
+// toChunk returns the 32 byte root as a chunk.
+func toChunk(root []byte) [32]byte {
+	var chunk [32]byte
+	copy(chunk[:], root)
+	return chunk
+}
+
+// rlpString returns the RLP encoding of the byte string.
+func rlpString(bz []byte) []byte {
+	if len(bz) == 1 && bz[0] < 0x80 {
+		return bz
+	}
+
+	return append(rlpHeader(0x80, len(bz)), bz...)
+}
+
+// rlpList returns the RLP encoding of the list of encoded items.
+func rlpList(items ...[]byte) []byte {
+	content := bytes.Join(items, nil)
+	return append(rlpHeader(0xc0, len(content)), content...)
+}
+
+// rlpHeader returns the RLP header of a string or list of the given content length.
+func rlpHeader(offset byte, length int) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+
+	lengthBz := big.NewInt(int64(length)).Bytes()
+	return append([]byte{offset + 55 + byte(len(lengthBz))}, lengthBz...)
+}
+
+// rlpAccount returns the RLP encoding of an account with zero nonce and balance.
+func rlpAccount(storageRoot, codeHash []byte) []byte {
+	return rlpList(rlpString(nil), rlpString(nil), rlpString(storageRoot), rlpString(codeHash))
+}
+
+// buildTrie builds a Merkle-Patricia trie of the entries, whose keys are expected to be hashed, and returns its root
+// and all of its nodes, which may be used as proof of any key.
+func buildTrie(entries map[string][]byte) ([]byte, [][]byte) {
+	if len(entries) == 0 {
+		return ethereum.Keccak256([]byte{0x80}), nil
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var nodes [][]byte
+	rootNode := buildTrieNode(keys, entries, 0, &nodes)
+	nodes = append(nodes, rootNode)
+
+	return ethereum.Keccak256(rootNode), nodes
+}
+
+// buildTrieNode returns the encoding of the trie node of the sorted keys from the given nibble depth.
+func buildTrieNode(keys []string, entries map[string][]byte, depth int, nodes *[][]byte) []byte {
+	if len(keys) == 1 {
+		return rlpList(rlpString(compactPath(nibbles(keys[0])[depth:], true)), rlpString(entries[keys[0]]))
+	}
+
+	// extension node for the common prefix of the keys
+	first, last := nibbles(keys[0]), nibbles(keys[len(keys)-1])
+	prefixLength := 0
+	for depth+prefixLength < len(first) && first[depth+prefixLength] == last[depth+prefixLength] {
+		prefixLength++
+	}
+
+	if prefixLength > 0 {
+		child := buildTrieNode(keys, entries, depth+prefixLength, nodes)
+		return rlpList(rlpString(compactPath(first[depth:depth+prefixLength], false)), trieNodeReference(child, nodes))
+	}
+
+	// branch node for the keys grouped by the nibble at the depth
+	children := make([][]byte, 17)
+	for i := range children {
+		children[i] = rlpString(nil)
+	}
+
+	for start := 0; start < len(keys); {
+		nibble := nibbles(keys[start])[depth]
+		end := start
+		for end < len(keys) && nibbles(keys[end])[depth] == nibble {
+			end++
+		}
+
+		children[nibble] = trieNodeReference(buildTrieNode(keys[start:end], entries, depth+1, nodes), nodes)
+		start = end
+	}
+
+	return rlpList(children...)
+}
+
+// trieNodeReference returns the reference to the node in its parent node, which is the node itself if its encoding is
+// shorter than 32 bytes and the hash of the node otherwise.
+func trieNodeReference(node []byte, nodes *[][]byte) []byte {
+	if len(node) < 32 {
+		return node
+	}
+
+	*nodes = append(*nodes, node)
+	return rlpString(ethereum.Keccak256(node))
+}
+
+// nibbles returns the nibbles of the key.
+func nibbles(key string) []byte {
+	nibbles := make([]byte, 0, 2*len(key))
+	for _, b := range []byte(key) {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+
+	return nibbles
+}
+
+// compactPath returns the hex prefix encoding of the nibbles of a leaf or extension node path.
+func compactPath(path []byte, isLeaf bool) []byte {
+	var flag byte
+	if isLeaf {
+		flag = 2
+	}
+
+	if len(path)%2 == 1 {
+		encoded := []byte{(flag+1)<<4 | path[0]}
+		for i := 1; i < len(path); i += 2 {
+			encoded = append(encoded, path[i]<<4|path[i+1])
+		}
+		return encoded
+	}
+
+	encoded := []byte{flag << 4}
+	for i := 0; i < len(path); i += 2 {
+		encoded = append(encoded, path[i]<<4|path[i+1])
+	}
+
+	return encoded
+}
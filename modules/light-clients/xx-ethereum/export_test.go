@@ -0,0 +1,44 @@
+package ethereum
+
+// Keccak256 is a wrapper for the keccak256 function for testing.
+func Keccak256(data ...[]byte) []byte {
+	return keccak256(data...)
+}
+
+// VerifyTrieProof is a wrapper for the verifyTrieProof function for testing.
+func VerifyTrieProof(root, key []byte, proof [][]byte) ([]byte, error) {
+	return verifyTrieProof(root, key, proof)
+}
+
+// VerifyMerkleBranch is a wrapper for the verifyMerkleBranch function for testing.
+func VerifyMerkleBranch(leaf [32]byte, branch [][]byte, gindex uint64, root []byte) error {
+	return verifyMerkleBranch(leaf, branch, gindex, root)
+}
+
+// FastAggregateVerify is a wrapper for the fastAggregateVerify function for testing.
+func FastAggregateVerify(pubkeys [][]byte, msg []byte, signature []byte) error {
+	return fastAggregateVerify(pubkeys, msg, signature)
+}
+
+// SyncCommitteeSigningRoot returns the signing root of the attested header signed by the sync committee at the given
+// signature slot for testing.
+func (cs ClientState) SyncCommitteeSigningRoot(attested BeaconBlockHeader, signatureSlot uint64) [32]byte {
+	forkVersion := cs.ForkParameters.forkVersionAtEpoch(cs.computeEpoch(signatureSlot - 1))
+	domain := computeDomain(domainSyncCommittee, forkVersion, cs.GenesisValidatorsRoot)
+	return computeSigningRoot(attested.HashTreeRoot(), domain)
+}
+
+// CommitmentStorageSlot is a wrapper for the commitmentStorageSlot function for testing.
+func (cs ClientState) CommitmentStorageSlot(key []byte) []byte {
+	return cs.commitmentStorageSlot(key)
+}
+
+// BLSSignatureDST is the domain separation tag of sync committee signatures for testing.
+var BLSSignatureDST = blsSignatureDST
+
+const (
+	FinalizedRootGindex             = finalizedRootGindex
+	NextSyncCommitteeGindex         = nextSyncCommitteeGindex
+	ExecutionStateRootGindexDeneb   = executionStateRootGindexDeneb
+	ExecutionStateRootGindexCapella = executionStateRootGindexCapella
+)
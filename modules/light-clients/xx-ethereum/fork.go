@@ -0,0 +1,96 @@
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// forkID identifies the beacon chain forks known to the client, in activation order.
+type forkID int
+
+const (
+	forkGenesis forkID = iota
+	forkAltair
+	forkBellatrix
+	forkCapella
+	forkDeneb
+	forkElectra
+)
+
+// forks returns the forks of the fork parameters in activation order, excluding the genesis fork.
+func (fp ForkParameters) forks() []Fork {
+	return []Fork{fp.Altair, fp.Bellatrix, fp.Capella, fp.Deneb, fp.Electra}
+}
+
+// Validate performs a basic validation of the fork parameters. Fork versions must be 4 bytes long and fork epochs must
+// be non-decreasing. Forks which are not scheduled are expected to have the maximum uint64 value as epoch.
+func (fp ForkParameters) Validate() error {
+	if len(fp.GenesisForkVersion) != forkVersionLength {
+		return errorsmod.Wrapf(ErrInvalidForkParameters, "genesis fork version must be %d bytes, got %d", forkVersionLength, len(fp.GenesisForkVersion))
+	}
+
+	var previousEpoch uint64
+	for i, fork := range fp.forks() {
+		if len(fork.Version) != forkVersionLength {
+			return errorsmod.Wrapf(ErrInvalidForkParameters, "fork %d version must be %d bytes, got %d", i+1, forkVersionLength, len(fork.Version))
+		}
+		if fork.Epoch < previousEpoch {
+			return errorsmod.Wrapf(ErrInvalidForkParameters, "fork %d epoch %d is before the epoch %d of the previous fork", i+1, fork.Epoch, previousEpoch)
+		}
+		previousEpoch = fork.Epoch
+	}
+
+	return nil
+}
+
+// forkAtEpoch returns the latest fork activated at the given epoch.
+func (fp ForkParameters) forkAtEpoch(epoch uint64) forkID {
+	forks := fp.forks()
+	for i := len(forks) - 1; i >= 0; i-- {
+		if epoch >= forks[i].Epoch {
+			return forkID(i + 1)
+		}
+	}
+
+	return forkGenesis
+}
+
+// forkVersionAtEpoch returns the version of the latest fork activated at the given epoch.
+func (fp ForkParameters) forkVersionAtEpoch(epoch uint64) []byte {
+	fork := fp.forkAtEpoch(epoch)
+	if fork == forkGenesis {
+		return fp.GenesisForkVersion
+	}
+
+	return fp.forks()[fork-1].Version
+}
+
+// finalizedRootGindexAtFork returns the generalized index of the finalized root in the beacon state of the given fork.
+func finalizedRootGindexAtFork(fork forkID) uint64 {
+	if fork >= forkElectra {
+		return finalizedRootGindexElectra
+	}
+
+	return finalizedRootGindex
+}
+
+// nextSyncCommitteeGindexAtFork returns the generalized index of the next sync committee in the beacon state of the given fork.
+func nextSyncCommitteeGindexAtFork(fork forkID) uint64 {
+	if fork >= forkElectra {
+		return nextSyncCommitteeGindexElectra
+	}
+
+	return nextSyncCommitteeGindex
+}
+
+// executionStateRootGindexAtFork returns the generalized index of the execution state root in the beacon block body of
+// the given fork. Execution payload headers are only provable from the Capella fork onwards.
+func executionStateRootGindexAtFork(fork forkID) (uint64, error) {
+	switch {
+	case fork >= forkDeneb:
+		return executionStateRootGindexDeneb, nil
+	case fork == forkCapella:
+		return executionStateRootGindexCapella, nil
+	default:
+		return 0, errorsmod.Wrapf(ErrUnsupportedFork, "execution state root cannot be proven before the Capella fork")
+	}
+}
@@ -0,0 +1,103 @@
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Header)(nil)
+
+// ClientType defines that the Header is an Ethereum header.
+func (Header) ClientType() string {
+	return exported.Ethereum
+}
+
+// GetHeight returns the height of the header, which is the slot of its finalized beacon block header.
+func (h Header) GetHeight() clienttypes.Height {
+	return clienttypes.NewHeight(0, h.ConsensusUpdate.FinalizedHeader.Slot)
+}
+
+// ValidateBasic performs basic validation of the header fields. The sync committee and signatures are verified
+// against the client state and trusted consensus state during header verification.
+func (h Header) ValidateBasic() error {
+	if h.TrustedHeight.RevisionNumber != 0 || h.TrustedHeight.RevisionHeight == 0 {
+		return errorsmod.Wrapf(ErrInvalidHeader, "trusted height must have revision number 0 and a non-zero revision height, got %s", h.TrustedHeight)
+	}
+
+	if h.SyncCommittee == nil {
+		return errorsmod.Wrap(ErrInvalidSyncCommittee, "sync committee cannot be nil")
+	}
+	if err := h.SyncCommittee.ValidateBasic(); err != nil {
+		return err
+	}
+
+	update := h.ConsensusUpdate
+	if update == nil {
+		return errorsmod.Wrap(ErrInvalidHeader, "consensus update cannot be nil")
+	}
+	if update.AttestedHeader == nil || update.FinalizedHeader == nil {
+		return errorsmod.Wrap(ErrInvalidHeader, "attested and finalized headers cannot be nil")
+	}
+	if err := update.AttestedHeader.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid attested header")
+	}
+	if err := update.FinalizedHeader.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid finalized header")
+	}
+	if update.FinalizedHeader.Slot == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "finalized header slot cannot be 0")
+	}
+	if update.NextSyncCommittee != nil {
+		if err := update.NextSyncCommittee.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "invalid next sync committee")
+		}
+	}
+	if len(update.ExecutionStateRoot) != rootLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution state root must be %d bytes, got %d", rootLength, len(update.ExecutionStateRoot))
+	}
+	if update.SyncAggregate == nil {
+		return errorsmod.Wrap(ErrInvalidHeader, "sync aggregate cannot be nil")
+	}
+	if len(update.SyncAggregate.SyncCommitteeSignature) != blsSignatureLength {
+		return errorsmod.Wrapf(ErrInvalidSignature, "signature must be %d bytes, got %d", blsSignatureLength, len(update.SyncAggregate.SyncCommitteeSignature))
+	}
+
+	if h.AccountProof == nil {
+		return errorsmod.Wrap(ErrInvalidHeader, "account proof cannot be nil")
+	}
+	if len(h.AccountProof.StorageRoot) != rootLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "storage root must be %d bytes, got %d", rootLength, len(h.AccountProof.StorageRoot))
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the beacon block header roots.
+func (h BeaconBlockHeader) ValidateBasic() error {
+	for _, root := range [][]byte{h.ParentRoot, h.StateRoot, h.BodyRoot} {
+		if len(root) != rootLength {
+			return errorsmod.Wrapf(ErrInvalidHeader, "beacon block header roots must be %d bytes, got %d", rootLength, len(root))
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the lengths of the sync committee public keys.
+func (sc SyncCommittee) ValidateBasic() error {
+	if len(sc.Pubkeys) == 0 {
+		return errorsmod.Wrap(ErrInvalidSyncCommittee, "sync committee cannot be empty")
+	}
+	for i, pubkey := range sc.Pubkeys {
+		if len(pubkey) != blsPubkeyLength {
+			return errorsmod.Wrapf(ErrInvalidSyncCommittee, "public key %d must be %d bytes, got %d", i, blsPubkeyLength, len(pubkey))
+		}
+	}
+	if len(sc.AggregatePubkey) != blsPubkeyLength {
+		return errorsmod.Wrapf(ErrInvalidSyncCommittee, "aggregate public key must be %d bytes, got %d", blsPubkeyLength, len(sc.AggregatePubkey))
+	}
+
+	return nil
+}
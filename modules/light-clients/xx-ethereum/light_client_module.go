@@ -0,0 +1,248 @@
+package ethereum
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule implements the core IBC api.LightClientModule interface.
+type LightClientModule struct {
+	cdc           codec.BinaryCodec
+	storeProvider exported.ClientStoreProvider
+}
+
+// NewLightClientModule creates and returns a new xx-ethereum LightClientModule.
+func NewLightClientModule(cdc codec.BinaryCodec) LightClientModule {
+	return LightClientModule{
+		cdc: cdc,
+	}
+}
+
+// RegisterStoreProvider is called by core IBC when a LightClientModule is added to the router.
+// It allows the LightClientModule to set a ClientStoreProvider which supplies isolated prefix client stores
+// to IBC light client instances.
+func (l *LightClientModule) RegisterStoreProvider(storeProvider exported.ClientStoreProvider) {
+	l.storeProvider = storeProvider
+}
+
+// Initialize unmarshals the provided client and consensus states and performs basic validation. It stores the client
+// state, the initial consensus state at the latest height of the client state and its processed time and height.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientStateBz, consensusStateBz []byte) error {
+	var clientState ClientState
+	if err := l.cdc.Unmarshal(clientStateBz, &clientState); err != nil {
+		return fmt.Errorf("failed to unmarshal client state bytes into client state: %w", err)
+	}
+
+	if err := clientState.Validate(); err != nil {
+		return err
+	}
+
+	var consensusState ConsensusState
+	if err := l.cdc.Unmarshal(consensusStateBz, &consensusState); err != nil {
+		return fmt.Errorf("failed to unmarshal consensus state bytes into consensus state: %w", err)
+	}
+
+	if err := consensusState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+
+	return clientState.initialize(ctx, l.cdc, clientStore, &consensusState)
+}
+
+// VerifyClientMessage obtains the client state associated with the client identifier and calls into the clientState.VerifyClientMessage method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyClientMessage(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// CheckForMisbehaviour obtains the client state associated with the client identifier and calls into the clientState.CheckForMisbehaviour method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) CheckForMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) bool {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.CheckForMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState.
+// This method should only be called when misbehaviour is detected as it does not perform
+// any misbehaviour checks.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientState.FrozenHeight = FrozenHeight
+	setClientState(clientStore, l.cdc, clientState)
+}
+
+// UpdateState obtains the client state associated with the client identifier and calls into the clientState.UpdateState method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
+}
+
+// VerifyMembership obtains the client state associated with the client identifier and calls into the clientState.VerifyMembership method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyNonMembership obtains the client state associated with the client identifier and calls into the clientState.VerifyNonMembership method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.VerifyNonMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
+// Status returns the status of the ethereum client.
+// The client may be:
+// - Active: frozen height is zero and the sync committee of the current period is known
+// - Frozen: frozen height is not zero
+// - Expired: the sync committee of the current period cannot be known from the latest consensus state
+// - Unknown: the client state associated with the provided client identifier is not found
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return exported.Unknown
+	}
+
+	return clientState.status(ctx, clientStore, l.cdc)
+}
+
+// LatestHeight returns the latest height for the client state for the given client identifier.
+// If no client is present for the provided client identifier a zero value height is returned.
+// NOTE: RevisionNumber is always 0 for ethereum client heights and RevisionHeight is the beacon chain slot.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) LatestHeight(ctx sdk.Context, clientID string) exported.Height {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return clienttypes.ZeroHeight()
+	}
+
+	return clientState.LatestHeight
+}
+
+// TimestampAtHeight obtains the consensus state at the given height and returns its timestamp in nanoseconds.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) TimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	if _, found := getClientState(clientStore, l.cdc); !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	consensusState, found := GetConsensusState(clientStore, l.cdc, height)
+	if !found {
+		return 0, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
+	}
+
+	return consensusState.GetTimestamp(), nil
+}
+
+// RecoverClient asserts that the substitute client is an ethereum client. It obtains the client state associated with the
+// subject client and calls into the subjectClientState.CheckSubstituteAndUpdateState method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
+	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
+	if err != nil {
+		return err
+	}
+
+	if substituteClientType != exported.Ethereum {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Ethereum, substituteClientType)
+	}
+
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	substituteClientStore := l.storeProvider.ClientStore(ctx, substituteClientID)
+	substituteClient, found := getClientState(substituteClientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
+	}
+
+	return clientState.CheckSubstituteAndUpdateState(ctx, l.cdc, clientStore, substituteClientStore, substituteClient)
+}
+
+// VerifyUpgradeAndUpdateState returns an error since the ethereum client does not support upgrades.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format xx-ethereum-{n}.
+func (LightClientModule) VerifyUpgradeAndUpdateState(ctx sdk.Context, clientID string, newClient, newConsState, upgradeClientProof, upgradeConsensusStateProof []byte) error {
+	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade ethereum client")
+}
@@ -0,0 +1,55 @@
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Misbehaviour)(nil)
+
+// FrozenHeight is same for all misbehaviour
+var FrozenHeight = clienttypes.NewHeight(0, 1)
+
+// NewMisbehaviour creates a new Misbehaviour instance.
+func NewMisbehaviour(header1, header2 *Header) *Misbehaviour {
+	return &Misbehaviour{
+		Header1: header1,
+		Header2: header2,
+	}
+}
+
+// ClientType is Ethereum light client
+func (Misbehaviour) ClientType() string {
+	return exported.Ethereum
+}
+
+// ValidateBasic implements Misbehaviour interface. Both headers must be valid and finalize different beacon block
+// headers at the same slot.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if misbehaviour.Header1 == nil {
+		return errorsmod.Wrap(ErrInvalidHeader, "misbehaviour Header1 cannot be nil")
+	}
+	if misbehaviour.Header2 == nil {
+		return errorsmod.Wrap(ErrInvalidHeader, "misbehaviour Header2 cannot be nil")
+	}
+
+	if err := misbehaviour.Header1.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "header 1 failed validation")
+	}
+	if err := misbehaviour.Header2.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "header 2 failed validation")
+	}
+
+	if !misbehaviour.Header1.GetHeight().EQ(misbehaviour.Header2.GetHeight()) {
+		return errorsmod.Wrapf(ErrInvalidMisbehaviour, "headers must finalize the same slot, got %s and %s",
+			misbehaviour.Header1.GetHeight(), misbehaviour.Header2.GetHeight())
+	}
+
+	if misbehaviour.Header1.ConsensusUpdate.FinalizedHeader.HashTreeRoot() == misbehaviour.Header2.ConsensusUpdate.FinalizedHeader.HashTreeRoot() {
+		return errorsmod.Wrap(ErrInvalidMisbehaviour, "headers must finalize conflicting beacon block headers")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package ethereum
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// CheckForMisbehaviour detects duplicate height misbehaviour and conflicting finalized headers.
+// A header is misbehaviour if a consensus state already exists at its height which does not match the consensus
+// state the header would create. A verified Misbehaviour is always misbehaviour, as its headers are verified to
+// finalize conflicting beacon block headers at the same slot.
+func (cs *ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+	switch msg := msg.(type) {
+	case *Header:
+		existingConsState, found := GetConsensusState(clientStore, cdc, msg.GetHeight())
+		if !found {
+			return false
+		}
+
+		// the finalized header of a slot determines its execution state root, storage root and timestamp
+		update := msg.ConsensusUpdate
+		return !bytes.Equal(existingConsState.StateRoot, update.ExecutionStateRoot) ||
+			!bytes.Equal(existingConsState.StorageRoot, msg.AccountProof.StorageRoot) ||
+			existingConsState.Timestamp != cs.computeTimestamp(update.FinalizedHeader.Slot)
+	case *Misbehaviour:
+		// the misbehaviour headers have been verified to finalize conflicting headers in VerifyClientMessage
+		return true
+	}
+
+	return false
+}
+
+// verifyMisbehaviour determines whether or not the two headers of the misbehaviour were both signed by a trusted sync
+// committee. Conflicting finalized headers at the same slot are checked in Misbehaviour.ValidateBasic.
+func (cs *ClientState) verifyMisbehaviour(ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, misbehaviour *Misbehaviour) error {
+	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.Header1); err != nil {
+		return errorsmod.Wrap(err, "verifying Header1 in Misbehaviour failed")
+	}
+
+	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.Header2); err != nil {
+		return errorsmod.Wrap(err, "verifying Header2 in Misbehaviour failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package ethereum
+
+import (
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// CheckSubstituteAndUpdateState will try to update the client with the state of the
+// substitute.
+//
+// The following must always be true:
+//   - The substitute client is the same type as the subject client
+//   - The subject and substitute client states match in all parameters (expect frozen height and latest height)
+//
+// The client is unfrozen by resetting the FrozenHeight to the zero Height, and the latest consensus state of the
+// substitute is copied to the subject.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore storetypes.KVStore, substituteClient exported.ClientState,
+) error {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient)
+	}
+
+	if !IsMatchingClientState(cs, *substituteClientState) {
+		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
+	}
+
+	// copy the latest consensus state and processed time from substitute to subject
+	height := substituteClientState.LatestHeight
+
+	consensusState, found := GetConsensusState(substituteClientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	setConsensusState(subjectClientStore, cdc, consensusState, height)
+
+	// set metadata stored for the substitute consensus state
+	processedHeight, found := getProcessedHeight(substituteClientStore, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed height for substitute client latest height")
+	}
+
+	processedTime, found := getProcessedTime(substituteClientStore, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed time for substitute client latest height")
+	}
+
+	setConsensusMetadataWithValues(subjectClientStore, height, processedHeight, processedTime)
+
+	cs.LatestHeight = substituteClientState.LatestHeight
+	cs.FrozenHeight = clienttypes.ZeroHeight()
+
+	// no validation is necessary since the substitute is verified to be Active
+	// in 02-client.
+	setClientState(subjectClientStore, cdc, &cs)
+
+	return nil
+}
+
+// IsMatchingClientState returns true if all the client state parameters match
+// except for frozen height and latest height.
+func IsMatchingClientState(subject, substitute ClientState) bool {
+	// zero out parameters which do not need to match
+	subject.LatestHeight = clienttypes.ZeroHeight()
+	subject.FrozenHeight = clienttypes.ZeroHeight()
+	substitute.LatestHeight = clienttypes.ZeroHeight()
+	substitute.FrozenHeight = clienttypes.ZeroHeight()
+
+	return reflect.DeepEqual(subject, substitute)
+}
@@ -0,0 +1,156 @@
+package ethereum
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// rootLength is the length in bytes of SSZ roots, such as block and state roots.
+	rootLength = 32
+	// blsPubkeyLength is the length in bytes of compressed BLS public keys.
+	blsPubkeyLength = 48
+	// blsSignatureLength is the length in bytes of compressed BLS signatures.
+	blsSignatureLength = 96
+	// forkVersionLength is the length in bytes of fork versions.
+	forkVersionLength = 4
+)
+
+// Generalized indices of the leaves proven by light client updates, as defined by the light client sync protocol.
+// The execution state root generalized indices locate the state root of the execution payload in a beacon block body.
+const (
+	finalizedRootGindex             = 105
+	finalizedRootGindexElectra      = 169
+	nextSyncCommitteeGindex         = 55
+	nextSyncCommitteeGindexElectra  = 87
+	executionStateRootGindexCapella = 402
+	executionStateRootGindexDeneb   = 802
+)
+
+// domainSyncCommittee is the domain type of sync committee signatures.
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// hashPair returns the sha256 hash of the concatenation of the two chunks.
+func hashPair(left, right [32]byte) [32]byte {
+	return sha256.Sum256(append(left[:], right[:]...))
+}
+
+// merkleize returns the merkle root of the given chunks, padded with zero chunks to the next power of two.
+func merkleize(chunks [][32]byte) [32]byte {
+	if len(chunks) == 0 {
+		return [32]byte{}
+	}
+
+	layer := make([][32]byte, 1<<bits.Len(uint(len(chunks)-1)))
+	copy(layer, chunks)
+
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+
+	return layer[0]
+}
+
+// packBytes packs the given bytes into zero padded chunks.
+func packBytes(bz []byte) [][32]byte {
+	chunks := make([][32]byte, (len(bz)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], bz[i*32:])
+	}
+
+	return chunks
+}
+
+// uint64Chunk returns the SSZ chunk of the given uint64.
+func uint64Chunk(value uint64) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:], value)
+	return chunk
+}
+
+// bytesRoot returns the hash tree root of a fixed length byte vector.
+func bytesRoot(bz []byte) [32]byte {
+	return merkleize(packBytes(bz))
+}
+
+// rootChunk returns the given root as a chunk. The root must be 32 bytes long.
+func rootChunk(root []byte) [32]byte {
+	var chunk [32]byte
+	copy(chunk[:], root)
+	return chunk
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the beacon block header, which is the root of the block.
+func (h BeaconBlockHeader) HashTreeRoot() [32]byte {
+	return merkleize([][32]byte{
+		uint64Chunk(h.Slot),
+		uint64Chunk(h.ProposerIndex),
+		rootChunk(h.ParentRoot),
+		rootChunk(h.StateRoot),
+		rootChunk(h.BodyRoot),
+	})
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the sync committee.
+func (sc SyncCommittee) HashTreeRoot() [32]byte {
+	pubkeyRoots := make([][32]byte, len(sc.Pubkeys))
+	for i, pubkey := range sc.Pubkeys {
+		pubkeyRoots[i] = bytesRoot(pubkey)
+	}
+
+	return hashPair(merkleize(pubkeyRoots), bytesRoot(sc.AggregatePubkey))
+}
+
+// computeForkDataRoot returns the hash tree root of the fork data of the given fork version and genesis validators root.
+func computeForkDataRoot(forkVersion, genesisValidatorsRoot []byte) [32]byte {
+	return hashPair(bytesRoot(forkVersion), rootChunk(genesisValidatorsRoot))
+}
+
+// computeDomain returns the signature domain of the given domain type, fork version and genesis validators root.
+func computeDomain(domainType [4]byte, forkVersion, genesisValidatorsRoot []byte) [32]byte {
+	forkDataRoot := computeForkDataRoot(forkVersion, genesisValidatorsRoot)
+
+	var domain [32]byte
+	copy(domain[:], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return domain
+}
+
+// computeSigningRoot returns the signing root of the object with the given hash tree root in the given domain.
+func computeSigningRoot(objectRoot, domain [32]byte) [32]byte {
+	return hashPair(objectRoot, domain)
+}
+
+// verifyMerkleBranch verifies that the leaf is included in the root at the given generalized index using the branch.
+func verifyMerkleBranch(leaf [32]byte, branch [][]byte, gindex uint64, root []byte) error {
+	depth := bits.Len64(gindex) - 1
+	if len(branch) != depth {
+		return errorsmod.Wrapf(ErrInvalidMerkleBranch, "expected branch of length %d for generalized index %d, got %d", depth, gindex, len(branch))
+	}
+
+	value := leaf
+	for i, node := range branch {
+		if len(node) != rootLength {
+			return errorsmod.Wrapf(ErrInvalidMerkleBranch, "branch node %d must be %d bytes, got %d", i, rootLength, len(node))
+		}
+
+		if (gindex>>uint(i))&1 == 1 {
+			value = hashPair(rootChunk(node), value)
+		} else {
+			value = hashPair(value, rootChunk(node))
+		}
+	}
+
+	if value != rootChunk(root) {
+		return errorsmod.Wrapf(ErrInvalidMerkleBranch, "computed root %X does not match expected root %X", value, root)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package ethereum_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ethereum "github.com/cosmos/ibc-go/modules/light-clients/xx-ethereum"
+)
+
+func TestBeaconBlockHeaderHashTreeRoot(t *testing.T) {
+	// the hash tree root of an empty header is the root of 8 zero chunks
+	header := ethereum.BeaconBlockHeader{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+
+	root := header.HashTreeRoot()
+	require.Equal(t, "c78009fdf07fc56a11f122370658a353aaa542ed63e44c4bc15ff4cd105ab33c", hex.EncodeToString(root[:]))
+}
+
+func TestVerifyMerkleBranch(t *testing.T) {
+	leaf := toChunk(bytes.Repeat([]byte{0x01}, 32))
+	tree := merkleTree{ethereum.ExecutionStateRootGindexCapella: leaf}
+
+	var (
+		branch [][]byte
+		gindex uint64
+		root   []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"branch of wrong length",
+			func() {
+				gindex = ethereum.ExecutionStateRootGindexDeneb
+			},
+			false,
+		},
+		{
+			"wrong generalized index",
+			func() {
+				gindex = ethereum.ExecutionStateRootGindexCapella + 1
+			},
+			false,
+		},
+		{
+			"invalid branch node",
+			func() {
+				branch[2] = []byte{0x01}
+			},
+			false,
+		},
+		{
+			"wrong root",
+			func() {
+				root = make([]byte, 32)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			branch = tree.branch(ethereum.ExecutionStateRootGindexCapella)
+			gindex = ethereum.ExecutionStateRootGindexCapella
+			root = tree.root()
+
+			tc.malleate()
+
+			err := ethereum.VerifyMerkleBranch(leaf, branch, gindex, root)
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ethereum.ErrInvalidMerkleBranch)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package ethereum
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var (
+	// KeyProcessedTime is appended to consensus state key to store the processed time
+	KeyProcessedTime = []byte("/processedTime")
+	// KeyProcessedHeight is appended to consensus state key to store the processed height
+	KeyProcessedHeight = []byte("/processedHeight")
+)
+
+// setClientState stores the client state
+func setClientState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, clientState *ClientState) {
+	key := host.ClientStateKey()
+	val := clienttypes.MustMarshalClientState(cdc, clientState)
+	clientStore.Set(key, val)
+}
+
+// getClientState retrieves the client state from the store using the provided KVStore and codec.
+// It returns the unmarshaled ClientState and a boolean indicating if the state was found.
+func getClientState(store storetypes.KVStore, cdc codec.BinaryCodec) (*ClientState, bool) {
+	bz := store.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	clientStateI := clienttypes.MustUnmarshalClientState(cdc, bz)
+	var clientState *ClientState
+	clientState, ok := clientStateI.(*ClientState)
+	if !ok {
+		panic(fmt.Errorf("cannot convert %T into %T", clientStateI, clientState))
+	}
+
+	return clientState, true
+}
+
+// setConsensusState stores the consensus state at the given height.
+func setConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, consensusState *ConsensusState, height exported.Height) {
+	key := host.ConsensusStateKey(height)
+	val := clienttypes.MustMarshalConsensusState(cdc, consensusState)
+	clientStore.Set(key, val)
+}
+
+// GetConsensusState retrieves the consensus state from the client prefixed store.
+// If the ConsensusState does not exist in state for the provided height a nil value and false boolean flag is returned
+func GetConsensusState(store storetypes.KVStore, cdc codec.BinaryCodec, height exported.Height) (*ConsensusState, bool) {
+	bz := store.Get(host.ConsensusStateKey(height))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	consensusStateI := clienttypes.MustUnmarshalConsensusState(cdc, bz)
+	var consensusState *ConsensusState
+	consensusState, ok := consensusStateI.(*ConsensusState)
+	if !ok {
+		panic(fmt.Errorf("cannot convert %T into %T", consensusStateI, consensusState))
+	}
+
+	return consensusState, true
+}
+
+// processedTimeKey returns the key under which the processed time will be stored in the client store.
+func processedTimeKey(height exported.Height) []byte {
+	return append(host.ConsensusStateKey(height), KeyProcessedTime...)
+}
+
+// processedHeightKey returns the key under which the processed height will be stored in the client store.
+func processedHeightKey(height exported.Height) []byte {
+	return append(host.ConsensusStateKey(height), KeyProcessedHeight...)
+}
+
+// getProcessedTime gets the time (in nanoseconds) at which this chain received and processed an ethereum header.
+// This is used to validate that a received packet has passed the time delay period.
+func getProcessedTime(clientStore storetypes.KVStore, height exported.Height) (uint64, bool) {
+	bz := clientStore.Get(processedTimeKey(height))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// getProcessedHeight gets the height at which this chain received and processed an ethereum header.
+// This is used to validate that a received packet has passed the block delay period.
+func getProcessedHeight(clientStore storetypes.KVStore, height exported.Height) (exported.Height, bool) {
+	bz := clientStore.Get(processedHeightKey(height))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	processedHeight, err := clienttypes.ParseHeight(string(bz))
+	if err != nil {
+		return nil, false
+	}
+
+	return processedHeight, true
+}
+
+// setConsensusMetadata sets context time and height as processed time and processed height for the consensus state
+// at the given height.
+func setConsensusMetadata(ctx sdk.Context, clientStore storetypes.KVStore, height exported.Height) {
+	setConsensusMetadataWithValues(clientStore, height, clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano()))
+}
+
+// setConsensusMetadataWithValues sets the provided processed height and processed time for the consensus state at the
+// given height.
+func setConsensusMetadataWithValues(clientStore storetypes.KVStore, height, processedHeight exported.Height, processedTime uint64) {
+	clientStore.Set(processedTimeKey(height), sdk.Uint64ToBigEndian(processedTime))
+	clientStore.Set(processedHeightKey(height), []byte(processedHeight.String()))
+}
@@ -0,0 +1,323 @@
+package ethereum
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// emptyTrieRoot is the root of an empty Merkle-Patricia trie, i.e. the keccak256 hash of the RLP encoding of an empty string.
+var emptyTrieRoot = keccak256([]byte{0x80})
+
+// keccak256 returns the legacy keccak256 hash of the concatenation of the given byte slices.
+func keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+
+	return hasher.Sum(nil)
+}
+
+// rlpItem is a decoded RLP item. The content of lists is the concatenation of the encodings of their items.
+type rlpItem struct {
+	isList  bool
+	content []byte
+	// raw is the full RLP encoding of the item.
+	raw []byte
+}
+
+// rlpSplit decodes the first RLP item of the given bytes and returns it with the remaining bytes.
+func rlpSplit(bz []byte) (rlpItem, []byte, error) {
+	if len(bz) == 0 {
+		return rlpItem{}, nil, fmt.Errorf("unexpected end of RLP input")
+	}
+
+	var (
+		isList        bool
+		headerLength  int
+		contentLength uint64
+	)
+
+	prefix := bz[0]
+	switch {
+	case prefix < 0x80:
+		return rlpItem{content: bz[:1], raw: bz[:1]}, bz[1:], nil
+	case prefix < 0xb8:
+		headerLength, contentLength = 1, uint64(prefix-0x80)
+		if contentLength == 1 && len(bz) > 1 && bz[1] < 0x80 {
+			return rlpItem{}, nil, fmt.Errorf("non-canonical RLP encoding of single byte")
+		}
+	case prefix < 0xc0:
+		lengthOfLength := int(prefix - 0xb7)
+		length, err := rlpReadLength(bz[1:], lengthOfLength)
+		if err != nil {
+			return rlpItem{}, nil, err
+		}
+		headerLength, contentLength = 1+lengthOfLength, length
+	case prefix < 0xf8:
+		isList, headerLength, contentLength = true, 1, uint64(prefix-0xc0)
+	default:
+		lengthOfLength := int(prefix - 0xf7)
+		length, err := rlpReadLength(bz[1:], lengthOfLength)
+		if err != nil {
+			return rlpItem{}, nil, err
+		}
+		isList, headerLength, contentLength = true, 1+lengthOfLength, length
+	}
+
+	if contentLength > uint64(len(bz)-headerLength) {
+		return rlpItem{}, nil, fmt.Errorf("RLP item length %d exceeds input length %d", contentLength, len(bz)-headerLength)
+	}
+
+	end := headerLength + int(contentLength)
+	return rlpItem{isList: isList, content: bz[headerLength:end], raw: bz[:end]}, bz[end:], nil
+}
+
+// rlpReadLength reads a big endian length of the given number of bytes.
+func rlpReadLength(bz []byte, lengthOfLength int) (uint64, error) {
+	if lengthOfLength > 8 || len(bz) < lengthOfLength {
+		return 0, fmt.Errorf("invalid RLP length of length %d", lengthOfLength)
+	}
+	if bz[0] == 0 {
+		return 0, fmt.Errorf("non-canonical RLP length with leading zero")
+	}
+
+	var buf [8]byte
+	copy(buf[8-lengthOfLength:], bz[:lengthOfLength])
+	length := binary.BigEndian.Uint64(buf[:])
+	if length < 56 {
+		return 0, fmt.Errorf("non-canonical RLP length %d", length)
+	}
+
+	return length, nil
+}
+
+// rlpDecodeList decodes the given bytes as a single RLP list and returns its items.
+func rlpDecodeList(bz []byte) ([]rlpItem, error) {
+	list, rest, err := rlpSplit(bz)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("unexpected %d trailing bytes after RLP list", len(rest))
+	}
+	if !list.isList {
+		return nil, fmt.Errorf("expected RLP list")
+	}
+
+	var items []rlpItem
+	for content := list.content; len(content) > 0; {
+		var item rlpItem
+		item, content, err = rlpSplit(content)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// rlpDecodeString decodes the given bytes as a single RLP string.
+func rlpDecodeString(bz []byte) ([]byte, error) {
+	item, rest, err := rlpSplit(bz)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 || item.isList {
+		return nil, fmt.Errorf("expected a single RLP string")
+	}
+
+	return item.content, nil
+}
+
+// keyNibbles returns the nibbles of the given key.
+func keyNibbles(key []byte) []byte {
+	nibbles := make([]byte, 2*len(key))
+	for i, b := range key {
+		nibbles[2*i] = b >> 4
+		nibbles[2*i+1] = b & 0x0f
+	}
+
+	return nibbles
+}
+
+// decodeCompactPath decodes a hex prefix encoded path of a leaf or extension node and returns its nibbles
+// and whether the node is a leaf.
+func decodeCompactPath(path []byte) ([]byte, bool, error) {
+	if len(path) == 0 {
+		return nil, false, fmt.Errorf("empty compact path")
+	}
+
+	flag := path[0] >> 4
+	if flag > 3 {
+		return nil, false, fmt.Errorf("invalid compact path flag %d", flag)
+	}
+
+	isLeaf := flag&2 != 0
+	nibbles := keyNibbles(path)[2:]
+	if flag&1 != 0 {
+		nibbles = keyNibbles(path)[1:]
+	}
+
+	return nibbles, isLeaf, nil
+}
+
+// verifyTrieProof verifies the Merkle-Patricia trie proof of the given key against the root. The value stored at the
+// key is returned, or nil if the proof proves that the key is absent from the trie. The key is expected to be hashed,
+// as in the secure tries of the execution layer state and account storage.
+func verifyTrieProof(root, key []byte, proof [][]byte) ([]byte, error) {
+	if bytes.Equal(root, emptyTrieRoot) {
+		return nil, nil
+	}
+
+	nodes := make(map[string][]byte, len(proof))
+	for _, node := range proof {
+		nodes[string(keccak256(node))] = node
+	}
+
+	path := keyNibbles(key)
+	wantHash := root
+
+	for {
+		node, found := nodes[string(wantHash)]
+		if !found {
+			return nil, errorsmod.Wrapf(ErrInvalidTrieProof, "missing trie node %X", wantHash)
+		}
+
+		child, remaining, value, err := resolveTrieNode(node, path)
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidTrieProof, err.Error())
+		}
+
+		// resolve embedded nodes, which are included in their parent node if their encoding is shorter than 32 bytes
+		for child != nil && child.isList {
+			child, remaining, value, err = resolveTrieNode(child.raw, remaining)
+			if err != nil {
+				return nil, errorsmod.Wrap(ErrInvalidTrieProof, err.Error())
+			}
+		}
+
+		switch {
+		case child == nil:
+			return value, nil
+		case len(child.content) != 32:
+			return nil, errorsmod.Wrapf(ErrInvalidTrieProof, "invalid trie node reference of length %d", len(child.content))
+		default:
+			wantHash, path = child.content, remaining
+		}
+	}
+}
+
+// resolveTrieNode resolves the given path in the trie node. It returns either the child node reference to continue
+// resolving the remaining path in, or the value stored at the path, which is nil if the path is absent from the trie.
+func resolveTrieNode(node, path []byte) (*rlpItem, []byte, []byte, error) {
+	items, err := rlpDecodeList(node)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	switch len(items) {
+	case 17:
+		if len(path) == 0 {
+			if items[16].isList {
+				return nil, nil, nil, fmt.Errorf("invalid branch node value")
+			}
+			return nil, nil, nonEmpty(items[16].content), nil
+		}
+
+		child := items[path[0]]
+		if !child.isList && len(child.content) == 0 {
+			return nil, nil, nil, nil
+		}
+
+		return &child, path[1:], nil, nil
+	case 2:
+		if items[0].isList {
+			return nil, nil, nil, fmt.Errorf("invalid short node path")
+		}
+
+		nodePath, isLeaf, err := decodeCompactPath(items[0].content)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if isLeaf {
+			if !bytes.Equal(nodePath, path) || items[1].isList {
+				return nil, nil, nil, nil
+			}
+			return nil, nil, nonEmpty(items[1].content), nil
+		}
+
+		if len(path) < len(nodePath) || !bytes.Equal(nodePath, path[:len(nodePath)]) {
+			return nil, nil, nil, nil
+		}
+
+		child := items[1]
+		return &child, path[len(nodePath):], nil, nil
+	default:
+		return nil, nil, nil, fmt.Errorf("invalid trie node with %d items", len(items))
+	}
+}
+
+// nonEmpty returns nil for empty values, which are not stored in the trie.
+func nonEmpty(value []byte) []byte {
+	if len(value) == 0 {
+		return nil
+	}
+
+	return value
+}
+
+// verifyAccountStorageRoot verifies the proof of the account at the given address against the execution state root
+// and returns the storage root of the account.
+func verifyAccountStorageRoot(stateRoot, address []byte, proof [][]byte) ([]byte, error) {
+	account, err := verifyTrieProof(stateRoot, keccak256(address), proof)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, errorsmod.Wrapf(ErrInvalidTrieProof, "account %X does not exist", address)
+	}
+
+	// accounts are encoded as the RLP list [nonce, balance, storageRoot, codeHash]
+	fields, err := rlpDecodeList(account)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidTrieProof, "failed to decode account: %s", err)
+	}
+	if len(fields) != 4 || fields[2].isList || len(fields[2].content) != 32 {
+		return nil, errorsmod.Wrap(ErrInvalidTrieProof, "invalid account encoding")
+	}
+
+	return fields[2].content, nil
+}
+
+// verifyStorageValue verifies the proof of the storage slot against the storage root and returns the value stored in
+// the slot as a 32 byte word, or nil if the slot is empty.
+func verifyStorageValue(storageRoot, slot []byte, proof [][]byte) ([]byte, error) {
+	encodedValue, err := verifyTrieProof(storageRoot, keccak256(slot), proof)
+	if err != nil {
+		return nil, err
+	}
+	if encodedValue == nil {
+		return nil, nil
+	}
+
+	// storage values are encoded as RLP strings of the value without leading zeros
+	value, err := rlpDecodeString(encodedValue)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidTrieProof, "failed to decode storage value: %s", err)
+	}
+	if len(value) > 32 {
+		return nil, errorsmod.Wrapf(ErrInvalidTrieProof, "storage value of length %d exceeds 32 bytes", len(value))
+	}
+
+	word := make([]byte, 32)
+	copy(word[32-len(value):], value)
+	return word, nil
+}
@@ -0,0 +1,98 @@
+package ethereum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ethereum "github.com/cosmos/ibc-go/modules/light-clients/xx-ethereum"
+)
+
+func TestVerifyTrieProof(t *testing.T) {
+	entries := make(map[string][]byte)
+	for i := byte(0); i < 20; i++ {
+		entries[string(ethereum.Keccak256([]byte{i}))] = rlpString(ethereum.Keccak256([]byte{i, i}))
+	}
+
+	trieRoot, nodes := buildTrie(entries)
+
+	var (
+		key   []byte
+		root  []byte
+		proof [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expValue []byte
+		expErr   error
+	}{
+		{
+			"success: membership",
+			func() {},
+			rlpString(ethereum.Keccak256([]byte{1, 1})),
+			nil,
+		},
+		{
+			"success: non-membership",
+			func() {
+				key = ethereum.Keccak256([]byte{100})
+			},
+			nil,
+			nil,
+		},
+		{
+			"success: non-membership in empty trie",
+			func() {
+				root, proof = buildTrie(nil)
+			},
+			nil,
+			nil,
+		},
+		{
+			"failure: missing trie node",
+			func() {
+				proof = proof[:len(proof)-1]
+			},
+			nil,
+			ethereum.ErrInvalidTrieProof,
+		},
+		{
+			"failure: invalid trie node",
+			func() {
+				proof = [][]byte{rlpList(rlpString([]byte{0x01}))}
+				root = ethereum.Keccak256(proof[0])
+			},
+			nil,
+			ethereum.ErrInvalidTrieProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			key = ethereum.Keccak256([]byte{1})
+			root, proof = buildTrie(entries)
+
+			tc.malleate()
+
+			value, err := ethereum.VerifyTrieProof(root, key, proof)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expValue, value)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+
+	// every entry can be proven with the nodes of the trie
+	for key, expValue := range entries {
+		value, err := ethereum.VerifyTrieProof(trieRoot, []byte(key), nodes)
+		require.NoError(t, err)
+		require.Equal(t, expValue, value)
+	}
+}
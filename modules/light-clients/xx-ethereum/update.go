@@ -0,0 +1,240 @@
+package ethereum
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+func (cs *ClientState) VerifyClientMessage(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
+	clientMsg exported.ClientMessage,
+) error {
+	switch msg := clientMsg.(type) {
+	case *Header:
+		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	case *Misbehaviour:
+		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
+	default:
+		return clienttypes.ErrInvalidClientType
+	}
+}
+
+// verifyHeader returns an error if:
+// - the trusted consensus state of the header cannot be found
+// - the slots of the update are not ordered as signature slot > attested slot >= finalized slot > trusted slot
+// - the signature slot is in the future
+// - the sync committee of the header is not the sync committee of the signature period known by the trusted consensus state
+// - less than two thirds of the sync committee participated in the signature
+// - the finality branch, next sync committee branch or execution state root branch are invalid
+// - the aggregate signature of the participants over the attested header is invalid
+// - the account proof of the IBC contract storage root against the execution state root is invalid
+func (cs *ClientState) verifyHeader(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	header *Header,
+) error {
+	trustedConsState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
+	if !found {
+		return errorsmod.Wrapf(
+			clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight,
+		)
+	}
+
+	update := header.ConsensusUpdate
+	attested, finalized := update.AttestedHeader, update.FinalizedHeader
+	trustedSlot := header.TrustedHeight.RevisionHeight
+
+	if !(update.SignatureSlot > attested.Slot && attested.Slot >= finalized.Slot && finalized.Slot > trustedSlot) {
+		return errorsmod.Wrapf(
+			ErrInvalidHeader, "expected signature slot > attested slot >= finalized slot > trusted slot, got %d, %d, %d, %d",
+			update.SignatureSlot, attested.Slot, finalized.Slot, trustedSlot,
+		)
+	}
+
+	if currentSlot := cs.currentSlot(ctx); update.SignatureSlot > currentSlot {
+		return errorsmod.Wrapf(ErrInvalidHeader, "signature slot %d is after the current slot %d", update.SignatureSlot, currentSlot)
+	}
+
+	// the sync committee signing the update must be the sync committee of the signature period, which must be either
+	// the current or the next sync committee of the trusted consensus state
+	storePeriod := cs.computeSyncCommitteePeriod(trustedSlot)
+	signaturePeriod := cs.computeSyncCommitteePeriod(update.SignatureSlot)
+	syncCommitteeRoot := header.SyncCommittee.HashTreeRoot()
+
+	switch signaturePeriod {
+	case storePeriod:
+		if !bytes.Equal(syncCommitteeRoot[:], trustedConsState.CurrentSyncCommittee) {
+			return errorsmod.Wrap(ErrInvalidSyncCommittee, "sync committee does not match the current sync committee of the trusted consensus state")
+		}
+	case storePeriod + 1:
+		if len(trustedConsState.NextSyncCommittee) == 0 {
+			return errorsmod.Wrap(ErrInvalidSyncCommittee, "next sync committee of the trusted consensus state is not known")
+		}
+		if !bytes.Equal(syncCommitteeRoot[:], trustedConsState.NextSyncCommittee) {
+			return errorsmod.Wrap(ErrInvalidSyncCommittee, "sync committee does not match the next sync committee of the trusted consensus state")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidHeader, "signature period %d must be the trusted period %d or the following period", signaturePeriod, storePeriod)
+	}
+
+	participants, err := cs.syncCommitteeParticipants(header.SyncCommittee, update.SyncAggregate)
+	if err != nil {
+		return err
+	}
+
+	// the finalized header and next sync committee are proven against the state of the attested header
+	attestedFork := cs.ForkParameters.forkAtEpoch(cs.computeEpoch(attested.Slot))
+	if attestedFork < forkAltair {
+		return errorsmod.Wrap(ErrUnsupportedFork, "light client updates are only supported from the Altair fork onwards")
+	}
+
+	if err := verifyMerkleBranch(finalized.HashTreeRoot(), update.FinalityBranch, finalizedRootGindexAtFork(attestedFork), attested.StateRoot); err != nil {
+		return errorsmod.Wrap(err, "invalid finality branch")
+	}
+
+	if update.NextSyncCommittee != nil {
+		if err := verifyMerkleBranch(update.NextSyncCommittee.HashTreeRoot(), update.NextSyncCommitteeBranch, nextSyncCommitteeGindexAtFork(attestedFork), attested.StateRoot); err != nil {
+			return errorsmod.Wrap(err, "invalid next sync committee branch")
+		}
+
+		// an update may not replace a next sync committee which is already known
+		if cs.computeSyncCommitteePeriod(attested.Slot) == storePeriod && len(trustedConsState.NextSyncCommittee) != 0 {
+			nextSyncCommitteeRoot := update.NextSyncCommittee.HashTreeRoot()
+			if !bytes.Equal(nextSyncCommitteeRoot[:], trustedConsState.NextSyncCommittee) {
+				return errorsmod.Wrap(ErrInvalidSyncCommittee, "next sync committee does not match the next sync committee of the trusted consensus state")
+			}
+		}
+	}
+
+	// the execution state root is proven against the body of the finalized header
+	executionGindex, err := executionStateRootGindexAtFork(cs.ForkParameters.forkAtEpoch(cs.computeEpoch(finalized.Slot)))
+	if err != nil {
+		return err
+	}
+
+	if err := verifyMerkleBranch(rootChunk(update.ExecutionStateRoot), update.ExecutionStateRootBranch, executionGindex, finalized.BodyRoot); err != nil {
+		return errorsmod.Wrap(err, "invalid execution state root branch")
+	}
+
+	// the sync committee signs the attested header in the domain of the fork of the slot before the signature slot
+	forkVersion := cs.ForkParameters.forkVersionAtEpoch(cs.computeEpoch(update.SignatureSlot - 1))
+	domain := computeDomain(domainSyncCommittee, forkVersion, cs.GenesisValidatorsRoot)
+	signingRoot := computeSigningRoot(attested.HashTreeRoot(), domain)
+
+	if err := fastAggregateVerify(participants, signingRoot[:], update.SyncAggregate.SyncCommitteeSignature); err != nil {
+		return err
+	}
+
+	storageRoot, err := verifyAccountStorageRoot(update.ExecutionStateRoot, cs.IbcContractAddress, header.AccountProof.Proof)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid account proof of the IBC contract")
+	}
+
+	if !bytes.Equal(storageRoot, header.AccountProof.StorageRoot) {
+		return errorsmod.Wrapf(ErrInvalidTrieProof, "proven storage root %X does not match the header storage root %X", storageRoot, header.AccountProof.StorageRoot)
+	}
+
+	return nil
+}
+
+// syncCommitteeParticipants returns the public keys of the members of the sync committee which participated in the
+// sync aggregate. An error is returned if the sync committee does not have the expected size or if less than two thirds
+// of its members participated.
+func (cs *ClientState) syncCommitteeParticipants(syncCommittee *SyncCommittee, syncAggregate *SyncAggregate) ([][]byte, error) {
+	if uint64(len(syncCommittee.Pubkeys)) != cs.SyncCommitteeSize {
+		return nil, errorsmod.Wrapf(ErrInvalidSyncCommittee, "expected %d sync committee members, got %d", cs.SyncCommitteeSize, len(syncCommittee.Pubkeys))
+	}
+
+	if uint64(len(syncAggregate.SyncCommitteeBits)) != cs.SyncCommitteeSize/8 {
+		return nil, errorsmod.Wrapf(ErrInvalidHeader, "expected %d bytes of sync committee bits, got %d", cs.SyncCommitteeSize/8, len(syncAggregate.SyncCommitteeBits))
+	}
+
+	var participants [][]byte
+	for i, pubkey := range syncCommittee.Pubkeys {
+		if syncAggregate.SyncCommitteeBits[i/8]&(1<<(uint(i)%8)) != 0 {
+			participants = append(participants, pubkey)
+		}
+	}
+
+	if uint64(len(participants))*3 < cs.SyncCommitteeSize*2 {
+		return nil, errorsmod.Wrapf(ErrInsufficientParticipation, "%d of %d sync committee members participated, expected at least two thirds", len(participants), cs.SyncCommitteeSize)
+	}
+
+	return participants, nil
+}
+
+// UpdateState creates a consensus state for the finalized header of the verified header and updates the latest height of
+// the client state if the finalized slot is greater than the latest height. The sync committees of the new consensus state
+// are rotated from the trusted consensus state when the finalized header is in the period following the trusted period.
+// A list containing the updated consensus height is returned.
+// If the provided clientMsg is not of type of Header then the handler will noop and empty slice is returned.
+func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		// clientMsg is invalid Misbehaviour, no update necessary
+		return []exported.Height{}
+	}
+
+	height := header.GetHeight()
+
+	// check for duplicate update
+	if _, found := GetConsensusState(clientStore, cdc, height); found {
+		// perform no-op
+		return []exported.Height{height}
+	}
+
+	trustedConsState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
+	if !found {
+		panic(fmt.Errorf("could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight))
+	}
+
+	update := header.ConsensusUpdate
+	storePeriod := cs.computeSyncCommitteePeriod(header.TrustedHeight.RevisionHeight)
+	finalizedPeriod := cs.computeSyncCommitteePeriod(update.FinalizedHeader.Slot)
+
+	// the next sync committee of the update is the next sync committee of the finalized header only if the attested
+	// and finalized headers are in the same period
+	var updateNextSyncCommittee []byte
+	if update.NextSyncCommittee != nil && cs.computeSyncCommitteePeriod(update.AttestedHeader.Slot) == finalizedPeriod {
+		root := update.NextSyncCommittee.HashTreeRoot()
+		updateNextSyncCommittee = root[:]
+	}
+
+	// the finalized period is either the trusted period or the following period, as guaranteed by header verification
+	currentSyncCommittee, nextSyncCommittee := trustedConsState.CurrentSyncCommittee, trustedConsState.NextSyncCommittee
+	if finalizedPeriod == storePeriod {
+		if len(nextSyncCommittee) == 0 {
+			nextSyncCommittee = updateNextSyncCommittee
+		}
+	} else {
+		currentSyncCommittee, nextSyncCommittee = trustedConsState.NextSyncCommittee, updateNextSyncCommittee
+	}
+
+	consensusState := NewConsensusState(
+		cs.computeTimestamp(update.FinalizedHeader.Slot),
+		update.ExecutionStateRoot,
+		header.AccountProof.StorageRoot,
+		currentSyncCommittee,
+		nextSyncCommittee,
+	)
+
+	setConsensusState(clientStore, cdc, consensusState, height)
+	setConsensusMetadata(ctx, clientStore, height)
+
+	if height.GT(cs.LatestHeight) {
+		cs.LatestHeight = height
+	}
+
+	setClientState(clientStore, cdc, &cs)
+
+	return []exported.Height{height}
+}
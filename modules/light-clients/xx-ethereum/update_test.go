@@ -0,0 +1,318 @@
+package ethereum_test
+
+import (
+	"bytes"
+
+	ethereum "github.com/cosmos/ibc-go/modules/light-clients/xx-ethereum"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+func (suite *EthereumTestSuite) TestVerifyHeader() {
+	var (
+		clientID string
+		header   *ethereum.Header
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: signed by the current sync committee",
+			func() {},
+			nil,
+		},
+		{
+			"success: signed by the next sync committee",
+			func() {
+				header = suite.newHeader(initialSlot, 130)
+			},
+			nil,
+		},
+		{
+			"success: two thirds participation",
+			func() {
+				suite.signHeader(header, 22)
+			},
+			nil,
+		},
+		{
+			"success: update without next sync committee",
+			func() {
+				header.ConsensusUpdate.NextSyncCommittee = nil
+				header.ConsensusUpdate.NextSyncCommitteeBranch = nil
+			},
+			nil,
+		},
+		{
+			"failure: trusted consensus state not found",
+			func() {
+				header.TrustedHeight = clienttypes.NewHeight(0, initialSlot-1)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: finalized slot is not after the trusted slot",
+			func() {
+				header = suite.newHeader(initialSlot, initialSlot)
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: signature slot is after the current slot",
+			func() {
+				header = suite.newHeader(initialSlot, currentSlot)
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: sync committee is not the current sync committee",
+			func() {
+				header.SyncCommittee = suite.syncCommittees[2].committee
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: next sync committee of the trusted consensus state is not known",
+			func() {
+				header = suite.newHeader(initialSlot, 130)
+
+				consensusState := suite.trustedConsensusState(clientID)
+				consensusState.NextSyncCommittee = nil
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientID, header.TrustedHeight, consensusState)
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: insufficient participation",
+			func() {
+				suite.signHeader(header, 21)
+			},
+			ethereum.ErrInsufficientParticipation,
+		},
+		{
+			"failure: invalid finality branch",
+			func() {
+				header.ConsensusUpdate.FinalityBranch[0] = bytes.Repeat([]byte{0xff}, 32)
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: invalid next sync committee branch",
+			func() {
+				header.ConsensusUpdate.NextSyncCommittee = suite.syncCommittees[3].committee
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: invalid execution state root branch",
+			func() {
+				header.ConsensusUpdate.ExecutionStateRoot = bytes.Repeat([]byte{0xff}, 32)
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: invalid signature",
+			func() {
+				header.ConsensusUpdate.AttestedHeader.ProposerIndex++
+			},
+			ethereum.ErrInvalidSignature,
+		},
+		{
+			"failure: missing account proof",
+			func() {
+				header.AccountProof.Proof = nil
+			},
+			ethereum.ErrInvalidTrieProof,
+		},
+		{
+			"failure: storage root does not match the account proof",
+			func() {
+				header.AccountProof.StorageRoot = bytes.Repeat([]byte{0xff}, 32)
+			},
+			ethereum.ErrInvalidTrieProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+			header = suite.newHeader(initialSlot, 110)
+
+			tc.malleate()
+
+			err := suite.lightClientModule(clientID).VerifyClientMessage(suite.chainA.GetContext(), clientID, header)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestUpdateState() {
+	var (
+		clientID string
+		header   *ethereum.Header
+	)
+
+	testCases := []struct {
+		name                    string
+		malleate                func()
+		expLatestSlot           uint64
+		expCurrentSyncCommittee uint64
+		expNextSyncCommittee    uint64
+	}{
+		{
+			"update within the trusted period",
+			func() {},
+			110, 1, 2,
+		},
+		{
+			"update to the next period rotates the sync committees",
+			func() {
+				header = suite.newHeader(initialSlot, 130)
+			},
+			130, 2, 3,
+		},
+		{
+			"update learns the unknown next sync committee",
+			func() {
+				consensusState := suite.trustedConsensusState(clientID)
+				consensusState.NextSyncCommittee = nil
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientID, header.TrustedHeight, consensusState)
+			},
+			110, 1, 2,
+		},
+		{
+			"update to a past height does not change the latest height",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, suite.newHeader(initialSlot, 130))
+				suite.Require().NoError(err)
+			},
+			130, 1, 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setCommitment([]byte("commitments/ports/transfer/channels/channel-0/sequences/1"), []byte("commitment"))
+
+			clientID = suite.createClient()
+			header = suite.newHeader(initialSlot, 110)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+			suite.Require().NoError(err)
+
+			lightClientModule := suite.lightClientModule(clientID)
+			suite.Require().Equal(clienttypes.NewHeight(0, tc.expLatestSlot), lightClientModule.LatestHeight(suite.chainA.GetContext(), clientID))
+			suite.Require().Equal(exported.Active, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+
+			consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, header.GetHeight())
+			suite.Require().True(found)
+
+			expConsensusState := ethereum.NewConsensusState(
+				timestampAtSlot(suite.clientState(), header.GetHeight().RevisionHeight),
+				header.ConsensusUpdate.ExecutionStateRoot,
+				header.AccountProof.StorageRoot,
+				suite.syncCommitteeRoot(tc.expCurrentSyncCommittee),
+				suite.syncCommitteeRoot(tc.expNextSyncCommittee),
+			)
+			suite.Require().Equal(expConsensusState, consensusState)
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestCheckForMisbehaviour() {
+	var (
+		clientID  string
+		clientMsg exported.ClientMessage
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expFrozen bool
+	}{
+		{
+			"no misbehaviour: new header",
+			func() {
+				clientMsg = suite.newHeader(initialSlot, 120)
+			},
+			false,
+		},
+		{
+			"no misbehaviour: duplicate header",
+			func() {
+				clientMsg = suite.newHeader(initialSlot, 110)
+			},
+			false,
+		},
+		{
+			"misbehaviour: header conflicts with an existing consensus state",
+			func() {
+				suite.setCommitment([]byte("commitments/ports/transfer/channels/channel-0/sequences/2"), []byte("commitment"))
+				suite.proposerIndex = 1
+				clientMsg = suite.newHeader(initialSlot, 110)
+			},
+			true,
+		},
+		{
+			"misbehaviour: conflicting finalized headers",
+			func() {
+				header1 := suite.newHeader(initialSlot, 115)
+				suite.proposerIndex = 1
+				header2 := suite.newHeader(initialSlot, 115)
+
+				clientMsg = ethereum.NewMisbehaviour(header1, header2)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, suite.newHeader(initialSlot, 110))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, clientMsg)
+			suite.Require().NoError(err)
+
+			status := suite.lightClientModule(clientID).Status(suite.chainA.GetContext(), clientID)
+			if tc.expFrozen {
+				suite.Require().Equal(exported.Frozen, status)
+			} else {
+				suite.Require().Equal(exported.Active, status)
+			}
+		})
+	}
+}
+
+// trustedConsensusState returns the consensus state of the client at the initial slot.
+func (suite *EthereumTestSuite) trustedConsensusState(clientID string) *ethereum.ConsensusState {
+	consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, clienttypes.NewHeight(0, initialSlot))
+	suite.Require().True(found)
+
+	return consensusState.(*ethereum.ConsensusState)
+}
@@ -10,6 +10,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -191,6 +192,31 @@ func NewTestChain(t *testing.T, coord *Coordinator, chainID string) *TestChain {
 	return NewTestChainWithValSet(t, coord, chainID, valSet, signersByAddress)
 }
 
+// RestartWithRevision halts the chain and restarts it with the provided revision number set in
+// its chain ID. The chain continues from its latest committed height, with the next proposed
+// block being the first block of the new revision. The revision number must be greater than the
+// current revision number of the chain.
+func (chain *TestChain) RestartWithRevision(revisionNumber uint64) error {
+	if !clienttypes.IsRevisionFormat(chain.ChainID) {
+		return fmt.Errorf("cannot restart chain which is not of revision format: %s", chain.ChainID)
+	}
+
+	if currentRevision := clienttypes.ParseChainID(chain.ChainID); revisionNumber <= currentRevision {
+		return fmt.Errorf("revision number must be greater than current revision number (%d <= %d)", revisionNumber, currentRevision)
+	}
+
+	newChainID, err := clienttypes.SetRevisionNumber(chain.ChainID, revisionNumber)
+	if err != nil {
+		return err
+	}
+
+	baseapp.SetChainID(newChainID)(chain.App.GetBaseApp())
+	chain.ChainID = newChainID
+	chain.ProposedHeader.ChainID = newChainID
+
+	return nil
+}
+
 // GetContext returns the current context for the application.
 func (chain *TestChain) GetContext() sdk.Context {
 	return chain.App.GetBaseApp().NewUncachedContext(false, chain.ProposedHeader)
@@ -9,6 +9,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -41,3 +44,54 @@ func TestChangeValSet(t *testing.T) {
 	err = path.EndpointB.UpdateClient()
 	require.NoError(t, err)
 }
+
+func TestNewCoordinatorWithRevision(t *testing.T) {
+	coord := ibctesting.NewCoordinatorWithRevision(t, 2, 5)
+	chainA := coord.GetChain(ibctesting.GetChainIDWithRevision(1, 5))
+	chainB := coord.GetChain(ibctesting.GetChainIDWithRevision(2, 5))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.Setup()
+
+	require.Equal(t, uint64(5), path.EndpointA.GetClientLatestHeight().GetRevisionNumber())
+	require.Equal(t, uint64(5), path.EndpointB.GetClientLatestHeight().GetRevisionNumber())
+}
+
+func TestRestartWithRevision(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+
+	require.Error(t, chainA.RestartWithRevision(clienttypes.ParseChainID(chainA.ChainID)))
+
+	require.NoError(t, chainA.RestartWithRevision(3))
+	require.Equal(t, ibctesting.GetChainIDWithRevision(1, 3), chainA.ChainID)
+
+	chainA.NextBlock()
+	require.Equal(t, uint64(3), chainA.LatestCommittedHeader.GetHeight().GetRevisionNumber())
+}
+
+func TestUpgradeChain(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.Setup()
+
+	err := path.EndpointA.UpgradeChain()
+	require.NoError(t, err)
+
+	clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+	require.True(t, ok)
+	require.Equal(t, chainA.ChainID, clientState.ChainId)
+	require.Equal(t, uint64(2), clientState.LatestHeight.GetRevisionNumber())
+
+	// packets sent after the upgrade are verified using the upgraded client
+	timeoutHeight := clienttypes.NewHeight(1, 1000)
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	require.NoError(t, err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	err = path.RelayPacket(packet)
+	require.NoError(t, err)
+}
@@ -34,6 +34,37 @@ func (*TendermintConfig) GetClientType() string {
 	return exported.Tendermint
 }
 
+// WasmConfig defines the client configuration used by an endpoint to create and update
+// 08-wasm light clients. The testing package does not depend on the 08-wasm module, so the
+// wasm client state, consensus state and client messages are supplied by the configured
+// WasmHeaderSource.
+type WasmConfig struct {
+	HeaderSource WasmHeaderSource
+}
+
+// WasmHeaderSource provides the states and client messages for an 08-wasm client tracking
+// a counterparty TestChain. Implementations wrap the data understood by the light client
+// contract (for example grandpa, near or ethereum headers) in the 08-wasm types.
+type WasmHeaderSource interface {
+	// ClientAndConsensusState returns the 08-wasm client state and consensus state used to
+	// create a client of the counterparty chain at its latest committed height.
+	ClientAndConsensusState(counterparty *TestChain) (exported.ClientState, exported.ConsensusState, error)
+
+	// ClientMessage returns the 08-wasm client message used to update the client from the
+	// trusted height to the latest committed height of the counterparty chain.
+	ClientMessage(counterparty *TestChain, trustedHeight exported.Height) (exported.ClientMessage, error)
+}
+
+func NewWasmConfig(headerSource WasmHeaderSource) *WasmConfig {
+	return &WasmConfig{
+		HeaderSource: headerSource,
+	}
+}
+
+func (*WasmConfig) GetClientType() string {
+	return exported.Wasm
+}
+
 type ConnectionConfig struct {
 	DelayPeriod uint64
 	Version     *connectiontypes.Version
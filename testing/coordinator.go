@@ -28,6 +28,22 @@ type Coordinator struct {
 
 // NewCoordinator initializes Coordinator with N TestChain's
 func NewCoordinator(t *testing.T, n int) *Coordinator {
+	t.Helper()
+	return newCoordinator(t, n, GetChainID)
+}
+
+// NewCoordinatorWithRevision initializes Coordinator with N TestChain's started at the provided
+// revision number. The chains may be retrieved using GetChainIDWithRevision.
+func NewCoordinatorWithRevision(t *testing.T, n int, revisionNumber uint64) *Coordinator {
+	t.Helper()
+	return newCoordinator(t, n, func(index int) string {
+		return GetChainIDWithRevision(index, revisionNumber)
+	})
+}
+
+// newCoordinator initializes Coordinator with N TestChain's using the provided function to
+// construct the chainID of each chain.
+func newCoordinator(t *testing.T, n int, chainIDFn func(index int) string) *Coordinator {
 	t.Helper()
 	chains := make(map[string]*TestChain)
 	coord := &Coordinator{
@@ -36,7 +52,7 @@ func NewCoordinator(t *testing.T, n int) *Coordinator {
 	}
 
 	for i := 1; i <= n; i++ {
-		chainID := GetChainID(i)
+		chainID := chainIDFn(i)
 		chains[chainID] = NewTestChain(t, coord, chainID)
 	}
 	coord.Chains = chains
@@ -144,6 +160,11 @@ func GetChainID(index int) string {
 	return ChainIDPrefix + strconv.Itoa(index) + ChainIDSuffix
 }
 
+// GetChainIDWithRevision returns the chainID used for the provided index at the provided revision number.
+func GetChainIDWithRevision(index int, revisionNumber uint64) string {
+	return fmt.Sprintf("%s%d-%d", ChainIDPrefix, index, revisionNumber)
+}
+
 // CommitBlock commits a block on the provided indexes and then increments the global time.
 //
 // CONTRACT: the passed in list of indexes must not contain duplicates
@@ -1,13 +1,16 @@
 package ibctesting
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/cosmos/cosmos-sdk/baseapp"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -101,6 +104,12 @@ func (endpoint *Endpoint) CreateClient() (err error) {
 			endpoint.Counterparty.Chain.ChainID, tmConfig.TrustLevel, tmConfig.TrustingPeriod, tmConfig.UnbondingPeriod, tmConfig.MaxClockDrift,
 			height, commitmenttypes.GetSDKSpecs(), UpgradePath)
 		consensusState = endpoint.Counterparty.Chain.LatestCommittedHeader.ConsensusState()
+	case exported.Wasm:
+		wasmConfig, ok := endpoint.ClientConfig.(*WasmConfig)
+		require.True(endpoint.Chain.TB, ok)
+		require.NotNil(endpoint.Chain.TB, wasmConfig.HeaderSource)
+
+		clientState, consensusState, err = wasmConfig.HeaderSource.ClientAndConsensusState(endpoint.Counterparty.Chain)
 	case exported.Solomachine:
 		// TODO
 		//		solo := NewSolomachine(endpoint.Chain.TB, endpoint.Chain.Codec, clientID, "", 1)
@@ -142,6 +151,12 @@ func (endpoint *Endpoint) UpdateClient() (err error) {
 		trustedHeight, ok := endpoint.GetClientLatestHeight().(clienttypes.Height)
 		require.True(endpoint.Chain.TB, ok)
 		header, err = endpoint.Counterparty.Chain.IBCClientHeader(endpoint.Counterparty.Chain.LatestCommittedHeader, trustedHeight)
+	case exported.Wasm:
+		wasmConfig, ok := endpoint.ClientConfig.(*WasmConfig)
+		require.True(endpoint.Chain.TB, ok)
+		require.NotNil(endpoint.Chain.TB, wasmConfig.HeaderSource)
+
+		header, err = wasmConfig.HeaderSource.ClientMessage(endpoint.Counterparty.Chain, endpoint.GetClientLatestHeight())
 	default:
 		err = fmt.Errorf("client type %s is not supported", endpoint.ClientConfig.GetClientType())
 	}
@@ -159,11 +174,13 @@ func (endpoint *Endpoint) UpdateClient() (err error) {
 	return endpoint.Chain.sendMsgs(msg)
 }
 
-// UpgradeChain will upgrade a chain's chainID to the next revision number.
-// It will also update the counterparty client.
-// TODO: implement actual upgrade chain functionality via scheduling an upgrade
-// and upgrading the client via MsgUpgradeClient
-// see reference https://github.com/cosmos/ibc-go/pull/1169
+// UpgradeChain will upgrade a chain's chainID to the next revision number and upgrade the
+// counterparty client using MsgUpgradeClient. An IBC software upgrade is scheduled on the chain
+// and the chain is committed up to the last block of the current revision. The counterparty
+// client is then updated to the upgrade height and upgraded using proofs of the upgraded client
+// and consensus states committed by the chain. Finally, the chain is restarted at the next
+// revision, applying the upgrade plan, and the counterparty client is updated to a header of
+// the new revision.
 func (endpoint *Endpoint) UpgradeChain() error {
 	if strings.TrimSpace(endpoint.Counterparty.ClientID) == "" {
 		return fmt.Errorf("cannot upgrade chain if there is no counterparty client")
@@ -185,31 +202,87 @@ func (endpoint *Endpoint) UpgradeChain() error {
 		return err
 	}
 
-	// update chain
-	baseapp.SetChainID(newChainID)(endpoint.Chain.App.GetBaseApp())
-	endpoint.Chain.ChainID = newChainID
-	endpoint.Chain.ProposedHeader.ChainID = newChainID
-	endpoint.Chain.NextBlock() // commit changes
+	// the plan height must leave room for the block preceding it to commit the upgraded consensus state
+	planHeight := endpoint.Chain.ProposedHeader.Height + 2
+	plan := upgradetypes.Plan{
+		Name:   fmt.Sprintf("upgrade-%s", newChainID),
+		Height: planHeight,
+	}
+
+	upgradedClientState := tmClientState.ZeroCustomFields()
+	upgradedClientState.ChainId = newChainID
+	upgradedClientState.LatestHeight = clienttypes.NewHeight(revisionNumber+1, uint64(planHeight))
+
+	if err := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.ScheduleIBCSoftwareUpgrade(endpoint.Chain.GetContext(), plan, upgradedClientState); err != nil {
+		return err
+	}
+
+	// commit blocks up to the last block of the current revision
+	endpoint.Chain.Coordinator.CommitNBlocks(endpoint.Chain, 2)
+
+	// the chain halts at the plan height, the header of the halted block commits to the state
+	// containing the upgraded client and consensus states
+	trustedHeight, ok := endpoint.Counterparty.GetClientLatestHeight().(clienttypes.Height)
+	require.True(endpoint.Chain.TB, ok)
+
+	header, err := endpoint.Chain.IBCClientHeader(endpoint.Chain.CurrentTMClientHeader(), trustedHeight)
+	if err != nil {
+		return err
+	}
+
+	updateMsg, err := clienttypes.NewMsgUpdateClient(
+		endpoint.Counterparty.ClientID, header,
+		endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+	)
+	require.NoError(endpoint.Chain.TB, err)
+
+	if err := endpoint.Counterparty.Chain.sendMsgs(updateMsg); err != nil {
+		return err
+	}
+
+	upgradedConsStateBz, err := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetUpgradedConsensusState(endpoint.Chain.GetContext(), planHeight)
+	if err != nil {
+		return err
+	}
+
+	upgradedConsState, err := clienttypes.UnmarshalConsensusState(endpoint.Chain.Codec, upgradedConsStateBz)
+	if err != nil {
+		return err
+	}
 
-	// update counterparty client manually
-	tmClientState.ChainId = newChainID
-	tmClientState.LatestHeight = clienttypes.NewHeight(revisionNumber+1, tmClientState.LatestHeight.GetRevisionHeight()+1)
+	// the proofs must be verifiable against the consensus state the counterparty client was just updated to
+	upgradeClientProof, clientProofHeight := endpoint.Chain.QueryUpgradeProof(upgradetypes.UpgradedClientKey(planHeight), uint64(planHeight))
+	upgradeConsensusStateProof, consensusStateProofHeight := endpoint.Chain.QueryUpgradeProof(upgradetypes.UpgradedConsStateKey(planHeight), uint64(planHeight))
+	require.Equal(endpoint.Chain.TB, header.GetHeight(), clientProofHeight)
+	require.Equal(endpoint.Chain.TB, header.GetHeight(), consensusStateProofHeight)
 
-	endpoint.Counterparty.SetClientState(clientState)
+	upgradeMsg, err := clienttypes.NewMsgUpgradeClient(
+		endpoint.Counterparty.ClientID, upgradedClientState, upgradedConsState,
+		upgradeClientProof, upgradeConsensusStateProof,
+		endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+	)
+	require.NoError(endpoint.Chain.TB, err)
 
-	tmConsensusState := &ibctm.ConsensusState{
-		Timestamp:          endpoint.Chain.LatestCommittedHeader.GetTime(),
-		Root:               commitmenttypes.NewMerkleRoot(endpoint.Chain.LatestCommittedHeader.Header.GetAppHash()),
-		NextValidatorsHash: endpoint.Chain.LatestCommittedHeader.Header.NextValidatorsHash,
+	if err := endpoint.Counterparty.Chain.sendMsgs(upgradeMsg); err != nil {
+		return err
 	}
 
-	latestHeight := endpoint.Counterparty.GetClientLatestHeight()
+	// restart the chain at the next revision with an upgrade handler installed for the plan
+	endpoint.Chain.App.GetUpgradeKeeper().SetUpgradeHandler(
+		plan.Name,
+		func(_ context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return fromVM, nil
+		},
+	)
+
+	if err := endpoint.Chain.RestartWithRevision(revisionNumber + 1); err != nil {
+		return err
+	}
 
-	endpoint.Counterparty.SetConsensusState(tmConsensusState, latestHeight)
+	endpoint.Chain.NextBlock()
 
 	// ensure the next update isn't identical to the one set in state
 	endpoint.Chain.Coordinator.IncrementTime()
-	endpoint.Chain.NextBlock()
 
 	return endpoint.Counterparty.UpdateClient()
 }
@@ -930,3 +1003,46 @@ func (endpoint *Endpoint) UpdateConnection(updater func(connection *connectionty
 
 	endpoint.SetConnection(connection)
 }
+
+// AssertPacketCommitted asserts that a packet commitment for the provided sequence is stored on the channel
+// associated with the endpoint. The test fails if the packet commitment does not exist.
+func (endpoint *Endpoint) AssertPacketCommitted(sequence uint64) {
+	commitment := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence)
+	require.NotEmpty(endpoint.Chain.TB, commitment, "packet commitment not found at %s on chain %s", host.PacketCommitmentPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence), endpoint.Chain.ChainID)
+}
+
+// AssertPacketCommitmentDeleted asserts that no packet commitment for the provided sequence is stored on the
+// channel associated with the endpoint, as is the case once the packet has been acknowledged or timed out.
+func (endpoint *Endpoint) AssertPacketCommitmentDeleted(sequence uint64) {
+	commitment := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence)
+	require.Empty(endpoint.Chain.TB, commitment, "packet commitment found at %s on chain %s", host.PacketCommitmentPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence), endpoint.Chain.ChainID)
+}
+
+// AssertPacketReceived asserts that the packet with the provided sequence has been received on the channel
+// associated with the endpoint. For unordered channels a packet receipt must be stored, for ordered channels
+// the next sequence to be received must be greater than the provided sequence.
+func (endpoint *Endpoint) AssertPacketReceived(sequence uint64) {
+	ctx := endpoint.Chain.GetContext()
+	channelKeeper := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper
+
+	if endpoint.GetChannel().Ordering == channeltypes.ORDERED {
+		nextSequenceRecv, found := channelKeeper.GetNextSequenceRecv(ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+		require.True(endpoint.Chain.TB, found, "next sequence receive not found at %s on chain %s", host.NextSequenceRecvPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID), endpoint.Chain.ChainID)
+		require.Greater(endpoint.Chain.TB, nextSequenceRecv, sequence, "packet with sequence %d not received at %s on chain %s", sequence, host.NextSequenceRecvPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID), endpoint.Chain.ChainID)
+		return
+	}
+
+	_, found := channelKeeper.GetPacketReceipt(ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence)
+	require.True(endpoint.Chain.TB, found, "packet receipt not found at %s on chain %s", host.PacketReceiptPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence), endpoint.Chain.ChainID)
+}
+
+// AssertAckWritten asserts that the commitment of the expected acknowledgement is stored for the provided
+// sequence on the channel associated with the endpoint. The test fails if no acknowledgement has been written
+// or if the stored acknowledgement commitment does not match the expected acknowledgement.
+func (endpoint *Endpoint) AssertAckWritten(sequence uint64, expectedAck exported.Acknowledgement) {
+	path := host.PacketAcknowledgementPath(endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence)
+
+	ackCommitment, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence)
+	require.True(endpoint.Chain.TB, found, "acknowledgement not found at %s on chain %s", path, endpoint.Chain.ChainID)
+	require.Equal(endpoint.Chain.TB, channeltypes.CommitAcknowledgement(expectedAck.Acknowledgement()), ackCommitment, "acknowledgement commitment at %s on chain %s does not match the expected acknowledgement", path, endpoint.Chain.ChainID)
+}
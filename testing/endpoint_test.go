@@ -0,0 +1,58 @@
+package ibctesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func TestPacketLifecycleAssertions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(path *ibctesting.Path)
+	}{
+		{
+			"unordered channel",
+			func(path *ibctesting.Path) {},
+		},
+		{
+			"ordered channel",
+			func(path *ibctesting.Path) {
+				path.SetChannelOrdered()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			coord := ibctesting.NewCoordinator(t, 2)
+			chainA := coord.GetChain(ibctesting.GetChainID(1))
+			chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+			path := ibctesting.NewPath(chainA, chainB)
+			tc.malleate(path)
+			path.Setup()
+
+			timeoutHeight := clienttypes.NewHeight(1, 110)
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			require.NoError(t, err)
+
+			path.EndpointA.AssertPacketCommitted(sequence)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err = path.RelayPacket(packet)
+			require.NoError(t, err)
+
+			path.EndpointB.AssertPacketReceived(sequence)
+			path.EndpointB.AssertAckWritten(sequence, mock.MockAcknowledgement)
+			path.EndpointA.AssertPacketCommitmentDeleted(sequence)
+		})
+	}
+}
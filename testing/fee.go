@@ -0,0 +1,137 @@
+package ibctesting
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// FeeVersion returns the channel version negotiated by the 29-fee middleware wrapping an application
+// with the provided application version.
+func FeeVersion(appVersion string) string {
+	return string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: appVersion}))
+}
+
+// EnableFee wraps the application version in the channel config of the endpoint with the 29-fee middleware
+// version metadata. As the mock module bound to the mock port is not wrapped with the fee middleware, an
+// endpoint configured with the mock port is moved to the mock fee port.
+func (endpoint *Endpoint) EnableFee() {
+	if endpoint.ChannelConfig.PortID == MockPort {
+		endpoint.ChannelConfig.PortID = MockFeePort
+	}
+
+	endpoint.ChannelConfig.Version = FeeVersion(endpoint.ChannelConfig.Version)
+}
+
+// PayPacketFee escrows the provided packet fee for the packet with the provided sequence sent on the channel
+// associated with the endpoint. The fee is escrowed from the refund address of the packet fee, as if it had
+// submitted a MsgPayPacketFeeAsync.
+func (endpoint *Endpoint) PayPacketFee(sequence uint64, packetFee feetypes.PacketFee) error {
+	packetID := channeltypes.NewPacketID(endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence)
+	msg := feetypes.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+	if _, err := endpoint.Chain.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(endpoint.Chain.GetContext(), msg); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// SetupWithFee enables the 29-fee middleware on both endpoints of the path and constructs a TM client,
+// connection, and fee enabled channel on both chains provided. It will fail if any error occurs.
+func (path *Path) SetupWithFee() {
+	path.EndpointA.EnableFee()
+	path.EndpointB.EnableFee()
+
+	path.Setup()
+}
+
+// RelayPacketWithFee escrows the provided packet fee for the packet, relays the packet to the counterparty
+// endpoint and acknowledges it on the sending endpoint. It asserts that the fee is held in escrow by the fee
+// module until the packet is acknowledged, and that it is then distributed as follows: the receive fee is paid
+// to the forward relayer, the acknowledgement fee is paid to the reverse relayer and the remainder of the fee is
+// refunded to the refund address. The forward relayer is the counterparty payee registered by the sender account
+// of the receiving chain, and the reverse relayer is the sender account of the sending chain or its registered payee.
+// The acknowledgement written on the receiving chain is returned.
+func (path *Path) RelayPacketWithFee(packet channeltypes.Packet, packetFee feetypes.PacketFee) ([]byte, error) {
+	// the packet is sent from endpoint A if endpoint A stores its commitment, as in RelayPacketWithResults
+	source, destination := path.EndpointB, path.EndpointA
+	pc := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(path.EndpointA.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if bytes.Equal(pc, channeltypes.CommitPacket(path.EndpointA.Chain.App.AppCodec(), packet)) {
+		source, destination = path.EndpointA, path.EndpointB
+	}
+
+	chain := source.Chain
+	bankKeeper := chain.GetSimApp().BankKeeper
+	escrowAddr := authtypes.NewModuleAddress(feetypes.ModuleName)
+
+	refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the forward relayer is only set if the sender account of the receiving chain registered a counterparty payee
+	forwardRelayer, _ := destination.Chain.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(destination.Chain.GetContext(), destination.Chain.SenderAccount.GetAddress().String(), destination.ChannelID)
+
+	reverseRelayer := chain.SenderAccount.GetAddress()
+	if payee, found := chain.GetSimApp().IBCFeeKeeper.GetPayeeAddress(chain.GetContext(), reverseRelayer.String(), source.ChannelID); found {
+		reverseRelayer = sdk.MustAccAddressFromBech32(payee)
+	}
+
+	// the receive fee is refunded if there is no forward relayer or it is not a valid address of the sending chain
+	recvFeeAddr := refundAddr
+	if addr, err := sdk.AccAddressFromBech32(forwardRelayer); err == nil && !bankKeeper.BlockedAddr(addr) {
+		recvFeeAddr = addr
+	}
+
+	escrowBalance := bankKeeper.GetAllBalances(chain.GetContext(), escrowAddr)
+	balances := make(map[string]sdk.Coins)
+	for _, addr := range []sdk.AccAddress{refundAddr, recvFeeAddr, reverseRelayer} {
+		balances[addr.String()] = bankKeeper.GetAllBalances(chain.GetContext(), addr)
+	}
+
+	if err := source.PayPacketFee(packet.GetSequence(), packetFee); err != nil {
+		return nil, err
+	}
+
+	total := packetFee.Fee.Total()
+	require.Equal(chain.TB, escrowBalance.Add(total...), bankKeeper.GetAllBalances(chain.GetContext(), escrowAddr), "fee not escrowed on chain %s", chain.ChainID)
+	require.Equal(chain.TB, balances[refundAddr.String()].Sub(total...), bankKeeper.GetAllBalances(chain.GetContext(), refundAddr), "fee not deducted from refund address on chain %s", chain.ChainID)
+
+	_, ack, err := path.RelayPacketWithResults(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	var incentivizedAck feetypes.IncentivizedAcknowledgement
+	if err := feetypes.ModuleCdc.UnmarshalJSON(ack, &incentivizedAck); err != nil {
+		return nil, fmt.Errorf("acknowledgement is not an incentivized acknowledgement: %w", err)
+	}
+
+	require.Equal(chain.TB, forwardRelayer, incentivizedAck.ForwardRelayerAddress, "unexpected forward relayer in acknowledgement")
+
+	expBalances := make(map[string]sdk.Coins, len(balances))
+	for addr, balance := range balances {
+		expBalances[addr] = balance
+	}
+	// the refund address was charged the total fee and is refunded everything but the relayer fees
+	expBalances[refundAddr.String()] = expBalances[refundAddr.String()].Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
+	expBalances[recvFeeAddr.String()] = expBalances[recvFeeAddr.String()].Add(packetFee.Fee.RecvFee...)
+	expBalances[reverseRelayer.String()] = expBalances[reverseRelayer.String()].Add(packetFee.Fee.AckFee...)
+
+	require.Equal(chain.TB, escrowBalance, bankKeeper.GetAllBalances(chain.GetContext(), escrowAddr), "fee not released from escrow on chain %s", chain.ChainID)
+	for addr, expBalance := range expBalances {
+		require.Equal(chain.TB, expBalance, bankKeeper.GetAllBalances(chain.GetContext(), sdk.MustAccAddressFromBech32(addr)), "unexpected balance of %s on chain %s after fee distribution", addr, chain.ChainID)
+	}
+
+	return ack, nil
+}
@@ -0,0 +1,107 @@
+package ibctesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestRelayPacketWithFee(t *testing.T) {
+	var (
+		packetData []byte
+		source     func(path *ibctesting.Path) (*ibctesting.Endpoint, *ibctesting.Endpoint)
+	)
+
+	testCases := []struct {
+		name          string
+		malleate      func(path *ibctesting.Path)
+		registerPayee bool
+	}{
+		{
+			"mock channel",
+			func(path *ibctesting.Path) {},
+			false,
+		},
+		{
+			"mock channel with counterparty payee",
+			func(path *ibctesting.Path) {},
+			true,
+		},
+		{
+			"transfer channel",
+			func(path *ibctesting.Path) {
+				path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+				path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+				path.EndpointA.ChannelConfig.Version = transfertypes.Version
+				path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+				packetData = transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", path.EndpointA.Chain.SenderAccount.GetAddress().String(), path.EndpointB.Chain.SenderAccount.GetAddress().String(), "").GetBytes()
+			},
+			true,
+		},
+		{
+			"packet sent from endpoint B",
+			func(path *ibctesting.Path) {
+				source = func(path *ibctesting.Path) (*ibctesting.Endpoint, *ibctesting.Endpoint) {
+					return path.EndpointB, path.EndpointA
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			coord := ibctesting.NewCoordinator(t, 2)
+			chainA := coord.GetChain(ibctesting.GetChainID(1))
+			chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+			path := ibctesting.NewPath(chainA, chainB)
+			packetData = ibctesting.MockPacketData
+			source = func(path *ibctesting.Path) (*ibctesting.Endpoint, *ibctesting.Endpoint) {
+				return path.EndpointA, path.EndpointB
+			}
+
+			tc.malleate(path)
+			path.SetupWithFee()
+
+			sender, receiver := source(path)
+
+			if tc.registerPayee {
+				counterpartyPayee := sender.Chain.SenderAccounts[2].SenderAccount.GetAddress().String()
+				receiver.Chain.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(receiver.Chain.GetContext(), receiver.Chain.SenderAccount.GetAddress().String(), counterpartyPayee, receiver.ChannelID)
+			}
+
+			isFeeEnabled := sender.Chain.GetSimApp().IBCFeeKeeper.IsFeeEnabled(sender.Chain.GetContext(), sender.ChannelConfig.PortID, sender.ChannelID)
+			require.True(t, isFeeEnabled)
+
+			timeoutHeight := clienttypes.NewHeight(1, 110)
+			sequence, err := sender.SendPacket(timeoutHeight, 0, packetData)
+			require.NoError(t, err)
+
+			fee := feetypes.NewFee(
+				sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+				sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+				sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)),
+			)
+			packetFee := feetypes.NewPacketFee(fee, sender.Chain.SenderAccounts[1].SenderAccount.GetAddress().String(), nil)
+
+			packet := channeltypes.NewPacket(packetData, sequence, sender.ChannelConfig.PortID, sender.ChannelID, receiver.ChannelConfig.PortID, receiver.ChannelID, timeoutHeight, 0)
+			ack, err := path.RelayPacketWithFee(packet, packetFee)
+			require.NoError(t, err)
+			require.NotEmpty(t, ack)
+
+			sender.AssertPacketCommitmentDeleted(sequence)
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package ibctesting
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+const (
+	// ScenarioActionSend sends a packet from the step chain to its counterparty.
+	ScenarioActionSend = "send"
+	// ScenarioActionRecv receives a previously sent packet on its destination chain.
+	ScenarioActionRecv = "recv"
+	// ScenarioActionAck acknowledges a previously received packet on its source chain.
+	ScenarioActionAck = "ack"
+	// ScenarioActionRelay receives a previously sent packet and relays its acknowledgement back to the source chain.
+	ScenarioActionRelay = "relay"
+	// ScenarioActionTimeout times out a previously sent packet on its source chain.
+	ScenarioActionTimeout = "timeout"
+	// ScenarioActionUpdateClient updates the client of the counterparty chain on the step chain.
+	ScenarioActionUpdateClient = "update_client"
+	// ScenarioActionMisbehaviour submits misbehaviour of the counterparty chain to the client on the step chain, freezing it.
+	ScenarioActionMisbehaviour = "misbehaviour"
+	// ScenarioActionCommit commits blocks on the step chain.
+	ScenarioActionCommit = "commit"
+	// ScenarioActionIncrementTime increments the time of the coordinator.
+	ScenarioActionIncrementTime = "increment_time"
+)
+
+// Scenario is a declarative description of a packet flow between two chains connected over a channel
+// of the mock application. Scenarios can be written as JSON or YAML documents, which allows reproduction
+// cases to be attached to bug reports and replayed as regression tests with RunScenario.
+//
+// An example scenario, in which the client on chain B is frozen before a packet sent from chain A is received:
+//
+//	name: recv after misbehaviour
+//	ordering: UNORDERED
+//	steps:
+//	  - {action: send, chain: A}
+//	  - {action: misbehaviour, chain: B}
+//	  - {action: recv, packet: 0, expect_error: "Frozen"}
+type Scenario struct {
+	// Name of the scenario, used in error messages.
+	Name string `yaml:"name"`
+	// Ordering of the channel, either ORDERED or UNORDERED. Defaults to UNORDERED.
+	Ordering string `yaml:"ordering"`
+	// Steps executed in order.
+	Steps []ScenarioStep `yaml:"steps"`
+}
+
+// ScenarioStep is a single step of a Scenario.
+type ScenarioStep struct {
+	// Action performed by the step.
+	Action string `yaml:"action"`
+	// Chain acting in the step, either A or B. Required by the send, update_client, misbehaviour
+	// and commit actions. Packet actions derive their chains from the packet.
+	Chain string `yaml:"chain"`
+	// Packet is the index of the packet acted on, in the order the packets were sent in the scenario.
+	Packet int `yaml:"packet"`
+	// Data of the packet sent. Defaults to the mock packet data.
+	Data string `yaml:"data"`
+	// TimeoutBlocks is the number of blocks after the current height of the destination chain at which
+	// a sent packet times out. Defaults to 100.
+	TimeoutBlocks uint64 `yaml:"timeout_blocks"`
+	// Blocks is the number of blocks committed by the commit action. Defaults to 1.
+	Blocks uint64 `yaml:"blocks"`
+	// Duration by which the increment_time action increments the coordinator time, e.g. "1h".
+	Duration string `yaml:"duration"`
+	// ExpectError is a substring of the error the step is expected to fail with. Steps without an
+	// expected error must succeed.
+	ExpectError string `yaml:"expect_error"`
+}
+
+// ParseScenario parses a scenario from its JSON or YAML encoding. Unknown fields are rejected.
+func ParseScenario(bz []byte) (Scenario, error) {
+	var scenario Scenario
+	if err := yaml.UnmarshalStrict(bz, &scenario); err != nil {
+		return Scenario{}, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+
+	if err := scenario.Validate(); err != nil {
+		return Scenario{}, err
+	}
+
+	return scenario, nil
+}
+
+// LoadScenario reads and parses the scenario in the provided JSON or YAML file.
+func LoadScenario(file string) (Scenario, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return Scenario{}, err
+	}
+
+	return ParseScenario(bz)
+}
+
+// Validate performs basic validation of the scenario. Packet references are only
+// checked when the scenario is run.
+func (s Scenario) Validate() error {
+	if _, err := s.order(); err != nil {
+		return err
+	}
+
+	if len(s.Steps) == 0 {
+		return fmt.Errorf("scenario %q has no steps", s.Name)
+	}
+
+	for i, step := range s.Steps {
+		if err := step.validate(); err != nil {
+			return fmt.Errorf("scenario %q step %d: %w", s.Name, i, err)
+		}
+	}
+
+	return nil
+}
+
+// order returns the channel ordering of the scenario.
+func (s Scenario) order() (channeltypes.Order, error) {
+	switch strings.ToUpper(s.Ordering) {
+	case "", "UNORDERED":
+		return channeltypes.UNORDERED, nil
+	case "ORDERED":
+		return channeltypes.ORDERED, nil
+	default:
+		return channeltypes.NONE, fmt.Errorf("scenario %q has invalid channel ordering %q", s.Name, s.Ordering)
+	}
+}
+
+// validate performs basic validation of the scenario step.
+func (s ScenarioStep) validate() error {
+	switch s.Action {
+	case ScenarioActionSend, ScenarioActionUpdateClient, ScenarioActionMisbehaviour, ScenarioActionCommit:
+		if s.Chain != "A" && s.Chain != "B" {
+			return fmt.Errorf("action %s requires chain A or B, got %q", s.Action, s.Chain)
+		}
+	case ScenarioActionRecv, ScenarioActionAck, ScenarioActionRelay, ScenarioActionTimeout:
+		if s.Packet < 0 {
+			return fmt.Errorf("action %s has negative packet index %d", s.Action, s.Packet)
+		}
+	case ScenarioActionIncrementTime:
+		if _, err := time.ParseDuration(s.Duration); err != nil {
+			return fmt.Errorf("action %s has invalid duration: %w", s.Action, err)
+		}
+	default:
+		return fmt.Errorf("unknown action %q", s.Action)
+	}
+
+	return nil
+}
+
+// scenarioPacket is a packet sent during a scenario together with the endpoints it was sent between
+// and the acknowledgement written for it, if any.
+type scenarioPacket struct {
+	packet      channeltypes.Packet
+	source      *Endpoint
+	destination *Endpoint
+	ack         []byte
+}
+
+// RunScenario sets up a channel of the mock application between chainA and chainB and executes the steps
+// of the scenario on it. An error is returned for the first step that fails unexpectedly, or that succeeds
+// although it is expected to fail. The path is returned so that the resulting state can be asserted on.
+func RunScenario(chainA, chainB *TestChain, scenario Scenario) (*Path, error) {
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+
+	order, err := scenario.order()
+	if err != nil {
+		return nil, err
+	}
+
+	path := NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.Order = order
+	path.EndpointB.ChannelConfig.Order = order
+	path.Setup()
+
+	var packets []*scenarioPacket
+	for i, step := range scenario.Steps {
+		err := runScenarioStep(path, step, &packets)
+
+		switch {
+		case step.ExpectError == "" && err != nil:
+			return path, fmt.Errorf("scenario %q step %d (%s): %w", scenario.Name, i, step.Action, err)
+		case step.ExpectError != "" && err == nil:
+			return path, fmt.Errorf("scenario %q step %d (%s): expected error containing %q", scenario.Name, i, step.Action, step.ExpectError)
+		case step.ExpectError != "" && !strings.Contains(err.Error(), step.ExpectError):
+			return path, fmt.Errorf("scenario %q step %d (%s): expected error containing %q, got: %w", scenario.Name, i, step.Action, step.ExpectError, err)
+		}
+	}
+
+	return path, nil
+}
+
+// runScenarioStep executes a single scenario step, appending sent packets to packets.
+func runScenarioStep(path *Path, step ScenarioStep, packets *[]*scenarioPacket) error {
+	endpoint := path.EndpointA
+	if step.Chain == "B" {
+		endpoint = path.EndpointB
+	}
+
+	var sp *scenarioPacket
+	switch step.Action {
+	case ScenarioActionRecv, ScenarioActionAck, ScenarioActionRelay, ScenarioActionTimeout:
+		if step.Packet >= len(*packets) {
+			return fmt.Errorf("packet %d has not been sent, %d packets sent", step.Packet, len(*packets))
+		}
+
+		sp = (*packets)[step.Packet]
+	}
+
+	switch step.Action {
+	case ScenarioActionSend:
+		data := MockPacketData
+		if step.Data != "" {
+			data = []byte(step.Data)
+		}
+
+		timeoutBlocks := step.TimeoutBlocks
+		if timeoutBlocks == 0 {
+			timeoutBlocks = 100
+		}
+
+		counterpartyChain := endpoint.Counterparty.Chain
+		timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(counterpartyChain.ChainID), uint64(counterpartyChain.GetContext().BlockHeight())+timeoutBlocks)
+
+		sequence, err := endpoint.SendPacket(timeoutHeight, 0, data)
+		if err != nil {
+			return err
+		}
+
+		packet := channeltypes.NewPacket(data, sequence, endpoint.ChannelConfig.PortID, endpoint.ChannelID, endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, timeoutHeight, 0)
+		*packets = append(*packets, &scenarioPacket{packet: packet, source: endpoint, destination: endpoint.Counterparty})
+
+		return nil
+
+	case ScenarioActionRecv:
+		if err := sp.destination.UpdateClient(); err != nil {
+			return err
+		}
+
+		res, err := sp.destination.RecvPacketWithResult(sp.packet)
+		if err != nil {
+			return err
+		}
+
+		// asynchronous acknowledgements are not emitted on receive
+		if ack, err := ParseAckFromEvents(res.Events); err == nil {
+			sp.ack = ack
+		}
+
+		return nil
+
+	case ScenarioActionAck:
+		if sp.ack == nil {
+			return fmt.Errorf("no acknowledgement written for packet %d", step.Packet)
+		}
+
+		if err := sp.source.UpdateClient(); err != nil {
+			return err
+		}
+
+		return sp.source.AcknowledgePacket(sp.packet, sp.ack)
+
+	case ScenarioActionRelay:
+		_, ack, err := path.RelayPacketWithResults(sp.packet)
+		if err != nil {
+			return err
+		}
+
+		sp.ack = ack
+		return nil
+
+	case ScenarioActionTimeout:
+		if err := sp.source.UpdateClient(); err != nil {
+			return err
+		}
+
+		return sp.source.TimeoutPacket(sp.packet)
+
+	case ScenarioActionUpdateClient:
+		return endpoint.UpdateClient()
+
+	case ScenarioActionMisbehaviour:
+		return submitMisbehaviour(endpoint)
+
+	case ScenarioActionCommit:
+		blocks := step.Blocks
+		if blocks == 0 {
+			blocks = 1
+		}
+
+		endpoint.Chain.Coordinator.CommitNBlocks(endpoint.Chain, blocks)
+		return nil
+
+	case ScenarioActionIncrementTime:
+		duration, err := time.ParseDuration(step.Duration)
+		if err != nil {
+			return err
+		}
+
+		path.EndpointA.Chain.Coordinator.IncrementTimeBy(duration)
+		return nil
+
+	default:
+		return fmt.Errorf("unknown action %q", step.Action)
+	}
+}
+
+// submitMisbehaviour submits a fork misbehaviour of the counterparty chain to the 07-tendermint
+// client on the chain of the provided endpoint, which freezes the client.
+func submitMisbehaviour(endpoint *Endpoint) error {
+	trustedHeight, ok := endpoint.GetClientLatestHeight().(clienttypes.Height)
+	if !ok {
+		return fmt.Errorf("client %s is not a 07-tendermint client", endpoint.ClientID)
+	}
+
+	counterpartyChain := endpoint.Counterparty.Chain
+	trustedVals, err := counterpartyChain.GetTrustedValidators(int64(trustedHeight.RevisionHeight))
+	if err != nil {
+		return err
+	}
+
+	if err := endpoint.UpdateClient(); err != nil {
+		return err
+	}
+
+	height, ok := endpoint.GetClientLatestHeight().(clienttypes.Height)
+	if !ok {
+		return fmt.Errorf("client %s is not a 07-tendermint client", endpoint.ClientID)
+	}
+
+	headerTime := counterpartyChain.ProposedHeader.Time
+	misbehaviour := ibctm.NewMisbehaviour(
+		endpoint.ClientID,
+		counterpartyChain.CreateTMClientHeader(counterpartyChain.ChainID, int64(height.RevisionHeight), trustedHeight, headerTime.Add(time.Minute), counterpartyChain.Vals, counterpartyChain.NextVals, trustedVals, counterpartyChain.Signers),
+		counterpartyChain.CreateTMClientHeader(counterpartyChain.ChainID, int64(height.RevisionHeight), trustedHeight, headerTime, counterpartyChain.Vals, counterpartyChain.NextVals, trustedVals, counterpartyChain.Signers),
+	)
+
+	msg, err := clienttypes.NewMsgUpdateClient(endpoint.ClientID, misbehaviour, endpoint.Chain.SenderAccount.GetAddress().String())
+	if err != nil {
+		return err
+	}
+
+	return endpoint.Chain.sendMsgs(msg)
+}
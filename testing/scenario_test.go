@@ -0,0 +1,115 @@
+package ibctesting_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestScenarios(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "scenarios", "*"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			scenario, err := ibctesting.LoadScenario(file)
+			require.NoError(t, err)
+
+			coord := ibctesting.NewCoordinator(t, 2)
+			_, err = ibctesting.RunScenario(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)), scenario)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseScenario(t *testing.T) {
+	testCases := []struct {
+		name   string
+		bz     string
+		expErr string
+	}{
+		{
+			"success: json",
+			`{"name": "json", "steps": [{"action": "send", "chain": "A"}, {"action": "relay", "packet": 0}]}`,
+			"",
+		},
+		{
+			"success: yaml",
+			"name: yaml\nordering: ordered\nsteps:\n  - {action: increment_time, duration: 1m}\n",
+			"",
+		},
+		{
+			"failure: unknown field",
+			`{"name": "unknown", "steps": [{"action": "send", "chain": "A", "sequence": 1}]}`,
+			"failed to parse scenario",
+		},
+		{
+			"failure: invalid ordering",
+			`{"name": "ordering", "ordering": "NONE", "steps": [{"action": "send", "chain": "A"}]}`,
+			"invalid channel ordering",
+		},
+		{
+			"failure: no steps",
+			`{"name": "empty"}`,
+			"has no steps",
+		},
+		{
+			"failure: unknown action",
+			`{"name": "action", "steps": [{"action": "close"}]}`,
+			"unknown action",
+		},
+		{
+			"failure: missing chain",
+			`{"name": "chain", "steps": [{"action": "send"}]}`,
+			"requires chain A or B",
+		},
+		{
+			"failure: invalid duration",
+			`{"name": "duration", "steps": [{"action": "increment_time", "duration": "1 hour"}]}`,
+			"invalid duration",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ibctesting.ParseScenario([]byte(tc.bz))
+
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestRunScenarioUnexpectedResult(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+
+	scenario := ibctesting.Scenario{
+		Name: "unexpected success",
+		Steps: []ibctesting.ScenarioStep{
+			{Action: ibctesting.ScenarioActionSend, Chain: "A"},
+			{Action: ibctesting.ScenarioActionRelay, Packet: 0, ExpectError: "timeout"},
+		},
+	}
+
+	_, err := ibctesting.RunScenario(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)), scenario)
+	require.ErrorContains(t, err, `step 1 (relay): expected error containing "timeout"`)
+
+	scenario = ibctesting.Scenario{
+		Name: "unsent packet",
+		Steps: []ibctesting.ScenarioStep{
+			{Action: ibctesting.ScenarioActionRecv, Packet: 0},
+		},
+	}
+
+	_, err = ibctesting.RunScenario(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)), scenario)
+	require.ErrorContains(t, err, "packet 0 has not been sent")
+}
@@ -1,6 +1,8 @@
 package simapp
 
 import (
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	storetypes "cosmossdk.io/store/types"
 	circuittypes "cosmossdk.io/x/circuit/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
@@ -55,17 +57,23 @@ func (app *SimApp) registerUpgradeHandlers() {
 
 	app.UpgradeKeeper.SetUpgradeHandler(
 		upgrades.V8,
-		upgrades.CreateDefaultUpgradeHandler(
-			app.ModuleManager,
-			app.configurator,
+		upgrades.WithIBCConsensusVersionsCheck(
+			upgrades.CreateDefaultUpgradeHandler(
+				app.ModuleManager,
+				app.configurator,
+			),
+			app.IBCKeeper,
 		),
 	)
 
 	app.UpgradeKeeper.SetUpgradeHandler(
 		upgrades.V8_1,
-		upgrades.CreateDefaultUpgradeHandler(
-			app.ModuleManager,
-			app.configurator,
+		upgrades.WithIBCConsensusVersionsCheck(
+			upgrades.CreateDefaultUpgradeHandler(
+				app.ModuleManager,
+				app.configurator,
+			),
+			app.IBCKeeper,
 		),
 	)
 
@@ -96,3 +104,24 @@ func (app *SimApp) registerUpgradeHandlers() {
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
 }
+
+// assertIBCConsensusVersions halts the application on startup if the IBC store was written by a newer binary, or if
+// the store migrations of the ibc module have not been run. The check is skipped before the chain is initialized and
+// when an upgrade is scheduled for the next block, since the upgrade handler runs the store migrations.
+func (app *SimApp) assertIBCConsensusVersions() {
+	lastHeight := app.LastBlockHeight()
+	if lastHeight == 0 {
+		return
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if upgradeInfo.Height == lastHeight+1 && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	app.IBCKeeper.AssertConsensusVersions(app.NewUncachedContext(false, cmtproto.Header{Height: lastHeight}))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/migrations/v6"
 	clientkeeper "github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	ibctmmigrations "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint/migrations"
 )
 
@@ -47,6 +48,23 @@ func CreateDefaultUpgradeHandler(
 	}
 }
 
+// WithIBCConsensusVersionsCheck wraps an upgrade handler so that the upgrade fails if, once the handler has run the
+// store migrations, the consensus versions recorded in the IBC store do not match the running binary.
+func WithIBCConsensusVersionsCheck(handler upgradetypes.UpgradeHandler, ibcKeeper *ibckeeper.Keeper) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm, err := handler(ctx, plan, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ibcKeeper.CheckConsensusVersions(sdk.UnwrapSDKContext(ctx)); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
+
 // CreateV6UpgradeHandler creates an upgrade handler for the ibc-go/v6 SimApp upgrade.
 // NOTE: The v6.MigrateICS27ChannelCapabiliity function can be omitted if chains do not yet implement an ICS27 controller module
 func CreateV6UpgradeHandler(
@@ -41,6 +41,7 @@ type TestingApp interface {
 	// ibc-go additions
 	GetBaseApp() *baseapp.BaseApp
 	GetStakingKeeper() ibctestingtypes.StakingKeeper
+	GetUpgradeKeeper() ibctestingtypes.UpgradeKeeper
 	GetIBCKeeper() *keeper.Keeper
 	GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper
 	GetTxConfig() client.TxConfig
@@ -3,6 +3,8 @@ package types
 import (
 	"context"
 
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -11,3 +13,9 @@ import (
 type StakingKeeper interface {
 	GetHistoricalInfo(ctx context.Context, height int64) (stakingtypes.HistoricalInfo, error)
 }
+
+// UpgradeKeeper defines the expected upgrade keeper interface used in the
+// IBC testing package
+type UpgradeKeeper interface {
+	SetUpgradeHandler(name string, upgradeHandler upgradetypes.UpgradeHandler)
+}